@@ -41,6 +41,17 @@ const (
 	MO_DATABASE_DAT_NAME_IDX = 1
 	MO_TABLES_REL_ID_IDX     = 0
 	MO_TABLES_REL_NAME_IDX   = 1
+
+	MO_COLUMNS_ATT_DATABASE_ID_IDX       = 2
+	MO_COLUMNS_ATT_RELNAME_ID_IDX        = 4
+	MO_COLUMNS_ATTNAME_IDX               = 6
+	MO_COLUMNS_ATTTYP_IDX                = 7
+	MO_COLUMNS_ATTNUM_IDX                = 8
+	MO_COLUMNS_ATT_LENGTH_IDX            = 9
+	MO_COLUMNS_ATT_CONSTRAINT_TYPE_IDX   = 14
+	MO_COLUMNS_ATT_IS_AUTO_INCREMENT_IDX = 16
+	MO_COLUMNS_ATT_COMMENT_IDX           = 17
+	MO_COLUMNS_ATT_IS_HIDDEN_IDX         = 18
 )
 
 var (
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/rpc"
 	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
@@ -110,10 +111,16 @@ func (s *service) Start() error {
 	if err := s.startCNStoreHeartbeat(); err != nil {
 		return err
 	}
+	if err := s.startTaskRunner(); err != nil {
+		return err
+	}
 	return s.server.Start()
 }
 
 func (s *service) Close() error {
+	if err := s.stopTaskRunner(); err != nil {
+		return err
+	}
 	err := s.serverShutdown(true)
 	if err != nil {
 		return err
@@ -205,6 +212,12 @@ func (s *service) createMOServer(inputCtx context.Context, pu *config.ParameterU
 	if err := metric.InitSchema(moServerCtx, ieFactory); err != nil {
 		panic(err)
 	}
+	if err := taskservice.InitSchema(moServerCtx, ieFactory()); err != nil {
+		panic(err)
+	}
+	if err := s.initTaskRunner(pu); err != nil {
+		panic(err)
+	}
 	frontend.InitServerVersion(pu.SV.MoVersion)
 	err := frontend.InitSysTenant(moServerCtx)
 	if err != nil {
@@ -212,6 +225,39 @@ func (s *service) createMOServer(inputCtx context.Context, pu *config.ParameterU
 	}
 }
 
+// initTaskRunner creates the task runner executing the tasks created by CREATE TASK.
+// The runner allocates the created tasks to itself, since the tasks are stored in the
+// tables of mo_task and there is no scheduler assigning them.
+func (s *service) initTaskRunner(pu *config.ParameterUnit) error {
+	s.taskService = taskservice.NewTaskService(
+		taskservice.NewSQLTaskStorage(frontend.NewInternalExecutor(pu)))
+	runner, err := taskservice.NewTaskRunner(s.cfg.UUID, s.taskService,
+		taskservice.WithRunnerLogger(s.logger),
+		taskservice.WithRunnerAllocateCreatedTasks())
+	if err != nil {
+		return err
+	}
+	runner.RegisterExectuor(frontend.SQLTaskExecutor, frontend.NewSQLTaskExecutor(pu))
+	s.taskRunner = runner
+	return nil
+}
+
+func (s *service) startTaskRunner() error {
+	if err := s.taskRunner.Start(); err != nil {
+		return err
+	}
+	s.taskService.StartScheduleCronTask()
+	return nil
+}
+
+func (s *service) stopTaskRunner() error {
+	s.taskService.StopScheduleCronTask()
+	if err := s.taskRunner.Stop(); err != nil {
+		return err
+	}
+	return s.taskService.Close()
+}
+
 func (s *service) runMoServer() error {
 	return s.mo.Start()
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/rpc"
 	"github.com/matrixorigin/matrixone/pkg/util/toml"
@@ -137,4 +138,6 @@ type service struct {
 	metadataFS             fileservice.ReplaceableFileService
 	fileService            fileservice.FileService
 	stopper                *stopper.Stopper
+	taskService            taskservice.TaskService
+	taskRunner             taskservice.TaskRunner
 }
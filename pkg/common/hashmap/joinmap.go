@@ -21,8 +21,9 @@ import (
 )
 
 func NewJoinMap(sels [][]int64, expr *plan.Expr, mp *StrHashMap, hasNull bool) *JoinMap {
+	cnt := int64(1)
 	return &JoinMap{
-		cnt:     1,
+		cnt:     &cnt,
 		mp:      mp,
 		expr:    expr,
 		sels:    sels,
@@ -63,17 +64,30 @@ func (jm *JoinMap) Dup() *JoinMap {
 		expr:    jm.expr,
 		sels:    jm.sels,
 		hasNull: jm.hasNull,
-		cnt:     atomic.LoadInt64(&jm.cnt),
+		cnt:     jm.cnt,
+		spilled: jm.spilled,
 	}
 }
 
+// SetSpilled sets the build rows spilled to disk, they are removed with the map.
+func (jm *JoinMap) SetSpilled(spilled Spilled) {
+	jm.spilled = spilled
+}
+
+func (jm *JoinMap) Spilled() Spilled {
+	return jm.spilled
+}
+
 func (jm *JoinMap) IncRef(ref int64) {
-	atomic.AddInt64(&jm.cnt, ref)
+	atomic.AddInt64(jm.cnt, ref)
 }
 
 func (jm *JoinMap) Free() {
-	if atomic.AddInt64(&jm.cnt, -1) != 0 {
+	if atomic.AddInt64(jm.cnt, -1) != 0 {
 		return
 	}
 	jm.mp.Free()
+	if jm.spilled != nil {
+		jm.spilled.Free()
+	}
 }
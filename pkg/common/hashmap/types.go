@@ -61,12 +61,21 @@ type Iterator interface {
 
 // JoinMap is used for join
 type JoinMap struct {
-	cnt  int64
+	// cnt is shared by the duplicates of the map
+	cnt  *int64
 	sels [][]int64
 	// push-down filter expression, possibly a bloomfilter
 	expr    *plan.Expr
 	mp      *StrHashMap
 	hasNull bool
+	// spilled has the build rows spilled to disk, which are not in the map
+	spilled Spilled
+}
+
+// Spilled is the part of the build rows of a join spilled to disk.
+type Spilled interface {
+	// Free removes the rows from disk
+	Free()
 }
 
 // StrHashMap key is []byte, value is an uint64 value (starting from 1)
@@ -0,0 +1,362 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+var errCorruptedPage = errors.New("corrupted parquet page")
+
+// Values holds the values of a column, only the slice of the physical type is used.
+// The values of ByteArray and FixedLenByteArray are both stored in Bytes.
+type Values struct {
+	Type    Type
+	Bools   []bool
+	Int32s  []int32
+	Int64s  []int64
+	Int96s  [][12]byte
+	Floats  []float32
+	Doubles []float64
+	Bytes   [][]byte
+}
+
+// Len returns the number of the values
+func (v *Values) Len() int {
+	switch v.Type {
+	case Boolean:
+		return len(v.Bools)
+	case Int32:
+		return len(v.Int32s)
+	case Int64:
+		return len(v.Int64s)
+	case Int96:
+		return len(v.Int96s)
+	case Float:
+		return len(v.Floats)
+	case Double:
+		return len(v.Doubles)
+	default:
+		return len(v.Bytes)
+	}
+}
+
+// appendValue appends the i-th value of src
+func (v *Values) appendValue(src *Values, i int) {
+	switch v.Type {
+	case Boolean:
+		v.Bools = append(v.Bools, src.Bools[i])
+	case Int32:
+		v.Int32s = append(v.Int32s, src.Int32s[i])
+	case Int64:
+		v.Int64s = append(v.Int64s, src.Int64s[i])
+	case Int96:
+		v.Int96s = append(v.Int96s, src.Int96s[i])
+	case Float:
+		v.Floats = append(v.Floats, src.Floats[i])
+	case Double:
+		v.Doubles = append(v.Doubles, src.Doubles[i])
+	default:
+		v.Bytes = append(v.Bytes, src.Bytes[i])
+	}
+}
+
+// appendZero appends the zero value, which is the place holder of the null value
+func (v *Values) appendZero() {
+	switch v.Type {
+	case Boolean:
+		v.Bools = append(v.Bools, false)
+	case Int32:
+		v.Int32s = append(v.Int32s, 0)
+	case Int64:
+		v.Int64s = append(v.Int64s, 0)
+	case Int96:
+		v.Int96s = append(v.Int96s, [12]byte{})
+	case Float:
+		v.Floats = append(v.Floats, 0)
+	case Double:
+		v.Doubles = append(v.Doubles, 0)
+	default:
+		v.Bytes = append(v.Bytes, nil)
+	}
+}
+
+// decodePlain decodes n values encoded by the PLAIN encoding
+func decodePlain(dst *Values, data []byte, n int, typeLength int) error {
+	switch dst.Type {
+	case Boolean:
+		if len(data)*8 < n {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			dst.Bools = append(dst.Bools, data[i/8]&(1<<(i%8)) != 0)
+		}
+	case Int32:
+		if len(data) < n*4 {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			dst.Int32s = append(dst.Int32s, int32(binary.LittleEndian.Uint32(data[i*4:])))
+		}
+	case Int64:
+		if len(data) < n*8 {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			dst.Int64s = append(dst.Int64s, int64(binary.LittleEndian.Uint64(data[i*8:])))
+		}
+	case Int96:
+		if len(data) < n*12 {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			var v [12]byte
+			copy(v[:], data[i*12:])
+			dst.Int96s = append(dst.Int96s, v)
+		}
+	case Float:
+		if len(data) < n*4 {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			dst.Floats = append(dst.Floats, math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:])))
+		}
+	case Double:
+		if len(data) < n*8 {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			dst.Doubles = append(dst.Doubles, math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:])))
+		}
+	case ByteArray:
+		for i := 0; i < n; i++ {
+			if len(data) < 4 {
+				return errCorruptedPage
+			}
+			size := int(binary.LittleEndian.Uint32(data))
+			if size < 0 || len(data)-4 < size {
+				return errCorruptedPage
+			}
+			dst.Bytes = append(dst.Bytes, data[4:4+size])
+			data = data[4+size:]
+		}
+	case FixedLenByteArray:
+		if typeLength <= 0 || len(data) < n*typeLength {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			dst.Bytes = append(dst.Bytes, data[i*typeLength:(i+1)*typeLength])
+		}
+	default:
+		return fmt.Errorf("unsupported parquet type %s", dst.Type)
+	}
+	return nil
+}
+
+// encodePlain encodes the values by the PLAIN encoding, the nulls are skipped
+func encodePlain(buf []byte, v *Values, nulls []bool) []byte {
+	isNull := func(i int) bool { return nulls != nil && nulls[i] }
+	switch v.Type {
+	case Boolean:
+		var b byte
+		n := 0
+		for i, value := range v.Bools {
+			if isNull(i) {
+				continue
+			}
+			if value {
+				b |= 1 << (n % 8)
+			}
+			n++
+			if n%8 == 0 {
+				buf = append(buf, b)
+				b = 0
+			}
+		}
+		if n%8 != 0 {
+			buf = append(buf, b)
+		}
+	case Int32:
+		for i, value := range v.Int32s {
+			if !isNull(i) {
+				buf = appendUint32(buf, uint32(value))
+			}
+		}
+	case Int64:
+		for i, value := range v.Int64s {
+			if !isNull(i) {
+				buf = appendUint64(buf, uint64(value))
+			}
+		}
+	case Int96:
+		for i, value := range v.Int96s {
+			if !isNull(i) {
+				buf = append(buf, value[:]...)
+			}
+		}
+	case Float:
+		for i, value := range v.Floats {
+			if !isNull(i) {
+				buf = appendUint32(buf, math.Float32bits(value))
+			}
+		}
+	case Double:
+		for i, value := range v.Doubles {
+			if !isNull(i) {
+				buf = appendUint64(buf, math.Float64bits(value))
+			}
+		}
+	case ByteArray:
+		for i, value := range v.Bytes {
+			if !isNull(i) {
+				buf = appendUint32(buf, uint32(len(value)))
+				buf = append(buf, value...)
+			}
+		}
+	case FixedLenByteArray:
+		for i, value := range v.Bytes {
+			if !isNull(i) {
+				buf = append(buf, value...)
+			}
+		}
+	}
+	return buf
+}
+
+// decodeHybrid decodes n values encoded by the RLE/bit-packing hybrid encoding
+func decodeHybrid(data []byte, bitWidth int, n int) ([]int32, error) {
+	if bitWidth < 0 || bitWidth > 32 {
+		return nil, errCorruptedPage
+	}
+	out := make([]int32, 0, n)
+	byteWidth := (bitWidth + 7) / 8
+	for len(out) < n {
+		header, k := binary.Uvarint(data)
+		if k <= 0 {
+			return nil, errCorruptedPage
+		}
+		data = data[k:]
+		if header&1 == 0 {
+			// rle run
+			count := int(header >> 1)
+			if len(data) < byteWidth {
+				return nil, errCorruptedPage
+			}
+			var value uint32
+			for i := 0; i < byteWidth; i++ {
+				value |= uint32(data[i]) << (8 * i)
+			}
+			data = data[byteWidth:]
+			for i := 0; i < count && len(out) < n; i++ {
+				out = append(out, int32(value))
+			}
+			continue
+		}
+		// bit-packed run
+		groups := int(header >> 1)
+		size := groups * bitWidth
+		if size > len(data) {
+			return nil, errCorruptedPage
+		}
+		for i := 0; i < groups*8 && len(out) < n; i++ {
+			var value uint32
+			for b := 0; b < bitWidth; b++ {
+				bit := i*bitWidth + b
+				if data[bit/8]&(1<<(bit%8)) != 0 {
+					value |= 1 << b
+				}
+			}
+			out = append(out, int32(value))
+		}
+		data = data[size:]
+	}
+	return out, nil
+}
+
+// encodeHybrid encodes the values by the RLE/bit-packing hybrid encoding, the
+// repeated values are encoded as the rle runs, the others are bit-packed.
+func encodeHybrid(buf []byte, values []int32, bitWidth int) []byte {
+	byteWidth := (bitWidth + 7) / 8
+	writeRun := func(value int32, count int) {
+		buf = appendUvarint(buf, uint64(count)<<1)
+		for i := 0; i < byteWidth; i++ {
+			buf = append(buf, byte(uint32(value)>>(8*i)))
+		}
+	}
+	writePacked := func(values []int32) {
+		groups := (len(values) + 7) / 8
+		buf = appendUvarint(buf, uint64(groups)<<1|1)
+		packed := make([]byte, groups*bitWidth)
+		for i, value := range values {
+			for b := 0; b < bitWidth; b++ {
+				if uint32(value)&(1<<b) != 0 {
+					bit := i*bitWidth + b
+					packed[bit/8] |= 1 << (bit % 8)
+				}
+			}
+		}
+		buf = append(buf, packed...)
+	}
+
+	start := 0
+	for i := 0; i < len(values); {
+		j := i + 1
+		for j < len(values) && values[j] == values[i] {
+			j++
+		}
+		if j-i < 8 {
+			i = j
+			continue
+		}
+		// the bit-packed values before the run must be a multiple of 8
+		if pad := (i - start) % 8; pad != 0 {
+			i += 8 - pad
+		}
+		if i > start {
+			writePacked(values[start:i])
+		}
+		if j > i {
+			writeRun(values[i], j-i)
+		}
+		start, i = j, j
+	}
+	if start < len(values) {
+		writePacked(values[start:])
+	}
+	return buf
+}
+
+// bitWidth returns the number of bits to represent the max value
+func bitWidth(max int) int {
+	return bits.Len32(uint32(max))
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	return append(buf, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	return appendUint32(appendUint32(buf, uint32(v)), uint32(v>>32))
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	return append(buf, b[:n]...)
+}
@@ -0,0 +1,273 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+// The structs of parquet.thrift that are used by the reader and the writer,
+// see https://github.com/apache/parquet-format/blob/master/src/main/thrift/parquet.thrift
+
+// Type is the physical type of the values
+type Type int32
+
+const (
+	Boolean           Type = 0
+	Int32             Type = 1
+	Int64             Type = 2
+	Int96             Type = 3
+	Float             Type = 4
+	Double            Type = 5
+	ByteArray         Type = 6
+	FixedLenByteArray Type = 7
+)
+
+func (t Type) String() string {
+	switch t {
+	case Boolean:
+		return "BOOLEAN"
+	case Int32:
+		return "INT32"
+	case Int64:
+		return "INT64"
+	case Int96:
+		return "INT96"
+	case Float:
+		return "FLOAT"
+	case Double:
+		return "DOUBLE"
+	case ByteArray:
+		return "BYTE_ARRAY"
+	case FixedLenByteArray:
+		return "FIXED_LEN_BYTE_ARRAY"
+	}
+	return "UNKNOWN"
+}
+
+// ConvertedType is the legacy logical type of the values
+type ConvertedType int32
+
+const (
+	ConvertedUTF8            ConvertedType = 0
+	ConvertedMap             ConvertedType = 1
+	ConvertedMapKeyValue     ConvertedType = 2
+	ConvertedList            ConvertedType = 3
+	ConvertedEnum            ConvertedType = 4
+	ConvertedDecimal         ConvertedType = 5
+	ConvertedDate            ConvertedType = 6
+	ConvertedTimeMillis      ConvertedType = 7
+	ConvertedTimeMicros      ConvertedType = 8
+	ConvertedTimestampMillis ConvertedType = 9
+	ConvertedTimestampMicros ConvertedType = 10
+	ConvertedUint8           ConvertedType = 11
+	ConvertedUint16          ConvertedType = 12
+	ConvertedUint32          ConvertedType = 13
+	ConvertedUint64          ConvertedType = 14
+	ConvertedInt8            ConvertedType = 15
+	ConvertedInt16           ConvertedType = 16
+	ConvertedInt32           ConvertedType = 17
+	ConvertedInt64           ConvertedType = 18
+	ConvertedJSON            ConvertedType = 19
+	ConvertedBSON            ConvertedType = 20
+	ConvertedInterval        ConvertedType = 21
+)
+
+// Repetition is the repetition of the field
+type Repetition int32
+
+const (
+	Required Repetition = 0
+	Optional Repetition = 1
+	Repeated Repetition = 2
+)
+
+// Encoding is the encoding of the values or the levels
+type Encoding int32
+
+const (
+	EncodingPlain                Encoding = 0
+	EncodingPlainDictionary      Encoding = 2
+	EncodingRLE                  Encoding = 3
+	EncodingBitPacked            Encoding = 4
+	EncodingDeltaBinaryPacked    Encoding = 5
+	EncodingDeltaLengthByteArray Encoding = 6
+	EncodingDeltaByteArray       Encoding = 7
+	EncodingRLEDictionary        Encoding = 8
+	EncodingByteStreamSplit      Encoding = 9
+)
+
+// Codec is the compression codec of the pages
+type Codec int32
+
+const (
+	Uncompressed Codec = 0
+	Snappy       Codec = 1
+	Gzip         Codec = 2
+	Lzo          Codec = 3
+	Brotli       Codec = 4
+	Lz4          Codec = 5
+	Zstd         Codec = 6
+	Lz4Raw       Codec = 7
+)
+
+// PageType is the type of the pages
+type PageType int32
+
+const (
+	DataPage       PageType = 0
+	IndexPage      PageType = 1
+	DictionaryPage PageType = 2
+	DataPageV2     PageType = 3
+)
+
+type Statistics struct {
+	Max           []byte `thrift:"1"`
+	Min           []byte `thrift:"2"`
+	NullCount     *int64 `thrift:"3"`
+	DistinctCount *int64 `thrift:"4"`
+	MaxValue      []byte `thrift:"5"`
+	MinValue      []byte `thrift:"6"`
+}
+
+type Empty struct{}
+
+type DecimalType struct {
+	Scale     int32 `thrift:"1,required"`
+	Precision int32 `thrift:"2,required"`
+}
+
+type TimeUnit struct {
+	Millis *Empty `thrift:"1"`
+	Micros *Empty `thrift:"2"`
+	Nanos  *Empty `thrift:"3"`
+}
+
+type TimestampType struct {
+	IsAdjustedToUTC bool     `thrift:"1,required"`
+	Unit            TimeUnit `thrift:"2,required"`
+}
+
+type TimeType struct {
+	IsAdjustedToUTC bool     `thrift:"1,required"`
+	Unit            TimeUnit `thrift:"2,required"`
+}
+
+type IntType struct {
+	BitWidth int8 `thrift:"1,required"`
+	IsSigned bool `thrift:"2,required"`
+}
+
+// LogicalType is the union of the logical types
+type LogicalType struct {
+	String    *Empty         `thrift:"1"`
+	Map       *Empty         `thrift:"2"`
+	List      *Empty         `thrift:"3"`
+	Enum      *Empty         `thrift:"4"`
+	Decimal   *DecimalType   `thrift:"5"`
+	Date      *Empty         `thrift:"6"`
+	Time      *TimeType      `thrift:"7"`
+	Timestamp *TimestampType `thrift:"8"`
+	Integer   *IntType       `thrift:"10"`
+	Unknown   *Empty         `thrift:"11"`
+	JSON      *Empty         `thrift:"12"`
+	BSON      *Empty         `thrift:"13"`
+	UUID      *Empty         `thrift:"14"`
+}
+
+type SchemaElement struct {
+	Type           *Type          `thrift:"1"`
+	TypeLength     *int32         `thrift:"2"`
+	RepetitionType *Repetition    `thrift:"3"`
+	Name           string         `thrift:"4,required"`
+	NumChildren    *int32         `thrift:"5"`
+	ConvertedType  *ConvertedType `thrift:"6"`
+	Scale          *int32         `thrift:"7"`
+	Precision      *int32         `thrift:"8"`
+	FieldID        *int32         `thrift:"9"`
+	LogicalType    *LogicalType   `thrift:"10"`
+}
+
+type DataPageHeader struct {
+	NumValues               int32       `thrift:"1,required"`
+	Encoding                Encoding    `thrift:"2,required"`
+	DefinitionLevelEncoding Encoding    `thrift:"3,required"`
+	RepetitionLevelEncoding Encoding    `thrift:"4,required"`
+	Statistics              *Statistics `thrift:"5"`
+}
+
+type DictionaryPageHeader struct {
+	NumValues int32    `thrift:"1,required"`
+	Encoding  Encoding `thrift:"2,required"`
+	IsSorted  *bool    `thrift:"3"`
+}
+
+type DataPageHeaderV2 struct {
+	NumValues                  int32       `thrift:"1,required"`
+	NumNulls                   int32       `thrift:"2,required"`
+	NumRows                    int32       `thrift:"3,required"`
+	Encoding                   Encoding    `thrift:"4,required"`
+	DefinitionLevelsByteLength int32       `thrift:"5,required"`
+	RepetitionLevelsByteLength int32       `thrift:"6,required"`
+	IsCompressed               *bool       `thrift:"7"`
+	Statistics                 *Statistics `thrift:"8"`
+}
+
+type PageHeader struct {
+	Type                 PageType              `thrift:"1,required"`
+	UncompressedPageSize int32                 `thrift:"2,required"`
+	CompressedPageSize   int32                 `thrift:"3,required"`
+	CRC                  *int32                `thrift:"4"`
+	DataPageHeader       *DataPageHeader       `thrift:"5"`
+	DictionaryPageHeader *DictionaryPageHeader `thrift:"7"`
+	DataPageHeaderV2     *DataPageHeaderV2     `thrift:"8"`
+}
+
+type KeyValue struct {
+	Key   string  `thrift:"1,required"`
+	Value *string `thrift:"2"`
+}
+
+type ColumnMetaData struct {
+	Type                  Type        `thrift:"1,required"`
+	Encodings             []Encoding  `thrift:"2,required"`
+	PathInSchema          []string    `thrift:"3,required"`
+	Codec                 Codec       `thrift:"4,required"`
+	NumValues             int64       `thrift:"5,required"`
+	TotalUncompressedSize int64       `thrift:"6,required"`
+	TotalCompressedSize   int64       `thrift:"7,required"`
+	KeyValueMetadata      []KeyValue  `thrift:"8"`
+	DataPageOffset        int64       `thrift:"9,required"`
+	IndexPageOffset       *int64      `thrift:"10"`
+	DictionaryPageOffset  *int64      `thrift:"11"`
+	Statistics            *Statistics `thrift:"12"`
+}
+
+type ColumnChunk struct {
+	FilePath   *string         `thrift:"1"`
+	FileOffset int64           `thrift:"2,required"`
+	MetaData   *ColumnMetaData `thrift:"3"`
+}
+
+type RowGroup struct {
+	Columns       []ColumnChunk `thrift:"1,required"`
+	TotalByteSize int64         `thrift:"2,required"`
+	NumRows       int64         `thrift:"3,required"`
+}
+
+type FileMetaData struct {
+	Version          int32           `thrift:"1,required"`
+	Schema           []SchemaElement `thrift:"2,required"`
+	NumRows          int64           `thrift:"3,required"`
+	RowGroups        []RowGroup      `thrift:"4,required"`
+	KeyValueMetadata []KeyValue      `thrift:"5"`
+	CreatedBy        *string         `thrift:"6"`
+}
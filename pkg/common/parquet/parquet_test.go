@@ -0,0 +1,212 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrift(t *testing.T) {
+	typ, length, repetition := FixedLenByteArray, int32(16), Optional
+	utc := true
+	v := FileMetaData{
+		Version: 1,
+		Schema: []SchemaElement{
+			{Name: "schema"},
+			{Type: &typ, TypeLength: &length, RepetitionType: &repetition, Name: "a",
+				LogicalType: &LogicalType{Timestamp: &TimestampType{IsAdjustedToUTC: utc, Unit: TimeUnit{Micros: &Empty{}}}}},
+		},
+		NumRows: 100,
+		RowGroups: []RowGroup{{
+			Columns: []ColumnChunk{{FileOffset: 4, MetaData: &ColumnMetaData{
+				Encodings:    []Encoding{EncodingPlain, EncodingRLE},
+				PathInSchema: []string{"a"},
+				Statistics:   &Statistics{MinValue: []byte{1}, MaxValue: []byte{2}},
+			}}},
+			NumRows: 100,
+		}},
+	}
+	for i := 0; i < 20; i++ {
+		v.KeyValueMetadata = append(v.KeyValueMetadata, KeyValue{Key: "k"})
+	}
+	data := marshalThrift(&v)
+	var decoded FileMetaData
+	n, err := unmarshalThrift(data, &decoded)
+	require.NoError(t, err)
+	assert.Equal(t, len(data), n)
+	assert.Equal(t, v, decoded)
+
+	// the unknown fields are skipped
+	var rg struct {
+		NumRows int64 `thrift:"3"`
+	}
+	_, err = unmarshalThrift(marshalThrift(&v.RowGroups[0]), &rg)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), rg.NumRows)
+
+	_, err = unmarshalThrift(data[:len(data)/2], &decoded)
+	assert.Error(t, err)
+}
+
+func TestHybrid(t *testing.T) {
+	values := []int32{1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+	for _, width := range []int{1, 3, 9} {
+		data := encodeHybrid(nil, values, width)
+		decoded, err := decodeHybrid(data, width, len(values))
+		require.NoError(t, err)
+		assert.Equal(t, values, decoded)
+	}
+	_, err := decodeHybrid(nil, 1, 1)
+	assert.Error(t, err)
+}
+
+func newTestColumns() []Column {
+	utf8 := ConvertedUTF8
+	return []Column{
+		{Name: "id", Type: Int64},
+		{Name: "name", Type: ByteArray, Optional: true, ConvertedType: &utf8},
+		{Name: "score", Type: Double, Optional: true},
+		{Name: "ok", Type: Boolean},
+	}
+}
+
+func TestWriteAndRead(t *testing.T) {
+	for _, codec := range []Codec{Uncompressed, Snappy, Gzip, Zstd} {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, newTestColumns(), WithCodec(codec))
+		require.NoError(t, w.WriteRowGroup([]*Values{
+			{Type: Int64, Int64s: []int64{3, 1, 2}},
+			{Type: ByteArray, Bytes: [][]byte{[]byte("c"), nil, []byte("a")}},
+			{Type: Double, Doubles: []float64{0, 0, 0}},
+			{Type: Boolean, Bools: []bool{true, false, true}},
+		}, [][]bool{nil, {false, true, false}, {true, true, true}, nil}))
+		require.NoError(t, w.WriteRowGroup([]*Values{
+			{Type: Int64, Int64s: []int64{10}},
+			{Type: ByteArray, Bytes: [][]byte{[]byte("d")}},
+			{Type: Double, Doubles: []float64{1.5}},
+			{Type: Boolean, Bools: []bool{false}},
+		}, [][]bool{nil, nil, nil, nil}))
+		require.NoError(t, w.Close())
+
+		data := buf.Bytes()
+		r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), r.NumRows())
+		assert.Equal(t, 2, r.NumRowGroups())
+		assert.Equal(t, newTestColumns(), r.Columns())
+
+		values, nulls, err := r.ReadColumn(0, 1)
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte("c"), nil, []byte("a")}, values.Bytes)
+		assert.Equal(t, []bool{false, true, false}, nulls)
+
+		values, nulls, err = r.ReadColumn(1, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{1.5}, values.Doubles)
+		assert.Equal(t, []bool{false}, nulls)
+
+		values, nulls, err = r.ReadColumn(0, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true, false, true}, values.Bools)
+		assert.Nil(t, nulls)
+
+		min, max, nullCount, ok := r.ColumnBounds(0, 0)
+		require.True(t, ok)
+		assert.Equal(t, int64(0), nullCount)
+		assert.Equal(t, []int64{1}, min.Int64s)
+		assert.Equal(t, []int64{3}, max.Int64s)
+
+		min, max, nullCount, ok = r.ColumnBounds(0, 1)
+		require.True(t, ok)
+		assert.Equal(t, int64(1), nullCount)
+		assert.Equal(t, [][]byte{[]byte("a")}, min.Bytes)
+		assert.Equal(t, [][]byte{[]byte("c")}, max.Bytes)
+
+		// all values are null
+		_, _, nullCount, ok = r.ColumnBounds(0, 2)
+		assert.False(t, ok)
+		assert.Equal(t, int64(3), nullCount)
+	}
+}
+
+func TestReadDictionaryPage(t *testing.T) {
+	column := Column{Name: "a", Type: ByteArray, Optional: true}
+	dict := encodePlain(nil, &Values{Type: ByteArray, Bytes: [][]byte{[]byte("x"), []byte("y")}}, nil)
+	levels := encodeHybrid(nil, []int32{1, 0, 1, 1}, 1)
+	page := appendUint32(nil, uint32(len(levels)))
+	page = append(page, levels...)
+	page = append(page, 1)
+	page = encodeHybrid(page, []int32{1, 0, 1}, 1)
+
+	var chunk []byte
+	chunk = append(chunk, marshalThrift(&PageHeader{
+		Type:                 DictionaryPage,
+		UncompressedPageSize: int32(len(dict)),
+		CompressedPageSize:   int32(len(dict)),
+		DictionaryPageHeader: &DictionaryPageHeader{NumValues: 2, Encoding: EncodingPlain},
+	})...)
+	chunk = append(chunk, dict...)
+	chunk = append(chunk, marshalThrift(&PageHeader{
+		Type:                 DataPage,
+		UncompressedPageSize: int32(len(page)),
+		CompressedPageSize:   int32(len(page)),
+		DataPageHeader:       &DataPageHeader{NumValues: 4, Encoding: EncodingRLEDictionary},
+	})...)
+	chunk = append(chunk, page...)
+
+	typ, repetition, numChildren := ByteArray, Optional, int32(1)
+	dictOffset := int64(len(magic))
+	meta := FileMetaData{
+		Schema: []SchemaElement{
+			{Name: "schema", NumChildren: &numChildren},
+			{Type: &typ, RepetitionType: &repetition, Name: "a"},
+		},
+		NumRows: 4,
+		RowGroups: []RowGroup{{
+			Columns: []ColumnChunk{{MetaData: &ColumnMetaData{
+				Type:                 ByteArray,
+				NumValues:            4,
+				TotalCompressedSize:  int64(len(chunk)),
+				DictionaryPageOffset: &dictOffset,
+				DataPageOffset:       dictOffset + 1,
+			}}},
+			NumRows: 4,
+		}},
+	}
+	data := []byte(magic)
+	data = append(data, chunk...)
+	footer := marshalThrift(&meta)
+	data = append(data, footer...)
+	data = appendUint32(data, uint32(len(footer)))
+	data = append(data, magic...)
+
+	r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	assert.Equal(t, []Column{column}, r.Columns())
+	values, nulls, err := r.ReadColumn(0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("y"), nil, []byte("x"), []byte("y")}, values.Bytes)
+	assert.Equal(t, []bool{false, true, false, false}, nulls)
+}
+
+func TestInvalidFile(t *testing.T) {
+	data := []byte("not a parquet file")
+	_, err := NewReader(bytes.NewReader(data), int64(len(data)))
+	assert.Equal(t, ErrInvalidFile, err)
+}
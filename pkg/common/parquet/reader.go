@@ -0,0 +1,412 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	magic      = "PAR1"
+	footerSize = 8
+)
+
+var ErrInvalidFile = errors.New("invalid parquet file")
+
+// Column describes a leaf column of the schema
+type Column struct {
+	// Name is the path of the column joined by '.'
+	Name          string
+	Type          Type
+	TypeLength    int32
+	Optional      bool
+	ConvertedType *ConvertedType
+	LogicalType   *LogicalType
+	Scale         int32
+	Precision     int32
+	// Nested is true if the column is a repeated field or in a group, which is not
+	// supported by the reader.
+	Nested bool
+}
+
+// Reader reads the columns of a parquet file, the values of a column chunk are
+// read at once.
+type Reader struct {
+	r       io.ReaderAt
+	meta    FileMetaData
+	columns []Column
+}
+
+// NewReader reads the metadata from the footer of the parquet file
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	if size < int64(len(magic)+footerSize) {
+		return nil, ErrInvalidFile
+	}
+	footer := make([]byte, footerSize)
+	if _, err := r.ReadAt(footer, size-footerSize); err != nil {
+		return nil, err
+	}
+	if string(footer[4:]) != magic {
+		return nil, ErrInvalidFile
+	}
+	metaSize := int64(binary.LittleEndian.Uint32(footer))
+	if metaSize <= 0 || metaSize > size-footerSize-int64(len(magic)) {
+		return nil, ErrInvalidFile
+	}
+	data := make([]byte, metaSize)
+	if _, err := r.ReadAt(data, size-footerSize-metaSize); err != nil {
+		return nil, err
+	}
+
+	reader := &Reader{r: r}
+	if _, err := unmarshalThrift(data, &reader.meta); err != nil {
+		return nil, err
+	}
+	if len(reader.meta.Schema) == 0 {
+		return nil, ErrInvalidFile
+	}
+	schema := reader.meta.Schema[1:]
+	for len(schema) > 0 {
+		var err error
+		if schema, err = reader.readSchema(schema, nil, false); err != nil {
+			return nil, err
+		}
+	}
+	for _, rg := range reader.meta.RowGroups {
+		if len(rg.Columns) != len(reader.columns) {
+			return nil, ErrInvalidFile
+		}
+		for _, chunk := range rg.Columns {
+			if chunk.MetaData == nil {
+				return nil, ErrInvalidFile
+			}
+		}
+	}
+	return reader, nil
+}
+
+// readSchema walks the schema elements in depth-first order and collects the leaf columns.
+func (r *Reader) readSchema(schema []SchemaElement, path []string, nested bool) ([]SchemaElement, error) {
+	e := schema[0]
+	schema = schema[1:]
+	path = append(path, e.Name)
+	repetition := Required
+	if e.RepetitionType != nil {
+		repetition = *e.RepetitionType
+	}
+	if e.NumChildren != nil && *e.NumChildren > 0 {
+		for i := int32(0); i < *e.NumChildren; i++ {
+			if len(schema) == 0 {
+				return nil, ErrInvalidFile
+			}
+			var err error
+			if schema, err = r.readSchema(schema, path, true); err != nil {
+				return nil, err
+			}
+		}
+		return schema, nil
+	}
+	if e.Type == nil {
+		return nil, ErrInvalidFile
+	}
+	col := Column{
+		Name:          strings.Join(path, "."),
+		Type:          *e.Type,
+		Optional:      repetition == Optional,
+		ConvertedType: e.ConvertedType,
+		LogicalType:   e.LogicalType,
+		Nested:        nested || repetition == Repeated,
+	}
+	if e.TypeLength != nil {
+		col.TypeLength = *e.TypeLength
+	}
+	if e.Scale != nil {
+		col.Scale = *e.Scale
+	}
+	if e.Precision != nil {
+		col.Precision = *e.Precision
+	}
+	if e.LogicalType != nil && e.LogicalType.Decimal != nil {
+		col.Scale = e.LogicalType.Decimal.Scale
+		col.Precision = e.LogicalType.Decimal.Precision
+	}
+	r.columns = append(r.columns, col)
+	return schema, nil
+}
+
+// Metadata returns the metadata of the file
+func (r *Reader) Metadata() *FileMetaData {
+	return &r.meta
+}
+
+// Columns returns the leaf columns
+func (r *Reader) Columns() []Column {
+	return r.columns
+}
+
+// NumRows returns the number of the rows of the file
+func (r *Reader) NumRows() int64 {
+	return r.meta.NumRows
+}
+
+// NumRowGroups returns the number of the row groups
+func (r *Reader) NumRowGroups() int {
+	return len(r.meta.RowGroups)
+}
+
+// RowGroupNumRows returns the number of the rows of the row group
+func (r *Reader) RowGroupNumRows(rg int) int64 {
+	return r.meta.RowGroups[rg].NumRows
+}
+
+// ColumnBounds returns the min and max value of the column in the row group
+// from the statistics, ok is false if the statistics are not available.
+func (r *Reader) ColumnBounds(rg, col int) (min, max *Values, nullCount int64, ok bool) {
+	stats := r.meta.RowGroups[rg].Columns[col].MetaData.Statistics
+	if stats == nil {
+		return nil, nil, 0, false
+	}
+	if stats.NullCount != nil {
+		nullCount = *stats.NullCount
+	}
+	minData, maxData := stats.MinValue, stats.MaxValue
+	if minData == nil || maxData == nil {
+		// the deprecated min and max are only correct for the signed values
+		if !r.columns[col].signedOrder() {
+			return nil, nil, nullCount, false
+		}
+		minData, maxData = stats.Min, stats.Max
+	}
+	if minData == nil || maxData == nil {
+		return nil, nil, nullCount, false
+	}
+	if min, ok = r.columns[col].decodeStatValue(minData); !ok {
+		return nil, nil, nullCount, false
+	}
+	if max, ok = r.columns[col].decodeStatValue(maxData); !ok {
+		return nil, nil, nullCount, false
+	}
+	return min, max, nullCount, true
+}
+
+func (c *Column) signedOrder() bool {
+	switch c.Type {
+	case Int32, Int64, Float, Double:
+		if c.ConvertedType == nil {
+			return c.LogicalType == nil || c.LogicalType.Integer == nil || c.LogicalType.Integer.IsSigned
+		}
+		switch *c.ConvertedType {
+		case ConvertedUint8, ConvertedUint16, ConvertedUint32, ConvertedUint64:
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+func (c *Column) decodeStatValue(data []byte) (*Values, bool) {
+	v := &Values{Type: c.Type}
+	switch c.Type {
+	case ByteArray, FixedLenByteArray:
+		v.Bytes = [][]byte{data}
+		return v, true
+	case Int96:
+		return nil, false
+	}
+	if err := decodePlain(v, data, 1, int(c.TypeLength)); err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+// ReadColumn reads the values of the column in the row group, the nulls are
+// returned as zero values and marked in the nulls, which is nil if the column
+// is required.
+func (r *Reader) ReadColumn(rg, col int) (*Values, []bool, error) {
+	column := r.columns[col]
+	if column.Nested {
+		return nil, nil, fmt.Errorf("the nested parquet column '%s' is not supported", column.Name)
+	}
+	meta := r.meta.RowGroups[rg].Columns[col].MetaData
+	start := meta.DataPageOffset
+	if meta.DictionaryPageOffset != nil && *meta.DictionaryPageOffset > 0 && *meta.DictionaryPageOffset < start {
+		start = *meta.DictionaryPageOffset
+	}
+	if start < 0 || meta.TotalCompressedSize <= 0 || meta.TotalCompressedSize > 1<<31 {
+		return nil, nil, ErrInvalidFile
+	}
+	data := make([]byte, meta.TotalCompressedSize)
+	if _, err := r.r.ReadAt(data, start); err != nil {
+		return nil, nil, err
+	}
+
+	cr := &chunkReader{
+		column: &column,
+		codec:  meta.Codec,
+		values: &Values{Type: column.Type},
+	}
+	if column.Optional {
+		cr.nulls = make([]bool, 0, meta.NumValues)
+	}
+	for int64(cr.values.Len()) < meta.NumValues && len(data) > 0 {
+		var header PageHeader
+		n, err := unmarshalThrift(data, &header)
+		if err != nil {
+			return nil, nil, err
+		}
+		data = data[n:]
+		if header.CompressedPageSize < 0 || int(header.CompressedPageSize) > len(data) {
+			return nil, nil, errCorruptedPage
+		}
+		if err = cr.readPage(&header, data[:header.CompressedPageSize]); err != nil {
+			return nil, nil, err
+		}
+		data = data[header.CompressedPageSize:]
+	}
+	if int64(cr.values.Len()) != meta.NumValues {
+		return nil, nil, errCorruptedPage
+	}
+	return cr.values, cr.nulls, nil
+}
+
+type chunkReader struct {
+	column *Column
+	codec  Codec
+	dict   *Values
+	values *Values
+	nulls  []bool
+}
+
+func (cr *chunkReader) readPage(header *PageHeader, data []byte) error {
+	switch header.Type {
+	case DictionaryPage:
+		if header.DictionaryPageHeader == nil {
+			return errCorruptedPage
+		}
+		data, err := decompress(cr.codec, data, int(header.UncompressedPageSize))
+		if err != nil {
+			return err
+		}
+		cr.dict = &Values{Type: cr.column.Type}
+		return decodePlain(cr.dict, data, int(header.DictionaryPageHeader.NumValues), int(cr.column.TypeLength))
+	case DataPage:
+		h := header.DataPageHeader
+		if h == nil {
+			return errCorruptedPage
+		}
+		data, err := decompress(cr.codec, data, int(header.UncompressedPageSize))
+		if err != nil {
+			return err
+		}
+		var levels []int32
+		if cr.column.Optional {
+			if len(data) < 4 {
+				return errCorruptedPage
+			}
+			size := int(binary.LittleEndian.Uint32(data))
+			if size < 0 || size > len(data)-4 {
+				return errCorruptedPage
+			}
+			if levels, err = decodeHybrid(data[4:4+size], 1, int(h.NumValues)); err != nil {
+				return err
+			}
+			data = data[4+size:]
+		}
+		return cr.readValues(h.Encoding, data, int(h.NumValues), levels)
+	case DataPageV2:
+		h := header.DataPageHeaderV2
+		if h == nil || h.RepetitionLevelsByteLength != 0 || h.DefinitionLevelsByteLength < 0 ||
+			int(h.DefinitionLevelsByteLength) > len(data) {
+			return errCorruptedPage
+		}
+		var levels []int32
+		var err error
+		if cr.column.Optional {
+			if levels, err = decodeHybrid(data[:h.DefinitionLevelsByteLength], 1, int(h.NumValues)); err != nil {
+				return err
+			}
+		}
+		data = data[h.DefinitionLevelsByteLength:]
+		if h.IsCompressed == nil || *h.IsCompressed {
+			size := int(header.UncompressedPageSize - h.DefinitionLevelsByteLength)
+			if data, err = decompress(cr.codec, data, size); err != nil {
+				return err
+			}
+		}
+		return cr.readValues(h.Encoding, data, int(h.NumValues), levels)
+	}
+	// index pages and unknown pages are skipped
+	return nil
+}
+
+func (cr *chunkReader) readValues(encoding Encoding, data []byte, n int, levels []int32) error {
+	count := n
+	if levels != nil {
+		count = 0
+		for _, l := range levels {
+			if l != 0 {
+				count++
+			}
+		}
+	}
+
+	values := &Values{Type: cr.column.Type}
+	switch encoding {
+	case EncodingPlain:
+		if err := decodePlain(values, data, count, int(cr.column.TypeLength)); err != nil {
+			return err
+		}
+	case EncodingPlainDictionary, EncodingRLEDictionary:
+		if cr.dict == nil || len(data) < 1 {
+			return errCorruptedPage
+		}
+		indexes, err := decodeHybrid(data[1:], int(data[0]), count)
+		if err != nil {
+			return err
+		}
+		dictLen := cr.dict.Len()
+		for _, idx := range indexes {
+			if idx < 0 || int(idx) >= dictLen {
+				return errCorruptedPage
+			}
+			values.appendValue(cr.dict, int(idx))
+		}
+	default:
+		return fmt.Errorf("unsupported parquet encoding %d of column '%s'", encoding, cr.column.Name)
+	}
+
+	if levels == nil {
+		for i := 0; i < n; i++ {
+			cr.values.appendValue(values, i)
+		}
+		return nil
+	}
+	j := 0
+	for _, l := range levels {
+		if l == 0 {
+			cr.values.appendZero()
+			cr.nulls = append(cr.nulls, true)
+			continue
+		}
+		cr.values.appendValue(values, j)
+		cr.nulls = append(cr.nulls, false)
+		j++
+	}
+	return nil
+}
@@ -0,0 +1,407 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// The metadata of parquet is serialized by the thrift compact protocol. The
+// structs in format.go describe the thrift fields by the tag `thrift:"id"`, the
+// optional scalar fields are pointers and the optional lists are nil slices.
+
+const (
+	thriftStop      = 0
+	thriftTrue      = 1
+	thriftFalse     = 2
+	thriftByte      = 3
+	thriftI16       = 4
+	thriftI32       = 5
+	thriftI64       = 6
+	thriftDouble    = 7
+	thriftBinary    = 8
+	thriftList      = 9
+	thriftSet       = 10
+	thriftMap       = 11
+	thriftStruct    = 12
+	thriftMaxDepth  = 64
+	thriftMaxLength = 1 << 30
+)
+
+type thriftField struct {
+	id       int16
+	index    int
+	required bool
+}
+
+func thriftFields(t reflect.Type) []thriftField {
+	fields := make([]thriftField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag, ok := t.Field(i).Tag.Lookup("thrift")
+		if !ok {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		id, err := strconv.Atoi(parts[0])
+		if err != nil {
+			panic(fmt.Sprintf("invalid thrift tag %q of %s", tag, t.Name()))
+		}
+		fields = append(fields, thriftField{
+			id:       int16(id),
+			index:    i,
+			required: len(parts) > 1 && parts[1] == "required",
+		})
+	}
+	return fields
+}
+
+func thriftType(t reflect.Type) byte {
+	switch t.Kind() {
+	case reflect.Bool:
+		return thriftTrue
+	case reflect.Int8:
+		return thriftByte
+	case reflect.Int16:
+		return thriftI16
+	case reflect.Int32:
+		return thriftI32
+	case reflect.Int64:
+		return thriftI64
+	case reflect.Float64:
+		return thriftDouble
+	case reflect.String:
+		return thriftBinary
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return thriftBinary
+		}
+		return thriftList
+	case reflect.Struct:
+		return thriftStruct
+	case reflect.Ptr:
+		return thriftType(t.Elem())
+	}
+	panic(fmt.Sprintf("unsupported thrift type %s", t))
+}
+
+// thriftEncoder encodes the structs with the thrift compact protocol
+type thriftEncoder struct {
+	buf bytes.Buffer
+}
+
+func marshalThrift(v any) []byte {
+	e := &thriftEncoder{}
+	e.writeStruct(reflect.Indirect(reflect.ValueOf(v)))
+	return e.buf.Bytes()
+}
+
+func (e *thriftEncoder) writeUvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	e.buf.Write(b[:n])
+}
+
+func (e *thriftEncoder) writeVarint(v int64) {
+	e.writeUvarint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (e *thriftEncoder) writeStruct(v reflect.Value) {
+	last := int16(0)
+	for _, f := range thriftFields(v.Type()) {
+		fv := v.Field(f.index)
+		if !f.required && (fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Slice) && fv.IsNil() {
+			continue
+		}
+		fv = reflect.Indirect(fv)
+		typ := thriftType(fv.Type())
+		if typ == thriftTrue && !fv.Bool() {
+			typ = thriftFalse
+		}
+		if delta := f.id - last; delta > 0 && delta <= 15 {
+			e.buf.WriteByte(byte(delta<<4) | typ)
+		} else {
+			e.buf.WriteByte(typ)
+			e.writeVarint(int64(f.id))
+		}
+		last = f.id
+		if typ != thriftTrue && typ != thriftFalse {
+			e.writeValue(fv)
+		}
+	}
+	e.buf.WriteByte(thriftStop)
+}
+
+func (e *thriftEncoder) writeValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			e.buf.WriteByte(thriftTrue)
+		} else {
+			e.buf.WriteByte(thriftFalse)
+		}
+	case reflect.Int8:
+		e.buf.WriteByte(byte(v.Int()))
+	case reflect.Int16, reflect.Int32, reflect.Int64:
+		e.writeVarint(v.Int())
+	case reflect.Float64:
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(v.Float()))
+		e.buf.Write(b[:])
+	case reflect.String:
+		e.writeUvarint(uint64(v.Len()))
+		e.buf.WriteString(v.String())
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			e.writeUvarint(uint64(v.Len()))
+			e.buf.Write(v.Bytes())
+			return
+		}
+		typ := thriftType(v.Type().Elem())
+		if typ == thriftTrue {
+			typ = thriftFalse
+		}
+		if v.Len() < 15 {
+			e.buf.WriteByte(byte(v.Len()<<4) | typ)
+		} else {
+			e.buf.WriteByte(0xf0 | typ)
+			e.writeUvarint(uint64(v.Len()))
+		}
+		for i := 0; i < v.Len(); i++ {
+			e.writeValue(reflect.Indirect(v.Index(i)))
+		}
+	case reflect.Struct:
+		e.writeStruct(v)
+	case reflect.Ptr:
+		e.writeValue(v.Elem())
+	default:
+		panic(fmt.Sprintf("unsupported thrift type %s", v.Type()))
+	}
+}
+
+// thriftDecoder decodes the structs encoded by the thrift compact protocol, the
+// unknown fields are skipped.
+type thriftDecoder struct {
+	r     *bytes.Reader
+	depth int
+}
+
+// unmarshalThrift decodes the struct from the data, and returns the number of bytes read.
+func unmarshalThrift(data []byte, v any) (n int, err error) {
+	d := &thriftDecoder{r: bytes.NewReader(data)}
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(thriftError); ok {
+				err = e.err
+				return
+			}
+			panic(r)
+		}
+	}()
+	d.readStruct(reflect.Indirect(reflect.ValueOf(v)))
+	return len(data) - d.r.Len(), nil
+}
+
+type thriftError struct {
+	err error
+}
+
+func (d *thriftDecoder) fail(format string, args ...any) {
+	panic(thriftError{err: fmt.Errorf("invalid parquet metadata: "+format, args...)})
+}
+
+func (d *thriftDecoder) readByte() byte {
+	b, err := d.r.ReadByte()
+	if err != nil {
+		d.fail("%v", io.ErrUnexpectedEOF)
+	}
+	return b
+}
+
+func (d *thriftDecoder) readUvarint() uint64 {
+	v, err := binary.ReadUvarint(d.r)
+	if err != nil {
+		d.fail("%v", err)
+	}
+	return v
+}
+
+func (d *thriftDecoder) readVarint() int64 {
+	v := d.readUvarint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (d *thriftDecoder) readBinary() []byte {
+	n := d.readUvarint()
+	if n > uint64(d.r.Len()) || n > thriftMaxLength {
+		d.fail("binary length %d out of range", n)
+	}
+	b := make([]byte, n)
+	_, _ = io.ReadFull(d.r, b)
+	return b
+}
+
+func (d *thriftDecoder) readListHeader() (byte, int) {
+	b := d.readByte()
+	n := uint64(b >> 4)
+	if n == 15 {
+		n = d.readUvarint()
+	}
+	if n > uint64(d.r.Len()) {
+		d.fail("list length %d out of range", n)
+	}
+	return b & 0x0f, int(n)
+}
+
+func (d *thriftDecoder) readStruct(v reflect.Value) {
+	d.depth++
+	if d.depth > thriftMaxDepth {
+		d.fail("nested too deep")
+	}
+	fields := make(map[int16]thriftField)
+	for _, f := range thriftFields(v.Type()) {
+		fields[f.id] = f
+	}
+	last := int16(0)
+	for {
+		b := d.readByte()
+		typ := b & 0x0f
+		if typ == thriftStop {
+			break
+		}
+		id := last + int16(b>>4)
+		if b>>4 == 0 {
+			id = int16(d.readVarint())
+		}
+		last = id
+		f, ok := fields[id]
+		if !ok {
+			d.skip(typ)
+			continue
+		}
+		fv := v.Field(f.index)
+		if fv.Kind() == reflect.Ptr {
+			fv.Set(reflect.New(fv.Type().Elem()))
+			fv = fv.Elem()
+		}
+		if typ == thriftTrue || typ == thriftFalse {
+			if fv.Kind() != reflect.Bool {
+				d.fail("unexpected bool field %d", id)
+			}
+			fv.SetBool(typ == thriftTrue)
+			continue
+		}
+		d.readValue(typ, fv)
+	}
+	d.depth--
+}
+
+func (d *thriftDecoder) readValue(typ byte, v reflect.Value) {
+	if expect := thriftType(v.Type()); expect != typ && !(expect == thriftTrue && typ == thriftFalse) {
+		d.fail("unexpected type %d of %s", typ, v.Type())
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(d.readByte() == thriftTrue)
+	case reflect.Int8:
+		v.SetInt(int64(int8(d.readByte())))
+	case reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(d.readVarint())
+	case reflect.Float64:
+		var b [8]byte
+		if _, err := io.ReadFull(d.r, b[:]); err != nil {
+			d.fail("%v", err)
+		}
+		v.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(b[:])))
+	case reflect.String:
+		v.SetString(string(d.readBinary()))
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes(d.readBinary())
+			return
+		}
+		elemType, n := d.readListHeader()
+		s := reflect.MakeSlice(v.Type(), n, n)
+		for i := 0; i < n; i++ {
+			ev := s.Index(i)
+			if ev.Kind() == reflect.Ptr {
+				ev.Set(reflect.New(ev.Type().Elem()))
+				ev = ev.Elem()
+			}
+			d.readValue(elemType, ev)
+		}
+		v.Set(s)
+	case reflect.Struct:
+		d.readStruct(v)
+	}
+}
+
+func (d *thriftDecoder) skip(typ byte) {
+	switch typ {
+	case thriftTrue, thriftFalse:
+	case thriftByte:
+		d.readByte()
+	case thriftI16, thriftI32, thriftI64:
+		d.readVarint()
+	case thriftDouble:
+		if _, err := d.r.Seek(8, io.SeekCurrent); err != nil {
+			d.fail("%v", err)
+		}
+	case thriftBinary:
+		d.readBinary()
+	case thriftList, thriftSet:
+		elemType, n := d.readListHeader()
+		for i := 0; i < n; i++ {
+			d.skip(elemType)
+		}
+	case thriftMap:
+		n := d.readUvarint()
+		if n == 0 {
+			return
+		}
+		if n > uint64(d.r.Len()) {
+			d.fail("map length %d out of range", n)
+		}
+		kv := d.readByte()
+		for i := uint64(0); i < n; i++ {
+			d.skip(kv >> 4)
+			d.skip(kv & 0x0f)
+		}
+	case thriftStruct:
+		d.depth++
+		if d.depth > thriftMaxDepth {
+			d.fail("nested too deep")
+		}
+		for {
+			b := d.readByte()
+			if b&0x0f == thriftStop {
+				break
+			}
+			if b>>4 == 0 {
+				d.readVarint()
+			}
+			d.skip(b & 0x0f)
+		}
+		d.depth--
+	default:
+		d.fail("unknown type %d", typ)
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const createdBy = "matrixone"
+
+// WriterOption is the option of the writer
+type WriterOption func(*Writer)
+
+// WithCodec sets the compression codec of the pages, only Uncompressed, Snappy, Gzip and Zstd are supported.
+func WithCodec(codec Codec) WriterOption {
+	return func(w *Writer) {
+		w.codec = codec
+	}
+}
+
+// Writer writes the values into a parquet file, each call of WriteRowGroup writes a
+// row group with one data page per column, and the footer is written by Close.
+type Writer struct {
+	w       io.Writer
+	codec   Codec
+	offset  int64
+	columns []Column
+	meta    FileMetaData
+	closed  bool
+}
+
+// NewWriter returns a writer of the flat schema described by the columns
+func NewWriter(w io.Writer, columns []Column, opts ...WriterOption) *Writer {
+	writer := &Writer{
+		w:       w,
+		codec:   Snappy,
+		columns: columns,
+	}
+	for _, opt := range opts {
+		opt(writer)
+	}
+
+	name := createdBy
+	writer.meta.Version = 1
+	writer.meta.CreatedBy = &name
+	numChildren := int32(len(columns))
+	writer.meta.Schema = append(writer.meta.Schema, SchemaElement{Name: "schema", NumChildren: &numChildren})
+	for i := range columns {
+		writer.meta.Schema = append(writer.meta.Schema, columns[i].schemaElement())
+	}
+	return writer
+}
+
+func (c *Column) schemaElement() SchemaElement {
+	typ := c.Type
+	repetition := Required
+	if c.Optional {
+		repetition = Optional
+	}
+	e := SchemaElement{
+		Type:           &typ,
+		RepetitionType: &repetition,
+		Name:           c.Name,
+		ConvertedType:  c.ConvertedType,
+		LogicalType:    c.LogicalType,
+	}
+	if c.Type == FixedLenByteArray {
+		length := c.TypeLength
+		e.TypeLength = &length
+	}
+	if c.ConvertedType != nil && *c.ConvertedType == ConvertedDecimal {
+		scale, precision := c.Scale, c.Precision
+		e.Scale, e.Precision = &scale, &precision
+	}
+	return e
+}
+
+func (w *Writer) write(data []byte) error {
+	if w.offset == 0 {
+		if _, err := io.WriteString(w.w, magic); err != nil {
+			return err
+		}
+		w.offset = int64(len(magic))
+	}
+	n, err := w.w.Write(data)
+	w.offset += int64(n)
+	return err
+}
+
+// WriteRowGroup writes a row group, the values and the nulls are in the order of
+// the columns. The nulls of a column can be nil if the column has no null value.
+func (w *Writer) WriteRowGroup(values []*Values, nulls [][]bool) error {
+	if w.closed {
+		return errors.New("the parquet writer is closed")
+	}
+	if len(values) != len(w.columns) || len(nulls) != len(w.columns) {
+		return fmt.Errorf("the row group has %d columns, but the schema has %d columns", len(values), len(w.columns))
+	}
+	rows := int64(-1)
+	rg := RowGroup{}
+	for i := range w.columns {
+		n := int64(values[i].Len())
+		if rows >= 0 && n != rows {
+			return errors.New("the columns of the row group have different number of values")
+		}
+		rows = n
+		if nulls[i] != nil && !w.columns[i].Optional {
+			for _, null := range nulls[i] {
+				if null {
+					return fmt.Errorf("the required parquet column '%s' can not be null", w.columns[i].Name)
+				}
+			}
+		}
+		chunk, err := w.writeColumnChunk(&w.columns[i], values[i], nulls[i])
+		if err != nil {
+			return err
+		}
+		rg.Columns = append(rg.Columns, chunk)
+		rg.TotalByteSize += chunk.MetaData.TotalUncompressedSize
+	}
+	if rows <= 0 {
+		return nil
+	}
+	rg.NumRows = rows
+	w.meta.RowGroups = append(w.meta.RowGroups, rg)
+	w.meta.NumRows += rows
+	return nil
+}
+
+func (w *Writer) writeColumnChunk(column *Column, values *Values, nulls []bool) (ColumnChunk, error) {
+	n := values.Len()
+	var page []byte
+	if column.Optional {
+		levels := make([]int32, n)
+		for i := range levels {
+			if nulls == nil || !nulls[i] {
+				levels[i] = 1
+			}
+		}
+		encoded := encodeHybrid(nil, levels, 1)
+		page = appendUint32(page, uint32(len(encoded)))
+		page = append(page, encoded...)
+	} else {
+		nulls = nil
+	}
+	page = encodePlain(page, values, nulls)
+	compressed, err := compress(w.codec, page)
+	if err != nil {
+		return ColumnChunk{}, err
+	}
+
+	stats := column.statistics(values, nulls)
+	header := PageHeader{
+		Type:                 DataPage,
+		UncompressedPageSize: int32(len(page)),
+		CompressedPageSize:   int32(len(compressed)),
+		DataPageHeader: &DataPageHeader{
+			NumValues:               int32(n),
+			Encoding:                EncodingPlain,
+			DefinitionLevelEncoding: EncodingRLE,
+			RepetitionLevelEncoding: EncodingRLE,
+		},
+	}
+	headerData := marshalThrift(&header)
+
+	if w.offset == 0 {
+		// the magic is written before the first page
+		if err = w.write(nil); err != nil {
+			return ColumnChunk{}, err
+		}
+	}
+	offset := w.offset
+	if err = w.write(headerData); err != nil {
+		return ColumnChunk{}, err
+	}
+	if err = w.write(compressed); err != nil {
+		return ColumnChunk{}, err
+	}
+	return ColumnChunk{
+		FileOffset: offset,
+		MetaData: &ColumnMetaData{
+			Type:                  column.Type,
+			Encodings:             []Encoding{EncodingPlain, EncodingRLE},
+			PathInSchema:          []string{column.Name},
+			Codec:                 w.codec,
+			NumValues:             int64(n),
+			TotalUncompressedSize: int64(len(headerData) + len(page)),
+			TotalCompressedSize:   int64(len(headerData) + len(compressed)),
+			DataPageOffset:        offset,
+			Statistics:            stats,
+		},
+	}, nil
+}
+
+// statistics returns the min, max and null count of the values, the min and max
+// are only computed for the values with the signed or the lexicographic order.
+func (c *Column) statistics(values *Values, nulls []bool) *Statistics {
+	var nullCount int64
+	var min, max int
+	first, hasNaN := true, false
+	for i := 0; i < values.Len(); i++ {
+		if nulls != nil && nulls[i] {
+			nullCount++
+			continue
+		}
+		if isNaN(values, i) {
+			hasNaN = true
+			continue
+		}
+		if first {
+			min, max, first = i, i, false
+			continue
+		}
+		if compareValue(values, i, min) < 0 {
+			min = i
+		}
+		if compareValue(values, i, max) > 0 {
+			max = i
+		}
+	}
+	stats := &Statistics{NullCount: &nullCount}
+	if first || hasNaN || !(c.signedOrder() || c.Type == ByteArray && c.ConvertedType != nil && *c.ConvertedType == ConvertedUTF8) {
+		return stats
+	}
+	minValues, maxValues := &Values{Type: c.Type}, &Values{Type: c.Type}
+	minValues.appendValue(values, min)
+	maxValues.appendValue(values, max)
+	if c.Type == ByteArray {
+		stats.MinValue, stats.MaxValue = values.Bytes[min], values.Bytes[max]
+	} else {
+		stats.MinValue, stats.MaxValue = encodePlain(nil, minValues, nil), encodePlain(nil, maxValues, nil)
+	}
+	return stats
+}
+
+func isNaN(v *Values, i int) bool {
+	switch v.Type {
+	case Float:
+		return v.Floats[i] != v.Floats[i]
+	case Double:
+		return v.Doubles[i] != v.Doubles[i]
+	}
+	return false
+}
+
+func compareValue(v *Values, i, j int) int {
+	switch v.Type {
+	case Int32:
+		return compareOrdered(v.Int32s[i], v.Int32s[j])
+	case Int64:
+		return compareOrdered(v.Int64s[i], v.Int64s[j])
+	case Float:
+		return compareOrdered(v.Floats[i], v.Floats[j])
+	case Double:
+		return compareOrdered(v.Doubles[i], v.Doubles[j])
+	case ByteArray, FixedLenByteArray:
+		return bytes.Compare(v.Bytes[i], v.Bytes[j])
+	}
+	return 0
+}
+
+func compareOrdered[T int32 | int64 | float32 | float64](a, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// Close writes the footer, the underlying writer is not closed.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	data := marshalThrift(&w.meta)
+	if err := w.write(data); err != nil {
+		return err
+	}
+	var footer [footerSize]byte
+	binary.LittleEndian.PutUint32(footer[:], uint32(len(data)))
+	copy(footer[4:], magic)
+	return w.write(footer[:])
+}
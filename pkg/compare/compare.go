@@ -85,6 +85,21 @@ func New(typ types.Type, desc bool) Compare {
 			return newCompare(genericDescCompare[types.Date], genericCopy[types.Date])
 		}
 		return newCompare(genericCompare[types.Date], genericCopy[types.Date])
+	case types.T_time:
+		if desc {
+			return newCompare(genericDescCompare[types.Time], genericCopy[types.Time])
+		}
+		return newCompare(genericCompare[types.Time], genericCopy[types.Time])
+	case types.T_enum:
+		if desc {
+			return newCompare(genericDescCompare[types.Enum], genericCopy[types.Enum])
+		}
+		return newCompare(genericCompare[types.Enum], genericCopy[types.Enum])
+	case types.T_set:
+		if desc {
+			return newCompare(genericDescCompare[types.Set], genericCopy[types.Set])
+		}
+		return newCompare(genericCompare[types.Set], genericCopy[types.Set])
 	case types.T_datetime:
 		if desc {
 			return newCompare(genericDescCompare[types.Datetime], genericCopy[types.Datetime])
@@ -15,12 +15,37 @@
 package compress
 
 import (
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
 var Algorithms map[string]int = map[string]int{
 	"lz4":  Lz4,
 	"none": None,
+	"zstd": Zstd,
+	"dict": Dict,
+	"rle":  Rle,
+}
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// CompressBound returns the max size of the compressed data of the src with size n
+func CompressBound(n int, typ int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(n)
+	case Zstd:
+		// the ZSTD_COMPRESSBOUND of the zstd library
+		bound := n + n>>8
+		if n < 128<<10 {
+			bound += (128<<10 - n) >> 11
+		}
+		return bound
+	}
+	return n
 }
 
 func Compress(src, dst []byte, typ int) ([]byte, error) {
@@ -31,6 +56,8 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
 	}
 	return nil, nil
 }
@@ -43,6 +70,8 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		return zstdDecoder.DecodeAll(src, dst[:0])
 	}
 	return nil, nil
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
 	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLz4(t *testing.T) {
@@ -41,3 +42,14 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestZstd(t *testing.T) {
+	xs := []int64{200, 200, 0, 200, 10, 30, 20, 1111}
+	raw := types.EncodeInt64Slice(xs)
+	buf := make([]byte, CompressBound(len(raw), Zstd))
+	buf, err := Compress(raw, buf, Zstd)
+	require.NoError(t, err)
+	data, err := Decompress(buf, make([]byte, len(raw)), Zstd)
+	require.NoError(t, err)
+	require.Equal(t, raw, data)
+}
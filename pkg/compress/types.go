@@ -19,6 +19,11 @@ import "fmt"
 const (
 	None = iota
 	Lz4
+	Zstd
+	// Dict and Rle are the encodings of the column data, which
+	// are encoded and decoded by the objectio
+	Dict
+	Rle
 )
 
 type T uint8
@@ -29,6 +34,12 @@ func (t T) String() string {
 		return "None"
 	case Lz4:
 		return "LZ4"
+	case Zstd:
+		return "ZSTD"
+	case Dict:
+		return "DICT"
+	case Rle:
+		return "RLE"
 	}
 	return fmt.Sprintf("unexpected compress type: %d", t)
 }
@@ -48,6 +48,15 @@ var (
 	//port defines which port the mo-server listens on and clients connect to
 	defaultPort = 6001
 
+	//postgresPort defines which port the mo-server listens on for the clients of the postgresql protocol
+	defaultPostgresPort = 6002
+
+	//the password authentication of the clients of the postgresql protocol
+	defaultPostgresAuthMethod = "scram-sha-256"
+
+	//the authentication plugin of the new user
+	defaultAuthPlugin = "mysql_native_password"
+
 	//listening ip
 	defaultHost = "0.0.0.0"
 
@@ -215,6 +224,26 @@ type FrontendParameters struct {
 
 	//default is ''. Path of file that contains X509 key in PEM format for client
 	TlsKeyFile string `toml:"tlsKeyFile"`
+
+	//default is false. With true. Server will also listen on the postgresPort for the clients of the postgresql protocol
+	EnablePostgres bool `toml:"enablePostgres"`
+
+	//postgresPort defines which port the mo-server listens on for the clients of the postgresql protocol
+	PostgresPort int64 `toml:"postgresPort"`
+
+	//default is 'scram-sha-256'. the password authentication of the postgresql protocol, 'md5' or 'scram-sha-256'
+	PostgresAuthMethod string `toml:"postgresAuthMethod"`
+
+	//default is 'mysql_native_password'. the authentication plugin of the user created without the plugin,
+	//'mysql_native_password' or 'caching_sha2_password'
+	DefaultAuthPlugin string `toml:"defaultAuthPlugin"`
+
+	//default is ''. Path of file that contains the RSA private key in PEM format for caching_sha2_password.
+	//the key pair is generated when the server starts if it is empty
+	CachingSha2PrivateKeyFile string `toml:"cachingSha2PrivateKeyFile"`
+
+	//default is ''. Path of file that contains the RSA public key in PEM format for caching_sha2_password
+	CachingSha2PublicKeyFile string `toml:"cachingSha2PublicKeyFile"`
 }
 
 func (fp *FrontendParameters) SetDefaultValues() {
@@ -242,6 +271,18 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.Host = defaultHost
 	}
 
+	if fp.PostgresPort == 0 {
+		fp.PostgresPort = int64(defaultPostgresPort)
+	}
+
+	if fp.PostgresAuthMethod == "" {
+		fp.PostgresAuthMethod = defaultPostgresAuthMethod
+	}
+
+	if fp.DefaultAuthPlugin == "" {
+		fp.DefaultAuthPlugin = defaultAuthPlugin
+	}
+
 	if fp.HostMmuLimitation == 0 {
 		fp.HostMmuLimitation = int64(defaultHostMmuLimitation)
 	}
@@ -0,0 +1,204 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// ModifyType is the way JSON_SET, JSON_INSERT and JSON_REPLACE treat the paths
+type ModifyType byte
+
+const (
+	// ModifySet replaces the existing values and adds the missing ones
+	ModifySet ModifyType = iota
+	// ModifyInsert only adds the missing values
+	ModifyInsert
+	// ModifyReplace only replaces the existing values
+	ModifyReplace
+)
+
+func CreateNull() ByteJson {
+	return ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralNull}}
+}
+
+func CreateBool(v bool) ByteJson {
+	if v {
+		return ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralTrue}}
+	}
+	return ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralFalse}}
+}
+
+func CreateInt64(v int64) ByteJson {
+	return ByteJson{Type: TpCodeInt64, Data: addInt64(nil, v)}
+}
+
+func CreateUint64(v uint64) ByteJson {
+	return ByteJson{Type: TpCodeUint64, Data: addUint64(nil, v)}
+}
+
+func CreateFloat64(v float64) (ByteJson, error) {
+	if err := checkFloat64(v); err != nil {
+		return ByteJson{}, err
+	}
+	return ByteJson{Type: TpCodeFloat64, Data: addFloat64(nil, v)}, nil
+}
+
+func CreateString(v string) ByteJson {
+	return ByteJson{Type: TpCodeString, Data: addString(nil, v)}
+}
+
+// CreateArray builds an array of the elements
+func CreateArray(elems []ByteJson) ByteJson {
+	return mergeToArray(elems)
+}
+
+// CreateObject builds an object of the keys and the values, the last one
+// of the duplicated keys wins as in MySQL
+func CreateObject(keys []string, vals []ByteJson) (ByteJson, error) {
+	m := make(map[string]interface{}, len(keys))
+	for i, key := range keys {
+		m[key] = vals[i]
+	}
+	return fromTree(m)
+}
+
+// toTree converts the document to the maps and slices which can be modified,
+// the scalars are kept as they are
+func (bj ByteJson) toTree() interface{} {
+	switch bj.Type {
+	case TpCodeObject:
+		cnt := bj.GetElemCnt()
+		m := make(map[string]interface{}, cnt)
+		for i := 0; i < cnt; i++ {
+			m[string(bj.getObjectKey(i))] = bj.getObjectVal(i).toTree()
+		}
+		return m
+	case TpCodeArray:
+		cnt := bj.GetElemCnt()
+		s := make([]interface{}, cnt)
+		for i := 0; i < cnt; i++ {
+			s[i] = bj.getArrayElem(i).toTree()
+		}
+		return s
+	}
+	return bj
+}
+
+func fromTree(in interface{}) (ByteJson, error) {
+	tpCode, buf, err := addElem(nil, in)
+	if err != nil {
+		return ByteJson{}, err
+	}
+	return ByteJson{Type: tpCode, Data: buf}, nil
+}
+
+// Modify sets the values at the paths one by one, the later paths see the
+// document modified by the earlier ones
+func (bj ByteJson) Modify(paths []Path, vals []ByteJson, tp ModifyType) (ByteJson, error) {
+	tree := bj.toTree()
+	for i, path := range paths {
+		if path.flag != 0 {
+			return ByteJson{}, errors.New(errno.InvalidJsonPath, fmt.Sprintf("the path '%s' may not contain the * and ** tokens", path))
+		}
+		tree = modifyTree(tree, path.paths, vals[i], tp)
+	}
+	return fromTree(tree)
+}
+
+func modifyTree(node interface{}, subs []subPath, val ByteJson, tp ModifyType) interface{} {
+	if len(subs) == 0 {
+		if tp == ModifyInsert {
+			return node
+		}
+		return val
+	}
+	sub, last := subs[0], len(subs) == 1
+	switch sub.tp {
+	case subPathKey:
+		m, ok := node.(map[string]interface{})
+		if !ok {
+			return node
+		}
+		if child, ok := m[sub.key]; ok {
+			m[sub.key] = modifyTree(child, subs[1:], val, tp)
+		} else if last && tp != ModifyReplace {
+			m[sub.key] = val
+		}
+	case subPathIdx:
+		s, ok := node.([]interface{})
+		if !ok {
+			// a scalar or an object is taken as an array of itself
+			if sub.idx == 0 {
+				return modifyTree(node, subs[1:], val, tp)
+			}
+			if last && tp != ModifyReplace {
+				return []interface{}{node, val}
+			}
+			return node
+		}
+		if sub.idx < len(s) {
+			s[sub.idx] = modifyTree(s[sub.idx], subs[1:], val, tp)
+		} else if last && tp != ModifyReplace {
+			return append(s, val)
+		}
+		return s
+	}
+	return node
+}
+
+// Remove removes the values at the paths one by one
+func (bj ByteJson) Remove(paths []Path) (ByteJson, error) {
+	tree := bj.toTree()
+	for _, path := range paths {
+		if path.flag != 0 {
+			return ByteJson{}, errors.New(errno.InvalidJsonPath, fmt.Sprintf("the path '%s' may not contain the * and ** tokens", path))
+		}
+		if path.empty() {
+			return ByteJson{}, errors.New(errno.InvalidJsonPath, "the path '$' can not be removed")
+		}
+		tree = removeTree(tree, path.paths)
+	}
+	return fromTree(tree)
+}
+
+func removeTree(node interface{}, subs []subPath) interface{} {
+	sub, last := subs[0], len(subs) == 1
+	switch x := node.(type) {
+	case map[string]interface{}:
+		if sub.tp != subPathKey {
+			return node
+		}
+		if child, ok := x[sub.key]; ok {
+			if last {
+				delete(x, sub.key)
+			} else {
+				x[sub.key] = removeTree(child, subs[1:])
+			}
+		}
+	case []interface{}:
+		if sub.tp != subPathIdx || sub.idx >= len(x) {
+			return node
+		}
+		if last {
+			return append(x[:sub.idx], x[sub.idx+1:]...)
+		}
+		x[sub.idx] = removeTree(x[sub.idx], subs[1:])
+	}
+	return node
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	f, err := CreateFloat64(1.5)
+	require.NoError(t, err)
+	arr := CreateArray([]ByteJson{CreateInt64(-1), CreateUint64(2), f, CreateString("a"), CreateBool(true), CreateNull()})
+	require.Equal(t, `[-1, 2, 1.5, "a", true, null]`, arr.String())
+
+	obj, err := CreateObject([]string{"b", "a", "b"}, []ByteJson{CreateInt64(1), arr, CreateInt64(3)})
+	require.NoError(t, err)
+	require.Equal(t, `{"a": [-1, 2, 1.5, "a", true, null], "b": 3}`, obj.String())
+}
+
+func TestModify(t *testing.T) {
+	kases := []struct {
+		json   string
+		path   string
+		tp     ModifyType
+		expect string
+	}{
+		{`{"a": 1, "b": [2, 3]}`, "$.a", ModifySet, `{"a": 10, "b": [2, 3]}`},
+		{`{"a": 1, "b": [2, 3]}`, "$.c", ModifySet, `{"a": 1, "b": [2, 3], "c": 10}`},
+		{`{"a": 1, "b": [2, 3]}`, "$.b[5]", ModifySet, `{"a": 1, "b": [2, 3, 10]}`},
+		{`{"a": 1, "b": [2, 3]}`, "$.a[1]", ModifySet, `{"a": [1, 10], "b": [2, 3]}`},
+		{`{"a": 1, "b": [2, 3]}`, "$.c.d", ModifySet, `{"a": 1, "b": [2, 3]}`},
+		{`{"a": 1, "b": [2, 3]}`, "$.a", ModifyInsert, `{"a": 1, "b": [2, 3]}`},
+		{`{"a": 1, "b": [2, 3]}`, "$.c", ModifyInsert, `{"a": 1, "b": [2, 3], "c": 10}`},
+		{`{"a": 1, "b": [2, 3]}`, "$.b[0]", ModifyReplace, `{"a": 1, "b": [10, 3]}`},
+		{`{"a": 1, "b": [2, 3]}`, "$.c", ModifyReplace, `{"a": 1, "b": [2, 3]}`},
+		{`[1, 2]`, "$", ModifySet, `10`},
+	}
+	for _, kase := range kases {
+		bj, err := ParseFromString(kase.json)
+		require.NoError(t, err)
+		path, err := ParseJsonPath(kase.path)
+		require.NoError(t, err)
+		ret, err := bj.Modify([]Path{path}, []ByteJson{CreateInt64(10)}, kase.tp)
+		require.NoError(t, err)
+		require.Equal(t, kase.expect, ret.String(), kase.path)
+	}
+
+	bj, _ := ParseFromString(`[1]`)
+	path, _ := ParseJsonPath("$[*]")
+	_, err := bj.Modify([]Path{path}, []ByteJson{CreateNull()}, ModifySet)
+	require.Error(t, err)
+}
+
+func TestRemove(t *testing.T) {
+	bj, err := ParseFromString(`{"a": [1, 2, 3], "b": {"c": 1}}`)
+	require.NoError(t, err)
+	p1, _ := ParseJsonPath("$.a[1]")
+	p2, _ := ParseJsonPath("$.b.c")
+	p3, _ := ParseJsonPath("$.x")
+	ret, err := bj.Remove([]Path{p1, p2, p3})
+	require.NoError(t, err)
+	require.Equal(t, `{"a": [1, 3], "b": {}}`, ret.String())
+
+	root, _ := ParseJsonPath("$")
+	_, err = bj.Remove([]Path{root})
+	require.Error(t, err)
+}
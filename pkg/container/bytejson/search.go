@@ -0,0 +1,268 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+)
+
+// Find returns the values matched by the path, unlike Query a missing value
+// is not returned as null
+func (bj ByteJson) Find(path Path) []ByteJson {
+	return bj.find(nil, path)
+}
+
+func (bj ByteJson) find(cur []ByteJson, path Path) []ByteJson {
+	if path.empty() {
+		return append(cur, bj)
+	}
+	sub, nPath := path.remove()
+	switch sub.tp {
+	case subPathIdx:
+		if bj.Type != TpCodeArray {
+			// a scalar or an object is taken as an array of itself
+			if sub.idx == 0 || sub.idx == subPathIdxALL {
+				cur = bj.find(cur, nPath)
+			}
+			return cur
+		}
+		cnt := bj.GetElemCnt()
+		if sub.idx == subPathIdxALL {
+			for i := 0; i < cnt; i++ {
+				cur = bj.getArrayElem(i).find(cur, nPath)
+			}
+		} else if sub.idx < cnt {
+			cur = bj.getArrayElem(sub.idx).find(cur, nPath)
+		}
+	case subPathKey:
+		if bj.Type != TpCodeObject {
+			return cur
+		}
+		cnt := bj.GetElemCnt()
+		if sub.key == "*" {
+			for i := 0; i < cnt; i++ {
+				cur = bj.getObjectVal(i).find(cur, nPath)
+			}
+		} else if i := bj.searchKey(string2Slice(sub.key)); i >= 0 {
+			cur = bj.getObjectVal(i).find(cur, nPath)
+		}
+	case subPathDoubleStar:
+		cur = bj.find(cur, nPath)
+		switch bj.Type {
+		case TpCodeObject:
+			for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+				cur = bj.getObjectVal(i).find(cur, path)
+			}
+		case TpCodeArray:
+			for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+				cur = bj.getArrayElem(i).find(cur, path)
+			}
+		}
+	}
+	return cur
+}
+
+// searchKey returns the position of the key in the object or -1
+func (bj ByteJson) searchKey(key []byte) int {
+	cnt := bj.GetElemCnt()
+	lo, hi := 0, cnt
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if bytes.Compare(bj.getObjectKey(mid), key) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < cnt && bytes.Equal(bj.getObjectKey(lo), key) {
+		return lo
+	}
+	return -1
+}
+
+// ContainsPath checks whether the document has any or all of the paths
+func (bj ByteJson) ContainsPath(paths []Path, all bool) bool {
+	for _, path := range paths {
+		found := len(bj.Find(path)) > 0
+		if found && !all {
+			return true
+		}
+		if !found && all {
+			return false
+		}
+	}
+	return all
+}
+
+// Contains checks whether the candidate is contained in the document as
+// JSON_CONTAINS of MySQL: a scalar is contained in an equal scalar or an
+// array having it, an array is contained if all its elements are contained,
+// and an object is contained in an object having all its keys whose values
+// contain the values of the candidate.
+func (bj ByteJson) Contains(candidate ByteJson) bool {
+	switch bj.Type {
+	case TpCodeObject:
+		if candidate.Type != TpCodeObject {
+			return false
+		}
+		for i, cnt := 0, candidate.GetElemCnt(); i < cnt; i++ {
+			j := bj.searchKey(candidate.getObjectKey(i))
+			if j < 0 || !bj.getObjectVal(j).Contains(candidate.getObjectVal(i)) {
+				return false
+			}
+		}
+		return true
+	case TpCodeArray:
+		if candidate.Type == TpCodeArray {
+			for i, cnt := 0, candidate.GetElemCnt(); i < cnt; i++ {
+				if !bj.Contains(candidate.getArrayElem(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+			if bj.getArrayElem(i).Contains(candidate) {
+				return true
+			}
+		}
+		return false
+	}
+	return bj.scalarEqual(candidate)
+}
+
+func (bj ByteJson) scalarEqual(other ByteJson) bool {
+	if bj.isNumber() && other.isNumber() {
+		switch {
+		case bj.Type == TpCodeFloat64 || other.Type == TpCodeFloat64:
+			return bj.toFloat64Value() == other.toFloat64Value()
+		case bj.Type == other.Type:
+			return bj.GetUint64() == other.GetUint64()
+		case bj.Type == TpCodeInt64:
+			return bj.GetInt64() >= 0 && bj.GetUint64() == other.GetUint64()
+		default:
+			return other.GetInt64() >= 0 && bj.GetUint64() == other.GetUint64()
+		}
+	}
+	switch {
+	case bj.Type != other.Type:
+		return false
+	case bj.Type == TpCodeString:
+		return bytes.Equal(bj.GetString(), other.GetString())
+	case bj.Type == TpCodeLiteral:
+		return bj.Data[0] == other.Data[0]
+	}
+	return false
+}
+
+func (bj ByteJson) isNumber() bool {
+	return bj.Type == TpCodeInt64 || bj.Type == TpCodeUint64 || bj.Type == TpCodeFloat64
+}
+
+func (bj ByteJson) toFloat64Value() float64 {
+	switch bj.Type {
+	case TpCodeInt64:
+		return float64(bj.GetInt64())
+	case TpCodeUint64:
+		return float64(bj.GetUint64())
+	}
+	return bj.GetFloat64()
+}
+
+// Keys returns the keys of the object as an array, ok is false if it's not an object
+func (bj ByteJson) Keys() (ByteJson, bool) {
+	if bj.Type != TpCodeObject {
+		return ByteJson{}, false
+	}
+	cnt := bj.GetElemCnt()
+	keys := make([]ByteJson, cnt)
+	for i := 0; i < cnt; i++ {
+		keys[i] = CreateString(string(bj.getObjectKey(i)))
+	}
+	return CreateArray(keys), true
+}
+
+// Length returns the number of the elements of an array or the members of
+// an object, a scalar has length 1
+func (bj ByteJson) Length() int {
+	if bj.Type == TpCodeObject || bj.Type == TpCodeArray {
+		return bj.GetElemCnt()
+	}
+	return 1
+}
+
+// ArrayElems returns the elements of an array, a scalar or an object is taken
+// as an array of itself
+func (bj ByteJson) ArrayElems() []ByteJson {
+	if bj.Type != TpCodeArray {
+		return []ByteJson{bj}
+	}
+	cnt := bj.GetElemCnt()
+	elems := make([]ByteJson, cnt)
+	for i := 0; i < cnt; i++ {
+		elems[i] = bj.getArrayElem(i)
+	}
+	return elems
+}
+
+// TypeName returns the type name as JSON_TYPE of MySQL
+func (bj ByteJson) TypeName() string {
+	switch bj.Type {
+	case TpCodeObject:
+		return "OBJECT"
+	case TpCodeArray:
+		return "ARRAY"
+	case TpCodeInt64:
+		return "INTEGER"
+	case TpCodeUint64:
+		return "UNSIGNED INTEGER"
+	case TpCodeFloat64:
+		return "DOUBLE"
+	case TpCodeString:
+		return "STRING"
+	case TpCodeLiteral:
+		if bj.Data[0] == LiteralNull {
+			return "NULL"
+		}
+		return "BOOLEAN"
+	}
+	return ""
+}
+
+// IsNull checks whether it's the literal null
+func (bj ByteJson) IsNull() bool {
+	return bj.Type == TpCodeLiteral && bj.Data[0] == LiteralNull
+}
+
+// Unquote returns the string without quotes, the others are the same as String
+func (bj ByteJson) Unquote() string {
+	if bj.Type == TpCodeString {
+		return string(bj.GetString())
+	}
+	return bj.String()
+}
+
+// UnquoteString removes the quotes of a JSON string text, the text which is
+// not quoted is returned as it is
+func UnquoteString(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return s, nil
+	}
+	bj, err := ParseFromString(s)
+	if err != nil {
+		return "", err
+	}
+	return bj.Unquote(), nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFind(t *testing.T) {
+	bj, err := ParseFromString(`{"a": [{"b": 1}, {"c": 2}], "d": "x"}`)
+	require.NoError(t, err)
+	kases := []struct {
+		path   string
+		expect []string
+	}{
+		{"$.a[*].b", []string{"1"}},
+		{"$.a[5]", nil},
+		{"$.d[0]", []string{`"x"`}},
+		{"$**.c", []string{"2"}},
+		{"$.e", nil},
+	}
+	for _, kase := range kases {
+		path, err := ParseJsonPath(kase.path)
+		require.NoError(t, err)
+		var ret []string
+		for _, v := range bj.Find(path) {
+			ret = append(ret, v.String())
+		}
+		require.Equal(t, kase.expect, ret, kase.path)
+	}
+
+	p1, _ := ParseJsonPath("$.d")
+	p2, _ := ParseJsonPath("$.e")
+	require.True(t, bj.ContainsPath([]Path{p1, p2}, false))
+	require.False(t, bj.ContainsPath([]Path{p1, p2}, true))
+}
+
+func TestContains(t *testing.T) {
+	kases := []struct {
+		target    string
+		candidate string
+		expect    bool
+	}{
+		{`{"a": 1, "b": [1, 2, {"c": 3}]}`, `{"b": [{"c": 3}, 1]}`, true},
+		{`{"a": 1, "b": [1, 2]}`, `{"a": 2}`, false},
+		{`[1, 2, [3, 4]]`, `3`, true},
+		{`[1, 2]`, `3`, false},
+		{`[1, 2, [3, 4]]`, `[4, 1]`, true},
+		{`[1, 2.0]`, `2`, true},
+		{`"a"`, `"a"`, true},
+		{`1`, `[1]`, false},
+		{`{"a": 1}`, `1`, false},
+	}
+	for _, kase := range kases {
+		target, err := ParseFromString(kase.target)
+		require.NoError(t, err)
+		candidate, err := ParseFromString(kase.candidate)
+		require.NoError(t, err)
+		require.Equal(t, kase.expect, target.Contains(candidate), kase.target+" "+kase.candidate)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	bj, err := ParseFromString(`{"b": 1, "a": [1, 2]}`)
+	require.NoError(t, err)
+	keys, ok := bj.Keys()
+	require.True(t, ok)
+	require.Equal(t, `["a", "b"]`, keys.String())
+	require.Equal(t, 2, bj.Length())
+	require.Equal(t, "OBJECT", bj.TypeName())
+	require.Equal(t, 1, len(bj.ArrayElems()))
+	arr, err := ParseFromString(`[1, "x", null]`)
+	require.NoError(t, err)
+	require.Equal(t, `"x"`, arr.ArrayElems()[1].String())
+
+	for json, name := range map[string]string{
+		`[]`: "ARRAY", `1`: "INTEGER", `18446744073709551615`: "UNSIGNED INTEGER",
+		`1.5`: "DOUBLE", `"s"`: "STRING", `true`: "BOOLEAN", `null`: "NULL",
+	} {
+		bj, err = ParseFromString(json)
+		require.NoError(t, err)
+		require.Equal(t, name, bj.TypeName())
+	}
+
+	s, err := UnquoteString(`"a\tb"`)
+	require.NoError(t, err)
+	require.Equal(t, "a\tb", s)
+	s, err = UnquoteString(`abc`)
+	require.NoError(t, err)
+	require.Equal(t, "abc", s)
+}
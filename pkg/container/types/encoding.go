@@ -30,6 +30,9 @@ import (
 const (
 	TSize          int = int(unsafe.Sizeof(Type{}))
 	DateSize       int = 4
+	TimeSize       int = 8
+	EnumSize       int = 2
+	SetSize        int = 8
 	DatetimeSize   int = 8
 	TimestampSize  int = 8
 	Decimal64Size  int = 8
@@ -189,6 +192,30 @@ func DecodeDate(v []byte) Date {
 	return *(*Date)(unsafe.Pointer(&v[0]))
 }
 
+func EncodeTime(v *Time) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(v)), 8)
+}
+
+func DecodeTime(v []byte) Time {
+	return *(*Time)(unsafe.Pointer(&v[0]))
+}
+
+func EncodeEnum(v *Enum) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(v)), 2)
+}
+
+func DecodeEnum(v []byte) Enum {
+	return *(*Enum)(unsafe.Pointer(&v[0]))
+}
+
+func EncodeSet(v *Set) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(v)), 8)
+}
+
+func DecodeSet(v []byte) Set {
+	return *(*Set)(unsafe.Pointer(&v[0]))
+}
+
 func EncodeDatetime(v *Datetime) []byte {
 	return unsafe.Slice((*byte)(unsafe.Pointer(v)), 8)
 }
@@ -367,6 +394,30 @@ func DecodeUuidSlice(v []byte) []Uuid {
 	return DecodeFixedSlice[Uuid](v, UuidSize)
 }
 
+func EncodeTimeSlice(v []Time) []byte {
+	return EncodeFixedSlice(v, TimeSize)
+}
+
+func DecodeTimeSlice(v []byte) (ret []Time) {
+	return DecodeFixedSlice[Time](v, TimeSize)
+}
+
+func EncodeEnumSlice(v []Enum) []byte {
+	return EncodeFixedSlice(v, EnumSize)
+}
+
+func DecodeEnumSlice(v []byte) []Enum {
+	return DecodeFixedSlice[Enum](v, EnumSize)
+}
+
+func EncodeSetSlice(v []Set) []byte {
+	return EncodeFixedSlice(v, SetSize)
+}
+
+func DecodeSetSlice(v []byte) []Set {
+	return DecodeFixedSlice[Set](v, SetSize)
+}
+
 func EncodeDatetimeSlice(v []Datetime) []byte {
 	return EncodeFixedSlice(v, DatetimeSize)
 }
@@ -476,6 +527,12 @@ func DecodeValue(val []byte, typ Type) any {
 		return DecodeFixed[float64](val)
 	case T_date:
 		return DecodeFixed[Date](val)
+	case T_time:
+		return DecodeFixed[Time](val)
+	case T_enum:
+		return DecodeFixed[Enum](val)
+	case T_set:
+		return DecodeFixed[Set](val)
 	case T_datetime:
 		return DecodeFixed[Datetime](val)
 	case T_timestamp:
@@ -486,7 +543,7 @@ func DecodeValue(val []byte, typ Type) any {
 		return DecodeFixed[Decimal128](val)
 	case T_uuid:
 		return DecodeFixed[Uuid](val)
-	case T_char, T_varchar:
+	case T_char, T_varchar, T_json, T_blob:
 		return val
 	default:
 		panic("unsupported type")
@@ -527,9 +584,15 @@ func EncodeValue(val any, typ Type) []byte {
 		return EncodeFixed(val.(Date))
 	case T_timestamp:
 		return EncodeFixed(val.(Timestamp))
+	case T_time:
+		return EncodeFixed(val.(Time))
+	case T_enum:
+		return EncodeFixed(val.(Enum))
+	case T_set:
+		return EncodeFixed(val.(Set))
 	case T_datetime:
 		return EncodeFixed(val.(Datetime))
-	case T_char, T_varchar:
+	case T_char, T_varchar, T_json, T_blob:
 		return val.([]byte)
 	default:
 		panic("unsupported type")
@@ -605,6 +668,21 @@ func WriteValues(w io.Writer, vals ...any) (n int64, err error) {
 				return
 			}
 			n += int64(nr)
+		case Time:
+			if nr, err = w.Write(EncodeFixed(v)); err != nil {
+				return
+			}
+			n += int64(nr)
+		case Enum:
+			if nr, err = w.Write(EncodeFixed(v)); err != nil {
+				return
+			}
+			n += int64(nr)
+		case Set:
+			if nr, err = w.Write(EncodeFixed(v)); err != nil {
+				return
+			}
+			n += int64(nr)
 		case Datetime:
 			if nr, err = w.Write(EncodeFixed(v)); err != nil {
 				return
@@ -0,0 +1,133 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// The value list of an ENUM or SET column is kept in the column definition, the
+// values are joined by EnumValueSeparator, so a value can not contain it
+
+const (
+	MaxEnumValues = 65535
+	MaxSetValues  = 64
+
+	EnumValueSeparator = ","
+)
+
+// ParseEnumValues splits the value list kept in the column definition
+func ParseEnumValues(s string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	return strings.Split(s, EnumValueSeparator)
+}
+
+// EnumValuesToString joins the values to be kept in the column definition
+func EnumValuesToString(values []string) string {
+	return strings.Join(values, EnumValueSeparator)
+}
+
+// ParseEnum returns the ordinal of the value in the value list, the trailing
+// spaces are ignored and the letter case is not significant as in MySQL.
+// A number which is not in the list is taken as the ordinal
+func ParseEnum(values []string, s string) (Enum, error) {
+	if i := findEnumValue(values, s); i >= 0 {
+		return Enum(i + 1), nil
+	}
+	if v, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64); err == nil {
+		return ParseIntToEnum(values, v)
+	}
+	return 0, errInvalidEnumValue("enum", s)
+}
+
+// ParseIntToEnum checks the ordinal is in the range of the value list
+func ParseIntToEnum(values []string, v uint64) (Enum, error) {
+	if v == 0 || v > uint64(len(values)) {
+		return 0, errInvalidEnumValue("enum", strconv.FormatUint(v, 10))
+	}
+	return Enum(v), nil
+}
+
+// ToString returns the value of the ordinal, the invalid ordinal 0 is the empty string
+func (e Enum) ToString(values []string) string {
+	if e == 0 || int(e) > len(values) {
+		return ""
+	}
+	return values[e-1]
+}
+
+// ParseSet returns the bitmap of the comma separated values, the order and the
+// duplicates are not significant. A number is taken as the bitmap
+func ParseSet(values []string, s string) (Set, error) {
+	if len(s) == 0 {
+		return 0, nil
+	}
+	var r Set
+	for _, v := range strings.Split(s, ",") {
+		i := findEnumValue(values, v)
+		if i < 0 {
+			if v, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64); err == nil {
+				return ParseIntToSet(values, v)
+			}
+			return 0, errInvalidEnumValue("set", s)
+		}
+		r |= 1 << i
+	}
+	return r, nil
+}
+
+// ParseIntToSet checks the bitmap only holds the values in the value list
+func ParseIntToSet(values []string, v uint64) (Set, error) {
+	if len(values) < MaxSetValues && v>>len(values) != 0 {
+		return 0, errInvalidEnumValue("set", strconv.FormatUint(v, 10))
+	}
+	return Set(v), nil
+}
+
+// ToString returns the values of the bitmap joined by comma in the order of the value list
+func (s Set) ToString(values []string) string {
+	var b strings.Builder
+	for i, v := range values {
+		if s&(1<<i) == 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(v)
+	}
+	return b.String()
+}
+
+func findEnumValue(values []string, s string) int {
+	s = strings.TrimRight(s, " ")
+	for i, v := range values {
+		if strings.EqualFold(v, s) {
+			return i
+		}
+	}
+	return -1
+}
+
+func errInvalidEnumValue(typ string, s string) error {
+	return errors.New(errno.DataException, fmt.Sprintf("Data truncated, '%s' is not a value of the %s", s, typ))
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnum(t *testing.T) {
+	values := ParseEnumValues("small,medium,large")
+	require.Equal(t, "small,medium,large", EnumValuesToString(values))
+
+	e, err := ParseEnum(values, "Medium  ")
+	require.NoError(t, err)
+	require.Equal(t, Enum(2), e)
+	require.Equal(t, "medium", e.ToString(values))
+
+	e, err = ParseEnum(values, "3")
+	require.NoError(t, err)
+	require.Equal(t, "large", e.ToString(values))
+
+	_, err = ParseEnum(values, "huge")
+	require.Error(t, err)
+	_, err = ParseEnum(values, "4")
+	require.Error(t, err)
+	_, err = ParseIntToEnum(values, 0)
+	require.Error(t, err)
+	require.Equal(t, "", Enum(0).ToString(values))
+}
+
+func TestSet(t *testing.T) {
+	values := ParseEnumValues("a,b,c,d")
+
+	s, err := ParseSet(values, "d,a,D")
+	require.NoError(t, err)
+	require.Equal(t, Set(9), s)
+	require.Equal(t, "a,d", s.ToString(values))
+
+	s, err = ParseSet(values, "")
+	require.NoError(t, err)
+	require.Equal(t, Set(0), s)
+
+	s, err = ParseSet(values, "6")
+	require.NoError(t, err)
+	require.Equal(t, "b,c", s.ToString(values))
+
+	_, err = ParseSet(values, "a,e")
+	require.Error(t, err)
+	_, err = ParseIntToSet(values, 16)
+	require.Error(t, err)
+}
@@ -0,0 +1,322 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// The Time type holds number of microseconds of a time of day or of an elapsed time,
+// it ranges from '-838:59:59.999999' to '838:59:59.999999' as in MySQL
+
+const (
+	MaxTimeHour = 838
+
+	MaxTime = Time((MaxTimeHour*secsPerHour+59*secsPerMinute+59)*microSecsPerSec + 999999)
+	MinTime = -MaxTime
+)
+
+var (
+	ErrIncorrectTimeValue = errors.New(errno.DataException, "Incorrect time format")
+	ErrTimeOutOfRange     = errors.New(errno.DataException, "Beyond the range of time")
+)
+
+func (t Time) String() string {
+	isNeg, hour, minute, sec := t.Clock()
+	if isNeg {
+		return fmt.Sprintf("-%02d:%02d:%02d", hour, minute, sec)
+	}
+	return fmt.Sprintf("%02d:%02d:%02d", hour, minute, sec)
+}
+
+func (t Time) String2(precision int32) string {
+	if precision <= 0 {
+		return t.String()
+	}
+	msecInstr := fmt.Sprintf("%06d", t.MicroSec())
+	return t.String() + "." + msecInstr[:precision]
+}
+
+// ParseTime will parse a string to be a Time
+// Support Format:
+// 1. [-][d ]hh:mm:ss(.msec), the minute and second can be omitted
+// 2. [-]hhmmss(.msec), a number with less than 6 digits is ss, mmss or hmmss
+// 3. all the Datetime value, only the time of the day is kept
+// during parsing, the Time value will be rounded(away from zero) to the predefined precision
+func ParseTime(s string, precision int32) (Time, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return 0, ErrIncorrectTimeValue
+	}
+	isNeg := false
+	body := s
+	if body[0] == '-' {
+		isNeg = true
+		body = body[1:]
+	}
+	integer, frac := body, ""
+	if i := strings.IndexByte(body, '.'); i >= 0 {
+		integer, frac = body[:i], body[i+1:]
+	}
+	if !isNeg && (strings.IndexByte(integer, '-') > 0 || (len(integer) >= 14 && isAllDigits(integer))) {
+		dt, err := ParseDatetime(s, precision)
+		if err != nil {
+			return 0, ErrIncorrectTimeValue
+		}
+		return dt.ToTime(precision), nil
+	}
+
+	var day, hour uint64
+	var minute, sec uint64
+	var err error
+	if strings.IndexByte(integer, ':') >= 0 {
+		clock := integer
+		if i := strings.IndexByte(integer, ' '); i >= 0 {
+			if day, err = strconv.ParseUint(integer[:i], 10, 32); err != nil {
+				return 0, ErrIncorrectTimeValue
+			}
+			clock = strings.TrimSpace(integer[i+1:])
+		}
+		parts := strings.Split(clock, ":")
+		if len(parts) > 3 {
+			return 0, ErrIncorrectTimeValue
+		}
+		nums := make([]uint64, 3)
+		for i, part := range parts {
+			if len(part) == 0 || !isAllDigits(part) {
+				return 0, ErrIncorrectTimeValue
+			}
+			if nums[i], err = strconv.ParseUint(part, 10, 32); err != nil {
+				return 0, ErrIncorrectTimeValue
+			}
+		}
+		hour, minute, sec = nums[0], nums[1], nums[2]
+	} else {
+		if len(integer) == 0 || !isAllDigits(integer) {
+			return 0, ErrIncorrectTimeValue
+		}
+		num, err := strconv.ParseUint(integer, 10, 64)
+		if err != nil {
+			return 0, ErrIncorrectTimeValue
+		}
+		hour, minute, sec = num/10000, num/100%100, num%100
+	}
+	if minute > maxMinuteInHour || sec > maxSecondInMinute {
+		return 0, ErrIncorrectTimeValue
+	}
+
+	var msec, carry uint32
+	if len(frac) > 0 {
+		if msec, carry, err = getMsec(frac, precision); err != nil {
+			return 0, ErrIncorrectTimeValue
+		}
+	}
+	hour += day * 24
+	if hour > MaxTimeHour {
+		return 0, ErrTimeOutOfRange
+	}
+	t := TimeFromClock(isNeg, hour, uint8(minute), uint8(sec), msec) + signedCarry(isNeg, carry)
+	if t > MaxTime || t < MinTime {
+		return 0, ErrTimeOutOfRange
+	}
+	return t, nil
+}
+
+// ParseInt64ToTime parses the number hhmmss to be a Time, as MySQL does for the
+// numbers inserted into a time column
+func ParseInt64ToTime(v int64) (Time, error) {
+	isNeg := v < 0
+	if isNeg {
+		v = -v
+	}
+	hour, minute, sec := uint64(v/10000), uint64(v/100%100), uint64(v%100)
+	if minute > maxMinuteInHour || sec > maxSecondInMinute || hour > MaxTimeHour {
+		return 0, ErrTimeOutOfRange
+	}
+	return TimeFromClock(isNeg, hour, uint8(minute), uint8(sec), 0), nil
+}
+
+// ParseFloat64ToTime parses the number hhmmss.msec to be a Time
+func ParseFloat64ToTime(v float64, precision int32) (Time, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, ErrIncorrectTimeValue
+	}
+	return ParseTime(strconv.FormatFloat(v, 'f', -1, 64), precision)
+}
+
+func TimeFromClock(isNeg bool, hour uint64, minute, sec uint8, msec uint32) Time {
+	secs := int64(hour)*secsPerHour + int64(minute)*secsPerMinute + int64(sec)
+	t := Time(secs*microSecsPerSec + int64(msec))
+	if isNeg {
+		return -t
+	}
+	return t
+}
+
+func signedCarry(isNeg bool, carry uint32) Time {
+	if isNeg {
+		return -Time(carry) * microSecsPerSec
+	}
+	return Time(carry) * microSecsPerSec
+}
+
+func isAllDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Clock returns the sign, hour, minute and second of the Time
+func (t Time) Clock() (isNeg bool, hour uint64, minute, sec uint8) {
+	v := int64(t)
+	if v < 0 {
+		isNeg = true
+		v = -v
+	}
+	secs := v / microSecsPerSec
+	hour = uint64(secs / secsPerHour)
+	minute = uint8(secs % secsPerHour / secsPerMinute)
+	sec = uint8(secs % secsPerMinute)
+	return
+}
+
+func (t Time) Hour() int64 {
+	_, hour, _, _ := t.Clock()
+	return int64(hour)
+}
+
+func (t Time) Minute() uint8 {
+	_, _, minute, _ := t.Clock()
+	return minute
+}
+
+func (t Time) Sec() uint8 {
+	_, _, _, sec := t.Clock()
+	return sec
+}
+
+// MicroSec returns the absolute value of the microsecond part
+func (t Time) MicroSec() int64 {
+	v := int64(t) % microSecsPerSec
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// Round rounds(away from zero) the Time to the precision
+func (t Time) Round(precision int32) Time {
+	if precision < 0 || precision >= 6 {
+		return t
+	}
+	scale := int64(scaleTable[precision])
+	v := int64(t)
+	if v < 0 {
+		return -Time((-v + scale/2) / scale * scale)
+	}
+	return Time((v + scale/2) / scale * scale)
+}
+
+// ToInt64 returns the number hhmmss of the Time, rounded to seconds
+func (t Time) ToInt64() int64 {
+	isNeg, hour, minute, sec := t.Round(0).Clock()
+	v := int64(hour)*10000 + int64(minute)*100 + int64(sec)
+	if isNeg {
+		return -v
+	}
+	return v
+}
+
+// ToFloat64 returns the number hhmmss.msec of the Time
+func (t Time) ToFloat64() float64 {
+	isNeg, hour, minute, sec := t.Clock()
+	v := float64(int64(hour)*10000+int64(minute)*100+int64(sec)) + float64(t.MicroSec())/microSecsPerSec
+	if isNeg {
+		return -v
+	}
+	return v
+}
+
+// ToSeconds returns the number of seconds of the Time, truncated towards zero
+func (t Time) ToSeconds() int64 {
+	return int64(t) / microSecsPerSec
+}
+
+// SecondsToTime converts a number of seconds to a Time, clipped to the range of Time
+func SecondsToTime(secs float64) Time {
+	v := math.Round(secs * microSecsPerSec)
+	if v > float64(MaxTime) {
+		return MaxTime
+	}
+	if v < float64(MinTime) {
+		return MinTime
+	}
+	return Time(v)
+}
+
+// ToDatetime returns the Datetime of the Time on the date
+func (t Time) ToDatetime(d Date) Datetime {
+	return d.ToDatetime() + Datetime(t)
+}
+
+// ToTime returns the time of the day of the Datetime
+func (dt Datetime) ToTime(precision int32) Time {
+	return Time(int64(dt) % (secsPerDay * microSecsPerSec)).Round(precision)
+}
+
+// AddTime adds two Time values, the result is clipped to the range of Time
+func (t Time) AddTime(d Time) Time {
+	return clipTime(int64(t) + int64(d))
+}
+
+// SubTime subtracts two Time values, the result is clipped to the range of Time
+func (t Time) SubTime(d Time) Time {
+	return clipTime(int64(t) - int64(d))
+}
+
+// DiffTime returns the Time elapsed from d to dt, clipped to the range of Time
+func (dt Datetime) DiffTime(d Datetime) Time {
+	return clipTime(int64(dt) - int64(d))
+}
+
+// AddTime adds a Time to the Datetime, the bool result is false if the Datetime
+// overflows
+func (dt Datetime) AddTime(t Time) (Datetime, bool) {
+	result := dt + Datetime(t)
+	y, m, d, _ := result.ToDate().Calendar(true)
+	if result < 0 || !validDatetime(y, m, d) {
+		return 0, false
+	}
+	return result, true
+}
+
+func clipTime(v int64) Time {
+	if v > int64(MaxTime) {
+		return MaxTime
+	}
+	if v < int64(MinTime) {
+		return MinTime
+	}
+	return Time(v)
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		precision int32
+		want      string
+		isErr     bool
+	}{
+		{"clock", "11:22:33", 0, "11:22:33", false},
+		{"clock with days", "2 11:22:33", 0, "59:22:33", false},
+		{"hour and minute", "11:22", 0, "11:22:00", false},
+		{"negative", "-838:59:59", 0, "-838:59:59", false},
+		{"number", "112233", 0, "11:22:33", false},
+		{"short number", "1233", 0, "00:12:33", false},
+		{"fraction", "11:22:33.1234567", 6, "11:22:33.123457", false},
+		{"fraction rounded", "11:22:33.5", 0, "11:22:34", false},
+		{"negative fraction rounded", "-11:22:59.99", 1, "-11:23:00.0", false},
+		{"datetime", "2022-10-01 11:22:33.45", 1, "11:22:33.5", false},
+		{"packed datetime", "20221001112233", 0, "11:22:33", false},
+		{"out of range", "839:00:00", 0, "", true},
+		{"invalid minute", "11:60:00", 0, "", true},
+		{"invalid number", "116033", 0, "", true},
+		{"invalid", "ab:cd", 0, "", true},
+		{"empty", "", 0, "", true},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := ParseTime(c.input, c.precision)
+			if c.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.want, got.String2(c.precision))
+		})
+	}
+}
+
+func TestTimeConvert(t *testing.T) {
+	tm, err := ParseInt64ToTime(-1234)
+	require.NoError(t, err)
+	require.Equal(t, "-00:12:34", tm.String())
+	require.Equal(t, int64(-1234), tm.ToInt64())
+	_, err = ParseInt64ToTime(8390000)
+	require.Error(t, err)
+
+	tm, err = ParseFloat64ToTime(112233.5, 1)
+	require.NoError(t, err)
+	require.Equal(t, 112233.5, tm.ToFloat64())
+	require.Equal(t, int64(112234), tm.ToInt64())
+	require.Equal(t, int64(11*3600+22*60+33), tm.ToSeconds())
+	require.Equal(t, int64(11), tm.Hour())
+	require.Equal(t, uint8(22), tm.Minute())
+	require.Equal(t, uint8(33), tm.Sec())
+
+	require.Equal(t, "00:01:40", SecondsToTime(100).String())
+	require.Equal(t, MaxTime, SecondsToTime(1e10))
+
+	dt := FromClock(2022, 10, 1, 11, 22, 33, 500000)
+	require.Equal(t, "11:22:34", dt.ToTime(0).String())
+	require.Equal(t, "2022-10-02 11:22:33", dt.ToTime(6).ToDatetime(FromCalendar(2022, 10, 2)).String())
+}
+
+func TestTimeArith(t *testing.T) {
+	a, _ := ParseTime("10:00:00", 0)
+	b, _ := ParseTime("12:30:00.5", 1)
+	require.Equal(t, "22:30:00.5", a.AddTime(b).String2(1))
+	require.Equal(t, "-02:30:00.5", a.SubTime(b).String2(1))
+	require.Equal(t, MaxTime, MaxTime.AddTime(b))
+	require.Equal(t, MinTime, MinTime.SubTime(b))
+
+	dt1 := FromClock(2022, 10, 2, 10, 0, 0, 0)
+	dt2 := FromClock(2022, 10, 1, 12, 30, 0, 0)
+	require.Equal(t, "21:30:00", dt1.DiffTime(dt2).String())
+	require.Equal(t, "-21:30:00", dt2.DiffTime(dt1).String())
+	require.Equal(t, MaxTime, dt2.DiffTime(FromClock(2022, 1, 1, 0, 0, 0, 0)))
+
+	result, ok := dt2.AddTime(a.AddTime(b))
+	require.True(t, ok)
+	require.Equal(t, "2022-10-02 11:00:00", result.String())
+	_, ok = FromClock(9999, 12, 31, 23, 0, 0, 0).AddTime(a)
+	require.False(t, ok)
+}
@@ -91,6 +91,8 @@ func printTuple(tuple Tuple) string {
 			res += fmt.Sprintf("(datetime: %v)", t.String())
 		case Timestamp:
 			res += fmt.Sprintf("(timestamp: %v)", t.String())
+		case Time:
+			res += fmt.Sprintf("(time: %v)", t.String())
 		case Decimal64:
 			res += fmt.Sprintf("(decimal64: %v)", t.String())
 		case Decimal128:
@@ -133,6 +135,7 @@ const timestampCode = 0x43
 const decimal64Code = 0x44
 const decimal128Code = 0x45
 const stringTypeCode = 0x46
+const timeCode = 0x47
 
 var sizeLimits = []uint64{
 	1<<(0*8) - 1,
@@ -334,6 +337,11 @@ func (p *packer) EncodeTimestamp(e Timestamp) {
 	p.encodeInt(int64(e))
 }
 
+func (p *packer) EncodeTime(e Time) {
+	p.putByte(timeCode)
+	p.encodeInt(int64(e))
+}
+
 func (p *packer) EncodeDecimal64(e Decimal64) {
 	p.putByte(decimal64Code)
 	b := [8]byte(e)
@@ -392,6 +400,8 @@ func decodeInt(code byte, b []byte) (interface{}, int) {
 			return Datetime(0), 1
 		case timestampCode:
 			return Timestamp(0), 1
+		case timeCode:
+			return Time(0), 1
 		default:
 			return int64(0), 1
 		}
@@ -425,6 +435,8 @@ func decodeInt(code byte, b []byte) (interface{}, int) {
 			return Datetime(ret - int64(sizeLimits[n])), n + 1
 		case timestampCode:
 			return Timestamp(ret - int64(sizeLimits[n])), n + 1
+		case timeCode:
+			return Time(ret - int64(sizeLimits[n])), n + 1
 		default:
 			return ret - int64(sizeLimits[n]), n + 1
 		}
@@ -442,6 +454,8 @@ func decodeInt(code byte, b []byte) (interface{}, int) {
 		return Datetime(ret), n + 1
 	case timestampCode:
 		return Timestamp(ret), n + 1
+	case timeCode:
+		return Time(ret), n + 1
 	default:
 		return ret, n + 1
 	}
@@ -555,6 +569,9 @@ func decodeTuple(b []byte) (Tuple, int, error) {
 		case b[i] == timestampCode:
 			el, off = decodeInt(timestampCode, b[i+1:])
 			off += 1
+		case b[i] == timeCode:
+			el, off = decodeInt(timeCode, b[i+1:])
+			off += 1
 		case b[i] == decimal64Code:
 			dEl, off = decodeBytes(b[i+1:])
 			var bb [8]byte
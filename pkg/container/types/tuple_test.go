@@ -30,7 +30,8 @@ func TestSimpleTupleAllTypes(t *testing.T) {
 				float64(1),
 				FromCalendar(2000, 1, 1), FromClock(2000, 1, 1, 1, 1, 0, 0),
 				FromClockUTC(2000, 2, 2, 2, 2, 0, 0), Decimal64_FromInt32(123),
-				Decimal128_FromInt32(123), []byte{1, 2, 3}},
+				Decimal128_FromInt32(123), []byte{1, 2, 3},
+				TimeFromClock(false, 10, 20, 30, 0), TimeFromClock(true, 1, 2, 3, 4), Time(0)},
 		},
 	}
 	for _, test := range tests {
@@ -425,6 +426,8 @@ func encodeBufToPacker(tuple Tuple, p *packer) {
 			p.EncodeDatetime(e)
 		case Timestamp:
 			p.EncodeTimestamp(e)
+		case Time:
+			p.EncodeTime(e)
 		case Decimal64:
 			p.EncodeDecimal64(e)
 		case Decimal128:
@@ -66,6 +66,8 @@ const (
 	T_varchar T = 61
 	T_json    T = 62
 	T_uuid    T = 63
+	T_enum    T = 64
+	T_set     T = 65
 
 	// blobs
 	T_blob T = 70
@@ -102,9 +104,18 @@ type Type struct {
 
 type Date int32
 
+type Time int64
 type Datetime int64
 type Timestamp int64
 
+// Enum is the 1-based ordinal of the value of an ENUM column in its value list,
+// 0 stands for the empty string used for the invalid values
+type Enum uint16
+
+// Set is the bitmap of the values of a SET column, bit i stands for the i-th value
+// in the value list
+type Set uint64
+
 type Decimal64 [8]byte
 type Decimal128 [16]byte
 
@@ -143,7 +154,7 @@ type BuiltinNumber interface {
 }
 
 type OrderedT interface {
-	constraints.Ordered | Date | Datetime | Timestamp
+	constraints.Ordered | Date | Time | Datetime | Timestamp | Enum | Set
 }
 
 type Decimal interface {
@@ -181,6 +192,7 @@ var Types map[string]T = map[string]T{
 	"double": T_float64,
 
 	"date":      T_date,
+	"time":      T_time,
 	"datetime":  T_datetime,
 	"timestamp": T_timestamp,
 	"interval":  T_interval,
@@ -191,6 +203,8 @@ var Types map[string]T = map[string]T{
 	"json": T_json,
 	"text": T_blob,
 	"uuid": T_uuid,
+	"enum": T_enum,
+	"set":  T_set,
 
 	"transaction timestamp": T_TS,
 	"rowid":                 T_Rowid,
@@ -288,15 +302,15 @@ func (t T) ToType() Type {
 		typ.Size = 2
 	case T_int32, T_date:
 		typ.Size = 4
-	case T_int64, T_datetime, T_timestamp:
+	case T_int64, T_time, T_datetime, T_timestamp:
 		typ.Size = 8
 	case T_uint8:
 		typ.Size = 1
-	case T_uint16:
+	case T_uint16, T_enum:
 		typ.Size = 2
 	case T_uint32:
 		typ.Size = 4
-	case T_uint64:
+	case T_uint64, T_set:
 		typ.Size = 8
 	case T_float32:
 		typ.Size = 4
@@ -351,6 +365,8 @@ func (t T) String() string {
 		return "DOUBLE"
 	case T_date:
 		return "DATE"
+	case T_time:
+		return "TIME"
 	case T_datetime:
 		return "DATETIME"
 	case T_timestamp:
@@ -375,6 +391,10 @@ func (t T) String() string {
 		return "ROWID"
 	case T_uuid:
 		return "UUID"
+	case T_enum:
+		return "ENUM"
+	case T_set:
+		return "SET"
 	}
 	return fmt.Sprintf("unexpected type: %d", t)
 }
@@ -386,6 +406,10 @@ func (t T) OidString() string {
 	switch t {
 	case T_uuid:
 		return "T_uuid"
+	case T_enum:
+		return "T_enum"
+	case T_set:
+		return "T_set"
 	case T_json:
 		return "T_json"
 	case T_bool:
@@ -416,6 +440,8 @@ func (t T) OidString() string {
 		return "T_varchar"
 	case T_date:
 		return "T_date"
+	case T_time:
+		return "T_time"
 	case T_datetime:
 		return "T_datetime"
 	case T_timestamp:
@@ -465,6 +491,8 @@ func (t T) GoType() string {
 		return "string"
 	case T_date:
 		return "date"
+	case T_time:
+		return "time"
 	case T_datetime:
 		return "datetime"
 	case T_timestamp:
@@ -477,6 +505,10 @@ func (t T) GoType() string {
 		return "string"
 	case T_uuid:
 		return "uuid"
+	case T_enum:
+		return "enum"
+	case T_set:
+		return "set"
 	}
 	return "unknown type"
 }
@@ -501,15 +533,15 @@ func (t T) TypeLen() int {
 		return 2
 	case T_int32, T_date:
 		return 4
-	case T_int64, T_datetime, T_timestamp:
+	case T_int64, T_time, T_datetime, T_timestamp:
 		return 8
 	case T_uint8:
 		return 1
-	case T_uint16:
+	case T_uint16, T_enum:
 		return 2
 	case T_uint32:
 		return 4
-	case T_uint64:
+	case T_uint64, T_set:
 		return 8
 	case T_float32:
 		return 4
@@ -538,11 +570,11 @@ func (t T) FixedLength() int {
 		return 0
 	case T_int8, T_uint8, T_bool:
 		return 1
-	case T_int16, T_uint16:
+	case T_int16, T_uint16, T_enum:
 		return 2
 	case T_int32, T_uint32, T_date, T_float32:
 		return 4
-	case T_int64, T_uint64, T_datetime, T_float64, T_timestamp:
+	case T_int64, T_uint64, T_time, T_datetime, T_float64, T_timestamp, T_set:
 		return 8
 	case T_decimal64:
 		return 8
@@ -601,12 +633,17 @@ func IsString(t T) bool {
 }
 
 func IsDateRelate(t T) bool {
-	if t == T_date || t == T_datetime || t == T_timestamp {
+	if t == T_date || t == T_time || t == T_datetime || t == T_timestamp {
 		return true
 	}
 	return false
 }
 
+// IsEnumRelate: return true if the types.T is enum or set
+func IsEnumRelate(t T) bool {
+	return t == T_enum || t == T_set
+}
+
 // IsDecimal: return true if the types.T is decimal64 or decimal128
 func IsDecimal(t T) bool {
 	if t == T_decimal64 || t == T_decimal128 {
@@ -146,6 +146,12 @@ func (v *Vector) colFromData() {
 			v.Col = DecodeFixedCol[types.Uuid](v, tlen)
 		case types.T_date:
 			v.Col = DecodeFixedCol[types.Date](v, tlen)
+		case types.T_time:
+			v.Col = DecodeFixedCol[types.Time](v, tlen)
+		case types.T_enum:
+			v.Col = DecodeFixedCol[types.Enum](v, tlen)
+		case types.T_set:
+			v.Col = DecodeFixedCol[types.Set](v, tlen)
 		case types.T_datetime:
 			v.Col = DecodeFixedCol[types.Datetime](v, tlen)
 		case types.T_timestamp:
@@ -201,6 +207,12 @@ func (v *Vector) setupColFromData(start, end int) {
 			v.Col = DecodeFixedCol[types.Uuid](v, tlen)[start:end]
 		case types.T_date:
 			v.Col = DecodeFixedCol[types.Date](v, tlen)[start:end]
+		case types.T_time:
+			v.Col = DecodeFixedCol[types.Time](v, tlen)[start:end]
+		case types.T_enum:
+			v.Col = DecodeFixedCol[types.Enum](v, tlen)[start:end]
+		case types.T_set:
+			v.Col = DecodeFixedCol[types.Set](v, tlen)[start:end]
 		case types.T_datetime:
 			v.Col = DecodeFixedCol[types.Datetime](v, tlen)[start:end]
 		case types.T_timestamp:
@@ -250,6 +262,12 @@ func (v *Vector) encodeColToByteSlice() []byte {
 		return types.EncodeUuidSlice(v.Col.([]types.Uuid))
 	case types.T_date:
 		return types.EncodeDateSlice(v.Col.([]types.Date))
+	case types.T_time:
+		return types.EncodeTimeSlice(v.Col.([]types.Time))
+	case types.T_enum:
+		return types.EncodeEnumSlice(v.Col.([]types.Enum))
+	case types.T_set:
+		return types.EncodeSetSlice(v.Col.([]types.Set))
 	case types.T_datetime:
 		return types.EncodeDatetimeSlice(v.Col.([]types.Datetime))
 	case types.T_timestamp:
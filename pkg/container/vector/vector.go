@@ -227,6 +227,14 @@ func (v *Vector) GetBytes(i int64) []byte {
 	return bs[i].GetByteSlice(v.area)
 }
 
+// GetRawBytesAt returns the element at i encoded as types.EncodeValue does.
+func (v *Vector) GetRawBytesAt(i int64) []byte {
+	if v.Typ.IsVarlen() {
+		return v.GetBytes(i)
+	}
+	return v.getRawValueAt(i)
+}
+
 func (v *Vector) GetString(i int64) string {
 
 	bs := MustTCols[types.Varlena](v)
@@ -262,6 +270,12 @@ func (v *Vector) FillDefaultValue() {
 		fillDefaultValue[float64](v)
 	case types.T_date:
 		fillDefaultValue[types.Date](v)
+	case types.T_time:
+		fillDefaultValue[types.Time](v)
+	case types.T_enum:
+		fillDefaultValue[types.Enum](v)
+	case types.T_set:
+		fillDefaultValue[types.Set](v)
 	case types.T_datetime:
 		fillDefaultValue[types.Datetime](v)
 	case types.T_timestamp:
@@ -312,6 +326,12 @@ func (v *Vector) ToConst(row int) *Vector {
 		return toConstVector[float64](v, row)
 	case types.T_date:
 		return toConstVector[types.Date](v, row)
+	case types.T_time:
+		return toConstVector[types.Time](v, row)
+	case types.T_enum:
+		return toConstVector[types.Enum](v, row)
+	case types.T_set:
+		return toConstVector[types.Set](v, row)
 	case types.T_datetime:
 		return toConstVector[types.Datetime](v, row)
 	case types.T_timestamp:
@@ -371,6 +391,12 @@ func (v *Vector) ConstExpand(m *mheap.Mheap) *Vector {
 		expandVector[float64](v, 8, m)
 	case types.T_date:
 		expandVector[types.Date](v, 4, m)
+	case types.T_time:
+		expandVector[types.Time](v, 8, m)
+	case types.T_enum:
+		expandVector[types.Enum](v, 2, m)
+	case types.T_set:
+		expandVector[types.Set](v, 8, m)
 	case types.T_datetime:
 		expandVector[types.Datetime](v, 8, m)
 	case types.T_timestamp:
@@ -549,6 +575,12 @@ func (v *Vector) initConst(typ types.Type) {
 		v.Col = []float64{0}
 	case types.T_date:
 		v.Col = make([]types.Date, 1)
+	case types.T_time:
+		v.Col = make([]types.Time, 1)
+	case types.T_enum:
+		v.Col = make([]types.Enum, 1)
+	case types.T_set:
+		v.Col = make([]types.Set, 1)
 	case types.T_datetime:
 		v.Col = make([]types.Datetime, 1)
 	case types.T_timestamp:
@@ -683,6 +715,12 @@ func (v *Vector) Append(w any, isNull bool, m *mheap.Mheap) error {
 		return appendOne(v, w.(float64), isNull, m)
 	case types.T_date:
 		return appendOne(v, w.(types.Date), isNull, m)
+	case types.T_time:
+		return appendOne(v, w.(types.Time), isNull, m)
+	case types.T_enum:
+		return appendOne(v, w.(types.Enum), isNull, m)
+	case types.T_set:
+		return appendOne(v, w.(types.Set), isNull, m)
 	case types.T_datetime:
 		return appendOne(v, w.(types.Datetime), isNull, m)
 	case types.T_timestamp:
@@ -939,6 +977,12 @@ func Shrink(v *Vector, sels []int64) {
 		ShrinkFixed[types.Varlena](v, sels)
 	case types.T_date:
 		ShrinkFixed[types.Date](v, sels)
+	case types.T_time:
+		ShrinkFixed[types.Time](v, sels)
+	case types.T_enum:
+		ShrinkFixed[types.Enum](v, sels)
+	case types.T_set:
+		ShrinkFixed[types.Set](v, sels)
 	case types.T_datetime:
 		ShrinkFixed[types.Datetime](v, sels)
 	case types.T_timestamp:
@@ -1015,6 +1059,12 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		ShuffleFixed[types.Varlena](v, sels, m)
 	case types.T_date:
 		ShuffleFixed[types.Date](v, sels, m)
+	case types.T_time:
+		ShuffleFixed[types.Time](v, sels, m)
+	case types.T_enum:
+		ShuffleFixed[types.Enum](v, sels, m)
+	case types.T_set:
+		ShuffleFixed[types.Set](v, sels, m)
 	case types.T_datetime:
 		ShuffleFixed[types.Datetime](v, sels, m)
 	case types.T_timestamp:
@@ -1377,6 +1427,12 @@ func (v *Vector) String() string {
 		return VecToString[float64](v)
 	case types.T_date:
 		return VecToString[types.Date](v)
+	case types.T_time:
+		return VecToString[types.Time](v)
+	case types.T_enum:
+		return VecToString[types.Enum](v)
+	case types.T_set:
+		return VecToString[types.Set](v)
 	case types.T_datetime:
 		return VecToString[types.Datetime](v)
 	case types.T_timestamp:
@@ -33,6 +33,7 @@ var (
 	defaultHeatbeatDuration = time.Second
 	defaultConnectTimeout   = time.Second * 30
 	defaultHeatbeatTimeout  = time.Millisecond * 500
+	defaultTAEDataDir       = "mo-data/tae"
 )
 
 // Config dn store configuration
@@ -80,6 +81,9 @@ type Config struct {
 
 			// TAE tae storage configuration
 			TAE struct {
+				// DataDir the directory of the TAE data, every DNShard keeps its data in
+				// a sub directory named by the shard id. Default is mo-data/tae.
+				DataDir string `toml:"data-dir"`
 			}
 
 			// Mem mem storage configuration
@@ -123,6 +127,9 @@ func (c *Config) Validate() error {
 	if _, ok := supportTxnStorageBackends[strings.ToUpper(c.Txn.Storage.Backend)]; !ok {
 		return fmt.Errorf("%s txn storage backend not support", c.Txn.Storage)
 	}
+	if c.Txn.Storage.TAE.DataDir == "" {
+		c.Txn.Storage.TAE.DataDir = defaultTAEDataDir
+	}
 	if c.Txn.ZombieTimeout.Duration == 0 {
 		c.Txn.ZombieTimeout.Duration = defaultZombieTimeout
 	}
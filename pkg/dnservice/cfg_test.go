@@ -31,6 +31,7 @@ func TestValidate(t *testing.T) {
 	assert.Equal(t, defaultMaxClockOffset, c.Txn.Clock.MaxClockOffset.Duration)
 	assert.Equal(t, localClockBackend, c.Txn.Clock.Backend)
 	assert.Equal(t, taeStorageBackend, c.Txn.Storage.Backend)
+	assert.Equal(t, defaultTAEDataDir, c.Txn.Storage.TAE.DataDir)
 	assert.Equal(t, defaultZombieTimeout, c.Txn.ZombieTimeout.Duration)
 	assert.Equal(t, defaultDiscoveryTimeout, c.HAKeeper.DiscoveryTimeout.Duration)
 	assert.Equal(t, defaultHeatbeatDuration, c.HAKeeper.HeatbeatDuration.Duration)
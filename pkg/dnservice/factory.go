@@ -17,6 +17,7 @@ package dnservice
 import (
 	"context"
 	"fmt"
+	"path"
 
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
@@ -134,5 +135,6 @@ func (s *store) newMemKVStorage(shard metadata.DNShard, logClient logservice.Cli
 }
 
 func (s *store) newTAEStorage(shard metadata.DNShard, logClient logservice.Client) (storage.TxnStorage, error) {
-	return taestorage.New(shard, logClient, s.fileService, s.clock)
+	dir := path.Join(s.cfg.Txn.Storage.TAE.DataDir, fmt.Sprintf("%d", shard.ShardID))
+	return taestorage.New(shard, logClient, s.fileService, s.clock, dir)
 }
@@ -0,0 +1,572 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	hll "github.com/axiomhq/hyperloglog"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+const (
+	// analyzeHistogramBuckets is the number of buckets of a histogram built by ANALYZE TABLE
+	analyzeHistogramBuckets = 100
+	// analyzeSampleSize is the max number of values kept for building a histogram
+	analyzeSampleSize = 1 << 17
+)
+
+const (
+	// tableStatsCacheTTL is how long the statistics read from mo_catalog.mo_table_stats are
+	// cached, the statistics saved by ANALYZE TABLE on the other CNs are seen after it.
+	tableStatsCacheTTL = time.Minute
+)
+
+var (
+	deleteTableStatsFormat = `delete from mo_catalog.mo_table_stats where database_name = '%s' and table_name = '%s';`
+	insertTableStatsFormat = `insert into mo_catalog.mo_table_stats(
+				database_name,
+				table_name,
+				table_rows,
+				stats,
+				analyzed_time) values ('%s','%s',%d,'%s','%s');`
+	getTableStatsFormat = `select stats from mo_catalog.mo_table_stats where database_name = '%s' and table_name = '%s';`
+)
+
+func getSqlForDeleteTableStats(dbName, tableName string) string {
+	return fmt.Sprintf(deleteTableStatsFormat, escapeSingleQuotedString(dbName), escapeSingleQuotedString(tableName))
+}
+
+func getSqlForTableStats(dbName, tableName string) string {
+	return fmt.Sprintf(getTableStatsFormat, escapeSingleQuotedString(dbName), escapeSingleQuotedString(tableName))
+}
+
+// savedTableStats is the json saved in mo_catalog.mo_table_stats. The id of the table
+// tells the statistics of a dropped or renamed table from those of the table created
+// with its name later, the statistics saved without it are taken as the table's.
+type savedTableStats struct {
+	*plan2.TableStats
+	TableID string `json:"table_id,omitempty"`
+}
+
+// tableStatsEntry is the statistics of a table in tableStatsCache, stats is nil
+// if the table has not been analyzed.
+type tableStatsEntry struct {
+	tableID string
+	stats   *plan2.TableStats
+	loaded  time.Time
+}
+
+// tableStatsCache keeps the statistics read from mo_catalog.mo_table_stats. An entry is
+// used only for the table it was read for and only for tableStatsCacheTTL, so neither
+// the statistics of a dropped table nor the missing statistics of a table analyzed later
+// are used for long.
+var tableStatsCache = struct {
+	sync.Mutex
+	stats map[string]tableStatsEntry
+}{stats: make(map[string]tableStatsEntry)}
+
+func getTableStatsKey(ses *Session, dbName, tableName string) string {
+	var tenantID uint32
+	if tenant := ses.GetTenantInfo(); tenant != nil {
+		tenantID = tenant.GetTenantID()
+	}
+	return fmt.Sprintf("%d-%s-%s", tenantID, dbName, tableName)
+}
+
+// invalidateTableStats removes the cached statistics of the table, they are read again
+// the next time the table is planned.
+func invalidateTableStats(ses *Session, dbName, tableName string) {
+	tableStatsCache.Lock()
+	delete(tableStatsCache.stats, getTableStatsKey(ses, dbName, tableName))
+	tableStatsCache.Unlock()
+}
+
+// getTableStats returns the statistics of the table saved by ANALYZE TABLE, tableID is
+// the id of the table planned.
+func getTableStats(ses *Session, dbName, tableName, tableID string) *plan2.TableStats {
+	// the statistics are kept in mo_catalog, whose tables are not analyzed
+	if dbName == catalog.MO_CATALOG || ses.Pu == nil {
+		return nil
+	}
+	key := getTableStatsKey(ses, dbName, tableName)
+	tableStatsCache.Lock()
+	entry, ok := tableStatsCache.stats[key]
+	tableStatsCache.Unlock()
+	if ok && entry.tableID == tableID && time.Since(entry.loaded) < tableStatsCacheTTL {
+		return entry.stats
+	}
+
+	stats, err := loadTableStats(ses, dbName, tableName, tableID)
+	if err != nil {
+		// the miss is cached too, the statistics are not read again until the entry expires
+		logutil.Errorf("load the statistics of %s.%s failed. error %v", dbName, tableName, err)
+		stats = nil
+	}
+	tableStatsCache.Lock()
+	tableStatsCache.stats[key] = tableStatsEntry{
+		tableID: tableID,
+		stats:   stats,
+		loaded:  time.Now(),
+	}
+	tableStatsCache.Unlock()
+	return stats
+}
+
+func loadTableStats(ses *Session, dbName, tableName, tableID string) (*plan2.TableStats, error) {
+	rsset, err := executeSQLInBackgroundSession(ses.GetRequestContext(), ses.GuestMmu, ses.Mempool, ses.Pu, getSqlForTableStats(dbName, tableName))
+	if err != nil {
+		return nil, err
+	}
+	if len(rsset) < 1 || rsset[0].GetRowCount() < 1 {
+		return nil, nil
+	}
+	data, err := rsset[0].GetString(0, 0)
+	if err != nil {
+		return nil, err
+	}
+	saved := &savedTableStats{TableStats: &plan2.TableStats{}}
+	if err = json.Unmarshal([]byte(data), saved); err != nil {
+		return nil, err
+	}
+	if saved.TableID != "" && saved.TableID != tableID {
+		return nil, nil
+	}
+	return saved.TableStats, nil
+}
+
+// saveTableStats replaces the statistics of the table in mo_catalog.mo_table_stats.
+func saveTableStats(ctx context.Context, ses *Session, dbName, tableName, tableID string, stats *plan2.TableStats) error {
+	data, err := json.Marshal(savedTableStats{TableStats: stats, TableID: tableID})
+	if err != nil {
+		return err
+	}
+	sqls := []string{
+		"begin;",
+		getSqlForDeleteTableStats(dbName, tableName),
+		fmt.Sprintf(insertTableStatsFormat, escapeSingleQuotedString(dbName), escapeSingleQuotedString(tableName),
+			int64(stats.RowCount), escapeSingleQuotedString(string(data)), types.CurrentTimestamp().String2(time.UTC, 0)),
+		"commit;",
+	}
+
+	bh := NewBackgroundHandler(ctx, ses.GuestMmu, ses.Mempool, ses.Pu)
+	defer bh.Close()
+	for _, sql := range sqls {
+		if err = bh.Exec(ctx, sql); err != nil {
+			goto handleFailed
+		}
+	}
+
+	tableStatsCache.Lock()
+	tableStatsCache.stats[getTableStatsKey(ses, dbName, tableName)] = tableStatsEntry{
+		tableID: tableID,
+		stats:   stats,
+		loaded:  time.Now(),
+	}
+	tableStatsCache.Unlock()
+	return nil
+
+handleFailed:
+	//ROLLBACK the transaction
+	if rbErr := bh.Exec(ctx, "rollback;"); rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// removeTableStatsOfStatement removes the statistics of the tables dropped or altered by the
+// statement, the statistics of the columns changed do not describe them any more.
+func removeTableStatsOfStatement(ctx context.Context, ses *Session, stmt tree.Statement) {
+	var names tree.TableNames
+	switch st := stmt.(type) {
+	case *tree.DropTable:
+		names = st.Names
+	case *tree.AlterTable:
+		names = tree.TableNames{st.Table}
+	default:
+		return
+	}
+	if ses.Pu == nil {
+		return
+	}
+	for _, name := range names {
+		dbName := string(name.SchemaName)
+		if len(dbName) == 0 {
+			dbName = ses.GetDatabaseName()
+		}
+		tableName := string(name.ObjectName)
+		if dbName == catalog.MO_CATALOG {
+			continue
+		}
+		invalidateTableStats(ses, dbName, tableName)
+		bh := NewBackgroundHandler(ctx, ses.GuestMmu, ses.Mempool, ses.Pu)
+		if err := bh.Exec(ctx, getSqlForDeleteTableStats(dbName, tableName)); err != nil {
+			logutil.Errorf("remove the statistics of %s.%s failed. error %v", dbName, tableName, err)
+		}
+		bh.Close()
+	}
+}
+
+func escapeSingleQuotedString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, `'`, `\'`)
+}
+
+// collectTableStats scans the table and builds the statistics of the columns,
+// all visible columns are analyzed if cols is empty.
+func collectTableStats(ctx context.Context, ses *Session, rel engine.Relation, tableName string, cols, histogramCols []string) (*plan2.TableStats, error) {
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attrs := make(map[string]engine.Attribute)
+	var visible []string
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok && !attr.Attr.IsHidden && !attr.Attr.IsRowId {
+			attrs[attr.Attr.Name] = attr.Attr
+			visible = append(visible, attr.Attr.Name)
+		}
+	}
+
+	if len(cols) == 0 {
+		cols = visible
+	}
+	collectors := make([]*columnStatsCollector, 0, len(cols))
+	colIdx := make(map[string]int)
+	addColumn := func(name string) error {
+		if _, ok := colIdx[name]; ok {
+			return nil
+		}
+		attr, ok := attrs[name]
+		if !ok {
+			return moerr.NewInternalError("Unknown column '%s' in '%s'", name, tableName)
+		}
+		colIdx[name] = len(collectors)
+		collectors = append(collectors, newColumnStatsCollector(attr.Type))
+		return nil
+	}
+	for _, name := range cols {
+		if err = addColumn(name); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range histogramCols {
+		if err = addColumn(name); err != nil {
+			return nil, err
+		}
+		collectors[colIdx[name]].histogram = true
+	}
+
+	names := make([]string, len(collectors))
+	for name, i := range colIdx {
+		names[i] = name
+	}
+	// the range of a column is read from the zone maps if the engine keeps them,
+	// the scan only counts the values of the column then
+	if zm, ok := rel.(engine.ZoneMapReader); ok {
+		for i, c := range collectors {
+			min, max, ok, err := zm.ColumnRange(ctx, names[i])
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				c.setRange(min, max)
+			}
+		}
+	}
+	ranges, err := rel.Ranges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rds, err := rel.NewReader(ctx, 1, nil, ranges)
+	if err != nil {
+		return nil, err
+	}
+	defer rds[0].Close()
+
+	m := mheap.New(ses.GuestMmu)
+	var rows int64
+	for {
+		bat, err := rds[0].Read(names, nil, m)
+		if err != nil {
+			return nil, err
+		}
+		if bat == nil {
+			break
+		}
+		rows += int64(vector.Length(bat.Vecs[0]))
+		for i, vec := range bat.Vecs {
+			collectors[i].add(vec)
+		}
+		bat.Clean(m)
+	}
+
+	stats := &plan2.TableStats{
+		RowCount: float64(rows),
+		Cols:     make(map[string]*plan2.ColumnStats),
+	}
+	for i, c := range collectors {
+		stats.Cols[names[i]] = c.stats()
+	}
+	return stats, nil
+}
+
+// columnStatsCollector accumulates the statistics of a column while the blocks are scanned.
+// Values are mapped to float64 for min, max and histogram, as the cost model does with constants.
+type columnStatsCollector struct {
+	typ       types.Type
+	sketch    *hll.Sketch
+	nullCount int64
+	nonNull   int64
+
+	hasRange bool
+	min      float64
+	max      float64
+	// zoneMapRange is true if min and max are read from the zone maps instead of the values
+	zoneMapRange bool
+
+	// histogram is true if a histogram is wanted, samples is a reservoir of the mapped values
+	histogram bool
+	samples   []float64
+	mapped    int64
+	rand      *rand.Rand
+}
+
+func newColumnStatsCollector(typ types.Type) *columnStatsCollector {
+	return &columnStatsCollector{
+		typ:    typ,
+		sketch: hll.New(),
+		rand:   rand.New(rand.NewSource(int64(typ.Oid))),
+	}
+}
+
+// supportRange reports whether the values of the type can be mapped to float64.
+func (c *columnStatsCollector) supportRange() bool {
+	switch c.typ.Oid {
+	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp:
+		return true
+	}
+	return false
+}
+
+func (c *columnStatsCollector) add(vec *vector.Vector) {
+	switch c.typ.Oid {
+	case types.T_bool:
+		addFixedValues(c, vec, func(v bool) float64 {
+			if v {
+				return 1
+			}
+			return 0
+		})
+	case types.T_int8:
+		addFixedValues(c, vec, numberToFloat64[int8])
+	case types.T_int16:
+		addFixedValues(c, vec, numberToFloat64[int16])
+	case types.T_int32:
+		addFixedValues(c, vec, numberToFloat64[int32])
+	case types.T_int64:
+		addFixedValues(c, vec, numberToFloat64[int64])
+	case types.T_uint8:
+		addFixedValues(c, vec, numberToFloat64[uint8])
+	case types.T_uint16:
+		addFixedValues(c, vec, numberToFloat64[uint16])
+	case types.T_uint32:
+		addFixedValues(c, vec, numberToFloat64[uint32])
+	case types.T_uint64:
+		addFixedValues(c, vec, numberToFloat64[uint64])
+	case types.T_float32:
+		addFixedValues(c, vec, numberToFloat64[float32])
+	case types.T_float64:
+		addFixedValues(c, vec, numberToFloat64[float64])
+	case types.T_decimal64:
+		addFixedValues(c, vec, func(v types.Decimal64) float64 { return v.ToFloat64() })
+	case types.T_decimal128:
+		addFixedValues(c, vec, func(v types.Decimal128) float64 { return v.ToFloat64() })
+	case types.T_date:
+		addFixedValues(c, vec, func(v types.Date) float64 { return float64(v) })
+	case types.T_time:
+		addFixedValues(c, vec, func(v types.Time) float64 { return float64(v) })
+	case types.T_datetime:
+		addFixedValues(c, vec, func(v types.Datetime) float64 { return float64(v) })
+	case types.T_timestamp:
+		addFixedValues(c, vec, func(v types.Timestamp) float64 { return float64(v) })
+	case types.T_uuid:
+		addFixedValues[types.Uuid](c, vec, nil)
+	default:
+		if !c.typ.IsVarlen() {
+			return
+		}
+		n := vector.Length(vec)
+		for i := 0; i < n; i++ {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				c.nullCount++
+				continue
+			}
+			c.nonNull++
+			c.sketch.Insert(vec.GetBytes(int64(i)))
+		}
+	}
+}
+
+func addFixedValues[T types.FixedSizeT](c *columnStatsCollector, vec *vector.Vector, toFloat64 func(T) float64) {
+	vs := vector.MustTCols[T](vec)
+	for i, v := range vs {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			c.nullCount++
+			continue
+		}
+		c.nonNull++
+		c.sketch.Insert(types.EncodeFixed(v))
+		if toFloat64 != nil {
+			c.addMappedValue(toFloat64(v))
+		}
+	}
+}
+
+func numberToFloat64[T types.BuiltinNumber](v T) float64 {
+	return float64(v)
+}
+
+func (c *columnStatsCollector) addMappedValue(v float64) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return
+	}
+	switch {
+	case c.zoneMapRange:
+		// the range is read from the zone maps
+	case !c.hasRange:
+		c.hasRange = true
+		c.min, c.max = v, v
+	case v < c.min:
+		c.min = v
+	case v > c.max:
+		c.max = v
+	}
+	if !c.histogram {
+		return
+	}
+	// reservoir sampling
+	c.mapped++
+	if len(c.samples) < analyzeSampleSize {
+		c.samples = append(c.samples, v)
+	} else if i := c.rand.Int63n(c.mapped); i < analyzeSampleSize {
+		c.samples[i] = v
+	}
+}
+
+// setRange sets the range of the column read from the zone maps, the values are mapped as add does.
+func (c *columnStatsCollector) setRange(min, max any) {
+	if !c.supportRange() {
+		return
+	}
+	minValue, ok := zoneMapValueToFloat64(min)
+	if !ok {
+		return
+	}
+	maxValue, ok := zoneMapValueToFloat64(max)
+	if !ok {
+		return
+	}
+	c.hasRange, c.zoneMapRange = true, true
+	c.min, c.max = minValue, maxValue
+}
+
+func zoneMapValueToFloat64(v any) (float64, bool) {
+	var f float64
+	switch v := v.(type) {
+	case bool:
+		if v {
+			f = 1
+		}
+	case int8:
+		f = float64(v)
+	case int16:
+		f = float64(v)
+	case int32:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case uint8:
+		f = float64(v)
+	case uint16:
+		f = float64(v)
+	case uint32:
+		f = float64(v)
+	case uint64:
+		f = float64(v)
+	case float32:
+		f = float64(v)
+	case float64:
+		f = v
+	case types.Decimal64:
+		f = v.ToFloat64()
+	case types.Decimal128:
+		f = v.ToFloat64()
+	case types.Date:
+		f = float64(v)
+	case types.Time:
+		f = float64(v)
+	case types.Datetime:
+		f = float64(v)
+	case types.Timestamp:
+		f = float64(v)
+	default:
+		return 0, false
+	}
+	return f, !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+func (c *columnStatsCollector) stats() *plan2.ColumnStats {
+	ndv := math.Min(float64(c.sketch.Estimate()), float64(c.nonNull))
+	stats := &plan2.ColumnStats{
+		Ndv:       ndv,
+		NullCount: float64(c.nullCount),
+		HasRange:  c.hasRange,
+		MinValue:  c.min,
+		MaxValue:  c.max,
+	}
+	if !c.histogram || !c.supportRange() {
+		return stats
+	}
+	stats.Histogram = &plan2.Histogram{}
+	if len(c.samples) > 0 {
+		stats.Histogram = plan2.BuildHistogram(c.samples, analyzeHistogramBuckets)
+		if scale := float64(c.mapped) / float64(len(c.samples)); scale > 1 {
+			for _, b := range stats.Histogram.Buckets {
+				b.Count *= scale
+			}
+		}
+	}
+	return stats
+}
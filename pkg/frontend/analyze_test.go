@@ -0,0 +1,160 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/prashantv/gostub"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_columnStatsCollector(t *testing.T) {
+	convey.Convey("collect the statistics of an int column", t, func() {
+		m := testutil.NewMheap()
+		nsp := nulls.NewWithSize(8)
+		nulls.Add(nsp, 7)
+		vec := vector.NewWithFixed(types.T_int64.ToType(), []int64{3, 1, 2, 2, 5, 4, 4, 0}, nsp, m)
+		defer vec.Free(m)
+
+		c := newColumnStatsCollector(types.T_int64.ToType())
+		c.histogram = true
+		c.add(vec)
+		c.add(vec)
+		stats := c.stats()
+		convey.So(stats.Ndv, convey.ShouldEqual, 5)
+		convey.So(stats.NullCount, convey.ShouldEqual, 2)
+		convey.So(stats.HasRange, convey.ShouldBeTrue)
+		convey.So(stats.MinValue, convey.ShouldEqual, 1)
+		convey.So(stats.MaxValue, convey.ShouldEqual, 5)
+		convey.So(stats.Histogram.Lower, convey.ShouldEqual, 1)
+		var count float64
+		for _, b := range stats.Histogram.Buckets {
+			count += b.Count
+		}
+		convey.So(count, convey.ShouldEqual, 14)
+	})
+
+	convey.Convey("the range is read from the zone maps", t, func() {
+		m := testutil.NewMheap()
+		vec := vector.NewWithFixed(types.T_int32.ToType(), []int32{3, 1, 2}, nil, m)
+		defer vec.Free(m)
+
+		c := newColumnStatsCollector(types.T_int32.ToType())
+		// the zone maps may cover deleted rows
+		c.setRange(int32(0), int32(9))
+		c.add(vec)
+		stats := c.stats()
+		convey.So(stats.Ndv, convey.ShouldEqual, 3)
+		convey.So(stats.HasRange, convey.ShouldBeTrue)
+		convey.So(stats.MinValue, convey.ShouldEqual, 0)
+		convey.So(stats.MaxValue, convey.ShouldEqual, 9)
+
+		// a string zone map is not a range
+		c = newColumnStatsCollector(types.T_varchar.ToType())
+		c.setRange([]byte("a"), []byte("b"))
+		convey.So(c.stats().HasRange, convey.ShouldBeFalse)
+	})
+
+	convey.Convey("collect the statistics of a varchar column", t, func() {
+		m := testutil.NewMheap()
+		vec := vector.NewWithStrings(types.T_varchar.ToType(), []string{"a", "b", "a", "c"}, nil, m)
+		defer vec.Free(m)
+
+		c := newColumnStatsCollector(types.T_varchar.ToType())
+		c.histogram = true
+		c.add(vec)
+		stats := c.stats()
+		convey.So(stats.Ndv, convey.ShouldEqual, 3)
+		convey.So(stats.NullCount, convey.ShouldEqual, 0)
+		convey.So(stats.HasRange, convey.ShouldBeFalse)
+		// strings can't be mapped to float64
+		convey.So(stats.Histogram, convey.ShouldBeNil)
+	})
+
+	convey.Convey("the statistics are saved as json", t, func() {
+		stats := &plan2.TableStats{
+			RowCount: 10,
+			Cols: map[string]*plan2.ColumnStats{
+				"it's": {Ndv: 2, Histogram: &plan2.Histogram{Lower: 1}},
+			},
+		}
+		data, err := json.Marshal(stats)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(escapeSingleQuotedString(`{"it's":"\"}`), convey.ShouldEqual, `{"it\'s":"\\"}`)
+
+		res := &plan2.TableStats{}
+		convey.So(json.Unmarshal(data, res), convey.ShouldBeNil)
+		convey.So(res.Cols["it's"].Ndv, convey.ShouldEqual, 2)
+		convey.So(res.Cols["it's"].Histogram.Lower, convey.ShouldEqual, 1)
+	})
+
+	convey.Convey("the statistics are saved with the table id", t, func() {
+		stats := &plan2.TableStats{RowCount: 10}
+		data, err := json.Marshal(savedTableStats{TableStats: stats, TableID: "272510"})
+		convey.So(err, convey.ShouldBeNil)
+
+		saved := &savedTableStats{TableStats: &plan2.TableStats{}}
+		convey.So(json.Unmarshal(data, saved), convey.ShouldBeNil)
+		convey.So(saved.TableID, convey.ShouldEqual, "272510")
+		convey.So(saved.RowCount, convey.ShouldEqual, 10)
+
+		// the statistics saved before have no table id
+		data, err = json.Marshal(stats)
+		convey.So(err, convey.ShouldBeNil)
+		saved = &savedTableStats{TableStats: &plan2.TableStats{}}
+		convey.So(json.Unmarshal(data, saved), convey.ShouldBeNil)
+		convey.So(saved.TableID, convey.ShouldBeEmpty)
+		convey.So(saved.RowCount, convey.ShouldEqual, 10)
+	})
+
+	convey.Convey("the names in the sql are escaped", t, func() {
+		convey.So(getSqlForTableStats("d", `t'; drop table x; --`), convey.ShouldEqual,
+			`select stats from mo_catalog.mo_table_stats where database_name = 'd' and table_name = 't\'; drop table x; --';`)
+	})
+}
+
+func Test_getTableStats(t *testing.T) {
+	convey.Convey("the failed lookup is cached", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var execs int
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, sql string) error {
+			execs++
+			return moerr.NewInternalError("no such table mo_table_stats")
+		}).AnyTimes()
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		ses := newSes(nil)
+		defer invalidateTableStats(ses, "db", "t_miss")
+		convey.So(getTableStats(ses, "db", "t_miss", "1"), convey.ShouldBeNil)
+		convey.So(getTableStats(ses, "db", "t_miss", "1"), convey.ShouldBeNil)
+		convey.So(execs, convey.ShouldEqual, 1)
+	})
+}
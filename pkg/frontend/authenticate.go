@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
@@ -525,13 +526,24 @@ var (
 		"system_metrics":     0,
 	}
 	sysWantedTables = map[string]int8{
-		"mo_user":       0,
-		"mo_account":    0,
-		"mo_role":       0,
-		"mo_user_grant": 0,
-		"mo_role_grant": 0,
-		"mo_role_privs": 0,
-	}
+		"mo_user":              0,
+		"mo_account":           0,
+		"mo_role":              0,
+		"mo_user_grant":        0,
+		"mo_role_grant":        0,
+		"mo_role_privs":        0,
+		"mo_role_column_privs": 0,
+		"mo_table_stats":       0,
+		"mo_policies":          0,
+	}
+	//the statistics saved by ANALYZE TABLE, the accounts created before it get it at the upgrade
+	createMoTableStatsSql = `create table mo_catalog.mo_table_stats(
+				database_name varchar(100),
+				table_name varchar(100),
+				table_rows bigint,
+				stats text,
+				analyzed_time timestamp
+			);`
 	//the sqls creating many tables for the tenant.
 	//Wrap them in a transaction
 	createSqls = []string{
@@ -546,7 +558,12 @@ var (
 				login_type  varchar(16),
 				creator int,
 				owner int,
-				default_role int
+				default_role int,
+				auth_plugin varchar(64),
+				ssl_type varchar(16),
+				ssl_cipher varchar(256),
+				x509_issuer varchar(256),
+				x509_subject varchar(256)
     		);`,
 		`create table mo_account(
 				account_id int,
@@ -589,6 +606,28 @@ var (
 				granted_time timestamp,
 				with_grant_option bool
 			);`,
+		`create table mo_role_column_privs(
+				role_id int,
+				role_name  varchar(100),
+				obj_id int,
+				column_name varchar(256),
+				privilege_id int,
+				privilege_name varchar(100),
+				operation_user_id int,
+				granted_time timestamp,
+				with_grant_option bool
+			);`,
+		createMoTableStatsSql,
+		`create table mo_policies(
+				policy_name varchar(100),
+				database_name varchar(100),
+				table_name varchar(100),
+				command varchar(16),
+				role_id int,
+				owner int,
+				predicate text,
+				created_time timestamp
+			);`,
 	}
 
 	initMoAccountFormat = `insert into mo_catalog.mo_account(
@@ -616,8 +655,13 @@ var (
 				login_type,
 				creator,
 				owner,
-				default_role
-    		) values(%d,%s,"%s","%s","%s","%s",%s,"%s",%d,%d,%d);`
+				default_role,
+				auth_plugin,
+				ssl_type,
+				ssl_cipher,
+				x509_issuer,
+				x509_subject
+    		) values(%d,%s,"%s","%s","%s","%s",%s,"%s",%d,%d,%d,"%s","%s","%s","%s","%s");`
 	initMoRolePrivFormat = `insert into mo_catalog.mo_role_privs(
 				role_id,
 				role_name,
@@ -630,6 +674,17 @@ var (
 				granted_time,
 				with_grant_option
 			) values(%d,"%s","%s",%d,%d,"%s","%s",%d,"%s",%v);`
+	initMoRoleColumnPrivFormat = `insert into mo_catalog.mo_role_column_privs(
+				role_id,
+				role_name,
+				obj_id,
+				column_name,
+				privilege_id,
+				privilege_name,
+				operation_user_id,
+				granted_time,
+				with_grant_option
+			) values(%d,"%s",%d,"%s",%d,"%s",%d,"%s",%v);`
 	initMoUserGrantFormat = `insert into mo_catalog.mo_user_grant(
             	role_id,
 				user_id,
@@ -642,7 +697,16 @@ var (
 	//privilege verification
 	checkTenantFormat = `select account_id,account_name from mo_catalog.mo_account where account_name = "%s";`
 
-	getPasswordOfUserFormat = `select user_id,authentication_string,default_role from mo_catalog.mo_user where user_name = "%s";`
+	getPasswordOfUserFormat = `select user_id,authentication_string,default_role,
+				coalesce(auth_plugin,"mysql_native_password"),
+				coalesce(ssl_type,""),
+				coalesce(ssl_cipher,""),
+				coalesce(x509_issuer,""),
+				coalesce(x509_subject,"")
+				from mo_catalog.mo_user where user_name = "%s";`
+
+	//the mo_user created before the authentication plugins and the tls requirements
+	getPasswordOfLegacyUserFormat = `select user_id,authentication_string,default_role from mo_catalog.mo_user where user_name = "%s";`
 
 	checkRoleExistsFormat = `select role_id from mo_catalog.mo_role where role_id = %d and role_name = "%s";`
 
@@ -671,7 +735,7 @@ var (
 	checkWithGrantOptionForTableDatabaseStar = `select rp.privilege_id,rp.with_grant_option
 				from mo_catalog.mo_database d, mo_catalog.mo_tables t, mo_catalog.mo_role_privs rp
 				where d.dat_id = t.reldatabase_id
-					and rp.obj_id = 0
+					and rp.obj_id = d.dat_id
 					and rp.obj_type = "table"
 					and rp.role_id = %d
 					and rp.privilege_id = %d
@@ -737,17 +801,66 @@ var (
 					and rp.privilege_level = "**"
 					and rp.with_grant_option = true;`
 
-	//check the role has the table level privilege
-	checkRoleHasTableLevelPrivilegeFormat = `select rp.privilege_id,rp.with_grant_option
+	//get the table level and the column level privileges of the role set on the tables.
+	//the privilege on the table is granted at the level *.*, db.* or db.table,
+	//it has the empty column name.
+	getTablePrivilegesOfRoleSetFormat = `select rp.privilege_id, d.datname, t.relname, "" as column_name
 				from mo_catalog.mo_database d, mo_catalog.mo_tables t, mo_catalog.mo_role_privs rp
 				where d.dat_id = t.reldatabase_id
-					and rp.obj_id = t.rel_id
 					and rp.obj_type = "table"
-					and rp.role_id = %d
-					and rp.privilege_id = %d
-					and rp.privilege_level in ("d.t","t")
+					and rp.role_id in (%s)
+					and d.datname in (%s)
+					and t.relname in (%s)
+					and ((rp.privilege_level in ("d.t","t") and rp.obj_id = t.rel_id)
+						or (rp.privilege_level = "d.*" and rp.obj_id = d.dat_id)
+						or rp.privilege_level = "*.*")
+				union all
+				select cp.privilege_id, d.datname, t.relname, cp.column_name
+				from mo_catalog.mo_database d, mo_catalog.mo_tables t, mo_catalog.mo_role_column_privs cp
+				where d.dat_id = t.reldatabase_id
+					and cp.obj_id = t.rel_id
+					and cp.role_id in (%s)
+					and d.datname in (%s)
+					and t.relname in (%s);`
+)
+
+var (
+	//grant and revoke the privileges on the tables and the columns
+	getDatabaseIdFormat = `select dat_id from mo_catalog.mo_database where datname = "%s";`
+
+	getTableIdFormat = `select t.rel_id
+				from mo_catalog.mo_database d, mo_catalog.mo_tables t
+				where d.dat_id = t.reldatabase_id
 					and d.datname = "%s"
 					and t.relname = "%s";`
+
+	checkColumnExistsFormat = `select attname from mo_catalog.mo_columns where att_relname_id = %d and attname = "%s";`
+
+	//upgrade the mo_user created before the authentication plugins and the tls requirements
+	getAccountIdsSql = `select account_id from mo_catalog.mo_account;`
+
+	upgradeMoUserColumns = []struct{ name, def string }{
+		{"auth_plugin", `varchar(64) default "mysql_native_password"`},
+		{"ssl_type", `varchar(16) default ""`},
+		{"ssl_cipher", `varchar(256) default ""`},
+		{"x509_issuer", `varchar(256) default ""`},
+		{"x509_subject", `varchar(256) default ""`},
+	}
+
+	addColumnOfMoUserFormat = `alter table mo_catalog.mo_user add column %s %s;`
+
+	deleteRolePrivFormat = `delete from mo_catalog.mo_role_privs
+				where role_id = %d
+					and obj_type = "%s"
+					and obj_id = %d
+					and privilege_id = %d
+					and privilege_level = "%s";`
+
+	deleteRoleColumnPrivFormat = `delete from mo_catalog.mo_role_column_privs
+				where role_id = %d
+					and obj_id = %d
+					and column_name = "%s"
+					and privilege_id = %d;`
 )
 
 func getSqlForCheckTenant(tenant string) string {
@@ -758,6 +871,10 @@ func getSqlForPasswordOfUser(user string) string {
 	return fmt.Sprintf(getPasswordOfUserFormat, user)
 }
 
+func getSqlForPasswordOfLegacyUser(user string) string {
+	return fmt.Sprintf(getPasswordOfLegacyUserFormat, user)
+}
+
 func getSqlForCheckRoleExists(roleID int, roleName string) string {
 	return fmt.Sprintf(checkRoleExistsFormat, roleID, roleName)
 }
@@ -810,8 +927,45 @@ func getSqlForCheckWithGrantOptionForAccountStar(roleId int64, privId PrivilegeT
 	return fmt.Sprintf(checkWithGrantOptionForAccountStar, roleId, privId)
 }
 
-func getSqlForCheckRoleHasTableLevelPrivilegeFormat(roleId int64, privId PrivilegeType, dbName string, tableName string) string {
-	return fmt.Sprintf(checkRoleHasTableLevelPrivilegeFormat, roleId, privId, dbName, tableName)
+func getSqlForTablePrivilegesOfRoleSet(roleIds []int64, entries []privilegeEntry) string {
+	roles := make([]string, len(roleIds))
+	for i, roleId := range roleIds {
+		roles[i] = fmt.Sprintf("%d", roleId)
+	}
+	dbNames := &btree.Set[string]{}
+	tableNames := &btree.Set[string]{}
+	for _, entry := range entries {
+		dbNames.Insert(fmt.Sprintf(`"%s"`, entry.databaseName))
+		tableNames.Insert(fmt.Sprintf(`"%s"`, entry.tableName))
+	}
+	roleList := strings.Join(roles, ",")
+	dbList := strings.Join(dbNames.Keys(), ",")
+	tableList := strings.Join(tableNames.Keys(), ",")
+	return fmt.Sprintf(getTablePrivilegesOfRoleSetFormat, roleList, dbList, tableList, roleList, dbList, tableList)
+}
+
+func getSqlForDatabaseId(dbName string) string {
+	return fmt.Sprintf(getDatabaseIdFormat, dbName)
+}
+
+func getSqlForTableId(dbName, tableName string) string {
+	return fmt.Sprintf(getTableIdFormat, dbName, tableName)
+}
+
+func getSqlForCheckColumnExists(tableId int64, columnName string) string {
+	return fmt.Sprintf(checkColumnExistsFormat, tableId, columnName)
+}
+
+func getSqlForAddColumnOfMoUser(columnName, columnDef string) string {
+	return fmt.Sprintf(addColumnOfMoUserFormat, columnName, columnDef)
+}
+
+func getSqlForDeleteRolePriv(roleId int64, objType objectType, objId int64, privId PrivilegeType, level privilegeLevelType) string {
+	return fmt.Sprintf(deleteRolePrivFormat, roleId, objType, objId, privId, level)
+}
+
+func getSqlForDeleteRoleColumnPriv(roleId int64, tableId int64, columnName string, privId PrivilegeType) string {
+	return fmt.Sprintf(deleteRoleColumnPrivFormat, roleId, tableId, columnName, privId)
 }
 
 type specialTag int
@@ -861,45 +1015,48 @@ type privilegeEntry struct {
 	//for object type table
 	databaseName string
 	tableName    string
+	//the columns of the table that are accessed.
+	//when it is empty, the privilege on the table is needed.
+	columns []string
 }
 
 var (
 	//initial privilege entries
 	privilegeEntriesMap = map[PrivilegeType]privilegeEntry{
-		PrivilegeTypeCreateAccount:     {PrivilegeTypeCreateAccount, privilegeLevelStar, objectTypeAccount, objectIDAll, false, "", ""},
-		PrivilegeTypeDropAccount:       {PrivilegeTypeDropAccount, privilegeLevelStar, objectTypeAccount, objectIDAll, false, "", ""},
-		PrivilegeTypeAlterAccount:      {PrivilegeTypeAlterAccount, privilegeLevelStar, objectTypeAccount, objectIDAll, false, "", ""},
-		PrivilegeTypeCreateUser:        {PrivilegeTypeCreateUser, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeDropUser:          {PrivilegeTypeDropUser, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeAlterUser:         {PrivilegeTypeAlterUser, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeCreateRole:        {PrivilegeTypeCreateRole, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeDropRole:          {PrivilegeTypeDropRole, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeAlterRole:         {PrivilegeTypeAlterRole, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeCreateDatabase:    {PrivilegeTypeCreateDatabase, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeDropDatabase:      {PrivilegeTypeDropDatabase, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeShowDatabases:     {PrivilegeTypeShowDatabases, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeConnect:           {PrivilegeTypeConnect, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeManageGrants:      {PrivilegeTypeManageGrants, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeAccountAll:        {PrivilegeTypeAccountAll, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeAccountOwnership:  {PrivilegeTypeAccountOwnership, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeUserOwnership:     {PrivilegeTypeUserOwnership, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeRoleOwnership:     {PrivilegeTypeRoleOwnership, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeShowTables:        {PrivilegeTypeShowTables, privilegeLevelDatabaseStar, objectTypeDatabase, objectIDAll, true, "", ""},
-		PrivilegeTypeCreateObject:      {PrivilegeTypeCreateObject, privilegeLevelDatabaseStar, objectTypeDatabase, objectIDAll, true, "", ""},
-		PrivilegeTypeDropObject:        {PrivilegeTypeDropObject, privilegeLevelDatabaseStar, objectTypeDatabase, objectIDAll, true, "", ""},
-		PrivilegeTypeAlterObject:       {PrivilegeTypeAlterObject, privilegeLevelDatabaseStar, objectTypeDatabase, objectIDAll, true, "", ""},
-		PrivilegeTypeDatabaseAll:       {PrivilegeTypeDatabaseAll, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeDatabaseOwnership: {PrivilegeTypeDatabaseOwnership, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeSelect:            {PrivilegeTypeSelect, privilegeLevelTable, objectTypeTable, objectIDAll, true, "", ""},
-		PrivilegeTypeInsert:            {PrivilegeTypeInsert, privilegeLevelTable, objectTypeTable, objectIDAll, true, "", ""},
-		PrivilegeTypeUpdate:            {PrivilegeTypeUpdate, privilegeLevelTable, objectTypeTable, objectIDAll, true, "", ""},
-		PrivilegeTypeTruncate:          {PrivilegeTypeTruncate, privilegeLevelTable, objectTypeTable, objectIDAll, true, "", ""},
-		PrivilegeTypeDelete:            {PrivilegeTypeDelete, privilegeLevelTable, objectTypeTable, objectIDAll, true, "", ""},
-		PrivilegeTypeReference:         {PrivilegeTypeReference, privilegeLevelTable, objectTypeTable, objectIDAll, true, "", ""},
-		PrivilegeTypeIndex:             {PrivilegeTypeIndex, privilegeLevelTable, objectTypeTable, objectIDAll, true, "", ""},
-		PrivilegeTypeTableAll:          {PrivilegeTypeTableAll, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeTableOwnership:    {PrivilegeTypeTableOwnership, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", ""},
-		PrivilegeTypeExecute:           {PrivilegeTypeExecute, privilegeLevelRoutine, objectTypeFunction, objectIDAll, true, "", ""},
+		PrivilegeTypeCreateAccount:     {PrivilegeTypeCreateAccount, privilegeLevelStar, objectTypeAccount, objectIDAll, false, "", "", nil},
+		PrivilegeTypeDropAccount:       {PrivilegeTypeDropAccount, privilegeLevelStar, objectTypeAccount, objectIDAll, false, "", "", nil},
+		PrivilegeTypeAlterAccount:      {PrivilegeTypeAlterAccount, privilegeLevelStar, objectTypeAccount, objectIDAll, false, "", "", nil},
+		PrivilegeTypeCreateUser:        {PrivilegeTypeCreateUser, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeDropUser:          {PrivilegeTypeDropUser, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeAlterUser:         {PrivilegeTypeAlterUser, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeCreateRole:        {PrivilegeTypeCreateRole, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeDropRole:          {PrivilegeTypeDropRole, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeAlterRole:         {PrivilegeTypeAlterRole, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeCreateDatabase:    {PrivilegeTypeCreateDatabase, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeDropDatabase:      {PrivilegeTypeDropDatabase, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeShowDatabases:     {PrivilegeTypeShowDatabases, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeConnect:           {PrivilegeTypeConnect, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeManageGrants:      {PrivilegeTypeManageGrants, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeAccountAll:        {PrivilegeTypeAccountAll, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeAccountOwnership:  {PrivilegeTypeAccountOwnership, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeUserOwnership:     {PrivilegeTypeUserOwnership, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeRoleOwnership:     {PrivilegeTypeRoleOwnership, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeShowTables:        {PrivilegeTypeShowTables, privilegeLevelDatabaseStar, objectTypeDatabase, objectIDAll, true, "", "", nil},
+		PrivilegeTypeCreateObject:      {PrivilegeTypeCreateObject, privilegeLevelDatabaseStar, objectTypeDatabase, objectIDAll, true, "", "", nil},
+		PrivilegeTypeDropObject:        {PrivilegeTypeDropObject, privilegeLevelDatabaseStar, objectTypeDatabase, objectIDAll, true, "", "", nil},
+		PrivilegeTypeAlterObject:       {PrivilegeTypeAlterObject, privilegeLevelDatabaseStar, objectTypeDatabase, objectIDAll, true, "", "", nil},
+		PrivilegeTypeDatabaseAll:       {PrivilegeTypeDatabaseAll, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeDatabaseOwnership: {PrivilegeTypeDatabaseOwnership, privilegeLevelStar, objectTypeAccount, objectIDAll, true, "", "", nil},
+		PrivilegeTypeSelect:            {PrivilegeTypeSelect, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", nil},
+		PrivilegeTypeInsert:            {PrivilegeTypeInsert, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", nil},
+		PrivilegeTypeUpdate:            {PrivilegeTypeUpdate, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", nil},
+		PrivilegeTypeTruncate:          {PrivilegeTypeTruncate, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", nil},
+		PrivilegeTypeDelete:            {PrivilegeTypeDelete, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", nil},
+		PrivilegeTypeReference:         {PrivilegeTypeReference, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", nil},
+		PrivilegeTypeIndex:             {PrivilegeTypeIndex, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", nil},
+		PrivilegeTypeTableAll:          {PrivilegeTypeTableAll, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", nil},
+		PrivilegeTypeTableOwnership:    {PrivilegeTypeTableOwnership, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", nil},
+		PrivilegeTypeExecute:           {PrivilegeTypeExecute, privilegeLevelRoutine, objectTypeFunction, objectIDAll, true, "", "", nil},
 	}
 
 	//the initial entries of mo_role_privs for the role 'moadmin'
@@ -988,7 +1145,7 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	kind := privilegeKindGeneral
 	special := specialTagNone
 	objType := objectTypeAccount
-	switch st := stmt.(type) {
+	switch st := unwrapGrantOrRevoke(stmt).(type) {
 	case *tree.CreateAccount:
 		typs = append(typs, PrivilegeTypeCreateAccount)
 	case *tree.DropAccount:
@@ -1018,6 +1175,10 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		objType = objectTypeNone
 		kind = privilegeKindSpecial
 		special = specialTagAdmin
+	case *tree.CreateTask, *tree.ShowTasks, *tree.CancelTask, *tree.AlterTask:
+		objType = objectTypeNone
+		kind = privilegeKindSpecial
+		special = specialTagAdmin
 	case *tree.CreateDatabase:
 		typs = append(typs, PrivilegeTypeCreateDatabase, PrivilegeTypeAccountAll /*, PrivilegeTypeAccountOwnership*/)
 	case *tree.DropDatabase:
@@ -1034,12 +1195,15 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	case *tree.ShowTables, *tree.ShowCreateTable, *tree.ShowColumns, *tree.ShowCreateView, *tree.ShowCreateDatabase:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeShowTables, PrivilegeTypeDatabaseAll /*PrivilegeTypeDatabaseOwnership*/)
-	case *tree.CreateTable, *tree.CreateView:
+	case *tree.CreateTable, *tree.CreateView, *tree.CreatePolicy:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateObject, PrivilegeTypeDatabaseAll /* PrivilegeTypeDatabaseOwnership*/)
-	case *tree.DropTable, *tree.DropView:
+	case *tree.DropTable, *tree.DropView, *tree.DropPolicy:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeDropObject, PrivilegeTypeDatabaseAll /*PrivilegeTypeDatabaseOwnership*/)
+	case *tree.AlterTable:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterObject, PrivilegeTypeDatabaseAll /*PrivilegeTypeDatabaseOwnership*/)
 	case *tree.Select:
 		objType = objectTypeTable
 		typs = append(typs, PrivilegeTypeSelect, PrivilegeTypeTableAll /*PrivilegeTypeTableOwnership*/)
@@ -1058,6 +1222,9 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	case *tree.ShowProcessList, *tree.ShowErrors, *tree.ShowWarnings, *tree.ShowVariables, *tree.ShowStatus, *tree.ShowTarget:
 		objType = objectTypeNone
 		kind = privilegeKindNone
+	case *tree.AnalyzeStmt:
+		objType = objectTypeTable
+		typs = append(typs, PrivilegeTypeSelect, PrivilegeTypeTableAll /*PrivilegeTypeTableOwnership*/)
 	case *tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
 		objType = objectTypeNone
 		kind = privilegeKindNone
@@ -1084,11 +1251,35 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	return &privilege{kind, objType, entries, special}
 }
 
+// unwrapGrantOrRevoke returns the GrantPrivilege, GrantRole, RevokePrivilege or RevokeRole
+// that the GRANT or REVOKE statement holds.
+func unwrapGrantOrRevoke(stmt tree.Statement) tree.Statement {
+	switch st := stmt.(type) {
+	case *tree.Grant:
+		switch st.Typ {
+		case tree.GrantTypePrivilege:
+			return &st.GrantPrivilege
+		case tree.GrantTypeRole:
+			return &st.GrantRole
+		}
+	case *tree.Revoke:
+		switch st.Typ {
+		case tree.RevokeTypePrivilege:
+			return &st.RevokePrivilege
+		case tree.RevokeTypeRole:
+			return &st.RevokeRole
+		}
+	}
+	return stmt
+}
+
 // privilege will be done on the table
 type privilegeTips struct {
 	typ          PrivilegeType
 	databaseName string
 	tableName    string
+	//the columns of the table that are accessed
+	columns []string
 }
 
 type privilegeTipsArray []privilegeTips
@@ -1106,7 +1297,24 @@ func (pota privilegeTipsArray) String() string {
 	return b.String()
 }
 
-// extractPrivilegeTipsFromPlan extracts the privilege tips from the plan
+// columnsOfTableDef returns the names of the columns in the table definition
+// except the hidden composite primary key.
+func columnsOfTableDef(tableDef *plan2.TableDef) []string {
+	var columns []string
+	for _, col := range tableDef.GetCols() {
+		if col.GetIsCPkey() {
+			continue
+		}
+		columns = append(columns, col.GetName())
+	}
+	return columns
+}
+
+// extractPrivilegeTipsFromPlan extracts the privilege tips from the plan.
+// Every table scanned needs the SELECT on the columns read by the query
+// except the tables updated or deleted. The latter need the UPDATE on the
+// columns updated or the DELETE on the table instead.
+// The tables in the views and the subqueries are scanned in the plan also.
 func extractPrivilegeTipsFromPlan(p *plan2.Plan) privilegeTipsArray {
 	//NOTE: the pots may be nil when the plan does operate any table.
 	var pots privilegeTipsArray
@@ -1115,38 +1323,78 @@ func extractPrivilegeTipsFromPlan(p *plan2.Plan) privilegeTipsArray {
 	}
 	if p.GetQuery() != nil { //select,insert select, update, delete
 		q := p.GetQuery()
-		lastNode := q.Nodes[len(q.Nodes)-1]
-		var t PrivilegeType
 
+		type pair struct {
+			databaseName string
+			tableName    string
+		}
+		//the tables updated or deleted
+		modified := make(map[pair]int8)
 		for _, node := range q.Nodes {
-			if node.NodeType == plan.Node_TABLE_SCAN {
-				switch lastNode.NodeType {
-				case plan.Node_UPDATE:
-					t = PrivilegeTypeUpdate
-				case plan.Node_DELETE:
-					t = PrivilegeTypeDelete
-				default:
-					t = PrivilegeTypeSelect
+			switch node.NodeType {
+			case plan.Node_UPDATE:
+				for _, ctx := range node.GetUpdateCtxs() {
+					var columns []string
+					for _, col := range ctx.GetUpdateCols() {
+						columns = append(columns, col.GetName())
+					}
+					appendPot(privilegeTips{
+						PrivilegeTypeUpdate,
+						ctx.GetDbName(),
+						ctx.GetTblName(),
+						columns,
+					})
+					modified[pair{ctx.GetDbName(), ctx.GetTblName()}] = 1
+				}
+			case plan.Node_DELETE:
+				for _, ctx := range node.GetDeleteTablesCtx() {
+					appendPot(privilegeTips{
+						PrivilegeTypeDelete,
+						ctx.GetDbName(),
+						ctx.GetTblName(),
+						nil,
+					})
+					modified[pair{ctx.GetDbName(), ctx.GetTblName()}] = 1
 				}
+			case plan.Node_INSERT: //insert select
+				//the values of the columns not specified are made from the default value,
+				//so the privilege on the table is needed.
 				appendPot(privilegeTips{
-					t,
+					PrivilegeTypeInsert,
 					node.ObjRef.GetSchemaName(),
 					node.ObjRef.GetObjName(),
+					nil,
 				})
-			} else if node.NodeType == plan.Node_INSERT { //insert select
+			}
+		}
+
+		for _, node := range q.Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN {
+				if _, ok := modified[pair{node.ObjRef.GetSchemaName(), node.ObjRef.GetObjName()}]; ok {
+					continue
+				}
 				appendPot(privilegeTips{
-					PrivilegeTypeInsert,
+					PrivilegeTypeSelect,
 					node.ObjRef.GetSchemaName(),
 					node.ObjRef.GetObjName(),
+					columnsOfTableDef(node.GetTableDef()),
 				})
 			}
 		}
 	} else if p.GetIns() != nil { //insert into values
 		ins := p.GetIns()
+		var columns []string
+		for _, col := range ins.GetExplicitCols() {
+			if col.GetIsCPkey() {
+				continue
+			}
+			columns = append(columns, col.GetName())
+		}
 		appendPot(privilegeTips{
 			PrivilegeTypeInsert,
 			ins.GetDbName(),
-			ins.GetTblName()})
+			ins.GetTblName(),
+			columns})
 	}
 	return pots
 }
@@ -1160,15 +1408,42 @@ func convertPrivilegeTipsToPrivilege(priv *privilege, arr privilegeTipsArray) {
 
 	//NOTE: when the arr is nil, it denotes that there is no operation on the table.
 
-	type pair struct {
+	type triple struct {
+		typ          PrivilegeType
 		databaseName string
 		tableName    string
 	}
 
-	dedup := make(map[pair]int8)
+	//merge the columns of the same privilege on the same table
+	dedup := make(map[triple]int)
 
 	entries := make([]privilegeEntry, 0, len(arr))
 	for _, tips := range arr {
+		key := triple{tips.typ, tips.databaseName, tips.tableName}
+		if i, ok := dedup[key]; ok {
+			if len(entries[i].columns) == 0 {
+				continue
+			}
+			if len(tips.columns) == 0 {
+				entries[i].columns = nil
+				continue
+			}
+			for _, col := range tips.columns {
+				found := false
+				for _, c := range entries[i].columns {
+					if c == col {
+						found = true
+						break
+					}
+				}
+				if !found {
+					entries[i].columns = append(entries[i].columns, col)
+				}
+			}
+			continue
+		}
+
+		dedup[key] = len(entries)
 		entries = append(entries, privilegeEntry{
 			privilegeId:    tips.typ,
 			privilegeLevel: 0,
@@ -1176,24 +1451,8 @@ func convertPrivilegeTipsToPrivilege(priv *privilege, arr privilegeTipsArray) {
 			objId:          objectIDAll,
 			databaseName:   tips.databaseName,
 			tableName:      tips.tableName,
+			columns:        append([]string(nil), tips.columns...),
 		})
-
-		dedup[pair{tips.databaseName, tips.tableName}] = 1
-	}
-
-	//predefined privilege : tableAll, ownership
-	predefined := []PrivilegeType{PrivilegeTypeTableAll /*,PrivilegeTypeTableOwnership*/}
-	for _, p := range predefined {
-		for par := range dedup {
-			entries = append(entries, privilegeEntry{
-				privilegeId:    p,
-				privilegeLevel: 0,
-				objType:        objectTypeTable,
-				objId:          objectIDAll,
-				databaseName:   par.databaseName,
-				tableName:      par.tableName,
-			})
-		}
 	}
 
 	priv.entries = entries
@@ -1207,6 +1466,9 @@ func determineRoleSetSatisfyPrivilegeSet(ctx context.Context, bh BackgroundExec,
 	if len(priv.entries) == 0 {
 		return true, nil
 	}
+	if priv.objectType() == objectTypeTable {
+		return determineRoleSetSatisfyTablePrivilegeSet(ctx, bh, roleIds, priv)
+	}
 	for _, roleId := range roleIds {
 		for _, entry := range priv.entries {
 			if entry.privilegeId == PrivilegeTypeAccountOwnership || entry.privilegeId == PrivilegeTypeUserOwnership || entry.privilegeId == PrivilegeTypeTableOwnership {
@@ -1216,15 +1478,7 @@ func determineRoleSetSatisfyPrivilegeSet(ctx context.Context, bh BackgroundExec,
 				}
 			}
 
-			var sqlForCheckRoleHasPrivilege string
-			//for object type table, need concrete tableid
-			//TODO: table level check should be done after getting the plan
-			if priv.objectType() == objectTypeTable {
-				sqlForCheckRoleHasPrivilege = getSqlForCheckRoleHasTableLevelPrivilegeFormat(roleId, entry.privilegeId, entry.databaseName, entry.tableName)
-			} else {
-				sqlForCheckRoleHasPrivilege = getSqlForCheckRoleHasPrivilege(roleId, entry.objType, int64(entry.objId), int64(entry.privilegeId))
-			}
-
+			sqlForCheckRoleHasPrivilege := getSqlForCheckRoleHasPrivilege(roleId, entry.objType, int64(entry.objId), int64(entry.privilegeId))
 			err := bh.Exec(ctx, sqlForCheckRoleHasPrivilege)
 			if err != nil {
 				return false, err
@@ -1244,6 +1498,84 @@ func determineRoleSetSatisfyPrivilegeSet(ctx context.Context, bh BackgroundExec,
 	return false, nil
 }
 
+// tablePrivilegeKey is a privilege granted on the table, or on the column of the table
+// when the column is not empty.
+type tablePrivilegeKey struct {
+	privilegeId  PrivilegeType
+	databaseName string
+	tableName    string
+	column       string
+}
+
+// determineRoleSetSatisfyTablePrivilegeSet decides the privileges of role set can satisfy the requirement of
+// the privilege set on the tables. Unlike the other object types, every entry must be satisfied.
+// The entry is satisfied by the privilege on the table or the privileges on all columns it accesses.
+// The privileges of the role set on the tables are read at once and checked in memory.
+// The entries and the columns satisfied are removed from the privilege set, the rest are checked
+// with the roles inherited later.
+func determineRoleSetSatisfyTablePrivilegeSet(ctx context.Context, bh BackgroundExec, roleIds []int64, priv *privilege) (bool, error) {
+	sqlForTablePrivileges := getSqlForTablePrivilegesOfRoleSet(roleIds, priv.entries)
+	err := bh.Exec(ctx, sqlForTablePrivileges)
+	if err != nil {
+		return false, err
+	}
+	results := bh.GetExecResultSet()
+	rsset, err := convertIntoResultSet(results)
+	if err != nil {
+		return false, err
+	}
+
+	granted := make(map[tablePrivilegeKey]int8)
+	if len(rsset) != 0 {
+		for i := uint64(0); i < rsset[0].GetRowCount(); i++ {
+			privId, err := rsset[0].GetInt64(i, 0)
+			if err != nil {
+				return false, err
+			}
+			dbName, err := rsset[0].GetString(i, 1)
+			if err != nil {
+				return false, err
+			}
+			tableName, err := rsset[0].GetString(i, 2)
+			if err != nil {
+				return false, err
+			}
+			column, err := rsset[0].GetString(i, 3)
+			if err != nil {
+				return false, err
+			}
+			granted[tablePrivilegeKey{PrivilegeType(privId), dbName, tableName, column}] = 1
+		}
+	}
+
+	rest := priv.entries[:0]
+	for _, entry := range priv.entries {
+		//the privilege 'table all' covers the others.
+		if _, ok := granted[tablePrivilegeKey{entry.privilegeId, entry.databaseName, entry.tableName, ""}]; ok {
+			continue
+		}
+		if _, ok := granted[tablePrivilegeKey{PrivilegeTypeTableAll, entry.databaseName, entry.tableName, ""}]; ok {
+			continue
+		}
+
+		if len(entry.columns) != 0 {
+			columns := entry.columns[:0]
+			for _, column := range entry.columns {
+				if _, ok := granted[tablePrivilegeKey{entry.privilegeId, entry.databaseName, entry.tableName, column}]; !ok {
+					columns = append(columns, column)
+				}
+			}
+			entry.columns = columns
+			if len(entry.columns) == 0 {
+				continue
+			}
+		}
+		rest = append(rest, entry)
+	}
+	priv.entries = rest
+	return len(priv.entries) == 0, nil
+}
+
 // determinePrivilegesOfUserSatisfyPrivilegeSet decides the privileges of user can satisfy the requirement of the privilege set
 // The algorithm 1.
 func determinePrivilegesOfUserSatisfyPrivilegeSet(ctx context.Context, ses *Session, priv *privilege, stmt tree.Statement) (bool, error) {
@@ -1526,7 +1858,7 @@ func authenticatePrivilegeOfStatementWithObjectTypeAccountAndDatabase(ctx contex
 
 	//for GrantRole statement, check with_grant_option
 	if !ok && priv.kind == privilegeKindInherit {
-		grantRole := stmt.(*tree.GrantRole)
+		grantRole := unwrapGrantOrRevoke(stmt).(*tree.GrantRole)
 		yes, err := determineRoleHasWithGrantOption(ctx, ses, grantRole.Roles)
 		if err != nil {
 			return false, err
@@ -1538,12 +1870,14 @@ func authenticatePrivilegeOfStatementWithObjectTypeAccountAndDatabase(ctx contex
 	return ok, nil
 }
 
-// authenticatePrivilegeOfStatementWithObjectTypeTable decides the user has the privilege of executing the statement with object type table
+// authenticatePrivilegeOfStatementWithObjectTypeTable decides the user has the privilege of executing the statement with object type table.
+// The privilege set of the session keeps the entries that are not satisfied.
 func authenticatePrivilegeOfStatementWithObjectTypeTable(ctx context.Context, ses *Session, stmt tree.Statement, p *plan2.Plan) (bool, error) {
 	priv := determinePrivilegeSetOfStatement(stmt)
 	if priv.objectType() == objectTypeTable {
 		arr := extractPrivilegeTipsFromPlan(p)
 		convertPrivilegeTipsToPrivilege(priv, arr)
+		ses.SetPrivilege(priv)
 		ok, err := determinePrivilegesOfUserSatisfyPrivilegeSet(ctx, ses, priv, stmt)
 		if err != nil {
 			return false, err
@@ -1553,6 +1887,52 @@ func authenticatePrivilegeOfStatementWithObjectTypeTable(ctx context.Context, se
 	return true, nil
 }
 
+// getPrivilegeTipsOfAnalyze returns the privilege tips of analyzing the columns of the table.
+func getPrivilegeTipsOfAnalyze(tenant *TenantInfo, dbName, tableName string, cols []string) privilegeTipsArray {
+	arr := privilegeTipsArray{
+		{PrivilegeTypeSelect, dbName, tableName, cols},
+	}
+	if !tenant.IsAdminRole() {
+		arr = append(arr, privilegeTips{PrivilegeTypeInsert, "mo_catalog", "mo_table_stats", nil})
+	}
+	return arr
+}
+
+// authenticatePrivilegeOfAnalyze decides the user has the privilege of analyzing the columns of the table,
+// all columns if cols is empty. ANALYZE TABLE reads every value of the columns, so it needs the SELECT on them,
+// and it replaces the statistics in mo_catalog.mo_table_stats, which needs the INSERT on it or an admin role.
+// The rows hidden by the row-level security policies are read too, so the users the policies apply to are denied.
+func authenticatePrivilegeOfAnalyze(ctx context.Context, ses *Session, stmt *tree.AnalyzeStmt, dbName, tableName string, cols []string) (bool, error) {
+	priv := determinePrivilegeSetOfStatement(stmt)
+	convertPrivilegeTipsToPrivilege(priv, getPrivilegeTipsOfAnalyze(ses.GetTenantInfo(), dbName, tableName, cols))
+	ses.SetPrivilege(priv)
+	ok, err := determinePrivilegesOfUserSatisfyPrivilegeSet(ctx, ses, priv, stmt)
+	if err != nil || !ok {
+		return false, err
+	}
+	predicates, err := getPolicyPredicates(ses, dbName, tableName, plan.Query_SELECT)
+	if err != nil {
+		return false, err
+	}
+	return len(predicates) == 0, nil
+}
+
+// getErrorOfTablePrivilegeDenied makes the error for the first entry of the privilege set
+// on the tables that is not satisfied.
+func getErrorOfTablePrivilegeDenied(ses *Session) error {
+	priv := ses.GetPrivilege()
+	if priv == nil || priv.objectType() != objectTypeTable || len(priv.entries) == 0 {
+		return moerr.NewInternalError("do not have privilege to execute the statement")
+	}
+	entry := priv.entries[0]
+	host, _ := ses.protocol.Peer()
+	command := strings.ToUpper(entry.privilegeId.String())
+	if len(entry.columns) != 0 {
+		return moerr.New(moerr.ER_COLUMNACCESS_DENIED_ERROR, command, ses.GetUserName(), host, entry.columns[0], entry.tableName)
+	}
+	return moerr.New(moerr.ER_TABLEACCESS_DENIED_ERROR, command, ses.GetUserName(), host, entry.tableName)
+}
+
 // formSqlFromGrantPrivilege makes the sql for querying the database.
 func formSqlFromGrantPrivilege(ctx context.Context, ses *Session, gp *tree.GrantPrivilege, priv *tree.Privilege) (string, error) {
 	tenant := ses.GetTenantInfo()
@@ -1769,11 +2149,42 @@ func convertAstPrivilegeTypeToPrivilegeType(priv tree.PrivilegeType) PrivilegeTy
 		return PrivilegeTypeSelect
 	case tree.PRIVILEGE_TYPE_STATIC_INSERT:
 		return PrivilegeTypeInsert
+	case tree.PRIVILEGE_TYPE_STATIC_UPDATE:
+		return PrivilegeTypeUpdate
+	case tree.PRIVILEGE_TYPE_STATIC_DELETE:
+		return PrivilegeTypeDelete
+	case tree.PRIVILEGE_TYPE_STATIC_INDEX:
+		return PrivilegeTypeIndex
+	case tree.PRIVILEGE_TYPE_STATIC_REFERENCES:
+		return PrivilegeTypeReference
 	default:
 		return PrivilegeTypeAccountAll
 	}
 }
 
+// convertAstPrivilegeToTablePrivilegeType converts the privilege granted on the tables or the columns
+func convertAstPrivilegeToTablePrivilegeType(priv *tree.Privilege) (PrivilegeType, error) {
+	var privType PrivilegeType
+	switch priv.Type {
+	case tree.PRIVILEGE_TYPE_STATIC_ALL:
+		privType = PrivilegeTypeTableAll
+	case tree.PRIVILEGE_TYPE_STATIC_SELECT, tree.PRIVILEGE_TYPE_STATIC_INSERT,
+		tree.PRIVILEGE_TYPE_STATIC_UPDATE, tree.PRIVILEGE_TYPE_STATIC_REFERENCES:
+		privType = convertAstPrivilegeTypeToPrivilegeType(priv.Type)
+	case tree.PRIVILEGE_TYPE_STATIC_DELETE, tree.PRIVILEGE_TYPE_STATIC_INDEX:
+		privType = convertAstPrivilegeTypeToPrivilegeType(priv.Type)
+		if len(priv.ColumnList) != 0 {
+			return 0, moerr.NewInternalError("the privilege %s can not be granted on the columns", privType)
+		}
+	default:
+		return 0, moerr.NewInternalError("the privilege %s can not be granted on the table", priv.Type.ToString())
+	}
+	if privType == PrivilegeTypeTableAll && len(priv.ColumnList) != 0 {
+		return 0, moerr.NewInternalError("the privilege %s can not be granted on the columns", privType)
+	}
+	return privType, nil
+}
+
 // authenticatePrivilegeOfStatementWithObjectTypeNone decides the user has the privilege of executing the statement with object type none
 func authenticatePrivilegeOfStatementWithObjectTypeNone(ctx context.Context, ses *Session, stmt tree.Statement) (bool, error) {
 	priv := ses.GetPrivilege()
@@ -1784,8 +2195,8 @@ func authenticatePrivilegeOfStatementWithObjectTypeNone(ctx context.Context, ses
 
 	if priv.privilegeKind() == privilegeKindNone { // do nothing
 		return true, nil
-	} else if priv.privilegeKind() == privilegeKindSpecial { //GrantPrivilege, RevokePrivilege
-		switch gp := stmt.(type) {
+	} else if priv.privilegeKind() == privilegeKindSpecial { //GrantPrivilege, RevokePrivilege, Task statements
+		switch gp := unwrapGrantOrRevoke(stmt).(type) {
 		case *tree.GrantPrivilege:
 			//in the version 0.6, only the moAdmin and accountAdmin can grant the privilege.
 			if tenant.IsAdminRole() {
@@ -1801,6 +2212,9 @@ func authenticatePrivilegeOfStatementWithObjectTypeNone(ctx context.Context, ses
 		case *tree.RevokePrivilege:
 			//in the version 0.6, only the moAdmin and accountAdmin can revoke the privilege.
 			return tenant.IsAdminRole(), nil
+		case *tree.CreateTask, *tree.ShowTasks, *tree.CancelTask, *tree.AlterTask:
+			//the tasks are shared by all accounts and run without a tenant, only the moAdmin can manage them.
+			return tenant.IsMoAdminRole(), nil
 		}
 	}
 
@@ -1899,7 +2313,10 @@ func InitSysTenant(ctx context.Context) error {
 		return err
 	}
 	if exists {
-		return nil
+		if err = upgradeMoUser(ctx, pu); err != nil {
+			return err
+		}
+		return upgradeMoTableStats(ctx, pu)
 	}
 
 	err = createTablesInMoCatalog(ctx, tenant, pu)
@@ -1915,6 +2332,103 @@ func InitSysTenant(ctx context.Context) error {
 	return nil
 }
 
+// upgradeMoUser adds the columns of the authentication plugins and the tls requirements
+// to the mo_user of the accounts created before them.
+func upgradeMoUser(ctx context.Context, pu *config.ParameterUnit) error {
+	guestMMu := guest.New(pu.SV.GuestMmuLimitation, pu.HostMmu)
+	bh := NewBackgroundHandler(ctx, guestMMu, pu.Mempool, pu)
+	defer bh.Close()
+
+	accountIds, err := queryInt64sInBackground(ctx, bh, getAccountIdsSql)
+	if err != nil {
+		return err
+	}
+
+	for _, accountId := range accountIds {
+		accountCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(accountId))
+		tableIds, err := queryInt64sInBackground(accountCtx, bh, getSqlForTableId("mo_catalog", "mo_user"))
+		if err != nil {
+			return err
+		}
+		if len(tableIds) == 0 {
+			return moerr.NewInternalError("there is no mo_user in the account %d", accountId)
+		}
+		for _, column := range upgradeMoUserColumns {
+			bh.ClearExecResultSet()
+			err = bh.Exec(accountCtx, getSqlForCheckColumnExists(tableIds[0], column.name))
+			if err != nil {
+				return err
+			}
+			rsset, err := convertIntoResultSet(bh.GetExecResultSet())
+			if err != nil {
+				return err
+			}
+			if len(rsset) > 0 && rsset[0].GetRowCount() > 0 {
+				continue
+			}
+			bh.ClearExecResultSet()
+			err = bh.Exec(accountCtx, getSqlForAddColumnOfMoUser(column.name, column.def))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// upgradeMoTableStats creates the mo_table_stats in the accounts created before it.
+func upgradeMoTableStats(ctx context.Context, pu *config.ParameterUnit) error {
+	guestMMu := guest.New(pu.SV.GuestMmuLimitation, pu.HostMmu)
+	bh := NewBackgroundHandler(ctx, guestMMu, pu.Mempool, pu)
+	defer bh.Close()
+
+	accountIds, err := queryInt64sInBackground(ctx, bh, getAccountIdsSql)
+	if err != nil {
+		return err
+	}
+
+	for _, accountId := range accountIds {
+		accountCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(accountId))
+		tableIds, err := queryInt64sInBackground(accountCtx, bh, getSqlForTableId("mo_catalog", "mo_table_stats"))
+		if err != nil {
+			return err
+		}
+		if len(tableIds) > 0 {
+			continue
+		}
+		bh.ClearExecResultSet()
+		err = bh.Exec(accountCtx, createMoTableStatsSql)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryInt64sInBackground returns the int64 values of the first column of the result of the sql.
+func queryInt64sInBackground(ctx context.Context, bh BackgroundExec, sql string) ([]int64, error) {
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	rsset, err := convertIntoResultSet(bh.GetExecResultSet())
+	if err != nil {
+		return nil, err
+	}
+	var values []int64
+	for _, rs := range rsset {
+		for i := uint64(0); i < rs.GetRowCount(); i++ {
+			v, err := rs.GetInt64(i, 0)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
+
 // createTablesInMoCatalog creates catalog tables in the database mo_catalog.
 func createTablesInMoCatalog(ctx context.Context, tenant *TenantInfo, pu *config.ParameterUnit) error {
 	var err error
@@ -1953,8 +2467,10 @@ func createTablesInMoCatalog(ctx context.Context, tenant *TenantInfo, pu *config
 
 	//step 3:add new user entry to the mo_user
 
-	initMoUser1 := fmt.Sprintf(initMoUserFormat, rootID, rootHost, rootName, rootPassword, rootStatus, types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType, rootCreatorID, rootOwnerRoleID, rootDefaultRoleID)
-	initMoUser2 := fmt.Sprintf(initMoUserFormat, dumpID, dumpHost, dumpName, dumpPassword, dumpStatus, types.CurrentTimestamp().String2(time.UTC, 0), dumpExpiredTime, dumpLoginType, dumpCreatorID, dumpOwnerRoleID, dumpDefaultRoleID)
+	initMoUser1 := fmt.Sprintf(initMoUserFormat, rootID, rootHost, rootName, rootPassword, rootStatus, types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType, rootCreatorID, rootOwnerRoleID, rootDefaultRoleID,
+		AuthNativePassword, sslTypeNone, "", "", "")
+	initMoUser2 := fmt.Sprintf(initMoUserFormat, dumpID, dumpHost, dumpName, dumpPassword, dumpStatus, types.CurrentTimestamp().String2(time.UTC, 0), dumpExpiredTime, dumpLoginType, dumpCreatorID, dumpOwnerRoleID, dumpDefaultRoleID,
+		AuthNativePassword, sslTypeNone, "", "", "")
 	addSqlIntoSet(initMoUser1)
 	addSqlIntoSet(initMoUser2)
 
@@ -2149,10 +2665,15 @@ func createTablesInMoCatalogOfGeneralTenant(ctx context.Context, tenant *TenantI
 			status = "suspend"
 		}
 	}
+	authString, err := getAuthenticationString(pu.SV.DefaultAuthPlugin, password)
+	if err != nil {
+		return nil, err
+	}
 	newUserId := uint32(rand.Int31())
-	initMoUser1 := fmt.Sprintf(initMoUserFormat, newUserId, rootHost, name, password, status,
+	initMoUser1 := fmt.Sprintf(initMoUserFormat, newUserId, rootHost, name, authString, status,
 		types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType,
-		tenant.GetUserID(), tenant.GetDefaultRoleID(), publicRoleID)
+		tenant.GetUserID(), tenant.GetDefaultRoleID(), publicRoleID,
+		pu.SV.DefaultAuthPlugin, sslTypeNone, "", "", "")
 	addSqlIntoSet(initMoUser1)
 
 	//step4: add new entries to the mo_role_privs
@@ -2302,6 +2823,11 @@ func InitUser(ctx context.Context, tenant *TenantInfo, cu *tree.CreateUser) erro
 		}
 	}
 
+	tlsReq, err := getTlsRequirement(cu.TlsOpts)
+	if err != nil {
+		return err
+	}
+
 	//TODO: get password_option or lock_option. there is no field in mo_user to store it.
 	status := userStatusUnlock
 	if cu.MiscOpt != nil {
@@ -2338,12 +2864,22 @@ func InitUser(ctx context.Context, tenant *TenantInfo, cu *tree.CreateUser) erro
 			return moerr.NewInternalError("password is empty string")
 		}
 
+		plugin := pu.SV.DefaultAuthPlugin
+		if len(user.AuthOption.AuthPlugin) != 0 {
+			plugin = strings.ToLower(user.AuthOption.AuthPlugin)
+		}
+		authString, err := getAuthenticationString(plugin, password)
+		if err != nil {
+			return err
+		}
+
 		//TODO: get comment or attribute. there is no field in mo_user to store it.
 		//TODO: to get the user id from the auto_increment table
 		newUserId := rand.Int31()
-		initMoUser1 := fmt.Sprintf(initMoUserFormat, newUserId, rootHost, user.Username, password, status,
+		initMoUser1 := fmt.Sprintf(initMoUserFormat, newUserId, rootHost, user.Username, authString, status,
 			types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType,
-			tenant.GetUserID(), tenant.GetDefaultRoleID(), newRoleId)
+			tenant.GetUserID(), tenant.GetDefaultRoleID(), newRoleId,
+			plugin, tlsReq.sslType, tlsReq.sslCipher, tlsReq.x509Issuer, tlsReq.x509Subject)
 
 		appendSql(initMoUser1)
 
@@ -2378,6 +2914,47 @@ handleFailed:
 	return err
 }
 
+// getAuthenticationString returns the authentication string of the password
+// which is saved in mo_user for the authentication plugin
+func getAuthenticationString(plugin, password string) (string, error) {
+	switch plugin {
+	case AuthNativePassword:
+		return password, nil
+	case AuthCachingSha2Password:
+		return generateCachingSha2Password(password)
+	default:
+		return "", moerr.NewInternalError("the authentication plugin %s is not supported", plugin)
+	}
+}
+
+// getTlsRequirement converts the REQUIRE clause into the ssl_type, ssl_cipher,
+// x509_issuer and x509_subject of the user
+func getTlsRequirement(opts []tree.TlsOption) (*userAuthInfo, error) {
+	tls := &userAuthInfo{sslType: sslTypeNone}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case *tree.TlsOptionNone:
+			tls.sslType = sslTypeNone
+		case *tree.TlsOptionSSL:
+			tls.sslType = sslTypeAny
+		case *tree.TlsOptionX509:
+			tls.sslType = sslTypeX509
+		case *tree.TlsOptionCipher:
+			tls.sslType = sslTypeSpecified
+			tls.sslCipher = o.Cipher
+		case *tree.TlsOptionIssuer:
+			tls.sslType = sslTypeSpecified
+			tls.x509Issuer = o.Issuer
+		case *tree.TlsOptionSubject:
+			tls.sslType = sslTypeSpecified
+			tls.x509Subject = o.Subject
+		default:
+			return nil, moerr.NewInternalError("the tls option %s is not supported", tree.String(opt, dialect.MYSQL))
+		}
+	}
+	return tls, nil
+}
+
 // InitRole creates the new role
 func InitRole(ctx context.Context, tenant *TenantInfo, cr *tree.CreateRole) error {
 	var err error
@@ -2449,3 +3026,231 @@ handleFailed:
 	}
 	return err
 }
+
+// tablePrivilegeObject denotes the tables that the privileges are granted on
+type tablePrivilegeObject struct {
+	level     privilegeLevelType
+	objId     int64
+	tableName string
+}
+
+// getTablePrivilegeObject resolves the privilege level of the GRANT or REVOKE on the tables
+func getTablePrivilegeObject(ctx context.Context, bh BackgroundExec, ses *Session, objType tree.ObjectType, level *tree.PrivilegeLevel) (*tablePrivilegeObject, error) {
+	var err error
+	var rsset []ExecResult
+	if objType != tree.OBJECT_TYPE_NONE && objType != tree.OBJECT_TYPE_TABLE {
+		return nil, moerr.NewInternalError("object type %s is unsupported", objType.ToString())
+	}
+
+	queryId := func(sql string, what string) (int64, error) {
+		err = bh.Exec(ctx, sql)
+		if err != nil {
+			return 0, err
+		}
+		rsset, err = convertIntoResultSet(bh.GetExecResultSet())
+		if err != nil {
+			return 0, err
+		}
+		if len(rsset) < 1 || rsset[0].GetRowCount() < 1 {
+			return 0, moerr.NewInternalError("there is no %s", what)
+		}
+		return rsset[0].GetInt64(0, 0)
+	}
+
+	dbName := level.DbName
+	switch level.Level {
+	case tree.PRIVILEGE_LEVEL_TYPE_STAR, tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+		dbName = ses.GetDatabaseName()
+		if len(dbName) == 0 {
+			return nil, moerr.NewInternalError("no database selected")
+		}
+	}
+
+	obj := &tablePrivilegeObject{}
+	switch level.Level {
+	case tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR:
+		obj.level = privilegeLevelStarStar
+		obj.objId = objectIDAll
+	case tree.PRIVILEGE_LEVEL_TYPE_STAR, tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR:
+		obj.level = privilegeLevelDatabaseStar
+		obj.objId, err = queryId(getSqlForDatabaseId(dbName), "database "+dbName)
+	case tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE, tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+		obj.level = privilegeLevelDatabaseTable
+		obj.tableName = level.TabName
+		obj.objId, err = queryId(getSqlForTableId(dbName, level.TabName), "table "+dbName+"."+level.TabName)
+	default:
+		return nil, moerr.NewInternalError("in object type %s privilege level type %s is unsupported", objType.ToString(), tree.String(level, dialect.MYSQL))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// getRoleIdOfRoleName gets the id of the role
+func getRoleIdOfRoleName(ctx context.Context, bh BackgroundExec, roleName string) (int64, error) {
+	err := bh.Exec(ctx, getSqlForRoleIdOfRole(roleName))
+	if err != nil {
+		return 0, err
+	}
+	rsset, err := convertIntoResultSet(bh.GetExecResultSet())
+	if err != nil {
+		return 0, err
+	}
+	if len(rsset) < 1 || rsset[0].GetRowCount() < 1 {
+		return 0, moerr.NewInternalError("there is no role %s", roleName)
+	}
+	return rsset[0].GetInt64(0, 0)
+}
+
+// getColumnsOfPrivilege checks the columns of the privilege exist in the table
+func getColumnsOfPrivilege(ctx context.Context, bh BackgroundExec, obj *tablePrivilegeObject, priv *tree.Privilege) ([]string, error) {
+	if len(priv.ColumnList) == 0 {
+		return nil, nil
+	}
+	if obj.level != privilegeLevelDatabaseTable {
+		return nil, moerr.NewInternalError("the privilege on the columns must be granted on a table")
+	}
+	columns := make([]string, 0, len(priv.ColumnList))
+	for _, c := range priv.ColumnList {
+		column := c.Parts[0]
+		err := bh.Exec(ctx, getSqlForCheckColumnExists(obj.objId, column))
+		if err != nil {
+			return nil, err
+		}
+		rsset, err := convertIntoResultSet(bh.GetExecResultSet())
+		if err != nil {
+			return nil, err
+		}
+		if len(rsset) < 1 || rsset[0].GetRowCount() < 1 {
+			return nil, moerr.NewInternalError("there is no column %s in the table %s", column, obj.tableName)
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+// execSqlsInTxn runs the sqls in a transaction of the background handler
+func execSqlsInTxn(ctx context.Context, bh BackgroundExec, sqls []string) error {
+	err := bh.Exec(ctx, "begin;")
+	if err != nil {
+		return err
+	}
+	for _, sql := range sqls {
+		err = bh.Exec(ctx, sql)
+		if err != nil {
+			goto handleFailed
+		}
+	}
+	return bh.Exec(ctx, "commit;")
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// doGrantPrivilege saves the privileges on the tables and the columns granted to the roles
+// into the mo_role_privs and the mo_role_column_privs.
+func doGrantPrivilege(ctx context.Context, ses *Session, gp *tree.GrantPrivilege) error {
+	var sqls []string
+	pu := ses.Pu
+	tenant := ses.GetTenantInfo()
+
+	guestMMu := guest.New(pu.SV.GuestMmuLimitation, pu.HostMmu)
+	bh := NewBackgroundHandler(ctx, guestMMu, pu.Mempool, pu)
+	defer bh.Close()
+
+	obj, err := getTablePrivilegeObject(ctx, bh, ses, gp.ObjType, gp.Level)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range gp.Roles {
+		roleId, err := getRoleIdOfRoleName(ctx, bh, role.UserName)
+		if err != nil {
+			return err
+		}
+		for _, p := range gp.Privileges {
+			privType, err := convertAstPrivilegeToTablePrivilegeType(p)
+			if err != nil {
+				return err
+			}
+			columns, err := getColumnsOfPrivilege(ctx, bh, obj, p)
+			if err != nil {
+				return err
+			}
+			grantedTime := types.CurrentTimestamp().String2(time.UTC, 0)
+			if len(columns) == 0 {
+				sqls = append(sqls,
+					getSqlForDeleteRolePriv(roleId, objectTypeTable, obj.objId, privType, obj.level),
+					fmt.Sprintf(initMoRolePrivFormat,
+						roleId, role.UserName,
+						objectTypeTable, obj.objId,
+						privType, privType, obj.level,
+						tenant.GetUserID(), grantedTime,
+						gp.GrantOption))
+				continue
+			}
+			for _, column := range columns {
+				sqls = append(sqls,
+					getSqlForDeleteRoleColumnPriv(roleId, obj.objId, column, privType),
+					fmt.Sprintf(initMoRoleColumnPrivFormat,
+						roleId, role.UserName,
+						obj.objId, column,
+						privType, privType,
+						tenant.GetUserID(), grantedTime,
+						gp.GrantOption))
+			}
+		}
+	}
+
+	return execSqlsInTxn(ctx, bh, sqls)
+}
+
+// doRevokePrivilege removes the privileges on the tables and the columns from the roles
+func doRevokePrivilege(ctx context.Context, ses *Session, rp *tree.RevokePrivilege) error {
+	var sqls []string
+	pu := ses.Pu
+
+	guestMMu := guest.New(pu.SV.GuestMmuLimitation, pu.HostMmu)
+	bh := NewBackgroundHandler(ctx, guestMMu, pu.Mempool, pu)
+	defer bh.Close()
+
+	obj, err := getTablePrivilegeObject(ctx, bh, ses, rp.ObjType, rp.Level)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range rp.Users {
+		roleId, err := getRoleIdOfRoleName(ctx, bh, role.Username)
+		if err != nil {
+			if rp.IfExists {
+				continue
+			}
+			return err
+		}
+		for _, p := range rp.Privileges {
+			privType, err := convertAstPrivilegeToTablePrivilegeType(p)
+			if err != nil {
+				return err
+			}
+			columns, err := getColumnsOfPrivilege(ctx, bh, obj, p)
+			if err != nil {
+				return err
+			}
+			if len(columns) == 0 {
+				sqls = append(sqls, getSqlForDeleteRolePriv(roleId, objectTypeTable, obj.objId, privType, obj.level))
+				continue
+			}
+			for _, column := range columns {
+				sqls = append(sqls, getSqlForDeleteRoleColumnPriv(roleId, obj.objId, column, privType))
+			}
+		}
+	}
+
+	return execSqlsInTxn(ctx, bh, sqls)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
@@ -28,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/prashantv/gostub"
 	"github.com/smartystreets/goconvey/convey"
+	"strings"
 	"testing"
 )
 
@@ -163,6 +165,7 @@ func Test_checkSysExistsOrNot(t *testing.T) {
 		mrs1.EXPECT().GetString(gomock.Any(), gomock.Any()).DoAndReturn(func(r uint64, c uint64) (string, error) {
 			return dbs[r], nil
 		}).AnyTimes()
+		mrs1.EXPECT().GetInt64(gomock.Any(), gomock.Any()).Return(int64(0), nil).AnyTimes()
 
 		mrs2 := mock_frontend.NewMockExecResult(ctrl)
 		tables := make([]string, 0)
@@ -174,6 +177,7 @@ func Test_checkSysExistsOrNot(t *testing.T) {
 		mrs2.EXPECT().GetString(gomock.Any(), gomock.Any()).DoAndReturn(func(r uint64, c uint64) (string, error) {
 			return tables[r], nil
 		}).AnyTimes()
+		mrs2.EXPECT().GetInt64(gomock.Any(), gomock.Any()).Return(int64(0), nil).AnyTimes()
 
 		rs := []ExecResult{
 			mrs1,
@@ -202,6 +206,133 @@ func Test_checkSysExistsOrNot(t *testing.T) {
 	})
 }
 
+func Test_upgradeMoUser(t *testing.T) {
+	convey.Convey("add the missing columns to the mo_user", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+
+		pu.HostMmu = host.New(pu.SV.HostMmuLimitation)
+		pu.Mempool = mempool.New()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		//the account 0 has been upgraded, the account 1 has not
+		sql2result := make(map[string]ExecResult)
+		sql2result[getAccountIdsSql] = newMrsForRoleIdOfRole([][]interface{}{{0}, {1}})
+		tableSql := getSqlForTableId("mo_catalog", "mo_user")
+
+		type execution struct {
+			account uint32
+			sql     string
+		}
+		var executed []execution
+		var currentSql string
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().ClearExecResultSet().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, sql string) error {
+			currentSql = sql
+			account, _ := ctx.Value(defines.TenantIDKey{}).(uint32)
+			executed = append(executed, execution{account, sql})
+			if sql == tableSql {
+				sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{{100 + int(account)}})
+			}
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+			if rs, ok := sql2result[currentSql]; ok {
+				return []interface{}{rs}
+			}
+			return []interface{}{newMrsForColumnsOfRole(nil)}
+		}).AnyTimes()
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		for _, column := range upgradeMoUserColumns {
+			sql2result[getSqlForCheckColumnExists(100, column.name)] = newMrsForColumnsOfRole([][]interface{}{{column.name}})
+		}
+		sql2result[getSqlForCheckColumnExists(101, "auth_plugin")] = newMrsForColumnsOfRole([][]interface{}{{"auth_plugin"}})
+
+		err := upgradeMoUser(ctx, pu)
+		convey.So(err, convey.ShouldBeNil)
+
+		var added []execution
+		for _, e := range executed {
+			if strings.HasPrefix(e.sql, "alter table") {
+				added = append(added, e)
+			}
+		}
+		convey.So(len(added), convey.ShouldEqual, len(upgradeMoUserColumns)-1)
+		for i, e := range added {
+			convey.So(e.account, convey.ShouldEqual, 1)
+			column := upgradeMoUserColumns[i+1]
+			convey.So(e.sql, convey.ShouldEqual, getSqlForAddColumnOfMoUser(column.name, column.def))
+		}
+	})
+}
+
+func Test_upgradeMoTableStats(t *testing.T) {
+	convey.Convey("create the missing mo_table_stats", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+
+		pu.HostMmu = host.New(pu.SV.HostMmuLimitation)
+		pu.Mempool = mempool.New()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		//the account 0 has the mo_table_stats, the account 1 has not
+		sql2result := make(map[string]ExecResult)
+		sql2result[getAccountIdsSql] = newMrsForRoleIdOfRole([][]interface{}{{0}, {1}})
+		tableSql := getSqlForTableId("mo_catalog", "mo_table_stats")
+
+		type execution struct {
+			account uint32
+			sql     string
+		}
+		var executed []execution
+		var currentSql string
+		var currentAccount uint32
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().ClearExecResultSet().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, sql string) error {
+			currentSql = sql
+			currentAccount, _ = ctx.Value(defines.TenantIDKey{}).(uint32)
+			executed = append(executed, execution{currentAccount, sql})
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+			if currentSql == tableSql && currentAccount == 0 {
+				return []interface{}{newMrsForRoleIdOfRole([][]interface{}{{100}})}
+			}
+			if rs, ok := sql2result[currentSql]; ok {
+				return []interface{}{rs}
+			}
+			return []interface{}{newMrsForRoleIdOfRole(nil)}
+		}).AnyTimes()
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		err := upgradeMoTableStats(ctx, pu)
+		convey.So(err, convey.ShouldBeNil)
+
+		var created []execution
+		for _, e := range executed {
+			if strings.HasPrefix(e.sql, "create table") {
+				created = append(created, e)
+			}
+		}
+		convey.So(len(created), convey.ShouldEqual, 1)
+		convey.So(created[0].account, convey.ShouldEqual, 1)
+		convey.So(created[0].sql, convey.ShouldEqual, createMoTableStatsSql)
+	})
+}
+
 func Test_createTablesInMoCatalog(t *testing.T) {
 	convey.Convey("createTablesInMoCatalog", t, func() {
 		ctrl := gomock.NewController(t)
@@ -522,6 +653,7 @@ func Test_determinePrivilege(t *testing.T) {
 		{stmt: &tree.ExplainFor{}},
 		{stmt: &tree.ExplainAnalyze{}},
 		{stmt: &tree.ExplainStmt{}},
+		{stmt: &tree.AnalyzeStmt{}},
 		{stmt: &tree.BeginTransaction{}},
 		{stmt: &tree.CommitTransaction{}},
 		{stmt: &tree.RollbackTransaction{}},
@@ -532,6 +664,10 @@ func Test_determinePrivilege(t *testing.T) {
 		{stmt: &tree.PrepareStmt{}},
 		{stmt: &tree.PrepareString{}},
 		{stmt: &tree.Deallocate{}},
+		{stmt: &tree.CreateTask{}},
+		{stmt: &tree.ShowTasks{}},
+		{stmt: &tree.CancelTask{}},
+		{stmt: &tree.AlterTask{}},
 	}
 
 	for i := 0; i < len(args); i++ {
@@ -3203,12 +3339,12 @@ func Test_determineDML(t *testing.T) {
 			}
 
 			for _, roleId := range roleIds {
+				var rows [][]interface{}
 				for _, entry := range priv.entries {
-					sql := getSqlForCheckRoleHasTableLevelPrivilegeFormat(int64(roleId), entry.privilegeId, entry.databaseName, entry.tableName)
-					sql2result[sql] = newMrsForWithGrantOptionPrivilege([][]interface{}{
-						{entry.privilegeId, true},
-					})
+					rows = append(rows, []interface{}{int(entry.privilegeId), entry.databaseName, entry.tableName, ""})
 				}
+				sql := getSqlForTablePrivilegesOfRoleSet([]int64{int64(roleId)}, priv.entries)
+				sql2result[sql] = newMrsForTablePrivilegesOfRoleSet(rows)
 			}
 
 			bh := newBh(ctrl, sql2result)
@@ -3255,16 +3391,14 @@ func Test_determineDML(t *testing.T) {
 			}
 
 			for _, roleId := range roleIds {
-				for _, entry := range priv.entries {
-					sql := getSqlForCheckRoleHasTableLevelPrivilegeFormat(int64(roleId), entry.privilegeId, entry.databaseName, entry.tableName)
-					var rows [][]interface{}
-					if roleId == 1 {
-						rows = [][]interface{}{
-							{entry.privilegeId, true},
-						}
+				var rows [][]interface{}
+				if roleId == 1 {
+					for _, entry := range priv.entries {
+						rows = append(rows, []interface{}{int(entry.privilegeId), entry.databaseName, entry.tableName, ""})
 					}
-					sql2result[sql] = newMrsForWithGrantOptionPrivilege(rows)
 				}
+				sql := getSqlForTablePrivilegesOfRoleSet([]int64{int64(roleId)}, priv.entries)
+				sql2result[sql] = newMrsForTablePrivilegesOfRoleSet(rows)
 			}
 
 			bh := newBh(ctrl, sql2result)
@@ -3311,11 +3445,8 @@ func Test_determineDML(t *testing.T) {
 			}
 
 			for _, roleId := range roleIds {
-				for _, entry := range priv.entries {
-					sql := getSqlForCheckRoleHasTableLevelPrivilegeFormat(int64(roleId), entry.privilegeId, entry.databaseName, entry.tableName)
-					rows := make([][]interface{}, 0)
-					sql2result[sql] = newMrsForWithGrantOptionPrivilege(rows)
-				}
+				sql := getSqlForTablePrivilegesOfRoleSet([]int64{int64(roleId)}, priv.entries)
+				sql2result[sql] = newMrsForTablePrivilegesOfRoleSet(nil)
 			}
 
 			bh := newBh(ctrl, sql2result)
@@ -3330,6 +3461,209 @@ func Test_determineDML(t *testing.T) {
 	})
 }
 
+func Test_determineAnalyze(t *testing.T) {
+	convey.Convey("analyze needs the select on the columns and the insert on the statistics", t, func() {
+		priv := determinePrivilegeSetOfStatement(&tree.AnalyzeStmt{})
+		convey.So(priv.objectType(), convey.ShouldEqual, objectTypeTable)
+
+		tenant := &TenantInfo{Tenant: "a", User: "u", DefaultRole: "r", DefaultRoleID: 10}
+		convertPrivilegeTipsToPrivilege(priv, getPrivilegeTipsOfAnalyze(tenant, "t", "a", []string{"b"}))
+		convey.So(len(priv.entries), convey.ShouldEqual, 2)
+		convey.So(priv.entries[0].privilegeId, convey.ShouldEqual, PrivilegeTypeSelect)
+		convey.So(priv.entries[0].tableName, convey.ShouldEqual, "a")
+		convey.So(priv.entries[0].columns, convey.ShouldResemble, []string{"b"})
+		convey.So(priv.entries[1].privilegeId, convey.ShouldEqual, PrivilegeTypeInsert)
+		convey.So(priv.entries[1].databaseName, convey.ShouldEqual, "mo_catalog")
+		convey.So(priv.entries[1].tableName, convey.ShouldEqual, "mo_table_stats")
+
+		// the admin roles replace the statistics without the insert
+		tenant.DefaultRoleID = accountAdminRoleID
+		priv = determinePrivilegeSetOfStatement(&tree.AnalyzeStmt{})
+		convertPrivilegeTipsToPrivilege(priv, getPrivilegeTipsOfAnalyze(tenant, "t", "a", nil))
+		convey.So(len(priv.entries), convey.ShouldEqual, 1)
+		convey.So(priv.entries[0].columns, convey.ShouldBeNil)
+	})
+}
+
+func Test_determineTableAndColumnPrivilege(t *testing.T) {
+	tableDef := &plan2.TableDef{
+		Cols: []*plan2.ColDef{{Name: "a"}, {Name: "b"}},
+	}
+	selectPlan := &plan2.Plan{
+		Plan: &plan2.Plan_Query{
+			Query: &plan2.Query{
+				Nodes: []*plan2.Node{
+					{NodeType: plan.Node_TABLE_SCAN, ObjRef: &plan2.ObjectRef{SchemaName: "t", ObjName: "a"}, TableDef: tableDef},
+					{NodeType: plan.Node_TABLE_SCAN, ObjRef: &plan2.ObjectRef{SchemaName: "t", ObjName: "a"}, TableDef: &plan2.TableDef{
+						Cols: []*plan2.ColDef{{Name: "b"}, {Name: "c"}},
+					}},
+				},
+			},
+		},
+	}
+
+	convey.Convey("extract privilege tips", t, func() {
+		updatePlan := &plan2.Plan{
+			Plan: &plan2.Plan_Query{
+				Query: &plan2.Query{
+					Nodes: []*plan2.Node{
+						{NodeType: plan.Node_TABLE_SCAN, ObjRef: &plan2.ObjectRef{SchemaName: "t", ObjName: "a"}, TableDef: tableDef},
+						{NodeType: plan.Node_TABLE_SCAN, ObjRef: &plan2.ObjectRef{SchemaName: "s", ObjName: "b"}, TableDef: tableDef},
+						{NodeType: plan.Node_UPDATE, UpdateCtxs: []*plan.UpdateCtx{
+							{DbName: "t", TblName: "a", UpdateCols: []*plan2.ColDef{{Name: "b"}}},
+						}},
+					},
+				},
+			},
+		}
+
+		priv := determinePrivilegeSetOfStatement(&tree.Update{})
+		convertPrivilegeTipsToPrivilege(priv, extractPrivilegeTipsFromPlan(updatePlan))
+		convey.So(len(priv.entries), convey.ShouldEqual, 2)
+		convey.So(priv.entries[0].privilegeId, convey.ShouldEqual, PrivilegeTypeUpdate)
+		convey.So(priv.entries[0].tableName, convey.ShouldEqual, "a")
+		convey.So(priv.entries[0].columns, convey.ShouldResemble, []string{"b"})
+		convey.So(priv.entries[1].privilegeId, convey.ShouldEqual, PrivilegeTypeSelect)
+		convey.So(priv.entries[1].tableName, convey.ShouldEqual, "b")
+		convey.So(priv.entries[1].columns, convey.ShouldResemble, []string{"a", "b"})
+
+		//the columns of the scans on the same table are merged
+		priv = determinePrivilegeSetOfStatement(&tree.Select{})
+		convertPrivilegeTipsToPrivilege(priv, extractPrivilegeTipsFromPlan(selectPlan))
+		convey.So(len(priv.entries), convey.ShouldEqual, 1)
+		convey.So(priv.entries[0].columns, convey.ShouldResemble, []string{"a", "b", "c"})
+	})
+
+	runCheck := func(ctrl *gomock.Controller, grantedColumns []string) (bool, *Session) {
+		priv := determinePrivilegeSetOfStatement(&tree.Select{})
+		ses := newSes(priv)
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().RemoteAddress().Return("127.0.0.1:12345").AnyTimes()
+		ses.protocol = NewMysqlClientProtocol(0, ioses, 1024, ses.Pu.SV)
+
+		//role 0 does not inherit other roles
+		sql2result := makeSql2ExecResult2(0, [][]interface{}{{0, false}},
+			nil, nil, nil,
+			[]int{0}, [][][]interface{}{{}})
+
+		//role 0 does not have the select on the table.
+		//the insert on the table and the update on the columns do not count.
+		rows := [][]interface{}{
+			{int(PrivilegeTypeInsert), "t", "a", ""},
+			{int(PrivilegeTypeUpdate), "t", "a", "b"},
+		}
+		for _, column := range grantedColumns {
+			rows = append(rows, []interface{}{int(PrivilegeTypeSelect), "t", "a", column})
+		}
+		sql := getSqlForTablePrivilegesOfRoleSet([]int64{0}, []privilegeEntry{{databaseName: "t", tableName: "a"}})
+		sql2result[sql] = newMrsForTablePrivilegesOfRoleSet(rows)
+
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		ok, err := authenticatePrivilegeOfStatementWithObjectTypeTable(ses.GetRequestContext(), ses, &tree.Select{}, selectPlan)
+		convey.So(err, convey.ShouldBeNil)
+		return ok, ses
+	}
+
+	convey.Convey("select on the columns succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ok, _ := runCheck(ctrl, []string{"a", "b", "c"})
+		convey.So(ok, convey.ShouldBeTrue)
+	})
+
+	convey.Convey("select on the columns fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ok, ses := runCheck(ctrl, []string{"a", "c"})
+		convey.So(ok, convey.ShouldBeFalse)
+
+		err := getErrorOfTablePrivilegeDenied(ses)
+		moErr, isMoErr := err.(*moerr.Error)
+		convey.So(isMoErr, convey.ShouldBeTrue)
+		convey.So(moErr.Code, convey.ShouldEqual, moerr.ER_COLUMNACCESS_DENIED_ERROR)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "column 'b' in table 'a'")
+
+		ses.GetPrivilege().entries[0].columns = nil
+		err = getErrorOfTablePrivilegeDenied(ses)
+		moErr, isMoErr = err.(*moerr.Error)
+		convey.So(isMoErr, convey.ShouldBeTrue)
+		convey.So(moErr.Code, convey.ShouldEqual, moerr.ER_TABLEACCESS_DENIED_ERROR)
+	})
+}
+
+func Test_doGrantPrivilege(t *testing.T) {
+	convey.Convey("grant and revoke the privileges on the table and the columns", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil)
+		ses.SetDatabaseName("db")
+
+		sql2result := make(map[string]ExecResult)
+		sql2result[getSqlForTableId("db", "t")] = newMrsForRoleIdOfRole([][]interface{}{{100}})
+		sql2result[getSqlForRoleIdOfRole("r1")] = newMrsForRoleIdOfRole([][]interface{}{{10}})
+		sql2result[getSqlForCheckColumnExists(100, "a")] = newMrsForColumnsOfRole([][]interface{}{{"a"}})
+		sql2result[getSqlForCheckColumnExists(100, "x")] = newMrsForColumnsOfRole(nil)
+
+		var executed []string
+		var currentSql string
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+			currentSql = sql
+			executed = append(executed, sql)
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+			return []interface{}{sql2result[currentSql]}
+		}).AnyTimes()
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		gp := &tree.GrantPrivilege{
+			Privileges: []*tree.Privilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_UPDATE, ColumnList: []*tree.UnresolvedName{tree.SetUnresolvedName("a")}},
+			},
+			ObjType: tree.OBJECT_TYPE_TABLE,
+			Level:   &tree.PrivilegeLevel{Level: tree.PRIVILEGE_LEVEL_TYPE_TABLE, TabName: "t"},
+			Roles:   []*tree.Role{{UserName: "r1"}},
+		}
+		err := doGrantPrivilege(ses.GetRequestContext(), ses, gp)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForDeleteRolePriv(10, objectTypeTable, 100, PrivilegeTypeSelect, privilegeLevelDatabaseTable))
+		convey.So(executed, convey.ShouldContain, getSqlForDeleteRoleColumnPriv(10, 100, "a", PrivilegeTypeUpdate))
+		convey.So(executed[len(executed)-1], convey.ShouldEqual, "commit;")
+
+		executed = nil
+		rp := &tree.RevokePrivilege{
+			Privileges: gp.Privileges,
+			ObjType:    tree.OBJECT_TYPE_TABLE,
+			Level:      gp.Level,
+			Users:      []*tree.User{{Username: "r1"}},
+		}
+		err = doRevokePrivilege(ses.GetRequestContext(), ses, rp)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForDeleteRolePriv(10, objectTypeTable, 100, PrivilegeTypeSelect, privilegeLevelDatabaseTable))
+		convey.So(executed, convey.ShouldContain, getSqlForDeleteRoleColumnPriv(10, 100, "a", PrivilegeTypeUpdate))
+
+		//the column does not exist
+		gp.Privileges[1].ColumnList = []*tree.UnresolvedName{tree.SetUnresolvedName("x")}
+		err = doGrantPrivilege(ses.GetRequestContext(), ses, gp)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the delete privilege can not be granted on the columns
+		gp.Privileges[1].Type = tree.PRIVILEGE_TYPE_STATIC_DELETE
+		err = doGrantPrivilege(ses.GetRequestContext(), ses, gp)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
 func newSes(priv *privilege) *Session {
 	pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil, nil, nil)
 	pu.SV.SetDefaultValues()
@@ -3522,6 +3856,53 @@ func newMrsForWithGrantOptionPrivilege(rows [][]interface{}) *MysqlResultSet {
 	return mrs
 }
 
+func newMrsForColumnsOfRole(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("column_name")
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	mrs.AddColumn(col1)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForTablePrivilegesOfRoleSet(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("privilege_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONG)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("datname")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col3 := &MysqlColumn{}
+	col3.SetName("relname")
+	col3.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col4 := &MysqlColumn{}
+	col4.SetName("column_name")
+	col4.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+	mrs.AddColumn(col4)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
 func makeRowsOfWithGrantOptionPrivilege(sql2result map[string]ExecResult, sql string, rows [][]interface{}) {
 	sql2result[sql] = newMrsForWithGrantOptionPrivilege(rows)
 }
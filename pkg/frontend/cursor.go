@@ -0,0 +1,165 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+)
+
+// Cursor keeps the result set of the statement executed with the read only cursor.
+// The batches produced by the pipeline are paged into a temporary file while the
+// statement is executed, the rows are made from them when they are fetched by
+// COM_STMT_FETCH. The rows of the result set made by the frontend are kept in Mrs.
+type Cursor struct {
+	Mrs *MysqlResultSet
+	// the position of the next row of Mrs to be fetched
+	Pos uint64
+	// the result set has been completed
+	Opened bool
+
+	// the batches are added by the pipelines concurrently
+	mu   sync.Mutex
+	file *os.File
+	w    *bufio.Writer
+	r    *bufio.Reader
+	// the batch being fetched, the row of it to be fetched next and the
+	// number of the duplicates of the row fetched
+	bat  *batch.Batch
+	row  int
+	dups int64
+}
+
+// addBatch pages the batch into the temporary file.
+func (c *Cursor) addBatch(bat *batch.Batch) error {
+	data, err := bat.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.file == nil {
+		if c.file, err = os.CreateTemp("", "mo-cursor-*"); err != nil {
+			return err
+		}
+		c.w = bufio.NewWriter(c.file)
+	}
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
+	if _, err = c.w.Write(size[:]); err != nil {
+		return err
+	}
+	_, err = c.w.Write(data)
+	return err
+}
+
+// open completes the result set, the batches are read from the first one.
+func (c *Cursor) open() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Opened = true
+	if c.file == nil {
+		return nil
+	}
+	if err := c.w.Flush(); err != nil {
+		return err
+	}
+	if _, err := c.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	c.w = nil
+	c.r = bufio.NewReader(c.file)
+	return nil
+}
+
+// fetch adds at most n rows to mrs, it returns true if no row is left.
+func (c *Cursor) fetch(ses *Session, mrs *MysqlResultSet, n uint64) (bool, error) {
+	for i := uint64(0); i < n; i++ {
+		if c.Pos < c.Mrs.GetRowCount() {
+			mrs.AddRow(c.Mrs.Data[c.Pos])
+			c.Pos++
+			continue
+		}
+		ok, err := c.next()
+		if err != nil || !ok {
+			return !ok, err
+		}
+		row := make([]interface{}, len(c.bat.Vecs))
+		if err = extractRowFromBatch(ses, c.bat, int64(c.row), row); err != nil {
+			return false, err
+		}
+		mrs.AddRow(row)
+		c.dups++
+	}
+	if c.Pos < c.Mrs.GetRowCount() {
+		return false, nil
+	}
+	ok, err := c.next()
+	return !ok, err
+}
+
+// next moves to the row to be fetched next, it returns false if no row is left.
+func (c *Cursor) next() (bool, error) {
+	for {
+		if c.bat != nil {
+			// a row is fetched as many times as it's duplicated
+			for c.row < c.bat.Length() && c.dups >= c.bat.Zs[c.row] {
+				c.row++
+				c.dups = 0
+			}
+			if c.row < c.bat.Length() {
+				return true, nil
+			}
+			c.bat = nil
+		}
+		if c.r == nil {
+			return false, nil
+		}
+		var size [4]byte
+		if _, err := io.ReadFull(c.r, size[:]); err == io.EOF {
+			c.Close()
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		data := make([]byte, binary.LittleEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(c.r, data); err != nil {
+			return false, err
+		}
+		bat := new(batch.Batch)
+		if err := bat.UnmarshalBinary(data); err != nil {
+			return false, err
+		}
+		c.bat, c.row, c.dups = bat, 0, 0
+	}
+}
+
+// Close removes the temporary file of the cursor.
+func (c *Cursor) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.file != nil {
+		c.file.Close()
+		os.Remove(c.file.Name())
+		c.file = nil
+	}
+	c.w = nil
+	c.r = nil
+}
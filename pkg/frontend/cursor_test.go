@@ -0,0 +1,69 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"os"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestCursorFetch(t *testing.T) {
+	convey.Convey("the rows are made from the paged batches", t, func() {
+		ses := &Session{}
+		cursor := &Cursor{Mrs: &MysqlResultSet{}}
+		cursor.Mrs.AddRow([]interface{}{int64(0)})
+
+		newBatch := func(values []int64, zs []int64) *batch.Batch {
+			bat := batch.New(true, []string{"a"})
+			bat.Vecs[0] = testutil.NewVector(len(values), types.T_int64.ToType(), testutil.NewMheap(), false, values)
+			bat.Zs = zs
+			return bat
+		}
+		convey.So(cursor.addBatch(newBatch([]int64{1, 2, 3}, []int64{1, 0, 2})), convey.ShouldBeNil)
+		convey.So(cursor.addBatch(newBatch([]int64{4}, []int64{1})), convey.ShouldBeNil)
+		name := cursor.file.Name()
+		convey.So(cursor.open(), convey.ShouldBeNil)
+		convey.So(cursor.Opened, convey.ShouldBeTrue)
+
+		fetch := func(n uint64) ([]interface{}, bool) {
+			mrs := &MysqlResultSet{}
+			last, err := cursor.fetch(ses, mrs, n)
+			convey.So(err, convey.ShouldBeNil)
+			var values []interface{}
+			for _, row := range mrs.Data {
+				values = append(values, row[0])
+			}
+			return values, last
+		}
+		values, last := fetch(2)
+		convey.So(values, convey.ShouldResemble, []interface{}{int64(0), int64(1)})
+		convey.So(last, convey.ShouldBeFalse)
+		values, last = fetch(2)
+		convey.So(values, convey.ShouldResemble, []interface{}{int64(3), int64(3)})
+		convey.So(last, convey.ShouldBeFalse)
+		values, last = fetch(2)
+		convey.So(values, convey.ShouldResemble, []interface{}{int64(4)})
+		convey.So(last, convey.ShouldBeTrue)
+
+		// the temporary file is removed once the rows are fetched
+		_, err := os.Stat(name)
+		convey.So(os.IsNotExist(err), convey.ShouldBeTrue)
+	})
+}
@@ -83,15 +83,10 @@ var openNewFile = func(ep *tree.ExportParam, mrs *MysqlResultSet) error {
 	}
 	ep.Writer = bufio.NewWriterSize(ep.File, int(ep.DefaultBufSize))
 	if ep.Header {
-		var header string
-		n := len(mrs.Columns)
-		if n == 0 {
+		header := getExportHeader(ep, mrs)
+		if len(header) == 0 {
 			return nil
 		}
-		for i := 0; i < n-1; i++ {
-			header += mrs.Columns[i].Name() + ep.Fields.Terminated
-		}
-		header += mrs.Columns[n-1].Name() + ep.Lines.TerminatedBy
 		if ep.MaxFileSize != 0 && uint64(len(header)) >= ep.MaxFileSize {
 			return errors.New("the header line size is over the maxFileSize")
 		}
@@ -109,6 +104,19 @@ var openNewFile = func(ep *tree.ExportParam, mrs *MysqlResultSet) error {
 	return nil
 }
 
+func getExportHeader(ep *tree.ExportParam, mrs *MysqlResultSet) string {
+	var header string
+	n := len(mrs.Columns)
+	if n == 0 {
+		return header
+	}
+	for i := 0; i < n-1; i++ {
+		header += mrs.Columns[i].Name() + ep.Fields.Terminated
+	}
+	header += mrs.Columns[n-1].Name() + ep.Lines.TerminatedBy
+	return header
+}
+
 func getExportFilePath(filename string, fileCnt uint) string {
 	if fileCnt == 0 {
 		return filename
@@ -164,6 +172,9 @@ var Write = func(ep *tree.ExportParam, output []byte) (int, error) {
 }
 
 func writeToCSVFile(oq *outputQueue, output []byte) error {
+	if w, ok := oq.exporter.(*csvExportWriter); ok {
+		return w.write(output)
+	}
 	if oq.ep.MaxFileSize != 0 && oq.ep.CurFileSize+uint64(len(output)) > oq.ep.MaxFileSize {
 		if oq.ep.Rows == 0 {
 			return errors.New("the OneLine size is over the maxFileSize")
@@ -232,8 +243,8 @@ func exportDataToCSVFile(oq *outputQueue) error {
 		if isNil, err := oq.mrs.ColumnIsNull(0, i); err != nil {
 			return err
 		} else if isNil {
-			//NULL is output as \N
-			if err = formatOutputString(oq, []byte{'\\', 'N'}, symbol[i], closeby, false); err != nil {
+			//NULL is output as \N by default
+			if err = formatOutputString(oq, []byte(oq.ep.NullValue), symbol[i], closeby, false); err != nil {
 				return err
 			}
 			continue
@@ -354,7 +365,13 @@ func exportDataToCSVFile(oq *outputQueue) error {
 				return err
 			}
 		case defines.MYSQL_TYPE_TIME:
-			return fmt.Errorf("unsupported DATE/DATETIME/TIMESTAMP/MYSQL_TYPE_TIME")
+			value, err := oq.mrs.GetString(0, i)
+			if err != nil {
+				return err
+			}
+			if err = formatOutputString(oq, []byte(value), symbol[i], closeby, flag[i]); err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
 		}
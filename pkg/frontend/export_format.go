@@ -0,0 +1,597 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/common/parquet"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// the number of the rows of a row group in the exported parquet files
+const exportRowGroupSize = 65536
+
+var unixEpochDatetime = types.Datetime(types.UnixToTimestamp(0))
+
+// exportWriter writes the exported rows of the formats other than the plain csv files
+// on the local disk, which are written by exportDataToCSVFile directly.
+type exportWriter interface {
+	// writeRow writes the first row of the result set of the output queue
+	writeRow(oq *outputQueue) error
+	close() error
+}
+
+// needExportWriter is true if the rows are exported in parquet or json lines, or compressed,
+// or exported into a file service.
+func needExportWriter(ep *tree.ExportParam) bool {
+	if ep.FileFormat != "" && ep.FileFormat != tree.CSV {
+		return true
+	}
+	if ep.Compression != "" && ep.Compression != tree.NOCOMPRESS {
+		return true
+	}
+	return isFileServicePath(ep.FilePath)
+}
+
+func isFileServicePath(filePath string) bool {
+	fsPath, err := fileservice.ParsePath(filePath)
+	return err == nil && fsPath.Service != ""
+}
+
+func newExportWriter(ctx context.Context, ep *tree.ExportParam, mrs *MysqlResultSet, fs fileservice.FileService) (exportWriter, error) {
+	switch ep.FileFormat {
+	case tree.PARQUET:
+		return newParquetExportWriter(ctx, ep, mrs, fs)
+	case tree.JSONLINE:
+		if ep.Compression == tree.SNAPPY {
+			return nil, fmt.Errorf("the compression '%s' is not supported by the json lines files", ep.Compression)
+		}
+		w := &jsonLineExportWriter{ctx: ctx, ep: ep, fs: fs}
+		var err error
+		w.file, err = openExportFile(ctx, ep, fs, ep.Compression)
+		return w, err
+	default:
+		if ep.Compression == tree.SNAPPY {
+			return nil, fmt.Errorf("the compression '%s' is not supported by the csv files", ep.Compression)
+		}
+		w := &csvExportWriter{ctx: ctx, ep: ep, mrs: mrs, fs: fs}
+		if err := w.open(); err != nil {
+			return nil, err
+		}
+		return w, nil
+	}
+}
+
+// exportFile is an exported file, which is compressed if required. The file of a file
+// service is spooled on the local disk and written when it is closed, because the file
+// service needs the size of the file.
+type exportFile struct {
+	sink       io.WriteCloser
+	compressor io.WriteCloser
+	writer     *bufio.Writer
+	// the size of the content before compression
+	size uint64
+}
+
+func openExportFile(ctx context.Context, ep *tree.ExportParam, fs fileservice.FileService, compression string) (*exportFile, error) {
+	filePath := getExportFilePath(ep.FilePath, ep.FileCnt)
+	f := &exportFile{}
+	if isFileServicePath(filePath) {
+		if fs == nil {
+			return nil, fmt.Errorf("no file service for the file '%s'", filePath)
+		}
+		etlFS, writePath, err := fileservice.GetForETL(fs, filePath)
+		if err != nil {
+			return nil, err
+		}
+		if f.sink, err = newFileServiceSink(ctx, etlFS, writePath); err != nil {
+			return nil, err
+		}
+	} else {
+		file, err := OpenFile(filePath, os.O_RDWR|os.O_EXCL|os.O_CREATE, 0o666)
+		if err != nil {
+			return nil, err
+		}
+		f.sink = file
+	}
+
+	var w io.Writer = f.sink
+	switch compression {
+	case tree.GZIP:
+		f.compressor = gzip.NewWriter(f.sink)
+		w = f.compressor
+	case tree.ZSTD:
+		encoder, err := zstd.NewWriter(f.sink)
+		if err != nil {
+			f.sink.Close()
+			return nil, err
+		}
+		f.compressor = encoder
+		w = f.compressor
+	}
+	f.writer = bufio.NewWriterSize(w, int(ep.DefaultBufSize))
+	return f, nil
+}
+
+func (f *exportFile) Write(p []byte) (int, error) {
+	n, err := f.writer.Write(p)
+	f.size += uint64(n)
+	return n, err
+}
+
+// full is true if the file should be closed and the rows are written into the next file
+func (f *exportFile) full(ep *tree.ExportParam) bool {
+	return ep.MaxFileSize != 0 && f.size >= ep.MaxFileSize
+}
+
+func (f *exportFile) Close() error {
+	if err := f.writer.Flush(); err != nil {
+		f.sink.Close()
+		return err
+	}
+	if f.compressor != nil {
+		if err := f.compressor.Close(); err != nil {
+			f.sink.Close()
+			return err
+		}
+	}
+	return f.sink.Close()
+}
+
+// fileServiceSink spools the content of a file in a temporary file and streams it into
+// the file service when closed, so the memory used doesn't grow with the size of the file.
+type fileServiceSink struct {
+	ctx  context.Context
+	fs   fileservice.ETLFileService
+	path string
+	tmp  *os.File
+	size int64
+}
+
+func newFileServiceSink(ctx context.Context, fs fileservice.ETLFileService, path string) (*fileServiceSink, error) {
+	tmp, err := os.CreateTemp("", "mo-export-*")
+	if err != nil {
+		return nil, err
+	}
+	return &fileServiceSink{ctx: ctx, fs: fs, path: path, tmp: tmp}, nil
+}
+
+func (s *fileServiceSink) Write(p []byte) (int, error) {
+	n, err := s.tmp.Write(p)
+	s.size += int64(n)
+	return n, err
+}
+
+func (s *fileServiceSink) Close() error {
+	defer func() {
+		s.tmp.Close()
+		os.Remove(s.tmp.Name())
+	}()
+	entry := fileservice.IOEntry{Offset: 0, Size: s.size}
+	if s.size > 0 {
+		if _, err := s.tmp.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		entry.ReaderForWrite = bufio.NewReader(s.tmp)
+	}
+	return s.fs.Write(s.ctx, fileservice.IOVector{
+		FilePath: s.path,
+		Entries:  []fileservice.IOEntry{entry},
+	})
+}
+
+// csvExportWriter writes the csv rows formatted by exportDataToCSVFile
+type csvExportWriter struct {
+	ctx  context.Context
+	ep   *tree.ExportParam
+	mrs  *MysqlResultSet
+	fs   fileservice.FileService
+	file *exportFile
+}
+
+func (w *csvExportWriter) open() error {
+	var err error
+	if w.file, err = openExportFile(w.ctx, w.ep, w.fs, w.ep.Compression); err != nil {
+		return err
+	}
+	if w.ep.Header {
+		if _, err = w.file.Write([]byte(getExportHeader(w.ep, w.mrs))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *csvExportWriter) write(output []byte) error {
+	_, err := w.file.Write(output)
+	return err
+}
+
+func (w *csvExportWriter) writeRow(oq *outputQueue) error {
+	if err := exportDataToCSVFile(oq); err != nil {
+		return err
+	}
+	if !w.file.full(w.ep) {
+		return nil
+	}
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	w.ep.FileCnt++
+	return w.open()
+}
+
+func (w *csvExportWriter) close() error {
+	return w.file.Close()
+}
+
+// jsonLineExportWriter writes a row as a json object in a line
+type jsonLineExportWriter struct {
+	ctx  context.Context
+	ep   *tree.ExportParam
+	fs   fileservice.FileService
+	file *exportFile
+	line []byte
+}
+
+func (w *jsonLineExportWriter) writeRow(oq *outputQueue) error {
+	var err error
+	line := append(w.line[:0], '{')
+	for i := uint64(0); i < oq.mrs.GetColumnCount(); i++ {
+		column, err := oq.mrs.GetColumn(i)
+		if err != nil {
+			return err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return fmt.Errorf("sendColumn need MysqlColumn")
+		}
+		if i > 0 {
+			line = append(line, ',')
+		}
+		if line, err = appendJsonString(line, mysqlColumn.Name()); err != nil {
+			return err
+		}
+		line = append(line, ':')
+		if line, err = appendJsonValue(line, mysqlColumn, oq.mrs.Data[0][i]); err != nil {
+			return err
+		}
+	}
+	line = append(line, '}', '\n')
+	w.line = line
+	if _, err = w.file.Write(line); err != nil {
+		return err
+	}
+	if !w.file.full(w.ep) {
+		return nil
+	}
+	if err = w.file.Close(); err != nil {
+		return err
+	}
+	w.ep.FileCnt++
+	w.file, err = openExportFile(w.ctx, w.ep, w.fs, w.ep.Compression)
+	return err
+}
+
+func (w *jsonLineExportWriter) close() error {
+	return w.file.Close()
+}
+
+func appendJsonString(buf []byte, s string) ([]byte, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, data...), nil
+}
+
+func appendJsonValue(buf []byte, column *MysqlColumn, value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return append(buf, "null"...), nil
+	case bool:
+		return strconv.AppendBool(buf, v), nil
+	case int8:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int16:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int32:
+		return strconv.AppendInt(buf, int64(v), 10), nil
+	case int64:
+		return strconv.AppendInt(buf, v, 10), nil
+	case uint8:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint16:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint32:
+		return strconv.AppendUint(buf, uint64(v), 10), nil
+	case uint64:
+		return strconv.AppendUint(buf, v, 10), nil
+	case float32:
+		return appendJsonFloat(buf, float64(v), 32), nil
+	case float64:
+		return appendJsonFloat(buf, v, 64), nil
+	case []byte:
+		return appendJsonString(buf, string(v))
+	case string:
+		// the decimals are exported as the numbers
+		if column.ColumnType() == defines.MYSQL_TYPE_DECIMAL {
+			return append(buf, v...), nil
+		}
+		return appendJsonString(buf, v)
+	case types.Date:
+		return appendJsonString(buf, v.String())
+	case bytejson.ByteJson:
+		return append(buf, v.String()...), nil
+	}
+	return nil, fmt.Errorf("unsupported column type %d ", column.ColumnType())
+}
+
+// appendJsonFloat appends the float, NaN and infinity are not valid json numbers and exported as null
+func appendJsonFloat(buf []byte, v float64, bitSize int) []byte {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return append(buf, "null"...)
+	}
+	return strconv.AppendFloat(buf, v, 'g', -1, bitSize)
+}
+
+// parquetExportWriter buffers the rows and writes them as a row group of the parquet file
+type parquetExportWriter struct {
+	ctx     context.Context
+	ep      *tree.ExportParam
+	fs      fileservice.FileService
+	codec   parquet.Codec
+	columns []parquet.Column
+	file    *exportFile
+	writer  *parquet.Writer
+	values  []*parquet.Values
+	nulls   [][]bool
+	rows    int
+}
+
+func newParquetExportWriter(ctx context.Context, ep *tree.ExportParam, mrs *MysqlResultSet, fs fileservice.FileService) (*parquetExportWriter, error) {
+	w := &parquetExportWriter{ctx: ctx, ep: ep, fs: fs}
+	switch ep.Compression {
+	case "", tree.SNAPPY:
+		w.codec = parquet.Snappy
+	case tree.NOCOMPRESS:
+		w.codec = parquet.Uncompressed
+	case tree.GZIP:
+		w.codec = parquet.Gzip
+	case tree.ZSTD:
+		w.codec = parquet.Zstd
+	default:
+		return nil, fmt.Errorf("the compression '%s' is not supported by the parquet files", ep.Compression)
+	}
+	for i := uint64(0); i < mrs.GetColumnCount(); i++ {
+		column, err := mrs.GetColumn(i)
+		if err != nil {
+			return nil, err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return nil, fmt.Errorf("sendColumn need MysqlColumn")
+		}
+		col, err := getParquetColumn(mysqlColumn)
+		if err != nil {
+			return nil, err
+		}
+		w.columns = append(w.columns, col)
+	}
+	w.reset()
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// getParquetColumn maps the type of the column to the type of the parquet column,
+// the decimals are exported as strings since the scale is unknown.
+func getParquetColumn(column *MysqlColumn) (parquet.Column, error) {
+	col := parquet.Column{Name: column.Name(), Optional: true}
+	convertedType := func(t parquet.ConvertedType) *parquet.ConvertedType {
+		return &t
+	}
+	unsigned := uint32(column.Flag())&defines.UNSIGNED_FLAG != 0
+	switch column.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		col.Type = parquet.Boolean
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_YEAR:
+		col.Type = parquet.Int32
+	case defines.MYSQL_TYPE_LONG:
+		col.Type = parquet.Int32
+		if unsigned {
+			col.Type = parquet.Int64
+		}
+	case defines.MYSQL_TYPE_LONGLONG:
+		col.Type = parquet.Int64
+		if unsigned {
+			col.ConvertedType = convertedType(parquet.ConvertedUint64)
+		}
+	case defines.MYSQL_TYPE_FLOAT:
+		col.Type = parquet.Float
+	case defines.MYSQL_TYPE_DOUBLE:
+		col.Type = parquet.Double
+	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
+		defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_UUID, defines.MYSQL_TYPE_TIME:
+		col.Type = parquet.ByteArray
+		col.ConvertedType = convertedType(parquet.ConvertedUTF8)
+	case defines.MYSQL_TYPE_BLOB:
+		col.Type = parquet.ByteArray
+	case defines.MYSQL_TYPE_JSON:
+		col.Type = parquet.ByteArray
+		col.ConvertedType = convertedType(parquet.ConvertedJSON)
+	case defines.MYSQL_TYPE_DATE:
+		col.Type = parquet.Int32
+		col.ConvertedType = convertedType(parquet.ConvertedDate)
+	case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+		// the timestamps are exported in the time zone of the session
+		col.Type = parquet.Int64
+		col.LogicalType = &parquet.LogicalType{Timestamp: &parquet.TimestampType{
+			IsAdjustedToUTC: false,
+			Unit:            parquet.TimeUnit{Micros: &parquet.Empty{}},
+		}}
+	default:
+		return col, fmt.Errorf("unsupported column type %d ", column.ColumnType())
+	}
+	return col, nil
+}
+
+func (w *parquetExportWriter) open() error {
+	var err error
+	if w.file, err = openExportFile(w.ctx, w.ep, w.fs, tree.NOCOMPRESS); err != nil {
+		return err
+	}
+	w.writer = parquet.NewWriter(w.file, w.columns, parquet.WithCodec(w.codec))
+	return nil
+}
+
+func (w *parquetExportWriter) reset() {
+	w.rows = 0
+	w.values = make([]*parquet.Values, len(w.columns))
+	w.nulls = make([][]bool, len(w.columns))
+	for i := range w.columns {
+		w.values[i] = &parquet.Values{Type: w.columns[i].Type}
+	}
+}
+
+func (w *parquetExportWriter) writeRow(oq *outputQueue) error {
+	for i := range w.columns {
+		value := oq.mrs.Data[0][i]
+		values := w.values[i]
+		w.nulls[i] = append(w.nulls[i], value == nil)
+		if value == nil {
+			appendParquetZero(values)
+			continue
+		}
+		switch values.Type {
+		case parquet.Boolean:
+			values.Bools = append(values.Bools, value.(bool))
+		case parquet.Int32, parquet.Int64:
+			v, err := w.intValue(oq.mrs, uint64(i), value)
+			if err != nil {
+				return err
+			}
+			if values.Type == parquet.Int32 {
+				values.Int32s = append(values.Int32s, int32(v))
+			} else {
+				values.Int64s = append(values.Int64s, v)
+			}
+		case parquet.Float:
+			values.Floats = append(values.Floats, value.(float32))
+		case parquet.Double:
+			values.Doubles = append(values.Doubles, value.(float64))
+		default:
+			switch v := value.(type) {
+			case []byte:
+				// the bytes are referenced by the batch, which is reused
+				values.Bytes = append(values.Bytes, append([]byte(nil), v...))
+			case string:
+				values.Bytes = append(values.Bytes, []byte(v))
+			case bytejson.ByteJson:
+				values.Bytes = append(values.Bytes, []byte(v.String()))
+			default:
+				return fmt.Errorf("unsupported value %v of column %s", value, w.columns[i].Name)
+			}
+		}
+	}
+	w.rows++
+	if w.rows < exportRowGroupSize {
+		return nil
+	}
+	return w.flush()
+}
+
+func (w *parquetExportWriter) intValue(mrs *MysqlResultSet, i uint64, value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case types.Date:
+		return int64(v - unixEpochDatetime.ToDate()), nil
+	case string:
+		dt, err := types.ParseDatetime(v, 6)
+		if err != nil {
+			return 0, err
+		}
+		return int64(dt - unixEpochDatetime), nil
+	case uint64:
+		return int64(v), nil
+	}
+	return mrs.GetInt64(0, i)
+}
+
+func appendParquetZero(values *parquet.Values) {
+	switch values.Type {
+	case parquet.Boolean:
+		values.Bools = append(values.Bools, false)
+	case parquet.Int32:
+		values.Int32s = append(values.Int32s, 0)
+	case parquet.Int64:
+		values.Int64s = append(values.Int64s, 0)
+	case parquet.Float:
+		values.Floats = append(values.Floats, 0)
+	case parquet.Double:
+		values.Doubles = append(values.Doubles, 0)
+	default:
+		values.Bytes = append(values.Bytes, nil)
+	}
+}
+
+// flush writes the buffered rows as a row group, the next file is opened if the file is full
+func (w *parquetExportWriter) flush() error {
+	if w.rows == 0 {
+		return nil
+	}
+	if err := w.writer.WriteRowGroup(w.values, w.nulls); err != nil {
+		return err
+	}
+	w.reset()
+	if !w.file.full(w.ep) {
+		return nil
+	}
+	if err := w.closeFile(); err != nil {
+		return err
+	}
+	w.ep.FileCnt++
+	return w.open()
+}
+
+func (w *parquetExportWriter) closeFile() error {
+	if err := w.writer.Close(); err != nil {
+		w.file.Close()
+		return err
+	}
+	return w.file.Close()
+}
+
+func (w *parquetExportWriter) close() error {
+	if err := w.flush(); err != nil {
+		w.file.Close()
+		return err
+	}
+	return w.closeFile()
+}
@@ -0,0 +1,193 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/parquet"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func newExportResultSet() *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for _, c := range []struct {
+		name string
+		typ  uint8
+	}{
+		{"id", defines.MYSQL_TYPE_LONGLONG},
+		{"name", defines.MYSQL_TYPE_VARCHAR},
+		{"d", defines.MYSQL_TYPE_DATE},
+		{"dt", defines.MYSQL_TYPE_DATETIME},
+	} {
+		col := new(MysqlColumn)
+		col.SetName(c.name)
+		col.SetColumnType(c.typ)
+		mrs.AddColumn(col)
+	}
+	return mrs
+}
+
+func newExportParam(filePath, format, compression string) *tree.ExportParam {
+	ep := &tree.ExportParam{
+		Outfile:     true,
+		FilePath:    filePath,
+		FileFormat:  format,
+		Compression: compression,
+		Fields:      &tree.Fields{Terminated: ",", EnclosedBy: '"'},
+		Lines:       &tree.Lines{TerminatedBy: "\n"},
+		NullValue:   tree.NULL_VALUE,
+		Header:      true,
+	}
+	ep.DefaultBufSize = 1
+	return ep
+}
+
+// exportRows writes the rows by the export writer of the export parameter
+func exportRows(ep *tree.ExportParam, fs fileservice.FileService, rows [][]interface{}) error {
+	mrs := newExportResultSet()
+	initExportFileParam(ep, mrs)
+	w, err := newExportWriter(context.Background(), ep, mrs, fs)
+	if err != nil {
+		return err
+	}
+	oq := &outputQueue{mrs: mrs, ep: ep, exporter: w}
+	for _, row := range rows {
+		mrs.Data = [][]interface{}{row}
+		if err = w.writeRow(oq); err != nil {
+			return err
+		}
+	}
+	return w.close()
+}
+
+func Test_exportWriter(t *testing.T) {
+	date, _ := types.ParseDate("2020-09-07")
+	rows := [][]interface{}{
+		{int64(1), []byte("a"), date, "2020-09-07 01:02:03"},
+		{int64(2), nil, nil, nil},
+	}
+
+	convey.Convey("export parquet file", t, func() {
+		filePath := path.Join(t.TempDir(), "a.parquet")
+		convey.So(needExportWriter(newExportParam(filePath, tree.PARQUET, "")), convey.ShouldBeTrue)
+		convey.So(exportRows(newExportParam(filePath, tree.PARQUET, tree.ZSTD), nil, rows), convey.ShouldBeNil)
+
+		data, err := os.ReadFile(filePath)
+		convey.So(err, convey.ShouldBeNil)
+		r, err := parquet.NewReader(bytes.NewReader(data), int64(len(data)))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(r.NumRows(), convey.ShouldEqual, 2)
+		convey.So(r.Columns()[1].Name, convey.ShouldEqual, "name")
+		values, nulls, err := r.ReadColumn(0, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(values.Bytes[0], convey.ShouldResemble, []byte("a"))
+		convey.So(nulls, convey.ShouldResemble, []bool{false, true})
+		values, _, err = r.ReadColumn(0, 2)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(values.Int32s[0], convey.ShouldEqual, 18512)
+		values, _, err = r.ReadColumn(0, 3)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(values.Int64s[0], convey.ShouldEqual, 1599440523000000)
+
+		// the file exists
+		convey.So(exportRows(newExportParam(filePath, tree.PARQUET, tree.GZIP), nil, rows), convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("export json lines file", t, func() {
+		filePath := path.Join(t.TempDir(), "a.jl")
+		convey.So(exportRows(newExportParam(filePath, tree.JSONLINE, ""), nil, rows), convey.ShouldBeNil)
+		data, err := os.ReadFile(filePath)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(data), convey.ShouldEqual,
+			`{"id":1,"name":"a","d":"2020-09-07","dt":"2020-09-07 01:02:03"}`+"\n"+
+				`{"id":2,"name":null,"d":null,"dt":null}`+"\n")
+
+		ep := newExportParam(path.Join(t.TempDir(), "a.jl"), tree.JSONLINE, tree.SNAPPY)
+		convey.So(exportRows(ep, nil, rows), convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("export compressed csv files", t, func() {
+		filePath := path.Join(t.TempDir(), "a.csv.gz")
+		ep := newExportParam(filePath, tree.CSV, tree.GZIP)
+		ep.NullValue = "NULL"
+		ep.MaxFileSize = 1
+		convey.So(exportRows(ep, nil, rows), convey.ShouldBeNil)
+		convey.So(ep.FileCnt, convey.ShouldEqual, 2)
+
+		readGzip := func(filePath string) string {
+			f, err := os.Open(filePath)
+			convey.So(err, convey.ShouldBeNil)
+			defer f.Close()
+			r, err := gzip.NewReader(f)
+			convey.So(err, convey.ShouldBeNil)
+			data, err := io.ReadAll(r)
+			convey.So(err, convey.ShouldBeNil)
+			return string(data)
+		}
+		convey.So(readGzip(filePath), convey.ShouldEqual, "id,name,d,dt\n1,\"a\",2020-09-07,2020-09-07 01:02:03\n")
+		convey.So(readGzip(filePath+".1"), convey.ShouldEqual, "id,name,d,dt\n2,NULL,NULL,NULL\n")
+	})
+
+	convey.Convey("export csv file into file service", t, func() {
+		etl, err := fileservice.NewLocalETLFS("etl", t.TempDir())
+		convey.So(err, convey.ShouldBeNil)
+		fs, err := fileservice.NewFileServices("etl", etl)
+		convey.So(err, convey.ShouldBeNil)
+		ep := newExportParam("etl:a.csv", "", "")
+		convey.So(needExportWriter(ep), convey.ShouldBeTrue)
+		convey.So(exportRows(ep, fs, rows[:1]), convey.ShouldBeNil)
+
+		vec := fileservice.IOVector{
+			FilePath: "etl:a.csv",
+			Entries:  []fileservice.IOEntry{{Size: -1}},
+		}
+		convey.So(fs.Read(context.Background(), &vec), convey.ShouldBeNil)
+		convey.So(string(vec.Entries[0].Data), convey.ShouldEqual, "id,name,d,dt\n1,\"a\",2020-09-07,2020-09-07 01:02:03\n")
+
+		// the file is spooled on the local disk until it is closed
+		etlFS, writePath, err := fileservice.GetForETL(fs, "etl:c.csv")
+		convey.So(err, convey.ShouldBeNil)
+		sink, err := newFileServiceSink(context.Background(), etlFS, writePath)
+		convey.So(err, convey.ShouldBeNil)
+		for i := 0; i < 3; i++ {
+			_, err = sink.Write([]byte("abc\n"))
+			convey.So(err, convey.ShouldBeNil)
+		}
+		tmpName := sink.tmp.Name()
+		convey.So(sink.Close(), convey.ShouldBeNil)
+		_, err = os.Stat(tmpName)
+		convey.So(os.IsNotExist(err), convey.ShouldBeTrue)
+		vec = fileservice.IOVector{
+			FilePath: "etl:c.csv",
+			Entries:  []fileservice.IOEntry{{Size: -1}},
+		}
+		convey.So(fs.Read(context.Background(), &vec), convey.ShouldBeNil)
+		convey.So(string(vec.Entries[0].Data), convey.ShouldEqual, "abc\nabc\nabc\n")
+
+		convey.So(exportRows(newExportParam("etl:b.csv", "", ""), nil, rows), convey.ShouldNotBeNil)
+		convey.So(needExportWriter(newExportParam("a.csv", tree.CSV, tree.NOCOMPRESS)), convey.ShouldBeFalse)
+	})
+}
@@ -76,6 +76,10 @@ func (res *internalExecResult) Error() error {
 	return res.err
 }
 
+func (res *internalExecResult) AffectedRows() uint64 {
+	return res.affectedRows
+}
+
 func (res *internalExecResult) ColumnCount() uint64 {
 	return res.resultSet.GetColumnCount()
 }
@@ -120,7 +124,11 @@ func (ie *internalExecutor) Exec(ctx context.Context, sql string, opts ie.Sessio
 	sess := ie.newCmdSession(ctx, opts)
 	ie.executor.PrepareSessionBeforeExecRequest(sess)
 	ie.proto.stashResult = false
-	return ie.executor.doComQuery(ctx, sql)
+	err = ie.executor.doComQuery(ctx, sql)
+	if rbErr := rollbackOpenTxn(sess); err == nil {
+		err = rbErr
+	}
+	return err
 }
 
 func (ie *internalExecutor) Query(ctx context.Context, sql string, opts ie.SessionOverrideOptions) ie.InternalExecResult {
@@ -130,11 +138,24 @@ func (ie *internalExecutor) Query(ctx context.Context, sql string, opts ie.Sessi
 	ie.executor.PrepareSessionBeforeExecRequest(sess)
 	ie.proto.stashResult = true
 	err := ie.executor.doComQuery(ctx, sql)
+	if rbErr := rollbackOpenTxn(sess); err == nil {
+		err = rbErr
+	}
 	res := ie.proto.swapOutResult()
 	res.err = err
 	return res
 }
 
+// rollbackOpenTxn rollbacks the transaction left open by the statements, the session
+// is dropped after the execution, so a transaction which is not committed explicitly
+// or failed in the middle is never committed.
+func rollbackOpenTxn(sess *Session) error {
+	if sess.InMultiStmtTransactionMode() && sess.InActiveTransaction() {
+		return sess.TxnRollback()
+	}
+	return nil
+}
+
 func (ie *internalExecutor) newCmdSession(ctx context.Context, opts ie.SessionOverrideOptions) *Session {
 	sess := NewSession(ie.proto, guest.New(ie.pu.SV.GuestMmuLimitation, ie.pu.HostMmu), ie.pu.Mempool, ie.pu, gSysVariables)
 	sess.SetRequestContext(ctx)
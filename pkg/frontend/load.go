@@ -38,6 +38,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 type LoadResult struct {
@@ -905,6 +908,42 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						}
 						cols[rowIdx] = d
 					}
+				case types.T_enum, types.T_set:
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						values := types.ParseEnumValues(handler.cols[colIdx].Attr.EnumValues)
+						var err error
+						if vec.Typ.Oid == types.T_enum {
+							vector.MustTCols[types.Enum](vec)[rowIdx], err = types.ParseEnum(values, field)
+						} else {
+							vector.MustTCols[types.Set](vec)[rowIdx], err = types.ParseSet(values, field)
+						}
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+						}
+					}
+				case types.T_time:
+					cols := vector.MustTCols[types.Time](vec)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						fs := field
+						d, err := types.ParseTime(fs, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[rowIdx] = d
+					}
 				case types.T_datetime:
 					cols := vector.MustTCols[types.Datetime](vec)
 					if isNullOrEmpty {
@@ -1439,6 +1478,51 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						cols[i] = d
 					}
 				}
+			case types.T_enum, types.T_set:
+				values := types.ParseEnumValues(handler.cols[colIdx].Attr.EnumValues)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						var err error
+						if vec.Typ.Oid == types.T_enum {
+							vector.MustTCols[types.Enum](vec)[i], err = types.ParseEnum(values, field)
+						} else {
+							vector.MustTCols[types.Set](vec)[i], err = types.ParseSet(values, field)
+						}
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+						}
+					}
+				}
+			case types.T_time:
+				cols := vector.MustTCols[types.Time](vec)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						//logutil.Infof("==== > field string [%s] ",fs)
+						d, err := types.ParseTime(field, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[i] = d
+					}
+				}
 			case types.T_datetime:
 				cols := vector.MustTCols[types.Datetime](vec)
 				for i := 0; i < countOfLineArray; i++ {
@@ -1667,7 +1751,7 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					goto handleError
 				}
 			}
-			err = tableHandler.Write(ctx, handler.batchData)
+			err = writeBatchWithIndexes(ctx, handler, dbHandler, tableHandler)
 			if handler.oneTxnPerBatch {
 				if err != nil {
 					goto handleError
@@ -1764,6 +1848,15 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_date:
 						cols := vector.MustTCols[types.Date](vec)
 						vec.Col = cols[:needLen]
+					case types.T_enum:
+						cols := vector.MustTCols[types.Enum](vec)
+						vec.Col = cols[:needLen]
+					case types.T_set:
+						cols := vector.MustTCols[types.Set](vec)
+						vec.Col = cols[:needLen]
+					case types.T_time:
+						cols := vector.MustTCols[types.Time](vec)
+						vec.Col = cols[:needLen]
 					case types.T_datetime:
 						cols := vector.MustTCols[types.Datetime](vec)
 						vec.Col = cols[:needLen]
@@ -1812,7 +1905,7 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 							goto handleError2
 						}
 					}
-					err = tableHandler.Write(ctx, handler.batchData)
+					err = writeBatchWithIndexes(ctx, handler, dbHandler, tableHandler)
 					if handler.oneTxnPerBatch {
 						if err != nil {
 							goto handleError2
@@ -1912,6 +2005,33 @@ func PrintThreadInfo(handler *ParseLineHandler, close *CloseFlag, a time.Duratio
 /*
 LoadLoop reads data from stream, extracts the fields, and saves into the table
 */
+// writeBatchWithIndexes writes the batch into the table and adds its entries to the secondary indexes of the table,
+// the rows are checked by the constraints of the table first.
+func writeBatchWithIndexes(ctx context.Context, handler *WriteBatchHandler, dbHandler engine.Database, tableHandler engine.Relation) error {
+	if dbHandler == nil {
+		dbHandler = handler.dbHandler
+	}
+	constraints, err := colexec.NewTableConstraints(ctx, dbHandler, handler.tableName, tableHandler)
+	if err != nil {
+		return err
+	}
+	indexes, err := colexec.NewTableIndexes(ctx, dbHandler, handler.tableName, tableHandler)
+	if err != nil {
+		return err
+	}
+	var proc *process.Process
+	if indexes != nil || constraints != nil {
+		proc = process.New(ctx, mheap.New(handler.ses.GuestMmu), nil, nil, nil)
+	}
+	if err = constraints.Write(ctx, proc, handler.batchData); err != nil {
+		return err
+	}
+	if err = tableHandler.Write(ctx, handler.batchData); err != nil {
+		return err
+	}
+	return indexes.Write(ctx, proc, handler.batchData)
+}
+
 func (mce *MysqlCmdExecutor) LoadLoop(requestCtx context.Context, load *tree.Load, dbHandler engine.Database, tableHandler engine.Relation, dbName string) (*LoadResult, error) {
 	ses := mce.GetSession()
 
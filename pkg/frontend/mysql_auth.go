@@ -0,0 +1,410 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+// the authentication of the caching_sha2_password.
+// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_caching_sha2_authentication_exchanges.html
+const (
+	// the first byte of the AuthMoreData packet
+	authMoreDataHeader byte = 0x01
+
+	// the client asks the public key of the server
+	cachingSha2RequestPublicKey byte = 0x02
+	// the password has been verified by the cache
+	cachingSha2FastAuthSuccess byte = 0x03
+	// the client needs to send the password
+	cachingSha2PerformFullAuthentication byte = 0x04
+)
+
+// the ssl_type of the user in mo_user
+const (
+	// REQUIRE NONE
+	sslTypeNone = ""
+	// REQUIRE SSL
+	sslTypeAny = "ANY"
+	// REQUIRE X509
+	sslTypeX509 = "X509"
+	// REQUIRE CIPHER, ISSUER or SUBJECT
+	sslTypeSpecified = "SPECIFIED"
+)
+
+// userAuthInfo is the authentication information of the user in mo_user
+type userAuthInfo struct {
+	// authentication_string
+	password []byte
+	plugin   string
+
+	sslType     string
+	sslCipher   string
+	x509Issuer  string
+	x509Subject string
+}
+
+// the authentication string of caching_sha2_password is the same as that of the mysql server.
+// $A$<the count of rounds/1000 in 3 hex digits>$<20 bytes salt><43 bytes digest>
+const (
+	sha256CryptPrefix     = "$A$"
+	sha256CryptRounds     = 5000
+	sha256CryptSaltLength = 20
+	sha256CryptAlphabet   = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// generateCachingSha2Password generates the authentication string of the password
+func generateCachingSha2Password(password string) (string, error) {
+	if len(password) == 0 {
+		return "", nil
+	}
+	salt := make([]byte, sha256CryptSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	for i := range salt {
+		salt[i] = sha256CryptAlphabet[int(salt[i])%len(sha256CryptAlphabet)]
+	}
+	digest := sha256Crypt([]byte(password), salt, sha256CryptRounds)
+	return fmt.Sprintf("%s%03X$%s%s", sha256CryptPrefix, sha256CryptRounds/1000, salt, digest), nil
+}
+
+// checkCachingSha2Password checks the password with the authentication string
+func checkCachingSha2Password(password []byte, authString string) bool {
+	if len(authString) == 0 {
+		return len(password) == 0
+	}
+	var rounds int
+	if !strings.HasPrefix(authString, sha256CryptPrefix) ||
+		len(authString) < len(sha256CryptPrefix)+4+sha256CryptSaltLength {
+		return false
+	}
+	if _, err := fmt.Sscanf(authString[len(sha256CryptPrefix):len(sha256CryptPrefix)+3], "%03X", &rounds); err != nil {
+		return false
+	}
+	pos := len(sha256CryptPrefix) + 4
+	salt := authString[pos : pos+sha256CryptSaltLength]
+	digest := sha256Crypt(password, []byte(salt), rounds*1000)
+	return subtle.ConstantTimeCompare(digest, []byte(authString[pos+sha256CryptSaltLength:])) == 1
+}
+
+// sha256Crypt is the SHA-256 based crypt(3) designed by Ulrich Drepper.
+// see https://www.akkadia.org/drepper/SHA-crypt.txt
+func sha256Crypt(password, salt []byte, rounds int) []byte {
+	b := sha256.New()
+	b.Write(password)
+	b.Write(salt)
+	b.Write(password)
+	digestB := b.Sum(nil)
+
+	a := sha256.New()
+	a.Write(password)
+	a.Write(salt)
+	for i := len(password); i > 0; i -= sha256.Size {
+		if i > sha256.Size {
+			a.Write(digestB)
+		} else {
+			a.Write(digestB[:i])
+		}
+	}
+	for i := len(password); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			a.Write(digestB)
+		} else {
+			a.Write(password)
+		}
+	}
+	digestA := a.Sum(nil)
+
+	dp := sha256.New()
+	for i := 0; i < len(password); i++ {
+		dp.Write(password)
+	}
+	p := repeatBytes(dp.Sum(nil), len(password))
+
+	ds := sha256.New()
+	for i := 0; i < 16+int(digestA[0]); i++ {
+		ds.Write(salt)
+	}
+	s := repeatBytes(ds.Sum(nil), len(salt))
+
+	c := digestA
+	for i := 0; i < rounds; i++ {
+		h := sha256.New()
+		if i&1 != 0 {
+			h.Write(p)
+		} else {
+			h.Write(c)
+		}
+		if i%3 != 0 {
+			h.Write(s)
+		}
+		if i%7 != 0 {
+			h.Write(p)
+		}
+		if i&1 != 0 {
+			h.Write(c)
+		} else {
+			h.Write(p)
+		}
+		c = h.Sum(nil)
+	}
+
+	// the bytes of the digest are encoded in the order
+	order := [][3]int{
+		{0, 10, 20}, {21, 1, 11}, {12, 22, 2}, {3, 13, 23}, {24, 4, 14},
+		{15, 25, 5}, {6, 16, 26}, {27, 7, 17}, {18, 28, 8}, {9, 19, 29},
+	}
+	res := make([]byte, 0, 43)
+	encode := func(w uint32, n int) {
+		for ; n > 0; n-- {
+			res = append(res, sha256CryptAlphabet[w&0x3f])
+			w >>= 6
+		}
+	}
+	for _, o := range order {
+		encode(uint32(c[o[0]])<<16|uint32(c[o[1]])<<8|uint32(c[o[2]]), 4)
+	}
+	encode(uint32(c[31])<<8|uint32(c[30]), 3)
+	return res
+}
+
+// repeatBytes repeats the data until the length is n
+func repeatBytes(data []byte, n int) []byte {
+	res := make([]byte, 0, n)
+	for len(res) < n {
+		if n-len(res) >= len(data) {
+			res = append(res, data...)
+		} else {
+			res = append(res, data[:n-len(res)]...)
+		}
+	}
+	return res
+}
+
+// cachingSha2Cache keeps SHA256(SHA256(password)) of the users authenticated
+// by the full authentication. The next authentication of the user is done with it.
+type cachingSha2Cache struct {
+	sync.Mutex
+	entries map[string]cachingSha2CacheEntry
+}
+
+type cachingSha2CacheEntry struct {
+	// the entry is invalid if the password of the user has been changed
+	authString string
+	digest     [sha256.Size]byte
+}
+
+var globalCachingSha2Cache = &cachingSha2Cache{
+	entries: make(map[string]cachingSha2CacheEntry),
+}
+
+func (c *cachingSha2Cache) put(user string, authString string, password []byte) {
+	stage1 := sha256.Sum256(password)
+	c.Lock()
+	defer c.Unlock()
+	c.entries[user] = cachingSha2CacheEntry{
+		authString: authString,
+		digest:     sha256.Sum256(stage1[:]),
+	}
+}
+
+// check verifies the scramble from the client.
+// return true if the user is in the cache ; true if the scramble is right
+func (c *cachingSha2Cache) check(user string, authString string, salt, scramble []byte) (bool, bool) {
+	c.Lock()
+	entry, ok := c.entries[user]
+	c.Unlock()
+	if !ok || entry.authString != authString {
+		return false, false
+	}
+	if len(scramble) != sha256.Size {
+		return true, false
+	}
+	// scramble = SHA256(password) XOR SHA256(SHA256(SHA256(password)) + salt)
+	h := sha256.New()
+	h.Write(entry.digest[:])
+	h.Write(salt)
+	stage1 := h.Sum(nil)
+	for i := range stage1 {
+		stage1[i] ^= scramble[i]
+	}
+	stage2 := sha256.Sum256(stage1)
+	return true, subtle.ConstantTimeCompare(stage2[:], entry.digest[:]) == 1
+}
+
+// cachingSha2Keys is the RSA key pair to exchange the password
+// on the connection without TLS.
+type cachingSha2Keys struct {
+	once       sync.Once
+	err        error
+	privateKey *rsa.PrivateKey
+	// the public key in PEM format
+	publicKey []byte
+}
+
+var globalCachingSha2Keys = &cachingSha2Keys{}
+
+// get loads the key pair from the files. The key pair is generated if the
+// files are not specified.
+func (k *cachingSha2Keys) get(SV *config.FrontendParameters) (*rsa.PrivateKey, []byte, error) {
+	k.once.Do(func() {
+		if SV != nil && len(SV.CachingSha2PrivateKeyFile) != 0 {
+			k.privateKey, k.publicKey, k.err = loadRSAKeyPair(SV.CachingSha2PrivateKeyFile, SV.CachingSha2PublicKeyFile)
+			return
+		}
+		logutil.Infof("generate the RSA key pair for caching_sha2_password")
+		k.privateKey, k.err = rsa.GenerateKey(rand.Reader, 2048)
+		if k.err != nil {
+			return
+		}
+		var der []byte
+		der, k.err = x509.MarshalPKIXPublicKey(&k.privateKey.PublicKey)
+		if k.err != nil {
+			return
+		}
+		k.publicKey = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	})
+	return k.privateKey, k.publicKey, k.err
+}
+
+func loadRSAKeyPair(privateKeyFile, publicKeyFile string) (*rsa.PrivateKey, []byte, error) {
+	data, err := os.ReadFile(privateKeyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, moerr.NewInternalError("invalid private key in %s", privateKeyFile)
+	}
+	var privateKey *rsa.PrivateKey
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		var ok bool
+		if privateKey, ok = key.(*rsa.PrivateKey); !ok {
+			return nil, nil, moerr.NewInternalError("the private key in %s is not the RSA key", privateKeyFile)
+		}
+	} else if privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+		return nil, nil, err
+	}
+
+	var publicKey []byte
+	if len(publicKeyFile) != 0 {
+		if publicKey, err = os.ReadFile(publicKeyFile); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		publicKey = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	}
+	return privateKey, publicKey, nil
+}
+
+// decryptPassword decrypts the password encrypted with the public key by the client.
+// the password is XORed with the salt before it is encrypted.
+func decryptPassword(privateKey *rsa.PrivateKey, salt, data []byte) ([]byte, error) {
+	plain, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, privateKey, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range plain {
+		plain[i] ^= salt[i%len(salt)]
+	}
+	return bytes.TrimRight(plain, "\x00"), nil
+}
+
+// the short names of the attributes in the distinguished name
+var x509AttributeNames = map[string]string{
+	"2.5.4.3":                    "CN",
+	"2.5.4.5":                    "serialNumber",
+	"2.5.4.6":                    "C",
+	"2.5.4.7":                    "L",
+	"2.5.4.8":                    "ST",
+	"2.5.4.9":                    "street",
+	"2.5.4.10":                   "O",
+	"2.5.4.11":                   "OU",
+	"2.5.4.12":                   "title",
+	"2.5.4.17":                   "postalCode",
+	"1.2.840.113549.1.9.1":       "emailAddress",
+	"0.9.2342.19200300.100.1.25": "DC",
+}
+
+// formatX509Name formats the distinguished name like "/C=SE/ST=Stockholm/O=MySQL/CN=client",
+// which is the format of the ISSUER and the SUBJECT in the REQUIRE clause.
+func formatX509Name(name pkix.Name) string {
+	var sb strings.Builder
+	for _, attr := range name.Names {
+		key, ok := x509AttributeNames[attr.Type.String()]
+		if !ok {
+			key = asn1.ObjectIdentifier(attr.Type).String()
+		}
+		sb.WriteString("/")
+		sb.WriteString(key)
+		sb.WriteString("=")
+		sb.WriteString(fmt.Sprint(attr.Value))
+	}
+	return sb.String()
+}
+
+// checkTlsRequirement checks the connection meets the REQUIRE clause of the user.
+// state is nil if the connection does not use TLS.
+func checkTlsRequirement(user *userAuthInfo, state *tls.ConnectionState) error {
+	if user.sslType == sslTypeNone {
+		return nil
+	}
+	if state == nil {
+		return moerr.NewInternalError("the user requires the connection with TLS")
+	}
+	if user.sslType == sslTypeAny {
+		return nil
+	}
+	if len(state.PeerCertificates) == 0 {
+		return moerr.NewInternalError("the user requires the certificate of the client")
+	}
+	if user.sslType == sslTypeX509 {
+		return nil
+	}
+	cert := state.PeerCertificates[0]
+	if len(user.sslCipher) != 0 && user.sslCipher != tls.CipherSuiteName(state.CipherSuite) {
+		return moerr.NewInternalError("the cipher %s does not match the required cipher", tls.CipherSuiteName(state.CipherSuite))
+	}
+	if len(user.x509Issuer) != 0 && user.x509Issuer != formatX509Name(cert.Issuer) {
+		return moerr.NewInternalError("the issuer %s does not match the required issuer", formatX509Name(cert.Issuer))
+	}
+	if len(user.x509Subject) != 0 && user.x509Subject != formatX509Name(cert.Subject) {
+		return moerr.NewInternalError("the subject %s does not match the required subject", formatX509Name(cert.Subject))
+	}
+	return nil
+}
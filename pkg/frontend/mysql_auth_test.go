@@ -0,0 +1,204 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"testing"
+
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_sha256Crypt(t *testing.T) {
+	convey.Convey("sha256 crypt", t, func() {
+		// the same as "openssl passwd -5 -salt saltstring 'Hello world!'"
+		digest := sha256Crypt([]byte("Hello world!"), []byte("saltstring"), 5000)
+		convey.So(string(digest), convey.ShouldEqual, "5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5")
+
+		authString, err := generateCachingSha2Password("abc")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(authString), convey.ShouldEqual, 70)
+		convey.So(checkCachingSha2Password([]byte("abc"), authString), convey.ShouldBeTrue)
+		convey.So(checkCachingSha2Password([]byte("abd"), authString), convey.ShouldBeFalse)
+		convey.So(checkCachingSha2Password([]byte("abc"), "abc"), convey.ShouldBeFalse)
+
+		authString, err = generateCachingSha2Password("")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(checkCachingSha2Password(nil, authString), convey.ShouldBeTrue)
+	})
+}
+
+// makeCachingSha2Scramble makes the scramble like the client
+func makeCachingSha2Scramble(password, salt []byte) []byte {
+	stage1 := sha256.Sum256(password)
+	stage2 := sha256.Sum256(stage1[:])
+	h := sha256.New()
+	h.Write(stage2[:])
+	h.Write(salt)
+	res := h.Sum(nil)
+	for i := range res {
+		res[i] ^= stage1[i]
+	}
+	return res
+}
+
+func Test_cachingSha2Cache(t *testing.T) {
+	convey.Convey("caching_sha2_password cache", t, func() {
+		cache := &cachingSha2Cache{entries: make(map[string]cachingSha2CacheEntry)}
+		salt := []byte("01234567890123456789")
+
+		cached, _ := cache.check("sys:u1", "auth", salt, makeCachingSha2Scramble([]byte("abc"), salt))
+		convey.So(cached, convey.ShouldBeFalse)
+
+		cache.put("sys:u1", "auth", []byte("abc"))
+		cached, ok := cache.check("sys:u1", "auth", salt, makeCachingSha2Scramble([]byte("abc"), salt))
+		convey.So(cached, convey.ShouldBeTrue)
+		convey.So(ok, convey.ShouldBeTrue)
+
+		cached, ok = cache.check("sys:u1", "auth", salt, makeCachingSha2Scramble([]byte("abd"), salt))
+		convey.So(cached, convey.ShouldBeTrue)
+		convey.So(ok, convey.ShouldBeFalse)
+
+		// the password has been changed
+		cached, _ = cache.check("sys:u1", "auth2", salt, makeCachingSha2Scramble([]byte("abc"), salt))
+		convey.So(cached, convey.ShouldBeFalse)
+	})
+}
+
+func Test_decryptPassword(t *testing.T) {
+	convey.Convey("decrypt password", t, func() {
+		keys := &cachingSha2Keys{}
+		privateKey, publicKey, err := keys.get(nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		block, _ := pem.Decode(publicKey)
+		convey.So(block, convey.ShouldNotBeNil)
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		convey.So(err, convey.ShouldBeNil)
+
+		salt := []byte("01234567890123456789")
+		plain := []byte("abc\x00")
+		for i := range plain {
+			plain[i] ^= salt[i%len(salt)]
+		}
+		data, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, key.(*rsa.PublicKey), plain, nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		password, err := decryptPassword(privateKey, salt, data)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(password), convey.ShouldEqual, "abc")
+	})
+}
+
+func Test_checkTlsRequirement(t *testing.T) {
+	convey.Convey("check the REQUIRE clause", t, func() {
+		name := pkix.Name{
+			Names: []pkix.AttributeTypeAndValue{
+				{Type: asn1.ObjectIdentifier{2, 5, 4, 6}, Value: "SE"},
+				{Type: asn1.ObjectIdentifier{2, 5, 4, 10}, Value: "MO"},
+				{Type: asn1.ObjectIdentifier{2, 5, 4, 3}, Value: "u1"},
+			},
+		}
+		convey.So(formatX509Name(name), convey.ShouldEqual, "/C=SE/O=MO/CN=u1")
+
+		cert := &x509.Certificate{Subject: name, Issuer: pkix.Name{
+			Names: []pkix.AttributeTypeAndValue{{Type: asn1.ObjectIdentifier{2, 5, 4, 3}, Value: "ca"}},
+		}}
+		withCert := &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}, CipherSuite: tls.TLS_AES_128_GCM_SHA256}
+		withoutCert := &tls.ConnectionState{}
+
+		type kase struct {
+			user  userAuthInfo
+			state *tls.ConnectionState
+			ok    bool
+		}
+		kases := []kase{
+			{user: userAuthInfo{sslType: sslTypeNone}, state: nil, ok: true},
+			{user: userAuthInfo{sslType: sslTypeAny}, state: nil, ok: false},
+			{user: userAuthInfo{sslType: sslTypeAny}, state: withoutCert, ok: true},
+			{user: userAuthInfo{sslType: sslTypeX509}, state: withoutCert, ok: false},
+			{user: userAuthInfo{sslType: sslTypeX509}, state: withCert, ok: true},
+			{user: userAuthInfo{sslType: sslTypeSpecified, x509Subject: "/C=SE/O=MO/CN=u1", x509Issuer: "/CN=ca"}, state: withCert, ok: true},
+			{user: userAuthInfo{sslType: sslTypeSpecified, x509Subject: "/CN=u2"}, state: withCert, ok: false},
+			{user: userAuthInfo{sslType: sslTypeSpecified, x509Issuer: "/CN=ca2"}, state: withCert, ok: false},
+			{user: userAuthInfo{sslType: sslTypeSpecified, sslCipher: "TLS_AES_128_GCM_SHA256"}, state: withCert, ok: true},
+			{user: userAuthInfo{sslType: sslTypeSpecified, sslCipher: "TLS_AES_256_GCM_SHA384"}, state: withCert, ok: false},
+		}
+		for _, k := range kases {
+			err := checkTlsRequirement(&k.user, k.state)
+			convey.So(err == nil, convey.ShouldEqual, k.ok)
+		}
+
+		user, err := getTlsRequirement([]tree.TlsOption{&tree.TlsOptionSubject{Subject: "/CN=u1"}, &tree.TlsOptionIssuer{Issuer: "/CN=ca"}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(user.sslType, convey.ShouldEqual, sslTypeSpecified)
+		convey.So(user.x509Subject, convey.ShouldEqual, "/CN=u1")
+		convey.So(user.x509Issuer, convey.ShouldEqual, "/CN=ca")
+
+		_, err = getTlsRequirement([]tree.TlsOption{&tree.TlsOptionSan{San: "u1"}})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_authenticateCachingSha2(t *testing.T) {
+	convey.Convey("caching_sha2_password authentication", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		// the password in plain text on the connection with TLS
+		ioses.EXPECT().Read(gomock.Any()).Return(&Packet{Payload: []byte("abc\x00")}, nil).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		proto.ses = &Session{}
+		proto.username = "caching_sha2_user"
+		proto.tlsState = &tls.ConnectionState{}
+
+		authString, err := generateCachingSha2Password("abc")
+		convey.So(err, convey.ShouldBeNil)
+		user := &userAuthInfo{password: []byte(authString), plugin: AuthCachingSha2Password}
+
+		// full authentication
+		err = proto.authenticateCachingSha2(user, makeCachingSha2Scramble([]byte("abc"), proto.salt))
+		convey.So(err, convey.ShouldBeNil)
+
+		// fast authentication
+		err = proto.authenticateCachingSha2(user, makeCachingSha2Scramble([]byte("abc"), proto.salt))
+		convey.So(err, convey.ShouldBeNil)
+		err = proto.authenticateCachingSha2(user, makeCachingSha2Scramble([]byte("abd"), proto.salt))
+		convey.So(err, convey.ShouldNotBeNil)
+
+		// the empty password
+		err = proto.authenticateCachingSha2(user, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
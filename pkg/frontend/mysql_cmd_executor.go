@@ -198,6 +198,7 @@ type outputQueue struct {
 	rowIdx       uint64
 	length       uint64
 	ep           *tree.ExportParam
+	exporter     exportWriter
 	lineStr      []byte
 	showStmtType ShowStatementType
 
@@ -257,7 +258,12 @@ func (o *outputQueue) flush() error {
 	if o.rowIdx <= 0 {
 		return nil
 	}
-	if o.ep.Outfile {
+	if o.ep.Outfile && o.exporter != nil {
+		if err := o.exporter.writeRow(o); err != nil {
+			logutil.Errorf("export to file error %v \n", err)
+			return err
+		}
+	} else if o.ep.Outfile {
 		if err := exportDataToCSVFile(o); err != nil {
 			logutil.Errorf("export to csv file error %v \n", err)
 			return err
@@ -373,6 +379,14 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 		return nil
 	}
 
+	// the batches are paged into the cursor opened by the statement, the rows
+	// are made when they are fetched
+	if mp, ok := ses.GetMysqlProtocol().(*MysqlProtocolImpl); ok && !ses.ep.Outfile && ses.showStmtType != ShowColumns {
+		if cursor := mp.openingCursor(); cursor != nil {
+			return cursor.addBatch(bat)
+		}
+	}
+
 	goID := GetRoutineId()
 
 	logutil.Infof("goid %d \n", goID)
@@ -405,6 +419,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	allocateOutBufferTime := time.Since(begin3)
 
 	oq := NewOutputQueue(proto, mrs, uint64(countOfResultSet), ses.ep, ses.showStmtType)
+	oq.exporter = ses.exporter
 	oq.reset()
 
 	row2colTime := time.Duration(0)
@@ -486,6 +501,24 @@ func extractRowFromEveryVector(ses *Session, dataSet *batch.Batch, j int64, oq o
 	if err != nil {
 		return nil, err
 	}
+	if err = extractRowFromBatch(ses, dataSet, j, row); err != nil {
+		return nil, err
+	}
+	//duplicate rows
+	for i := int64(0); i < dataSet.Zs[j]-1; i++ {
+		erow, rr := oq.getEmptyRow()
+		if rr != nil {
+			return nil, rr
+		}
+		for l := 0; l < len(dataSet.Vecs); l++ {
+			erow[l] = row[l]
+		}
+	}
+	return row, nil
+}
+
+// extractRowFromBatch gets the j row from the every vector into the row
+func extractRowFromBatch(ses *Session, dataSet *batch.Batch, j int64, row []interface{}) error {
 	var rowIndex = int64(j)
 	for i, vec := range dataSet.Vecs { //col index
 		rowIndexBackup := rowIndex
@@ -497,23 +530,13 @@ func extractRowFromEveryVector(ses *Session, dataSet *batch.Batch, j int64, oq o
 			rowIndex = 0
 		}
 
-		err = extractRowFromVector(ses, vec, i, row, rowIndex)
+		err := extractRowFromVector(ses, vec, i, row, rowIndex)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		rowIndex = rowIndexBackup
 	}
-	//duplicate rows
-	for i := int64(0); i < dataSet.Zs[j]-1; i++ {
-		erow, rr := oq.getEmptyRow()
-		if rr != nil {
-			return nil, rr
-		}
-		for l := 0; l < len(dataSet.Vecs); l++ {
-			erow[l] = row[l]
-		}
-	}
-	return row, nil
+	return nil
 }
 
 // extractRowFromVector gets the rowIndex row from the i vector
@@ -637,6 +660,32 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 				row[i] = vs[rowIndex]
 			}
 		}
+	case types.T_enum:
+		// the plan returns the values of enum and set, the ordinals are only
+		// returned when the value list is unknown
+		if !nulls.Any(vec.Nsp) { //all data in this column are not null
+			vs := vec.Col.([]types.Enum)
+			row[i] = uint16(vs[rowIndex])
+		} else {
+			if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+				row[i] = nil
+			} else {
+				vs := vec.Col.([]types.Enum)
+				row[i] = uint16(vs[rowIndex])
+			}
+		}
+	case types.T_set:
+		if !nulls.Any(vec.Nsp) { //all data in this column are not null
+			vs := vec.Col.([]types.Set)
+			row[i] = uint64(vs[rowIndex])
+		} else {
+			if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+				row[i] = nil
+			} else {
+				vs := vec.Col.([]types.Set)
+				row[i] = uint64(vs[rowIndex])
+			}
+		}
 	case types.T_float32:
 		if !nulls.Any(vec.Nsp) { //all data in this column are not null
 			vs := vec.Col.([]float32)
@@ -683,6 +732,19 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 				row[i] = vs[rowIndex]
 			}
 		}
+	case types.T_time:
+		precision := vec.Typ.Precision
+		if !nulls.Any(vec.Nsp) { //all data in this column are not null
+			vs := vec.Col.([]types.Time)
+			row[i] = vs[rowIndex].String2(precision)
+		} else {
+			if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+				row[i] = nil
+			} else {
+				vs := vec.Col.([]types.Time)
+				row[i] = vs[rowIndex].String2(precision)
+			}
+		}
 	case types.T_datetime:
 		precision := vec.Typ.Precision
 		if !nulls.Any(vec.Nsp) { //all data in this column are not null
@@ -1169,25 +1231,77 @@ func (mce *MysqlCmdExecutor) handleShowVariables(sv *tree.ShowVariables) error {
 	return err
 }
 
+// handleAnalyzeStmt collects the statistics of the table for the planner
+// and saves them in mo_catalog.mo_table_stats.
 func (mce *MysqlCmdExecutor) handleAnalyzeStmt(requestCtx context.Context, stmt *tree.AnalyzeStmt) error {
-	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
-	// IMO, this approach is simple and future-proof
-	// Although this rewriting processing could have been handled in rewrite module,
-	// `handleAnalyzeStmt` can be easily managed by cron jobs in the future
-	ctx := tree.NewFmtCtx(dialect.MYSQL)
-	ctx.WriteString("select ")
-	for i, ident := range stmt.Cols {
-		if i > 0 {
-			ctx.WriteByte(',')
-		}
-		ctx.WriteString("approx_count_distinct(")
-		ctx.WriteString(string(ident))
-		ctx.WriteByte(')')
-	}
-	ctx.WriteString(" from ")
-	stmt.Table.Format(ctx)
-	sql := ctx.String()
-	return mce.doComQuery(requestCtx, sql)
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	dbName := string(stmt.Table.SchemaName)
+	if len(dbName) == 0 {
+		dbName = ses.GetDatabaseName()
+	}
+	if len(dbName) == 0 {
+		return moerr.New(moerr.ER_NO_DB_ERROR)
+	}
+	tableName := string(stmt.Table.ObjectName)
+
+	cols := make([]string, len(stmt.Cols))
+	for i, col := range stmt.Cols {
+		cols[i] = string(col)
+	}
+	histogramCols := make([]string, len(stmt.HistogramCols))
+	for i, col := range stmt.HistogramCols {
+		histogramCols[i] = string(col)
+	}
+	if ses.GetTenantInfo() != nil {
+		yes, err := authenticatePrivilegeOfAnalyze(requestCtx, ses, stmt, dbName, tableName, append(cols, histogramCols...))
+		if err != nil {
+			return err
+		}
+		if !yes {
+			return getErrorOfTablePrivilegeDenied(ses)
+		}
+	}
+
+	db, err := ses.GetStorage().Database(requestCtx, dbName, ses.GetTxnHandler().GetTxn())
+	if err != nil {
+		return err
+	}
+	rel, err := db.Relation(requestCtx, tableName)
+	if err != nil {
+		return err
+	}
+	stats, err := collectTableStats(requestCtx, ses, rel, tableName, cols, histogramCols)
+	if err != nil {
+		return err
+	}
+	if err = saveTableStats(requestCtx, ses, dbName, tableName, rel.GetTableID(requestCtx), stats); err != nil {
+		return err
+	}
+
+	for _, name := range []string{"Table", "Op", "Msg_type", "Msg_text"} {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		ses.Mrs.AddColumn(col)
+	}
+	table := dbName + "." + tableName
+	ses.Mrs.AddRow([]interface{}{table, "analyze", "status", "OK"})
+	for _, name := range histogramCols {
+		if stats.Cols[name].Histogram != nil {
+			ses.Mrs.AddRow([]interface{}{table, "histogram", "status", fmt.Sprintf("Histogram statistics created for column '%s'.", name)})
+		} else {
+			ses.Mrs.AddRow([]interface{}{table, "histogram", "Error", fmt.Sprintf("The column '%s' has an unsupported data type.", name)})
+		}
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err = proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
 }
 
 // Note: for pass the compile quickly. We will remove the comments in the future.
@@ -1262,7 +1376,62 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 		logutil.Errorf("explain Query statement error: %v", err)
 		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("explain Query statement error:%v", err))
 	}
+	return mce.sendExplainResult(buffer)
+}
+
+// handleExplainAnalyze runs the statement and explains its plan with the analyze info
+// collected by running it, the result of the statement is discarded.
+func (mce *MysqlCmdExecutor) handleExplainAnalyze(requestCtx context.Context, stmt *tree.ExplainAnalyze, proc *process.Process) error {
+	ses := mce.GetSession()
+	switch stmt.Statement.(type) {
+	case *tree.Delete:
+		ses.GetTxnCompileCtx().SetQueryType(TXN_DELETE)
+	case *tree.Update:
+		ses.GetTxnCompileCtx().SetQueryType(TXN_UPDATE)
+	default:
+		ses.GetTxnCompileCtx().SetQueryType(TXN_DEFAULT)
+	}
+
+	pn, err := buildPlan(requestCtx, ses, ses.GetTxnCompilerContext(), stmt.Statement)
+	if err != nil {
+		return err
+	}
+	if pn.GetQuery() == nil {
+		logutil.Errorf("The sql query plan does not support explain")
+		return errors.New(errno.SyntaxErrororAccessRuleViolation, "the sql query plan does not support explain.")
+	}
+	// replace @var with their values
+	vp := plan2.NewVisitPlan(pn, []plan2.VisitPlanRule{plan2.NewResetVarRefRule(ses.GetTxnCompilerContext())})
+	if err = vp.Visit(); err != nil {
+		return err
+	}
+
+	proc.UnixTime = time.Now().UnixNano()
+	proc.TxnOperator = ses.GetTxnHandler().GetTxn()
+	proc.TxnChecks = ses.GetTxnHandler().GetTxnChecks()
+	proc.FileService = ses.Pu.FileService
+	c := compile.New(ses.GetDatabaseName(), ses.GetSql(), ses.GetUserName(), requestCtx, ses.GetStorage(), proc, stmt.Statement)
+	discard := func(interface{}, *batch.Batch) error {
+		return nil
+	}
+	if err = c.Compile(pn, ses, discard); err != nil {
+		return err
+	}
+	if err = c.Run(0); err != nil {
+		return err
+	}
+
+	buffer := explain.NewExplainDataBuffer()
+	err = explain.NewExplainQueryImpl(pn.GetQuery()).ExplainAnalyze(buffer, explain.NewExplainDefaultOptions())
+	if err != nil {
+		logutil.Errorf("explain analyze Query statement error: %v", err)
+		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("explain analyze Query statement error:%v", err))
+	}
+	return mce.sendExplainResult(buffer)
+}
 
+// sendExplainResult sends the lines of the explain result as a result set
+func (mce *MysqlCmdExecutor) sendExplainResult(buffer *explain.ExplainDataBuffer) error {
 	session := mce.GetSession()
 	protocol := session.GetMysqlProtocol()
 
@@ -1392,6 +1561,16 @@ func (mce *MysqlCmdExecutor) handleCreateUser(ctx context.Context, cu *tree.Crea
 	return InitUser(ctx, tenant, cu)
 }
 
+// handleGrantPrivilege grants the privileges on the tables or the columns to the roles
+func (mce *MysqlCmdExecutor) handleGrantPrivilege(ctx context.Context, gp *tree.GrantPrivilege) error {
+	return doGrantPrivilege(ctx, mce.GetSession(), gp)
+}
+
+// handleRevokePrivilege revokes the privileges on the tables or the columns from the roles
+func (mce *MysqlCmdExecutor) handleRevokePrivilege(ctx context.Context, rp *tree.RevokePrivilege) error {
+	return doRevokePrivilege(ctx, mce.GetSession(), rp)
+}
+
 // handleCreateRole creates the new role
 func (mce *MysqlCmdExecutor) handleCreateRole(ctx context.Context, cr *tree.CreateRole) error {
 	ses := mce.GetSession()
@@ -1553,6 +1732,7 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 	cwft.proc.UnixTime = time.Now().UnixNano()
 	txnHandler := cwft.ses.GetTxnHandler()
 	cwft.proc.TxnOperator = txnHandler.GetTxn()
+	cwft.proc.TxnChecks = txnHandler.GetTxnChecks()
 	cwft.proc.FileService = cwft.ses.Pu.FileService
 	cwft.compile = compile.New(cwft.ses.GetDatabaseName(), cwft.ses.GetSql(), cwft.ses.GetUserName(), requestCtx, cwft.ses.GetStorage(), cwft.proc, cwft.stmt)
 	err = cwft.compile.Compile(cwft.plan, cwft.ses, fill)
@@ -1588,7 +1768,7 @@ func buildPlan(requestCtx context.Context, ses *Session, ctx plan2.CompilerConte
 				return nil, err
 			}
 			if !yes {
-				return nil, moerr.NewInternalError("do not have privilege to execute the statement")
+				return nil, getErrorOfTablePrivilegeDenied(ses)
 			}
 		}
 		return ret, err
@@ -1618,7 +1798,7 @@ func buildPlan(requestCtx context.Context, ses *Session, ctx plan2.CompilerConte
 				return nil, err
 			}
 			if !yes {
-				return nil, moerr.NewInternalError("do not have privilege to execute the statement")
+				return nil, getErrorOfTablePrivilegeDenied(ses)
 			}
 		}
 	}
@@ -1640,7 +1820,14 @@ var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *
 		}
 		stmts = append(stmts, cmdFieldStmt)
 	} else {
-		stmts, err = parsers.Parse(dialect.MYSQL, sql)
+		if ses.GetDialectType() == dialect.POSTGRESQL {
+			// the postgresql grammar only covers a few statements so far,
+			// the others are parsed by the mysql grammar sharing their syntax.
+			stmts, err = parsers.Parse(dialect.POSTGRESQL, sql)
+		}
+		if stmts == nil {
+			stmts, err = parsers.Parse(dialect.MYSQL, sql)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -1680,6 +1867,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	proto := ses.GetMysqlProtocol()
 	ses.SetSql(sql)
 	ses.ep.Outfile = false
+	ses.exporter = nil
 
 	proc := process.New(
 		requestCtx,
@@ -1732,6 +1920,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	for _, cw := range cws {
 		ses.SetMysqlResultSet(&MysqlResultSet{})
 		stmt := cw.GetAst()
+		ses.SetStatement(stmt)
 		ctx := mce.RecordStatement(requestCtx, ses, proc, cw, beginInstant)
 
 		if ses.GetTenantInfo() != nil {
@@ -1798,6 +1987,10 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			}
 		}
 
+		// the role set is read again for every statement, the roles granted or
+		// revoked by the other sessions apply from the next statement
+		ses.roleSet = nil
+
 		//check transaction states
 		switch stmt.(type) {
 		case *tree.BeginTransaction:
@@ -1899,8 +2092,10 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				goto handleFailed
 			}
 		case *tree.ExplainAnalyze:
-			err = errors.New(errno.FeatureNotSupported, "not support explain analyze statement now")
-			goto handleFailed
+			selfHandle = true
+			if err = mce.handleExplainAnalyze(requestCtx, st, proc); err != nil {
+				goto handleFailed
+			}
 		case *tree.ShowColumns:
 			ses.showStmtType = ShowColumns
 			ses.Data = nil
@@ -1928,6 +2123,50 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if err = mce.handleCreateRole(requestCtx, st); err != nil {
 				goto handleFailed
 			}
+		case *tree.Grant:
+			if st.Typ == tree.GrantTypePrivilege {
+				selfHandle = true
+				if err = mce.handleGrantPrivilege(requestCtx, &st.GrantPrivilege); err != nil {
+					goto handleFailed
+				}
+			}
+		case *tree.Revoke:
+			if st.Typ == tree.RevokeTypePrivilege {
+				selfHandle = true
+				if err = mce.handleRevokePrivilege(requestCtx, &st.RevokePrivilege); err != nil {
+					goto handleFailed
+				}
+			}
+		case *tree.CreatePolicy:
+			selfHandle = true
+			if err = mce.handleCreatePolicy(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropPolicy:
+			selfHandle = true
+			if err = mce.handleDropPolicy(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.CreateTask:
+			selfHandle = true
+			if err = mce.handleCreateTask(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.ShowTasks:
+			selfHandle = true
+			if err = mce.handleShowTasks(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.CancelTask:
+			selfHandle = true
+			if err = mce.handleCancelTask(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.AlterTask:
+			selfHandle = true
+			if err = mce.handleAlterTask(requestCtx, st); err != nil {
+				goto handleFailed
+			}
 		}
 
 		if selfHandle {
@@ -1943,6 +2182,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			goto handleFailed
 		}
 		stmt = cw.GetAst()
+		ses.SetStatement(stmt)
 
 		runner = ret.(ComputationRunner)
 		if !ses.Pu.SV.DisableRecordTimeElapsedOfSqlRequest {
@@ -2006,7 +2246,11 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if ses.ep.Outfile {
 				ses.ep.DefaultBufSize = ses.Pu.SV.ExportDataDefaultFlushSize
 				initExportFileParam(ses.ep, ses.Mrs)
-				if err = openNewFile(ses.ep, ses.Mrs); err != nil {
+				if needExportWriter(ses.ep) {
+					if ses.exporter, err = newExportWriter(requestCtx, ses.ep, ses.Mrs, ses.Pu.FileService); err != nil {
+						goto handleFailed
+					}
+				} else if err = openNewFile(ses.ep, ses.Mrs); err != nil {
 					goto handleFailed
 				}
 			}
@@ -2019,7 +2263,13 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				}
 			}
 
-			if ses.ep.Outfile {
+			if ses.ep.Outfile && ses.exporter != nil {
+				err = ses.exporter.close()
+				ses.exporter = nil
+				if err != nil {
+					goto handleFailed
+				}
+			} else if ses.ep.Outfile {
 				if err = ses.ep.Writer.Flush(); err != nil {
 					goto handleFailed
 				}
@@ -2041,7 +2291,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				goto handleFailed
 			}
 		//just status, no result set
-		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
+		case *tree.CreateTable, *tree.DropTable, *tree.AlterTable, *tree.CreateDatabase, *tree.DropDatabase,
 			*tree.CreateIndex, *tree.DropIndex,
 			*tree.CreateView, *tree.DropView,
 			*tree.Insert, *tree.Update,
@@ -2080,15 +2330,17 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				logStatementStatus(ctx, ses, stmt, fail, txnErr)
 				return txnErr
 			}
+			removeTableStatsOfStatement(requestCtx, ses, stmt)
 			switch stmt.(type) {
-			case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
+			case *tree.CreateTable, *tree.DropTable, *tree.AlterTable, *tree.CreateDatabase, *tree.DropDatabase,
 				*tree.CreateIndex, *tree.DropIndex, *tree.Insert, *tree.Update,
 				*tree.CreateView, *tree.DropView, *tree.Load,
 				*tree.CreateAccount, *tree.DropAccount, *tree.AlterAccount,
 				*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
 				*tree.CreateRole, *tree.DropRole, *tree.Revoke, *tree.Grant,
 				*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword, *tree.Delete,
-				*tree.Deallocate:
+				*tree.Deallocate, *tree.CreateTask, *tree.CancelTask, *tree.AlterTask,
+				*tree.CreatePolicy, *tree.DropPolicy:
 				resp := NewOkResponse(rspLen, 0, 0, 0, int(COM_QUERY), "")
 				if err := mce.GetSession().protocol.SendResponse(resp); err != nil {
 					retErr = fmt.Errorf("routine send response failed. error:%v ", err)
@@ -2159,6 +2411,7 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, req *Reques
 		)*/
 		return resp, nil
 	case COM_QUERY:
+		mce.ses.Cmd = int(COM_QUERY)
 		var query = string(req.GetData().([]byte))
 		mce.addSqlCount(1)
 		logutil.Infof("connection id %d query:%s", ses.GetConnectionID(), SubStringFromBegin(query, int(ses.Pu.SV.LengthOfQueryPrinted)))
@@ -2266,6 +2519,54 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, req *Reques
 		}
 		return resp, nil
 
+	case COM_STMT_SEND_LONG_DATA:
+		// the server does not response the COM_STMT_SEND_LONG_DATA.
+		// the error is reported by the next COM_STMT_EXECUTE.
+		data := req.GetData().([]byte)
+		err := mce.parseStmtSendLongData(data)
+		if err != nil {
+			logutil.Errorf("handle COM_STMT_SEND_LONG_DATA failed. error:%v", err)
+		}
+		return nil, nil
+
+	case COM_STMT_RESET:
+		data := req.GetData().([]byte)
+		preStmt, err := mce.getPrepareStmtFromData(data)
+		if err != nil {
+			return NewGeneralErrorResponse(COM_STMT_RESET, err), nil
+		}
+		preStmt.LongData = nil
+		if preStmt.Cursor != nil {
+			preStmt.Cursor.Close()
+			preStmt.Cursor = nil
+		}
+		return NewGeneralOkResponse(COM_STMT_RESET), nil
+
+	case COM_STMT_FETCH:
+		mce.ses.Cmd = int(COM_STMT_FETCH)
+		data := req.GetData().([]byte)
+		err := mce.doStmtFetch(data)
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_FETCH, err)
+		}
+		return resp, nil
+
+	case COM_RESET_CONNECTION:
+		err := ses.ResetState()
+		if err != nil {
+			return NewGeneralErrorResponse(COM_RESET_CONNECTION, err), nil
+		}
+		return NewGeneralOkResponse(COM_RESET_CONNECTION), nil
+
+	case COM_CHANGE_USER:
+		// the user has been authenticated by the protocol.
+		// the state of the previous user is discarded.
+		err := ses.ResetState()
+		if err != nil {
+			return NewGeneralErrorResponse(COM_CHANGE_USER, err), nil
+		}
+		return NewGeneralOkResponse(COM_CHANGE_USER), nil
+
 	default:
 		err := fmt.Errorf("unsupported command. 0x%x", req.GetCmd())
 		resp = NewGeneralErrorResponse(uint8(req.GetCmd()), err)
@@ -2304,6 +2605,69 @@ func (mce *MysqlCmdExecutor) parseStmtExecute(data []byte) (string, error) {
 	return sql, nil
 }
 
+// getPrepareStmtFromData returns the prepared statement of the id at the beginning of the data
+func (mce *MysqlCmdExecutor) getPrepareStmtFromData(data []byte) (*PrepareStmt, error) {
+	if len(data) < 4 {
+		return nil, moerr.NewError(moerr.INVALID_INPUT, "malform packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	return mce.ses.GetPrepareStmt(getPrepareStmtName(stmtID))
+}
+
+func (mce *MysqlCmdExecutor) parseStmtSendLongData(data []byte) error {
+	// see https://dev.mysql.com/doc/internals/en/com-stmt-send-long-data.html
+	if len(data) < 6 {
+		return moerr.NewError(moerr.INVALID_INPUT, "malform packet")
+	}
+	preStmt, err := mce.getPrepareStmtFromData(data)
+	if err != nil {
+		return err
+	}
+	paramID := binary.LittleEndian.Uint16(data[4:6])
+	if preStmt.LongData == nil {
+		preStmt.LongData = make(map[uint16][]byte)
+	}
+	// the data of the parameter may be sent by several packets
+	preStmt.LongData[paramID] = append(preStmt.LongData[paramID], data[6:]...)
+	return nil
+}
+
+// doStmtFetch sends the rows of the cursor opened by the COM_STMT_EXECUTE
+func (mce *MysqlCmdExecutor) doStmtFetch(data []byte) error {
+	// see https://dev.mysql.com/doc/internals/en/com-stmt-fetch.html
+	if len(data) < 8 {
+		return moerr.NewError(moerr.INVALID_INPUT, "malform packet")
+	}
+	preStmt, err := mce.getPrepareStmtFromData(data)
+	if err != nil {
+		return err
+	}
+	cursor := preStmt.Cursor
+	if cursor == nil || !cursor.Opened {
+		return moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("the cursor of the statement %s does not exist", preStmt.Name))
+	}
+	numRows := uint64(binary.LittleEndian.Uint32(data[4:8]))
+
+	mrs := &MysqlResultSet{
+		Columns:    cursor.Mrs.Columns,
+		Name2Index: cursor.Mrs.Name2Index,
+	}
+	last, err := cursor.fetch(mce.ses, mrs, numRows)
+	if err != nil {
+		return err
+	}
+	proto := mce.ses.GetMysqlProtocol()
+	if err = proto.SendResultSetTextBatchRowSpeedup(mrs, mrs.GetRowCount()); err != nil {
+		return err
+	}
+
+	status := SERVER_STATUS_CURSOR_EXISTS
+	if last {
+		status |= SERVER_STATUS_LAST_ROW_SENT
+	}
+	return proto.sendEOFOrOkPacket(0, status)
+}
+
 func (mce *MysqlCmdExecutor) setCancelRequestFunc(cancelFunc context.CancelFunc) {
 	mce.cancelRequestFunc = cancelFunc
 }
@@ -2325,6 +2689,7 @@ func (mce *MysqlCmdExecutor) Close() {
 		if err != nil {
 			logutil.Errorf("rollback txn in mce.Close failed.error:%v", err)
 		}
+		ses.closeCursors()
 	}
 }
 
@@ -2345,7 +2710,7 @@ func StatementCanBeExecutedInUncommittedTransaction(stmt tree.Statement) bool {
 		//show
 	case *tree.ShowTables, *tree.ShowCreateTable, *tree.ShowCreateDatabase, *tree.ShowDatabases,
 		*tree.ShowVariables, *tree.ShowColumns, *tree.ShowErrors, *tree.ShowIndex, *tree.ShowProcessList,
-		*tree.ShowStatus, *tree.ShowTarget, *tree.ShowWarnings:
+		*tree.ShowStatus, *tree.ShowTarget, *tree.ShowWarnings, *tree.ShowTasks:
 		return true
 		//others
 	case *tree.PrepareStmt, *tree.Execute, *tree.Deallocate,
@@ -2366,7 +2731,7 @@ func StatementCanBeExecutedInUncommittedTransaction(stmt tree.Statement) bool {
 // IsDDL checks the statement is the DDL statement.
 func IsDDL(stmt tree.Statement) bool {
 	switch stmt.(type) {
-	case *tree.CreateTable, *tree.DropTable,
+	case *tree.CreateTable, *tree.DropTable, *tree.AlterTable,
 		*tree.CreateView, *tree.DropView,
 		*tree.CreateDatabase, *tree.DropDatabase,
 		*tree.CreateIndex, *tree.DropIndex:
@@ -2391,7 +2756,9 @@ func IsAdministrativeStatement(stmt tree.Statement) bool {
 		*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
 		*tree.CreateRole, *tree.DropRole,
 		*tree.Revoke, *tree.Grant,
-		*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword:
+		*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword,
+		*tree.CreateTask, *tree.CancelTask, *tree.AlterTask,
+		*tree.CreatePolicy, *tree.DropPolicy:
 		return true
 	case *tree.Use:
 		return st.IsUseRole()
@@ -2458,6 +2825,12 @@ func convertEngineTypeToMysqlType(engineType types.T, col *MysqlColumn) error {
 	case types.T_uint64:
 		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
 		col.SetSigned(false)
+	case types.T_enum:
+		col.SetColumnType(defines.MYSQL_TYPE_SHORT)
+		col.SetSigned(false)
+	case types.T_set:
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		col.SetSigned(false)
 	case types.T_float32:
 		col.SetColumnType(defines.MYSQL_TYPE_FLOAT)
 	case types.T_float64:
@@ -2468,6 +2841,8 @@ func convertEngineTypeToMysqlType(engineType types.T, col *MysqlColumn) error {
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 	case types.T_date:
 		col.SetColumnType(defines.MYSQL_TYPE_DATE)
+	case types.T_time:
+		col.SetColumnType(defines.MYSQL_TYPE_TIME)
 	case types.T_datetime:
 		col.SetColumnType(defines.MYSQL_TYPE_DATETIME)
 	case types.T_timestamp:
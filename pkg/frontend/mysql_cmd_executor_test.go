@@ -986,3 +986,106 @@ func Test_handleLoadData(t *testing.T) {
 		convey.So(err, convey.ShouldNotBeNil)
 	})
 }
+
+func Test_mce_stmt_commands(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("COM_STMT_SEND_LONG_DATA, COM_STMT_RESET, COM_STMT_FETCH, COM_RESET_CONNECTION", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		eng.EXPECT().Hints().Return(engine.Hints{
+			CommitOrRollbackTimeout: time.Second,
+		}).AnyTimes()
+
+		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOperator.EXPECT().Commit(ctx).Return(nil).AnyTimes()
+		txnOperator.EXPECT().Rollback(ctx).Return(nil).AnyTimes()
+
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		txnClient.EXPECT().New().Return(txnOperator, nil).AnyTimes()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng, txnClient)
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		var gSys GlobalSystemVariables
+		InitGlobalSystemVariables(&gSys)
+		ses := NewSession(proto, nil, nil, pu, &gSys)
+		ses.SetRequestContext(ctx)
+		ses.Mrs = &MysqlResultSet{}
+		proto.ses = ses
+		mce := &MysqlCmdExecutor{}
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		stmtName := getPrepareStmtName(1)
+		preStmt := &PrepareStmt{Name: stmtName}
+		err = ses.SetPrepareStmt(stmtName, preStmt)
+		convey.So(err, convey.ShouldBeNil)
+
+		//the long data of the parameter 1 in two packets
+		longData := func(data string) []byte {
+			return append([]byte{1, 0, 0, 0, 1, 0}, data...)
+		}
+		resp, err := mce.ExecRequest(ctx, &Request{cmd: int(COM_STMT_SEND_LONG_DATA), data: longData("abc")})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		_, err = mce.ExecRequest(ctx, &Request{cmd: int(COM_STMT_SEND_LONG_DATA), data: longData("def")})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(preStmt.LongData[1]), convey.ShouldEqual, "abcdef")
+
+		//fetch without the cursor
+		fetch := []byte{1, 0, 0, 0, 2, 0, 0, 0}
+		resp, err = mce.ExecRequest(ctx, &Request{cmd: int(COM_STMT_FETCH), data: fetch})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldNotBeNil)
+		convey.So(resp.category, convey.ShouldEqual, ErrorResponse)
+
+		//fetch the rows of the cursor
+		col := &MysqlColumn{}
+		col.SetName("a")
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		cursor := &Cursor{Mrs: &MysqlResultSet{}, Opened: true}
+		cursor.Mrs.AddColumn(col)
+		for i := 0; i < 3; i++ {
+			cursor.Mrs.AddRow([]interface{}{int64(i)})
+		}
+		preStmt.Cursor = cursor
+		resp, err = mce.ExecRequest(ctx, &Request{cmd: int(COM_STMT_FETCH), data: fetch})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		convey.So(cursor.Pos, convey.ShouldEqual, 2)
+		resp, err = mce.ExecRequest(ctx, &Request{cmd: int(COM_STMT_FETCH), data: fetch})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		convey.So(cursor.Pos, convey.ShouldEqual, 3)
+
+		resp, err = mce.ExecRequest(ctx, &Request{cmd: int(COM_STMT_RESET), data: []byte{1, 0, 0, 0}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, OkResponse)
+		convey.So(preStmt.LongData, convey.ShouldBeNil)
+		convey.So(preStmt.Cursor, convey.ShouldBeNil)
+
+		resp, err = mce.ExecRequest(ctx, &Request{cmd: int(COM_STMT_RESET), data: []byte{2, 0, 0, 0}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, ErrorResponse)
+
+		err = ses.SetUserDefinedVar("x", 1)
+		convey.So(err, convey.ShouldBeNil)
+		resp, err = mce.ExecRequest(ctx, &Request{cmd: int(COM_RESET_CONNECTION)})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, OkResponse)
+		_, err = ses.GetPrepareStmt(stmtName)
+		convey.So(err, convey.ShouldNotBeNil)
+		_, val, err := ses.GetUserDefinedVar("x")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(val, convey.ShouldBeNil)
+		convey.So(ses.OptionBitsIsSet(OPTION_AUTOCOMMIT), convey.ShouldBeTrue)
+	})
+}
@@ -17,6 +17,7 @@ package frontend
 import (
 	"bytes"
 	"crypto/sha1"
+	"crypto/tls"
 	"encoding/binary"
 	"fmt"
 	"math"
@@ -129,6 +130,8 @@ const (
 
 	AuthNativePassword string = "mysql_native_password"
 
+	AuthCachingSha2Password string = "caching_sha2_password"
+
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
 	HeaderOffset              int = 0
@@ -296,6 +299,12 @@ type MysqlProtocolImpl struct {
 
 	//skip checking the password of the user
 	skipCheckUser bool
+
+	//the cursor opened by the executing statement
+	cursor *Cursor
+
+	//the state of TLS. it is nil if the connection does not use TLS
+	tlsState *tls.ConnectionState
 }
 
 func (mp *MysqlProtocolImpl) SetSkipCheckUser(b bool) {
@@ -456,12 +465,27 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(stmt *PrepareStmt, data []byte, po
 		err = moerr.NewError(moerr.INVALID_INPUT, "malform packet")
 		return
 	}
-	if flag != 0 {
-		// TODO only support CURSOR_TYPE_NO_CURSOR flag now
+	mp.cursor = nil
+	if stmt.Cursor != nil {
+		stmt.Cursor.Close()
+	}
+	switch flag {
+	case CURSOR_TYPE_NO_CURSOR:
+		stmt.Cursor = nil
+	case CURSOR_TYPE_READ_ONLY:
+		// the result set is kept in the cursor, and sent by COM_STMT_FETCH
+		mp.cursor = &Cursor{Mrs: &MysqlResultSet{}}
+		stmt.Cursor = mp.cursor
+	default:
 		err = moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("unsupported flag %d", flag))
 		return
 	}
 
+	// the long data are only used by this execution
+	defer func() {
+		stmt.LongData = nil
+	}()
+
 	// skip iteration-count, always 1
 	pos += 4
 
@@ -499,9 +523,6 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(stmt *PrepareStmt, data []byte, po
 			varName := getPrepareStmtSessionVarName(i)
 			names[i] = varName
 
-			// TODO :if params had received via COM_STMT_SEND_LONG_DATA, use them directly.
-			// ref https://dev.mysql.com/doc/internals/en/com-stmt-send-long-data.html
-
 			if nullBitmaps[i>>3]&(1<<(uint(i)%8)) > 0 {
 				vars[i] = nil
 				continue
@@ -514,6 +535,18 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(stmt *PrepareStmt, data []byte, po
 			tp := stmt.ParamTypes[i<<1]
 			isUnsigned := (stmt.ParamTypes[(i<<1)+1] & 0x80) > 0
 
+			// the value had been received via COM_STMT_SEND_LONG_DATA, use it directly.
+			// ref https://dev.mysql.com/doc/internals/en/com-stmt-send-long-data.html
+			if longData, ok := stmt.LongData[uint16(i)]; ok {
+				switch tp {
+				case defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB:
+					vars[i] = longData
+				default:
+					vars[i] = string(longData)
+				}
+				continue
+			}
+
 			switch tp {
 			case defines.MYSQL_TYPE_NULL:
 				vars[i] = nil
@@ -979,20 +1012,40 @@ func (mp *MysqlProtocolImpl) checkPassword(password, salt, auth []byte) bool {
 }
 
 // the server authenticate that the client can connect and use the database
-func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte) error {
-	//TODO:check the user and the connection
-	//TODO:get the user's password
+// the authResponse is generated by the authentication method plugin of the client
+func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte, plugin string) error {
 	var psw []byte
 	var err error
 
 	if !mp.GetSkipCheckUser() {
-		psw, err = mp.ses.AuthenticateUser(mp.username)
+		var user *userAuthInfo
+		user, err = mp.ses.AuthenticateUser(mp.username)
 		if err != nil {
 			return err
 		}
 
+		if err = checkTlsRequirement(user, mp.tlsState); err != nil {
+			return err
+		}
+
+		//the client is asked to switch to the authentication method of the user
+		if user.plugin == AuthCachingSha2Password {
+			if plugin != AuthCachingSha2Password {
+				if authResponse, err = mp.negotiateAuthenticationMethod(AuthCachingSha2Password); err != nil {
+					return fmt.Errorf("negotiate authentication method failed. error:%v", err)
+				}
+			}
+			return mp.authenticateCachingSha2(user, authResponse)
+		}
+
+		if plugin != AuthNativePassword {
+			if authResponse, err = mp.negotiateAuthenticationMethod(AuthNativePassword); err != nil {
+				return fmt.Errorf("negotiate authentication method failed. error:%v", err)
+			}
+		}
+
 		//TO Check password
-		if mp.checkPassword(psw, mp.salt, authResponse) {
+		if mp.checkPassword(user.password, mp.salt, authResponse) {
 			logutil.Infof("check password succeeded\n")
 		} else {
 			return fmt.Errorf("check password failed")
@@ -1019,6 +1072,71 @@ func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte) error {
 	return nil
 }
 
+// authenticateCachingSha2 authenticates the user with caching_sha2_password.
+// The scramble is verified with the cache first. If the user is not in the cache,
+// the client sends the password on the connection with TLS or encrypts it with the
+// RSA public key of the server.
+func (mp *MysqlProtocolImpl) authenticateCachingSha2(user *userAuthInfo, authResponse []byte) error {
+	authString := string(user.password)
+	//the empty password
+	if len(authResponse) == 0 {
+		if len(authString) == 0 {
+			return nil
+		}
+		return fmt.Errorf("check password failed")
+	}
+
+	key := mp.username
+	if tenant := mp.ses.GetTenantInfo(); tenant != nil {
+		key = tenant.GetTenant() + ":" + tenant.GetUser()
+	}
+
+	//fast authentication
+	if cached, ok := globalCachingSha2Cache.check(key, authString, mp.salt, authResponse); cached {
+		if !ok {
+			return fmt.Errorf("check password failed")
+		}
+		return mp.writePackets(mp.makeAuthMoreDataPayload([]byte{cachingSha2FastAuthSuccess}))
+	}
+
+	//full authentication
+	if err := mp.writePackets(mp.makeAuthMoreDataPayload([]byte{cachingSha2PerformFullAuthentication})); err != nil {
+		return err
+	}
+	data, err := mp.readAuthPacket()
+	if err != nil {
+		return err
+	}
+
+	var password []byte
+	if mp.tlsState != nil {
+		//the password in plain text ends with 0
+		password = bytes.TrimRight(data, "\x00")
+	} else {
+		privateKey, publicKey, err := globalCachingSha2Keys.get(mp.SV)
+		if err != nil {
+			return err
+		}
+		if len(data) == 1 && data[0] == cachingSha2RequestPublicKey {
+			if err = mp.writePackets(mp.makeAuthMoreDataPayload(publicKey)); err != nil {
+				return err
+			}
+			if data, err = mp.readAuthPacket(); err != nil {
+				return err
+			}
+		}
+		if password, err = decryptPassword(privateKey, mp.salt, data); err != nil {
+			return fmt.Errorf("decrypt password failed. error:%v", err)
+		}
+	}
+
+	if !checkCachingSha2Password(password, authString) {
+		return fmt.Errorf("check password failed")
+	}
+	globalCachingSha2Cache.put(key, authString, password)
+	return nil
+}
+
 func (mp *MysqlProtocolImpl) setSequenceID(value uint8) {
 	mp.sequenceId = value
 }
@@ -1029,6 +1147,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) (bool, error) {
 	}
 
 	var authResponse []byte
+	var plugin = AuthNativePassword
 	if capabilities, _, ok := mp.io.ReadUint16(payload, 0); !ok {
 		return false, fmt.Errorf("read capabilities from response packet failed")
 	} else if uint32(capabilities)&CLIENT_PROTOCOL_41 != 0 {
@@ -1045,6 +1164,9 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) (bool, error) {
 		}
 
 		authResponse = resp41.authResponse
+		if resp41.capabilities&CLIENT_PLUGIN_AUTH != 0 {
+			plugin = resp41.clientPluginName
+		}
 		mp.capability = mp.capability & resp41.capabilities
 
 		if nameAndCharset, ok := collationID2CharsetAndName[int(resp41.collationID)]; !ok {
@@ -1082,7 +1204,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) (bool, error) {
 		mp.database = resp320.database
 	}
 
-	if err := mp.authenticateUser(authResponse); err != nil {
+	if err := mp.authenticateUser(authResponse, plugin); err != nil {
 		fail := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
 		_ = mp.sendErrPacket(fail.ErrorCode, fail.SqlStates[0], "Access denied for user")
 		return false, err
@@ -1095,6 +1217,91 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) (bool, error) {
 	return false, nil
 }
 
+// handleChangeUser authenticates the user in the COM_CHANGE_USER and switches the
+// connection to the user and the database. The state of the session is reset by
+// the executor after that.
+// the data does not contain the command byte.
+func (mp *MysqlProtocolImpl) handleChangeUser(data []byte) error {
+	var pos = 0
+	var ok bool
+	var username, database string
+	var authResponse []byte
+	var plugin = AuthNativePassword
+
+	//string[NUL]    user
+	username, pos, ok = mp.readStringNUL(data, pos)
+	if !ok {
+		return fmt.Errorf("get username failed")
+	}
+
+	if mp.capability&CLIENT_SECURE_CONNECTION != 0 {
+		//int<1>           length of auth-response
+		//string[$len]     auth-response
+		var l uint8
+		l, pos, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			return fmt.Errorf("get length of auth-response failed")
+		}
+		authResponse, pos, ok = mp.readCountOfBytes(data, pos, int(l))
+		if !ok {
+			return fmt.Errorf("get auth-response failed")
+		}
+	} else {
+		//string[NUL]    auth-response
+		var auth string
+		auth, pos, ok = mp.readStringNUL(data, pos)
+		if !ok {
+			return fmt.Errorf("get auth-response failed")
+		}
+		authResponse = []byte(auth)
+	}
+
+	//string[NUL]    schema-name
+	database, pos, ok = mp.readStringNUL(data, pos)
+	if !ok {
+		return fmt.Errorf("get database failed")
+	}
+
+	if pos < len(data) {
+		//int<2>     character-set
+		var collationID uint16
+		collationID, pos, ok = mp.io.ReadUint16(data, pos)
+		if !ok {
+			return fmt.Errorf("get character set failed")
+		}
+		if nameAndCharset, ok := collationID2CharsetAndName[int(collationID)]; ok {
+			mp.collationID = int(collationID)
+			mp.collationName = nameAndCharset.collationName
+			mp.charset = nameAndCharset.charset
+		}
+
+		if mp.capability&CLIENT_PLUGIN_AUTH != 0 {
+			//string[NUL]    auth plugin name
+			clientPluginName, _, ok := mp.readStringNUL(data, pos)
+			if !ok {
+				return fmt.Errorf("get auth plugin name failed")
+			}
+			if clientPluginName != "" {
+				plugin = clientPluginName
+			}
+		}
+	}
+
+	//drop client connection attributes
+	mp.username = username
+	mp.database = database
+	if err := mp.authenticateUser(authResponse, plugin); err != nil {
+		fail := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
+		_ = mp.sendErrPacket(fail.ErrorCode, fail.SqlStates[0], "Access denied for user")
+		return err
+	}
+
+	if mp.ses != nil {
+		mp.ses.SetDatabaseName(database)
+	}
+	return nil
+}
+
 // the server makes a handshake v10 packet
 // return handshake packet
 func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
@@ -1256,15 +1463,7 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(data []byte) (bool, resp
 		if !ok {
 			return false, info, fmt.Errorf("get auth plugin name failed")
 		}
-
-		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
-			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(); err != nil {
-				return false, info, fmt.Errorf("negotiate authentication method failed. error:%v", err)
-			}
-			info.clientPluginName = AuthNativePassword
-		}
+		//the authentication method is switched to that of the user later
 	}
 
 	//drop client connection attributes
@@ -1405,17 +1604,30 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 	return data[:pos]
 }
 
+// the server makes a AuthMoreData packet in the authentication
+func (mp *MysqlProtocolImpl) makeAuthMoreDataPayload(moreData []byte) []byte {
+	data := make([]byte, HeaderOffset+1+len(moreData))
+	pos := HeaderOffset
+	pos = mp.io.WriteUint8(data, pos, authMoreDataHeader)
+	pos = mp.writeCountOfBytes(data, pos, moreData)
+	return data[:pos]
+}
+
 // the server can send AuthSwitchRequest to ask client to use designated authentication method,
 // if both server and client support CLIENT_PLUGIN_AUTH capability.
 // return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod() ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(authMethodName string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
 	}
+	return mp.readAuthPacket()
+}
 
+// readAuthPacket reads the response of the client in the authentication
+func (mp *MysqlProtocolImpl) readAuthPacket() ([]byte, error) {
 	read, err := mp.tcpConn.Read(goetty.ReadOptions{})
 	if err != nil {
 		return nil, err
@@ -1521,7 +1733,21 @@ func (mp *MysqlProtocolImpl) sendEOFPacket(warnings, status uint16) error {
 	return mp.writePackets(data)
 }
 
+// openingCursor returns the cursor opened by the executing COM_STMT_EXECUTE.
+// The result set is kept in the cursor instead of being sent.
+func (mp *MysqlProtocolImpl) openingCursor() *Cursor {
+	if mp.cursor == nil || mp.ses == nil || mp.ses.Cmd != int(COM_STMT_EXECUTE) {
+		return nil
+	}
+	return mp.cursor
+}
+
 func (mp *MysqlProtocolImpl) SendEOFPacketIf(warnings, status uint16) error {
+	//the EOF after the column definitions is not sent when the cursor is opened.
+	//the EOF or OK packet sent later marks the end of the column definitions.
+	if mp.openingCursor() != nil {
+		return nil
+	}
 	//If the CLIENT_DEPRECATE_EOF client capabilities flag is not set, EOF_Packet
 	if mp.capability&CLIENT_DEPRECATE_EOF == 0 {
 		return mp.sendEOFPacket(warnings, status)
@@ -1532,6 +1758,15 @@ func (mp *MysqlProtocolImpl) SendEOFPacketIf(warnings, status uint16) error {
 // the OK or EOF packet
 // thread safe
 func (mp *MysqlProtocolImpl) sendEOFOrOkPacket(warnings, status uint16) error {
+	if cursor := mp.openingCursor(); cursor != nil {
+		cursor.Mrs.Columns = mp.ses.Mrs.Columns
+		cursor.Mrs.Name2Index = mp.ses.Mrs.Name2Index
+		mp.cursor = nil
+		if err := cursor.open(); err != nil {
+			return err
+		}
+		status |= SERVER_STATUS_CURSOR_EXISTS
+	}
 	//If the CLIENT_DEPRECATE_EOF client capabilities flag is set, OK_Packet; else EOF_Packet.
 	if mp.capability&CLIENT_DEPRECATE_EOF != 0 {
 		return mp.sendOKPacket(0, 0, status, 0, "")
@@ -1754,6 +1989,12 @@ func (mp *MysqlProtocolImpl) makeResultSetBinaryRow(data []byte, mrs *MysqlResul
 			} else {
 				data = mp.appendStringLenEnc(data, value)
 			}
+		case defines.MYSQL_TYPE_TIME:
+			if value, err := mrs.GetString(rowIdx, i); err != nil {
+				return nil, err
+			} else {
+				data = mp.appendStringLenEnc(data, value)
+			}
 		case defines.MYSQL_TYPE_DATETIME:
 			if value, err := mrs.GetString(rowIdx, i); err != nil {
 				return nil, err
@@ -1884,7 +2125,11 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 				data = mp.appendStringLenEnc(data, value)
 			}
 		case defines.MYSQL_TYPE_TIME:
-			return nil, fmt.Errorf("unsupported MYSQL_TYPE_TIME")
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendStringLenEnc(data, value)
+			}
 
 		default:
 			return nil, fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
@@ -1921,9 +2166,26 @@ func (mp *MysqlProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSe
 	defer mp.GetLock().Unlock()
 	var err error = nil
 
+	//the rows made by the frontend are kept in the cursor until they are fetched,
+	//the batches of the pipeline are paged by getDataFromPipeline instead.
+	//they are copied since the rows and the bytes in them are reused.
+	if cursor := mp.openingCursor(); cursor != nil {
+		for i := uint64(0); i < cnt; i++ {
+			row := make([]interface{}, len(mrs.Data[i]))
+			for j, value := range mrs.Data[i] {
+				if b, ok := value.([]byte); ok {
+					value = append([]byte(nil), b...)
+				}
+				row[j] = value
+			}
+			cursor.Mrs.AddRow(row)
+		}
+		return nil
+	}
+
 	binary := false
-	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE use binaryRow
-	if mp.ses.Cmd == int(COM_STMT_EXECUTE) {
+	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE and COM_STMT_FETCH use binaryRow
+	if mp.ses.Cmd == int(COM_STMT_EXECUTE) || mp.ses.Cmd == int(COM_STMT_FETCH) {
 		binary = true
 	}
 
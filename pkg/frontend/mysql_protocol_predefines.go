@@ -376,6 +376,14 @@ const (
 	COM_RESET_CONNECTION    uint8 = 0x1f
 )
 
+// cursor type in COM_STMT_EXECUTE
+const (
+	CURSOR_TYPE_NO_CURSOR  uint8 = 0x00
+	CURSOR_TYPE_READ_ONLY  uint8 = 0x01
+	CURSOR_TYPE_FOR_UPDATE uint8 = 0x02
+	CURSOR_TYPE_SCROLLABLE uint8 = 0x04
+)
+
 // reference to sql/query_options.h in mysql server 8.0.23
 const (
 	OPTION_AUTOCOMMIT        uint32 = 1 << 8
@@ -1915,6 +1915,109 @@ func TestParseExecuteData(t *testing.T) {
 		convey.ShouldEqual(vars[0], 10)
 	})
 
+	convey.Convey("parseExecuteData with long data and cursor", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		st := tree.NewPrepareString(tree.Identifier(getPrepareStmtName(1)), "select ?, 1")
+		stmts, err := mysql.Parse(st.Sql)
+		if err != nil {
+			t.Error(err)
+		}
+		preparePlan, err := buildPlan(context.TODO(), nil, nil, st)
+		if err != nil {
+			t.Error(err)
+		}
+		prepareStmt := &PrepareStmt{
+			Name:        preparePlan.GetDcl().GetPrepare().GetName(),
+			PreparePlan: preparePlan,
+			PrepareStmt: stmts[0],
+			LongData:    map[uint16][]byte{0: []byte("long data")},
+		}
+
+		var testData []byte
+		testData = append(testData, CURSOR_TYPE_READ_ONLY)         //flag
+		testData = append(testData, 0, 0, 0, 0)                    // skip iteration-count
+		testData = append(testData, 0)                             //nullBitmap
+		testData = append(testData, 1)                             // new param bound flag
+		testData = append(testData, defines.MYSQL_TYPE_VAR_STRING) // type
+		testData = append(testData, 0)                             //is unsigned
+
+		names, vars, err := proto.ParseExecuteData(prepareStmt, testData, 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(names), convey.ShouldEqual, 1)
+		convey.So(vars[0], convey.ShouldEqual, "long data")
+		convey.So(prepareStmt.LongData, convey.ShouldBeNil)
+		convey.So(prepareStmt.Cursor, convey.ShouldNotBeNil)
+		convey.So(proto.cursor, convey.ShouldEqual, prepareStmt.Cursor)
+
+		testData[0] = CURSOR_TYPE_FOR_UPDATE
+		_, _, err = proto.ParseExecuteData(prepareStmt, testData, 0)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func TestOpenCursor(t *testing.T) {
+	convey.Convey("the result set is kept in the cursor", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		written := 0
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, opts goetty.WriteOptions) error {
+			written++
+			return nil
+		}).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		ses := &Session{Cmd: int(COM_STMT_EXECUTE), Mrs: &MysqlResultSet{}}
+		proto.ses = ses
+		cursor := &Cursor{Mrs: &MysqlResultSet{}}
+		proto.cursor = cursor
+
+		col := &MysqlColumn{}
+		col.SetName("a")
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		ses.Mrs.AddColumn(col)
+
+		err = proto.SendEOFPacketIf(0, 0)
+		convey.So(err, convey.ShouldBeNil)
+
+		value := []byte("abc")
+		mrs := &MysqlResultSet{Columns: ses.Mrs.Columns}
+		mrs.AddRow([]interface{}{value})
+		err = proto.SendResultSetTextBatchRowSpeedup(mrs, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(written, convey.ShouldEqual, 0)
+
+		// the row is copied
+		value[0] = 'x'
+		convey.So(cursor.Mrs.GetRowCount(), convey.ShouldEqual, 1)
+		convey.So(cursor.Mrs.Data[0][0], convey.ShouldResemble, []byte("abc"))
+
+		err = proto.sendEOFOrOkPacket(0, 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(cursor.Opened, convey.ShouldBeTrue)
+		convey.So(cursor.Mrs.GetColumnCount(), convey.ShouldEqual, 1)
+		convey.So(proto.cursor, convey.ShouldBeNil)
+	})
 }
 
 func Test_resultset(t *testing.T) {
@@ -2388,3 +2491,49 @@ func Test_handleHandshake_Recover(t *testing.T) {
 		}
 	})
 }
+
+func Test_handleChangeUser(t *testing.T) {
+	convey.Convey("handle change user", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		proto.SetSkipCheckUser(true)
+		proto.capability = CLIENT_PROTOCOL_41 | CLIENT_SECURE_CONNECTION | CLIENT_PLUGIN_AUTH
+
+		var data []byte
+		data = append(data, "user1"...)
+		data = append(data, 0)
+		data = append(data, 0) // empty auth-response
+		data = append(data, "db1"...)
+		data = append(data, 0)
+		data = append(data, 45, 0) // utf8mb4_general_ci
+		data = append(data, AuthNativePassword...)
+		data = append(data, 0)
+
+		err = proto.handleChangeUser(data)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(proto.GetUserName(), convey.ShouldEqual, "user1")
+		convey.So(proto.GetDatabaseName(), convey.ShouldEqual, "db1")
+
+		// the broken packets
+		kases := [][]byte{
+			[]byte("user2"),
+			append([]byte("user2"), 0, 10, 'a'),
+			append([]byte("user2"), 0, 0, 'd', 'b'),
+			append([]byte("user2"), 0, 0, 0, 45),
+		}
+		for _, kase := range kases {
+			err = proto.handleChangeUser(kase)
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(proto.GetUserName(), convey.ShouldEqual, "user1")
+		}
+	})
+}
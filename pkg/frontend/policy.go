@@ -0,0 +1,347 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/tidwall/btree"
+)
+
+const moPoliciesTable = "mo_policies"
+
+var (
+	insertPolicyFormat = `insert into mo_catalog.mo_policies(
+				policy_name,
+				database_name,
+				table_name,
+				command,
+				role_id,
+				owner,
+				predicate,
+				created_time) values ("%s","%s","%s","%s",%d,%d,'%s',"%s");`
+	deletePolicyFormat         = `delete from mo_catalog.mo_policies where policy_name = "%s" and database_name = "%s" and table_name = "%s";`
+	checkPolicyExistsFormat    = `select policy_name from mo_catalog.mo_policies where policy_name = "%s" and database_name = "%s" and table_name = "%s";`
+	getPoliciesOfTableFormat   = `select command, role_id, owner, predicate from mo_catalog.mo_policies where database_name = "%s" and table_name = "%s";`
+	checkPolicyPredicateFormat = "select 1 from `%s`.`%s` where %s limit 0;"
+)
+
+func getSqlForCheckPolicyExists(policyName, dbName, tableName string) string {
+	return fmt.Sprintf(checkPolicyExistsFormat, policyName, dbName, tableName)
+}
+
+func getSqlForPoliciesOfTable(dbName, tableName string) string {
+	return fmt.Sprintf(getPoliciesOfTableFormat, dbName, tableName)
+}
+
+// policyDef is a row-level security policy in mo_catalog.mo_policies
+type policyDef struct {
+	command   string
+	roleId    int64
+	owner     int64
+	predicate string
+}
+
+// getPolicyPredicates returns the predicates of the policies on the table that apply to
+// the roles of the user for the statement type. There is none if the user has the role
+// owning one of them, the policy owners bypass the policies.
+func getPolicyPredicates(ses *Session, dbName, tableName string, stmtType plan.Query_StatementType) ([]string, error) {
+	tenant := ses.GetTenantInfo()
+	// the background sessions have no tenant, and the tables in mo_catalog have no policy
+	if tenant == nil || dbName == catalog.MO_CATALOG || ses.Pu == nil {
+		return nil, nil
+	}
+	policies, err := loadPoliciesOfTable(ses, dbName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	command := strings.ToLower(stmtType.String())
+	matched := make([]*policyDef, 0, len(policies))
+	for _, p := range policies {
+		if p.command == command {
+			matched = append(matched, p)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, nil
+	}
+
+	roles, err := getRoleSetOfSession(ses, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return filterPolicies(matched, roles), nil
+}
+
+// getRoleSetOfSession returns the role set of the user cached in the session for
+// the statement executed
+func getRoleSetOfSession(ses *Session, tenant *TenantInfo) (*btree.Set[int64], error) {
+	if ses.roleSet != nil {
+		return ses.roleSet, nil
+	}
+	ctx := ses.GetRequestContext()
+	bh := NewBackgroundHandler(ctx, ses.GuestMmu, ses.Mempool, ses.Pu)
+	defer bh.Close()
+	roles, err := getRoleSetOfUser(ctx, bh, tenant)
+	if err != nil {
+		return nil, err
+	}
+	ses.roleSet = roles
+	return roles, nil
+}
+
+// filterPolicies returns the predicates of the policies granted to the roles.
+func filterPolicies(policies []*policyDef, roles *btree.Set[int64]) []string {
+	var predicates []string
+	for _, p := range policies {
+		if roles.Contains(p.owner) {
+			return nil
+		}
+		if roles.Contains(p.roleId) {
+			predicates = append(predicates, p.predicate)
+		}
+	}
+	return predicates
+}
+
+// loadPoliciesOfTable reads the policies on the table from mo_catalog.mo_policies,
+// they are read for every statement, as they are created and dropped by the other sessions.
+func loadPoliciesOfTable(ses *Session, dbName, tableName string) ([]*policyDef, error) {
+	ctx := ses.GetRequestContext()
+	// the accounts created before the policies were supported have no mo_policies
+	db, err := ses.GetStorage().Database(ctx, catalog.MO_CATALOG, ses.GetTxnHandler().GetTxn())
+	if err != nil {
+		return nil, err
+	}
+	tableNames, err := db.Relations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, name := range tableNames {
+		if name == moPoliciesTable {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+
+	rsset, err := executeSQLInBackgroundSession(ctx, ses.GuestMmu, ses.Mempool, ses.Pu, getSqlForPoliciesOfTable(dbName, tableName))
+	if err != nil {
+		return nil, err
+	}
+	if len(rsset) < 1 {
+		return nil, nil
+	}
+	policies := make([]*policyDef, 0, rsset[0].GetRowCount())
+	for i := uint64(0); i < rsset[0].GetRowCount(); i++ {
+		p := &policyDef{}
+		if p.command, err = rsset[0].GetString(i, 0); err != nil {
+			return nil, err
+		}
+		if p.roleId, err = rsset[0].GetInt64(i, 1); err != nil {
+			return nil, err
+		}
+		if p.owner, err = rsset[0].GetInt64(i, 2); err != nil {
+			return nil, err
+		}
+		if p.predicate, err = rsset[0].GetString(i, 3); err != nil {
+			return nil, err
+		}
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+// getRoleSetOfUser returns the current role, the roles granted to the user
+// and all the roles inherited by them.
+func getRoleSetOfUser(ctx context.Context, bh BackgroundExec, tenant *TenantInfo) (*btree.Set[int64], error) {
+	roles := &btree.Set[int64]{}
+	roles.Insert(int64(tenant.GetDefaultRoleID()))
+	granted, err := getRoleIdsOfSql(ctx, bh, getSqlForRoleIdOfUserId(int(tenant.GetUserID())))
+	if err != nil {
+		return nil, err
+	}
+	for _, roleId := range granted {
+		roles.Insert(roleId)
+	}
+
+	queue := make([]int64, 0, roles.Len())
+	roles.Scan(func(roleId int64) bool {
+		queue = append(queue, roleId)
+		return true
+	})
+	for len(queue) != 0 {
+		inherited, err := getRoleIdsOfSql(ctx, bh, getSqlForInheritedRoleIdOfRoleId(queue[0]))
+		if err != nil {
+			return nil, err
+		}
+		queue = queue[1:]
+		for _, roleId := range inherited {
+			if !roles.Contains(roleId) {
+				roles.Insert(roleId)
+				queue = append(queue, roleId)
+			}
+		}
+	}
+	return roles, nil
+}
+
+// getRoleIdsOfSql returns the role ids in the first column of the result
+func getRoleIdsOfSql(ctx context.Context, bh BackgroundExec, sql string) ([]int64, error) {
+	err := bh.Exec(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	rsset, err := convertIntoResultSet(bh.GetExecResultSet())
+	if err != nil {
+		return nil, err
+	}
+	if len(rsset) < 1 {
+		return nil, nil
+	}
+	roleIds := make([]int64, 0, rsset[0].GetRowCount())
+	for i := uint64(0); i < rsset[0].GetRowCount(); i++ {
+		roleId, err := rsset[0].GetInt64(i, 0)
+		if err != nil {
+			return nil, err
+		}
+		roleIds = append(roleIds, roleId)
+	}
+	return roleIds, nil
+}
+
+func getDatabaseOfPolicy(ses *Session, table tree.TableName) (string, error) {
+	dbName := string(table.SchemaName)
+	if len(dbName) == 0 {
+		dbName = ses.GetDatabaseName()
+	}
+	if len(dbName) == 0 {
+		return "", moerr.New(moerr.ER_NO_DB_ERROR)
+	}
+	return dbName, nil
+}
+
+// doCreatePolicy saves the policy into mo_catalog.mo_policies,
+// the current role of the user owns the policy.
+func doCreatePolicy(ctx context.Context, ses *Session, cp *tree.CreatePolicy) error {
+	pu := ses.Pu
+	tenant := ses.GetTenantInfo()
+	dbName, err := getDatabaseOfPolicy(ses, cp.Table)
+	if err != nil {
+		return err
+	}
+	tableName := string(cp.Table.ObjectName)
+	if dbName == catalog.MO_CATALOG {
+		return moerr.NewInternalError("can not create the policy on the table %s.%s", dbName, tableName)
+	}
+
+	guestMMu := guest.New(pu.SV.GuestMmuLimitation, pu.HostMmu)
+	bh := NewBackgroundHandler(ctx, guestMMu, pu.Mempool, pu)
+	defer bh.Close()
+
+	exists, err := policyExists(ctx, bh, string(cp.Name), dbName, tableName)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if cp.IfNotExists {
+			return nil
+		}
+		return moerr.NewInternalError("the policy %s on the table %s.%s exists", cp.Name, dbName, tableName)
+	}
+	roleId, err := getRoleIdOfRoleName(ctx, bh, cp.Role)
+	if err != nil {
+		return err
+	}
+
+	// the strings are quoted, so that the predicate can be parsed again
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	cp.Using.Format(fmtCtx)
+	predicate := fmtCtx.String()
+	// check the table exists and the predicate can be evaluated on it
+	if err = bh.Exec(ctx, fmt.Sprintf(checkPolicyPredicateFormat, dbName, tableName, predicate)); err != nil {
+		return moerr.NewInternalError("the predicate of the policy %s is invalid. error:%v", cp.Name, err)
+	}
+
+	return execSqlsInTxn(ctx, bh, []string{
+		fmt.Sprintf(insertPolicyFormat,
+			cp.Name, dbName, tableName,
+			cp.Command, roleId, tenant.GetDefaultRoleID(),
+			escapeSingleQuotedString(predicate),
+			types.CurrentTimestamp().String2(time.UTC, 0)),
+	})
+}
+
+// doDropPolicy removes the policy from mo_catalog.mo_policies
+func doDropPolicy(ctx context.Context, ses *Session, dp *tree.DropPolicy) error {
+	pu := ses.Pu
+	dbName, err := getDatabaseOfPolicy(ses, dp.Table)
+	if err != nil {
+		return err
+	}
+	tableName := string(dp.Table.ObjectName)
+
+	guestMMu := guest.New(pu.SV.GuestMmuLimitation, pu.HostMmu)
+	bh := NewBackgroundHandler(ctx, guestMMu, pu.Mempool, pu)
+	defer bh.Close()
+
+	exists, err := policyExists(ctx, bh, string(dp.Name), dbName, tableName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if dp.IfExists {
+			return nil
+		}
+		return moerr.NewInternalError("there is no policy %s on the table %s.%s", dp.Name, dbName, tableName)
+	}
+
+	return execSqlsInTxn(ctx, bh, []string{fmt.Sprintf(deletePolicyFormat, dp.Name, dbName, tableName)})
+}
+
+func policyExists(ctx context.Context, bh BackgroundExec, policyName, dbName, tableName string) (bool, error) {
+	err := bh.Exec(ctx, getSqlForCheckPolicyExists(policyName, dbName, tableName))
+	if err != nil {
+		return false, err
+	}
+	rsset, err := convertIntoResultSet(bh.GetExecResultSet())
+	if err != nil {
+		return false, err
+	}
+	return len(rsset) != 0 && rsset[0].GetRowCount() != 0, nil
+}
+
+// handleCreatePolicy creates a row-level security policy on the table
+func (mce *MysqlCmdExecutor) handleCreatePolicy(ctx context.Context, cp *tree.CreatePolicy) error {
+	return doCreatePolicy(ctx, mce.GetSession(), cp)
+}
+
+// handleDropPolicy drops a row-level security policy on the table
+func (mce *MysqlCmdExecutor) handleDropPolicy(ctx context.Context, dp *tree.DropPolicy) error {
+	return doDropPolicy(ctx, mce.GetSession(), dp)
+}
@@ -0,0 +1,234 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/prashantv/gostub"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_filterPolicies(t *testing.T) {
+	convey.Convey("the policies of the roles apply unless the user owns one of them", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil)
+		ses.tenant.DefaultRoleID = 5
+		sql2result := make(map[string]ExecResult)
+		sql2result[getSqlForRoleIdOfUserId(int(ses.tenant.GetUserID()))] = newMrsForRoleIdOfUserId([][]interface{}{{6, false}})
+		makeRowsOfMoRoleGrant(sql2result, []int{5, 7}, nil)
+		makeRowsOfMoRoleGrant(sql2result, []int{6}, [][]interface{}{{7, false}})
+		bh := newBh(ctrl, sql2result)
+
+		roles, err := getRoleSetOfUser(context.TODO(), bh, ses.tenant)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(roles.Len(), convey.ShouldEqual, 3)
+		convey.So(roles.Contains(7), convey.ShouldBeTrue)
+
+		policies := []*policyDef{
+			{command: "select", roleId: 7, owner: 1, predicate: "a > 1"},
+			{command: "select", roleId: 9, owner: 1, predicate: "b > 1"},
+			{command: "select", roleId: 6, owner: 1, predicate: "c > 1"},
+		}
+		convey.So(filterPolicies(policies, roles), convey.ShouldResemble, []string{"a > 1", "c > 1"})
+		convey.So(filterPolicies(policies[1:2], roles), convey.ShouldBeEmpty)
+
+		policies = append(policies, &policyDef{command: "select", roleId: 9, owner: 5, predicate: "d > 1"})
+		convey.So(filterPolicies(policies, roles), convey.ShouldBeEmpty)
+
+		// there is no policy on the tables of mo_catalog
+		predicates, err := getPolicyPredicates(ses, "mo_catalog", "mo_user", 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(predicates, convey.ShouldBeEmpty)
+	})
+}
+
+func Test_getRoleSetOfSession(t *testing.T) {
+	convey.Convey("the role set is cached in the session for the statement", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil)
+		ses.tenant.DefaultRoleID = 5
+		sql2result := make(map[string]ExecResult)
+		sql2result[getSqlForRoleIdOfUserId(int(ses.tenant.GetUserID()))] = newMrsForRoleIdOfUserId([][]interface{}{{6, false}})
+		makeRowsOfMoRoleGrant(sql2result, []int{5, 6}, nil)
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		roles, err := getRoleSetOfSession(ses, ses.tenant)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(roles.Len(), convey.ShouldEqual, 2)
+
+		// the roles are read once for the session
+		bhStub.Reset()
+		cached, err := getRoleSetOfSession(ses, ses.tenant)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(cached, convey.ShouldEqual, roles)
+	})
+}
+
+func Test_getPolicyPredicatesAfterChangeUser(t *testing.T) {
+	convey.Convey("the policies apply to the roles of the user changed to", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.TODO()
+		db := mock_frontend.NewMockDatabase(ctrl)
+		db.EXPECT().Relations(gomock.Any()).Return([]string{moPoliciesTable}, nil).AnyTimes()
+		eng := mock_frontend.NewMockEngine(ctrl)
+		eng.EXPECT().Database(gomock.Any(), catalog.MO_CATALOG, gomock.Any()).Return(db, nil).AnyTimes()
+		eng.EXPECT().Hints().Return(engine.Hints{CommitOrRollbackTimeout: time.Second}).AnyTimes()
+		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOperator.EXPECT().Commit(gomock.Any()).Return(nil).AnyTimes()
+		txnOperator.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		txnClient.EXPECT().New().Return(txnOperator, nil).AnyTimes()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		pu.HostMmu = host.New(pu.SV.HostMmuLimitation)
+		pu.Mempool = mempool.New()
+		pu.StorageEngine = eng
+		pu.TxnClient = txnClient
+		ses := NewSession(NewMysqlClientProtocol(0, nil, 1024, pu.SV), nil, nil, pu, gSysVariables)
+		ses.SetRequestContext(ctx)
+		ses.SetTenantInfo(&TenantInfo{Tenant: sysAccountName, User: "u1", UserID: 1, DefaultRoleID: 5})
+
+		sql2result := make(map[string]ExecResult)
+		sql2result[getSqlForPoliciesOfTable("db", "t")] = newMrsForPoliciesOfTable([][]interface{}{
+			{"select", 5, 0, "a > 1"},
+			{"select", 7, 0, "b > 1"},
+		})
+		sql2result[getSqlForRoleIdOfUserId(1)] = newMrsForRoleIdOfUserId(nil)
+		sql2result[getSqlForRoleIdOfUserId(2)] = newMrsForRoleIdOfUserId(nil)
+		makeRowsOfMoRoleGrant(sql2result, []int{5, 7}, nil)
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		predicates, err := getPolicyPredicates(ses, "db", "t", plan.Query_SELECT)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(predicates, convey.ShouldResemble, []string{"a > 1"})
+
+		// COM_CHANGE_USER
+		ses.SetTenantInfo(&TenantInfo{Tenant: sysAccountName, User: "u2", UserID: 2, DefaultRoleID: 7})
+		convey.So(ses.ResetState(), convey.ShouldBeNil)
+		predicates, err = getPolicyPredicates(ses, "db", "t", plan.Query_SELECT)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(predicates, convey.ShouldResemble, []string{"b > 1"})
+	})
+}
+
+func newMrsForPoliciesOfTable(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"command", "role_id", "owner", "predicate"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		if name == "role_id" || name == "owner" {
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		} else {
+			col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		}
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func Test_doCreatePolicy(t *testing.T) {
+	convey.Convey("create and drop the policy", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil)
+		ses.SetDatabaseName("db")
+
+		sql2result := make(map[string]ExecResult)
+		sql2result[getSqlForCheckPolicyExists("p1", "db", "t")] = newMrsForColumnsOfRole(nil)
+		sql2result[getSqlForRoleIdOfRole("r1")] = newMrsForRoleIdOfRole([][]interface{}{{10}})
+
+		var executed []string
+		var currentSql string
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+			currentSql = sql
+			executed = append(executed, sql)
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+			return []interface{}{sql2result[currentSql]}
+		}).AnyTimes()
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		stmts, err := mysql.Parse("create policy p1 on t for select to r1 using (a = 'x')")
+		convey.So(err, convey.ShouldBeNil)
+		cp := stmts[0].(*tree.CreatePolicy)
+		err = doCreatePolicy(ses.GetRequestContext(), ses, cp)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, "select 1 from `db`.`t` where a = \"x\" limit 0;")
+		insert := executed[len(executed)-2]
+		convey.So(insert, convey.ShouldStartWith, "insert into mo_catalog.mo_policies")
+		convey.So(insert, convey.ShouldContainSubstring, `"p1","db","t","select",10,0,'a = "x"'`)
+
+		// the policy exists
+		sql2result[getSqlForCheckPolicyExists("p1", "db", "t")] = newMrsForColumnsOfRole([][]interface{}{{"p1"}})
+		err = doCreatePolicy(ses.GetRequestContext(), ses, cp)
+		convey.So(err, convey.ShouldNotBeNil)
+		cp.IfNotExists = true
+		err = doCreatePolicy(ses.GetRequestContext(), ses, cp)
+		convey.So(err, convey.ShouldBeNil)
+
+		executed = nil
+		dp := &tree.DropPolicy{Name: "p1", Table: cp.Table}
+		err = doDropPolicy(ses.GetRequestContext(), ses, dp)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(strings.Join(executed, "\n"), convey.ShouldContainSubstring, "delete from mo_catalog.mo_policies")
+
+		// the policy does not exist
+		sql2result[getSqlForCheckPolicyExists("p1", "db", "t")] = newMrsForColumnsOfRole(nil)
+		err = doDropPolicy(ses.GetRequestContext(), ses, dp)
+		convey.So(err, convey.ShouldNotBeNil)
+		dp.IfExists = true
+		err = doDropPolicy(ses.GetRequestContext(), ses, dp)
+		convey.So(err, convey.ShouldBeNil)
+
+		// no policy on the tables of mo_catalog
+		cp.Table = *tree.NewTableName("mo_user", tree.ObjectNamePrefix{SchemaName: "mo_catalog"})
+		err = doCreatePolicy(ses.GetRequestContext(), ses, cp)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
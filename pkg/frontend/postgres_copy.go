@@ -0,0 +1,421 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+// the COPY statement is executed as the LOAD DATA statement. the data from the
+// client are saved into a temporary file in the csv format, then the file is loaded.
+
+var pgCopyFromStdinRegexp = regexp.MustCompile(`(?is)^\s*copy\s+((?:"(?:[^"]|"")*"|[^\s("])+)\s*(\((?:"(?:[^"]|"")*"|[^)"])*\))?\s*from\s+stdin\b(.*)$`)
+
+// pgIdentifierRegexp matches the identifier which is not quoted
+var pgIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+// parseCopyFromStdin parses the COPY FROM STDIN statement. It returns false if
+// the query is not the COPY FROM STDIN statement.
+func parseCopyFromStdin(query string) (*pgCopyIn, bool, error) {
+	matches := pgCopyFromStdinRegexp.FindStringSubmatch(strings.TrimRight(strings.TrimSpace(query), ";"))
+	if matches == nil {
+		return nil, false, nil
+	}
+	table, err := parsePGIdentifiers(matches[1], '.')
+	if err != nil {
+		return nil, true, err
+	}
+	if len(table) > 2 {
+		return nil, true, moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("invalid table name %s", matches[1]))
+	}
+	var columns []string
+	if matches[2] != "" {
+		columns, err = parsePGIdentifiers(matches[2][1:len(matches[2])-1], ',')
+		if err != nil {
+			return nil, true, err
+		}
+	}
+	copyIn := &pgCopyIn{
+		table:   table,
+		columns: columns,
+		delim:   '\t',
+	}
+
+	// the options are in the form "WITH (FORMAT csv, HEADER true, DELIMITER ',')"
+	// or in the old form "WITH CSV HEADER DELIMITER ','"
+	options := strings.NewReplacer("(", " ", ")", " ", ",", " ").Replace(matches[3])
+	words := strings.Fields(options)
+	delim := ""
+	for i := 0; i < len(words); i++ {
+		word := strings.ToLower(words[i])
+		next := ""
+		if i+1 < len(words) {
+			next = words[i+1]
+		}
+		switch word {
+		case "with", "binary":
+			if word == "binary" {
+				return nil, true, moerr.New(moerr.NYI, "COPY in the binary format")
+			}
+		case "csv":
+			copyIn.csv = true
+		case "format":
+			switch strings.ToLower(next) {
+			case "csv":
+				copyIn.csv = true
+			case "text":
+			default:
+				return nil, true, moerr.New(moerr.NYI, fmt.Sprintf("COPY in the format %s", next))
+			}
+			i++
+		case "header":
+			copyIn.header = true
+			switch strings.ToLower(next) {
+			case "true", "on", "1":
+				i++
+			case "false", "off", "0":
+				copyIn.header = false
+				i++
+			}
+		case "delimiter":
+			s, err := unquotePGString(next)
+			if err != nil || len(s) != 1 {
+				return nil, true, moerr.NewError(moerr.INVALID_INPUT, "COPY delimiter must be a single one-byte character")
+			}
+			delim = s
+			i++
+		default:
+			return nil, true, moerr.New(moerr.NYI, fmt.Sprintf("COPY option %s", words[i]))
+		}
+	}
+	if copyIn.csv {
+		copyIn.delim = ','
+	}
+	if delim != "" {
+		copyIn.delim = delim[0]
+	}
+	return copyIn, true, nil
+}
+
+// parsePGIdentifiers parses the identifiers separated by sep, an identifier is
+// either a plain one or one quoted by double quotes in which "" is a quote.
+func parsePGIdentifiers(list string, sep byte) ([]string, error) {
+	invalid := moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("invalid identifier %s", list))
+	var idents []string
+	s := strings.TrimSpace(list)
+	for {
+		var ident string
+		if strings.HasPrefix(s, `"`) {
+			end := 1
+			for {
+				i := strings.IndexByte(s[end:], '"')
+				if i < 0 {
+					return nil, invalid
+				}
+				end += i + 1
+				if end < len(s) && s[end] == '"' {
+					end++
+					continue
+				}
+				break
+			}
+			ident = strings.ReplaceAll(s[1:end-1], `""`, `"`)
+			s = s[end:]
+		} else {
+			end := strings.IndexByte(s, sep)
+			if end < 0 {
+				end = len(s)
+			}
+			ident = strings.TrimSpace(s[:end])
+			if !pgIdentifierRegexp.MatchString(ident) {
+				return nil, invalid
+			}
+			s = s[end:]
+		}
+		if ident == "" {
+			return nil, invalid
+		}
+		idents = append(idents, ident)
+
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return idents, nil
+		}
+		if s[0] != sep {
+			return nil, invalid
+		}
+		s = strings.TrimSpace(s[1:])
+	}
+}
+
+// quoteIdentifier quotes the identifier by backticks for the LOAD DATA statement.
+func quoteIdentifier(ident string) string {
+	return "`" + strings.ReplaceAll(ident, "`", "``") + "`"
+}
+
+func unquotePGString(s string) (string, error) {
+	if strings.HasPrefix(strings.ToUpper(s), "E'") {
+		return strconv.Unquote(`"` + strings.ReplaceAll(s[2:len(s)-1], `"`, `\"`) + `"`)
+	}
+	if len(s) < 2 || s[0] != '\'' || s[len(s)-1] != '\'' {
+		return "", fmt.Errorf("invalid string %s", s)
+	}
+	return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+}
+
+// loadStatement returns the LOAD DATA statement which loads the file.
+func (c *pgCopyIn) loadStatement(path string) string {
+	var sb strings.Builder
+	escape := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	table := make([]string, len(c.table))
+	for i, ident := range c.table {
+		table[i] = quoteIdentifier(ident)
+	}
+	fmt.Fprintf(&sb, "load data infile '%s' into table %s fields terminated by '%s' enclosed by '\"' lines terminated by '\\n'",
+		escape.Replace(path), strings.Join(table, "."), escape.Replace(string(c.delim)))
+	if c.header {
+		sb.WriteString(" ignore 1 lines")
+	}
+	if len(c.columns) > 0 {
+		columns := make([]string, len(c.columns))
+		for i, ident := range c.columns {
+			columns[i] = quoteIdentifier(ident)
+		}
+		fmt.Fprintf(&sb, " (%s)", strings.Join(columns, ", "))
+	}
+	return sb.String()
+}
+
+// pgCopyFile is the temporary file of the data of the COPY statement.
+type pgCopyFile struct {
+	file *os.File
+	w    *bufio.Writer
+	// the data are converted into the csv format if they are in the text format
+	text  bool
+	delim byte
+	// the incomplete line in the text format
+	pending []byte
+}
+
+func newPGCopyFile(text bool, delim byte) (*pgCopyFile, error) {
+	file, err := os.CreateTemp("", "mo-pg-copy-*.csv")
+	if err != nil {
+		return nil, err
+	}
+	return &pgCopyFile{
+		file:  file,
+		w:     bufio.NewWriter(file),
+		text:  text,
+		delim: delim,
+	}, nil
+}
+
+func (f *pgCopyFile) Write(data []byte) error {
+	if !f.text {
+		_, err := f.w.Write(data)
+		return err
+	}
+	f.pending = append(f.pending, data...)
+	for {
+		idx := bytes.IndexByte(f.pending, '\n')
+		if idx < 0 {
+			break
+		}
+		if err := f.writeTextLine(f.pending[:idx]); err != nil {
+			return err
+		}
+		f.pending = f.pending[idx+1:]
+	}
+	return nil
+}
+
+// writeTextLine writes the line in the text format as the line in the csv format.
+func (f *pgCopyFile) writeTextLine(line []byte) error {
+	line = bytes.TrimSuffix(line, []byte{'\r'})
+	// the end-of-data marker
+	if string(line) == `\.` {
+		return nil
+	}
+	for i, field := range bytes.Split(line, []byte{f.delim}) {
+		if i > 0 {
+			if err := f.w.WriteByte(f.delim); err != nil {
+				return err
+			}
+		}
+		if string(field) == NULL_FLAG {
+			if _, err := f.w.WriteString(NULL_FLAG); err != nil {
+				return err
+			}
+			continue
+		}
+		value := unescapePGText(field)
+		if bytes.ContainsAny(value, "\"\r\n") || bytes.IndexByte(value, f.delim) >= 0 {
+			value = append(append([]byte{'"'}, bytes.ReplaceAll(value, []byte{'"'}, []byte(`""`))...), '"')
+		}
+		if _, err := f.w.Write(value); err != nil {
+			return err
+		}
+	}
+	return f.w.WriteByte('\n')
+}
+
+// unescapePGText unescapes the backslash sequences in the text format.
+func unescapePGText(field []byte) []byte {
+	if bytes.IndexByte(field, '\\') < 0 {
+		return field
+	}
+	res := make([]byte, 0, len(field))
+	for i := 0; i < len(field); i++ {
+		c := field[i]
+		if c != '\\' || i+1 == len(field) {
+			res = append(res, c)
+			continue
+		}
+		i++
+		switch c = field[i]; c {
+		case 'b':
+			res = append(res, '\b')
+		case 'f':
+			res = append(res, '\f')
+		case 'n':
+			res = append(res, '\n')
+		case 'r':
+			res = append(res, '\r')
+		case 't':
+			res = append(res, '\t')
+		case 'v':
+			res = append(res, '\v')
+		case 'x':
+			j := i + 1
+			for j < len(field) && j < i+3 && isHexDigit(field[j]) {
+				j++
+			}
+			if j == i+1 {
+				res = append(res, c)
+				continue
+			}
+			v, _ := strconv.ParseUint(string(field[i+1:j]), 16, 8)
+			res = append(res, byte(v))
+			i = j - 1
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			j := i
+			for j < len(field) && j < i+3 && field[j] >= '0' && field[j] <= '7' {
+				j++
+			}
+			v, _ := strconv.ParseUint(string(field[i:j]), 8, 8)
+			res = append(res, byte(v))
+			i = j - 1
+		default:
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+// Close flushes the data into the file.
+func (f *pgCopyFile) Close() error {
+	if f.text && len(f.pending) != 0 {
+		if err := f.writeTextLine(f.pending); err != nil {
+			return err
+		}
+		f.pending = nil
+	}
+	if err := f.w.Flush(); err != nil {
+		return err
+	}
+	return f.file.Close()
+}
+
+func (f *pgCopyFile) Remove() {
+	_ = f.file.Close()
+	if err := os.Remove(f.file.Name()); err != nil {
+		logutil.Errorf("remove the file %s of COPY failed. error:%v", f.file.Name(), err)
+	}
+}
+
+// startCopy sends the CopyInResponse, then the client sends the data.
+func (pp *PostgresProtocolImpl) startCopy(copyIn *pgCopyIn) error {
+	file, err := newPGCopyFile(!copyIn.csv, copyIn.delim)
+	if err != nil {
+		if err = pp.sendErrorResponse("ERROR", err); err != nil {
+			return err
+		}
+		return pp.sendReadyForQuery()
+	}
+	copyIn.file = file
+	pp.copyIn = copyIn
+
+	// the count of the columns is unknown, all the columns are in the text format
+	pp.openMessage(pgCopyInResponse)
+	pp.msg = append(pp.msg, 0)
+	pp.msg = appendPGInt16(pp.msg, 0)
+	if err = pp.closeMessage(); err != nil {
+		return err
+	}
+	return pp.flush()
+}
+
+func (pp *PostgresProtocolImpl) handleCopyMessage(typ byte, data []byte) (*Request, error) {
+	copyIn := pp.copyIn
+	switch typ {
+	case pgCopyDataMessage:
+		if err := copyIn.file.Write(data); err != nil {
+			return nil, pp.abortCopy(err)
+		}
+		return nil, nil
+	case pgCopyDoneMessage:
+		if err := copyIn.file.Close(); err != nil {
+			return nil, pp.abortCopy(err)
+		}
+		pp.copying = true
+		return &Request{cmd: int(COM_QUERY), data: []byte(copyIn.loadStatement(copyIn.file.file.Name()))}, nil
+	case pgCopyFailMessage:
+		r := &pgReader{data: data}
+		return nil, pp.abortCopy(moerr.NewError(moerr.INVALID_INPUT, "COPY from stdin failed: "+r.readString()))
+	case pgFlushMessage, pgSyncMessage:
+		return nil, nil
+	default:
+		return nil, pp.abortCopy(moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("unexpected message type %q during COPY from stdin", typ)))
+	}
+}
+
+// abortCopy stops the copy with the error. The rest of the data from the client are discarded.
+func (pp *PostgresProtocolImpl) abortCopy(err error) error {
+	pp.finishCopy()
+	if err = pp.sendErrorResponse("ERROR", err); err != nil {
+		return err
+	}
+	return pp.sendReadyForQuery()
+}
+
+func (pp *PostgresProtocolImpl) finishCopy() {
+	if pp.copyIn != nil {
+		pp.copyIn.file.Remove()
+		pp.copyIn = nil
+	}
+}
@@ -0,0 +1,1769 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/fagongzi/goetty/v2/codec"
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// the codes in the messages of the startup phase, see
+// https://www.postgresql.org/docs/current/protocol-message-formats.html
+const (
+	pgProtocolVersion   uint32 = 196608
+	pgCancelRequestCode uint32 = 80877102
+	pgSSLRequestCode    uint32 = 80877103
+	pgGSSENCRequestCode uint32 = 80877104
+)
+
+// the messages from the client
+const (
+	// the startup messages have no type
+	pgStartupMessage   byte = 0
+	pgQueryMessage     byte = 'Q'
+	pgParseMessage     byte = 'P'
+	pgBindMessage      byte = 'B'
+	pgDescribeMessage  byte = 'D'
+	pgExecuteMessage   byte = 'E'
+	pgCloseMessage     byte = 'C'
+	pgSyncMessage      byte = 'S'
+	pgFlushMessage     byte = 'H'
+	pgTerminateMessage byte = 'X'
+	pgPasswordMessage  byte = 'p'
+	pgCopyDataMessage  byte = 'd'
+	pgCopyDoneMessage  byte = 'c'
+	pgCopyFailMessage  byte = 'f'
+)
+
+// the messages from the server
+const (
+	pgAuthentication       byte = 'R'
+	pgParameterStatus      byte = 'S'
+	pgBackendKeyData       byte = 'K'
+	pgReadyForQuery        byte = 'Z'
+	pgRowDescription       byte = 'T'
+	pgDataRow              byte = 'D'
+	pgCommandComplete      byte = 'C'
+	pgErrorResponse        byte = 'E'
+	pgEmptyQueryResponse   byte = 'I'
+	pgParseComplete        byte = '1'
+	pgBindComplete         byte = '2'
+	pgCloseComplete        byte = '3'
+	pgNoData               byte = 'n'
+	pgParameterDescription byte = 't'
+	pgCopyInResponse       byte = 'G'
+)
+
+// the codes of the authentication messages
+const (
+	pgAuthenticationOk       int32 = 0
+	pgAuthenticationMD5      int32 = 5
+	pgAuthenticationSASL     int32 = 10
+	pgAuthenticationSASLCont int32 = 11
+	pgAuthenticationSASLDone int32 = 12
+)
+
+// the oids of the types in pg_type
+const (
+	pgTypeBool      uint32 = 16
+	pgTypeBytea     uint32 = 17
+	pgTypeInt8      uint32 = 20
+	pgTypeInt2      uint32 = 21
+	pgTypeInt4      uint32 = 23
+	pgTypeText      uint32 = 25
+	pgTypeJson      uint32 = 114
+	pgTypeFloat4    uint32 = 700
+	pgTypeFloat8    uint32 = 701
+	pgTypeUnknown   uint32 = 705
+	pgTypeBpchar    uint32 = 1042
+	pgTypeVarchar   uint32 = 1043
+	pgTypeDate      uint32 = 1082
+	pgTypeTime      uint32 = 1083
+	pgTypeTimestamp uint32 = 1114
+	pgTypeNumeric   uint32 = 1700
+	pgTypeUUID      uint32 = 2950
+)
+
+// the format codes of the values
+const (
+	pgFormatText   int16 = 0
+	pgFormatBinary int16 = 1
+)
+
+const (
+	pgAuthMethodMD5   = "md5"
+	pgAuthMethodSCRAM = "scram-sha-256"
+
+	pgScramMechanism  = "SCRAM-SHA-256"
+	pgScramIterations = 4096
+
+	// the largest message accepted from the client
+	pgMaxMessageLength = 1 << 30
+
+	pgDefaultSqlState = "XX000"
+)
+
+// the postgresql epoch of the date and timestamp values in the binary format
+var pgEpoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// PostgresMessage is a message of the postgresql protocol v3.
+type PostgresMessage struct {
+	// Type is the type of the message, it is pgStartupMessage for the startup messages
+	Type    byte
+	Payload []byte
+}
+
+func NewPostgresCodec() codec.Codec {
+	return &postgresCodec{}
+}
+
+type postgresCodec struct {
+}
+
+func (c *postgresCodec) Decode(in *buf.ByteBuf) (interface{}, bool, error) {
+	readable := in.Readable()
+	if readable < 5 {
+		return nil, false, nil
+	}
+
+	// the length of the startup message begins with the zero byte since
+	// it is less than pgMaxMessageLength, other messages begin with the type.
+	header := in.PeekN(0, 5)
+	typ := header[0]
+	headerLength := 5
+	length := int(binary.BigEndian.Uint32(header[1:5]))
+	if typ == pgStartupMessage {
+		headerLength = 4
+		length = int(binary.BigEndian.Uint32(header[0:4]))
+	}
+	if length < 4 || length > pgMaxMessageLength {
+		return nil, false, fmt.Errorf("invalid length %d of the message %q", length, typ)
+	}
+	payloadLength := length - 4
+	if readable < headerLength+payloadLength {
+		return nil, false, nil
+	}
+
+	in.Skip(headerLength)
+	in.SetMarkIndex(in.GetReadIndex() + payloadLength)
+	payload := in.ReadMarkedData()
+
+	return &PostgresMessage{
+		Type:    typ,
+		Payload: payload,
+	}, true, nil
+}
+
+func (c *postgresCodec) Encode(data interface{}, out *buf.ByteBuf, writer io.Writer) error {
+	x := data.([]byte)
+	xlen := len(x)
+	tlen, err := out.Write(x)
+	if err != nil {
+		return err
+	}
+	if tlen != xlen {
+		return fmt.Errorf("len of written != len of the data")
+	}
+	return nil
+}
+
+// pgReader reads the fields of a message from the client
+type pgReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *pgReader) malformed() {
+	if r.err == nil {
+		r.err = moerr.NewError(moerr.INVALID_INPUT, "malform packet")
+	}
+}
+
+func (r *pgReader) readInt16() int16 {
+	if r.err != nil || r.pos+2 > len(r.data) {
+		r.malformed()
+		return 0
+	}
+	v := int16(binary.BigEndian.Uint16(r.data[r.pos:]))
+	r.pos += 2
+	return v
+}
+
+func (r *pgReader) readInt32() int32 {
+	if r.err != nil || r.pos+4 > len(r.data) {
+		r.malformed()
+		return 0
+	}
+	v := int32(binary.BigEndian.Uint32(r.data[r.pos:]))
+	r.pos += 4
+	return v
+}
+
+func (r *pgReader) readString() string {
+	if r.err != nil {
+		return ""
+	}
+	end := r.pos
+	for end < len(r.data) && r.data[end] != 0 {
+		end++
+	}
+	if end >= len(r.data) {
+		r.malformed()
+		return ""
+	}
+	s := string(r.data[r.pos:end])
+	r.pos = end + 1
+	return s
+}
+
+func (r *pgReader) readBytes(n int) []byte {
+	if r.err != nil || n < 0 || r.pos+n > len(r.data) {
+		r.malformed()
+		return nil
+	}
+	v := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return v
+}
+
+func (r *pgReader) remaining() []byte {
+	if r.err != nil {
+		return nil
+	}
+	v := r.data[r.pos:]
+	r.pos = len(r.data)
+	return v
+}
+
+func appendPGInt16(data []byte, v int16) []byte {
+	return append(data, byte(v>>8), byte(v))
+}
+
+func appendPGInt32(data []byte, v int32) []byte {
+	return append(data, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendPGInt64(data []byte, v int64) []byte {
+	return appendPGInt32(appendPGInt32(data, int32(v>>32)), int32(v))
+}
+
+func appendPGString(data []byte, s string) []byte {
+	data = append(data, s...)
+	return append(data, 0)
+}
+
+// pgStatement is a statement prepared by the Parse message.
+type pgStatement struct {
+	// name of the prepared statement in the session
+	name string
+	// the oids of the parameters
+	paramOIDs []uint32
+	// the parameter of the i-th placeholder of the prepared statement
+	paramIndexes []int
+}
+
+// pgPortal is a statement bound to the parameters by the Bind message.
+type pgPortal struct {
+	stmt          *pgStatement
+	vars          []any
+	resultFormats []int16
+}
+
+// pgCopyIn keeps the data of the COPY FROM STDIN statement.
+type pgCopyIn struct {
+	// the database and the table, or the table
+	table   []string
+	columns []string
+	csv     bool
+	header  bool
+	delim   byte
+	file    *pgCopyFile
+}
+
+// PostgresProtocolImpl implements the postgresql protocol v3. It works as a MysqlProtocol so that
+// the requests are executed by the Session and the CmdExecutor of the mysql protocol, the column
+// definitions, the rows and the responses sent by them become the messages of the postgresql protocol.
+type PostgresProtocolImpl struct {
+	ProtocolImpl
+
+	//the user of the client
+	username string
+
+	//the default database for the client
+	database string
+
+	//the parameters in the startup message
+	parameters map[string]string
+
+	//the key the client sends to cancel the request
+	secretKey uint32
+
+	//the password authentication, md5 or scram-sha-256
+	authMethod string
+
+	//the messages of the scram authentication
+	scramClientFirst string
+	scramServerFirst string
+	scramNonce       string
+
+	//the prepared statements and the portals of the extended query
+	statements map[string]*pgStatement
+	portals    map[string]*pgPortal
+
+	//the statement in the Parse message being prepared and its name.
+	//it is saved after it has been prepared.
+	parsing     *pgStatement
+	parsingName string
+
+	//the portal in the Execute message being executed
+	executing *pgPortal
+
+	//the messages in the extended query are answered until the Sync message.
+	//after an error, the messages before the Sync message are discarded.
+	extended       bool
+	ignoreTillSync bool
+
+	//the copy in progress
+	copyIn *pgCopyIn
+
+	//the request comes from the COPY FROM STDIN statement
+	copying bool
+
+	//the columns and the count of the rows of the result set being sent
+	columns []*MysqlColumn
+	rows    uint64
+
+	//the message being made
+	msg []byte
+
+	//the bytes written into the outbuf since the last flush
+	bytesInOutbuf     int
+	untilBytesToFlush int
+
+	SV *config.FrontendParameters
+
+	m sync.Mutex
+
+	ses *Session
+
+	//skip checking the password of the user
+	skipCheckUser bool
+
+	//the state of TLS. it is nil if the connection does not use TLS
+	tlsState *tls.ConnectionState
+}
+
+var _ MysqlProtocol = &PostgresProtocolImpl{}
+
+func NewPostgresProtocol(connectionID uint32, tcp goetty.IOSession, maxBytesToFlush int, SV *config.FrontendParameters) *PostgresProtocolImpl {
+	salt := make([]byte, 4)
+	_, _ = rand.Read(salt)
+	var key [4]byte
+	_, _ = rand.Read(key[:])
+
+	return &PostgresProtocolImpl{
+		ProtocolImpl: ProtocolImpl{
+			io:           NewIOPackage(false),
+			tcpConn:      tcp,
+			salt:         salt,
+			connectionID: connectionID,
+			established:  false,
+		},
+		parameters:        make(map[string]string),
+		secretKey:         binary.BigEndian.Uint32(key[:]),
+		authMethod:        SV.PostgresAuthMethod,
+		statements:        make(map[string]*pgStatement),
+		portals:           make(map[string]*pgPortal),
+		untilBytesToFlush: maxBytesToFlush * 1024,
+		SV:                SV,
+	}
+}
+
+func (pp *PostgresProtocolImpl) SetSkipCheckUser(b bool) {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	pp.skipCheckUser = b
+}
+
+func (pp *PostgresProtocolImpl) GetSkipCheckUser() bool {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	return pp.skipCheckUser
+}
+
+func (pp *PostgresProtocolImpl) SetSession(ses *Session) {
+	pp.ses = ses
+}
+
+func (pp *PostgresProtocolImpl) GetDatabaseName() string {
+	return pp.database
+}
+
+func (pp *PostgresProtocolImpl) SetDatabaseName(s string) {
+	pp.database = s
+}
+
+func (pp *PostgresProtocolImpl) GetUserName() string {
+	return pp.username
+}
+
+func (pp *PostgresProtocolImpl) SetUserName(s string) {
+	pp.username = s
+}
+
+func (pp *PostgresProtocolImpl) GetStats() string {
+	return ""
+}
+
+func (pp *PostgresProtocolImpl) PrepareBeforeProcessingResultSet() {}
+
+func (pp *PostgresProtocolImpl) openMessage(typ byte) {
+	pp.msg = append(pp.msg[:0], typ, 0, 0, 0, 0)
+}
+
+func (pp *PostgresProtocolImpl) closeMessage() error {
+	binary.BigEndian.PutUint32(pp.msg[1:5], uint32(len(pp.msg)-1))
+	return pp.write(pp.msg)
+}
+
+func (pp *PostgresProtocolImpl) write(data []byte) error {
+	if err := pp.tcpConn.Write(data, goetty.WriteOptions{}); err != nil {
+		return err
+	}
+	pp.bytesInOutbuf += len(data)
+	if pp.bytesInOutbuf >= pp.untilBytesToFlush {
+		return pp.flush()
+	}
+	return nil
+}
+
+func (pp *PostgresProtocolImpl) flush() error {
+	pp.bytesInOutbuf = 0
+	return pp.tcpConn.Flush(0)
+}
+
+func (pp *PostgresProtocolImpl) sendAuthentication(code int32, data []byte) error {
+	pp.openMessage(pgAuthentication)
+	pp.msg = appendPGInt32(pp.msg, code)
+	pp.msg = append(pp.msg, data...)
+	if err := pp.closeMessage(); err != nil {
+		return err
+	}
+	return pp.flush()
+}
+
+func (pp *PostgresProtocolImpl) sendParameterStatus(name, value string) error {
+	pp.openMessage(pgParameterStatus)
+	pp.msg = appendPGString(pp.msg, name)
+	pp.msg = appendPGString(pp.msg, value)
+	return pp.closeMessage()
+}
+
+func (pp *PostgresProtocolImpl) sendEmptyMessage(typ byte) error {
+	pp.openMessage(typ)
+	return pp.closeMessage()
+}
+
+func (pp *PostgresProtocolImpl) sendReadyForQuery() error {
+	status := byte('I')
+	if pp.ses != nil && pp.ses.InActiveMultiStmtTransaction() {
+		status = 'T'
+	}
+	pp.openMessage(pgReadyForQuery)
+	pp.msg = append(pp.msg, status)
+	if err := pp.closeMessage(); err != nil {
+		return err
+	}
+	return pp.flush()
+}
+
+func (pp *PostgresProtocolImpl) sendCommandComplete(tag string) error {
+	pp.openMessage(pgCommandComplete)
+	pp.msg = appendPGString(pp.msg, tag)
+	return pp.closeMessage()
+}
+
+// sendErrorResponse sends the error to the client. The error in the extended query discards
+// the messages until the Sync message.
+func (pp *PostgresProtocolImpl) sendErrorResponse(severity string, err error) error {
+	sqlState := pgDefaultSqlState
+	if moe, ok := err.(*moerr.Error); ok && len(moe.SqlState) == 5 && moe.SqlState != moerr.MySQLDefaultSqlState {
+		sqlState = moe.SqlState
+	}
+	if pp.extended {
+		pp.ignoreTillSync = true
+	}
+	pp.openMessage(pgErrorResponse)
+	pp.msg = append(pp.msg, 'S')
+	pp.msg = appendPGString(pp.msg, severity)
+	pp.msg = append(pp.msg, 'V')
+	pp.msg = appendPGString(pp.msg, severity)
+	pp.msg = append(pp.msg, 'C')
+	pp.msg = appendPGString(pp.msg, sqlState)
+	pp.msg = append(pp.msg, 'M')
+	pp.msg = appendPGString(pp.msg, err.Error())
+	pp.msg = append(pp.msg, 0)
+	if err := pp.closeMessage(); err != nil {
+		return err
+	}
+	return pp.flush()
+}
+
+// handleStartup handles the startup message. It returns the code of the message.
+func (pp *PostgresProtocolImpl) handleStartup(payload []byte) (uint32, error) {
+	r := &pgReader{data: payload}
+	code := uint32(r.readInt32())
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	switch code {
+	case pgSSLRequestCode, pgGSSENCRequestCode, pgCancelRequestCode:
+		return code, nil
+
+	case pgProtocolVersion:
+		for {
+			name := r.readString()
+			if r.err != nil {
+				return 0, r.err
+			}
+			if name == "" {
+				break
+			}
+			pp.parameters[name] = r.readString()
+		}
+		pp.username = pp.parameters["user"]
+		pp.database = pp.parameters["database"]
+		logutil.Infof("postgresql client %s connects to the database %s", pp.username, pp.database)
+
+		if pp.GetSkipCheckUser() {
+			return code, pp.authenticated()
+		}
+		switch pp.authMethod {
+		case pgAuthMethodMD5:
+			return code, pp.sendAuthentication(pgAuthenticationMD5, pp.salt)
+		case pgAuthMethodSCRAM:
+			return code, pp.sendAuthentication(pgAuthenticationSASL, appendPGString(appendPGString(nil, pgScramMechanism), ""))
+		default:
+			return 0, fmt.Errorf("unsupported authentication method %s", pp.authMethod)
+		}
+
+	default:
+		err := fmt.Errorf("unsupported protocol version %d.%d", code>>16, code&0xffff)
+		_ = pp.sendErrorResponse("FATAL", moerr.NewError(moerr.INVALID_INPUT, err.Error()))
+		return 0, err
+	}
+}
+
+// handleCancelRequest returns the process id and the secret key in the cancel request.
+func (pp *PostgresProtocolImpl) handleCancelRequest(payload []byte) (uint32, uint32, error) {
+	r := &pgReader{data: payload}
+	r.readInt32()
+	pid := uint32(r.readInt32())
+	key := uint32(r.readInt32())
+	return pid, key, r.err
+}
+
+// handlePassword handles the password message in the authentication.
+func (pp *PostgresProtocolImpl) handlePassword(payload []byte) error {
+	if pp.authMethod == pgAuthMethodSCRAM {
+		if pp.scramServerFirst == "" {
+			return pp.handleSASLInitialResponse(payload)
+		}
+		return pp.handleSASLResponse(payload)
+	}
+
+	r := &pgReader{data: payload}
+	response := r.readString()
+	if r.err != nil {
+		return r.err
+	}
+	password, err := pp.getPassword()
+	if err != nil {
+		return pp.authenticationFailed(err)
+	}
+	if password != nil && !checkPostgresMD5Password(password, pp.username, pp.salt, response) {
+		return pp.authenticationFailed(fmt.Errorf("check password failed"))
+	}
+	return pp.authenticated()
+}
+
+func (pp *PostgresProtocolImpl) handleSASLInitialResponse(payload []byte) error {
+	r := &pgReader{data: payload}
+	mechanism := r.readString()
+	length := r.readInt32()
+	clientFirst := string(r.readBytes(int(length)))
+	if r.err != nil {
+		return r.err
+	}
+	if mechanism != pgScramMechanism {
+		return pp.authenticationFailed(fmt.Errorf("unsupported SASL mechanism %s", mechanism))
+	}
+
+	serverFirst, nonce, err := makeScramServerFirst(clientFirst, pp.salt)
+	if err != nil {
+		return pp.authenticationFailed(err)
+	}
+	pp.scramClientFirst = clientFirst
+	pp.scramServerFirst = serverFirst
+	pp.scramNonce = nonce
+	return pp.sendAuthentication(pgAuthenticationSASLCont, []byte(serverFirst))
+}
+
+func (pp *PostgresProtocolImpl) handleSASLResponse(payload []byte) error {
+	password, err := pp.getPassword()
+	if err != nil {
+		return pp.authenticationFailed(err)
+	}
+	if password == nil {
+		return pp.authenticated()
+	}
+	serverFinal, err := checkScramClientFinal(password, pp.salt, pp.scramClientFirst, pp.scramServerFirst, pp.scramNonce, string(payload))
+	if err != nil {
+		return pp.authenticationFailed(err)
+	}
+	if err = pp.sendAuthentication(pgAuthenticationSASLDone, []byte(serverFinal)); err != nil {
+		return err
+	}
+	return pp.authenticated()
+}
+
+// getPassword gets the password of the user. It returns nil if the password is not checked.
+func (pp *PostgresProtocolImpl) getPassword() ([]byte, error) {
+	if pp.GetSkipCheckUser() {
+		return nil, nil
+	}
+	user, err := pp.ses.AuthenticateUser(pp.username)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkTlsRequirement(user, pp.tlsState); err != nil {
+		return nil, err
+	}
+	// the password in plain text is needed by md5 and scram-sha-256
+	if user.plugin != AuthNativePassword {
+		return nil, moerr.NewInternalError("the authentication plugin %s of the user is not supported by the postgresql protocol", user.plugin)
+	}
+	return user.password, nil
+}
+
+func (pp *PostgresProtocolImpl) authenticationFailed(err error) error {
+	_ = pp.sendErrorResponse("FATAL", moerr.NewError(moerr.INVALID_INPUT, err.Error()))
+	return err
+}
+
+// authenticated finishes the startup phase.
+func (pp *PostgresProtocolImpl) authenticated() error {
+	if pp.GetSkipCheckUser() && pp.ses != nil {
+		tenant, err := GetTenantInfo(pp.username)
+		if err != nil {
+			return err
+		}
+		pp.ses.SetTenantInfo(tenant)
+	}
+
+	if err := pp.sendAuthentication(pgAuthenticationOk, nil); err != nil {
+		return err
+	}
+	parameters := [][2]string{
+		{"server_version", "13.0.0"},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, MDY"},
+		{"IntervalStyle", "postgres"},
+		{"TimeZone", "UTC"},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+		{"is_superuser", "off"},
+		{"application_name", pp.parameters["application_name"]},
+	}
+	for _, p := range parameters {
+		if err := pp.sendParameterStatus(p[0], p[1]); err != nil {
+			return err
+		}
+	}
+	pp.openMessage(pgBackendKeyData)
+	pp.msg = appendPGInt32(pp.msg, int32(pp.connectionID))
+	pp.msg = appendPGInt32(pp.msg, int32(pp.secretKey))
+	if err := pp.closeMessage(); err != nil {
+		return err
+	}
+	pp.SetEstablished()
+	if pp.ses != nil && pp.database != "" {
+		pp.ses.SetDatabaseName(pp.database)
+	}
+	return pp.sendReadyForQuery()
+}
+
+// checkPostgresMD5Password checks the response of the md5 authentication,
+// it is "md5" + md5(md5(password + user) + salt) in hex.
+func checkPostgresMD5Password(password []byte, user string, salt []byte, response string) bool {
+	inner := md5.Sum(append(append([]byte{}, password...), user...))
+	innerHex := hex.EncodeToString(inner[:])
+	outer := md5.Sum(append([]byte(innerHex), salt...))
+	expected := "md5" + hex.EncodeToString(outer[:])
+	return hmac.Equal([]byte(expected), []byte(response))
+}
+
+// parseScramAttributes parses the attributes "a=x,b=y" in the scram messages.
+func parseScramAttributes(s string) map[byte]string {
+	attrs := make(map[byte]string)
+	for _, part := range strings.Split(s, ",") {
+		if len(part) >= 2 && part[1] == '=' {
+			attrs[part[0]] = part[2:]
+		}
+	}
+	return attrs
+}
+
+// makeScramServerFirst makes the server-first-message of the scram authentication
+// for the client-first-message. It returns the message and the nonce in it.
+func makeScramServerFirst(clientFirst string, salt []byte) (string, string, error) {
+	// gs2-header is "n,," or "y,,", channel binding is not supported
+	if !strings.HasPrefix(clientFirst, "n,") && !strings.HasPrefix(clientFirst, "y,") {
+		return "", "", fmt.Errorf("unsupported SCRAM channel binding")
+	}
+	parts := strings.SplitN(clientFirst, ",", 3)
+	if len(parts) != 3 {
+		return "", "", fmt.Errorf("malformed SCRAM message")
+	}
+	clientNonce := parseScramAttributes(parts[2])['r']
+	if clientNonce == "" {
+		return "", "", fmt.Errorf("malformed SCRAM message")
+	}
+
+	serverNonce := make([]byte, 18)
+	if _, err := rand.Read(serverNonce); err != nil {
+		return "", "", err
+	}
+	nonce := clientNonce + base64.StdEncoding.EncodeToString(serverNonce)
+	serverFirst := fmt.Sprintf("r=%s,s=%s,i=%d", nonce, base64.StdEncoding.EncodeToString(salt), pgScramIterations)
+	return serverFirst, nonce, nil
+}
+
+// checkScramClientFinal verifies the proof in the client-final-message of the scram authentication.
+// It returns the server-final-message.
+func checkScramClientFinal(password, salt []byte, clientFirst, serverFirst, nonce, clientFinal string) (string, error) {
+	idx := strings.LastIndex(clientFinal, ",p=")
+	if idx < 0 {
+		return "", fmt.Errorf("malformed SCRAM message")
+	}
+	attrs := parseScramAttributes(clientFinal)
+	if attrs['r'] != nonce {
+		return "", fmt.Errorf("SCRAM nonce mismatch")
+	}
+	proof, err := base64.StdEncoding.DecodeString(attrs['p'])
+	if err != nil || len(proof) != sha256.Size {
+		return "", fmt.Errorf("malformed SCRAM proof")
+	}
+
+	clientFirstBare := strings.SplitN(clientFirst, ",", 3)[2]
+	authMessage := clientFirstBare + "," + serverFirst + "," + clientFinal[:idx]
+
+	saltedPassword := pbkdf2.Key(password, salt, pgScramIterations, sha256.Size, sha256.New)
+	clientKey := scramHMAC(saltedPassword, "Client Key")
+	storedKey := sha256.Sum256(clientKey)
+	clientSignature := scramHMAC(storedKey[:], authMessage)
+	for i := range proof {
+		proof[i] ^= clientSignature[i]
+	}
+	if recovered := sha256.Sum256(proof); !hmac.Equal(recovered[:], storedKey[:]) {
+		return "", fmt.Errorf("check password failed")
+	}
+
+	serverKey := scramHMAC(saltedPassword, "Server Key")
+	serverSignature := scramHMAC(serverKey, authMessage)
+	return "v=" + base64.StdEncoding.EncodeToString(serverSignature), nil
+}
+
+func scramHMAC(key []byte, message string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(message))
+	return h.Sum(nil)
+}
+
+// GetRequest gets the Request from the message, the type of the message is the first byte of the payload.
+func (pp *PostgresProtocolImpl) GetRequest(payload []byte) *Request {
+	req := &Request{
+		cmd:  int(payload[0]),
+		data: payload[1:],
+	}
+
+	return req
+}
+
+// handleRequest answers the messages which are not executed by the CmdExecutor,
+// and turns the others into the requests of the mysql commands. It returns nil
+// if the message has been answered.
+func (pp *PostgresProtocolImpl) handleRequest(req *Request) (*Request, error) {
+	typ := byte(req.GetCmd())
+	data := req.GetData().([]byte)
+
+	if pp.copyIn != nil {
+		return pp.handleCopyMessage(typ, data)
+	}
+
+	switch typ {
+	case pgQueryMessage:
+		pp.extended = false
+		return pp.handleQuery(data)
+	case pgSyncMessage:
+		pp.extended = false
+		pp.ignoreTillSync = false
+		return nil, pp.sendReadyForQuery()
+	case pgFlushMessage:
+		return nil, pp.flush()
+	case pgTerminateMessage:
+		pp.Quit()
+		return nil, nil
+	case pgCopyDataMessage, pgCopyDoneMessage, pgCopyFailMessage:
+		// the rest of a failed copy
+		return nil, nil
+	}
+
+	pp.extended = true
+	if pp.ignoreTillSync {
+		return nil, nil
+	}
+	var next *Request
+	var err error
+	switch typ {
+	case pgParseMessage:
+		next, err = pp.handleParse(data)
+	case pgBindMessage:
+		err = pp.handleBind(data)
+	case pgDescribeMessage:
+		err = pp.handleDescribe(data)
+	case pgExecuteMessage:
+		next, err = pp.handleExecute(data)
+	case pgCloseMessage:
+		err = pp.handleClose(data)
+	default:
+		err = moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("unsupported message %q", typ))
+	}
+	if err != nil {
+		return nil, pp.sendErrorResponse("ERROR", err)
+	}
+	return next, nil
+}
+
+// finishRequest is called after the request from handleRequest has been executed.
+func (pp *PostgresProtocolImpl) finishRequest() error {
+	pp.parsing = nil
+	pp.parsingName = ""
+	pp.executing = nil
+	if pp.copying {
+		pp.copying = false
+		pp.finishCopy()
+	}
+	if pp.extended {
+		return nil
+	}
+	return pp.sendReadyForQuery()
+}
+
+func (pp *PostgresProtocolImpl) handleQuery(data []byte) (*Request, error) {
+	r := &pgReader{data: data}
+	query := r.readString()
+	if r.err != nil {
+		return nil, pp.sendErrorResponse("ERROR", r.err)
+	}
+	if strings.TrimSpace(strings.TrimRight(strings.TrimSpace(query), ";")) == "" {
+		if err := pp.sendEmptyMessage(pgEmptyQueryResponse); err != nil {
+			return nil, err
+		}
+		return nil, pp.sendReadyForQuery()
+	}
+	if copyIn, ok, err := parseCopyFromStdin(query); ok {
+		if err != nil {
+			if err = pp.sendErrorResponse("ERROR", err); err != nil {
+				return nil, err
+			}
+			return nil, pp.sendReadyForQuery()
+		}
+		return nil, pp.startCopy(copyIn)
+	}
+	return &Request{cmd: int(COM_QUERY), data: []byte(query)}, nil
+}
+
+// rewritePostgresPlaceholders rewrites the placeholders $n in the query into ?.
+// It returns the parameter of each ? and the count of the parameters.
+func rewritePostgresPlaceholders(query string) (string, []int, int) {
+	var sb strings.Builder
+	var indexes []int
+	count := 0
+	var quote byte
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '$' && i+1 < len(query) && query[i+1] >= '0' && query[i+1] <= '9':
+			j := i + 1
+			for j < len(query) && query[j] >= '0' && query[j] <= '9' {
+				j++
+			}
+			n, err := strconv.Atoi(query[i+1 : j])
+			if err == nil && n > 0 {
+				indexes = append(indexes, n-1)
+				if n > count {
+					count = n
+				}
+				sb.WriteByte('?')
+				i = j - 1
+				continue
+			}
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String(), indexes, count
+}
+
+func (pp *PostgresProtocolImpl) handleParse(data []byte) (*Request, error) {
+	r := &pgReader{data: data}
+	name := r.readString()
+	query := r.readString()
+	n := int(r.readInt16())
+	oids := make([]uint32, n)
+	for i := range oids {
+		oids[i] = uint32(r.readInt32())
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	// the unnamed statement is replaced by the next Parse message
+	if name != "" {
+		if _, ok := pp.statements[name]; ok {
+			return nil, moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("prepared statement \"%s\" already exists", name))
+		}
+	}
+	pp.closeStatement(name)
+
+	sql, indexes, count := rewritePostgresPlaceholders(query)
+	if count > len(oids) {
+		oids = append(oids, make([]uint32, count-len(oids))...)
+	}
+	pp.parsing = &pgStatement{
+		paramOIDs:    oids,
+		paramIndexes: indexes,
+	}
+	pp.parsingName = name
+	return &Request{cmd: int(COM_STMT_PREPARE), data: []byte(sql)}, nil
+}
+
+// SendPrepareResponse answers the Parse message after the statement has been prepared.
+func (pp *PostgresProtocolImpl) SendPrepareResponse(stmt *PrepareStmt) error {
+	pp.GetLock().Lock()
+	defer pp.GetLock().Unlock()
+
+	ps := pp.parsing
+	if ps == nil {
+		return moerr.NewError(moerr.INTERNAL_ERROR, "can not get the statement of the Parse message")
+	}
+	ps.name = stmt.Name
+	pp.statements[pp.parsingName] = ps
+
+	// the types of the parameters not specified by the client are inferred by the plan
+	dcPrepare, ok := stmt.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
+	if !ok {
+		return moerr.NewError(moerr.INTERNAL_ERROR, "can not get prepare plan in prepareStmt")
+	}
+	for i, index := range ps.paramIndexes {
+		if ps.paramOIDs[index] == 0 && i < len(dcPrepare.Prepare.ParamTypes) {
+			ps.paramOIDs[index] = pgTypeOfEngineType(types.T(dcPrepare.Prepare.ParamTypes[i]))
+		}
+	}
+	for i := range ps.paramOIDs {
+		if ps.paramOIDs[i] == 0 {
+			ps.paramOIDs[i] = pgTypeText
+		}
+	}
+	return pp.sendEmptyMessage(pgParseComplete)
+}
+
+func (pp *PostgresProtocolImpl) closeStatement(name string) {
+	ps, ok := pp.statements[name]
+	if !ok {
+		return
+	}
+	delete(pp.statements, name)
+	if pp.ses != nil {
+		pp.ses.RemovePrepareStmt(ps.name)
+	}
+}
+
+func (pp *PostgresProtocolImpl) getStatement(name string) (*pgStatement, *PrepareStmt, error) {
+	ps, ok := pp.statements[name]
+	if !ok {
+		return nil, nil, moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("prepared statement \"%s\" does not exist", name))
+	}
+	stmt, err := pp.ses.GetPrepareStmt(ps.name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ps, stmt, nil
+}
+
+func (pp *PostgresProtocolImpl) handleBind(data []byte) error {
+	r := &pgReader{data: data}
+	portalName := r.readString()
+	stmtName := r.readString()
+	formats := make([]int16, r.readInt16())
+	for i := range formats {
+		formats[i] = r.readInt16()
+	}
+	values := make([][]byte, r.readInt16())
+	for i := range values {
+		length := r.readInt32()
+		if length >= 0 {
+			values[i] = r.readBytes(int(length))
+		}
+	}
+	resultFormats := make([]int16, r.readInt16())
+	for i := range resultFormats {
+		resultFormats[i] = r.readInt16()
+	}
+	if r.err != nil {
+		return r.err
+	}
+
+	ps, _, err := pp.getStatement(stmtName)
+	if err != nil {
+		return err
+	}
+	if len(values) != len(ps.paramOIDs) {
+		return moerr.NewError(moerr.INVALID_INPUT,
+			fmt.Sprintf("bind message supplies %d parameters, but prepared statement \"%s\" requires %d", len(values), stmtName, len(ps.paramOIDs)))
+	}
+
+	vars := make([]any, len(values))
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		format := pgFormatText
+		if len(formats) == 1 {
+			format = formats[0]
+		} else if i < len(formats) {
+			format = formats[i]
+		}
+		if vars[i], err = decodePostgresParameter(ps.paramOIDs[i], format, value); err != nil {
+			return err
+		}
+	}
+
+	pp.portals[portalName] = &pgPortal{
+		stmt:          ps,
+		vars:          vars,
+		resultFormats: resultFormats,
+	}
+	return pp.sendEmptyMessage(pgBindComplete)
+}
+
+// decodePostgresParameter decodes the value of the parameter in the Bind message.
+func decodePostgresParameter(oid uint32, format int16, value []byte) (any, error) {
+	if format == pgFormatText {
+		s := string(value)
+		switch oid {
+		case pgTypeInt2, pgTypeInt4, pgTypeInt8:
+			return strconv.ParseInt(s, 10, 64)
+		case pgTypeFloat4, pgTypeFloat8:
+			return strconv.ParseFloat(s, 64)
+		case pgTypeBool:
+			return strconv.ParseBool(s)
+		default:
+			return s, nil
+		}
+	}
+
+	switch oid {
+	case pgTypeInt2:
+		if len(value) == 2 {
+			return int64(int16(binary.BigEndian.Uint16(value))), nil
+		}
+	case pgTypeInt4:
+		if len(value) == 4 {
+			return int64(int32(binary.BigEndian.Uint32(value))), nil
+		}
+	case pgTypeInt8:
+		if len(value) == 8 {
+			return int64(binary.BigEndian.Uint64(value)), nil
+		}
+	case pgTypeFloat4:
+		if len(value) == 4 {
+			return float64(math.Float32frombits(binary.BigEndian.Uint32(value))), nil
+		}
+	case pgTypeFloat8:
+		if len(value) == 8 {
+			return math.Float64frombits(binary.BigEndian.Uint64(value)), nil
+		}
+	case pgTypeBool:
+		if len(value) == 1 {
+			return value[0] != 0, nil
+		}
+	case pgTypeText, pgTypeVarchar, pgTypeBpchar, pgTypeJson, pgTypeUnknown, pgTypeBytea:
+		return string(value), nil
+	default:
+		return nil, moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("unsupported binary format of the parameter type %d", oid))
+	}
+	return nil, moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("invalid binary value of the parameter type %d", oid))
+}
+
+func (pp *PostgresProtocolImpl) handleDescribe(data []byte) error {
+	r := &pgReader{data: data}
+	kind := r.readBytes(1)
+	name := r.readString()
+	if r.err != nil {
+		return r.err
+	}
+
+	var ps *pgStatement
+	var resultFormats []int16
+	switch kind[0] {
+	case 'S':
+		var err error
+		if ps, _, err = pp.getStatement(name); err != nil {
+			return err
+		}
+		pp.openMessage(pgParameterDescription)
+		pp.msg = appendPGInt16(pp.msg, int16(len(ps.paramOIDs)))
+		for _, oid := range ps.paramOIDs {
+			pp.msg = appendPGInt32(pp.msg, int32(oid))
+		}
+		if err = pp.closeMessage(); err != nil {
+			return err
+		}
+	case 'P':
+		portal, ok := pp.portals[name]
+		if !ok {
+			return moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("portal \"%s\" does not exist", name))
+		}
+		ps = portal.stmt
+		resultFormats = portal.resultFormats
+	default:
+		return moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("invalid DESCRIBE message subtype %d", kind[0]))
+	}
+
+	stmt, err := pp.ses.GetPrepareStmt(ps.name)
+	if err != nil {
+		return err
+	}
+	columns := plan2.GetResultColumnsFromPlan(stmt.PreparePlan)
+	if len(columns) == 0 {
+		return pp.sendEmptyMessage(pgNoData)
+	}
+	pp.columns = pp.columns[:0]
+	for _, c := range columns {
+		column := new(MysqlColumn)
+		column.SetName(c.Name)
+		if err = convertEngineTypeToMysqlType(types.T(c.Typ.Id), column); err != nil {
+			return err
+		}
+		pp.columns = append(pp.columns, column)
+	}
+	return pp.sendRowDescription(resultFormats)
+}
+
+func (pp *PostgresProtocolImpl) handleExecute(data []byte) (*Request, error) {
+	r := &pgReader{data: data}
+	name := r.readString()
+	// TODO: suspend the portal after the max rows, all the rows are sent now
+	r.readInt32()
+	if r.err != nil {
+		return nil, r.err
+	}
+	portal, ok := pp.portals[name]
+	if !ok {
+		return nil, moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("portal \"%s\" does not exist", name))
+	}
+	stmtID, err := GetPrepareStmtID(portal.stmt.name)
+	if err != nil {
+		return nil, err
+	}
+	pp.executing = portal
+
+	// the data of COM_STMT_EXECUTE is the id of the statement and the name of the portal,
+	// the parameters of the portal are got by ParseExecuteData.
+	payload := make([]byte, 4, 4+len(name))
+	binary.LittleEndian.PutUint32(payload, uint32(stmtID))
+	payload = append(payload, name...)
+	return &Request{cmd: int(COM_STMT_EXECUTE), data: payload}, nil
+}
+
+// ParseExecuteData gets the parameters of the portal being executed.
+func (pp *PostgresProtocolImpl) ParseExecuteData(stmt *PrepareStmt, data []byte, pos int) (names []string, vars []any, err error) {
+	portal := pp.executing
+	if portal == nil || portal.stmt.name != stmt.Name {
+		return nil, nil, moerr.NewError(moerr.INTERNAL_ERROR, "can not get the portal of the Execute message")
+	}
+	names = make([]string, len(portal.stmt.paramIndexes))
+	vars = make([]any, len(portal.stmt.paramIndexes))
+	for i, index := range portal.stmt.paramIndexes {
+		names[i] = getPrepareStmtSessionVarName(i)
+		vars[i] = portal.vars[index]
+	}
+	return names, vars, nil
+}
+
+func (pp *PostgresProtocolImpl) handleClose(data []byte) error {
+	r := &pgReader{data: data}
+	kind := r.readBytes(1)
+	name := r.readString()
+	if r.err != nil {
+		return r.err
+	}
+	switch kind[0] {
+	case 'S':
+		pp.closeStatement(name)
+	case 'P':
+		delete(pp.portals, name)
+	default:
+		return moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("invalid CLOSE message subtype %d", kind[0]))
+	}
+	return pp.sendEmptyMessage(pgCloseComplete)
+}
+
+// resultFormat returns the format of the i-th column of the result set.
+func resultFormat(formats []int16, i int) int16 {
+	switch {
+	case len(formats) == 0:
+		return pgFormatText
+	case len(formats) == 1:
+		return formats[0]
+	case i < len(formats):
+		return formats[i]
+	}
+	return pgFormatText
+}
+
+func (pp *PostgresProtocolImpl) sendRowDescription(formats []int16) error {
+	pp.openMessage(pgRowDescription)
+	pp.msg = appendPGInt16(pp.msg, int16(len(pp.columns)))
+	for i, column := range pp.columns {
+		oid, size := pgTypeOfColumn(column)
+		pp.msg = appendPGString(pp.msg, column.Name())
+		// the oid of the table and the number of the column
+		pp.msg = appendPGInt32(pp.msg, 0)
+		pp.msg = appendPGInt16(pp.msg, 0)
+		pp.msg = appendPGInt32(pp.msg, int32(oid))
+		pp.msg = appendPGInt16(pp.msg, size)
+		// the type modifier
+		pp.msg = appendPGInt32(pp.msg, -1)
+		pp.msg = appendPGInt16(pp.msg, resultFormat(formats, i))
+	}
+	return pp.closeMessage()
+}
+
+// pgTypeOfColumn returns the oid and the size of the type of the column.
+func pgTypeOfColumn(column *MysqlColumn) (uint32, int16) {
+	switch column.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		return pgTypeBool, 1
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_YEAR:
+		return pgTypeInt2, 2
+	case defines.MYSQL_TYPE_SHORT:
+		if !column.IsSigned() {
+			return pgTypeInt4, 4
+		}
+		return pgTypeInt2, 2
+	case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+		if !column.IsSigned() {
+			return pgTypeInt8, 8
+		}
+		return pgTypeInt4, 4
+	case defines.MYSQL_TYPE_LONGLONG:
+		if !column.IsSigned() {
+			return pgTypeNumeric, -1
+		}
+		return pgTypeInt8, 8
+	case defines.MYSQL_TYPE_FLOAT:
+		return pgTypeFloat4, 4
+	case defines.MYSQL_TYPE_DOUBLE:
+		return pgTypeFloat8, 8
+	case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL:
+		return pgTypeNumeric, -1
+	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
+		return pgTypeVarchar, -1
+	case defines.MYSQL_TYPE_STRING:
+		return pgTypeBpchar, -1
+	case defines.MYSQL_TYPE_JSON:
+		return pgTypeJson, -1
+	case defines.MYSQL_TYPE_UUID:
+		return pgTypeUUID, 16
+	case defines.MYSQL_TYPE_DATE:
+		return pgTypeDate, 4
+	case defines.MYSQL_TYPE_TIME:
+		return pgTypeTime, 8
+	case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+		return pgTypeTimestamp, 8
+	default:
+		return pgTypeText, -1
+	}
+}
+
+// pgTypeOfEngineType returns the oid of the type of the engine.
+func pgTypeOfEngineType(typ types.T) uint32 {
+	column := new(MysqlColumn)
+	if err := convertEngineTypeToMysqlType(typ, column); err != nil {
+		return pgTypeText
+	}
+	oid, _ := pgTypeOfColumn(column)
+	return oid
+}
+
+func (pp *PostgresProtocolImpl) SendColumnCountPacket(count uint64) error {
+	pp.columns = pp.columns[:0]
+	pp.rows = 0
+	return nil
+}
+
+func (pp *PostgresProtocolImpl) SendColumnDefinitionPacket(column Column, cmd int) error {
+	mysqlColumn, ok := column.(*MysqlColumn)
+	if !ok {
+		return fmt.Errorf("sendColumn need MysqlColumn")
+	}
+	pp.columns = append(pp.columns, mysqlColumn)
+	return nil
+}
+
+// SendEOFPacketIf sends the RowDescription after the column definitions. The RowDescription of
+// the portal has been sent by the Describe message in the extended query.
+func (pp *PostgresProtocolImpl) SendEOFPacketIf(warnings uint16, status uint16) error {
+	if pp.executing != nil {
+		return nil
+	}
+	return pp.sendRowDescription(nil)
+}
+
+func (pp *PostgresProtocolImpl) SendResultSetTextBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	return pp.SendResultSetTextBatchRowSpeedup(mrs, cnt)
+}
+
+func (pp *PostgresProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error {
+	if cnt == 0 {
+		return nil
+	}
+
+	pp.GetLock().Lock()
+	defer pp.GetLock().Unlock()
+
+	var formats []int16
+	if pp.executing != nil {
+		formats = pp.executing.resultFormats
+	}
+	for r := uint64(0); r < cnt; r++ {
+		if err := pp.sendDataRow(mrs, r, formats); err != nil {
+			return err
+		}
+	}
+	pp.rows += cnt
+	return nil
+}
+
+func (pp *PostgresProtocolImpl) sendDataRow(mrs *MysqlResultSet, r uint64, formats []int16) error {
+	pp.openMessage(pgDataRow)
+	pp.msg = appendPGInt16(pp.msg, int16(mrs.GetColumnCount()))
+	for i := uint64(0); i < mrs.GetColumnCount(); i++ {
+		column, err := mrs.GetColumn(i)
+		if err != nil {
+			return err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return fmt.Errorf("sendColumn need MysqlColumn")
+		}
+
+		if isNil, err := mrs.ColumnIsNull(r, i); err != nil {
+			return err
+		} else if isNil {
+			pp.msg = appendPGInt32(pp.msg, -1)
+			continue
+		}
+
+		lengthPos := len(pp.msg)
+		pp.msg = appendPGInt32(pp.msg, 0)
+		if resultFormat(formats, int(i)) == pgFormatBinary {
+			pp.msg, err = appendPGBinaryValue(pp.msg, mrs, mysqlColumn, r, i)
+		} else {
+			pp.msg, err = appendPGTextValue(pp.msg, mrs, mysqlColumn, r, i)
+		}
+		if err != nil {
+			return err
+		}
+		binary.BigEndian.PutUint32(pp.msg[lengthPos:], uint32(len(pp.msg)-lengthPos-4))
+	}
+	return pp.closeMessage()
+}
+
+// appendPGTextValue appends the value in the text format.
+func appendPGTextValue(data []byte, mrs *MysqlResultSet, column *MysqlColumn, r, i uint64) ([]byte, error) {
+	switch column.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		value, err := mrs.GetString(r, i)
+		if err != nil {
+			return nil, err
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, err
+		}
+		if b {
+			return append(data, 't'), nil
+		}
+		return append(data, 'f'), nil
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_YEAR:
+		value, err := mrs.GetInt64(r, i)
+		if err != nil {
+			return nil, err
+		}
+		return strconv.AppendInt(data, value, 10), nil
+	case defines.MYSQL_TYPE_LONGLONG:
+		if !column.IsSigned() {
+			value, err := mrs.GetUint64(r, i)
+			if err != nil {
+				return nil, err
+			}
+			return strconv.AppendUint(data, value, 10), nil
+		}
+		value, err := mrs.GetInt64(r, i)
+		if err != nil {
+			return nil, err
+		}
+		return strconv.AppendInt(data, value, 10), nil
+	case defines.MYSQL_TYPE_FLOAT:
+		value, err := mrs.GetFloat64(r, i)
+		if err != nil {
+			return nil, err
+		}
+		return strconv.AppendFloat(data, value, 'g', -1, 32), nil
+	case defines.MYSQL_TYPE_DOUBLE:
+		value, err := mrs.GetFloat64(r, i)
+		if err != nil {
+			return nil, err
+		}
+		return strconv.AppendFloat(data, value, 'g', -1, 64), nil
+	case defines.MYSQL_TYPE_DATE:
+		value, err := mrs.GetValue(r, i)
+		if err != nil {
+			return nil, err
+		}
+		return append(data, value.(types.Date).String()...), nil
+	case defines.MYSQL_TYPE_JSON, defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_UUID,
+		defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_BLOB,
+		defines.MYSQL_TYPE_TIME, defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+		value, err := mrs.GetString(r, i)
+		if err != nil {
+			return nil, err
+		}
+		return append(data, value...), nil
+	default:
+		return nil, fmt.Errorf("unsupported column type %d ", column.ColumnType())
+	}
+}
+
+// appendPGBinaryValue appends the value in the binary format.
+func appendPGBinaryValue(data []byte, mrs *MysqlResultSet, column *MysqlColumn, r, i uint64) ([]byte, error) {
+	oid, _ := pgTypeOfColumn(column)
+	switch oid {
+	case pgTypeInt2, pgTypeInt4, pgTypeInt8:
+		value, err := mrs.GetInt64(r, i)
+		if err != nil {
+			return nil, err
+		}
+		switch oid {
+		case pgTypeInt2:
+			return appendPGInt16(data, int16(value)), nil
+		case pgTypeInt4:
+			return appendPGInt32(data, int32(value)), nil
+		default:
+			return appendPGInt64(data, value), nil
+		}
+	case pgTypeFloat4:
+		value, err := mrs.GetFloat64(r, i)
+		if err != nil {
+			return nil, err
+		}
+		return appendPGInt32(data, int32(math.Float32bits(float32(value)))), nil
+	case pgTypeFloat8:
+		value, err := mrs.GetFloat64(r, i)
+		if err != nil {
+			return nil, err
+		}
+		return appendPGInt64(data, int64(math.Float64bits(value))), nil
+	case pgTypeBool:
+		text, err := appendPGTextValue(nil, mrs, column, r, i)
+		if err != nil {
+			return nil, err
+		}
+		if text[0] == 't' {
+			return append(data, 1), nil
+		}
+		return append(data, 0), nil
+	case pgTypeNumeric:
+		text, err := appendPGTextValue(nil, mrs, column, r, i)
+		if err != nil {
+			return nil, err
+		}
+		return appendPGNumeric(data, string(text))
+	case pgTypeDate:
+		value, err := mrs.GetValue(r, i)
+		if err != nil {
+			return nil, err
+		}
+		t, err := time.Parse("2006-01-02", value.(types.Date).String())
+		if err != nil {
+			return nil, err
+		}
+		return appendPGInt32(data, int32(t.Sub(pgEpoch)/(24*time.Hour))), nil
+	case pgTypeTimestamp:
+		value, err := mrs.GetString(r, i)
+		if err != nil {
+			return nil, err
+		}
+		t, err := time.Parse("2006-01-02 15:04:05.999999", value)
+		if err != nil {
+			return nil, err
+		}
+		return appendPGInt64(data, t.Sub(pgEpoch).Microseconds()), nil
+	case pgTypeUUID:
+		value, err := mrs.GetString(r, i)
+		if err != nil {
+			return nil, err
+		}
+		u, err := uuid.Parse(value)
+		if err != nil {
+			return nil, err
+		}
+		return append(data, u[:]...), nil
+	default:
+		// the binary format of the text types is the text
+		return appendPGTextValue(data, mrs, column, r, i)
+	}
+}
+
+// appendPGNumeric appends the decimal in the binary format of numeric, which are the base 10000 digits
+// with the weight of the first digit, the sign and the count of the decimal digits.
+func appendPGNumeric(data []byte, s string) ([]byte, error) {
+	sign := int16(0)
+	if strings.HasPrefix(s, "-") {
+		sign = 0x4000
+		s = s[1:]
+	}
+	intPart, fracPart := s, ""
+	if idx := strings.IndexByte(s, '.'); idx >= 0 {
+		intPart, fracPart = s[:idx], s[idx+1:]
+	}
+	for _, c := range intPart + fracPart {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("invalid decimal %s", s)
+		}
+	}
+	dscale := int16(len(fracPart))
+
+	// pad the integer part on the left and the fraction part on the right to the groups of 4 digits
+	intPart = strings.TrimLeft(intPart, "0")
+	if pad := len(intPart) % 4; pad != 0 {
+		intPart = strings.Repeat("0", 4-pad) + intPart
+	}
+	if pad := len(fracPart) % 4; pad != 0 {
+		fracPart += strings.Repeat("0", 4-pad)
+	}
+	var digits []int16
+	for i := 0; i < len(intPart); i += 4 {
+		d, _ := strconv.Atoi(intPart[i : i+4])
+		digits = append(digits, int16(d))
+	}
+	weight := int16(len(digits) - 1)
+	for i := 0; i < len(fracPart); i += 4 {
+		d, _ := strconv.Atoi(fracPart[i : i+4])
+		digits = append(digits, int16(d))
+	}
+
+	// strip the zero digits
+	for len(digits) > 0 && digits[0] == 0 {
+		digits = digits[1:]
+		weight--
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) == 0 {
+		weight = 0
+		sign = 0
+	}
+
+	data = appendPGInt16(data, int16(len(digits)))
+	data = appendPGInt16(data, weight)
+	data = appendPGInt16(data, sign)
+	data = appendPGInt16(data, dscale)
+	for _, d := range digits {
+		data = appendPGInt16(data, d)
+	}
+	return data, nil
+}
+
+// commandTag returns the tag of the CommandComplete message of the statement.
+func (pp *PostgresProtocolImpl) commandTag(rows uint64) string {
+	if pp.copying {
+		return fmt.Sprintf("COPY %d", rows)
+	}
+	var stmt tree.Statement
+	if pp.ses != nil {
+		stmt = pp.ses.GetStatement()
+	}
+	if st, ok := stmt.(*tree.Execute); ok {
+		if prepareStmt, err := pp.ses.GetPrepareStmt(string(st.Name)); err == nil {
+			stmt = prepareStmt.PrepareStmt
+		}
+	}
+
+	switch stmt.(type) {
+	case *tree.Insert:
+		return fmt.Sprintf("INSERT 0 %d", rows)
+	case *tree.Update:
+		return fmt.Sprintf("UPDATE %d", rows)
+	case *tree.Delete:
+		return fmt.Sprintf("DELETE %d", rows)
+	case *tree.Load:
+		return fmt.Sprintf("COPY %d", rows)
+	case *tree.BeginTransaction:
+		return "BEGIN"
+	case *tree.CommitTransaction:
+		return "COMMIT"
+	case *tree.RollbackTransaction:
+		return "ROLLBACK"
+	case *tree.CreateDatabase:
+		return "CREATE DATABASE"
+	case *tree.DropDatabase:
+		return "DROP DATABASE"
+	case *tree.CreateTable:
+		return "CREATE TABLE"
+	case *tree.DropTable:
+		return "DROP TABLE"
+	case *tree.AlterTable:
+		return "ALTER TABLE"
+	case *tree.CreateIndex:
+		return "CREATE INDEX"
+	case *tree.DropIndex:
+		return "DROP INDEX"
+	case *tree.CreateView:
+		return "CREATE VIEW"
+	case *tree.DropView:
+		return "DROP VIEW"
+	case *tree.SetVar, *tree.Use:
+		return "SET"
+	case *tree.PrepareStmt, *tree.PrepareString:
+		return "PREPARE"
+	case *tree.Deallocate:
+		return "DEALLOCATE"
+	default:
+		return fmt.Sprintf("SELECT %d", rows)
+	}
+}
+
+func (pp *PostgresProtocolImpl) sendOKPacket(affectedRows uint64, lastInsertId uint64, status uint16, warnings uint16, message string) error {
+	return pp.sendCommandComplete(pp.commandTag(affectedRows))
+}
+
+func (pp *PostgresProtocolImpl) sendEOFOrOkPacket(warnings uint16, status uint16) error {
+	return pp.sendCommandComplete(pp.commandTag(pp.rows))
+}
+
+func (pp *PostgresProtocolImpl) SendResponse(resp *Response) error {
+	pp.GetLock().Lock()
+	defer pp.GetLock().Unlock()
+
+	switch resp.category {
+	case OkResponse:
+		return pp.sendOKPacket(resp.affectedRows, resp.lastInsertId, uint16(resp.status), resp.warnings, "")
+	case EoFResponse:
+		return nil
+	case ErrorResponse:
+		err := resp.data.(error)
+		if err == nil {
+			return pp.sendOKPacket(0, 0, uint16(resp.status), 0, "")
+		}
+		return pp.sendErrorResponse("ERROR", err)
+	case ResultResponse:
+		mer := resp.data.(*MysqlExecutionResult)
+		if mer == nil {
+			return pp.sendOKPacket(0, 0, uint16(resp.status), 0, "")
+		}
+		if mer.Mrs() == nil {
+			return pp.sendOKPacket(mer.AffectedRows(), mer.InsertID(), uint16(resp.status), mer.Warnings(), "")
+		}
+		return pp.sendResultSet(mer.Mrs())
+	default:
+		return fmt.Errorf("unsupported response:%d ", resp.category)
+	}
+}
+
+func (pp *PostgresProtocolImpl) sendResultSet(mrs *MysqlResultSet) error {
+	pp.columns = pp.columns[:0]
+	for i := uint64(0); i < mrs.GetColumnCount(); i++ {
+		column, err := mrs.GetColumn(i)
+		if err != nil {
+			return err
+		}
+		if err = pp.SendColumnDefinitionPacket(column, int(COM_QUERY)); err != nil {
+			return err
+		}
+	}
+	var formats []int16
+	if pp.executing != nil {
+		formats = pp.executing.resultFormats
+	} else if err := pp.sendRowDescription(nil); err != nil {
+		return err
+	}
+	for r := uint64(0); r < mrs.GetRowCount(); r++ {
+		if err := pp.sendDataRow(mrs, r, formats); err != nil {
+			return err
+		}
+	}
+	return pp.sendCommandComplete(pp.commandTag(mrs.GetRowCount()))
+}
@@ -0,0 +1,352 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/smartystreets/goconvey/convey"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// pgMessages splits the data written by the server into the messages
+func pgMessages(data []byte) []*PostgresMessage {
+	var msgs []*PostgresMessage
+	for len(data) >= 5 {
+		length := int(binary.BigEndian.Uint32(data[1:5]))
+		msgs = append(msgs, &PostgresMessage{Type: data[0], Payload: data[5 : 1+length]})
+		data = data[1+length:]
+	}
+	return msgs
+}
+
+func newTestPostgresProtocol(t *testing.T, ctrl *gomock.Controller) (*PostgresProtocolImpl, *[]byte) {
+	var written []byte
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, opts goetty.WriteOptions) error {
+		written = append(written, msg.([]byte)...)
+		return nil
+	}).AnyTimes()
+	ioses.EXPECT().Flush(gomock.Any()).Return(nil).AnyTimes()
+
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	if err != nil {
+		t.Error(err)
+	}
+	sv.PostgresAuthMethod = pgAuthMethodSCRAM
+	return NewPostgresProtocol(1, ioses, 1024, sv), &written
+}
+
+func Test_postgresCodec(t *testing.T) {
+	convey.Convey("decode postgresql messages", t, func() {
+		c := NewPostgresCodec()
+		in := buf.NewByteBuf(1024)
+
+		startup := appendPGInt32(nil, 0)
+		startup = appendPGInt32(startup, int32(pgProtocolVersion))
+		startup = appendPGString(startup, "user")
+		startup = appendPGString(startup, "dump")
+		startup = append(startup, 0)
+		binary.BigEndian.PutUint32(startup, uint32(len(startup)))
+
+		query := []byte{pgQueryMessage, 0, 0, 0, 0}
+		query = appendPGString(query, "select 1")
+		binary.BigEndian.PutUint32(query[1:], uint32(len(query)-1))
+
+		_, _ = in.Write(startup)
+		_, _ = in.Write(query[:7])
+
+		msg, ok, err := c.Decode(in)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(msg.(*PostgresMessage).Type, convey.ShouldEqual, pgStartupMessage)
+		convey.So(msg.(*PostgresMessage).Payload, convey.ShouldResemble, startup[4:])
+
+		// the query is incomplete
+		_, ok, err = c.Decode(in)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+
+		_, _ = in.Write(query[7:])
+		msg, ok, err = c.Decode(in)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(msg.(*PostgresMessage).Type, convey.ShouldEqual, pgQueryMessage)
+		convey.So(string(msg.(*PostgresMessage).Payload), convey.ShouldEqual, "select 1\x00")
+
+		_, _ = in.Write([]byte{pgQueryMessage, 0, 0, 0, 1})
+		_, _, err = c.Decode(in)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_postgresPassword(t *testing.T) {
+	convey.Convey("md5 password", t, func() {
+		salt := []byte{1, 2, 3, 4}
+		inner := md5.Sum([]byte("111dump"))
+		outer := md5.Sum(append([]byte(hex.EncodeToString(inner[:])), salt...))
+		response := "md5" + hex.EncodeToString(outer[:])
+
+		convey.So(checkPostgresMD5Password([]byte("111"), "dump", salt, response), convey.ShouldBeTrue)
+		convey.So(checkPostgresMD5Password([]byte("112"), "dump", salt, response), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("scram-sha-256 password", t, func() {
+		salt := []byte("0123456789abcdef")
+		clientFirst := "n,,n=,r=clientnonce"
+		serverFirst, nonce, err := makeScramServerFirst(clientFirst, salt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(strings.HasPrefix(nonce, "clientnonce"), convey.ShouldBeTrue)
+		convey.So(serverFirst, convey.ShouldStartWith, "r="+nonce+",s=")
+
+		// the proof made by the client
+		clientFinalWithoutProof := "c=biws,r=" + nonce
+		authMessage := "n=,r=clientnonce," + serverFirst + "," + clientFinalWithoutProof
+		saltedPassword := pbkdf2.Key([]byte("111"), salt, pgScramIterations, sha256.Size, sha256.New)
+		clientKey := scramHMAC(saltedPassword, "Client Key")
+		storedKey := sha256.Sum256(clientKey)
+		clientSignature := scramHMAC(storedKey[:], authMessage)
+		proof := make([]byte, len(clientKey))
+		for i := range proof {
+			proof[i] = clientKey[i] ^ clientSignature[i]
+		}
+		clientFinal := clientFinalWithoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof)
+
+		serverFinal, err := checkScramClientFinal([]byte("111"), salt, clientFirst, serverFirst, nonce, clientFinal)
+		convey.So(err, convey.ShouldBeNil)
+		serverSignature := scramHMAC(scramHMAC(saltedPassword, "Server Key"), authMessage)
+		convey.So(hmac.Equal([]byte(serverFinal), []byte("v="+base64.StdEncoding.EncodeToString(serverSignature))), convey.ShouldBeTrue)
+
+		_, err = checkScramClientFinal([]byte("112"), salt, clientFirst, serverFirst, nonce, clientFinal)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		_, _, err = makeScramServerFirst("p=tls-server-end-point,,n=,r=x", salt)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_PostgresProtocolImpl_startup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	convey.Convey("startup without the password", t, func() {
+		pp, written := newTestPostgresProtocol(t, ctrl)
+		pp.SetSkipCheckUser(true)
+
+		startup := appendPGInt32(nil, int32(pgProtocolVersion))
+		startup = appendPGString(startup, "user")
+		startup = appendPGString(startup, "dump")
+		startup = appendPGString(startup, "database")
+		startup = appendPGString(startup, "db1")
+		startup = append(startup, 0)
+
+		code, err := pp.handleStartup(startup)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(code, convey.ShouldEqual, pgProtocolVersion)
+		convey.So(pp.IsEstablished(), convey.ShouldBeTrue)
+		convey.So(pp.GetUserName(), convey.ShouldEqual, "dump")
+		convey.So(pp.GetDatabaseName(), convey.ShouldEqual, "db1")
+
+		msgs := pgMessages(*written)
+		convey.So(msgs[0].Type, convey.ShouldEqual, pgAuthentication)
+		convey.So(msgs[0].Payload, convey.ShouldResemble, []byte{0, 0, 0, 0})
+		convey.So(msgs[len(msgs)-2].Type, convey.ShouldEqual, pgBackendKeyData)
+		convey.So(msgs[len(msgs)-1].Type, convey.ShouldEqual, pgReadyForQuery)
+		convey.So(msgs[len(msgs)-1].Payload, convey.ShouldResemble, []byte{'I'})
+	})
+
+	convey.Convey("ssl request and sasl", t, func() {
+		pp, written := newTestPostgresProtocol(t, ctrl)
+
+		code, err := pp.handleStartup(appendPGInt32(nil, int32(pgSSLRequestCode)))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(code, convey.ShouldEqual, pgSSLRequestCode)
+
+		startup := appendPGInt32(nil, int32(pgProtocolVersion))
+		startup = appendPGString(startup, "user")
+		startup = appendPGString(startup, "dump")
+		startup = append(startup, 0)
+		_, err = pp.handleStartup(startup)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(pp.IsEstablished(), convey.ShouldBeFalse)
+
+		msgs := pgMessages(*written)
+		convey.So(msgs, convey.ShouldHaveLength, 1)
+		convey.So(int32(binary.BigEndian.Uint32(msgs[0].Payload)), convey.ShouldEqual, pgAuthenticationSASL)
+		convey.So(string(msgs[0].Payload[4:]), convey.ShouldEqual, pgScramMechanism+"\x00\x00")
+
+		_, err = pp.handleStartup(appendPGInt32(nil, 1<<16))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_PostgresProtocolImpl_resultSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	convey.Convey("send the result set", t, func() {
+		pp, written := newTestPostgresProtocol(t, ctrl)
+
+		mrs := &MysqlResultSet{}
+		c1 := &MysqlColumn{}
+		c1.SetName("a")
+		c1.SetColumnType(defines.MYSQL_TYPE_LONG)
+		c1.SetSigned(true)
+		c2 := &MysqlColumn{}
+		c2.SetName("b")
+		c2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(c1)
+		mrs.AddColumn(c2)
+		mrs.AddRow([]interface{}{int32(1), "x"})
+		mrs.AddRow([]interface{}{int32(-2), nil})
+
+		convey.So(pp.SendColumnCountPacket(2), convey.ShouldBeNil)
+		convey.So(pp.SendColumnDefinitionPacket(c1, int(COM_QUERY)), convey.ShouldBeNil)
+		convey.So(pp.SendColumnDefinitionPacket(c2, int(COM_QUERY)), convey.ShouldBeNil)
+		convey.So(pp.SendEOFPacketIf(0, 0), convey.ShouldBeNil)
+		convey.So(pp.SendResultSetTextBatchRowSpeedup(mrs, 2), convey.ShouldBeNil)
+		convey.So(pp.sendEOFOrOkPacket(0, 0), convey.ShouldBeNil)
+
+		msgs := pgMessages(*written)
+		convey.So(msgs, convey.ShouldHaveLength, 4)
+
+		convey.So(msgs[0].Type, convey.ShouldEqual, pgRowDescription)
+		r := &pgReader{data: msgs[0].Payload}
+		convey.So(r.readInt16(), convey.ShouldEqual, 2)
+		convey.So(r.readString(), convey.ShouldEqual, "a")
+		r.readInt32()
+		r.readInt16()
+		convey.So(uint32(r.readInt32()), convey.ShouldEqual, pgTypeInt4)
+
+		convey.So(msgs[1].Type, convey.ShouldEqual, pgDataRow)
+		convey.So(msgs[1].Payload, convey.ShouldResemble, []byte{0, 2, 0, 0, 0, 1, '1', 0, 0, 0, 1, 'x'})
+		convey.So(msgs[2].Payload, convey.ShouldResemble, []byte{0, 2, 0, 0, 0, 2, '-', '2', 0xff, 0xff, 0xff, 0xff})
+
+		convey.So(msgs[3].Type, convey.ShouldEqual, pgCommandComplete)
+		convey.So(string(msgs[3].Payload), convey.ShouldEqual, "SELECT 2\x00")
+	})
+}
+
+func Test_appendPGNumeric(t *testing.T) {
+	convey.Convey("numeric in the binary format", t, func() {
+		kases := []struct {
+			input  string
+			output []int16
+		}{
+			{"12345.678", []int16{3, 1, 0, 3, 1, 2345, 6780}},
+			{"-0.0001", []int16{1, -1, 0x4000, 4, 1}},
+			{"0.00", []int16{0, 0, 0, 2}},
+			{"10000", []int16{1, 1, 0, 0, 1}},
+		}
+		for _, kase := range kases {
+			data, err := appendPGNumeric(nil, kase.input)
+			convey.So(err, convey.ShouldBeNil)
+			var output []int16
+			for i := 0; i < len(data); i += 2 {
+				output = append(output, int16(binary.BigEndian.Uint16(data[i:])))
+			}
+			convey.So(output, convey.ShouldResemble, kase.output)
+		}
+
+		_, err := appendPGNumeric(nil, "1e10")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_rewritePostgresPlaceholders(t *testing.T) {
+	convey.Convey("rewrite $n into ?", t, func() {
+		sql, indexes, count := rewritePostgresPlaceholders("select a, '$1' from t where a = $2 and b = $1 and c = \"$3\"")
+		convey.So(sql, convey.ShouldEqual, "select a, '$1' from t where a = ? and b = ? and c = \"$3\"")
+		convey.So(indexes, convey.ShouldResemble, []int{1, 0})
+		convey.So(count, convey.ShouldEqual, 2)
+	})
+}
+
+func Test_parseCopyFromStdin(t *testing.T) {
+	convey.Convey("parse the COPY statement", t, func() {
+		_, ok, _ := parseCopyFromStdin("select 1")
+		convey.So(ok, convey.ShouldBeFalse)
+
+		copyIn, ok, err := parseCopyFromStdin("COPY db1.t1 (a, b) FROM STDIN;")
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(copyIn.table, convey.ShouldResemble, []string{"db1", "t1"})
+		convey.So(copyIn.csv, convey.ShouldBeFalse)
+		convey.So(copyIn.delim, convey.ShouldEqual, '\t')
+		convey.So(copyIn.loadStatement("/tmp/a.csv"), convey.ShouldEqual,
+			"load data infile '/tmp/a.csv' into table `db1`.`t1` fields terminated by '\t' enclosed by '\"' lines terminated by '\\n' (`a`, `b`)")
+
+		copyIn, _, err = parseCopyFromStdin("copy \"my \"\"t\"\".x`\" (\"a b\", c) from stdin")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(copyIn.table, convey.ShouldResemble, []string{`my "t".x` + "`"})
+		convey.So(copyIn.columns, convey.ShouldResemble, []string{"a b", "c"})
+		convey.So(copyIn.loadStatement("/tmp/a.csv"), convey.ShouldContainSubstring,
+			"into table `my \"t\".x``` fields")
+		convey.So(copyIn.loadStatement("/tmp/a.csv"), convey.ShouldEndWith, "(`a b`, `c`)")
+
+		for _, query := range []string{
+			"copy t1; drop table t2 from stdin",
+			"copy db.t1.x from stdin",
+			"copy t1 (a, b; drop table t2) from stdin",
+			"copy t1 (a,,b) from stdin",
+			`copy "t1 from stdin`,
+		} {
+			copyIn, _, _ = parseCopyFromStdin(query)
+			convey.So(copyIn, convey.ShouldBeNil)
+		}
+
+		copyIn, _, err = parseCopyFromStdin("copy t1 from stdin with (format csv, header true, delimiter '|')")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(copyIn.csv, convey.ShouldBeTrue)
+		convey.So(copyIn.header, convey.ShouldBeTrue)
+		convey.So(copyIn.delim, convey.ShouldEqual, '|')
+		convey.So(copyIn.loadStatement("/tmp/a.csv"), convey.ShouldEndWith, "ignore 1 lines")
+
+		copyIn, _, err = parseCopyFromStdin("copy t1 from stdin csv header")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(copyIn.delim, convey.ShouldEqual, ',')
+
+		_, ok, err = parseCopyFromStdin("copy t1 from stdin with (format binary)")
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("convert the text format", t, func() {
+		f, err := newPGCopyFile(true, '\t')
+		convey.So(err, convey.ShouldBeNil)
+		defer f.Remove()
+
+		convey.So(f.Write([]byte("1\ta\\tb\n2\t\\N\n3\tx")), convey.ShouldBeNil)
+		convey.So(f.Write([]byte(",\"y\\\\\n\\.\n")), convey.ShouldBeNil)
+		convey.So(f.Close(), convey.ShouldBeNil)
+
+		data, err := os.ReadFile(f.file.Name())
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(data), convey.ShouldEqual, "1\t\"a\tb\"\n2\t\\N\n3\t\"x,\"\"y\\\"\n")
+	})
+}
@@ -106,8 +106,18 @@ func (routine *Routine) Loop(routineCtx context.Context) {
 
 		mgr := routine.GetRoutineMgr()
 
-		mpi := routine.protocol.(*MysqlProtocolImpl)
-		mpi.sequenceId = req.seq
+		switch pro := routine.protocol.(type) {
+		case *MysqlProtocolImpl:
+			pro.sequenceId = req.seq
+		case *PostgresProtocolImpl:
+			//the messages not executed by the executor are answered here
+			if req, err = pro.handleRequest(req); err != nil {
+				logutil.Errorf("routine handle postgresql message failed. error:%v ", err)
+			}
+			if req == nil {
+				continue
+			}
+		}
 
 		cancelRequestCtx, cancelRequestFunc := context.WithCancel(routineCtx)
 		routine.executor.(*MysqlCmdExecutor).setCancelRequestFunc(cancelRequestFunc)
@@ -129,6 +139,12 @@ func (routine *Routine) Loop(routineCtx context.Context) {
 			}
 		}
 
+		if pro, ok := routine.protocol.(*PostgresProtocolImpl); ok {
+			if err = pro.finishRequest(); err != nil {
+				logutil.Errorf("routine finish postgresql message failed. error:%v ", err)
+			}
+		}
+
 		if !mgr.getParameterUnit().SV.DisableRecordTimeElapsedOfSqlRequest {
 			logutil.Infof("connection id %d , the time of handling the request %s", routine.getConnID(), time.Since(reqBegin).String())
 		}
@@ -19,6 +19,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"fmt"
 	"os"
 	"sync"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 )
 
 type RoutineManager struct {
@@ -184,6 +186,8 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 				logutil.Infof("TLS handshake ok")
 				rs.UseConn(tlsConn)
 				logutil.Infof("TLS handshake finished")
+				tlsState := tlsConn.ConnectionState()
+				protocol.tlsState = &tlsState
 
 				// tls upgradeOk
 				protocol.SetTlsEstablished()
@@ -206,6 +210,15 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 		return nil
 	}
 
+	// the user in COM_CHANGE_USER is authenticated here like the handshake, since the
+	// authentication method may be negotiated by reading the connection.
+	if len(payload) > 0 && payload[0] == COM_CHANGE_USER {
+		if err := protocol.handleChangeUser(payload[1:]); err != nil {
+			return err
+		}
+		seq = protocol.sequenceId
+	}
+
 	req := routine.protocol.GetRequest(payload)
 	req.seq = seq
 	routine.requestChan <- req
@@ -213,6 +226,133 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 	return nil
 }
 
+// postgresSessionAware creates the routines of the connections from the clients of the postgresql protocol.
+type postgresSessionAware struct {
+	rm *RoutineManager
+}
+
+func (psa *postgresSessionAware) Created(rs goetty.IOSession) {
+	rm := psa.rm
+	pro := NewPostgresProtocol(nextConnectionID(), rs, int(rm.pu.SV.MaxBytesInOutbufToFlush), rm.pu.SV)
+	pro.SetSkipCheckUser(rm.GetSkipCheckUser())
+	exe := NewMysqlCmdExecutor()
+	exe.SetRoutineManager(rm)
+
+	routine := NewRoutine(rm.ctx, pro, exe, rm.pu)
+	routine.SetRoutineMgr(rm)
+	ses := NewSession(routine.protocol, routine.guestMmu, routine.mempool, rm.pu, gSysVariables)
+	ses.SetRequestContext(routine.cancelRoutineCtx)
+	ses.SetDialectType(dialect.POSTGRESQL)
+	routine.SetSession(ses)
+	pro.SetSession(ses)
+
+	// the server waits for the startup message from the client
+	rm.rwlock.Lock()
+	defer rm.rwlock.Unlock()
+	rs.Ref()
+	rm.clients[rs] = routine
+}
+
+func (psa *postgresSessionAware) Closed(rs goetty.IOSession) {
+	psa.rm.Closed(rs)
+}
+
+/*
+cancel the request of the postgresql connection with the secret key
+*/
+func (rm *RoutineManager) cancelPostgresRequest(id uint32, secretKey uint32) {
+	rm.rwlock.Lock()
+	defer rm.rwlock.Unlock()
+	for _, value := range rm.clients {
+		if pro, ok := value.protocol.(*PostgresProtocolImpl); ok && pro.ConnectionID() == id && pro.secretKey == secretKey {
+			logutil.Infof("will cancel the request of the connection %d", id)
+			value.notifyClose()
+			return
+		}
+	}
+}
+
+// PostgresHandler handles the messages from the clients of the postgresql protocol.
+func (rm *RoutineManager) PostgresHandler(rs goetty.IOSession, msg interface{}, received uint64) error {
+	rm.rwlock.RLock()
+	routine, ok := rm.clients[rs]
+	rm.rwlock.RUnlock()
+	if !ok {
+		return errors.New("routine does not exist")
+	}
+
+	protocol := routine.protocol.(*PostgresProtocolImpl)
+	message, ok := msg.(*PostgresMessage)
+	if !ok {
+		return errors.New("message is not PostgresMessage")
+	}
+
+	// finish startup process
+	if !protocol.IsEstablished() {
+		if message.Type == pgPasswordMessage {
+			return protocol.handlePassword(message.Payload)
+		}
+		if message.Type != pgStartupMessage {
+			return fmt.Errorf("unexpected message %q in the startup", message.Type)
+		}
+
+		code, err := protocol.handleStartup(message.Payload)
+		if err != nil {
+			return err
+		}
+		switch code {
+		case pgSSLRequestCode:
+			if rm.tlsConfig == nil || protocol.IsTlsEstablished() {
+				if err = protocol.write([]byte{'N'}); err != nil {
+					return err
+				}
+				return protocol.flush()
+			}
+			if err = protocol.write([]byte{'S'}); err != nil {
+				return err
+			}
+			if err = protocol.flush(); err != nil {
+				return err
+			}
+			logutil.Infof("upgrade to TLS")
+			tlsConn := tls.Server(rs.RawConn(), rm.tlsConfig)
+			newCtx, cancelFun := context.WithTimeout(protocol.ses.requestCtx, 20*time.Second)
+			if err = tlsConn.HandshakeContext(newCtx); err != nil {
+				cancelFun()
+				return err
+			}
+			cancelFun()
+			rs.UseConn(tlsConn)
+			logutil.Infof("TLS handshake finished")
+			tlsState := tlsConn.ConnectionState()
+			protocol.tlsState = &tlsState
+			protocol.SetTlsEstablished()
+		case pgGSSENCRequestCode:
+			if err = protocol.write([]byte{'N'}); err != nil {
+				return err
+			}
+			return protocol.flush()
+		case pgCancelRequestCode:
+			id, secretKey, err := protocol.handleCancelRequest(message.Payload)
+			if err != nil {
+				return err
+			}
+			rm.cancelPostgresRequest(id, secretKey)
+			// the connection of the cancel request is closed at once
+			return errors.New("the cancel request has been handled")
+		}
+		return nil
+	}
+
+	payload := make([]byte, 0, len(message.Payload)+1)
+	payload = append(payload, message.Type)
+	payload = append(payload, message.Payload...)
+	req := routine.protocol.GetRequest(payload)
+	routine.requestChan <- req
+
+	return nil
+}
+
 func NewRoutineManager(ctx context.Context, pu *config.ParameterUnit) (*RoutineManager, error) {
 	rm := &RoutineManager{
 		ctx:     ctx,
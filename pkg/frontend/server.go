@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"fmt"
 
 	"sync/atomic"
 
@@ -32,6 +33,10 @@ type MOServer struct {
 	addr string
 	app  goetty.NetApplication
 	rm   *RoutineManager
+
+	//the listener of the postgresql protocol, it is nil if it is disabled
+	pgAddr string
+	pgApp  goetty.NetApplication
 }
 
 func (mo *MOServer) Start() error {
@@ -48,10 +53,21 @@ func (mo *MOServer) Start() error {
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
+	if mo.pgApp != nil {
+		logutil.Infof("Server Listening on : %s for postgresql clients", mo.pgAddr)
+		if err := mo.pgApp.Start(); err != nil {
+			return err
+		}
+	}
 	return mo.app.Start()
 }
 
 func (mo *MOServer) Stop() error {
+	if mo.pgApp != nil {
+		if err := mo.pgApp.Stop(); err != nil {
+			return err
+		}
+	}
 	return mo.app.Stop()
 }
 
@@ -77,9 +93,25 @@ func NewMOServer(ctx context.Context, addr string, pu *config.ParameterUnit) *MO
 		logutil.Panicf("start server failed with %+v", err)
 	}
 
-	return &MOServer{
+	mo := &MOServer{
 		addr: addr,
 		app:  app,
 		rm:   rm,
 	}
+
+	if pu.SV.EnablePostgres {
+		mo.pgAddr = fmt.Sprintf("%s:%d", pu.SV.Host, pu.SV.PostgresPort)
+		mo.pgApp, err = goetty.NewApplication(mo.pgAddr, rm.PostgresHandler,
+			goetty.WithAppLogger(logutil.GetGlobalLogger()),
+			goetty.WithAppSessionOptions(
+				goetty.WithSessionCodec(NewPostgresCodec()),
+				goetty.WithSessionLogger(logutil.GetGlobalLogger()),
+				goetty.WithSessionRWBUfferSize(1024*1024, 1024*1024)),
+			goetty.WithAppSessionAware(&postgresSessionAware{rm: rm}))
+		if err != nil {
+			logutil.Panicf("start server failed with %+v", err)
+		}
+	}
+
+	return mo
 }
@@ -27,7 +27,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -35,6 +38,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/tidwall/btree"
 )
 
 const MaxPrepareNumberInOneSession = 64
@@ -51,6 +56,8 @@ type TxnHandler struct {
 	txnClient TxnClient
 	ses       *Session
 	txn       TxnOperator
+	// checks are the constraint checks done when txn commits
+	checks *process.TxnChecks
 }
 
 func InitTxnHandler(storage engine.Engine, txnClient TxnClient) *TxnHandler {
@@ -80,6 +87,7 @@ type Session struct {
 
 	Data         [][]interface{}
 	ep           *tree.ExportParam
+	exporter     exportWriter
 	showStmtType ShowStatementType
 
 	txnHandler    *TxnHandler
@@ -87,6 +95,12 @@ type Session struct {
 	storage       engine.Engine
 	sql           string
 
+	//the dialect of the sql from the client, it is mysql if it is not set
+	dialectType dialect.DialectType
+
+	//the statement being executed
+	stmt tree.Statement
+
 	sysVars         map[string]interface{}
 	userDefinedVars map[string]interface{}
 	gSysVars        *GlobalSystemVariables
@@ -115,6 +129,10 @@ type Session struct {
 	timeZone *time.Location
 
 	priv *privilege
+
+	//the roles of the user the row-level security policies are checked with,
+	//they are read once a statement
+	roleSet *btree.Set[int64]
 }
 
 func NewSession(proto Protocol, gm *guest.Mmu, mp *mempool.Mempool, PU *config.ParameterUnit, gSysVars *GlobalSystemVariables) *Session {
@@ -125,9 +143,10 @@ func NewSession(proto Protocol, gm *guest.Mmu, mp *mempool.Mempool, PU *config.P
 		Mempool:  mp,
 		Pu:       PU,
 		ep: &tree.ExportParam{
-			Outfile: false,
-			Fields:  &tree.Fields{},
-			Lines:   &tree.Lines{},
+			Outfile:   false,
+			Fields:    &tree.Fields{},
+			Lines:     &tree.Lines{},
+			NullValue: tree.NULL_VALUE,
 		},
 		txnHandler: txnHandler,
 		//TODO:fix database name after the catalog is ready
@@ -251,7 +270,19 @@ func (ses *Session) GetPrepareStmt(name string) (*PrepareStmt, error) {
 	return nil, errors.New("", fmt.Sprintf("prepare statement '%s' does not exist", name))
 }
 
+// closeCursors removes the temporary files of the cursors of the prepared statements
+func (ses *Session) closeCursors() {
+	for _, prepareStmt := range ses.prepareStmts {
+		if prepareStmt.Cursor != nil {
+			prepareStmt.Cursor.Close()
+		}
+	}
+}
+
 func (ses *Session) RemovePrepareStmt(name string) {
+	if prepareStmt, ok := ses.prepareStmts[name]; ok && prepareStmt.Cursor != nil {
+		prepareStmt.Cursor.Close()
+	}
 	delete(ses.prepareStmts, name)
 }
 
@@ -356,6 +387,22 @@ func (ses *Session) GetSql() string {
 	return ses.sql
 }
 
+func (ses *Session) SetDialectType(dialectType dialect.DialectType) {
+	ses.dialectType = dialectType
+}
+
+func (ses *Session) GetDialectType() dialect.DialectType {
+	return ses.dialectType
+}
+
+func (ses *Session) SetStatement(stmt tree.Statement) {
+	ses.stmt = stmt
+}
+
+func (ses *Session) GetStatement() tree.Statement {
+	return ses.stmt
+}
+
 func (ses *Session) IsTaeEngine() bool {
 	_, ok := ses.storage.(moengine.TxnEngine)
 	return ok
@@ -555,6 +602,27 @@ func (ses *Session) TxnRollback() error {
 	return err
 }
 
+/*
+ResetState resets the session to the state of the new connection.
+It is used by COM_RESET_CONNECTION and COM_CHANGE_USER.
+The active transaction is rolled back, the session variables, the user defined variables
+and the prepared statements are discarded.
+*/
+func (ses *Session) ResetState() error {
+	err := ses.TxnRollback()
+	ses.sysVars = ses.gSysVars.CopySysVarsToSession()
+	ses.userDefinedVars = make(map[string]interface{})
+	ses.closeCursors()
+	ses.prepareStmts = make(map[string]*PrepareStmt)
+	ses.serverStatus = 0
+	ses.optionBits = 0
+	ses.SetOptionBits(OPTION_AUTOCOMMIT)
+	ses.timeZone = time.Local
+	ses.priv = nil
+	ses.roleSet = nil
+	return err
+}
+
 /*
 InActiveTransaction checks if it is in an active transaction.
 */
@@ -591,7 +659,7 @@ func (ses *Session) SetOutputCallback(callback func(interface{}, *batch.Batch) e
 }
 
 // AuthenticateUser verifies the password of the user.
-func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
+func (ses *Session) AuthenticateUser(userInput string) (*userAuthInfo, error) {
 	//Get tenant info
 	tenant, err := GetTenantInfo(userInput)
 	if err != nil {
@@ -627,8 +695,15 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	//Get the password of the user in an independent session
 	sqlForPasswordOfUser := getSqlForPasswordOfUser(tenant.GetUser())
 	rsset, err = executeSQLInBackgroundSession(tenantCtx, ses.GuestMmu, ses.Mempool, ses.Pu, sqlForPasswordOfUser)
+	legacy := false
 	if err != nil {
-		return nil, err
+		//the mo_user has not been upgraded yet
+		sqlForPasswordOfUser = getSqlForPasswordOfLegacyUser(tenant.GetUser())
+		rsset, err = executeSQLInBackgroundSession(tenantCtx, ses.GuestMmu, ses.Mempool, ses.Pu, sqlForPasswordOfUser)
+		if err != nil {
+			return nil, err
+		}
+		legacy = true
 	}
 	if len(rsset) < 1 || rsset[0].GetRowCount() < 1 {
 		return nil, fmt.Errorf("there is no user %s", tenant.GetUser())
@@ -649,6 +724,18 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 		return nil, err
 	}
 
+	user := &userAuthInfo{password: []byte(pwd), plugin: AuthNativePassword, sslType: sslTypeNone}
+	if !legacy {
+		for i, field := range []*string{&user.plugin, &user.sslType, &user.sslCipher, &user.x509Issuer, &user.x509Subject} {
+			if *field, err = rsset[0].GetString(0, uint64(i+3)); err != nil {
+				return nil, err
+			}
+		}
+		if user.plugin == "" {
+			user.plugin = AuthNativePassword
+		}
+	}
+
 	tenant.SetUserID(uint32(userID))
 	tenant.SetDefaultRoleID(uint32(defaultRoleID))
 
@@ -684,7 +771,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 
 	logutil.Info(tenant.String())
 
-	return []byte(pwd), nil
+	return user, nil
 }
 
 func (ses *Session) GetPrivilege() *privilege {
@@ -717,6 +804,7 @@ func (th *TxnHandler) NewTxn() error {
 	if err != nil {
 		return err
 	}
+	th.checks = process.NewTxnChecks()
 	return nil
 }
 
@@ -727,6 +815,7 @@ func (th *TxnHandler) IsValidTxn() bool {
 
 func (th *TxnHandler) SetInvalid() {
 	th.txn = nil
+	th.checks = nil
 }
 
 func (th *TxnHandler) CommitTxn() error {
@@ -742,7 +831,12 @@ func (th *TxnHandler) CommitTxn() error {
 		th.storage.Hints().CommitOrRollbackTimeout,
 	)
 	defer cancel()
-	err := th.txn.Commit(ctx)
+	var err error
+	if err = th.checks.Verify(ctx); err != nil {
+		_ = th.txn.Rollback(ctx)
+	} else {
+		err = th.txn.Commit(ctx)
+	}
 	th.SetInvalid()
 	return err
 }
@@ -769,6 +863,11 @@ func (th *TxnHandler) GetStorage() engine.Engine {
 	return th.storage
 }
 
+// GetTxnChecks returns the checks of the current transaction.
+func (th *TxnHandler) GetTxnChecks() *process.TxnChecks {
+	return th.checks
+}
+
 func (th *TxnHandler) GetTxn() TxnOperator {
 	err := th.ses.TxnStart()
 	if err != nil {
@@ -893,10 +992,11 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 			cols = append(cols, &plan2.ColDef{
 				Name: attr.Attr.Name,
 				Typ: &plan2.Type{
-					Id:        int32(attr.Attr.Type.Oid),
-					Width:     attr.Attr.Type.Width,
-					Precision: attr.Attr.Type.Precision,
-					Scale:     attr.Attr.Type.Scale,
+					Id:         int32(attr.Attr.Type.Oid),
+					Width:      attr.Attr.Type.Width,
+					Precision:  attr.Attr.Type.Precision,
+					Scale:      attr.Attr.Type.Scale,
+					Enumvalues: attr.Attr.EnumValues,
 				},
 				Primary:       attr.Attr.Primary,
 				Default:       attr.Attr.Default,
@@ -942,8 +1042,36 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 					Partition: p,
 				},
 			})
+		} else if constraintDef, ok := def.(*engine.ConstraintDef); ok {
+			c := &plan2.ConstraintDef{}
+			if err = c.Unmarshal([]byte(constraintDef.Constraint)); err != nil {
+				return nil, nil
+			}
+			defs = append(defs, &plan2.TableDefType{
+				Def: &plan2.TableDef_DefType_Constraint{
+					Constraint: c,
+				},
+			})
 		}
 	}
+
+	//the secondary indexes are kept in hidden tables
+	db, err := tcc.txnHandler.GetStorage().Database(ctx, dbName, tcc.txnHandler.GetTxn())
+	if err != nil {
+		return nil, nil
+	}
+	indexDefs, err := colexec.GetIndexDefs(ctx, db, tableName)
+	if err != nil {
+		return nil, nil
+	}
+	for _, indexDef := range indexDefs {
+		defs = append(defs, &plan2.TableDefType{
+			Def: &plan2.TableDef_DefType_Idx{
+				Idx: indexDef,
+			},
+		})
+	}
+
 	if len(properties) > 0 {
 		defs = append(defs, &plan2.TableDefType{
 			Def: &plan2.TableDef_DefType_Properties{
@@ -1091,6 +1219,33 @@ func (tcc *TxnCompilerContext) Cost(obj *plan2.ObjectRef, e *plan2.Expr) (cost *
 	return
 }
 
+// Stats returns the statistics collected by ANALYZE TABLE, the cost model falls back to
+// the default selectivity when there is none.
+func (tcc *TxnCompilerContext) Stats(obj *plan2.ObjectRef) *plan2.TableStats {
+	dbName, err := tcc.ensureDatabaseIsNotEmpty(obj.GetSchemaName())
+	if err != nil || tcc.ses == nil {
+		return nil
+	}
+	table, err := tcc.getRelation(dbName, obj.GetObjName())
+	if err != nil {
+		return nil
+	}
+	return getTableStats(tcc.ses, dbName, obj.GetObjName(), table.GetTableID(tcc.ses.GetRequestContext()))
+}
+
+// Policies returns the predicates of the row-level security policies on the table
+// applying to the roles of the user.
+func (tcc *TxnCompilerContext) Policies(obj *plan2.ObjectRef, stmtType planPb.Query_StatementType) ([]string, error) {
+	dbName, err := tcc.ensureDatabaseIsNotEmpty(obj.GetSchemaName())
+	if err != nil {
+		return nil, err
+	}
+	if tcc.ses == nil {
+		return nil, nil
+	}
+	return getPolicyPredicates(tcc.ses, dbName, obj.GetObjName(), stmtType)
+}
+
 // fakeDataSetFetcher gets the result set from the pipeline and save it in the session.
 // It will not send the result to the client.
 func fakeDataSetFetcher(handle interface{}, dataSet *batch.Batch) error {
@@ -0,0 +1,229 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	// SQLTaskExecutor is the executor code of the tasks created by CREATE TASK
+	SQLTaskExecutor = 1
+
+	taskTimeFormat = "2006-01-02 15:04:05"
+)
+
+// sqlTaskContext is the context of the tasks created by CREATE TASK
+type sqlTaskContext struct {
+	SQL      string `json:"sql"`
+	Database string `json:"database"`
+	User     string `json:"user"`
+}
+
+// NewSQLTaskExecutor returns the task executor running the sql body of the
+// tasks created by CREATE TASK.
+func NewSQLTaskExecutor(pu *config.ParameterUnit) taskservice.TaskExecutor {
+	return func(ctx context.Context, value task.Task) error {
+		var c sqlTaskContext
+		if err := json.Unmarshal(value.Metadata.Context, &c); err != nil {
+			return err
+		}
+		opts := ie.NewOptsBuilder().Database(c.Database).Username(c.User).Finish()
+		return NewInternalExecutor(pu).Exec(ctx, c.SQL, opts)
+	}
+}
+
+// newTaskService returns the task service on the tables of mo_task.
+func newTaskService(ses *Session) taskservice.TaskService {
+	return taskservice.NewTaskService(taskservice.NewSQLTaskStorage(NewInternalExecutor(ses.Pu)))
+}
+
+// taskExists checks the task or the cron task with the name exists or not.
+func taskExists(ctx context.Context, ts taskservice.TaskService, name string) (bool, error) {
+	tasks, err := ts.QueryTask(ctx, taskservice.WithTaskMetadataIDCond(taskservice.EQ, name), taskservice.WithLimitCond(1))
+	if err != nil {
+		return false, err
+	}
+	if len(tasks) != 0 {
+		return true, nil
+	}
+	crons, err := ts.QueryCronTask(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range crons {
+		if c.Metadata.ID == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// handleCreateTask creates a task running the sql body once, or a cron task
+// if the schedule is given.
+func (mce *MysqlCmdExecutor) handleCreateTask(requestCtx context.Context, ct *tree.CreateTask) error {
+	ses := mce.GetSession()
+	ts := newTaskService(ses)
+	defer ts.Close()
+
+	exists, err := taskExists(requestCtx, ts, ct.Name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if ct.IfNotExists {
+			return nil
+		}
+		return moerr.NewInternalError("the task %s exists", ct.Name)
+	}
+
+	user := ses.GetUserName()
+	if tenant := ses.GetTenantInfo(); tenant != nil {
+		user = tenant.GetUser()
+	}
+	data, err := json.Marshal(sqlTaskContext{
+		SQL:      ct.Body,
+		Database: ses.GetDatabaseName(),
+		User:     user,
+	})
+	if err != nil {
+		return err
+	}
+	metadata := task.TaskMetadata{
+		ID:       ct.Name,
+		Executor: SQLTaskExecutor,
+		Context:  data,
+	}
+	if len(ct.Schedule) == 0 {
+		return ts.Create(requestCtx, metadata)
+	}
+	return ts.CreateCronTask(requestCtx, metadata, ct.Schedule)
+}
+
+// handleShowTasks lists the cron tasks and the tasks.
+func (mce *MysqlCmdExecutor) handleShowTasks(requestCtx context.Context, st *tree.ShowTasks) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	ts := newTaskService(ses)
+	defer ts.Close()
+
+	crons, err := ts.QueryCronTask(requestCtx)
+	if err != nil {
+		return err
+	}
+	tasks, err := ts.QueryTask(requestCtx)
+	if err != nil {
+		return err
+	}
+
+	likePattern := ""
+	if st.Like != nil {
+		likePattern = strings.ToLower(st.Like.Right.String())
+	}
+	matched := func(name string) bool {
+		return len(likePattern) == 0 || WildcardMatch(likePattern, strings.ToLower(name))
+	}
+
+	for _, name := range []string{"Task_name", "Schedule", "Status", "Runner", "Epoch",
+		"Last_heartbeat", "Result", "Error_msg", "Create_at", "End_at"} {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		ses.Mrs.AddColumn(col)
+	}
+
+	sort.Slice(crons, func(i, j int) bool { return crons[i].ID < crons[j].ID })
+	for _, c := range crons {
+		if !matched(c.Metadata.ID) {
+			continue
+		}
+		// a cron task has no runner, the tasks created by it are named by the schedule time
+		ses.Mrs.AddRow([]interface{}{c.Metadata.ID, c.CronExpr, "Scheduled", nil, nil,
+			nil, nil, nil, formatTaskTime(c.CreateAt), nil})
+	}
+	for _, t := range tasks {
+		if !matched(t.Metadata.ID) {
+			continue
+		}
+		var result, errMsg interface{}
+		if t.ExecuteResult != nil {
+			result = t.ExecuteResult.Code.String()
+			errMsg = t.ExecuteResult.Error
+		}
+		schedule := "once"
+		if len(t.ParentTaskID) != 0 {
+			schedule = fmt.Sprintf("cron(%s)", t.ParentTaskID)
+		}
+		ses.Mrs.AddRow([]interface{}{t.Metadata.ID, schedule, t.Status.String(), t.TaskRunner, fmt.Sprintf("%d", t.Epoch),
+			formatTaskTime(t.LastHeartbeat), result, errMsg, formatTaskTime(t.CreateAt), formatTaskTime(t.CompletedAt)})
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err = proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+// handleCancelTask cancels the task, the running task is terminated by its runner.
+func (mce *MysqlCmdExecutor) handleCancelTask(requestCtx context.Context, ct *tree.CancelTask) error {
+	ts := newTaskService(mce.GetSession())
+	defer ts.Close()
+	return convertTaskError(ct.Name, ts.CancelTask(requestCtx, ct.Name))
+}
+
+// handleAlterTask suspends or resumes the task.
+func (mce *MysqlCmdExecutor) handleAlterTask(requestCtx context.Context, at *tree.AlterTask) error {
+	ts := newTaskService(mce.GetSession())
+	defer ts.Close()
+	switch at.Option {
+	case tree.AlterTaskSuspend:
+		return convertTaskError(at.Name, ts.PauseTask(requestCtx, at.Name))
+	case tree.AlterTaskResume:
+		return convertTaskError(at.Name, ts.ResumeTask(requestCtx, at.Name))
+	}
+	return moerr.NewInternalError("unsupported alter task option %d", at.Option)
+}
+
+func convertTaskError(name string, err error) error {
+	switch err {
+	case taskservice.ErrTaskNotFound:
+		return moerr.NewInternalError("the task %s does not exist", name)
+	case taskservice.ErrTaskCompleted:
+		return moerr.NewInternalError("the task %s is completed", name)
+	}
+	return err
+}
+
+func formatTaskTime(ms int64) interface{} {
+	if ms == 0 {
+		return nil
+	}
+	return time.UnixMilli(ms).Format(taskTimeFormat)
+}
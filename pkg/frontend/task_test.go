@@ -0,0 +1,65 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_taskPrivilege(t *testing.T) {
+	convey.Convey("only moadmin can manage the tasks", t, func() {
+		stmts := []tree.Statement{&tree.CreateTask{}, &tree.ShowTasks{}, &tree.CancelTask{}, &tree.AlterTask{}}
+		for _, stmt := range stmts {
+			priv := determinePrivilegeSetOfStatement(stmt)
+			ses := newSes(priv)
+
+			ses.tenant.DefaultRoleID = moAdminRoleID
+			ses.tenant.DefaultRole = moAdminRoleName
+			ok, err := authenticatePrivilegeOfStatementWithObjectTypeNone(context.TODO(), ses, stmt)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ok, convey.ShouldBeTrue)
+
+			ses.tenant.DefaultRoleID = accountAdminRoleID
+			ses.tenant.DefaultRole = accountAdminRoleName
+			ok, err = authenticatePrivilegeOfStatementWithObjectTypeNone(context.TODO(), ses, stmt)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ok, convey.ShouldBeFalse)
+		}
+	})
+}
+
+func Test_sqlTaskExecutor(t *testing.T) {
+	convey.Convey("invalid task context", t, func() {
+		executor := NewSQLTaskExecutor(nil)
+		err := executor(context.TODO(), task.Task{Metadata: task.TaskMetadata{Context: []byte("sql")}})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_convertTaskError(t *testing.T) {
+	convey.Convey("convert task error", t, func() {
+		convey.So(convertTaskError("t1", nil), convey.ShouldBeNil)
+		convey.So(convertTaskError("t1", taskservice.ErrTaskNotFound).Error(), convey.ShouldContainSubstring, "the task t1 does not exist")
+		convey.So(convertTaskError("t1", taskservice.ErrTaskCompleted).Error(), convey.ShouldContainSubstring, "the task t1 is completed")
+		convey.So(formatTaskTime(0), convey.ShouldBeNil)
+		convey.So(formatTaskTime(1000), convey.ShouldNotBeNil)
+	})
+}
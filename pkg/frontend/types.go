@@ -82,6 +82,13 @@ type PrepareStmt struct {
 	PreparePlan *plan.Plan
 	PrepareStmt tree.Statement
 	ParamTypes  []byte
+
+	// LongData keeps the values of the parameters sent by COM_STMT_SEND_LONG_DATA.
+	// They are used by the next COM_STMT_EXECUTE, then cleared.
+	LongData map[uint16][]byte
+
+	// Cursor is the cursor opened by the last COM_STMT_EXECUTE
+	Cursor *Cursor
 }
 
 /*
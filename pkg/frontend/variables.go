@@ -1066,6 +1066,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Default:           "SYSTEM",
 		UpdateSessVar:     updateTimeZone,
 	},
+	"cte_max_recursion_depth": {
+		Name:              "cte_max_recursion_depth",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              InitSystemVariableIntType("cte_max_recursion_depth", 0, 4294967295, false),
+		Default:           int64(1000),
+	},
 }
 
 func updateTimeZone(sess *Session, vars map[string]interface{}, name string, val interface{}) error {
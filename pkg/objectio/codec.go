@@ -0,0 +1,325 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var ErrInvalidColumnData = errors.New("object io: invalid column data")
+
+// columnData is the parsed data of a vector serialized by vector.Show
+type columnData struct {
+	typ  types.Type
+	nsp  []byte
+	rows [][]byte
+}
+
+// EncodeColumn encodes the data of a vector serialized by vector.Show with the algorithm,
+// it returns the algorithm actually used, the data is kept as is if it is not smaller
+// after being compressed or encoded.
+func EncodeColumn(data []byte, alg compress.T) ([]byte, compress.T, error) {
+	var (
+		err error
+		buf []byte
+	)
+	switch alg {
+	case compress.None:
+		return data, compress.None, nil
+	case compress.Lz4, compress.Zstd:
+		buf = make([]byte, compress.CompressBound(len(data), int(alg)))
+		if buf, err = compress.Compress(data, buf, int(alg)); err != nil {
+			return nil, alg, err
+		}
+	case compress.Dict, compress.Rle:
+		col, err := parseColumnData(data)
+		if err != nil {
+			return nil, alg, err
+		}
+		if alg == compress.Dict {
+			buf = encodeDict(col)
+		} else {
+			buf = encodeRle(col)
+		}
+	default:
+		return nil, alg, fmt.Errorf("object io: unknown compress algorithm %d", alg)
+	}
+	if len(buf) >= len(data) {
+		return data, compress.None, nil
+	}
+	return buf, alg, nil
+}
+
+// DecodeColumn decodes the data encoded by EncodeColumn into the data serialized by vector.Show,
+// originSize is the size of the data before being encoded.
+func DecodeColumn(data []byte, alg compress.T, originSize uint32) ([]byte, error) {
+	switch alg {
+	case compress.None:
+		return data, nil
+	case compress.Lz4, compress.Zstd:
+		return compress.Decompress(data, make([]byte, originSize), int(alg))
+	case compress.Dict:
+		col, err := decodeDict(data)
+		if err != nil {
+			return nil, err
+		}
+		return col.show(), nil
+	case compress.Rle:
+		col, err := decodeRle(data)
+		if err != nil {
+			return nil, err
+		}
+		return col.show(), nil
+	}
+	return nil, fmt.Errorf("object io: unknown compress algorithm %d", alg)
+}
+
+func parseColumnData(data []byte) (*columnData, error) {
+	if len(data) < types.TSize {
+		return nil, ErrInvalidColumnData
+	}
+	col := &columnData{typ: types.DecodeType(data[:types.TSize])}
+	if col.typ.IsTuple() {
+		return nil, fmt.Errorf("object io: the type %s can not be encoded", col.typ)
+	}
+	data = data[types.TSize:]
+	var err error
+	if col.nsp, data, err = readSection(data); err != nil {
+		return nil, err
+	}
+	values, data, err := readSection(data)
+	if err != nil {
+		return nil, err
+	}
+	area, _, err := readSection(data)
+	if err != nil {
+		return nil, err
+	}
+	size := col.typ.TypeSize()
+	if len(values)%size != 0 {
+		return nil, ErrInvalidColumnData
+	}
+	col.rows = make([][]byte, len(values)/size)
+	for i := range col.rows {
+		value := values[i*size : (i+1)*size]
+		if col.typ.IsVarlen() {
+			va := (*types.Varlena)(value)
+			value = va.GetByteSlice(area)
+		}
+		col.rows[i] = value
+	}
+	return col, nil
+}
+
+// show serializes the column data in the format of vector.Show
+func (col *columnData) show() []byte {
+	var values, area []byte
+	for _, row := range col.rows {
+		if col.typ.IsVarlen() {
+			var va types.Varlena
+			va, area, _ = types.BuildVarlena(row, area, nil)
+			values = append(values, va[:]...)
+		} else {
+			values = append(values, row...)
+		}
+	}
+	var buf bytes.Buffer
+	buf.Write(types.EncodeType(&col.typ))
+	writeSection(&buf, col.nsp)
+	writeSection(&buf, values)
+	writeSection(&buf, area)
+	return buf.Bytes()
+}
+
+func writeSection(buf *bytes.Buffer, data []byte) {
+	_ = binary.Write(buf, endian, uint32(len(data)))
+	buf.Write(data)
+}
+
+func readSection(data []byte) ([]byte, []byte, error) {
+	if len(data) < 4 {
+		return nil, nil, ErrInvalidColumnData
+	}
+	size := endian.Uint32(data)
+	data = data[4:]
+	if uint32(len(data)) < size {
+		return nil, nil, ErrInvalidColumnData
+	}
+	return data[:size], data[size:], nil
+}
+
+func readUint32(data []byte) (uint32, []byte, error) {
+	if len(data) < 4 {
+		return 0, nil, ErrInvalidColumnData
+	}
+	return endian.Uint32(data), data[4:], nil
+}
+
+// +----------+----------------+------------+----------------+-------------------+--------------+-------+
+// | Type     | NspLen(4B)     | Rows(4B)   | DictCnt(4B)    | DictValue...      | CodeWidth(1B)| Codes |
+// +----------+----------------+------------+----------------+-------------------+--------------+-------+
+// The distinct values are stored in the dictionary, and each row is stored as the code
+// of its value in the dictionary, the width of the codes is the least bytes to hold them.
+func encodeDict(col *columnData) []byte {
+	var buf bytes.Buffer
+	buf.Write(types.EncodeType(&col.typ))
+	writeSection(&buf, col.nsp)
+	_ = binary.Write(&buf, endian, uint32(len(col.rows)))
+
+	codes := make([]uint32, len(col.rows))
+	dict := make(map[string]uint32)
+	var values [][]byte
+	for i, row := range col.rows {
+		code, ok := dict[string(row)]
+		if !ok {
+			code = uint32(len(values))
+			dict[string(row)] = code
+			values = append(values, row)
+		}
+		codes[i] = code
+	}
+	_ = binary.Write(&buf, endian, uint32(len(values)))
+	for _, value := range values {
+		writeSection(&buf, value)
+	}
+
+	width := codeWidth(len(values))
+	buf.WriteByte(width)
+	code := make([]byte, 4)
+	for _, c := range codes {
+		endian.PutUint32(code, c)
+		buf.Write(code[:width])
+	}
+	return buf.Bytes()
+}
+
+func codeWidth(n int) uint8 {
+	switch {
+	case n <= 1<<8:
+		return 1
+	case n <= 1<<16:
+		return 2
+	}
+	return 4
+}
+
+func decodeDict(data []byte) (*columnData, error) {
+	var (
+		err          error
+		rows, dictCn uint32
+	)
+	if len(data) < types.TSize {
+		return nil, ErrInvalidColumnData
+	}
+	col := &columnData{typ: types.DecodeType(data[:types.TSize])}
+	data = data[types.TSize:]
+	if col.nsp, data, err = readSection(data); err != nil {
+		return nil, err
+	}
+	if rows, data, err = readUint32(data); err != nil {
+		return nil, err
+	}
+	if dictCn, data, err = readUint32(data); err != nil {
+		return nil, err
+	}
+	values := make([][]byte, dictCn)
+	for i := range values {
+		if values[i], data, err = readSection(data); err != nil {
+			return nil, err
+		}
+	}
+	if len(data) < 1 {
+		return nil, ErrInvalidColumnData
+	}
+	width := int(data[0])
+	data = data[1:]
+	if len(data) != int(rows)*width {
+		return nil, ErrInvalidColumnData
+	}
+	col.rows = make([][]byte, rows)
+	code := make([]byte, 4)
+	for i := range col.rows {
+		copy(code, data[i*width:(i+1)*width])
+		c := endian.Uint32(code)
+		if c >= dictCn {
+			return nil, ErrInvalidColumnData
+		}
+		col.rows[i] = values[c]
+	}
+	return col, nil
+}
+
+// +----------+----------------+------------+---------------------------+-----+
+// | Type     | NspLen(4B)     | Runs(4B)   | Count(4B) | Value         | ... |
+// +----------+----------------+------------+---------------------------+-----+
+// The consecutive rows with the same value are stored as a run of the value.
+func encodeRle(col *columnData) []byte {
+	var buf bytes.Buffer
+	buf.Write(types.EncodeType(&col.typ))
+	writeSection(&buf, col.nsp)
+
+	var runs bytes.Buffer
+	cnt := uint32(0)
+	for i := 0; i < len(col.rows); {
+		j := i + 1
+		for j < len(col.rows) && bytes.Equal(col.rows[i], col.rows[j]) {
+			j++
+		}
+		_ = binary.Write(&runs, endian, uint32(j-i))
+		writeSection(&runs, col.rows[i])
+		cnt++
+		i = j
+	}
+	_ = binary.Write(&buf, endian, cnt)
+	buf.Write(runs.Bytes())
+	return buf.Bytes()
+}
+
+func decodeRle(data []byte) (*columnData, error) {
+	var (
+		err        error
+		runs, rows uint32
+		value      []byte
+	)
+	if len(data) < types.TSize {
+		return nil, ErrInvalidColumnData
+	}
+	col := &columnData{typ: types.DecodeType(data[:types.TSize])}
+	data = data[types.TSize:]
+	if col.nsp, data, err = readSection(data); err != nil {
+		return nil, err
+	}
+	if runs, data, err = readUint32(data); err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < runs; i++ {
+		if rows, data, err = readUint32(data); err != nil {
+			return nil, err
+		}
+		if value, data, err = readSection(data); err != nil {
+			return nil, err
+		}
+		for j := uint32(0); j < rows; j++ {
+			col.rows = append(col.rows, value)
+		}
+	}
+	return col, nil
+}
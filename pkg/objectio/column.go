@@ -35,8 +35,12 @@ type ColumnBlock struct {
 
 func NewColumnBlock(idx uint16, object *Object) ColumnObject {
 	meta := &ColumnMeta{
-		idx:         idx,
-		zoneMap:     ZoneMap{},
+		idx: idx,
+		zoneMap: ZoneMap{
+			idx: idx,
+			min: make([]byte, ZoneMapMinSize),
+			max: make([]byte, ZoneMapMaxSize),
+		},
 		bloomFilter: Extent{},
 	}
 	col := &ColumnBlock{
@@ -60,6 +64,10 @@ func (cb *ColumnBlock) GetData() (*fileservice.IOVector, error) {
 	if err != nil {
 		return nil, err
 	}
+	data.Entries[0].Data, err = DecodeColumn(data.Entries[0].Data, cb.meta.GetAlg(), cb.meta.location.OriginSize())
+	if err != nil {
+		return nil, err
+	}
 	return data, nil
 }
 
@@ -68,6 +76,10 @@ func (cb *ColumnBlock) GetIndex(dataType IndexDataType) (IndexData, error) {
 	if dataType == ZoneMapType {
 		return &cb.meta.zoneMap, nil
 	} else if dataType == BloomFilterType {
+		if cb.meta.bloomFilter.Length() == 0 {
+			// no bloom filter is written for the column
+			return nil, nil
+		}
 		data := &fileservice.IOVector{
 			FilePath: cb.object.name,
 			Entries:  make([]fileservice.IOEntry, 1),
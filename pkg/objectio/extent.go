@@ -21,6 +21,15 @@ type Extent struct {
 	originSize uint32
 }
 
+func NewExtent(id uint64, offset, length, originSize uint32) Extent {
+	return Extent{
+		id:         id,
+		offset:     offset,
+		length:     length,
+		originSize: originSize,
+	}
+}
+
 func (ex *Extent) Id() uint64 { return ex.id }
 
 func (ex *Extent) End() uint32 { return ex.offset + ex.length }
@@ -14,7 +14,16 @@
 
 package objectio
 
-import "errors"
+import (
+	"bytes"
+	"errors"
+
+	"github.com/FastFilter/xorfilter"
+	"github.com/cespare/xxhash/v2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
 
 type IndexDataType uint8
 
@@ -26,6 +35,25 @@ const (
 const ZoneMapMinSize = 32
 const ZoneMapMaxSize = 32
 
+// The zone map built by BuildZoneMap keeps the values in the first 31 bytes of min and max,
+// and the last byte of them holds the flags and the length of the value. The values of the
+// fixed types are in their memory layout, the values of the varlen types longer than 31 bytes
+// are truncated.
+const (
+	zoneMapValueSize = ZoneMapMinSize - 1
+	zoneMapLenMask   = 0x1f
+	// zoneMapInit is set in min if the zone map is built from the data of the column
+	zoneMapInit = 0x80
+	// zoneMapHasNull is set in min if there are nulls in the column
+	zoneMapHasNull = 0x40
+	// zoneMapTruncated is set in max if the max value is truncated
+	zoneMapTruncated = 0x80
+	// zoneMapAllNull is set in max if all values of the column are null
+	zoneMapAllNull = 0x40
+)
+
+var ErrUnsupportedIndexType = errors.New("object io: the type of the column is not supported by the index")
+
 type IndexData interface {
 	Write(writer *ObjectWriter, block *Block) error
 	GetIdx() uint16
@@ -49,10 +77,138 @@ func NewZoneMap(idx uint16, min, max []byte) (IndexData, error) {
 	return zoneMap, nil
 }
 
+// BuildZoneMap builds the zone map of the column idx from the data of the vector
+func BuildZoneMap(idx uint16, vec *vector.Vector) (IndexData, error) {
+	typ := vec.GetType()
+	if _, ok := comparableTypes[typ.Oid]; !ok {
+		return nil, ErrUnsupportedIndexType
+	}
+	values, err := vectorValues(vec)
+	if err != nil {
+		return nil, err
+	}
+	var min, max []byte
+	hasNull, allNull := false, true
+	for i, v := range values {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			hasNull = true
+			continue
+		}
+		if allNull {
+			min, max, allNull = v, v, false
+			continue
+		}
+		if r, _ := compareValue(typ, v, min); r < 0 {
+			min = v
+		}
+		if r, _ := compareValue(typ, v, max); r > 0 {
+			max = v
+		}
+	}
+	zm := &ZoneMap{
+		idx: idx,
+		min: make([]byte, ZoneMapMinSize),
+		max: make([]byte, ZoneMapMaxSize),
+	}
+	if len(min) > zoneMapValueSize {
+		min = min[:zoneMapValueSize]
+	}
+	if len(max) > zoneMapValueSize {
+		max = max[:zoneMapValueSize]
+		zm.max[zoneMapValueSize] |= zoneMapTruncated
+	}
+	copy(zm.min, min)
+	copy(zm.max, max)
+	zm.min[zoneMapValueSize] |= zoneMapInit | uint8(len(min))
+	zm.max[zoneMapValueSize] |= uint8(len(max))
+	if hasNull {
+		zm.min[zoneMapValueSize] |= zoneMapHasNull
+	}
+	if allNull {
+		zm.max[zoneMapValueSize] |= zoneMapAllNull
+	}
+	return zm, nil
+}
+
 func (z *ZoneMap) GetIdx() uint16 {
 	return z.idx
 }
 
+func (z *ZoneMap) GetMin() []byte {
+	return z.min
+}
+
+func (z *ZoneMap) GetMax() []byte {
+	return z.max
+}
+
+// IsInit returns whether the zone map is built by BuildZoneMap,
+// nothing can be told from the zone map which is not initialized
+func (z *ZoneMap) IsInit() bool {
+	return len(z.min) == ZoneMapMinSize && len(z.max) == ZoneMapMaxSize &&
+		z.min[zoneMapValueSize]&zoneMapInit != 0
+}
+
+// MayHaveNull returns false if there is no null in the column
+func (z *ZoneMap) MayHaveNull() bool {
+	return !z.IsInit() || z.min[zoneMapValueSize]&zoneMapHasNull != 0
+}
+
+// MayHaveValue returns false if all values of the column are null
+func (z *ZoneMap) MayHaveValue() bool {
+	return !z.IsInit() || z.max[zoneMapValueSize]&zoneMapAllNull == 0
+}
+
+// MayContain returns false if no value of the column equals to v,
+// v is in the memory layout of the type of the column
+func (z *ZoneMap) MayContain(typ types.Type, v []byte) bool {
+	return z.MayLess(typ, v, true) && z.MayGreater(typ, v, true)
+}
+
+// MayLess returns false if no value of the column is less than v,
+// or less than or equal to v if orEqual is true
+func (z *ZoneMap) MayLess(typ types.Type, v []byte, orEqual bool) bool {
+	if !z.IsInit() {
+		return true
+	}
+	if !z.MayHaveValue() {
+		return false
+	}
+	min := z.min[:z.min[zoneMapValueSize]&zoneMapLenMask]
+	// the truncated min is the prefix of the min value, which is not greater than it
+	r, ok := compareValue(typ, min, v)
+	if !ok {
+		return true
+	}
+	return r < 0 || (orEqual && r == 0)
+}
+
+// MayGreater returns false if no value of the column is greater than v,
+// or greater than or equal to v if orEqual is true
+func (z *ZoneMap) MayGreater(typ types.Type, v []byte, orEqual bool) bool {
+	if !z.IsInit() {
+		return true
+	}
+	if !z.MayHaveValue() {
+		return false
+	}
+	max := z.max[:z.max[zoneMapValueSize]&zoneMapLenMask]
+	if z.max[zoneMapValueSize]&zoneMapTruncated != 0 {
+		// the max value starts with the truncated one and it is longer,
+		// it may be greater than v if v is not greater than the truncated one.
+		if len(v) > len(max) {
+			v = v[:len(max)]
+		}
+		r, ok := compareValue(typ, max, v)
+		return !ok || r >= 0
+	}
+	r, ok := compareValue(typ, max, v)
+	if !ok {
+		return true
+	}
+	return r > 0 || (orEqual && r == 0)
+}
+
 func (z *ZoneMap) Write(_ *ObjectWriter, block *Block) error {
 	var err error
 	block.columns[z.idx].(*ColumnBlock).meta.zoneMap = *z
@@ -74,10 +230,57 @@ func NewBloomFilter(idx uint16, alg uint8, buf []byte) IndexData {
 	return bloomFilter
 }
 
+// BuildBloomFilter builds the bloom filter of the column idx from the data of the vector,
+// the filter is a binary fuse filter of the hashes of the values which are not null.
+func BuildBloomFilter(idx uint16, vec *vector.Vector) (IndexData, error) {
+	values, err := vectorValues(vec)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]uint64, 0, len(values))
+	for i, v := range values {
+		if !nulls.Contains(vec.Nsp, uint64(i)) {
+			hashes = append(hashes, xxhash.Sum64(v))
+		}
+	}
+	filter, err := xorfilter.PopulateBinaryFuse8(hashes)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if _, err = types.WriteValues(&buf, filter.Seed, filter.SegmentLength,
+		filter.SegmentLengthMask, filter.SegmentCount, filter.SegmentCountLength); err != nil {
+		return nil, err
+	}
+	buf.Write(filter.Fingerprints)
+	return NewBloomFilter(idx, 0, buf.Bytes()), nil
+}
+
 func (b *BloomFilter) GetIdx() uint16 {
 	return b.idx
 }
 
+func (b *BloomFilter) GetData() []byte {
+	return b.buf
+}
+
+// MayContain returns false if no value of the column equals to v, the filter
+// must be built by BuildBloomFilter, v is in the memory layout of the type of the column
+func (b *BloomFilter) MayContain(v []byte) (bool, error) {
+	if len(b.buf) < 24 {
+		return false, ErrInvalidColumnData
+	}
+	filter := &xorfilter.BinaryFuse8{
+		Seed:               types.DecodeFixed[uint64](b.buf[:8]),
+		SegmentLength:      types.DecodeFixed[uint32](b.buf[8:12]),
+		SegmentLengthMask:  types.DecodeFixed[uint32](b.buf[12:16]),
+		SegmentCount:       types.DecodeFixed[uint32](b.buf[16:20]),
+		SegmentCountLength: types.DecodeFixed[uint32](b.buf[20:24]),
+		Fingerprints:       b.buf[24:],
+	}
+	return filter.Contains(xxhash.Sum64(v)), nil
+}
+
 func (b *BloomFilter) Write(writer *ObjectWriter, block *Block) error {
 	var err error
 	offset, length, err := writer.buffer.Write(b.buf)
@@ -89,3 +292,69 @@ func (b *BloomFilter) Write(writer *ObjectWriter, block *Block) error {
 	block.columns[b.idx].(*ColumnBlock).meta.bloomFilter.originSize = uint32(length)
 	return err
 }
+
+// vectorValues returns the value of each row of the vector in its memory layout
+func vectorValues(vec *vector.Vector) ([][]byte, error) {
+	data, err := vec.Show()
+	if err != nil {
+		return nil, err
+	}
+	col, err := parseColumnData(data)
+	if err != nil {
+		return nil, err
+	}
+	return col.rows, nil
+}
+
+// compareValue compares two values of the type in their memory layout,
+// it returns false if the values of the type can not be compared.
+func compareValue(typ types.Type, a, b []byte) (int, bool) {
+	switch typ.Oid {
+	case types.T_bool, types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_enum, types.T_set:
+		return compareOrdered(decodeUint(a), decodeUint(b)), true
+	case types.T_int8:
+		return compareOrdered(types.DecodeFixed[int8](a), types.DecodeFixed[int8](b)), true
+	case types.T_int16:
+		return compareOrdered(types.DecodeFixed[int16](a), types.DecodeFixed[int16](b)), true
+	case types.T_int32, types.T_date:
+		return compareOrdered(types.DecodeFixed[int32](a), types.DecodeFixed[int32](b)), true
+	case types.T_int64, types.T_time, types.T_datetime, types.T_timestamp:
+		return compareOrdered(types.DecodeFixed[int64](a), types.DecodeFixed[int64](b)), true
+	case types.T_float32:
+		return compareOrdered(types.DecodeFixed[float32](a), types.DecodeFixed[float32](b)), true
+	case types.T_float64:
+		return compareOrdered(types.DecodeFixed[float64](a), types.DecodeFixed[float64](b)), true
+	case types.T_decimal64:
+		return types.CompareDecimal64(types.DecodeFixed[types.Decimal64](a), types.DecodeFixed[types.Decimal64](b)), true
+	case types.T_decimal128:
+		return types.CompareDecimal128(types.DecodeFixed[types.Decimal128](a), types.DecodeFixed[types.Decimal128](b)), true
+	case types.T_char, types.T_varchar, types.T_blob:
+		return bytes.Compare(a, b), true
+	}
+	return 0, false
+}
+
+var comparableTypes = map[types.T]struct{}{
+	types.T_bool: {}, types.T_uint8: {}, types.T_uint16: {}, types.T_uint32: {}, types.T_uint64: {},
+	types.T_enum: {}, types.T_set: {},
+	types.T_int8: {}, types.T_int16: {}, types.T_int32: {}, types.T_int64: {},
+	types.T_float32: {}, types.T_float64: {}, types.T_decimal64: {}, types.T_decimal128: {},
+	types.T_date: {}, types.T_time: {}, types.T_datetime: {}, types.T_timestamp: {},
+	types.T_char: {}, types.T_varchar: {}, types.T_blob: {},
+}
+
+func decodeUint(v []byte) uint64 {
+	var buf [8]byte
+	copy(buf[:], v)
+	return endian.Uint64(buf[:])
+}
+
+func compareOrdered[T int8 | int16 | int32 | int64 | uint64 | float32 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -14,6 +14,8 @@
 
 package objectio
 
+import "github.com/matrixorigin/matrixone/pkg/compress"
+
 // +---------------------------------------------------------------------------------------------+
 // |                                           Header                                            |
 // +-------------+---------------+--------------+---------------+---------------+----------------+
@@ -57,7 +59,7 @@ type BlockHeader struct {
 // ColumnMeta Size = 128B
 // Type = Metadata type, always 0, representing column meta, used for extension.
 // Idx = Column index
-// Algo = Type of compression algorithm for column data
+// Algo = Type of compression algorithm or encoding (dict, rle) for column data
 // Offset = Offset of column data
 // Size = Size of column data
 // oSize = Original data size
@@ -79,6 +81,17 @@ type ColumnMeta struct {
 	checksum    uint32
 }
 
+// GetAlg returns the compression algorithm or the encoding of the column data
+func (cm *ColumnMeta) GetAlg() compress.T {
+	return compress.T(cm.alg)
+}
+
+// GetLocation returns the location of the column data, its origin size is
+// the size of the column data before compression
+func (cm *ColumnMeta) GetLocation() Extent {
+	return cm.location
+}
+
 type Header struct {
 	magic   uint64
 	version uint16
@@ -16,6 +16,7 @@ package objectio
 
 import (
 	"context"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 )
 
@@ -87,6 +88,13 @@ func (r *ObjectReader) Read(extent Extent, idxs []uint16) (*fileservice.IOVector
 	if err != nil {
 		return nil, err
 	}
+	for i, idx := range idxs {
+		meta := block.(*Block).columns[idx].GetMeta()
+		data.Entries[i].Data, err = DecodeColumn(data.Entries[i].Data, compress.T(meta.alg), meta.location.OriginSize())
+		if err != nil {
+			return nil, err
+		}
+	}
 	return data, nil
 }
 
@@ -16,6 +16,7 @@ package objectio
 
 import (
 	"encoding/binary"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 )
@@ -34,6 +35,10 @@ type Writer interface {
 	// buf is the data to write to the index
 	WriteIndex(block BlockObject, index IndexData) error
 
+	// SetCompressAlgs sets the compression algorithm or the encoding of each column
+	// in the batches to be written, the columns without an algorithm are not compressed
+	SetCompressAlgs(algs []compress.T)
+
 	// WriteEnd is to write multiple batches written to
 	// the buffer to the fileservice at one time
 	WriteEnd() (map[uint32]BlockObject, error)
@@ -44,6 +49,7 @@ type Reader interface {
 	// Read is to read columns data of a block from fileservice at one time
 	// extent is location of the block meta
 	// idxs is the column serial number of the data to be read
+	// the data of the columns are decompressed or decoded into the format of vector.Show
 	Read(extents Extent, idxs []uint16) (*fileservice.IOVector, error)
 
 	// ReadMeta is the meta that reads a block
@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"sync"
@@ -31,6 +32,7 @@ type ObjectWriter struct {
 	buffer *ObjectBuffer
 	name   string
 	lastId uint32
+	algs   []compress.T
 }
 
 func NewObjectWriter(name string, fs fileservice.FileService) (Writer, error) {
@@ -65,6 +67,10 @@ func (w *ObjectWriter) WriteHeader() error {
 	return err
 }
 
+func (w *ObjectWriter) SetCompressAlgs(algs []compress.T) {
+	w.algs = algs
+}
+
 func (w *ObjectWriter) Write(batch *batch.Batch) (BlockObject, error) {
 	block := NewBlock(batch, w.object)
 	w.AddBlock(block.(*Block))
@@ -73,15 +79,24 @@ func (w *ObjectWriter) Write(batch *batch.Batch) (BlockObject, error) {
 		if err != nil {
 			return nil, err
 		}
+		originSize := len(buf)
+		alg := compress.T(compress.None)
+		if i < len(w.algs) {
+			if buf, alg, err = EncodeColumn(buf, w.algs[i]); err != nil {
+				return nil, err
+			}
+		}
 		offset, length, err := w.buffer.Write(buf)
 		if err != nil {
 			return nil, err
 		}
-		block.(*Block).columns[i].(*ColumnBlock).meta.location = Extent{
+		meta := block.(*Block).columns[i].(*ColumnBlock).meta
+		meta.alg = uint8(alg)
+		meta.location = Extent{
 			id:         block.GetMeta().header.blockId,
 			offset:     uint32(offset),
 			length:     uint32(length),
-			originSize: uint32(length),
+			originSize: uint32(originSize),
 		}
 	}
 	return block, nil
@@ -16,7 +16,9 @@ package objectio
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -124,3 +126,166 @@ func newVector(tye types.Type, buf []byte) *vector.Vector {
 	vector.Read(buf)
 	return vector
 }
+
+func TestColumnCompressAlgs(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	dir = path.Join(dir, "/local")
+	name := fmt.Sprintf("%d.blk", common.NextGlobalSeqNum())
+	c := fileservice.Config{
+		Name:    "LOCAL",
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(c)
+	assert.Nil(t, err)
+
+	rows := 1000
+	strs := make([]string, rows)
+	ints := make([]int64, rows)
+	for i := range strs {
+		strs[i] = fmt.Sprintf("a long status value of the row %d", i%3)
+		ints[i] = int64(i / 100)
+	}
+	nsp := &nulls.Nulls{}
+	nulls.Add(nsp, 5)
+	bat := batch.New(true, []string{"a", "b", "c", "d", "e"})
+	bat.Vecs[0] = vector.NewWithStrings(types.Type{Oid: types.T_varchar, Width: 64}, strs, nsp, nil)
+	bat.Vecs[1] = vector.NewWithFixed(types.Type{Oid: types.T_int64}, ints, nil, nil)
+	bat.Vecs[2] = vector.NewWithStrings(types.Type{Oid: types.T_varchar, Width: 64}, strs, nil, nil)
+	bat.Vecs[3] = vector.NewWithFixed(types.Type{Oid: types.T_int64}, ints, nil, nil)
+	bat.Vecs[4] = vector.NewWithFixed(types.Type{Oid: types.T_int64}, []int64{1}, nil, nil)
+	algs := []compress.T{compress.Dict, compress.Rle, compress.Zstd, compress.Lz4, compress.Dict}
+
+	objectWriter, err := NewObjectWriter(name, service)
+	assert.Nil(t, err)
+	objectWriter.SetCompressAlgs(algs)
+	_, err = objectWriter.Write(bat)
+	assert.Nil(t, err)
+	extents, err := objectWriter.WriteEnd()
+	assert.Nil(t, err)
+	err = objectWriter.(*ObjectWriter).Sync(dir)
+	assert.Nil(t, err)
+
+	objectReader, _ := NewObjectReader(name, service)
+	blocks, err := objectReader.ReadMeta([]Extent{extents[0].GetExtent()})
+	assert.Nil(t, err)
+	for i, alg := range algs {
+		col, err := blocks[0].GetColumn(uint16(i))
+		assert.Nil(t, err)
+		location := col.GetMeta().GetLocation()
+		if i == 4 {
+			// the encoded data is larger
+			assert.Equal(t, compress.T(compress.None), col.GetMeta().GetAlg())
+			continue
+		}
+		assert.Equal(t, alg, col.GetMeta().GetAlg())
+		assert.Less(t, location.Length(), location.OriginSize())
+	}
+
+	data, err := objectReader.Read(extents[0].GetExtent(), []uint16{0, 1, 2, 3, 4})
+	assert.Nil(t, err)
+	for i := range algs {
+		vec := newVector(bat.Vecs[i].Typ, data.Entries[i].Data)
+		if bat.Vecs[i].Typ.IsVarlen() {
+			assert.Equal(t, vector.GetStrVectorValues(bat.Vecs[i]), vector.GetStrVectorValues(vec))
+		} else {
+			assert.Equal(t, bat.Vecs[i].Col, vec.Col)
+		}
+	}
+	vec := newVector(bat.Vecs[0].Typ, data.Entries[0].Data)
+	assert.True(t, nulls.Contains(vec.Nsp, 5))
+
+	col, err := blocks[0].GetColumn(0)
+	assert.Nil(t, err)
+	colData, err := col.GetData()
+	assert.Nil(t, err)
+	assert.Equal(t, data.Entries[0].Data, colData.Entries[0].Data)
+}
+
+func TestBuildIndex(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	dir = path.Join(dir, "/local")
+	name := fmt.Sprintf("%d.blk", common.NextGlobalSeqNum())
+	c := fileservice.Config{
+		Name:    "LOCAL",
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(c)
+	assert.Nil(t, err)
+
+	nsp := &nulls.Nulls{}
+	nulls.Add(nsp, 1)
+	long := "z string value which is longer than the zone map"
+	bat := batch.New(true, []string{"a", "b", "c"})
+	bat.Vecs[0] = vector.NewWithFixed(types.Type{Oid: types.T_int32}, []int32{-5, 0, 10, 3}, nsp, nil)
+	bat.Vecs[1] = vector.NewWithStrings(types.Type{Oid: types.T_varchar, Width: 64}, []string{"b", long, "c", ""}, nil, nil)
+	bat.Vecs[2] = vector.NewWithFixed(types.Type{Oid: types.T_int64}, []int64{1, 2, 3, 4}, nulls.NewWithSize(4), nil)
+	for i := 0; i < 4; i++ {
+		nulls.Add(bat.Vecs[2].Nsp, uint64(i))
+	}
+
+	objectWriter, err := NewObjectWriter(name, service)
+	assert.Nil(t, err)
+	fd, err := objectWriter.Write(bat)
+	assert.Nil(t, err)
+	for i, vec := range bat.Vecs {
+		index, err := BuildZoneMap(uint16(i), vec)
+		assert.Nil(t, err)
+		assert.Nil(t, objectWriter.WriteIndex(fd, index))
+		index, err = BuildBloomFilter(uint16(i), vec)
+		assert.Nil(t, err)
+		assert.Nil(t, objectWriter.WriteIndex(fd, index))
+	}
+	extents, err := objectWriter.WriteEnd()
+	assert.Nil(t, err)
+	err = objectWriter.(*ObjectWriter).Sync(dir)
+	assert.Nil(t, err)
+
+	objectReader, _ := NewObjectReader(name, service)
+	zms, err := objectReader.ReadIndex(extents[0].GetExtent(), []uint16{0, 1, 2}, ZoneMapType)
+	assert.Nil(t, err)
+	bfs, err := objectReader.ReadIndex(extents[0].GetExtent(), []uint16{0, 1, 2}, BloomFilterType)
+	assert.Nil(t, err)
+
+	typ := bat.Vecs[0].Typ
+	zm := zms[0].(*ZoneMap)
+	assert.True(t, zm.IsInit())
+	assert.True(t, zm.MayHaveNull())
+	assert.True(t, zm.MayContain(typ, types.EncodeFixed[int32](3)))
+	assert.True(t, zm.MayContain(typ, types.EncodeFixed[int32](-5)))
+	assert.False(t, zm.MayContain(typ, types.EncodeFixed[int32](11)))
+	assert.False(t, zm.MayLess(typ, types.EncodeFixed[int32](-5), false))
+	assert.True(t, zm.MayLess(typ, types.EncodeFixed[int32](-5), true))
+	assert.False(t, zm.MayGreater(typ, types.EncodeFixed[int32](10), false))
+	ok, err := bfs[0].(*BloomFilter).MayContain(types.EncodeFixed[int32](10))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	// the null value is not in the filter
+	ok, err = bfs[0].(*BloomFilter).MayContain(types.EncodeFixed[int32](0))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	typ = bat.Vecs[1].Typ
+	zm = zms[1].(*ZoneMap)
+	assert.False(t, zm.MayHaveNull())
+	assert.True(t, zm.MayContain(typ, []byte("")))
+	assert.True(t, zm.MayContain(typ, []byte(long)))
+	assert.True(t, zm.MayGreater(typ, []byte(long+"z"), false))
+	assert.False(t, zm.MayGreater(typ, []byte("zz"), false))
+	assert.False(t, zm.MayContain(typ, []byte("zz")))
+	assert.True(t, zm.MayContain(typ, []byte("c0")))
+	ok, err = bfs[1].(*BloomFilter).MayContain([]byte(long))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	typ = bat.Vecs[2].Typ
+	zm = zms[2].(*ZoneMap)
+	assert.False(t, zm.MayHaveValue())
+	assert.False(t, zm.MayContain(typ, types.EncodeFixed[int64](1)))
+
+	// the zone map which is not built from the data tells nothing
+	zm = &ZoneMap{min: make([]byte, ZoneMapMinSize), max: make([]byte, ZoneMapMaxSize)}
+	assert.True(t, zm.MayContain(typ, types.EncodeFixed[int64](1)))
+	assert.True(t, zm.MayHaveNull())
+}
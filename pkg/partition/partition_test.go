@@ -109,4 +109,18 @@ func TestPartition(t *testing.T) {
 	nulls.Add(v11.Nsp, 1)
 	Partition([]int64{1, 3, 5}, []bool{false, false, false}, partitions, v11)
 	require.Equal(t, []int64{0, 1}, partitions)
+
+	v12 := vector.NewWithFixed(types.T_bool.ToType(), []bool{true, true, false, false, true, true}, nil, nil)
+	Partition([]int64{1, 3, 5}, []bool{false, false, false}, partitions, v12)
+	require.Equal(t, []int64{0, 1}, partitions)
+	nulls.Add(v12.Nsp, 1)
+	Partition([]int64{1, 3, 5}, []bool{false, false, false}, partitions, v12)
+	require.Equal(t, []int64{0, 1}, partitions)
+
+	v13 := vector.NewWithFixed(types.T_timestamp.ToType(), []types.Timestamp{3, 4, 5, 6, 7, 8}, nil, nil)
+	Partition([]int64{1, 3, 5}, []bool{false, false, false}, partitions, v13)
+	require.Equal(t, []int64{0, 1}, partitions)
+	nulls.Add(v13.Nsp, 1)
+	Partition([]int64{1, 3, 5}, []bool{false, false, false}, partitions, v13)
+	require.Equal(t, []int64{0, 1}, partitions)
 }
@@ -53,7 +53,7 @@ func (x Pipeline_PipelineType) String() string {
 }
 
 func (Pipeline_PipelineType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{17, 0}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{19, 0}
 }
 
 type Message struct {
@@ -799,6 +799,117 @@ func (m *LeftJoin) GetRightCond() []*plan.Expr {
 	return nil
 }
 
+type RightJoin struct {
+	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
+	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
+	RelList              []int32      `protobuf:"varint,3,rep,packed,name=rel_list,json=relList,proto3" json:"rel_list,omitempty"`
+	ColList              []int32      `protobuf:"varint,4,rep,packed,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
+	Expr                 *plan.Expr   `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	Types                []*plan.Type `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
+	LeftTypes            []*plan.Type `protobuf:"bytes,7,rep,name=left_types,json=leftTypes,proto3" json:"left_types,omitempty"`
+	LeftCond             []*plan.Expr `protobuf:"bytes,8,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
+	RightCond            []*plan.Expr `protobuf:"bytes,9,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RightJoin) Reset()         { *m = RightJoin{} }
+func (m *RightJoin) String() string { return proto.CompactTextString(m) }
+func (*RightJoin) ProtoMessage()    {}
+func (*RightJoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac67a7adf3df9c7, []int{9}
+}
+func (m *RightJoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RightJoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RightJoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RightJoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RightJoin.Merge(m, src)
+}
+func (m *RightJoin) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RightJoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_RightJoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RightJoin proto.InternalMessageInfo
+
+func (m *RightJoin) GetIbucket() uint64 {
+	if m != nil {
+		return m.Ibucket
+	}
+	return 0
+}
+
+func (m *RightJoin) GetNbucket() uint64 {
+	if m != nil {
+		return m.Nbucket
+	}
+	return 0
+}
+
+func (m *RightJoin) GetRelList() []int32 {
+	if m != nil {
+		return m.RelList
+	}
+	return nil
+}
+
+func (m *RightJoin) GetColList() []int32 {
+	if m != nil {
+		return m.ColList
+	}
+	return nil
+}
+
+func (m *RightJoin) GetExpr() *plan.Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+func (m *RightJoin) GetTypes() []*plan.Type {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *RightJoin) GetLeftTypes() []*plan.Type {
+	if m != nil {
+		return m.LeftTypes
+	}
+	return nil
+}
+
+func (m *RightJoin) GetLeftCond() []*plan.Expr {
+	if m != nil {
+		return m.LeftCond
+	}
+	return nil
+}
+
+func (m *RightJoin) GetRightCond() []*plan.Expr {
+	if m != nil {
+		return m.RightCond
+	}
+	return nil
+}
+
 type SemiJoin struct {
 	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
 	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
@@ -816,7 +927,7 @@ func (m *SemiJoin) Reset()         { *m = SemiJoin{} }
 func (m *SemiJoin) String() string { return proto.CompactTextString(m) }
 func (*SemiJoin) ProtoMessage()    {}
 func (*SemiJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{9}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{10}
 }
 func (m *SemiJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +1023,7 @@ func (m *SingleJoin) Reset()         { *m = SingleJoin{} }
 func (m *SingleJoin) String() string { return proto.CompactTextString(m) }
 func (*SingleJoin) ProtoMessage()    {}
 func (*SingleJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{10}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{11}
 }
 func (m *SingleJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1130,7 @@ func (m *MarkJoin) Reset()         { *m = MarkJoin{} }
 func (m *MarkJoin) String() string { return proto.CompactTextString(m) }
 func (*MarkJoin) ProtoMessage()    {}
 func (*MarkJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{11}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{12}
 }
 func (m *MarkJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1145,7 +1256,7 @@ func (m *Product) Reset()         { *m = Product{} }
 func (m *Product) String() string { return proto.CompactTextString(m) }
 func (*Product) ProtoMessage()    {}
 func (*Product) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{12}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{13}
 }
 func (m *Product) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1195,6 +1306,71 @@ func (m *Product) GetTypes() []*plan.Type {
 	return nil
 }
 
+type RecursiveCte struct {
+	UnionAll bool  `protobuf:"varint,1,opt,name=union_all,json=unionAll,proto3" json:"union_all,omitempty"`
+	MaxDepth int64 `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// step_id is the position of the recursive part in the recursive parts of the
+	// copied scope, the recursive part is not encoded so it can only run in the local process.
+	StepId               uint64   `protobuf:"varint,3,opt,name=step_id,json=stepId,proto3" json:"step_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecursiveCte) Reset()         { *m = RecursiveCte{} }
+func (m *RecursiveCte) String() string { return proto.CompactTextString(m) }
+func (*RecursiveCte) ProtoMessage()    {}
+func (*RecursiveCte) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac67a7adf3df9c7, []int{14}
+}
+func (m *RecursiveCte) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecursiveCte) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecursiveCte.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecursiveCte) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecursiveCte.Merge(m, src)
+}
+func (m *RecursiveCte) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RecursiveCte) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecursiveCte.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecursiveCte proto.InternalMessageInfo
+
+func (m *RecursiveCte) GetUnionAll() bool {
+	if m != nil {
+		return m.UnionAll
+	}
+	return false
+}
+
+func (m *RecursiveCte) GetMaxDepth() int64 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *RecursiveCte) GetStepId() uint64 {
+	if m != nil {
+		return m.StepId
+	}
+	return 0
+}
+
 type Instruction struct {
 	// Op specified the operator code of an instruction.
 	Op int32 `protobuf:"varint,1,opt,name=op,proto3" json:"op,omitempty"`
@@ -1216,6 +1392,10 @@ type Instruction struct {
 	Filter               *plan.Expr          `protobuf:"bytes,16,opt,name=filter,proto3" json:"filter,omitempty"`
 	Limit                uint64              `protobuf:"varint,17,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset               uint64              `protobuf:"varint,18,opt,name=offset,proto3" json:"offset,omitempty"`
+	WinSpec              *plan.WindowSpec    `protobuf:"bytes,19,opt,name=win_spec,json=winSpec,proto3" json:"win_spec,omitempty"`
+	JsonTable            *plan.JsonTable     `protobuf:"bytes,20,opt,name=json_table,json=jsonTable,proto3" json:"json_table,omitempty"`
+	RightJoin            *RightJoin          `protobuf:"bytes,21,opt,name=right_join,json=rightJoin,proto3" json:"right_join,omitempty"`
+	RecursiveCte         *RecursiveCte       `protobuf:"bytes,22,opt,name=recursive_cte,json=recursiveCte,proto3" json:"recursive_cte,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -1225,7 +1405,7 @@ func (m *Instruction) Reset()         { *m = Instruction{} }
 func (m *Instruction) String() string { return proto.CompactTextString(m) }
 func (*Instruction) ProtoMessage()    {}
 func (*Instruction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{13}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{15}
 }
 func (m *Instruction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,6 +1560,34 @@ func (m *Instruction) GetOffset() uint64 {
 	return 0
 }
 
+func (m *Instruction) GetWinSpec() *plan.WindowSpec {
+	if m != nil {
+		return m.WinSpec
+	}
+	return nil
+}
+
+func (m *Instruction) GetJsonTable() *plan.JsonTable {
+	if m != nil {
+		return m.JsonTable
+	}
+	return nil
+}
+
+func (m *Instruction) GetRightJoin() *RightJoin {
+	if m != nil {
+		return m.RightJoin
+	}
+	return nil
+}
+
+func (m *Instruction) GetRecursiveCte() *RecursiveCte {
+	if m != nil {
+		return m.RecursiveCte
+	}
+	return nil
+}
+
 type AnalysisList struct {
 	List                 []*plan.AnalyzeInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -1391,7 +1599,7 @@ func (m *AnalysisList) Reset()         { *m = AnalysisList{} }
 func (m *AnalysisList) String() string { return proto.CompactTextString(m) }
 func (*AnalysisList) ProtoMessage()    {}
 func (*AnalysisList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{14}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{16}
 }
 func (m *AnalysisList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1428,22 +1636,25 @@ func (m *AnalysisList) GetList() []*plan.AnalyzeInfo {
 }
 
 type Source struct {
-	SchemaName           string   `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
-	TableName            string   `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColList              []string `protobuf:"bytes,3,rep,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
-	Block                string   `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
-	PushdownId           uint64   `protobuf:"varint,5,opt,name=pushdown_id,json=pushdownId,proto3" json:"pushdown_id,omitempty"`
-	PushdownAddr         string   `protobuf:"bytes,6,opt,name=pushdown_addr,json=pushdownAddr,proto3" json:"pushdown_addr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SchemaName           string          `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	TableName            string          `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	ColList              []string        `protobuf:"bytes,3,rep,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
+	Block                string          `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	PushdownId           uint64          `protobuf:"varint,5,opt,name=pushdown_id,json=pushdownId,proto3" json:"pushdown_id,omitempty"`
+	PushdownAddr         string          `protobuf:"bytes,6,opt,name=pushdown_addr,json=pushdownAddr,proto3" json:"pushdown_addr,omitempty"`
+	IndexScan            *plan.IndexScan `protobuf:"bytes,7,opt,name=index_scan,json=indexScan,proto3" json:"index_scan,omitempty"`
+	Expr                 *plan.Expr      `protobuf:"bytes,8,opt,name=expr,proto3" json:"expr,omitempty"`
+	AnalyzeIdx           int32           `protobuf:"varint,9,opt,name=analyze_idx,json=analyzeIdx,proto3" json:"analyze_idx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Source) Reset()         { *m = Source{} }
 func (m *Source) String() string { return proto.CompactTextString(m) }
 func (*Source) ProtoMessage()    {}
 func (*Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{15}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{17}
 }
 func (m *Source) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1514,6 +1725,27 @@ func (m *Source) GetPushdownAddr() string {
 	return ""
 }
 
+func (m *Source) GetIndexScan() *plan.IndexScan {
+	if m != nil {
+		return m.IndexScan
+	}
+	return nil
+}
+
+func (m *Source) GetExpr() *plan.Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+func (m *Source) GetAnalyzeIdx() int32 {
+	if m != nil {
+		return m.AnalyzeIdx
+	}
+	return 0
+}
+
 type NodeInfo struct {
 	Mcpu                 int32    `protobuf:"varint,1,opt,name=mcpu,proto3" json:"mcpu,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -1528,7 +1760,7 @@ func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
 func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()    {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{16}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{18}
 }
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1606,7 +1838,7 @@ func (m *Pipeline) Reset()         { *m = Pipeline{} }
 func (m *Pipeline) String() string { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()    {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{17}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{19}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1723,10 +1955,12 @@ func init() {
 	proto.RegisterType((*AntiJoin)(nil), "pipeline.AntiJoin")
 	proto.RegisterType((*InnerJoin)(nil), "pipeline.InnerJoin")
 	proto.RegisterType((*LeftJoin)(nil), "pipeline.LeftJoin")
+	proto.RegisterType((*RightJoin)(nil), "pipeline.RightJoin")
 	proto.RegisterType((*SemiJoin)(nil), "pipeline.SemiJoin")
 	proto.RegisterType((*SingleJoin)(nil), "pipeline.SingleJoin")
 	proto.RegisterType((*MarkJoin)(nil), "pipeline.MarkJoin")
 	proto.RegisterType((*Product)(nil), "pipeline.Product")
+	proto.RegisterType((*RecursiveCte)(nil), "pipeline.RecursiveCte")
 	proto.RegisterType((*Instruction)(nil), "pipeline.Instruction")
 	proto.RegisterType((*AnalysisList)(nil), "pipeline.AnalysisList")
 	proto.RegisterType((*Source)(nil), "pipeline.Source")
@@ -1737,98 +1971,112 @@ func init() {
 func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9c7) }
 
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
-	// 1444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6f, 0xdb, 0x46,
-	0x16, 0x8f, 0xfe, 0x50, 0x22, 0x9f, 0x64, 0x59, 0x99, 0x4d, 0x76, 0x99, 0xec, 0xae, 0xe3, 0x30,
-	0x9b, 0xc4, 0x8b, 0xdd, 0xd8, 0x88, 0x17, 0x39, 0xef, 0x3a, 0x4e, 0xb0, 0x70, 0x11, 0x3b, 0xc6,
-	0xb8, 0xbd, 0x14, 0x05, 0x84, 0x11, 0x39, 0xa2, 0x27, 0x26, 0x67, 0x58, 0xfe, 0x49, 0xac, 0x7e,
-	0x80, 0x1e, 0xda, 0x7e, 0x82, 0xf6, 0xd2, 0x6f, 0xd2, 0x53, 0x81, 0x1e, 0x7b, 0xeb, 0xb5, 0x48,
-	0xaf, 0xfd, 0x10, 0xc5, 0xbc, 0x21, 0x29, 0xda, 0x8a, 0x53, 0xa3, 0xe8, 0xad, 0xb9, 0xcd, 0xfb,
-	0xbd, 0xdf, 0x88, 0xef, 0xff, 0xcc, 0x08, 0x46, 0x89, 0x48, 0x78, 0x24, 0x24, 0xdf, 0x4c, 0x52,
-	0x95, 0x2b, 0x62, 0x57, 0xf2, 0xcd, 0x07, 0xa1, 0xc8, 0x8f, 0x8b, 0xe9, 0xa6, 0xaf, 0xe2, 0xad,
-	0x50, 0x85, 0x6a, 0x0b, 0x09, 0xd3, 0x62, 0x86, 0x12, 0x0a, 0xb8, 0x32, 0x1b, 0x6f, 0x42, 0x12,
-	0x31, 0x69, 0xd6, 0x9e, 0x82, 0xfe, 0x3e, 0xcf, 0x32, 0x16, 0x72, 0x32, 0x86, 0x4e, 0x26, 0x02,
-	0xb7, 0xb5, 0xde, 0xda, 0xe8, 0x52, 0xbd, 0xd4, 0x88, 0x1f, 0x07, 0x6e, 0xdb, 0x20, 0x7e, 0x1c,
-	0x10, 0x02, 0x5d, 0x5f, 0x05, 0xdc, 0xed, 0xac, 0xb7, 0x36, 0x86, 0x14, 0xd7, 0x1a, 0x0b, 0x58,
-	0xce, 0xdc, 0xae, 0xc1, 0xf4, 0x9a, 0xb8, 0xd0, 0x67, 0x92, 0x45, 0xf3, 0x8c, 0xbb, 0x16, 0xc2,
-	0x95, 0xe8, 0x7d, 0x00, 0xce, 0xae, 0x92, 0x92, 0xfb, 0xb9, 0x4a, 0xc9, 0x2d, 0x18, 0x54, 0x4e,
-	0x4c, 0xca, 0x4f, 0x5b, 0x14, 0x2a, 0x68, 0x2f, 0x20, 0xf7, 0x61, 0xd5, 0xaf, 0xd8, 0x13, 0x21,
-	0x03, 0x7e, 0x8a, 0xd6, 0x58, 0x74, 0x54, 0xc3, 0x7b, 0x1a, 0xf5, 0x9e, 0x83, 0xfd, 0x44, 0x64,
-	0x09, 0xcb, 0xfd, 0x63, 0x6d, 0x36, 0x8b, 0x22, 0xfc, 0x35, 0x9b, 0xea, 0x25, 0x79, 0x08, 0x4e,
-	0xcd, 0x77, 0xdb, 0xeb, 0x9d, 0x8d, 0xc1, 0xf6, 0x9f, 0x36, 0xeb, 0x70, 0xd6, 0xf6, 0xd0, 0x05,
-	0xcb, 0x7b, 0x0e, 0xce, 0x4e, 0x18, 0xa6, 0x3c, 0x64, 0x39, 0x27, 0x23, 0x68, 0xab, 0xa4, 0x34,
-	0xaf, 0xad, 0x12, 0x74, 0x59, 0x64, 0x39, 0xda, 0x62, 0x53, 0x5c, 0x93, 0x35, 0xe8, 0xf2, 0xd3,
-	0x24, 0xc5, 0xd0, 0x0c, 0xb6, 0x61, 0x13, 0x83, 0xfc, 0xf4, 0x34, 0x49, 0x29, 0xe2, 0xde, 0xb7,
-	0x2d, 0xb0, 0xfe, 0x9f, 0xaa, 0x22, 0x21, 0x7f, 0x05, 0x47, 0x72, 0x1e, 0x4c, 0xf8, 0x4b, 0x56,
-	0x59, 0x69, 0x6b, 0xe0, 0xe9, 0x4b, 0x16, 0xe9, 0xc8, 0x89, 0x69, 0xe1, 0x9f, 0xf0, 0xbc, 0x8c,
-	0x7b, 0x25, 0x6a, 0x8d, 0x2c, 0x35, 0x1d, 0xa3, 0x29, 0x45, 0xb2, 0x0e, 0x96, 0xfe, 0x44, 0xe6,
-	0x76, 0xd1, 0xb5, 0xe6, 0xb7, 0x8d, 0x42, 0x33, 0xf2, 0x79, 0xc2, 0x33, 0xd7, 0x6a, 0x32, 0xde,
-	0x9f, 0x27, 0x9c, 0x1a, 0x05, 0xb9, 0x0f, 0x5d, 0x16, 0x86, 0x99, 0xdb, 0x3b, 0x1f, 0x9d, 0x3a,
-	0x0a, 0x14, 0x09, 0xde, 0xa7, 0x6d, 0xe8, 0xbe, 0xa7, 0x84, 0x6c, 0x5a, 0xda, 0xba, 0xd0, 0xd2,
-	0xf6, 0x59, 0x4b, 0x6f, 0x80, 0x9d, 0xf2, 0x68, 0x12, 0xe9, 0xe0, 0x75, 0xd6, 0x3b, 0x1b, 0x16,
-	0xed, 0xa7, 0x3c, 0x7a, 0xa6, 0xe3, 0x77, 0x03, 0x6c, 0x5f, 0x95, 0xaa, 0xae, 0x51, 0xf9, 0xca,
-	0xa8, 0xaa, 0xd0, 0x5a, 0x6f, 0x0e, 0xed, 0xc2, 0xbb, 0xde, 0xc5, 0xde, 0x39, 0x11, 0x9f, 0xe5,
-	0x13, 0x5f, 0xc9, 0xc0, 0xed, 0x2f, 0x45, 0xc9, 0xd6, 0xca, 0x5d, 0x25, 0x03, 0xf2, 0x4f, 0x80,
-	0x54, 0x84, 0xc7, 0x25, 0xd3, 0x5e, 0x62, 0x3a, 0xa8, 0xd5, 0x54, 0xef, 0xe7, 0x16, 0xd8, 0x3b,
-	0x32, 0x17, 0xbf, 0x39, 0x18, 0x7f, 0x86, 0x5e, 0xca, 0xb3, 0x22, 0xaa, 0x42, 0x51, 0x4a, 0xb5,
-	0xbb, 0xdd, 0x5f, 0x73, 0xd7, 0xba, 0x94, 0xbb, 0xbd, 0x4b, 0xbb, 0xdb, 0x7f, 0x9b, 0xbb, 0x9f,
-	0xb7, 0xc1, 0xd9, 0x93, 0x92, 0xa7, 0xef, 0x92, 0x2f, 0x03, 0xef, 0xb3, 0x36, 0xd8, 0xcf, 0xf8,
-	0x2c, 0x7f, 0x17, 0x8c, 0xb2, 0x13, 0x8e, 0x78, 0xfc, 0x47, 0xe9, 0x84, 0x2f, 0xda, 0x00, 0x47,
-	0x42, 0x86, 0x11, 0x7f, 0x97, 0x7d, 0x19, 0x78, 0x5f, 0x75, 0xc0, 0xde, 0x67, 0xe9, 0xc9, 0xef,
-	0x9e, 0xfd, 0x33, 0xc6, 0x76, 0x2f, 0x6d, 0xac, 0xf5, 0x16, 0x63, 0x2f, 0x11, 0xa2, 0x35, 0x7d,
-	0xc5, 0xc1, 0xe8, 0x2c, 0x05, 0x59, 0xe3, 0xe4, 0x0e, 0xf4, 0x95, 0x34, 0xe9, 0x59, 0x0e, 0x4b,
-	0x4f, 0x49, 0xcc, 0xd4, 0x2d, 0x18, 0xa8, 0x22, 0x4f, 0x8a, 0x7c, 0x22, 0x8b, 0x28, 0x72, 0x1d,
-	0x3c, 0xe4, 0xc1, 0x40, 0x07, 0x45, 0x14, 0x35, 0x08, 0x31, 0x4b, 0x4f, 0x5c, 0x68, 0x12, 0x74,
-	0x30, 0xc9, 0x1d, 0x58, 0x29, 0x09, 0x4c, 0xce, 0x5f, 0xb1, 0xb9, 0x3b, 0x40, 0xca, 0xd0, 0x80,
-	0x3b, 0x88, 0x91, 0xdb, 0x30, 0xd4, 0xdb, 0x27, 0x31, 0x67, 0x52, 0xc8, 0xd0, 0x1d, 0x22, 0x67,
-	0xa0, 0xb1, 0x7d, 0x03, 0x79, 0x0c, 0xfa, 0x87, 0xa9, 0x0a, 0x0a, 0xff, 0x6c, 0xd1, 0xb5, 0x2e,
-	0x2e, 0xba, 0xf6, 0xd9, 0xa2, 0xab, 0x23, 0xd6, 0xb9, 0x20, 0x62, 0xde, 0x0f, 0x16, 0x0c, 0xf6,
-	0x64, 0x96, 0xa7, 0x85, 0x9f, 0x0b, 0x25, 0x97, 0x6e, 0x4b, 0x63, 0xe8, 0x88, 0xa0, 0xba, 0xb8,
-	0xe9, 0x25, 0xb9, 0x07, 0x5d, 0x26, 0x73, 0x51, 0xde, 0x95, 0x48, 0xe3, 0xb2, 0x51, 0x9e, 0xa7,
-	0x14, 0xf5, 0xe4, 0x01, 0xf4, 0xcb, 0x1b, 0x59, 0x39, 0x02, 0xde, 0x78, 0x6b, 0xab, 0x38, 0x64,
-	0x13, 0xec, 0xa0, 0xbc, 0x04, 0x96, 0x3d, 0xd2, 0xf8, 0xe9, 0xea, 0x7a, 0x48, 0x6b, 0x0e, 0xb9,
-	0x0d, 0x1d, 0x16, 0x86, 0x6e, 0x0f, 0xa9, 0xab, 0x0b, 0x2a, 0x5e, 0xd3, 0xa8, 0xd6, 0x91, 0x6d,
-	0x00, 0xa1, 0x0f, 0xbd, 0xc9, 0x0b, 0x25, 0x64, 0x59, 0x13, 0x0d, 0x23, 0xea, 0x03, 0x91, 0x3a,
-	0xa2, 0x3e, 0x1b, 0xb7, 0xca, 0xba, 0xc5, 0x2d, 0xf6, 0x79, 0x3b, 0xaa, 0x53, 0xc3, 0xd4, 0x6f,
-	0xb5, 0x21, 0xe3, 0xb1, 0x30, 0x1b, 0x9c, 0xf3, 0x1b, 0xaa, 0xc9, 0x4a, 0xed, 0xac, 0x9a, 0xb1,
-	0x8f, 0x60, 0x90, 0xe1, 0x00, 0x32, 0x5b, 0x00, 0xb7, 0x5c, 0x6b, 0x6c, 0xa9, 0xa7, 0x13, 0x85,
-	0x6c, 0x31, 0xa9, 0xb6, 0xc0, 0xc1, 0x72, 0xc1, 0x4d, 0x83, 0xf3, 0xdf, 0xa9, 0x7a, 0x98, 0xda,
-	0x71, 0xd5, 0xcd, 0x1e, 0x74, 0x91, 0x3b, 0x44, 0xee, 0x68, 0xc1, 0x35, 0x39, 0xd2, 0x3a, 0xf2,
-	0x2f, 0xe8, 0x27, 0xa6, 0xc0, 0xdc, 0x15, 0xa4, 0x5d, 0x5d, 0xd0, 0xca, 0xca, 0xa3, 0x15, 0x83,
-	0xfc, 0x1b, 0x6c, 0x95, 0x06, 0x3c, 0x9d, 0x4c, 0xe7, 0xee, 0x08, 0xeb, 0xe9, 0xaa, 0xa9, 0xa7,
-	0xe7, 0x1a, 0x7d, 0x3c, 0x3f, 0x4a, 0xb8, 0x4f, 0xfb, 0xca, 0x08, 0xe4, 0x01, 0x0c, 0x93, 0x54,
-	0xbd, 0xe0, 0x7e, 0x6e, 0x2a, 0x73, 0x75, 0xa9, 0xdf, 0x06, 0xa5, 0x1e, 0x2b, 0xd5, 0x83, 0xde,
-	0x4c, 0x44, 0x39, 0x4f, 0xdd, 0xf1, 0x52, 0xef, 0x96, 0x1a, 0x72, 0x0d, 0xac, 0x48, 0xc4, 0x22,
-	0x77, 0xaf, 0xe2, 0x0c, 0x32, 0x82, 0x9e, 0x40, 0x6a, 0x36, 0xcb, 0x78, 0xee, 0x12, 0x84, 0x4b,
-	0xc9, 0x7b, 0x04, 0xc3, 0x1d, 0x7c, 0xb7, 0x88, 0x0c, 0xbf, 0x70, 0x17, 0xba, 0x75, 0xf7, 0xd4,
-	0xa6, 0x23, 0xe3, 0x13, 0xbe, 0x27, 0x67, 0x8a, 0xa2, 0xda, 0xfb, 0xa6, 0x05, 0xbd, 0x23, 0x55,
-	0xa4, 0x3e, 0xd7, 0x7d, 0x9e, 0xf9, 0xc7, 0x3c, 0x66, 0x13, 0xc9, 0x62, 0x8e, 0x4d, 0xe1, 0x50,
-	0x30, 0xd0, 0x01, 0x8b, 0x39, 0xf9, 0x3b, 0x40, 0xce, 0xa6, 0x11, 0x37, 0xfa, 0x36, 0xea, 0x1d,
-	0x44, 0x50, 0xdd, 0x6c, 0x4c, 0xdd, 0x80, 0xce, 0xa2, 0x31, 0xaf, 0x81, 0x35, 0x8d, 0x94, 0x7f,
-	0x82, 0xad, 0xe1, 0x50, 0x23, 0xe0, 0x93, 0xaa, 0xc8, 0x8e, 0x03, 0xf5, 0x4a, 0xea, 0x27, 0x95,
-	0x85, 0xfe, 0x40, 0x05, 0xed, 0xe9, 0xf9, 0xb5, 0x52, 0x13, 0x58, 0x10, 0xa4, 0x58, 0xfe, 0x0e,
-	0x1d, 0x56, 0xe0, 0x4e, 0x10, 0xa4, 0xde, 0x47, 0x60, 0x1f, 0xa8, 0x00, 0x7d, 0xd2, 0x8f, 0x9d,
-	0xd8, 0x4f, 0x8a, 0xb2, 0xa1, 0x71, 0xad, 0x5b, 0x5c, 0x04, 0xa5, 0xb5, 0x6d, 0x81, 0xef, 0x42,
-	0xfc, 0xad, 0x0e, 0x22, 0xb8, 0xd6, 0x03, 0x3f, 0x61, 0xf3, 0x48, 0x31, 0x33, 0xbc, 0x1d, 0x5a,
-	0x89, 0xde, 0x97, 0x5d, 0xb0, 0x0f, 0xcb, 0x1a, 0x21, 0x4f, 0x60, 0xa5, 0x7e, 0x03, 0xea, 0x79,
-	0x82, 0xdf, 0x19, 0x6d, 0xdf, 0x6a, 0x54, 0xd1, 0xf9, 0x05, 0x0e, 0x9f, 0x61, 0xd2, 0x90, 0xce,
-	0xbf, 0x24, 0xdb, 0x4b, 0x2f, 0xc9, 0xbf, 0x41, 0xe7, 0xe3, 0x74, 0x7e, 0xf6, 0x75, 0x76, 0x18,
-	0x31, 0x49, 0x35, 0x4c, 0x1e, 0xc2, 0x40, 0xbf, 0x5b, 0x27, 0x19, 0x66, 0xad, 0x1c, 0x36, 0xe3,
-	0x46, 0x43, 0x21, 0x4e, 0x41, 0x93, 0xca, 0xcc, 0x6e, 0x82, 0xed, 0x1f, 0x8b, 0x28, 0x48, 0xb9,
-	0x2c, 0x8f, 0x1c, 0xb2, 0x6c, 0x32, 0xad, 0x39, 0xe4, 0x7f, 0x30, 0x16, 0x8b, 0x21, 0x69, 0x32,
-	0x6a, 0x0e, 0xa1, 0xeb, 0xcd, 0x79, 0x52, 0x33, 0xe8, 0x6a, 0x83, 0x8e, 0x09, 0xbf, 0x0e, 0x3d,
-	0x91, 0x4d, 0x78, 0x79, 0x36, 0xd9, 0xd4, 0x12, 0xd9, 0x53, 0x19, 0x90, 0xbf, 0x40, 0x5f, 0x64,
-	0x8b, 0x61, 0x63, 0xd3, 0x9e, 0xc8, 0xb0, 0x7b, 0xef, 0x41, 0x57, 0xea, 0xc7, 0xfa, 0xd2, 0x44,
-	0xa9, 0x52, 0x4b, 0x51, 0x4f, 0xfe, 0x01, 0x23, 0x9d, 0xfc, 0x89, 0xa9, 0x19, 0x39, 0x53, 0x38,
-	0x50, 0x2c, 0x53, 0x12, 0x4f, 0x74, 0xd5, 0xe8, 0x32, 0xb8, 0x0b, 0xa3, 0xca, 0x97, 0x89, 0xaf,
-	0x0a, 0x99, 0xe3, 0x04, 0xb1, 0xe8, 0x4a, 0x85, 0xee, 0x6a, 0xd0, 0xfb, 0x2f, 0x0c, 0x9b, 0x69,
-	0x22, 0x0e, 0x58, 0xfb, 0x3c, 0x0d, 0xf9, 0xf8, 0x0a, 0x01, 0xe8, 0x1d, 0xa8, 0x34, 0x66, 0xd1,
-	0xb8, 0xa5, 0xd7, 0x94, 0xc7, 0x2a, 0xe7, 0xe3, 0x36, 0x19, 0x82, 0x7d, 0xc8, 0x52, 0x16, 0x45,
-	0x3c, 0x1a, 0x77, 0x1e, 0xef, 0x7e, 0xf7, 0x7a, 0xad, 0xf5, 0xfd, 0xeb, 0xb5, 0xd6, 0x8f, 0xaf,
-	0xd7, 0xae, 0x7c, 0xfd, 0xd3, 0x5a, 0xeb, 0xc3, 0x87, 0x8d, 0xbf, 0x37, 0x62, 0x96, 0xa7, 0xe2,
-	0x54, 0xa5, 0x22, 0x14, 0xb2, 0x12, 0x24, 0xdf, 0x4a, 0x4e, 0xc2, 0xad, 0x64, 0xba, 0x55, 0x79,
-	0x38, 0xed, 0xe1, 0xbf, 0x1b, 0xff, 0xf9, 0x25, 0x00, 0x00, 0xff, 0xff, 0x49, 0x80, 0xd5, 0x98,
-	0x34, 0x11, 0x00, 0x00,
+	// 1666 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x8f, 0xdc, 0x48,
+	0x15, 0xdf, 0xee, 0xb6, 0xbb, 0xed, 0xd7, 0x3d, 0x93, 0x4e, 0x6d, 0x12, 0xbc, 0x0b, 0x4c, 0x66,
+	0xbd, 0xec, 0x6e, 0x56, 0x4b, 0x66, 0x94, 0xa0, 0x3d, 0x71, 0x80, 0xd9, 0x49, 0x84, 0x66, 0x95,
+	0x7f, 0xaa, 0x09, 0x42, 0x42, 0x48, 0x56, 0x8d, 0x5d, 0xed, 0xa9, 0x8c, 0x5d, 0x65, 0xca, 0x76,
+	0x66, 0x9a, 0x0f, 0xc0, 0x01, 0xf8, 0x04, 0x70, 0xe1, 0xc2, 0x9d, 0x13, 0xdf, 0x00, 0x89, 0x23,
+	0x1f, 0x01, 0x85, 0x2b, 0x1f, 0x02, 0xd5, 0x2b, 0xdb, 0xed, 0xe9, 0xde, 0xd9, 0x1d, 0x21, 0x2e,
+	0x88, 0xdc, 0xde, 0x9f, 0x5f, 0xb9, 0x5e, 0xfd, 0xea, 0xd5, 0xab, 0xe7, 0x82, 0xed, 0x42, 0x14,
+	0x3c, 0x13, 0x92, 0xef, 0x15, 0x5a, 0x55, 0x8a, 0x78, 0xad, 0xfe, 0xfe, 0xfd, 0x54, 0x54, 0xa7,
+	0xf5, 0xc9, 0x5e, 0xac, 0xf2, 0xfd, 0x54, 0xa5, 0x6a, 0x1f, 0x01, 0x27, 0xf5, 0x02, 0x35, 0x54,
+	0x50, 0xb2, 0x03, 0xdf, 0x87, 0x22, 0x63, 0xd2, 0xca, 0xa1, 0x82, 0xc9, 0x53, 0x5e, 0x96, 0x2c,
+	0xe5, 0x64, 0x0e, 0xa3, 0x52, 0x24, 0xc1, 0x60, 0x77, 0x70, 0xcf, 0xa1, 0x46, 0x34, 0x96, 0x38,
+	0x4f, 0x82, 0xa1, 0xb5, 0xc4, 0x79, 0x42, 0x08, 0x38, 0xb1, 0x4a, 0x78, 0x30, 0xda, 0x1d, 0xdc,
+	0x9b, 0x51, 0x94, 0x8d, 0x2d, 0x61, 0x15, 0x0b, 0x1c, 0x6b, 0x33, 0x32, 0x09, 0x60, 0xc2, 0x24,
+	0xcb, 0x96, 0x25, 0x0f, 0x5c, 0x34, 0xb7, 0x6a, 0xf8, 0x53, 0xf0, 0x0f, 0x95, 0x94, 0x3c, 0xae,
+	0x94, 0x26, 0x77, 0x61, 0xda, 0x2e, 0x22, 0x6a, 0xa6, 0x76, 0x29, 0xb4, 0xa6, 0xa3, 0x84, 0x7c,
+	0x02, 0x37, 0xe2, 0x16, 0x1d, 0x09, 0x99, 0xf0, 0x0b, 0x8c, 0xc6, 0xa5, 0xdb, 0x9d, 0xf9, 0xc8,
+	0x58, 0xc3, 0xe7, 0xe0, 0x3d, 0x12, 0x65, 0xc1, 0xaa, 0xf8, 0xd4, 0x84, 0xcd, 0xb2, 0x0c, 0xbf,
+	0xe6, 0x51, 0x23, 0x92, 0x07, 0xe0, 0x77, 0xf8, 0x60, 0xb8, 0x3b, 0xba, 0x37, 0x7d, 0xf8, 0xee,
+	0x5e, 0x47, 0x67, 0x17, 0x0f, 0x5d, 0xa1, 0xc2, 0xe7, 0xe0, 0x1f, 0xa4, 0xa9, 0xe6, 0x29, 0xab,
+	0x38, 0xd9, 0x86, 0xa1, 0x2a, 0x9a, 0xf0, 0x86, 0xaa, 0xc0, 0x25, 0x8b, 0xb2, 0xc2, 0x58, 0x3c,
+	0x8a, 0x32, 0xd9, 0x01, 0x87, 0x5f, 0x14, 0x1a, 0xa9, 0x99, 0x3e, 0x84, 0x3d, 0x24, 0xf9, 0xf1,
+	0x45, 0xa1, 0x29, 0xda, 0xc3, 0xbf, 0x0e, 0xc0, 0xfd, 0x89, 0x56, 0x75, 0x41, 0xbe, 0x0d, 0xbe,
+	0xe4, 0x3c, 0x89, 0xf8, 0x6b, 0xd6, 0x46, 0xe9, 0x19, 0xc3, 0xe3, 0xd7, 0x2c, 0x33, 0xcc, 0x89,
+	0x93, 0x3a, 0x3e, 0xe3, 0x55, 0xc3, 0x7b, 0xab, 0x1a, 0x8f, 0x6c, 0x3c, 0x23, 0xeb, 0x69, 0x54,
+	0xb2, 0x0b, 0xae, 0x99, 0xa2, 0x0c, 0x9c, 0xdd, 0xd1, 0xda, 0xdc, 0xd6, 0x61, 0x10, 0xd5, 0xb2,
+	0xe0, 0x65, 0xe0, 0xf6, 0x11, 0x2f, 0x97, 0x05, 0xa7, 0xd6, 0x41, 0x3e, 0x01, 0x87, 0xa5, 0x69,
+	0x19, 0x8c, 0xd7, 0xd9, 0xe9, 0x58, 0xa0, 0x08, 0x08, 0x7f, 0x3d, 0x04, 0xe7, 0x4b, 0x25, 0x64,
+	0x3f, 0xd2, 0xc1, 0x95, 0x91, 0x0e, 0x2f, 0x47, 0xfa, 0x1e, 0x78, 0x9a, 0x67, 0x51, 0x66, 0xc8,
+	0x1b, 0xed, 0x8e, 0xee, 0xb9, 0x74, 0xa2, 0x79, 0xf6, 0xc4, 0xf0, 0xf7, 0x1e, 0x78, 0xb1, 0x6a,
+	0x5c, 0x8e, 0x75, 0xc5, 0x2a, 0x7b, 0xd2, 0xa7, 0xd6, 0xfd, 0x6a, 0x6a, 0x57, 0xab, 0x1b, 0x5f,
+	0xbd, 0x3a, 0x3f, 0xe3, 0x8b, 0x2a, 0x8a, 0x95, 0x4c, 0x82, 0xc9, 0x06, 0x4b, 0x9e, 0x71, 0x1e,
+	0x2a, 0x99, 0x90, 0x4f, 0x01, 0xb4, 0x48, 0x4f, 0x1b, 0xa4, 0xb7, 0x81, 0xf4, 0xd1, 0x6b, 0xa0,
+	0xe1, 0xbf, 0x06, 0xe0, 0x1d, 0xc8, 0x4a, 0xfc, 0xc7, 0x64, 0xdc, 0x81, 0xb1, 0xe6, 0x65, 0x9d,
+	0xb5, 0x54, 0x34, 0x5a, 0xb7, 0x5c, 0xe7, 0x9b, 0x96, 0xeb, 0x5e, 0x6b, 0xb9, 0xe3, 0x6b, 0x2f,
+	0x77, 0xf2, 0x75, 0xcb, 0xfd, 0xed, 0x10, 0xfc, 0x23, 0x29, 0xb9, 0x7e, 0xbb, 0xf9, 0x32, 0x09,
+	0x7f, 0x33, 0x04, 0xef, 0x09, 0x5f, 0x54, 0x6f, 0xc9, 0x90, 0x49, 0xf8, 0x97, 0x21, 0xf8, 0xd4,
+	0x68, 0xff, 0x73, 0x6c, 0x7c, 0x0a, 0x80, 0x6c, 0x58, 0xd8, 0x64, 0x03, 0x86, 0x5c, 0xbd, 0xdc,
+	0x24, 0xce, 0xbb, 0x36, 0x71, 0xfe, 0x37, 0x95, 0x90, 0x63, 0x9e, 0xff, 0xbf, 0x94, 0x90, 0xdf,
+	0x0d, 0x01, 0x8e, 0x85, 0x4c, 0x33, 0xfe, 0xf6, 0xd8, 0xc8, 0x24, 0xfc, 0xc3, 0x08, 0xbc, 0xa7,
+	0x4c, 0x9f, 0xfd, 0xd7, 0x77, 0xff, 0x52, 0xb0, 0xce, 0xb5, 0x83, 0x75, 0xbf, 0x26, 0xd8, 0x6b,
+	0x50, 0xb4, 0x03, 0x4e, 0xc3, 0xce, 0x06, 0xc9, 0xc6, 0x4e, 0x3e, 0x84, 0x89, 0x92, 0x76, 0x7b,
+	0x36, 0x69, 0x19, 0x2b, 0x89, 0x3b, 0x75, 0x17, 0xa6, 0xaa, 0xae, 0x8a, 0xba, 0x8a, 0x64, 0x9d,
+	0x65, 0x81, 0x8f, 0xdd, 0x11, 0x58, 0xd3, 0xb3, 0x3a, 0xcb, 0x7a, 0x80, 0x9c, 0xe9, 0xb3, 0x00,
+	0xfa, 0x00, 0x43, 0x26, 0xf9, 0x10, 0xb6, 0x1a, 0x00, 0x93, 0xcb, 0x73, 0xb6, 0x0c, 0xa6, 0x08,
+	0x99, 0x59, 0xe3, 0x01, 0xda, 0xc8, 0x07, 0x30, 0x33, 0xc3, 0xa3, 0x9c, 0x33, 0x29, 0x64, 0x1a,
+	0xcc, 0x10, 0x33, 0x35, 0xb6, 0xa7, 0xd6, 0x14, 0x32, 0x98, 0xbc, 0xd0, 0x2a, 0xa9, 0xe3, 0xcb,
+	0x49, 0x37, 0xb8, 0x3a, 0xe9, 0x86, 0x97, 0x93, 0xae, 0x63, 0x6c, 0x74, 0x05, 0x63, 0x21, 0x83,
+	0x19, 0xe5, 0x71, 0xad, 0x4b, 0xf1, 0x9a, 0x1f, 0x56, 0xdc, 0x34, 0x86, 0xb5, 0x14, 0x4a, 0x46,
+	0xab, 0xf6, 0xd5, 0x43, 0xc3, 0x41, 0x96, 0x19, 0x67, 0xce, 0x2e, 0xa2, 0x84, 0x17, 0xd5, 0x29,
+	0x26, 0xc2, 0x88, 0x7a, 0x39, 0xbb, 0x78, 0x64, 0x74, 0xf2, 0x2d, 0x98, 0x94, 0x15, 0x2f, 0x4c,
+	0x13, 0x6d, 0x7b, 0xc3, 0xb1, 0x51, 0x8f, 0x92, 0xf0, 0x4f, 0x13, 0x98, 0x1e, 0xc9, 0xb2, 0xd2,
+	0x75, 0x5c, 0x09, 0x25, 0x37, 0x3a, 0xd9, 0x39, 0x8c, 0x44, 0xd2, 0x36, 0xd5, 0x46, 0x24, 0x1f,
+	0x83, 0xc3, 0x64, 0x25, 0x9a, 0x3e, 0x96, 0xf4, 0x1a, 0xc1, 0xa6, 0xd7, 0xa1, 0xe8, 0x27, 0xf7,
+	0x61, 0xd2, 0x74, 0xcb, 0x4d, 0x95, 0xf9, 0xca, 0x8e, 0xba, 0xc5, 0x90, 0x3d, 0xf0, 0x92, 0xa6,
+	0x41, 0x0f, 0xdc, 0xf5, 0x4f, 0xb7, 0xad, 0x3b, 0xed, 0x30, 0xe4, 0x03, 0x18, 0xb1, 0x34, 0x0d,
+	0xc6, 0x08, 0xbd, 0xb1, 0x82, 0x62, 0x0b, 0x4d, 0x8d, 0x8f, 0x3c, 0x04, 0x10, 0x52, 0x72, 0x1d,
+	0xbd, 0x52, 0x42, 0x06, 0x93, 0xf5, 0x20, 0xba, 0x66, 0x85, 0xfa, 0xa2, 0x15, 0xc9, 0x7e, 0x73,
+	0x34, 0x70, 0x88, 0xb7, 0x1e, 0x47, 0x7b, 0xa3, 0xdb, 0x23, 0xd2, 0x0e, 0x28, 0x79, 0x2e, 0xec,
+	0x00, 0x7f, 0x7d, 0x40, 0x5b, 0xbc, 0xa9, 0x57, 0x36, 0x12, 0xf9, 0x1c, 0xa6, 0x25, 0xd6, 0x38,
+	0x3b, 0x04, 0x70, 0xc8, 0xad, 0xde, 0x90, 0xae, 0x00, 0x52, 0x28, 0x3b, 0xd9, 0xcc, 0x83, 0x19,
+	0x89, 0x83, 0xa6, 0xeb, 0xf3, 0xb4, 0x65, 0xc2, 0x6c, 0xb9, 0x95, 0x48, 0x08, 0x0e, 0x62, 0x67,
+	0x88, 0xdd, 0x5e, 0x61, 0xed, 0x1e, 0x19, 0x1f, 0xf9, 0x0c, 0x26, 0x85, 0xcd, 0xe1, 0x60, 0x0b,
+	0x61, 0x37, 0x57, 0xb0, 0x26, 0xb9, 0x69, 0x8b, 0x20, 0xdf, 0x07, 0x4f, 0xe9, 0x84, 0xeb, 0xe8,
+	0x64, 0x19, 0x6c, 0x63, 0xca, 0xde, 0xb4, 0x29, 0xfb, 0xdc, 0x58, 0xbf, 0x58, 0x1e, 0x17, 0x3c,
+	0xa6, 0x13, 0x65, 0x15, 0x72, 0x1f, 0x66, 0x85, 0x56, 0xaf, 0x78, 0x5c, 0xd9, 0xe4, 0xbf, 0xb1,
+	0x71, 0xa4, 0xa7, 0x8d, 0x1f, 0x0f, 0x43, 0x08, 0xe3, 0x85, 0xc8, 0x2a, 0xae, 0x83, 0xf9, 0x46,
+	0x79, 0x68, 0x3c, 0xe4, 0x16, 0xb8, 0x99, 0xc8, 0x45, 0x15, 0xdc, 0xc4, 0x14, 0xb6, 0x8a, 0x29,
+	0x72, 0x6a, 0xb1, 0x28, 0x79, 0x15, 0x10, 0x9b, 0xd9, 0x56, 0x23, 0x9f, 0x81, 0x77, 0x2e, 0x64,
+	0x54, 0x16, 0x3c, 0x0e, 0xde, 0xc5, 0x6f, 0xce, 0xed, 0x37, 0x7f, 0x26, 0x64, 0xa2, 0xce, 0x6d,
+	0xb4, 0xe7, 0x42, 0x1a, 0x81, 0xec, 0x01, 0xbc, 0x2a, 0x95, 0x8c, 0x2a, 0x76, 0x92, 0xf1, 0xe0,
+	0x56, 0x9b, 0x54, 0x06, 0xfe, 0x65, 0xa9, 0xe4, 0x4b, 0x63, 0xa6, 0xfe, 0xab, 0x56, 0x34, 0xa9,
+	0x65, 0x0b, 0x23, 0x52, 0x7c, 0x7b, 0x3d, 0xb5, 0xba, 0x66, 0xa7, 0xa9, 0x90, 0x46, 0x24, 0x3f,
+	0x84, 0x2d, 0xdd, 0x9e, 0xe6, 0x28, 0xae, 0x78, 0x70, 0x07, 0x87, 0xdd, 0xe9, 0x0d, 0xeb, 0x1d,
+	0x76, 0x3a, 0xd3, 0x3d, 0x2d, 0xfc, 0x1c, 0x66, 0x07, 0xf8, 0x87, 0x2c, 0x4a, 0xe4, 0xeb, 0x23,
+	0x70, 0xba, 0x72, 0xd3, 0x6d, 0x04, 0x22, 0x7e, 0xc5, 0x8f, 0xe4, 0x42, 0x51, 0x74, 0x87, 0x7f,
+	0x1e, 0xc2, 0xf8, 0x58, 0xd5, 0x3a, 0xe6, 0xa6, 0x30, 0x96, 0xf1, 0x29, 0xcf, 0x59, 0x24, 0x59,
+	0xce, 0xf1, 0x88, 0xfb, 0x14, 0xac, 0xe9, 0x19, 0xcb, 0x39, 0xf9, 0x2e, 0x00, 0x2e, 0xdf, 0xfa,
+	0x87, 0xe8, 0xf7, 0xd1, 0x82, 0xee, 0x7e, 0x25, 0x33, 0x15, 0xcb, 0x5f, 0x55, 0xb2, 0x5b, 0xe0,
+	0x9e, 0x64, 0x2a, 0x3e, 0xc3, 0x83, 0xee, 0x53, 0xab, 0x98, 0x09, 0x8b, 0xba, 0x3c, 0x4d, 0xd4,
+	0xb9, 0x34, 0x75, 0xc7, 0xc5, 0xdd, 0x81, 0xd6, 0x74, 0x64, 0x0a, 0xfe, 0x56, 0x07, 0x60, 0x49,
+	0xa2, 0xf1, 0x30, 0xfb, 0x74, 0xd6, 0x1a, 0x0f, 0x92, 0x44, 0x9b, 0x9d, 0xc1, 0xff, 0xfa, 0xa8,
+	0x8c, 0x59, 0x7b, 0x88, 0x9b, 0x9d, 0xc1, 0x3f, 0xfb, 0xe3, 0x98, 0xe1, 0x01, 0x6e, 0xc4, 0xee,
+	0x2a, 0xf7, 0xae, 0xb8, 0xca, 0xef, 0xc2, 0x94, 0x59, 0x9a, 0x22, 0x53, 0xd8, 0x7c, 0xfb, 0xa4,
+	0xd0, 0x98, 0x8e, 0x92, 0x8b, 0xf0, 0x17, 0xe0, 0x3d, 0x53, 0x09, 0x92, 0x68, 0xfe, 0xe3, 0xf3,
+	0xb8, 0xa8, 0x9b, 0x7a, 0x88, 0xb2, 0xa9, 0x90, 0x22, 0x69, 0xe8, 0x19, 0x0a, 0x7c, 0xf2, 0xc0,
+	0xe0, 0x47, 0x68, 0x41, 0xd9, 0x5c, 0xc9, 0x05, 0x5b, 0x66, 0x8a, 0xd9, 0xeb, 0xd5, 0xa7, 0xad,
+	0x1a, 0xfe, 0xde, 0x01, 0xef, 0x45, 0xb3, 0xdf, 0xe4, 0x11, 0x6c, 0x75, 0xcf, 0x1b, 0xa6, 0xe2,
+	0xe3, 0x3c, 0xdb, 0x0f, 0xef, 0xf6, 0x0e, 0xe1, 0xba, 0x80, 0xd7, 0xc3, 0xac, 0xe8, 0x69, 0xeb,
+	0x8f, 0x24, 0xc3, 0x8d, 0x47, 0x92, 0xef, 0xc0, 0xe8, 0x97, 0x7a, 0x79, 0xf9, 0xe1, 0xe1, 0x45,
+	0xc6, 0x24, 0x35, 0x66, 0xf2, 0x00, 0xa6, 0xe6, 0x49, 0x26, 0x2a, 0x31, 0x4d, 0x9a, 0x5a, 0x3d,
+	0xef, 0xd5, 0x23, 0xb4, 0x53, 0x30, 0x20, 0x2b, 0x9b, 0x5a, 0x1d, 0x9f, 0x8a, 0x2c, 0xd1, 0x5c,
+	0x36, 0x4d, 0x01, 0xd9, 0x0c, 0x99, 0x76, 0x18, 0xf2, 0x63, 0x98, 0x8b, 0xd5, 0x1d, 0x63, 0x53,
+	0xc8, 0xb6, 0x09, 0xb7, 0xfb, 0xe5, 0xb8, 0x43, 0xd0, 0x1b, 0x3d, 0x38, 0x66, 0xd8, 0x6d, 0x18,
+	0x8b, 0x32, 0xe2, 0x4d, 0xf7, 0xe0, 0x51, 0x57, 0x94, 0x8f, 0x65, 0x62, 0xae, 0x35, 0x51, 0xae,
+	0x6a, 0xb5, 0x47, 0xc7, 0xa2, 0xc4, 0xb3, 0xf6, 0x31, 0x38, 0xd2, 0xbc, 0x43, 0x6d, 0x14, 0xe4,
+	0x76, 0x6b, 0x29, 0xfa, 0xc9, 0xf7, 0x60, 0xdb, 0x64, 0x5b, 0x64, 0x93, 0x54, 0x2e, 0x14, 0xd6,
+	0x63, 0xd7, 0xe6, 0xe0, 0x23, 0x93, 0xa6, 0x26, 0x0d, 0x3e, 0x82, 0xed, 0x76, 0x2d, 0x51, 0xac,
+	0x6a, 0x59, 0x61, 0x01, 0x76, 0xe9, 0x56, 0x6b, 0x3d, 0x34, 0xc6, 0xf0, 0x47, 0x30, 0xeb, 0x6f,
+	0x13, 0xf1, 0xc1, 0x7d, 0xca, 0x75, 0xca, 0xe7, 0xef, 0x10, 0x80, 0xf1, 0x33, 0xa5, 0x73, 0x96,
+	0xcd, 0x07, 0x46, 0xa6, 0x3c, 0x57, 0x15, 0x9f, 0x0f, 0xc9, 0x0c, 0xbc, 0x17, 0x4c, 0xb3, 0x2c,
+	0xe3, 0xd9, 0x7c, 0xf4, 0xc5, 0xe1, 0xdf, 0xde, 0xec, 0x0c, 0xfe, 0xfe, 0x66, 0x67, 0xf0, 0x8f,
+	0x37, 0x3b, 0xef, 0xfc, 0xf1, 0x9f, 0x3b, 0x83, 0x9f, 0x3f, 0xe8, 0xbd, 0xdc, 0xe5, 0xac, 0xd2,
+	0xe2, 0x42, 0x69, 0x91, 0x0a, 0xd9, 0x2a, 0x92, 0xef, 0x17, 0x67, 0xe9, 0x7e, 0x71, 0xb2, 0xdf,
+	0xae, 0xf0, 0x64, 0x8c, 0x0f, 0x77, 0x3f, 0xf8, 0xf7, 0x00, 0x4e, 0x39, 0x9e, 0x74, 0x0f, 0x14,
+	0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -2617,7 +2865,7 @@ func (m *LeftJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SemiJoin) Marshal() (dAtA []byte, err error) {
+func (m *RightJoin) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2627,12 +2875,12 @@ func (m *SemiJoin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SemiJoin) MarshalTo(dAtA []byte) (int, error) {
+func (m *RightJoin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RightJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2652,7 +2900,7 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x4a
 		}
 	}
 	if len(m.LeftCond) > 0 {
@@ -2666,7 +2914,21 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LeftTypes) > 0 {
+		for iNdEx := len(m.LeftTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LeftTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
 	if len(m.Types) > 0 {
@@ -2680,7 +2942,7 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x32
 		}
 	}
 	if m.Expr != nil {
@@ -2693,12 +2955,12 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPipeline(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	if len(m.Result) > 0 {
-		dAtA22 := make([]byte, len(m.Result)*10)
+	if len(m.ColList) > 0 {
+		dAtA22 := make([]byte, len(m.ColList)*10)
 		var j21 int
-		for _, num1 := range m.Result {
+		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
 				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
@@ -2712,6 +2974,25 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA22[:j21])
 		i = encodeVarintPipeline(dAtA, i, uint64(j21))
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RelList) > 0 {
+		dAtA24 := make([]byte, len(m.RelList)*10)
+		var j23 int
+		for _, num1 := range m.RelList {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintPipeline(dAtA, i, uint64(j23))
+		i--
 		dAtA[i] = 0x1a
 	}
 	if m.Nbucket != 0 {
@@ -2727,7 +3008,7 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SingleJoin) Marshal() (dAtA []byte, err error) {
+func (m *SemiJoin) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2737,12 +3018,12 @@ func (m *SingleJoin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SingleJoin) MarshalTo(dAtA []byte) (int, error) {
+func (m *SemiJoin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SingleJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2762,7 +3043,7 @@ func (m *SingleJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x3a
 		}
 	}
 	if len(m.LeftCond) > 0 {
@@ -2776,7 +3057,7 @@ func (m *SingleJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x32
 		}
 	}
 	if len(m.Types) > 0 {
@@ -2790,7 +3071,7 @@ func (m *SingleJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x2a
 		}
 	}
 	if m.Expr != nil {
@@ -2803,31 +3084,12 @@ func (m *SingleJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPipeline(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ColList) > 0 {
-		dAtA25 := make([]byte, len(m.ColList)*10)
-		var j24 int
-		for _, num1 := range m.ColList {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j24++
-			}
-			dAtA25[j24] = uint8(num)
-			j24++
-		}
-		i -= j24
-		copy(dAtA[i:], dAtA25[:j24])
-		i = encodeVarintPipeline(dAtA, i, uint64(j24))
-		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.RelList) > 0 {
-		dAtA27 := make([]byte, len(m.RelList)*10)
+	if len(m.Result) > 0 {
+		dAtA27 := make([]byte, len(m.Result)*10)
 		var j26 int
-		for _, num1 := range m.RelList {
+		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
 				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
@@ -2856,7 +3118,7 @@ func (m *SingleJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MarkJoin) Marshal() (dAtA []byte, err error) {
+func (m *SingleJoin) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2866,12 +3128,12 @@ func (m *MarkJoin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MarkJoin) MarshalTo(dAtA []byte) (int, error) {
+func (m *SingleJoin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarkJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SingleJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2880,16 +3142,145 @@ func (m *MarkJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MarkMeaning {
-		i--
-		if m.MarkMeaning {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
+	if len(m.RightCond) > 0 {
+		for iNdEx := len(m.RightCond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RightCond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LeftCond) > 0 {
+		for iNdEx := len(m.LeftCond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LeftCond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Types[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Expr != nil {
+		{
+			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ColList) > 0 {
+		dAtA30 := make([]byte, len(m.ColList)*10)
+		var j29 int
+		for _, num1 := range m.ColList {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j29++
+			}
+			dAtA30[j29] = uint8(num)
+			j29++
+		}
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintPipeline(dAtA, i, uint64(j29))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RelList) > 0 {
+		dAtA32 := make([]byte, len(m.RelList)*10)
+		var j31 int
+		for _, num1 := range m.RelList {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			dAtA32[j31] = uint8(num)
+			j31++
+		}
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintPipeline(dAtA, i, uint64(j31))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nbucket != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.Nbucket))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Ibucket != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.Ibucket))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkJoin) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkJoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MarkMeaning {
+		i--
+		if m.MarkMeaning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.OutputAnyway {
 		i--
 		if m.OutputAnyway {
@@ -2989,21 +3380,21 @@ func (m *MarkJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Result) > 0 {
-		dAtA30 := make([]byte, len(m.Result)*10)
-		var j29 int
+		dAtA35 := make([]byte, len(m.Result)*10)
+		var j34 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j34++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA35[j34] = uint8(num)
+			j34++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintPipeline(dAtA, i, uint64(j29))
+		i -= j34
+		copy(dAtA[i:], dAtA35[:j34])
+		i = encodeVarintPipeline(dAtA, i, uint64(j34))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3059,46 +3450,93 @@ func (m *Product) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ColList) > 0 {
-		dAtA32 := make([]byte, len(m.ColList)*10)
-		var j31 int
+		dAtA37 := make([]byte, len(m.ColList)*10)
+		var j36 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j31++
+				j36++
 			}
-			dAtA32[j31] = uint8(num)
-			j31++
+			dAtA37[j36] = uint8(num)
+			j36++
 		}
-		i -= j31
-		copy(dAtA[i:], dAtA32[:j31])
-		i = encodeVarintPipeline(dAtA, i, uint64(j31))
+		i -= j36
+		copy(dAtA[i:], dAtA37[:j36])
+		i = encodeVarintPipeline(dAtA, i, uint64(j36))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA34 := make([]byte, len(m.RelList)*10)
-		var j33 int
+		dAtA39 := make([]byte, len(m.RelList)*10)
+		var j38 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA39[j38] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j33++
+				j38++
 			}
-			dAtA34[j33] = uint8(num)
-			j33++
+			dAtA39[j38] = uint8(num)
+			j38++
 		}
-		i -= j33
-		copy(dAtA[i:], dAtA34[:j33])
-		i = encodeVarintPipeline(dAtA, i, uint64(j33))
+		i -= j38
+		copy(dAtA[i:], dAtA39[:j38])
+		i = encodeVarintPipeline(dAtA, i, uint64(j38))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
+func (m *RecursiveCte) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecursiveCte) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecursiveCte) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StepId != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.StepId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxDepth != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.MaxDepth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UnionAll {
+		i--
+		if m.UnionAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Instruction) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -3123,6 +3561,62 @@ func (m *Instruction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RecursiveCte != nil {
+		{
+			size, err := m.RecursiveCte.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.RightJoin != nil {
+		{
+			size, err := m.RightJoin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.JsonTable != nil {
+		{
+			size, err := m.JsonTable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.WinSpec != nil {
+		{
+			size, err := m.WinSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.Offset != 0 {
 		i = encodeVarintPipeline(dAtA, i, uint64(m.Offset))
 		i--
@@ -3389,20 +3883,49 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PushdownAddr) > 0 {
-		i -= len(m.PushdownAddr)
-		copy(dAtA[i:], m.PushdownAddr)
-		i = encodeVarintPipeline(dAtA, i, uint64(len(m.PushdownAddr)))
+	if m.AnalyzeIdx != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.AnalyzeIdx))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x48
 	}
-	if m.PushdownId != 0 {
-		i = encodeVarintPipeline(dAtA, i, uint64(m.PushdownId))
+	if m.Expr != nil {
+		{
+			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x42
 	}
-	if len(m.Block) > 0 {
-		i -= len(m.Block)
+	if m.IndexScan != nil {
+		{
+			size, err := m.IndexScan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PushdownAddr) > 0 {
+		i -= len(m.PushdownAddr)
+		copy(dAtA[i:], m.PushdownAddr)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.PushdownAddr)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PushdownId != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.PushdownId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
 		copy(dAtA[i:], m.Block)
 		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Block)))
 		i--
@@ -3970,6 +4493,66 @@ func (m *LeftJoin) ProtoSize() (n int) {
 	return n
 }
 
+func (m *RightJoin) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ibucket != 0 {
+		n += 1 + sovPipeline(uint64(m.Ibucket))
+	}
+	if m.Nbucket != 0 {
+		n += 1 + sovPipeline(uint64(m.Nbucket))
+	}
+	if len(m.RelList) > 0 {
+		l = 0
+		for _, e := range m.RelList {
+			l += sovPipeline(uint64(e))
+		}
+		n += 1 + sovPipeline(uint64(l)) + l
+	}
+	if len(m.ColList) > 0 {
+		l = 0
+		for _, e := range m.ColList {
+			l += sovPipeline(uint64(e))
+		}
+		n += 1 + sovPipeline(uint64(l)) + l
+	}
+	if m.Expr != nil {
+		l = m.Expr.ProtoSize()
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if len(m.Types) > 0 {
+		for _, e := range m.Types {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if len(m.LeftTypes) > 0 {
+		for _, e := range m.LeftTypes {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if len(m.LeftCond) > 0 {
+		for _, e := range m.LeftCond {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if len(m.RightCond) > 0 {
+		for _, e := range m.RightCond {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *SemiJoin) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -4168,6 +4751,27 @@ func (m *Product) ProtoSize() (n int) {
 	return n
 }
 
+func (m *RecursiveCte) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnionAll {
+		n += 2
+	}
+	if m.MaxDepth != 0 {
+		n += 1 + sovPipeline(uint64(m.MaxDepth))
+	}
+	if m.StepId != 0 {
+		n += 1 + sovPipeline(uint64(m.StepId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Instruction) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -4246,6 +4850,22 @@ func (m *Instruction) ProtoSize() (n int) {
 	if m.Offset != 0 {
 		n += 2 + sovPipeline(uint64(m.Offset))
 	}
+	if m.WinSpec != nil {
+		l = m.WinSpec.ProtoSize()
+		n += 2 + l + sovPipeline(uint64(l))
+	}
+	if m.JsonTable != nil {
+		l = m.JsonTable.ProtoSize()
+		n += 2 + l + sovPipeline(uint64(l))
+	}
+	if m.RightJoin != nil {
+		l = m.RightJoin.ProtoSize()
+		n += 2 + l + sovPipeline(uint64(l))
+	}
+	if m.RecursiveCte != nil {
+		l = m.RecursiveCte.ProtoSize()
+		n += 2 + l + sovPipeline(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4301,6 +4921,17 @@ func (m *Source) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPipeline(uint64(l))
 	}
+	if m.IndexScan != nil {
+		l = m.IndexScan.ProtoSize()
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.Expr != nil {
+		l = m.Expr.ProtoSize()
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.AnalyzeIdx != 0 {
+		n += 1 + sovPipeline(uint64(m.AnalyzeIdx))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5538,33 +6169,412 @@ func (m *AntiJoin) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Ibucket |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ibucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nbucket", wireType)
+			}
+			m.Nbucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nbucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Result = append(m.Result, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Result) == 0 {
+					m.Result = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPipeline
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Result = append(m.Result, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &plan.Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, &plan.Type{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftCond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeftCond = append(m.LeftCond, &plan.Expr{})
+			if err := m.LeftCond[len(m.LeftCond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightCond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RightCond = append(m.RightCond, &plan.Expr{})
+			if err := m.RightCond[len(m.RightCond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InnerJoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InnerJoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InnerJoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ibucket", wireType)
+			}
+			m.Ibucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ibucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nbucket", wireType)
+			}
+			m.Nbucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nbucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RelList = append(m.RelList, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPipeline
 				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nbucket", wireType)
-			}
-			m.Nbucket = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPipeline
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nbucket |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RelList) == 0 {
+					m.RelList = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPipeline
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RelList = append(m.RelList, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelList", wireType)
 			}
-		case 3:
+		case 4:
 			if wireType == 0 {
 				var v int32
 				for shift := uint(0); ; shift += 7 {
@@ -5581,7 +6591,7 @@ func (m *AntiJoin) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.Result = append(m.Result, v)
+				m.ColList = append(m.ColList, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -5616,8 +6626,8 @@ func (m *AntiJoin) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.Result) == 0 {
-					m.Result = make([]int32, 0, elementCount)
+				if elementCount != 0 && len(m.ColList) == 0 {
+					m.ColList = make([]int32, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v int32
@@ -5635,12 +6645,12 @@ func (m *AntiJoin) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.Result = append(m.Result, v)
+					m.ColList = append(m.ColList, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ColList", wireType)
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
 			}
@@ -5676,7 +6686,7 @@ func (m *AntiJoin) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
@@ -5710,7 +6720,7 @@ func (m *AntiJoin) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LeftCond", wireType)
 			}
@@ -5744,7 +6754,7 @@ func (m *AntiJoin) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RightCond", wireType)
 			}
@@ -5800,7 +6810,7 @@ func (m *AntiJoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InnerJoin) Unmarshal(dAtA []byte) error {
+func (m *LeftJoin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5823,10 +6833,10 @@ func (m *InnerJoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InnerJoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeftJoin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InnerJoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeftJoin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6179,7 +7189,7 @@ func (m *InnerJoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeftJoin) Unmarshal(dAtA []byte) error {
+func (m *RightJoin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6202,10 +7212,10 @@ func (m *LeftJoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeftJoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: RightJoin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeftJoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RightJoin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6469,6 +7479,40 @@ func (m *LeftJoin) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeftTypes = append(m.LeftTypes, &plan.Type{})
+			if err := m.LeftTypes[len(m.LeftTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LeftCond", wireType)
 			}
@@ -6502,7 +7546,7 @@ func (m *LeftJoin) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RightCond", wireType)
 			}
@@ -7813,36 +8857,160 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 						count++
 					}
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.ColList) == 0 {
-					m.ColList = make([]int32, 0, elementCount)
+				elementCount = count
+				if elementCount != 0 && len(m.ColList) == 0 {
+					m.ColList = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPipeline
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ColList = append(m.ColList, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColList", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, &plan.Type{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecursiveCte) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecursiveCte: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecursiveCte: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnionAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnionAll = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepth", wireType)
+			}
+			m.MaxDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPipeline
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ColList = append(m.ColList, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDepth |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ColList", wireType)
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepId", wireType)
 			}
-			var msglen int
+			m.StepId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -7852,26 +9020,11 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StepId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Types = append(m.Types, &plan.Type{})
-			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -8499,6 +9652,150 @@ func (m *Instruction) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WinSpec == nil {
+				m.WinSpec = &plan.WindowSpec{}
+			}
+			if err := m.WinSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JsonTable == nil {
+				m.JsonTable = &plan.JsonTable{}
+			}
+			if err := m.JsonTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightJoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RightJoin == nil {
+				m.RightJoin = &RightJoin{}
+			}
+			if err := m.RightJoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecursiveCte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecursiveCte == nil {
+				m.RecursiveCte = &RecursiveCte{}
+			}
+			if err := m.RecursiveCte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -8814,6 +10111,97 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			}
 			m.PushdownAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexScan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IndexScan == nil {
+				m.IndexScan = &plan.IndexScan{}
+			}
+			if err := m.IndexScan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &plan.Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalyzeIdx", wireType)
+			}
+			m.AnalyzeIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnalyzeIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
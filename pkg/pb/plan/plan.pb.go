@@ -30,16 +30,25 @@ type CompressType int32
 const (
 	CompressType_None CompressType = 0
 	CompressType_Lz4  CompressType = 1
+	CompressType_Zstd CompressType = 2
+	CompressType_Dict CompressType = 3
+	CompressType_Rle  CompressType = 4
 )
 
 var CompressType_name = map[int32]string{
 	0: "None",
 	1: "Lz4",
+	2: "Zstd",
+	3: "Dict",
+	4: "Rle",
 }
 
 var CompressType_value = map[string]int32{
 	"None": 0,
 	"Lz4":  1,
+	"Zstd": 2,
+	"Dict": 3,
+	"Rle":  4,
 }
 
 func (x CompressType) String() string {
@@ -220,21 +229,24 @@ func (Function_FuncFlag) EnumDescriptor() ([]byte, []int) {
 type IndexDef_IndexType int32
 
 const (
-	IndexDef_INVAILD IndexDef_IndexType = 0
-	IndexDef_ZONEMAP IndexDef_IndexType = 1
-	IndexDef_BSI     IndexDef_IndexType = 2
+	IndexDef_INVAILD   IndexDef_IndexType = 0
+	IndexDef_ZONEMAP   IndexDef_IndexType = 1
+	IndexDef_BSI       IndexDef_IndexType = 2
+	IndexDef_SECONDARY IndexDef_IndexType = 3
 )
 
 var IndexDef_IndexType_name = map[int32]string{
 	0: "INVAILD",
 	1: "ZONEMAP",
 	2: "BSI",
+	3: "SECONDARY",
 }
 
 var IndexDef_IndexType_value = map[string]int32{
-	"INVAILD": 0,
-	"ZONEMAP": 1,
-	"BSI":     2,
+	"INVAILD":   0,
+	"ZONEMAP":   1,
+	"BSI":       2,
+	"SECONDARY": 3,
 }
 
 func (x IndexDef_IndexType) String() string {
@@ -245,6 +257,37 @@ func (IndexDef_IndexType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{17, 0}
 }
 
+type ForeignKeyDef_RefAction int32
+
+const (
+	ForeignKeyDef_RESTRICT  ForeignKeyDef_RefAction = 0
+	ForeignKeyDef_CASCADE   ForeignKeyDef_RefAction = 1
+	ForeignKeyDef_SET_NULL  ForeignKeyDef_RefAction = 2
+	ForeignKeyDef_NO_ACTION ForeignKeyDef_RefAction = 3
+)
+
+var ForeignKeyDef_RefAction_name = map[int32]string{
+	0: "RESTRICT",
+	1: "CASCADE",
+	2: "SET_NULL",
+	3: "NO_ACTION",
+}
+
+var ForeignKeyDef_RefAction_value = map[string]int32{
+	"RESTRICT":  0,
+	"CASCADE":   1,
+	"SET_NULL":  2,
+	"NO_ACTION": 3,
+}
+
+func (x ForeignKeyDef_RefAction) String() string {
+	return proto.EnumName(ForeignKeyDef_RefAction_name, int32(x))
+}
+
+func (ForeignKeyDef_RefAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{22, 0}
+}
+
 type OrderBySpec_OrderByFlag int32
 
 const (
@@ -279,7 +322,88 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{35, 0}
+}
+
+type FrameBound_BoundType int32
+
+const (
+	FrameBound_FOLLOWING   FrameBound_BoundType = 0
+	FrameBound_PRECEDING   FrameBound_BoundType = 1
+	FrameBound_CURRENT_ROW FrameBound_BoundType = 2
+)
+
+var FrameBound_BoundType_name = map[int32]string{
+	0: "FOLLOWING",
+	1: "PRECEDING",
+	2: "CURRENT_ROW",
+}
+
+var FrameBound_BoundType_value = map[string]int32{
+	"FOLLOWING":   0,
+	"PRECEDING":   1,
+	"CURRENT_ROW": 2,
+}
+
+func (x FrameBound_BoundType) String() string {
+	return proto.EnumName(FrameBound_BoundType_name, int32(x))
+}
+
+func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{36, 0}
+}
+
+type FrameClause_FrameType int32
+
+const (
+	FrameClause_ROWS  FrameClause_FrameType = 0
+	FrameClause_RANGE FrameClause_FrameType = 1
+)
+
+var FrameClause_FrameType_name = map[int32]string{
+	0: "ROWS",
+	1: "RANGE",
+}
+
+var FrameClause_FrameType_value = map[string]int32{
+	"ROWS":  0,
+	"RANGE": 1,
+}
+
+func (x FrameClause_FrameType) String() string {
+	return proto.EnumName(FrameClause_FrameType_name, int32(x))
+}
+
+func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{37, 0}
+}
+
+type JsonTableColumn_Kind int32
+
+const (
+	JsonTableColumn_VALUE      JsonTableColumn_Kind = 0
+	JsonTableColumn_ORDINALITY JsonTableColumn_Kind = 1
+	JsonTableColumn_EXISTS     JsonTableColumn_Kind = 2
+)
+
+var JsonTableColumn_Kind_name = map[int32]string{
+	0: "VALUE",
+	1: "ORDINALITY",
+	2: "EXISTS",
+}
+
+var JsonTableColumn_Kind_value = map[string]int32{
+	"VALUE":      0,
+	"ORDINALITY": 1,
+	"EXISTS":     2,
+}
+
+func (x JsonTableColumn_Kind) String() string {
+	return proto.EnumName(JsonTableColumn_Kind_name, int32(x))
+}
+
+func (JsonTableColumn_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{40, 0}
 }
 
 type Node_NodeType int32
@@ -406,7 +530,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{43, 0}
 }
 
 type Node_JoinFlag int32
@@ -452,7 +576,7 @@ func (x Node_JoinFlag) String() string {
 }
 
 func (Node_JoinFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{43, 1}
 }
 
 type Node_AggMode int32
@@ -480,7 +604,7 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{43, 2}
 }
 
 type Query_StatementType int32
@@ -517,7 +641,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{47, 0}
 }
 
 type TransationControl_TclType int32
@@ -545,7 +669,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{48, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -573,7 +697,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{49, 0}
 }
 
 type DataControl_DclType int32
@@ -613,7 +737,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{55, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -695,16 +819,18 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
 }
 
 type Type struct {
-	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nullable             bool     `protobuf:"varint,2,opt,name=nullable,proto3" json:"nullable,omitempty"`
-	Width                int32    `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
-	Precision            int32    `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
-	Size                 int32    `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
-	Scale                int32    `protobuf:"varint,6,opt,name=scale,proto3" json:"scale,omitempty"`
+	Id        int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nullable  bool  `protobuf:"varint,2,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	Width     int32 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Precision int32 `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
+	Size      int32 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Scale     int32 `protobuf:"varint,6,opt,name=scale,proto3" json:"scale,omitempty"`
+	// the comma separated value list of enum and set
+	Enumvalues           string   `protobuf:"bytes,7,opt,name=enumvalues,proto3" json:"enumvalues,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -785,6 +911,13 @@ func (m *Type) GetScale() int32 {
 	return 0
 }
 
+func (m *Type) GetEnumvalues() string {
+	if m != nil {
+		return m.Enumvalues
+	}
+	return ""
+}
+
 // Const: if a const value can be reprensented by int64 or
 // double, use that, otherwise store a string representation.
 type Const struct {
@@ -2146,12 +2279,15 @@ func (m *Default) GetOriginString() string {
 }
 
 type IndexDef struct {
-	Typ                  IndexDef_IndexType `protobuf:"varint,1,opt,name=typ,proto3,enum=plan.IndexDef_IndexType" json:"typ,omitempty"`
-	Name                 string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ColNames             []string           `protobuf:"bytes,3,rep,name=col_names,json=colNames,proto3" json:"col_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Typ      IndexDef_IndexType `protobuf:"varint,1,opt,name=typ,proto3,enum=plan.IndexDef_IndexType" json:"typ,omitempty"`
+	Name     string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ColNames []string           `protobuf:"bytes,3,rep,name=col_names,json=colNames,proto3" json:"col_names,omitempty"`
+	Unique   bool               `protobuf:"varint,4,opt,name=unique,proto3" json:"unique,omitempty"`
+	// the hidden table storing the entries of a secondary index
+	IndexTableName       string   `protobuf:"bytes,5,opt,name=index_table_name,json=indexTableName,proto3" json:"index_table_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IndexDef) Reset()         { *m = IndexDef{} }
@@ -2208,6 +2344,20 @@ func (m *IndexDef) GetColNames() []string {
 	return nil
 }
 
+func (m *IndexDef) GetUnique() bool {
+	if m != nil {
+		return m.Unique
+	}
+	return false
+}
+
+func (m *IndexDef) GetIndexTableName() string {
+	if m != nil {
+		return m.IndexTableName
+	}
+	return ""
+}
+
 type PrimaryKeyDef struct {
 	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2357,6 +2507,223 @@ func (m *PropertiesDef) GetProperties() []*Property {
 	return nil
 }
 
+// CheckDef is a CHECK constraint, the column refs in check are bound to
+// the columns of the table by name
+type CheckDef struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Check                *Expr    `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	CheckStr             string   `protobuf:"bytes,3,opt,name=check_str,json=checkStr,proto3" json:"check_str,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckDef) Reset()         { *m = CheckDef{} }
+func (m *CheckDef) String() string { return proto.CompactTextString(m) }
+func (*CheckDef) ProtoMessage()    {}
+func (*CheckDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{21}
+}
+func (m *CheckDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckDef.Merge(m, src)
+}
+func (m *CheckDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CheckDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckDef proto.InternalMessageInfo
+
+func (m *CheckDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CheckDef) GetCheck() *Expr {
+	if m != nil {
+		return m.Check
+	}
+	return nil
+}
+
+func (m *CheckDef) GetCheckStr() string {
+	if m != nil {
+		return m.CheckStr
+	}
+	return ""
+}
+
+type ForeignKeyDef struct {
+	Name                 string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cols                 []string                `protobuf:"bytes,2,rep,name=cols,proto3" json:"cols,omitempty"`
+	ForeignTbl           string                  `protobuf:"bytes,3,opt,name=foreign_tbl,json=foreignTbl,proto3" json:"foreign_tbl,omitempty"`
+	ForeignCols          []string                `protobuf:"bytes,4,rep,name=foreign_cols,json=foreignCols,proto3" json:"foreign_cols,omitempty"`
+	OnDelete             ForeignKeyDef_RefAction `protobuf:"varint,5,opt,name=on_delete,json=onDelete,proto3,enum=plan.ForeignKeyDef_RefAction" json:"on_delete,omitempty"`
+	OnUpdate             ForeignKeyDef_RefAction `protobuf:"varint,6,opt,name=on_update,json=onUpdate,proto3,enum=plan.ForeignKeyDef_RefAction" json:"on_update,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ForeignKeyDef) Reset()         { *m = ForeignKeyDef{} }
+func (m *ForeignKeyDef) String() string { return proto.CompactTextString(m) }
+func (*ForeignKeyDef) ProtoMessage()    {}
+func (*ForeignKeyDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{22}
+}
+func (m *ForeignKeyDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForeignKeyDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForeignKeyDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForeignKeyDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForeignKeyDef.Merge(m, src)
+}
+func (m *ForeignKeyDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ForeignKeyDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForeignKeyDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForeignKeyDef proto.InternalMessageInfo
+
+func (m *ForeignKeyDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ForeignKeyDef) GetCols() []string {
+	if m != nil {
+		return m.Cols
+	}
+	return nil
+}
+
+func (m *ForeignKeyDef) GetForeignTbl() string {
+	if m != nil {
+		return m.ForeignTbl
+	}
+	return ""
+}
+
+func (m *ForeignKeyDef) GetForeignCols() []string {
+	if m != nil {
+		return m.ForeignCols
+	}
+	return nil
+}
+
+func (m *ForeignKeyDef) GetOnDelete() ForeignKeyDef_RefAction {
+	if m != nil {
+		return m.OnDelete
+	}
+	return ForeignKeyDef_RESTRICT
+}
+
+func (m *ForeignKeyDef) GetOnUpdate() ForeignKeyDef_RefAction {
+	if m != nil {
+		return m.OnUpdate
+	}
+	return ForeignKeyDef_RESTRICT
+}
+
+// ConstraintDef holds the CHECK and FOREIGN KEY constraints of a table, and
+// the tables whose foreign keys reference it
+type ConstraintDef struct {
+	Checks               []*CheckDef      `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+	Fkeys                []*ForeignKeyDef `protobuf:"bytes,2,rep,name=fkeys,proto3" json:"fkeys,omitempty"`
+	RefChildTbls         []string         `protobuf:"bytes,3,rep,name=ref_child_tbls,json=refChildTbls,proto3" json:"ref_child_tbls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ConstraintDef) Reset()         { *m = ConstraintDef{} }
+func (m *ConstraintDef) String() string { return proto.CompactTextString(m) }
+func (*ConstraintDef) ProtoMessage()    {}
+func (*ConstraintDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{23}
+}
+func (m *ConstraintDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConstraintDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConstraintDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConstraintDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstraintDef.Merge(m, src)
+}
+func (m *ConstraintDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ConstraintDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstraintDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstraintDef proto.InternalMessageInfo
+
+func (m *ConstraintDef) GetChecks() []*CheckDef {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+func (m *ConstraintDef) GetFkeys() []*ForeignKeyDef {
+	if m != nil {
+		return m.Fkeys
+	}
+	return nil
+}
+
+func (m *ConstraintDef) GetRefChildTbls() []string {
+	if m != nil {
+		return m.RefChildTbls
+	}
+	return nil
+}
+
 type PartitionInfo struct {
 	Type                 PartitionType    `protobuf:"varint,1,opt,name=type,proto3,enum=plan.PartitionType" json:"type,omitempty"`
 	Expr                 *Expr            `protobuf:"bytes,2,opt,name=Expr,proto3" json:"Expr,omitempty"`
@@ -2377,7 +2744,7 @@ func (m *PartitionInfo) Reset()         { *m = PartitionInfo{} }
 func (m *PartitionInfo) String() string { return proto.CompactTextString(m) }
 func (*PartitionInfo) ProtoMessage()    {}
 func (*PartitionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{21}
+	return fileDescriptor_2d655ab2f7683c23, []int{24}
 }
 func (m *PartitionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2492,7 +2859,7 @@ func (m *PartitionItem) Reset()         { *m = PartitionItem{} }
 func (m *PartitionItem) String() string { return proto.CompactTextString(m) }
 func (*PartitionItem) ProtoMessage()    {}
 func (*PartitionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{22}
+	return fileDescriptor_2d655ab2f7683c23, []int{25}
 }
 func (m *PartitionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2574,7 +2941,7 @@ func (m *ViewDef) Reset()         { *m = ViewDef{} }
 func (m *ViewDef) String() string { return proto.CompactTextString(m) }
 func (*ViewDef) ProtoMessage()    {}
 func (*ViewDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{23}
+	return fileDescriptor_2d655ab2f7683c23, []int{26}
 }
 func (m *ViewDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2626,7 +2993,7 @@ func (m *TableDef) Reset()         { *m = TableDef{} }
 func (m *TableDef) String() string { return proto.CompactTextString(m) }
 func (*TableDef) ProtoMessage()    {}
 func (*TableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{24}
+	return fileDescriptor_2d655ab2f7683c23, []int{27}
 }
 func (m *TableDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,6 +3071,7 @@ type TableDef_DefType struct {
 	//	*TableDef_DefType_Properties
 	//	*TableDef_DefType_View
 	//	*TableDef_DefType_Partition
+	//	*TableDef_DefType_Constraint
 	Def                  isTableDef_DefType_Def `protobuf_oneof:"def"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
@@ -2714,7 +3082,7 @@ func (m *TableDef_DefType) Reset()         { *m = TableDef_DefType{} }
 func (m *TableDef_DefType) String() string { return proto.CompactTextString(m) }
 func (*TableDef_DefType) ProtoMessage()    {}
 func (*TableDef_DefType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{24, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{27, 0}
 }
 func (m *TableDef_DefType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2764,12 +3132,16 @@ type TableDef_DefType_View struct {
 type TableDef_DefType_Partition struct {
 	Partition *PartitionInfo `protobuf:"bytes,5,opt,name=partition,proto3,oneof" json:"partition,omitempty"`
 }
+type TableDef_DefType_Constraint struct {
+	Constraint *ConstraintDef `protobuf:"bytes,6,opt,name=constraint,proto3,oneof" json:"constraint,omitempty"`
+}
 
 func (*TableDef_DefType_Pk) isTableDef_DefType_Def()         {}
 func (*TableDef_DefType_Idx) isTableDef_DefType_Def()        {}
 func (*TableDef_DefType_Properties) isTableDef_DefType_Def() {}
 func (*TableDef_DefType_View) isTableDef_DefType_Def()       {}
 func (*TableDef_DefType_Partition) isTableDef_DefType_Def()  {}
+func (*TableDef_DefType_Constraint) isTableDef_DefType_Def() {}
 
 func (m *TableDef_DefType) GetDef() isTableDef_DefType_Def {
 	if m != nil {
@@ -2813,6 +3185,13 @@ func (m *TableDef_DefType) GetPartition() *PartitionInfo {
 	return nil
 }
 
+func (m *TableDef_DefType) GetConstraint() *ConstraintDef {
+	if x, ok := m.GetDef().(*TableDef_DefType_Constraint); ok {
+		return x.Constraint
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*TableDef_DefType) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2821,6 +3200,7 @@ func (*TableDef_DefType) XXX_OneofWrappers() []interface{} {
 		(*TableDef_DefType_Properties)(nil),
 		(*TableDef_DefType_View)(nil),
 		(*TableDef_DefType_Partition)(nil),
+		(*TableDef_DefType_Constraint)(nil),
 	}
 }
 
@@ -2839,7 +3219,7 @@ func (m *Cost) Reset()         { *m = Cost{} }
 func (m *Cost) String() string { return proto.CompactTextString(m) }
 func (*Cost) ProtoMessage()    {}
 func (*Cost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{25}
+	return fileDescriptor_2d655ab2f7683c23, []int{28}
 }
 func (m *Cost) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2903,32 +3283,28 @@ func (m *Cost) GetTotal() float64 {
 	return 0
 }
 
-type ColData struct {
-	RowCount             int32     `protobuf:"varint,1,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
-	NullCount            int32     `protobuf:"varint,2,opt,name=null_count,json=nullCount,proto3" json:"null_count,omitempty"`
-	Nulls                []bool    `protobuf:"varint,3,rep,packed,name=nulls,proto3" json:"nulls,omitempty"`
-	I32                  []int32   `protobuf:"varint,4,rep,packed,name=i32,proto3" json:"i32,omitempty"`
-	I64                  []int64   `protobuf:"varint,5,rep,packed,name=i64,proto3" json:"i64,omitempty"`
-	F32                  []float32 `protobuf:"fixed32,6,rep,packed,name=f32,proto3" json:"f32,omitempty"`
-	F64                  []float64 `protobuf:"fixed64,7,rep,packed,name=f64,proto3" json:"f64,omitempty"`
-	S                    []string  `protobuf:"bytes,8,rep,name=s,proto3" json:"s,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+// HistogramBucket covers the values in (upper of the previous bucket, upper]
+type HistogramBucket struct {
+	Upper                float64  `protobuf:"fixed64,1,opt,name=upper,proto3" json:"upper,omitempty"`
+	Count                float64  `protobuf:"fixed64,2,opt,name=count,proto3" json:"count,omitempty"`
+	Ndv                  float64  `protobuf:"fixed64,3,opt,name=ndv,proto3" json:"ndv,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ColData) Reset()         { *m = ColData{} }
-func (m *ColData) String() string { return proto.CompactTextString(m) }
-func (*ColData) ProtoMessage()    {}
-func (*ColData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{26}
+func (m *HistogramBucket) Reset()         { *m = HistogramBucket{} }
+func (m *HistogramBucket) String() string { return proto.CompactTextString(m) }
+func (*HistogramBucket) ProtoMessage()    {}
+func (*HistogramBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{29}
 }
-func (m *ColData) XXX_Unmarshal(b []byte) error {
+func (m *HistogramBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ColData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HistogramBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ColData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HistogramBucket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2938,10 +3314,278 @@ func (m *ColData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ColData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ColData.Merge(m, src)
+func (m *HistogramBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistogramBucket.Merge(m, src)
 }
-func (m *ColData) XXX_Size() int {
+func (m *HistogramBucket) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *HistogramBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistogramBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistogramBucket proto.InternalMessageInfo
+
+func (m *HistogramBucket) GetUpper() float64 {
+	if m != nil {
+		return m.Upper
+	}
+	return 0
+}
+
+func (m *HistogramBucket) GetCount() float64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *HistogramBucket) GetNdv() float64 {
+	if m != nil {
+		return m.Ndv
+	}
+	return 0
+}
+
+// Histogram is an equi-depth histogram over the non-null values of a column,
+// values are mapped to double before being put into it
+type Histogram struct {
+	Lower                float64            `protobuf:"fixed64,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Buckets              []*HistogramBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Histogram) Reset()         { *m = Histogram{} }
+func (m *Histogram) String() string { return proto.CompactTextString(m) }
+func (*Histogram) ProtoMessage()    {}
+func (*Histogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{30}
+}
+func (m *Histogram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Histogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Histogram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Histogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Histogram.Merge(m, src)
+}
+func (m *Histogram) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Histogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_Histogram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Histogram proto.InternalMessageInfo
+
+func (m *Histogram) GetLower() float64 {
+	if m != nil {
+		return m.Lower
+	}
+	return 0
+}
+
+func (m *Histogram) GetBuckets() []*HistogramBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type ColumnStats struct {
+	Ndv       float64    `protobuf:"fixed64,1,opt,name=ndv,proto3" json:"ndv,omitempty"`
+	NullCount float64    `protobuf:"fixed64,2,opt,name=null_count,json=nullCount,proto3" json:"null_count,omitempty"`
+	Histogram *Histogram `protobuf:"bytes,3,opt,name=histogram,proto3" json:"histogram,omitempty"`
+	// min and max of the values mapped to double, only valid if has_range
+	HasRange             bool     `protobuf:"varint,4,opt,name=has_range,json=hasRange,proto3" json:"has_range,omitempty"`
+	MinValue             float64  `protobuf:"fixed64,5,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue             float64  `protobuf:"fixed64,6,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ColumnStats) Reset()         { *m = ColumnStats{} }
+func (m *ColumnStats) String() string { return proto.CompactTextString(m) }
+func (*ColumnStats) ProtoMessage()    {}
+func (*ColumnStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{31}
+}
+func (m *ColumnStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ColumnStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ColumnStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ColumnStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ColumnStats.Merge(m, src)
+}
+func (m *ColumnStats) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ColumnStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ColumnStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ColumnStats proto.InternalMessageInfo
+
+func (m *ColumnStats) GetNdv() float64 {
+	if m != nil {
+		return m.Ndv
+	}
+	return 0
+}
+
+func (m *ColumnStats) GetNullCount() float64 {
+	if m != nil {
+		return m.NullCount
+	}
+	return 0
+}
+
+func (m *ColumnStats) GetHistogram() *Histogram {
+	if m != nil {
+		return m.Histogram
+	}
+	return nil
+}
+
+func (m *ColumnStats) GetHasRange() bool {
+	if m != nil {
+		return m.HasRange
+	}
+	return false
+}
+
+func (m *ColumnStats) GetMinValue() float64 {
+	if m != nil {
+		return m.MinValue
+	}
+	return 0
+}
+
+func (m *ColumnStats) GetMaxValue() float64 {
+	if m != nil {
+		return m.MaxValue
+	}
+	return 0
+}
+
+type TableStats struct {
+	RowCount             float64                 `protobuf:"fixed64,1,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Cols                 map[string]*ColumnStats `protobuf:"bytes,2,rep,name=cols,proto3" json:"cols,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *TableStats) Reset()         { *m = TableStats{} }
+func (m *TableStats) String() string { return proto.CompactTextString(m) }
+func (*TableStats) ProtoMessage()    {}
+func (*TableStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{32}
+}
+func (m *TableStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TableStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TableStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TableStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableStats.Merge(m, src)
+}
+func (m *TableStats) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TableStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableStats proto.InternalMessageInfo
+
+func (m *TableStats) GetRowCount() float64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *TableStats) GetCols() map[string]*ColumnStats {
+	if m != nil {
+		return m.Cols
+	}
+	return nil
+}
+
+type ColData struct {
+	RowCount             int32     `protobuf:"varint,1,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	NullCount            int32     `protobuf:"varint,2,opt,name=null_count,json=nullCount,proto3" json:"null_count,omitempty"`
+	Nulls                []bool    `protobuf:"varint,3,rep,packed,name=nulls,proto3" json:"nulls,omitempty"`
+	I32                  []int32   `protobuf:"varint,4,rep,packed,name=i32,proto3" json:"i32,omitempty"`
+	I64                  []int64   `protobuf:"varint,5,rep,packed,name=i64,proto3" json:"i64,omitempty"`
+	F32                  []float32 `protobuf:"fixed32,6,rep,packed,name=f32,proto3" json:"f32,omitempty"`
+	F64                  []float64 `protobuf:"fixed64,7,rep,packed,name=f64,proto3" json:"f64,omitempty"`
+	S                    []string  `protobuf:"bytes,8,rep,name=s,proto3" json:"s,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ColData) Reset()         { *m = ColData{} }
+func (m *ColData) String() string { return proto.CompactTextString(m) }
+func (*ColData) ProtoMessage()    {}
+func (*ColData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{33}
+}
+func (m *ColData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ColData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ColData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ColData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ColData.Merge(m, src)
+}
+func (m *ColData) XXX_Size() int {
 	return m.ProtoSize()
 }
 func (m *ColData) XXX_DiscardUnknown() {
@@ -3018,7 +3662,7 @@ func (m *RowsetData) Reset()         { *m = RowsetData{} }
 func (m *RowsetData) String() string { return proto.CompactTextString(m) }
 func (*RowsetData) ProtoMessage()    {}
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{27}
+	return fileDescriptor_2d655ab2f7683c23, []int{34}
 }
 func (m *RowsetData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3074,7 +3718,7 @@ func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
 func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
 func (*OrderBySpec) ProtoMessage()    {}
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28}
+	return fileDescriptor_2d655ab2f7683c23, []int{35}
 }
 func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,28 +3768,27 @@ func (m *OrderBySpec) GetFlag() OrderBySpec_OrderByFlag {
 	return OrderBySpec_ASC
 }
 
-type WindowSpec struct {
-	PartitionBy          []*Expr        `protobuf:"bytes,1,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
-	OrderBy              []*OrderBySpec `protobuf:"bytes,2,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Lead                 int32          `protobuf:"varint,3,opt,name=lead,proto3" json:"lead,omitempty"`
-	Lag                  int32          `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type FrameBound struct {
+	Type                 FrameBound_BoundType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.FrameBound_BoundType" json:"type,omitempty"`
+	Unbounded            bool                 `protobuf:"varint,2,opt,name=unbounded,proto3" json:"unbounded,omitempty"`
+	Val                  *Expr                `protobuf:"bytes,3,opt,name=val,proto3" json:"val,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
-func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
-func (*WindowSpec) ProtoMessage()    {}
-func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29}
+func (m *FrameBound) Reset()         { *m = FrameBound{} }
+func (m *FrameBound) String() string { return proto.CompactTextString(m) }
+func (*FrameBound) ProtoMessage()    {}
+func (*FrameBound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{36}
 }
-func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
+func (m *FrameBound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WindowSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FrameBound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WindowSpec.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FrameBound.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3155,73 +3798,60 @@ func (m *WindowSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *WindowSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WindowSpec.Merge(m, src)
+func (m *FrameBound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameBound.Merge(m, src)
 }
-func (m *WindowSpec) XXX_Size() int {
+func (m *FrameBound) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *WindowSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_WindowSpec.DiscardUnknown(m)
+func (m *FrameBound) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameBound.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WindowSpec proto.InternalMessageInfo
-
-func (m *WindowSpec) GetPartitionBy() []*Expr {
-	if m != nil {
-		return m.PartitionBy
-	}
-	return nil
-}
+var xxx_messageInfo_FrameBound proto.InternalMessageInfo
 
-func (m *WindowSpec) GetOrderBy() []*OrderBySpec {
+func (m *FrameBound) GetType() FrameBound_BoundType {
 	if m != nil {
-		return m.OrderBy
+		return m.Type
 	}
-	return nil
+	return FrameBound_FOLLOWING
 }
 
-func (m *WindowSpec) GetLead() int32 {
+func (m *FrameBound) GetUnbounded() bool {
 	if m != nil {
-		return m.Lead
+		return m.Unbounded
 	}
-	return 0
+	return false
 }
 
-func (m *WindowSpec) GetLag() int32 {
+func (m *FrameBound) GetVal() *Expr {
 	if m != nil {
-		return m.Lag
+		return m.Val
 	}
-	return 0
+	return nil
 }
 
-type UpdateCtx struct {
-	DbName               string    `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	TblName              string    `protobuf:"bytes,2,opt,name=tbl_name,json=tblName,proto3" json:"tbl_name,omitempty"`
-	PriKey               string    `protobuf:"bytes,3,opt,name=pri_key,json=priKey,proto3" json:"pri_key,omitempty"`
-	PriKeyIdx            int32     `protobuf:"varint,4,opt,name=pri_key_idx,json=priKeyIdx,proto3" json:"pri_key_idx,omitempty"`
-	HideKey              string    `protobuf:"bytes,5,opt,name=hide_key,json=hideKey,proto3" json:"hide_key,omitempty"`
-	HideKeyIdx           int32     `protobuf:"varint,6,opt,name=hide_key_idx,json=hideKeyIdx,proto3" json:"hide_key_idx,omitempty"`
-	UpdateCols           []*ColDef `protobuf:"bytes,7,rep,name=update_cols,json=updateCols,proto3" json:"update_cols,omitempty"`
-	OtherAttrs           []string  `protobuf:"bytes,8,rep,name=other_attrs,json=otherAttrs,proto3" json:"other_attrs,omitempty"`
-	OrderAttrs           []string  `protobuf:"bytes,9,rep,name=order_attrs,json=orderAttrs,proto3" json:"order_attrs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type FrameClause struct {
+	Type                 FrameClause_FrameType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.FrameClause_FrameType" json:"type,omitempty"`
+	Start                *FrameBound           `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *FrameBound           `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *UpdateCtx) Reset()         { *m = UpdateCtx{} }
-func (m *UpdateCtx) String() string { return proto.CompactTextString(m) }
-func (*UpdateCtx) ProtoMessage()    {}
-func (*UpdateCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{30}
+func (m *FrameClause) Reset()         { *m = FrameClause{} }
+func (m *FrameClause) String() string { return proto.CompactTextString(m) }
+func (*FrameClause) ProtoMessage()    {}
+func (*FrameClause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{37}
 }
-func (m *UpdateCtx) XXX_Unmarshal(b []byte) error {
+func (m *FrameClause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateCtx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FrameClause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateCtx.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FrameClause.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3231,10 +3861,316 @@ func (m *UpdateCtx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *UpdateCtx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateCtx.Merge(m, src)
+func (m *FrameClause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameClause.Merge(m, src)
 }
-func (m *UpdateCtx) XXX_Size() int {
+func (m *FrameClause) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FrameClause) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameClause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrameClause proto.InternalMessageInfo
+
+func (m *FrameClause) GetType() FrameClause_FrameType {
+	if m != nil {
+		return m.Type
+	}
+	return FrameClause_ROWS
+}
+
+func (m *FrameClause) GetStart() *FrameBound {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *FrameClause) GetEnd() *FrameBound {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+type WindowSpec struct {
+	PartitionBy          []*Expr        `protobuf:"bytes,1,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
+	OrderBy              []*OrderBySpec `protobuf:"bytes,2,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Lead                 int32          `protobuf:"varint,3,opt,name=lead,proto3" json:"lead,omitempty"`
+	Lag                  int32          `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	WindowFunc           *Expr          `protobuf:"bytes,5,opt,name=window_func,json=windowFunc,proto3" json:"window_func,omitempty"`
+	Frame                *FrameClause   `protobuf:"bytes,6,opt,name=frame,proto3" json:"frame,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
+func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
+func (*WindowSpec) ProtoMessage()    {}
+func (*WindowSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{38}
+}
+func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindowSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WindowSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowSpec.Merge(m, src)
+}
+func (m *WindowSpec) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WindowSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowSpec proto.InternalMessageInfo
+
+func (m *WindowSpec) GetPartitionBy() []*Expr {
+	if m != nil {
+		return m.PartitionBy
+	}
+	return nil
+}
+
+func (m *WindowSpec) GetOrderBy() []*OrderBySpec {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *WindowSpec) GetLead() int32 {
+	if m != nil {
+		return m.Lead
+	}
+	return 0
+}
+
+func (m *WindowSpec) GetLag() int32 {
+	if m != nil {
+		return m.Lag
+	}
+	return 0
+}
+
+func (m *WindowSpec) GetWindowFunc() *Expr {
+	if m != nil {
+		return m.WindowFunc
+	}
+	return nil
+}
+
+func (m *WindowSpec) GetFrame() *FrameClause {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
+// JsonTable is the JSON_TABLE of a FUNCTION_SCAN node. Each row of the child
+// is joined with the rows made from the values matched by the path in doc, the
+// row is kept with nulls if nothing matches and outer is set.
+type JsonTable struct {
+	Doc                  *Expr              `protobuf:"bytes,1,opt,name=doc,proto3" json:"doc,omitempty"`
+	Path                 string             `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Cols                 []*JsonTableColumn `protobuf:"bytes,3,rep,name=cols,proto3" json:"cols,omitempty"`
+	Outer                bool               `protobuf:"varint,4,opt,name=outer,proto3" json:"outer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *JsonTable) Reset()         { *m = JsonTable{} }
+func (m *JsonTable) String() string { return proto.CompactTextString(m) }
+func (*JsonTable) ProtoMessage()    {}
+func (*JsonTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{39}
+}
+func (m *JsonTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JsonTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JsonTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JsonTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsonTable.Merge(m, src)
+}
+func (m *JsonTable) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *JsonTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsonTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsonTable proto.InternalMessageInfo
+
+func (m *JsonTable) GetDoc() *Expr {
+	if m != nil {
+		return m.Doc
+	}
+	return nil
+}
+
+func (m *JsonTable) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *JsonTable) GetCols() []*JsonTableColumn {
+	if m != nil {
+		return m.Cols
+	}
+	return nil
+}
+
+func (m *JsonTable) GetOuter() bool {
+	if m != nil {
+		return m.Outer
+	}
+	return false
+}
+
+type JsonTableColumn struct {
+	Name string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind JsonTableColumn_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=plan.JsonTableColumn_Kind" json:"kind,omitempty"`
+	Path string               `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// expr converts the value at the path, which is the column 0 of its
+	// batch, to the type of the column
+	Expr                 *Expr    `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsonTableColumn) Reset()         { *m = JsonTableColumn{} }
+func (m *JsonTableColumn) String() string { return proto.CompactTextString(m) }
+func (*JsonTableColumn) ProtoMessage()    {}
+func (*JsonTableColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
+}
+func (m *JsonTableColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JsonTableColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JsonTableColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JsonTableColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsonTableColumn.Merge(m, src)
+}
+func (m *JsonTableColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *JsonTableColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsonTableColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsonTableColumn proto.InternalMessageInfo
+
+func (m *JsonTableColumn) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JsonTableColumn) GetKind() JsonTableColumn_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return JsonTableColumn_VALUE
+}
+
+func (m *JsonTableColumn) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *JsonTableColumn) GetExpr() *Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+type UpdateCtx struct {
+	DbName     string    `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	TblName    string    `protobuf:"bytes,2,opt,name=tbl_name,json=tblName,proto3" json:"tbl_name,omitempty"`
+	PriKey     string    `protobuf:"bytes,3,opt,name=pri_key,json=priKey,proto3" json:"pri_key,omitempty"`
+	PriKeyIdx  int32     `protobuf:"varint,4,opt,name=pri_key_idx,json=priKeyIdx,proto3" json:"pri_key_idx,omitempty"`
+	HideKey    string    `protobuf:"bytes,5,opt,name=hide_key,json=hideKey,proto3" json:"hide_key,omitempty"`
+	HideKeyIdx int32     `protobuf:"varint,6,opt,name=hide_key_idx,json=hideKeyIdx,proto3" json:"hide_key_idx,omitempty"`
+	UpdateCols []*ColDef `protobuf:"bytes,7,rep,name=update_cols,json=updateCols,proto3" json:"update_cols,omitempty"`
+	OtherAttrs []string  `protobuf:"bytes,8,rep,name=other_attrs,json=otherAttrs,proto3" json:"other_attrs,omitempty"`
+	OrderAttrs []string  `protobuf:"bytes,9,rep,name=order_attrs,json=orderAttrs,proto3" json:"order_attrs,omitempty"`
+	// the old values of the columns kept by the secondary indexes, they are
+	// projected after the order_attrs
+	IndexAttrs           []string `protobuf:"bytes,10,rep,name=index_attrs,json=indexAttrs,proto3" json:"index_attrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateCtx) Reset()         { *m = UpdateCtx{} }
+func (m *UpdateCtx) String() string { return proto.CompactTextString(m) }
+func (*UpdateCtx) ProtoMessage()    {}
+func (*UpdateCtx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
+}
+func (m *UpdateCtx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateCtx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateCtx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateCtx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCtx.Merge(m, src)
+}
+func (m *UpdateCtx) XXX_Size() int {
 	return m.ProtoSize()
 }
 func (m *UpdateCtx) XXX_DiscardUnknown() {
@@ -3306,6 +4242,13 @@ func (m *UpdateCtx) GetOrderAttrs() []string {
 	return nil
 }
 
+func (m *UpdateCtx) GetIndexAttrs() []string {
+	if m != nil {
+		return m.IndexAttrs
+	}
+	return nil
+}
+
 type AnalyzeInfo struct {
 	InputRows            int64    `protobuf:"varint,1,opt,name=input_rows,json=inputRows,proto3" json:"input_rows,omitempty"`
 	OutputRows           int64    `protobuf:"varint,2,opt,name=output_rows,json=outputRows,proto3" json:"output_rows,omitempty"`
@@ -3313,6 +4256,7 @@ type AnalyzeInfo struct {
 	OutputSize           int64    `protobuf:"varint,4,opt,name=output_size,json=outputSize,proto3" json:"output_size,omitempty"`
 	TimeConsumed         int64    `protobuf:"varint,5,opt,name=time_consumed,json=timeConsumed,proto3" json:"time_consumed,omitempty"`
 	MemorySize           int64    `protobuf:"varint,6,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
+	PrunedBlocks         int64    `protobuf:"varint,7,opt,name=pruned_blocks,json=prunedBlocks,proto3" json:"pruned_blocks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3322,7 +4266,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{31}
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3393,41 +4337,54 @@ func (m *AnalyzeInfo) GetMemorySize() int64 {
 	return 0
 }
 
+func (m *AnalyzeInfo) GetPrunedBlocks() int64 {
+	if m != nil {
+		return m.PrunedBlocks
+	}
+	return 0
+}
+
 type Node struct {
-	NodeType             Node_NodeType     `protobuf:"varint,1,opt,name=node_type,json=nodeType,proto3,enum=plan.Node_NodeType" json:"node_type,omitempty"`
-	NodeId               int32             `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Cost                 *Cost             `protobuf:"bytes,3,opt,name=cost,proto3" json:"cost,omitempty"`
-	ProjectList          []*Expr           `protobuf:"bytes,4,rep,name=project_list,json=projectList,proto3" json:"project_list,omitempty"`
-	Children             []int32           `protobuf:"varint,5,rep,packed,name=children,proto3" json:"children,omitempty"`
-	JoinType             Node_JoinFlag     `protobuf:"varint,6,opt,name=join_type,json=joinType,proto3,enum=plan.Node_JoinFlag" json:"join_type,omitempty"`
-	OnList               []*Expr           `protobuf:"bytes,7,rep,name=on_list,json=onList,proto3" json:"on_list,omitempty"`
-	FilterList           []*Expr           `protobuf:"bytes,8,rep,name=filter_list,json=filterList,proto3" json:"filter_list,omitempty"`
-	GroupBy              []*Expr           `protobuf:"bytes,9,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
-	GroupingSet          []*Expr           `protobuf:"bytes,10,rep,name=grouping_set,json=groupingSet,proto3" json:"grouping_set,omitempty"`
-	AggList              []*Expr           `protobuf:"bytes,11,rep,name=agg_list,json=aggList,proto3" json:"agg_list,omitempty"`
-	OrderBy              []*OrderBySpec    `protobuf:"bytes,12,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	UpdateCtxs           []*UpdateCtx      `protobuf:"bytes,13,rep,name=update_ctxs,json=updateCtxs,proto3" json:"update_ctxs,omitempty"`
-	WinSpec              *WindowSpec       `protobuf:"bytes,14,opt,name=win_spec,json=winSpec,proto3" json:"win_spec,omitempty"`
-	Limit                *Expr             `protobuf:"bytes,15,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset               *Expr             `protobuf:"bytes,16,opt,name=offset,proto3" json:"offset,omitempty"`
-	TableDef             *TableDef         `protobuf:"bytes,17,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
-	TableDefVec          []*TableDef       `protobuf:"bytes,18,rep,name=table_def_vec,json=tableDefVec,proto3" json:"table_def_vec,omitempty"`
-	ObjRef               *ObjectRef        `protobuf:"bytes,19,opt,name=obj_ref,json=objRef,proto3" json:"obj_ref,omitempty"`
-	RowsetData           *RowsetData       `protobuf:"bytes,20,opt,name=rowset_data,json=rowsetData,proto3" json:"rowset_data,omitempty"`
-	ExtraOptions         string            `protobuf:"bytes,21,opt,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
-	DeleteTablesCtx      []*DeleteTableCtx `protobuf:"bytes,22,rep,name=deleteTablesCtx,proto3" json:"deleteTablesCtx,omitempty"`
-	BindingTags          []int32           `protobuf:"varint,23,rep,packed,name=binding_tags,json=bindingTags,proto3" json:"binding_tags,omitempty"`
-	AnalyzeInfo          *AnalyzeInfo      `protobuf:"bytes,24,opt,name=analyze_info,json=analyzeInfo,proto3" json:"analyze_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	NodeType        Node_NodeType     `protobuf:"varint,1,opt,name=node_type,json=nodeType,proto3,enum=plan.Node_NodeType" json:"node_type,omitempty"`
+	NodeId          int32             `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Cost            *Cost             `protobuf:"bytes,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	ProjectList     []*Expr           `protobuf:"bytes,4,rep,name=project_list,json=projectList,proto3" json:"project_list,omitempty"`
+	Children        []int32           `protobuf:"varint,5,rep,packed,name=children,proto3" json:"children,omitempty"`
+	JoinType        Node_JoinFlag     `protobuf:"varint,6,opt,name=join_type,json=joinType,proto3,enum=plan.Node_JoinFlag" json:"join_type,omitempty"`
+	OnList          []*Expr           `protobuf:"bytes,7,rep,name=on_list,json=onList,proto3" json:"on_list,omitempty"`
+	FilterList      []*Expr           `protobuf:"bytes,8,rep,name=filter_list,json=filterList,proto3" json:"filter_list,omitempty"`
+	GroupBy         []*Expr           `protobuf:"bytes,9,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	GroupingSet     []*Expr           `protobuf:"bytes,10,rep,name=grouping_set,json=groupingSet,proto3" json:"grouping_set,omitempty"`
+	AggList         []*Expr           `protobuf:"bytes,11,rep,name=agg_list,json=aggList,proto3" json:"agg_list,omitempty"`
+	OrderBy         []*OrderBySpec    `protobuf:"bytes,12,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	UpdateCtxs      []*UpdateCtx      `protobuf:"bytes,13,rep,name=update_ctxs,json=updateCtxs,proto3" json:"update_ctxs,omitempty"`
+	WinSpec         *WindowSpec       `protobuf:"bytes,14,opt,name=win_spec,json=winSpec,proto3" json:"win_spec,omitempty"`
+	Limit           *Expr             `protobuf:"bytes,15,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          *Expr             `protobuf:"bytes,16,opt,name=offset,proto3" json:"offset,omitempty"`
+	TableDef        *TableDef         `protobuf:"bytes,17,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	TableDefVec     []*TableDef       `protobuf:"bytes,18,rep,name=table_def_vec,json=tableDefVec,proto3" json:"table_def_vec,omitempty"`
+	ObjRef          *ObjectRef        `protobuf:"bytes,19,opt,name=obj_ref,json=objRef,proto3" json:"obj_ref,omitempty"`
+	RowsetData      *RowsetData       `protobuf:"bytes,20,opt,name=rowset_data,json=rowsetData,proto3" json:"rowset_data,omitempty"`
+	ExtraOptions    string            `protobuf:"bytes,21,opt,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
+	DeleteTablesCtx []*DeleteTableCtx `protobuf:"bytes,22,rep,name=deleteTablesCtx,proto3" json:"deleteTablesCtx,omitempty"`
+	BindingTags     []int32           `protobuf:"varint,23,rep,packed,name=binding_tags,json=bindingTags,proto3" json:"binding_tags,omitempty"`
+	AnalyzeInfo     *AnalyzeInfo      `protobuf:"bytes,24,opt,name=analyze_info,json=analyzeInfo,proto3" json:"analyze_info,omitempty"`
+	// index of the window function computed by a WINDOW node
+	WindowIdx int32 `protobuf:"varint,25,opt,name=window_idx,json=windowIdx,proto3" json:"window_idx,omitempty"`
+	// the secondary index used by a TABLE_SCAN node
+	IndexScan            *IndexScan    `protobuf:"bytes,26,opt,name=index_scan,json=indexScan,proto3" json:"index_scan,omitempty"`
+	JsonTable            *JsonTable    `protobuf:"bytes,27,opt,name=json_table,json=jsonTable,proto3" json:"json_table,omitempty"`
+	RecursiveCte         *RecursiveCte `protobuf:"bytes,28,opt,name=recursive_cte,json=recursiveCte,proto3" json:"recursive_cte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32}
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3624,24 +4581,198 @@ func (m *Node) GetAnalyzeInfo() *AnalyzeInfo {
 	return nil
 }
 
-type DeleteTableCtx struct {
-	DbName               string   `protobuf:"bytes,1,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	TblName              string   `protobuf:"bytes,2,opt,name=tblName,proto3" json:"tblName,omitempty"`
-	UseDeleteKey         string   `protobuf:"bytes,3,opt,name=useDeleteKey,proto3" json:"useDeleteKey,omitempty"`
-	CanTruncate          bool     `protobuf:"varint,4,opt,name=canTruncate,proto3" json:"canTruncate,omitempty"`
-	IsHideKey            bool     `protobuf:"varint,5,opt,name=isHideKey,proto3" json:"isHideKey,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Node) GetWindowIdx() int32 {
+	if m != nil {
+		return m.WindowIdx
+	}
+	return 0
 }
 
-func (m *DeleteTableCtx) Reset()         { *m = DeleteTableCtx{} }
-func (m *DeleteTableCtx) String() string { return proto.CompactTextString(m) }
-func (*DeleteTableCtx) ProtoMessage()    {}
-func (*DeleteTableCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{33}
-}
-func (m *DeleteTableCtx) XXX_Unmarshal(b []byte) error {
+func (m *Node) GetIndexScan() *IndexScan {
+	if m != nil {
+		return m.IndexScan
+	}
+	return nil
+}
+
+func (m *Node) GetJsonTable() *JsonTable {
+	if m != nil {
+		return m.JsonTable
+	}
+	return nil
+}
+
+func (m *Node) GetRecursiveCte() *RecursiveCte {
+	if m != nil {
+		return m.RecursiveCte
+	}
+	return nil
+}
+
+// RecursiveCte is the recursive common table expression of a RECURSIVE_CTE node. The
+// first child is the anchor part, the second child is the recursive part which is run
+// again over the rows returned by its last run until it returns nothing, it reads these
+// rows through the MATERIAL_SCAN nodes on name.
+type RecursiveCte struct {
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ColNames []string `protobuf:"bytes,2,rep,name=col_names,json=colNames,proto3" json:"col_names,omitempty"`
+	// the rows returned before are returned again only if union_all is set
+	UnionAll bool `protobuf:"varint,3,opt,name=union_all,json=unionAll,proto3" json:"union_all,omitempty"`
+	// the recursive part runs at most max_depth times
+	MaxDepth             int64    `protobuf:"varint,4,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecursiveCte) Reset()         { *m = RecursiveCte{} }
+func (m *RecursiveCte) String() string { return proto.CompactTextString(m) }
+func (*RecursiveCte) ProtoMessage()    {}
+func (*RecursiveCte) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
+}
+func (m *RecursiveCte) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecursiveCte) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecursiveCte.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecursiveCte) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecursiveCte.Merge(m, src)
+}
+func (m *RecursiveCte) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RecursiveCte) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecursiveCte.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecursiveCte proto.InternalMessageInfo
+
+func (m *RecursiveCte) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RecursiveCte) GetColNames() []string {
+	if m != nil {
+		return m.ColNames
+	}
+	return nil
+}
+
+func (m *RecursiveCte) GetUnionAll() bool {
+	if m != nil {
+		return m.UnionAll
+	}
+	return false
+}
+
+func (m *RecursiveCte) GetMaxDepth() int64 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+// IndexScan finds the rows of a table through a secondary index
+type IndexScan struct {
+	IndexDef *IndexDef `protobuf:"bytes,1,opt,name=index_def,json=indexDef,proto3" json:"index_def,omitempty"`
+	// the keys of a point lookup of a unique index, every key is the constants of all index columns
+	Keys []*ExprList `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	// the conditions on the index columns, a column reference points to the position in col_names
+	FilterList           []*Expr  `protobuf:"bytes,3,rep,name=filter_list,json=filterList,proto3" json:"filter_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexScan) Reset()         { *m = IndexScan{} }
+func (m *IndexScan) String() string { return proto.CompactTextString(m) }
+func (*IndexScan) ProtoMessage()    {}
+func (*IndexScan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
+}
+func (m *IndexScan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexScan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexScan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexScan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexScan.Merge(m, src)
+}
+func (m *IndexScan) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *IndexScan) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexScan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexScan proto.InternalMessageInfo
+
+func (m *IndexScan) GetIndexDef() *IndexDef {
+	if m != nil {
+		return m.IndexDef
+	}
+	return nil
+}
+
+func (m *IndexScan) GetKeys() []*ExprList {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *IndexScan) GetFilterList() []*Expr {
+	if m != nil {
+		return m.FilterList
+	}
+	return nil
+}
+
+type DeleteTableCtx struct {
+	DbName       string `protobuf:"bytes,1,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	TblName      string `protobuf:"bytes,2,opt,name=tblName,proto3" json:"tblName,omitempty"`
+	UseDeleteKey string `protobuf:"bytes,3,opt,name=useDeleteKey,proto3" json:"useDeleteKey,omitempty"`
+	CanTruncate  bool   `protobuf:"varint,4,opt,name=canTruncate,proto3" json:"canTruncate,omitempty"`
+	IsHideKey    bool   `protobuf:"varint,5,opt,name=isHideKey,proto3" json:"isHideKey,omitempty"`
+	// the columns kept by the secondary indexes are projected from index_attrs_idx
+	IndexAttrs           []string `protobuf:"bytes,6,rep,name=index_attrs,json=indexAttrs,proto3" json:"index_attrs,omitempty"`
+	IndexAttrsIdx        int32    `protobuf:"varint,7,opt,name=index_attrs_idx,json=indexAttrsIdx,proto3" json:"index_attrs_idx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTableCtx) Reset()         { *m = DeleteTableCtx{} }
+func (m *DeleteTableCtx) String() string { return proto.CompactTextString(m) }
+func (*DeleteTableCtx) ProtoMessage()    {}
+func (*DeleteTableCtx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
+}
+func (m *DeleteTableCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 func (m *DeleteTableCtx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
@@ -3703,6 +4834,20 @@ func (m *DeleteTableCtx) GetIsHideKey() bool {
 	return false
 }
 
+func (m *DeleteTableCtx) GetIndexAttrs() []string {
+	if m != nil {
+		return m.IndexAttrs
+	}
+	return nil
+}
+
+func (m *DeleteTableCtx) GetIndexAttrsIdx() int32 {
+	if m != nil {
+		return m.IndexAttrsIdx
+	}
+	return 0
+}
+
 type Query struct {
 	StmtType Query_StatementType `protobuf:"varint,1,opt,name=stmt_type,json=stmtType,proto3,enum=plan.Query_StatementType" json:"stmt_type,omitempty"`
 	// Each step is simply a root node.  Root node refers to other
@@ -3724,7 +4869,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34}
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3805,7 +4950,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3909,7 +5054,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36}
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3956,7 +5101,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37}
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4003,7 +5148,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38}
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4056,7 +5201,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39}
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4183,7 +5328,7 @@ func (m *InsertValues) Reset()         { *m = InsertValues{} }
 func (m *InsertValues) String() string { return proto.CompactTextString(m) }
 func (*InsertValues) ProtoMessage()    {}
 func (*InsertValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
 func (m *InsertValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +5417,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4326,7 +5471,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4460,7 +5605,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4668,7 +5813,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4723,7 +5868,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4778,7 +5923,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4835,7 +5980,7 @@ func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4893,18 +6038,20 @@ func (m *CreateTable) GetTableDef() *TableDef {
 }
 
 type AlterTable struct {
-	Table                string    `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
-	TableDef             *TableDef `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Table                string              `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	TableDef             *TableDef           `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	Database             string              `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Actions              []*AlterTableAction `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4947,27 +6094,40 @@ func (m *AlterTable) GetTableDef() *TableDef {
 	return nil
 }
 
-type DropTable struct {
-	IfExists             bool     `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
-	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
-	Table                string   `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+func (m *AlterTable) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *AlterTable) GetActions() []*AlterTableAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+type AlterRenameColumn struct {
+	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropTable) Reset()         { *m = DropTable{} }
-func (m *DropTable) String() string { return proto.CompactTextString(m) }
-func (*DropTable) ProtoMessage()    {}
-func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+func (m *AlterRenameColumn) Reset()         { *m = AlterRenameColumn{} }
+func (m *AlterRenameColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterRenameColumn) ProtoMessage()    {}
+func (*AlterRenameColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
-func (m *DropTable) XXX_Unmarshal(b []byte) error {
+func (m *AlterRenameColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DropTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AlterRenameColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DropTable.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AlterRenameColumn.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4977,59 +6137,61 @@ func (m *DropTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DropTable) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropTable.Merge(m, src)
+func (m *AlterRenameColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterRenameColumn.Merge(m, src)
 }
-func (m *DropTable) XXX_Size() int {
+func (m *AlterRenameColumn) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *DropTable) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropTable.DiscardUnknown(m)
+func (m *AlterRenameColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterRenameColumn.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropTable proto.InternalMessageInfo
-
-func (m *DropTable) GetIfExists() bool {
-	if m != nil {
-		return m.IfExists
-	}
-	return false
-}
+var xxx_messageInfo_AlterRenameColumn proto.InternalMessageInfo
 
-func (m *DropTable) GetDatabase() string {
+func (m *AlterRenameColumn) GetOldName() string {
 	if m != nil {
-		return m.Database
+		return m.OldName
 	}
 	return ""
 }
 
-func (m *DropTable) GetTable() string {
+func (m *AlterRenameColumn) GetNewName() string {
 	if m != nil {
-		return m.Table
+		return m.NewName
 	}
 	return ""
 }
 
-type CreateIndex struct {
-	IfNotExists          bool     `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
-	Index                string   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
-func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
-func (*CreateIndex) ProtoMessage()    {}
-func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
-}
-func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
+// AlterTableAction is one change of an ALTER TABLE statement, applied in order.
+type AlterTableAction struct {
+	// Types that are valid to be assigned to Action:
+	//	*AlterTableAction_AddColumn
+	//	*AlterTableAction_DropColumn
+	//	*AlterTableAction_RenameColumn
+	//	*AlterTableAction_ModifyColumn
+	//	*AlterTableAction_AddIndex
+	//	*AlterTableAction_DropIndex
+	//	*AlterTableAction_RenameTable
+	//	*AlterTableAction_Properties
+	Action               isAlterTableAction_Action `protobuf_oneof:"action"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *AlterTableAction) Reset()         { *m = AlterTableAction{} }
+func (m *AlterTableAction) String() string { return proto.CompactTextString(m) }
+func (*AlterTableAction) ProtoMessage()    {}
+func (*AlterTableAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
+}
+func (m *AlterTableAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AlterTableAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateIndex.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AlterTableAction.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5039,44 +6201,289 @@ func (m *CreateIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *CreateIndex) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateIndex.Merge(m, src)
+func (m *AlterTableAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableAction.Merge(m, src)
 }
-func (m *CreateIndex) XXX_Size() int {
+func (m *AlterTableAction) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *CreateIndex) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateIndex.DiscardUnknown(m)
+func (m *AlterTableAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableAction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateIndex proto.InternalMessageInfo
+var xxx_messageInfo_AlterTableAction proto.InternalMessageInfo
 
-func (m *CreateIndex) GetIfNotExists() bool {
+type isAlterTableAction_Action interface {
+	isAlterTableAction_Action()
+	MarshalTo([]byte) (int, error)
+	ProtoSize() int
+}
+
+type AlterTableAction_AddColumn struct {
+	AddColumn *ColDef `protobuf:"bytes,1,opt,name=add_column,json=addColumn,proto3,oneof" json:"add_column,omitempty"`
+}
+type AlterTableAction_DropColumn struct {
+	DropColumn string `protobuf:"bytes,2,opt,name=drop_column,json=dropColumn,proto3,oneof" json:"drop_column,omitempty"`
+}
+type AlterTableAction_RenameColumn struct {
+	RenameColumn *AlterRenameColumn `protobuf:"bytes,3,opt,name=rename_column,json=renameColumn,proto3,oneof" json:"rename_column,omitempty"`
+}
+type AlterTableAction_ModifyColumn struct {
+	ModifyColumn *ColDef `protobuf:"bytes,4,opt,name=modify_column,json=modifyColumn,proto3,oneof" json:"modify_column,omitempty"`
+}
+type AlterTableAction_AddIndex struct {
+	AddIndex *CreateIndex `protobuf:"bytes,5,opt,name=add_index,json=addIndex,proto3,oneof" json:"add_index,omitempty"`
+}
+type AlterTableAction_DropIndex struct {
+	DropIndex *DropIndex `protobuf:"bytes,6,opt,name=drop_index,json=dropIndex,proto3,oneof" json:"drop_index,omitempty"`
+}
+type AlterTableAction_RenameTable struct {
+	RenameTable string `protobuf:"bytes,7,opt,name=rename_table,json=renameTable,proto3,oneof" json:"rename_table,omitempty"`
+}
+type AlterTableAction_Properties struct {
+	Properties *PropertiesDef `protobuf:"bytes,8,opt,name=properties,proto3,oneof" json:"properties,omitempty"`
+}
+
+func (*AlterTableAction_AddColumn) isAlterTableAction_Action()    {}
+func (*AlterTableAction_DropColumn) isAlterTableAction_Action()   {}
+func (*AlterTableAction_RenameColumn) isAlterTableAction_Action() {}
+func (*AlterTableAction_ModifyColumn) isAlterTableAction_Action() {}
+func (*AlterTableAction_AddIndex) isAlterTableAction_Action()     {}
+func (*AlterTableAction_DropIndex) isAlterTableAction_Action()    {}
+func (*AlterTableAction_RenameTable) isAlterTableAction_Action()  {}
+func (*AlterTableAction_Properties) isAlterTableAction_Action()   {}
+
+func (m *AlterTableAction) GetAction() isAlterTableAction_Action {
 	if m != nil {
-		return m.IfNotExists
+		return m.Action
 	}
-	return false
+	return nil
 }
 
-func (m *CreateIndex) GetIndex() string {
-	if m != nil {
-		return m.Index
+func (m *AlterTableAction) GetAddColumn() *ColDef {
+	if x, ok := m.GetAction().(*AlterTableAction_AddColumn); ok {
+		return x.AddColumn
 	}
-	return ""
+	return nil
 }
 
-type AlterIndex struct {
-	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AlterTableAction) GetDropColumn() string {
+	if x, ok := m.GetAction().(*AlterTableAction_DropColumn); ok {
+		return x.DropColumn
+	}
+	return ""
 }
 
-func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
+func (m *AlterTableAction) GetRenameColumn() *AlterRenameColumn {
+	if x, ok := m.GetAction().(*AlterTableAction_RenameColumn); ok {
+		return x.RenameColumn
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetModifyColumn() *ColDef {
+	if x, ok := m.GetAction().(*AlterTableAction_ModifyColumn); ok {
+		return x.ModifyColumn
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetAddIndex() *CreateIndex {
+	if x, ok := m.GetAction().(*AlterTableAction_AddIndex); ok {
+		return x.AddIndex
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetDropIndex() *DropIndex {
+	if x, ok := m.GetAction().(*AlterTableAction_DropIndex); ok {
+		return x.DropIndex
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetRenameTable() string {
+	if x, ok := m.GetAction().(*AlterTableAction_RenameTable); ok {
+		return x.RenameTable
+	}
+	return ""
+}
+
+func (m *AlterTableAction) GetProperties() *PropertiesDef {
+	if x, ok := m.GetAction().(*AlterTableAction_Properties); ok {
+		return x.Properties
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AlterTableAction) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AlterTableAction_AddColumn)(nil),
+		(*AlterTableAction_DropColumn)(nil),
+		(*AlterTableAction_RenameColumn)(nil),
+		(*AlterTableAction_ModifyColumn)(nil),
+		(*AlterTableAction_AddIndex)(nil),
+		(*AlterTableAction_DropIndex)(nil),
+		(*AlterTableAction_RenameTable)(nil),
+		(*AlterTableAction_Properties)(nil),
+	}
+}
+
+type DropTable struct {
+	IfExists             bool     `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
+	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Table                string   `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropTable) Reset()         { *m = DropTable{} }
+func (m *DropTable) String() string { return proto.CompactTextString(m) }
+func (*DropTable) ProtoMessage()    {}
+func (*DropTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
+}
+func (m *DropTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DropTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DropTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DropTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropTable.Merge(m, src)
+}
+func (m *DropTable) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DropTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropTable proto.InternalMessageInfo
+
+func (m *DropTable) GetIfExists() bool {
+	if m != nil {
+		return m.IfExists
+	}
+	return false
+}
+
+func (m *DropTable) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *DropTable) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+type CreateIndex struct {
+	IfNotExists          bool      `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+	Index                string    `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Database             string    `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Table                string    `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	IndexDef             *IndexDef `protobuf:"bytes,5,opt,name=index_def,json=indexDef,proto3" json:"index_def,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
+func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
+func (*CreateIndex) ProtoMessage()    {}
+func (*CreateIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
+}
+func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateIndex.Merge(m, src)
+}
+func (m *CreateIndex) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CreateIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateIndex proto.InternalMessageInfo
+
+func (m *CreateIndex) GetIfNotExists() bool {
+	if m != nil {
+		return m.IfNotExists
+	}
+	return false
+}
+
+func (m *CreateIndex) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *CreateIndex) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *CreateIndex) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *CreateIndex) GetIndexDef() *IndexDef {
+	if m != nil {
+		return m.IndexDef
+	}
+	return nil
+}
+
+type AlterIndex struct {
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5115,6 +6522,8 @@ func (m *AlterIndex) GetIndex() string {
 type DropIndex struct {
 	IfExists             bool     `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
 	Index                string   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Database             string   `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Table                string   `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5124,7 +6533,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5167,6 +6576,20 @@ func (m *DropIndex) GetIndex() string {
 	return ""
 }
 
+func (m *DropIndex) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *DropIndex) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
 type TruncateTable struct {
 	Table                string   `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -5178,7 +6601,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5226,7 +6649,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5280,7 +6703,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5331,7 +6754,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5409,7 +6832,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5478,7 +6901,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5532,7 +6955,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5575,7 +6998,11 @@ func init() {
 	proto.RegisterEnum("plan.SubqueryRef_Type", SubqueryRef_Type_name, SubqueryRef_Type_value)
 	proto.RegisterEnum("plan.Function_FuncFlag", Function_FuncFlag_name, Function_FuncFlag_value)
 	proto.RegisterEnum("plan.IndexDef_IndexType", IndexDef_IndexType_name, IndexDef_IndexType_value)
+	proto.RegisterEnum("plan.ForeignKeyDef_RefAction", ForeignKeyDef_RefAction_name, ForeignKeyDef_RefAction_value)
 	proto.RegisterEnum("plan.OrderBySpec_OrderByFlag", OrderBySpec_OrderByFlag_name, OrderBySpec_OrderByFlag_value)
+	proto.RegisterEnum("plan.FrameBound_BoundType", FrameBound_BoundType_name, FrameBound_BoundType_value)
+	proto.RegisterEnum("plan.FrameClause_FrameType", FrameClause_FrameType_name, FrameClause_FrameType_value)
+	proto.RegisterEnum("plan.JsonTableColumn_Kind", JsonTableColumn_Kind_name, JsonTableColumn_Kind_value)
 	proto.RegisterEnum("plan.Node_NodeType", Node_NodeType_name, Node_NodeType_value)
 	proto.RegisterEnum("plan.Node_JoinFlag", Node_JoinFlag_name, Node_JoinFlag_value)
 	proto.RegisterEnum("plan.Node_AggMode", Node_AggMode_name, Node_AggMode_value)
@@ -5605,6 +7032,9 @@ func init() {
 	proto.RegisterType((*PrimaryKeyDef)(nil), "plan.PrimaryKeyDef")
 	proto.RegisterType((*Property)(nil), "plan.Property")
 	proto.RegisterType((*PropertiesDef)(nil), "plan.PropertiesDef")
+	proto.RegisterType((*CheckDef)(nil), "plan.CheckDef")
+	proto.RegisterType((*ForeignKeyDef)(nil), "plan.ForeignKeyDef")
+	proto.RegisterType((*ConstraintDef)(nil), "plan.ConstraintDef")
 	proto.RegisterType((*PartitionInfo)(nil), "plan.PartitionInfo")
 	proto.RegisterType((*PartitionItem)(nil), "plan.PartitionItem")
 	proto.RegisterType((*ViewDef)(nil), "plan.ViewDef")
@@ -5612,13 +7042,24 @@ func init() {
 	proto.RegisterMapType((map[string]int32)(nil), "plan.TableDef.Name2colIndexEntry")
 	proto.RegisterType((*TableDef_DefType)(nil), "plan.TableDef.DefType")
 	proto.RegisterType((*Cost)(nil), "plan.Cost")
+	proto.RegisterType((*HistogramBucket)(nil), "plan.HistogramBucket")
+	proto.RegisterType((*Histogram)(nil), "plan.Histogram")
+	proto.RegisterType((*ColumnStats)(nil), "plan.ColumnStats")
+	proto.RegisterType((*TableStats)(nil), "plan.TableStats")
+	proto.RegisterMapType((map[string]*ColumnStats)(nil), "plan.TableStats.ColsEntry")
 	proto.RegisterType((*ColData)(nil), "plan.ColData")
 	proto.RegisterType((*RowsetData)(nil), "plan.RowsetData")
 	proto.RegisterType((*OrderBySpec)(nil), "plan.OrderBySpec")
+	proto.RegisterType((*FrameBound)(nil), "plan.FrameBound")
+	proto.RegisterType((*FrameClause)(nil), "plan.FrameClause")
 	proto.RegisterType((*WindowSpec)(nil), "plan.WindowSpec")
+	proto.RegisterType((*JsonTable)(nil), "plan.JsonTable")
+	proto.RegisterType((*JsonTableColumn)(nil), "plan.JsonTableColumn")
 	proto.RegisterType((*UpdateCtx)(nil), "plan.UpdateCtx")
 	proto.RegisterType((*AnalyzeInfo)(nil), "plan.AnalyzeInfo")
 	proto.RegisterType((*Node)(nil), "plan.Node")
+	proto.RegisterType((*RecursiveCte)(nil), "plan.RecursiveCte")
+	proto.RegisterType((*IndexScan)(nil), "plan.IndexScan")
 	proto.RegisterType((*DeleteTableCtx)(nil), "plan.DeleteTableCtx")
 	proto.RegisterType((*Query)(nil), "plan.Query")
 	proto.RegisterType((*TransationControl)(nil), "plan.TransationControl")
@@ -5635,6 +7076,8 @@ func init() {
 	proto.RegisterType((*DropDatabase)(nil), "plan.DropDatabase")
 	proto.RegisterType((*CreateTable)(nil), "plan.CreateTable")
 	proto.RegisterType((*AlterTable)(nil), "plan.AlterTable")
+	proto.RegisterType((*AlterRenameColumn)(nil), "plan.AlterRenameColumn")
+	proto.RegisterType((*AlterTableAction)(nil), "plan.AlterTableAction")
 	proto.RegisterType((*DropTable)(nil), "plan.DropTable")
 	proto.RegisterType((*CreateIndex)(nil), "plan.CreateIndex")
 	proto.RegisterType((*AlterIndex)(nil), "plan.AlterIndex")
@@ -5651,320 +7094,392 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 4995 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3a, 0xcf, 0x8f, 0xdb, 0xd8,
-	0x79, 0x43, 0xfd, 0xa4, 0x3e, 0x49, 0x33, 0xf4, 0xb3, 0xd7, 0xab, 0xdd, 0x78, 0xbd, 0xb3, 0xf4,
-	0x8f, 0x9d, 0x78, 0xb3, 0xde, 0xdd, 0xb1, 0xe3, 0x38, 0x41, 0x9a, 0x44, 0xa3, 0xa1, 0x47, 0x8a,
-	0x35, 0xd4, 0xe4, 0x49, 0x33, 0xde, 0x4d, 0x50, 0x08, 0x94, 0x48, 0x69, 0x68, 0x53, 0xa4, 0x42,
-	0x52, 0x9e, 0x99, 0x05, 0x0a, 0xe4, 0xd0, 0x16, 0xe8, 0xa9, 0x39, 0x14, 0x68, 0x8f, 0x8b, 0xa2,
-	0xc8, 0xbd, 0xff, 0x40, 0xcf, 0x3d, 0x16, 0x28, 0x7a, 0x28, 0x7a, 0x69, 0xd2, 0x63, 0x7b, 0xeb,
-	0xad, 0xe8, 0xa1, 0xf8, 0xbe, 0xf7, 0x48, 0x51, 0xa3, 0x71, 0x36, 0x58, 0xf4, 0x22, 0xbc, 0xef,
-	0xe7, 0xfb, 0xde, 0x8f, 0xef, 0xd7, 0x13, 0x01, 0xe6, 0x9e, 0xe5, 0x3f, 0x9c, 0x87, 0x41, 0x1c,
-	0xb0, 0x02, 0x8e, 0xdf, 0xfd, 0x78, 0xea, 0xc6, 0xa7, 0x8b, 0xd1, 0xc3, 0x71, 0x30, 0xfb, 0x64,
-	0x1a, 0x4c, 0x83, 0x4f, 0x88, 0x38, 0x5a, 0x4c, 0x08, 0x22, 0x80, 0x46, 0x42, 0x48, 0xff, 0xb5,
-	0x02, 0x85, 0xc1, 0xc5, 0xdc, 0x61, 0x9b, 0x90, 0x73, 0xed, 0x86, 0xb2, 0xad, 0xec, 0x14, 0x79,
-	0xce, 0xb5, 0xd9, 0xbb, 0xa0, 0xfa, 0x0b, 0xcf, 0xb3, 0x46, 0x9e, 0xd3, 0xc8, 0x6d, 0x2b, 0x3b,
-	0x2a, 0x4f, 0x61, 0x76, 0x03, 0x8a, 0x67, 0xae, 0x1d, 0x9f, 0x36, 0xf2, 0xc4, 0x2e, 0x00, 0x76,
-	0x0b, 0x2a, 0xf3, 0xd0, 0x19, 0xbb, 0x91, 0x1b, 0xf8, 0x8d, 0x02, 0x51, 0x96, 0x08, 0xc6, 0xa0,
-	0x10, 0xb9, 0x5f, 0x3a, 0x8d, 0x22, 0x11, 0x68, 0x8c, 0x7a, 0xa2, 0xb1, 0xe5, 0x39, 0x8d, 0x92,
-	0xd0, 0x43, 0x80, 0xfe, 0xdb, 0x3c, 0x14, 0x5b, 0x81, 0x1f, 0xc5, 0xec, 0x26, 0x94, 0xdc, 0x08,
-	0x67, 0x25, 0xbb, 0x54, 0x2e, 0x21, 0x76, 0x03, 0x0a, 0xee, 0x6b, 0xcb, 0x23, 0xbb, 0xf2, 0xed,
-	0x0d, 0x4e, 0x10, 0x62, 0x6d, 0xc4, 0xa2, 0x51, 0x0a, 0x62, 0x6d, 0x89, 0x8d, 0x10, 0x8b, 0x06,
-	0x55, 0x10, 0x1b, 0x49, 0xec, 0x08, 0xb1, 0x68, 0x8d, 0x8a, 0xd8, 0x91, 0xc4, 0x2e, 0x10, 0x8b,
-	0xe6, 0x14, 0x10, 0xbb, 0x90, 0xd8, 0x09, 0x62, 0xcb, 0xdb, 0xca, 0x4e, 0x0e, 0xb1, 0x08, 0xb1,
-	0x77, 0xa1, 0x6c, 0x5b, 0xb1, 0x83, 0x04, 0x15, 0xad, 0x6f, 0x6f, 0xf0, 0x04, 0xc1, 0x74, 0xa8,
-	0xe2, 0x30, 0x76, 0x67, 0x44, 0xaf, 0x48, 0x33, 0xb3, 0x48, 0xf6, 0x5d, 0xa8, 0xd9, 0xce, 0xd8,
-	0x9d, 0x59, 0xde, 0x93, 0xc7, 0xc8, 0x04, 0xdb, 0xca, 0x4e, 0x75, 0x77, 0xeb, 0x21, 0x1d, 0x68,
-	0x4a, 0x69, 0x6f, 0xf0, 0x15, 0x36, 0xf6, 0x14, 0xea, 0x12, 0xfe, 0x6c, 0xf7, 0x29, 0xca, 0x55,
-	0x49, 0x4e, 0x5b, 0x91, 0xfb, 0x6c, 0xf7, 0x69, 0x7b, 0x83, 0xaf, 0x32, 0xb2, 0xbb, 0x50, 0xc3,
-	0xb9, 0xa3, 0xd8, 0x9a, 0xcd, 0x51, 0xb0, 0x26, 0xad, 0x5a, 0xc1, 0xe2, 0xb2, 0x5e, 0x46, 0x81,
-	0x8f, 0x0c, 0x75, 0xb9, 0x63, 0x09, 0x82, 0x6d, 0x03, 0xd8, 0xce, 0xc4, 0x5a, 0x78, 0x31, 0x92,
-	0x37, 0xe5, 0xd6, 0x65, 0x70, 0xec, 0x36, 0x54, 0x16, 0x73, 0x5c, 0xe5, 0x89, 0xe5, 0x35, 0xb6,
-	0x24, 0xc3, 0x12, 0xb5, 0x57, 0x86, 0xe2, 0x6b, 0xcb, 0x5b, 0x38, 0xfa, 0x2d, 0x50, 0x8f, 0xac,
-	0xd0, 0x9a, 0x71, 0x67, 0xc2, 0x34, 0xc8, 0xcf, 0x83, 0x48, 0x5e, 0x3d, 0x1c, 0xea, 0x5d, 0x28,
-	0x9d, 0x58, 0x21, 0xd2, 0x18, 0x14, 0x7c, 0x6b, 0xe6, 0x10, 0xb1, 0xc2, 0x69, 0x8c, 0xb7, 0x22,
-	0xba, 0x88, 0x62, 0x67, 0x26, 0xef, 0xa5, 0x84, 0x10, 0x3f, 0xf5, 0x82, 0x91, 0xbc, 0x01, 0x2a,
-	0x97, 0x90, 0x6e, 0x42, 0xa9, 0x15, 0x78, 0xa8, 0xed, 0x6d, 0x28, 0x87, 0x8e, 0x37, 0x5c, 0xce,
-	0x56, 0x0a, 0x1d, 0xef, 0x28, 0x88, 0x90, 0x30, 0x0e, 0x04, 0x21, 0x27, 0x08, 0xe3, 0x80, 0x08,
-	0xc9, 0xfc, 0xf9, 0xe5, 0xfc, 0xfa, 0x00, 0xa0, 0x15, 0x84, 0xe1, 0x37, 0xd6, 0x79, 0x03, 0x8a,
-	0xb6, 0x33, 0x5f, 0x7a, 0x0f, 0x01, 0xfa, 0x03, 0x50, 0x8d, 0xf3, 0x79, 0xd8, 0x75, 0xa3, 0x98,
-	0xdd, 0x86, 0x82, 0xe7, 0x46, 0x71, 0x43, 0xd9, 0xce, 0xef, 0x54, 0x77, 0x41, 0x9c, 0x2d, 0x52,
-	0x39, 0xe1, 0xf5, 0x6d, 0x50, 0x0f, 0xad, 0xf3, 0x13, 0xdc, 0x49, 0xd4, 0x46, 0x5b, 0x2a, 0xb7,
-	0x48, 0xee, 0xef, 0x03, 0x80, 0x81, 0x15, 0x4e, 0x9d, 0x98, 0x7c, 0xfb, 0x16, 0xe4, 0xe3, 0x8b,
-	0x39, 0x71, 0xa4, 0xea, 0x90, 0xc0, 0x11, 0xad, 0xff, 0xb7, 0x02, 0xd5, 0xfe, 0x62, 0xf4, 0xcb,
-	0x85, 0x13, 0x5e, 0xe0, 0x8a, 0x76, 0x96, 0xdc, 0x9b, 0xbb, 0x37, 0x05, 0x77, 0x86, 0xbe, 0x94,
-	0xc4, 0x25, 0xfa, 0x81, 0xed, 0x0c, 0x5d, 0x3b, 0x59, 0x22, 0x82, 0x1d, 0x1b, 0x83, 0x49, 0x30,
-	0x97, 0x9b, 0x96, 0x0b, 0xe6, 0x6c, 0x1b, 0x8a, 0xe3, 0x53, 0xd7, 0xb3, 0xc9, 0x0b, 0x57, 0x57,
-	0x24, 0x08, 0xec, 0x1d, 0x50, 0xc3, 0xe0, 0x6c, 0x98, 0x09, 0x11, 0xe5, 0x30, 0x38, 0xeb, 0xbb,
-	0x5f, 0xe2, 0x7e, 0x8b, 0x08, 0x05, 0x50, 0xea, 0xb7, 0x9a, 0xdd, 0x26, 0xd7, 0x36, 0x70, 0x6c,
-	0x7c, 0xde, 0xe9, 0x0f, 0xfa, 0x9a, 0xc2, 0x36, 0x01, 0xcc, 0xde, 0x60, 0x28, 0xe1, 0x1c, 0x2b,
-	0x41, 0xae, 0x63, 0x6a, 0x79, 0xe4, 0x41, 0x7c, 0xc7, 0xd4, 0x0a, 0xac, 0x0c, 0xf9, 0xa6, 0xf9,
-	0x85, 0x56, 0xa4, 0x41, 0xb7, 0xab, 0x95, 0xf4, 0x7f, 0x56, 0xa0, 0xd2, 0x1b, 0xbd, 0x74, 0xc6,
-	0x31, 0xae, 0x19, 0xef, 0x94, 0x13, 0xbe, 0x76, 0x42, 0x5a, 0x76, 0x9e, 0x4b, 0x08, 0x17, 0x62,
-	0x8f, 0x44, 0x9c, 0xe1, 0x39, 0x7b, 0x44, 0x7c, 0xe3, 0x53, 0x67, 0x66, 0xd1, 0xe2, 0x90, 0x8f,
-	0x20, 0xbc, 0xc3, 0xc1, 0xe8, 0x25, 0x2d, 0x2f, 0xcf, 0x71, 0xc8, 0xde, 0x87, 0xaa, 0xd0, 0x31,
-	0xa4, 0x0b, 0x54, 0xa4, 0xbd, 0x00, 0x81, 0x32, 0xf1, 0x1a, 0xbf, 0x0d, 0x65, 0x7b, 0x24, 0x88,
-	0x25, 0x22, 0x96, 0xec, 0x11, 0x11, 0x50, 0x92, 0xb4, 0x0a, 0x62, 0x59, 0x4a, 0x12, 0x8a, 0x18,
-	0xde, 0x01, 0x35, 0x18, 0xbd, 0x14, 0x54, 0x95, 0xa8, 0xe5, 0x60, 0xf4, 0x12, 0x49, 0xfa, 0x6f,
-	0x15, 0x50, 0x9f, 0x2d, 0xfc, 0x71, 0x8c, 0x21, 0xf7, 0x0e, 0x14, 0x26, 0x0b, 0x7f, 0x2c, 0xcf,
-	0x5d, 0x86, 0x96, 0x74, 0xcd, 0x9c, 0x88, 0x78, 0xd7, 0xac, 0x70, 0x8a, 0x77, 0x74, 0xed, 0xae,
-	0x21, 0x5e, 0xff, 0x4b, 0xa9, 0xf1, 0x99, 0x67, 0x4d, 0x99, 0x0a, 0x05, 0xb3, 0x67, 0x1a, 0xda,
-	0x06, 0xab, 0x81, 0xda, 0x31, 0x07, 0x06, 0x37, 0x9b, 0x5d, 0x4d, 0xa1, 0xa3, 0x19, 0x34, 0xf7,
-	0xba, 0x86, 0x96, 0x43, 0xca, 0x49, 0xaf, 0xdb, 0x1c, 0x74, 0xba, 0x86, 0x56, 0x10, 0x14, 0xde,
-	0x69, 0x0d, 0x34, 0x95, 0x69, 0x50, 0x3b, 0xe2, 0xbd, 0xfd, 0xe3, 0x96, 0x31, 0x34, 0x8f, 0xbb,
-	0x5d, 0x4d, 0x63, 0xd7, 0x61, 0x2b, 0xc5, 0xf4, 0x04, 0x72, 0x1b, 0x45, 0x4e, 0x9a, 0xbc, 0xc9,
-	0x0f, 0xb4, 0x9f, 0x30, 0x15, 0xf2, 0xcd, 0x83, 0x03, 0xed, 0x57, 0x0a, 0x8e, 0x5e, 0x74, 0x4c,
-	0xed, 0x57, 0x39, 0xfd, 0x4f, 0xf3, 0x50, 0x40, 0x03, 0x7f, 0xff, 0xb5, 0x66, 0xdf, 0x02, 0x65,
-	0x4c, 0x27, 0x57, 0xdd, 0xad, 0x0a, 0x1a, 0x25, 0x95, 0xf6, 0x06, 0x57, 0x70, 0xd5, 0x8a, 0xb8,
-	0x9f, 0xd5, 0xdd, 0x4d, 0x41, 0x4c, 0xc2, 0x11, 0xd2, 0xe7, 0xec, 0x16, 0x28, 0xaf, 0xe5, 0x65,
-	0xad, 0x09, 0xba, 0x08, 0x48, 0x48, 0x7d, 0xcd, 0xb6, 0x21, 0x3f, 0x0e, 0x44, 0xf2, 0x48, 0xe9,
-	0x22, 0x1c, 0xb4, 0x37, 0x38, 0x92, 0x50, 0xff, 0x84, 0x8e, 0x35, 0xd5, 0x9f, 0x9c, 0x0a, 0x6a,
-	0x98, 0xb0, 0x7b, 0x90, 0x8f, 0x16, 0x23, 0x3a, 0xdb, 0xea, 0xee, 0xb5, 0x35, 0x1f, 0x43, 0x35,
-	0xd1, 0x62, 0xc4, 0xee, 0x43, 0x61, 0x1c, 0x84, 0x21, 0x9d, 0x72, 0x1a, 0xe4, 0x97, 0xc1, 0x07,
-	0x93, 0x11, 0xd2, 0xd9, 0x36, 0x28, 0x31, 0xa5, 0x99, 0x94, 0x69, 0xe9, 0xfd, 0x38, 0x61, 0xcc,
-	0xee, 0xca, 0x90, 0x02, 0x59, 0x9b, 0x92, 0x80, 0x83, 0x7a, 0x90, 0xca, 0x74, 0xc8, 0xcf, 0xac,
-	0x73, 0x99, 0x53, 0x24, 0x53, 0x12, 0x69, 0xd0, 0xa6, 0x99, 0x75, 0xbe, 0x57, 0x82, 0x82, 0x73,
-	0x3e, 0x0f, 0xf5, 0x77, 0xa0, 0x92, 0x66, 0x26, 0x56, 0x03, 0xc5, 0x92, 0xae, 0xa3, 0x58, 0xfa,
-	0x0e, 0x26, 0x8a, 0x24, 0xf7, 0xac, 0xd2, 0x10, 0x4a, 0x1c, 0x4a, 0x19, 0xe9, 0xff, 0x90, 0xa3,
-	0xe0, 0xbc, 0xff, 0x86, 0x50, 0x7f, 0x17, 0xf2, 0x96, 0x37, 0x25, 0xf6, 0xcd, 0x5d, 0x96, 0x2c,
-	0x7f, 0x36, 0x0f, 0x9d, 0x28, 0x12, 0x27, 0x6d, 0x79, 0xd3, 0xe4, 0x1e, 0xe4, 0xaf, 0xbe, 0x07,
-	0x1f, 0x42, 0x59, 0x66, 0x28, 0x79, 0xa0, 0x75, 0xc1, 0xb1, 0x2f, 0x90, 0x3c, 0xa1, 0xb2, 0x06,
-	0x94, 0xe7, 0xa1, 0x3b, 0xb3, 0xc2, 0x0b, 0x51, 0x16, 0xf0, 0x04, 0x64, 0xf7, 0x60, 0xd3, 0x5a,
-	0xc4, 0xc1, 0xd0, 0xf5, 0xc7, 0xa1, 0x33, 0x73, 0xfc, 0x98, 0x8e, 0x56, 0xe5, 0x75, 0xc4, 0x76,
-	0x12, 0x24, 0x86, 0xe2, 0xf9, 0x2b, 0xd7, 0x3e, 0xa7, 0x63, 0x2d, 0x72, 0x01, 0xa0, 0xda, 0x71,
-	0x30, 0x23, 0x29, 0xe9, 0xac, 0x12, 0x44, 0x3f, 0x76, 0xa3, 0xe1, 0xf8, 0xe8, 0x95, 0x73, 0x41,
-	0x87, 0xa7, 0xf2, 0xb2, 0x1b, 0xb5, 0x10, 0x64, 0x1f, 0x42, 0x25, 0xf0, 0x87, 0x22, 0x71, 0xca,
-	0x33, 0xcb, 0xba, 0xa6, 0x1a, 0xf8, 0xc7, 0x44, 0xd3, 0x7f, 0x09, 0x65, 0xb9, 0x10, 0xf6, 0x01,
-	0xd4, 0xb0, 0x3a, 0x1a, 0x5a, 0x23, 0xd7, 0x73, 0xe3, 0x0b, 0x59, 0x33, 0x55, 0x11, 0xd7, 0x14,
-	0x28, 0x74, 0x76, 0x3c, 0x3b, 0xe9, 0x16, 0x2b, 0xce, 0x8e, 0x78, 0x76, 0x07, 0xea, 0x41, 0xe8,
-	0x4e, 0x5d, 0x7f, 0x18, 0xc5, 0xa1, 0xeb, 0x4f, 0x65, 0x08, 0xaf, 0x09, 0x64, 0x9f, 0x70, 0xfa,
-	0x5f, 0x2b, 0xa0, 0x76, 0x7c, 0xdb, 0x39, 0xc7, 0x53, 0x7b, 0x90, 0x4d, 0x16, 0x0d, 0xa1, 0x30,
-	0x21, 0x8a, 0xc1, 0xf2, 0x24, 0x92, 0x13, 0xce, 0x65, 0x4e, 0xf8, 0x5b, 0x50, 0xc1, 0x2c, 0x89,
-	0xe3, 0xa8, 0x91, 0xdf, 0xce, 0xef, 0x54, 0xb8, 0x3a, 0x0e, 0x3c, 0x0c, 0x66, 0x91, 0xfe, 0x10,
-	0x2a, 0xa9, 0x0a, 0x56, 0x85, 0x72, 0xc7, 0x3c, 0x69, 0x76, 0xba, 0xfb, 0xda, 0x06, 0x02, 0x3f,
-	0xef, 0x99, 0xc6, 0x61, 0xf3, 0x48, 0x53, 0x30, 0xa6, 0xef, 0xf5, 0x3b, 0x5a, 0x4e, 0xbf, 0x07,
-	0xf5, 0x23, 0x71, 0x64, 0xcf, 0x9d, 0x0b, 0xb4, 0xee, 0x06, 0x14, 0x85, 0x66, 0x85, 0x34, 0x0b,
-	0x40, 0xdf, 0x05, 0xf5, 0x28, 0x0c, 0xe6, 0x4e, 0x18, 0x5f, 0x60, 0xe0, 0xc6, 0xed, 0x17, 0x97,
-	0x0e, 0x87, 0xcb, 0x84, 0x9a, 0xcb, 0x26, 0xd4, 0x1f, 0xa3, 0x6a, 0x92, 0x71, 0x9d, 0x08, 0x55,
-	0x3f, 0x04, 0x98, 0xa7, 0x08, 0x99, 0xa9, 0x93, 0x50, 0x22, 0x95, 0xf3, 0x0c, 0x87, 0xfe, 0x55,
-	0x1e, 0xea, 0x47, 0x56, 0x18, 0xbb, 0x18, 0x04, 0x3a, 0xfe, 0x24, 0x60, 0x1f, 0x42, 0x21, 0xbe,
-	0x98, 0x3b, 0x72, 0xef, 0xae, 0xa7, 0x61, 0x48, 0xb0, 0xd0, 0xb6, 0x11, 0x03, 0x9e, 0x9a, 0xf1,
-	0x86, 0x53, 0xa3, 0x38, 0xf8, 0x29, 0x5c, 0x9f, 0x27, 0x62, 0x88, 0x70, 0x22, 0x2a, 0xc1, 0xc5,
-	0xd9, 0x5d, 0x45, 0x62, 0x77, 0xa1, 0xdc, 0x0a, 0xbc, 0xc5, 0xcc, 0x8f, 0x1a, 0x85, 0xb5, 0xb8,
-	0x9f, 0x90, 0xd8, 0x03, 0xd0, 0x52, 0xe1, 0x84, 0xbd, 0x48, 0x1b, 0xb9, 0x86, 0x67, 0x3a, 0xd4,
-	0x52, 0x9c, 0xb9, 0x98, 0x89, 0x12, 0x9a, 0xaf, 0xe0, 0xd8, 0x23, 0x80, 0x14, 0x8e, 0x1a, 0x65,
-	0x9a, 0xf8, 0xf2, 0xb2, 0x3b, 0xb1, 0x33, 0xe3, 0x19, 0x36, 0xec, 0x2a, 0x2c, 0x6f, 0x1a, 0x84,
-	0x6e, 0x7c, 0x3a, 0x23, 0x07, 0xca, 0xf3, 0x25, 0x82, 0xdd, 0x87, 0x4d, 0x37, 0xea, 0x2f, 0x46,
-	0xa9, 0xbc, 0x74, 0xa4, 0x4b, 0x58, 0xbc, 0xd8, 0xa9, 0xce, 0xe1, 0x2c, 0x9a, 0x92, 0x4f, 0x55,
-	0x32, 0xf6, 0x1d, 0x46, 0x53, 0xfd, 0x3f, 0x95, 0xec, 0x11, 0x61, 0x49, 0x79, 0x37, 0x23, 0x66,
-	0x2e, 0x83, 0xd3, 0x2a, 0x92, 0xed, 0xc0, 0x56, 0x10, 0xda, 0xae, 0x6f, 0x61, 0x79, 0x27, 0xac,
-	0xc0, 0xa3, 0xaa, 0xf3, 0xcb, 0x68, 0xb6, 0x0d, 0x55, 0xdb, 0x89, 0xc6, 0xa1, 0x3b, 0x8f, 0x97,
-	0x27, 0x94, 0x45, 0x65, 0xa3, 0x45, 0x61, 0x35, 0x5a, 0xdc, 0x07, 0xd5, 0xc3, 0xb0, 0x77, 0x6a,
-	0xf9, 0x74, 0x0a, 0x97, 0x22, 0x42, 0x42, 0x43, 0x3e, 0xd7, 0xa7, 0x88, 0x1d, 0x35, 0x4a, 0xeb,
-	0x7c, 0x09, 0x4d, 0x7f, 0x0f, 0xca, 0x27, 0xae, 0x73, 0x26, 0x43, 0xef, 0x6b, 0xd7, 0x39, 0x4b,
-	0x42, 0x2f, 0x8e, 0xf5, 0xbf, 0x2b, 0x80, 0x3a, 0xc0, 0x6e, 0xef, 0x4d, 0xb1, 0x79, 0x1b, 0x73,
-	0x93, 0x97, 0x14, 0x0e, 0xcb, 0x2c, 0xb8, 0x8f, 0xa5, 0x05, 0x52, 0xd8, 0x03, 0x28, 0xd8, 0xce,
-	0x44, 0xb8, 0x75, 0x35, 0xa9, 0x24, 0x13, 0x9d, 0x18, 0x7f, 0xc5, 0x1d, 0x47, 0x1e, 0xf6, 0x1e,
-	0x40, 0x8c, 0x94, 0x21, 0xb9, 0x84, 0x58, 0x7a, 0x85, 0x30, 0xb2, 0x82, 0xad, 0x8c, 0x43, 0xc7,
-	0x8a, 0x9d, 0xe8, 0x97, 0x9e, 0xac, 0xa5, 0x96, 0x08, 0xd6, 0x86, 0x4d, 0x34, 0x69, 0x17, 0x23,
-	0x89, 0x8b, 0x01, 0x43, 0x2e, 0xfc, 0x83, 0x4b, 0x53, 0x9a, 0x92, 0x89, 0x82, 0x8a, 0xe1, 0xc7,
-	0xe1, 0x05, 0xaf, 0xfb, 0x59, 0xdc, 0xbb, 0xff, 0xa5, 0x50, 0x3c, 0xa5, 0x39, 0xef, 0x41, 0x6e,
-	0xfe, 0x4a, 0x56, 0x17, 0xc9, 0x35, 0xcd, 0x46, 0x97, 0xf6, 0x06, 0xcf, 0xcd, 0x5f, 0x61, 0xce,
-	0xc4, 0x98, 0x9f, 0xcb, 0xe6, 0xcc, 0x24, 0x02, 0x62, 0xce, 0xc4, 0x1c, 0xf0, 0xdd, 0x95, 0x60,
-	0x91, 0x5f, 0x55, 0x99, 0x89, 0x2a, 0xd8, 0x4e, 0x2d, 0x19, 0xb1, 0x80, 0xa3, 0x73, 0x59, 0xc9,
-	0x5b, 0xf2, 0xd0, 0x30, 0x67, 0x23, 0x91, 0x3d, 0x82, 0x4a, 0x7a, 0x1d, 0x65, 0x49, 0xb2, 0xe6,
-	0x54, 0xfe, 0x24, 0xc0, 0x46, 0x2c, 0xe5, 0xdb, 0x2b, 0x42, 0xde, 0x76, 0x26, 0xef, 0xfe, 0x04,
-	0xd8, 0xfa, 0x9e, 0x7c, 0x5d, 0x4c, 0x2c, 0xca, 0x98, 0xf8, 0x83, 0xdc, 0x53, 0x45, 0x0f, 0xa1,
-	0xd0, 0x0a, 0xa2, 0x18, 0x6f, 0xc8, 0xd8, 0x0a, 0xc5, 0x03, 0x82, 0xc2, 0x69, 0x8c, 0x77, 0x39,
-	0x0c, 0xce, 0xa8, 0xa4, 0xcf, 0x11, 0x3a, 0x01, 0x71, 0x06, 0xdf, 0x7e, 0x2d, 0x3a, 0x75, 0x8e,
-	0x43, 0x7a, 0x0a, 0x88, 0xad, 0x50, 0xdc, 0x7a, 0x85, 0x0b, 0x00, 0xb1, 0x71, 0x10, 0xcb, 0x3e,
-	0x5d, 0xe1, 0x02, 0xd0, 0xff, 0x5e, 0xa1, 0xf0, 0xb5, 0x6f, 0xc5, 0x16, 0xe6, 0x0f, 0xec, 0x1b,
-	0xc6, 0xc1, 0xc2, 0x8f, 0x65, 0x03, 0x86, 0x8d, 0x44, 0x0b, 0x61, 0xbc, 0x54, 0x94, 0x11, 0x05,
-	0x55, 0xd8, 0x5e, 0x41, 0x8c, 0x20, 0x63, 0x76, 0x58, 0x78, 0x9e, 0xb8, 0xa0, 0x2a, 0x17, 0x00,
-	0xda, 0xe6, 0x3e, 0xda, 0xa5, 0xb8, 0x58, 0xe4, 0x38, 0x24, 0xcc, 0x93, 0xc7, 0xe4, 0x74, 0x79,
-	0x8e, 0x43, 0xc4, 0x4c, 0x1e, 0xed, 0xd2, 0x2d, 0xcb, 0x71, 0x1c, 0x12, 0xe6, 0xc9, 0x63, 0x0a,
-	0x6a, 0x0a, 0xc7, 0x21, 0x16, 0x3a, 0x51, 0x43, 0xa5, 0x70, 0xa9, 0x44, 0xfa, 0x0b, 0x00, 0x1e,
-	0x9c, 0x45, 0x4e, 0x4c, 0x56, 0xdf, 0x4f, 0xdb, 0x08, 0x25, 0x7b, 0x6d, 0x92, 0x8b, 0x9a, 0xb6,
-	0x15, 0x1f, 0xac, 0xf8, 0x58, 0x7d, 0xe9, 0x63, 0x56, 0x6c, 0x09, 0x27, 0xd3, 0xff, 0x4d, 0x81,
-	0x6a, 0x2f, 0xb4, 0x9d, 0x70, 0xef, 0xa2, 0x3f, 0x77, 0xc6, 0x69, 0x8a, 0x57, 0xde, 0x90, 0xe2,
-	0x6f, 0x51, 0xc2, 0xf5, 0xac, 0x34, 0x4c, 0xa1, 0x27, 0x25, 0x08, 0xf6, 0x19, 0x14, 0x26, 0x9e,
-	0x25, 0xf2, 0xfe, 0xe6, 0xee, 0x7b, 0xb2, 0x65, 0x58, 0xaa, 0x4f, 0xc6, 0xd8, 0x0d, 0x70, 0x62,
-	0xd5, 0x7f, 0x91, 0xce, 0x4f, 0x2d, 0x02, 0x36, 0x58, 0xfd, 0x96, 0xb6, 0x81, 0xbd, 0xc2, 0xbe,
-	0xd1, 0x6f, 0x69, 0x0a, 0xdb, 0x82, 0x2a, 0x96, 0xf6, 0xfd, 0xe1, 0xb3, 0x0e, 0xef, 0x0f, 0xb4,
-	0x1c, 0x75, 0x6c, 0x84, 0xe8, 0x36, 0xfb, 0x03, 0xd1, 0x24, 0x1c, 0x9b, 0x9d, 0x9f, 0x1d, 0x1b,
-	0x9a, 0xba, 0xd2, 0x58, 0x68, 0xd8, 0x7d, 0xc0, 0x0b, 0xd7, 0xb7, 0x83, 0x33, 0x5a, 0xdc, 0xc7,
-	0x99, 0x2c, 0x33, 0x1c, 0x5d, 0x5c, 0xd1, 0x20, 0x57, 0x97, 0x77, 0xfc, 0x82, 0x7d, 0x07, 0xd4,
-	0x00, 0x4d, 0x43, 0x56, 0xb1, 0x85, 0xd7, 0xd6, 0x56, 0xc4, 0xcb, 0x81, 0x00, 0xf0, 0x0a, 0x7b,
-	0x8e, 0x65, 0xcb, 0xb6, 0x9c, 0xc6, 0x78, 0xac, 0xb8, 0x1d, 0xe2, 0x35, 0x0b, 0x87, 0xfa, 0x6f,
-	0x72, 0x50, 0x11, 0xb5, 0x57, 0x2b, 0x3e, 0xcf, 0x36, 0x71, 0xca, 0x4a, 0x13, 0xf7, 0x0e, 0xa8,
-	0xf1, 0x48, 0xd4, 0x35, 0x72, 0x97, 0xcb, 0xf1, 0xc8, 0x4b, 0x1a, 0xbf, 0x79, 0xe8, 0x0e, 0xd1,
-	0xc5, 0x44, 0x02, 0x28, 0xcd, 0x43, 0xf7, 0xb9, 0x83, 0xd5, 0x59, 0x55, 0x12, 0x86, 0x18, 0x51,
-	0xd2, 0x27, 0x34, 0x24, 0x76, 0xec, 0x73, 0xd4, 0x79, 0xea, 0xda, 0x0e, 0x49, 0x8a, 0x18, 0x58,
-	0x46, 0x18, 0x45, 0xb7, 0xa1, 0x96, 0x90, 0x48, 0x56, 0x3c, 0xa8, 0x81, 0x24, 0xa3, 0xf0, 0xc7,
-	0x50, 0x15, 0xe5, 0xe4, 0x90, 0x6e, 0x54, 0xf9, 0x8a, 0xa8, 0x0d, 0x82, 0xa1, 0x85, 0xb1, 0xfb,
-	0x7d, 0xa8, 0x06, 0xf1, 0xa9, 0x13, 0x0e, 0xad, 0x38, 0x0e, 0x93, 0x7b, 0x0c, 0x84, 0x6a, 0x22,
-	0x86, 0x18, 0x68, 0x6f, 0x05, 0x43, 0x45, 0x32, 0x20, 0x8a, 0x18, 0xb0, 0xc1, 0xae, 0x36, 0x7d,
-	0xcb, 0xbb, 0xf8, 0xd2, 0xa1, 0x72, 0xe7, 0x3d, 0x00, 0xd7, 0x9f, 0x2f, 0xe2, 0x21, 0x06, 0x01,
-	0xd9, 0x0f, 0x54, 0x08, 0x83, 0x8e, 0x41, 0xfa, 0x16, 0x71, 0x4a, 0x17, 0x1d, 0x02, 0x08, 0x14,
-	0x31, 0xa4, 0xf2, 0x14, 0x50, 0xf2, 0x19, 0xf9, 0x3e, 0x86, 0x94, 0xa5, 0x3c, 0xd1, 0x0b, 0x59,
-	0x79, 0x62, 0xb8, 0x03, 0xf5, 0xd8, 0x9d, 0xe1, 0xf2, 0xfd, 0x68, 0x31, 0x73, 0x6c, 0xda, 0xc2,
-	0xbc, 0x78, 0xfe, 0x6a, 0x49, 0x1c, 0x6a, 0x99, 0x39, 0xb3, 0x20, 0xbc, 0x10, 0x5a, 0x4a, 0x42,
-	0x8b, 0x40, 0xd1, 0x63, 0xc4, 0xff, 0xd4, 0xa0, 0x60, 0x06, 0xb6, 0xc3, 0x3e, 0x85, 0x0a, 0xbd,
-	0x7d, 0xac, 0x97, 0x70, 0x48, 0xa6, 0x1f, 0x4a, 0x6f, 0xaa, 0x2f, 0x47, 0x6f, 0x7e, 0x2d, 0xb9,
-	0x8d, 0x5e, 0x1e, 0xc5, 0xab, 0x0d, 0x0c, 0x46, 0x55, 0x4e, 0x78, 0xba, 0xf5, 0x61, 0x80, 0x6d,
-	0xfb, 0x90, 0x7a, 0xb8, 0xc2, 0x15, 0xb7, 0x5e, 0xd0, 0xe9, 0xf5, 0xe8, 0x5d, 0x50, 0xe9, 0x4d,
-	0x25, 0x74, 0x44, 0xa1, 0x50, 0xe4, 0x29, 0x8c, 0x56, 0xbf, 0x0c, 0x5c, 0x5f, 0x58, 0x5d, 0x5a,
-	0xb3, 0xfa, 0xa7, 0x81, 0xeb, 0x93, 0x6b, 0xab, 0xc8, 0x45, 0x56, 0xdf, 0x81, 0x72, 0xe0, 0x8b,
-	0x79, 0xcb, 0x6b, 0xf3, 0x96, 0x02, 0x9f, 0xa6, 0xfc, 0x08, 0xaa, 0x13, 0xd7, 0x8b, 0x9d, 0x50,
-	0x30, 0xaa, 0x6b, 0x8c, 0x20, 0xc8, 0xc4, 0x7c, 0x0f, 0xd4, 0x69, 0x18, 0x2c, 0xe6, 0xe8, 0x95,
-	0x95, 0xf5, 0xea, 0x93, 0x68, 0x7b, 0x17, 0xb8, 0x6a, 0x1a, 0xba, 0xfe, 0x74, 0x18, 0x39, 0xd8,
-	0xb9, 0xae, 0xad, 0x3a, 0xa1, 0xf7, 0x1d, 0xd2, 0x6a, 0x4d, 0xa7, 0x62, 0xfe, 0xea, 0xba, 0x56,
-	0x6b, 0x3a, 0xa5, 0xc9, 0xb3, 0x21, 0xa1, 0xf6, 0xb5, 0x21, 0xe1, 0xd3, 0xa5, 0xd3, 0xc4, 0xe7,
-	0x51, 0xa3, 0x4e, 0x02, 0xf2, 0x21, 0x25, 0x0d, 0x02, 0xa9, 0xdf, 0xc4, 0xe7, 0x11, 0xfb, 0x08,
-	0xd4, 0x33, 0x6c, 0x9f, 0xe6, 0xce, 0x98, 0x5e, 0x48, 0xd3, 0x86, 0x7c, 0x19, 0xc5, 0x78, 0xf9,
-	0xcc, 0xf5, 0x29, 0x9c, 0x6d, 0x43, 0xd1, 0x73, 0x67, 0x6e, 0x4c, 0x4f, 0xa5, 0x97, 0x9e, 0xc5,
-	0x88, 0xc0, 0x74, 0x28, 0x05, 0x93, 0x09, 0x2e, 0x5f, 0x5b, 0x63, 0x91, 0x14, 0xf6, 0x11, 0x88,
-	0x42, 0x69, 0x68, 0x3b, 0x93, 0xc6, 0xb5, 0x2b, 0xf3, 0x89, 0x1a, 0x27, 0x95, 0xdc, 0x2e, 0xd4,
-	0x53, 0xe6, 0xe1, 0x6b, 0x67, 0xdc, 0x60, 0xd9, 0xce, 0x25, 0x15, 0xa8, 0x26, 0x02, 0x27, 0xce,
-	0x98, 0xed, 0x40, 0x39, 0x18, 0xbd, 0x1c, 0x86, 0xce, 0xa4, 0x71, 0xfd, 0xea, 0xa7, 0xa4, 0x52,
-	0x30, 0x7a, 0xc9, 0x9d, 0x09, 0xfb, 0x0c, 0xaa, 0x21, 0x65, 0xb9, 0xa1, 0x6d, 0xc5, 0x56, 0xe3,
-	0x46, 0x76, 0x03, 0x96, 0xe9, 0x8f, 0x43, 0xb8, 0x4c, 0x85, 0x77, 0xa0, 0xee, 0x9c, 0xc7, 0xa1,
-	0x35, 0x0c, 0xe6, 0xa2, 0x2f, 0x78, 0x4b, 0x54, 0xe6, 0x84, 0xec, 0x09, 0x1c, 0xfb, 0x11, 0x6c,
-	0xd9, 0x8e, 0xe7, 0xc4, 0x0e, 0x19, 0x18, 0xb5, 0xe2, 0xf3, 0xc6, 0x4d, 0xb2, 0xfb, 0x46, 0xd2,
-	0xcb, 0xa7, 0x44, 0x3c, 0x90, 0xcb, 0xcc, 0xd8, 0x1a, 0x8f, 0x5c, 0xdf, 0xc6, 0xab, 0x14, 0x5b,
-	0xd3, 0xa8, 0xf1, 0x36, 0xb9, 0x45, 0x55, 0xe2, 0x06, 0xd6, 0x34, 0x62, 0x8f, 0xa1, 0x66, 0x89,
-	0x68, 0x35, 0x74, 0xfd, 0x49, 0xd0, 0x68, 0x64, 0x9f, 0x66, 0x32, 0x71, 0x8c, 0x57, 0xad, 0x25,
-	0xa0, 0xff, 0x4b, 0x1e, 0xd4, 0xc4, 0xd5, 0xb1, 0x29, 0x3d, 0x36, 0x9f, 0x9b, 0xbd, 0x17, 0xa6,
-	0xb6, 0x81, 0x39, 0xee, 0xa4, 0xd9, 0x3d, 0x36, 0x86, 0xfd, 0x56, 0xd3, 0x14, 0xaf, 0x94, 0xf4,
-	0x42, 0x26, 0xe0, 0x1c, 0xbb, 0x06, 0xf5, 0x67, 0xc7, 0x66, 0x6b, 0xd0, 0xe9, 0x99, 0x02, 0x95,
-	0x47, 0x94, 0xf1, 0xb9, 0x48, 0x7d, 0x02, 0x55, 0x40, 0xd4, 0x61, 0x73, 0x60, 0xf0, 0x4e, 0x82,
-	0x2a, 0xe2, 0x2c, 0x47, 0xbc, 0xf7, 0x53, 0xa3, 0x35, 0xd0, 0x80, 0xbd, 0x05, 0xd7, 0x52, 0x91,
-	0x44, 0x9d, 0x56, 0xc5, 0x24, 0x9a, 0x88, 0x69, 0x37, 0x50, 0x09, 0x37, 0x5a, 0xc7, 0xbc, 0xdf,
-	0x39, 0x31, 0x86, 0xad, 0x81, 0xa1, 0xbd, 0x85, 0xc9, 0xb9, 0xdf, 0x31, 0x9f, 0x6b, 0x37, 0x59,
-	0x1d, 0x2a, 0x38, 0x12, 0xda, 0xdf, 0xa6, 0xf4, 0x7d, 0x70, 0xa0, 0xdd, 0x46, 0x15, 0xfb, 0x9d,
-	0xfe, 0xa0, 0x63, 0xb6, 0x06, 0xda, 0xfb, 0x98, 0xa1, 0x9f, 0x75, 0xba, 0x03, 0x83, 0x6b, 0xdb,
-	0x28, 0xfb, 0xd3, 0x5e, 0xc7, 0xd4, 0x3e, 0xa0, 0xc7, 0xbd, 0xe6, 0xe1, 0x51, 0xd7, 0xd0, 0x74,
-	0xd2, 0xd8, 0xe3, 0x03, 0xed, 0x0e, 0xab, 0x40, 0xf1, 0xd8, 0x44, 0x3b, 0xee, 0xa2, 0x72, 0x1a,
-	0x0e, 0x9b, 0xdd, 0xae, 0x76, 0x2f, 0x93, 0xe7, 0xef, 0xe3, 0xf8, 0x45, 0xc7, 0xdc, 0xef, 0xbd,
-	0xd0, 0x3e, 0x44, 0xb6, 0x3d, 0xde, 0x6b, 0xee, 0xb7, 0xb0, 0x1c, 0xd8, 0x41, 0x05, 0xfd, 0xa3,
-	0x6e, 0x67, 0xa0, 0x7d, 0x1b, 0xb9, 0x0e, 0x9a, 0x83, 0xb6, 0xc1, 0xb5, 0x07, 0x38, 0x6e, 0xf6,
-	0xfb, 0x06, 0x1f, 0x68, 0xbb, 0x38, 0xee, 0x98, 0x34, 0x7e, 0x44, 0x5a, 0x8f, 0xf6, 0x9b, 0x03,
-	0x43, 0x7b, 0x8c, 0xe3, 0x7d, 0xa3, 0x6b, 0x0c, 0x0c, 0xed, 0xbb, 0xa8, 0x95, 0x2a, 0x89, 0x3e,
-	0x6e, 0xd5, 0x13, 0xdc, 0x85, 0x14, 0x24, 0x7b, 0xbe, 0x87, 0x13, 0x1d, 0x76, 0xcc, 0xe3, 0xbe,
-	0xf6, 0x14, 0x99, 0x69, 0x48, 0x94, 0xef, 0xeb, 0x2f, 0x41, 0x4d, 0x62, 0x21, 0x72, 0x75, 0x4c,
-	0xd3, 0xe0, 0xa2, 0xa6, 0xe9, 0x1a, 0xcf, 0x06, 0x9a, 0x82, 0x48, 0xde, 0x39, 0x68, 0x63, 0x35,
-	0x53, 0x81, 0x62, 0xef, 0x18, 0xb7, 0x26, 0x4f, 0x9b, 0x60, 0x1c, 0x76, 0xb4, 0x02, 0x8e, 0x9a,
-	0xe6, 0xa0, 0xa3, 0x15, 0x69, 0x93, 0x3a, 0xe6, 0x41, 0xd7, 0xd0, 0x4a, 0x88, 0x3d, 0x6c, 0xf2,
-	0xe7, 0x5a, 0x19, 0x85, 0x9a, 0x47, 0x47, 0xdd, 0x2f, 0x34, 0x55, 0xdf, 0x81, 0x72, 0x73, 0x3a,
-	0x3d, 0xc4, 0xa4, 0xa2, 0x42, 0xe1, 0xd9, 0x71, 0xb7, 0x2b, 0x1e, 0xb8, 0xf7, 0x7a, 0x83, 0x41,
-	0xef, 0x50, 0xbc, 0x6f, 0x0c, 0x7a, 0x47, 0x5a, 0x4e, 0xff, 0x8d, 0x02, 0x9b, 0xab, 0x57, 0x9d,
-	0xdd, 0x04, 0x59, 0x71, 0x5c, 0xaa, 0x3f, 0x1a, 0x90, 0xd4, 0x1b, 0x97, 0xcb, 0x0f, 0x1d, 0x6a,
-	0x8b, 0xc8, 0x11, 0x6a, 0x9e, 0xa7, 0x35, 0xc8, 0x0a, 0x0e, 0xfb, 0xd4, 0xb1, 0xe5, 0x0f, 0xc2,
-	0x85, 0x3f, 0xb6, 0x62, 0x91, 0x4c, 0x55, 0x9e, 0x45, 0x61, 0x19, 0xe9, 0x46, 0x6d, 0x51, 0x5e,
-	0xc8, 0xe7, 0xb2, 0x25, 0x42, 0xff, 0x75, 0x0e, 0x8a, 0x3f, 0x5b, 0x38, 0xe1, 0x05, 0x7b, 0x02,
-	0x95, 0x28, 0x9e, 0xc5, 0xd9, 0x34, 0xf9, 0x8e, 0xf0, 0x29, 0xa2, 0x3f, 0xec, 0xc7, 0x56, 0x4c,
-	0xaf, 0x67, 0x22, 0x59, 0x22, 0x2f, 0xf9, 0x12, 0xf5, 0x03, 0xce, 0x5c, 0x94, 0xbe, 0x45, 0x2e,
-	0x00, 0x0c, 0x98, 0x98, 0x33, 0x93, 0x96, 0x12, 0x96, 0xa9, 0x8b, 0x0b, 0x02, 0x06, 0xcc, 0xb9,
-	0x15, 0x5a, 0xb3, 0xab, 0x1e, 0x36, 0x24, 0x05, 0x13, 0xe4, 0xa9, 0x63, 0xa1, 0xe7, 0x27, 0xef,
-	0x19, 0x29, 0xac, 0xbf, 0x80, 0xfa, 0x8a, 0x49, 0xab, 0x4e, 0x8d, 0x67, 0x69, 0x74, 0xf1, 0x3e,
-	0x29, 0x99, 0x2b, 0x98, 0xcb, 0x5c, 0xbb, 0x7c, 0xe6, 0x3a, 0x16, 0xe8, 0x82, 0x19, 0xfc, 0xc0,
-	0xd0, 0x8a, 0xfa, 0xdf, 0xe6, 0xe0, 0xda, 0x20, 0xb4, 0xfc, 0xc8, 0x12, 0xcf, 0x26, 0x7e, 0x1c,
-	0x06, 0x1e, 0xfb, 0x01, 0xa8, 0xf1, 0xd8, 0xcb, 0xee, 0xce, 0xfb, 0x32, 0x12, 0x5f, 0x66, 0x7d,
-	0x38, 0x18, 0x7b, 0xb4, 0x47, 0xe5, 0x58, 0x0c, 0xd8, 0xc7, 0x50, 0x1c, 0x39, 0x53, 0xd7, 0x97,
-	0xad, 0xe7, 0x5b, 0x97, 0x05, 0xf7, 0x90, 0xd8, 0xde, 0xe0, 0x82, 0x8b, 0x7d, 0x0a, 0xa5, 0x71,
-	0x30, 0xc3, 0x6c, 0x23, 0xea, 0x8c, 0x9b, 0xeb, 0x13, 0x21, 0xb5, 0xbd, 0xc1, 0x25, 0x1f, 0x7b,
-	0x02, 0x6a, 0x18, 0x78, 0xde, 0xc8, 0x1a, 0xbf, 0x92, 0x2d, 0x68, 0xe3, 0xb2, 0x0c, 0x97, 0xf4,
-	0xf6, 0x06, 0x4f, 0x79, 0xf5, 0x87, 0x50, 0x96, 0xc6, 0xe2, 0x06, 0xec, 0x19, 0x07, 0x1d, 0xb9,
-	0x77, 0xad, 0xde, 0xe1, 0x61, 0x07, 0xf7, 0xae, 0x06, 0x2a, 0xef, 0x75, 0xbb, 0x7b, 0xcd, 0xd6,
-	0x73, 0x2d, 0xb7, 0xa7, 0x42, 0xc9, 0xa2, 0xb7, 0x71, 0xfd, 0xcf, 0x15, 0xd8, 0xba, 0xb4, 0x00,
-	0xf6, 0x14, 0x0a, 0xb3, 0xc0, 0x4e, 0xb6, 0xe7, 0xee, 0x95, 0xab, 0xcc, 0xc0, 0xe8, 0x47, 0x9c,
-	0x24, 0xf4, 0xef, 0xc3, 0xe6, 0x2a, 0x3e, 0xf3, 0xff, 0x45, 0x1d, 0x2a, 0xdc, 0x68, 0xee, 0x0f,
-	0x7b, 0x66, 0xf7, 0x0b, 0x11, 0x9d, 0x09, 0x7c, 0xc1, 0x3b, 0x03, 0x43, 0xcb, 0xe9, 0xbf, 0x00,
-	0xed, 0xf2, 0xc6, 0xb0, 0x03, 0xd8, 0x1a, 0x07, 0xb3, 0xb9, 0xe7, 0x50, 0xf7, 0x91, 0x39, 0xb2,
-	0xdb, 0x57, 0xec, 0xa4, 0x64, 0xa3, 0x13, 0xdb, 0x1c, 0xaf, 0xc0, 0xfa, 0x1f, 0x03, 0x5b, 0xdf,
-	0xc1, 0xff, 0x3f, 0xf5, 0xff, 0xaa, 0x40, 0xe1, 0xc8, 0xb3, 0x7c, 0x76, 0x07, 0x8a, 0xf4, 0x87,
-	0x82, 0xec, 0x05, 0xab, 0x19, 0xbf, 0xc3, 0x6b, 0x41, 0x34, 0xf6, 0x11, 0xe4, 0xe3, 0xb1, 0x27,
-	0xef, 0xd0, 0xdb, 0x6f, 0xb8, 0x7c, 0xed, 0x0d, 0x8e, 0x5c, 0x6c, 0x07, 0xf2, 0xb6, 0xed, 0xc9,
-	0x0b, 0x94, 0xe4, 0x5e, 0x2b, 0xb6, 0xf6, 0x9d, 0x89, 0xeb, 0xbb, 0xf2, 0xef, 0x0d, 0x64, 0x61,
-	0xf7, 0x20, 0x6f, 0x8f, 0x3d, 0x79, 0x6d, 0xae, 0x2d, 0x39, 0x33, 0x0a, 0xed, 0xb1, 0xc7, 0xee,
-	0x43, 0xde, 0xa5, 0x57, 0x45, 0x64, 0x63, 0xc9, 0xe3, 0x49, 0xe4, 0x84, 0xb1, 0x78, 0xa5, 0xa2,
-	0x07, 0x14, 0x3f, 0xda, 0x2b, 0x01, 0x7d, 0xdd, 0xa0, 0x7f, 0x95, 0x83, 0x5a, 0x96, 0xfe, 0x8d,
-	0x1a, 0xb0, 0xcf, 0xb0, 0xe4, 0x98, 0x7b, 0xee, 0xd8, 0x8d, 0x45, 0x33, 0x94, 0xbf, 0xa2, 0x19,
-	0xaa, 0x25, 0x2c, 0xd4, 0x0e, 0x7d, 0x04, 0xa2, 0xf7, 0x11, 0xfc, 0x85, 0x2b, 0xf8, 0x2b, 0x44,
-	0x4f, 0x7b, 0xa7, 0x4c, 0x6b, 0x54, 0xbc, 0xdc, 0x1a, 0xb1, 0xfb, 0xf4, 0xd7, 0x30, 0xbd, 0xa7,
-	0x96, 0xb2, 0xaa, 0x04, 0x92, 0x27, 0x44, 0xf6, 0x08, 0xe8, 0x6c, 0x83, 0xc8, 0x8d, 0x9d, 0xe1,
-	0x1c, 0xdb, 0xbe, 0xf2, 0xa5, 0xbf, 0x9c, 0x70, 0xe6, 0x7a, 0xca, 0x73, 0xf4, 0xca, 0xb9, 0xd0,
-	0xbf, 0x83, 0x7e, 0x8e, 0xf2, 0x18, 0x0b, 0xc5, 0xe8, 0x8a, 0x3e, 0x59, 0x52, 0xf4, 0xff, 0xcd,
-	0x41, 0x35, 0x73, 0x2e, 0xec, 0x31, 0xa8, 0xf6, 0x6a, 0x40, 0x7a, 0x67, 0xed, 0xf0, 0x1e, 0xee,
-	0x27, 0xa1, 0xc8, 0x96, 0x6e, 0xfe, 0x7d, 0xa8, 0x63, 0xd1, 0xf7, 0xda, 0x0a, 0x5d, 0xaa, 0xb9,
-	0xe4, 0x75, 0x92, 0x07, 0xda, 0x77, 0xe2, 0x93, 0x84, 0xd2, 0xde, 0xe0, 0xb5, 0x28, 0x03, 0xb3,
-	0x6f, 0x63, 0x37, 0xec, 0xcc, 0xad, 0xd0, 0x91, 0xd7, 0xaa, 0x9e, 0xbc, 0x8b, 0x11, 0xb2, 0xbd,
-	0xc1, 0x13, 0x3a, 0xb2, 0x3a, 0xe7, 0xce, 0x78, 0x21, 0x33, 0x52, 0xca, 0x6a, 0x08, 0x24, 0xb2,
-	0x4a, 0x3a, 0xdb, 0x05, 0xb0, 0x1d, 0xcb, 0xf3, 0x02, 0xca, 0x5f, 0xc5, 0x6c, 0x1d, 0xba, 0x9f,
-	0xe2, 0xc5, 0xc7, 0x0b, 0x09, 0xa4, 0x4f, 0xa1, 0x2c, 0x17, 0x86, 0xb5, 0x42, 0xdf, 0x18, 0x0c,
-	0x4f, 0x9a, 0xbc, 0x83, 0x35, 0x5b, 0x5f, 0xdb, 0xc0, 0x48, 0x76, 0xc0, 0x9b, 0xa6, 0x8c, 0xfc,
-	0xdc, 0x38, 0xe9, 0x3d, 0x37, 0xb4, 0x9c, 0x78, 0xe5, 0x30, 0xbf, 0xd0, 0xf2, 0xa2, 0x2e, 0x33,
-	0x8e, 0x9a, 0x1c, 0x03, 0x7f, 0x15, 0xca, 0xc6, 0xe7, 0x46, 0xeb, 0x78, 0x60, 0x68, 0x45, 0x0c,
-	0x2e, 0xfb, 0x46, 0xb3, 0xdb, 0xed, 0xb5, 0x30, 0x2b, 0x94, 0xf6, 0x2a, 0x78, 0xfc, 0xb4, 0x93,
-	0xfa, 0x9f, 0x55, 0x60, 0x73, 0xd5, 0x81, 0xd8, 0xf7, 0x40, 0xb5, 0xed, 0x95, 0x13, 0xb8, 0x75,
-	0x95, 0xa3, 0x3d, 0xdc, 0xb7, 0x93, 0x43, 0x10, 0x03, 0xf6, 0x41, 0xe2, 0xee, 0xb9, 0x35, 0x77,
-	0x4f, 0x9c, 0xfd, 0xc7, 0xb0, 0x25, 0x5e, 0x4d, 0xa9, 0x3e, 0x1f, 0x59, 0x91, 0xb3, 0xea, 0xcb,
-	0x2d, 0x22, 0xee, 0x4b, 0x5a, 0x7b, 0x83, 0x6f, 0x8e, 0x57, 0x30, 0xec, 0x87, 0xb0, 0x69, 0x51,
-	0x9f, 0x97, 0xca, 0x17, 0xb2, 0x2f, 0x8e, 0x4d, 0xa4, 0x65, 0xc4, 0xeb, 0x56, 0x16, 0x81, 0xd7,
-	0xc4, 0x0e, 0x83, 0xf9, 0x52, 0x78, 0xc5, 0xef, 0xf7, 0xc3, 0x60, 0x9e, 0x91, 0xad, 0xd9, 0x19,
-	0x98, 0x3d, 0x81, 0x9a, 0xb4, 0x9c, 0x3a, 0x13, 0xf9, 0xdf, 0xea, 0xb5, 0xac, 0xd9, 0x54, 0x13,
-	0xb5, 0x37, 0x78, 0x75, 0xbc, 0x04, 0xd9, 0x23, 0xa8, 0x0a, 0x83, 0x85, 0x58, 0x39, 0x7b, 0x13,
-	0xc8, 0xda, 0x44, 0x0a, 0xac, 0x14, 0x62, 0x9f, 0x02, 0x90, 0x9d, 0x42, 0x46, 0xcd, 0xf6, 0x3c,
-	0x68, 0x64, 0x22, 0x52, 0xb1, 0x13, 0x20, 0x63, 0x9e, 0x78, 0x7f, 0xae, 0xac, 0x9b, 0x47, 0x0f,
-	0xac, 0x4b, 0xf3, 0x08, 0x5c, 0x9a, 0x27, 0xc4, 0x60, 0xcd, 0xbc, 0x44, 0x4a, 0x98, 0x27, 0x84,
-	0x12, 0xf3, 0x84, 0x4c, 0xf5, 0xb2, 0x79, 0x89, 0x08, 0x99, 0x27, 0x24, 0x7e, 0x08, 0x9b, 0xb1,
-	0xac, 0xdc, 0xe4, 0xa2, 0x6a, 0xd9, 0x63, 0x4b, 0xaa, 0xba, 0x64, 0x61, 0xf5, 0x38, 0x8b, 0x40,
-	0xe9, 0xe8, 0x34, 0x38, 0xcb, 0xb8, 0x77, 0x3d, 0x2b, 0xdd, 0x3f, 0x0d, 0xce, 0xb2, 0xfe, 0x5d,
-	0x8f, 0xb2, 0x08, 0xfd, 0xaf, 0xf2, 0x50, 0x96, 0x77, 0x95, 0x5d, 0x87, 0xad, 0x16, 0x37, 0x9a,
-	0x03, 0x63, 0xb8, 0xdf, 0x1c, 0x34, 0xf7, 0x9a, 0x7d, 0x4c, 0xc5, 0x0c, 0x36, 0x9b, 0xd8, 0x5a,
-	0x2c, 0x71, 0x0a, 0x3a, 0xe0, 0x3e, 0xef, 0x1d, 0x2d, 0x51, 0x39, 0xa6, 0x41, 0x4d, 0xca, 0x8a,
-	0x2f, 0x0d, 0xf2, 0x6c, 0x0b, 0xaa, 0x42, 0x50, 0x20, 0x0a, 0xe4, 0x68, 0x28, 0x25, 0xe0, 0x62,
-	0x46, 0xa4, 0x63, 0xee, 0x1b, 0x9f, 0x6b, 0xa5, 0xa5, 0x88, 0x40, 0x94, 0x53, 0x11, 0x01, 0xab,
-	0x68, 0xcc, 0x80, 0x1f, 0x9b, 0xad, 0xe5, 0x3c, 0x15, 0xf6, 0x36, 0x5c, 0xef, 0xb7, 0x7b, 0x2f,
-	0x86, 0x42, 0x57, 0x6a, 0x12, 0xb0, 0x1b, 0xa0, 0x65, 0x08, 0x82, 0xbd, 0x8a, 0x2a, 0x08, 0x9b,
-	0x30, 0xf6, 0xb5, 0x1a, 0xce, 0x4b, 0xb8, 0x81, 0x08, 0x27, 0x75, 0x34, 0x4d, 0x88, 0xf6, 0xba,
-	0xc7, 0x87, 0x66, 0x5f, 0xdb, 0x44, 0x4b, 0x08, 0x23, 0x2c, 0xd9, 0x4a, 0xd5, 0x2c, 0x83, 0x90,
-	0x46, 0x71, 0x09, 0x71, 0x2f, 0x9a, 0xdc, 0xec, 0x98, 0x07, 0x7d, 0xed, 0x5a, 0xaa, 0xd9, 0xe0,
-	0xbc, 0xc7, 0xfb, 0x1a, 0x4b, 0x11, 0xfd, 0x41, 0x73, 0x70, 0xdc, 0xd7, 0xae, 0xa7, 0x56, 0x1e,
-	0xf1, 0x5e, 0xcb, 0xe8, 0xf7, 0xbb, 0x9d, 0xfe, 0x40, 0xbb, 0xb1, 0x57, 0xa3, 0x8f, 0xb9, 0x64,
-	0x30, 0xd1, 0x8f, 0x60, 0x73, 0xd5, 0xf7, 0x99, 0x0e, 0x75, 0x77, 0x32, 0xf4, 0x83, 0x78, 0xe8,
-	0x9c, 0xbb, 0x51, 0x1c, 0x25, 0x7f, 0x27, 0xbb, 0x13, 0x33, 0x88, 0x0d, 0x42, 0x61, 0x21, 0x9d,
-	0xba, 0xb2, 0xc8, 0xb1, 0x29, 0xac, 0xb7, 0xa1, 0xbe, 0x12, 0x0d, 0xd8, 0xb7, 0xa0, 0xe2, 0x4e,
-	0x56, 0x95, 0xa9, 0xee, 0xe4, 0x0f, 0xd0, 0x74, 0x00, 0xb5, 0x6c, 0x68, 0xf8, 0xe6, 0x8a, 0xfe,
-	0x46, 0x81, 0x6a, 0x26, 0x54, 0xfc, 0x41, 0x4b, 0xbc, 0x05, 0x95, 0xd8, 0x99, 0xcd, 0x83, 0xd0,
-	0x92, 0x81, 0x55, 0xe5, 0x4b, 0xc4, 0xca, 0x6c, 0xf9, 0xd5, 0xd9, 0x56, 0x9f, 0x65, 0x0a, 0xbf,
-	0xff, 0x59, 0x46, 0xef, 0x01, 0x2c, 0xa3, 0x11, 0xfd, 0xed, 0x41, 0x5e, 0x2a, 0xbf, 0xe9, 0x22,
-	0x60, 0x55, 0x61, 0xee, 0x6b, 0x14, 0xfe, 0x1c, 0x2a, 0x69, 0xa8, 0xfa, 0xc6, 0x3b, 0xb6, 0x34,
-	0x24, 0x9f, 0x31, 0x44, 0x3f, 0x48, 0xb6, 0x51, 0x04, 0x97, 0x3f, 0x64, 0x1b, 0x6f, 0x40, 0x51,
-	0x44, 0x2b, 0xf9, 0xa7, 0x3a, 0x01, 0xba, 0x2e, 0x57, 0x2d, 0xf4, 0xa4, 0x3c, 0x4a, 0x96, 0xe7,
-	0x47, 0x62, 0x21, 0x82, 0xe5, 0xf7, 0x2e, 0xe4, 0xea, 0x39, 0xee, 0x41, 0x7d, 0x25, 0xbc, 0x5d,
-	0xbd, 0xb9, 0x7a, 0x07, 0xea, 0x2b, 0x71, 0x2c, 0xf3, 0x35, 0xa1, 0x92, 0xfd, 0x9a, 0x10, 0x5b,
-	0xd0, 0xb3, 0x53, 0x27, 0x74, 0xae, 0xf8, 0x60, 0x4a, 0x10, 0xf4, 0x1f, 0x42, 0x2d, 0x5b, 0xf1,
-	0xb0, 0xef, 0x40, 0xd1, 0x8d, 0x9d, 0x59, 0xf2, 0x91, 0xc0, 0xcd, 0xf5, 0xa2, 0x88, 0xfe, 0xf4,
-	0x16, 0x4c, 0xfa, 0x57, 0x0a, 0x68, 0x97, 0x69, 0x99, 0x4f, 0x1e, 0x95, 0x37, 0x7c, 0xf2, 0x98,
-	0x5b, 0x31, 0xf2, 0x8a, 0xcf, 0x16, 0xd1, 0x70, 0xf1, 0x1f, 0xde, 0x15, 0xdf, 0xe0, 0x11, 0x81,
-	0xdd, 0x07, 0x35, 0x74, 0xe8, 0x0b, 0x35, 0x5b, 0x66, 0xe6, 0x95, 0x7f, 0x8e, 0x13, 0x9a, 0xfe,
-	0x17, 0x0a, 0x94, 0x65, 0x79, 0x76, 0xe5, 0x3f, 0xc3, 0xdf, 0x86, 0xb2, 0xf8, 0xff, 0x2a, 0xf9,
-	0xe3, 0x6a, 0xed, 0xbd, 0x30, 0xa1, 0xb3, 0xdb, 0xa2, 0xae, 0x5f, 0x7d, 0xfa, 0xc6, 0xe6, 0x85,
-	0x13, 0x1e, 0x4b, 0x69, 0x6a, 0xda, 0xa9, 0x1c, 0x8a, 0xe4, 0x9f, 0x72, 0x40, 0x28, 0x4c, 0x28,
-	0x91, 0xfe, 0x47, 0x50, 0x96, 0xe5, 0xdf, 0x95, 0xa6, 0x7c, 0xdd, 0xd7, 0x6d, 0xdb, 0x00, 0xcb,
-	0x7a, 0xf0, 0x2a, 0x0d, 0x0f, 0x3e, 0x80, 0x5a, 0xf6, 0x8b, 0x23, 0x6a, 0x21, 0x03, 0xdf, 0xd1,
-	0x36, 0x58, 0x19, 0xf2, 0xdd, 0x2f, 0x1f, 0x6b, 0xca, 0x83, 0x3f, 0xc9, 0x7c, 0x36, 0x40, 0x3c,
-	0x65, 0xc8, 0x3f, 0x37, 0xbe, 0x10, 0x8f, 0x80, 0xdd, 0x8e, 0x69, 0x34, 0xf9, 0x10, 0x61, 0x05,
-	0x85, 0xdb, 0xcd, 0x7e, 0x5b, 0xcb, 0x61, 0x94, 0x96, 0x14, 0x42, 0xe4, 0xe9, 0x41, 0xa9, 0x69,
-	0x1e, 0x18, 0xe2, 0xd1, 0x8f, 0x86, 0x69, 0x72, 0x28, 0xd2, 0xc3, 0x13, 0xc6, 0xed, 0x12, 0x26,
-	0x0e, 0x1c, 0xa5, 0xb4, 0xf2, 0x83, 0x9f, 0x40, 0xe3, 0x4d, 0xbd, 0x21, 0x6a, 0x6d, 0xb5, 0x9b,
-	0xd4, 0x7f, 0xd7, 0x40, 0x35, 0x7b, 0x43, 0x01, 0x29, 0x58, 0xa0, 0x72, 0xa3, 0x6b, 0x50, 0x6a,
-	0xdd, 0xfb, 0xf1, 0x3f, 0xfe, 0xee, 0xb6, 0xf2, 0x4f, 0xbf, 0xbb, 0xad, 0xfc, 0xfb, 0xef, 0x6e,
-	0x6f, 0x7c, 0xf5, 0x1f, 0xb7, 0x95, 0x9f, 0x67, 0xbf, 0x22, 0x9f, 0x59, 0x71, 0xe8, 0x9e, 0x8b,
-	0x4f, 0x80, 0x12, 0xc0, 0x77, 0x3e, 0x99, 0xbf, 0x9a, 0x7e, 0x32, 0x1f, 0x7d, 0x82, 0x3b, 0x3a,
-	0x2a, 0xd1, 0xc7, 0xe4, 0x8f, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x42, 0x0e, 0xa4, 0x09, 0x8f,
-	0x2e, 0x00, 0x00,
+	// 6151 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3b, 0x4b, 0x8c, 0x1b, 0x47,
+	0x76, 0xd3, 0xfc, 0x36, 0x1f, 0x87, 0xa3, 0x56, 0x59, 0xb6, 0x69, 0x59, 0xd6, 0x8e, 0xdb, 0xb2,
+	0x2c, 0xcb, 0xeb, 0xb1, 0x3d, 0xf2, 0x7a, 0xbd, 0xc6, 0xfe, 0x38, 0x9c, 0xd6, 0x0c, 0x2d, 0x8a,
+	0x9c, 0x2d, 0x72, 0x24, 0xdb, 0x8b, 0x05, 0xd1, 0x64, 0x37, 0x39, 0x2d, 0x35, 0xbb, 0xe9, 0xee,
+	0xa6, 0x66, 0xc6, 0x40, 0x82, 0x3d, 0x24, 0x41, 0x72, 0x4a, 0x0e, 0x39, 0xe4, 0x12, 0xc0, 0xc8,
+	0x22, 0x39, 0xe5, 0x90, 0x04, 0x39, 0xec, 0x29, 0xa7, 0x00, 0xc9, 0x31, 0x40, 0x90, 0x43, 0xb0,
+	0x97, 0xec, 0x06, 0x01, 0x02, 0xe4, 0x90, 0x43, 0x2e, 0x39, 0xe4, 0x10, 0xbc, 0x57, 0xd5, 0xcd,
+	0xe2, 0x90, 0xd2, 0x1a, 0xc6, 0x5e, 0x88, 0x7a, 0xdf, 0x7e, 0xf5, 0x7b, 0xef, 0xd5, 0xab, 0x22,
+	0xc0, 0xcc, 0xb7, 0x83, 0x9d, 0x59, 0x14, 0x26, 0x21, 0x2b, 0x60, 0xfb, 0xea, 0xdb, 0x13, 0x2f,
+	0x39, 0x99, 0x0f, 0x77, 0x46, 0xe1, 0xf4, 0x9d, 0x49, 0x38, 0x09, 0xdf, 0x21, 0xe2, 0x70, 0x3e,
+	0x26, 0x88, 0x00, 0x6a, 0x09, 0x21, 0xf3, 0xaf, 0x34, 0x28, 0xf4, 0xcf, 0x67, 0x2e, 0xdb, 0x82,
+	0x9c, 0xe7, 0xd4, 0xb5, 0x6d, 0xed, 0x56, 0x91, 0xe7, 0x3c, 0x87, 0x5d, 0x05, 0x3d, 0x98, 0xfb,
+	0xbe, 0x3d, 0xf4, 0xdd, 0x7a, 0x6e, 0x5b, 0xbb, 0xa5, 0xf3, 0x0c, 0x66, 0x57, 0xa0, 0x78, 0xea,
+	0x39, 0xc9, 0x49, 0x3d, 0x4f, 0xec, 0x02, 0x60, 0xd7, 0xa0, 0x32, 0x8b, 0xdc, 0x91, 0x17, 0x7b,
+	0x61, 0x50, 0x2f, 0x10, 0x65, 0x81, 0x60, 0x0c, 0x0a, 0xb1, 0xf7, 0x85, 0x5b, 0x2f, 0x12, 0x81,
+	0xda, 0xa8, 0x27, 0x1e, 0xd9, 0xbe, 0x5b, 0x2f, 0x09, 0x3d, 0x04, 0xb0, 0xeb, 0x00, 0x6e, 0x30,
+	0x9f, 0x3e, 0xb1, 0xfd, 0xb9, 0x1b, 0xd7, 0xcb, 0xdb, 0xda, 0xad, 0x0a, 0x57, 0x30, 0xe6, 0x2f,
+	0xf3, 0x50, 0x6c, 0x86, 0x41, 0x9c, 0xb0, 0x17, 0xa0, 0xe4, 0xc5, 0x68, 0x15, 0xd9, 0xad, 0x73,
+	0x09, 0xb1, 0x2b, 0x50, 0xf0, 0x9e, 0xd8, 0x3e, 0xd9, 0x9d, 0x3f, 0xdc, 0xe0, 0x04, 0x21, 0xd6,
+	0x41, 0x2c, 0x1a, 0xad, 0x21, 0xd6, 0x91, 0xd8, 0x18, 0xb1, 0x68, 0x70, 0x05, 0xb1, 0xb1, 0xc4,
+	0x0e, 0x11, 0x8b, 0xd6, 0xea, 0x88, 0x1d, 0x4a, 0xec, 0x1c, 0xb1, 0x68, 0x6e, 0x01, 0xb1, 0x73,
+	0x89, 0x1d, 0x23, 0x16, 0x2d, 0xcd, 0x21, 0x16, 0x21, 0x76, 0x15, 0xca, 0x8e, 0x9d, 0xb8, 0x48,
+	0xd0, 0xb1, 0x77, 0x87, 0x1b, 0x3c, 0x45, 0x30, 0x13, 0xaa, 0xd8, 0x4c, 0xbc, 0x29, 0xd1, 0x2b,
+	0xd2, 0x4c, 0x15, 0xc9, 0xbe, 0x05, 0x9b, 0x8e, 0x3b, 0xf2, 0xa6, 0xb6, 0xff, 0xc1, 0xfb, 0xc8,
+	0x04, 0xdb, 0xda, 0xad, 0xea, 0xee, 0xa5, 0x1d, 0x9a, 0xf0, 0x8c, 0x72, 0xb8, 0xc1, 0x97, 0xd8,
+	0xd8, 0x87, 0x50, 0x93, 0xf0, 0x7b, 0xbb, 0x1f, 0xa2, 0x5c, 0x95, 0xe4, 0x8c, 0x25, 0xb9, 0xf7,
+	0x76, 0x3f, 0x3c, 0xdc, 0xe0, 0xcb, 0x8c, 0xec, 0x06, 0x6c, 0xe2, 0xb7, 0xe3, 0xc4, 0x9e, 0xce,
+	0x50, 0x70, 0x53, 0x5a, 0xb5, 0x84, 0xc5, 0x6e, 0x3d, 0x8a, 0xc3, 0x00, 0x19, 0x6a, 0x72, 0xc4,
+	0x52, 0x04, 0xdb, 0x06, 0x70, 0xdc, 0xb1, 0x3d, 0xf7, 0x13, 0x24, 0x6f, 0xc9, 0xa1, 0x53, 0x70,
+	0xec, 0x3a, 0x54, 0xe6, 0x33, 0xec, 0xe5, 0x03, 0xdb, 0xaf, 0x5f, 0x92, 0x0c, 0x0b, 0xd4, 0x5e,
+	0x19, 0x8a, 0x34, 0xc9, 0xe6, 0x35, 0xd0, 0x8f, 0xec, 0xc8, 0x9e, 0x72, 0x77, 0xcc, 0x0c, 0xc8,
+	0xcf, 0xc2, 0x58, 0x2e, 0x4d, 0x6c, 0x9a, 0x6d, 0x28, 0x3d, 0xb0, 0x23, 0xa4, 0x31, 0x28, 0x04,
+	0xf6, 0xd4, 0x25, 0x62, 0x85, 0x53, 0x1b, 0x57, 0x45, 0x7c, 0x1e, 0x27, 0xee, 0x54, 0xae, 0x5b,
+	0x09, 0x21, 0x7e, 0xe2, 0x87, 0x43, 0xb9, 0x02, 0x74, 0x2e, 0x21, 0xb3, 0x03, 0xa5, 0x66, 0xe8,
+	0xa3, 0xb6, 0x17, 0xa1, 0x1c, 0xb9, 0xfe, 0x60, 0xf1, 0xb5, 0x52, 0xe4, 0xfa, 0x47, 0x61, 0x8c,
+	0x84, 0x51, 0x28, 0x08, 0x39, 0x41, 0x18, 0x85, 0x44, 0x48, 0xbf, 0x9f, 0x5f, 0x7c, 0xdf, 0xec,
+	0x03, 0x34, 0xc3, 0x28, 0xfa, 0xda, 0x3a, 0xaf, 0x40, 0xd1, 0x71, 0x67, 0x8b, 0xdd, 0x45, 0x80,
+	0x79, 0x1b, 0x74, 0xeb, 0x6c, 0x16, 0xb5, 0xbd, 0x38, 0x61, 0xd7, 0xa1, 0xe0, 0x7b, 0x71, 0x52,
+	0xd7, 0xb6, 0xf3, 0xb7, 0xaa, 0xbb, 0x20, 0xe6, 0x16, 0xa9, 0x9c, 0xf0, 0xe6, 0x36, 0xe8, 0xf7,
+	0xed, 0xb3, 0x07, 0x38, 0x92, 0xec, 0x8a, 0x1c, 0x52, 0x39, 0x44, 0x72, 0x7c, 0x6f, 0x03, 0xf4,
+	0xed, 0x68, 0xe2, 0x26, 0xb4, 0xf7, 0xaf, 0x41, 0x3e, 0x39, 0x9f, 0x11, 0x47, 0xa6, 0x0e, 0x09,
+	0x1c, 0xd1, 0xe6, 0xff, 0x68, 0x50, 0xed, 0xcd, 0x87, 0x9f, 0xcf, 0xdd, 0xe8, 0x1c, 0x7b, 0x74,
+	0x6b, 0xc1, 0xbd, 0xb5, 0xfb, 0x82, 0xe0, 0x56, 0xe8, 0x0b, 0x49, 0xec, 0x62, 0x10, 0x3a, 0xee,
+	0xc0, 0x73, 0xd2, 0x2e, 0x22, 0xd8, 0x72, 0xd0, 0xd9, 0x84, 0x33, 0x39, 0x68, 0xb9, 0x70, 0xc6,
+	0xb6, 0xa1, 0x38, 0x3a, 0xf1, 0x7c, 0xa7, 0x5e, 0x50, 0x4d, 0xa0, 0x1e, 0x09, 0x02, 0x7b, 0x09,
+	0xf4, 0x28, 0x3c, 0x1d, 0x28, 0x2e, 0xa4, 0x1c, 0x85, 0xa7, 0x3d, 0xef, 0x0b, 0x1c, 0x6f, 0xe1,
+	0xc1, 0x00, 0x4a, 0xbd, 0x66, 0xa3, 0xdd, 0xe0, 0xc6, 0x06, 0xb6, 0xad, 0x4f, 0x5a, 0xbd, 0x7e,
+	0xcf, 0xd0, 0xd8, 0x16, 0x40, 0xa7, 0xdb, 0x1f, 0x48, 0x38, 0xc7, 0x4a, 0x90, 0x6b, 0x75, 0x8c,
+	0x3c, 0xf2, 0x20, 0xbe, 0xd5, 0x31, 0x0a, 0xac, 0x0c, 0xf9, 0x46, 0xe7, 0x53, 0xa3, 0x48, 0x8d,
+	0x76, 0xdb, 0x28, 0x99, 0xff, 0xac, 0x41, 0xa5, 0x3b, 0x7c, 0xe4, 0x8e, 0x12, 0xec, 0x33, 0xae,
+	0x29, 0x37, 0x7a, 0xe2, 0x46, 0xd4, 0xed, 0x3c, 0x97, 0x10, 0x76, 0xc4, 0x19, 0x0a, 0x3f, 0xc3,
+	0x73, 0xce, 0x90, 0xf8, 0x46, 0x27, 0xee, 0xd4, 0xae, 0xe7, 0x25, 0x1f, 0x41, 0xb8, 0x86, 0xc3,
+	0xe1, 0x23, 0xea, 0x5e, 0x9e, 0x63, 0x93, 0x7d, 0x03, 0xaa, 0x42, 0xc7, 0x80, 0x16, 0x50, 0x51,
+	0xb8, 0x39, 0x81, 0xea, 0xe0, 0x32, 0x7e, 0x11, 0xca, 0xce, 0x50, 0x10, 0x4b, 0x44, 0x2c, 0x39,
+	0x43, 0x22, 0xa0, 0x24, 0x69, 0x15, 0x44, 0xe9, 0x20, 0x05, 0x8a, 0x18, 0x5e, 0x02, 0x3d, 0x1c,
+	0x3e, 0x12, 0x54, 0x9d, 0xa8, 0xe5, 0x70, 0xf8, 0x08, 0x49, 0xe6, 0x2f, 0x35, 0xd0, 0xef, 0xce,
+	0x83, 0x51, 0x82, 0x2e, 0xf9, 0x35, 0x28, 0x8c, 0xe7, 0xc1, 0xa8, 0xae, 0xa9, 0xae, 0x25, 0xeb,
+	0x33, 0x27, 0x22, 0xae, 0x35, 0x3b, 0x9a, 0xe0, 0x1a, 0x5d, 0x59, 0x6b, 0x88, 0x37, 0xff, 0x50,
+	0x6a, 0xbc, 0xeb, 0xdb, 0x13, 0xa6, 0x43, 0xa1, 0xd3, 0xed, 0x58, 0xc6, 0x06, 0xdb, 0x04, 0xbd,
+	0xd5, 0xe9, 0x5b, 0xbc, 0xd3, 0x68, 0x1b, 0x1a, 0x4d, 0x4d, 0xbf, 0xb1, 0xd7, 0xb6, 0x8c, 0x1c,
+	0x52, 0x1e, 0x74, 0xdb, 0x8d, 0x7e, 0xab, 0x6d, 0x19, 0x05, 0x41, 0xe1, 0xad, 0x66, 0xdf, 0xd0,
+	0x99, 0x01, 0x9b, 0x47, 0xbc, 0xbb, 0x7f, 0xdc, 0xb4, 0x06, 0x9d, 0xe3, 0x76, 0xdb, 0x30, 0xd8,
+	0x73, 0x70, 0x29, 0xc3, 0x74, 0x05, 0x72, 0x1b, 0x45, 0x1e, 0x34, 0x78, 0x83, 0x1f, 0x18, 0x3f,
+	0x64, 0x3a, 0xe4, 0x1b, 0x07, 0x07, 0xc6, 0x4f, 0x35, 0x6c, 0x3d, 0x6c, 0x75, 0x8c, 0x9f, 0xe6,
+	0xcc, 0xdf, 0xc9, 0x43, 0x01, 0x0d, 0x7c, 0xf6, 0xb2, 0x66, 0x2f, 0x83, 0x36, 0xa2, 0x99, 0xab,
+	0xee, 0x56, 0x05, 0x8d, 0x82, 0xca, 0xe1, 0x06, 0xd7, 0xb0, 0xd7, 0x9a, 0x58, 0x9f, 0xd5, 0xdd,
+	0x2d, 0x41, 0x4c, 0xdd, 0x11, 0xd2, 0x67, 0xec, 0x1a, 0x68, 0x4f, 0xe4, 0x62, 0xdd, 0x14, 0x74,
+	0xe1, 0x90, 0x90, 0xfa, 0x84, 0x6d, 0x43, 0x7e, 0x14, 0x8a, 0xe0, 0x91, 0xd1, 0x85, 0x3b, 0x38,
+	0xdc, 0xe0, 0x48, 0x42, 0xfd, 0xe3, 0x7a, 0x49, 0xd5, 0x9f, 0xce, 0x0a, 0x6a, 0x18, 0xb3, 0xd7,
+	0x21, 0x1f, 0xcf, 0x87, 0x34, 0xb7, 0xd5, 0xdd, 0xcb, 0x2b, 0x7b, 0x0c, 0xd5, 0xc4, 0xf3, 0x21,
+	0xbb, 0x09, 0x85, 0x51, 0x18, 0x45, 0x75, 0x5d, 0x75, 0xf2, 0x0b, 0xe7, 0x83, 0xc1, 0x08, 0xe9,
+	0x6c, 0x1b, 0xb4, 0xa4, 0x5e, 0x51, 0x99, 0x16, 0xbb, 0x1f, 0x3f, 0x98, 0xb0, 0x1b, 0xd2, 0xa5,
+	0x80, 0x6a, 0x53, 0xea, 0x70, 0x50, 0x0f, 0x52, 0x99, 0x09, 0xf9, 0xa9, 0x7d, 0x56, 0xaf, 0xaa,
+	0x4c, 0xa9, 0xa7, 0x41, 0x9b, 0xa6, 0xf6, 0xd9, 0x5e, 0x09, 0x0a, 0xee, 0xd9, 0x2c, 0x32, 0x5f,
+	0x82, 0x4a, 0x16, 0x99, 0xd8, 0x26, 0x68, 0xb6, 0xdc, 0x3a, 0x9a, 0x6d, 0xde, 0x02, 0x90, 0xa4,
+	0xf7, 0x76, 0x3f, 0x5c, 0xa6, 0x21, 0x94, 0x6e, 0x28, 0x6d, 0x68, 0xfe, 0x5d, 0x8e, 0x9c, 0xf3,
+	0xfe, 0x53, 0x5c, 0xfd, 0x0d, 0xc8, 0xdb, 0xfe, 0x84, 0xd8, 0xb7, 0x76, 0x59, 0xda, 0xfd, 0xe9,
+	0x2c, 0x72, 0xe3, 0x58, 0xcc, 0xb4, 0xed, 0x4f, 0xd2, 0x75, 0x90, 0x5f, 0xbf, 0x0e, 0xde, 0x80,
+	0xb2, 0x8c, 0x50, 0x72, 0x42, 0x6b, 0x82, 0x63, 0x5f, 0x20, 0x79, 0x4a, 0x65, 0x75, 0x28, 0xcf,
+	0x22, 0x6f, 0x6a, 0x47, 0xe7, 0x22, 0x2d, 0xe0, 0x29, 0xc8, 0x5e, 0x87, 0x2d, 0x7b, 0x9e, 0x84,
+	0x03, 0x2f, 0x18, 0x45, 0xee, 0xd4, 0x0d, 0x12, 0x9a, 0x5a, 0x9d, 0xd7, 0x10, 0xdb, 0x4a, 0x91,
+	0xe8, 0x8a, 0x67, 0x8f, 0x3d, 0xe7, 0x8c, 0xa6, 0xb5, 0xc8, 0x05, 0x80, 0x6a, 0x47, 0xe1, 0x94,
+	0xa4, 0xe4, 0x66, 0x95, 0x20, 0xee, 0x63, 0x2f, 0x1e, 0x8c, 0x8e, 0x1e, 0xbb, 0xe7, 0x34, 0x79,
+	0x3a, 0x2f, 0x7b, 0x71, 0x13, 0x41, 0xf6, 0x06, 0x54, 0xc2, 0x60, 0x20, 0x02, 0x67, 0x1d, 0xd4,
+	0x8e, 0xd1, 0xd6, 0xd4, 0xc3, 0xe0, 0x98, 0x68, 0xe6, 0xe7, 0x50, 0x96, 0x1d, 0x61, 0xaf, 0xc2,
+	0x26, 0x66, 0x47, 0x03, 0x7b, 0xe8, 0xf9, 0x5e, 0x72, 0x2e, 0x73, 0xa6, 0x2a, 0xe2, 0x1a, 0x02,
+	0xc5, 0xae, 0x8b, 0xb9, 0xab, 0xe7, 0x56, 0x34, 0x12, 0x9e, 0xbd, 0x06, 0xb5, 0x30, 0xf2, 0x26,
+	0x5e, 0x30, 0x88, 0x93, 0xc8, 0x0b, 0x26, 0xd2, 0x85, 0x6f, 0x0a, 0x64, 0x8f, 0x70, 0xe6, 0x7f,
+	0x6a, 0xa0, 0xb7, 0x02, 0xc7, 0x3d, 0xc3, 0x59, 0xbb, 0xad, 0x06, 0x8b, 0xba, 0x50, 0x98, 0x12,
+	0x45, 0x63, 0x31, 0x13, 0xe9, 0x0c, 0xe7, 0x94, 0x19, 0x7e, 0x19, 0x2a, 0x18, 0x25, 0xb1, 0x1d,
+	0xd7, 0xf3, 0xdb, 0xf9, 0x5b, 0x15, 0xae, 0x8f, 0x42, 0x1f, 0x9d, 0x59, 0x8c, 0xde, 0x76, 0x1e,
+	0x78, 0x9f, 0xcf, 0x5d, 0x9a, 0x39, 0x9d, 0x4b, 0x88, 0xdd, 0x02, 0xc3, 0x43, 0xd5, 0x83, 0x04,
+	0xd3, 0x55, 0xd5, 0xc1, 0x6e, 0x11, 0xbe, 0x8f, 0x68, 0xf2, 0x87, 0xdf, 0x83, 0x4a, 0x66, 0x04,
+	0xab, 0x42, 0xb9, 0xd5, 0x79, 0xd0, 0x68, 0xb5, 0xf7, 0x8d, 0x0d, 0x04, 0x3e, 0xeb, 0x76, 0xac,
+	0xfb, 0x8d, 0x23, 0x43, 0xc3, 0xa8, 0xb0, 0xd7, 0x6b, 0x19, 0x39, 0x56, 0x83, 0x4a, 0xcf, 0x6a,
+	0x76, 0x3b, 0xfb, 0x0d, 0xfe, 0xa9, 0x91, 0x37, 0x5f, 0x87, 0xda, 0x91, 0x58, 0x03, 0xf7, 0xdc,
+	0x73, 0xec, 0xee, 0x15, 0x28, 0x0a, 0x53, 0x35, 0x32, 0x55, 0x00, 0xe6, 0x2e, 0xe8, 0x47, 0x51,
+	0x38, 0x73, 0xa3, 0xe4, 0x1c, 0x23, 0x01, 0xce, 0xa7, 0x58, 0xc5, 0xd8, 0x5c, 0x44, 0xe8, 0x9c,
+	0x1a, 0xa1, 0x7f, 0x00, 0x35, 0x29, 0xe3, 0xb9, 0x31, 0xaa, 0xde, 0x01, 0x98, 0x65, 0x08, 0x19,
+	0xfa, 0x53, 0xdf, 0x24, 0x95, 0x73, 0x85, 0xc3, 0xfc, 0x09, 0xe8, 0xcd, 0x13, 0x77, 0xf4, 0xf8,
+	0x69, 0x7b, 0x87, 0x62, 0xae, 0x3b, 0x7a, 0xbc, 0x66, 0xb2, 0x05, 0x81, 0xc6, 0x1e, 0x1b, 0x38,
+	0xd9, 0x72, 0xa6, 0x75, 0x42, 0xf4, 0x92, 0xc8, 0xfc, 0x79, 0x0e, 0x6a, 0x77, 0xc3, 0xc8, 0xf5,
+	0x26, 0x81, 0xec, 0xfb, 0xba, 0x8f, 0x30, 0x74, 0x50, 0xbe, 0x88, 0x1e, 0x15, 0x4e, 0x6d, 0x8c,
+	0x5f, 0x63, 0x21, 0x38, 0x48, 0x86, 0xbe, 0x54, 0x0c, 0x12, 0xd5, 0x1f, 0xfa, 0xb8, 0x50, 0x53,
+	0x06, 0x12, 0x2e, 0x90, 0x70, 0x2a, 0xd4, 0x44, 0x1d, 0x1f, 0xd1, 0xfa, 0x77, 0x5c, 0xdf, 0x4d,
+	0xc4, 0xd4, 0x6e, 0xed, 0xbe, 0x22, 0xfd, 0xa8, 0x6a, 0xd3, 0x0e, 0x77, 0xc7, 0x0d, 0x72, 0xab,
+	0xb8, 0x25, 0xf6, 0x89, 0x9d, 0x7d, 0xa4, 0xee, 0x9d, 0xd2, 0x57, 0x94, 0x95, 0xdb, 0xa9, 0x09,
+	0x95, 0x0c, 0x8d, 0x91, 0x8c, 0x5b, 0x32, 0x7a, 0xd1, 0x82, 0x69, 0x36, 0x7a, 0xcd, 0xc6, 0xbe,
+	0x65, 0x68, 0x48, 0xea, 0x59, 0x7d, 0x11, 0xb1, 0x68, 0xd5, 0x74, 0xba, 0x83, 0x46, 0xb3, 0xdf,
+	0xea, 0x76, 0x8c, 0xbc, 0xf9, 0xfb, 0x1a, 0xd4, 0x28, 0xd6, 0x44, 0xb6, 0x17, 0x24, 0x38, 0x74,
+	0x37, 0xa1, 0x44, 0x03, 0x7b, 0x61, 0x5e, 0xd3, 0xf9, 0xe3, 0x92, 0xca, 0xde, 0x84, 0xe2, 0xf8,
+	0xb1, 0x7b, 0x9e, 0x46, 0xe3, 0xe7, 0xd6, 0x98, 0xcd, 0x05, 0x07, 0xbb, 0x01, 0x5b, 0x91, 0x3b,
+	0x1e, 0x50, 0xf6, 0x84, 0xe3, 0x9c, 0xee, 0x9e, 0xcd, 0xc8, 0x1d, 0x37, 0x11, 0xd9, 0x1f, 0xfa,
+	0xb1, 0xf9, 0x65, 0x1e, 0x6a, 0x47, 0x76, 0x94, 0x78, 0xd8, 0xa1, 0x56, 0x30, 0x0e, 0xd9, 0x1b,
+	0x50, 0x48, 0xce, 0x67, 0xae, 0xdc, 0xb1, 0xcf, 0x65, 0xc1, 0x4f, 0xb0, 0xd0, 0x66, 0x25, 0x06,
+	0xf4, 0x15, 0xd6, 0x53, 0x7c, 0x05, 0xfe, 0xb2, 0x77, 0xe1, 0xb9, 0x59, 0x2a, 0x86, 0x08, 0x37,
+	0xa6, 0x83, 0xa1, 0x98, 0xee, 0x75, 0x24, 0x76, 0x03, 0xca, 0xcd, 0xd0, 0x9f, 0x4f, 0x03, 0x31,
+	0xe5, 0xcb, 0x4a, 0x53, 0x12, 0xbb, 0x0d, 0x46, 0x26, 0x9c, 0xb2, 0x17, 0xa9, 0x6b, 0x2b, 0x78,
+	0x66, 0xc2, 0x66, 0x86, 0xeb, 0xcc, 0xa7, 0xe2, 0xe0, 0xc6, 0x97, 0x70, 0xec, 0x0e, 0x40, 0x06,
+	0xe3, 0x71, 0x53, 0x19, 0xd8, 0xc5, 0xc8, 0x24, 0xee, 0x94, 0x2b, 0x6c, 0x78, 0xd6, 0xb5, 0xfd,
+	0x49, 0x18, 0x79, 0xc9, 0xc9, 0x94, 0xdc, 0x76, 0x9e, 0x2f, 0x10, 0xec, 0x26, 0x6c, 0x79, 0x71,
+	0x6f, 0x3e, 0xcc, 0xe4, 0xa5, 0xfb, 0xbe, 0x80, 0x45, 0x77, 0x9a, 0xe9, 0x1c, 0x4c, 0xe3, 0x09,
+	0x79, 0xf2, 0x8a, 0x62, 0xdf, 0xfd, 0x78, 0x62, 0xfe, 0x97, 0xa6, 0x4e, 0x11, 0x1e, 0x64, 0x6e,
+	0x28, 0x62, 0x9d, 0xc5, 0x8e, 0x5b, 0x46, 0xb2, 0x5b, 0x70, 0x29, 0x8c, 0x1c, 0x2f, 0xb0, 0xf1,
+	0x50, 0x21, 0xac, 0xc0, 0xa9, 0xaa, 0xf1, 0x8b, 0x68, 0xb6, 0x0d, 0x55, 0xc7, 0x8d, 0x47, 0x91,
+	0x37, 0x4b, 0x16, 0x33, 0xa4, 0xa2, 0xd4, 0x18, 0x55, 0x58, 0x8e, 0x51, 0x37, 0x41, 0xf7, 0x31,
+	0xd8, 0x9e, 0xd8, 0x41, 0xbd, 0xb8, 0x32, 0x69, 0x19, 0x0d, 0xf9, 0xbc, 0xe0, 0x81, 0x38, 0xd2,
+	0x97, 0x56, 0xf9, 0x52, 0x9a, 0xf9, 0x0a, 0x94, 0x1f, 0x78, 0xee, 0xa9, 0xf4, 0x27, 0x4f, 0x3c,
+	0xf7, 0x34, 0xf5, 0x27, 0xd8, 0x36, 0x7f, 0x51, 0x00, 0x9d, 0xbc, 0xf7, 0xd3, 0xbd, 0xda, 0xc2,
+	0xe1, 0xa8, 0xb9, 0x17, 0xee, 0x0c, 0xa2, 0xb0, 0xdb, 0x50, 0x70, 0xdc, 0xb1, 0xd8, 0x0e, 0xd5,
+	0xf4, 0xfc, 0x92, 0xea, 0xc4, 0xa8, 0x2f, 0xd6, 0x38, 0xf2, 0xb0, 0x57, 0x00, 0x44, 0x08, 0xa1,
+	0x2d, 0x21, 0xba, 0x5e, 0x21, 0x8c, 0x3c, 0x37, 0x55, 0x46, 0x91, 0x6b, 0x27, 0x6e, 0xfc, 0xb9,
+	0x2f, 0x03, 0xcc, 0x02, 0xc1, 0x0e, 0x61, 0x0b, 0x4d, 0xda, 0xc5, 0xf8, 0x45, 0x61, 0x47, 0x76,
+	0xfc, 0xd5, 0x0b, 0x9f, 0xec, 0x48, 0x26, 0x0a, 0x44, 0x56, 0x90, 0x44, 0xe7, 0xbc, 0x16, 0xa8,
+	0xb8, 0xab, 0x3f, 0xcb, 0x51, 0x14, 0xa7, 0x6f, 0xbe, 0x0e, 0xb9, 0xd9, 0x63, 0x99, 0xd3, 0xa6,
+	0xcb, 0x54, 0x0d, 0x41, 0x87, 0x1b, 0x3c, 0x37, 0x7b, 0x8c, 0x99, 0x1a, 0x66, 0x1a, 0x39, 0x35,
+	0x53, 0x4b, 0xe3, 0x2e, 0x66, 0x6a, 0x98, 0x79, 0x7c, 0x6b, 0x29, 0xa2, 0xe4, 0x97, 0x55, 0x2a,
+	0xa1, 0x07, 0x0f, 0xf1, 0x0b, 0x46, 0x3c, 0x36, 0xd0, 0xbc, 0x2c, 0x65, 0x4b, 0x72, 0xd2, 0x30,
+	0x53, 0x44, 0x22, 0xbb, 0x03, 0x95, 0x6c, 0x39, 0xd6, 0x8b, 0x4b, 0xaa, 0x55, 0x77, 0x83, 0xc7,
+	0xff, 0x8c, 0x0f, 0x0d, 0x1a, 0x65, 0x7e, 0xb1, 0x5e, 0x52, 0xa5, 0x96, 0xfc, 0x25, 0x1a, 0xb4,
+	0x60, 0xdc, 0x2b, 0x42, 0xde, 0x71, 0xc7, 0x57, 0x7f, 0x08, 0x6c, 0x75, 0x28, 0x7f, 0x5d, 0xbc,
+	0x2d, 0xca, 0x78, 0xfb, 0x51, 0xee, 0x43, 0xcd, 0x8c, 0xa0, 0xd0, 0x0c, 0xe3, 0x84, 0xa2, 0x96,
+	0x1d, 0x89, 0x6a, 0x98, 0xc6, 0xa9, 0x8d, 0x5b, 0x20, 0x0a, 0x4f, 0xe9, 0xfc, 0x99, 0x23, 0x74,
+	0x0a, 0xe2, 0x17, 0x02, 0xe7, 0x89, 0x28, 0x2b, 0x71, 0x6c, 0xe2, 0x17, 0xe2, 0xc4, 0x8e, 0xc4,
+	0x66, 0xd1, 0xb8, 0x00, 0x10, 0x9b, 0x84, 0x89, 0x2c, 0x2a, 0x69, 0x5c, 0x00, 0x66, 0x17, 0x2e,
+	0x1d, 0x7a, 0x71, 0x12, 0x4e, 0x22, 0x7b, 0xba, 0x37, 0x1f, 0x3d, 0x76, 0x89, 0x71, 0x3e, 0x9b,
+	0xc9, 0xb3, 0xa6, 0xc6, 0x05, 0x80, 0xd8, 0x51, 0x38, 0x0f, 0x12, 0xf9, 0x79, 0x01, 0xac, 0x7e,
+	0xdc, 0xe4, 0x50, 0xc9, 0x14, 0xa2, 0x90, 0x1f, 0x9e, 0x2e, 0x54, 0x11, 0xc0, 0xde, 0x81, 0xf2,
+	0x90, 0x3e, 0x95, 0xee, 0x93, 0xe7, 0xc5, 0x20, 0x5f, 0x30, 0x84, 0xa7, 0x5c, 0xe6, 0xdf, 0x6b,
+	0x50, 0x15, 0x3e, 0xb5, 0x97, 0xd8, 0x49, 0x9c, 0x7e, 0x55, 0x5b, 0x74, 0xf9, 0x15, 0x00, 0x4a,
+	0x2e, 0x55, 0x13, 0x2b, 0x88, 0x69, 0x92, 0x99, 0x6f, 0x43, 0xe5, 0x24, 0x55, 0x5e, 0xcf, 0xab,
+	0xe7, 0xcd, 0xec, 0x9b, 0x7c, 0xc1, 0x81, 0x99, 0xc7, 0x89, 0x1d, 0x0f, 0x22, 0x3b, 0x98, 0xa4,
+	0xb9, 0x9d, 0x7e, 0x62, 0xc7, 0x1c, 0x61, 0x24, 0x4e, 0xbd, 0x60, 0x20, 0xe6, 0x50, 0x8c, 0xa5,
+	0x3e, 0x95, 0x0e, 0x84, 0x88, 0xf6, 0x99, 0x24, 0x96, 0x24, 0x51, 0x9e, 0x50, 0xcc, 0x3f, 0xd7,
+	0x00, 0x68, 0xdb, 0x89, 0x5e, 0xbc, 0x0c, 0x15, 0xac, 0x29, 0x08, 0x93, 0x45, 0x5f, 0xb0, 0xc8,
+	0x20, 0x2c, 0xde, 0x59, 0x72, 0x24, 0x57, 0x95, 0x3d, 0x4b, 0xc2, 0xe8, 0x53, 0x62, 0xb1, 0x59,
+	0x89, 0xef, 0xea, 0xc7, 0x50, 0xc9, 0x50, 0x6b, 0x16, 0xdd, 0x1b, 0xea, 0xa2, 0xcb, 0x8e, 0x74,
+	0xca, 0x98, 0xaa, 0xeb, 0xf0, 0xaf, 0x35, 0x8a, 0x84, 0xfb, 0x76, 0x62, 0xaf, 0x1a, 0x59, 0x54,
+	0x8c, 0x5c, 0x1d, 0xf5, 0xa2, 0x3a, 0xea, 0x98, 0x8d, 0xce, 0x7d, 0x19, 0xfa, 0x75, 0x2e, 0x00,
+	0x34, 0xce, 0xbb, 0xb3, 0x4b, 0x21, 0xb6, 0xc8, 0xb1, 0x49, 0x98, 0x0f, 0xde, 0x27, 0xff, 0x9d,
+	0xe7, 0xd8, 0x44, 0xcc, 0xf8, 0xce, 0x2e, 0x39, 0xac, 0x1c, 0xc7, 0x26, 0x61, 0x3e, 0x78, 0x9f,
+	0xe2, 0xa3, 0xc6, 0xb1, 0x89, 0x27, 0xb5, 0xb8, 0xae, 0x53, 0xe4, 0xd5, 0x62, 0xf3, 0x21, 0x00,
+	0x0f, 0x4f, 0x63, 0x37, 0x21, 0xab, 0x6f, 0x66, 0x75, 0x10, 0x4d, 0xf5, 0x40, 0xa9, 0xcf, 0xcb,
+	0xea, 0x22, 0xaf, 0x2e, 0x8d, 0x72, 0x6d, 0xe1, 0xae, 0xed, 0xc4, 0x16, 0x03, 0x6b, 0xfe, 0x42,
+	0x83, 0x6a, 0x37, 0x72, 0xdc, 0x68, 0xef, 0xbc, 0x37, 0x73, 0x47, 0xd9, 0x19, 0x45, 0x7b, 0xca,
+	0x19, 0xe5, 0x1a, 0x9d, 0x18, 0x7c, 0x3b, 0x8b, 0x78, 0x15, 0xbe, 0x40, 0xb0, 0xf7, 0xa0, 0x30,
+	0xf6, 0x6d, 0x71, 0x70, 0xc9, 0xf2, 0x3e, 0x45, 0x7d, 0xda, 0xc6, 0x72, 0x06, 0x27, 0x56, 0xf3,
+	0xc7, 0x50, 0x55, 0x90, 0x54, 0x21, 0xea, 0x35, 0x8d, 0x0d, 0x2c, 0x76, 0xec, 0x5b, 0xbd, 0xa6,
+	0xa1, 0xb1, 0x4b, 0x50, 0xc5, 0x4c, 0xaf, 0x37, 0xb8, 0xdb, 0xe2, 0xbd, 0xbe, 0x91, 0xa3, 0x92,
+	0x13, 0x21, 0xda, 0x8d, 0x5e, 0x5f, 0x54, 0x39, 0x8e, 0x3b, 0xad, 0x1f, 0x1d, 0x5b, 0x86, 0xbe,
+	0x54, 0x19, 0x31, 0xcc, 0xbf, 0xd5, 0x00, 0xee, 0x46, 0xf6, 0xd4, 0xdd, 0x0b, 0xe7, 0x81, 0x83,
+	0xab, 0x4e, 0xc9, 0xbe, 0xe4, 0xaa, 0x5b, 0xd0, 0x77, 0xe8, 0x57, 0x49, 0xc2, 0xae, 0x41, 0x65,
+	0x1e, 0x0c, 0x11, 0xe9, 0x3a, 0xb2, 0xdc, 0xb9, 0x40, 0xe0, 0xc1, 0x37, 0x2d, 0x78, 0x2f, 0x8f,
+	0x14, 0xa2, 0xcd, 0x8f, 0xa0, 0x92, 0xa9, 0xc3, 0x14, 0xf5, 0x6e, 0xb7, 0xdd, 0xee, 0x3e, 0x6c,
+	0x75, 0x0e, 0x8c, 0x0d, 0x04, 0x8f, 0xb8, 0xd5, 0xb4, 0xf6, 0x11, 0xa4, 0x0e, 0x36, 0x8f, 0x39,
+	0xb7, 0x3a, 0xfd, 0x01, 0xef, 0x3e, 0x34, 0x72, 0xe6, 0x5f, 0x6a, 0x50, 0x25, 0xb3, 0x9a, 0xbe,
+	0x3d, 0x8f, 0x5d, 0xf6, 0xce, 0x92, 0xdd, 0x2f, 0x2b, 0x76, 0x0b, 0x06, 0xd1, 0x56, 0x0c, 0xbf,
+	0x99, 0xba, 0xc8, 0x9c, 0x5a, 0x95, 0x58, 0xf4, 0x34, 0x75, 0x9a, 0x26, 0xe4, 0xdd, 0xc0, 0xa9,
+	0xe7, 0x9f, 0xc2, 0x85, 0x44, 0x73, 0x1b, 0x2a, 0x99, 0x7a, 0x9c, 0x15, 0xde, 0x7d, 0xd8, 0x33,
+	0x36, 0x58, 0x05, 0x8a, 0xbc, 0xd1, 0x39, 0xb0, 0x0c, 0xcd, 0xfc, 0x0f, 0x0d, 0xe0, 0xa1, 0x17,
+	0x38, 0xe1, 0x29, 0x2d, 0xa1, 0xb7, 0x95, 0xb4, 0x70, 0x30, 0x3c, 0x5f, 0x53, 0x47, 0xad, 0x66,
+	0xf4, 0xbd, 0x73, 0xf6, 0x4d, 0xd0, 0x43, 0x5c, 0x00, 0xc8, 0x2a, 0x16, 0xea, 0xe5, 0x95, 0x75,
+	0xc3, 0xcb, 0xa1, 0x00, 0x30, 0x78, 0xf8, 0xae, 0xed, 0xc8, 0xea, 0x2d, 0xb5, 0x71, 0xf3, 0xe0,
+	0xa2, 0x13, 0x97, 0x22, 0xd8, 0x64, 0x6f, 0x41, 0xf5, 0x94, 0x0c, 0x1a, 0x50, 0x09, 0xae, 0xb8,
+	0x32, 0x45, 0x20, 0xc8, 0x58, 0x16, 0x42, 0xe7, 0x31, 0x8e, 0xd2, 0x42, 0x60, 0xf6, 0x75, 0x65,
+	0x78, 0xb9, 0xa0, 0x9b, 0xbf, 0x0d, 0x95, 0x8f, 0xe3, 0x30, 0xa0, 0x6d, 0x86, 0xb3, 0xef, 0x84,
+	0xa3, 0x35, 0xfb, 0x04, 0xd1, 0x68, 0xe6, 0xcc, 0x4e, 0x4e, 0xd2, 0xc3, 0x36, 0xb6, 0xd9, 0x9b,
+	0x72, 0x37, 0xe6, 0xd5, 0xa0, 0x90, 0x29, 0x14, 0xce, 0x4a, 0x66, 0x51, 0x57, 0xa0, 0x18, 0xce,
+	0x13, 0x37, 0x92, 0xde, 0x59, 0x00, 0xe6, 0xcf, 0x35, 0xb8, 0x74, 0x81, 0x7f, 0x6d, 0x96, 0xb6,
+	0x03, 0x85, 0xc7, 0x5e, 0xe0, 0xd4, 0x73, 0xea, 0x32, 0xbf, 0x20, 0xb8, 0x73, 0xcf, 0x0b, 0x1c,
+	0x4e, 0x7c, 0x99, 0xb1, 0x79, 0xc5, 0xd8, 0xd4, 0x0f, 0x14, 0xd6, 0xfb, 0x01, 0xf3, 0x6d, 0x28,
+	0xa0, 0x06, 0x5c, 0x06, 0x0f, 0x1a, 0xed, 0x63, 0x2c, 0x4a, 0x6e, 0x01, 0x74, 0xf9, 0x7e, 0xab,
+	0xd3, 0x68, 0xb7, 0xfa, 0x9f, 0x8a, 0xb2, 0x64, 0x5a, 0x15, 0x36, 0xff, 0x21, 0x07, 0x15, 0x71,
+	0xc8, 0x6b, 0x26, 0x67, 0x6a, 0xf1, 0x55, 0x5b, 0x2a, 0xbe, 0xbe, 0x04, 0x7a, 0x32, 0x14, 0xf5,
+	0x08, 0x39, 0x74, 0xe5, 0x64, 0xe8, 0xa7, 0x05, 0xdb, 0x59, 0xe4, 0x0d, 0xd0, 0xf1, 0x0b, 0x3b,
+	0x4b, 0xb3, 0xc8, 0xbb, 0xe7, 0x62, 0x55, 0xa5, 0x2a, 0x09, 0x03, 0xcc, 0xc9, 0xb2, 0xab, 0x31,
+	0x24, 0xb6, 0x9c, 0x33, 0xd4, 0x79, 0xe2, 0x39, 0x2e, 0x49, 0x8a, 0x2c, 0xb2, 0x8c, 0x30, 0x8a,
+	0x6e, 0xc3, 0x66, 0x4a, 0x22, 0x59, 0x71, 0x51, 0x06, 0x92, 0x8c, 0xc2, 0x6f, 0x43, 0x55, 0x1c,
+	0x65, 0xc5, 0x59, 0xb9, 0xbc, 0x26, 0xef, 0x05, 0xc1, 0xd0, 0x94, 0x87, 0xef, 0x30, 0x39, 0x71,
+	0xa3, 0x81, 0x9d, 0x24, 0x51, 0xea, 0xbe, 0x81, 0x50, 0x0d, 0xc4, 0x10, 0x43, 0xe4, 0x64, 0x0c,
+	0x15, 0xc9, 0x10, 0x39, 0x0a, 0x83, 0x28, 0xae, 0x08, 0x06, 0x10, 0x0c, 0x84, 0x22, 0x06, 0xf3,
+	0x7f, 0x35, 0xa8, 0x36, 0x02, 0xdb, 0x3f, 0xff, 0xc2, 0xa5, 0x13, 0xe5, 0x2b, 0x00, 0x5e, 0x30,
+	0x9b, 0x27, 0x03, 0x4c, 0x98, 0x64, 0xa1, 0xaf, 0x42, 0x18, 0x0c, 0x18, 0xf4, 0xc1, 0x79, 0x92,
+	0xd1, 0x45, 0xe9, 0x0f, 0x04, 0x8a, 0x18, 0x32, 0x79, 0x4a, 0xbe, 0xf2, 0x8a, 0x3c, 0x96, 0xff,
+	0x15, 0x79, 0xa2, 0x17, 0x54, 0x79, 0x62, 0x78, 0x0d, 0x6a, 0x78, 0x85, 0x35, 0xc0, 0x8c, 0x71,
+	0x3e, 0x75, 0x1d, 0x1a, 0xe3, 0xbc, 0xb8, 0xd7, 0x6a, 0x4a, 0x1c, 0x6a, 0x99, 0xba, 0xd3, 0x30,
+	0x3a, 0x17, 0x5a, 0x4a, 0x42, 0x8b, 0x40, 0xa5, 0x5a, 0x66, 0xd1, 0x3c, 0x70, 0x9d, 0xc1, 0xd0,
+	0x0f, 0xf1, 0xa4, 0x5e, 0x16, 0x5a, 0x04, 0x72, 0x8f, 0x70, 0xe6, 0xdf, 0x6c, 0x41, 0xa1, 0x13,
+	0x3a, 0x2e, 0x7b, 0x17, 0x2a, 0x74, 0xf3, 0xb1, 0x7a, 0x94, 0x46, 0x32, 0xfd, 0x90, 0x33, 0xd4,
+	0x03, 0xd9, 0x7a, 0xfa, 0x5d, 0xc9, 0x75, 0xdc, 0x94, 0x71, 0xb2, 0xec, 0xc5, 0x31, 0x4d, 0xe5,
+	0x84, 0x27, 0x67, 0x16, 0x85, 0x58, 0xb4, 0x1f, 0x50, 0x05, 0xb7, 0xb0, 0xc6, 0x99, 0x09, 0x3a,
+	0xdd, 0x1d, 0x5d, 0x05, 0x9d, 0x6a, 0x02, 0x91, 0x2b, 0x0e, 0x6c, 0x45, 0x9e, 0xc1, 0x68, 0xf5,
+	0xa3, 0xd0, 0x0b, 0x84, 0xd5, 0xa5, 0x15, 0xab, 0x3f, 0x0e, 0xbd, 0x80, 0xe2, 0xa2, 0x8e, 0x5c,
+	0x64, 0xf5, 0x6b, 0x50, 0x0e, 0x03, 0xf1, 0xdd, 0xf2, 0xca, 0x77, 0x4b, 0x61, 0x40, 0x9f, 0x7c,
+	0x0b, 0xaa, 0x63, 0xcf, 0x4f, 0xdc, 0x48, 0x30, 0xea, 0x2b, 0x8c, 0x20, 0xc8, 0xc4, 0xfc, 0x3a,
+	0xe8, 0x93, 0x28, 0x9c, 0xcf, 0xd0, 0xd9, 0x56, 0x56, 0x38, 0xcb, 0x44, 0xdb, 0x3b, 0xc7, 0x5e,
+	0x53, 0xd3, 0x0b, 0x26, 0x83, 0xd8, 0x4d, 0xea, 0xb0, 0xc2, 0x5a, 0x4d, 0xe9, 0x3d, 0x97, 0xb4,
+	0xda, 0x93, 0x89, 0xf8, 0x7e, 0x75, 0x55, 0xab, 0x3d, 0x99, 0xd0, 0xc7, 0x55, 0x4f, 0xbf, 0xf9,
+	0x6b, 0x3d, 0xfd, 0xbb, 0x8b, 0xad, 0x97, 0x9c, 0xc5, 0xf5, 0xda, 0x76, 0x7e, 0x91, 0xd6, 0x66,
+	0xae, 0x24, 0xdb, 0x7d, 0xc9, 0x59, 0xcc, 0xde, 0x02, 0xfd, 0x14, 0x8b, 0xa7, 0x33, 0x77, 0x54,
+	0xdf, 0x52, 0x43, 0xda, 0x22, 0x38, 0xf1, 0xf2, 0xa9, 0x17, 0x60, 0x03, 0x0b, 0x74, 0xbe, 0x37,
+	0xf5, 0x12, 0xba, 0x28, 0xbd, 0x50, 0xa0, 0x23, 0x02, 0x33, 0xa1, 0x14, 0x8e, 0xc7, 0xd8, 0x7d,
+	0x63, 0x85, 0x45, 0x52, 0xd8, 0x5b, 0x20, 0x0e, 0xac, 0x03, 0xc7, 0x1d, 0xd7, 0x2f, 0xaf, 0x4d,
+	0xc6, 0xf4, 0x44, 0xb6, 0xd8, 0x2e, 0xd4, 0x32, 0xe6, 0xc1, 0x13, 0x77, 0x54, 0x67, 0xdb, 0xf9,
+	0x35, 0x02, 0xd5, 0x54, 0xe0, 0x81, 0x3b, 0x62, 0xb7, 0x00, 0x6f, 0x97, 0x06, 0x91, 0x3b, 0xae,
+	0x3f, 0xb7, 0xfe, 0x22, 0xa9, 0x14, 0x0e, 0x1f, 0xe1, 0x25, 0xda, 0x7b, 0x50, 0x8d, 0x28, 0x45,
+	0x1c, 0x38, 0x76, 0x62, 0xd7, 0xaf, 0xa8, 0x03, 0xb0, 0xc8, 0x1d, 0x39, 0x44, 0x59, 0x1b, 0x77,
+	0x9d, 0x7b, 0x96, 0x44, 0xf6, 0x20, 0x9c, 0x89, 0xfa, 0xcc, 0xf3, 0xa2, 0x42, 0x42, 0xc8, 0xae,
+	0xc0, 0xb1, 0xef, 0xc3, 0x25, 0x51, 0x09, 0x24, 0x03, 0xe3, 0x66, 0x72, 0x56, 0x7f, 0x81, 0xec,
+	0xbe, 0x92, 0x56, 0xf2, 0x33, 0x22, 0x4e, 0xc8, 0x45, 0x66, 0xac, 0x37, 0x0e, 0xbd, 0xc0, 0xc1,
+	0xa5, 0x94, 0xd8, 0x93, 0xb8, 0xfe, 0x22, 0x6d, 0x8b, 0xaa, 0xc4, 0xf5, 0xed, 0x49, 0xcc, 0xde,
+	0x87, 0x4d, 0x5b, 0xb8, 0xb4, 0x81, 0x17, 0x8c, 0xc3, 0x7a, 0x5d, 0x0d, 0xc4, 0x8a, 0xb3, 0xe3,
+	0x55, 0x7b, 0xd9, 0xf3, 0xc9, 0x20, 0x8f, 0xbe, 0xfb, 0x25, 0xe1, 0xf7, 0x05, 0x06, 0x5d, 0xf7,
+	0x0e, 0x08, 0xb7, 0x39, 0x88, 0x47, 0x76, 0x50, 0xbf, 0xaa, 0x0e, 0x1e, 0x1d, 0x60, 0x7b, 0x23,
+	0x3b, 0x40, 0x4f, 0x27, 0x9b, 0xc8, 0x8f, 0x57, 0xed, 0xa2, 0xaa, 0x5d, 0x7f, 0x59, 0xe5, 0xcf,
+	0x62, 0x27, 0xaf, 0x3c, 0x4a, 0x9b, 0xec, 0xdb, 0x50, 0x8b, 0xdc, 0xd1, 0x3c, 0x8a, 0xbd, 0x27,
+	0xb8, 0x44, 0xdd, 0xfa, 0x35, 0x12, 0x91, 0xf7, 0x24, 0x3c, 0x25, 0x35, 0x13, 0x17, 0xab, 0x82,
+	0x0b, 0xc8, 0xfc, 0x97, 0x3c, 0xe8, 0xa9, 0x8b, 0xc2, 0xba, 0xe6, 0x71, 0xe7, 0x5e, 0xa7, 0xfb,
+	0xb0, 0x23, 0x22, 0x28, 0x05, 0xd3, 0x41, 0xaf, 0xd9, 0xe8, 0x88, 0xbb, 0x55, 0xba, 0xd7, 0x13,
+	0x70, 0x8e, 0x5d, 0x86, 0xda, 0xdd, 0xe3, 0x0e, 0x15, 0x3a, 0x05, 0x2a, 0x8f, 0x28, 0xeb, 0x13,
+	0x91, 0xef, 0x0a, 0x54, 0x01, 0x51, 0xf7, 0x1b, 0x7d, 0x8b, 0xb7, 0x52, 0x54, 0x11, 0xbf, 0x72,
+	0xc4, 0xbb, 0x1f, 0x5b, 0xcd, 0xbe, 0x01, 0xec, 0x79, 0xb8, 0x9c, 0x89, 0xa4, 0xea, 0x8c, 0x2a,
+	0x66, 0xce, 0xa9, 0x98, 0x71, 0x05, 0x95, 0x70, 0xab, 0x79, 0xcc, 0x7b, 0xad, 0x07, 0xd6, 0xa0,
+	0xd9, 0xb7, 0x8c, 0xe7, 0x31, 0xf7, 0xeb, 0xb5, 0x3a, 0xf7, 0x8c, 0x17, 0xa8, 0x4e, 0xdf, 0xea,
+	0xdc, 0x13, 0xda, 0x5f, 0xa4, 0x9c, 0xfd, 0xe0, 0xc0, 0xb8, 0x8e, 0x2a, 0xf6, 0x5b, 0xbd, 0x7e,
+	0xab, 0xd3, 0xec, 0x1b, 0xdf, 0xc0, 0xf8, 0x7f, 0xb7, 0xd5, 0xee, 0x5b, 0xdc, 0xd8, 0x46, 0xd9,
+	0x8f, 0xbb, 0xad, 0x8e, 0xf1, 0x2a, 0x62, 0x7b, 0x8d, 0xfb, 0x47, 0x6d, 0xcb, 0x30, 0x49, 0x63,
+	0x97, 0xf7, 0x8d, 0xd7, 0x30, 0x8d, 0x38, 0xee, 0xa0, 0x1d, 0x37, 0x50, 0x39, 0x35, 0x07, 0x78,
+	0x53, 0xfc, 0xba, 0x92, 0xdc, 0xdf, 0xc4, 0xf6, 0xc3, 0x56, 0x67, 0xbf, 0xfb, 0xd0, 0x78, 0x03,
+	0xd9, 0xf6, 0x78, 0xb7, 0xb1, 0xdf, 0xc4, 0x33, 0xc0, 0x2d, 0x54, 0xd0, 0x3b, 0x6a, 0xb7, 0xfa,
+	0xc6, 0x9b, 0xc8, 0x75, 0xd0, 0xe8, 0x1f, 0x5a, 0xdc, 0xb8, 0x8d, 0xed, 0x46, 0xaf, 0x67, 0xf1,
+	0xbe, 0xb1, 0x8b, 0xed, 0x56, 0x87, 0xda, 0x77, 0x48, 0xeb, 0xd1, 0x7e, 0xa3, 0x6f, 0x19, 0xef,
+	0x63, 0x7b, 0xdf, 0x6a, 0x5b, 0x7d, 0xcb, 0xf8, 0x16, 0x6a, 0xa5, 0xe3, 0x43, 0x0f, 0x87, 0xea,
+	0x03, 0x1c, 0x85, 0x0c, 0x24, 0x7b, 0xbe, 0x8d, 0x1f, 0xba, 0xdf, 0xea, 0x1c, 0xf7, 0x8c, 0x0f,
+	0x91, 0x99, 0x9a, 0x44, 0xf9, 0x8e, 0xf9, 0x08, 0xf4, 0xd4, 0x87, 0x23, 0x57, 0xab, 0xd3, 0xb1,
+	0xb8, 0x38, 0xc8, 0xb4, 0xad, 0xbb, 0x7d, 0x43, 0x43, 0x24, 0x6f, 0x1d, 0x1c, 0xe2, 0x11, 0xa6,
+	0x02, 0xc5, 0xee, 0x31, 0x0e, 0x4d, 0x9e, 0x06, 0xc1, 0xba, 0xdf, 0x32, 0x0a, 0xd8, 0x6a, 0x74,
+	0xfa, 0x2d, 0xa3, 0x48, 0x83, 0xd4, 0xea, 0x1c, 0xb4, 0x2d, 0xa3, 0x84, 0xd8, 0xfb, 0x0d, 0x7e,
+	0xcf, 0x28, 0xa3, 0x50, 0xe3, 0xe8, 0xa8, 0xfd, 0xa9, 0xa1, 0x9b, 0xb7, 0xa0, 0xdc, 0x98, 0x4c,
+	0xee, 0x63, 0x30, 0xd4, 0xa1, 0x70, 0x17, 0x0b, 0xe1, 0x74, 0x2d, 0xbf, 0xd7, 0xed, 0xf7, 0xbb,
+	0xf7, 0xc5, 0x9d, 0x4a, 0xbf, 0x7b, 0x64, 0xe4, 0xcc, 0x73, 0xd8, 0x54, 0xd7, 0xe2, 0xda, 0x84,
+	0x71, 0xe9, 0x1a, 0x28, 0x77, 0xe1, 0x1a, 0xe8, 0x65, 0x3c, 0x04, 0x61, 0x2a, 0x6f, 0xfb, 0xe9,
+	0xdb, 0x0e, 0x9d, 0x10, 0x0d, 0xdf, 0x4f, 0x0b, 0x02, 0xe2, 0x45, 0x85, 0x48, 0x0e, 0xb0, 0x20,
+	0xb0, 0x8f, 0xb0, 0xf9, 0x07, 0x9a, 0xbc, 0xff, 0xa1, 0xfd, 0xf5, 0x16, 0x88, 0xcd, 0x46, 0xae,
+	0x52, 0x5b, 0x57, 0x39, 0xc3, 0x42, 0xa5, 0x68, 0x31, 0x13, 0x0a, 0x4a, 0x25, 0xfe, 0xc2, 0x85,
+	0x29, 0x27, 0xda, 0xc5, 0xc0, 0x97, 0x7f, 0x56, 0xe0, 0x33, 0xff, 0x5b, 0x83, 0xad, 0x65, 0x4f,
+	0x85, 0xf7, 0x5b, 0x22, 0xed, 0xbc, 0x90, 0x84, 0xd6, 0x21, 0x4d, 0x3a, 0x2f, 0xe6, 0xa0, 0x26,
+	0x6c, 0xce, 0x63, 0x57, 0xa8, 0xb9, 0x97, 0x25, 0xa2, 0x4b, 0x38, 0x2c, 0xf7, 0x8e, 0xec, 0xa0,
+	0x1f, 0xcd, 0x83, 0x11, 0xde, 0x80, 0x88, 0x04, 0x5e, 0x45, 0xe1, 0xa9, 0xd2, 0x8b, 0x0f, 0x45,
+	0x8e, 0x29, 0xef, 0x3a, 0x17, 0x88, 0x8b, 0x09, 0x60, 0xe9, 0x62, 0x02, 0xc8, 0x6e, 0xc2, 0x25,
+	0x85, 0x61, 0xb0, 0xb8, 0xf1, 0xac, 0x2d, 0x98, 0x5a, 0xce, 0x99, 0xf9, 0x47, 0x39, 0x28, 0xfe,
+	0x08, 0x6f, 0xb4, 0xd9, 0x07, 0x50, 0x89, 0x93, 0x69, 0xa2, 0xa6, 0x4b, 0x2f, 0x89, 0x61, 0x22,
+	0xfa, 0x0e, 0x56, 0x48, 0xe8, 0x0e, 0x55, 0x24, 0x4d, 0xc8, 0x8b, 0x2d, 0x51, 0x68, 0x73, 0x67,
+	0x62, 0x16, 0x8a, 0x5c, 0x00, 0x18, 0x38, 0x31, 0x77, 0x8a, 0x97, 0x07, 0x1c, 0x1d, 0x1a, 0x17,
+	0x04, 0x0c, 0x9c, 0x33, 0xbc, 0xcf, 0x5f, 0x77, 0xd1, 0x20, 0x29, 0x98, 0x28, 0x9d, 0xb8, 0x36,
+	0x46, 0x80, 0xf4, 0x7e, 0x21, 0x83, 0xcd, 0x87, 0x50, 0x5b, 0x32, 0x69, 0xd9, 0x49, 0xe2, 0xde,
+	0xb0, 0xda, 0xb8, 0x3f, 0x35, 0x65, 0x4b, 0xe7, 0x94, 0x6d, 0x9c, 0x57, 0xb6, 0x77, 0x81, 0x36,
+	0xac, 0xc5, 0x0f, 0x2c, 0xa3, 0x68, 0xfe, 0x59, 0x0e, 0x2e, 0xf7, 0x23, 0x3b, 0x88, 0x6d, 0x71,
+	0x8d, 0x11, 0x24, 0x51, 0xe8, 0xb3, 0x8f, 0x40, 0x4f, 0x46, 0xbe, 0x3a, 0x3a, 0xdf, 0x90, 0x11,
+	0xf9, 0x22, 0xeb, 0x4e, 0x7f, 0xe4, 0xd3, 0x18, 0x95, 0x13, 0xd1, 0x60, 0x6f, 0x43, 0x71, 0xe8,
+	0x4e, 0xbc, 0x40, 0x1e, 0xb4, 0x9f, 0xbf, 0x28, 0xb8, 0x87, 0xc4, 0xc3, 0x0d, 0x2e, 0xb8, 0xd8,
+	0xbb, 0x50, 0xc2, 0xd2, 0xbe, 0x97, 0xe6, 0x9b, 0x2f, 0xac, 0x7e, 0x08, 0xa9, 0x87, 0x1b, 0x5c,
+	0xf2, 0xb1, 0x0f, 0xf0, 0x65, 0x8e, 0xef, 0x0f, 0xed, 0xd1, 0x63, 0x79, 0x16, 0xab, 0x5f, 0x94,
+	0xe1, 0x92, 0x7e, 0xb8, 0xc1, 0x33, 0x5e, 0x73, 0x07, 0xca, 0xd2, 0x58, 0x1c, 0x80, 0x3d, 0xeb,
+	0xa0, 0x25, 0xc7, 0xae, 0xd9, 0xbd, 0x7f, 0xbf, 0xd5, 0x17, 0x97, 0x68, 0xbc, 0xdb, 0x6e, 0xef,
+	0x35, 0x9a, 0xf7, 0x8c, 0xdc, 0x9e, 0x0e, 0x25, 0x9b, 0xee, 0xdd, 0xcc, 0xdf, 0xd3, 0xe0, 0xd2,
+	0x85, 0x0e, 0xb0, 0x0f, 0xa1, 0x30, 0x0d, 0x9d, 0x74, 0x78, 0x6e, 0xac, 0xed, 0xa5, 0x02, 0xa3,
+	0x5f, 0xe2, 0x24, 0x61, 0x7e, 0x07, 0xb6, 0x96, 0xf1, 0xca, 0x2b, 0x96, 0x1a, 0x54, 0xb8, 0xd5,
+	0xd8, 0x1f, 0x74, 0x3b, 0xed, 0x4f, 0x45, 0xb4, 0x23, 0xf0, 0x21, 0x6f, 0xf5, 0x2d, 0x23, 0x67,
+	0xfe, 0x18, 0x8c, 0x8b, 0x03, 0xc3, 0x0e, 0xe0, 0xd2, 0x28, 0x9c, 0xce, 0x7c, 0x17, 0x71, 0xea,
+	0x94, 0x5d, 0x5f, 0x33, 0x92, 0x92, 0x8d, 0x66, 0x6c, 0x6b, 0xb4, 0x04, 0x9b, 0x3f, 0x01, 0xb6,
+	0x3a, 0x82, 0xbf, 0x39, 0xf5, 0xff, 0xaa, 0x41, 0xe1, 0xc8, 0xb7, 0xf1, 0x12, 0xaa, 0x48, 0xcf,
+	0x4a, 0xea, 0x9a, 0xfa, 0x16, 0x86, 0xf6, 0x1d, 0x2e, 0x0b, 0xa2, 0xb1, 0xb7, 0x20, 0x9f, 0x8c,
+	0x7c, 0xb9, 0x86, 0x5e, 0x7c, 0xca, 0xe2, 0xc3, 0x7b, 0x85, 0x64, 0xe4, 0xe3, 0x03, 0x31, 0xc7,
+	0x49, 0xcb, 0x4e, 0x69, 0x0e, 0x66, 0x27, 0xf6, 0xbe, 0x3b, 0xf6, 0x02, 0x4f, 0x3e, 0x72, 0x41,
+	0x16, 0x7c, 0xe6, 0xe2, 0x8c, 0xfc, 0x7a, 0x41, 0xcd, 0xa6, 0x90, 0x53, 0x51, 0xe8, 0x8c, 0x7c,
+	0x76, 0x13, 0xf2, 0x1e, 0xdd, 0xf2, 0x29, 0xe9, 0x4b, 0x2b, 0x88, 0xdd, 0x28, 0x11, 0xb7, 0x46,
+	0xc8, 0xe7, 0x05, 0x31, 0x3e, 0x3d, 0x41, 0x9a, 0xf9, 0x65, 0x0e, 0x36, 0x55, 0xfa, 0xd7, 0x3a,
+	0xce, 0xbf, 0x87, 0xa9, 0xe7, 0xcc, 0xf7, 0x46, 0x5e, 0x32, 0x50, 0xaa, 0x22, 0xcb, 0x47, 0xeb,
+	0xcd, 0x94, 0x85, 0x0e, 0xd7, 0x6f, 0x81, 0x38, 0x49, 0x2f, 0xae, 0xad, 0x2f, 0xf2, 0x57, 0x88,
+	0x9e, 0x9d, 0xc4, 0x95, 0x83, 0x76, 0x71, 0xe5, 0xa0, 0x7d, 0x93, 0x1e, 0x08, 0xd2, 0xfd, 0x66,
+	0x49, 0x55, 0x25, 0x90, 0x3c, 0x25, 0xb2, 0x3b, 0x40, 0x73, 0x8b, 0xb7, 0x79, 0xee, 0x60, 0x86,
+	0x45, 0x84, 0xf2, 0xb6, 0xb6, 0xf2, 0xe5, 0x5a, 0xc6, 0x83, 0x0f, 0x48, 0xcc, 0x6f, 0x42, 0x49,
+	0xc8, 0x33, 0x33, 0x6d, 0xad, 0x29, 0x83, 0x49, 0x8a, 0xf9, 0x7f, 0x39, 0xa8, 0x2a, 0xf3, 0xc2,
+	0xde, 0x07, 0xdd, 0x19, 0xf9, 0x6b, 0xdc, 0xb5, 0xc2, 0xb4, 0xb3, 0x9f, 0xba, 0x22, 0x47, 0x34,
+	0xd8, 0x77, 0xa0, 0x86, 0xc9, 0xff, 0x13, 0x3b, 0xf2, 0x28, 0xf7, 0xae, 0xe7, 0xd4, 0x09, 0xed,
+	0xb9, 0xc9, 0x83, 0x94, 0x82, 0xcf, 0x4e, 0x63, 0x05, 0x66, 0x6f, 0x62, 0x6d, 0xc5, 0x9d, 0xd9,
+	0x91, 0x2b, 0x97, 0x55, 0x2d, 0xbd, 0xa7, 0x22, 0x24, 0xbe, 0x42, 0x95, 0x74, 0x64, 0x75, 0xcf,
+	0xdc, 0xd1, 0x5c, 0x86, 0xb6, 0x8c, 0xd5, 0x12, 0x48, 0x64, 0x95, 0x74, 0xb6, 0x0b, 0xe0, 0xb8,
+	0xb6, 0xef, 0x87, 0x14, 0x08, 0x8b, 0xea, 0x79, 0x64, 0x3f, 0xc3, 0x8b, 0x27, 0xac, 0x29, 0x64,
+	0x4e, 0xa0, 0x2c, 0x3b, 0x86, 0xb9, 0x17, 0x5e, 0xf2, 0x3f, 0x68, 0xf0, 0x16, 0xe6, 0xc0, 0xb2,
+	0xe6, 0x78, 0xc0, 0x1b, 0x1d, 0xe9, 0xf9, 0xb9, 0xf5, 0xa0, 0x7b, 0x0f, 0xdf, 0xbc, 0x51, 0xa9,
+	0xb8, 0xf3, 0xa9, 0x91, 0x17, 0x79, 0xae, 0x75, 0xd4, 0xe0, 0xe8, 0xf8, 0xab, 0x50, 0xb6, 0x3e,
+	0xb1, 0x9a, 0xc7, 0x7d, 0xcb, 0x28, 0xa2, 0x73, 0xd9, 0xb7, 0x1a, 0xed, 0x76, 0xb7, 0x89, 0x51,
+	0xa1, 0xb4, 0x57, 0xc1, 0xe9, 0xa7, 0x91, 0x34, 0x7f, 0xb7, 0x02, 0x5b, 0xcb, 0x1b, 0x88, 0x7d,
+	0x1b, 0x74, 0xc7, 0x59, 0x9a, 0x81, 0x6b, 0xeb, 0x36, 0xda, 0xce, 0xbe, 0x93, 0x4e, 0x82, 0x68,
+	0xb0, 0x57, 0xd3, 0xed, 0x9e, 0x5b, 0xd9, 0xee, 0xe9, 0x66, 0xff, 0x01, 0x5c, 0x12, 0xb7, 0x98,
+	0x74, 0x4e, 0x1b, 0xda, 0xb1, 0xbb, 0xbc, 0x97, 0x9b, 0x44, 0xdc, 0x97, 0xb4, 0xc3, 0x0d, 0xbe,
+	0x35, 0x5a, 0xc2, 0xb0, 0xef, 0xc2, 0x96, 0x4d, 0x69, 0x4f, 0x26, 0x5f, 0x50, 0xef, 0xf2, 0x1a,
+	0x48, 0x53, 0xc4, 0x6b, 0xb6, 0x8a, 0xc0, 0x65, 0xe2, 0x44, 0xe1, 0x6c, 0x21, 0xbc, 0xb4, 0xef,
+	0xf7, 0xa3, 0x70, 0xa6, 0xc8, 0x6e, 0x3a, 0x0a, 0xcc, 0x3e, 0x80, 0x4d, 0x69, 0xb9, 0x38, 0x23,
+	0x2d, 0xd5, 0x4b, 0x85, 0xd9, 0x94, 0x5c, 0xe1, 0x63, 0xeb, 0xd1, 0x02, 0x64, 0x77, 0xa0, 0x2a,
+	0x0c, 0x16, 0x62, 0x65, 0x75, 0x25, 0x90, 0xb5, 0xa9, 0x14, 0xd8, 0x19, 0xc4, 0xde, 0x05, 0x20,
+	0x3b, 0x85, 0x8c, 0xae, 0x1e, 0xc7, 0xd0, 0xc8, 0x54, 0xa4, 0xe2, 0xa4, 0x80, 0x62, 0x9e, 0xb8,
+	0x0f, 0xae, 0xac, 0x9a, 0x47, 0x99, 0xe6, 0xc2, 0x3c, 0x02, 0x17, 0xe6, 0x09, 0x31, 0x58, 0x31,
+	0x2f, 0x95, 0x02, 0x3b, 0x83, 0x32, 0xf3, 0x84, 0x4c, 0xf5, 0xa2, 0x79, 0xa9, 0x48, 0xc5, 0x49,
+	0x01, 0x9c, 0xb6, 0x44, 0xa6, 0x80, 0xb2, 0x53, 0x9b, 0xea, 0xb4, 0xa5, 0xe9, 0x61, 0xda, 0xb1,
+	0x5a, 0xa2, 0x22, 0x50, 0x3a, 0x3e, 0x09, 0x4f, 0x95, 0xed, 0x5d, 0x53, 0xa5, 0x7b, 0x27, 0xe1,
+	0xa9, 0xba, 0xbf, 0x6b, 0xb1, 0x8a, 0x30, 0xff, 0x38, 0x0f, 0x65, 0xb9, 0x56, 0xf1, 0xd5, 0x67,
+	0x93, 0x5b, 0x8d, 0xbe, 0x35, 0xd8, 0x6f, 0xf4, 0x1b, 0x7b, 0x8d, 0x1e, 0x86, 0x62, 0x06, 0x5b,
+	0x0d, 0x3c, 0xaa, 0x2d, 0x70, 0x1a, 0x6e, 0xc0, 0x7d, 0xde, 0x3d, 0x5a, 0xa0, 0x72, 0xf8, 0x86,
+	0x54, 0xca, 0x8a, 0xf7, 0xa6, 0x79, 0xbc, 0xbb, 0x10, 0x82, 0x02, 0x51, 0xa0, 0x8d, 0x86, 0x52,
+	0x02, 0x2e, 0x2a, 0x22, 0xad, 0xce, 0xbe, 0xf5, 0x89, 0x51, 0x5a, 0x88, 0x08, 0x44, 0x39, 0x13,
+	0x11, 0xb0, 0x8e, 0xc6, 0xf4, 0xf9, 0x71, 0xa7, 0xb9, 0xf8, 0x4e, 0x85, 0xbd, 0x08, 0xcf, 0xf5,
+	0x0e, 0xbb, 0x0f, 0x07, 0x42, 0x57, 0x66, 0x12, 0xb0, 0x2b, 0x60, 0x28, 0x04, 0xc1, 0x5e, 0x45,
+	0x15, 0x84, 0x4d, 0x19, 0x7b, 0xc6, 0x26, 0x7e, 0x97, 0x70, 0x7d, 0xe1, 0x4e, 0x6a, 0x68, 0x9a,
+	0x10, 0xed, 0xb6, 0x8f, 0xef, 0x77, 0x7a, 0xc6, 0x16, 0x5a, 0x42, 0x18, 0x61, 0xc9, 0xa5, 0x4c,
+	0xcd, 0xc2, 0x09, 0x19, 0xe4, 0x97, 0x10, 0xf7, 0xb0, 0xc1, 0x3b, 0xad, 0xce, 0x41, 0xcf, 0xb8,
+	0x9c, 0x69, 0xb6, 0x38, 0xef, 0xf2, 0x9e, 0xc1, 0x32, 0x44, 0xaf, 0xdf, 0xe8, 0x1f, 0xf7, 0x8c,
+	0xe7, 0x32, 0x2b, 0x8f, 0x78, 0xb7, 0x69, 0xf5, 0x7a, 0xed, 0x56, 0xaf, 0x6f, 0x5c, 0xd9, 0xdb,
+	0xa4, 0x27, 0xfd, 0xd2, 0x99, 0x98, 0x47, 0xb0, 0xb5, 0xbc, 0xf7, 0x99, 0x09, 0x35, 0x6f, 0x3c,
+	0x08, 0xc2, 0x64, 0xe0, 0x9e, 0x79, 0x71, 0x12, 0xa7, 0x8f, 0x0a, 0xbd, 0x71, 0x27, 0x4c, 0x2c,
+	0x42, 0x61, 0x22, 0x9d, 0x6d, 0x65, 0x11, 0x63, 0x33, 0xd8, 0x3c, 0x84, 0xda, 0x92, 0x37, 0xc0,
+	0xe3, 0x9a, 0x37, 0x5e, 0x56, 0xa6, 0x7b, 0xe3, 0xaf, 0xa0, 0xe9, 0x00, 0x36, 0x55, 0xd7, 0xf0,
+	0xf5, 0x15, 0xfd, 0x09, 0xde, 0x75, 0x2b, 0xbe, 0xe1, 0xab, 0x74, 0xf1, 0x1a, 0x54, 0x12, 0x77,
+	0x3a, 0x0b, 0x23, 0x5b, 0x3a, 0x56, 0x9d, 0x2f, 0x10, 0x4b, 0x5f, 0xcb, 0x2f, 0x7f, 0x6d, 0xb9,
+	0x3c, 0x57, 0x78, 0x76, 0x79, 0xce, 0xfc, 0x53, 0x0d, 0x60, 0xe1, 0x8e, 0xe8, 0x41, 0x01, 0x36,
+	0xd2, 0xa7, 0xfd, 0x04, 0x2c, 0x6b, 0xcc, 0x3d, 0x5b, 0xe3, 0x33, 0x4d, 0x7b, 0x17, 0xca, 0x22,
+	0xe1, 0x4e, 0x53, 0x99, 0x17, 0x2e, 0x3a, 0x44, 0xf9, 0x3c, 0x2e, 0x65, 0x33, 0x5b, 0x70, 0x99,
+	0x88, 0xdc, 0xc5, 0x84, 0x4a, 0xde, 0xff, 0xe0, 0x6b, 0x74, 0xdf, 0x51, 0x93, 0xaf, 0x72, 0xe8,
+	0x3b, 0x69, 0xf6, 0x15, 0xb8, 0xa7, 0x4b, 0xd9, 0x57, 0xe0, 0x9e, 0x22, 0xc9, 0xfc, 0x59, 0x1e,
+	0x8c, 0x8b, 0x1f, 0x62, 0x6f, 0x03, 0xd8, 0x8e, 0x33, 0xc8, 0xd2, 0x95, 0x95, 0x2c, 0x07, 0xfd,
+	0x99, 0xed, 0x38, 0xf2, 0xcb, 0xaf, 0x42, 0x95, 0x3c, 0xa0, 0xe4, 0xcf, 0xc9, 0xff, 0xab, 0x90,
+	0x5b, 0x94, 0x2c, 0xdf, 0xc7, 0x12, 0x19, 0x7e, 0x3f, 0x65, 0xca, 0xab, 0x19, 0xee, 0x4a, 0x67,
+	0x30, 0xe0, 0x44, 0x6a, 0xe7, 0xee, 0x40, 0x6d, 0x1a, 0x3a, 0xde, 0xf8, 0x3c, 0x95, 0x2f, 0xac,
+	0x35, 0x6a, 0x53, 0x30, 0x49, 0xa1, 0x77, 0x01, 0x8d, 0x94, 0x8e, 0xb9, 0xf8, 0xf4, 0x18, 0xa0,
+	0xdb, 0x8e, 0xb3, 0xce, 0x97, 0x97, 0xbe, 0x82, 0x2f, 0x7f, 0x0d, 0xa4, 0xa1, 0x4a, 0x48, 0xc3,
+	0xce, 0x57, 0x05, 0x56, 0x2c, 0xa0, 0xe5, 0x07, 0x40, 0xfa, 0x57, 0x7c, 0x00, 0xa4, 0x9c, 0xc4,
+	0x3e, 0x83, 0x4a, 0x16, 0xea, 0xbe, 0xf6, 0x8e, 0x5b, 0xac, 0xe3, 0xbc, 0xb2, 0x8e, 0xcd, 0xbf,
+	0xc8, 0xf6, 0xa1, 0xe8, 0xd1, 0x57, 0xd9, 0x87, 0x57, 0xa0, 0x28, 0x86, 0x48, 0x7c, 0x42, 0x00,
+	0xcf, 0x5c, 0xe4, 0xd9, 0xb7, 0x0b, 0x17, 0xf6, 0xd0, 0xa2, 0x12, 0x54, 0x7c, 0x76, 0x25, 0xc8,
+	0x34, 0xe5, 0xa6, 0x14, 0x66, 0x66, 0x26, 0x68, 0x8a, 0x09, 0xe6, 0x4c, 0x0c, 0x94, 0x60, 0x79,
+	0xe6, 0x40, 0xfd, 0x86, 0xba, 0x80, 0x4f, 0x93, 0x97, 0x02, 0xf6, 0x7a, 0x6f, 0x61, 0xb6, 0xa0,
+	0xb6, 0x14, 0x99, 0x95, 0x7f, 0x49, 0x69, 0xea, 0xbf, 0xa4, 0xb0, 0xa8, 0x72, 0x7a, 0xe2, 0x46,
+	0xee, 0x9a, 0x3f, 0x82, 0x08, 0x82, 0xf9, 0x5d, 0xd8, 0x54, 0x73, 0x78, 0xf6, 0x4d, 0x28, 0x7a,
+	0x89, 0x3b, 0x4d, 0xdf, 0xb4, 0xbe, 0xb0, 0x9a, 0xe6, 0xd3, 0xb3, 0x4a, 0xc1, 0x64, 0x7e, 0xa9,
+	0x81, 0x71, 0x91, 0xa6, 0xfc, 0x95, 0x4b, 0x7b, 0xca, 0x5f, 0xb9, 0x72, 0x4b, 0x46, 0xae, 0xf9,
+	0x3b, 0x16, 0x1a, 0x2e, 0x5e, 0xde, 0xac, 0xf9, 0x6f, 0x11, 0x11, 0xf0, 0x6d, 0x62, 0xe4, 0xd2,
+	0x3f, 0x6f, 0x9c, 0x35, 0x17, 0xf1, 0x19, 0x0d, 0xab, 0x85, 0x65, 0x79, 0xe0, 0x58, 0x5b, 0xa4,
+	0x7c, 0x13, 0xca, 0xe2, 0x59, 0x4b, 0x5a, 0x15, 0x5c, 0xb9, 0x09, 0x49, 0xe9, 0x78, 0xa9, 0x87,
+	0xa4, 0xe5, 0x4b, 0x3d, 0x3c, 0x8e, 0x73, 0xc2, 0xe3, 0xe1, 0x90, 0xca, 0x50, 0x94, 0xe0, 0xc7,
+	0xf2, 0xad, 0x0e, 0x10, 0x0a, 0x53, 0xa4, 0xd8, 0xfc, 0x1e, 0x94, 0xe5, 0x81, 0x66, 0xad, 0x29,
+	0xbf, 0xee, 0x5f, 0x3b, 0xdb, 0x00, 0x8b, 0x13, 0xce, 0x3a, 0x0d, 0xb7, 0xbf, 0x0f, 0x9b, 0xea,
+	0x3f, 0x29, 0xa8, 0x28, 0x12, 0x06, 0xae, 0xb1, 0x81, 0x85, 0xdb, 0xf6, 0x17, 0xef, 0x1b, 0xf8,
+	0x97, 0x9b, 0xc2, 0x67, 0x71, 0xe2, 0xc8, 0xf3, 0x8d, 0x37, 0x4a, 0x8c, 0x3c, 0x12, 0xb9, 0xef,
+	0x1a, 0x85, 0xdb, 0xbf, 0xa5, 0xbc, 0x5a, 0x25, 0x05, 0x65, 0xc8, 0xdf, 0xb3, 0x3e, 0x15, 0x77,
+	0x08, 0xed, 0x56, 0xc7, 0x6a, 0xf0, 0x01, 0xc2, 0xa4, 0xe6, 0xb0, 0xd1, 0x3b, 0x34, 0x72, 0x98,
+	0x94, 0x48, 0x0a, 0x21, 0xf2, 0x8b, 0x27, 0x1c, 0x74, 0x67, 0x40, 0xcd, 0x2c, 0x17, 0x2a, 0x52,
+	0xdd, 0x1a, 0xd3, 0x94, 0x12, 0xe6, 0x49, 0xd8, 0xca, 0x68, 0xe5, 0xdb, 0x3f, 0x84, 0xfa, 0xd3,
+	0x4a, 0x21, 0xa8, 0xb5, 0x79, 0xd8, 0xa0, 0x72, 0xd3, 0x26, 0xe8, 0x9d, 0xee, 0x40, 0x40, 0x1a,
+	0x9e, 0xc7, 0xb8, 0xd5, 0xb6, 0x28, 0x93, 0xdc, 0xfb, 0xc1, 0x3f, 0xfe, 0xea, 0xba, 0xf6, 0x4f,
+	0xbf, 0xba, 0xae, 0xfd, 0xdb, 0xaf, 0xae, 0x6f, 0x7c, 0xf9, 0xef, 0xd7, 0xb5, 0xcf, 0xd4, 0xbf,
+	0xd6, 0x4e, 0xed, 0x24, 0xf2, 0xce, 0xc4, 0xff, 0x1e, 0x52, 0x20, 0x70, 0xdf, 0x99, 0x3d, 0x9e,
+	0xbc, 0x33, 0x1b, 0xbe, 0x83, 0xc3, 0x3d, 0x2c, 0xd1, 0x3f, 0x6c, 0xef, 0xfc, 0xff, 0x00, 0xd8,
+	0x72, 0x1a, 0x6c, 0xa4, 0x3b, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -5991,6 +7506,13 @@ func (m *Type) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Enumvalues) > 0 {
+		i -= len(m.Enumvalues)
+		copy(dAtA[i:], m.Enumvalues)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Enumvalues)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Scale != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.Scale))
 		i--
@@ -7281,6 +8803,23 @@ func (m *IndexDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.IndexTableName) > 0 {
+		i -= len(m.IndexTableName)
+		copy(dAtA[i:], m.IndexTableName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexTableName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Unique {
+		i--
+		if m.Unique {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ColNames) > 0 {
 		for iNdEx := len(m.ColNames) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ColNames[iNdEx])
@@ -7423,7 +8962,7 @@ func (m *PropertiesDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PartitionInfo) Marshal() (dAtA []byte, err error) {
+func (m *CheckDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7433,12 +8972,12 @@ func (m *PartitionInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PartitionInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *CheckDef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PartitionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7447,46 +8986,232 @@ func (m *PartitionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PartitionMsg) > 0 {
-		i -= len(m.PartitionMsg)
-		copy(dAtA[i:], m.PartitionMsg)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionMsg)))
+	if len(m.CheckStr) > 0 {
+		i -= len(m.CheckStr)
+		copy(dAtA[i:], m.CheckStr)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.CheckStr)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x1a
 	}
-	if m.IsSubPartition {
-		i--
-		if m.IsSubPartition {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Check != nil {
+		{
+			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x12
 	}
-	if m.Algorithm != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Algorithm))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Partitions) > 0 {
-		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPlan(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+		dAtA[i] = 0xa
 	}
-	if m.PartitionNum != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.PartitionNum))
-		i--
-		dAtA[i] = 0x30
+	return len(dAtA) - i, nil
+}
+
+func (m *ForeignKeyDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForeignKeyDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OnUpdate != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.OnUpdate))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OnDelete != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.OnDelete))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ForeignCols) > 0 {
+		for iNdEx := len(m.ForeignCols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ForeignCols[iNdEx])
+			copy(dAtA[i:], m.ForeignCols[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.ForeignCols[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ForeignTbl) > 0 {
+		i -= len(m.ForeignTbl)
+		copy(dAtA[i:], m.ForeignTbl)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ForeignTbl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Cols) > 0 {
+		for iNdEx := len(m.Cols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cols[iNdEx])
+			copy(dAtA[i:], m.Cols[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Cols[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConstraintDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConstraintDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RefChildTbls) > 0 {
+		for iNdEx := len(m.RefChildTbls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RefChildTbls[iNdEx])
+			copy(dAtA[i:], m.RefChildTbls[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.RefChildTbls[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Fkeys) > 0 {
+		for iNdEx := len(m.Fkeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fkeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PartitionMsg) > 0 {
+		i -= len(m.PartitionMsg)
+		copy(dAtA[i:], m.PartitionMsg)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionMsg)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.IsSubPartition {
+		i--
+		if m.IsSubPartition {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Algorithm != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PartitionNum != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.PartitionNum))
+		i--
+		dAtA[i] = 0x30
 	}
 	if len(m.PartitionColumns) > 0 {
 		for iNdEx := len(m.PartitionColumns) - 1; iNdEx >= 0; iNdEx-- {
@@ -7887,6 +9612,27 @@ func (m *TableDef_DefType_Partition) MarshalToSizedBuffer(dAtA []byte) (int, err
 	}
 	return len(dAtA) - i, nil
 }
+func (m *TableDef_DefType_Constraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableDef_DefType_Constraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Constraint != nil {
+		{
+			size, err := m.Constraint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Cost) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -7944,7 +9690,7 @@ func (m *Cost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ColData) Marshal() (dAtA []byte, err error) {
+func (m *HistogramBucket) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7954,12 +9700,12 @@ func (m *ColData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ColData) MarshalTo(dAtA []byte) (int, error) {
+func (m *HistogramBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ColData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HistogramBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7968,70 +9714,294 @@ func (m *ColData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.S) > 0 {
-		for iNdEx := len(m.S) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.S[iNdEx])
-			copy(dAtA[i:], m.S[iNdEx])
-			i = encodeVarintPlan(dAtA, i, uint64(len(m.S[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
+	if m.Ndv != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Ndv))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if len(m.F64) > 0 {
-		for iNdEx := len(m.F64) - 1; iNdEx >= 0; iNdEx-- {
-			f27 := math.Float64bits(float64(m.F64[iNdEx]))
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f27))
-		}
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.F64)*8))
+	if m.Count != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Count))))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x11
 	}
-	if len(m.F32) > 0 {
-		for iNdEx := len(m.F32) - 1; iNdEx >= 0; iNdEx-- {
-			f28 := math.Float32bits(float32(m.F32[iNdEx]))
-			i -= 4
-			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f28))
-		}
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.F32)*4))
+	if m.Upper != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Upper))))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x9
 	}
-	if len(m.I64) > 0 {
-		dAtA30 := make([]byte, len(m.I64)*10)
-		var j29 int
-		for _, num1 := range m.I64 {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j29++
+	return len(dAtA) - i, nil
+}
+
+func (m *Histogram) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintPlan(dAtA, i, uint64(j29))
-		i--
-		dAtA[i] = 0x2a
 	}
-	if len(m.I32) > 0 {
-		dAtA32 := make([]byte, len(m.I32)*10)
-		var j31 int
+	if m.Lower != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lower))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ColumnStats) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ColumnStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ColumnStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxValue != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxValue))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.MinValue != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MinValue))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.HasRange {
+		i--
+		if m.HasRange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Histogram != nil {
+		{
+			size, err := m.Histogram.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NullCount != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NullCount))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Ndv != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Ndv))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TableStats) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cols) > 0 {
+		for k := range m.Cols {
+			v := m.Cols[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPlan(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPlan(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPlan(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RowCount != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RowCount))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ColData) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ColData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ColData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.S) > 0 {
+		for iNdEx := len(m.S) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.S[iNdEx])
+			copy(dAtA[i:], m.S[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.S[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.F64) > 0 {
+		for iNdEx := len(m.F64) - 1; iNdEx >= 0; iNdEx-- {
+			f31 := math.Float64bits(float64(m.F64[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f31))
+		}
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.F64)*8))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.F32) > 0 {
+		for iNdEx := len(m.F32) - 1; iNdEx >= 0; iNdEx-- {
+			f32 := math.Float32bits(float32(m.F32[iNdEx]))
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f32))
+		}
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.F32)*4))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.I64) > 0 {
+		dAtA34 := make([]byte, len(m.I64)*10)
+		var j33 int
+		for _, num1 := range m.I64 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j33++
+			}
+			dAtA34[j33] = uint8(num)
+			j33++
+		}
+		i -= j33
+		copy(dAtA[i:], dAtA34[:j33])
+		i = encodeVarintPlan(dAtA, i, uint64(j33))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.I32) > 0 {
+		dAtA36 := make([]byte, len(m.I32)*10)
+		var j35 int
 		for _, num1 := range m.I32 {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j31++
+				j35++
 			}
-			dAtA32[j31] = uint8(num)
-			j31++
+			dAtA36[j35] = uint8(num)
+			j35++
 		}
-		i -= j31
-		copy(dAtA[i:], dAtA32[:j31])
-		i = encodeVarintPlan(dAtA, i, uint64(j31))
+		i -= j35
+		copy(dAtA[i:], dAtA36[:j35])
+		i = encodeVarintPlan(dAtA, i, uint64(j35))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -8165,7 +10135,7 @@ func (m *OrderBySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WindowSpec) Marshal() (dAtA []byte, err error) {
+func (m *FrameBound) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8175,12 +10145,12 @@ func (m *WindowSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WindowSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *FrameBound) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FrameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8189,48 +10159,37 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Lag != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Lag))
+	if m.Val != nil {
+		{
+			size, err := m.Val.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x1a
 	}
-	if m.Lead != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Lead))
+	if m.Unbounded {
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.OrderBy) > 0 {
-		for iNdEx := len(m.OrderBy) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OrderBy[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPlan(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+		if m.Unbounded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.PartitionBy) > 0 {
-		for iNdEx := len(m.PartitionBy) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PartitionBy[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPlan(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateCtx) Marshal() (dAtA []byte, err error) {
+func (m *FrameClause) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8240,12 +10199,12 @@ func (m *UpdateCtx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateCtx) MarshalTo(dAtA []byte) (int, error) {
+func (m *FrameClause) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FrameClause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8254,24 +10213,306 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.OrderAttrs) > 0 {
-		for iNdEx := len(m.OrderAttrs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.OrderAttrs[iNdEx])
-			copy(dAtA[i:], m.OrderAttrs[iNdEx])
-			i = encodeVarintPlan(dAtA, i, uint64(len(m.OrderAttrs[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.OtherAttrs) > 0 {
-		for iNdEx := len(m.OtherAttrs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.OtherAttrs[iNdEx])
-			copy(dAtA[i:], m.OtherAttrs[iNdEx])
-			i = encodeVarintPlan(dAtA, i, uint64(len(m.OtherAttrs[iNdEx])))
-			i--
-			dAtA[i] = 0x42
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
-	}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WindowSpec) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindowSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Frame != nil {
+		{
+			size, err := m.Frame.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.WindowFunc != nil {
+		{
+			size, err := m.WindowFunc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Lag != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Lag))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Lead != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Lead))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderBy) > 0 {
+		for iNdEx := len(m.OrderBy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBy[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PartitionBy) > 0 {
+		for iNdEx := len(m.PartitionBy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartitionBy[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JsonTable) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JsonTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JsonTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Outer {
+		i--
+		if m.Outer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cols) > 0 {
+		for iNdEx := len(m.Cols) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cols[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Doc != nil {
+		{
+			size, err := m.Doc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JsonTableColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JsonTableColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JsonTableColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Expr != nil {
+		{
+			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Kind != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateCtx) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateCtx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IndexAttrs) > 0 {
+		for iNdEx := len(m.IndexAttrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IndexAttrs[iNdEx])
+			copy(dAtA[i:], m.IndexAttrs[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexAttrs[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.OrderAttrs) > 0 {
+		for iNdEx := len(m.OrderAttrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OrderAttrs[iNdEx])
+			copy(dAtA[i:], m.OrderAttrs[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.OrderAttrs[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.OtherAttrs) > 0 {
+		for iNdEx := len(m.OtherAttrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OtherAttrs[iNdEx])
+			copy(dAtA[i:], m.OtherAttrs[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.OtherAttrs[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.UpdateCols) > 0 {
 		for iNdEx := len(m.UpdateCols) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8351,6 +10592,11 @@ func (m *AnalyzeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PrunedBlocks != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.PrunedBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MemorySize != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.MemorySize))
 		i--
@@ -8408,9 +10654,9 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AnalyzeInfo != nil {
+	if m.RecursiveCte != nil {
 		{
-			size, err := m.AnalyzeInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RecursiveCte.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8420,24 +10666,73 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xc2
+		dAtA[i] = 0xe2
+	}
+	if m.JsonTable != nil {
+		{
+			size, err := m.JsonTable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.IndexScan != nil {
+		{
+			size, err := m.IndexScan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.WindowIdx != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.WindowIdx))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.AnalyzeInfo != nil {
+		{
+			size, err := m.AnalyzeInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA37 := make([]byte, len(m.BindingTags)*10)
-		var j36 int
+		dAtA51 := make([]byte, len(m.BindingTags)*10)
+		var j50 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j36++
+				j50++
 			}
-			dAtA37[j36] = uint8(num)
-			j36++
+			dAtA51[j50] = uint8(num)
+			j50++
 		}
-		i -= j36
-		copy(dAtA[i:], dAtA37[:j36])
-		i = encodeVarintPlan(dAtA, i, uint64(j36))
+		i -= j50
+		copy(dAtA[i:], dAtA51[:j50])
+		i = encodeVarintPlan(dAtA, i, uint64(j50))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -8668,21 +10963,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x30
 	}
 	if len(m.Children) > 0 {
-		dAtA45 := make([]byte, len(m.Children)*10)
-		var j44 int
+		dAtA59 := make([]byte, len(m.Children)*10)
+		var j58 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA59[j58] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j44++
+				j58++
 			}
-			dAtA45[j44] = uint8(num)
-			j44++
+			dAtA59[j58] = uint8(num)
+			j58++
 		}
-		i -= j44
-		copy(dAtA[i:], dAtA45[:j44])
-		i = encodeVarintPlan(dAtA, i, uint64(j44))
+		i -= j58
+		copy(dAtA[i:], dAtA59[:j58])
+		i = encodeVarintPlan(dAtA, i, uint64(j58))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -8725,6 +11020,131 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecursiveCte) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecursiveCte) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecursiveCte) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxDepth != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.MaxDepth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UnionAll {
+		i--
+		if m.UnionAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ColNames) > 0 {
+		for iNdEx := len(m.ColNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ColNames[iNdEx])
+			copy(dAtA[i:], m.ColNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.ColNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexScan) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexScan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexScan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FilterList) > 0 {
+		for iNdEx := len(m.FilterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FilterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.IndexDef != nil {
+		{
+			size, err := m.IndexDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DeleteTableCtx) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -8749,6 +11169,20 @@ func (m *DeleteTableCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IndexAttrsIdx != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.IndexAttrsIdx))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.IndexAttrs) > 0 {
+		for iNdEx := len(m.IndexAttrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IndexAttrs[iNdEx])
+			copy(dAtA[i:], m.IndexAttrs[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexAttrs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.IsHideKey {
 		i--
 		if m.IsHideKey {
@@ -8855,21 +11289,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA48 := make([]byte, len(m.Steps)*10)
-		var j47 int
+		dAtA63 := make([]byte, len(m.Steps)*10)
+		var j62 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j47++
+				j62++
 			}
-			dAtA48[j47] = uint8(num)
-			j47++
+			dAtA63[j62] = uint8(num)
+			j62++
 		}
-		i -= j47
-		copy(dAtA[i:], dAtA48[:j47])
-		i = encodeVarintPlan(dAtA, i, uint64(j47))
+		i -= j62
+		copy(dAtA[i:], dAtA63[:j62])
+		i = encodeVarintPlan(dAtA, i, uint64(j62))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9998,6 +12432,27 @@ func (m *AlterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TableDef != nil {
 		{
 			size, err := m.TableDef.MarshalToSizedBuffer(dAtA[:i])
@@ -10020,7 +12475,7 @@ func (m *AlterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DropTable) Marshal() (dAtA []byte, err error) {
+func (m *AlterRenameColumn) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10030,12 +12485,12 @@ func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DropTable) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterRenameColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterRenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10044,34 +12499,24 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Table) > 0 {
-		i -= len(m.Table)
-		copy(dAtA[i:], m.Table)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Database) > 0 {
-		i -= len(m.Database)
-		copy(dAtA[i:], m.Database)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.NewName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.IfExists {
-		i--
-		if m.IfExists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.OldName) > 0 {
+		i -= len(m.OldName)
+		copy(dAtA[i:], m.OldName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OldName)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CreateIndex) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableAction) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10081,12 +12526,12 @@ func (m *CreateIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableAction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10095,190 +12540,173 @@ func (m *CreateIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.IfNotExists {
-		i--
-		if m.IfNotExists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Action != nil {
+		{
+			size := m.Action.ProtoSize()
+			i -= size
+			if _, err := m.Action.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AlterIndex) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *AlterIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableAction_AddColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableAction_AddColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
+	if m.AddColumn != nil {
+		{
+			size, err := m.AddColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *DropIndex) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableAction_DropColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableAction_DropColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.DropColumn)
+	copy(dAtA[i:], m.DropColumn)
+	i = encodeVarintPlan(dAtA, i, uint64(len(m.DropColumn)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAction_RenameColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableAction_RenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
+	if m.RenameColumn != nil {
+		{
+			size, err := m.RenameColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.IfExists {
-		i--
-		if m.IfExists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAction_ModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAction_ModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ModifyColumn != nil {
+		{
+			size, err := m.ModifyColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *TruncateTable) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableAction_AddIndex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TruncateTable) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableAction_AddIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddIndex != nil {
+		{
+			size, err := m.AddIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAction_DropIndex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableAction_DropIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Table) > 0 {
-		i -= len(m.Table)
-		copy(dAtA[i:], m.Table)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
+	if m.DropIndex != nil {
+		{
+			size, err := m.DropIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *ShowVariables) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableAction_RenameTable) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ShowVariables) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableAction_RenameTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.RenameTable)
+	copy(dAtA[i:], m.RenameTable)
+	i = encodeVarintPlan(dAtA, i, uint64(len(m.RenameTable)))
+	i--
+	dAtA[i] = 0x3a
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAction_Properties) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ShowVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableAction_Properties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Where) > 0 {
-		for iNdEx := len(m.Where) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Where[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPlan(dAtA, i, uint64(size))
+	if m.Properties != nil {
+		{
+			size, err := m.Properties.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Global {
-		i--
-		if m.Global {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x42
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *SetVariables) Marshal() (dAtA []byte, err error) {
+func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10288,12 +12716,12 @@ func (m *SetVariables) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetVariables) MarshalTo(dAtA []byte) (int, error) {
+func (m *DropTable) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10302,24 +12730,34 @@ func (m *SetVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPlan(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IfExists {
+		i--
+		if m.IfExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SetVariablesItem) Marshal() (dAtA []byte, err error) {
+func (m *CreateIndex) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10329,12 +12767,12 @@ func (m *SetVariablesItem) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetVariablesItem) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateIndex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetVariablesItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10343,9 +12781,9 @@ func (m *SetVariablesItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Reserved != nil {
+	if m.IndexDef != nil {
 		{
-			size, err := m.Reserved.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.IndexDef.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10355,38 +12793,30 @@ func (m *SetVariablesItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPlan(dAtA, i, uint64(size))
-		}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Global {
-		i--
-		if m.Global {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.System {
+	if m.IfNotExists {
 		i--
-		if m.System {
+		if m.IfNotExists {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -10397,7 +12827,7 @@ func (m *SetVariablesItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Prepare) Marshal() (dAtA []byte, err error) {
+func (m *AlterIndex) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10407,12 +12837,12 @@ func (m *Prepare) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Prepare) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterIndex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10421,41 +12851,136 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ParamTypes) > 0 {
-		dAtA79 := make([]byte, len(m.ParamTypes)*10)
-		var j78 int
-		for _, num1 := range m.ParamTypes {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA79[j78] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j78++
-			}
-			dAtA79[j78] = uint8(num)
-			j78++
-		}
-		i -= j78
-		copy(dAtA[i:], dAtA79[:j78])
-		i = encodeVarintPlan(dAtA, i, uint64(j78))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
-	if m.Plan != nil {
-		{
-			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPlan(dAtA, i, uint64(size))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *DropIndex) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DropIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DropIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Schemas) > 0 {
-		for iNdEx := len(m.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IfExists {
+		i--
+		if m.IfExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TruncateTable) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncateTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShowVariables) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShowVariables) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShowVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Where) > 0 {
+		for iNdEx := len(m.Where) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Schemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Where[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -10466,17 +12991,20 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+	if m.Global {
 		i--
-		dAtA[i] = 0xa
+		if m.Global {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Execute) Marshal() (dAtA []byte, err error) {
+func (m *SetVariables) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10486,12 +13014,12 @@ func (m *Execute) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Execute) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetVariables) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Execute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10500,10 +13028,10 @@ func (m *Execute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -10511,20 +13039,13 @@ func (m *Execute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPlan(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Deallocate) Marshal() (dAtA []byte, err error) {
+func (m *SetVariablesItem) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10534,12 +13055,12 @@ func (m *Deallocate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Deallocate) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetVariablesItem) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Deallocate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetVariablesItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10548,41 +13069,246 @@ func (m *Deallocate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Reserved != nil {
+		{
+			size, err := m.Reserved.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
 		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if m.Global {
+		i--
+		if m.Global {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.System {
+		i--
+		if m.System {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPlan(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPlan(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Prepare) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Type) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Prepare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovPlan(uint64(m.Id))
-	}
-	if m.Nullable {
-		n += 2
-	}
-	if m.Width != 0 {
-		n += 1 + sovPlan(uint64(m.Width))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ParamTypes) > 0 {
+		dAtA101 := make([]byte, len(m.ParamTypes)*10)
+		var j100 int
+		for _, num1 := range m.ParamTypes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA101[j100] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j100++
+			}
+			dAtA101[j100] = uint8(num)
+			j100++
+		}
+		i -= j100
+		copy(dAtA[i:], dAtA101[:j100])
+		i = encodeVarintPlan(dAtA, i, uint64(j100))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Plan != nil {
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Schemas) > 0 {
+		for iNdEx := len(m.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Execute) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Execute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Execute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deallocate) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deallocate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deallocate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPlan(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPlan(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Type) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPlan(uint64(m.Id))
+	}
+	if m.Nullable {
+		n += 2
+	}
+	if m.Width != 0 {
+		n += 1 + sovPlan(uint64(m.Width))
 	}
 	if m.Precision != 0 {
 		n += 1 + sovPlan(uint64(m.Precision))
@@ -10593,6 +13319,10 @@ func (m *Type) ProtoSize() (n int) {
 	if m.Scale != 0 {
 		n += 1 + sovPlan(uint64(m.Scale))
 	}
+	l = len(m.Enumvalues)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11233,6 +13963,13 @@ func (m *IndexDef) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if m.Unique {
+		n += 2
+	}
+	l = len(m.IndexTableName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11295,6 +14032,98 @@ func (m *PropertiesDef) ProtoSize() (n int) {
 	return n
 }
 
+func (m *CheckDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Check != nil {
+		l = m.Check.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.CheckStr)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForeignKeyDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.Cols) > 0 {
+		for _, s := range m.Cols {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	l = len(m.ForeignTbl)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.ForeignCols) > 0 {
+		for _, s := range m.ForeignCols {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.OnDelete != 0 {
+		n += 1 + sovPlan(uint64(m.OnDelete))
+	}
+	if m.OnUpdate != 0 {
+		n += 1 + sovPlan(uint64(m.OnUpdate))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConstraintDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.Fkeys) > 0 {
+		for _, e := range m.Fkeys {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.RefChildTbls) > 0 {
+		for _, s := range m.RefChildTbls {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *PartitionInfo) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -11523,6 +14352,18 @@ func (m *TableDef_DefType_Partition) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *TableDef_DefType_Constraint) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constraint != nil {
+		l = m.Constraint.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *Cost) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -11550,23 +14391,124 @@ func (m *Cost) ProtoSize() (n int) {
 	return n
 }
 
-func (m *ColData) ProtoSize() (n int) {
+func (m *HistogramBucket) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.RowCount != 0 {
-		n += 1 + sovPlan(uint64(m.RowCount))
+	if m.Upper != 0 {
+		n += 9
 	}
-	if m.NullCount != 0 {
-		n += 1 + sovPlan(uint64(m.NullCount))
+	if m.Count != 0 {
+		n += 9
 	}
-	if len(m.Nulls) > 0 {
-		n += 1 + sovPlan(uint64(len(m.Nulls))) + len(m.Nulls)*1
+	if m.Ndv != 0 {
+		n += 9
 	}
-	if len(m.I32) > 0 {
-		l = 0
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Histogram) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lower != 0 {
+		n += 9
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ColumnStats) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ndv != 0 {
+		n += 9
+	}
+	if m.NullCount != 0 {
+		n += 9
+	}
+	if m.Histogram != nil {
+		l = m.Histogram.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.HasRange {
+		n += 2
+	}
+	if m.MinValue != 0 {
+		n += 9
+	}
+	if m.MaxValue != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TableStats) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RowCount != 0 {
+		n += 9
+	}
+	if len(m.Cols) > 0 {
+		for k, v := range m.Cols {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.ProtoSize()
+				l += 1 + sovPlan(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPlan(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPlan(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ColData) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RowCount != 0 {
+		n += 1 + sovPlan(uint64(m.RowCount))
+	}
+	if m.NullCount != 0 {
+		n += 1 + sovPlan(uint64(m.NullCount))
+	}
+	if len(m.Nulls) > 0 {
+		n += 1 + sovPlan(uint64(len(m.Nulls))) + len(m.Nulls)*1
+	}
+	if len(m.I32) > 0 {
+		l = 0
 		for _, e := range m.I32 {
 			l += sovPlan(uint64(e))
 		}
@@ -11642,6 +14584,51 @@ func (m *OrderBySpec) ProtoSize() (n int) {
 	return n
 }
 
+func (m *FrameBound) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Unbounded {
+		n += 2
+	}
+	if m.Val != nil {
+		l = m.Val.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FrameClause) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Start != nil {
+		l = m.Start.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *WindowSpec) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -11666,6 +14653,70 @@ func (m *WindowSpec) ProtoSize() (n int) {
 	if m.Lag != 0 {
 		n += 1 + sovPlan(uint64(m.Lag))
 	}
+	if m.WindowFunc != nil {
+		l = m.WindowFunc.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Frame != nil {
+		l = m.Frame.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JsonTable) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Doc != nil {
+		l = m.Doc.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.Cols) > 0 {
+		for _, e := range m.Cols {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.Outer {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JsonTableColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovPlan(uint64(m.Kind))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Expr != nil {
+		l = m.Expr.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11718,6 +14769,12 @@ func (m *UpdateCtx) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if len(m.IndexAttrs) > 0 {
+		for _, s := range m.IndexAttrs {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11748,6 +14805,9 @@ func (m *AnalyzeInfo) ProtoSize() (n int) {
 	if m.MemorySize != 0 {
 		n += 1 + sovPlan(uint64(m.MemorySize))
 	}
+	if m.PrunedBlocks != 0 {
+		n += 1 + sovPlan(uint64(m.PrunedBlocks))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11879,6 +14939,77 @@ func (m *Node) ProtoSize() (n int) {
 		l = m.AnalyzeInfo.ProtoSize()
 		n += 2 + l + sovPlan(uint64(l))
 	}
+	if m.WindowIdx != 0 {
+		n += 2 + sovPlan(uint64(m.WindowIdx))
+	}
+	if m.IndexScan != nil {
+		l = m.IndexScan.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.JsonTable != nil {
+		l = m.JsonTable.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.RecursiveCte != nil {
+		l = m.RecursiveCte.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecursiveCte) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.ColNames) > 0 {
+		for _, s := range m.ColNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.UnionAll {
+		n += 2
+	}
+	if m.MaxDepth != 0 {
+		n += 1 + sovPlan(uint64(m.MaxDepth))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndexScan) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IndexDef != nil {
+		l = m.IndexDef.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.FilterList) > 0 {
+		for _, e := range m.FilterList {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11909,6 +15040,15 @@ func (m *DeleteTableCtx) ProtoSize() (n int) {
 	if m.IsHideKey {
 		n += 2
 	}
+	if len(m.IndexAttrs) > 0 {
+		for _, s := range m.IndexAttrs {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.IndexAttrsIdx != 0 {
+		n += 1 + sovPlan(uint64(m.IndexAttrsIdx))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12512,26 +15652,33 @@ func (m *AlterTable) ProtoSize() (n int) {
 		l = m.TableDef.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DropTable) ProtoSize() (n int) {
+func (m *AlterRenameColumn) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.IfExists {
-		n += 2
-	}
-	l = len(m.Database)
+	l = len(m.OldName)
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
-	l = len(m.Table)
+	l = len(m.NewName)
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
@@ -12541,19 +15688,161 @@ func (m *DropTable) ProtoSize() (n int) {
 	return n
 }
 
-func (m *CreateIndex) ProtoSize() (n int) {
+func (m *AlterTableAction) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.IfNotExists {
-		n += 2
+	if m.Action != nil {
+		n += m.Action.ProtoSize()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableAction_AddColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddColumn != nil {
+		l = m.AddColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_DropColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DropColumn)
+	n += 1 + l + sovPlan(uint64(l))
+	return n
+}
+func (m *AlterTableAction_RenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RenameColumn != nil {
+		l = m.RenameColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_ModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModifyColumn != nil {
+		l = m.ModifyColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_AddIndex) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddIndex != nil {
+		l = m.AddIndex.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_DropIndex) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropIndex != nil {
+		l = m.DropIndex.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_RenameTable) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RenameTable)
+	n += 1 + l + sovPlan(uint64(l))
+	return n
+}
+func (m *AlterTableAction_Properties) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Properties != nil {
+		l = m.Properties.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *DropTable) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IfExists {
+		n += 2
+	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateIndex) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IfNotExists {
+		n += 2
 	}
 	l = len(m.Index)
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.IndexDef != nil {
+		l = m.IndexDef.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12589,6 +15878,14 @@ func (m *DropIndex) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12901,6 +16198,38 @@ func (m *Type) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enumvalues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enumvalues = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -15710,6 +19039,58 @@ func (m *IndexDef) Unmarshal(dAtA []byte) error {
 			}
 			m.ColNames = append(m.ColNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unique = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -16015,7 +19396,7 @@ func (m *PropertiesDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
+func (m *CheckDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16038,17 +19419,17 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PartitionInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartitionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16058,14 +19439,27 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= PartitionType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16092,16 +19486,16 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expr == nil {
-				m.Expr = &Expr{}
+			if m.Check == nil {
+				m.Check = &Expr{}
 			}
-			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Check.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionExpression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckStr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16129,45 +19523,62 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartitionExpression = string(dAtA[iNdEx:postIndex])
+			m.CheckStr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForeignKeyDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Columns = append(m.Columns, &Expr{})
-			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForeignKeyDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForeignKeyDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionColumns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16195,13 +19606,13 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartitionColumns = append(m.PartitionColumns, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionNum", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
 			}
-			m.PartitionNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16211,16 +19622,29 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PartitionNum |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignTbl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16230,31 +19654,29 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Partitions = append(m.Partitions, &PartitionItem{})
-			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ForeignTbl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignCols", wireType)
 			}
-			m.Algorithm = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16264,16 +19686,29 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Algorithm |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForeignCols = append(m.ForeignCols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsSubPartition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDelete", wireType)
 			}
-			var v int
+			m.OnDelete = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16283,17 +19718,16 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.OnDelete |= ForeignKeyDef_RefAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsSubPartition = bool(v != 0)
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionMsg", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnUpdate", wireType)
 			}
-			var stringLen uint64
+			m.OnUpdate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16303,24 +19737,11 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OnUpdate |= ForeignKeyDef_RefAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PartitionMsg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -16343,7 +19764,7 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PartitionItem) Unmarshal(dAtA []byte) error {
+func (m *ConstraintDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16366,17 +19787,17 @@ func (m *PartitionItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PartitionItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConstraintDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartitionItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConstraintDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16386,48 +19807,31 @@ func (m *PartitionItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartitionName = string(dAtA[iNdEx:postIndex])
+			m.Checks = append(m.Checks, &CheckDef{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrdinalPosition", wireType)
-			}
-			m.OrdinalPosition = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrdinalPosition |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fkeys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16437,27 +19841,29 @@ func (m *PartitionItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Fkeys = append(m.Fkeys, &ForeignKeyDef{})
+			if err := m.Fkeys[len(m.Fkeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefChildTbls", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16485,13 +19891,64 @@ func (m *PartitionItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
+			m.RefChildTbls = append(m.RefChildTbls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LessThan", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16501,29 +19958,14 @@ func (m *PartitionItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= PartitionType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LessThan = append(m.LessThan, &Expr{})
-			if err := m.LessThan[len(m.LessThan)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16550,65 +19992,16 @@ func (m *PartitionItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InValues = append(m.InValues, &Expr{})
-			if err := m.InValues[len(m.InValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Expr == nil {
+				m.Expr = &Expr{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ViewDef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ViewDef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ViewDef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionExpression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16636,62 +20029,45 @@ func (m *ViewDef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.View = string(dAtA[iNdEx:postIndex])
+			m.PartitionExpression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TableDef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Columns = append(m.Columns, &Expr{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TableDef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableDef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionColumns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16719,13 +20095,13 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.PartitionColumns = append(m.PartitionColumns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionNum", wireType)
 			}
-			var msglen int
+			m.PartitionNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16735,29 +20111,14 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PartitionNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cols = append(m.Cols, &ColDef{})
-			if err := m.Cols[len(m.Cols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Defs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16784,16 +20145,16 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Defs = append(m.Defs, &TableDef_DefType{})
-			if err := m.Defs[len(m.Defs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Partitions = append(m.Partitions, &PartitionItem{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableType", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
 			}
-			var stringLen uint64
+			m.Algorithm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16803,29 +20164,16 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Algorithm |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TableType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Createsql", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSubPartition", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16835,29 +20183,17 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Createsql = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.IsSubPartition = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name2ColIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionMsg", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16867,104 +20203,23 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Name2ColIndex == nil {
-				m.Name2ColIndex = make(map[string]int32)
-			}
-			var mapkey string
-			var mapvalue int32
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPlan
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPlan
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPlan(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPlan
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Name2ColIndex[mapkey] = mapvalue
+			m.PartitionMsg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16988,7 +20243,7 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
+func (m *PartitionItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17011,17 +20266,17 @@ func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DefType: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17031,32 +20286,48 @@ func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PrimaryKeyDef{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Def = &TableDef_DefType_Pk{v}
+			m.PartitionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdinalPosition", wireType)
+			}
+			m.OrdinalPosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdinalPosition |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Idx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17066,32 +20337,29 @@ func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IndexDef{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Def = &TableDef_DefType_Idx{v}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17101,30 +20369,27 @@ func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PropertiesDef{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Def = &TableDef_DefType_Properties{v}
+			m.Comment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LessThan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17151,15 +20416,14 @@ func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ViewDef{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LessThan = append(m.LessThan, &Expr{})
+			if err := m.LessThan[len(m.LessThan)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Def = &TableDef_DefType_View{v}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InValues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17186,11 +20450,10 @@ func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PartitionInfo{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InValues = append(m.InValues, &Expr{})
+			if err := m.InValues[len(m.InValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Def = &TableDef_DefType_Partition{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17214,7 +20477,7 @@ func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cost) Unmarshal(dAtA []byte) error {
+func (m *ViewDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17237,67 +20500,44 @@ func (m *Cost) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cost: wiretype end group for non-group")
+			return fmt.Errorf("proto: ViewDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cost: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ViewDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Card", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Card = float64(math.Float64frombits(v))
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rowsize", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Rowsize = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ndv", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Ndv = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Start = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Total = float64(math.Float64frombits(v))
+			m.View = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -17320,7 +20560,7 @@ func (m *Cost) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ColData) Unmarshal(dAtA []byte) error {
+func (m *TableDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17343,17 +20583,17 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ColData: wiretype end group for non-group")
+			return fmt.Errorf("proto: TableDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ColData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TableDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.RowCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17363,16 +20603,29 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RowCount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NullCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
 			}
-			m.NullCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17382,31 +20635,161 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NullCount |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, &ColDef{})
+			if err := m.Cols[len(m.Cols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Defs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				m.Nulls = append(m.Nulls, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Defs = append(m.Defs, &TableDef_DefType{})
+			if err := m.Defs[len(m.Defs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Createsql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Createsql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name2ColIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Name2ColIndex == nil {
+				m.Name2ColIndex = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowPlan
@@ -17416,28 +20799,14 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.Nulls) == 0 {
-					m.Nulls = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowPlan
@@ -17447,73 +20816,25 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= int(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.Nulls = append(m.Nulls, bool(v != 0))
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nulls", wireType)
-			}
-		case 4:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPlan
 					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPlan
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
 					}
-				}
-				m.I32 = append(m.I32, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.I32) == 0 {
-					m.I32 = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowPlan
@@ -17523,135 +20844,1271 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= int32(b&0x7F) << shift
+						mapvalue |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.I32 = append(m.I32, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field I32", wireType)
-			}
-		case 5:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPlan(dAtA[iNdEx:])
+					if err != nil {
+						return err
 					}
-				}
-				m.I64 = append(m.I64, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPlan
 					}
-					if iNdEx >= l {
+					if (iNdEx + skippy) > postIndex {
 						return io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
+					iNdEx += skippy
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
+			}
+			m.Name2ColIndex[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DefType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DefType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.I64) == 0 {
-					m.I64 = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.I64 = append(m.I64, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field I64", wireType)
 			}
-		case 6:
-			if wireType == 5 {
-				var v uint32
-				if (iNdEx + 4) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-				iNdEx += 4
-				v2 := float32(math.Float32frombits(v))
-				m.F32 = append(m.F32, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PrimaryKeyDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Def = &TableDef_DefType_Pk{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Idx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen / 4
-				if elementCount != 0 && len(m.F32) == 0 {
-					m.F32 = make([]float32, 0, elementCount)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				for iNdEx < postIndex {
-					var v uint32
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IndexDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Def = &TableDef_DefType_Idx{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PropertiesDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Def = &TableDef_DefType_Properties{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ViewDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Def = &TableDef_DefType_View{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PartitionInfo{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Def = &TableDef_DefType_Partition{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConstraintDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Def = &TableDef_DefType_Constraint{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Card", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Card = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rowsize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Rowsize = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ndv", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Ndv = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Start = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Total = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistogramBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistogramBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistogramBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Upper = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Count = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ndv", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Ndv = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Histogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Lower = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, &HistogramBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ColumnStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ColumnStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ColumnStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ndv", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Ndv = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NullCount", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NullCount = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Histogram == nil {
+				m.Histogram = &Histogram{}
+			}
+			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRange", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasRange = bool(v != 0)
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValue", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MinValue = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValue", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxValue = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowCount", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RowCount = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cols == nil {
+				m.Cols = make(map[string]*ColumnStats)
+			}
+			var mapkey string
+			var mapvalue *ColumnStats
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPlan
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPlan
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPlan
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPlan
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ColumnStats{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPlan(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPlan
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Cols[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ColData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ColData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ColData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowCount", wireType)
+			}
+			m.RowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NullCount", wireType)
+			}
+			m.NullCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NullCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nulls = append(m.Nulls, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.Nulls) == 0 {
+					m.Nulls = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nulls = append(m.Nulls, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nulls", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.I32 = append(m.I32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.I32) == 0 {
+					m.I32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.I32 = append(m.I32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field I32", wireType)
+			}
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.I64 = append(m.I64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.I64) == 0 {
+					m.I64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.I64 = append(m.I64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field I64", wireType)
+			}
+		case 6:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.F32 = append(m.F32, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.F32) == 0 {
+					m.F32 = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
 					if (iNdEx + 4) > l {
 						return io.ErrUnexpectedEOF
 					}
@@ -17660,68 +22117,1692 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 					v2 := float32(math.Float32frombits(v))
 					m.F32 = append(m.F32, v2)
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field F32", wireType)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field F32", wireType)
+			}
+		case 7:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.F64 = append(m.F64, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.F64) == 0 {
+					m.F64 = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.F64 = append(m.F64, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field F64", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RowsetData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RowsetData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RowsetData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &TableDef{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, &ColData{})
+			if err := m.Cols[len(m.Cols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBySpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag", wireType)
+			}
+			m.Flag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flag |= OrderBySpec_OrderByFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrameBound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrameBound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FrameBound_BoundType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbounded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unbounded = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Val == nil {
+				m.Val = &Expr{}
+			}
+			if err := m.Val.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrameClause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrameClause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrameClause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FrameClause_FrameType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &FrameBound{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &FrameBound{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionBy = append(m.PartitionBy, &Expr{})
+			if err := m.PartitionBy[len(m.PartitionBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = append(m.OrderBy, &OrderBySpec{})
+			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lead", wireType)
+			}
+			m.Lead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lead |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+			}
+			m.Lag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lag |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowFunc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindowFunc == nil {
+				m.WindowFunc = &Expr{}
+			}
+			if err := m.WindowFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Frame == nil {
+				m.Frame = &FrameClause{}
+			}
+			if err := m.Frame.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JsonTable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JsonTable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JsonTable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Doc == nil {
+				m.Doc = &Expr{}
+			}
+			if err := m.Doc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, &JsonTableColumn{})
+			if err := m.Cols[len(m.Cols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Outer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JsonTableColumn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JsonTableColumn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JsonTableColumn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= JsonTableColumn_Kind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateCtx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TblName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TblName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriKeyIdx", wireType)
+			}
+			m.PriKeyIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriKeyIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HideKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HideKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HideKeyIdx", wireType)
+			}
+			m.HideKeyIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HideKeyIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateCols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		case 7:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateCols = append(m.UpdateCols, &ColDef{})
+			if err := m.UpdateCols[len(m.UpdateCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherAttrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.F64 = append(m.F64, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherAttrs = append(m.OtherAttrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderAttrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderAttrs = append(m.OrderAttrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAttrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexAttrs = append(m.IndexAttrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalyzeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalyzeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputRows", wireType)
+			}
+			m.InputRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRows", wireType)
+			}
+			m.OutputRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputSize", wireType)
+			}
+			m.InputSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputSize", wireType)
+			}
+			m.OutputSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeConsumed", wireType)
+			}
+			m.TimeConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.F64) == 0 {
-					m.F64 = make([]float64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.F64 = append(m.F64, v2)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeConsumed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field F64", wireType)
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemorySize", wireType)
 			}
-			var stringLen uint64
+			m.MemorySize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17731,24 +23812,30 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MemorySize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedBlocks", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.PrunedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrunedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.S = append(m.S, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -17771,7 +23858,7 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RowsetData) Unmarshal(dAtA []byte) error {
+func (m *Node) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17794,15 +23881,53 @@ func (m *RowsetData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RowsetData: wiretype end group for non-group")
+			return fmt.Errorf("proto: Node: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RowsetData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeType", wireType)
+			}
+			m.NodeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeType |= Node_NodeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			m.NodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17829,16 +23954,145 @@ func (m *RowsetData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Schema == nil {
-				m.Schema = &TableDef{}
+			if m.Cost == nil {
+				m.Cost = &Cost{}
 			}
-			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectList = append(m.ProjectList, &Expr{})
+			if err := m.ProjectList[len(m.ProjectList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Children = append(m.Children, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Children) == 0 {
+					m.Children = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Children = append(m.Children, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinType", wireType)
+			}
+			m.JoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinType |= Node_JoinFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17865,65 +24119,14 @@ func (m *RowsetData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cols = append(m.Cols, &ColData{})
-			if err := m.Cols[len(m.Cols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OnList = append(m.OnList, &Expr{})
+			if err := m.OnList[len(m.OnList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OrderBySpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderBySpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17950,18 +24153,16 @@ func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expr == nil {
-				m.Expr = &Expr{}
-			}
-			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FilterList = append(m.FilterList, &Expr{})
+			if err := m.FilterList[len(m.FilterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17971,29 +24172,31 @@ func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collation = string(dAtA[iNdEx:postIndex])
+			m.GroupBy = append(m.GroupBy, &Expr{})
+			if err := m.GroupBy[len(m.GroupBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupingSet", wireType)
 			}
-			m.Flag = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18003,65 +24206,29 @@ func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Flag |= OrderBySpec_OrderByFlag(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WindowSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.GroupingSet = append(m.GroupingSet, &Expr{})
+			if err := m.GroupingSet[len(m.GroupingSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WindowSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18088,12 +24255,12 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartitionBy = append(m.PartitionBy, &Expr{})
-			if err := m.PartitionBy[len(m.PartitionBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AggList = append(m.AggList, &Expr{})
+			if err := m.AggList[len(m.AggList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
 			}
@@ -18127,30 +24294,11 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lead", wireType)
-			}
-			m.Lead = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Lead |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateCtxs", wireType)
 			}
-			m.Lag = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18160,67 +24308,31 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Lag |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UpdateCtxs = append(m.UpdateCtxs, &UpdateCtx{})
+			if err := m.UpdateCtxs[len(m.UpdateCtxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateCtx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateCtx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WinSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18230,29 +24342,33 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			if m.WinSpec == nil {
+				m.WinSpec = &WindowSpec{}
+			}
+			if err := m.WinSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TblName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18262,29 +24378,33 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TblName = string(dAtA[iNdEx:postIndex])
+			if m.Limit == nil {
+				m.Limit = &Expr{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18294,29 +24414,33 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriKey = string(dAtA[iNdEx:postIndex])
+			if m.Offset == nil {
+				m.Offset = &Expr{}
+			}
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriKeyIdx", wireType)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
 			}
-			m.PriKeyIdx = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18326,16 +24450,33 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PriKeyIdx |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HideKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDefVec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18345,29 +24486,31 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HideKey = string(dAtA[iNdEx:postIndex])
+			m.TableDefVec = append(m.TableDefVec, &TableDef{})
+			if err := m.TableDefVec[len(m.TableDefVec)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HideKeyIdx", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjRef", wireType)
 			}
-			m.HideKeyIdx = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18377,14 +24520,31 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HideKeyIdx |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObjRef == nil {
+				m.ObjRef = &ObjectRef{}
+			}
+			if err := m.ObjRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateCols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsetData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18411,14 +24571,16 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateCols = append(m.UpdateCols, &ColDef{})
-			if err := m.UpdateCols[len(m.UpdateCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RowsetData == nil {
+				m.RowsetData = &RowsetData{}
+			}
+			if err := m.RowsetData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OtherAttrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18446,13 +24608,13 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OtherAttrs = append(m.OtherAttrs, string(dAtA[iNdEx:postIndex]))
+			m.ExtraOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderAttrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteTablesCtx", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18462,99 +24624,107 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderAttrs = append(m.OrderAttrs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
+			m.DeleteTablesCtx = append(m.DeleteTablesCtx, &DeleteTableCtx{})
+			if err := m.DeleteTablesCtx[len(m.DeleteTablesCtx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AnalyzeInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalyzeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputRows", wireType)
-			}
-			m.InputRows = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
+			iNdEx = postIndex
+		case 23:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BindingTags = append(m.BindingTags, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InputRows |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BindingTags) == 0 {
+					m.BindingTags = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BindingTags = append(m.BindingTags, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingTags", wireType)
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRows", wireType)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalyzeInfo", wireType)
 			}
-			m.OutputRows = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18564,16 +24734,33 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutputRows |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AnalyzeInfo == nil {
+				m.AnalyzeInfo = &AnalyzeInfo{}
+			}
+			if err := m.AnalyzeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowIdx", wireType)
 			}
-			m.InputSize = 0
+			m.WindowIdx = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18583,16 +24770,16 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InputSize |= int64(b&0x7F) << shift
+				m.WindowIdx |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputSize", wireType)
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexScan", wireType)
 			}
-			m.OutputSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18602,16 +24789,33 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutputSize |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeConsumed", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			m.TimeConsumed = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IndexScan == nil {
+				m.IndexScan = &IndexScan{}
+			}
+			if err := m.IndexScan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonTable", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18621,16 +24825,33 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeConsumed |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemorySize", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			m.MemorySize = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JsonTable == nil {
+				m.JsonTable = &JsonTable{}
+			}
+			if err := m.JsonTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecursiveCte", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18640,11 +24861,28 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemorySize |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecursiveCte == nil {
+				m.RecursiveCte = &RecursiveCte{}
+			}
+			if err := m.RecursiveCte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -18667,7 +24905,7 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Node) Unmarshal(dAtA []byte) error {
+func (m *RecursiveCte) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18690,55 +24928,17 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecursiveCte: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecursiveCte: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeType", wireType)
-			}
-			m.NodeType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeType |= Node_NodeType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
-			}
-			m.NodeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18748,33 +24948,29 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cost == nil {
-				m.Cost = &Cost{}
-			}
-			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ColNames", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18784,107 +24980,49 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProjectList = append(m.ProjectList, &Expr{})
-			if err := m.ProjectList[len(m.ProjectList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Children = append(m.Children, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
+				return io.ErrUnexpectedEOF
+			}
+			m.ColNames = append(m.ColNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnionAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Children) == 0 {
-					m.Children = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Children = append(m.Children, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
-		case 6:
+			m.UnionAll = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepth", wireType)
 			}
-			m.JoinType = 0
+			m.MaxDepth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18894,14 +25032,65 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JoinType |= Node_JoinFlag(b&0x7F) << shift
+				m.MaxDepth |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexScan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexScan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexScan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexDef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18928,14 +25117,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnList = append(m.OnList, &Expr{})
-			if err := m.OnList[len(m.OnList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.IndexDef == nil {
+				m.IndexDef = &IndexDef{}
+			}
+			if err := m.IndexDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilterList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18962,14 +25153,14 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FilterList = append(m.FilterList, &Expr{})
-			if err := m.FilterList[len(m.FilterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Keys = append(m.Keys, &ExprList{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18996,16 +25187,67 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupBy = append(m.GroupBy, &Expr{})
-			if err := m.GroupBy[len(m.GroupBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FilterList = append(m.FilterList, &Expr{})
+			if err := m.FilterList[len(m.FilterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTableCtx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTableCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupingSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19015,31 +25257,29 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupingSet = append(m.GroupingSet, &Expr{})
-			if err := m.GroupingSet[len(m.GroupingSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TblName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19049,31 +25289,29 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggList = append(m.AggList, &Expr{})
-			if err := m.AggList[len(m.AggList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TblName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDeleteKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19083,31 +25321,69 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = append(m.OrderBy, &OrderBySpec{})
-			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UseDeleteKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanTruncate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanTruncate = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsHideKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 13:
+			m.IsHideKey = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateCtxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAttrs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19117,31 +25393,29 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateCtxs = append(m.UpdateCtxs, &UpdateCtx{})
-			if err := m.UpdateCtxs[len(m.UpdateCtxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IndexAttrs = append(m.IndexAttrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WinSpec", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAttrsIdx", wireType)
 			}
-			var msglen int
+			m.IndexAttrsIdx = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19151,33 +25425,67 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.IndexAttrsIdx |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WinSpec == nil {
-				m.WinSpec = &WindowSpec{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
 			}
-			if err := m.WinSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StmtType", wireType)
+			}
+			m.StmtType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19187,31 +25495,90 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StmtType |= Query_StatementType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Limit == nil {
-				m.Limit = &Expr{}
-			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Steps = append(m.Steps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Steps) == 0 {
+					m.Steps = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Steps = append(m.Steps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19238,16 +25605,14 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Offset == nil {
-				m.Offset = &Expr{}
-			}
-			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, &Node{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19274,18 +25639,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
-			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Params = append(m.Params, &Expr{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDefVec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headings", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19295,31 +25658,80 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headings = append(m.Headings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransationControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableDefVec = append(m.TableDefVec, &TableDef{})
-			if err := m.TableDefVec[len(m.TableDefVec)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjRef", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransationControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransationControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TclType", wireType)
 			}
-			var msglen int
+			m.TclType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19329,31 +25741,14 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TclType |= TransationControl_TclType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ObjRef == nil {
-				m.ObjRef = &ObjectRef{}
-			}
-			if err := m.ObjRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowsetData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19380,48 +25775,15 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RowsetData == nil {
-				m.RowsetData = &RowsetData{}
-			}
-			if err := m.RowsetData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &TransationBegin{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Action = &TransationControl_Begin{v}
 			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOptions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExtraOptions = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 22:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteTablesCtx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19448,90 +25810,15 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeleteTablesCtx = append(m.DeleteTablesCtx, &DeleteTableCtx{})
-			if err := m.DeleteTablesCtx[len(m.DeleteTablesCtx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &TransationCommit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Action = &TransationControl_Commit{v}
 			iNdEx = postIndex
-		case 23:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BindingTags = append(m.BindingTags, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BindingTags) == 0 {
-					m.BindingTags = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BindingTags = append(m.BindingTags, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BindingTags", wireType)
-			}
-		case 24:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalyzeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19558,12 +25845,11 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AnalyzeInfo == nil {
-				m.AnalyzeInfo = &AnalyzeInfo{}
-			}
-			if err := m.AnalyzeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &TransationRollback{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Action = &TransationControl_Rollback{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19587,7 +25873,7 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
+func (m *TransationBegin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19610,17 +25896,17 @@ func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteTableCtx: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransationBegin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteTableCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransationBegin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var stringLen uint64
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19630,61 +25916,67 @@ func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Mode |= TransationBegin_TransationMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TblName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransationCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TblName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseDeleteKey", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransationCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransationCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionType", wireType)
+			}
+			m.CompletionType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19694,49 +25986,67 @@ func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CompletionType |= TransationCompletionType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UseDeleteKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanTruncate", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransationRollback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.CanTruncate = bool(v != 0)
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransationRollback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransationRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsHideKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionType", wireType)
 			}
-			var v int
+			m.CompletionType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19746,12 +26056,11 @@ func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CompletionType |= TransationCompletionType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsHideKey = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -19774,7 +26083,7 @@ func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Query) Unmarshal(dAtA []byte) error {
+func (m *Plan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19797,17 +26106,17 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StmtType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			m.StmtType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19817,90 +26126,65 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StmtType |= Query_StatementType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Query{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Plan = &Plan_Query{v}
+			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Steps = append(m.Steps, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tcl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Steps) == 0 {
-					m.Steps = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Steps = append(m.Steps, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TransationControl{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Plan = &Plan_Tcl{v}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ddl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19927,14 +26211,15 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &Node{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &DataDefinition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Plan = &Plan_Ddl{v}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dcl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19961,16 +26246,17 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, &Expr{})
-			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &DataControl{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Plan = &Plan_Dcl{v}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19980,23 +26266,26 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headings = append(m.Headings, string(dAtA[iNdEx:postIndex]))
+			v := &InsertValues{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Plan = &Plan_Ins{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20020,7 +26309,7 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransationControl) Unmarshal(dAtA []byte) error {
+func (m *InsertValues) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20043,17 +26332,17 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransationControl: wiretype end group for non-group")
+			return fmt.Errorf("proto: InsertValues: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InsertValues: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TclType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			m.TclType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -20063,16 +26352,29 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TclType |= TransationControl_TclType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TblName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -20082,30 +26384,27 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationBegin{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Action = &TransationControl_Begin{v}
+			m.TblName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitCols", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20132,15 +26431,14 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationCommit{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExplicitCols = append(m.ExplicitCols, &ColDef{})
+			if err := m.ExplicitCols[len(m.ExplicitCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Action = &TransationControl_Commit{v}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherCols", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20167,68 +26465,48 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationRollback{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OtherCols = append(m.OtherCols, &ColDef{})
+			if err := m.OtherCols[len(m.OtherCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Action = &TransationControl_Rollback{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderAttrs", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransationBegin) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransationBegin: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationBegin: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			m.OrderAttrs = append(m.OrderAttrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
-			m.Mode = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -20238,67 +26516,31 @@ func (m *TransationBegin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= TransationBegin_TransationMode(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransationCommit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Columns = append(m.Columns, &Column{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransationCommit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationCommit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionType", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositePkey", wireType)
 			}
-			m.CompletionType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -20308,11 +26550,28 @@ func (m *TransationCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletionType |= TransationCompletionType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompositePkey == nil {
+				m.CompositePkey = &ColDef{}
+			}
+			if err := m.CompositePkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -20335,7 +26594,7 @@ func (m *TransationCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransationRollback) Unmarshal(dAtA []byte) error {
+func (m *Column) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20358,17 +26617,17 @@ func (m *TransationRollback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransationRollback: wiretype end group for non-group")
+			return fmt.Errorf("proto: column: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: column: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
-			m.CompletionType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -20378,11 +26637,26 @@ func (m *TransationRollback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletionType |= TransationCompletionType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Column = append(m.Column, &Expr{})
+			if err := m.Column[len(m.Column)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -20405,7 +26679,7 @@ func (m *TransationRollback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plan) Unmarshal(dAtA []byte) error {
+func (m *DataControl) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20428,17 +26702,17 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataControl: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataControl: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DclType", wireType)
 			}
-			var msglen int
+			m.DclType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -20448,30 +26722,14 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DclType |= DataControl_DclType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &Query{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Plan = &Plan_Query{v}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tcl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetVariables", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20498,15 +26756,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationControl{}
+			v := &SetVariables{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Plan = &Plan_Tcl{v}
+			m.Control = &DataControl_SetVariables{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ddl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20533,15 +26791,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DataDefinition{}
+			v := &Prepare{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Plan = &Plan_Ddl{v}
+			m.Control = &DataControl_Prepare{v}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dcl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execute", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20568,15 +26826,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DataControl{}
+			v := &Execute{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Plan = &Plan_Dcl{v}
+			m.Control = &DataControl_Execute{v}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deallocate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20603,11 +26861,11 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &InsertValues{}
+			v := &Deallocate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Plan = &Plan_Ins{v}
+			m.Control = &DataControl_Deallocate{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20631,7 +26889,7 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertValues) Unmarshal(dAtA []byte) error {
+func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20654,17 +26912,17 @@ func (m *InsertValues) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertValues: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataDefinition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertValues: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DdlType", wireType)
 			}
-			var stringLen uint64
+			m.DdlType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -20674,29 +26932,16 @@ func (m *InsertValues) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DdlType |= DataDefinition_DdlType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TblName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -20706,27 +26951,31 @@ func (m *InsertValues) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TblName = string(dAtA[iNdEx:postIndex])
+			if m.Query == nil {
+				m.Query = &Query{}
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitCols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateDatabase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20753,14 +27002,15 @@ func (m *InsertValues) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExplicitCols = append(m.ExplicitCols, &ColDef{})
-			if err := m.ExplicitCols[len(m.ExplicitCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &CreateDatabase{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_CreateDatabase{v}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OtherCols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterDatabase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20787,16 +27037,17 @@ func (m *InsertValues) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OtherCols = append(m.OtherCols, &ColDef{})
-			if err := m.OtherCols[len(m.OtherCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &AlterDatabase{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_AlterDatabase{v}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderAttrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropDatabase", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -20806,27 +27057,30 @@ func (m *InsertValues) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderAttrs = append(m.OrderAttrs, string(dAtA[iNdEx:postIndex]))
+			v := &DropDatabase{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Definition = &DataDefinition_DropDatabase{v}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20853,14 +27107,15 @@ func (m *InsertValues) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Columns = append(m.Columns, &Column{})
-			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &CreateTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_CreateTable{v}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompositePkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20887,67 +27142,15 @@ func (m *InsertValues) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompositePkey == nil {
-				m.CompositePkey = &ColDef{}
-			}
-			if err := m.CompositePkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &AlterTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_AlterTable{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Column) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: column: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: column: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20967,74 +27170,24 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Column = append(m.Column, &Expr{})
-			if err := m.Column[len(m.Column)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DataControl) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &DropTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DataControl: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataControl: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DclType", wireType)
+			m.Definition = &DataDefinition_DropTable{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateIndex", wireType)
 			}
-			m.DclType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21044,14 +27197,30 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DclType |= DataControl_DclType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CreateIndex{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Definition = &DataDefinition_CreateIndex{v}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetVariables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21078,15 +27247,15 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SetVariables{}
+			v := &AlterIndex{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Control = &DataControl_SetVariables{v}
+			m.Definition = &DataDefinition_AlterIndex{v}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prepare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21113,15 +27282,15 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Prepare{}
+			v := &DropIndex{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Control = &DataControl_Prepare{v}
+			m.Definition = &DataDefinition_DropIndex{v}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncateTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21148,15 +27317,15 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Execute{}
+			v := &TruncateTable{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Control = &DataControl_Execute{v}
+			m.Definition = &DataDefinition_TruncateTable{v}
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deallocate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShowVariables", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21183,11 +27352,11 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Deallocate{}
+			v := &ShowVariables{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Control = &DataControl_Deallocate{v}
+			m.Definition = &DataDefinition_ShowVariables{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21211,7 +27380,7 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataDefinition) Unmarshal(dAtA []byte) error {
+func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21234,17 +27403,17 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataDefinition: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateDatabase: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DdlType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
 			}
-			m.DdlType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21254,16 +27423,17 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DdlType |= DataDefinition_DdlType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IfNotExists = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21273,138 +27443,80 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Query == nil {
-				m.Query = &Query{}
-			}
-			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateDatabase", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &CreateDatabase{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_CreateDatabase{v}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterDatabase", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterDatabase{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Definition = &DataDefinition_AlterDatabase{v}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropDatabase", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DropDatabase{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Definition = &DataDefinition_DropDatabase{v}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTable", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterDatabase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21414,32 +27526,17 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &CreateTable{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Definition = &DataDefinition_CreateTable{v}
-			iNdEx = postIndex
-		case 7:
+			m.IfExists = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21449,32 +27546,80 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterTable{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterTable{v}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropTable", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DropDatabase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DropDatabase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DropDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21484,32 +27629,17 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &DropTable{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Definition = &DataDefinition_DropTable{v}
-			iNdEx = postIndex
-		case 9:
+			m.IfExists = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21519,32 +27649,80 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CreateIndex{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_CreateIndex{v}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterIndex", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21554,32 +27732,17 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &AlterIndex{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Definition = &DataDefinition_AlterIndex{v}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropIndex", wireType)
+			m.IfNotExists = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Temporary", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21589,32 +27752,17 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &DropIndex{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Definition = &DataDefinition_DropIndex{v}
-			iNdEx = postIndex
-		case 12:
+			m.Temporary = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TruncateTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21624,30 +27772,27 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &TruncateTable{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				return io.ErrUnexpectedEOF
 			}
-			m.Definition = &DataDefinition_TruncateTable{v}
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShowVariables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21674,11 +27819,12 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ShowVariables{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_ShowVariables{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21702,7 +27848,7 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
+func (m *AlterTable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21725,17 +27871,17 @@ func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateDatabase: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21745,13 +27891,61 @@ func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IfNotExists = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
@@ -21783,6 +27977,40 @@ func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 			}
 			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, &AlterTableAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -21805,7 +28033,7 @@ func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
+func (m *AlterRenameColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21828,17 +28056,17 @@ func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterDatabase: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterRenameColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterRenameColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21848,15 +28076,27 @@ func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IfExists = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21884,7 +28124,7 @@ func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.NewName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21908,7 +28148,7 @@ func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DropDatabase) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21931,17 +28171,17 @@ func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DropDatabase: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DropDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddColumn", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -21951,15 +28191,30 @@ func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IfExists = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ColDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTableAction_AddColumn{v}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropColumn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21987,64 +28242,13 @@ func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.Action = &AlterTableAction_DropColumn{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateTable) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTable: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTable: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameColumn", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -22054,17 +28258,32 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IfNotExists = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Temporary", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterRenameColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTableAction_RenameColumn{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyColumn", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -22074,17 +28293,32 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Temporary = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ColDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTableAction_ModifyColumn{v}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddIndex", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -22094,27 +28328,30 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			v := &CreateIndex{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTableAction_AddIndex{v}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22141,67 +28378,15 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
-			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &DropIndex{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Action = &AlterTableAction_DropIndex{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AlterTable) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTable: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTable: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameTable", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22229,11 +28414,11 @@ func (m *AlterTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Table = string(dAtA[iNdEx:postIndex])
+			m.Action = &AlterTableAction_RenameTable{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22260,12 +28445,11 @@ func (m *AlterTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
-			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &PropertiesDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Action = &AlterTableAction_Properties{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22505,6 +28689,106 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 			}
 			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IndexDef == nil {
+				m.IndexDef = &IndexDef{}
+			}
+			if err := m.IndexDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -22691,6 +28975,70 @@ func (m *DropIndex) Unmarshal(dAtA []byte) error {
 			}
 			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
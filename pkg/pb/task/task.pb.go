@@ -34,18 +34,22 @@ const (
 	TaskStatus_Running TaskStatus = 1
 	// Completed the task has been completed.
 	TaskStatus_Completed TaskStatus = 2
+	// Paused the task is paused, it is not scheduled until it is resumed.
+	TaskStatus_Paused TaskStatus = 3
 )
 
 var TaskStatus_name = map[int32]string{
 	0: "Created",
 	1: "Running",
 	2: "Completed",
+	3: "Paused",
 }
 
 var TaskStatus_value = map[string]int32{
 	"Created":   0,
 	"Running":   1,
 	"Completed": 2,
+	"Paused":    3,
 }
 
 func (x TaskStatus) String() string {
@@ -64,16 +68,20 @@ const (
 	ResultCode_Success ResultCode = 0
 	// Failed failed
 	ResultCode_Failed ResultCode = 1
+	// Canceled the task is canceled before it is completed
+	ResultCode_Canceled ResultCode = 2
 )
 
 var ResultCode_name = map[int32]string{
 	0: "Success",
 	1: "Failed",
+	2: "Canceled",
 }
 
 var ResultCode_value = map[string]int32{
-	"Success": 0,
-	"Failed":  1,
+	"Success":  0,
+	"Failed":   1,
+	"Canceled": 2,
 }
 
 func (x ResultCode) String() string {
@@ -522,44 +530,45 @@ func init() {
 func init() { proto.RegisterFile("task.proto", fileDescriptor_ce5d8dd45b4a91ff) }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe7, 0x36, 0xeb, 0x9f, 0xb7, 0xeb, 0x14, 0x0c, 0x87, 0xa8, 0x87, 0x52, 0x45, 0x43,
-	0xaa, 0x26, 0xb1, 0x8a, 0x01, 0x07, 0x4e, 0x68, 0x6b, 0x87, 0xa8, 0x60, 0x80, 0xbc, 0x71, 0xe1,
-	0xe6, 0x36, 0x26, 0x8b, 0xd6, 0xc6, 0x91, 0xf3, 0x06, 0x65, 0x27, 0x8e, 0x7c, 0x2d, 0x8e, 0x3b,
-	0xee, 0x13, 0x20, 0xd8, 0x17, 0xe0, 0x2b, 0x20, 0xdb, 0x49, 0xd6, 0xec, 0xcc, 0x2d, 0xcf, 0xf3,
-	0xbc, 0xb6, 0xdf, 0xf7, 0x67, 0x2b, 0x00, 0xc8, 0xd3, 0xcb, 0x83, 0x44, 0x49, 0x94, 0xd4, 0xd1,
-	0xdf, 0x83, 0xa7, 0x61, 0x84, 0x17, 0xd9, 0xe2, 0x60, 0x29, 0xd7, 0x93, 0x50, 0x86, 0x72, 0x62,
-	0xc2, 0x45, 0xf6, 0xd5, 0x28, 0x23, 0xcc, 0x97, 0x5d, 0xe4, 0xff, 0x20, 0xb0, 0x73, 0xce, 0xd3,
-	0xcb, 0x53, 0x81, 0x3c, 0xe0, 0xc8, 0xe9, 0x2e, 0x34, 0xe6, 0x33, 0x8f, 0x8c, 0xc8, 0xb8, 0xcb,
-	0x1a, 0xf3, 0x19, 0x1d, 0x40, 0xe7, 0x24, 0x17, 0xcb, 0x0c, 0xa5, 0xf2, 0x1a, 0x23, 0x32, 0xee,
-	0xb3, 0x4a, 0x53, 0x0f, 0xda, 0x53, 0x19, 0xa3, 0xc8, 0xd1, 0x6b, 0x8e, 0xc8, 0x78, 0x87, 0x95,
-	0x92, 0x3e, 0x83, 0xf6, 0xc7, 0x04, 0x23, 0x19, 0xa7, 0x9e, 0x33, 0x22, 0xe3, 0xde, 0xe1, 0x83,
-	0x03, 0xd3, 0xa9, 0x3e, 0xaa, 0x08, 0x8e, 0x9d, 0xeb, 0x5f, 0x8f, 0xb7, 0x58, 0x59, 0xe7, 0x7f,
-	0x87, 0xde, 0x46, 0x4a, 0xf7, 0xa0, 0x7f, 0xca, 0x73, 0x26, 0x50, 0x5d, 0x9d, 0x47, 0x6b, 0x91,
-	0x9a, 0x96, 0xfa, 0xac, 0x6e, 0xea, 0x2a, 0xa3, 0xe6, 0x31, 0x0a, 0xf5, 0x8d, 0xaf, 0x4c, 0x8b,
-	0x4d, 0x56, 0x37, 0x75, 0xd5, 0x4c, 0xac, 0xf8, 0xd5, 0x2c, 0x53, 0x5c, 0xef, 0x6e, 0xba, 0x6d,
-	0xb2, 0xba, 0xe9, 0xbf, 0x83, 0xbe, 0x9d, 0x4c, 0x30, 0x91, 0x66, 0x2b, 0xa4, 0x7b, 0xe0, 0x4c,
-	0x65, 0x20, 0xcc, 0xc9, 0xbb, 0x87, 0xae, 0x9d, 0xc0, 0x66, 0xda, 0x67, 0x26, 0xa5, 0x8f, 0x60,
-	0xfb, 0x44, 0xa9, 0x82, 0x4e, 0x97, 0x59, 0xe1, 0xff, 0x6d, 0x80, 0xa3, 0xc7, 0xd9, 0xe0, 0xe9,
-	0x18, 0x9e, 0x2f, 0xa0, 0x53, 0xb2, 0x36, 0x2b, 0x7a, 0x87, 0xf4, 0x0e, 0x4d, 0x99, 0x14, 0x6c,
-	0xaa, 0x4a, 0xea, 0xc3, 0xce, 0x27, 0xae, 0x44, 0x8c, 0xba, 0x6a, 0x3e, 0x33, 0x03, 0x74, 0x59,
-	0xcd, 0xa3, 0x63, 0x68, 0x9d, 0x21, 0xc7, 0xcc, 0x22, 0xaf, 0x1a, 0xd6, 0xa9, 0xf5, 0x59, 0x91,
-	0xd3, 0x21, 0x80, 0x76, 0x59, 0x16, 0xc7, 0x42, 0x79, 0xdb, 0x66, 0xaf, 0x0d, 0xc7, 0x8c, 0x94,
-	0xc8, 0xe5, 0x85, 0xd7, 0x32, 0xcc, 0xad, 0xd0, 0x14, 0xdf, 0xf3, 0x14, 0xdf, 0x0a, 0xae, 0x70,
-	0x21, 0x38, 0x7a, 0x6d, 0x4b, 0xb1, 0x66, 0xea, 0xf7, 0x32, 0x55, 0x82, 0xa3, 0x38, 0x42, 0xaf,
-	0x63, 0x0a, 0x2a, 0x4d, 0x47, 0xd0, 0x9b, 0xca, 0x75, 0xb2, 0x12, 0x28, 0x82, 0x23, 0xf4, 0xba,
-	0x26, 0xde, 0xb4, 0xe8, 0xab, 0x7b, 0x77, 0xe0, 0x81, 0x41, 0xf4, 0xd0, 0x8e, 0x52, 0x8b, 0x58,
-	0xbd, 0xd2, 0xff, 0x49, 0xf4, 0xc9, 0x32, 0xfe, 0x8f, 0xd4, 0x07, 0x76, 0xc7, 0x93, 0x3c, 0x51,
-	0x05, 0xf1, 0x4a, 0xeb, 0xec, 0x83, 0xc8, 0x51, 0x3f, 0x43, 0xc3, 0xbb, 0xc9, 0x2a, 0x5d, 0x63,
-	0xb0, 0x7d, 0x8f, 0xc1, 0x00, 0x3a, 0x9f, 0x93, 0xc0, 0x66, 0x2d, 0x9b, 0x95, 0x7a, 0xff, 0xa5,
-	0xbd, 0x97, 0xe2, 0x96, 0x7a, 0xd0, 0xb6, 0xab, 0x02, 0x77, 0x4b, 0x0b, 0x7d, 0x39, 0x51, 0x1c,
-	0xba, 0x84, 0xf6, 0xa1, 0x5b, 0x41, 0x73, 0x1b, 0xfb, 0x4f, 0x00, 0xee, 0x5e, 0xa5, 0xae, 0x3c,
-	0xcb, 0x96, 0x4b, 0x91, 0xa6, 0xee, 0x16, 0x05, 0x68, 0xbd, 0xe1, 0xd1, 0x4a, 0x04, 0x2e, 0x39,
-	0x7e, 0x7d, 0xf3, 0x67, 0x48, 0xae, 0x6f, 0x87, 0xe4, 0xe6, 0x76, 0x48, 0x7e, 0xdf, 0x0e, 0xc9,
-	0x97, 0xcd, 0x7f, 0xc5, 0x9a, 0xa3, 0x8a, 0x72, 0xa9, 0xa2, 0x30, 0x8a, 0x4b, 0x11, 0x8b, 0x49,
-	0x72, 0x19, 0x4e, 0x92, 0xc5, 0x44, 0xb3, 0x5a, 0xb4, 0xcc, 0x2f, 0xe3, 0xf9, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x31, 0xd5, 0xdd, 0x07, 0x75, 0x04, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xeb, 0x24, 0xcd, 0x9f, 0x49, 0x52, 0x2d, 0x86, 0xc3, 0x2a, 0x87, 0x10, 0x45, 0x3d,
+	0x44, 0x95, 0x68, 0x44, 0xcb, 0x85, 0x13, 0x6a, 0x93, 0x22, 0x22, 0x28, 0x54, 0x6e, 0xb9, 0x70,
+	0x73, 0xb2, 0x43, 0xba, 0x6a, 0xb2, 0x5e, 0x79, 0x67, 0x51, 0x7a, 0xe2, 0xc8, 0x6b, 0x71, 0xec,
+	0xb1, 0x4f, 0x80, 0x20, 0x2f, 0xc0, 0x2b, 0x20, 0xdb, 0xd9, 0x6d, 0xb6, 0x67, 0x6e, 0xfb, 0x7d,
+	0xdf, 0xd8, 0x9e, 0xf9, 0xd9, 0x5a, 0x00, 0x92, 0xc9, 0xcd, 0x61, 0xac, 0x15, 0x29, 0x5e, 0x31,
+	0xdf, 0x9d, 0x17, 0xf3, 0x90, 0xae, 0xd3, 0xe9, 0xe1, 0x4c, 0x2d, 0x87, 0x73, 0x35, 0x57, 0x43,
+	0x1b, 0x4e, 0xd3, 0xaf, 0x56, 0x59, 0x61, 0xbf, 0xdc, 0xa2, 0xfe, 0x0f, 0x06, 0xad, 0x2b, 0x99,
+	0xdc, 0x9c, 0x23, 0xc9, 0x40, 0x92, 0xe4, 0x7b, 0x50, 0x9a, 0x8c, 0x7d, 0xd6, 0x63, 0x83, 0x86,
+	0x28, 0x4d, 0xc6, 0xbc, 0x03, 0xf5, 0xb3, 0x15, 0xce, 0x52, 0x52, 0xda, 0x2f, 0xf5, 0xd8, 0xa0,
+	0x2d, 0x72, 0xcd, 0x7d, 0xa8, 0x8d, 0x54, 0x44, 0xb8, 0x22, 0xbf, 0xdc, 0x63, 0x83, 0x96, 0xc8,
+	0x24, 0x7f, 0x09, 0xb5, 0x4f, 0x31, 0x85, 0x2a, 0x4a, 0xfc, 0x4a, 0x8f, 0x0d, 0x9a, 0x47, 0x4f,
+	0x0e, 0x6d, 0xa7, 0xe6, 0xa8, 0x4d, 0x70, 0x5a, 0xb9, 0xfb, 0xf5, 0x7c, 0x47, 0x64, 0x75, 0xfd,
+	0xef, 0xd0, 0xdc, 0x4a, 0xf9, 0x3e, 0xb4, 0xcf, 0xe5, 0x4a, 0x20, 0xe9, 0xdb, 0xab, 0x70, 0x89,
+	0x89, 0x6d, 0xa9, 0x2d, 0x8a, 0xa6, 0xa9, 0xb2, 0x6a, 0x12, 0x11, 0xea, 0x6f, 0x72, 0x61, 0x5b,
+	0x2c, 0x8b, 0xa2, 0x69, 0xaa, 0xc6, 0xb8, 0x90, 0xb7, 0xe3, 0x54, 0x4b, 0xb3, 0xbb, 0xed, 0xb6,
+	0x2c, 0x8a, 0x66, 0xff, 0x3d, 0xb4, 0xdd, 0x64, 0x28, 0x30, 0x49, 0x17, 0xc4, 0xf7, 0xa1, 0x32,
+	0x52, 0x01, 0xda, 0x93, 0xf7, 0x8e, 0x3c, 0x37, 0x81, 0xcb, 0x8c, 0x2f, 0x6c, 0xca, 0x9f, 0xc1,
+	0xee, 0x99, 0xd6, 0x1b, 0x3a, 0x0d, 0xe1, 0x44, 0xff, 0x6f, 0x09, 0x2a, 0x66, 0x9c, 0x2d, 0x9e,
+	0x15, 0xcb, 0xf3, 0x15, 0xd4, 0x33, 0xd6, 0x76, 0x45, 0xf3, 0x88, 0x3f, 0xa0, 0xc9, 0x92, 0x0d,
+	0x9b, 0xbc, 0x92, 0xf7, 0xa1, 0x75, 0x21, 0x35, 0x46, 0x64, 0xaa, 0x26, 0x63, 0x3b, 0x40, 0x43,
+	0x14, 0x3c, 0x3e, 0x80, 0xea, 0x25, 0x49, 0x4a, 0x1d, 0xf2, 0xbc, 0x61, 0x93, 0x3a, 0x5f, 0x6c,
+	0x72, 0xde, 0x05, 0x30, 0xae, 0x48, 0xa3, 0x08, 0xb5, 0xbf, 0x6b, 0xf7, 0xda, 0x72, 0xec, 0x48,
+	0xb1, 0x9a, 0x5d, 0xfb, 0x55, 0xcb, 0xdc, 0x09, 0x43, 0xf1, 0x83, 0x4c, 0xe8, 0x1d, 0x4a, 0x4d,
+	0x53, 0x94, 0xe4, 0xd7, 0x1c, 0xc5, 0x82, 0x69, 0xde, 0xcb, 0x48, 0xa3, 0x24, 0x3c, 0x21, 0xbf,
+	0x6e, 0x0b, 0x72, 0xcd, 0x7b, 0xd0, 0x1c, 0xa9, 0x65, 0xbc, 0x40, 0xc2, 0xe0, 0x84, 0xfc, 0x86,
+	0x8d, 0xb7, 0x2d, 0xfe, 0xfa, 0xd1, 0x1d, 0xf8, 0x60, 0x11, 0x3d, 0x75, 0xa3, 0x14, 0x22, 0x51,
+	0xac, 0xec, 0xff, 0x64, 0xe6, 0x64, 0x15, 0xfd, 0x47, 0xea, 0x1d, 0xb7, 0xe3, 0xd9, 0x2a, 0xd6,
+	0x1b, 0xe2, 0xb9, 0x36, 0xd9, 0x47, 0x5c, 0x91, 0x79, 0x86, 0x96, 0x77, 0x59, 0xe4, 0xba, 0xc0,
+	0x60, 0xf7, 0x11, 0x83, 0x0e, 0xd4, 0x3f, 0xc7, 0x81, 0xcb, 0xaa, 0x2e, 0xcb, 0xf4, 0xc1, 0x89,
+	0xbb, 0x97, 0xcd, 0x2d, 0x35, 0xa1, 0xe6, 0x56, 0x05, 0xde, 0x8e, 0x11, 0xe6, 0x72, 0xc2, 0x68,
+	0xee, 0x31, 0xde, 0x86, 0x46, 0x0e, 0xcd, 0x2b, 0x71, 0x80, 0xea, 0x85, 0x4c, 0x13, 0x0c, 0xbc,
+	0xf2, 0xc1, 0x31, 0xc0, 0xc3, 0x0b, 0x35, 0xab, 0x2e, 0xd3, 0xd9, 0x0c, 0x93, 0xc4, 0xdb, 0x31,
+	0x65, 0x6f, 0x65, 0xb8, 0xc0, 0xc0, 0x63, 0xbc, 0x05, 0xf5, 0x91, 0x8c, 0x66, 0x68, 0x54, 0xe9,
+	0xf4, 0xcd, 0xfd, 0x9f, 0x2e, 0xbb, 0x5b, 0x77, 0xd9, 0xfd, 0xba, 0xcb, 0x7e, 0xaf, 0xbb, 0xec,
+	0xcb, 0xf6, 0x5f, 0x64, 0x29, 0x49, 0x87, 0x2b, 0xa5, 0xc3, 0x79, 0x18, 0x65, 0x22, 0xc2, 0x61,
+	0x7c, 0x33, 0x1f, 0xc6, 0xd3, 0xa1, 0xa1, 0x38, 0xad, 0xda, 0x9f, 0xc9, 0xf1, 0xbf, 0x01, 0x00,
+	0xc7, 0x3a, 0x5c, 0x46, 0x8f, 0x04, 0x00, 0x00,
 }
 
 func (m *TaskMetadata) Marshal() (dAtA []byte, err error) {
@@ -116,6 +116,27 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			genericSort(col, os, genericGreater[types.Date])
 		}
+	case types.T_time:
+		col := vector.GetFixedVectorValues[types.Time](vec)
+		if !desc {
+			genericSort(col, os, genericLess[types.Time])
+		} else {
+			genericSort(col, os, genericGreater[types.Time])
+		}
+	case types.T_enum:
+		col := vector.GetFixedVectorValues[types.Enum](vec)
+		if !desc {
+			genericSort(col, os, genericLess[types.Enum])
+		} else {
+			genericSort(col, os, genericGreater[types.Enum])
+		}
+	case types.T_set:
+		col := vector.GetFixedVectorValues[types.Set](vec)
+		if !desc {
+			genericSort(col, os, genericLess[types.Set])
+		} else {
+			genericSort(col, os, genericGreater[types.Set])
+		}
 	case types.T_datetime:
 		col := vector.GetFixedVectorValues[types.Datetime](vec)
 		if !desc {
@@ -0,0 +1,78 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggut
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func makeJsonVector(t *testing.T, m *mheap.Mheap, docs ...string) *vector.Vector {
+	vals := make([][]byte, len(docs))
+	for i, doc := range docs {
+		bj, err := bytejson.ParseFromString(doc)
+		require.NoError(t, err)
+		vals[i], err = types.EncodeJson(bj)
+		require.NoError(t, err)
+	}
+	return vector.NewWithBytes(types.T_json.ToType(), vals, nil, m)
+}
+
+func TestJsonAgg(t *testing.T) {
+	testTyp := types.T_json.ToType()
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	{
+		// JSON_ARRAYAGG with Fill and Merge
+		a, a2 := agg.NewJsonAgg(false), agg.NewJsonAgg(false)
+		vec := makeJsonVector(t, m, `[1]`, `["x"]`)
+		vec2 := makeJsonVector(t, m, `[null]`)
+		agg0 := agg.NewUnaryAgg(a, false, testTyp, agg.JsonAggReturnType(nil), a.Grows, a.Eval, a.Merge, a.Fill, nil)
+		require.NoError(t, agg0.Grows(1, m))
+		for i := 0; i < vec.Length(); i++ {
+			require.NoError(t, agg0.Fill(0, int64(i), 1, []*vector.Vector{vec}))
+		}
+		agg1 := agg.NewUnaryAgg(a2, false, testTyp, agg.JsonAggReturnType(nil), a2.Grows, a2.Eval, a2.Merge, a2.Fill, nil)
+		require.NoError(t, agg1.Grows(1, m))
+		require.NoError(t, agg1.Fill(0, 0, 2, []*vector.Vector{vec2}))
+		require.NoError(t, agg0.Merge(agg1, 0, 0))
+		v, err := agg0.Eval(m)
+		require.NoError(t, err)
+		require.Equal(t, `[1, "x", null, null]`, types.DecodeJson(v.GetBytes(0)).String())
+		v.Free(m)
+	}
+	{
+		// JSON_OBJECTAGG skips the null keys, and the last value of a key wins
+		a := agg.NewJsonAgg(true)
+		vec := makeJsonVector(t, m, `["a", 1]`, `[null, 2]`, `["b", [3]]`, `["a", 4]`)
+		agg0 := agg.NewUnaryAgg(a, false, testTyp, agg.JsonAggReturnType(nil), a.Grows, a.Eval, a.Merge, a.Fill, nil)
+		require.NoError(t, agg0.Grows(2, m))
+		for i := 0; i < vec.Length(); i++ {
+			require.NoError(t, agg0.Fill(0, int64(i), 1, []*vector.Vector{vec}))
+		}
+		v, err := agg0.Eval(m)
+		require.NoError(t, err)
+		require.Equal(t, `{"a": 4, "b": [3]}`, types.DecodeJson(v.GetBytes(0)).String())
+		require.True(t, v.Nsp.Contains(1))
+		v.Free(m)
+	}
+}
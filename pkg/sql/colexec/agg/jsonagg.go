@@ -0,0 +1,98 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import (
+	"encoding/binary"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// JsonAgg is JSON_ARRAYAGG and JSON_OBJECTAGG, the input is the json array of
+// the arguments, [x] for JSON_ARRAYAGG(x) and [k, v] for JSON_OBJECTAGG(k, v).
+// The value of a group is the length prefixed arguments of its rows, and it's
+// made a json document by Eval.
+type JsonAgg struct {
+	IsObject bool
+}
+
+func JsonAggReturnType(_ []types.Type) types.Type {
+	return types.T_json.ToType()
+}
+
+func NewJsonAgg(isObject bool) *JsonAgg {
+	return &JsonAgg{IsObject: isObject}
+}
+
+func (a *JsonAgg) Grows(_ int) {
+}
+
+func (a *JsonAgg) Eval(vs [][]byte) [][]byte {
+	for i, v := range vs {
+		var elems []bytejson.ByteJson
+		for len(v) > 0 {
+			n, sz := binary.Uvarint(v)
+			elems = append(elems, types.DecodeJson(v[sz:sz+int(n)]))
+			v = v[sz+int(n):]
+		}
+		var bj bytejson.ByteJson
+		if a.IsObject {
+			keys := make([]string, 0, len(elems)/2)
+			vals := make([]bytejson.ByteJson, 0, len(elems)/2)
+			for j := 0; j+1 < len(elems); j += 2 {
+				keys = append(keys, elems[j].Unquote())
+				vals = append(vals, elems[j+1])
+			}
+			// the keys are strings, so it doesn't fail
+			bj, _ = bytejson.CreateObject(keys, vals)
+		} else {
+			bj = bytejson.CreateArray(elems)
+		}
+		vs[i], _ = types.EncodeJson(bj)
+	}
+	return vs
+}
+
+// Fill appends the arguments of the row, a row of JSON_OBJECTAGG whose key is
+// null is skipped
+func (a *JsonAgg) Fill(_ int64, value []byte, ov []byte, z int64, isEmpty bool, isNull bool) ([]byte, bool) {
+	if isNull {
+		return ov, isEmpty
+	}
+	args := types.DecodeJson(value).ArrayElems()
+	if a.IsObject && (len(args) != 2 || args[0].IsNull()) {
+		return ov, isEmpty
+	}
+	var row []byte
+	var lenBuf [binary.MaxVarintLen64]byte
+	for _, arg := range args {
+		buf, _ := types.EncodeJson(arg)
+		row = append(row, lenBuf[:binary.PutUvarint(lenBuf[:], uint64(len(buf)))]...)
+		row = append(row, buf...)
+	}
+	// ov is owned by the group, so it's safe to append to it
+	for ; z > 0; z-- {
+		ov = append(ov, row...)
+	}
+	return ov, false
+}
+
+func (a *JsonAgg) Merge(_ int64, _ int64, x []byte, y []byte, xEmpty bool, yEmpty bool, _ any) ([]byte, bool) {
+	if yEmpty {
+		return x, xEmpty
+	}
+	return append(x, y...), false
+}
@@ -46,6 +46,8 @@ func ReturnType(op int, typ types.Type) (types.Type, error) {
 		otyp = BitOrReturnType([]types.Type{typ})
 	case AggregateStdDevPop:
 		otyp = StdDevPopReturnType([]types.Type{typ})
+	case AggregateJsonArrayAgg, AggregateJsonObjectAgg:
+		otyp = JsonAggReturnType([]types.Type{typ})
 	}
 	if otyp.Oid == types.T_any {
 		return typ, fmt.Errorf("'%v' not support %s", typ, Names[op])
@@ -81,6 +83,10 @@ func New(op int, dist bool, typ types.Type) (Agg[any], error) {
 		return newStdDevPop(typ, dist), nil
 	case AggregateAnyValue:
 		return newAnyValue(typ, dist), nil
+	case AggregateJsonArrayAgg:
+		return newJsonAgg(typ, dist, false), nil
+	case AggregateJsonObjectAgg:
+		return newJsonAgg(typ, dist, true), nil
 	}
 	panic(fmt.Errorf("unsupport type '%s' for aggregate %s", typ, Names[op]))
 }
@@ -117,6 +123,12 @@ func newCount(typ types.Type, dist bool, isStar bool) Agg[any] {
 		return newGenericCount[[]byte](typ, dist, isStar)
 	case types.T_date:
 		return newGenericCount[types.Date](typ, dist, isStar)
+	case types.T_time:
+		return newGenericCount[types.Time](typ, dist, isStar)
+	case types.T_enum:
+		return newGenericCount[types.Enum](typ, dist, isStar)
+	case types.T_set:
+		return newGenericCount[types.Set](typ, dist, isStar)
 	case types.T_datetime:
 		return newGenericCount[types.Datetime](typ, dist, isStar)
 	case types.T_timestamp:
@@ -163,6 +175,12 @@ func newAnyValue(typ types.Type, dist bool) Agg[any] {
 		return newGenericAnyValue[[]byte](typ, dist)
 	case types.T_date:
 		return newGenericAnyValue[types.Date](typ, dist)
+	case types.T_time:
+		return newGenericAnyValue[types.Time](typ, dist)
+	case types.T_enum:
+		return newGenericAnyValue[types.Enum](typ, dist)
+	case types.T_set:
+		return newGenericAnyValue[types.Set](typ, dist)
 	case types.T_datetime:
 		return newGenericAnyValue[types.Datetime](typ, dist)
 	case types.T_timestamp:
@@ -177,6 +195,14 @@ func newAnyValue(typ types.Type, dist bool) Agg[any] {
 	panic(fmt.Errorf("unsupport type '%s' for anyvalue", typ))
 }
 
+func newJsonAgg(typ types.Type, dist bool, isObject bool) Agg[any] {
+	aggPriv := NewJsonAgg(isObject)
+	if dist {
+		return NewUnaryDistAgg(false, typ, JsonAggReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill)
+	}
+	return NewUnaryAgg(aggPriv, false, typ, JsonAggReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
+}
+
 func newAvg(typ types.Type, dist bool) Agg[any] {
 	switch typ.Oid {
 	case types.T_int8:
@@ -301,6 +327,8 @@ func newMax(typ types.Type, dist bool) Agg[any] {
 		return NewUnaryAgg(aggPriv, false, typ, MaxReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
 	case types.T_date:
 		return newGenericMax[types.Date](typ, dist)
+	case types.T_time:
+		return newGenericMax[types.Time](typ, dist)
 	case types.T_datetime:
 		return newGenericMax[types.Datetime](typ, dist)
 	case types.T_timestamp:
@@ -375,6 +403,8 @@ func newMin(typ types.Type, dist bool) Agg[any] {
 		return NewUnaryAgg(aggPriv, false, typ, MinReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
 	case types.T_date:
 		return newGenericMin[types.Date](typ, dist)
+	case types.T_time:
+		return newGenericMin[types.Time](typ, dist)
 	case types.T_datetime:
 		return newGenericMin[types.Datetime](typ, dist)
 	case types.T_timestamp:
@@ -433,6 +463,8 @@ func newApprox(typ types.Type, dist bool) Agg[any] {
 		return newGenericApproxcd[[]byte](typ, dist)
 	case types.T_date:
 		return newGenericApproxcd[types.Date](typ, dist)
+	case types.T_time:
+		return newGenericApproxcd[types.Time](typ, dist)
 	case types.T_datetime:
 		return newGenericApproxcd[types.Datetime](typ, dist)
 	case types.T_timestamp:
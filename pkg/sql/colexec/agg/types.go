@@ -38,6 +38,8 @@ const (
 	AggregateBitOr
 	AggregateStdDevPop
 	AggregateAnyValue
+	AggregateJsonArrayAgg
+	AggregateJsonObjectAgg
 )
 
 var Names = [...]string{
@@ -54,6 +56,8 @@ var Names = [...]string{
 	AggregateBitOr:               "bit_or",
 	AggregateStdDevPop:           "stddev_pop",
 	AggregateAnyValue:            "any",
+	AggregateJsonArrayAgg:        "json_arrayagg",
+	AggregateJsonObjectAgg:       "json_objectagg",
 }
 
 type Aggregate struct {
@@ -19,12 +19,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 )
 
 const (
 	Build = iota
 	Probe
+	ProbeSpilled
 	End
 )
 
@@ -46,6 +48,10 @@ type container struct {
 	vecs  []*vector.Vector
 
 	mp *hashmap.JoinMap
+
+	// sj joins the build rows spilled to disk by the hash build with the
+	// probe rows, which are spilled too, partition by partition
+	sj *colexec.SpilledJoin
 }
 
 type Argument struct {
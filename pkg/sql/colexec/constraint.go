@@ -0,0 +1,1173 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/builtin/multi"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+/*
+	The CHECK and FOREIGN KEY constraints of a table are saved as a marshaled plan.ConstraintDef
+	in the engine.ConstraintDef of the table. The constraint of a parent table lists the tables
+	referring to it in RefChildTbls, so the rows referring to a deleted or updated row can be found.
+
+	A CHECK constraint is evaluated on the rows written by a statement. A child row without
+	parent row, or a parent row still referred by a child row, is reported when the transaction
+	commits, since a later statement of the transaction may fix it. CASCADE and SET NULL change
+	the child rows in the statement which deletes or updates the parent rows.
+
+	The keys of a foreign key are made by serial() like the keys of a unique index, a row with
+	a null foreign key column refers to nothing.
+*/
+
+// maxRefDepth is the max number of tables a cascading foreign key goes through, as MySQL.
+const maxRefDepth = 15
+
+// GetConstraintDef returns the CHECK and FOREIGN KEY constraints of a table, or nil if there is none.
+func GetConstraintDef(ctx context.Context, rel engine.Relation) (*plan.ConstraintDef, error) {
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, def := range defs {
+		if c, ok := def.(*engine.ConstraintDef); ok {
+			constraint := &plan.ConstraintDef{}
+			if err = constraint.Unmarshal([]byte(c.Constraint)); err != nil {
+				return nil, err
+			}
+			return constraint, nil
+		}
+	}
+	return nil, nil
+}
+
+// SetConstraintDef replaces the constraints of a table.
+func SetConstraintDef(ctx context.Context, rel engine.Relation, constraint *plan.ConstraintDef) error {
+	data, err := constraint.Marshal()
+	if err != nil {
+		return err
+	}
+	return rel.AddTableDef(ctx, &engine.ConstraintDef{Constraint: string(data)})
+}
+
+// AddRefChildren adds a new table to the children of the tables its foreign keys refer to,
+// a table referring to itself already lists itself when it is created.
+func AddRefChildren(ctx context.Context, db engine.Database, tblName string, constraint *plan.ConstraintDef) error {
+	for _, parent := range getRefParents(tblName, constraint) {
+		rel, err := db.Relation(ctx, parent)
+		if err != nil {
+			return err
+		}
+		parentConstraint, err := GetConstraintDef(ctx, rel)
+		if err != nil {
+			return err
+		}
+		if parentConstraint == nil {
+			parentConstraint = &plan.ConstraintDef{}
+		}
+		if hasAttr(parentConstraint.RefChildTbls, tblName) {
+			continue
+		}
+		parentConstraint.RefChildTbls = append(parentConstraint.RefChildTbls, tblName)
+		if err = SetConstraintDef(ctx, rel, parentConstraint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DropRefs removes a table from the children of the tables it refers to before it is dropped,
+// a table referred by another table can't be dropped.
+func DropRefs(ctx context.Context, db engine.Database, tblName string, rel engine.Relation) error {
+	constraint, err := GetConstraintDef(ctx, rel)
+	if err != nil || constraint == nil {
+		return err
+	}
+	for _, child := range constraint.RefChildTbls {
+		if child == tblName {
+			continue
+		}
+		childRel, err := db.Relation(ctx, child)
+		if err != nil {
+			return err
+		}
+		childConstraint, err := GetConstraintDef(ctx, childRel)
+		if err != nil {
+			return err
+		}
+		if childConstraint == nil {
+			continue
+		}
+		for _, fk := range childConstraint.Fkeys {
+			if fk.ForeignTbl == tblName {
+				return moerr.New(moerr.ER_FK_CANNOT_DROP_PARENT, tblName, fk.Name, child)
+			}
+		}
+	}
+	for _, parent := range getRefParents(tblName, constraint) {
+		parentRel, err := db.Relation(ctx, parent)
+		if err != nil {
+			return err
+		}
+		parentConstraint, err := GetConstraintDef(ctx, parentRel)
+		if err != nil {
+			return err
+		}
+		if parentConstraint == nil {
+			continue
+		}
+		children := parentConstraint.RefChildTbls[:0]
+		for _, child := range parentConstraint.RefChildTbls {
+			if child != tblName {
+				children = append(children, child)
+			}
+		}
+		parentConstraint.RefChildTbls = children
+		if err = SetConstraintDef(ctx, parentRel, parentConstraint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RenameRefs changes the name of a table in the foreign keys referring to it, and in the
+// children lists of the tables it refers to. It is called before the table is renamed.
+func RenameRefs(ctx context.Context, db engine.Database, rel engine.Relation, tblName, newName string) error {
+	constraint, err := GetConstraintDef(ctx, rel)
+	if err != nil || constraint == nil {
+		return err
+	}
+	for _, parent := range getRefParents(tblName, constraint) {
+		parentRel, err := db.Relation(ctx, parent)
+		if err != nil {
+			return err
+		}
+		parentConstraint, err := GetConstraintDef(ctx, parentRel)
+		if err != nil {
+			return err
+		}
+		if parentConstraint == nil {
+			continue
+		}
+		renameAttr(parentConstraint.RefChildTbls, tblName, newName)
+		if err = SetConstraintDef(ctx, parentRel, parentConstraint); err != nil {
+			return err
+		}
+	}
+	for _, child := range constraint.RefChildTbls {
+		if child == tblName {
+			continue
+		}
+		childRel, err := db.Relation(ctx, child)
+		if err != nil {
+			return err
+		}
+		childConstraint, err := GetConstraintDef(ctx, childRel)
+		if err != nil {
+			return err
+		}
+		if childConstraint == nil {
+			continue
+		}
+		for _, fk := range childConstraint.Fkeys {
+			if fk.ForeignTbl == tblName {
+				fk.ForeignTbl = newName
+			}
+		}
+		if err = SetConstraintDef(ctx, childRel, childConstraint); err != nil {
+			return err
+		}
+	}
+	// a table referring to itself
+	renameAttr(constraint.RefChildTbls, tblName, newName)
+	for _, fk := range constraint.Fkeys {
+		if fk.ForeignTbl == tblName {
+			fk.ForeignTbl = newName
+		}
+	}
+	return SetConstraintDef(ctx, rel, constraint)
+}
+
+// getRefParents returns the tables referred by the foreign keys of a table, except itself.
+func getRefParents(tblName string, constraint *plan.ConstraintDef) []string {
+	var parents []string
+	for _, fk := range constraint.GetFkeys() {
+		if fk.ForeignTbl != tblName && !hasAttr(parents, fk.ForeignTbl) {
+			parents = append(parents, fk.ForeignTbl)
+		}
+	}
+	return parents
+}
+
+// TableConstraints checks the CHECK and FOREIGN KEY constraints of a table while its rows
+// are written and deleted.
+type TableConstraints struct {
+	db       engine.Database
+	tblName  string
+	rel      engine.Relation
+	checks   []*plan.CheckDef
+	parents  []*refParent
+	children []*refChild
+	// the number of cascading foreign keys through which the table is changed
+	depth int
+}
+
+// refParent is a foreign key of the table and the table it refers to.
+type refParent struct {
+	fk  *plan.ForeignKeyDef
+	rel engine.Relation
+}
+
+// refChild is a foreign key of a table referring to the table, the columns, indexes and
+// constraints of the child table are loaded when its rows are changed by the foreign key.
+type refChild struct {
+	sync.Mutex
+	tblName     string
+	fk          *plan.ForeignKeyDef
+	rel         engine.Relation
+	loaded      bool
+	attrs       []string
+	hideKey     string
+	indexes     *TableIndexes
+	constraints *TableConstraints
+}
+
+// NewTableConstraints returns nil if the table has no constraint to check.
+func NewTableConstraints(ctx context.Context, db engine.Database, tblName string, rel engine.Relation) (*TableConstraints, error) {
+	return newTableConstraints(ctx, db, tblName, rel, 0)
+}
+
+func newTableConstraints(ctx context.Context, db engine.Database, tblName string, rel engine.Relation, depth int) (*TableConstraints, error) {
+	constraint, err := GetConstraintDef(ctx, rel)
+	if err != nil || constraint == nil {
+		return nil, err
+	}
+	c := &TableConstraints{
+		db:      db,
+		tblName: tblName,
+		rel:     rel,
+		checks:  constraint.Checks,
+		depth:   depth,
+	}
+	for _, fk := range constraint.Fkeys {
+		parentRel := rel
+		if fk.ForeignTbl != tblName {
+			if parentRel, err = db.Relation(ctx, fk.ForeignTbl); err != nil {
+				return nil, err
+			}
+		}
+		c.parents = append(c.parents, &refParent{fk: fk, rel: parentRel})
+	}
+	for _, child := range constraint.RefChildTbls {
+		childRel, childConstraint := rel, constraint
+		if child != tblName {
+			if childRel, err = db.Relation(ctx, child); err != nil {
+				return nil, err
+			}
+			if childConstraint, err = GetConstraintDef(ctx, childRel); err != nil {
+				return nil, err
+			}
+		}
+		for _, fk := range childConstraint.GetFkeys() {
+			if fk.ForeignTbl == tblName {
+				c.children = append(c.children, &refChild{tblName: child, fk: fk, rel: childRel})
+			}
+		}
+	}
+	if len(c.checks) == 0 && len(c.parents) == 0 && len(c.children) == 0 {
+		return nil, nil
+	}
+	return c, nil
+}
+
+// Check returns an error if a row in bat, whose attrs are the names of the table columns,
+// violates a CHECK constraint. A row for which a check is null satisfies it.
+func (c *TableConstraints) Check(proc *process.Process, bat *batch.Batch) error {
+	if c == nil {
+		return nil
+	}
+	for _, check := range c.checks {
+		expr := proto.Clone(check.Check).(*plan.Expr)
+		if err := bindColPos(expr, bat.Attrs); err != nil {
+			return err
+		}
+		vec, err := EvalExpr(bat, proc, expr)
+		if err != nil {
+			return err
+		}
+		violated := false
+		if !vec.IsScalarNull() {
+			bs := vector.MustTCols[bool](vec)
+			for i := range bat.Zs {
+				j := i
+				if vec.IsScalar() {
+					j = 0
+				}
+				if !bs[j] && !nulls.Contains(vec.Nsp, uint64(j)) {
+					violated = true
+					break
+				}
+			}
+		}
+		if !isBatchVec(bat, vec) {
+			vec.Free(proc.Mp())
+		}
+		if violated {
+			return moerr.New(moerr.ER_CHECK_CONSTRAINT_VIOLATED, check.Name)
+		}
+	}
+	return nil
+}
+
+// Write checks the rows written to the table, whose attrs are the names of the table columns.
+func (c *TableConstraints) Write(ctx context.Context, proc *process.Process, bat *batch.Batch) error {
+	if c == nil {
+		return nil
+	}
+	if err := c.Check(proc, bat); err != nil {
+		return err
+	}
+	for _, parent := range c.parents {
+		if err := c.checkParentKeys(ctx, proc, parent, bat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete handles the child rows referring to the rows deleted from the table, bat holds
+// the old values of the columns referred by the foreign keys of other tables.
+func (c *TableConstraints) Delete(ctx context.Context, proc *process.Process, bat *batch.Batch) error {
+	if c == nil {
+		return nil
+	}
+	for _, child := range c.children {
+		keys, rows, err := getRowKeys(proc, bat, child.fk.ForeignCols)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		switch child.fk.OnDelete {
+		case plan.ForeignKeyDef_CASCADE:
+			err = c.cascade(ctx, proc, child, makeKeyRows(keys, rows), nil, false)
+		case plan.ForeignKeyDef_SET_NULL:
+			err = c.cascade(ctx, proc, child, makeKeyRows(keys, rows), nil, true)
+		default:
+			err = c.checkChildKeys(ctx, proc, child, keys)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Update checks the updated rows and handles the child rows referring to their old keys,
+// attrs are the updated columns, oldBat holds the old values of the columns referred by
+// the foreign keys of other tables and newBat holds the new rows, in the same order.
+func (c *TableConstraints) Update(ctx context.Context, proc *process.Process, attrs []string, oldBat, newBat *batch.Batch) error {
+	if c == nil {
+		return nil
+	}
+	if err := c.Check(proc, newBat); err != nil {
+		return err
+	}
+	for _, parent := range c.parents {
+		if !hasAnyAttr(parent.fk.Cols, attrs) {
+			continue
+		}
+		if err := c.checkParentKeys(ctx, proc, parent, newBat); err != nil {
+			return err
+		}
+	}
+	for _, child := range c.children {
+		if !hasAnyAttr(child.fk.ForeignCols, attrs) {
+			continue
+		}
+		oldKeys, oldRows, err := getRowKeys(proc, oldBat, child.fk.ForeignCols)
+		if err != nil {
+			return err
+		}
+		if len(oldKeys) == 0 {
+			continue
+		}
+		newKeys, newRows, err := getRowKeys(proc, newBat, child.fk.ForeignCols)
+		if err != nil {
+			return err
+		}
+		newKeyOf := make(map[int64]string, len(newKeys))
+		for i, key := range newKeys {
+			newKeyOf[newRows[i]] = key
+		}
+		// the old keys which are changed, and the rows of their new values
+		changed := make(map[string]int64)
+		var changedKeys []string
+		for i, key := range oldKeys {
+			if newKey, ok := newKeyOf[oldRows[i]]; ok && newKey == key {
+				continue
+			}
+			if _, ok := changed[key]; !ok {
+				changedKeys = append(changedKeys, key)
+			}
+			changed[key] = oldRows[i]
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		switch child.fk.OnUpdate {
+		case plan.ForeignKeyDef_CASCADE:
+			err = c.cascade(ctx, proc, child, changed, newBat, false)
+		case plan.ForeignKeyDef_SET_NULL:
+			err = c.cascade(ctx, proc, child, changed, nil, true)
+		default:
+			err = c.checkChildKeys(ctx, proc, child, changedKeys)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkParentKeys checks that the keys of a foreign key written to the table are in the
+// parent table when the transaction commits.
+func (c *TableConstraints) checkParentKeys(ctx context.Context, proc *process.Process, parent *refParent, bat *batch.Batch) error {
+	keys, _, err := getRowKeys(proc, bat, parent.fk.Cols)
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+	newCheck := func() process.TxnCheck {
+		return &fkChildCheck{
+			gm:      proc.Mp().Gm,
+			db:      c.db,
+			tblName: c.tblName,
+			parent:  parent,
+			keys:    make(map[string]struct{}),
+		}
+	}
+	if proc.TxnChecks == nil {
+		check := newCheck().(*fkChildCheck)
+		check.add(keys)
+		return check.Verify(ctx)
+	}
+	key := fmt.Sprintf("fk-child:%s:%s", c.rel.GetTableID(ctx), parent.fk.Name)
+	proc.TxnChecks.GetOrAdd(key, newCheck).(*fkChildCheck).add(keys)
+	return nil
+}
+
+// checkChildKeys checks that the keys removed from the table are not referred by the
+// child table when the transaction commits.
+func (c *TableConstraints) checkChildKeys(ctx context.Context, proc *process.Process, child *refChild, keys []string) error {
+	newCheck := func() process.TxnCheck {
+		return &fkParentCheck{
+			gm:      proc.Mp().Gm,
+			db:      c.db,
+			rel:     c.rel,
+			tblName: c.tblName,
+			child:   child,
+			keys:    make(map[string]struct{}),
+		}
+	}
+	if proc.TxnChecks == nil {
+		check := newCheck().(*fkParentCheck)
+		check.add(keys)
+		return check.Verify(ctx)
+	}
+	key := fmt.Sprintf("fk-parent:%s:%s", child.rel.GetTableID(ctx), child.fk.Name)
+	proc.TxnChecks.GetOrAdd(key, newCheck).(*fkParentCheck).add(keys)
+	return nil
+}
+
+// cascade changes the child rows referring to the keys, which are mapped to the rows of
+// their new values in newBat. The child rows are deleted if newBat is nil and setNull is
+// false, otherwise their foreign key columns are set to null or to the new values.
+func (c *TableConstraints) cascade(ctx context.Context, proc *process.Process, child *refChild, keys map[string]int64, newBat *batch.Batch, setNull bool) error {
+	if c.depth >= maxRefDepth {
+		return moerr.New(moerr.ER_FK_DEPTH_EXCEEDED, maxRefDepth)
+	}
+	if err := child.load(ctx, c.db, c.depth+1); err != nil {
+		return err
+	}
+	attrs := append(append([]string{}, child.attrs...), child.hideKey)
+	var bats []*batch.Batch
+	var srcRows [][]int64
+	defer func() {
+		for _, bat := range bats {
+			bat.Clean(proc.Mp())
+		}
+	}()
+	err := scanTable(ctx, proc, child.rel, attrs, func(bat *batch.Batch) (bool, error) {
+		rowKeys, rows, err := getRowKeys(proc, bat, child.fk.Cols)
+		if err != nil {
+			bat.Clean(proc.Mp())
+			return false, err
+		}
+		var sels, srcs []int64
+		for i, key := range rowKeys {
+			if row, ok := keys[key]; ok {
+				sels = append(sels, rows[i])
+				srcs = append(srcs, row)
+			}
+		}
+		if len(sels) == 0 {
+			bat.Clean(proc.Mp())
+			return false, nil
+		}
+		bat.Shrink(sels)
+		bats = append(bats, bat)
+		srcRows = append(srcRows, srcs)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the rows are read before they are changed, as a table may refer to itself
+	for i, bat := range bats {
+		n := len(child.attrs)
+		if err = child.rel.Delete(ctx, bat.Vecs[n], child.hideKey); err != nil {
+			return err
+		}
+		oldBat := &batch.Batch{
+			Attrs: child.attrs,
+			Vecs:  bat.Vecs[:n],
+			Zs:    bat.Zs,
+		}
+		if newBat == nil && !setNull {
+			if err = child.indexes.Delete(ctx, proc, oldBat); err != nil {
+				return err
+			}
+			if err = child.constraints.Delete(ctx, proc, oldBat); err != nil {
+				return err
+			}
+			continue
+		}
+		rows, err := makeCascadeRows(proc, child.fk, oldBat, newBat, srcRows[i])
+		if err != nil {
+			return err
+		}
+		err = child.rel.Write(ctx, rows)
+		if err == nil {
+			err = child.indexes.Update(ctx, proc, child.fk.Cols, oldBat, rows)
+		}
+		if err == nil {
+			err = child.constraints.Update(ctx, proc, child.fk.Cols, oldBat, rows)
+		}
+		for j, vec := range rows.Vecs {
+			if vec != oldBat.Vecs[j] {
+				vec.Free(proc.Mp())
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// makeCascadeRows returns the child rows of oldBat with the foreign key columns set to
+// the values of the rows srcRows of newBat, or to null if newBat is nil. The other
+// columns are shared with oldBat.
+func makeCascadeRows(proc *process.Process, fk *plan.ForeignKeyDef, oldBat, newBat *batch.Batch, srcRows []int64) (*batch.Batch, error) {
+	rows := batch.NewWithSize(len(oldBat.Vecs))
+	rows.Attrs = oldBat.Attrs
+	rows.Zs = oldBat.Zs
+	copy(rows.Vecs, oldBat.Vecs)
+	free := func() {
+		for j, vec := range rows.Vecs {
+			if vec != oldBat.Vecs[j] {
+				vec.Free(proc.Mp())
+			}
+		}
+	}
+	for i, name := range fk.Cols {
+		j := getAttrIdx(rows.Attrs, name)
+		if j == -1 {
+			return nil, errors.New("", fmt.Sprintf("internal error: column '%s' of foreign key '%s' not found", name, fk.Name))
+		}
+		src, sels := oldBat.Vecs[j], make([]int64, len(srcRows))
+		if newBat != nil {
+			k := getAttrIdx(newBat.Attrs, fk.ForeignCols[i])
+			if k == -1 {
+				free()
+				return nil, errors.New("", fmt.Sprintf("internal error: column '%s' of foreign key '%s' not found", fk.ForeignCols[i], fk.Name))
+			}
+			src, sels = newBat.Vecs[k], srcRows
+		} else {
+			for k := range sels {
+				sels[k] = int64(k)
+			}
+		}
+		vec := vector.New(oldBat.Vecs[j].Typ)
+		if err := vector.Union(vec, src, sels, proc.Mp()); err != nil {
+			vec.Free(proc.Mp())
+			free()
+			return nil, err
+		}
+		for k, sel := range sels {
+			if newBat == nil || nulls.Contains(src.Nsp, uint64(sel)) {
+				nulls.Add(vec.Nsp, uint64(k))
+			}
+		}
+		rows.Vecs[j] = vec
+	}
+	return rows, nil
+}
+
+// load reads the columns, indexes and constraints of the child table.
+func (r *refChild) load(ctx context.Context, db engine.Database, depth int) error {
+	r.Lock()
+	defer r.Unlock()
+	if r.loaded {
+		return nil
+	}
+	defs, err := r.rel.TableDefs(ctx)
+	if err != nil {
+		return err
+	}
+	r.attrs = r.attrs[:0]
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok && !attr.Attr.IsHidden && !attr.Attr.IsRowId {
+			r.attrs = append(r.attrs, attr.Attr.Name)
+		}
+	}
+	hideKeys, err := r.rel.GetHideKeys(ctx)
+	if err != nil {
+		return err
+	}
+	if len(hideKeys) == 0 {
+		return errors.New("", fmt.Sprintf("internal error: rows of table '%s' can't be changed by foreign key", r.tblName))
+	}
+	r.hideKey = hideKeys[0].Name
+	if r.indexes, err = NewTableIndexes(ctx, db, r.tblName, r.rel); err != nil {
+		return err
+	}
+	if r.constraints, err = newTableConstraints(ctx, db, r.tblName, r.rel, depth); err != nil {
+		return err
+	}
+	r.loaded = true
+	return nil
+}
+
+// fkChildCheck checks that the keys written to the columns of a foreign key are in the parent table.
+// It keeps the memory of the session instead of the statement, which is done when the check runs.
+type fkChildCheck struct {
+	sync.Mutex
+	gm      *guest.Mmu
+	db      engine.Database
+	tblName string
+	parent  *refParent
+	keys    map[string]struct{}
+}
+
+func (f *fkChildCheck) add(keys []string) {
+	f.Lock()
+	defer f.Unlock()
+	for _, key := range keys {
+		f.keys[key] = struct{}{}
+	}
+}
+
+func (f *fkChildCheck) Verify(ctx context.Context) error {
+	f.Lock()
+	defer f.Unlock()
+	if len(f.keys) == 0 {
+		return nil
+	}
+	proc := process.New(ctx, mheap.New(f.gm), nil, nil, nil)
+	fk := f.parent.fk
+	err := findKeys(ctx, proc, f.db, fk.ForeignTbl, f.parent.rel, fk.ForeignCols, f.keys, func(key string) bool {
+		delete(f.keys, key)
+		return len(f.keys) == 0
+	})
+	if err != nil {
+		return err
+	}
+	if len(f.keys) > 0 {
+		f.keys = make(map[string]struct{})
+		return moerr.New(moerr.ER_NO_REFERENCED_ROW_2, describeForeignKey(f.tblName, fk))
+	}
+	return nil
+}
+
+// fkParentCheck checks that the keys removed from a parent table are not referred by the child table.
+type fkParentCheck struct {
+	sync.Mutex
+	gm      *guest.Mmu
+	db      engine.Database
+	rel     engine.Relation
+	tblName string
+	child   *refChild
+	keys    map[string]struct{}
+}
+
+func (f *fkParentCheck) add(keys []string) {
+	f.Lock()
+	defer f.Unlock()
+	for _, key := range keys {
+		f.keys[key] = struct{}{}
+	}
+}
+
+func (f *fkParentCheck) Verify(ctx context.Context) error {
+	f.Lock()
+	defer f.Unlock()
+	if len(f.keys) == 0 {
+		return nil
+	}
+	proc := process.New(ctx, mheap.New(f.gm), nil, nil, nil)
+	// a key written to the parent table again is still there
+	err := findKeys(ctx, proc, f.db, f.tblName, f.rel, f.child.fk.ForeignCols, f.keys, func(key string) bool {
+		delete(f.keys, key)
+		return len(f.keys) == 0
+	})
+	if err != nil || len(f.keys) == 0 {
+		return err
+	}
+	referred := false
+	err = findKeys(ctx, proc, f.db, f.child.tblName, f.child.rel, f.child.fk.Cols, f.keys, func(string) bool {
+		referred = true
+		return true
+	})
+	f.keys = make(map[string]struct{})
+	if err != nil {
+		return err
+	}
+	if referred {
+		return moerr.New(moerr.ER_ROW_IS_REFERENCED_2, describeForeignKey(f.child.tblName, f.child.fk))
+	}
+	return nil
+}
+
+// findKeys calls fn with each of the keys which are made of the columns cols of a row of the
+// table, until fn returns true. The rows are looked up by the primary key, or by a secondary
+// index whose leading columns are cols, the table is scanned only if there is neither.
+func findKeys(ctx context.Context, proc *process.Process, db engine.Database, tblName string, rel engine.Relation,
+	cols []string, keys map[string]struct{}, fn func(string) bool) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	pks, err := rel.GetPrimaryKeys(ctx)
+	if err != nil {
+		return err
+	}
+	if kr, ok := rel.(engine.KeyReader); ok && len(pks) == 1 && len(cols) == 1 && pks[0].Name == cols[0] {
+		vec := makeKeyValues(proc, pks[0].Type, keys)
+		if vec != nil {
+			return lookupKeys(ctx, proc, kr, pks[0].Name, vec, keys, fn)
+		}
+	}
+	defs, err := GetIndexDefs(ctx, db, tblName)
+	if err != nil {
+		return err
+	}
+	for _, def := range defs {
+		if !isLeadingCols(def.ColNames, cols) {
+			continue
+		}
+		idxRel, err := db.Relation(ctx, def.IndexTableName)
+		if err != nil {
+			return err
+		}
+		// the key of a unique index is made of its columns only
+		if kr, ok := idxRel.(engine.KeyReader); ok && def.Unique && len(def.ColNames) == len(cols) {
+			vec := vector.NewWithStrings(types.T_varchar.ToType(), getKeys(keys), nil, proc.Mp())
+			return lookupKeys(ctx, proc, kr, INDEX_KEY_COLNAME, vec, keys, fn)
+		}
+		return seekKeys(ctx, proc, idxRel, keys, fn)
+	}
+	return scanTable(ctx, proc, rel, cols, func(bat *batch.Batch) (bool, error) {
+		defer bat.Clean(proc.Mp())
+		rowKeys, _, err := getRowKeys(proc, bat, cols)
+		if err != nil {
+			return false, err
+		}
+		for _, key := range rowKeys {
+			if _, ok := keys[key]; ok && fn(key) {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// lookupKeys reads the rows whose primary key attr is in vec, and calls fn with the keys of the rows.
+// attr is the key of an index table, or a single column whose values are serialized into the keys.
+func lookupKeys(ctx context.Context, proc *process.Process, rel engine.KeyReader, attr string, vec *vector.Vector,
+	keys map[string]struct{}, fn func(string) bool) error {
+	defer vec.Free(proc.Mp())
+	if vector.Length(vec) == 0 {
+		return nil
+	}
+	bat, err := rel.ReadByPrimaryKeys(ctx, []string{attr}, vec, proc.Mp())
+	if err != nil {
+		return err
+	}
+	defer bat.Clean(proc.Mp())
+	if vector.Length(bat.Vecs[0]) == 0 {
+		return nil
+	}
+	found := make([]string, 0, vector.Length(bat.Vecs[0]))
+	if attr == INDEX_KEY_COLNAME {
+		for i := 0; i < vector.Length(bat.Vecs[0]); i++ {
+			found = append(found, bat.Vecs[0].GetString(int64(i)))
+		}
+	} else {
+		bat.Attrs = []string{attr}
+		if found, _, err = getRowKeys(proc, bat, bat.Attrs); err != nil {
+			return err
+		}
+	}
+	for _, key := range found {
+		if _, ok := keys[key]; ok && fn(key) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// seekKeys calls fn with each of the keys which is the prefix of an entry of the index,
+// until fn returns true. The entries of a key are read by the range of the key.
+func seekKeys(ctx context.Context, proc *process.Process, idxRel engine.Relation, keys map[string]struct{}, fn func(string) bool) error {
+	ranges, err := idxRel.Ranges(ctx)
+	if err != nil {
+		return err
+	}
+	for _, key := range getKeys(keys) {
+		found, err := seekKey(ctx, proc, idxRel, ranges, key)
+		if err != nil {
+			return err
+		}
+		if found && fn(key) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func seekKey(ctx context.Context, proc *process.Process, idxRel engine.Relation, ranges [][]byte, key string) (bool, error) {
+	filter, err := makeKeyCompare(">=", 0, []byte(key))
+	if err != nil {
+		return false, err
+	}
+	if next := nextPrefix([]byte(key)); next != nil {
+		cmp, err := makeKeyCompare("<", 0, next)
+		if err != nil {
+			return false, err
+		}
+		if filter, err = makeIndexFunction("and", filter, cmp); err != nil {
+			return false, err
+		}
+	}
+	rds, err := idxRel.NewReader(ctx, 1, filter, ranges)
+	if err != nil {
+		return false, err
+	}
+	defer rds[0].Close()
+	attrs := []string{INDEX_KEY_COLNAME}
+	for {
+		bat, err := rds[0].Read(attrs, filter, proc.Mp())
+		if err != nil || bat == nil {
+			return false, err
+		}
+		// the filter only skips the blocks out of the range
+		found := false
+		for i := 0; i < vector.Length(bat.Vecs[0]); i++ {
+			if strings.HasPrefix(bat.Vecs[0].GetString(int64(i)), key) {
+				found = true
+				break
+			}
+		}
+		bat.Clean(proc.Mp())
+		if found {
+			return true, nil
+		}
+	}
+}
+
+// makeKeyValues returns the values of a single column serialized into the keys as a vector of
+// the type, or nil if the column of the type can't be looked up by its values.
+func makeKeyValues(proc *process.Process, typ types.Type, keys map[string]struct{}) *vector.Vector {
+	vals := make([]any, 0, len(keys))
+	for key := range keys {
+		t, err := types.Unpack([]byte(key))
+		if err != nil || len(t) != 1 {
+			continue
+		}
+		vals = append(vals, t[0])
+	}
+	switch typ.Oid {
+	case types.T_bool:
+		return newKeyValues[bool](typ, vals, proc.Mp())
+	case types.T_int8:
+		return newKeyValues[int8](typ, vals, proc.Mp())
+	case types.T_int16:
+		return newKeyValues[int16](typ, vals, proc.Mp())
+	case types.T_int32:
+		return newKeyValues[int32](typ, vals, proc.Mp())
+	case types.T_int64:
+		return newKeyValues[int64](typ, vals, proc.Mp())
+	case types.T_uint8:
+		return newKeyValues[uint8](typ, vals, proc.Mp())
+	case types.T_uint16:
+		return newKeyValues[uint16](typ, vals, proc.Mp())
+	case types.T_uint32:
+		return newKeyValues[uint32](typ, vals, proc.Mp())
+	case types.T_uint64:
+		return newKeyValues[uint64](typ, vals, proc.Mp())
+	case types.T_float32:
+		return newKeyValues[float32](typ, vals, proc.Mp())
+	case types.T_float64:
+		return newKeyValues[float64](typ, vals, proc.Mp())
+	case types.T_date:
+		return newKeyValues[types.Date](typ, vals, proc.Mp())
+	case types.T_datetime:
+		return newKeyValues[types.Datetime](typ, vals, proc.Mp())
+	case types.T_timestamp:
+		return newKeyValues[types.Timestamp](typ, vals, proc.Mp())
+	case types.T_time:
+		return newKeyValues[types.Time](typ, vals, proc.Mp())
+	case types.T_decimal64:
+		return newKeyValues[types.Decimal64](typ, vals, proc.Mp())
+	case types.T_decimal128:
+		return newKeyValues[types.Decimal128](typ, vals, proc.Mp())
+	case types.T_char, types.T_varchar:
+		col := make([][]byte, 0, len(vals))
+		for _, v := range vals {
+			if b, ok := v.([]byte); ok {
+				col = append(col, b)
+			}
+		}
+		return vector.NewWithBytes(typ, col, nil, proc.Mp())
+	}
+	return nil
+}
+
+// newKeyValues skips a value of another type, which is serialized from a column of another type
+// and so is in no key of the column.
+func newKeyValues[T types.FixedSizeT](typ types.Type, vals []any, m *mheap.Mheap) *vector.Vector {
+	col := make([]T, 0, len(vals))
+	for _, v := range vals {
+		if x, ok := v.(T); ok {
+			col = append(col, x)
+		}
+	}
+	return vector.NewWithFixed(typ, col, nil, m)
+}
+
+// isLeadingCols reports whether cols are the leading columns of the index in order.
+func isLeadingCols(idxCols, cols []string) bool {
+	if len(cols) > len(idxCols) {
+		return false
+	}
+	for i, name := range cols {
+		if idxCols[i] != name {
+			return false
+		}
+	}
+	return true
+}
+
+func getKeys(keys map[string]struct{}) []string {
+	s := make([]string, 0, len(keys))
+	for key := range keys {
+		s = append(s, key)
+	}
+	return s
+}
+
+// describeForeignKey returns the foreign key as it is shown in the errors of MySQL.
+func describeForeignKey(tblName string, fk *plan.ForeignKeyDef) string {
+	quote := func(names []string) string {
+		return "`" + strings.Join(names, "`, `") + "`"
+	}
+	return fmt.Sprintf("`%s`, CONSTRAINT `%s` FOREIGN KEY (%s) REFERENCES `%s` (%s)",
+		tblName, fk.Name, quote(fk.Cols), fk.ForeignTbl, quote(fk.ForeignCols))
+}
+
+// scanTable reads the columns cols of all rows of a table, and calls fn with each batch
+// until fn returns true. fn owns the batch.
+func scanTable(ctx context.Context, proc *process.Process, rel engine.Relation, cols []string, fn func(*batch.Batch) (bool, error)) error {
+	ranges, err := rel.Ranges(ctx)
+	if err != nil {
+		return err
+	}
+	rds, err := rel.NewReader(ctx, 1, nil, ranges)
+	if err != nil {
+		return err
+	}
+	defer rds[0].Close()
+	for {
+		bat, err := rds[0].Read(cols, nil, proc.Mp())
+		if err != nil || bat == nil {
+			return err
+		}
+		bat.Attrs = cols
+		done, err := fn(bat)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// getRowKeys returns the keys of the rows in bat made of the columns cols, and the rows of
+// the keys. A row with a null column has no key.
+func getRowKeys(proc *process.Process, bat *batch.Batch, cols []string) ([]string, []int64, error) {
+	srcs := make([]*vector.Vector, len(cols))
+	for i, name := range cols {
+		j := getAttrIdx(bat.Attrs, name)
+		if j == -1 {
+			return nil, nil, errors.New("", fmt.Sprintf("internal error: column '%s' of foreign key not found", name))
+		}
+		if bat.Vecs[j].IsScalarNull() {
+			return nil, nil, nil
+		}
+		bat.Vecs[j] = bat.Vecs[j].ConstExpand(proc.Mp())
+		srcs[i] = bat.Vecs[j]
+	}
+	n := vector.Length(srcs[0])
+	sels := make([]int64, 0, n)
+	for i := 0; i < n; i++ {
+		isNull := false
+		for _, v := range srcs {
+			if nulls.Contains(v.Nsp, uint64(i)) {
+				isNull = true
+				break
+			}
+		}
+		if !isNull {
+			sels = append(sels, int64(i))
+		}
+	}
+	if len(sels) == 0 {
+		return nil, nil, nil
+	}
+
+	vecs := make([]*vector.Vector, len(srcs))
+	defer func() {
+		for _, vec := range vecs {
+			if vec != nil {
+				vec.Free(proc.Mp())
+			}
+		}
+	}()
+	for i, src := range srcs {
+		vecs[i] = vector.New(src.Typ)
+		if err := vector.Union(vecs[i], src, sels, proc.Mp()); err != nil {
+			return nil, nil, err
+		}
+	}
+	key, err := multi.Serial(vecs, proc)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer key.Free(proc.Mp())
+	keys := make([]string, len(sels))
+	for i := range keys {
+		keys[i] = key.GetString(int64(i))
+	}
+	return keys, sels, nil
+}
+
+func makeKeyRows(keys []string, rows []int64) map[string]int64 {
+	m := make(map[string]int64, len(keys))
+	for i, key := range keys {
+		m[key] = rows[i]
+	}
+	return m
+}
+
+// bindColPos points the columns of a check constraint, which are known by name, to the vectors of bat.
+func bindColPos(expr *plan.Expr, attrs []string) error {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		i := getAttrIdx(attrs, e.Col.Name)
+		if i == -1 {
+			return errors.New("", fmt.Sprintf("internal error: column '%s' of check constraint not found", e.Col.Name))
+		}
+		e.Col.RelPos, e.Col.ColPos = 0, int32(i)
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			if err := bindColPos(arg, attrs); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_List:
+		for _, arg := range e.List.List {
+			if err := bindColPos(arg, attrs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isBatchVec(bat *batch.Batch, vec *vector.Vector) bool {
+	for _, v := range bat.Vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
+
+func getAttrIdx(attrs []string, name string) int {
+	for i, attr := range attrs {
+		if attr == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func hasAttr(attrs []string, name string) bool {
+	return getAttrIdx(attrs, name) != -1
+}
+
+func hasAnyAttr(attrs []string, names []string) bool {
+	for _, name := range names {
+		if hasAttr(attrs, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func renameAttr(attrs []string, name, newName string) {
+	for i, attr := range attrs {
+		if attr == name {
+			attrs[i] = newName
+		}
+	}
+}
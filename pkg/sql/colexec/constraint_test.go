@@ -0,0 +1,166 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_checkConstraint(t *testing.T) {
+	convey.Convey("Test check constraint succ", t, func() {
+		proc := testutil.NewProcess()
+		bat := batch.NewWithSize(2)
+		bat.Attrs = []string{"a", "ok"}
+		bat.Vecs[0] = testutil.NewInt64Vector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3})
+		bat.Vecs[1] = testutil.NewBoolVector(3, types.T_bool.ToType(), proc.Mp(), false, []bool{true, false, true})
+		bat.InitZsOne(3)
+		defer bat.Clean(proc.Mp())
+
+		// the column of a check is bound by name
+		check := &plan.CheckDef{
+			Name: "chk",
+			Check: &plan.Expr{
+				Typ:  &plan.Type{Id: int32(types.T_bool)},
+				Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0, Name: "ok"}},
+			},
+		}
+		c := &TableConstraints{checks: []*plan.CheckDef{check}}
+		err := c.Check(proc, bat)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "Check constraint 'chk' is violated")
+		convey.So(check.Check.GetCol().ColPos, convey.ShouldEqual, 0)
+
+		// a null result satisfies the check
+		nulls.Add(bat.Vecs[1].Nsp, 1)
+		convey.So(c.Check(proc, bat), convey.ShouldBeNil)
+
+		var nilConstraints *TableConstraints
+		convey.So(nilConstraints.Check(proc, bat), convey.ShouldBeNil)
+	})
+}
+
+func Test_getRowKeys(t *testing.T) {
+	convey.Convey("Test getRowKeys succ", t, func() {
+		proc := testutil.NewProcess()
+		bat := batch.NewWithSize(2)
+		bat.Attrs = []string{"a", "b"}
+		bat.Vecs[0] = testutil.NewInt64Vector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 1})
+		bat.Vecs[1] = testutil.NewStringVector(3, types.T_varchar.ToType(), proc.Mp(), false, []string{"x", "y", "x"})
+		nulls.Add(bat.Vecs[1].Nsp, 1)
+		bat.InitZsOne(3)
+		defer bat.Clean(proc.Mp())
+
+		// a row with a null column has no key
+		keys, rows, err := getRowKeys(proc, bat, []string{"a", "b"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(rows, convey.ShouldResemble, []int64{0, 2})
+		convey.So(keys[0], convey.ShouldEqual, keys[1])
+
+		keys, rows, err = getRowKeys(proc, bat, []string{"a"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(rows, convey.ShouldResemble, []int64{0, 1, 2})
+		convey.So(keys[0], convey.ShouldNotEqual, keys[1])
+
+		_, _, err = getRowKeys(proc, bat, []string{"c"})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_makeCascadeRows(t *testing.T) {
+	convey.Convey("Test makeCascadeRows succ", t, func() {
+		proc := testutil.NewProcess()
+		fk := &plan.ForeignKeyDef{Name: "fk", Cols: []string{"pid"}, ForeignTbl: "p", ForeignCols: []string{"id"}}
+		oldBat := batch.NewWithSize(2)
+		oldBat.Attrs = []string{"id", "pid"}
+		oldBat.Vecs[0] = testutil.NewInt64Vector(2, types.T_int64.ToType(), proc.Mp(), false, []int64{10, 11})
+		oldBat.Vecs[1] = testutil.NewInt64Vector(2, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2})
+		oldBat.InitZsOne(2)
+		defer oldBat.Clean(proc.Mp())
+		newBat := batch.NewWithSize(1)
+		newBat.Attrs = []string{"id"}
+		newBat.Vecs[0] = testutil.NewInt64Vector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{5, 6, 7})
+		nulls.Add(newBat.Vecs[0].Nsp, 0)
+		newBat.InitZsOne(3)
+		defer newBat.Clean(proc.Mp())
+
+		// the foreign key columns are set to the new keys, the other columns are shared
+		rows, err := makeCascadeRows(proc, fk, oldBat, newBat, []int64{2, 0})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(rows.Vecs[0], convey.ShouldEqual, oldBat.Vecs[0])
+		convey.So(rows.Vecs[1].Col.([]int64)[0], convey.ShouldEqual, 7)
+		convey.So(nulls.Contains(rows.Vecs[1].Nsp, 0), convey.ShouldBeFalse)
+		convey.So(nulls.Contains(rows.Vecs[1].Nsp, 1), convey.ShouldBeTrue)
+		rows.Vecs[1].Free(proc.Mp())
+
+		rows, err = makeCascadeRows(proc, fk, oldBat, nil, []int64{0, 1})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(nulls.Contains(rows.Vecs[1].Nsp, 0), convey.ShouldBeTrue)
+		convey.So(nulls.Contains(rows.Vecs[1].Nsp, 1), convey.ShouldBeTrue)
+		rows.Vecs[1].Free(proc.Mp())
+
+		convey.So(describeForeignKey("c", fk), convey.ShouldEqual, "`c`, CONSTRAINT `fk` FOREIGN KEY (`pid`) REFERENCES `p` (`id`)")
+	})
+}
+
+func Test_makeKeyValues(t *testing.T) {
+	convey.Convey("Test makeKeyValues succ", t, func() {
+		proc := testutil.NewProcess()
+		bat := batch.NewWithSize(2)
+		bat.Attrs = []string{"a", "b"}
+		bat.Vecs[0] = testutil.NewInt64Vector(2, types.T_int64.ToType(), proc.Mp(), false, []int64{3, 5})
+		bat.Vecs[1] = testutil.NewStringVector(2, types.T_varchar.ToType(), proc.Mp(), false, []string{"x", "y"})
+		bat.InitZsOne(2)
+		defer bat.Clean(proc.Mp())
+
+		toMap := func(keys []string) map[string]struct{} {
+			m := make(map[string]struct{})
+			for _, key := range keys {
+				m[key] = struct{}{}
+			}
+			return m
+		}
+		keys, _, err := getRowKeys(proc, bat, []string{"a"})
+		convey.So(err, convey.ShouldBeNil)
+		vec := makeKeyValues(proc, types.T_int64.ToType(), toMap(keys))
+		convey.So(vector.Length(vec), convey.ShouldEqual, 2)
+		convey.So(vec.Col.([]int64), convey.ShouldContain, int64(5))
+		vec.Free(proc.Mp())
+
+		// a value of another type is in no key of the column
+		vec = makeKeyValues(proc, types.T_int32.ToType(), toMap(keys))
+		convey.So(vector.Length(vec), convey.ShouldEqual, 0)
+		vec.Free(proc.Mp())
+
+		keys, _, err = getRowKeys(proc, bat, []string{"b"})
+		convey.So(err, convey.ShouldBeNil)
+		vec = makeKeyValues(proc, types.T_varchar.ToType(), toMap(keys))
+		convey.So(vector.Length(vec), convey.ShouldEqual, 2)
+		vec.Free(proc.Mp())
+
+		convey.So(makeKeyValues(proc, types.T_json.ToType(), toMap(keys)), convey.ShouldBeNil)
+
+		convey.So(isLeadingCols([]string{"a", "b"}, []string{"a"}), convey.ShouldBeTrue)
+		convey.So(isLeadingCols([]string{"a", "b"}, []string{"b"}), convey.ShouldBeFalse)
+		convey.So(isLeadingCols([]string{"a"}, []string{"a", "b"}), convey.ShouldBeFalse)
+	})
+}
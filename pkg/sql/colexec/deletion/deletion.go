@@ -69,6 +69,18 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 			affectedRows += uint64(batLen)
 		}
 
+		if idx := p.DeleteCtxs[i].IndexAttrsIdx; len(p.DeleteCtxs[i].IndexAttrs) > 0 {
+			idxBat := &batch.Batch{
+				Attrs: p.DeleteCtxs[i].IndexAttrs,
+				Vecs:  bat.Vecs[idx : int(idx)+len(p.DeleteCtxs[i].IndexAttrs)],
+			}
+			if err := p.DeleteCtxs[i].Indexes.Delete(ctx, proc, idxBat); err != nil {
+				return false, err
+			}
+			if err := p.DeleteCtxs[i].Constraints.Delete(ctx, proc, idxBat); err != nil {
+				return false, err
+			}
+		}
 	}
 
 	atomic.AddUint64(&p.AffectedRows, affectedRows)
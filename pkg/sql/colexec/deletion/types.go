@@ -15,6 +15,7 @@
 package deletion
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
@@ -25,8 +26,12 @@ type Argument struct {
 }
 
 type DeleteCtx struct {
-	IsHideKey    bool
-	TableSource  engine.Relation
-	UseDeleteKey string
-	CanTruncate  bool
+	IsHideKey     bool
+	TableSource   engine.Relation
+	UseDeleteKey  string
+	CanTruncate   bool
+	IndexAttrs    []string
+	IndexAttrsIdx int32
+	Indexes       *colexec.TableIndexes
+	Constraints   *colexec.TableConstraints
 }
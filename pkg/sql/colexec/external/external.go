@@ -61,7 +61,7 @@ func Prepare(proc *process.Process, arg any) error {
 	param.extern.FileService = proc.FileService
 	param.IgnoreLineTag = int(param.extern.Tail.IgnoredLines)
 	param.IgnoreLine = param.IgnoreLineTag
-	fileList, err := ReadDir(param.extern)
+	fileList, fileSize, err := ReadDir(param.extern)
 	if err != nil {
 		param.End = true
 		return err
@@ -72,6 +72,7 @@ func Prepare(proc *process.Process, arg any) error {
 		return fmt.Errorf("no such file '%s'", param.extern.Filepath)
 	}
 	param.FileList = fileList
+	param.FileSize = fileSize
 	param.FileCnt = len(fileList)
 	return nil
 }
@@ -83,7 +84,16 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 		return true, nil
 	}
 	param.extern.Filepath = param.FileList[param.FileIndex]
-	bat, err := ScanFileData(param, proc)
+	var bat *batch.Batch
+	var err error
+	switch param.extern.Format {
+	case tree.PARQUET:
+		bat, err = ScanParquetFile(param, proc)
+	case tree.JSONLINE:
+		bat, err = ScanJsonLineFile(param, proc)
+	default:
+		bat, err = ScanFileData(param, proc)
+	}
 	if err != nil {
 		param.End = true
 		return false, err
@@ -92,16 +102,16 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 	return false, nil
 }
 
-func ReadDir(param *tree.ExternParam) (fileList []string, err error) {
+func ReadDir(param *tree.ExternParam) (fileList []string, fileSize []int64, err error) {
 	dir, pattern := path.Split(param.Filepath)
 	fs, readPath, err := fileservice.GetForETL(param.FileService, dir+"/")
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	ctx := context.TODO()
 	entries, err := fs.List(ctx, readPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	for _, entry := range entries {
 		matched, _ := path.Match(pattern, entry.Name)
@@ -109,6 +119,7 @@ func ReadDir(param *tree.ExternParam) (fileList []string, err error) {
 			continue
 		}
 		fileList = append(fileList, path.Join(dir, entry.Name))
+		fileSize = append(fileSize, entry.Size)
 	}
 	return
 }
@@ -202,9 +213,8 @@ func judgeInterge(field string) bool {
 	return true
 }
 
-func makeBatch(param *ExternalParam, plh *ParseLineHandler) *batch.Batch {
+func makeBatch(param *ExternalParam, batchSize int) *batch.Batch {
 	batchData := batch.New(true, param.Attrs)
-	batchSize := plh.batchSize
 	//alloc space for vector
 	for i := 0; i < len(param.Attrs); i++ {
 		typ := types.New(types.T(param.Cols[i].Typ.Id), param.Cols[i].Typ.Width, param.Cols[i].Typ.Scale, param.Cols[i].Typ.Precision)
@@ -236,7 +246,7 @@ func deleteEnclosed(param *ExternalParam, plh *ParseLineHandler) {
 }
 
 func GetBatchData(param *ExternalParam, plh *ParseLineHandler, proc *process.Process) (*batch.Batch, error) {
-	bat := makeBatch(param, plh)
+	bat := makeBatch(param, plh.batchSize)
 	var Line []string
 	deleteEnclosed(param, plh)
 	for rowIdx := 0; rowIdx < plh.batchSize; rowIdx++ {
@@ -255,318 +265,338 @@ func GetBatchData(param *ExternalParam, plh *ParseLineHandler, proc *process.Pro
 			if id != types.T_char && id != types.T_varchar && id != types.T_json && id != types.T_blob {
 				isNullOrEmpty = isNullOrEmpty || len(field) == 0
 			}
-			switch id {
-			case types.T_bool:
-				cols := vec.Col.([]bool)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					if field == "true" || field == "1" {
-						cols[rowIdx] = true
-					} else if field == "false" || field == "0" {
-						cols[rowIdx] = false
-					} else {
-						return nil, fmt.Errorf("the input value '%s' is not bool type for column %d", field, colIdx)
-					}
+			if err := setFieldValue(vec, field, isNullOrEmpty, rowIdx, colIdx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	n := vector.Length(bat.Vecs[0])
+	sels := proc.Mp().GetSels()
+	if n > cap(sels) {
+		proc.Mp().PutSels(sels)
+		sels = make([]int64, n)
+	}
+	bat.Zs = sels[:n]
+	for k := 0; k < n; k++ {
+		bat.Zs[k] = 1
+	}
+
+	return bat, nil
+}
+
+// setFieldValue sets the rowIdx-th value of the vector by the text of the field
+func setFieldValue(vec *vector.Vector, field string, isNullOrEmpty bool, rowIdx, colIdx int) error {
+	switch vec.Typ.Oid {
+	case types.T_bool:
+		cols := vec.Col.([]bool)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			if field == "true" || field == "1" {
+				cols[rowIdx] = true
+			} else if field == "false" || field == "0" {
+				cols[rowIdx] = false
+			} else {
+				return fmt.Errorf("the input value '%s' is not bool type for column %d", field, colIdx)
+			}
+		}
+	case types.T_int8:
+		cols := vec.Col.([]int8)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			if judgeInterge(field) {
+				d, err := strconv.ParseInt(field, 10, 8)
+				if err != nil {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not int8 type for column %d", field, colIdx)
 				}
-			case types.T_int8:
-				cols := vec.Col.([]int8)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					if judgeInterge(field) {
-						d, err := strconv.ParseInt(field, 10, 8)
-						if err != nil {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not int8 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = int8(d)
-					} else {
-						d, err := strconv.ParseFloat(field, 64)
-						if err != nil || d < math.MinInt8 || d > math.MaxInt8 {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not int8 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = int8(d)
-					}
+				cols[rowIdx] = int8(d)
+			} else {
+				d, err := strconv.ParseFloat(field, 64)
+				if err != nil || d < math.MinInt8 || d > math.MaxInt8 {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not int8 type for column %d", field, colIdx)
 				}
-			case types.T_int16:
-				cols := vec.Col.([]int16)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					if judgeInterge(field) {
-						d, err := strconv.ParseInt(field, 10, 16)
-						if err != nil {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not int16 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = int16(d)
-					} else {
-						d, err := strconv.ParseFloat(field, 64)
-						if err != nil || d < math.MinInt16 || d > math.MaxInt16 {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not int16 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = int16(d)
-					}
+				cols[rowIdx] = int8(d)
+			}
+		}
+	case types.T_int16:
+		cols := vec.Col.([]int16)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			if judgeInterge(field) {
+				d, err := strconv.ParseInt(field, 10, 16)
+				if err != nil {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not int16 type for column %d", field, colIdx)
 				}
-			case types.T_int32:
-				cols := vec.Col.([]int32)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					if judgeInterge(field) {
-						d, err := strconv.ParseInt(field, 10, 32)
-						if err != nil {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not int32 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = int32(d)
-					} else {
-						d, err := strconv.ParseFloat(field, 64)
-						if err != nil || d < math.MinInt32 || d > math.MaxInt32 {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not int32 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = int32(d)
-					}
+				cols[rowIdx] = int16(d)
+			} else {
+				d, err := strconv.ParseFloat(field, 64)
+				if err != nil || d < math.MinInt16 || d > math.MaxInt16 {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not int16 type for column %d", field, colIdx)
 				}
-			case types.T_int64:
-				cols := vec.Col.([]int64)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					if judgeInterge(field) {
-						d, err := strconv.ParseInt(field, 10, 64)
-						if err != nil {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not int64 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = d
-					} else {
-						d, err := strconv.ParseFloat(field, 64)
-						if err != nil || d < math.MinInt64 || d > math.MaxInt64 {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not int64 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = int64(d)
-					}
+				cols[rowIdx] = int16(d)
+			}
+		}
+	case types.T_int32:
+		cols := vec.Col.([]int32)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			if judgeInterge(field) {
+				d, err := strconv.ParseInt(field, 10, 32)
+				if err != nil {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not int32 type for column %d", field, colIdx)
 				}
-			case types.T_uint8:
-				cols := vec.Col.([]uint8)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					if judgeInterge(field) {
-						d, err := strconv.ParseUint(field, 10, 8)
-						if err != nil {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not uint8 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = uint8(d)
-					} else {
-						d, err := strconv.ParseFloat(field, 64)
-						if err != nil || d < 0 || d > math.MaxUint8 {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not uint8 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = uint8(d)
-					}
+				cols[rowIdx] = int32(d)
+			} else {
+				d, err := strconv.ParseFloat(field, 64)
+				if err != nil || d < math.MinInt32 || d > math.MaxInt32 {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not int32 type for column %d", field, colIdx)
 				}
-			case types.T_uint16:
-				cols := vec.Col.([]uint16)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					if judgeInterge(field) {
-						d, err := strconv.ParseUint(field, 10, 16)
-						if err != nil {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not uint16 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = uint16(d)
-					} else {
-						d, err := strconv.ParseFloat(field, 64)
-						if err != nil || d < 0 || d > math.MaxUint16 {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not uint16 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = uint16(d)
-					}
+				cols[rowIdx] = int32(d)
+			}
+		}
+	case types.T_int64:
+		cols := vec.Col.([]int64)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			if judgeInterge(field) {
+				d, err := strconv.ParseInt(field, 10, 64)
+				if err != nil {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not int64 type for column %d", field, colIdx)
 				}
-			case types.T_uint32:
-				cols := vec.Col.([]uint32)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					if judgeInterge(field) {
-						d, err := strconv.ParseUint(field, 10, 32)
-						if err != nil {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not uint32 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = uint32(d)
-					} else {
-						d, err := strconv.ParseFloat(field, 64)
-						if err != nil || d < 0 || d > math.MaxUint32 {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not uint32 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = uint32(d)
-					}
+				cols[rowIdx] = d
+			} else {
+				d, err := strconv.ParseFloat(field, 64)
+				if err != nil || d < math.MinInt64 || d > math.MaxInt64 {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not int64 type for column %d", field, colIdx)
 				}
-			case types.T_uint64:
-				cols := vec.Col.([]uint64)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					if judgeInterge(field) {
-						d, err := strconv.ParseUint(field, 10, 64)
-						if err != nil {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not uint64 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = d
-					} else {
-						d, err := strconv.ParseFloat(field, 64)
-						if err != nil || d < 0 || d > math.MaxUint64 {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not uint64 type for column %d", field, colIdx)
-						}
-						cols[rowIdx] = uint64(d)
-					}
+				cols[rowIdx] = int64(d)
+			}
+		}
+	case types.T_uint8:
+		cols := vec.Col.([]uint8)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			if judgeInterge(field) {
+				d, err := strconv.ParseUint(field, 10, 8)
+				if err != nil {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not uint8 type for column %d", field, colIdx)
 				}
-			case types.T_float32:
-				cols := vec.Col.([]float32)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					d, err := strconv.ParseFloat(field, 32)
-					if err != nil {
-						logutil.Errorf("parse field[%v] err:%v", field, err)
-						return nil, fmt.Errorf("the input value '%v' is not float32 type for column %d", field, colIdx)
-					}
-					cols[rowIdx] = float32(d)
+				cols[rowIdx] = uint8(d)
+			} else {
+				d, err := strconv.ParseFloat(field, 64)
+				if err != nil || d < 0 || d > math.MaxUint8 {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not uint8 type for column %d", field, colIdx)
 				}
-			case types.T_float64:
-				cols := vec.Col.([]float64)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					d, err := strconv.ParseFloat(field, 32)
-					if err != nil {
-						logutil.Errorf("parse field[%v] err:%v", field, err)
-						return nil, fmt.Errorf("the input value '%v' is not float64 type for column %d", field, colIdx)
-					}
-					cols[rowIdx] = d
+				cols[rowIdx] = uint8(d)
+			}
+		}
+	case types.T_uint16:
+		cols := vec.Col.([]uint16)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			if judgeInterge(field) {
+				d, err := strconv.ParseUint(field, 10, 16)
+				if err != nil {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not uint16 type for column %d", field, colIdx)
 				}
-			case types.T_char, types.T_varchar, types.T_blob:
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					// XXX Memory accounting?
-					vector.SetStringAt(vec, rowIdx, field, nil)
+				cols[rowIdx] = uint16(d)
+			} else {
+				d, err := strconv.ParseFloat(field, 64)
+				if err != nil || d < 0 || d > math.MaxUint16 {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not uint16 type for column %d", field, colIdx)
 				}
-			case types.T_json:
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					byteJson, err := types.ParseStringToByteJson(field)
-					if err != nil {
-						logutil.Errorf("parse field[%v] err:%v", field, err)
-						return nil, fmt.Errorf("the input value '%v' is not json type for column %d", field, colIdx)
-					}
-					jsonBytes, err := types.EncodeJson(byteJson)
-					if err != nil {
-						logutil.Errorf("encode json[%v] err:%v", field, err)
-						return nil, fmt.Errorf("the input value '%v' is not json type for column %d", field, colIdx)
-					}
-					vector.SetBytesAt(vec, rowIdx, jsonBytes, nil)
+				cols[rowIdx] = uint16(d)
+			}
+		}
+	case types.T_uint32:
+		cols := vec.Col.([]uint32)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			if judgeInterge(field) {
+				d, err := strconv.ParseUint(field, 10, 32)
+				if err != nil {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not uint32 type for column %d", field, colIdx)
 				}
-			case types.T_date:
-				cols := vec.Col.([]types.Date)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					d, err := types.ParseDate(field)
-					if err != nil {
-						logutil.Errorf("parse field[%v] err:%v", field, err)
-						return nil, fmt.Errorf("the input value '%v' is not Date type for column %d", field, colIdx)
-					}
-					cols[rowIdx] = d
+				cols[rowIdx] = uint32(d)
+			} else {
+				d, err := strconv.ParseFloat(field, 64)
+				if err != nil || d < 0 || d > math.MaxUint32 {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not uint32 type for column %d", field, colIdx)
 				}
-			case types.T_datetime:
-				cols := vec.Col.([]types.Datetime)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					d, err := types.ParseDatetime(field, vec.Typ.Precision)
-					if err != nil {
-						logutil.Errorf("parse field[%v] err:%v", field, err)
-						return nil, fmt.Errorf("the input value '%v' is not Datetime type for column %d", field, colIdx)
-					}
-					cols[rowIdx] = d
+				cols[rowIdx] = uint32(d)
+			}
+		}
+	case types.T_uint64:
+		cols := vec.Col.([]uint64)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			if judgeInterge(field) {
+				d, err := strconv.ParseUint(field, 10, 64)
+				if err != nil {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not uint64 type for column %d", field, colIdx)
 				}
-			case types.T_decimal64:
-				cols := vec.Col.([]types.Decimal64)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					d, err := types.Decimal64_FromString(field)
-					if err != nil {
-						// we tolerate loss of digits.
-						if !moerr.IsMoErrCode(err, moerr.DATA_TRUNCATED) {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not Decimal64 type for column %d", field, colIdx)
-						}
-					}
-					cols[rowIdx] = d
+				cols[rowIdx] = d
+			} else {
+				d, err := strconv.ParseFloat(field, 64)
+				if err != nil || d < 0 || d > math.MaxUint64 {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not uint64 type for column %d", field, colIdx)
 				}
-			case types.T_decimal128:
-				cols := vec.Col.([]types.Decimal128)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					d, err := types.Decimal128_FromString(field)
-					if err != nil {
-						// we tolerate loss of digits.
-						if !moerr.IsMoErrCode(err, moerr.DATA_TRUNCATED) {
-							logutil.Errorf("parse field[%v] err:%v", field, err)
-							return nil, fmt.Errorf("the input value '%v' is not Decimal128 type for column %d", field, colIdx)
-						}
-					}
-					cols[rowIdx] = d
+				cols[rowIdx] = uint64(d)
+			}
+		}
+	case types.T_float32:
+		cols := vec.Col.([]float32)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			d, err := strconv.ParseFloat(field, 32)
+			if err != nil {
+				logutil.Errorf("parse field[%v] err:%v", field, err)
+				return fmt.Errorf("the input value '%v' is not float32 type for column %d", field, colIdx)
+			}
+			cols[rowIdx] = float32(d)
+		}
+	case types.T_float64:
+		cols := vec.Col.([]float64)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			d, err := strconv.ParseFloat(field, 32)
+			if err != nil {
+				logutil.Errorf("parse field[%v] err:%v", field, err)
+				return fmt.Errorf("the input value '%v' is not float64 type for column %d", field, colIdx)
+			}
+			cols[rowIdx] = d
+		}
+	case types.T_char, types.T_varchar, types.T_blob:
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			// XXX Memory accounting?
+			vector.SetStringAt(vec, rowIdx, field, nil)
+		}
+	case types.T_json:
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			byteJson, err := types.ParseStringToByteJson(field)
+			if err != nil {
+				logutil.Errorf("parse field[%v] err:%v", field, err)
+				return fmt.Errorf("the input value '%v' is not json type for column %d", field, colIdx)
+			}
+			jsonBytes, err := types.EncodeJson(byteJson)
+			if err != nil {
+				logutil.Errorf("encode json[%v] err:%v", field, err)
+				return fmt.Errorf("the input value '%v' is not json type for column %d", field, colIdx)
+			}
+			vector.SetBytesAt(vec, rowIdx, jsonBytes, nil)
+		}
+	case types.T_date:
+		cols := vec.Col.([]types.Date)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			d, err := types.ParseDate(field)
+			if err != nil {
+				logutil.Errorf("parse field[%v] err:%v", field, err)
+				return fmt.Errorf("the input value '%v' is not Date type for column %d", field, colIdx)
+			}
+			cols[rowIdx] = d
+		}
+	case types.T_time:
+		cols := vec.Col.([]types.Time)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			d, err := types.ParseTime(field, vec.Typ.Precision)
+			if err != nil {
+				logutil.Errorf("parse field[%v] err:%v", field, err)
+				return fmt.Errorf("the input value '%v' is not Time type for column %d", field, colIdx)
+			}
+			cols[rowIdx] = d
+		}
+	case types.T_datetime:
+		cols := vec.Col.([]types.Datetime)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			d, err := types.ParseDatetime(field, vec.Typ.Precision)
+			if err != nil {
+				logutil.Errorf("parse field[%v] err:%v", field, err)
+				return fmt.Errorf("the input value '%v' is not Datetime type for column %d", field, colIdx)
+			}
+			cols[rowIdx] = d
+		}
+	case types.T_decimal64:
+		cols := vec.Col.([]types.Decimal64)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			d, err := types.Decimal64_FromString(field)
+			if err != nil {
+				// we tolerate loss of digits.
+				if !moerr.IsMoErrCode(err, moerr.DATA_TRUNCATED) {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not Decimal64 type for column %d", field, colIdx)
 				}
-			case types.T_timestamp:
-				cols := vec.Col.([]types.Timestamp)
-				if isNullOrEmpty {
-					nulls.Add(vec.Nsp, uint64(rowIdx))
-				} else {
-					d, err := types.ParseTimestamp(time.UTC, field, vec.Typ.Precision)
-					if err != nil {
-						logutil.Errorf("parse field[%v] err:%v", field, err)
-						return nil, fmt.Errorf("the input value '%v' is not Timestamp type for column %d", field, colIdx)
-					}
-					cols[rowIdx] = d
+			}
+			cols[rowIdx] = d
+		}
+	case types.T_decimal128:
+		cols := vec.Col.([]types.Decimal128)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			d, err := types.Decimal128_FromString(field)
+			if err != nil {
+				// we tolerate loss of digits.
+				if !moerr.IsMoErrCode(err, moerr.DATA_TRUNCATED) {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return fmt.Errorf("the input value '%v' is not Decimal128 type for column %d", field, colIdx)
 				}
-			default:
-				return nil, fmt.Errorf("the value type %d is not support now", param.Cols[rowIdx].Typ.Id)
 			}
+			cols[rowIdx] = d
 		}
+	case types.T_timestamp:
+		cols := vec.Col.([]types.Timestamp)
+		if isNullOrEmpty {
+			nulls.Add(vec.Nsp, uint64(rowIdx))
+		} else {
+			d, err := types.ParseTimestamp(time.UTC, field, vec.Typ.Precision)
+			if err != nil {
+				logutil.Errorf("parse field[%v] err:%v", field, err)
+				return fmt.Errorf("the input value '%v' is not Timestamp type for column %d", field, colIdx)
+			}
+			cols[rowIdx] = d
+		}
+	default:
+		return fmt.Errorf("the value type %d is not support now", vec.Typ.Oid)
 	}
-	n := vector.Length(bat.Vecs[0])
-	sels := proc.Mp().GetSels()
-	if n > cap(sels) {
-		proc.Mp().PutSels(sels)
-		sels = make([]int64, n)
-	}
-	bat.Zs = sels[:n]
-	for k := 0; k < n; k++ {
-		bat.Zs[k] = 1
-	}
-
-	return bat, nil
+	return nil
 }
 
 // get file reader from external file
@@ -598,6 +628,15 @@ func GetSimdcsvReader(param *ExternalParam) (*ParseLineHandler, error) {
 	return plh, nil
 }
 
+// finishFile moves to the next file after the current file is read
+func finishFile(param *ExternalParam) {
+	param.FileIndex++
+	param.IgnoreLine = param.IgnoreLineTag
+	if param.FileIndex >= param.FileCnt {
+		param.End = true
+	}
+}
+
 // read batch data from external file
 func ScanFileData(param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
 	var bat *batch.Batch
@@ -619,11 +658,7 @@ func ScanFileData(param *ExternalParam, proc *process.Process) (*batch.Batch, er
 			logutil.Errorf("close file failed. err:%v", err)
 		}
 		param.plh = nil
-		param.FileIndex++
-		param.IgnoreLine = param.IgnoreLineTag
-		if param.FileIndex >= param.FileCnt {
-			param.End = true
-		}
+		finishFile(param)
 	}
 	if param.IgnoreLine != 0 {
 		plh.simdCsvLineArray = plh.simdCsvLineArray[param.IgnoreLine:]
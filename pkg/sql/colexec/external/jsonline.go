@@ -0,0 +1,160 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var jsonNull = []byte("null")
+
+func newJsonLineHandler(param *ExternalParam) (*JsonLineHandler, error) {
+	var err error
+	param.reader, err = ReadFile(param.extern)
+	if err != nil {
+		return nil, err
+	}
+	param.reader, err = getUnCompressReader(param.extern, param.reader)
+	if err != nil {
+		return nil, err
+	}
+	return &JsonLineHandler{reader: bufio.NewReader(param.reader)}, nil
+}
+
+// ScanJsonLineFile reads a batch from the json lines file, each line is an object
+// keyed by the column names or an array of the values in the order of the columns.
+func ScanJsonLineFile(param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	var err error
+	if param.jlh == nil {
+		if param.jlh, err = newJsonLineHandler(param); err != nil {
+			return nil, err
+		}
+	}
+	var lines [][]byte
+	finished := false
+	for len(lines) < param.batchSize {
+		line, err := param.jlh.reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if param.IgnoreLine > 0 {
+				param.IgnoreLine--
+			} else {
+				lines = append(lines, line)
+			}
+		}
+		if err == io.EOF {
+			finished = true
+			break
+		}
+	}
+	if finished {
+		if err = param.reader.Close(); err != nil {
+			logutil.Errorf("close file failed. err:%v", err)
+		}
+		param.jlh = nil
+		finishFile(param)
+	}
+
+	bat := makeBatch(param, len(lines))
+	for rowIdx, line := range lines {
+		values, err := parseJsonLine(param, line)
+		if err != nil {
+			return nil, err
+		}
+		for colIdx := range param.Attrs {
+			if err = setJsonValue(bat.Vecs[colIdx], values[colIdx], rowIdx, colIdx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	bat.InitZsOne(len(lines))
+	bat.Cnt = 1
+	return bat, nil
+}
+
+// parseJsonLine returns the raw json values of the attributes, the missing values are nil
+func parseJsonLine(param *ExternalParam, line []byte) ([]json.RawMessage, error) {
+	values := make([]json.RawMessage, len(param.Attrs))
+	switch line[0] {
+	case '{':
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(line, &obj); err != nil {
+			return nil, fmt.Errorf("the input line '%s' is not a json object: %v", line, err)
+		}
+		for i, attr := range param.Attrs {
+			v, ok := obj[attr]
+			if !ok {
+				for key, value := range obj {
+					if strings.EqualFold(key, attr) {
+						v = value
+						break
+					}
+				}
+			}
+			values[i] = v
+		}
+	case '[':
+		var array []json.RawMessage
+		if err := json.Unmarshal(line, &array); err != nil {
+			return nil, fmt.Errorf("the input line '%s' is not a json array: %v", line, err)
+		}
+		for i, attr := range param.Attrs {
+			idx := int(param.Name2ColIndex[attr])
+			if idx >= len(array) {
+				return nil, errors.New("the table column is larger than input data column")
+			}
+			values[i] = array[idx]
+		}
+	default:
+		return nil, fmt.Errorf("the input line '%s' is not a json object or array", line)
+	}
+	return values, nil
+}
+
+// setJsonValue sets the rowIdx-th value of the vector, the nested objects and arrays are kept as
+// json text, which are stored as bytejson by the json columns.
+func setJsonValue(vec *vector.Vector, value json.RawMessage, rowIdx, colIdx int) error {
+	if value == nil || bytes.Equal(value, jsonNull) {
+		nulls.Add(vec.Nsp, uint64(rowIdx))
+		return nil
+	}
+	id := vec.Typ.Oid
+	field := string(value)
+	if id != types.T_json && value[0] == '"' {
+		if err := json.Unmarshal(value, &field); err != nil {
+			return err
+		}
+	}
+	isNullOrEmpty := false
+	if id != types.T_char && id != types.T_varchar && id != types.T_json && id != types.T_blob {
+		isNullOrEmpty = len(field) == 0
+	}
+	return setFieldValue(vec, field, isNullOrEmpty, rowIdx, colIdx)
+}
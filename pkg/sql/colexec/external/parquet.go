@@ -0,0 +1,597 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/parquet"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// the julian day of 1970-01-01, which is used by the INT96 timestamps
+	julianDayOfUnixEpoch = 2440588
+	microSecsPerDay      = 24 * 3600 * 1000000
+)
+
+var unixEpochDate = types.Datetime(types.UnixToTimestamp(0)).ToDate()
+
+// fileReaderAt reads the ranges of an external file through the file service
+type fileReaderAt struct {
+	ctx      context.Context
+	fs       fileservice.ETLFileService
+	readPath string
+}
+
+func (r *fileReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	vec := fileservice.IOVector{
+		FilePath: r.readPath,
+		Entries: []fileservice.IOEntry{
+			0: {
+				Offset: off,
+				Size:   int64(len(p)),
+				Data:   p,
+			},
+		},
+	}
+	if err := r.fs.Read(r.ctx, &vec); err != nil {
+		return 0, err
+	}
+	return copy(p, vec.Entries[0].Data), nil
+}
+
+func newParquetHandler(param *ExternalParam) (*ParquetHandler, error) {
+	fs, readPath, err := fileservice.GetForETL(param.extern.FileService, param.extern.Filepath)
+	if err != nil {
+		return nil, err
+	}
+	ctx := param.Ctx
+	if ctx == nil {
+		ctx = context.TODO()
+	}
+	reader, err := parquet.NewReader(&fileReaderAt{ctx: ctx, fs: fs, readPath: readPath}, param.FileSize[param.FileIndex])
+	if err != nil {
+		return nil, fmt.Errorf("read parquet file '%s' failed: %v", param.extern.Filepath, err)
+	}
+	h := &ParquetHandler{
+		reader:  reader,
+		columns: make([]int, len(param.Attrs)),
+	}
+	// only the columns of the attributes are read
+	for i, attr := range param.Attrs {
+		h.columns[i] = -1
+		for j, col := range reader.Columns() {
+			if strings.EqualFold(col.Name, attr) {
+				h.columns[i] = j
+				break
+			}
+		}
+		if h.columns[i] < 0 {
+			return nil, fmt.Errorf("the column '%s' is not found in the parquet file '%s'", attr, param.extern.Filepath)
+		}
+	}
+	return h, nil
+}
+
+// ScanParquetFile reads a batch from the current row group of the parquet file,
+// the row groups which can not match the filters are skipped.
+func ScanParquetFile(param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	var err error
+	if param.pqh == nil {
+		if param.pqh, err = newParquetHandler(param); err != nil {
+			return nil, err
+		}
+	}
+	h := param.pqh
+	for h.values == nil && h.rowGroup < h.reader.NumRowGroups() {
+		if h.reader.RowGroupNumRows(h.rowGroup) == 0 || !h.rowGroupMayMatch(param.Filters) {
+			h.rowGroup++
+			continue
+		}
+		if err = h.readRowGroup(); err != nil {
+			return nil, fmt.Errorf("read parquet file '%s' failed: %v", param.extern.Filepath, err)
+		}
+	}
+
+	n := 0
+	if h.values != nil {
+		n = int(h.reader.RowGroupNumRows(h.rowGroup)) - h.offset
+		if n > param.batchSize {
+			n = param.batchSize
+		}
+	}
+	bat := makeBatch(param, n)
+	for colIdx := range param.Attrs {
+		col := &h.reader.Columns()[h.columns[colIdx]]
+		for rowIdx := 0; rowIdx < n; rowIdx++ {
+			i := h.offset + rowIdx
+			if h.nulls[colIdx] != nil && h.nulls[colIdx][i] {
+				nulls.Add(bat.Vecs[colIdx].Nsp, uint64(rowIdx))
+				continue
+			}
+			if err = setParquetValue(bat.Vecs[colIdx], col, h.values[colIdx], i, rowIdx, colIdx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	h.offset += n
+	if h.values != nil && h.offset >= int(h.reader.RowGroupNumRows(h.rowGroup)) {
+		h.values, h.nulls, h.offset = nil, nil, 0
+		h.rowGroup++
+	}
+	if h.rowGroup >= h.reader.NumRowGroups() {
+		param.pqh = nil
+		finishFile(param)
+	}
+	bat.InitZsOne(n)
+	bat.Cnt = 1
+	return bat, nil
+}
+
+func (h *ParquetHandler) readRowGroup() error {
+	h.values = make([]*parquet.Values, len(h.columns))
+	h.nulls = make([][]bool, len(h.columns))
+	for i, col := range h.columns {
+		values, nulls, err := h.reader.ReadColumn(h.rowGroup, col)
+		if err != nil {
+			h.values, h.nulls = nil, nil
+			return err
+		}
+		h.values[i], h.nulls[i] = values, nulls
+	}
+	h.offset = 0
+	return nil
+}
+
+// rowGroupMayMatch checks the filters with the statistics of the row group, it is false
+// only if no row of the row group can satisfy the filters.
+func (h *ParquetHandler) rowGroupMayMatch(filters []*plan.Expr) bool {
+	for _, filter := range filters {
+		if !h.mayMatch(filter) {
+			return false
+		}
+	}
+	return true
+}
+
+// mayMatch only checks the comparisons between a column and a constant, which
+// may be combined by and, the other filters are always considered as matched.
+func (h *ParquetHandler) mayMatch(expr *plan.Expr) bool {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return true
+	}
+	op, args := f.F.Func.ObjName, f.F.Args
+	switch op {
+	case "and":
+		return h.mayMatch(args[0]) && h.mayMatch(args[1])
+	case "=", "<", "<=", ">", ">=":
+	default:
+		return true
+	}
+
+	left, right := args[0], args[1]
+	if _, ok := right.Expr.(*plan.Expr_Col); ok {
+		left, right = right, left
+		switch op {
+		case "<":
+			op = ">"
+		case "<=":
+			op = ">="
+		case ">":
+			op = "<"
+		case ">=":
+			op = "<="
+		}
+	}
+	colRef, ok := left.Expr.(*plan.Expr_Col)
+	if !ok || int(colRef.Col.ColPos) >= len(h.columns) {
+		return true
+	}
+	col := h.columns[colRef.Col.ColPos]
+	min, max, nullCount, ok := h.reader.ColumnBounds(h.rowGroup, col)
+	if !ok {
+		// a comparison with null is never true
+		return nullCount < h.reader.RowGroupNumRows(h.rowGroup)
+	}
+	if !hasNaturalOrder(&h.reader.Columns()[col]) {
+		return true
+	}
+	cmpMin, ok := compareWithConst(min, right)
+	if !ok {
+		return true
+	}
+	cmpMax, ok := compareWithConst(max, right)
+	if !ok {
+		return true
+	}
+	switch op {
+	case "=":
+		return cmpMin <= 0 && cmpMax >= 0
+	case "<":
+		return cmpMin < 0
+	case "<=":
+		return cmpMin <= 0
+	case ">":
+		return cmpMax > 0
+	default:
+		return cmpMax >= 0
+	}
+}
+
+// hasNaturalOrder is true if the order of the statistics is the order of the values
+// in the column, which are the signed numbers and the strings.
+func hasNaturalOrder(col *parquet.Column) bool {
+	if col.LogicalType != nil && col.LogicalType.Integer == nil && col.LogicalType.String == nil {
+		return false
+	}
+	if col.LogicalType != nil && col.LogicalType.Integer != nil && !col.LogicalType.Integer.IsSigned {
+		return false
+	}
+	if col.ConvertedType != nil {
+		switch *col.ConvertedType {
+		case parquet.ConvertedUTF8, parquet.ConvertedInt8, parquet.ConvertedInt16,
+			parquet.ConvertedInt32, parquet.ConvertedInt64:
+		default:
+			return false
+		}
+	}
+	switch col.Type {
+	case parquet.Int32, parquet.Int64, parquet.Float, parquet.Double, parquet.ByteArray:
+		return true
+	}
+	return false
+}
+
+// compareWithConst compares the statistic value with the constant, ok is false if
+// the result is unknown. A cast of the constant is ignored if it does not change the value.
+func compareWithConst(v *parquet.Values, expr *plan.Expr) (int, bool) {
+	if f, ok := expr.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "cast" {
+		expr = f.F.Args[0]
+	}
+	c, ok := expr.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return 0, false
+	}
+	switch v.Type {
+	case parquet.Int32, parquet.Int64:
+		x := int64(0)
+		if v.Type == parquet.Int32 {
+			x = int64(v.Int32s[0])
+		} else {
+			x = v.Int64s[0]
+		}
+		switch cv := c.C.Value.(type) {
+		case *plan.Const_Ival:
+			return compareOrdered(x, cv.Ival), true
+		case *plan.Const_Uval:
+			if cv.Uval > math.MaxInt64 {
+				return -1, true
+			}
+			return compareOrdered(x, int64(cv.Uval)), true
+		}
+	case parquet.Float, parquet.Double:
+		x := 0.0
+		if v.Type == parquet.Float {
+			x = float64(v.Floats[0])
+		} else {
+			x = v.Doubles[0]
+		}
+		var y float64
+		switch cv := c.C.Value.(type) {
+		case *plan.Const_Ival:
+			y = float64(cv.Ival)
+		case *plan.Const_Uval:
+			y = float64(cv.Uval)
+		case *plan.Const_Fval:
+			y = float64(cv.Fval)
+		case *plan.Const_Dval:
+			y = cv.Dval
+		default:
+			return 0, false
+		}
+		// the float32 values may be different after rounded
+		if cmp := compareOrdered(x, y); cmp != 0 {
+			return cmp, true
+		}
+	case parquet.ByteArray:
+		if cv, ok := c.C.Value.(*plan.Const_Sval); ok {
+			return bytes.Compare(v.Bytes[0], []byte(cv.Sval)), true
+		}
+	}
+	return 0, false
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+func isUnsignedColumn(col *parquet.Column) bool {
+	if col.LogicalType != nil && col.LogicalType.Integer != nil {
+		return !col.LogicalType.Integer.IsSigned
+	}
+	if col.ConvertedType != nil {
+		switch *col.ConvertedType {
+		case parquet.ConvertedUint8, parquet.ConvertedUint16, parquet.ConvertedUint32, parquet.ConvertedUint64:
+			return true
+		}
+	}
+	return false
+}
+
+func isDateColumn(col *parquet.Column) bool {
+	return col.Type == parquet.Int32 && (col.LogicalType != nil && col.LogicalType.Date != nil ||
+		col.ConvertedType != nil && *col.ConvertedType == parquet.ConvertedDate)
+}
+
+func isDecimalColumn(col *parquet.Column) bool {
+	return col.LogicalType != nil && col.LogicalType.Decimal != nil ||
+		col.ConvertedType != nil && *col.ConvertedType == parquet.ConvertedDecimal
+}
+
+// timestampUnit returns the number of the units per second of the timestamp column,
+// it is 0 if the column is not a timestamp.
+func timestampUnit(col *parquet.Column) int64 {
+	if col.Type == parquet.Int96 {
+		return 1000000000
+	}
+	if col.Type != parquet.Int64 {
+		return 0
+	}
+	if col.LogicalType != nil && col.LogicalType.Timestamp != nil {
+		unit := col.LogicalType.Timestamp.Unit
+		switch {
+		case unit.Millis != nil:
+			return 1000
+		case unit.Micros != nil:
+			return 1000000
+		case unit.Nanos != nil:
+			return 1000000000
+		}
+	}
+	if col.ConvertedType != nil {
+		switch *col.ConvertedType {
+		case parquet.ConvertedTimestampMillis:
+			return 1000
+		case parquet.ConvertedTimestampMicros:
+			return 1000000
+		}
+	}
+	return 0
+}
+
+// timestampMicros returns the microseconds since the unix epoch of the i-th value
+func timestampMicros(col *parquet.Column, values *parquet.Values, i int) (int64, bool) {
+	switch unit := timestampUnit(col); {
+	case unit == 0:
+		return 0, false
+	case col.Type == parquet.Int96:
+		// the nanoseconds of the day and the julian day
+		v := values.Int96s[i]
+		nanos := int64(binary.LittleEndian.Uint64(v[:8]))
+		days := int64(binary.LittleEndian.Uint32(v[8:])) - julianDayOfUnixEpoch
+		return days*microSecsPerDay + nanos/1000, true
+	case unit > 1000000:
+		return values.Int64s[i] / (unit / 1000000), true
+	default:
+		return values.Int64s[i] * (1000000 / unit), true
+	}
+}
+
+func intValue(col *parquet.Column, values *parquet.Values, i int) (int64, bool) {
+	switch values.Type {
+	case parquet.Int32:
+		if isUnsignedColumn(col) {
+			return int64(uint32(values.Int32s[i])), true
+		}
+		return int64(values.Int32s[i]), true
+	case parquet.Int64:
+		return values.Int64s[i], true
+	}
+	return 0, false
+}
+
+// setParquetValue sets the rowIdx-th value of the vector by the i-th value of the parquet
+// column, the values which can not be converted directly are converted by their text.
+func setParquetValue(vec *vector.Vector, col *parquet.Column, values *parquet.Values, i, rowIdx, colIdx int) error {
+	id := vec.Typ.Oid
+	switch {
+	case id == types.T_bool && values.Type == parquet.Boolean:
+		vec.Col.([]bool)[rowIdx] = values.Bools[i]
+		return nil
+	case id == types.T_float32 && values.Type == parquet.Float:
+		vec.Col.([]float32)[rowIdx] = values.Floats[i]
+		return nil
+	case id == types.T_float64 && values.Type == parquet.Float:
+		vec.Col.([]float64)[rowIdx] = float64(values.Floats[i])
+		return nil
+	case id == types.T_float64 && values.Type == parquet.Double:
+		vec.Col.([]float64)[rowIdx] = values.Doubles[i]
+		return nil
+	case id == types.T_date && isDateColumn(col):
+		vec.Col.([]types.Date)[rowIdx] = unixEpochDate + types.Date(values.Int32s[i])
+		return nil
+	case id == types.T_datetime || id == types.T_timestamp:
+		if us, ok := timestampMicros(col, values, i); ok {
+			ts := types.UnixMicroToTimestamp(us)
+			if id == types.T_datetime {
+				vec.Col.([]types.Datetime)[rowIdx] = types.Datetime(ts)
+			} else {
+				vec.Col.([]types.Timestamp)[rowIdx] = ts
+			}
+			return nil
+		}
+	case (id == types.T_char || id == types.T_varchar || id == types.T_blob) &&
+		values.Type == parquet.ByteArray && !isDecimalColumn(col):
+		vector.SetBytesAt(vec, rowIdx, values.Bytes[i], nil)
+		return nil
+	case isIntegerType(id) && !isDecimalColumn(col) && timestampUnit(col) == 0 && !isDateColumn(col):
+		if v, ok := intValue(col, values, i); ok {
+			return setIntValue(vec, v, isUnsignedColumn(col), rowIdx, colIdx)
+		}
+	}
+	field, err := parquetValueString(col, values, i)
+	if err != nil {
+		return err
+	}
+	return setFieldValue(vec, field, false, rowIdx, colIdx)
+}
+
+func isIntegerType(id types.T) bool {
+	switch id {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true
+	}
+	return false
+}
+
+func setIntValue(vec *vector.Vector, v int64, unsigned bool, rowIdx, colIdx int) error {
+	outOfRange := func(min, max int64) bool {
+		return unsigned && v < 0 || v < min || v > max
+	}
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		if outOfRange(math.MinInt8, math.MaxInt8) {
+			break
+		}
+		vec.Col.([]int8)[rowIdx] = int8(v)
+		return nil
+	case types.T_int16:
+		if outOfRange(math.MinInt16, math.MaxInt16) {
+			break
+		}
+		vec.Col.([]int16)[rowIdx] = int16(v)
+		return nil
+	case types.T_int32:
+		if outOfRange(math.MinInt32, math.MaxInt32) {
+			break
+		}
+		vec.Col.([]int32)[rowIdx] = int32(v)
+		return nil
+	case types.T_int64:
+		if outOfRange(math.MinInt64, math.MaxInt64) {
+			break
+		}
+		vec.Col.([]int64)[rowIdx] = v
+		return nil
+	case types.T_uint8:
+		if outOfRange(0, math.MaxUint8) {
+			break
+		}
+		vec.Col.([]uint8)[rowIdx] = uint8(v)
+		return nil
+	case types.T_uint16:
+		if outOfRange(0, math.MaxUint16) {
+			break
+		}
+		vec.Col.([]uint16)[rowIdx] = uint16(v)
+		return nil
+	case types.T_uint32:
+		if outOfRange(0, math.MaxUint32) {
+			break
+		}
+		vec.Col.([]uint32)[rowIdx] = uint32(v)
+		return nil
+	case types.T_uint64:
+		// the unsigned 64 bits integers are stored as int64 in the parquet file
+		if !unsigned && v < 0 {
+			break
+		}
+		vec.Col.([]uint64)[rowIdx] = uint64(v)
+		return nil
+	}
+	if unsigned {
+		return fmt.Errorf("the input value '%v' is not %s type for column %d", uint64(v), vec.Typ.Oid, colIdx)
+	}
+	return fmt.Errorf("the input value '%v' is not %s type for column %d", v, vec.Typ.Oid, colIdx)
+}
+
+// parquetValueString returns the text of the i-th value of the parquet column
+func parquetValueString(col *parquet.Column, values *parquet.Values, i int) (string, error) {
+	if us, ok := timestampMicros(col, values, i); ok {
+		return types.Datetime(types.UnixMicroToTimestamp(us)).String2(6), nil
+	}
+	if isDateColumn(col) {
+		return (unixEpochDate + types.Date(values.Int32s[i])).String(), nil
+	}
+	switch values.Type {
+	case parquet.Boolean:
+		return strconv.FormatBool(values.Bools[i]), nil
+	case parquet.Int32, parquet.Int64:
+		v, _ := intValue(col, values, i)
+		if isDecimalColumn(col) {
+			return formatDecimal(big.NewInt(v), col.Scale), nil
+		}
+		if isUnsignedColumn(col) {
+			return strconv.FormatUint(uint64(v), 10), nil
+		}
+		return strconv.FormatInt(v, 10), nil
+	case parquet.Float:
+		return strconv.FormatFloat(float64(values.Floats[i]), 'g', -1, 32), nil
+	case parquet.Double:
+		return strconv.FormatFloat(values.Doubles[i], 'g', -1, 64), nil
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		if isDecimalColumn(col) {
+			// the unscaled value is a big-endian two's complement integer
+			b := values.Bytes[i]
+			v := new(big.Int).SetBytes(b)
+			if len(b) > 0 && b[0]&0x80 != 0 {
+				v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+			}
+			return formatDecimal(v, col.Scale), nil
+		}
+		return string(values.Bytes[i]), nil
+	}
+	return "", fmt.Errorf("the parquet type %s of column '%s' is not support now", values.Type, col.Name)
+}
+
+// formatDecimal formats the unscaled value of a decimal with the scale
+func formatDecimal(v *big.Int, scale int32) string {
+	s := new(big.Int).Abs(v).String()
+	if scale > 0 {
+		if len(s) <= int(scale) {
+			s = strings.Repeat("0", int(scale)-len(s)+1) + s
+		}
+		s = s[:len(s)-int(scale)] + "." + s[len(s)-int(scale):]
+	}
+	if v.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
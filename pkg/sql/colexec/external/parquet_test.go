@@ -0,0 +1,203 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/parquet"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func newScanArgument(filepath, format string, attrs []string, typs []types.T) *Argument {
+	extern := &tree.ExternParam{
+		Filepath: filepath,
+		Format:   format,
+		Tail:     &tree.TailParameter{},
+	}
+	data, err := json.Marshal(extern)
+	if err != nil {
+		panic(err)
+	}
+	param := &ExternalParam{
+		Attrs:         attrs,
+		Name2ColIndex: make(map[string]int32),
+		CreateSql:     string(data),
+		Ctx:           context.Background(),
+	}
+	for i, attr := range attrs {
+		param.Cols = append(param.Cols, &plan.ColDef{Name: attr, Typ: &plan.Type{Id: int32(typs[i])}})
+		param.Name2ColIndex[attr] = int32(i)
+	}
+	return &Argument{Es: param}
+}
+
+// scanAll reads all the batches of the files
+func scanAll(arg *Argument) ([]*batch.Batch, error) {
+	proc := testutil.NewProcess()
+	proc.FileService = testutil.NewFS()
+	if err := Prepare(proc, arg); err != nil {
+		return nil, err
+	}
+	var bats []*batch.Batch
+	for {
+		end, err := Call(0, proc, arg)
+		if err != nil {
+			return nil, err
+		}
+		if end {
+			return bats, nil
+		}
+		if bat := proc.InputBatch(); bat != nil && len(bat.Zs) > 0 {
+			bats = append(bats, bat)
+		}
+	}
+}
+
+func writeParquetFile(dir string) error {
+	utf8 := parquet.ConvertedUTF8
+	date := parquet.ConvertedDate
+	timestamp := parquet.ConvertedTimestampMillis
+	decimal := parquet.ConvertedDecimal
+	f, err := os.Create(path.Join(dir, "a.parquet"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := parquet.NewWriter(f, []parquet.Column{
+		{Name: "id", Type: parquet.Int64},
+		{Name: "Name", Type: parquet.ByteArray, Optional: true, ConvertedType: &utf8},
+		{Name: "d", Type: parquet.Int32, ConvertedType: &date},
+		{Name: "ts", Type: parquet.Int64, ConvertedType: &timestamp},
+		{Name: "price", Type: parquet.Int64, ConvertedType: &decimal, Scale: 2, Precision: 10},
+		{Name: "doc", Type: parquet.ByteArray, Optional: true, ConvertedType: &utf8},
+	})
+	if err = w.WriteRowGroup([]*parquet.Values{
+		{Type: parquet.Int64, Int64s: []int64{1, 2, 3}},
+		{Type: parquet.ByteArray, Bytes: [][]byte{[]byte("a"), nil, []byte("c")}},
+		{Type: parquet.Int32, Int32s: []int32{0, 1, 18512}},
+		{Type: parquet.Int64, Int64s: []int64{0, 1000, 1599436800000}},
+		{Type: parquet.Int64, Int64s: []int64{100, -250, 5}},
+		{Type: parquet.ByteArray, Bytes: [][]byte{[]byte(`{"a":[1,2]}`), nil, []byte(`"x"`)}},
+	}, [][]bool{nil, {false, true, false}, nil, nil, nil, {false, true, false}}); err != nil {
+		return err
+	}
+	if err = w.WriteRowGroup([]*parquet.Values{
+		{Type: parquet.Int64, Int64s: []int64{10}},
+		{Type: parquet.ByteArray, Bytes: [][]byte{[]byte("d")}},
+		{Type: parquet.Int32, Int32s: []int32{-1}},
+		{Type: parquet.Int64, Int64s: []int64{-1000}},
+		{Type: parquet.Int64, Int64s: []int64{1}},
+		{Type: parquet.ByteArray, Bytes: [][]byte{[]byte(`null`)}},
+	}, [][]bool{nil, nil, nil, nil, nil, nil}); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func Test_ScanParquetFile(t *testing.T) {
+	convey.Convey("scan parquet file", t, func() {
+		dir := t.TempDir()
+		convey.So(writeParquetFile(dir), convey.ShouldBeNil)
+		attrs := []string{"id", "name", "d", "ts", "price", "doc"}
+		typs := []types.T{types.T_int32, types.T_varchar, types.T_date, types.T_datetime, types.T_decimal64, types.T_json}
+
+		bats, err := scanAll(newScanArgument(path.Join(dir, "*.parquet"), tree.PARQUET, attrs, typs))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(bats), convey.ShouldEqual, 2)
+		bat := bats[0]
+		convey.So(len(bat.Zs), convey.ShouldEqual, 3)
+		convey.So(vector.MustTCols[int32](bat.Vecs[0]), convey.ShouldResemble, []int32{1, 2, 3})
+		convey.So(bat.Vecs[1].GetString(0), convey.ShouldEqual, "a")
+		convey.So(nulls.Contains(bat.Vecs[1].Nsp, 1), convey.ShouldBeTrue)
+		dates := vector.MustTCols[types.Date](bat.Vecs[2])
+		convey.So(dates[0].String(), convey.ShouldEqual, "1970-01-01")
+		convey.So(dates[2].String(), convey.ShouldEqual, "2020-09-07")
+		datetimes := vector.MustTCols[types.Datetime](bat.Vecs[3])
+		convey.So(datetimes[1].String(), convey.ShouldEqual, "1970-01-01 00:00:01")
+		convey.So(datetimes[2].String(), convey.ShouldEqual, "2020-09-07 00:00:00")
+		decimals := vector.MustTCols[types.Decimal64](bat.Vecs[4])
+		convey.So(decimals[0].ToStringWithScale(2), convey.ShouldEqual, "1.00")
+		convey.So(decimals[1].ToStringWithScale(2), convey.ShouldEqual, "-2.50")
+		convey.So(types.DecodeJson(bat.Vecs[5].GetBytes(0)).String(), convey.ShouldEqual, `{"a": [1, 2]}`)
+		convey.So(nulls.Contains(bat.Vecs[5].Nsp, 1), convey.ShouldBeTrue)
+		convey.So(vector.MustTCols[int32](bats[1].Vecs[0]), convey.ShouldResemble, []int32{10})
+
+		// only the projected columns are read, and the row groups are skipped by the filters
+		arg := newScanArgument(path.Join(dir, "a.parquet"), tree.PARQUET, []string{"name", "id"}, []types.T{types.T_varchar, types.T_int64})
+		arg.Es.Filters = []*plan.Expr{{
+			Expr: &plan.Expr_F{F: &plan.Function{
+				Func: &plan.ObjectRef{ObjName: "<"},
+				Args: []*plan.Expr{
+					{Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: 5}}}},
+					{Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 1}}},
+				},
+			}},
+		}}
+		bats, err = scanAll(arg)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(bats), convey.ShouldEqual, 1)
+		convey.So(bats[0].Vecs[0].GetString(0), convey.ShouldEqual, "d")
+		convey.So(vector.MustTCols[int64](bats[0].Vecs[1]), convey.ShouldResemble, []int64{10})
+
+		// the value is out of the range of the column
+		_, err = scanAll(newScanArgument(path.Join(dir, "a.parquet"), tree.PARQUET, []string{"ts"}, []types.T{types.T_int8}))
+		convey.So(err, convey.ShouldNotBeNil)
+
+		_, err = scanAll(newScanArgument(path.Join(dir, "a.parquet"), tree.PARQUET, []string{"b"}, []types.T{types.T_int8}))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_ScanJsonLineFile(t *testing.T) {
+	convey.Convey("scan json lines file", t, func() {
+		dir := t.TempDir()
+		data := `{"id": 1, "name": "a", "doc": {"k": [1, {"x": null}]}}
+
+{"ID": 2, "name": null, "doc": "s", "other": true}
+[3, "c", [1, 2]]
+{"id": "4"}`
+		convey.So(os.WriteFile(path.Join(dir, "a.jl"), []byte(data), 0644), convey.ShouldBeNil)
+		attrs := []string{"id", "name", "doc"}
+		typs := []types.T{types.T_int64, types.T_varchar, types.T_json}
+		bats, err := scanAll(newScanArgument(path.Join(dir, "a.jl"), tree.JSONLINE, attrs, typs))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(bats), convey.ShouldEqual, 1)
+		bat := bats[0]
+		convey.So(vector.MustTCols[int64](bat.Vecs[0]), convey.ShouldResemble, []int64{1, 2, 3, 4})
+		convey.So(bat.Vecs[1].GetString(0), convey.ShouldEqual, "a")
+		convey.So(nulls.Contains(bat.Vecs[1].Nsp, 1), convey.ShouldBeTrue)
+		convey.So(bat.Vecs[1].GetString(2), convey.ShouldEqual, "c")
+		convey.So(nulls.Contains(bat.Vecs[1].Nsp, 3), convey.ShouldBeTrue)
+		convey.So(types.DecodeJson(bat.Vecs[2].GetBytes(0)).String(), convey.ShouldEqual, `{"k": [1, {"x": null}]}`)
+		convey.So(types.DecodeJson(bat.Vecs[2].GetBytes(1)).String(), convey.ShouldEqual, `"s"`)
+		convey.So(types.DecodeJson(bat.Vecs[2].GetBytes(2)).String(), convey.ShouldEqual, `[1, 2]`)
+
+		convey.So(os.WriteFile(path.Join(dir, "b.jl"), []byte(`{"id": "x"}`), 0644), convey.ShouldBeNil)
+		_, err = scanAll(newScanArgument(path.Join(dir, "b.jl"), tree.JSONLINE, attrs, typs))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -15,10 +15,12 @@
 package external
 
 import (
+	"bufio"
 	"context"
 	"io"
 	"sync/atomic"
 
+	"github.com/matrixorigin/matrixone/pkg/common/parquet"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/simdcsv"
@@ -40,8 +42,13 @@ type ExternalParam struct {
 	FileCnt   int
 	FileIndex int
 	FileList  []string
+	FileSize  []int64
+	// the filters pushed down to the scan, which are used to skip the row groups of the parquet files
+	Filters   []*plan.Expr
 	batchSize int
 	reader    io.ReadCloser
+	pqh       *ParquetHandler
+	jlh       *JsonLineHandler
 }
 
 type Argument struct {
@@ -57,3 +64,18 @@ type ParseLineHandler struct {
 	//simd csv
 	simdCsvLineArray [][]string
 }
+
+type ParquetHandler struct {
+	reader *parquet.Reader
+	// the parquet column of each attribute
+	columns []int
+	// the row group being read and the values of its projected columns
+	rowGroup int
+	offset   int
+	values   []*parquet.Values
+	nulls    [][]bool
+}
+
+type JsonLineHandler struct {
+	reader *bufio.Reader
+}
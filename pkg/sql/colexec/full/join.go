@@ -0,0 +1,396 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package full
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/common/bitmap"
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(_ any, buf *bytes.Buffer) {
+	buf.WriteString(" full join ")
+}
+
+func Prepare(proc *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	ap.ctr.inBuckets = make([]uint8, hashmap.UnitLimit)
+	ap.ctr.evecs = make([]evalVector, len(ap.Conditions[0]))
+	ap.ctr.vecs = make([]*vector.Vector, len(ap.Conditions[0]))
+	ap.ctr.bat = batch.NewWithSize(len(ap.Typs))
+	ap.ctr.bat.Zs = proc.GetMheap().GetSels()
+	for i, typ := range ap.Typs {
+		ap.ctr.bat.Vecs[i] = vector.New(typ)
+	}
+	if ap.Matched == nil {
+		ap.Matched = colexec.NewMatchedRows()
+	}
+	return nil
+}
+
+func Call(idx int, proc *process.Process, arg any) (bool, error) {
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(ap, proc, anal); err != nil {
+				ctr.state = End
+				if ctr.mp != nil {
+					ctr.mp.Free()
+				}
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			bat := <-proc.Reg.MergeReceivers[0].Ch
+			if bat == nil {
+				ctr.state = Finalize
+				continue
+			}
+			if bat.Length() == 0 {
+				continue
+			}
+			if ctr.sj != nil {
+				if err := ctr.sj.Spill(proc, bat, anal); err != nil {
+					ctr.state = End
+					ctr.freeSpilled(proc)
+					return true, err
+				}
+				continue
+			}
+			if ctr.bat.Length() == 0 {
+				if err := ctr.emptyProbe(bat, ap, proc, anal); err != nil {
+					ctr.state = End
+					ctr.free(proc)
+					proc.SetInputBatch(nil)
+					return true, err
+				}
+			} else {
+				if err := ctr.probe(bat, ap, proc, anal); err != nil {
+					ctr.state = End
+					ctr.free(proc)
+					proc.SetInputBatch(nil)
+					return true, err
+				}
+			}
+			return false, nil
+		case Finalize:
+			if ctr.sj != nil {
+				// the last pipeline to finish joins the probe rows spilled by all of them
+				matched, sps := ap.Matched.MergeSpilled(ctr.matched, ctr.sj.TakeProbe())
+				if matched == nil {
+					ctr.state = End
+					ctr.freeSpilled(proc)
+					continue
+				}
+				ctr.sj.AddProbe(sps...)
+				ctr.state = ProbeSpilled
+				continue
+			}
+			ctr.state = End
+			ok, err := ctr.finalize(ap, proc, anal)
+			ctr.free(proc)
+			if err != nil {
+				proc.SetInputBatch(nil)
+				return true, err
+			}
+			if ok {
+				return false, nil
+			}
+		case ProbeSpilled:
+			ok, err := ctr.probeSpilled(ap, proc, anal)
+			if err != nil || !ok {
+				ctr.state = End
+				ctr.freeSpilled(proc)
+				if err != nil {
+					proc.SetInputBatch(nil)
+					return true, err
+				}
+				continue
+			}
+			return false, nil
+		default:
+			proc.SetInputBatch(nil)
+			return true, nil
+		}
+	}
+}
+
+func (ctr *container) build(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	bat := <-proc.Reg.MergeReceivers[1].Ch
+	if bat != nil {
+		ctr.bat = bat
+		ctr.mp = bat.Ht.(*hashmap.JoinMap).Dup()
+	}
+	ctr.matched = bitmap.New(ctr.bat.Length())
+	if ctr.mp != nil && ctr.mp.Spilled() != nil {
+		ctr.sj = colexec.NewSpilledJoin(ctr.mp, ap.Typs, ap.Conditions, ap.Ibucket, ap.Nbucket,
+			colexec.SpillKeepProbe|colexec.SpillKeepBuild)
+		ctr.bat.Clean(proc.GetMheap())
+		ctr.bat, ctr.mp = nil, nil
+	}
+	return nil
+}
+
+// probeSpilled probes the next probe batch of the spilled partitions with the
+// build rows of its partition, and emits the build rows of the partition never
+// matched once all its probe batches are probed, it returns false once all the
+// partitions are joined.
+func (ctr *container) probeSpilled(ap *Argument, proc *process.Process, anal process.Analyze) (bool, error) {
+	return ctr.sj.Probe(proc, func(bat *batch.Batch, mp *hashmap.JoinMap) {
+		ctr.bat, ctr.mp = bat, mp
+		ctr.matched = bitmap.New(bat.Length())
+	}, func(bat *batch.Batch) error {
+		return ctr.probe(bat, ap, proc, anal)
+	}, func() (bool, error) {
+		return ctr.emitUnmatched(ctr.matched, ap, proc, anal)
+	})
+}
+
+// freeSpilled removes the rows spilled, the build rows of the partition probed
+// are freed with them.
+func (ctr *container) freeSpilled(proc *process.Process) {
+	ctr.sj.Free(proc)
+	ctr.sj = nil
+	ctr.bat, ctr.mp = nil, nil
+}
+
+func (ctr *container) emptyProbe(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze) error {
+	defer bat.Clean(proc.GetMheap())
+	anal.Input(bat)
+	rbat := batch.NewWithSize(len(ap.Result))
+	count := bat.Length()
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = bat.Vecs[rp.Pos]
+			bat.Vecs[rp.Pos] = nil
+		} else {
+			rbat.Vecs[i] = vector.NewConstNull(ctr.bat.Vecs[rp.Pos].Typ, count)
+		}
+	}
+	rbat.Zs = bat.Zs
+	bat.Zs = nil
+	anal.Output(rbat)
+	proc.SetInputBatch(rbat)
+	return nil
+}
+
+func (ctr *container) probe(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze) error {
+	defer bat.Clean(proc.GetMheap())
+	anal.Input(bat)
+	rbat := batch.NewWithSize(len(ap.Result))
+	rbat.Zs = proc.GetMheap().GetSels()
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = vector.New(bat.Vecs[rp.Pos].Typ)
+		} else {
+			rbat.Vecs[i] = vector.New(ctr.bat.Vecs[rp.Pos].Typ)
+		}
+	}
+	if err := ctr.evalJoinCondition(bat, ap.Conditions[0], proc); err != nil {
+		return err
+	}
+	defer ctr.freeJoinCondition(proc)
+	count := bat.Length()
+	mSels := ctr.mp.Sels()
+	itr := ctr.mp.Map().NewIterator()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		copy(ctr.inBuckets, hashmap.OneUInt8s)
+		vals, zvals := itr.Find(i, n, ctr.vecs, ctr.inBuckets)
+		for k := 0; k < n; k++ {
+			if ctr.inBuckets[k] == 0 {
+				continue
+			}
+			if zvals[k] == 0 || vals[k] == 0 {
+				if err := ctr.unionProbeRow(rbat, bat, int64(i+k), ap, proc); err != nil {
+					rbat.Clean(proc.Mp())
+					return err
+				}
+				continue
+			}
+			sels := mSels[vals[k]-1]
+			matched := false
+			for _, sel := range sels {
+				if ap.Cond != nil {
+					vec, err := colexec.JoinFilterEvalExprInBucket(bat, ctr.bat, i+k, int(sel), proc, ap.Cond)
+					if err != nil {
+						return err
+					}
+					bs := vec.Col.([]bool)
+					if !bs[0] {
+						vec.Free(proc.Mp())
+						continue
+					}
+					vec.Free(proc.Mp())
+				}
+				matched = true
+				ctr.matched.Add(uint64(sel))
+				for j, rp := range ap.Result {
+					if rp.Rel == 0 {
+						if err := vector.UnionOne(rbat.Vecs[j], bat.Vecs[rp.Pos], int64(i+k), proc.Mp()); err != nil {
+							rbat.Clean(proc.Mp())
+							return err
+						}
+					} else {
+						if err := vector.UnionOne(rbat.Vecs[j], ctr.bat.Vecs[rp.Pos], sel, proc.Mp()); err != nil {
+							rbat.Clean(proc.Mp())
+							return err
+						}
+					}
+				}
+				rbat.Zs = append(rbat.Zs, ctr.bat.Zs[sel])
+			}
+			if !matched {
+				if err := ctr.unionProbeRow(rbat, bat, int64(i+k), ap, proc); err != nil {
+					rbat.Clean(proc.Mp())
+					return err
+				}
+			}
+		}
+	}
+	rbat.ExpandNulls()
+	anal.Output(rbat)
+	proc.SetInputBatch(rbat)
+	return nil
+}
+
+// unionProbeRow appends a probe row that matched nothing, with nulls for the build side.
+func (ctr *container) unionProbeRow(rbat, bat *batch.Batch, row int64, ap *Argument, proc *process.Process) error {
+	for j, rp := range ap.Result {
+		if rp.Rel == 0 {
+			if err := vector.UnionOne(rbat.Vecs[j], bat.Vecs[rp.Pos], row, proc.Mp()); err != nil {
+				return err
+			}
+		} else {
+			if err := vector.UnionNull(rbat.Vecs[j], ctr.bat.Vecs[rp.Pos], proc.Mp()); err != nil {
+				return err
+			}
+		}
+	}
+	rbat.Zs = append(rbat.Zs, bat.Zs[row])
+	return nil
+}
+
+// finalize emits the build rows never matched by any probe pipeline of the join,
+// it returns false if there is nothing to emit or another pipeline is still probing.
+func (ctr *container) finalize(ap *Argument, proc *process.Process, anal process.Analyze) (bool, error) {
+	matched := ap.Matched.Merge(ctr.matched)
+	if matched == nil {
+		return false, nil
+	}
+	return ctr.emitUnmatched(matched, ap, proc, anal)
+}
+
+// emitUnmatched emits the build rows not in matched, it returns false if there
+// is nothing to emit.
+func (ctr *container) emitUnmatched(matched *bitmap.Bitmap, ap *Argument, proc *process.Process, anal process.Analyze) (bool, error) {
+	count := ctr.bat.Length()
+	sels := make([]int64, 0, count-matched.Count())
+	for i := 0; i < count; i++ {
+		if !matched.Contains(uint64(i)) {
+			sels = append(sels, int64(i))
+		}
+	}
+	if len(sels) == 0 {
+		return false, nil
+	}
+	rbat := batch.NewWithSize(len(ap.Result))
+	rbat.Zs = proc.GetMheap().GetSels()
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = vector.New(ap.LeftTypes[rp.Pos])
+		} else {
+			rbat.Vecs[i] = vector.New(ctr.bat.Vecs[rp.Pos].Typ)
+		}
+	}
+	for _, sel := range sels {
+		for j, rp := range ap.Result {
+			if rp.Rel == 0 {
+				if err := vector.UnionNull(rbat.Vecs[j], nil, proc.Mp()); err != nil {
+					rbat.Clean(proc.Mp())
+					return false, err
+				}
+			} else {
+				if err := vector.UnionOne(rbat.Vecs[j], ctr.bat.Vecs[rp.Pos], sel, proc.Mp()); err != nil {
+					rbat.Clean(proc.Mp())
+					return false, err
+				}
+			}
+		}
+		rbat.Zs = append(rbat.Zs, ctr.bat.Zs[sel])
+	}
+	rbat.ExpandNulls()
+	anal.Output(rbat)
+	proc.SetInputBatch(rbat)
+	return true, nil
+}
+
+func (ctr *container) free(proc *process.Process) {
+	if ctr.mp != nil {
+		ctr.mp.Free()
+		ctr.mp = nil
+	}
+	if ctr.bat != nil {
+		ctr.bat.Clean(proc.GetMheap())
+		ctr.bat = nil
+	}
+}
+
+func (ctr *container) evalJoinCondition(bat *batch.Batch, conds []*plan.Expr, proc *process.Process) error {
+	for i, cond := range conds {
+		vec, err := colexec.EvalExpr(bat, proc, cond)
+		if err != nil || vec.ConstExpand(proc.GetMheap()) == nil {
+			for j := 0; j < i; j++ {
+				if ctr.evecs[j].needFree {
+					vector.Clean(ctr.evecs[j].vec, proc.GetMheap())
+				}
+			}
+			return err
+		}
+		ctr.vecs[i] = vec
+		ctr.evecs[i].vec = vec
+		ctr.evecs[i].needFree = true
+		for j := range bat.Vecs {
+			if bat.Vecs[j] == vec {
+				ctr.evecs[i].needFree = false
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (ctr *container) freeJoinCondition(proc *process.Process) {
+	for i := range ctr.evecs {
+		if ctr.evecs[i].needFree {
+			ctr.evecs[i].vec.Free(proc.GetMheap())
+		}
+	}
+}
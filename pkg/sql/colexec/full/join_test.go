@@ -0,0 +1,319 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package full
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows          = 10     // default rows
+	BenchmarkRows = 100000 // default rows for benchmark
+)
+
+// add unit tests for cases
+type joinTestCase struct {
+	arg    *Argument
+	flgs   []bool // flgs[i] == true: nullable
+	types  []types.Type
+	proc   *process.Process
+	cancel context.CancelFunc
+	barg   *hashbuild.Argument
+}
+
+var (
+	tcs []joinTestCase
+)
+
+func init() {
+	tcs = []joinTestCase{
+		newTestCase(testutil.NewMheap(), []bool{false}, []types.Type{{Oid: types.T_int8}}, []colexec.ResultPos{colexec.NewResultPos(1, 0)},
+			[][]*plan.Expr{
+				{
+					newExpr(0, types.Type{Oid: types.T_int8}),
+				},
+				{
+					newExpr(0, types.Type{Oid: types.T_int8}),
+				},
+			}),
+		newTestCase(testutil.NewMheap(), []bool{true}, []types.Type{{Oid: types.T_int8}}, []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)},
+			[][]*plan.Expr{
+				{
+					newExpr(0, types.Type{Oid: types.T_int8}),
+				},
+				{
+					newExpr(0, types.Type{Oid: types.T_int8}),
+				},
+			}),
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(tc.arg, buf)
+	}
+}
+
+func TestJoin(t *testing.T) {
+	for _, tc := range tcs {
+		bat := hashBuild(t, tc)
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- &batch.Batch{}
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- bat
+		for {
+			if ok, err := Call(0, tc.proc, tc.arg); ok || err != nil {
+				break
+			}
+			tc.proc.Reg.InputBatch.Clean(tc.proc.Mp())
+		}
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp()))
+	}
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- &batch.Batch{}
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+		for {
+			if ok, err := Call(0, tc.proc, tc.arg); ok || err != nil {
+				break
+			}
+			tc.proc.Reg.InputBatch.Clean(tc.proc.Mp())
+		}
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp()))
+	}
+
+}
+
+func TestParallelJoin(t *testing.T) {
+	rp := []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)}
+	tc := newTestCase(testutil.NewMheap(), []bool{false}, []types.Type{{Oid: types.T_int8}}, rp,
+		[][]*plan.Expr{
+			{
+				newExpr(0, types.Type{Oid: types.T_int8}),
+			},
+			{
+				newExpr(0, types.Type{Oid: types.T_int8}),
+			},
+		})
+	// the build batch is dispatched to two probe pipelines sharing the matched rows
+	bat := hashBuild(t, tc)
+	atomic.AddInt64(&bat.Cnt, 1)
+	bat.Ht.(*hashmap.JoinMap).IncRef(1)
+	matched := colexec.NewMatchedRows()
+	tcs := []joinTestCase{tc, newTestCase(tc.proc.Mp(), tc.flgs, tc.types, rp, tc.arg.Conditions)}
+	for i := range tcs {
+		tcs[i].arg.Matched = matched.Share()
+		err := Prepare(tcs[i].proc, tcs[i].arg)
+		require.NoError(t, err)
+		tcs[i].proc.Reg.MergeReceivers[1].Ch <- bat
+	}
+	// the build rows are 0 to 9, the pipelines match 0 to 4 and 5 to 7, the probe row 20 matches nothing
+	tcs[0].proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, 5)
+	tcs[0].proc.Reg.MergeReceivers[0].Ch <- nil
+	probe := batch.NewWithSize(1)
+	probe.Vecs[0] = testutil.NewInt8Vector(4, types.Type{Oid: types.T_int8}, tc.proc.Mp(), false, []int8{5, 6, 7, 20})
+	probe.InitZsOne(4)
+	tcs[1].proc.Reg.MergeReceivers[0].Ch <- probe
+	tcs[1].proc.Reg.MergeReceivers[0].Ch <- nil
+	var rows []int
+	for i := range tcs {
+		cnt := 0
+		for {
+			if ok, err := Call(0, tcs[i].proc, tcs[i].arg); ok || err != nil {
+				require.NoError(t, err)
+				break
+			}
+			cnt += tcs[i].proc.Reg.InputBatch.Length()
+			tcs[i].proc.Reg.InputBatch.Clean(tcs[i].proc.Mp())
+		}
+		rows = append(rows, cnt)
+	}
+	// the unmatched build rows 8 and 9 are emitted once, by the last pipeline
+	require.Equal(t, []int{5, 6}, rows)
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp()))
+}
+
+func BenchmarkJoin(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		tcs = []joinTestCase{
+			newTestCase(testutil.NewMheap(), []bool{false}, []types.Type{{Oid: types.T_int8}}, []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)},
+				[][]*plan.Expr{
+					{
+						newExpr(0, types.Type{Oid: types.T_int8}),
+					},
+					{
+						newExpr(0, types.Type{Oid: types.T_int8}),
+					},
+				}),
+			newTestCase(testutil.NewMheap(), []bool{true}, []types.Type{{Oid: types.T_int8}}, []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)},
+				[][]*plan.Expr{
+					{
+						newExpr(0, types.Type{Oid: types.T_int8}),
+					},
+					{
+						newExpr(0, types.Type{Oid: types.T_int8}),
+					},
+				}),
+		}
+		t := new(testing.T)
+		for _, tc := range tcs {
+			bat := hashBuild(t, tc)
+			err := Prepare(tc.proc, tc.arg)
+			require.NoError(t, err)
+			tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+			tc.proc.Reg.MergeReceivers[0].Ch <- &batch.Batch{}
+			tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+			tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+			tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+			tc.proc.Reg.MergeReceivers[0].Ch <- nil
+			tc.proc.Reg.MergeReceivers[1].Ch <- bat
+			for {
+				if ok, err := Call(0, tc.proc, tc.arg); ok || err != nil {
+					break
+				}
+				tc.proc.Reg.InputBatch.Clean(tc.proc.Mp())
+			}
+		}
+	}
+}
+
+func newExpr(pos int32, typ types.Type) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{
+			Size:  typ.Size,
+			Scale: typ.Scale,
+			Width: typ.Width,
+			Id:    int32(typ.Oid),
+		},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: pos,
+			},
+		},
+	}
+}
+
+func newTestCase(m *mheap.Mheap, flgs []bool, ts []types.Type, rp []colexec.ResultPos, cs [][]*plan.Expr) joinTestCase {
+	proc := testutil.NewProcessWithMheap(m)
+	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 10),
+	}
+	proc.Reg.MergeReceivers[1] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 3),
+	}
+	fid := function.EncodeOverloadID(function.EQUAL, 4)
+	args := make([]*plan.Expr, 0, 2)
+	args = append(args, &plan.Expr{
+		Typ: &plan.Type{
+			Size: ts[0].Size,
+			Id:   int32(ts[0].Oid),
+		},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: 0,
+				ColPos: 0,
+			},
+		},
+	})
+	args = append(args, &plan.Expr{
+		Typ: &plan.Type{
+			Size: ts[0].Size,
+			Id:   int32(ts[0].Oid),
+		},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: 1,
+				ColPos: 0,
+			},
+		},
+	})
+	cond := &plan.Expr{
+		Typ: &plan.Type{
+			Size: 1,
+			Id:   int32(types.T_bool),
+		},
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Args: args,
+				Func: &plan.ObjectRef{Obj: fid},
+			},
+		},
+	}
+	return joinTestCase{
+		types:  ts,
+		flgs:   flgs,
+		proc:   proc,
+		cancel: cancel,
+		arg: &Argument{
+			Typs:       ts,
+			LeftTypes:  ts,
+			Result:     rp,
+			Conditions: cs,
+			Cond:       cond,
+		},
+		barg: &hashbuild.Argument{
+			Typs:        ts,
+			NeedHashMap: true,
+			Conditions:  cs[1],
+		},
+	}
+}
+
+func hashBuild(t *testing.T, tc joinTestCase) *batch.Batch {
+	err := hashbuild.Prepare(tc.proc, tc.barg)
+	require.NoError(t, err)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	ok, err := hashbuild.Call(0, tc.proc, tc.barg)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	return tc.proc.Reg.InputBatch
+}
+
+// create a new block based on the type information, flgs[i] == ture: has null
+func newBatch(t *testing.T, flgs []bool, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
+	return testutil.NewBatch(ts, false, int(rows), proc.Mp())
+}
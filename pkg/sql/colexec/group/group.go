@@ -50,6 +50,7 @@ func Prepare(_ *process.Process, arg any) error {
 	ap.ctr = new(container)
 	ap.ctr.inserted = make([]uint8, hashmap.UnitLimit)
 	ap.ctr.zInserted = make([]uint8, hashmap.UnitLimit)
+	ap.ctr.inBuckets = make([]uint8, hashmap.UnitLimit)
 	return nil
 }
 
@@ -135,32 +136,21 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 
 	bat := proc.InputBatch()
 	if bat == nil {
-		if ctr.bat != nil {
-			if ap.NeedEval {
-				for i, agg := range ctr.bat.Aggs {
-					vec, err := agg.Eval(proc.GetMheap())
-					if err != nil {
-						ctr.clean()
-						ctr.bat.Clean(proc.GetMheap())
-						return false, err
-					}
-					ctr.bat.Aggs[i] = nil
-					ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
-				}
-				ctr.bat.Aggs = nil
-				for i := range ctr.bat.Zs { // reset zs
-					ctr.bat.Zs[i] = 1
-				}
+		for {
+			if ctr.bat != nil {
+				return ctr.output(ap, proc, anal)
+			}
+			if len(ctr.parts) == 0 {
+				ctr.clean()
+				proc.SetInputBatch(nil)
+				return true, nil
+			}
+			if err := ctr.processSpilled(ap, proc, anal); err != nil {
+				ctr.clean()
+				ctr.cleanBatch(proc)
+				return false, err
 			}
-			ctr.clean()
-			ctr.bat.ExpandNulls()
-			anal.Output(ctr.bat)
-			proc.SetInputBatch(ctr.bat)
-			ctr.bat = nil
-			return true, nil
 		}
-		proc.SetInputBatch(nil)
-		return true, nil
 	}
 	if bat.Length() == 0 {
 		return false, nil
@@ -254,8 +244,13 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 			}
 		}
 	}
-	switch ctr.typ {
-	case H8:
+	if ctr.spilled == nil && ctr.level <= colexec.MaxSpillLevel && colexec.NeedSpill(proc) {
+		ctr.spilled = colexec.NewSpilledPartitions(proc, "group", ctr.level)
+	}
+	switch {
+	case ctr.spilled != nil:
+		err = ctr.processSpill(bat, proc)
+	case ctr.typ == H8:
 		err = ctr.processH8(bat, proc)
 	default:
 		err = ctr.processHStr(bat, proc)
@@ -268,6 +263,114 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 	return false, err
 }
 
+// processSpill aggregates the rows of the groups in memory, and spills the rows of
+// the other groups by partition, so the groups in memory do not grow any more.
+func (ctr *container) processSpill(bat *batch.Batch, proc *process.Process) error {
+	var mp hashmap.HashMap
+	var itr hashmap.Iterator
+
+	if ctr.typ == H8 {
+		mp, itr = ctr.intHashMap, ctr.intHashMap.NewIterator()
+	} else {
+		mp, itr = ctr.strHashMap, ctr.strHashMap.NewIterator()
+	}
+	var sels []int64
+	count := bat.Length()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		copy(ctr.inBuckets, hashmap.OneUInt8s)
+		vals, _ := itr.Find(i, n, ctr.vecs, ctr.inBuckets)
+		for k, v := range vals[:n] {
+			if v == 0 {
+				sels = append(sels, int64(i+k))
+			}
+		}
+		if err := ctr.batchFill(i, n, bat, vals, mp.GroupCount(), proc); err != nil {
+			return err
+		}
+	}
+	if len(sels) == 0 {
+		return nil
+	}
+	return ctr.spilled.Spill(proc, bat, ctr.vecs, sels)
+}
+
+// output returns the groups aggregated, the groups of the spilled partitions are
+// returned later one partition at a time, so it returns false if any is left.
+func (ctr *container) output(ap *Argument, proc *process.Process, anal process.Analyze) (bool, error) {
+	if ctr.spilled != nil {
+		ctr.parts = append(ctr.parts, spilledPartitions(ctr.spilled)...)
+		ctr.spilled = nil
+	}
+	if ap.NeedEval {
+		for i, agg := range ctr.bat.Aggs {
+			vec, err := agg.Eval(proc.GetMheap())
+			if err != nil {
+				ctr.clean()
+				ctr.cleanBatch(proc)
+				return false, err
+			}
+			ctr.bat.Aggs[i] = nil
+			ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
+		}
+		ctr.bat.Aggs = nil
+		for i := range ctr.bat.Zs { // reset zs
+			ctr.bat.Zs[i] = 1
+		}
+	}
+	ctr.cleanHashMap()
+	ctr.bat.ExpandNulls()
+	anal.Output(ctr.bat)
+	proc.SetInputBatch(ctr.bat)
+	ctr.bat = nil
+	if len(ctr.parts) > 0 {
+		return false, nil
+	}
+	ctr.clean()
+	return true, nil
+}
+
+// processSpilled aggregates the spilled rows of the next partition with a hash map
+// of their own, the rows of the groups which are not held in memory are spilled
+// again to the partitions of the next level.
+func (ctr *container) processSpilled(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	part := ctr.parts[0]
+	ctr.parts = ctr.parts[1:]
+	if len(ctr.parts) == 0 || ctr.parts[0].sp != part.sp {
+		// it is the last partition of sp
+		defer part.sp.Free()
+	}
+	ctr.level = part.sp.Level() + 1
+	for _, blk := range part.sp.Blocks(part.part) {
+		bat, err := part.sp.Read(proc, blk)
+		if err != nil {
+			return err
+		}
+		proc.SetInputBatch(bat)
+		if _, err := ctr.processWithGroup(ap, proc, anal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// spilledPartitions returns the partitions with rows of sp.
+func spilledPartitions(sp *colexec.SpilledPartitions) []spilledPartition {
+	parts := make([]spilledPartition, 0, colexec.SpillPartitions)
+	for i := 0; i < colexec.SpillPartitions; i++ {
+		if len(sp.Blocks(i)) > 0 {
+			parts = append(parts, spilledPartition{sp: sp, part: i})
+		}
+	}
+	if len(parts) == 0 {
+		sp.Free()
+	}
+	return parts
+}
+
 func (ctr *container) processH0(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	for _, z := range bat.Zs {
 		ctr.bat.Zs[0] += z
@@ -391,7 +494,20 @@ func (ctr *container) freeAggVector(proc *process.Process) {
 	}
 }
 
+// clean frees the hash map, and removes all the rows spilled.
 func (ctr *container) clean() {
+	ctr.cleanHashMap()
+	if ctr.spilled != nil {
+		ctr.spilled.Free()
+		ctr.spilled = nil
+	}
+	for _, part := range ctr.parts {
+		part.sp.Free()
+	}
+	ctr.parts = nil
+}
+
+func (ctr *container) cleanHashMap() {
 	if ctr.intHashMap != nil {
 		ctr.intHashMap.Free()
 		ctr.intHashMap = nil
@@ -16,11 +16,13 @@ package group
 
 import (
 	"bytes"
+	"context"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -123,6 +125,66 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupSpill(t *testing.T) {
+	proc := testutil.NewProcess()
+	arg := &Argument{
+		NeedEval: true,
+		Exprs:    []*plan.Expr{newExpression(0)},
+		Aggs:     []agg.Aggregate{{Op: agg.AggregateSum, E: newExpression(1)}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+	keys := make([]int64, 2000)
+	for i := range keys {
+		keys[i] = int64(i % 1000)
+	}
+	proc.Reg.InputBatch = newGroupBatch(proc, keys)
+	_, err := Call(0, proc, arg)
+	require.NoError(t, err)
+	require.Nil(t, arg.ctr.spilled)
+
+	// the query is short of memory, so the rows of the new groups are spilled
+	ballast := proc.Mp().Gm.Limit * 8 / 10
+	require.NoError(t, proc.Mp().Increase(ballast))
+	for i := range keys {
+		keys[i] = int64(i)
+	}
+	proc.Reg.InputBatch = newGroupBatch(proc, keys)
+	_, err = Call(0, proc, arg)
+	require.NoError(t, err)
+	require.NotNil(t, arg.ctr.spilled)
+	proc.Mp().Decrease(ballast)
+
+	// the groups in memory and those of every spilled partition are returned
+	// one batch at a time
+	rows, bats := 0, 0
+	for end := false; !end; {
+		proc.Reg.InputBatch = nil
+		end, err = Call(0, proc, arg)
+		require.NoError(t, err)
+		bat := proc.InputBatch()
+		if bat == nil {
+			continue
+		}
+		ks, sums := vector.MustTCols[int64](bat.Vecs[0]), vector.MustTCols[int64](bat.Vecs[1])
+		for i, k := range ks {
+			if k < 1000 {
+				require.Equal(t, int64(3), sums[i])
+			} else {
+				require.Equal(t, int64(1), sums[i])
+			}
+		}
+		rows += bat.Length()
+		bats++
+		bat.Clean(proc.Mp())
+	}
+	require.Equal(t, 2000, rows)
+	require.Greater(t, bats, 1)
+	entries, err := proc.FileService.List(context.Background(), "LOCAL:spill")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+	require.Equal(t, int64(0), mheap.Size(proc.Mp()))
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -181,3 +243,15 @@ func newExpression(pos int32) *plan.Expr {
 func newBatch(t *testing.T, flgs []bool, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
 	return testutil.NewBatch(ts, false, int(rows), proc.Mp())
 }
+
+// newGroupBatch returns the batch of the keys, and 1 as the value of every row.
+func newGroupBatch(proc *process.Process, keys []int64) *batch.Batch {
+	vals := make([]int64, len(keys))
+	for i := range vals {
+		vals[i] = 1
+	}
+	return testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewVector(len(keys), types.T_int64.ToType(), proc.Mp(), false, keys),
+		testutil.NewVector(len(vals), types.T_int64.ToType(), proc.Mp(), false, vals),
+	}, nil)
+}
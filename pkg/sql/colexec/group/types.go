@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 )
 
@@ -47,6 +48,20 @@ type container struct {
 	vecs []*vector.Vector
 
 	bat *batch.Batch
+
+	// spilled has the rows of the groups not in memory once the memory is
+	// short, they are aggregated partition by partition at the end, parts are
+	// the partitions left and level is the level of the partitions to spill to
+	spilled   *colexec.SpilledPartitions
+	parts     []spilledPartition
+	level     int
+	inBuckets []uint8
+}
+
+// spilledPartition is a partition of the rows spilled to sp.
+type spilledPartition struct {
+	sp   *colexec.SpilledPartitions
+	part int
 }
 
 type Argument struct {
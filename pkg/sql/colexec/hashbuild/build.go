@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
@@ -41,11 +42,7 @@ func Prepare(proc *process.Process, arg any) error {
 		ap.ctr.vecs = make([]*vector.Vector, len(ap.Conditions))
 		ap.ctr.evecs = make([]evalVector, len(ap.Conditions))
 	}
-	ap.ctr.bat = batch.NewWithSize(len(ap.Typs))
-	ap.ctr.bat.Zs = proc.GetMheap().GetSels()
-	for i, typ := range ap.Typs {
-		ap.ctr.bat.Vecs[i] = vector.New(typ)
-	}
+	ap.ctr.bat = newBuildBatch(ap, proc)
 	return nil
 }
 
@@ -61,13 +58,20 @@ func Call(idx int, proc *process.Process, arg any) (bool, error) {
 			if err := ctr.build(ap, proc, anal); err != nil {
 				ctr.state = End
 				ctr.mp.Free()
+				if ctr.spilled != nil {
+					ctr.spilled.Free()
+				}
 				return true, err
 			}
 			ctr.state = End
 		default:
 			if ctr.bat != nil {
 				if ap.NeedHashMap {
-					ctr.bat.Ht = hashmap.NewJoinMap(ctr.sels, nil, ctr.mp, ctr.hasNull)
+					jm := hashmap.NewJoinMap(ctr.sels, nil, ctr.mp, ctr.hasNull)
+					if ctr.spilled != nil {
+						jm.SetSpilled(ctr.spilled)
+					}
+					ctr.bat.Ht = jm
 				}
 				proc.SetInputBatch(ctr.bat)
 				ctr.bat = nil
@@ -92,12 +96,30 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 		}
 		anal.Input(bat)
 		anal.Alloc(int64(bat.Size()))
+		if ctr.spilled != nil {
+			err = ctr.spill(bat, ap, proc)
+			bat.Clean(proc.GetMheap())
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		if ctr.bat, err = ctr.bat.Append(proc.GetMheap(), bat); err != nil {
 			bat.Clean(proc.GetMheap())
 			ctr.bat.Clean(proc.GetMheap())
 			return err
 		}
 		bat.Clean(proc.GetMheap())
+		if ap.CanSpill && colexec.NeedSpill(proc) {
+			// the rows built so far are spilled, and so are the rows received later
+			if ctr.spilled = colexec.NewSpilledPartitions(proc, "join", 0); ctr.spilled != nil {
+				if err = ctr.spill(ctr.bat, ap, proc); err != nil {
+					return err
+				}
+				ctr.bat.Clean(proc.GetMheap())
+				ctr.bat = newBuildBatch(ap, proc)
+			}
+		}
 	}
 	if ctr.bat == nil || ctr.bat.Length() == 0 || !ap.NeedHashMap {
 		return nil
@@ -137,6 +159,29 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 	return nil
 }
 
+// spill spills the rows of the batch to the partitions of their join keys.
+func (ctr *container) spill(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	if err := ctr.evalJoinCondition(bat, ap.Conditions, proc); err != nil {
+		return err
+	}
+	defer ctr.freeJoinCondition(proc)
+	for _, vec := range ctr.vecs {
+		if nulls.Any(vec.Nsp) {
+			ctr.hasNull = true
+		}
+	}
+	return ctr.spilled.Spill(proc, bat, ctr.vecs, nil)
+}
+
+func newBuildBatch(ap *Argument, proc *process.Process) *batch.Batch {
+	bat := batch.NewWithSize(len(ap.Typs))
+	bat.Zs = proc.GetMheap().GetSels()
+	for i, typ := range ap.Typs {
+		bat.Vecs[i] = vector.New(typ)
+	}
+	return bat
+}
+
 func (ctr *container) evalJoinCondition(bat *batch.Batch, conds []*plan.Expr, proc *process.Process) error {
 	for i, cond := range conds {
 		vec, err := colexec.EvalExpr(bat, proc, cond)
@@ -19,6 +19,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 )
 
@@ -45,6 +46,10 @@ type container struct {
 	vecs  []*vector.Vector
 
 	mp *hashmap.StrHashMap
+
+	// spilled has the build rows once the memory is short, all of them are
+	// spilled by partition and joined partition by partition by the probe
+	spilled *colexec.SpilledPartitions
 }
 
 type Argument struct {
@@ -52,8 +57,10 @@ type Argument struct {
 	// need to generate a push-down filter expression
 	NeedExpr    bool
 	NeedHashMap bool
-	Ibucket     uint64
-	Nbucket     uint64
-	Typs        []types.Type
-	Conditions  []*plan.Expr
+	// CanSpill is true if the join probing the map handles the build rows spilled to disk
+	CanSpill   bool
+	Ibucket    uint64
+	Nbucket    uint64
+	Typs       []types.Type
+	Conditions []*plan.Expr
 }
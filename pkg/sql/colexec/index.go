@@ -0,0 +1,898 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/builtin/multi"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+/*
+	A secondary index is kept in a hidden table of the same database, so its entries are
+	written in the transaction which changes the table and replayed with the table.
+
+	%!%mo_index_<table>%!%<index> schema
+	| Attribute        |  Type   | Primary Key |                  Note                    |
+	| ---------------- | ------- | ----------- | ---------------------------------------- |
+	|  __mo_index_key  | varchar |     yes     | serial(cols) or serial(cols, pk)         |
+	|  <index cols>    |   ...   |             | the values of the index columns          |
+	|  <pk>            |   ...   |             | omitted if it is one of the index columns |
+	|                  |         |             | or the table has no primary key          |
+
+	The key of a unique index is made of the index columns only, so a duplicate value is
+	rejected by the primary key of the hidden table. A row with a null index column has no entry.
+*/
+
+var INDEX_TABLE_PREFIX = "%!%mo_index_"
+var INDEX_KEY_COLNAME = "__mo_index_key"
+
+// indexMeta is saved as the comment of the index table.
+type indexMeta struct {
+	Name   string   `json:"name"`
+	Unique bool     `json:"unique"`
+	Cols   []string `json:"cols"`
+}
+
+// GetIndexTableName returns the name of the hidden table of an index.
+func GetIndexTableName(tblName, idxName string) string {
+	return INDEX_TABLE_PREFIX + tblName + "%!%" + idxName
+}
+
+func IsIndexTable(name string) bool {
+	return strings.HasPrefix(name, INDEX_TABLE_PREFIX)
+}
+
+// IsIndexableType reports whether a column of the type can be a column of a secondary index.
+func IsIndexableType(oid types.T) bool {
+	switch oid {
+	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_time, types.T_datetime, types.T_timestamp,
+		types.T_decimal64, types.T_decimal128, types.T_char, types.T_varchar:
+		return true
+	}
+	return false
+}
+
+// GetIndexDefs returns the secondary indexes of a table ordered by name.
+func GetIndexDefs(ctx context.Context, db engine.Database, tblName string) ([]*plan.IndexDef, error) {
+	names, err := db.Relations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefix := GetIndexTableName(tblName, "")
+	var defs []*plan.IndexDef
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		rel, err := db.Relation(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		tblDefs, err := rel.TableDefs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, def := range tblDefs {
+			c, ok := def.(*engine.CommentDef)
+			if !ok {
+				continue
+			}
+			var meta indexMeta
+			if err = json.Unmarshal([]byte(c.Comment), &meta); err != nil {
+				return nil, err
+			}
+			defs = append(defs, &plan.IndexDef{
+				Typ:            plan.IndexDef_SECONDARY,
+				Name:           meta.Name,
+				ColNames:       meta.Cols,
+				Unique:         meta.Unique,
+				IndexTableName: name,
+			})
+		}
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+	return defs, nil
+}
+
+// CreateIndex creates the hidden table of a secondary index and fills it with the entries of the existing rows.
+func CreateIndex(ctx context.Context, proc *process.Process, db engine.Database, tblName string, def *plan.IndexDef) error {
+	rel, err := db.Relation(ctx, tblName)
+	if err != nil {
+		return err
+	}
+	pk, err := getIndexPrimaryKey(ctx, rel)
+	if err != nil {
+		return err
+	}
+	if pk == "" && !def.Unique {
+		return errors.New("", "secondary index requires a table with a single column primary key")
+	}
+	tblDefs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return err
+	}
+	attrs := make(map[string]engine.Attribute)
+	for _, d := range tblDefs {
+		if a, ok := d.(*engine.AttributeDef); ok {
+			attrs[a.Attr.Name] = a.Attr
+		}
+	}
+	cols := getIndexTableCols(def, pk)
+	idxDefs := make([]engine.TableDef, 0, len(cols)+3)
+	idxDefs = append(idxDefs, &engine.AttributeDef{Attr: engine.Attribute{
+		Name:    INDEX_KEY_COLNAME,
+		Type:    types.T_varchar.ToType(),
+		Default: &plan.Default{},
+		Primary: true,
+	}})
+	for _, name := range cols {
+		attr, ok := attrs[name]
+		if !ok {
+			return errors.New("", fmt.Sprintf("column '%s' does not exist", name))
+		}
+		idxDefs = append(idxDefs, &engine.AttributeDef{Attr: engine.Attribute{
+			Name:    name,
+			Type:    attr.Type,
+			Default: &plan.Default{},
+		}})
+	}
+	meta, err := json.Marshal(&indexMeta{Name: def.Name, Unique: def.Unique, Cols: def.ColNames})
+	if err != nil {
+		return err
+	}
+	idxDefs = append(idxDefs, &engine.PrimaryIndexDef{Names: []string{INDEX_KEY_COLNAME}})
+	idxDefs = append(idxDefs, &engine.PropertiesDef{Properties: []engine.Property{
+		{Key: catalog.SystemRelAttr_Comment, Value: string(meta)},
+	}})
+	name := GetIndexTableName(tblName, def.Name)
+	if err = db.Create(ctx, name, idxDefs); err != nil {
+		return err
+	}
+	idxRel, err := db.Relation(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	// fill the index with the rows already in the table
+	ranges, err := rel.Ranges(ctx)
+	if err != nil {
+		return err
+	}
+	rds, err := rel.NewReader(ctx, 1, nil, ranges)
+	if err != nil {
+		return err
+	}
+	defer rds[0].Close()
+	for {
+		bat, err := rds[0].Read(cols, nil, proc.Mp())
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			return nil
+		}
+		bat.Attrs = cols
+		err = writeIndexEntries(ctx, proc, idxRel, def, pk, bat)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// DropIndex drops the hidden table of a secondary index.
+func DropIndex(ctx context.Context, db engine.Database, tblName, idxName string) error {
+	return db.Delete(ctx, GetIndexTableName(tblName, idxName))
+}
+
+// RenameIndexes renames the index tables of tblName to follow the new name of the table.
+func RenameIndexes(ctx context.Context, db engine.Database, tblName, newName string) error {
+	defs, err := GetIndexDefs(ctx, db, tblName)
+	if err != nil {
+		return err
+	}
+	for _, def := range defs {
+		rel, err := db.Relation(ctx, def.IndexTableName)
+		if err != nil {
+			return err
+		}
+		if err = rel.AddTableDef(ctx, &engine.RenameTableDef{Name: GetIndexTableName(newName, def.Name)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DropIndexes drops the hidden tables of all secondary indexes of a table.
+func DropIndexes(ctx context.Context, db engine.Database, tblName string) error {
+	defs, err := GetIndexDefs(ctx, db, tblName)
+	if err != nil {
+		return err
+	}
+	for _, def := range defs {
+		if err = db.Delete(ctx, def.IndexTableName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TableIndexes maintains the secondary indexes of a table while its rows are written and deleted.
+type TableIndexes struct {
+	pk   string
+	defs []*plan.IndexDef
+	rels []engine.Relation
+}
+
+// NewTableIndexes returns nil if the table has no secondary index.
+func NewTableIndexes(ctx context.Context, db engine.Database, tblName string, rel engine.Relation) (*TableIndexes, error) {
+	defs, err := GetIndexDefs(ctx, db, tblName)
+	if err != nil || len(defs) == 0 {
+		return nil, err
+	}
+	pk, err := getIndexPrimaryKey(ctx, rel)
+	if err != nil {
+		return nil, err
+	}
+	idx := &TableIndexes{
+		pk:   pk,
+		defs: defs,
+		rels: make([]engine.Relation, len(defs)),
+	}
+	for i, def := range defs {
+		if idx.rels[i], err = db.Relation(ctx, def.IndexTableName); err != nil {
+			return nil, err
+		}
+	}
+	return idx, nil
+}
+
+// Write adds the entries of the rows in bat, whose attrs are the names of the table columns.
+func (idx *TableIndexes) Write(ctx context.Context, proc *process.Process, bat *batch.Batch) error {
+	if idx == nil {
+		return nil
+	}
+	for i, def := range idx.defs {
+		if err := writeIndexEntries(ctx, proc, idx.rels[i], def, idx.pk, bat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete removes the entries of the rows in bat, which holds the primary key and the index columns.
+func (idx *TableIndexes) Delete(ctx context.Context, proc *process.Process, bat *batch.Batch) error {
+	if idx == nil {
+		return nil
+	}
+	for i, def := range idx.defs {
+		if err := deleteIndexEntries(ctx, proc, idx.rels[i], def, idx.pk, bat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Update replaces the entries of the updated rows, only the indexes depending on attrs are changed.
+// oldBat holds the old values of the primary key and the index columns, newBat holds the new rows.
+func (idx *TableIndexes) Update(ctx context.Context, proc *process.Process, attrs []string, oldBat, newBat *batch.Batch) error {
+	if idx == nil {
+		return nil
+	}
+	for i, def := range idx.defs {
+		if !IsIndexAffected(def, idx.pk, attrs) {
+			continue
+		}
+		if err := deleteIndexEntries(ctx, proc, idx.rels[i], def, idx.pk, oldBat); err != nil {
+			return err
+		}
+		if err := writeIndexEntries(ctx, proc, idx.rels[i], def, idx.pk, newBat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Truncate removes all entries of the indexes.
+func (idx *TableIndexes) Truncate(ctx context.Context) error {
+	if idx == nil {
+		return nil
+	}
+	for _, rel := range idx.rels {
+		if _, err := rel.Truncate(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsIndexAffected reports whether the entries of an index change when the columns attrs are updated.
+func IsIndexAffected(def *plan.IndexDef, pk string, attrs []string) bool {
+	for _, attr := range attrs {
+		if attr == pk {
+			return true
+		}
+		for _, name := range def.ColNames {
+			if attr == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func getIndexPrimaryKey(ctx context.Context, rel engine.Relation) (string, error) {
+	pks, err := rel.GetPrimaryKeys(ctx)
+	if err != nil {
+		return "", err
+	}
+	switch len(pks) {
+	case 0:
+		// only unique indexes, whose entries are found by the index columns
+		return "", nil
+	case 1:
+		return pks[0].Name, nil
+	default:
+		return "", errors.New("", "secondary index requires a table with a single column primary key")
+	}
+}
+
+// getIndexTableCols returns the columns of the index table except the key.
+func getIndexTableCols(def *plan.IndexDef, pk string) []string {
+	cols := append([]string{}, def.ColNames...)
+	if pk == "" {
+		return cols
+	}
+	for _, name := range def.ColNames {
+		if name == pk {
+			return cols
+		}
+	}
+	return append(cols, pk)
+}
+
+func writeIndexEntries(ctx context.Context, proc *process.Process, rel engine.Relation, def *plan.IndexDef, pk string, bat *batch.Batch) error {
+	entries, err := makeIndexEntries(proc, def, pk, bat)
+	if err != nil || entries == nil {
+		return err
+	}
+	defer entries.Clean(proc.Mp())
+	return rel.Write(ctx, entries)
+}
+
+func deleteIndexEntries(ctx context.Context, proc *process.Process, rel engine.Relation, def *plan.IndexDef, pk string, bat *batch.Batch) error {
+	entries, err := makeIndexEntries(proc, def, pk, bat)
+	if err != nil || entries == nil {
+		return err
+	}
+	defer entries.Clean(proc.Mp())
+
+	// a row may be deleted more than once by a join
+	key := entries.Vecs[0]
+	keys := make([]string, 0, vector.Length(key))
+	seen := make(map[string]struct{}, vector.Length(key))
+	for i := 0; i < vector.Length(key); i++ {
+		k := key.GetString(int64(i))
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		keys = append(keys, k)
+	}
+	vec := vector.NewWithStrings(key.Typ, keys, nil, proc.Mp())
+	defer vec.Free(proc.Mp())
+	return rel.Delete(ctx, vec, INDEX_KEY_COLNAME)
+}
+
+// makeIndexEntries returns the rows of the index table for the rows in bat, or nil if there is none.
+func makeIndexEntries(proc *process.Process, def *plan.IndexDef, pk string, bat *batch.Batch) (*batch.Batch, error) {
+	cols := getIndexTableCols(def, pk)
+	srcs := make([]*vector.Vector, len(cols))
+	for i, name := range cols {
+		j := -1
+		for k, attr := range bat.Attrs {
+			if attr == name {
+				j = k
+				break
+			}
+		}
+		if j == -1 {
+			return nil, errors.New("", fmt.Sprintf("internal error: column '%s' of index '%s' not found", name, def.Name))
+		}
+		if bat.Vecs[j].IsScalarNull() {
+			return nil, nil
+		}
+		bat.Vecs[j] = bat.Vecs[j].ConstExpand(proc.Mp())
+		srcs[i] = bat.Vecs[j]
+	}
+
+	// the rows with a null index column are not indexed
+	n := vector.Length(srcs[0])
+	sels := make([]int64, 0, n)
+	for i := 0; i < n; i++ {
+		isNull := false
+		for _, v := range srcs {
+			if nulls.Contains(v.Nsp, uint64(i)) {
+				isNull = true
+				break
+			}
+		}
+		if !isNull {
+			sels = append(sels, int64(i))
+		}
+	}
+	if len(sels) == 0 {
+		return nil, nil
+	}
+
+	entries := batch.NewWithSize(len(cols) + 1)
+	entries.Attrs = append([]string{INDEX_KEY_COLNAME}, cols...)
+	for i, src := range srcs {
+		vec := vector.New(src.Typ)
+		if err := vector.Union(vec, src, sels, proc.Mp()); err != nil {
+			entries.Clean(proc.Mp())
+			return nil, err
+		}
+		entries.Vecs[i+1] = vec
+	}
+	keyCols := entries.Vecs[1 : len(def.ColNames)+1]
+	if !def.Unique {
+		keyCols = entries.Vecs[1:]
+	}
+	key, err := multi.Serial(keyCols, proc)
+	if err != nil {
+		entries.Clean(proc.Mp())
+		return nil, err
+	}
+	entries.Vecs[0] = key
+	entries.InitZsOne(len(sels))
+	return entries, nil
+}
+
+// indexReadRows is the number of rows read from the table by an index reader at a time.
+const indexReadRows = 8192
+
+// indexReader reads the rows of a table found through a secondary index.
+type indexReader struct {
+	ctx    context.Context
+	proc   *process.Process
+	rel    engine.KeyReader
+	idxRel engine.Relation
+	scan   *plan.IndexScan
+	pk     string
+	// the primary keys of the rows found in the index, nil before the index is read
+	keys *vector.Vector
+	pos  int
+}
+
+// NewIndexReader returns a reader of the rows found through the index of scan,
+// or nil if the table can't be read by primary key.
+func NewIndexReader(ctx context.Context, proc *process.Process, db engine.Database, rel engine.Relation, scan *plan.IndexScan) (engine.Reader, error) {
+	kr, ok := rel.(engine.KeyReader)
+	if !ok {
+		return nil, nil
+	}
+	pk, err := getIndexPrimaryKey(ctx, rel)
+	if err != nil || pk == "" {
+		return nil, err
+	}
+	idxRel, err := db.Relation(ctx, scan.IndexDef.IndexTableName)
+	if err != nil {
+		return nil, err
+	}
+	return &indexReader{
+		ctx:    ctx,
+		proc:   proc,
+		rel:    kr,
+		idxRel: idxRel,
+		scan:   scan,
+		pk:     pk,
+	}, nil
+}
+
+func (r *indexReader) Close() error {
+	if r.keys != nil {
+		r.keys.Free(r.proc.Mp())
+		r.keys = nil
+	}
+	return nil
+}
+
+func (r *indexReader) Read(attrs []string, _ *plan.Expr, m *mheap.Mheap) (*batch.Batch, error) {
+	if r.keys == nil {
+		keys, err := r.readIndex()
+		if err != nil {
+			return nil, err
+		}
+		r.keys = keys
+	}
+	for r.pos < vector.Length(r.keys) {
+		n := vector.Length(r.keys) - r.pos
+		if n > indexReadRows {
+			n = indexReadRows
+		}
+		sels := make([]int64, n)
+		for i := range sels {
+			sels[i] = int64(r.pos + i)
+		}
+		r.pos += n
+		keys := vector.New(r.keys.Typ)
+		if err := vector.Union(keys, r.keys, sels, m); err != nil {
+			return nil, err
+		}
+		bat, err := r.rel.ReadByPrimaryKeys(r.ctx, attrs, keys, m)
+		keys.Free(m)
+		if err != nil {
+			return nil, err
+		}
+		if len(bat.Zs) > 0 {
+			return bat, nil
+		}
+		bat.Clean(m)
+	}
+	return nil, nil
+}
+
+// readIndex returns the primary keys of the rows found in the index.
+func (r *indexReader) readIndex() (*vector.Vector, error) {
+	if len(r.scan.Keys) > 0 {
+		return r.lookupIndex()
+	}
+	def := r.scan.IndexDef
+	cols := getIndexTableCols(def, r.pk)
+	pkIdx := len(cols) - 1
+	for i, name := range def.ColNames {
+		if name == r.pk {
+			pkIdx = i
+		}
+	}
+	// the key is read only for the reader to skip the blocks out of the range of the scan
+	seek, err := r.seekIndex(int32(len(cols)))
+	if err != nil {
+		return nil, err
+	}
+	if seek != nil {
+		cols = append(cols, INDEX_KEY_COLNAME)
+	}
+	ranges, err := r.idxRel.Ranges(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+	rds, err := r.idxRel.NewReader(r.ctx, 1, seek, ranges)
+	if err != nil {
+		return nil, err
+	}
+	defer rds[0].Close()
+	var keys *vector.Vector
+	for {
+		bat, err := rds[0].Read(cols, seek, r.proc.Mp())
+		if err != nil {
+			if keys != nil {
+				keys.Free(r.proc.Mp())
+			}
+			return nil, err
+		}
+		if bat == nil {
+			break
+		}
+		if keys == nil {
+			keys = vector.New(bat.Vecs[pkIdx].Typ)
+		}
+		sels, err := r.filterIndex(bat)
+		if err == nil && len(sels) > 0 {
+			err = vector.Union(keys, bat.Vecs[pkIdx], sels, r.proc.Mp())
+		}
+		bat.Clean(r.proc.Mp())
+		if err != nil {
+			keys.Free(r.proc.Mp())
+			return nil, err
+		}
+	}
+	if keys == nil {
+		return vector.New(types.T_any.ToType()), nil
+	}
+	return keys, nil
+}
+
+// seekIndex returns the range of the index keys satisfying the equalities on the leading index
+// columns and the range on the column next to them, as a filter on the key at position keyPos.
+// The key serializes the index columns in an order preserving encoding, so the keys with the
+// same leading values share a prefix. It returns nil if the leading column has no such filter.
+func (r *indexReader) seekIndex(keyPos int32) (*plan.Expr, error) {
+	var consts []*vector.Vector
+	defer func() {
+		for _, vec := range consts {
+			vec.Free(r.proc.Mp())
+		}
+	}()
+	serial := func(vecs ...*vector.Vector) ([]byte, error) {
+		key, err := multi.Serial(append(append([]*vector.Vector{}, consts...), vecs...), r.proc)
+		if err != nil {
+			return nil, err
+		}
+		defer key.Free(r.proc.Mp())
+		return []byte(key.GetString(0)), nil
+	}
+
+	var lo, hi []byte
+	for i := range r.scan.IndexDef.ColNames {
+		vec, err := r.getIndexConst(int32(i), "=")
+		if err != nil {
+			return nil, err
+		}
+		if vec != nil {
+			consts = append(consts, vec)
+			continue
+		}
+		for _, op := range []string{">", ">=", "<", "<="} {
+			if vec, err = r.getIndexConst(int32(i), op); err != nil {
+				return nil, err
+			}
+			if vec == nil {
+				continue
+			}
+			key, err := serial(vec)
+			vec.Free(r.proc.Mp())
+			if err != nil {
+				return nil, err
+			}
+			switch op {
+			case ">":
+				lo = nextPrefix(key)
+			case ">=":
+				lo = key
+			case "<":
+				hi = key
+			case "<=":
+				hi = nextPrefix(key)
+			}
+		}
+		break
+	}
+	if len(consts) > 0 {
+		prefix, err := serial()
+		if err != nil {
+			return nil, err
+		}
+		if lo == nil {
+			lo = prefix
+		}
+		if hi == nil {
+			hi = nextPrefix(prefix)
+		}
+	}
+
+	var filter *plan.Expr
+	var err error
+	if lo != nil {
+		if filter, err = makeKeyCompare(">=", keyPos, lo); err != nil {
+			return nil, err
+		}
+	}
+	if hi != nil {
+		cmp, err := makeKeyCompare("<", keyPos, hi)
+		if err != nil || filter == nil {
+			return cmp, err
+		}
+		return makeIndexFunction("and", filter, cmp)
+	}
+	return filter, nil
+}
+
+// getIndexConst returns the constant compared with the index column at position pos by op
+// in the filters of the scan, or nil if there is no such filter.
+func (r *indexReader) getIndexConst(pos int32, op string) (*vector.Vector, error) {
+	for _, expr := range r.scan.FilterList {
+		f, ok := expr.Expr.(*plan.Expr_F)
+		if !ok || len(f.F.Args) != 2 {
+			continue
+		}
+		name := f.F.Func.ObjName
+		col, c := f.F.Args[0], f.F.Args[1]
+		if _, ok := col.Expr.(*plan.Expr_C); ok {
+			col, c = c, col
+			name = reverseIndexCompare[name]
+		}
+		if name != op {
+			continue
+		}
+		ref, ok := col.Expr.(*plan.Expr_Col)
+		if !ok || ref.Col.ColPos != pos {
+			continue
+		}
+		val, ok := c.Expr.(*plan.Expr_C)
+		if !ok || val.C.Isnull || c.Typ.Id != col.Typ.Id || !isSeekableType(types.T(col.Typ.Id), op != "=") {
+			continue
+		}
+		bat := batch.NewWithSize(0)
+		bat.Zs = []int64{1}
+		vec, err := EvalExpr(bat, r.proc, c)
+		if err != nil {
+			return nil, err
+		}
+		return vec.ConstExpand(r.proc.Mp()), nil
+	}
+	return nil, nil
+}
+
+var reverseIndexCompare = map[string]string{
+	"=":  "=",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+// isSeekableType reports whether the values of the type are serialized into the index key
+// deterministically, and in their order if ordered is true.
+func isSeekableType(oid types.T, ordered bool) bool {
+	switch oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_datetime, types.T_timestamp,
+		types.T_char, types.T_varchar:
+		return true
+	case types.T_bool, types.T_decimal64, types.T_decimal128:
+		return !ordered
+	}
+	return false
+}
+
+// nextPrefix returns the least key greater than all keys with the prefix,
+// or nil if there is no such key.
+func nextPrefix(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] != 0xff {
+			next := append([]byte{}, prefix[:i+1]...)
+			next[i]++
+			return next
+		}
+	}
+	return nil
+}
+
+func makeKeyCompare(op string, keyPos int32, key []byte) (*plan.Expr, error) {
+	typ := &plan.Type{Id: int32(types.T_varchar)}
+	col := &plan.Expr{
+		Typ:  typ,
+		Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: keyPos, Name: INDEX_KEY_COLNAME}},
+	}
+	c := &plan.Expr{
+		Typ:  typ,
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Sval{Sval: string(key)}}},
+	}
+	return makeIndexFunction(op, col, c)
+}
+
+func makeIndexFunction(name string, args ...*plan.Expr) (*plan.Expr, error) {
+	typs := make([]types.Type, len(args))
+	for i, arg := range args {
+		typs[i] = types.T(arg.Typ.Id).ToType()
+	}
+	fid, _, _, err := function.GetFunctionByName(name, typs)
+	if err != nil {
+		return nil, err
+	}
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_bool)},
+		Expr: &plan.Expr_F{F: &plan.Function{
+			Func: &plan.ObjectRef{Obj: fid, ObjName: name},
+			Args: args,
+		}},
+	}, nil
+}
+
+// filterIndex returns the rows of the index entries which satisfy the filters of the scan.
+func (r *indexReader) filterIndex(bat *batch.Batch) ([]int64, error) {
+	if len(bat.Zs) == 0 {
+		return nil, nil
+	}
+	flags := make([]bool, len(bat.Zs))
+	for i := range flags {
+		flags[i] = true
+	}
+	for _, expr := range r.scan.FilterList {
+		vec, err := EvalExpr(bat, r.proc, expr)
+		if err != nil {
+			return nil, err
+		}
+		bs := vector.MustTCols[bool](vec)
+		for i := range flags {
+			j := i
+			if vec.IsScalar() {
+				j = 0
+			}
+			if !bs[j] || nulls.Contains(vec.Nsp, uint64(j)) {
+				flags[i] = false
+			}
+		}
+		vec.Free(r.proc.Mp())
+	}
+	sels := make([]int64, 0, len(flags))
+	for i, ok := range flags {
+		if ok {
+			sels = append(sels, int64(i))
+		}
+	}
+	return sels, nil
+}
+
+// lookupIndex finds the keys of the scan in a unique index.
+func (r *indexReader) lookupIndex() (*vector.Vector, error) {
+	bat := batch.NewWithSize(0)
+	bat.Zs = []int64{1}
+	keys := make([]string, 0, len(r.scan.Keys))
+	seen := make(map[string]struct{}, len(r.scan.Keys))
+	for _, key := range r.scan.Keys {
+		vecs := make([]*vector.Vector, len(key.List))
+		free := func() {
+			for _, vec := range vecs {
+				if vec != nil {
+					vec.Free(r.proc.Mp())
+				}
+			}
+		}
+		for i, expr := range key.List {
+			vec, err := EvalExpr(bat, r.proc, expr)
+			if err != nil {
+				free()
+				return nil, err
+			}
+			vecs[i] = vec.ConstExpand(r.proc.Mp())
+		}
+		k, err := multi.Serial(vecs, r.proc)
+		free()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[k.GetString(0)]; !ok {
+			seen[k.GetString(0)] = struct{}{}
+			keys = append(keys, k.GetString(0))
+		}
+		k.Free(r.proc.Mp())
+	}
+	vec := vector.NewWithStrings(types.T_varchar.ToType(), keys, nil, r.proc.Mp())
+	defer vec.Free(r.proc.Mp())
+	kr, ok := r.idxRel.(engine.KeyReader)
+	if !ok {
+		return nil, errors.New("", fmt.Sprintf("internal error: index '%s' can't be read by key", r.scan.IndexDef.Name))
+	}
+	entries, err := kr.ReadByPrimaryKeys(r.ctx, []string{r.pk}, vec, r.proc.Mp())
+	if err != nil {
+		return nil, err
+	}
+	keyVec := entries.Vecs[0]
+	entries.Vecs[0] = nil
+	entries.Clean(r.proc.Mp())
+	return keyVec, nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_getIndexTableName(t *testing.T) {
+	convey.Convey("Test index table name succ", t, func() {
+		name := GetIndexTableName("t1", "idx")
+		convey.So(name, convey.ShouldEqual, "%!%mo_index_t1%!%idx")
+		convey.So(IsIndexTable(name), convey.ShouldBeTrue)
+		convey.So(IsIndexTable("t1"), convey.ShouldBeFalse)
+	})
+}
+
+func Test_getIndexTableCols(t *testing.T) {
+	convey.Convey("Test getIndexTableCols succ", t, func() {
+		def := &plan.IndexDef{Name: "idx", ColNames: []string{"b", "c"}}
+		convey.So(getIndexTableCols(def, "a"), convey.ShouldResemble, []string{"b", "c", "a"})
+		convey.So(getIndexTableCols(def, "c"), convey.ShouldResemble, []string{"b", "c"})
+		convey.So(IsIndexAffected(def, "a", []string{"a"}), convey.ShouldBeTrue)
+		convey.So(IsIndexAffected(def, "a", []string{"c"}), convey.ShouldBeTrue)
+		convey.So(IsIndexAffected(def, "a", []string{"d"}), convey.ShouldBeFalse)
+	})
+}
+
+func Test_makeIndexEntries(t *testing.T) {
+	convey.Convey("Test makeIndexEntries succ", t, func() {
+		proc := testutil.NewProcess()
+		bat := batch.NewWithSize(3)
+		bat.Attrs = []string{"a", "b", "c"}
+		bat.Vecs[0] = testutil.NewInt64Vector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3})
+		bat.Vecs[1] = testutil.NewStringVector(3, types.T_varchar.ToType(), proc.Mp(), false, []string{"x", "y", "x"})
+		bat.Vecs[2] = testutil.NewInt64Vector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{7, 8, 9})
+		nulls.Add(bat.Vecs[2].Nsp, 1)
+		bat.InitZsOne(3)
+		defer bat.Clean(proc.Mp())
+
+		// rows with a null index column are skipped
+		def := &plan.IndexDef{Name: "idx", ColNames: []string{"b", "c"}}
+		entries, err := makeIndexEntries(proc, def, "a", bat)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(entries.Attrs, convey.ShouldResemble, []string{INDEX_KEY_COLNAME, "b", "c", "a"})
+		convey.So(vector.Length(entries.Vecs[0]), convey.ShouldEqual, 2)
+		convey.So(entries.Vecs[3].Col, convey.ShouldResemble, []int64{1, 3})
+		keys := vector.GetStrVectorValues(entries.Vecs[0])
+		convey.So(keys[0], convey.ShouldNotEqual, keys[1])
+		entries.Clean(proc.Mp())
+
+		// the key of a unique index is made of the index columns only
+		def = &plan.IndexDef{Name: "idx", ColNames: []string{"b"}, Unique: true}
+		entries, err = makeIndexEntries(proc, def, "a", bat)
+		convey.So(err, convey.ShouldBeNil)
+		keys = vector.GetStrVectorValues(entries.Vecs[0])
+		convey.So(len(keys), convey.ShouldEqual, 3)
+		convey.So(keys[0], convey.ShouldEqual, keys[2])
+		entries.Clean(proc.Mp())
+
+		def = &plan.IndexDef{Name: "idx", ColNames: []string{"d"}}
+		_, err = makeIndexEntries(proc, def, "a", bat)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_seekIndex(t *testing.T) {
+	convey.Convey("Test seekIndex succ", t, func() {
+		proc := testutil.NewProcess()
+		bat := batch.NewWithSize(3)
+		bat.Attrs = []string{"a", "b", "c"}
+		bat.Vecs[0] = testutil.NewInt64Vector(5, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3, 4, 5})
+		bat.Vecs[1] = testutil.NewStringVector(5, types.T_varchar.ToType(), proc.Mp(), false, []string{"x", "x", "x", "xy", "w"})
+		bat.Vecs[2] = testutil.NewInt64Vector(5, types.T_int64.ToType(), proc.Mp(), false, []int64{7, 8, 9, 8, 8})
+		bat.InitZsOne(5)
+		defer bat.Clean(proc.Mp())
+		def := &plan.IndexDef{Name: "idx", ColNames: []string{"b", "c"}}
+		entries, err := makeIndexEntries(proc, def, "a", bat)
+		convey.So(err, convey.ShouldBeNil)
+		defer entries.Clean(proc.Mp())
+		keys := vector.GetStrVectorValues(entries.Vecs[0])
+
+		col := func(pos int32, id types.T) *plan.Expr {
+			return &plan.Expr{Typ: &plan.Type{Id: int32(id)}, Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: pos}}}
+		}
+		compare := func(op string, args ...*plan.Expr) *plan.Expr {
+			return &plan.Expr{Expr: &plan.Expr_F{F: &plan.Function{Func: &plan.ObjectRef{ObjName: op}, Args: args}}}
+		}
+		sval := &plan.Expr{Typ: &plan.Type{Id: int32(types.T_varchar)}, Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Sval{Sval: "x"}}}}
+		ival := &plan.Expr{Typ: &plan.Type{Id: int32(types.T_int64)}, Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: 7}}}}
+		// returns the rows whose keys are in the range of the filters
+		seek := func(filters ...*plan.Expr) []int {
+			r := &indexReader{proc: proc, scan: &plan.IndexScan{IndexDef: def, FilterList: filters}}
+			expr, err := r.seekIndex(3)
+			convey.So(err, convey.ShouldBeNil)
+			if expr == nil {
+				return nil
+			}
+			var lo, hi string
+			var walk func(e *plan.Expr)
+			walk = func(e *plan.Expr) {
+				f := e.Expr.(*plan.Expr_F).F
+				switch f.Func.ObjName {
+				case "and":
+					walk(f.Args[0])
+					walk(f.Args[1])
+				case ">=":
+					lo = f.Args[1].Expr.(*plan.Expr_C).C.GetSval()
+				case "<":
+					hi = f.Args[1].Expr.(*plan.Expr_C).C.GetSval()
+				}
+			}
+			walk(expr)
+			var rows []int
+			for i, key := range keys {
+				if key >= lo && (hi == "" || key < hi) {
+					rows = append(rows, i)
+				}
+			}
+			return rows
+		}
+
+		convey.So(seek(compare("=", col(0, types.T_varchar), sval)), convey.ShouldResemble, []int{0, 1, 2})
+		convey.So(seek(compare("=", sval, col(0, types.T_varchar)), compare(">", col(1, types.T_int64), ival)), convey.ShouldResemble, []int{1, 2})
+		convey.So(seek(compare("=", col(0, types.T_varchar), sval), compare("<=", ival, col(1, types.T_int64))), convey.ShouldResemble, []int{0, 1, 2})
+		convey.So(seek(compare("=", col(0, types.T_varchar), sval), compare("<", col(1, types.T_int64), ival)), convey.ShouldResemble, []int(nil))
+		convey.So(seek(compare(">=", col(0, types.T_varchar), sval)), convey.ShouldResemble, []int{0, 1, 2, 3})
+		convey.So(seek(compare("<=", col(0, types.T_varchar), sval)), convey.ShouldResemble, []int{0, 1, 2, 4})
+		// no range on the leading column
+		convey.So(seek(compare(">", col(1, types.T_int64), ival)), convey.ShouldBeNil)
+		convey.So(nextPrefix([]byte{1, 0xff}), convey.ShouldResemble, []byte{2})
+		convey.So(nextPrefix([]byte{0xff}), convey.ShouldBeNil)
+	})
+}
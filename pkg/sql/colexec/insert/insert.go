@@ -36,6 +36,8 @@ type Argument struct {
 	Engine        engine.Engine
 	DB            engine.Database
 	TableID       string
+	Indexes       *colexec.TableIndexes
+	Constraints   *colexec.TableConstraints
 }
 
 func String(_ any, buf *bytes.Buffer) {
@@ -80,7 +82,15 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 	if err := colexec.UpdateInsertBatch(n.Engine, n.DB, ctx, proc, n.TargetColDefs, bat, n.TableID); err != nil {
 		return false, err
 	}
-	err := n.TargetTable.Write(ctx, bat)
+	if err := n.Constraints.Write(ctx, proc, bat); err != nil {
+		return false, err
+	}
+	if err := n.TargetTable.Write(ctx, bat); err != nil {
+		return false, err
+	}
+	if err := n.Indexes.Write(ctx, proc, bat); err != nil {
+		return false, err
+	}
 	n.Affected += uint64(len(bat.Zs))
-	return false, err
+	return false, nil
 }
@@ -19,8 +19,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
@@ -102,6 +104,59 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestJoinSpill(t *testing.T) {
+	typs := []types.Type{{Oid: types.T_int64}}
+	tc := newTestCase(testutil.NewMheap(), []bool{false}, typs, []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)},
+		[][]*plan.Expr{
+			{
+				newExpr(0, typs[0]),
+			},
+			{
+				newExpr(0, typs[0]),
+			},
+		})
+	tc.arg.Cond = nil
+	tc.barg.CanSpill = true
+	require.NoError(t, hashbuild.Prepare(tc.proc, tc.barg))
+
+	// the query is short of memory, so all the build rows are spilled
+	ballast := tc.proc.Mp().Gm.Limit * 8 / 10
+	require.NoError(t, tc.proc.Mp().Increase(ballast))
+	tc.proc.Reg.MergeReceivers[0].Ch <- newKeyBatch(tc.proc, 0, 1000)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newKeyBatch(tc.proc, 1000, 2000)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	ok, err := hashbuild.Call(0, tc.proc, tc.barg)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	bat := tc.proc.Reg.InputBatch
+	require.Equal(t, 0, bat.Length())
+	require.NotNil(t, bat.Ht.(*hashmap.JoinMap).Spilled())
+	tc.proc.Mp().Decrease(ballast)
+
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	tc.proc.Reg.MergeReceivers[0].Ch <- newKeyBatch(tc.proc, 500, 1500)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newKeyBatch(tc.proc, 1500, 3000)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- bat
+	rows := 0
+	for {
+		ok, err := Call(0, tc.proc, tc.arg)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		rbat := tc.proc.Reg.InputBatch
+		require.Equal(t, vector.MustTCols[int64](rbat.Vecs[0]), vector.MustTCols[int64](rbat.Vecs[1]))
+		rows += rbat.Length()
+		rbat.Clean(tc.proc.Mp())
+	}
+	require.Equal(t, 1500, rows)
+	entries, err := tc.proc.FileService.List(context.Background(), "LOCAL:spill")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp()))
+}
+
 func BenchmarkJoin(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs = []joinTestCase{
@@ -246,3 +301,15 @@ func hashBuild(t *testing.T, tc joinTestCase) *batch.Batch {
 func newBatch(t *testing.T, flgs []bool, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
 	return testutil.NewBatch(ts, false, int(rows), proc.Mp())
 }
+
+// newKeyBatch returns a batch of the keys in [start, end).
+func newKeyBatch(proc *process.Process, start, end int64) *batch.Batch {
+	vs := make([]int64, 0, end-start)
+	for v := start; v < end; v++ {
+		vs = append(vs, v)
+	}
+	return testutil.NewBatchWithVectors(
+		[]*vector.Vector{
+			testutil.NewVector(len(vs), types.T_int64.ToType(), proc.Mp(), false, vs),
+		}, nil)
+}
@@ -26,6 +26,7 @@ import (
 const (
 	Build = iota
 	Probe
+	ProbeSpilled
 	End
 )
 
@@ -45,6 +46,10 @@ type container struct {
 	vecs  []*vector.Vector
 
 	mp *hashmap.JoinMap
+
+	// sj joins the build rows spilled to disk by the hash build with the
+	// probe rows, which are spilled too, partition by partition
+	sj *colexec.SpilledJoin
 }
 
 type Argument struct {
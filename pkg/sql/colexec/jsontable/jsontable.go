@@ -0,0 +1,245 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsontable
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("json_table('%s')", ap.JsonTable.Path))
+}
+
+func Prepare(_ *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	path, err := types.ParseStringToPath(ap.JsonTable.Path)
+	if err != nil {
+		return err
+	}
+	ap.ctr.path = path
+	ap.ctr.colPaths = make([]bytejson.Path, len(ap.JsonTable.Cols))
+	for i, col := range ap.JsonTable.Cols {
+		if col.Kind == plan.JsonTableColumn_ORDINALITY {
+			continue
+		}
+		if ap.ctr.colPaths[i], err = types.ParseStringToPath(col.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Call joins each input row with the rows made from its document, the columns
+// of JSON_TABLE are appended after the columns of the input.
+func Call(idx int, proc *process.Process, arg any) (bool, error) {
+	ap := arg.(*Argument)
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+	bat := proc.InputBatch()
+	if bat == nil {
+		return true, nil
+	}
+	if bat.Length() == 0 {
+		return false, nil
+	}
+	anal.Input(bat)
+	rbat, err := ap.ctr.eval(ap, bat, proc)
+	bat.Clean(proc.Mp())
+	if err != nil {
+		return false, err
+	}
+	anal.Output(rbat)
+	proc.SetInputBatch(rbat)
+	return false, nil
+}
+
+func (ctr *container) eval(ap *Argument, bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	docVec, err := colexec.EvalExpr(bat, proc, ap.JsonTable.Doc)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, vec := range bat.Vecs {
+			if vec == docVec {
+				return
+			}
+		}
+		docVec.Free(proc.Mp())
+	}()
+
+	// sels are the input rows of the output rows, rows are the values matched
+	// for them and ords are their ordinal numbers, 0 for the rows kept with nulls
+	var sels, ords []int64
+	var rows []bytejson.ByteJson
+	for i := 0; i < bat.Length(); i++ {
+		matched, err := ctr.match(docVec, i)
+		if err != nil {
+			return nil, err
+		}
+		if len(matched) == 0 && ap.JsonTable.Outer {
+			sels = append(sels, int64(i))
+			ords = append(ords, 0)
+			rows = append(rows, bytejson.ByteJson{})
+		}
+		for k, m := range matched {
+			sels = append(sels, int64(i))
+			ords = append(ords, int64(k+1))
+			rows = append(rows, m)
+		}
+	}
+
+	rbat := batch.NewWithSize(len(bat.Vecs) + len(ap.JsonTable.Cols))
+	for i, vec := range bat.Vecs {
+		vec.ConstExpand(proc.Mp())
+		rbat.Vecs[i] = vector.New(vec.Typ)
+		for _, sel := range sels {
+			if err := vector.UnionOne(rbat.Vecs[i], vec, sel, proc.Mp()); err != nil {
+				rbat.Clean(proc.Mp())
+				return nil, err
+			}
+		}
+	}
+	for i, col := range ap.JsonTable.Cols {
+		vec, err := ctr.evalColumn(i, col, rows, ords, proc)
+		if err != nil {
+			rbat.Clean(proc.Mp())
+			return nil, err
+		}
+		rbat.Vecs[len(bat.Vecs)+i] = vec
+	}
+	rbat.Zs = make([]int64, len(sels))
+	for i, sel := range sels {
+		rbat.Zs[i] = bat.Zs[sel]
+	}
+	return rbat, nil
+}
+
+// match returns the values matched by the path in the document of the row i
+func (ctr *container) match(docVec *vector.Vector, i int) ([]bytejson.ByteJson, error) {
+	row := int64(i)
+	if docVec.IsScalar() {
+		row = 0
+	}
+	if docVec.IsScalarNull() || nulls.Contains(docVec.Nsp, uint64(row)) {
+		return nil, nil
+	}
+	var doc bytejson.ByteJson
+	if docVec.Typ.Oid == types.T_json {
+		doc = types.DecodeJson(docVec.GetBytes(row))
+	} else {
+		var err error
+		if doc, err = types.ParseSliceToByteJson(docVec.GetBytes(row)); err != nil {
+			return nil, err
+		}
+	}
+	return doc.Find(ctr.path), nil
+}
+
+// evalColumn makes the values of the column i, the value at the path is
+// converted to the type of the column by the expression of the column
+func (ctr *container) evalColumn(i int, col *plan.JsonTableColumn, rows []bytejson.ByteJson, ords []int64, proc *process.Process) (*vector.Vector, error) {
+	if len(rows) == 0 {
+		if col.Expr == nil {
+			return vector.New(types.T_int64.ToType()), nil
+		}
+		return vector.New(makeType(col.Expr.Typ)), nil
+	}
+	nsp := nulls.NewWithSize(len(rows))
+	var raw *vector.Vector
+	switch col.Kind {
+	case plan.JsonTableColumn_ORDINALITY, plan.JsonTableColumn_EXISTS:
+		vals := make([]int64, len(rows))
+		for j, ord := range ords {
+			switch {
+			case ord == 0:
+				nulls.Add(nsp, uint64(j))
+			case col.Kind == plan.JsonTableColumn_ORDINALITY:
+				vals[j] = ord
+			case len(rows[j].Find(ctr.colPaths[i])) > 0:
+				vals[j] = 1
+			}
+		}
+		raw = vector.NewWithFixed(types.T_int64.ToType(), vals, nsp, proc.Mp())
+	default:
+		isJson := types.T(col.Expr.Typ.Id) == types.T_json
+		vals := make([][]byte, len(rows))
+		for j, ord := range ords {
+			if ord == 0 {
+				nulls.Add(nsp, uint64(j))
+				continue
+			}
+			found := rows[j].Find(ctr.colPaths[i])
+			switch {
+			case len(found) > 1:
+				return nil, errors.New(errno.DataException, fmt.Sprintf("the path of the column '%s' of json_table matches more than one value", col.Name))
+			case len(found) == 0 || found[0].IsNull():
+				nulls.Add(nsp, uint64(j))
+			case isJson:
+				buf, err := types.EncodeJson(found[0])
+				if err != nil {
+					return nil, err
+				}
+				vals[j] = buf
+			default:
+				vals[j] = []byte(found[0].Unquote())
+			}
+		}
+		typ := types.T_varchar.ToType()
+		if isJson {
+			typ = types.T_json.ToType()
+		}
+		raw = vector.NewWithBytes(typ, vals, nsp, proc.Mp())
+	}
+	if col.Expr == nil {
+		return raw, nil
+	}
+	tmp := batch.NewWithSize(1)
+	tmp.Vecs[0] = raw
+	tmp.InitZsOne(len(rows))
+	vec, err := colexec.EvalExpr(tmp, proc, col.Expr)
+	if err != nil {
+		raw.Free(proc.Mp())
+		return nil, err
+	}
+	if vec != raw {
+		raw.Free(proc.Mp())
+	}
+	return vec, nil
+}
+
+func makeType(typ *plan.Type) types.Type {
+	return types.Type{
+		Oid:       types.T(typ.Id),
+		Size:      typ.Size,
+		Width:     typ.Width,
+		Scale:     typ.Scale,
+		Precision: typ.Precision,
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsontable
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+var docs = []string{`[{"a": "x", "b": 1}, {"a": "y"}]`, `[]`, `{"a": "z"}`}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(newArgument(false), buf)
+	require.Equal(t, "json_table('$[*]')", buf.String())
+}
+
+func TestJsonTable(t *testing.T) {
+	for _, outer := range []bool{false, true} {
+		proc := testutil.NewProcess()
+		arg := newArgument(outer)
+		require.NoError(t, Prepare(proc, arg))
+		proc.Reg.InputBatch = newBatch(proc)
+		_, err := Call(0, proc, arg)
+		require.NoError(t, err)
+
+		bat := proc.Reg.InputBatch
+		require.Equal(t, 4, len(bat.Vecs))
+		ords, names, exists := bat.Vecs[1], bat.Vecs[2], bat.Vecs[3]
+		if outer {
+			// the second document matches nothing, and it's kept with nulls
+			require.Equal(t, 4, bat.Length())
+			require.Equal(t, []int64{1, 2, 0, 1}, vector.MustTCols[int64](ords))
+			require.True(t, nulls.Contains(ords.Nsp, 2))
+			require.True(t, nulls.Contains(names.Nsp, 2))
+			require.Equal(t, docs[1], bat.Vecs[0].GetString(2))
+		} else {
+			require.Equal(t, 3, bat.Length())
+			require.Equal(t, []int64{1, 2, 1}, vector.MustTCols[int64](ords))
+			require.Equal(t, []string{"x", "y", "z"}, vector.GetStrVectorValues(names))
+			require.Equal(t, []int64{1, 0, 0}, vector.MustTCols[int64](exists))
+		}
+		bat.Clean(proc.Mp())
+	}
+}
+
+func TestJsonTableError(t *testing.T) {
+	proc := testutil.NewProcess()
+	arg := newArgument(false)
+	// $**.a matches both values of the first document
+	arg.JsonTable.Cols[1].Path = "$**.a"
+	arg.JsonTable.Path = "$"
+	require.NoError(t, Prepare(proc, arg))
+	proc.Reg.InputBatch = newBatch(proc)
+	_, err := Call(0, proc, arg)
+	require.Error(t, err)
+}
+
+func newArgument(outer bool) *Argument {
+	varchar := &plan.Type{Id: int32(types.T_varchar), Size: types.VarlenaSize, Width: types.MaxStringSize}
+	int64Typ := &plan.Type{Id: int32(types.T_int64), Size: 8}
+	return &Argument{
+		JsonTable: &plan.JsonTable{
+			Doc:   newColumn(varchar),
+			Path:  "$[*]",
+			Outer: outer,
+			Cols: []*plan.JsonTableColumn{
+				{Name: "id", Kind: plan.JsonTableColumn_ORDINALITY},
+				{Name: "a", Kind: plan.JsonTableColumn_VALUE, Path: "$.a", Expr: newColumn(varchar)},
+				{Name: "b", Kind: plan.JsonTableColumn_EXISTS, Path: "$.b", Expr: newColumn(int64Typ)},
+			},
+		},
+	}
+}
+
+func newColumn(typ *plan.Type) *plan.Expr {
+	return &plan.Expr{
+		Typ: typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{},
+		},
+	}
+}
+
+func newBatch(proc *process.Process) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = vector.NewWithStrings(types.T_varchar.ToType(), docs, nil, proc.Mp())
+	bat.InitZsOne(len(docs))
+	return bat
+}
@@ -0,0 +1,30 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsontable
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+type container struct {
+	path     bytejson.Path   // path of the rows in the document
+	colPaths []bytejson.Path // paths of the columns relative to the rows
+}
+
+type Argument struct {
+	ctr       *container
+	JsonTable *plan.JsonTable // JsonTable is the document, the path and the columns of JSON_TABLE
+}
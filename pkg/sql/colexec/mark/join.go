@@ -86,6 +86,10 @@ func Call(idx int, proc *process.Process, arg any) (bool, error) {
 		case Probe:
 			bat := <-proc.Reg.MergeReceivers[0].Ch
 			if bat == nil {
+				if ctr.sj != nil {
+					ctr.state = ProbeSpilled
+					continue
+				}
 				ctr.state = End
 				ctr.freeBuildEqVec(proc)
 				if ctr.nullWithBatch != nil {
@@ -102,6 +106,14 @@ func Call(idx int, proc *process.Process, arg any) (bool, error) {
 			if bat.Length() == 0 {
 				continue
 			}
+			if ctr.sj != nil {
+				if err := ctr.sj.Spill(proc, bat, anal); err != nil {
+					ctr.state = End
+					ctr.freeSpilled(proc)
+					return true, err
+				}
+				continue
+			}
 			if ctr.bat == nil || ctr.bat.Length() == 0 {
 				if err := ctr.emptyProbe(bat, ap, proc, anal); err != nil {
 					ctr.state = End
@@ -129,6 +141,18 @@ func Call(idx int, proc *process.Process, arg any) (bool, error) {
 				}
 			}
 			return false, nil
+		case ProbeSpilled:
+			ok, err := ctr.probeSpilled(ap, proc, anal)
+			if err != nil || !ok {
+				ctr.state = End
+				ctr.freeSpilled(proc)
+				if err != nil {
+					proc.SetInputBatch(nil)
+					return true, err
+				}
+				continue
+			}
+			return false, nil
 		default:
 			proc.SetInputBatch(nil)
 			return true, nil
@@ -140,6 +164,14 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 	bat := <-proc.Reg.MergeReceivers[1].Ch
 	if bat != nil {
 		joinMap := bat.Ht.(*hashmap.JoinMap)
+		if joinMap.Spilled() != nil {
+			ctr.rewriteCond = colexec.RewriteFilterExprList(ap.OnList)
+			ctr.hasNull = joinMap.HasNull()
+			ctr.sj = colexec.NewSpilledJoin(joinMap.Dup(), ap.Typs, ap.Conditions, ap.Ibucket, ap.Nbucket,
+				colexec.SpillKeepProbe|colexec.SpillApartNulls)
+			bat.Clean(proc.GetMheap())
+			return nil
+		}
 		ctr.evalNullSels(bat)
 		ctr.nullWithBatch = DumpBatch(bat, proc, ctr.nullSels)
 		if err := ctr.evalJoinBuildCondition(bat, ap.Conditions[1], proc); err != nil {
@@ -153,6 +185,107 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 	return nil
 }
 
+// probeSpilled probes the next probe batch of the spilled partitions with the
+// build rows of its partition, and then checks the probe rows with a null key
+// with all the build rows, it returns false once all of them are probed.
+func (ctr *container) probeSpilled(ap *Argument, proc *process.Process, anal process.Analyze) (bool, error) {
+	if ctr.nullWithBatch == nil {
+		// the build rows with a null key are checked by the probe rows of every partition
+		bat, err := ctr.sj.NullBuild(proc)
+		if err != nil {
+			return false, err
+		}
+		ctr.nullWithBatch = bat
+		ctr.nullSels = make([]int64, bat.Length())
+		for i := range ctr.nullSels {
+			ctr.nullSels[i] = int64(i)
+		}
+	}
+	ok, err := ctr.sj.Probe(proc, func(bat *batch.Batch, mp *hashmap.JoinMap) {
+		ctr.bat, ctr.mp = bat, mp
+	}, func(bat *batch.Batch) error {
+		return ctr.probe(bat, ap, proc, anal)
+	}, nil)
+	if err != nil || ok {
+		return ok, err
+	}
+	bat, err := ctr.sj.NextNullProbe(proc)
+	if err != nil || bat == nil {
+		return false, err
+	}
+	if err := ctr.probeNulls(bat, ap, proc, anal); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// probeNulls checks the probe rows with a null key with all the build rows
+// spilled, which is 2.1 of the probe rows not spilled.
+func (ctr *container) probeNulls(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze) error {
+	defer bat.Clean(proc.Mp())
+	anal.Input(bat)
+	rbat := batch.NewWithSize(len(ap.Result) + 1)
+	rbat.Zs = proc.GetMheap().GetSels()
+	for i, pos := range ap.Result {
+		rbat.Vecs[i] = vector.New(bat.Vecs[pos].Typ)
+	}
+	rbat.Vecs[len(ap.Result)] = vector.New(types.T_bool.ToType())
+	ctr.joinFlags = make([]bool, bat.Length())
+	ctr.Nsp = nulls.NewWithSize(bat.Length())
+	count := bat.Length()
+	// the state of a probe row is the Three-valued OR of its states with every build block
+	states := make([]resultType, count)
+	if err := ctr.sj.ReadBuild(proc, func(build *batch.Batch) error {
+		for i := 0; i < count; i++ {
+			if states[i] == condTrue {
+				continue
+			}
+			condState, err := ctr.EvalEntire(bat, build, i, proc, ctr.rewriteCond)
+			if err != nil {
+				return err
+			}
+			if condState != condFalse {
+				states[i] = condState
+			}
+		}
+		return nil
+	}); err != nil {
+		rbat.Clean(proc.GetMheap())
+		return err
+	}
+	for i, condState := range states {
+		ctr.handleResultType(i, condState)
+	}
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		if err := ctr.appendMarked(bat, rbat, i, n, ap, proc); err != nil {
+			return err
+		}
+	}
+	if !ap.OutputMark {
+		rbat.Vecs = rbat.Vecs[:len(rbat.Vecs)-1]
+	}
+	rbat.ExpandNulls()
+	anal.Output(rbat)
+	proc.SetInputBatch(rbat)
+	return nil
+}
+
+// freeSpilled removes the rows spilled, the build rows of the partition probed
+// are freed with them.
+func (ctr *container) freeSpilled(proc *process.Process) {
+	ctr.sj.Free(proc)
+	ctr.sj = nil
+	ctr.bat, ctr.mp = nil, nil
+	if ctr.nullWithBatch != nil {
+		ctr.nullWithBatch.Clean(proc.GetMheap())
+		ctr.nullWithBatch = nil
+	}
+}
+
 func (ctr *container) emptyProbe(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze) error {
 	defer bat.Clean(proc.GetMheap())
 	anal.Input(bat)
@@ -272,26 +405,8 @@ func (ctr *container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 				ctr.handleResultType(i+k, condState)
 			}
 		}
-		data := unsafe.Slice((*byte)(unsafe.Pointer(&ctr.joinFlags[0])), cap(ctr.joinFlags))[:len(ctr.joinFlags)]
-		// add mark flag, the initial
-		rbat.Vecs[len(ap.Result)] = vector.NewWithData(types.T_bool.ToType(), data, ctr.joinFlags, ctr.Nsp)
-		markVec := vector.NewWithData(types.T_bool.ToType(), data, ctr.joinFlags, ctr.Nsp)
-		for k := 0; k < n; k++ {
-			if ap.OutputAnyway || (ctr.Nsp.Np.Contains(uint64(i+k)) && ap.OutputNull || !ctr.Nsp.Np.Contains(uint64(i+k)) && ctr.joinFlags[i+k] == ap.MarkMeaning) {
-				for j, pos := range ap.Result {
-					if err := vector.UnionOne(rbat.Vecs[j], bat.Vecs[pos], int64(i+k), proc.GetMheap()); err != nil {
-						rbat.Clean(proc.GetMheap())
-						return err
-					}
-				}
-				if ap.OutputMark {
-					if err := vector.UnionOne(rbat.Vecs[lastIndex], markVec, int64(i+k), proc.GetMheap()); err != nil {
-						rbat.Clean(proc.GetMheap())
-						return err
-					}
-				}
-				rbat.Zs = append(rbat.Zs, bat.Zs[i+k])
-			}
+		if err := ctr.appendMarked(bat, rbat, i, n, ap, proc); err != nil {
+			return err
 		}
 	}
 	if !ap.OutputMark {
@@ -303,6 +418,33 @@ func (ctr *container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 	return nil
 }
 
+// appendMarked appends the n probe rows from i to the result by their marks.
+func (ctr *container) appendMarked(bat, rbat *batch.Batch, i, n int, ap *Argument, proc *process.Process) error {
+	lastIndex := len(rbat.Vecs) - 1
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&ctr.joinFlags[0])), cap(ctr.joinFlags))[:len(ctr.joinFlags)]
+	// add mark flag, the initial
+	rbat.Vecs[len(ap.Result)] = vector.NewWithData(types.T_bool.ToType(), data, ctr.joinFlags, ctr.Nsp)
+	markVec := vector.NewWithData(types.T_bool.ToType(), data, ctr.joinFlags, ctr.Nsp)
+	for k := 0; k < n; k++ {
+		if ap.OutputAnyway || (ctr.Nsp.Np.Contains(uint64(i+k)) && ap.OutputNull || !ctr.Nsp.Np.Contains(uint64(i+k)) && ctr.joinFlags[i+k] == ap.MarkMeaning) {
+			for j, pos := range ap.Result {
+				if err := vector.UnionOne(rbat.Vecs[j], bat.Vecs[pos], int64(i+k), proc.GetMheap()); err != nil {
+					rbat.Clean(proc.GetMheap())
+					return err
+				}
+			}
+			if ap.OutputMark {
+				if err := vector.UnionOne(rbat.Vecs[lastIndex], markVec, int64(i+k), proc.GetMheap()); err != nil {
+					rbat.Clean(proc.GetMheap())
+					return err
+				}
+			}
+			rbat.Zs = append(rbat.Zs, bat.Zs[i+k])
+		}
+	}
+	return nil
+}
+
 // store the results of the calculation on the probe side of the equation condition
 func (ctr *container) evalJoinProbeCondition(bat *batch.Batch, conds []*plan.Expr, proc *process.Process) error {
 	for i, cond := range conds {
@@ -20,12 +20,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 )
 
 const (
 	Build = iota
 	Probe
+	ProbeSpilled
 	End
 )
 
@@ -54,6 +56,7 @@ type container struct {
 	// here, we will have three states:
 	// Build：we will use the right table to build a hashtable
 	// Probe: we will use the left table data to probe the hashtable
+	// ProbeSpilled: we will probe the partitions spilled to disk one by one
 	// End: Join working is over
 	state int
 
@@ -94,6 +97,10 @@ type container struct {
 	nullWithBatch *batch.Batch
 
 	rewriteCond *plan.Expr
+
+	// sj joins the build rows spilled to disk by the hash build with the
+	// probe rows, which are spilled too, partition by partition
+	sj *colexec.SpilledJoin
 }
 
 // // for join operator, it's a two-ary operator, we will reference to two table
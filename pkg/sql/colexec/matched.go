@@ -0,0 +1,85 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/bitmap"
+)
+
+// MatchedRows collects the build rows of a right or full join that found a match.
+// It is shared by the parallel probe pipelines of one join, the rows never matched
+// can only be known once all of them are done, so they are emitted by the last one.
+type MatchedRows struct {
+	sync.Mutex
+	// cnt is the number of probe pipelines that have not finished yet
+	cnt int
+	bm  *bitmap.Bitmap
+	// spilled are the probe rows spilled by the pipelines finished, once the build
+	// rows of the join are spilled
+	spilled []*SpilledPartitions
+}
+
+func NewMatchedRows() *MatchedRows {
+	return &MatchedRows{}
+}
+
+// Share registers one more probe pipeline of the join.
+func (m *MatchedRows) Share() *MatchedRows {
+	m.Lock()
+	defer m.Unlock()
+	m.cnt++
+	return m
+}
+
+// Merge merges the rows matched by a finished probe pipeline. It returns the rows
+// matched by all the pipelines to the last one to finish, and nil to the others.
+func (m *MatchedRows) Merge(bm *bitmap.Bitmap) *bitmap.Bitmap {
+	m.Lock()
+	defer m.Unlock()
+	return m.merge(bm)
+}
+
+// MergeSpilled is Merge for a join whose build rows are spilled, the probe rows
+// spilled by the pipeline are handed over too, and the last one to finish gets
+// the probe rows spilled by all the pipelines to join them partition by partition.
+func (m *MatchedRows) MergeSpilled(bm *bitmap.Bitmap, sp *SpilledPartitions) (*bitmap.Bitmap, []*SpilledPartitions) {
+	m.Lock()
+	defer m.Unlock()
+	if sp != nil {
+		m.spilled = append(m.spilled, sp)
+	}
+	if bm = m.merge(bm); bm == nil {
+		return nil, nil
+	}
+	sps := m.spilled
+	m.spilled = nil
+	return bm, sps
+}
+
+func (m *MatchedRows) merge(bm *bitmap.Bitmap) *bitmap.Bitmap {
+	if m.bm == nil {
+		m.bm = bm
+	} else {
+		m.bm.Or(bm)
+	}
+	if m.cnt--; m.cnt > 0 {
+		return nil
+	}
+	// the join is done, so the next run of it starts over
+	bm, m.bm, m.cnt = m.bm, nil, 0
+	return bm
+}
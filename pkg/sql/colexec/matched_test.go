@@ -0,0 +1,63 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/bitmap"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_matchedRows(t *testing.T) {
+	convey.Convey("Test matched rows succ", t, func() {
+		m := NewMatchedRows()
+		for i := 0; i < 3; i++ {
+			m.Share()
+		}
+		for i := 0; i < 3; i++ {
+			bm := bitmap.New(10)
+			bm.Add(uint64(i * 2))
+			res := m.Merge(bm)
+			if i < 2 {
+				convey.So(res, convey.ShouldBeNil)
+				continue
+			}
+			// only the last pipeline sees the rows matched by all of them
+			convey.So(res, convey.ShouldNotBeNil)
+			convey.So(res.ToArray(), convey.ShouldResemble, []uint64{0, 2, 4})
+		}
+
+		// a join run by a single pipeline owns its matched rows
+		bm := bitmap.New(10)
+		bm.Add(1)
+		res := NewMatchedRows().Merge(bm)
+		convey.So(res.Contains(1), convey.ShouldBeTrue)
+	})
+	convey.Convey("Test matched rows with the probe rows spilled", t, func() {
+		m := NewMatchedRows()
+		for i := 0; i < 2; i++ {
+			m.Share()
+		}
+		sps := []*SpilledPartitions{{}, nil}
+		res, spilled := m.MergeSpilled(bitmap.New(10), sps[0])
+		convey.So(res, convey.ShouldBeNil)
+		convey.So(spilled, convey.ShouldBeNil)
+		// the last pipeline gets the probe rows spilled by all of them
+		res, spilled = m.MergeSpilled(bitmap.New(10), sps[1])
+		convey.So(res, convey.ShouldNotBeNil)
+		convey.So(spilled, convey.ShouldResemble, sps[:1])
+	})
+}
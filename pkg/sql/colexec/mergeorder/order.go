@@ -54,10 +54,27 @@ func Call(idx int, proc *process.Process, arg any) (bool, error) {
 		case Build:
 			if err := ctr.build(ap, proc, anal); err != nil {
 				ctr.state = End
+				ctr.free(proc)
 				return true, err
 			}
 			ctr.state = Eval
 		case Eval:
+			if len(ctr.runs) > 0 {
+				bat, err := ctr.merge(proc)
+				if err != nil {
+					ctr.state = End
+					ctr.free(proc)
+					return true, err
+				}
+				if bat == nil {
+					ctr.state = End
+					ctr.free(proc)
+					continue
+				}
+				anal.Output(bat)
+				proc.SetInputBatch(bat)
+				return false, nil
+			}
 			if ctr.bat != nil {
 				for i := ctr.n; i < len(ctr.bat.Vecs); i++ {
 					vector.Clean(ctr.bat.Vecs[i], proc.Mp())
@@ -135,16 +152,161 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 			} else {
 				if err := ctr.processBatch(bat, proc); err != nil {
 					bat.Clean(proc.Mp())
-					ctr.bat.Clean(proc.Mp())
 					return err
 				}
 				bat.Clean(proc.Mp())
 			}
+			if ctr.bat.Length() >= blockRows && colexec.NeedSpill(proc) {
+				if err := ctr.spill(proc); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
 }
 
+// spill writes the rows sorted so far to disk as a run.
+func (ctr *container) spill(proc *process.Process) error {
+	if ctr.spiller == nil {
+		if ctr.spiller = colexec.NewSpiller(proc, "order"); ctr.spiller == nil {
+			return nil
+		}
+	}
+	count := ctr.bat.Length()
+	bats := make([]*batch.Batch, 0, (count+blockRows-1)/blockRows)
+	defer func() {
+		for _, bat := range bats {
+			bat.Clean(proc.Mp())
+		}
+	}()
+	flags := makeFlagsOne(blockRows)
+	for i := 0; i < count; i += blockRows {
+		n := count - i
+		if n > blockRows {
+			n = blockRows
+		}
+		bat := batch.NewWithSize(len(ctr.bat.Vecs))
+		bats = append(bats, bat)
+		for j, vec := range ctr.bat.Vecs {
+			bat.Vecs[j] = vector.New(vec.Typ)
+			if err := vector.UnionBatch(bat.Vecs[j], vec, int64(i), n, flags[:n], proc.Mp()); err != nil {
+				return err
+			}
+		}
+		bat.Zs = append(bat.Zs, ctr.bat.Zs[i:i+n]...)
+	}
+	blks, err := ctr.spiller.Write(proc, bats...)
+	if err != nil {
+		return err
+	}
+	ctr.runs = append(ctr.runs, &run{blks: blks})
+	ctr.bat.Clean(proc.Mp())
+	ctr.bat = nil
+	return nil
+}
+
+// merge returns the next batch of the rows of the runs and the rows kept in
+// memory in order, and nil once all of them are returned.
+func (ctr *container) merge(proc *process.Process) (*batch.Batch, error) {
+	if ctr.bat != nil {
+		ctr.runs = append(ctr.runs, &run{bat: ctr.bat})
+		ctr.bat = nil
+	}
+	var rbat *batch.Batch
+	for rbat == nil || rbat.Length() < blockRows {
+		var min *run
+		for _, r := range ctr.runs {
+			if err := r.next(ctr.spiller, proc); err != nil {
+				if rbat != nil {
+					rbat.Clean(proc.Mp())
+				}
+				return nil, err
+			}
+			if r.bat == nil {
+				continue
+			}
+			if min == nil || ctr.compare(r, min) < 0 {
+				min = r
+			}
+		}
+		if min == nil {
+			break
+		}
+		if rbat == nil {
+			rbat = batch.NewWithSize(ctr.n)
+			for i := range rbat.Vecs {
+				rbat.Vecs[i] = vector.New(min.bat.Vecs[i].Typ)
+			}
+		}
+		for i := range rbat.Vecs {
+			if err := vector.UnionOne(rbat.Vecs[i], min.bat.Vecs[i], min.row, proc.Mp()); err != nil {
+				rbat.Clean(proc.Mp())
+				return nil, err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, min.bat.Zs[min.row])
+		min.row++
+	}
+	if rbat != nil {
+		rbat.ExpandNulls()
+	}
+	return rbat, nil
+}
+
+// compare compares the current rows of two runs by the order attributes.
+func (ctr *container) compare(r1, r2 *run) int {
+	for _, pos := range ctr.poses {
+		cmp := ctr.cmps[pos]
+		cmp.Set(0, r1.bat.GetVector(pos))
+		cmp.Set(1, r2.bat.GetVector(pos))
+		if r := cmp.Compare(0, 1, r1.row, r2.row); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// next makes bat the block with the next row of the run, bat is nil once the
+// run is done.
+func (r *run) next(spiller *colexec.Spiller, proc *process.Process) error {
+	var err error
+
+	for {
+		if r.bat != nil {
+			if r.row < int64(r.bat.Length()) {
+				return nil
+			}
+			r.bat.Clean(proc.Mp())
+			r.bat = nil
+		}
+		if len(r.blks) == 0 {
+			return nil
+		}
+		if r.bat, err = spiller.Read(proc, r.blks[0]); err != nil {
+			return err
+		}
+		r.blks = r.blks[1:]
+		r.row = 0
+	}
+}
+
+func (ctr *container) free(proc *process.Process) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(proc.Mp())
+		ctr.bat = nil
+	}
+	for _, r := range ctr.runs {
+		if r.bat != nil {
+			r.bat.Clean(proc.Mp())
+		}
+	}
+	ctr.runs = nil
+	if ctr.spiller != nil {
+		ctr.spiller.Free()
+	}
+}
+
 func (ctr *container) processBatch(bat2 *batch.Batch, proc *process.Process) error {
 	bat1 := ctr.bat
 	rbat := batch.NewWithSize(len(bat1.Vecs))
@@ -17,10 +17,13 @@ package mergeorder
 import (
 	"bytes"
 	"context"
+	"math/rand"
+	"sort"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
@@ -105,6 +108,42 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderSpill(t *testing.T) {
+	tc := newTestCase(testutil.NewMheap(), []bool{false}, []types.Type{{Oid: types.T_int64}}, []colexec.Field{{E: newExpression(0), Type: 0}})
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	for i := range tc.proc.Reg.MergeReceivers {
+		tc.proc.Reg.MergeReceivers[i].Ch <- newSortedBatch(tc.proc, blockRows+100)
+		tc.proc.Reg.MergeReceivers[i].Ch <- newSortedBatch(tc.proc, blockRows+100)
+		tc.proc.Reg.MergeReceivers[i].Ch <- nil
+	}
+	// the query is short of memory, so every batch received is spilled as a run
+	gm := tc.proc.Mp().Gm
+	ballast := gm.Limit * 8 / 10
+	require.NoError(t, tc.proc.Mp().Increase(ballast))
+	var rows []int64
+	for {
+		ok, err := Call(0, tc.proc, tc.arg)
+		require.NoError(t, err)
+		if len(rows) == 0 {
+			require.Equal(t, 4, len(tc.arg.ctr.runs))
+		}
+		if bat := tc.proc.InputBatch(); bat != nil {
+			rows = append(rows, vector.MustTCols[int64](bat.Vecs[0])...)
+			bat.Clean(tc.proc.Mp())
+		}
+		if ok {
+			break
+		}
+	}
+	tc.proc.Mp().Decrease(ballast)
+	require.Equal(t, 4*(blockRows+100), len(rows))
+	require.True(t, sort.SliceIsSorted(rows, func(i, j int) bool { return rows[i] < rows[j] }))
+	entries, err := tc.proc.FileService.List(context.Background(), "LOCAL:spill")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp()))
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -180,3 +219,13 @@ func newExpression(pos int32) *plan.Expr {
 func newBatch(t *testing.T, ds []bool, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
 	return testutil.NewBatch(ts, false, int(rows), proc.Mp())
 }
+
+// the batches received by merge order are sorted already
+func newSortedBatch(proc *process.Process, rows int) *batch.Batch {
+	vs := make([]int64, rows)
+	for i := range vs {
+		vs[i] = rand.Int63()
+	}
+	sort.Slice(vs, func(i, j int) bool { return vs[i] < vs[j] })
+	return testutil.NewBatchWithVectors([]*vector.Vector{testutil.NewVector(rows, types.T_int64.ToType(), proc.Mp(), false, vs)}, nil)
+}
@@ -26,6 +26,10 @@ const (
 	End
 )
 
+// blockRows is the number of rows of a block of a spilled run, and of a batch
+// returned by the merge of the runs.
+const blockRows = 8192
+
 type container struct {
 	n     int // result vector number
 	state int
@@ -33,6 +37,18 @@ type container struct {
 	cmps  []compare.Compare // compare structures used to do sort work for attrs
 
 	bat *batch.Batch // bat store the result of merge-order
+
+	// spiller writes the sorted runs to disk once the memory is short, the
+	// runs and the rows kept in memory are merged at the end
+	spiller *colexec.Spiller
+	runs    []*run
+}
+
+// run is a sorted source of the external merge sort, which is read a block at a time.
+type run struct {
+	blks []colexec.SpillBlock
+	bat  *batch.Batch // the block being merged
+	row  int64        // the next row of bat
 }
 
 type Argument struct {
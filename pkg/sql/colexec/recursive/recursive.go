@@ -0,0 +1,218 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursive
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	if ap.UnionAll {
+		buf.WriteString(" recursive union all ")
+	} else {
+		buf.WriteString(" recursive union ")
+	}
+}
+
+func Prepare(proc *process.Process, arg any) error {
+	var err error
+
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	if !ap.UnionAll {
+		ap.ctr.inserted = make([]uint8, hashmap.UnitLimit)
+		if ap.ctr.hashTable, err = hashmap.NewStrMap(true, 0, 0, proc.Mp()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Call(idx int, proc *process.Process, arg any) (bool, error) {
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Anchor:
+			bat := ctr.receive(proc)
+			if bat == nil {
+				ctr.state = Recurse
+				continue
+			}
+			anal.Input(bat)
+			ok, err := ctr.emit(bat, ap, proc, anal)
+			if err != nil {
+				ctr.state = End
+				ctr.free(proc)
+				return true, err
+			}
+			if ok {
+				return false, nil
+			}
+		case Recurse:
+			if ctr.bat == nil {
+				ctr.state = End
+				continue
+			}
+			if ctr.depth >= ap.MaxDepth {
+				ctr.state = End
+				ctr.free(proc)
+				return true, errors.New(errno.ProgramLimitExceeded, fmt.Sprintf("recursive query aborted after %d iterations, try increasing @@cte_max_recursion_depth to a larger value", ctr.depth+1))
+			}
+			ctr.depth++
+			workTable := ctr.bat
+			ctr.bat = nil
+			bat, err := ap.Step.Run(proc, workTable)
+			workTable.Clean(proc.Mp())
+			if err != nil {
+				ctr.state = End
+				ctr.free(proc)
+				return true, err
+			}
+			if bat == nil {
+				continue
+			}
+			anal.Input(bat)
+			ok, err := ctr.emit(bat, ap, proc, anal)
+			if err != nil {
+				ctr.state = End
+				ctr.free(proc)
+				return true, err
+			}
+			if ok {
+				return false, nil
+			}
+		default:
+			ctr.free(proc)
+			proc.SetInputBatch(nil)
+			return true, nil
+		}
+	}
+}
+
+// receive returns the next batch of the anchor part, and nil if there is no more.
+func (ctr *container) receive(proc *process.Process) *batch.Batch {
+	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
+		reg := proc.Reg.MergeReceivers[i]
+		bat := <-reg.Ch
+		if bat == nil {
+			proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
+			i--
+			continue
+		}
+		if bat.Length() == 0 {
+			i--
+			continue
+		}
+		return bat
+	}
+	return nil
+}
+
+// emit returns the rows of the batch not returned before, and keeps them as the work
+// table of the next run. It returns false if there is no such row.
+func (ctr *container) emit(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze) (bool, error) {
+	if !ap.UnionAll {
+		rbat, err := ctr.dedup(bat, proc)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return false, err
+		}
+		bat = rbat
+	}
+	if bat.Length() == 0 {
+		bat.Clean(proc.Mp())
+		return false, nil
+	}
+	if ctr.bat == nil {
+		ctr.bat = batch.NewWithSize(len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		}
+	}
+	if _, err := ctr.bat.Append(proc.Mp(), bat); err != nil {
+		bat.Clean(proc.Mp())
+		return false, err
+	}
+	anal.Output(bat)
+	proc.SetInputBatch(bat)
+	return true, nil
+}
+
+// dedup returns the rows of the batch which are not in the hash table, and adds them to it.
+func (ctr *container) dedup(bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.New(vec.Typ)
+	}
+	count := bat.Length()
+	itr := ctr.hashTable.NewIterator()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := ctr.hashTable.GroupCount()
+		vs, _, err := itr.Insert(i, n, bat.Vecs)
+		if err != nil {
+			rbat.Clean(proc.Mp())
+			return nil, err
+		}
+		cnt := 0
+		for j, v := range vs {
+			ctr.inserted[j] = 0
+			// the row is new only the first time its group is seen
+			if v > rows {
+				rows++
+				ctr.inserted[j] = 1
+				rbat.Zs = append(rbat.Zs, 1)
+				cnt++
+			}
+		}
+		if cnt == 0 {
+			continue
+		}
+		for pos := range bat.Vecs {
+			if err := vector.UnionBatch(rbat.Vecs[pos], bat.Vecs[pos], int64(i), cnt, ctr.inserted[:n], proc.Mp()); err != nil {
+				rbat.Clean(proc.Mp())
+				return nil, err
+			}
+		}
+	}
+	return rbat, nil
+}
+
+func (ctr *container) free(proc *process.Process) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(proc.Mp())
+		ctr.bat = nil
+	}
+	if ctr.hashTable != nil {
+		ctr.hashTable.Free()
+		ctr.hashTable = nil
+	}
+}
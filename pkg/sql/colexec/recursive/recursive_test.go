@@ -0,0 +1,134 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursive
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+type recursiveTestCase struct {
+	proc   *process.Process
+	arg    *Argument
+	cancel context.CancelFunc
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{UnionAll: true}, buf)
+	String(&Argument{}, buf)
+}
+
+func TestRecursive(t *testing.T) {
+	proc := testutil.NewProcess()
+	// the anchor part returns {1, 1, 3}, the recursive part returns n % 3 + 1 for every n
+	// of the work table, so union all never stops and union returns {1, 3, 2}
+	c := newRecursiveTestCase(proc, false, 10, []*batch.Batch{
+		newBatch(proc, []int64{1, 1}),
+		newBatch(proc, []int64{3}),
+	})
+	require.NoError(t, Prepare(c.proc, c.arg))
+	rows, err := run(c)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 3, 2}, rows)
+	require.Equal(t, int64(0), mheap.Size(c.proc.Mp()))
+
+	c = newRecursiveTestCase(proc, true, 10, []*batch.Batch{
+		newBatch(proc, []int64{1, 1}),
+		newBatch(proc, []int64{3}),
+	})
+	require.NoError(t, Prepare(c.proc, c.arg))
+	rows, err = run(c)
+	require.Error(t, err)
+	require.Equal(t, 3*11, len(rows))
+	require.Equal(t, int64(0), mheap.Size(c.proc.Mp()))
+}
+
+func TestRecursiveEmptyAnchor(t *testing.T) {
+	proc := testutil.NewProcess()
+	c := newRecursiveTestCase(proc, true, 10, nil)
+	require.NoError(t, Prepare(c.proc, c.arg))
+	rows, err := run(c)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rows))
+	require.Equal(t, int64(0), mheap.Size(c.proc.Mp()))
+}
+
+func run(c recursiveTestCase) ([]int64, error) {
+	var rows []int64
+	for {
+		end, err := Call(0, c.proc, c.arg)
+		if err != nil || end {
+			return rows, err
+		}
+		if bat := c.proc.InputBatch(); bat != nil && len(bat.Zs) != 0 {
+			rows = append(rows, vector.MustTCols[int64](bat.Vecs[0])...)
+			bat.Clean(c.proc.Mp())
+		}
+	}
+}
+
+// testStep returns n % 3 + 1 for every n of the work table.
+type testStep struct{}
+
+func (testStep) Run(proc *process.Process, workTable *batch.Batch) (*batch.Batch, error) {
+	vs := vector.MustTCols[int64](workTable.Vecs[0])
+	rs := make([]int64, len(vs))
+	for i, v := range vs {
+		rs[i] = v%3 + 1
+	}
+	return newBatch(proc, rs), nil
+}
+
+func newBatch(proc *process.Process, vs []int64) *batch.Batch {
+	return testutil.NewBatchWithVectors(
+		[]*vector.Vector{
+			testutil.NewVector(len(vs), types.T_int64.ToType(), proc.Mp(), false, vs),
+		}, nil)
+}
+
+func newRecursiveTestCase(proc *process.Process, unionAll bool, maxDepth int64, anchor []*batch.Batch) recursiveTestCase {
+	ctx, cancel := context.WithCancel(context.Background())
+	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
+	{
+		c := make(chan *batch.Batch, len(anchor)+1)
+		for i := range anchor {
+			c <- anchor[i]
+		}
+		c <- nil
+		proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+			Ctx: ctx,
+			Ch:  c,
+		}
+	}
+	return recursiveTestCase{
+		proc: proc,
+		arg: &Argument{
+			UnionAll: unionAll,
+			MaxDepth: maxDepth,
+			Step:     testStep{},
+		},
+		cancel: cancel,
+	}
+}
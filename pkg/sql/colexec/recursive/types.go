@@ -0,0 +1,60 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursive
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	Anchor = iota
+	Recurse
+	End
+)
+
+type container struct {
+	state int
+
+	// depth is the number of runs of the recursive part
+	depth int64
+
+	// bat is the rows returned by the last run, the work table of the next run
+	bat *batch.Batch
+
+	// hashTable has the rows returned so far if they are deduplicated
+	hashTable *hashmap.StrHashMap
+	inserted  []uint8
+}
+
+// Argument of the recursive CTE, it returns the rows of the anchor part, which
+// are received from the merge receivers, and the rows returned by every run of the
+// recursive part until a run returns nothing.
+type Argument struct {
+	ctr *container
+	// UnionAll is false if the rows returned before are not returned again
+	UnionAll bool
+	// MaxDepth is the limit of the runs of the recursive part
+	MaxDepth int64
+	// Step is the recursive part
+	Step Step
+}
+
+// Step is the recursive part of a recursive CTE.
+type Step interface {
+	// Run runs the recursive part once over the work table, and returns its rows.
+	Run(proc *process.Process, workTable *batch.Batch) (*batch.Batch, error)
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package right
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/bitmap"
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+const (
+	Build = iota
+	Probe
+	Finalize
+	ProbeSpilled
+	End
+)
+
+type evalVector struct {
+	needFree bool
+	vec      *vector.Vector
+}
+
+type container struct {
+	state int
+
+	inBuckets []uint8
+
+	bat *batch.Batch
+
+	evecs []evalVector
+	vecs  []*vector.Vector
+
+	// matched is the build rows matched by this pipeline
+	matched *bitmap.Bitmap
+
+	mp *hashmap.JoinMap
+
+	// sj joins the build rows spilled to disk by the hash build with the
+	// probe rows, which are spilled too, partition by partition
+	sj *colexec.SpilledJoin
+}
+
+// Argument of the right join, the probe side is the left child and the
+// build side is the right child whose unmatched rows are kept.
+type Argument struct {
+	ctr     *container
+	Ibucket uint64
+	Nbucket uint64
+	Result  []colexec.ResultPos
+	// Typs is the types of the build side
+	Typs []types.Type
+	// LeftTypes is the types of the probe side
+	LeftTypes  []types.Type
+	Cond       *plan.Expr
+	Conditions [][]*plan.Expr
+	// Matched is shared by the parallel probe pipelines of the join
+	Matched *colexec.MatchedRows
+}
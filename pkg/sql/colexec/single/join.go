@@ -64,6 +64,10 @@ func Call(idx int, proc *process.Process, arg any) (bool, error) {
 		case Probe:
 			bat := <-proc.Reg.MergeReceivers[0].Ch
 			if bat == nil {
+				if ctr.sj != nil {
+					ctr.state = ProbeSpilled
+					continue
+				}
 				ctr.state = End
 				if ctr.mp != nil {
 					ctr.mp.Free()
@@ -76,6 +80,14 @@ func Call(idx int, proc *process.Process, arg any) (bool, error) {
 			if bat.Length() == 0 {
 				continue
 			}
+			if ctr.sj != nil {
+				if err := ctr.sj.Spill(proc, bat, anal); err != nil {
+					ctr.state = End
+					ctr.freeSpilled(proc)
+					return true, err
+				}
+				continue
+			}
 			if ctr.bat.Length() == 0 {
 				if err := ctr.emptyProbe(bat, ap, proc, anal); err != nil {
 					ctr.state = End
@@ -97,6 +109,18 @@ func Call(idx int, proc *process.Process, arg any) (bool, error) {
 				}
 			}
 			return false, nil
+		case ProbeSpilled:
+			ok, err := ctr.probeSpilled(ap, proc, anal)
+			if err != nil || !ok {
+				ctr.state = End
+				ctr.freeSpilled(proc)
+				if err != nil {
+					proc.SetInputBatch(nil)
+					return true, err
+				}
+				continue
+			}
+			return false, nil
 		default:
 			proc.SetInputBatch(nil)
 			return true, nil
@@ -109,10 +133,33 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 	if bat != nil {
 		ctr.bat = bat
 		ctr.mp = bat.Ht.(*hashmap.JoinMap).Dup()
+		if ctr.mp.Spilled() != nil {
+			ctr.sj = colexec.NewSpilledJoin(ctr.mp, ap.Typs, ap.Conditions, ap.Ibucket, ap.Nbucket, colexec.SpillKeepProbe)
+			ctr.bat.Clean(proc.GetMheap())
+			ctr.bat, ctr.mp = nil, nil
+		}
 	}
 	return nil
 }
 
+// probeSpilled probes the next probe batch of the spilled partitions with the
+// build rows of its partition, it returns false once all of them are probed.
+func (ctr *container) probeSpilled(ap *Argument, proc *process.Process, anal process.Analyze) (bool, error) {
+	return ctr.sj.Probe(proc, func(bat *batch.Batch, mp *hashmap.JoinMap) {
+		ctr.bat, ctr.mp = bat, mp
+	}, func(bat *batch.Batch) error {
+		return ctr.probe(bat, ap, proc, anal)
+	}, nil)
+}
+
+// freeSpilled removes the rows spilled, the build rows of the partition probed
+// are freed with them.
+func (ctr *container) freeSpilled(proc *process.Process) {
+	ctr.sj.Free(proc)
+	ctr.sj = nil
+	ctr.bat, ctr.mp = nil, nil
+}
+
 func (ctr *container) emptyProbe(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze) error {
 	defer bat.Clean(proc.GetMheap())
 	anal.Input(bat)
@@ -0,0 +1,336 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"fmt"
+	"unsafe"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/util/metric"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// SpillPartitions is the number of partitions the rows of a spilling hash
+	// operator are split into by their keys.
+	SpillPartitions = 8
+
+	// MaxSpillLevel is the level of the deepest partitions, the rows of a partition
+	// are split again into partitions of the next level if they are still too many
+	// to be held in memory, but the partitions of MaxSpillLevel are not split.
+	MaxSpillLevel = 4
+
+	// an operator spills once the memory used by its query reaches
+	// spillPercent percent of the limit of the guest mmu.
+	spillPercent = 80
+)
+
+// NeedSpill returns true if the memory used by the query is close to its limit,
+// so the operators holding rows should move them to disk.
+func NeedSpill(proc *process.Process) bool {
+	gm := proc.Mp().Gm
+	if gm == nil || gm.Limit <= 0 {
+		return false
+	}
+	return gm.Size()*100 >= gm.Limit*spillPercent
+}
+
+// fitInMemory returns true if size bytes more can be held in memory before the
+// query needs to spill.
+func fitInMemory(proc *process.Process, size int64) bool {
+	gm := proc.Mp().Gm
+	if gm == nil || gm.Limit <= 0 {
+		return true
+	}
+	return (gm.Size()+size)*100 < gm.Limit*spillPercent
+}
+
+// SpillBlock is a batch written to disk by a Spiller.
+type SpillBlock struct {
+	path   string
+	offset int64
+	size   int64
+}
+
+// Spiller writes the batches an operator can not keep in memory to the local
+// file service, and reads them back. The files are write-once, so every write
+// is a new file, they are all removed by Free.
+type Spiller struct {
+	// typ is the operator spilling, it is the label of the metrics
+	typ   string
+	dir   string
+	fs    fileservice.FileService
+	files []string
+}
+
+// NewSpiller returns nil if there is no local file service to spill to.
+func NewSpiller(proc *process.Process, typ string) *Spiller {
+	if proc.FileService == nil {
+		return nil
+	}
+	fs, err := fileservice.Get[fileservice.FileService](proc.FileService, "LOCAL")
+	if err != nil {
+		return nil
+	}
+	return &Spiller{
+		typ: typ,
+		fs:  fs,
+		dir: fmt.Sprintf("spill/%s", uuid.New().String()),
+	}
+}
+
+// Write writes the batches, which should not be empty, to a new file and
+// returns a block for each of them.
+func (s *Spiller) Write(proc *process.Process, bats ...*batch.Batch) ([]SpillBlock, error) {
+	path := fmt.Sprintf("%s/%d", s.dir, len(s.files))
+	blks := make([]SpillBlock, len(bats))
+	entries := make([]fileservice.IOEntry, len(bats))
+	offset := int64(0)
+	for i, bat := range bats {
+		data, err := bat.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = fileservice.IOEntry{
+			Offset: offset,
+			Size:   int64(len(data)),
+			Data:   data,
+		}
+		blks[i] = SpillBlock{
+			path:   path,
+			offset: offset,
+			size:   int64(len(data)),
+		}
+		offset += int64(len(data))
+	}
+	if err := s.fs.Write(proc.Ctx, fileservice.IOVector{
+		FilePath: path,
+		Entries:  entries,
+	}); err != nil {
+		return nil, err
+	}
+	s.files = append(s.files, path)
+	metric.SpillBytesCounter(s.typ).Add(float64(offset))
+	return blks, nil
+}
+
+// Read returns the batch of the block, its vectors are not allocated from the
+// mheap of the process, so they should not be appended to.
+func (s *Spiller) Read(proc *process.Process, blk SpillBlock) (*batch.Batch, error) {
+	vec := &fileservice.IOVector{
+		FilePath: blk.path,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: blk.offset,
+				Size:   blk.size,
+			},
+		},
+	}
+	if err := s.fs.Read(proc.Ctx, vec); err != nil {
+		return nil, err
+	}
+	bat := new(batch.Batch)
+	if err := bat.UnmarshalBinary(vec.Entries[0].Data); err != nil {
+		return nil, err
+	}
+	return bat, nil
+}
+
+// Free removes the files written, it is also called once the query is canceled,
+// so it does not use the context of the process.
+func (s *Spiller) Free() {
+	for _, path := range s.files {
+		_ = s.fs.Delete(context.Background(), path)
+	}
+	s.files = nil
+}
+
+// SpilledPartitions are the rows of a hash operator spilled to disk, they are
+// split into SpillPartitions partitions by their keys, rows with the same keys
+// are always in the same partition. The partitions of a level are split by other
+// bits of the hash of the keys than the partitions of the level before, so the
+// rows of one partition are split again by the partitions of the next level.
+type SpilledPartitions struct {
+	level   int
+	spiller *Spiller
+	blks    [SpillPartitions][]SpillBlock
+	rows    [SpillPartitions]int64
+	parts   []int
+	flags   []uint8
+	sels    [SpillPartitions][]int64
+}
+
+// NewSpilledPartitions returns nil if there is no local file service to spill to.
+func NewSpilledPartitions(proc *process.Process, typ string, level int) *SpilledPartitions {
+	spiller := NewSpiller(proc, typ)
+	if spiller == nil {
+		return nil
+	}
+	metric.SpillPartitionsCounter(typ).Add(SpillPartitions)
+	return &SpilledPartitions{
+		level:   level,
+		spiller: spiller,
+	}
+}
+
+// Level returns the level of the partitions.
+func (sp *SpilledPartitions) Level() int {
+	return sp.level
+}
+
+// Spill spills the rows of sels of the batch to their partitions, all the rows
+// if sels is nil, vecs are the keys of the rows.
+func (sp *SpilledPartitions) Spill(proc *process.Process, bat *batch.Batch, vecs []*vector.Vector, sels []int64) error {
+	count := bat.Length()
+	sp.partition(proc, vecs, count)
+	for i := range sp.sels {
+		sp.sels[i] = sp.sels[i][:0]
+	}
+	if sels == nil {
+		for k, p := range sp.parts {
+			sp.sels[p] = append(sp.sels[p], int64(k))
+		}
+	} else {
+		for _, sel := range sels {
+			p := sp.parts[sel]
+			sp.sels[p] = append(sp.sels[p], sel)
+		}
+	}
+	if cap(sp.flags) < count {
+		sp.flags = make([]uint8, count)
+	}
+	flags := sp.flags[:count]
+	var bats []*batch.Batch
+	var parts []int
+	defer func() {
+		for _, b := range bats {
+			b.Clean(proc.Mp())
+		}
+	}()
+	for i := range sp.sels {
+		if len(sp.sels[i]) == 0 {
+			continue
+		}
+		for k := range flags {
+			flags[k] = 0
+		}
+		for _, sel := range sp.sels[i] {
+			flags[sel] = 1
+		}
+		b := batch.NewWithSize(len(bat.Vecs))
+		bats = append(bats, b)
+		parts = append(parts, i)
+		for j, vec := range bat.Vecs {
+			b.Vecs[j] = vector.New(vec.Typ)
+			if err := vector.UnionBatch(b.Vecs[j], vec, 0, len(sp.sels[i]), flags, proc.Mp()); err != nil {
+				return err
+			}
+		}
+		for _, sel := range sp.sels[i] {
+			b.Zs = append(b.Zs, bat.Zs[sel])
+		}
+		sp.rows[i] += int64(len(sp.sels[i]))
+	}
+	if len(bats) == 0 {
+		return nil
+	}
+	blks, err := sp.spiller.Write(proc, bats...)
+	if err != nil {
+		return err
+	}
+	for i, blk := range blks {
+		sp.blks[parts[i]] = append(sp.blks[parts[i]], blk)
+	}
+	return nil
+}
+
+// Blocks returns the blocks spilled of the partition.
+func (sp *SpilledPartitions) Blocks(part int) []SpillBlock {
+	return sp.blks[part]
+}
+
+// Rows returns the number of rows spilled to the partition.
+func (sp *SpilledPartitions) Rows(part int) int64 {
+	return sp.rows[part]
+}
+
+// Read returns the batch of a block of the partitions.
+func (sp *SpilledPartitions) Read(proc *process.Process, blk SpillBlock) (*batch.Batch, error) {
+	return sp.spiller.Read(proc, blk)
+}
+
+// Free removes the partitions from disk.
+func (sp *SpilledPartitions) Free() {
+	sp.spiller.Free()
+	for i := range sp.blks {
+		sp.blks[i] = nil
+		sp.rows[i] = 0
+	}
+}
+
+// partition computes the partition of every row from the bits of the hash of its
+// keys of the level of the partitions, a constant key is expanded to the rows.
+func (sp *SpilledPartitions) partition(proc *process.Process, vecs []*vector.Vector, count int) {
+	if cap(sp.parts) < count {
+		sp.parts = make([]int, count)
+	}
+	sp.parts = sp.parts[:count]
+	hs := make([]uint64, count)
+	for _, vec := range vecs {
+		if vec.IsScalarNull() {
+			for i := range hs {
+				hs[i] *= 31
+			}
+			continue
+		}
+		if vec.IsScalar() {
+			vec.ConstExpand(proc.Mp())
+		}
+		nsp := vec.GetNulls()
+		if vec.GetType().IsFixedLen() {
+			sz := vec.GetType().TypeSize()
+			data := unsafe.Slice((*byte)(vector.GetPtrAt(vec, 0)), count*sz)
+			for i := range hs {
+				hs[i] *= 31
+				if !nsp.Contains(uint64(i)) {
+					hs[i] += xxhash.Sum64(data[i*sz : (i+1)*sz])
+				}
+			}
+		} else {
+			area := vec.GetArea()
+			vs := vector.MustTCols[types.Varlena](vec)
+			for i := range hs {
+				hs[i] *= 31
+				if !nsp.Contains(uint64(i)) {
+					hs[i] += xxhash.Sum64(vs[i].GetByteSlice(area))
+				}
+			}
+		}
+	}
+	div := uint64(1)
+	for i := 0; i < sp.level; i++ {
+		div *= SpillPartitions
+	}
+	for i, h := range hs {
+		sp.parts[i] = int(h / div % SpillPartitions)
+	}
+}
@@ -0,0 +1,568 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// the flags of a SpilledJoin
+const (
+	// SpillKeepProbe is set if the join returns the probe rows matching nothing,
+	// so the partitions without build rows are joined too
+	SpillKeepProbe = 1 << iota
+	// SpillKeepBuild is set if the join returns the build rows matching nothing,
+	// so the partitions without probe rows are joined too
+	SpillKeepBuild
+	// SpillApartNulls is set if the probe rows with a null key are not spilled to
+	// the partitions, but kept apart for the join to check them with all the build rows
+	SpillApartNulls
+)
+
+// SpilledJoin joins the rows of a hash join spilled to disk a partition at a time.
+// The build rows are spilled by the hash build, and the probe rows are spilled by
+// the join to the partitions of their keys, then the build rows of a partition are
+// loaded with a map of them, which the probe rows of the partition are probed with.
+// The rows of a partition whose build rows are still too many to be held in memory
+// are split again into the partitions of the next level.
+type SpilledJoin struct {
+	flag    int
+	ibucket uint64
+	nbucket uint64
+	typs    []types.Type
+	conds   [][]*plan.Expr
+
+	// mp is the map of the hash build, the build rows spilled are removed with it
+	mp    *hashmap.JoinMap
+	build *SpilledPartitions
+	// probe are the probe rows spilled by the join, and by the other pipelines of it
+	probe []*SpilledPartitions
+	// nulls are the blocks of the probe rows with a null key
+	nulls   []spilledBlock
+	spiller *Spiller
+	// sps are the partitions split again, they are removed once the join is done
+	sps     []*SpilledPartitions
+	parts   []*joinPartition
+	started bool
+
+	// bat is the build rows of the partition joined and bmp is their map,
+	// blks are the probe blocks of the partition not probed yet, loaded is
+	// true until all of them are probed
+	bat    *batch.Batch
+	bmp    *hashmap.JoinMap
+	blks   []spilledBlock
+	loaded bool
+
+	vecs  []*vector.Vector
+	frees []bool
+}
+
+// spilledBlock is a block and the partitions it is spilled to.
+type spilledBlock struct {
+	sp  *SpilledPartitions
+	blk SpillBlock
+}
+
+// joinPartition is the build rows and the probe rows of a partition, final is
+// true if the keys of the build rows can not be split any more.
+type joinPartition struct {
+	level int
+	final bool
+	rows  int64
+	build []spilledBlock
+	probe []spilledBlock
+}
+
+// NewSpilledJoin returns the join of the build rows spilled by the hash build of
+// the map, typs are the types of the build rows and conds are the join keys of
+// both sides. The map is freed by the join once it is done.
+//
+// A hash join whose build rows are all spilled spills its probe rows too, and joins
+// them with the build rows partition by partition through Probe once its probe
+// rows are all spilled.
+func NewSpilledJoin(mp *hashmap.JoinMap, typs []types.Type, conds [][]*plan.Expr, ibucket, nbucket uint64, flag int) *SpilledJoin {
+	return &SpilledJoin{
+		flag:    flag,
+		ibucket: ibucket,
+		nbucket: nbucket,
+		typs:    typs,
+		conds:   conds,
+		mp:      mp,
+		build:   mp.Spilled().(*SpilledPartitions),
+		vecs:    make([]*vector.Vector, len(conds[0])),
+		frees:   make([]bool, len(conds[0])),
+	}
+}
+
+// Rows returns the number of build rows.
+func (sj *SpilledJoin) Rows() int64 {
+	rows := int64(0)
+	for i := 0; i < SpillPartitions; i++ {
+		rows += sj.build.Rows(i)
+	}
+	return rows
+}
+
+// Spill spills the rows of the probe batch to the partitions of their keys, the
+// batch is freed.
+func (sj *SpilledJoin) Spill(proc *process.Process, bat *batch.Batch, anal process.Analyze) error {
+	anal.Input(bat)
+	defer bat.Clean(proc.Mp())
+	if len(sj.probe) == 0 {
+		sp := NewSpilledPartitions(proc, "join", 0)
+		if sp == nil {
+			return errors.New(errno.InternalError, "no local file service to spill the join to")
+		}
+		sj.probe = append(sj.probe, sp)
+	}
+	if err := sj.evalKeys(proc, bat, sj.conds[0]); err != nil {
+		return err
+	}
+	defer sj.freeKeys(proc)
+	if sj.flag&SpillApartNulls == 0 {
+		return sj.probe[0].Spill(proc, bat, sj.vecs, nil)
+	}
+	var sels, nullSels []int64
+	for i := 0; i < bat.Length(); i++ {
+		if sj.hasNullKey(i) {
+			nullSels = append(nullSels, int64(i))
+		} else {
+			sels = append(sels, int64(i))
+		}
+	}
+	if len(sels) > 0 {
+		if err := sj.probe[0].Spill(proc, bat, sj.vecs, sels); err != nil {
+			return err
+		}
+	}
+	if len(nullSels) == 0 {
+		return nil
+	}
+	if sj.spiller == nil {
+		if sj.spiller = NewSpiller(proc, "join"); sj.spiller == nil {
+			return errors.New(errno.InternalError, "no local file service to spill the join to")
+		}
+	}
+	nbat, err := copyRows(proc, bat, nullSels)
+	if err != nil {
+		return err
+	}
+	defer nbat.Clean(proc.Mp())
+	blks, err := sj.spiller.Write(proc, nbat)
+	if err != nil {
+		return err
+	}
+	sj.nulls = append(sj.nulls, spilledBlock{blk: blks[0]})
+	return nil
+}
+
+// TakeProbe returns the probe rows spilled by the join, and they are no longer
+// removed by it.
+func (sj *SpilledJoin) TakeProbe() *SpilledPartitions {
+	if len(sj.probe) == 0 {
+		return nil
+	}
+	sp := sj.probe[0]
+	sj.probe = sj.probe[1:]
+	return sp
+}
+
+// AddProbe adds the probe rows spilled by the other pipelines of the join, they
+// are removed by the join once it is done.
+func (sj *SpilledJoin) AddProbe(sps ...*SpilledPartitions) {
+	sj.probe = append(sj.probe, sps...)
+}
+
+// NextPartition frees the partition joined, and loads the build rows of the next
+// partition with their map. It returns false once all the partitions are joined.
+func (sj *SpilledJoin) NextPartition(proc *process.Process) (bool, error) {
+	sj.freePartition(proc)
+	if !sj.started {
+		sj.started = true
+		for i := 0; i < SpillPartitions; i++ {
+			part := &joinPartition{
+				rows:  sj.build.Rows(i),
+				build: blocksOf(sj.build, i),
+			}
+			for _, sp := range sj.probe {
+				part.probe = append(part.probe, blocksOf(sp, i)...)
+			}
+			sj.parts = append(sj.parts, part)
+		}
+	}
+	for len(sj.parts) > 0 {
+		part := sj.parts[0]
+		sj.parts = sj.parts[1:]
+		if len(part.probe) == 0 && (len(part.build) == 0 || sj.flag&SpillKeepBuild == 0) {
+			continue
+		}
+		if len(part.build) == 0 && sj.flag&SpillKeepProbe == 0 {
+			continue
+		}
+		// the partitions of a build row at most are never split, they are small anyway
+		if !part.final && part.rows > 1 && part.level < MaxSpillLevel && !fitInMemory(proc, part.size()) {
+			if err := sj.splitPartition(proc, part); err != nil {
+				return false, err
+			}
+			continue
+		}
+		if err := sj.buildPartition(proc, part); err != nil {
+			return false, err
+		}
+		sj.blks = part.probe
+		return true, nil
+	}
+	return false, nil
+}
+
+// Probe probes the next probe batch of the partitions with probe, it returns false
+// once all the partitions are probed. load is called with the build rows of a
+// partition and their map before its probe batches are probed, and done, if not
+// nil, is called once all of them are probed, the next partition is loaded unless
+// it returns true.
+func (sj *SpilledJoin) Probe(proc *process.Process, load func(*batch.Batch, *hashmap.JoinMap),
+	probe func(*batch.Batch) error, done func() (bool, error)) (bool, error) {
+	for {
+		if !sj.loaded {
+			ok, err := sj.NextPartition(proc)
+			if err != nil || !ok {
+				return false, err
+			}
+			sj.loaded = true
+			load(sj.bat, sj.bmp)
+		}
+		bat, err := sj.NextProbe(proc)
+		if err != nil {
+			return false, err
+		}
+		if bat == nil {
+			sj.loaded = false
+			if done == nil {
+				continue
+			}
+			if ok, err := done(); err != nil || ok {
+				return ok, err
+			}
+			continue
+		}
+		if err := probe(bat); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+}
+
+// Batch returns the build rows of the partition joined.
+func (sj *SpilledJoin) Batch() *batch.Batch {
+	return sj.bat
+}
+
+// Map returns the map of the build rows of the partition joined.
+func (sj *SpilledJoin) Map() *hashmap.JoinMap {
+	return sj.bmp
+}
+
+// NextProbe returns the next probe batch of the partition joined, and nil once
+// all of them are returned.
+func (sj *SpilledJoin) NextProbe(proc *process.Process) (*batch.Batch, error) {
+	if len(sj.blks) == 0 {
+		return nil, nil
+	}
+	blk := sj.blks[0]
+	sj.blks = sj.blks[1:]
+	return blk.sp.Read(proc, blk.blk)
+}
+
+// NextNullProbe returns the next batch of the probe rows with a null key, and nil
+// once all of them are returned.
+func (sj *SpilledJoin) NextNullProbe(proc *process.Process) (*batch.Batch, error) {
+	if len(sj.nulls) == 0 {
+		return nil, nil
+	}
+	blk := sj.nulls[0]
+	sj.nulls = sj.nulls[1:]
+	return sj.spiller.Read(proc, blk.blk)
+}
+
+// ReadBuild calls fn with every block of the build rows of all the partitions.
+func (sj *SpilledJoin) ReadBuild(proc *process.Process, fn func(*batch.Batch) error) error {
+	for i := 0; i < SpillPartitions; i++ {
+		for _, blk := range sj.build.Blocks(i) {
+			bat, err := sj.build.Read(proc, blk)
+			if err != nil {
+				return err
+			}
+			err = fn(bat)
+			bat.Clean(proc.Mp())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// NullBuild returns the build rows with a null key of all the partitions.
+func (sj *SpilledJoin) NullBuild(proc *process.Process) (*batch.Batch, error) {
+	rbat := batch.NewWithSize(len(sj.typs))
+	for i, typ := range sj.typs {
+		rbat.Vecs[i] = vector.New(typ)
+	}
+	if err := sj.ReadBuild(proc, func(bat *batch.Batch) error {
+		if err := sj.evalKeys(proc, bat, sj.conds[1]); err != nil {
+			return err
+		}
+		defer sj.freeKeys(proc)
+		for i := 0; i < bat.Length(); i++ {
+			if !sj.hasNullKey(i) {
+				continue
+			}
+			for j, vec := range bat.Vecs {
+				if err := vector.UnionOne(rbat.Vecs[j], vec, int64(i), proc.Mp()); err != nil {
+					return err
+				}
+			}
+			rbat.Zs = append(rbat.Zs, bat.Zs[i])
+		}
+		return nil
+	}); err != nil {
+		rbat.Clean(proc.Mp())
+		return nil, err
+	}
+	return rbat, nil
+}
+
+// Free removes all the rows spilled of the join, and frees the map of the hash build.
+func (sj *SpilledJoin) Free(proc *process.Process) {
+	sj.freePartition(proc)
+	for _, sp := range sj.probe {
+		sp.Free()
+	}
+	for _, sp := range sj.sps {
+		sp.Free()
+	}
+	if sj.spiller != nil {
+		sj.spiller.Free()
+	}
+	sj.probe, sj.sps, sj.parts, sj.nulls, sj.blks = nil, nil, nil, nil, nil
+	if sj.mp != nil {
+		sj.mp.Free()
+		sj.mp = nil
+	}
+}
+
+// splitPartition spills the build rows and the probe rows of the partition to the
+// partitions of the next level, which are joined before the other partitions left.
+func (sj *SpilledJoin) splitPartition(proc *process.Process, part *joinPartition) error {
+	build := NewSpilledPartitions(proc, "join", part.level+1)
+	probe := NewSpilledPartitions(proc, "join", part.level+1)
+	if build == nil || probe == nil {
+		return errors.New(errno.InternalError, "no local file service to spill the join to")
+	}
+	sj.sps = append(sj.sps, build, probe)
+	if err := sj.spillBlocks(proc, build, part.build, sj.conds[1]); err != nil {
+		return err
+	}
+	if err := sj.spillBlocks(proc, probe, part.probe, sj.conds[0]); err != nil {
+		return err
+	}
+	parts := make([]*joinPartition, 0, SpillPartitions+len(sj.parts))
+	for i := 0; i < SpillPartitions; i++ {
+		parts = append(parts, &joinPartition{
+			level: part.level + 1,
+			// all the build rows are in the same partition again, so they are
+			// likely to be of the same keys, which are never split
+			final: build.Rows(i) == part.rows,
+			rows:  build.Rows(i),
+			build: blocksOf(build, i),
+			probe: blocksOf(probe, i),
+		})
+	}
+	sj.parts = append(parts, sj.parts...)
+	return nil
+}
+
+func (sj *SpilledJoin) spillBlocks(proc *process.Process, sp *SpilledPartitions, blks []spilledBlock, conds []*plan.Expr) error {
+	for _, blk := range blks {
+		bat, err := blk.sp.Read(proc, blk.blk)
+		if err != nil {
+			return err
+		}
+		if err = sj.evalKeys(proc, bat, conds); err == nil {
+			err = sp.Spill(proc, bat, sj.vecs, nil)
+			sj.freeKeys(proc)
+		}
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildPartition loads the build rows of the partition, and builds their map.
+func (sj *SpilledJoin) buildPartition(proc *process.Process, part *joinPartition) error {
+	var err error
+
+	sj.bat = batch.NewWithSize(len(sj.typs))
+	sj.bat.Zs = proc.Mp().GetSels()
+	for i, typ := range sj.typs {
+		sj.bat.Vecs[i] = vector.New(typ)
+	}
+	for _, blk := range part.build {
+		bat, err := blk.sp.Read(proc, blk.blk)
+		if err != nil {
+			return err
+		}
+		sj.bat, err = sj.bat.Append(proc.Mp(), bat)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	mp, err := hashmap.NewStrMap(false, sj.ibucket, sj.nbucket, proc.Mp())
+	if err != nil {
+		return err
+	}
+	sj.bmp = hashmap.NewJoinMap(nil, nil, mp, false)
+	count := sj.bat.Length()
+	if count == 0 {
+		return nil
+	}
+	if err = sj.evalKeys(proc, sj.bat, sj.conds[1]); err != nil {
+		return err
+	}
+	defer sj.freeKeys(proc)
+	var sels [][]int64
+	itr := mp.NewIterator()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := mp.GroupCount()
+		vals, zvals, err := itr.Insert(i, n, sj.vecs)
+		if err != nil {
+			return err
+		}
+		for k, v := range vals[:n] {
+			if zvals[k] == 0 || v == 0 {
+				continue
+			}
+			if v > rows {
+				sels = append(sels, make([]int64, 0, 8))
+			}
+			ai := int64(v) - 1
+			sels[ai] = append(sels[ai], int64(i+k))
+		}
+	}
+	sj.bmp = hashmap.NewJoinMap(sels, nil, mp, false)
+	return nil
+}
+
+func (sj *SpilledJoin) freePartition(proc *process.Process) {
+	if sj.bmp != nil {
+		sj.bmp.Free()
+		sj.bmp = nil
+	}
+	if sj.bat != nil {
+		sj.bat.Clean(proc.Mp())
+		sj.bat = nil
+	}
+	sj.blks = nil
+}
+
+func (sj *SpilledJoin) evalKeys(proc *process.Process, bat *batch.Batch, conds []*plan.Expr) error {
+	for i, cond := range conds {
+		vec, err := EvalExpr(bat, proc, cond)
+		if err != nil || vec.ConstExpand(proc.Mp()) == nil {
+			for j := 0; j < i; j++ {
+				if sj.frees[j] {
+					vector.Clean(sj.vecs[j], proc.Mp())
+				}
+			}
+			return err
+		}
+		sj.vecs[i] = vec
+		sj.frees[i] = true
+		for j := range bat.Vecs {
+			if bat.Vecs[j] == vec {
+				sj.frees[i] = false
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (sj *SpilledJoin) freeKeys(proc *process.Process) {
+	for i, vec := range sj.vecs {
+		if sj.frees[i] {
+			vec.Free(proc.Mp())
+		}
+	}
+}
+
+func (sj *SpilledJoin) hasNullKey(row int) bool {
+	for _, vec := range sj.vecs {
+		if nulls.Contains(vec.Nsp, uint64(row)) {
+			return true
+		}
+	}
+	return false
+}
+
+// size returns the bytes of the build rows of the partition on disk.
+func (part *joinPartition) size() int64 {
+	size := int64(0)
+	for _, blk := range part.build {
+		size += blk.blk.size
+	}
+	return size
+}
+
+func blocksOf(sp *SpilledPartitions, part int) []spilledBlock {
+	blks := make([]spilledBlock, 0, len(sp.Blocks(part)))
+	for _, blk := range sp.Blocks(part) {
+		blks = append(blks, spilledBlock{sp: sp, blk: blk})
+	}
+	return blks
+}
+
+// copyRows returns a batch of the rows of sels of the batch.
+func copyRows(proc *process.Process, bat *batch.Batch, sels []int64) (*batch.Batch, error) {
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.New(vec.Typ)
+		for _, sel := range sels {
+			if err := vector.UnionOne(rbat.Vecs[i], vec, sel, proc.Mp()); err != nil {
+				rbat.Clean(proc.Mp())
+				return nil, err
+			}
+		}
+	}
+	for _, sel := range sels {
+		rbat.Zs = append(rbat.Zs, bat.Zs[sel])
+	}
+	return rbat, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_spilledPartitions(t *testing.T) {
+	convey.Convey("a constant key is partitioned as its value in every row", t, func() {
+		const count = 100
+		proc := testutil.NewProcess()
+		m := proc.Mp()
+		sp := &SpilledPartitions{level: 1}
+		consts := []*vector.Vector{
+			vector.NewConstFixed(types.T_int64.ToType(), count, int64(7)),
+			vector.NewConstString(types.T_varchar.ToType(), count, "abc"),
+		}
+		sp.partition(proc, consts, count)
+		constParts := append([]int(nil), sp.parts...)
+
+		vs := make([]int64, count)
+		ss := make([]string, count)
+		for i := range vs {
+			vs[i] = 7
+			ss[i] = "abc"
+		}
+		flats := []*vector.Vector{
+			testutil.NewVector(count, types.T_int64.ToType(), m, false, vs),
+			testutil.NewVector(count, types.T_varchar.ToType(), m, false, ss),
+		}
+		sp.partition(proc, flats, count)
+		convey.So(constParts, convey.ShouldResemble, sp.parts)
+
+		// all the rows of a constant null key are in one partition
+		sp.partition(proc, []*vector.Vector{vector.NewConstNull(types.T_int64.ToType(), count)}, count)
+		for _, p := range sp.parts {
+			convey.So(p, convey.ShouldEqual, sp.parts[0])
+		}
+	})
+}
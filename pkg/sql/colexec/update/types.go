@@ -15,6 +15,7 @@
 package update
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
@@ -37,5 +38,8 @@ type UpdateCtx struct {
 	UpdateAttrs []string
 	OtherAttrs  []string
 	OrderAttrs  []string
+	IndexAttrs  []string
 	TableSource engine.Relation
+	Indexes     *colexec.TableIndexes
+	Constraints *colexec.TableConstraints
 }
@@ -60,6 +60,10 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 			idx := updateCtx.PriKeyIdx
 
 			tmpBat.Vecs = bat.Vecs[int(idx)+1 : int(idx)+len(updateCtx.OrderAttrs)+1]
+			oldBat := &batch.Batch{
+				Attrs: updateCtx.IndexAttrs,
+				Vecs:  bat.Vecs[int(idx)+len(updateCtx.OrderAttrs)+1 : int(idx)+len(updateCtx.OrderAttrs)+len(updateCtx.IndexAttrs)+1],
+			}
 			tmpBat.Attrs = append(tmpBat.Attrs, updateCtx.UpdateAttrs...)
 			tmpBat.Attrs = append(tmpBat.Attrs, updateCtx.OtherAttrs...)
 			tmpBat.Zs = bat.Zs
@@ -84,11 +88,19 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 			if err != nil {
 				return false, err
 			}
+			if len(updateCtx.IndexAttrs) > 0 {
+				if err = updateCtx.Indexes.Update(ctx, proc, updateCtx.UpdateAttrs, oldBat, tmpBat); err != nil {
+					return false, err
+				}
+			}
+			if err = updateCtx.Constraints.Update(ctx, proc, updateCtx.UpdateAttrs, oldBat, tmpBat); err != nil {
+				return false, err
+			}
 
 			affectedRows += uint64(batch.Length(bat))
 		} else {
 			idx := updateCtx.HideKeyIdx
-			tmpBat.Vecs = bat.Vecs[int(idx) : int(idx)+len(updateCtx.OrderAttrs)+1]
+			tmpBat.Vecs = bat.Vecs[int(idx) : int(idx)+len(updateCtx.OrderAttrs)+len(updateCtx.IndexAttrs)+1]
 
 			// need to de duplicate
 			var cnt uint64
@@ -96,6 +108,11 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 			if tmpBat == nil {
 				panic(any("internal error when filter Batch"))
 			}
+			oldBat := &batch.Batch{
+				Attrs: updateCtx.IndexAttrs,
+				Vecs:  tmpBat.Vecs[len(updateCtx.OrderAttrs)+1:],
+			}
+			tmpBat.Vecs = tmpBat.Vecs[:len(updateCtx.OrderAttrs)+1]
 
 			err := updateCtx.TableSource.Delete(ctx, tmpBat.GetVector(0), updateCtx.HideKey)
 			if err != nil {
@@ -114,6 +131,15 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 				return false, err
 			}
 			err = updateCtx.TableSource.Write(ctx, tmpBat)
+			if err == nil && len(updateCtx.IndexAttrs) > 0 {
+				err = updateCtx.Indexes.Update(ctx, proc, updateCtx.UpdateAttrs, oldBat, tmpBat)
+			}
+			if err == nil {
+				err = updateCtx.Constraints.Update(ctx, proc, updateCtx.UpdateAttrs, oldBat, tmpBat)
+			}
+			for _, vec := range oldBat.Vecs {
+				vec.Free(proc.Mp())
+			}
 			if err != nil {
 				tmpBat.Clean(proc.Mp())
 				return false, err
@@ -241,6 +267,24 @@ func getIndexValue(idx int, v *vector.Vector, isNull bool) any {
 		}
 		col := v.Col.([]types.Date)
 		return col[idx]
+	case types.T_time:
+		if isNull {
+			return types.Time(0)
+		}
+		col := v.Col.([]types.Time)
+		return col[idx]
+	case types.T_enum:
+		if isNull {
+			return types.Enum(0)
+		}
+		col := v.Col.([]types.Enum)
+		return col[idx]
+	case types.T_set:
+		if isNull {
+			return types.Set(0)
+		}
+		col := v.Col.([]types.Set)
+		return col[idx]
 	case types.T_datetime:
 		if isNull {
 			return types.Datetime(0)
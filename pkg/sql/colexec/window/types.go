@@ -0,0 +1,47 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+type container struct {
+	name  string // name of the window function
+	isAgg bool   // the window function is an aggregate function
+	aggOp int    // aggregate operator, see agg.AggregateSum and so on
+	typ   types.Type
+
+	bat *batch.Batch // bat stores all the input rows, which are sorted by partition and order keys
+
+	// for each row of bat, the bounds of its partition and its peer group
+	partStart []int64
+	partEnd   []int64
+	peerStart []int64
+	peerEnd   []int64
+}
+
+type Argument struct {
+	ctr     *container
+	WinSpec *plan.WindowSpec // WinSpec is the window function and its window definition
+}
+
+type evalVector struct {
+	needFree bool
+	vec      *vector.Vector
+}
@@ -0,0 +1,640 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/partition"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString("window(")
+	if f := ap.WinSpec.WindowFunc.GetF(); f != nil {
+		buf.WriteString(f.Func.GetObjName())
+	}
+	buf.WriteString(")")
+}
+
+func Prepare(_ *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	f := ap.WinSpec.WindowFunc.GetF()
+	if f == nil {
+		return errors.New(errno.InternalError, "window function should be a function expression")
+	}
+	fun, err := function.GetFunctionByID(int64(uint64(f.Func.Obj) & function.DistinctMask))
+	if err != nil {
+		return err
+	}
+	ap.ctr.name = f.Func.GetObjName()
+	if fun.IsAggregate() {
+		ap.ctr.isAgg = true
+		ap.ctr.aggOp = fun.AggregateInfo
+	}
+	typ := ap.WinSpec.WindowFunc.Typ
+	ap.ctr.typ = types.Type{
+		Oid:       types.T(typ.Id),
+		Size:      typ.Size,
+		Width:     typ.Width,
+		Scale:     typ.Scale,
+		Precision: typ.Precision,
+	}
+	return nil
+}
+
+// Call collects all the input rows, which have been sorted by partition keys and order keys,
+// and appends the result vector of the window function to the batch when the input is done.
+func Call(idx int, proc *process.Process, arg any) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+	bat := proc.InputBatch()
+	if bat == nil {
+		if ctr.bat == nil {
+			proc.SetInputBatch(nil)
+			return true, nil
+		}
+		if err := ctr.eval(ap, proc); err != nil {
+			ctr.bat.Clean(proc.Mp())
+			ctr.bat = nil
+			return true, err
+		}
+		anal.Output(ctr.bat)
+		proc.SetInputBatch(ctr.bat)
+		ctr.bat = nil
+		return true, nil
+	}
+	defer bat.Clean(proc.Mp())
+	if len(bat.Vecs) == 0 {
+		return false, nil
+	}
+	anal.Input(bat)
+	proc.SetInputBatch(&batch.Batch{})
+	if bat.Length() == 0 {
+		return false, nil
+	}
+	bat.ExpandNulls()
+	for _, vec := range bat.Vecs {
+		vec.ConstExpand(proc.Mp())
+	}
+	if ctr.bat == nil {
+		ctr.bat = batch.NewWithSize(len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		}
+	}
+	var err error
+	if ctr.bat, err = ctr.bat.Append(proc.Mp(), bat); err != nil {
+		ctr.bat.Clean(proc.Mp())
+		ctr.bat = nil
+		return false, err
+	}
+	anal.Alloc(int64(bat.Size()))
+	return false, nil
+}
+
+func (ctr *container) eval(ap *Argument, proc *process.Process) error {
+	if err := ctr.evalBounds(ap, proc); err != nil {
+		return err
+	}
+	var vec *vector.Vector
+	var err error
+	switch {
+	case ctr.isAgg:
+		vec, err = ctr.evalAggregate(ap, proc)
+	case ctr.name == "row_number", ctr.name == "rank", ctr.name == "dense_rank",
+		ctr.name == "percent_rank", ctr.name == "cume_dist":
+		vec, err = ctr.evalRank(proc)
+	case ctr.name == "ntile":
+		vec, err = ctr.evalNtile(ap, proc)
+	case ctr.name == "lag", ctr.name == "lead":
+		vec, err = ctr.evalOffset(ap, proc)
+	case ctr.name == "first_value", ctr.name == "last_value", ctr.name == "nth_value":
+		vec, err = ctr.evalValue(ap, proc)
+	default:
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("window function '%s' is not supported now", ctr.name))
+	}
+	if err != nil {
+		return err
+	}
+	ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
+	return nil
+}
+
+// evalBounds works out the partition and the peer group of each row.
+// Rows in the same partition have the same partition keys, and peers have the same order keys too.
+func (ctr *container) evalBounds(ap *Argument, proc *process.Process) error {
+	n := len(ctr.bat.Zs)
+	sels := make([]int64, n)
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	diffs := make([]bool, n)
+	diffs[0] = true
+	ps := make([]int64, 0, 16)
+	for _, e := range ap.WinSpec.PartitionBy {
+		if err := ctr.partition(sels, diffs, ps, e, proc); err != nil {
+			return err
+		}
+	}
+	ctr.partStart, ctr.partEnd = expandBounds(diffs)
+	for _, o := range ap.WinSpec.OrderBy {
+		if err := ctr.partition(sels, diffs, ps, o.Expr, proc); err != nil {
+			return err
+		}
+	}
+	ctr.peerStart, ctr.peerEnd = expandBounds(diffs)
+	return nil
+}
+
+func (ctr *container) partition(sels []int64, diffs []bool, ps []int64, expr *plan.Expr, proc *process.Process) error {
+	vec, err := ctr.evalExpr(expr, proc)
+	if err != nil {
+		return err
+	}
+	defer ctr.freeVector(vec, proc)
+	if vec.vec.IsScalar() {
+		return nil
+	}
+	partition.Partition(sels, diffs, ps, vec.vec)
+	return nil
+}
+
+// expandBounds returns the start and the end of the group of each row,
+// a new group begins at the row whose diff is true.
+func expandBounds(diffs []bool) ([]int64, []int64) {
+	n := int64(len(diffs))
+	starts, ends := make([]int64, n), make([]int64, n)
+	for i := int64(0); i < n; {
+		j := i + 1
+		for j < n && !diffs[j] {
+			j++
+		}
+		for k := i; k < j; k++ {
+			starts[k], ends[k] = i, j
+		}
+		i = j
+	}
+	return starts, ends
+}
+
+func (ctr *container) evalRank(proc *process.Process) (*vector.Vector, error) {
+	n := len(ctr.bat.Zs)
+	if ctr.name == "percent_rank" || ctr.name == "cume_dist" {
+		vs := make([]float64, n)
+		for i := range vs {
+			size := ctr.partEnd[i] - ctr.partStart[i]
+			if ctr.name == "cume_dist" {
+				vs[i] = float64(ctr.peerEnd[i]-ctr.partStart[i]) / float64(size)
+			} else if size > 1 {
+				vs[i] = float64(ctr.peerStart[i]-ctr.partStart[i]) / float64(size-1)
+			}
+		}
+		return vector.NewWithFixed(ctr.typ, vs, nil, proc.Mp()), nil
+	}
+	vs := make([]int64, n)
+	rank := int64(0)
+	for i := range vs {
+		switch ctr.name {
+		case "row_number":
+			vs[i] = int64(i) - ctr.partStart[i] + 1
+		case "rank":
+			vs[i] = ctr.peerStart[i] - ctr.partStart[i] + 1
+		case "dense_rank":
+			if int64(i) == ctr.partStart[i] {
+				rank = 0
+			}
+			if int64(i) == ctr.peerStart[i] {
+				rank++
+			}
+			vs[i] = rank
+		}
+	}
+	return vector.NewWithFixed(ctr.typ, vs, nil, proc.Mp()), nil
+}
+
+func (ctr *container) evalNtile(ap *Argument, proc *process.Process) (*vector.Vector, error) {
+	args := ap.WinSpec.WindowFunc.GetF().Args
+	bucket, err := ctr.evalExpr(args[0], proc)
+	if err != nil {
+		return nil, err
+	}
+	defer ctr.freeVector(bucket, proc)
+	n := len(ctr.bat.Zs)
+	vs := make([]int64, n)
+	nsp := nulls.NewWithSize(n)
+	for i := range vs {
+		b, isNull := int64Value(bucket.vec, int64(i))
+		if isNull {
+			nsp.Set(uint64(i))
+			continue
+		}
+		if b <= 0 {
+			return nil, errors.New(errno.DataException, "argument of ntile must be greater than zero")
+		}
+		// the first size%b buckets have one more row than the others
+		size, pos := ctr.partEnd[i]-ctr.partStart[i], int64(i)-ctr.partStart[i]
+		q, r := size/b, size%b
+		if pos < r*(q+1) {
+			vs[i] = pos/(q+1) + 1
+		} else {
+			vs[i] = (pos-r*(q+1))/q + r + 1
+		}
+	}
+	return vector.NewWithFixed(ctr.typ, vs, nsp, proc.Mp()), nil
+}
+
+func (ctr *container) evalOffset(ap *Argument, proc *process.Process) (*vector.Vector, error) {
+	args := ap.WinSpec.WindowFunc.GetF().Args
+	vecs := make([]evalVector, len(args))
+	defer func() {
+		for i := range vecs {
+			ctr.freeVector(vecs[i], proc)
+		}
+	}()
+	for i, arg := range args {
+		vec, err := ctr.evalExpr(arg, proc)
+		if err != nil {
+			return nil, err
+		}
+		vecs[i] = vec
+	}
+	rvec := vector.New(ctr.typ)
+	for i := int64(0); i < int64(len(ctr.bat.Zs)); i++ {
+		offset, isNull := int64(1), false
+		if len(vecs) > 1 {
+			if offset, isNull = int64Value(vecs[1].vec, i); !isNull && offset < 0 {
+				rvec.Free(proc.Mp())
+				return nil, errors.New(errno.DataException, fmt.Sprintf("offset of %s must not be negative", ctr.name))
+			}
+		}
+		if ctr.name == "lag" {
+			offset = -offset
+		}
+		var err error
+		switch j := i + offset; {
+		case isNull:
+			err = vector.UnionNull(rvec, nil, proc.Mp())
+		case j >= ctr.partStart[i] && j < ctr.partEnd[i]:
+			err = appendValue(rvec, vecs[0].vec, j, proc)
+		case len(vecs) > 2:
+			err = appendValue(rvec, vecs[2].vec, i, proc)
+		default:
+			err = vector.UnionNull(rvec, nil, proc.Mp())
+		}
+		if err != nil {
+			rvec.Free(proc.Mp())
+			return nil, err
+		}
+	}
+	return rvec, nil
+}
+
+func (ctr *container) evalValue(ap *Argument, proc *process.Process) (*vector.Vector, error) {
+	args := ap.WinSpec.WindowFunc.GetF().Args
+	vec, err := ctr.evalExpr(args[0], proc)
+	if err != nil {
+		return nil, err
+	}
+	defer ctr.freeVector(vec, proc)
+	var nth evalVector
+	if ctr.name == "nth_value" {
+		if nth, err = ctr.evalExpr(args[1], proc); err != nil {
+			return nil, err
+		}
+		defer ctr.freeVector(nth, proc)
+	}
+	fr, err := ctr.newFrame(ap, proc)
+	if err != nil {
+		return nil, err
+	}
+	rvec := vector.New(ctr.typ)
+	for i := int64(0); i < int64(len(ctr.bat.Zs)); i++ {
+		start, end := fr.bounds(ctr, i)
+		j := int64(-1)
+		switch ctr.name {
+		case "first_value":
+			j = start
+		case "last_value":
+			j = end - 1
+		case "nth_value":
+			k, isNull := int64Value(nth.vec, i)
+			if !isNull && k <= 0 {
+				rvec.Free(proc.Mp())
+				return nil, errors.New(errno.DataException, "argument of nth_value must be greater than zero")
+			}
+			if !isNull {
+				j = start + k - 1
+			}
+		}
+		if j >= start && j < end {
+			err = appendValue(rvec, vec.vec, j, proc)
+		} else {
+			err = vector.UnionNull(rvec, nil, proc.Mp())
+		}
+		if err != nil {
+			rvec.Free(proc.Mp())
+			return nil, err
+		}
+	}
+	return rvec, nil
+}
+
+// evalAggregate computes an aggregate function over the frame of each row, every row is a group of the agg.
+// If a frame has the same start as the previous one and does not shrink,
+// the result of the previous row is merged and only the new rows are filled.
+func (ctr *container) evalAggregate(ap *Argument, proc *process.Process) (*vector.Vector, error) {
+	args := ap.WinSpec.WindowFunc.GetF().Args
+	vec, err := ctr.evalExpr(args[0], proc)
+	if err != nil {
+		return nil, err
+	}
+	defer ctr.freeVector(vec, proc)
+	fr, err := ctr.newFrame(ap, proc)
+	if err != nil {
+		return nil, err
+	}
+	ag, err := agg.New(ctr.aggOp, false, vec.vec.Typ)
+	if err != nil {
+		return nil, err
+	}
+	n := int64(len(ctr.bat.Zs))
+	if err = ag.Grows(int(n), proc.Mp()); err != nil {
+		ag.Free(proc.Mp())
+		return nil, err
+	}
+	vecs := []*vector.Vector{vec.vec}
+	lastStart, lastEnd := int64(-1), int64(-1)
+	for i := int64(0); i < n; i++ {
+		start, end := fr.bounds(ctr, i)
+		from := start
+		if i > 0 && start == lastStart && end >= lastEnd {
+			if err = ag.Merge(ag, i, i-1); err != nil {
+				ag.Free(proc.Mp())
+				return nil, err
+			}
+			if lastEnd > from {
+				from = lastEnd
+			}
+		}
+		for j := from; j < end; j++ {
+			if err = ag.Fill(i, j, ctr.bat.Zs[j], vecs); err != nil {
+				ag.Free(proc.Mp())
+				return nil, err
+			}
+		}
+		lastStart, lastEnd = start, end
+	}
+	return ag.Eval(proc.Mp())
+}
+
+type bound struct {
+	typ       plan.FrameBound_BoundType
+	unbounded bool
+	rows      int64   // offset of ROWS frame
+	delta     float64 // offset of RANGE frame
+}
+
+type frame struct {
+	isRange bool
+	start   bound
+	end     bound
+
+	desc bool
+	keys []float64 // values of the order key, only for RANGE frame with offset
+	nsp  *nulls.Nulls
+}
+
+// newFrame makes the frame of the window, the whole partition is the frame if it is not specified.
+func (ctr *container) newFrame(ap *Argument, proc *process.Process) (*frame, error) {
+	fc := ap.WinSpec.Frame
+	if fc == nil {
+		return &frame{
+			start: bound{typ: plan.FrameBound_PRECEDING, unbounded: true},
+			end:   bound{typ: plan.FrameBound_FOLLOWING, unbounded: true},
+		}, nil
+	}
+	fr := &frame{isRange: fc.Type == plan.FrameClause_RANGE}
+	var err error
+	hasOffset := false
+	for _, b := range []struct {
+		dst *bound
+		src *plan.FrameBound
+	}{{&fr.start, fc.Start}, {&fr.end, fc.End}} {
+		b.dst.typ, b.dst.unbounded = b.src.Type, b.src.Unbounded
+		if b.src.Unbounded || b.src.Type == plan.FrameBound_CURRENT_ROW {
+			continue
+		}
+		hasOffset = true
+		if b.dst.rows, b.dst.delta, err = ctr.evalBoundOffset(b.src.Val, proc); err != nil {
+			return nil, err
+		}
+	}
+	if fr.isRange && hasOffset {
+		if len(ap.WinSpec.OrderBy) != 1 {
+			return nil, errors.New(errno.WindowingError, "RANGE with offset PRECEDING/FOLLOWING requires exactly one ORDER BY column")
+		}
+		key, err := ctr.evalExpr(ap.WinSpec.OrderBy[0].Expr, proc)
+		if err != nil {
+			return nil, err
+		}
+		defer ctr.freeVector(key, proc)
+		if fr.keys, err = float64Values(key.vec); err != nil {
+			return nil, err
+		}
+		fr.nsp = key.vec.Nsp
+		fr.desc = ap.WinSpec.OrderBy[0].Flag&plan.OrderBySpec_DESC != 0
+	}
+	return fr, nil
+}
+
+func (ctr *container) evalBoundOffset(expr *plan.Expr, proc *process.Process) (int64, float64, error) {
+	vec, err := ctr.evalExpr(expr, proc)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer ctr.freeVector(vec, proc)
+	if vec.vec.Nsp.Contains(0) {
+		return 0, 0, errors.New(errno.DataException, "frame offset must not be null")
+	}
+	vs, err := float64Values(vec.vec)
+	if err != nil {
+		return 0, 0, err
+	}
+	if vs[0] < 0 {
+		return 0, 0, errors.New(errno.DataException, "frame offset must not be negative")
+	}
+	return int64(vs[0]), vs[0], nil
+}
+
+// bounds returns the frame [start, end) of the row i, which is limited in the partition of the row.
+func (fr *frame) bounds(ctr *container, i int64) (int64, int64) {
+	start, end := fr.position(ctr, fr.start, i, true), fr.position(ctr, fr.end, i, false)
+	if start < ctr.partStart[i] {
+		start = ctr.partStart[i]
+	}
+	if end > ctr.partEnd[i] {
+		end = ctr.partEnd[i]
+	}
+	if start > end {
+		start = end
+	}
+	return start, end
+}
+
+func (fr *frame) position(ctr *container, b bound, i int64, isStart bool) int64 {
+	switch {
+	case b.unbounded && b.typ == plan.FrameBound_PRECEDING:
+		return ctr.partStart[i]
+	case b.unbounded:
+		return ctr.partEnd[i]
+	case b.typ == plan.FrameBound_CURRENT_ROW:
+		if !fr.isRange {
+			if isStart {
+				return i
+			}
+			return i + 1
+		}
+		if isStart {
+			return ctr.peerStart[i]
+		}
+		return ctr.peerEnd[i]
+	}
+	if !fr.isRange {
+		pos := i - b.rows
+		if b.typ == plan.FrameBound_FOLLOWING {
+			pos = i + b.rows
+		}
+		if !isStart {
+			pos++
+		}
+		return pos
+	}
+	// rows with null key are only peers of each other
+	if fr.nsp.Contains(uint64(i)) {
+		if isStart {
+			return ctr.peerStart[i]
+		}
+		return ctr.peerEnd[i]
+	}
+	target := fr.keys[i] - b.delta
+	if (b.typ == plan.FrameBound_FOLLOWING) != fr.desc {
+		target = fr.keys[i] + b.delta
+	}
+	lo, hi := ctr.partStart[i], ctr.partEnd[i]
+	return lo + int64(sort.Search(int(hi-lo), func(k int) bool {
+		v := fr.keys[lo+int64(k)]
+		switch {
+		case isStart && fr.desc:
+			return v <= target
+		case isStart:
+			return v >= target
+		case fr.desc:
+			return v < target
+		default:
+			return v > target
+		}
+	}))
+}
+
+func (ctr *container) evalExpr(expr *plan.Expr, proc *process.Process) (evalVector, error) {
+	vec, err := colexec.EvalExpr(ctr.bat, proc, expr)
+	if err != nil {
+		return evalVector{}, err
+	}
+	for i := range ctr.bat.Vecs {
+		if ctr.bat.Vecs[i] == vec {
+			return evalVector{vec: vec}, nil
+		}
+	}
+	// a constant has no raw data to be copied row by row, so expand it
+	return evalVector{vec: vec.ConstExpand(proc.Mp()), needFree: true}, nil
+}
+
+func (ctr *container) freeVector(vec evalVector, proc *process.Process) {
+	if vec.needFree {
+		vec.vec.Free(proc.Mp())
+	}
+}
+
+// appendValue appends the row j of src to vec.
+func appendValue(vec, src *vector.Vector, j int64, proc *process.Process) error {
+	if src.IsScalarNull() || src.Nsp.Contains(uint64(j)) {
+		return vector.UnionNull(vec, nil, proc.Mp())
+	}
+	return vector.UnionOne(vec, src, j, proc.Mp())
+}
+
+func int64Value(vec *vector.Vector, i int64) (int64, bool) {
+	if vec.IsScalar() {
+		i = 0
+	}
+	if vec.IsScalarNull() || vec.Nsp.Contains(uint64(i)) {
+		return 0, true
+	}
+	return vector.GetColumn[int64](vec)[i], false
+}
+
+func float64Values(vec *vector.Vector) ([]float64, error) {
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return toFloat64(vector.GetColumn[int8](vec)), nil
+	case types.T_int16:
+		return toFloat64(vector.GetColumn[int16](vec)), nil
+	case types.T_int32:
+		return toFloat64(vector.GetColumn[int32](vec)), nil
+	case types.T_int64:
+		return toFloat64(vector.GetColumn[int64](vec)), nil
+	case types.T_uint8:
+		return toFloat64(vector.GetColumn[uint8](vec)), nil
+	case types.T_uint16:
+		return toFloat64(vector.GetColumn[uint16](vec)), nil
+	case types.T_uint32:
+		return toFloat64(vector.GetColumn[uint32](vec)), nil
+	case types.T_uint64:
+		return toFloat64(vector.GetColumn[uint64](vec)), nil
+	case types.T_float32:
+		return toFloat64(vector.GetColumn[float32](vec)), nil
+	case types.T_float64:
+		// the keys are copied, they are read after the vector is freed
+		return append([]float64(nil), vector.GetColumn[float64](vec)...), nil
+	}
+	return nil, errors.New(errno.WindowingError, fmt.Sprintf("RANGE with offset PRECEDING/FOLLOWING does not support type '%s'", vec.Typ))
+}
+
+func toFloat64[T int8 | int16 | int32 | int64 | uint8 | uint16 | uint32 | uint64 | float32](vs []T) []float64 {
+	rs := make([]float64, len(vs))
+	for i, v := range vs {
+		rs[i] = float64(v)
+	}
+	return rs
+}
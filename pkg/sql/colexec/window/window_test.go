@@ -0,0 +1,203 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type windowTestCase struct {
+	arg    *Argument
+	proc   *process.Process
+	expect []int64 // nil value is math.MinInt64
+}
+
+const null = -1 << 63
+
+var (
+	// the input is sorted by partition key and order key
+	partKeys = []int64{1, 1, 1, 2, 2}
+	ordKeys  = []int64{1, 2, 2, 3, 4}
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range newTestCases(t) {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range newTestCases(t) {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	for _, tc := range newTestCases(t) {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = newBatch(tc.proc, partKeys[:2], ordKeys[:2])
+		_, err = Call(0, tc.proc, tc.arg)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = newBatch(tc.proc, partKeys[2:], ordKeys[2:])
+		_, err = Call(0, tc.proc, tc.arg)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = &batch.Batch{}
+		_, err = Call(0, tc.proc, tc.arg)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = nil
+		end, err := Call(0, tc.proc, tc.arg)
+		require.NoError(t, err)
+		require.True(t, end)
+
+		bat := tc.proc.Reg.InputBatch
+		require.Equal(t, 3, len(bat.Vecs))
+		vec := bat.Vecs[2]
+		for i, v := range tc.expect {
+			if v == null {
+				require.True(t, vec.Nsp.Contains(uint64(i)), tc.arg.WinSpec.WindowFunc.GetF().Func.ObjName)
+				continue
+			}
+			require.False(t, vec.Nsp.Contains(uint64(i)), tc.arg.WinSpec.WindowFunc.GetF().Func.ObjName)
+			require.Equal(t, v, int64Of(vec, i), tc.arg.WinSpec.WindowFunc.GetF().Func.ObjName)
+		}
+		bat.Clean(tc.proc.Mp())
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp()))
+	}
+}
+
+func TestFloat64Values(t *testing.T) {
+	proc := testutil.NewProcess()
+	vec := testutil.NewFloat64Vector(3, types.T_float64.ToType(), proc.Mp(), false, []float64{1, 2, 3})
+	keys, err := float64Values(vec)
+	require.NoError(t, err)
+	// the keys are not in the memory of the vector
+	vector.GetColumn[float64](vec)[0] = 4
+	require.Equal(t, []float64{1, 2, 3}, keys)
+	vec.Free(proc.Mp())
+}
+
+func newTestCases(t *testing.T) []windowTestCase {
+	rows := &plan.FrameClause{
+		Type:  plan.FrameClause_ROWS,
+		Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: newConst(1)},
+		End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Val: newConst(1)},
+	}
+	rangeOffset := &plan.FrameClause{
+		Type:  plan.FrameClause_RANGE,
+		Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: newConst(1)},
+		End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+	}
+	rangeDefault := &plan.FrameClause{
+		Type:  plan.FrameClause_RANGE,
+		Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Unbounded: true},
+		End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+	}
+	return []windowTestCase{
+		newTestCase(t, "row_number", nil, nil, []int64{1, 2, 3, 1, 2}),
+		newTestCase(t, "rank", nil, nil, []int64{1, 2, 2, 1, 2}),
+		newTestCase(t, "dense_rank", nil, nil, []int64{1, 2, 2, 1, 2}),
+		newTestCase(t, "ntile", []*plan.Expr{newConst(2)}, nil, []int64{1, 1, 2, 1, 2}),
+		newTestCase(t, "lag", []*plan.Expr{newColumn(1)}, nil, []int64{null, 1, 2, null, 3}),
+		newTestCase(t, "lead", []*plan.Expr{newColumn(1), newConst(1), newConst(0)}, nil, []int64{2, 2, 0, 4, 0}),
+		newTestCase(t, "first_value", []*plan.Expr{newColumn(1)}, rangeDefault, []int64{1, 1, 1, 3, 3}),
+		newTestCase(t, "last_value", []*plan.Expr{newColumn(1)}, rangeDefault, []int64{1, 2, 2, 3, 4}),
+		newTestCase(t, "nth_value", []*plan.Expr{newColumn(1), newConst(2)}, rows, []int64{2, 2, 2, 4, 4}),
+		newTestCase(t, "sum", []*plan.Expr{newColumn(1)}, rangeDefault, []int64{1, 5, 5, 3, 7}),
+		newTestCase(t, "sum", []*plan.Expr{newColumn(1)}, rows, []int64{3, 5, 4, 7, 7}),
+		newTestCase(t, "sum", []*plan.Expr{newColumn(1)}, rangeOffset, []int64{1, 5, 5, 3, 7}),
+		newTestCase(t, "sum", []*plan.Expr{newColumn(1)}, nil, []int64{5, 5, 5, 7, 7}),
+		newTestCase(t, "count", []*plan.Expr{newColumn(1)}, rows, []int64{2, 3, 2, 2, 2}),
+	}
+}
+
+func newTestCase(t *testing.T, name string, args []*plan.Expr, frame *plan.FrameClause, expect []int64) windowTestCase {
+	typs := make([]types.Type, len(args))
+	for i := range args {
+		typs[i] = types.T_int64.ToType()
+	}
+	fid, typ, _, err := function.GetFunctionByName(name, typs)
+	require.NoError(t, err)
+	return windowTestCase{
+		proc:   testutil.NewProcessWithMheap(mheap.New(guest.New(1<<30, host.New(1<<30)))),
+		expect: expect,
+		arg: &Argument{
+			WinSpec: &plan.WindowSpec{
+				WindowFunc: &plan.Expr{
+					Typ: &plan.Type{Id: int32(typ.Oid), Size: typ.Size},
+					Expr: &plan.Expr_F{
+						F: &plan.Function{
+							Func: &plan.ObjectRef{Obj: fid, ObjName: name},
+							Args: args,
+						},
+					},
+				},
+				PartitionBy: []*plan.Expr{newColumn(0)},
+				OrderBy:     []*plan.OrderBySpec{{Expr: newColumn(1), Flag: plan.OrderBySpec_ASC}},
+				Frame:       frame,
+			},
+		},
+	}
+}
+
+func newColumn(pos int32) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64), Size: 8},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: pos,
+			},
+		},
+	}
+}
+
+func newConst(v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64), Size: 8},
+		Expr: &plan.Expr_C{
+			C: &plan.Const{
+				Value: &plan.Const_Ival{Ival: v},
+			},
+		},
+	}
+}
+
+func newBatch(proc *process.Process, part, ord []int64) *batch.Batch {
+	typ := types.T_int64.ToType()
+	return testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(len(part), typ, proc.Mp(), false, part),
+		testutil.NewInt64Vector(len(ord), typ, proc.Mp(), false, ord),
+	}, nil)
+}
+
+func int64Of(vec *vector.Vector, i int) int64 {
+	return vector.GetColumn[int64](vec)[i]
+}
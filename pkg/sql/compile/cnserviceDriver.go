@@ -29,10 +29,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/anti"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dispatch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/full"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/group"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/intersect"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/intersectall"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/join"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/jsontable"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/left"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopanti"
@@ -52,10 +54,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/recursive"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/right"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/semi"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/single"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
@@ -174,16 +179,42 @@ func (s *Scope) remoteRun(c *Compile) error {
 var _ = new(Scope).remoteRun
 
 // encodeScope generate a pipeline.Pipeline from Scope, encode pipeline, and returns.
+// A scope with a recursive CTE can't be encoded, since its recursive part runs in the local process only.
 func encodeScope(s *Scope) ([]byte, error) {
-	p, err := fillPipeline(s)
+	data, steps, err := encodeLocalScope(s)
 	if err != nil {
 		return nil, err
 	}
-	return p.Marshal()
+	if len(steps) > 0 {
+		return nil, moerr.New(moerr.INTERNAL_ERROR, "recursive CTE can only run in the local process")
+	}
+	return data, nil
+}
+
+// encodeLocalScope encodes the scope as encodeScope, and returns the recursive parts of the
+// recursive CTEs in it, which are found by their positions when the scope is decoded by
+// decodeLocalScope in the same process.
+func encodeLocalScope(s *Scope) ([]byte, []*recursiveStep, error) {
+	p, steps, err := fillPipeline(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := p.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, steps, nil
 }
 
 // decodeScope decode a pipeline.Pipeline from bytes, and generate a Scope from it.
 func decodeScope(data []byte, proc *process.Process) (*Scope, error) {
+	return decodeLocalScope(data, proc, nil, nil, nil)
+}
+
+// decodeLocalScope decodes a scope encoded by encodeLocalScope, the recursive CTEs in the
+// scope read the work tables of the runs they are in.
+func decodeLocalScope(data []byte, proc *process.Process, steps []*recursiveStep,
+	workTables map[string]*workTable, analNodes []*process.AnalyzeInfo) (*Scope, error) {
 	// unmarshal to pipeline
 	p := &pipeline.Pipeline{}
 	err := p.Unmarshal(data)
@@ -191,12 +222,14 @@ func decodeScope(data []byte, proc *process.Process) (*Scope, error) {
 		return nil, err
 	}
 	ctx := &scopeContext{
-		parent: nil,
-		id:     p.PipelineId,
-		regs:   make(map[*process.WaitRegister]int32),
+		parent:     nil,
+		id:         p.PipelineId,
+		regs:       make(map[*process.WaitRegister]int32),
+		steps:      steps,
+		workTables: workTables,
 	}
 	ctx.root = ctx
-	s, err := generateScope(proc, p, ctx, nil)
+	s, err := generateScope(proc, p, ctx, analNodes)
 	if err != nil {
 		return nil, err
 	}
@@ -207,8 +240,9 @@ func refactorScope(_ context.Context, _ *Scope, _ morpc.ClientSession) {
 	// refactor the scope
 }
 
-// fillPipeline convert the scope to pipeline.Pipeline structure through 2 iterations.
-func fillPipeline(s *Scope) (*pipeline.Pipeline, error) {
+// fillPipeline convert the scope to pipeline.Pipeline structure through 2 iterations,
+// and returns the recursive parts of the recursive CTEs in the scope.
+func fillPipeline(s *Scope) (*pipeline.Pipeline, []*recursiveStep, error) {
 	ctx := &scopeContext{
 		id:     0,
 		parent: nil,
@@ -217,12 +251,12 @@ func fillPipeline(s *Scope) (*pipeline.Pipeline, error) {
 	ctx.root = ctx
 	p, ctxId, err := generatePipeline(s, ctx, 1)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if _, err := fillInstructionsForPipeline(s, ctx, p, ctxId); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return p, nil
+	return p, ctx.steps, nil
 }
 
 // generatePipeline generate a base pipeline.Pipeline structure without instructions
@@ -265,6 +299,9 @@ func generatePipeline(s *Scope, ctx *scopeContext, ctxId int32) (*pipeline.Pipel
 			ColList:      s.DataSource.Attributes,
 			PushdownId:   s.DataSource.PushdownId,
 			PushdownAddr: s.DataSource.PushdownAddr,
+			IndexScan:    s.DataSource.IndexScan,
+			Expr:         s.DataSource.Expr,
+			AnalyzeIdx:   int32(s.DataSource.AnalyzeIdx),
 		}
 		if s.DataSource.Bat != nil {
 			data, err := types.Encode(s.DataSource.Bat)
@@ -329,6 +366,9 @@ func generateScope(proc *process.Process, p *pipeline.Pipeline, ctx *scopeContex
 			Attributes:   dsc.ColList,
 			PushdownId:   dsc.PushdownId,
 			PushdownAddr: dsc.PushdownAddr,
+			IndexScan:    dsc.IndexScan,
+			Expr:         dsc.Expr,
+			AnalyzeIdx:   int(dsc.AnalyzeIdx),
 		}
 		if len(dsc.Block) > 0 {
 			bat := new(batch.Batch)
@@ -454,6 +494,32 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			LeftCond:  t.Conditions[0],
 			RightCond: t.Conditions[1],
 		}
+	case *right.Argument:
+		relList, colList := getRelColList(t.Result)
+		in.RightJoin = &pipeline.RightJoin{
+			Ibucket:   t.Ibucket,
+			Nbucket:   t.Nbucket,
+			RelList:   relList,
+			ColList:   colList,
+			Expr:      t.Cond,
+			Types:     convertToPlanTypes(t.Typs),
+			LeftTypes: convertToPlanTypes(t.LeftTypes),
+			LeftCond:  t.Conditions[0],
+			RightCond: t.Conditions[1],
+		}
+	case *full.Argument:
+		relList, colList := getRelColList(t.Result)
+		in.RightJoin = &pipeline.RightJoin{
+			Ibucket:   t.Ibucket,
+			Nbucket:   t.Nbucket,
+			RelList:   relList,
+			ColList:   colList,
+			Expr:      t.Cond,
+			Types:     convertToPlanTypes(t.Typs),
+			LeftTypes: convertToPlanTypes(t.LeftTypes),
+			LeftCond:  t.Conditions[0],
+			RightCond: t.Conditions[1],
+		}
 	case *limit.Argument:
 		in.Limit = t.Limit
 	case *loopanti.Argument:
@@ -590,6 +656,17 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			OutputAnyway: t.OutputAnyway,
 			MarkMeaning:  t.MarkMeaning,
 		}
+	case *window.Argument:
+		in.WinSpec = t.WinSpec
+	case *jsontable.Argument:
+		in.JsonTable = t.JsonTable
+	case *recursive.Argument:
+		in.RecursiveCte = &pipeline.RecursiveCte{
+			UnionAll: t.UnionAll,
+			MaxDepth: t.MaxDepth,
+			StepId:   uint64(len(ctx.root.steps)),
+		}
+		ctx.root.steps = append(ctx.root.steps, t.Step.(*recursiveRun).step)
 	default:
 		return -1, nil, moerr.New(moerr.INTERNAL_ERROR, "unexpected operator: %v", opr.Op)
 	}
@@ -652,6 +729,30 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 			Result:     convertToResultPos(t.RelList, t.ColList),
 			Conditions: [][]*plan.Expr{t.LeftCond, t.RightCond},
 		}
+	case vm.Right:
+		t := opr.GetRightJoin()
+		v.Arg = &right.Argument{
+			Ibucket:    t.Ibucket,
+			Nbucket:    t.Nbucket,
+			Cond:       t.Expr,
+			Typs:       convertToTypes(t.Types),
+			LeftTypes:  convertToTypes(t.LeftTypes),
+			Result:     convertToResultPos(t.RelList, t.ColList),
+			Conditions: [][]*plan.Expr{t.LeftCond, t.RightCond},
+			Matched:    colexec.NewMatchedRows(),
+		}
+	case vm.Full:
+		t := opr.GetRightJoin()
+		v.Arg = &full.Argument{
+			Ibucket:    t.Ibucket,
+			Nbucket:    t.Nbucket,
+			Cond:       t.Expr,
+			Typs:       convertToTypes(t.Types),
+			LeftTypes:  convertToTypes(t.LeftTypes),
+			Result:     convertToResultPos(t.RelList, t.ColList),
+			Conditions: [][]*plan.Expr{t.LeftCond, t.RightCond},
+			Matched:    colexec.NewMatchedRows(),
+		}
 	case vm.Limit:
 		v.Arg = &limit.Argument{Limit: opr.Limit}
 	case vm.LoopAnti:
@@ -791,6 +892,27 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 		v.Arg = &mergeorder.Argument{
 			Fs: convertToColExecField(opr.OrderBy),
 		}
+	case vm.Window:
+		v.Arg = &window.Argument{
+			WinSpec: opr.WinSpec,
+		}
+	case vm.JsonTable:
+		v.Arg = &jsontable.Argument{
+			JsonTable: opr.JsonTable,
+		}
+	case vm.Recursive:
+		t := opr.GetRecursiveCte()
+		if t.StepId >= uint64(len(ctx.root.steps)) {
+			return v, moerr.New(moerr.INTERNAL_ERROR, "recursive CTE can only run in the local process")
+		}
+		v.Arg = &recursive.Argument{
+			UnionAll: t.UnionAll,
+			MaxDepth: t.MaxDepth,
+			Step: &recursiveRun{
+				step:       ctx.root.steps[t.StepId],
+				workTables: ctx.root.workTables,
+			},
+		}
 	default:
 		return v, moerr.New(moerr.INTERNAL_ERROR, "unexpected operator: %v", opr.Op)
 	}
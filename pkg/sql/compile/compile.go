@@ -29,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
@@ -108,6 +109,12 @@ func (c *Compile) Run(_ uint64) (err error) {
 		return c.scope.CreateTable(c)
 	case DropTable:
 		return c.scope.DropTable(c)
+	case CreateIndex:
+		return c.scope.CreateIndex(c)
+	case DropIndex:
+		return c.scope.DropIndex(c)
+	case AlterTable:
+		return c.scope.AlterTable(c)
 	case Deletion:
 		defer c.fillAnalyzeInfo()
 		affectedRows, err := c.scope.Delete(c)
@@ -179,6 +186,11 @@ func (c *Compile) compileScope(pn *plan.Plan) (*Scope, error) {
 				Magic: DropIndex,
 				Plan:  pn,
 			}, nil
+		case plan.DataDefinition_ALTER_TABLE:
+			return &Scope{
+				Magic: AlterTable,
+				Plan:  pn,
+			}, nil
 		case plan.DataDefinition_SHOW_DATABASES,
 			plan.DataDefinition_SHOW_TABLES,
 			plan.DataDefinition_SHOW_COLUMNS,
@@ -341,6 +353,12 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		}
 		ds.DataSource = &Source{Bat: bat}
 		return c.compileSort(n, c.compileProjection(n, []*Scope{ds})), nil
+	case plan.Node_MATERIAL_SCAN:
+		ss, err := c.compileWorkTableScan(n)
+		if err != nil {
+			return nil, err
+		}
+		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
 	case plan.Node_EXTERNAL_SCAN:
 		ss := c.compileExternScan(n)
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
@@ -382,7 +400,7 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		rewriteExprListForAggNode(n.ProjectList, int32(len(n.GroupBy)))
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
 	case plan.Node_JOIN:
-		needSwap, joinTyp := joinType(n, ns)
+		joinTyp := joinType(n, ns)
 		curr := c.anal.curr
 		c.anal.curr = int(n.Children[0])
 		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
@@ -395,10 +413,7 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 			return nil, err
 		}
 		c.anal.curr = curr
-		if needSwap {
-			return c.compileSort(n, c.compileJoin(n, ns[n.Children[0]], children, ss, joinTyp)), nil
-		}
-		return c.compileSort(n, c.compileJoin(n, ns[n.Children[1]], ss, children, joinTyp)), nil
+		return c.compileSort(n, c.compileJoin(n, ns[n.Children[0]], ns[n.Children[1]], ss, children, joinTyp)), nil
 	case plan.Node_SORT:
 		curr := c.anal.curr
 		c.anal.curr = int(n.Children[0])
@@ -409,6 +424,24 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		c.anal.curr = curr
 		ss = c.compileSort(n, ss)
 		return c.compileProjection(n, c.compileRestrict(n, ss)), nil
+	case plan.Node_WINDOW:
+		curr := c.anal.curr
+		c.anal.curr = int(n.Children[0])
+		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		c.anal.curr = curr
+		return c.compileProjection(n, c.compileWindow(n, ss)), nil
+	case plan.Node_FUNCTION_SCAN:
+		curr := c.anal.curr
+		c.anal.curr = int(n.Children[0])
+		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		c.anal.curr = curr
+		return c.compileProjection(n, c.compileRestrict(n, c.compileJsonTable(n, ss))), nil
 	case plan.Node_UNION:
 		curr := c.anal.curr
 		c.anal.curr = int(n.Children[0])
@@ -423,6 +456,18 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		}
 		c.anal.curr = curr
 		return c.compileSort(n, c.compileUnion(n, ss, children, ns)), nil
+	case plan.Node_RECURSIVE_CTE:
+		curr := c.anal.curr
+		c.anal.curr = int(n.Children[0])
+		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		c.anal.curr = curr
+		if ss, err = c.compileRecursiveCte(n, ss, ns); err != nil {
+			return nil, err
+		}
+		return c.compileSort(n, ss), nil
 	case plan.Node_MINUS, plan.Node_INTERSECT, plan.Node_INTERSECT_ALL:
 		curr := c.anal.curr
 		c.anal.curr = int(n.Children[0])
@@ -499,6 +544,10 @@ func (c *Compile) compileExternScan(n *plan.Node) []*Scope {
 }
 
 func (c *Compile) compileTableScan(n *plan.Node) []*Scope {
+	// the rows found through an index are read by one node
+	if n.IndexScan != nil && len(c.cnList) > 0 {
+		return []*Scope{c.compileTableScanWithNode(n, c.cnList[0])}
+	}
 	ss := make([]*Scope, 0, len(c.cnList))
 	for i := range c.cnList {
 		ss = append(ss, c.compileTableScanWithNode(n, c.cnList[i]))
@@ -520,6 +569,9 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 			Attributes:   attrs,
 			RelationName: n.TableDef.Name,
 			SchemaName:   n.ObjRef.SchemaName,
+			IndexScan:    n.IndexScan,
+			Expr:         colexec.RewriteFilterExprList(n.FilterList),
+			AnalyzeIdx:   c.anal.curr,
 		},
 	}
 	s.Proc = process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes())
@@ -576,6 +628,194 @@ func (c *Compile) compileUnion(n *plan.Node, ss []*Scope, children []*Scope, ns
 	return rs
 }
 
+// compileRecursiveCte merges the anchor part of the recursive CTE, and then runs its
+// recursive part over the rows returned by the last run until it returns nothing.
+func (c *Compile) compileRecursiveCte(n *plan.Node, ss []*Scope, ns []*plan.Node) ([]*Scope, error) {
+	step, err := c.newRecursiveStep(n, ns)
+	if err != nil {
+		return nil, err
+	}
+	rs := c.newMergeScope(ss)
+	rs.Instructions[0] = vm.Instruction{
+		Op:  vm.Recursive,
+		Idx: c.anal.curr,
+		Arg: constructRecursive(n, &recursiveRun{step: step}),
+	}
+	return []*Scope{rs}, nil
+}
+
+// newRecursiveStep compiles the recursive part of the recursive CTE into a pipeline,
+// its work table scans are filled when the scopes are made from the pipeline to run.
+func (c *Compile) newRecursiveStep(n *plan.Node, ns []*plan.Node) (*recursiveStep, error) {
+	cc := &Compile{
+		info:   c.info,
+		db:     c.db,
+		uid:    c.uid,
+		sql:    c.sql,
+		e:      c.e,
+		ctx:    c.ctx,
+		proc:   c.proc,
+		cnList: c.cnList,
+		stmt:   c.stmt,
+		anal: &anaylze{
+			curr:      int(n.Children[1]),
+			qry:       c.anal.qry,
+			analInfos: c.anal.analInfos,
+		},
+		workTables: make(map[string]*workTable, len(c.workTables)+1),
+	}
+	for name, wt := range c.workTables {
+		cc.workTables[name] = wt
+	}
+	cc.workTables[n.RecursiveCte.Name] = &workTable{names: n.RecursiveCte.ColNames}
+	ss, err := cc.compilePlanScope(ns[n.Children[1]], ns)
+	if err != nil {
+		return nil, err
+	}
+	data, steps, err := encodeLocalScope(cc.newMergeScope(ss))
+	if err != nil {
+		return nil, err
+	}
+	return &recursiveStep{
+		c:     c,
+		name:  n.RecursiveCte.Name,
+		names: n.RecursiveCte.ColNames,
+		data:  data,
+		steps: steps,
+	}, nil
+}
+
+// Run makes the scopes of the recursive part from its pipeline, and returns all rows of them
+// over the work table.
+func (r *recursiveRun) Run(proc *process.Process, bat *batch.Batch) (*batch.Batch, error) {
+	step := r.step
+	workTables := make(map[string]*workTable, len(r.workTables)+1)
+	for name, wt := range r.workTables {
+		workTables[name] = wt
+	}
+	workTables[step.name] = &workTable{names: step.names, bat: bat}
+	rs, err := decodeLocalScope(step.data, proc, step.steps, workTables, step.c.anal.analInfos)
+	if err != nil {
+		return nil, err
+	}
+	var bats []*batch.Batch
+	defer func() {
+		for _, bat := range bats {
+			// the copies never run or filtered out entirely are not freed by the scopes
+			if bat.Cnt > 0 {
+				bat.Clean(proc.Mp())
+			}
+		}
+	}()
+	if err = fillWorkTableScans(rs, workTables, proc, &bats); err != nil {
+		return nil, err
+	}
+	var rbat *batch.Batch
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op: vm.Output,
+		Arg: &output.Argument{
+			Func: func(_ any, bat *batch.Batch) error {
+				if rbat == nil {
+					rbat = batch.NewWithSize(len(bat.Vecs))
+					for i, vec := range bat.Vecs {
+						rbat.Vecs[i] = vector.New(vec.Typ)
+					}
+				}
+				_, err := rbat.Append(proc.Mp(), bat)
+				return err
+			},
+		},
+	})
+	c := step.c
+	cc := &Compile{
+		info:   c.info,
+		db:     c.db,
+		uid:    c.uid,
+		sql:    c.sql,
+		e:      c.e,
+		ctx:    proc.Ctx,
+		proc:   proc,
+		cnList: c.cnList,
+		stmt:   c.stmt,
+		anal:   c.anal,
+	}
+	if err := rs.MergeRun(cc); err != nil {
+		if rbat != nil {
+			rbat.Clean(proc.Mp())
+		}
+		return nil, err
+	}
+	return rbat, nil
+}
+
+// compileWorkTableScan reads the work table of the recursive CTE being compiled, the scope
+// reads nothing until it is filled by fillWorkTableScans.
+func (c *Compile) compileWorkTableScan(n *plan.Node) ([]*Scope, error) {
+	wt, ok := c.workTables[n.TableDef.Name]
+	if !ok {
+		return nil, errors.New(errno.InternalError, fmt.Sprintf("work table of recursive CTE '%s' not found", n.TableDef.Name))
+	}
+	attrs := make([]string, len(n.TableDef.Cols))
+	for i, col := range n.TableDef.Cols {
+		if getWorkTableCol(wt, col.Name) == -1 {
+			return nil, errors.New(errno.InternalError, fmt.Sprintf("column '%s' of recursive CTE '%s' not found", col.Name, n.TableDef.Name))
+		}
+		attrs[i] = col.Name
+	}
+	ds := &Scope{Magic: Normal}
+	ds.Proc = process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes())
+	ds.DataSource = &Source{
+		RelationName: n.TableDef.Name,
+		Attributes:   attrs,
+		Bat:          batch.NewWithSize(0),
+	}
+	return []*Scope{ds}, nil
+}
+
+// fillWorkTableScans makes the work table scans of the scopes read copies of the work tables,
+// the copies are added to bats.
+func fillWorkTableScans(s *Scope, workTables map[string]*workTable, proc *process.Process, bats *[]*batch.Batch) error {
+	for _, ps := range s.PreScopes {
+		if err := fillWorkTableScans(ps, workTables, proc, bats); err != nil {
+			return err
+		}
+	}
+	if s.DataSource == nil || s.DataSource.Bat == nil {
+		return nil
+	}
+	wt, ok := workTables[s.DataSource.RelationName]
+	if !ok || wt.bat == nil {
+		return nil
+	}
+	bat := batch.NewWithSize(len(s.DataSource.Attributes))
+	for i, name := range s.DataSource.Attributes {
+		j := getWorkTableCol(wt, name)
+		if j == -1 {
+			bat.Clean(proc.Mp())
+			return errors.New(errno.InternalError, fmt.Sprintf("column '%s' of recursive CTE '%s' not found", name, s.DataSource.RelationName))
+		}
+		vec, err := vector.Dup(wt.bat.Vecs[j], proc.Mp())
+		if err != nil {
+			bat.Clean(proc.Mp())
+			return err
+		}
+		bat.Vecs[i] = vec
+	}
+	bat.Zs = append(proc.Mp().GetSels(), wt.bat.Zs...)
+	*bats = append(*bats, bat)
+	s.DataSource.Bat = bat
+	return nil
+}
+
+func getWorkTableCol(wt *workTable, name string) int {
+	for i, colName := range wt.names {
+		if colName == name {
+			return i
+		}
+	}
+	return -1
+}
+
 func (c *Compile) compileMinusAndIntersect(n *plan.Node, ss []*Scope, children []*Scope, nodeType plan.Node_NodeType) []*Scope {
 	rs := c.newJoinScopeListWithBucket(c.newScopeList(2), ss, children)
 	switch nodeType {
@@ -614,7 +854,10 @@ func (c *Compile) compileUnionAll(n *plan.Node, ss []*Scope, children []*Scope)
 	return []*Scope{rs}
 }
 
-func (c *Compile) compileJoin(n, right *plan.Node, ss []*Scope, children []*Scope, joinTyp plan.Node_JoinFlag) []*Scope {
+func (c *Compile) compileJoin(n, left, right *plan.Node, ss []*Scope, children []*Scope, joinTyp plan.Node_JoinFlag) []*Scope {
+	if joinTyp == plan.Node_RIGHT || joinTyp == plan.Node_OUTER {
+		ss = c.newProbeScopeList(ss)
+	}
 	rs := c.newJoinScopeList(ss, children)
 	isEq := isEquiJoin(n.OnList)
 	typs := make([]types.Type, len(right.ProjectList))
@@ -680,6 +923,29 @@ func (c *Compile) compileJoin(n, right *plan.Node, ss []*Scope, children []*Scop
 				})
 			}
 		}
+	case plan.Node_RIGHT, plan.Node_OUTER:
+		if !isEq {
+			panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("join typ '%v' without equal condition not support now", n.JoinType)))
+		}
+		leftTyps := make([]types.Type, len(left.ProjectList))
+		for i, expr := range left.ProjectList {
+			leftTyps[i] = dupType(expr.Typ)
+		}
+		for i := range rs {
+			if joinTyp == plan.Node_RIGHT {
+				rs[i].appendInstruction(vm.Instruction{
+					Op:  vm.Right,
+					Idx: c.anal.curr,
+					Arg: constructRight(n, typs, leftTyps, c.proc),
+				})
+			} else {
+				rs[i].appendInstruction(vm.Instruction{
+					Op:  vm.Full,
+					Idx: c.anal.curr,
+					Arg: constructFull(n, typs, leftTyps, c.proc),
+				})
+			}
+		}
 	case plan.Node_SINGLE:
 		for i := range rs {
 			if isEq {
@@ -774,6 +1040,43 @@ func (c *Compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	return []*Scope{rs}
 }
 
+// compileWindow sorts all the rows by partition keys and order keys,
+// then merges them into one scope to compute the window function.
+// compileJsonTable joins the rows of each scope with the rows of JSON_TABLE
+func (c *Compile) compileJsonTable(n *plan.Node, ss []*Scope) []*Scope {
+	for i := range ss {
+		ss[i].appendInstruction(vm.Instruction{
+			Op:  vm.JsonTable,
+			Idx: c.anal.curr,
+			Arg: constructJsonTable(n),
+		})
+	}
+	return ss
+}
+
+func (c *Compile) compileWindow(n *plan.Node, ss []*Scope) []*Scope {
+	on := &plan.Node{
+		OrderBy: make([]*plan.OrderBySpec, 0, len(n.WinSpec.PartitionBy)+len(n.WinSpec.OrderBy)),
+	}
+	for _, e := range n.WinSpec.PartitionBy {
+		on.OrderBy = append(on.OrderBy, &plan.OrderBySpec{
+			Expr: e,
+			Flag: plan.OrderBySpec_ASC,
+		})
+	}
+	on.OrderBy = append(on.OrderBy, n.WinSpec.OrderBy...)
+	if len(on.OrderBy) > 0 {
+		ss = c.compileOrder(on, ss)
+	}
+	rs := c.newMergeScope(ss)
+	rs.appendInstruction(vm.Instruction{
+		Op:  vm.Window,
+		Idx: c.anal.curr,
+		Arg: constructWindow(n),
+	})
+	return []*Scope{rs}
+}
+
 func (c *Compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	rs := c.newMergeScope(ss)
 	rs.Instructions[0] = vm.Instruction{
@@ -919,6 +1222,24 @@ func (c *Compile) newJoinScopeListWithBucket(rs, ss, children []*Scope) []*Scope
 	return rs
 }
 
+// newProbeScopeList merges the probe side of a right or full join into one scope.
+// The build rows never matched are only known once the whole probe side is done,
+// so the parallel probe pipelines of the join must share a single build side.
+func (c *Compile) newProbeScopeList(ss []*Scope) []*Scope {
+	if len(ss) == 1 {
+		return ss
+	}
+	mcpu := 1
+	for i := range ss {
+		if ss[i].NodeInfo.Mcpu > mcpu {
+			mcpu = ss[i].NodeInfo.Mcpu
+		}
+	}
+	rs := c.newMergeScope(ss)
+	rs.NodeInfo = engine.Node{Mcpu: mcpu}
+	return []*Scope{rs}
+}
+
 func (c *Compile) newJoinScopeList(ss []*Scope, children []*Scope) []*Scope {
 	rs := make([]*Scope, len(ss))
 	for i := range ss {
@@ -1015,6 +1336,7 @@ func (c *Compile) fillAnalyzeInfo() {
 		c.anal.qry.Nodes[i].AnalyzeInfo.OutputSize = atomic.LoadInt64(&anal.OutputSize)
 		c.anal.qry.Nodes[i].AnalyzeInfo.TimeConsumed = atomic.LoadInt64(&anal.TimeConsumed)
 		c.anal.qry.Nodes[i].AnalyzeInfo.MemorySize = atomic.LoadInt64(&anal.MemorySize)
+		c.anal.qry.Nodes[i].AnalyzeInfo.PrunedBlocks = atomic.LoadInt64(&anal.PrunedBlocks)
 	}
 }
 
@@ -1066,22 +1388,24 @@ func rewriteExprForAggNode(expr *plan.Expr, groupSize int32) {
 	}
 }
 
-func joinType(n *plan.Node, ns []*plan.Node) (bool, plan.Node_JoinFlag) {
+func joinType(n *plan.Node, ns []*plan.Node) plan.Node_JoinFlag {
 	switch n.JoinType {
 	case plan.Node_INNER:
-		return false, plan.Node_INNER
+		return plan.Node_INNER
 	case plan.Node_LEFT:
-		return false, plan.Node_LEFT
+		return plan.Node_LEFT
 	case plan.Node_SEMI:
-		return false, plan.Node_SEMI
+		return plan.Node_SEMI
 	case plan.Node_ANTI:
-		return false, plan.Node_ANTI
+		return plan.Node_ANTI
 	case plan.Node_RIGHT:
-		return true, plan.Node_LEFT
+		return plan.Node_RIGHT
+	case plan.Node_OUTER:
+		return plan.Node_OUTER
 	case plan.Node_SINGLE:
-		return false, plan.Node_SINGLE
+		return plan.Node_SINGLE
 	case plan.Node_MARK:
-		return false, plan.Node_MARK
+		return plan.Node_MARK
 	default:
 		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("join typ '%v' not support now", n.JoinType)))
 	}
@@ -53,9 +53,15 @@ func init() {
 		newTestCase("select count(*) from R", new(testing.T)),
 		newTestCase("select * from R join S on R.uid = S.uid", new(testing.T)),
 		newTestCase("select * from R left join S on R.uid = S.uid", new(testing.T)),
+		newTestCase("select * from R full join S on R.uid = S.uid", new(testing.T)),
 		newTestCase("select * from R right join S on R.uid = S.uid", new(testing.T)),
 		newTestCase("select * from R join S on R.uid > S.uid", new(testing.T)),
+		newTestCase("select * from R left join S on R.uid = S.uid limit 1", new(testing.T)),
+		newTestCase("select uid from (select * from R) t limit 2, 1", new(testing.T)),
 		newTestCase("insert into R select * from R", new(testing.T)),
+		newTestCase("with recursive c(n) as (select 1 union all select n + 1 from c where n < 5) select * from c", new(testing.T)),
+		newTestCase("with recursive c(n) as (select 1 union select n % 3 + 1 from c) select * from c", new(testing.T)),
+		newTestCase("with recursive c(n) as (select 1 union all select n + 1 from c where n < 3) select * from R join c on R.uid = c.n", new(testing.T)),
 	}
 }
 
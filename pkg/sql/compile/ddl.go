@@ -19,6 +19,8 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -87,6 +89,20 @@ func (s *Scope) CreateTable(c *Compile) error {
 	if err := dbSource.Create(c.ctx, tblName, append(exeCols, exeDefs...)); err != nil {
 		return err
 	}
+	for _, def := range planDefs {
+		switch defVal := def.GetDef().(type) {
+		case *plan.TableDef_DefType_Idx:
+			if defVal.Idx.GetTyp() == plan.IndexDef_SECONDARY {
+				if err := colexec.CreateIndex(c.ctx, c.proc, dbSource, tblName, defVal.Idx); err != nil {
+					return err
+				}
+			}
+		case *plan.TableDef_DefType_Constraint:
+			if err := colexec.AddRefChildren(c.ctx, dbSource, tblName, defVal.Constraint); err != nil {
+				return err
+			}
+		}
+	}
 	return colexec.CreateAutoIncrCol(dbSource, c.ctx, c.proc, planCols, tblName)
 }
 
@@ -109,22 +125,163 @@ func (s *Scope) DropTable(c *Compile) error {
 		}
 		return err
 	}
+	if err := colexec.DropRefs(c.ctx, dbSource, tblName, rel); err != nil {
+		return err
+	}
+	if err := colexec.DropIndexes(c.ctx, dbSource, tblName); err != nil {
+		return err
+	}
 	if err := dbSource.Delete(c.ctx, tblName); err != nil {
 		return err
 	}
 	return colexec.DeleteAutoIncrCol(rel, dbSource, c.ctx, c.proc, rel.GetTableID(c.ctx))
 }
 
+func (s *Scope) CreateIndex(c *Compile) error {
+	qry := s.Plan.GetDdl().GetCreateIndex()
+	dbSource, err := c.e.Database(c.ctx, qry.GetDatabase(), c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	if _, err := dbSource.Relation(c.ctx, colexec.GetIndexTableName(qry.GetTable(), qry.GetIndex())); err == nil {
+		if qry.GetIfNotExists() {
+			return nil
+		}
+		return errors.New(errno.DuplicateObject, fmt.Sprintf("Duplicate key name '%s'", qry.GetIndex()))
+	}
+	return colexec.CreateIndex(c.ctx, c.proc, dbSource, qry.GetTable(), qry.GetIndexDef())
+}
+
+func (s *Scope) DropIndex(c *Compile) error {
+	qry := s.Plan.GetDdl().GetDropIndex()
+	dbSource, err := c.e.Database(c.ctx, qry.GetDatabase(), c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	if _, err := dbSource.Relation(c.ctx, colexec.GetIndexTableName(qry.GetTable(), qry.GetIndex())); err != nil {
+		if qry.GetIfExists() {
+			return nil
+		}
+		return errors.New(errno.UndefinedObject, fmt.Sprintf("Can't DROP '%s'; check that column/key exists", qry.GetIndex()))
+	}
+	return colexec.DropIndex(c.ctx, dbSource, qry.GetTable(), qry.GetIndex())
+}
+
+func (s *Scope) AlterTable(c *Compile) error {
+	qry := s.Plan.GetDdl().GetAlterTable()
+	dbSource, err := c.e.Database(c.ctx, qry.GetDatabase(), c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	rel, err := dbSource.Relation(c.ctx, qry.GetTable())
+	if err != nil {
+		return err
+	}
+	// the relation is looked up by its name, so a rename is done after
+	// all the other changes of the statement
+	newName := ""
+	for _, action := range qry.GetActions() {
+		switch act := action.GetAction().(type) {
+		case *plan.AlterTableAction_AddColumn:
+			col := act.AddColumn
+			attr := planColsToExeCols([]*plan.ColDef{col})[0].(*engine.AttributeDef).Attr
+			fill, err := evalFillValue(c, col)
+			if err != nil {
+				return err
+			}
+			if err = rel.AddTableDef(c.ctx, &engine.AddColumnDef{Attr: attr, Fill: fill}); err != nil {
+				return err
+			}
+		case *plan.AlterTableAction_DropColumn:
+			if err = rel.DelTableDef(c.ctx, &engine.AttributeDef{Attr: engine.Attribute{Name: act.DropColumn}}); err != nil {
+				return err
+			}
+		case *plan.AlterTableAction_RenameColumn:
+			def := &engine.RenameColumnDef{
+				OldName: act.RenameColumn.GetOldName(),
+				NewName: act.RenameColumn.GetNewName(),
+			}
+			if err = rel.AddTableDef(c.ctx, def); err != nil {
+				return err
+			}
+		case *plan.AlterTableAction_ModifyColumn:
+			attr := planColsToExeCols([]*plan.ColDef{act.ModifyColumn})[0].(*engine.AttributeDef).Attr
+			if err = rel.AddTableDef(c.ctx, &engine.ModifyColumnDef{Attr: attr}); err != nil {
+				return err
+			}
+		case *plan.AlterTableAction_AddIndex:
+			if err = colexec.CreateIndex(c.ctx, c.proc, dbSource, qry.GetTable(), act.AddIndex.GetIndexDef()); err != nil {
+				return err
+			}
+		case *plan.AlterTableAction_DropIndex:
+			if err = colexec.DropIndex(c.ctx, dbSource, qry.GetTable(), act.DropIndex.GetIndex()); err != nil {
+				return err
+			}
+		case *plan.AlterTableAction_RenameTable:
+			newName = act.RenameTable
+		case *plan.AlterTableAction_Properties:
+			defs, err := planDefsToExeDefs([]*plan.TableDef_DefType{
+				{Def: &plan.TableDef_DefType_Properties{Properties: act.Properties}},
+			})
+			if err != nil {
+				return err
+			}
+			if err = rel.AddTableDef(c.ctx, defs[0]); err != nil {
+				return err
+			}
+		}
+	}
+	if newName == "" {
+		return nil
+	}
+	if _, err := dbSource.Relation(c.ctx, newName); err == nil {
+		return errors.New(errno.DuplicateTable, fmt.Sprintf("table '%s' already exists", newName))
+	}
+	if err = colexec.RenameIndexes(c.ctx, dbSource, qry.GetTable(), newName); err != nil {
+		return err
+	}
+	if err = colexec.RenameRefs(c.ctx, dbSource, rel, qry.GetTable(), newName); err != nil {
+		return err
+	}
+	return rel.AddTableDef(c.ctx, &engine.RenameTableDef{Name: newName})
+}
+
+// evalFillValue evaluates the default of a column added to a table, the rows
+// already in the table read the result as the value of the column.
+func evalFillValue(c *Compile, col *plan.ColDef) ([]byte, error) {
+	expr := col.GetDefault().GetExpr()
+	if expr == nil {
+		return nil, nil
+	}
+	bat := batch.NewWithSize(0)
+	bat.Zs = []int64{1}
+	vec, err := colexec.EvalExpr(bat, c.proc, expr)
+	if err != nil {
+		return nil, err
+	}
+	defer vec.Free(c.proc.Mp())
+	if vec.IsScalarNull() || nulls.Contains(vec.Nsp, 0) {
+		return nil, nil
+	}
+	return append([]byte{}, vec.GetRawBytesAt(0)...), nil
+}
+
+// planDefsToExeDefs converts the defs of a table, a secondary index is not converted
+// since it is kept in a table of its own.
 func planDefsToExeDefs(planDefs []*plan.TableDef_DefType) ([]engine.TableDef, error) {
-	exeDefs := make([]engine.TableDef, len(planDefs))
-	for i, def := range planDefs {
+	exeDefs := make([]engine.TableDef, 0, len(planDefs))
+	for _, def := range planDefs {
+		var exeDef engine.TableDef
 		switch defVal := def.GetDef().(type) {
 		case *plan.TableDef_DefType_Pk:
-			exeDefs[i] = &engine.PrimaryIndexDef{
+			exeDef = &engine.PrimaryIndexDef{
 				Names: defVal.Pk.GetNames(),
 			}
 		case *plan.TableDef_DefType_Idx:
-			exeDefs[i] = &engine.IndexTableDef{
+			if defVal.Idx.GetTyp() == plan.IndexDef_SECONDARY {
+				continue
+			}
+			exeDef = &engine.IndexTableDef{
 				ColNames: defVal.Idx.GetColNames(),
 				Name:     defVal.Idx.GetName(),
 			}
@@ -136,11 +293,11 @@ func planDefsToExeDefs(planDefs []*plan.TableDef_DefType) ([]engine.TableDef, er
 					Value: p.GetValue(),
 				}
 			}
-			exeDefs[i] = &engine.PropertiesDef{
+			exeDef = &engine.PropertiesDef{
 				Properties: properties,
 			}
 		case *plan.TableDef_DefType_View:
-			exeDefs[i] = &engine.ViewDef{
+			exeDef = &engine.ViewDef{
 				View: defVal.View.View,
 			}
 		case *plan.TableDef_DefType_Partition:
@@ -148,9 +305,20 @@ func planDefsToExeDefs(planDefs []*plan.TableDef_DefType) ([]engine.TableDef, er
 			if err != nil {
 				return nil, err
 			}
-			exeDefs[i] = &engine.PartitionDef{
+			exeDef = &engine.PartitionDef{
 				Partition: string(bytes),
 			}
+		case *plan.TableDef_DefType_Constraint:
+			bytes, err := defVal.Constraint.Marshal()
+			if err != nil {
+				return nil, err
+			}
+			exeDef = &engine.ConstraintDef{
+				Constraint: string(bytes),
+			}
+		}
+		if exeDef != nil {
+			exeDefs = append(exeDefs, exeDef)
 		}
 	}
 	return exeDefs, nil
@@ -165,6 +333,12 @@ func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 			alg = compress.None
 		case plan.CompressType_Lz4:
 			alg = compress.Lz4
+		case plan.CompressType_Zstd:
+			alg = compress.Zstd
+		case plan.CompressType_Dict:
+			alg = compress.Dict
+		case plan.CompressType_Rle:
+			alg = compress.Rle
 		}
 		colTyp := col.GetTyp()
 		exeCols[i] = &engine.AttributeDef{
@@ -183,6 +357,7 @@ func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 				Primary:       col.GetPrimary(),
 				Comment:       col.GetComment(),
 				AutoIncrement: col.GetAutoIncrement(),
+				EnumValues:    colTyp.GetEnumvalues(),
 			},
 		}
 	}
@@ -36,6 +36,9 @@ func (s *Scope) Delete(c *Compile) (uint64, error) {
 	arg := s.Instructions[len(s.Instructions)-1].Arg.(*deletion.Argument)
 
 	if arg.DeleteCtxs[0].CanTruncate {
+		if err := arg.DeleteCtxs[0].Indexes.Truncate(c.ctx); err != nil {
+			return 0, err
+		}
 		return arg.DeleteCtxs[0].TableSource.Truncate(c.ctx)
 	}
 
@@ -89,9 +92,23 @@ func (s *Scope) InsertValues(c *Compile, stmt *tree.Insert) (uint64, error) {
 	if err = colexec.UpdateInsertValueBatch(c.e, c.ctx, c.proc, p, bat); err != nil {
 		return 0, err
 	}
+	constraints, err := colexec.NewTableConstraints(c.ctx, dbSource, p.TblName, relation)
+	if err != nil {
+		return 0, err
+	}
+	if err := constraints.Write(c.ctx, c.proc, bat); err != nil {
+		return 0, err
+	}
 	if err := relation.Write(c.ctx, bat); err != nil {
 		return 0, err
 	}
+	indexes, err := colexec.NewTableIndexes(c.ctx, dbSource, p.TblName, relation)
+	if err != nil {
+		return 0, err
+	}
+	if err := indexes.Write(c.ctx, c.proc, bat); err != nil {
+		return 0, err
+	}
 
 	return uint64(len(p.Columns[0].Column)), nil
 }
@@ -357,6 +374,60 @@ func fillBatch(bat *batch.Batch, p *plan.InsertValues, rows []tree.Exprs, proc *
 			if err := vector.AppendFixed(v, vs, proc.Mp()); err != nil {
 				return err
 			}
+		case types.T_time:
+			vs := make([]types.Time, rowCount)
+			{
+				for j, expr := range p.Columns[i].Column {
+					vec, err := colexec.EvalExpr(tmpBat, proc, expr)
+					if err != nil {
+						return y.MakeInsertError(v.Typ.Oid, p.ExplicitCols[i], rows, i, j)
+					}
+					if nulls.Any(vec.Nsp) {
+						nulls.Add(v.Nsp, uint64(j))
+					} else {
+						vs[j] = vector.GetValueAt[types.Time](vec, 0)
+					}
+				}
+			}
+			if err := vector.AppendFixed(v, vs, proc.Mp()); err != nil {
+				return err
+			}
+		case types.T_enum:
+			vs := make([]types.Enum, rowCount)
+			{
+				for j, expr := range p.Columns[i].Column {
+					vec, err := colexec.EvalExpr(tmpBat, proc, expr)
+					if err != nil {
+						return y.MakeInsertError(v.Typ.Oid, p.ExplicitCols[i], rows, i, j)
+					}
+					if nulls.Any(vec.Nsp) {
+						nulls.Add(v.Nsp, uint64(j))
+					} else {
+						vs[j] = vector.GetValueAt[types.Enum](vec, 0)
+					}
+				}
+			}
+			if err := vector.AppendFixed(v, vs, proc.Mp()); err != nil {
+				return err
+			}
+		case types.T_set:
+			vs := make([]types.Set, rowCount)
+			{
+				for j, expr := range p.Columns[i].Column {
+					vec, err := colexec.EvalExpr(tmpBat, proc, expr)
+					if err != nil {
+						return y.MakeInsertError(v.Typ.Oid, p.ExplicitCols[i], rows, i, j)
+					}
+					if nulls.Any(vec.Nsp) {
+						nulls.Add(v.Nsp, uint64(j))
+					} else {
+						vs[j] = vector.GetValueAt[types.Set](vec, 0)
+					}
+				}
+			}
+			if err := vector.AppendFixed(v, vs, proc.Mp()); err != nil {
+				return err
+			}
 		case types.T_datetime:
 			vs := make([]types.Datetime, rowCount)
 			{
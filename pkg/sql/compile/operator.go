@@ -20,9 +20,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/intersectall"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/jsontable"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/anti"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/full"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/intersect"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopanti"
@@ -60,10 +62,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/recursive"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/right"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/semi"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/single"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/vm"
@@ -113,6 +118,24 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 			Result:     arg.Result,
 			Conditions: arg.Conditions,
 		}
+	case *right.Argument:
+		rin.Arg = &right.Argument{
+			Typs:       arg.Typs,
+			LeftTypes:  arg.LeftTypes,
+			Cond:       arg.Cond,
+			Result:     arg.Result,
+			Conditions: arg.Conditions,
+			Matched:    arg.Matched.Share(),
+		}
+	case *full.Argument:
+		rin.Arg = &full.Argument{
+			Typs:       arg.Typs,
+			LeftTypes:  arg.LeftTypes,
+			Cond:       arg.Cond,
+			Result:     arg.Result,
+			Conditions: arg.Conditions,
+			Matched:    arg.Matched.Share(),
+		}
 	case *group.Argument:
 		rin.Arg = &group.Argument{
 			Aggs:    arg.Aggs,
@@ -253,11 +276,24 @@ func constructDeletion(n *plan.Node, eg engine.Engine, txnOperator TxnOperator)
 			return nil, err
 		}
 
+		indexes, err := colexec.NewTableIndexes(ctx, dbSource, n.DeleteTablesCtx[i].TblName, relation)
+		if err != nil {
+			return nil, err
+		}
+		constraints, err := colexec.NewTableConstraints(ctx, dbSource, n.DeleteTablesCtx[i].TblName, relation)
+		if err != nil {
+			return nil, err
+		}
+
 		ds[i] = &deletion.DeleteCtx{
-			TableSource:  relation,
-			UseDeleteKey: n.DeleteTablesCtx[i].UseDeleteKey,
-			CanTruncate:  n.DeleteTablesCtx[i].CanTruncate,
-			IsHideKey:    n.DeleteTablesCtx[i].IsHideKey,
+			TableSource:   relation,
+			UseDeleteKey:  n.DeleteTablesCtx[i].UseDeleteKey,
+			CanTruncate:   n.DeleteTablesCtx[i].CanTruncate,
+			IsHideKey:     n.DeleteTablesCtx[i].IsHideKey,
+			IndexAttrs:    n.DeleteTablesCtx[i].IndexAttrs,
+			IndexAttrsIdx: n.DeleteTablesCtx[i].IndexAttrsIdx,
+			Indexes:       indexes,
+			Constraints:   constraints,
 		}
 	}
 
@@ -276,12 +312,22 @@ func constructInsert(n *plan.Node, eg engine.Engine, txnOperator TxnOperator) (*
 	if err != nil {
 		return nil, err
 	}
+	indexes, err := colexec.NewTableIndexes(ctx, db, n.TableDef.Name, relation)
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := colexec.NewTableConstraints(ctx, db, n.TableDef.Name, relation)
+	if err != nil {
+		return nil, err
+	}
 	return &insert.Argument{
 		TargetTable:   relation,
 		TargetColDefs: n.TableDef.Cols,
 		Engine:        eg,
 		DB:            db,
 		TableID:       relation.GetTableID(ctx),
+		Indexes:       indexes,
+		Constraints:   constraints,
 	}, nil
 }
 
@@ -302,6 +348,16 @@ func constructUpdate(n *plan.Node, eg engine.Engine, txnOperator TxnOperator) (*
 		}
 
 		tableID[i] = relation.GetTableID(ctx)
+		var indexes *colexec.TableIndexes
+		if len(updateCtx.IndexAttrs) > 0 {
+			if indexes, err = colexec.NewTableIndexes(ctx, dbSource, updateCtx.TblName, relation); err != nil {
+				return nil, err
+			}
+		}
+		constraints, err := colexec.NewTableConstraints(ctx, dbSource, updateCtx.TblName, relation)
+		if err != nil {
+			return nil, err
+		}
 		colNames := make([]string, 0, len(updateCtx.UpdateCols))
 		for _, col := range updateCtx.UpdateCols {
 			colNames = append(colNames, col.Name)
@@ -315,7 +371,10 @@ func constructUpdate(n *plan.Node, eg engine.Engine, txnOperator TxnOperator) (*
 			UpdateAttrs: colNames,
 			OtherAttrs:  updateCtx.OtherAttrs,
 			OrderAttrs:  updateCtx.OrderAttrs,
+			IndexAttrs:  updateCtx.IndexAttrs,
 			TableSource: relation,
+			Indexes:     indexes,
+			Constraints: constraints,
 		}
 	}
 	return &update.Argument{
@@ -344,6 +403,7 @@ func constructExternal(n *plan.Node, ctx context.Context) *external.Argument {
 			Cols:          n.TableDef.Cols,
 			Name2ColIndex: n.TableDef.Name2ColIndex,
 			CreateSql:     n.TableDef.Createsql,
+			Filters:       n.FilterList,
 			Ctx:           ctx,
 		},
 	}
@@ -413,6 +473,38 @@ func constructLeft(n *plan.Node, typs []types.Type, proc *process.Process) *left
 	}
 }
 
+func constructRight(n *plan.Node, typs, leftTyps []types.Type, proc *process.Process) *right.Argument {
+	result := make([]colexec.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr)
+	}
+	cond, conds := extraJoinConditions(n.OnList)
+	return &right.Argument{
+		Typs:       typs,
+		LeftTypes:  leftTyps,
+		Result:     result,
+		Cond:       cond,
+		Conditions: constructJoinConditions(conds),
+		Matched:    colexec.NewMatchedRows(),
+	}
+}
+
+func constructFull(n *plan.Node, typs, leftTyps []types.Type, proc *process.Process) *full.Argument {
+	result := make([]colexec.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr)
+	}
+	cond, conds := extraJoinConditions(n.OnList)
+	return &full.Argument{
+		Typs:       typs,
+		LeftTypes:  leftTyps,
+		Result:     result,
+		Cond:       cond,
+		Conditions: constructJoinConditions(conds),
+		Matched:    colexec.NewMatchedRows(),
+	}
+}
+
 func constructSingle(n *plan.Node, typs []types.Type, proc *process.Process) *single.Argument {
 	result := make([]colexec.ResultPos, len(n.ProjectList))
 	for i, expr := range n.ProjectList {
@@ -623,6 +715,26 @@ func constructMergeLimit(n *plan.Node, proc *process.Process) *mergelimit.Argume
 	}
 }
 
+func constructJsonTable(n *plan.Node) *jsontable.Argument {
+	return &jsontable.Argument{
+		JsonTable: n.JsonTable,
+	}
+}
+
+func constructWindow(n *plan.Node) *window.Argument {
+	return &window.Argument{
+		WinSpec: n.WinSpec,
+	}
+}
+
+func constructRecursive(n *plan.Node, step recursive.Step) *recursive.Argument {
+	return &recursive.Argument{
+		UnionAll: n.RecursiveCte.UnionAll,
+		MaxDepth: n.RecursiveCte.MaxDepth,
+		Step:     step,
+	}
+}
+
 func constructMergeOrder(n *plan.Node, proc *process.Process) *mergeorder.Argument {
 	fs := make([]colexec.Field, len(n.OrderBy))
 	for i, e := range n.OrderBy {
@@ -709,6 +821,7 @@ func constructHashBuild(in vm.Instruction) *hashbuild.Argument {
 		arg := in.Arg.(*anti.Argument)
 		return &hashbuild.Argument{
 			NeedHashMap: true,
+			CanSpill:    true,
 			Typs:        arg.Typs,
 			Conditions:  arg.Conditions[1],
 		}
@@ -716,6 +829,7 @@ func constructHashBuild(in vm.Instruction) *hashbuild.Argument {
 		arg := in.Arg.(*mark.Argument)
 		return &hashbuild.Argument{
 			NeedHashMap: true,
+			CanSpill:    true,
 			Typs:        arg.Typs,
 			Conditions:  arg.Conditions[1],
 		}
@@ -723,6 +837,7 @@ func constructHashBuild(in vm.Instruction) *hashbuild.Argument {
 		arg := in.Arg.(*join.Argument)
 		return &hashbuild.Argument{
 			NeedHashMap: true,
+			CanSpill:    true,
 			Typs:        arg.Typs,
 			Conditions:  arg.Conditions[1],
 		}
@@ -730,6 +845,23 @@ func constructHashBuild(in vm.Instruction) *hashbuild.Argument {
 		arg := in.Arg.(*left.Argument)
 		return &hashbuild.Argument{
 			NeedHashMap: true,
+			CanSpill:    true,
+			Typs:        arg.Typs,
+			Conditions:  arg.Conditions[1],
+		}
+	case vm.Right:
+		arg := in.Arg.(*right.Argument)
+		return &hashbuild.Argument{
+			NeedHashMap: true,
+			CanSpill:    true,
+			Typs:        arg.Typs,
+			Conditions:  arg.Conditions[1],
+		}
+	case vm.Full:
+		arg := in.Arg.(*full.Argument)
+		return &hashbuild.Argument{
+			NeedHashMap: true,
+			CanSpill:    true,
 			Typs:        arg.Typs,
 			Conditions:  arg.Conditions[1],
 		}
@@ -737,6 +869,7 @@ func constructHashBuild(in vm.Instruction) *hashbuild.Argument {
 		arg := in.Arg.(*semi.Argument)
 		return &hashbuild.Argument{
 			NeedHashMap: true,
+			CanSpill:    true,
 			Typs:        arg.Typs,
 			Conditions:  arg.Conditions[1],
 		}
@@ -744,6 +877,7 @@ func constructHashBuild(in vm.Instruction) *hashbuild.Argument {
 		arg := in.Arg.(*single.Argument)
 		return &hashbuild.Argument{
 			NeedHashMap: true,
+			CanSpill:    true,
 			Typs:        arg.Typs,
 			Conditions:  arg.Conditions[1],
 		}
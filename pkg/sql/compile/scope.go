@@ -17,6 +17,7 @@ package compile
 import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/merge"
 
@@ -53,7 +54,11 @@ func (s *Scope) Run(c *Compile) (err error) {
 			return err
 		}
 	} else {
-		if _, err = p.Run(s.DataSource.R, s.Proc); err != nil {
+		_, err = p.Run(s.DataSource.R, s.Proc)
+		if r, ok := s.DataSource.R.(engine.PruneReader); ok {
+			s.Proc.GetAnalyze(s.DataSource.AnalyzeIdx).Prune(r.PrunedBlocks())
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -171,7 +176,19 @@ func (s *Scope) ParallelRun(c *Compile) error {
 		if err != nil {
 			return err
 		}
-		rds, _ = rel.NewReader(c.ctx, mcpu, nil, s.NodeInfo.Data)
+		if s.DataSource.IndexScan != nil {
+			rd, err := colexec.NewIndexReader(c.ctx, s.Proc, db, rel, s.DataSource.IndexScan)
+			if err != nil {
+				return err
+			}
+			if rd != nil {
+				mcpu = 1
+				rds = []engine.Reader{rd}
+			}
+		}
+		if rds == nil {
+			rds, _ = rel.NewReader(c.ctx, mcpu, s.DataSource.Expr, s.NodeInfo.Data)
+		}
 	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
@@ -182,6 +199,7 @@ func (s *Scope) ParallelRun(c *Compile) error {
 				SchemaName:   s.DataSource.SchemaName,
 				RelationName: s.DataSource.RelationName,
 				Attributes:   s.DataSource.Attributes,
+				AnalyzeIdx:   s.DataSource.AnalyzeIdx,
 			},
 		}
 		ss[i].Proc = process.NewWithAnalyze(s.Proc, c.ctx, 0, c.anal.Nodes())
@@ -403,11 +421,11 @@ func (s *Scope) appendInstruction(in vm.Instruction) {
 }
 
 func dupScope(s *Scope) *Scope {
-	data, err := encodeScope(s)
+	data, steps, err := encodeLocalScope(s)
 	if err != nil {
 		return nil
 	}
-	rs, err := decodeScope(data, s.Proc)
+	rs, err := decodeLocalScope(data, s.Proc, steps, nil, nil)
 	if err != nil {
 		return nil
 	}
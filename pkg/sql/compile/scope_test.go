@@ -66,6 +66,25 @@ func TestScopeSerialization(t *testing.T) {
 
 }
 
+func TestRecursiveScopeSerialization(t *testing.T) {
+	sql := "with recursive c(n) as (select 1 union all select n + 1 from c where n < 5) select * from c"
+	sourceScope := generateScopeCases(t, []string{sql})[0]
+
+	// the recursive part can't be sent to another node
+	_, err := encodeScope(sourceScope)
+	require.Error(t, err)
+
+	data, steps, err := encodeLocalScope(sourceScope)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(steps))
+	targetScope, err := decodeLocalScope(data, sourceScope.Proc, steps, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, len(sourceScope.PreScopes), len(targetScope.PreScopes))
+
+	_, err = decodeScope(data, sourceScope.Proc)
+	require.Error(t, err)
+}
+
 func generateScopeCases(t *testing.T, testCases []string) []*Scope {
 	// getScope method generate and return the scope of a SQL string.
 	getScope := func(t1 *testing.T, sql string) *Scope {
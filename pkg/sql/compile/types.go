@@ -51,6 +51,7 @@ const (
 	Insert
 	Update
 	InsertValues
+	AlterTable
 )
 
 // Source contains information of a relation which will be used in execution,
@@ -62,6 +63,12 @@ type Source struct {
 	Attributes   []string
 	R            engine.Reader
 	Bat          *batch.Batch
+	// IndexScan is the secondary index used to find the rows
+	IndexScan *plan.IndexScan
+	// Expr is the filter of the table scan, the reader skips the blocks which can not satisfy it
+	Expr *plan.Expr
+	// AnalyzeIdx is the index of the analyze information of the table scan
+	AnalyzeIdx int
 }
 
 // Col is the information of attribute
@@ -109,6 +116,11 @@ type scopeContext struct {
 	children []*scopeContext
 	pipe     *pipeline.Pipeline
 	regs     map[*process.WaitRegister]int32
+	// steps are the recursive parts of the recursive CTEs in the pipeline, they are kept
+	// by the root context since they can not be encoded.
+	steps []*recursiveStep
+	// workTables are the work tables read by the scopes, they are kept by the root context.
+	workTables map[string]*workTable
 }
 
 // anaylze information
@@ -119,6 +131,36 @@ type anaylze struct {
 	analInfos []*process.AnalyzeInfo
 }
 
+// recursiveStep is the recursive part of a recursive CTE, which is compiled once into a
+// pipeline. Every run makes new scopes from the pipeline, so it can be shared by the copies
+// of the recursive CTE.
+type recursiveStep struct {
+	c    *Compile
+	name string
+	// names are the column names of the recursive CTE
+	names []string
+	// data is the encoded pipeline of the merged scopes of the recursive part
+	data []byte
+	// steps are the recursive parts of the recursive CTEs in the pipeline
+	steps []*recursiveStep
+}
+
+// recursiveRun runs a recursive step in the scopes made from a pipeline, which may be the
+// recursive part of another recursive CTE. workTables are the work tables of the runs of the
+// recursive CTEs it is in.
+type recursiveRun struct {
+	step       *recursiveStep
+	workTables map[string]*workTable
+}
+
+// workTable is the work table of a recursive CTE, the rows returned by the last
+// run of its recursive part. bat is nil while the recursive part is compiled.
+type workTable struct {
+	// names are the column names of the recursive CTE
+	names []string
+	bat   *batch.Batch
+}
+
 type Server struct {
 	sync.Mutex
 	id uint64
@@ -154,4 +196,6 @@ type Compile struct {
 	cnList engine.Nodes
 	// ast
 	stmt tree.Statement
+	// workTables are the work tables of the recursive CTEs being compiled, k = cte name
+	workTables map[string]*workTable
 }
@@ -70,6 +70,9 @@ func init() {
 		"collation":                COLLATION,
 		"column":                   COLUMN,
 		"columns":                  COLUMNS,
+		"json_table":               JSON_TABLE,
+		"ordinality":               ORDINALITY,
+		"path":                     PATH,
 		"column_format":            COLUMN_FORMAT,
 		"comment":                  COMMENT_KEYWORD,
 		"committed":                COMMITTED,
@@ -307,10 +310,12 @@ func init() {
 		"redundant":                REDUNDANT,
 		"read_write":               UNUSED,
 		"real":                     REAL,
+		"recursive":                RECURSIVE,
 		"references":               REFERENCES,
 		"regexp":                   REGEXP,
 		"release":                  RELEASE,
 		"rename":                   RENAME,
+		"modify":                   MODIFY,
 		"reorganize":               REORGANIZE,
 		"repair":                   REPAIR,
 		"repeat":                   REPEAT,
@@ -493,6 +498,16 @@ func init() {
 		"failed_login_attempts":    FAILED_LOGIN_ATTEMPTS,
 		"password_lock_time":       PASSWORD_LOCK_TIME,
 		"unbounded":                UNBOUNDED,
+		"over":                     OVER,
+		"preceding":                PRECEDING,
+		"following":                FOLLOWING,
+		"histogram":                HISTOGRAM,
+		"task":                     TASK,
+		"tasks":                    TASKS,
+		"schedule":                 SCHEDULE,
+		"resume":                   RESUME,
+		"cancel":                   CANCEL,
+		"policy":                   POLICY,
 		"secondary":                SECONDARY,
 	}
 }
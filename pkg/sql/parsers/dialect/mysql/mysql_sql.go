@@ -65,424 +65,440 @@ const JOIN = 57387
 const STRAIGHT_JOIN = 57388
 const LEFT = 57389
 const RIGHT = 57390
-const INNER = 57391
-const OUTER = 57392
-const CROSS = 57393
-const NATURAL = 57394
-const USE = 57395
-const FORCE = 57396
-const LOWER_THAN_ON = 57397
-const ON = 57398
-const USING = 57399
-const SUBQUERY_AS_EXPR = 57400
-const LOWER_THAN_STRING = 57401
-const ID = 57402
-const AT_ID = 57403
-const AT_AT_ID = 57404
-const STRING = 57405
-const VALUE_ARG = 57406
-const LIST_ARG = 57407
-const COMMENT = 57408
-const COMMENT_KEYWORD = 57409
-const INTEGRAL = 57410
-const HEX = 57411
-const BIT_LITERAL = 57412
-const FLOAT = 57413
-const HEXNUM = 57414
-const NULL = 57415
-const TRUE = 57416
-const FALSE = 57417
-const LOWER_THAN_CHARSET = 57418
-const CHARSET = 57419
-const UNIQUE = 57420
-const KEY = 57421
-const OR = 57422
-const PIPE_CONCAT = 57423
-const XOR = 57424
-const AND = 57425
-const NOT = 57426
-const BETWEEN = 57427
-const CASE = 57428
-const WHEN = 57429
-const THEN = 57430
-const ELSE = 57431
-const END = 57432
-const LE = 57433
-const GE = 57434
-const NE = 57435
-const NULL_SAFE_EQUAL = 57436
-const IS = 57437
-const LIKE = 57438
-const REGEXP = 57439
-const IN = 57440
-const ASSIGNMENT = 57441
-const SHIFT_LEFT = 57442
-const SHIFT_RIGHT = 57443
-const DIV = 57444
-const MOD = 57445
-const UNARY = 57446
-const COLLATE = 57447
-const BINARY = 57448
-const UNDERSCORE_BINARY = 57449
-const INTERVAL = 57450
-const BEGIN = 57451
-const START = 57452
-const TRANSACTION = 57453
-const COMMIT = 57454
-const ROLLBACK = 57455
-const WORK = 57456
-const CONSISTENT = 57457
-const SNAPSHOT = 57458
-const CHAIN = 57459
-const NO = 57460
-const RELEASE = 57461
-const PRIORITY = 57462
-const QUICK = 57463
-const BIT = 57464
-const TINYINT = 57465
-const SMALLINT = 57466
-const MEDIUMINT = 57467
-const INT = 57468
-const INTEGER = 57469
-const BIGINT = 57470
-const INTNUM = 57471
-const REAL = 57472
-const DOUBLE = 57473
-const FLOAT_TYPE = 57474
-const DECIMAL = 57475
-const NUMERIC = 57476
-const DECIMAL_VALUE = 57477
-const TIME = 57478
-const TIMESTAMP = 57479
-const DATETIME = 57480
-const YEAR = 57481
-const CHAR = 57482
-const VARCHAR = 57483
-const BOOL = 57484
-const CHARACTER = 57485
-const VARBINARY = 57486
-const NCHAR = 57487
-const TEXT = 57488
-const TINYTEXT = 57489
-const MEDIUMTEXT = 57490
-const LONGTEXT = 57491
-const BLOB = 57492
-const TINYBLOB = 57493
-const MEDIUMBLOB = 57494
-const LONGBLOB = 57495
-const JSON = 57496
-const ENUM = 57497
-const UUID = 57498
-const GEOMETRY = 57499
-const POINT = 57500
-const LINESTRING = 57501
-const POLYGON = 57502
-const GEOMETRYCOLLECTION = 57503
-const MULTIPOINT = 57504
-const MULTILINESTRING = 57505
-const MULTIPOLYGON = 57506
-const INT1 = 57507
-const INT2 = 57508
-const INT3 = 57509
-const INT4 = 57510
-const INT8 = 57511
-const SQL_SMALL_RESULT = 57512
-const SQL_BIG_RESULT = 57513
-const SQL_BUFFER_RESULT = 57514
-const LOW_PRIORITY = 57515
-const HIGH_PRIORITY = 57516
-const DELAYED = 57517
-const CREATE = 57518
-const ALTER = 57519
-const DROP = 57520
-const RENAME = 57521
-const ANALYZE = 57522
-const ADD = 57523
-const SCHEMA = 57524
-const TABLE = 57525
-const INDEX = 57526
-const VIEW = 57527
-const TO = 57528
-const IGNORE = 57529
-const IF = 57530
-const PRIMARY = 57531
-const COLUMN = 57532
-const CONSTRAINT = 57533
-const SPATIAL = 57534
-const FULLTEXT = 57535
-const FOREIGN = 57536
-const KEY_BLOCK_SIZE = 57537
-const SHOW = 57538
-const DESCRIBE = 57539
-const EXPLAIN = 57540
-const DATE = 57541
-const ESCAPE = 57542
-const REPAIR = 57543
-const OPTIMIZE = 57544
-const TRUNCATE = 57545
-const MAXVALUE = 57546
-const PARTITION = 57547
-const REORGANIZE = 57548
-const LESS = 57549
-const THAN = 57550
-const PROCEDURE = 57551
-const TRIGGER = 57552
-const STATUS = 57553
-const VARIABLES = 57554
-const ROLE = 57555
-const PROXY = 57556
-const AVG_ROW_LENGTH = 57557
-const STORAGE = 57558
-const DISK = 57559
-const MEMORY = 57560
-const CHECKSUM = 57561
-const COMPRESSION = 57562
-const DATA = 57563
-const DIRECTORY = 57564
-const DELAY_KEY_WRITE = 57565
-const ENCRYPTION = 57566
-const ENGINE = 57567
-const MAX_ROWS = 57568
-const MIN_ROWS = 57569
-const PACK_KEYS = 57570
-const ROW_FORMAT = 57571
-const STATS_AUTO_RECALC = 57572
-const STATS_PERSISTENT = 57573
-const STATS_SAMPLE_PAGES = 57574
-const DYNAMIC = 57575
-const COMPRESSED = 57576
-const REDUNDANT = 57577
-const COMPACT = 57578
-const FIXED = 57579
-const COLUMN_FORMAT = 57580
-const AUTO_RANDOM = 57581
-const RESTRICT = 57582
-const CASCADE = 57583
-const ACTION = 57584
-const PARTIAL = 57585
-const SIMPLE = 57586
-const CHECK = 57587
-const ENFORCED = 57588
-const RANGE = 57589
-const LIST = 57590
-const ALGORITHM = 57591
-const LINEAR = 57592
-const PARTITIONS = 57593
-const SUBPARTITION = 57594
-const SUBPARTITIONS = 57595
-const TYPE = 57596
-const ANY = 57597
-const SOME = 57598
-const EXTERNAL = 57599
-const LOCALFILE = 57600
-const URL = 57601
-const PREPARE = 57602
-const DEALLOCATE = 57603
-const PROPERTIES = 57604
-const PARSER = 57605
-const VISIBLE = 57606
-const INVISIBLE = 57607
-const BTREE = 57608
-const HASH = 57609
-const RTREE = 57610
-const BSI = 57611
-const ZONEMAP = 57612
-const LEADING = 57613
-const BOTH = 57614
-const TRAILING = 57615
-const UNKNOWN = 57616
-const EXPIRE = 57617
-const ACCOUNT = 57618
-const UNLOCK = 57619
-const DAY = 57620
-const NEVER = 57621
-const SECOND = 57622
-const ASCII = 57623
-const COALESCE = 57624
-const COLLATION = 57625
-const HOUR = 57626
-const MICROSECOND = 57627
-const MINUTE = 57628
-const MONTH = 57629
-const QUARTER = 57630
-const REPEAT = 57631
-const REVERSE = 57632
-const ROW_COUNT = 57633
-const WEEK = 57634
-const REVOKE = 57635
-const FUNCTION = 57636
-const PRIVILEGES = 57637
-const TABLESPACE = 57638
-const EXECUTE = 57639
-const SUPER = 57640
-const GRANT = 57641
-const OPTION = 57642
-const REFERENCES = 57643
-const REPLICATION = 57644
-const SLAVE = 57645
-const CLIENT = 57646
-const USAGE = 57647
-const RELOAD = 57648
-const FILE = 57649
-const TEMPORARY = 57650
-const ROUTINE = 57651
-const EVENT = 57652
-const SHUTDOWN = 57653
-const NULLX = 57654
-const AUTO_INCREMENT = 57655
-const APPROXNUM = 57656
-const SIGNED = 57657
-const UNSIGNED = 57658
-const ZEROFILL = 57659
-const ADMIN_NAME = 57660
-const RANDOM = 57661
-const SUSPEND = 57662
-const ATTRIBUTE = 57663
-const HISTORY = 57664
-const REUSE = 57665
-const CURRENT = 57666
-const OPTIONAL = 57667
-const FAILED_LOGIN_ATTEMPTS = 57668
-const PASSWORD_LOCK_TIME = 57669
-const UNBOUNDED = 57670
-const SECONDARY = 57671
-const USER = 57672
-const IDENTIFIED = 57673
-const CIPHER = 57674
-const ISSUER = 57675
-const X509 = 57676
-const SUBJECT = 57677
-const SAN = 57678
-const REQUIRE = 57679
-const SSL = 57680
-const NONE = 57681
-const PASSWORD = 57682
-const MAX_QUERIES_PER_HOUR = 57683
-const MAX_UPDATES_PER_HOUR = 57684
-const MAX_CONNECTIONS_PER_HOUR = 57685
-const MAX_USER_CONNECTIONS = 57686
-const FORMAT = 57687
-const VERBOSE = 57688
-const CONNECTION = 57689
-const LOAD = 57690
-const INFILE = 57691
-const TERMINATED = 57692
-const OPTIONALLY = 57693
-const ENCLOSED = 57694
-const ESCAPED = 57695
-const STARTING = 57696
-const LINES = 57697
-const ROWS = 57698
-const DATABASES = 57699
-const TABLES = 57700
-const EXTENDED = 57701
-const FULL = 57702
-const PROCESSLIST = 57703
-const FIELDS = 57704
-const COLUMNS = 57705
-const OPEN = 57706
-const ERRORS = 57707
-const WARNINGS = 57708
-const INDEXES = 57709
-const SCHEMAS = 57710
-const NAMES = 57711
-const GLOBAL = 57712
-const SESSION = 57713
-const ISOLATION = 57714
-const LEVEL = 57715
-const READ = 57716
-const WRITE = 57717
-const ONLY = 57718
-const REPEATABLE = 57719
-const COMMITTED = 57720
-const UNCOMMITTED = 57721
-const SERIALIZABLE = 57722
-const LOCAL = 57723
-const CURRENT_TIMESTAMP = 57724
-const DATABASE = 57725
-const CURRENT_TIME = 57726
-const LOCALTIME = 57727
-const LOCALTIMESTAMP = 57728
-const UTC_DATE = 57729
-const UTC_TIME = 57730
-const UTC_TIMESTAMP = 57731
-const REPLACE = 57732
-const CONVERT = 57733
-const SEPARATOR = 57734
-const CURRENT_DATE = 57735
-const CURRENT_USER = 57736
-const CURRENT_ROLE = 57737
-const SECOND_MICROSECOND = 57738
-const MINUTE_MICROSECOND = 57739
-const MINUTE_SECOND = 57740
-const HOUR_MICROSECOND = 57741
-const HOUR_SECOND = 57742
-const HOUR_MINUTE = 57743
-const DAY_MICROSECOND = 57744
-const DAY_SECOND = 57745
-const DAY_MINUTE = 57746
-const DAY_HOUR = 57747
-const YEAR_MONTH = 57748
-const SQL_TSI_HOUR = 57749
-const SQL_TSI_DAY = 57750
-const SQL_TSI_WEEK = 57751
-const SQL_TSI_MONTH = 57752
-const SQL_TSI_QUARTER = 57753
-const SQL_TSI_YEAR = 57754
-const SQL_TSI_SECOND = 57755
-const SQL_TSI_MINUTE = 57756
-const RECURSIVE = 57757
-const CONFIG = 57758
-const MATCH = 57759
-const AGAINST = 57760
-const BOOLEAN = 57761
-const LANGUAGE = 57762
-const WITH = 57763
-const QUERY = 57764
-const EXPANSION = 57765
-const ADDDATE = 57766
-const BIT_AND = 57767
-const BIT_OR = 57768
-const BIT_XOR = 57769
-const CAST = 57770
-const COUNT = 57771
-const APPROX_COUNT_DISTINCT = 57772
-const APPROX_PERCENTILE = 57773
-const CURDATE = 57774
-const CURTIME = 57775
-const DATE_ADD = 57776
-const DATE_SUB = 57777
-const EXTRACT = 57778
-const GROUP_CONCAT = 57779
-const MAX = 57780
-const MID = 57781
-const MIN = 57782
-const NOW = 57783
-const POSITION = 57784
-const SESSION_USER = 57785
-const STD = 57786
-const STDDEV = 57787
-const STDDEV_POP = 57788
-const STDDEV_SAMP = 57789
-const SUBDATE = 57790
-const SUBSTR = 57791
-const SUBSTRING = 57792
-const SUM = 57793
-const SYSDATE = 57794
-const SYSTEM_USER = 57795
-const TRANSLATE = 57796
-const TRIM = 57797
-const VARIANCE = 57798
-const VAR_POP = 57799
-const VAR_SAMP = 57800
-const AVG = 57801
-const JSON_EXTRACT = 57802
-const ROW = 57803
-const OUTFILE = 57804
-const HEADER = 57805
-const MAX_FILE_SIZE = 57806
-const FORCE_QUOTE = 57807
-const UNUSED = 57808
+const FULL = 57391
+const INNER = 57392
+const OUTER = 57393
+const CROSS = 57394
+const NATURAL = 57395
+const USE = 57396
+const FORCE = 57397
+const LOWER_THAN_ON = 57398
+const ON = 57399
+const USING = 57400
+const SUBQUERY_AS_EXPR = 57401
+const LOWER_THAN_STRING = 57402
+const ID = 57403
+const AT_ID = 57404
+const AT_AT_ID = 57405
+const STRING = 57406
+const VALUE_ARG = 57407
+const LIST_ARG = 57408
+const COMMENT = 57409
+const COMMENT_KEYWORD = 57410
+const INTEGRAL = 57411
+const HEX = 57412
+const BIT_LITERAL = 57413
+const FLOAT = 57414
+const HEXNUM = 57415
+const NULL = 57416
+const TRUE = 57417
+const FALSE = 57418
+const LOWER_THAN_CHARSET = 57419
+const CHARSET = 57420
+const UNIQUE = 57421
+const KEY = 57422
+const OR = 57423
+const PIPE_CONCAT = 57424
+const XOR = 57425
+const AND = 57426
+const NOT = 57427
+const BETWEEN = 57428
+const CASE = 57429
+const WHEN = 57430
+const THEN = 57431
+const ELSE = 57432
+const END = 57433
+const LE = 57434
+const GE = 57435
+const NE = 57436
+const NULL_SAFE_EQUAL = 57437
+const IS = 57438
+const LIKE = 57439
+const REGEXP = 57440
+const IN = 57441
+const ASSIGNMENT = 57442
+const SHIFT_LEFT = 57443
+const SHIFT_RIGHT = 57444
+const DIV = 57445
+const MOD = 57446
+const UNARY = 57447
+const COLLATE = 57448
+const BINARY = 57449
+const UNDERSCORE_BINARY = 57450
+const INTERVAL = 57451
+const BEGIN = 57452
+const START = 57453
+const TRANSACTION = 57454
+const COMMIT = 57455
+const ROLLBACK = 57456
+const WORK = 57457
+const CONSISTENT = 57458
+const SNAPSHOT = 57459
+const CHAIN = 57460
+const NO = 57461
+const RELEASE = 57462
+const PRIORITY = 57463
+const QUICK = 57464
+const BIT = 57465
+const TINYINT = 57466
+const SMALLINT = 57467
+const MEDIUMINT = 57468
+const INT = 57469
+const INTEGER = 57470
+const BIGINT = 57471
+const INTNUM = 57472
+const REAL = 57473
+const DOUBLE = 57474
+const FLOAT_TYPE = 57475
+const DECIMAL = 57476
+const NUMERIC = 57477
+const DECIMAL_VALUE = 57478
+const TIME = 57479
+const TIMESTAMP = 57480
+const DATETIME = 57481
+const YEAR = 57482
+const CHAR = 57483
+const VARCHAR = 57484
+const BOOL = 57485
+const CHARACTER = 57486
+const VARBINARY = 57487
+const NCHAR = 57488
+const TEXT = 57489
+const TINYTEXT = 57490
+const MEDIUMTEXT = 57491
+const LONGTEXT = 57492
+const BLOB = 57493
+const TINYBLOB = 57494
+const MEDIUMBLOB = 57495
+const LONGBLOB = 57496
+const JSON = 57497
+const ENUM = 57498
+const UUID = 57499
+const GEOMETRY = 57500
+const POINT = 57501
+const LINESTRING = 57502
+const POLYGON = 57503
+const GEOMETRYCOLLECTION = 57504
+const MULTIPOINT = 57505
+const MULTILINESTRING = 57506
+const MULTIPOLYGON = 57507
+const INT1 = 57508
+const INT2 = 57509
+const INT3 = 57510
+const INT4 = 57511
+const INT8 = 57512
+const SQL_SMALL_RESULT = 57513
+const SQL_BIG_RESULT = 57514
+const SQL_BUFFER_RESULT = 57515
+const LOW_PRIORITY = 57516
+const HIGH_PRIORITY = 57517
+const DELAYED = 57518
+const CREATE = 57519
+const ALTER = 57520
+const DROP = 57521
+const RENAME = 57522
+const ANALYZE = 57523
+const ADD = 57524
+const MODIFY = 57525
+const SCHEMA = 57526
+const TABLE = 57527
+const INDEX = 57528
+const VIEW = 57529
+const TO = 57530
+const IGNORE = 57531
+const IF = 57532
+const PRIMARY = 57533
+const COLUMN = 57534
+const CONSTRAINT = 57535
+const SPATIAL = 57536
+const FULLTEXT = 57537
+const FOREIGN = 57538
+const KEY_BLOCK_SIZE = 57539
+const SHOW = 57540
+const DESCRIBE = 57541
+const EXPLAIN = 57542
+const DATE = 57543
+const ESCAPE = 57544
+const REPAIR = 57545
+const OPTIMIZE = 57546
+const TRUNCATE = 57547
+const MAXVALUE = 57548
+const PARTITION = 57549
+const REORGANIZE = 57550
+const LESS = 57551
+const THAN = 57552
+const PROCEDURE = 57553
+const TRIGGER = 57554
+const STATUS = 57555
+const VARIABLES = 57556
+const ROLE = 57557
+const PROXY = 57558
+const AVG_ROW_LENGTH = 57559
+const STORAGE = 57560
+const DISK = 57561
+const MEMORY = 57562
+const CHECKSUM = 57563
+const COMPRESSION = 57564
+const DATA = 57565
+const DIRECTORY = 57566
+const DELAY_KEY_WRITE = 57567
+const ENCRYPTION = 57568
+const ENGINE = 57569
+const MAX_ROWS = 57570
+const MIN_ROWS = 57571
+const PACK_KEYS = 57572
+const ROW_FORMAT = 57573
+const STATS_AUTO_RECALC = 57574
+const STATS_PERSISTENT = 57575
+const STATS_SAMPLE_PAGES = 57576
+const DYNAMIC = 57577
+const COMPRESSED = 57578
+const REDUNDANT = 57579
+const COMPACT = 57580
+const FIXED = 57581
+const COLUMN_FORMAT = 57582
+const AUTO_RANDOM = 57583
+const RESTRICT = 57584
+const CASCADE = 57585
+const ACTION = 57586
+const PARTIAL = 57587
+const SIMPLE = 57588
+const CHECK = 57589
+const ENFORCED = 57590
+const RANGE = 57591
+const LIST = 57592
+const ALGORITHM = 57593
+const LINEAR = 57594
+const PARTITIONS = 57595
+const SUBPARTITION = 57596
+const SUBPARTITIONS = 57597
+const TYPE = 57598
+const ANY = 57599
+const SOME = 57600
+const EXTERNAL = 57601
+const LOCALFILE = 57602
+const URL = 57603
+const PREPARE = 57604
+const DEALLOCATE = 57605
+const PROPERTIES = 57606
+const PARSER = 57607
+const VISIBLE = 57608
+const INVISIBLE = 57609
+const BTREE = 57610
+const HASH = 57611
+const RTREE = 57612
+const BSI = 57613
+const OVER = 57614
+const PRECEDING = 57615
+const FOLLOWING = 57616
+const HISTOGRAM = 57617
+const TASK = 57618
+const TASKS = 57619
+const SCHEDULE = 57620
+const RESUME = 57621
+const CANCEL = 57622
+const POLICY = 57623
+const ZONEMAP = 57624
+const LEADING = 57625
+const BOTH = 57626
+const TRAILING = 57627
+const UNKNOWN = 57628
+const EXPIRE = 57629
+const ACCOUNT = 57630
+const UNLOCK = 57631
+const DAY = 57632
+const NEVER = 57633
+const SECOND = 57634
+const ASCII = 57635
+const COALESCE = 57636
+const COLLATION = 57637
+const HOUR = 57638
+const MICROSECOND = 57639
+const MINUTE = 57640
+const MONTH = 57641
+const QUARTER = 57642
+const REPEAT = 57643
+const REVERSE = 57644
+const ROW_COUNT = 57645
+const WEEK = 57646
+const REVOKE = 57647
+const FUNCTION = 57648
+const PRIVILEGES = 57649
+const TABLESPACE = 57650
+const EXECUTE = 57651
+const SUPER = 57652
+const GRANT = 57653
+const OPTION = 57654
+const REFERENCES = 57655
+const REPLICATION = 57656
+const SLAVE = 57657
+const CLIENT = 57658
+const USAGE = 57659
+const RELOAD = 57660
+const FILE = 57661
+const TEMPORARY = 57662
+const ROUTINE = 57663
+const EVENT = 57664
+const SHUTDOWN = 57665
+const NULLX = 57666
+const AUTO_INCREMENT = 57667
+const APPROXNUM = 57668
+const SIGNED = 57669
+const UNSIGNED = 57670
+const ZEROFILL = 57671
+const ADMIN_NAME = 57672
+const RANDOM = 57673
+const SUSPEND = 57674
+const ATTRIBUTE = 57675
+const HISTORY = 57676
+const REUSE = 57677
+const CURRENT = 57678
+const OPTIONAL = 57679
+const FAILED_LOGIN_ATTEMPTS = 57680
+const PASSWORD_LOCK_TIME = 57681
+const UNBOUNDED = 57682
+const SECONDARY = 57683
+const USER = 57684
+const IDENTIFIED = 57685
+const CIPHER = 57686
+const ISSUER = 57687
+const X509 = 57688
+const SUBJECT = 57689
+const SAN = 57690
+const REQUIRE = 57691
+const SSL = 57692
+const NONE = 57693
+const PASSWORD = 57694
+const MAX_QUERIES_PER_HOUR = 57695
+const MAX_UPDATES_PER_HOUR = 57696
+const MAX_CONNECTIONS_PER_HOUR = 57697
+const MAX_USER_CONNECTIONS = 57698
+const FORMAT = 57699
+const VERBOSE = 57700
+const CONNECTION = 57701
+const LOAD = 57702
+const INFILE = 57703
+const TERMINATED = 57704
+const OPTIONALLY = 57705
+const ENCLOSED = 57706
+const ESCAPED = 57707
+const STARTING = 57708
+const LINES = 57709
+const ROWS = 57710
+const DATABASES = 57711
+const TABLES = 57712
+const EXTENDED = 57713
+const PROCESSLIST = 57714
+const FIELDS = 57715
+const COLUMNS = 57716
+const OPEN = 57717
+const ERRORS = 57718
+const WARNINGS = 57719
+const INDEXES = 57720
+const SCHEMAS = 57721
+const NAMES = 57722
+const GLOBAL = 57723
+const SESSION = 57724
+const ISOLATION = 57725
+const LEVEL = 57726
+const READ = 57727
+const WRITE = 57728
+const ONLY = 57729
+const REPEATABLE = 57730
+const COMMITTED = 57731
+const UNCOMMITTED = 57732
+const SERIALIZABLE = 57733
+const LOCAL = 57734
+const CURRENT_TIMESTAMP = 57735
+const DATABASE = 57736
+const CURRENT_TIME = 57737
+const LOCALTIME = 57738
+const LOCALTIMESTAMP = 57739
+const UTC_DATE = 57740
+const UTC_TIME = 57741
+const UTC_TIMESTAMP = 57742
+const REPLACE = 57743
+const CONVERT = 57744
+const SEPARATOR = 57745
+const CURRENT_DATE = 57746
+const CURRENT_USER = 57747
+const CURRENT_ROLE = 57748
+const SECOND_MICROSECOND = 57749
+const MINUTE_MICROSECOND = 57750
+const MINUTE_SECOND = 57751
+const HOUR_MICROSECOND = 57752
+const HOUR_SECOND = 57753
+const HOUR_MINUTE = 57754
+const DAY_MICROSECOND = 57755
+const DAY_SECOND = 57756
+const DAY_MINUTE = 57757
+const DAY_HOUR = 57758
+const YEAR_MONTH = 57759
+const SQL_TSI_HOUR = 57760
+const SQL_TSI_DAY = 57761
+const SQL_TSI_WEEK = 57762
+const SQL_TSI_MONTH = 57763
+const SQL_TSI_QUARTER = 57764
+const SQL_TSI_YEAR = 57765
+const SQL_TSI_SECOND = 57766
+const SQL_TSI_MINUTE = 57767
+const RECURSIVE = 57768
+const CONFIG = 57769
+const MATCH = 57770
+const AGAINST = 57771
+const BOOLEAN = 57772
+const LANGUAGE = 57773
+const WITH = 57774
+const QUERY = 57775
+const EXPANSION = 57776
+const ADDDATE = 57777
+const BIT_AND = 57778
+const BIT_OR = 57779
+const BIT_XOR = 57780
+const CAST = 57781
+const COUNT = 57782
+const APPROX_COUNT_DISTINCT = 57783
+const APPROX_PERCENTILE = 57784
+const CURDATE = 57785
+const CURTIME = 57786
+const DATE_ADD = 57787
+const DATE_SUB = 57788
+const EXTRACT = 57789
+const GROUP_CONCAT = 57790
+const MAX = 57791
+const MID = 57792
+const MIN = 57793
+const NOW = 57794
+const POSITION = 57795
+const SESSION_USER = 57796
+const STD = 57797
+const STDDEV = 57798
+const STDDEV_POP = 57799
+const STDDEV_SAMP = 57800
+const SUBDATE = 57801
+const SUBSTR = 57802
+const SUBSTRING = 57803
+const SUM = 57804
+const SYSDATE = 57805
+const SYSTEM_USER = 57806
+const TRANSLATE = 57807
+const TRIM = 57808
+const VARIANCE = 57809
+const VAR_POP = 57810
+const VAR_SAMP = 57811
+const AVG = 57812
+const JSON_EXTRACT = 57813
+const JSON_TABLE = 57814
+const ORDINALITY = 57815
+const PATH = 57816
+const JSON_EXTRACT_OP = 57817
+const JSON_UNQUOTE_EXTRACT_OP = 57818
+const ROW = 57819
+const OUTFILE = 57820
+const HEADER = 57821
+const MAX_FILE_SIZE = 57822
+const FORCE_QUOTE = 57823
+const UNUSED = 57824
 
 var yyToknames = [...]string{
 	"$end",
@@ -533,6 +549,7 @@ var yyToknames = [...]string{
 	"STRAIGHT_JOIN",
 	"LEFT",
 	"RIGHT",
+	"FULL",
 	"INNER",
 	"OUTER",
 	"CROSS",
@@ -683,6 +700,7 @@ var yyToknames = [...]string{
 	"RENAME",
 	"ANALYZE",
 	"ADD",
+	"MODIFY",
 	"SCHEMA",
 	"TABLE",
 	"INDEX",
@@ -771,6 +789,16 @@ var yyToknames = [...]string{
 	"HASH",
 	"RTREE",
 	"BSI",
+	"OVER",
+	"PRECEDING",
+	"FOLLOWING",
+	"HISTOGRAM",
+	"TASK",
+	"TASKS",
+	"SCHEDULE",
+	"RESUME",
+	"CANCEL",
+	"POLICY",
 	"ZONEMAP",
 	"LEADING",
 	"BOTH",
@@ -861,7 +889,6 @@ var yyToknames = [...]string{
 	"DATABASES",
 	"TABLES",
 	"EXTENDED",
-	"FULL",
 	"PROCESSLIST",
 	"FIELDS",
 	"COLUMNS",
@@ -962,6 +989,11 @@ var yyToknames = [...]string{
 	"VAR_SAMP",
 	"AVG",
 	"JSON_EXTRACT",
+	"JSON_TABLE",
+	"ORDINALITY",
+	"PATH",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
 	"ROW",
 	"OUTFILE",
 	"HEADER",
@@ -980,2349 +1012,2669 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:7248
+//line mysql_sql.y:7721
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 63,
-	21, 433,
-	-2, 414,
-	-1, 68,
-	201, 588,
-	-2, 630,
-	-1, 85,
-	228, 291,
-	229, 291,
-	-2, 312,
-	-1, 366,
-	21, 434,
-	-2, 397,
-	-1, 442,
-	94, 1317,
-	105, 1317,
-	124, 1317,
-	-2, 1127,
-	-1, 472,
-	21, 434,
-	-2, 397,
-	-1, 633,
-	59, 1468,
-	-2, 1475,
-	-1, 641,
-	59, 1469,
-	-2, 1483,
-	-1, 643,
-	59, 1465,
-	-2, 1485,
-	-1, 644,
-	59, 1466,
-	-2, 1486,
-	-1, 649,
-	59, 1467,
-	-2, 1492,
-	-1, 650,
-	59, 1470,
-	-2, 1493,
-	-1, 651,
-	59, 1471,
-	-2, 1494,
-	-1, 652,
-	59, 887,
-	-2, 1495,
-	-1, 653,
-	59, 888,
-	-2, 1496,
-	-1, 654,
-	59, 889,
-	-2, 1497,
-	-1, 656,
-	59, 1472,
-	-2, 1499,
-	-1, 657,
-	59, 907,
-	-2, 1500,
-	-1, 658,
-	59, 906,
-	-2, 1501,
-	-1, 661,
-	59, 1473,
-	-2, 1504,
-	-1, 662,
-	59, 1474,
-	-2, 1505,
-	-1, 668,
-	59, 969,
-	-2, 1317,
-	-1, 669,
-	59, 978,
-	-2, 1342,
-	-1, 670,
-	59, 982,
-	-2, 1381,
+	-1, 72,
+	21, 468,
+	-2, 449,
+	-1, 77,
+	203, 654,
+	-2, 699,
+	-1, 95,
+	230, 320,
+	231, 320,
+	-2, 341,
+	-1, 390,
+	21, 469,
+	-2, 428,
+	-1, 471,
+	95, 1411,
+	106, 1411,
+	125, 1411,
+	-2, 1217,
+	-1, 502,
+	21, 469,
+	-2, 428,
 	-1, 671,
-	59, 993,
-	-2, 1441,
-	-1, 672,
-	59, 995,
-	-2, 1451,
-	-1, 673,
-	59, 983,
-	-2, 1456,
-	-1, 674,
-	59, 991,
-	-2, 1460,
-	-1, 675,
-	59, 972,
-	-2, 1461,
-	-1, 834,
-	1, 614,
-	60, 614,
-	484, 614,
-	-2, 621,
-	-1, 974,
-	21, 433,
-	-2, 815,
-	-1, 1021,
-	124, 1137,
-	-2, 1135,
-	-1, 1023,
-	124, 532,
-	-2, 1132,
-	-1, 1024,
-	124, 533,
-	-2, 1133,
-	-1, 1236,
-	1, 615,
-	60, 615,
-	484, 615,
-	-2, 621,
-	-1, 1324,
-	59, 1038,
-	-2, 1458,
-	-1, 1325,
-	59, 1039,
-	-2, 1459,
-	-1, 1493,
-	57, 354,
-	125, 354,
-	-2, 721,
-	-1, 1814,
-	79, 621,
-	120, 621,
-	157, 621,
-	160, 621,
-	-2, 669,
-	-1, 1816,
-	262, 783,
-	-2, 763,
-	-1, 1846,
-	57, 354,
-	125, 354,
-	-2, 722,
-	-1, 1923,
-	79, 621,
-	120, 621,
-	157, 621,
-	160, 621,
-	-2, 670,
-	-1, 1951,
-	262, 783,
-	-2, 764,
-	-1, 2352,
-	60, 642,
-	125, 642,
-	-2, 621,
-	-1, 2356,
-	60, 642,
-	125, 642,
-	-2, 621,
-	-1, 2370,
-	60, 646,
-	125, 646,
-	-2, 621,
-	-1, 2375,
-	60, 647,
-	125, 647,
-	-2, 621,
+	60, 1571,
+	-2, 1577,
+	-1, 679,
+	60, 1572,
+	-2, 1585,
+	-1, 681,
+	60, 1568,
+	-2, 1587,
+	-1, 682,
+	60, 1569,
+	-2, 1588,
+	-1, 687,
+	60, 1570,
+	-2, 1594,
+	-1, 689,
+	60, 1573,
+	-2, 1596,
+	-1, 690,
+	60, 974,
+	-2, 1597,
+	-1, 691,
+	60, 975,
+	-2, 1598,
+	-1, 692,
+	60, 976,
+	-2, 1599,
+	-1, 694,
+	60, 1574,
+	-2, 1601,
+	-1, 695,
+	60, 995,
+	-2, 1602,
+	-1, 696,
+	60, 994,
+	-2, 1603,
+	-1, 699,
+	60, 1575,
+	-2, 1606,
+	-1, 700,
+	60, 1576,
+	-2, 1607,
+	-1, 706,
+	60, 1057,
+	-2, 1411,
+	-1, 707,
+	60, 1066,
+	-2, 1436,
+	-1, 708,
+	60, 1071,
+	-2, 1475,
+	-1, 709,
+	60, 1083,
+	-2, 1535,
+	-1, 710,
+	60, 1085,
+	-2, 1545,
+	-1, 711,
+	60, 1072,
+	-2, 1550,
+	-1, 712,
+	60, 1081,
+	-2, 1554,
+	-1, 713,
+	60, 1060,
+	-2, 1555,
+	-1, 880,
+	1, 680,
+	61, 680,
+	500, 680,
+	-2, 687,
+	-1, 1059,
+	21, 468,
+	-2, 884,
+	-1, 1109,
+	125, 1227,
+	-2, 1225,
+	-1, 1111,
+	125, 575,
+	-2, 1222,
+	-1, 1112,
+	125, 576,
+	-2, 1223,
+	-1, 1332,
+	1, 681,
+	61, 681,
+	500, 681,
+	-2, 687,
+	-1, 1472,
+	60, 1128,
+	-2, 1552,
+	-1, 1473,
+	60, 1129,
+	-2, 1553,
+	-1, 1643,
+	58, 385,
+	126, 385,
+	-2, 790,
+	-1, 1842,
+	20, 647,
+	-2, 644,
+	-1, 1890,
+	264, 852,
+	-2, 832,
+	-1, 2062,
+	80, 687,
+	121, 687,
+	158, 687,
+	161, 687,
+	-2, 738,
+	-1, 2083,
+	58, 385,
+	126, 385,
+	-2, 791,
+	-1, 2089,
+	264, 852,
+	-2, 833,
+	-1, 2209,
+	80, 687,
+	121, 687,
+	158, 687,
+	161, 687,
+	-2, 739,
+	-1, 2555,
+	61, 711,
+	126, 711,
+	-2, 687,
+	-1, 2559,
+	61, 711,
+	126, 711,
+	-2, 687,
+	-1, 2573,
+	61, 715,
+	126, 715,
+	-2, 687,
+	-1, 2578,
+	61, 716,
+	126, 716,
+	-2, 687,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 21539
+const yyLast = 24734
 
 var yyAct = [...]int{
-	817, 1327, 2358, 2364, 2356, 2355, 2333, 809, 678, 2201,
-	1989, 2322, 697, 2282, 1963, 2240, 1284, 2266, 2173, 2177,
-	2153, 2267, 1911, 1919, 1220, 1987, 992, 904, 102, 600,
-	1808, 869, 1988, 319, 325, 609, 325, 1328, 715, 2161,
-	805, 2012, 1909, 1280, 367, 105, 366, 323, 22, 1839,
-	1636, 1469, 329, 1972, 1952, 1867, 440, 2001, 839, 101,
-	812, 841, 1496, 1632, 550, 1971, 1878, 676, 539, 889,
-	1860, 395, 1870, 709, 63, 632, 1882, 1508, 863, 1641,
-	1279, 1637, 1820, 1709, 1194, 1003, 1199, 1699, 1571, 677,
-	1717, 1688, 1651, 441, 1647, 1243, 467, 1195, 1012, 335,
-	1004, 102, 1630, 1013, 311, 1018, 63, 1021, 1534, 1411,
-	62, 1315, 1397, 687, 866, 1266, 1507, 864, 882, 552,
-	322, 15, 3, 1471, 1927, 1466, 1237, 1242, 827, 803,
-	819, 848, 469, 1329, 448, 30, 1326, 1196, 443, 624,
-	445, 1341, 397, 679, 886, 1229, 22, 1226, 808, 320,
-	6, 321, 5, 850, 312, 907, 1206, 795, 849, 802,
-	521, 482, 1282, 910, 993, 315, 823, 30, 447, 432,
-	1306, 856, 63, 826, 394, 576, 337, 1913, 338, 12,
-	7, 4, 2248, 1213, 98, 1203, 2019, 1915, 1807, 814,
-	1006, 610, 2229, 324, 623, 93, 578, 501, 1444, 1200,
-	96, 2219, 537, 1211, 1980, 446, 97, 332, 27, 87,
-	69, 520, 569, 1452, 570, 871, 872, 592, 392, 15,
-	384, 466, 1468, 2255, 563, 564, 310, 2253, 750, 327,
-	749, 751, 752, 30, 753, 754, 97, 1458, 27, 87,
-	69, 416, 852, 579, 97, 97, 97, 97, 6, 97,
-	5, 27, 87, 69, 561, 94, 433, 560, 563, 564,
-	760, 796, 811, 800, 2270, 2271, 1467, 518, 2010, 453,
-	452, 454, 514, 757, 1597, 2244, 2245, 1624, 402, 2013,
-	2014, 2015, 2016, 2109, 2106, 94, 1625, 799, 1626, 2022,
-	1809, 780, 813, 94, 759, 94, 94, 485, 94, 451,
-	1439, 476, 584, 883, 2176, 1803, 1660, 1828, 1207, 417,
-	1662, 585, 1835, 475, 1652, 2081, 1985, 1618, 1227, 1998,
-	1866, 1865, 505, 474, 791, 516, 517, 325, 1969, 102,
-	1616, 504, 515, 1449, 2084, 2228, 1982, 2280, 363, 386,
-	1656, 364, 326, 509, 2252, 2349, 456, 2257, 334, 383,
-	382, 2075, 2365, 1657, 1658, 471, 473, 445, 472, 2289,
-	492, 2203, 449, 418, 879, 1318, 1319, 1320, 1659, 2296,
-	377, 510, 798, 2226, 2175, 2068, 1316, 1539, 1319, 1320,
-	2343, 2269, 2209, 2037, 368, 1482, 1483, 1484, 1485, 1474,
-	63, 63, 447, 2036, 395, 2162, 2163, 2164, 2166, 2165,
-	363, 365, 1480, 364, 2231, 2232, 1212, 2199, 2200, 588,
-	2203, 512, 571, 2366, 380, 494, 450, 2259, 2260, 68,
-	2372, 95, 559, 558, 2334, 562, 2360, 2025, 1654, 446,
-	468, 485, 496, 375, 538, 441, 441, 441, 1572, 85,
-	604, 604, 526, 501, 513, 541, 542, 540, 544, 408,
-	2104, 30, 30, 333, 507, 577, 1445, 325, 627, 627,
-	1293, 797, 487, 486, 1204, 381, 508, 511, 2325, 455,
-	606, 762, 821, 543, 1645, 1621, 1527, 545, 444, 328,
-	602, 602, 478, 479, 2059, 1862, 1861, 376, 506, 778,
-	1488, 389, 390, 391, 547, 626, 626, 2138, 1291, 1290,
-	1289, 604, 582, 604, 475, 2063, 874, 763, 875, 493,
-	1201, 1201, 1201, 1288, 810, 758, 873, 554, 612, 420,
-	490, 500, 311, 421, 566, 567, 2308, 410, 587, 424,
-	409, 580, 581, 2378, 2377, 1586, 793, 787, 1585, 1912,
-	385, 63, 604, 563, 564, 834, 2359, 563, 564, 395,
-	2258, 2174, 840, 2368, 63, 1542, 102, 555, 1494, 523,
-	830, 2230, 816, 63, 1444, 820, 1428, 1815, 1834, 525,
-	857, 857, 459, 464, 465, 423, 604, 102, 2326, 426,
-	425, 1317, 2371, 1653, 1799, 1663, 2082, 845, 1619, 1591,
-	441, 855, 604, 1538, 1646, 884, 487, 486, 1542, 1542,
-	807, 1655, 480, 1542, 1214, 499, 822, 1202, 1276, 898,
-	786, 1489, 549, 501, 835, 30, 844, 604, 2369, 903,
-	102, 102, 1981, 1986, 30, 574, 575, 919, 853, 854,
-	890, 1453, 586, 764, 70, 789, 890, 890, 908, 783,
-	859, 782, 829, 843, 310, 611, 769, 1473, 906, 804,
-	622, 905, 905, 755, 792, 598, 599, 615, 616, 617,
-	618, 619, 620, 621, 70, 765, 923, 909, 1495, 785,
-	784, 781, 70, 70, 70, 70, 801, 70, 846, 847,
-	806, 495, 565, 976, 815, 568, 2350, 2345, 880, 885,
-	828, 595, 596, 597, 878, 444, 1477, 1478, 2337, 2061,
-	2323, 2324, 2336, 2060, 2064, 2065, 773, 774, 413, 1231,
-	1476, 1580, 2139, 2141, 2142, 2143, 2140, 902, 842, 975,
-	2284, 851, 836, 837, 828, 408, 1217, 983, 1434, 2277,
-	1672, 1409, 2272, 461, 462, 463, 916, 917, 918, 915,
-	2261, 860, 858, 895, 896, 2249, 985, 862, 842, 861,
-	408, 1209, 2346, 2224, 2223, 974, 2222, 498, 1275, 881,
-	1010, 1010, 1015, 1209, 1302, 804, 2031, 1209, 913, 2221,
-	899, 2331, 977, 978, 979, 980, 901, 2211, 2100, 1579,
-	2098, 2096, 900, 1023, 794, 2285, 916, 917, 918, 915,
-	1200, 840, 446, 777, 2086, 604, 2094, 2086, 1495, 1230,
-	2091, 776, 981, 410, 2085, 1302, 409, 941, 1772, 1758,
-	2250, 999, 1024, 892, 893, 894, 1219, 1191, 2086, 2086,
-	1550, 2086, 102, 102, 548, 949, 499, 1276, 410, 1216,
-	589, 409, 1549, 447, 2086, 1442, 102, 1244, 1436, 1642,
-	1645, 1430, 2212, 2101, 63, 2099, 2095, 2286, 1246, 1192,
-	1849, 1208, 319, 1223, 1225, 1783, 959, 1685, 1276, 1673,
-	1260, 2095, 770, 1009, 407, 1247, 908, 1240, 1677, 2086,
-	446, 1627, 411, 1542, 1542, 1536, 1497, 897, 911, 1447,
-	1248, 1757, 1754, 1755, 1756, 1542, 1446, 1788, 1438, 1787,
-	1786, 1784, 1433, 1615, 501, 909, 1198, 1542, 604, 1613,
-	1247, 1258, 556, 1437, 1218, 30, 1431, 1249, 1250, 1251,
-	1190, 1947, 627, 1247, 102, 1285, 1209, 922, 766, 1002,
-	608, 1311, 488, 1313, 1016, 470, 1017, 771, 553, 1022,
-	890, 890, 890, 1189, 1947, 2320, 1188, 593, 1300, 591,
-	1239, 1337, 1338, 1614, 1287, 2309, 1197, 1470, 594, 626,
-	1785, 1193, 2213, 1307, 1308, 1309, 1310, 1331, 1330, 1238,
-	1646, 2112, 1686, 1239, 2357, 1639, 1622, 1435, 1252, 1640,
-	1643, 1295, 1303, 477, 1929, 1334, 1292, 1778, 999, 1404,
-	1412, 1286, 1254, 422, 1256, 1232, 387, 1412, 1376, 1577,
-	1464, 557, 1305, 1402, 1403, 1401, 1421, 1929, 918, 915,
-	915, 1321, 2071, 825, 2070, 1253, 1255, 1262, 1261, 1257,
-	590, 1824, 2054, 1819, 851, 962, 963, 964, 965, 966,
-	959, 1644, 1413, 2342, 1416, 1385, 1386, 1387, 1388, 1389,
-	1390, 1391, 1392, 1393, 1394, 1395, 1396, 1296, 1297, 1298,
-	1406, 1407, 2149, 1336, 2354, 404, 2339, 406, 416, 2147,
-	1339, 1304, 403, 401, 400, 412, 405, 2306, 414, 415,
-	1340, 1405, 2290, 2341, 1427, 1423, 1789, 1790, 2145, 1332,
-	1333, 2187, 1335, 2184, 419, 427, 2183, 2148, 1371, 1372,
-	1373, 1374, 1375, 1399, 2146, 1381, 1382, 1383, 1384, 958,
-	957, 967, 968, 960, 961, 962, 963, 964, 965, 966,
-	959, 2135, 2155, 2144, 396, 2133, 1933, 1648, 1588, 960,
-	961, 962, 963, 964, 965, 966, 959, 1937, 2132, 916,
-	917, 918, 915, 1415, 1417, 1418, 1904, 1414, 1780, 1933,
-	2131, 2128, 1983, 1422, 1425, 1424, 2134, 1926, 2122, 2119,
-	1937, 1928, 1930, 1932, 1559, 1934, 1935, 1936, 1938, 1939,
-	1940, 1942, 1943, 1944, 1945, 1832, 2264, 2118, 2020, 2006,
-	1926, 2005, 2004, 1903, 1928, 1930, 1932, 1984, 1934, 1935,
-	1936, 1938, 1939, 1940, 1942, 1943, 1944, 1945, 916, 917,
-	918, 915, 1767, 2279, 1948, 916, 917, 918, 915, 1558,
-	1833, 1440, 926, 927, 928, 929, 930, 931, 932, 924,
-	2000, 604, 1999, 604, 1831, 604, 1661, 1948, 1609, 767,
-	475, 916, 917, 918, 915, 2180, 2263, 2154, 1946, 1461,
-	1454, 967, 968, 960, 961, 962, 963, 964, 965, 966,
-	959, 604, 831, 832, 833, 1925, 1920, 916, 917, 918,
-	915, 1946, 1493, 1450, 363, 1221, 1222, 364, 1499, 916,
-	917, 918, 915, 1582, 1459, 1460, 2246, 820, 1925, 1504,
-	2207, 2206, 1941, 2194, 475, 102, 102, 102, 102, 1931,
-	2182, 2136, 2129, 1491, 1509, 2125, 475, 102, 1524, 2370,
-	2124, 2123, 2102, 2083, 2056, 1941, 1509, 2080, 1463, 1451,
-	2021, 2017, 1931, 22, 604, 1487, 916, 917, 918, 915,
-	2002, 1918, 102, 102, 916, 917, 918, 915, 1916, 916,
-	917, 918, 915, 1842, 1500, 916, 917, 918, 915, 63,
-	916, 917, 918, 915, 1830, 1525, 1829, 1285, 1826, 2007,
-	1448, 1443, 1805, 1532, 1533, 1441, 1796, 1547, 957, 967,
-	968, 960, 961, 962, 963, 964, 965, 966, 959, 804,
-	1462, 916, 917, 918, 915, 1650, 1620, 1530, 1456, 1479,
-	1426, 1238, 1215, 1486, 725, 724, 15, 1543, 1498, 1492,
-	1544, 1545, 995, 956, 1501, 955, 1502, 768, 1528, 828,
-	30, 1890, 1510, 1511, 1512, 1513, 1506, 1505, 2347, 1503,
-	1521, 1523, 1522, 1665, 824, 6, 2235, 5, 2234, 2214,
-	2097, 1889, 1566, 916, 917, 918, 915, 2093, 2092, 1553,
-	1554, 1555, 1556, 1557, 1531, 1561, 1907, 1888, 1905, 1562,
-	1563, 1564, 1565, 916, 917, 918, 915, 1902, 1537, 1795,
-	1894, 1859, 1010, 1843, 1601, 1010, 1540, 1777, 1604, 916,
-	917, 918, 915, 1814, 1798, 604, 1698, 1574, 1678, 1589,
-	1578, 916, 917, 918, 915, 1607, 1587, 1584, 1583, 916,
-	917, 918, 915, 1590, 1771, 1581, 475, 890, 1551, 1548,
-	1541, 1569, 1570, 890, 974, 1526, 1635, 97, 1598, 1420,
-	87, 69, 102, 1419, 1608, 1770, 916, 917, 918, 915,
-	613, 475, 1568, 2367, 2319, 102, 1244, 2313, 1676, 1596,
-	1769, 1635, 2340, 2297, 63, 1603, 2294, 916, 917, 918,
-	915, 446, 97, 1666, 1567, 1399, 2292, 942, 1600, 2186,
-	1576, 1546, 916, 917, 918, 915, 94, 2171, 2159, 1593,
-	2156, 2151, 2113, 1602, 1869, 1599, 1592, 1702, 1617, 1667,
-	1668, 1669, 1611, 1768, 1605, 1612, 1606, 2078, 958, 957,
-	967, 968, 960, 961, 962, 963, 964, 965, 966, 959,
-	2077, 94, 2076, 1679, 1680, 916, 917, 918, 915, 1684,
-	2073, 2067, 2052, 1674, 916, 917, 918, 915, 551, 1879,
-	1704, 604, 2074, 1764, 1696, 1871, 1883, 604, 1671, 1697,
-	1759, 1675, 1886, 1670, 1876, 1875, 1855, 1765, 1766, 1683,
-	1682, 1837, 1825, 1400, 1693, 916, 917, 918, 915, 1763,
-	1791, 604, 2302, 1762, 94, 1779, 1793, 2300, 1761, 1490,
-	1792, 602, 102, 1681, 1465, 1429, 1294, 602, 1702, 1245,
-	102, 916, 917, 918, 915, 916, 917, 918, 915, 1818,
-	916, 917, 918, 915, 1001, 1000, 998, 997, 996, 1776,
-	994, 991, 990, 1804, 988, 987, 1794, 1773, 986, 984,
-	1955, 1813, 1775, 954, 1239, 1760, 953, 952, 604, 604,
-	1782, 951, 950, 102, 1846, 948, 1812, 947, 946, 945,
-	1797, 1965, 944, 1800, 1234, 943, 475, 916, 917, 918,
-	915, 1707, 940, 939, 1958, 1801, 1509, 1838, 938, 1802,
-	1953, 937, 63, 1858, 936, 1967, 1968, 935, 602, 1840,
-	1822, 1954, 934, 916, 917, 918, 915, 1285, 1817, 1816,
-	1821, 933, 1821, 790, 890, 1823, 761, 1854, 503, 1851,
-	1856, 445, 1689, 1690, 491, 2268, 1847, 1692, 1857, 1848,
-	1481, 1301, 1706, 1850, 502, 1959, 2353, 1695, 1518, 1516,
-	1705, 1845, 1844, 1519, 1517, 350, 1853, 349, 353, 345,
-	1694, 1408, 1515, 1852, 916, 917, 918, 915, 1514, 1432,
-	1455, 341, 916, 917, 918, 915, 1873, 1874, 1221, 1222,
-	50, 360, 1863, 916, 917, 918, 915, 370, 371, 372,
-	373, 1877, 1872, 522, 1881, 1268, 1271, 1272, 1273, 1269,
-	369, 1270, 1274, 1629, 1880, 1891, 1520, 363, 1272, 1273,
-	364, 307, 29, 1228, 497, 2023, 475, 1924, 1893, 1628,
-	1973, 1975, 28, 1973, 1973, 1263, 1635, 1278, 838, 1331,
-	1330, 1966, 1884, 1638, 1887, 534, 535, 890, 475, 1895,
-	2237, 614, 1897, 308, 1899, 1268, 1271, 1272, 1273, 1269,
-	1892, 1270, 1274, 309, 1898, 573, 840, 1896, 1961, 572,
-	1900, 1901, 1187, 1979, 532, 533, 524, 1970, 2314, 1908,
-	530, 531, 528, 529, 1974, 2191, 2189, 2116, 1921, 1949,
-	1960, 1962, 370, 371, 372, 373, 2114, 1995, 1976, 1977,
-	2111, 2110, 2108, 1978, 1917, 369, 1610, 1811, 1810, 1701,
-	527, 369, 1700, 1535, 1992, 842, 1993, 1994, 1552, 1848,
-	2304, 2303, 2304, 489, 1996, 2303, 2069, 876, 1277, 398,
-	35, 1, 1205, 1827, 1664, 1649, 2027, 546, 388, 1377,
-	536, 775, 458, 484, 2003, 772, 483, 481, 343, 342,
-	346, 1410, 2008, 1969, 1342, 710, 348, 1005, 1011, 2152,
-	2236, 2281, 2185, 2239, 788, 1956, 696, 2103, 352, 1623,
-	604, 2009, 2105, 2011, 2317, 1457, 1910, 1210, 519, 1594,
-	102, 1595, 344, 722, 713, 989, 336, 2028, 2029, 1975,
-	2032, 2033, 2034, 2035, 2030, 756, 2038, 2039, 2040, 2041,
-	2042, 2043, 2044, 2045, 2046, 2047, 2048, 2049, 2050, 2051,
-	1840, 2072, 1970, 460, 712, 1836, 2053, 1475, 374, 2057,
-	958, 957, 967, 968, 960, 961, 962, 963, 964, 965,
-	966, 959, 457, 2055, 399, 1997, 1806, 1864, 2088, 2117,
-	1885, 1868, 2079, 2363, 2352, 2089, 2332, 2312, 2090, 2202,
-	445, 2348, 2251, 2295, 2288, 2087, 2198, 2024, 339, 877,
-	583, 2150, 430, 2107, 2172, 340, 2227, 2158, 378, 347,
-	351, 354, 1233, 355, 356, 379, 1236, 357, 358, 359,
-	1235, 2115, 361, 362, 475, 1322, 925, 475, 475, 475,
-	1398, 982, 2120, 2121, 2130, 630, 1285, 475, 2126, 2127,
-	1575, 686, 680, 1472, 1964, 1529, 34, 63, 33, 2160,
-	32, 914, 2168, 2169, 2170, 1019, 711, 104, 2157, 1259,
-	1020, 2195, 2179, 2018, 2196, 2167, 2241, 695, 694, 2178,
-	693, 604, 604, 692, 691, 1267, 2181, 1265, 1264, 868,
-	867, 912, 2190, 2265, 2192, 2193, 2188, 2217, 2197, 2218,
-	1914, 2066, 2137, 2062, 2058, 2208, 1923, 1922, 1950, 1951,
-	2204, 2205, 102, 1957, 1716, 1712, 1714, 1715, 1713, 1781,
-	475, 602, 602, 1708, 1633, 1634, 1631, 1691, 1687, 1007,
-	1014, 818, 475, 99, 865, 1991, 11, 10, 779, 2210,
-	9, 14, 21, 905, 2216, 2220, 20, 2243, 19, 58,
-	57, 56, 55, 18, 2215, 8, 54, 2225, 53, 2242,
-	52, 17, 16, 47, 48, 2233, 45, 44, 43, 42,
-	41, 40, 39, 46, 38, 2247, 37, 36, 2315, 67,
-	66, 65, 2254, 2256, 64, 23, 24, 25, 26, 77,
-	76, 78, 970, 2262, 973, 74, 72, 75, 73, 71,
-	31, 2273, 2274, 2275, 2276, 13, 2283, 2, 971, 972,
-	969, 2287, 958, 957, 967, 968, 960, 961, 962, 963,
-	964, 965, 966, 959, 958, 957, 967, 968, 960, 961,
-	962, 963, 964, 965, 966, 959, 0, 0, 0, 0,
-	2278, 0, 0, 2298, 0, 2301, 2243, 2311, 2299, 1906,
-	0, 0, 2305, 475, 0, 475, 0, 2307, 2242, 0,
-	2316, 2310, 2318, 810, 0, 810, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2327, 2283, 0, 0,
-	475, 2291, 2328, 2293, 2335, 0, 0, 2338, 0, 0,
-	810, 0, 2344, 0, 0, 958, 957, 967, 968, 960,
-	961, 962, 963, 964, 965, 966, 959, 2330, 0, 0,
-	0, 0, 0, 2351, 0, 0, 0, 0, 0, 2362,
-	0, 0, 2361, 0, 0, 2321, 0, 0, 0, 2373,
-	0, 0, 0, 2374, 2376, 2375, 1132, 1175, 2362, 0,
-	1120, 0, 1081, 1134, 1055, 1070, 1142, 1071, 1072, 1106,
-	1034, 1090, 229, 1068, 0, 1123, 1026, 1058, 1059, 1028,
-	1065, 1029, 1056, 1083, 174, 1054, 1093, 199, 1140, 0,
-	0, 258, 213, 0, 0, 1086, 1125, 1088, 1111, 1080,
-	1107, 1042, 1100, 1135, 1069, 0, 1104, 1136, 0, 0,
-	0, 0, 831, 832, 833, 0, 0, 0, 0, 156,
-	0, 0, 0, 0, 0, 1103, 1129, 1067, 0, 159,
-	1133, 1087, 1105, 0, 0, 1027, 1101, 0, 1032, 1035,
-	1141, 1127, 1062, 1063, 0, 0, 0, 0, 0, 0,
-	0, 1084, 1089, 1108, 1077, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1060, 0, 1097, 0, 0, 0,
-	1037, 1033, 0, 1082, 0, 0, 148, 263, 277, 157,
-	254, 290, 162, 261, 153, 228, 250, 0, 1174, 150,
-	275, 260, 210, 193, 194, 149, 0, 245, 172, 185,
-	169, 226, 0, 1131, 302, 168, 293, 1036, 285, 152,
-	1169, 284, 225, 272, 276, 211, 205, 151, 274, 209,
-	204, 197, 176, 0, 189, 237, 203, 238, 190, 215,
-	214, 216, 1153, 1154, 1155, 1156, 1157, 1165, 1166, 0,
-	1170, 1171, 1172, 1041, 0, 1061, 1109, 0, 1025, 1118,
-	1126, 1079, 287, 1128, 1076, 1075, 1160, 0, 1159, 262,
-	1161, 1162, 198, 1124, 1057, 1066, 303, 1064, 248, 231,
-	1130, 1096, 1173, 246, 201, 273, 239, 278, 264, 286,
-	242, 240, 144, 265, 171, 212, 154, 155, 167, 173,
-	175, 177, 178, 221, 222, 234, 253, 266, 267, 268,
-	170, 163, 247, 164, 187, 165, 145, 255, 166, 146,
-	235, 271, 1158, 183, 243, 208, 147, 207, 236, 270,
-	269, 294, 300, 301, 305, 0, 306, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1167, 0,
-	1168, 299, 181, 142, 282, 0, 227, 1121, 1030, 1040,
-	1038, 1073, 1098, 1099, 223, 298, 1113, 1117, 1114, 1143,
-	251, 0, 0, 0, 0, 0, 192, 233, 1115, 252,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1031, 0, 259, 280, 292, 1176, 1177, 1178, 1179, 0,
-	1180, 1181, 1182, 1183, 1184, 1185, 1186, 283, 1074, 1048,
-	1085, 291, 1051, 1049, 1112, 1050, 1102, 1145, 217, 218,
-	219, 220, 184, 0, 161, 1094, 1078, 1146, 1147, 1148,
-	1149, 1150, 1151, 1152, 1053, 304, 180, 186, 0, 188,
-	160, 232, 182, 289, 195, 1119, 224, 191, 256, 196,
-	202, 244, 288, 230, 249, 158, 279, 257, 206, 1047,
-	1052, 1046, 1091, 1092, 1137, 1138, 1139, 1110, 1039, 1122,
-	1043, 1045, 1044, 958, 957, 967, 968, 960, 961, 962,
-	963, 964, 965, 966, 959, 0, 0, 0, 0, 0,
-	0, 0, 1116, 0, 1095, 143, 0, 200, 1144, 241,
-	179, 718, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 688,
-	0, 0, 0, 174, 0, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 733, 739, 1163, 1164,
-	295, 296, 297, 281, 0, 0, 0, 0, 681, 0,
-	0, 631, 725, 724, 698, 707, 0, 0, 156, 699,
-	1774, 706, 700, 704, 703, 701, 702, 0, 668, 0,
-	0, 0, 0, 0, 0, 628, 685, 0, 689, 0,
-	0, 958, 957, 967, 968, 960, 961, 962, 963, 964,
-	965, 966, 959, 0, 0, 0, 0, 0, 0, 682,
-	683, 0, 0, 0, 0, 719, 0, 684, 0, 0,
-	721, 0, 708, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 705, 717, 674, 168, 672, 716, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 744, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 714, 0,
-	0, 287, 0, 0, 732, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 675, 0, 248, 231, 742,
-	629, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1379, 1378, 1380,
-	299, 181, 142, 282, 730, 227, 741, 726, 727, 728,
-	731, 734, 735, 670, 673, 736, 738, 740, 743, 251,
-	0, 0, 0, 1573, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 958, 957, 967, 968, 960, 961,
-	962, 963, 964, 965, 966, 959, 671, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 720, 217, 218, 219,
-	220, 669, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 750, 729,
-	749, 751, 752, 748, 753, 754, 737, 690, 0, 746,
-	745, 747, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	633, 634, 635, 636, 637, 638, 639, 640, 641, 642,
-	643, 644, 645, 646, 647, 121, 648, 649, 650, 651,
-	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
-	662, 663, 664, 665, 666, 667, 723, 0, 0, 295,
-	296, 297, 281, 97, 0, 718, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 688, 0, 0, 0, 174, 0, 0,
-	199, 0, 0, 0, 258, 213, 0, 0, 0, 0,
-	733, 739, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 681, 0, 0, 631, 725, 724, 698, 707,
-	0, 0, 156, 699, 0, 706, 700, 704, 703, 701,
-	702, 0, 668, 0, 0, 0, 0, 0, 0, 628,
-	685, 0, 689, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 682, 683, 0, 0, 0, 0, 719,
-	0, 684, 0, 0, 721, 0, 708, 0, 0, 148,
-	263, 277, 157, 254, 290, 162, 261, 153, 228, 250,
-	0, 0, 150, 275, 260, 210, 193, 194, 149, 0,
-	245, 172, 185, 169, 226, 705, 717, 674, 168, 672,
-	716, 285, 152, 0, 284, 225, 272, 276, 211, 205,
-	151, 274, 209, 204, 197, 176, 744, 189, 237, 203,
-	238, 190, 215, 214, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 714, 0, 0, 287, 0, 0, 732, 0,
-	0, 0, 262, 0, 0, 198, 0, 0, 0, 675,
-	0, 248, 231, 742, 629, 0, 246, 201, 273, 239,
-	278, 264, 286, 242, 240, 144, 265, 171, 212, 154,
-	155, 167, 173, 175, 177, 178, 221, 222, 234, 253,
-	266, 267, 268, 170, 163, 247, 164, 187, 165, 145,
-	255, 166, 146, 235, 271, 0, 183, 243, 208, 147,
-	207, 236, 270, 269, 294, 300, 301, 305, 0, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 299, 181, 142, 282, 730, 227,
-	741, 726, 727, 728, 731, 734, 735, 670, 673, 736,
-	738, 740, 743, 251, 0, 0, 0, 0, 0, 192,
-	233, 0, 252, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 280, 292, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	671, 0, 0, 0, 291, 0, 0, 0, 0, 0,
-	720, 217, 218, 219, 220, 669, 0, 161, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 304, 180,
-	186, 0, 188, 160, 232, 182, 289, 195, 0, 224,
-	191, 256, 196, 202, 244, 288, 230, 249, 158, 279,
-	257, 206, 750, 729, 749, 751, 752, 748, 753, 754,
-	737, 690, 0, 746, 745, 747, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
-	200, 70, 241, 179, 633, 634, 635, 636, 637, 638,
-	639, 640, 641, 642, 643, 644, 645, 646, 647, 121,
-	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
-	658, 659, 660, 661, 662, 663, 664, 665, 666, 667,
-	723, 718, 0, 295, 296, 297, 281, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 688,
-	0, 0, 0, 174, 891, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 733, 739, 0, 0,
-	0, 0, 0, 0, 0, 887, 0, 0, 681, 0,
-	0, 631, 725, 724, 698, 707, 0, 0, 156, 699,
-	0, 706, 700, 704, 703, 701, 702, 0, 668, 0,
-	0, 0, 0, 0, 0, 628, 685, 0, 689, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 682,
-	683, 0, 0, 0, 0, 719, 0, 684, 0, 0,
-	888, 0, 708, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 705, 717, 674, 168, 672, 716, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 744, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 714, 0,
-	0, 287, 0, 0, 732, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 675, 0, 248, 231, 742,
-	629, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 730, 227, 741, 726, 727, 728,
-	731, 734, 735, 670, 673, 736, 738, 740, 743, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 720, 217, 218, 219,
-	220, 669, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 750, 729,
-	749, 751, 752, 748, 753, 754, 737, 690, 0, 746,
-	745, 747, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	633, 634, 635, 636, 637, 638, 639, 640, 641, 642,
-	643, 644, 645, 646, 647, 121, 648, 649, 650, 651,
-	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
-	662, 663, 664, 665, 666, 667, 723, 718, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 688, 0, 0, 0, 174,
-	2329, 0, 199, 0, 0, 0, 258, 213, 0, 0,
-	0, 0, 733, 739, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 681, 0, 0, 631, 725, 724,
-	698, 707, 0, 0, 156, 699, 0, 706, 700, 704,
-	703, 701, 702, 0, 668, 0, 0, 0, 0, 0,
-	0, 628, 685, 0, 689, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 682, 683, 0, 0, 0,
-	0, 719, 0, 684, 0, 0, 721, 0, 708, 0,
-	0, 148, 263, 277, 157, 254, 290, 162, 261, 153,
-	228, 250, 0, 0, 150, 275, 260, 210, 193, 194,
-	149, 0, 245, 172, 185, 169, 226, 705, 717, 674,
-	168, 672, 716, 285, 152, 0, 284, 225, 272, 276,
-	211, 205, 151, 274, 209, 204, 197, 176, 744, 189,
-	237, 203, 238, 190, 215, 214, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 714, 0, 0, 287, 0, 0,
-	732, 0, 0, 0, 262, 0, 0, 198, 0, 0,
-	0, 675, 0, 248, 231, 742, 629, 0, 246, 201,
-	273, 239, 278, 264, 286, 242, 240, 144, 265, 171,
-	212, 154, 155, 167, 173, 175, 177, 178, 221, 222,
-	234, 253, 266, 267, 268, 170, 163, 247, 164, 187,
-	165, 145, 255, 166, 146, 235, 271, 0, 183, 243,
-	208, 147, 207, 236, 270, 269, 294, 300, 301, 305,
-	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 299, 181, 142, 282,
-	730, 227, 741, 726, 727, 728, 731, 734, 735, 670,
-	673, 736, 738, 740, 743, 251, 0, 0, 0, 0,
-	0, 192, 233, 0, 252, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 280, 292,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 671, 0, 0, 0, 291, 0, 0, 0,
-	0, 0, 720, 217, 218, 219, 220, 669, 0, 161,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	304, 180, 186, 0, 188, 160, 232, 182, 289, 195,
-	0, 224, 191, 256, 196, 202, 244, 288, 230, 249,
-	158, 279, 257, 206, 750, 729, 749, 751, 752, 748,
-	753, 754, 737, 690, 0, 746, 745, 747, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	143, 0, 200, 0, 241, 179, 633, 634, 635, 636,
-	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
-	647, 121, 648, 649, 650, 651, 652, 653, 654, 655,
-	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
-	666, 667, 723, 718, 0, 295, 296, 297, 281, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 688, 0, 0, 0, 174, 891, 0, 199, 0,
-	0, 0, 258, 213, 0, 0, 0, 0, 733, 739,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	681, 0, 0, 631, 725, 724, 698, 707, 0, 0,
-	156, 699, 0, 706, 700, 704, 703, 701, 702, 0,
-	668, 0, 0, 0, 0, 0, 0, 628, 685, 0,
-	689, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 682, 683, 0, 0, 0, 0, 719, 0, 684,
-	0, 0, 721, 0, 708, 0, 0, 148, 263, 277,
-	157, 254, 290, 162, 261, 153, 228, 250, 0, 0,
-	150, 275, 260, 210, 193, 194, 149, 0, 245, 172,
-	185, 169, 226, 705, 717, 674, 168, 672, 716, 285,
-	152, 0, 284, 225, 272, 276, 211, 205, 151, 274,
-	209, 204, 197, 176, 744, 189, 237, 203, 238, 190,
-	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	714, 0, 0, 287, 0, 0, 732, 0, 0, 0,
-	262, 0, 0, 198, 0, 0, 0, 675, 0, 248,
-	231, 742, 629, 0, 246, 201, 273, 239, 278, 264,
-	286, 242, 240, 144, 265, 171, 212, 154, 155, 167,
-	173, 175, 177, 178, 221, 222, 234, 253, 266, 267,
-	268, 170, 163, 247, 164, 187, 165, 145, 255, 166,
-	146, 235, 271, 0, 183, 243, 208, 147, 207, 236,
-	270, 269, 294, 300, 301, 305, 0, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 299, 181, 142, 282, 730, 227, 741, 726,
-	727, 728, 731, 734, 735, 670, 673, 736, 738, 740,
-	743, 251, 0, 0, 0, 0, 0, 192, 233, 0,
-	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 280, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 671, 0,
-	0, 0, 291, 0, 0, 0, 0, 0, 720, 217,
-	218, 219, 220, 669, 0, 161, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 304, 180, 186, 0,
-	188, 160, 232, 182, 289, 195, 0, 224, 191, 256,
-	196, 202, 244, 288, 230, 249, 158, 279, 257, 206,
-	750, 729, 749, 751, 752, 748, 753, 754, 737, 690,
-	0, 746, 745, 747, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 0, 200, 0,
-	241, 179, 633, 634, 635, 636, 637, 638, 639, 640,
-	641, 642, 643, 644, 645, 646, 647, 121, 648, 649,
-	650, 651, 652, 653, 654, 655, 656, 657, 658, 659,
-	660, 661, 662, 663, 664, 665, 666, 667, 723, 0,
-	0, 295, 296, 297, 281, 718, 0, 0, 1560, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 688, 0, 0, 0, 174, 0, 0,
-	199, 0, 0, 0, 258, 213, 0, 0, 0, 0,
-	733, 739, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 681, 0, 0, 631, 725, 724, 698, 707,
-	0, 0, 156, 699, 0, 706, 700, 704, 703, 701,
-	702, 0, 668, 0, 0, 0, 0, 0, 0, 628,
-	685, 0, 689, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 682, 683, 0, 0, 0, 0, 719,
-	0, 684, 0, 0, 721, 0, 708, 0, 0, 148,
-	263, 277, 157, 254, 290, 162, 261, 153, 228, 250,
-	0, 0, 150, 275, 260, 210, 193, 194, 149, 0,
-	245, 172, 185, 169, 226, 705, 717, 674, 168, 672,
-	716, 285, 152, 0, 284, 225, 272, 276, 211, 205,
-	151, 274, 209, 204, 197, 176, 744, 189, 237, 203,
-	238, 190, 215, 214, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 714, 0, 0, 287, 0, 0, 732, 0,
-	0, 0, 262, 0, 0, 198, 0, 0, 0, 675,
-	0, 248, 231, 742, 629, 0, 246, 201, 273, 239,
-	278, 264, 286, 242, 240, 144, 265, 171, 212, 154,
-	155, 167, 173, 175, 177, 178, 221, 222, 234, 253,
-	266, 267, 268, 170, 163, 247, 164, 187, 165, 145,
-	255, 166, 146, 235, 271, 0, 183, 243, 208, 147,
-	207, 236, 270, 269, 294, 300, 301, 305, 0, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 299, 181, 142, 282, 730, 227,
-	741, 726, 727, 728, 731, 734, 735, 670, 673, 736,
-	738, 740, 743, 251, 0, 0, 0, 0, 0, 192,
-	233, 0, 252, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 280, 292, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	671, 0, 0, 0, 291, 0, 0, 0, 0, 0,
-	720, 217, 218, 219, 220, 669, 0, 161, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 304, 180,
-	186, 0, 188, 160, 232, 182, 289, 195, 0, 224,
-	191, 256, 196, 202, 244, 288, 230, 249, 158, 279,
-	257, 206, 750, 729, 749, 751, 752, 748, 753, 754,
-	737, 690, 0, 746, 745, 747, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
-	200, 0, 241, 179, 633, 634, 635, 636, 637, 638,
-	639, 640, 641, 642, 643, 644, 645, 646, 647, 121,
-	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
-	658, 659, 660, 661, 662, 663, 664, 665, 666, 667,
-	723, 718, 0, 295, 296, 297, 281, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 688,
-	0, 0, 0, 174, 0, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 733, 739, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 681, 0,
-	0, 631, 725, 724, 698, 707, 0, 0, 156, 699,
-	0, 706, 700, 704, 703, 701, 702, 0, 668, 0,
-	0, 0, 0, 0, 0, 628, 685, 0, 689, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 682,
-	683, 625, 0, 0, 0, 719, 0, 684, 0, 0,
-	721, 0, 708, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 705, 717, 674, 168, 672, 716, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 744, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 714, 0,
-	0, 287, 0, 0, 732, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 675, 0, 248, 231, 742,
-	629, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 730, 227, 741, 726, 727, 728,
-	731, 734, 735, 670, 673, 736, 738, 740, 743, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 720, 217, 218, 219,
-	220, 669, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 750, 729,
-	749, 751, 752, 748, 753, 754, 737, 690, 0, 746,
-	745, 747, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	633, 634, 635, 636, 637, 638, 639, 640, 641, 642,
-	643, 644, 645, 646, 647, 121, 648, 649, 650, 651,
-	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
-	662, 663, 664, 665, 666, 667, 723, 718, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 688, 0, 0, 0, 174,
-	0, 0, 199, 0, 0, 0, 258, 213, 0, 0,
-	0, 0, 733, 739, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 681, 0, 0, 631, 725, 724,
-	698, 707, 0, 0, 156, 699, 0, 706, 700, 704,
-	703, 701, 702, 0, 668, 0, 0, 0, 0, 0,
-	0, 628, 685, 0, 689, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 682, 683, 0, 0, 0,
-	0, 719, 0, 684, 0, 0, 721, 0, 708, 0,
-	0, 148, 263, 277, 157, 254, 290, 162, 261, 153,
-	228, 250, 0, 0, 150, 275, 260, 210, 193, 194,
-	149, 0, 245, 172, 185, 169, 226, 705, 717, 674,
-	168, 672, 716, 285, 152, 0, 284, 225, 272, 276,
-	211, 205, 151, 274, 209, 204, 197, 176, 744, 189,
-	237, 203, 238, 190, 215, 214, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 714, 0, 0, 287, 0, 0,
-	732, 0, 0, 0, 262, 0, 0, 198, 0, 0,
-	0, 675, 0, 248, 231, 742, 629, 0, 246, 201,
-	273, 239, 278, 264, 286, 242, 240, 144, 265, 171,
-	212, 154, 155, 167, 173, 175, 177, 178, 221, 222,
-	234, 253, 266, 267, 268, 170, 163, 247, 164, 187,
-	165, 145, 255, 166, 146, 235, 271, 0, 183, 243,
-	208, 147, 207, 236, 270, 269, 294, 300, 301, 305,
-	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 299, 181, 142, 282,
-	730, 227, 741, 726, 727, 728, 731, 734, 735, 670,
-	673, 736, 738, 740, 743, 251, 0, 0, 0, 0,
-	0, 192, 233, 0, 252, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 280, 292,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 671, 0, 0, 0, 291, 0, 0, 0,
-	0, 0, 720, 217, 218, 219, 220, 669, 0, 161,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	304, 180, 186, 0, 188, 160, 232, 182, 289, 195,
-	0, 224, 191, 256, 196, 202, 244, 288, 230, 249,
-	158, 279, 257, 206, 750, 729, 749, 751, 752, 748,
-	753, 754, 737, 690, 0, 746, 745, 747, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	143, 0, 200, 0, 241, 179, 633, 634, 635, 636,
-	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
-	647, 121, 648, 649, 650, 651, 652, 653, 654, 655,
-	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
-	666, 667, 723, 718, 0, 295, 296, 297, 281, 0,
-	0, 0, 0, 229, 0, 0, 0, 1323, 0, 0,
-	0, 688, 0, 0, 0, 174, 0, 0, 199, 0,
-	0, 0, 258, 213, 0, 0, 0, 0, 733, 739,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	681, 0, 0, 631, 725, 724, 698, 707, 0, 0,
-	156, 699, 0, 706, 700, 704, 703, 701, 702, 0,
-	668, 0, 0, 0, 0, 0, 0, 0, 685, 0,
-	689, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 682, 683, 0, 0, 0, 0, 719, 0, 684,
-	0, 0, 721, 0, 708, 0, 0, 148, 263, 277,
-	157, 254, 290, 162, 261, 153, 228, 250, 0, 0,
-	150, 275, 260, 210, 193, 194, 149, 0, 245, 172,
-	185, 169, 226, 705, 717, 674, 168, 672, 716, 285,
-	152, 0, 284, 225, 272, 276, 211, 205, 151, 274,
-	209, 204, 197, 176, 744, 189, 237, 203, 238, 190,
-	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	714, 0, 0, 287, 0, 0, 732, 0, 0, 0,
-	262, 0, 0, 198, 0, 0, 0, 675, 0, 248,
-	231, 742, 0, 0, 246, 201, 273, 239, 278, 264,
-	286, 242, 240, 144, 265, 171, 212, 154, 155, 167,
-	173, 175, 177, 178, 221, 222, 234, 253, 266, 267,
-	268, 170, 163, 247, 164, 187, 165, 145, 255, 166,
-	146, 235, 271, 0, 183, 243, 208, 147, 207, 236,
-	270, 269, 294, 1324, 1325, 305, 0, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 299, 181, 142, 282, 730, 227, 741, 726,
-	727, 728, 731, 734, 735, 670, 673, 736, 738, 740,
-	743, 251, 0, 0, 0, 0, 0, 192, 233, 0,
-	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 280, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 671, 0,
-	0, 0, 291, 0, 0, 0, 0, 0, 720, 217,
-	218, 219, 220, 669, 0, 161, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 304, 180, 186, 0,
-	188, 160, 232, 182, 289, 195, 0, 224, 191, 256,
-	196, 202, 244, 288, 230, 249, 158, 279, 257, 206,
-	750, 729, 749, 751, 752, 748, 753, 754, 737, 690,
-	0, 746, 745, 747, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 0, 200, 0,
-	241, 179, 633, 634, 635, 636, 637, 638, 639, 640,
-	641, 642, 643, 644, 645, 646, 647, 121, 648, 649,
-	650, 651, 652, 653, 654, 655, 656, 657, 658, 659,
-	660, 661, 662, 663, 664, 665, 666, 667, 723, 718,
-	0, 295, 296, 297, 281, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 688, 0, 0,
-	0, 174, 0, 0, 199, 0, 0, 0, 258, 213,
-	0, 0, 0, 0, 733, 739, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 631,
-	725, 724, 698, 707, 0, 0, 156, 699, 0, 706,
-	700, 704, 703, 701, 702, 0, 668, 0, 0, 0,
-	0, 0, 0, 628, 685, 0, 689, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 682, 683, 0,
-	0, 0, 0, 719, 0, 684, 0, 0, 721, 0,
-	708, 0, 0, 148, 263, 277, 157, 254, 290, 162,
-	261, 153, 228, 250, 0, 0, 150, 275, 260, 210,
-	193, 194, 149, 0, 245, 172, 185, 169, 226, 705,
-	717, 674, 168, 672, 716, 285, 152, 0, 284, 225,
-	272, 276, 211, 205, 151, 274, 209, 204, 197, 176,
-	744, 189, 237, 203, 238, 190, 215, 214, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 714, 0, 0, 287,
-	0, 0, 732, 0, 0, 0, 262, 0, 0, 198,
-	0, 0, 0, 675, 0, 248, 231, 742, 629, 0,
-	246, 201, 273, 239, 278, 264, 286, 242, 240, 144,
-	265, 171, 212, 154, 155, 167, 173, 175, 177, 178,
-	221, 222, 234, 253, 266, 267, 268, 170, 163, 247,
-	164, 187, 165, 145, 255, 166, 146, 235, 271, 0,
-	183, 243, 208, 147, 207, 236, 270, 269, 294, 300,
-	301, 305, 0, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 299, 181,
-	142, 282, 730, 227, 741, 726, 727, 728, 731, 734,
-	735, 670, 673, 736, 738, 740, 743, 251, 0, 0,
-	0, 0, 0, 192, 233, 0, 252, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	280, 292, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 0, 0, 0, 291, 0,
-	0, 0, 0, 0, 720, 217, 218, 219, 220, 669,
-	0, 161, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 304, 180, 186, 0, 188, 160, 232, 182,
-	289, 195, 0, 224, 191, 256, 196, 202, 244, 288,
-	230, 249, 158, 279, 257, 206, 750, 729, 749, 751,
-	752, 748, 753, 754, 737, 690, 0, 746, 745, 747,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 143, 0, 200, 0, 241, 179, 633, 634,
-	635, 636, 637, 638, 639, 640, 641, 642, 643, 644,
-	645, 646, 647, 121, 648, 649, 650, 651, 652, 653,
-	654, 655, 656, 657, 658, 659, 660, 661, 662, 663,
-	664, 665, 666, 667, 723, 718, 0, 295, 296, 297,
-	281, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 688, 0, 0, 0, 174, 0, 0,
-	199, 0, 0, 0, 258, 213, 0, 0, 0, 0,
-	733, 739, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 681, 0, 0, 631, 725, 724, 698, 707,
-	0, 0, 156, 699, 0, 706, 700, 704, 703, 701,
-	702, 0, 668, 0, 0, 0, 0, 0, 0, 0,
-	685, 0, 689, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 682, 683, 0, 0, 0, 0, 719,
-	0, 684, 0, 0, 721, 0, 708, 0, 0, 148,
-	263, 277, 157, 254, 290, 162, 261, 153, 228, 250,
-	0, 0, 150, 275, 260, 210, 193, 194, 149, 0,
-	245, 172, 185, 169, 226, 705, 717, 674, 168, 672,
-	716, 285, 152, 0, 284, 225, 272, 276, 211, 205,
-	151, 274, 209, 204, 197, 176, 744, 189, 237, 203,
-	238, 190, 215, 214, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 714, 0, 0, 287, 0, 0, 732, 0,
-	0, 0, 262, 0, 0, 198, 0, 0, 0, 675,
-	0, 248, 231, 742, 0, 0, 246, 201, 273, 239,
-	278, 264, 286, 242, 240, 144, 265, 171, 212, 154,
-	155, 167, 173, 175, 177, 178, 221, 222, 234, 253,
-	266, 267, 268, 170, 163, 247, 164, 187, 165, 145,
-	255, 166, 146, 235, 271, 0, 183, 243, 208, 147,
-	207, 236, 270, 269, 294, 300, 301, 305, 0, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 299, 181, 142, 282, 730, 227,
-	741, 726, 727, 728, 731, 734, 735, 670, 673, 736,
-	738, 740, 743, 251, 0, 0, 0, 0, 0, 192,
-	233, 0, 252, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 280, 292, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	671, 0, 0, 0, 291, 0, 0, 0, 0, 0,
-	720, 217, 218, 219, 220, 669, 0, 161, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 304, 180,
-	186, 0, 188, 160, 232, 182, 289, 195, 0, 224,
-	191, 256, 196, 202, 244, 288, 230, 249, 158, 279,
-	257, 206, 750, 729, 749, 751, 752, 748, 753, 754,
-	737, 690, 0, 746, 745, 747, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
-	200, 0, 241, 179, 633, 634, 635, 636, 637, 638,
-	639, 640, 641, 642, 643, 644, 645, 646, 647, 121,
-	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
-	658, 659, 660, 661, 662, 663, 664, 665, 666, 667,
-	723, 0, 0, 295, 296, 297, 281, 97, 0, 27,
-	87, 69, 0, 0, 0, 0, 0, 0, 0, 229,
-	313, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 174, 0, 0, 199, 0, 0, 0, 258, 213,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 318, 0, 0, 103,
-	0, 0, 0, 0, 0, 0, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 350, 0, 349, 353, 345,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 341, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 360, 0, 148, 263, 277, 157, 254, 290, 162,
-	261, 153, 228, 250, 0, 0, 150, 275, 260, 210,
-	193, 194, 149, 0, 245, 172, 185, 169, 226, 0,
-	0, 302, 168, 293, 0, 285, 152, 0, 284, 225,
-	272, 276, 211, 205, 151, 274, 209, 204, 197, 176,
-	0, 189, 237, 203, 238, 190, 215, 214, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 287,
-	0, 0, 0, 0, 0, 0, 262, 0, 0, 198,
-	0, 0, 0, 303, 0, 248, 231, 0, 0, 0,
-	246, 201, 273, 239, 278, 264, 286, 242, 240, 144,
-	265, 171, 212, 154, 155, 167, 173, 175, 177, 178,
-	221, 222, 234, 253, 266, 267, 268, 170, 163, 247,
-	164, 187, 165, 145, 255, 166, 146, 235, 271, 0,
-	183, 243, 208, 147, 207, 236, 270, 269, 294, 300,
-	301, 305, 0, 306, 0, 0, 0, 1362, 343, 342,
-	346, 0, 0, 0, 0, 0, 348, 0, 299, 181,
-	142, 282, 0, 227, 0, 0, 0, 0, 352, 0,
-	0, 223, 298, 0, 0, 0, 0, 251, 0, 0,
-	0, 0, 344, 192, 233, 0, 252, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	280, 292, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 283, 0, 0, 0, 291, 0,
-	0, 0, 0, 0, 0, 217, 218, 219, 220, 314,
-	316, 161, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 304, 180, 186, 0, 188, 160, 232, 182,
-	289, 195, 0, 224, 191, 256, 196, 202, 244, 288,
-	230, 249, 158, 279, 257, 206, 0, 0, 0, 347,
-	351, 354, 0, 355, 356, 0, 0, 357, 358, 359,
-	0, 0, 361, 362, 0, 0, 1358, 0, 1355, 0,
-	0, 0, 1357, 1354, 1356, 1360, 1361, 0, 0, 0,
-	1359, 0, 143, 0, 200, 70, 241, 179, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
-	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
-	138, 139, 140, 141, 229, 0, 0, 295, 296, 297,
-	281, 0, 0, 0, 0, 0, 174, 0, 0, 199,
-	0, 0, 0, 258, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 103, 0, 0, 0, 0, 0,
-	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 1642, 1645, 1343, 1344, 1345, 1346, 1347, 1348,
-	1349, 1350, 1351, 1352, 1353, 1365, 1366, 1367, 1368, 1369,
-	1370, 1363, 1364, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 148, 263,
-	277, 157, 254, 290, 162, 261, 153, 228, 250, 0,
-	0, 150, 275, 260, 210, 193, 194, 149, 0, 245,
-	172, 185, 169, 226, 0, 0, 302, 168, 293, 0,
-	285, 152, 0, 284, 225, 272, 276, 211, 205, 151,
-	274, 209, 204, 197, 176, 0, 189, 237, 203, 238,
-	190, 215, 214, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1646, 287, 0, 0, 0, 1639, 0,
-	1638, 262, 1640, 1643, 198, 0, 0, 0, 303, 0,
-	248, 231, 0, 0, 0, 246, 201, 273, 239, 278,
-	264, 286, 242, 240, 144, 265, 171, 212, 154, 155,
-	167, 173, 175, 177, 178, 221, 222, 234, 253, 266,
-	267, 268, 170, 163, 247, 164, 187, 165, 145, 255,
-	166, 146, 235, 271, 1644, 183, 243, 208, 147, 207,
-	236, 270, 269, 294, 300, 301, 305, 0, 306, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 299, 181, 142, 282, 0, 227, 0,
-	0, 0, 0, 0, 0, 0, 223, 298, 0, 0,
-	0, 0, 251, 0, 0, 0, 0, 0, 192, 233,
-	0, 252, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 280, 292, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 283,
-	0, 0, 0, 291, 0, 0, 0, 0, 0, 0,
-	217, 218, 219, 220, 184, 0, 161, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 304, 180, 186,
-	0, 188, 160, 232, 182, 289, 195, 0, 224, 191,
-	256, 196, 202, 244, 288, 230, 249, 158, 279, 257,
-	206, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 143, 0, 200,
-	0, 241, 179, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	861, 1475, 912, 2558, 2559, 2538, 2567, 852, 2217, 2411,
+	714, 716, 2481, 736, 2442, 1423, 1380, 2501, 2361, 2389,
+	1431, 2423, 2266, 2424, 2279, 2101, 2199, 1890, 1313, 2296,
+	2368, 715, 112, 1080, 2215, 646, 2216, 341, 347, 2288,
+	347, 754, 345, 24, 364, 391, 885, 2197, 2112, 2254,
+	748, 72, 2247, 2076, 2090, 1360, 848, 1646, 1867, 855,
+	1855, 353, 2165, 584, 2115, 670, 1710, 2122, 1722, 970,
+	1938, 2127, 1426, 943, 1958, 1290, 115, 1988, 1790, 637,
+	421, 1091, 1975, 1285, 1896, 333, 1858, 72, 1937, 986,
+	1338, 497, 1713, 568, 1106, 888, 1853, 1286, 1109, 1100,
+	1101, 1092, 725, 470, 1463, 963, 359, 1752, 1562, 1546,
+	474, 112, 1427, 946, 1411, 1721, 1622, 1856, 1361, 71,
+	1661, 586, 3, 1365, 907, 344, 17, 342, 6, 894,
+	469, 343, 5, 873, 915, 863, 846, 1337, 1304, 717,
+	1287, 1474, 944, 477, 34, 1333, 1489, 662, 967, 472,
+	1325, 24, 950, 851, 1323, 476, 1857, 1297, 1357, 72,
+	838, 512, 1429, 992, 1070, 1081, 337, 870, 553, 845,
+	895, 629, 896, 461, 1454, 902, 872, 1477, 334, 989,
+	34, 420, 613, 390, 111, 361, 362, 12, 7, 4,
+	1476, 2201, 647, 2450, 108, 1306, 1294, 2452, 2403, 2295,
+	858, 2340, 1094, 1057, 1058, 2509, 2479, 2477, 346, 103,
+	661, 2416, 2212, 1605, 106, 533, 1597, 107, 615, 31,
+	97, 78, 2378, 418, 475, 462, 424, 1291, 1302, 107,
+	107, 496, 572, 107, 17, 107, 6, 31, 97, 78,
+	5, 552, 2461, 107, 332, 31, 97, 78, 349, 839,
+	429, 843, 34, 107, 952, 953, 1624, 2276, 1818, 604,
+	2054, 605, 898, 499, 854, 616, 546, 104, 801, 598,
+	599, 2459, 550, 821, 596, 842, 595, 598, 599, 104,
+	104, 798, 2286, 104, 791, 104, 790, 792, 793, 2188,
+	794, 795, 356, 104, 2446, 2447, 410, 2203, 2349, 1623,
+	2204, 2406, 2205, 800, 2289, 2290, 2291, 2292, 2352, 2297,
+	857, 1591, 506, 1305, 964, 1716, 2367, 2049, 483, 482,
+	484, 1870, 1868, 1865, 1869, 1871, 2066, 1864, 1863, 1628,
+	1298, 2272, 1969, 515, 960, 505, 1967, 1870, 1868, 2153,
+	1869, 1871, 1959, 504, 939, 2068, 358, 1324, 481, 347,
+	444, 112, 112, 524, 2306, 2415, 2244, 2111, 2110, 621,
+	834, 1602, 537, 548, 549, 2107, 1838, 1840, 622, 547,
+	841, 387, 392, 536, 388, 2336, 2269, 1319, 501, 503,
+	352, 2343, 2344, 1964, 1965, 2341, 2342, 1071, 1283, 72,
+	72, 476, 2467, 348, 2135, 486, 387, 938, 1966, 388,
+	2463, 77, 2552, 105, 2255, 2256, 2257, 2259, 2187, 1632,
+	1633, 1634, 1635, 2568, 478, 2490, 412, 515, 2258, 2458,
+	421, 479, 95, 2413, 2418, 2419, 409, 408, 2409, 2410,
+	2268, 2413, 2497, 1963, 2335, 1757, 1467, 1468, 2238, 2528,
+	1303, 445, 1390, 558, 502, 112, 610, 403, 545, 1389,
+	389, 1847, 1952, 446, 2142, 2143, 597, 2563, 840, 1630,
+	475, 625, 544, 528, 470, 470, 470, 526, 606, 641,
+	641, 594, 593, 573, 2569, 480, 473, 2504, 2539, 2303,
+	2426, 2575, 34, 34, 498, 1791, 1801, 347, 665, 665,
+	1359, 406, 569, 570, 357, 574, 575, 576, 2347, 578,
+	614, 803, 533, 522, 523, 508, 509, 447, 399, 517,
+	516, 2206, 2233, 398, 2229, 1946, 1598, 1440, 1295, 819,
+	401, 831, 1961, 868, 649, 639, 639, 485, 333, 577,
+	1844, 1369, 579, 641, 351, 641, 505, 804, 1292, 1292,
+	799, 525, 350, 581, 853, 588, 589, 1744, 72, 643,
+	1292, 1436, 407, 601, 602, 2167, 2166, 520, 415, 416,
+	417, 72, 828, 619, 624, 1438, 1437, 956, 2200, 865,
+	72, 867, 598, 599, 402, 955, 641, 1435, 2562, 880,
+	954, 532, 449, 421, 555, 1715, 886, 390, 2505, 450,
+	2417, 112, 1807, 517, 516, 1806, 590, 609, 876, 2581,
+	510, 598, 599, 1421, 2464, 903, 903, 557, 2267, 617,
+	618, 2510, 541, 641, 112, 889, 1644, 965, 1970, 887,
+	1870, 1868, 1960, 1869, 1871, 1760, 411, 470, 889, 641,
+	901, 1307, 850, 1293, 1719, 1720, 2307, 1327, 635, 636,
+	542, 2213, 1606, 583, 2574, 827, 979, 824, 1718, 1841,
+	2273, 823, 1370, 981, 34, 641, 79, 985, 112, 112,
+	1760, 830, 1756, 34, 1760, 1001, 869, 2478, 79, 79,
+	1597, 805, 79, 881, 79, 995, 959, 810, 664, 664,
+	990, 600, 79, 875, 603, 890, 847, 332, 648, 632,
+	633, 634, 79, 2427, 2428, 660, 806, 899, 900, 796,
+	905, 623, 835, 527, 1422, 473, 1962, 826, 825, 822,
+	1580, 2502, 2503, 2234, 2235, 892, 893, 844, 2580, 2063,
+	849, 1061, 1366, 1369, 859, 1422, 966, 1645, 1326, 874,
+	837, 2231, 961, 539, 2571, 2230, 991, 1342, 1972, 611,
+	612, 435, 2553, 891, 2548, 540, 543, 860, 988, 2045,
+	864, 1373, 1062, 1063, 1064, 1065, 1812, 1422, 1466, 1467,
+	1468, 533, 984, 882, 883, 1586, 874, 538, 897, 1464,
+	1059, 652, 653, 654, 655, 656, 657, 658, 659, 940,
+	2542, 904, 1638, 1760, 976, 977, 993, 2541, 942, 941,
+	2093, 2535, 1087, 435, 2483, 2534, 2433, 1366, 1369, 2572,
+	962, 1098, 1098, 1103, 973, 974, 975, 1300, 847, 2549,
+	987, 987, 2103, 1560, 374, 980, 373, 377, 369, 437,
+	971, 982, 436, 1880, 983, 2096, 971, 971, 1111, 1066,
+	365, 2091, 112, 886, 1384, 2429, 2105, 2106, 641, 475,
+	384, 533, 2092, 2387, 1370, 1300, 1023, 2337, 1450, 1363,
+	1291, 476, 1300, 1364, 1367, 1356, 2536, 1005, 2333, 2484,
+	2027, 2434, 72, 453, 1032, 1312, 814, 815, 836, 112,
+	112, 437, 1372, 1280, 436, 582, 2097, 1374, 1359, 1282,
+	626, 1695, 112, 1339, 1112, 530, 1936, 1933, 1934, 1935,
+	2152, 1645, 2032, 2332, 2031, 2030, 2028, 1072, 341, 2331,
+	2310, 1097, 2330, 2328, 1639, 1368, 1355, 1885, 2310, 452,
+	1060, 112, 1342, 455, 454, 2485, 990, 2326, 1068, 1370,
+	475, 2086, 978, 2310, 1363, 1359, 1362, 2324, 1364, 1367,
+	2322, 1382, 1881, 1385, 1386, 1387, 1388, 531, 1073, 1391,
+	1392, 1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401,
+	1402, 1403, 1404, 818, 531, 34, 2029, 1042, 2310, 1848,
+	1090, 817, 1754, 2104, 2310, 1362, 2309, 2310, 2329, 1510,
+	1110, 2524, 991, 1104, 1278, 1105, 2144, 2016, 1277, 641,
+	1368, 2511, 2327, 1647, 1281, 1465, 1310, 1344, 1345, 1346,
+	2099, 1835, 2323, 1600, 665, 2323, 112, 1284, 367, 366,
+	370, 1087, 1434, 1459, 1599, 1461, 1590, 372, 1585, 489,
+	494, 495, 2098, 2100, 1353, 1334, 1347, 1311, 1279, 376,
+	1004, 1320, 1322, 1485, 1486, 807, 1349, 1432, 1351, 2001,
+	587, 2310, 1328, 368, 1335, 1447, 998, 999, 1000, 997,
+	1522, 1450, 1760, 645, 518, 500, 1383, 998, 999, 1000,
+	997, 1534, 1535, 1536, 1537, 1538, 1539, 1540, 1541, 1542,
+	1543, 1544, 1545, 1378, 1439, 1358, 1555, 1556, 1371, 1453,
+	1379, 1433, 897, 1469, 1352, 1348, 1350, 1954, 451, 1986,
+	1343, 1768, 2033, 2034, 1573, 2107, 1554, 1053, 1451, 1056,
+	1309, 1767, 1407, 1594, 1760, 1406, 1575, 2094, 1696, 1698,
+	1699, 1700, 1697, 1054, 1055, 1052, 1381, 1041, 1040, 1050,
+	1051, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1042, 1506,
+	1588, 1503, 1443, 1444, 1445, 1505, 1502, 1504, 1508, 1509,
+	371, 375, 378, 1507, 379, 380, 1582, 1341, 381, 382,
+	383, 1452, 1955, 385, 386, 2355, 1760, 1837, 1479, 1478,
+	1289, 1799, 630, 1553, 2022, 1548, 1760, 1299, 1342, 413,
+	811, 1973, 1956, 631, 971, 971, 971, 1551, 1552, 1550,
+	390, 491, 492, 493, 591, 1845, 998, 999, 1000, 997,
+	456, 1587, 1563, 1442, 664, 1589, 1579, 628, 1455, 1456,
+	1457, 1458, 507, 1000, 997, 1480, 1481, 1836, 1483, 1620,
+	1288, 1583, 1342, 2391, 1519, 1520, 1521, 866, 1523, 1524,
+	1482, 997, 1530, 1531, 1532, 1533, 1567, 1569, 1570, 1798,
+	1566, 1563, 1300, 1796, 1525, 812, 1574, 2241, 1576, 1045,
+	1046, 1047, 1048, 1049, 1042, 1484, 1491, 1492, 1493, 1494,
+	1495, 1496, 1497, 1498, 1499, 1500, 1501, 1513, 1514, 1515,
+	1516, 1517, 1518, 1511, 1512, 2240, 1670, 448, 1665, 627,
+	1564, 1314, 1315, 1660, 592, 1568, 1577, 1041, 1040, 1050,
+	1051, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1042, 2357,
+	1592, 998, 999, 1000, 997, 2527, 1809, 2224, 2557, 2544,
+	2024, 2011, 641, 2499, 641, 1487, 641, 998, 999, 1000,
+	997, 505, 2270, 2150, 1706, 1488, 1704, 2191, 1702, 1607,
+	1692, 2491, 1614, 998, 999, 1000, 997, 112, 1043, 1044,
+	1045, 1046, 1047, 1048, 1049, 1042, 2526, 641, 1008, 1009,
+	1010, 1011, 1012, 1013, 1014, 1006, 2453, 1643, 2271, 2151,
+	1705, 1625, 1703, 1649, 1701, 2190, 1691, 2570, 2394, 2382,
+	1603, 2381, 2315, 2252, 1654, 2530, 2251, 2250, 505, 998,
+	999, 1000, 997, 1659, 2246, 2245, 1657, 998, 999, 1000,
+	997, 2488, 1667, 1668, 1669, 2149, 1671, 112, 112, 1604,
+	505, 2475, 1968, 24, 1619, 1803, 1830, 1690, 1657, 1689,
+	1688, 72, 1685, 998, 999, 1000, 997, 1679, 1676, 1675,
+	1677, 1678, 808, 1859, 1707, 387, 1683, 1684, 388, 505,
+	112, 112, 112, 112, 877, 878, 879, 1723, 2508, 2362,
+	1593, 2466, 505, 112, 1740, 1596, 2454, 2448, 1601, 2405,
+	1723, 1050, 1051, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
+	1042, 641, 2401, 1650, 2380, 847, 2360, 1611, 1615, 112,
+	112, 112, 2358, 998, 999, 1000, 997, 2308, 2293, 1663,
+	1629, 2545, 2248, 1648, 2226, 1637, 17, 1334, 6, 1616,
+	1432, 1651, 5, 1652, 1642, 1656, 2196, 2161, 1636, 1658,
+	874, 1655, 1653, 2148, 34, 1765, 2147, 1664, 1662, 1641,
+	1662, 2146, 1711, 2079, 2073, 2072, 2071, 1674, 2070, 2064,
+	1612, 1613, 2055, 864, 2052, 1432, 1687, 1041, 1040, 1050,
+	1051, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1042, 2042,
+	1764, 1950, 1949, 1712, 1745, 1861, 1850, 1747, 1693, 1672,
+	1673, 1686, 1682, 1681, 2421, 1737, 1739, 1738, 1680, 2523,
+	1618, 1742, 1610, 2371, 1609, 390, 1578, 1558, 1748, 1778,
+	1557, 1785, 1724, 1725, 1726, 1727, 998, 999, 1000, 997,
+	764, 763, 2573, 1788, 1789, 998, 999, 1000, 997, 1755,
+	1308, 1083, 1039, 1758, 998, 999, 1000, 997, 1038, 809,
+	2550, 1873, 871, 1787, 1098, 2431, 1822, 1098, 107, 2430,
+	1825, 97, 78, 2373, 2325, 1777, 886, 2321, 641, 2320,
+	1059, 1749, 1750, 1751, 2345, 2278, 2194, 641, 2192, 2184,
+	2176, 1828, 2277, 2164, 2080, 2062, 1741, 998, 999, 1000,
+	997, 2044, 505, 1987, 1886, 1819, 998, 999, 1000, 997,
+	1657, 1810, 112, 72, 998, 999, 1000, 997, 104, 2363,
+	1808, 505, 1831, 1805, 1804, 112, 1339, 1802, 1884, 1657,
+	1769, 1817, 2172, 1548, 1875, 1876, 1877, 1824, 1766, 1759,
+	1786, 1939, 1941, 1843, 1939, 1939, 1821, 1829, 1761, 475,
+	1795, 1762, 1763, 1743, 998, 999, 1000, 997, 641, 641,
+	1839, 1572, 1814, 1571, 1820, 1826, 1823, 1813, 650, 1827,
+	2517, 394, 395, 396, 397, 1833, 2498, 2495, 2493, 2486,
+	107, 2407, 833, 2171, 393, 1834, 2393, 1024, 2338, 112,
+	1771, 1772, 1773, 2319, 1775, 1776, 2170, 1780, 1882, 1862,
+	2169, 1781, 1782, 1783, 1784, 998, 999, 1000, 997, 2316,
+	2114, 2264, 1940, 1879, 639, 639, 1883, 1878, 998, 999,
+	1000, 997, 998, 999, 1000, 997, 651, 1887, 1888, 832,
+	104, 1793, 1942, 1943, 1797, 1991, 2237, 1947, 1948, 1944,
+	585, 2123, 2556, 2140, 2116, 2159, 2138, 2137, 1811, 2136,
+	2134, 2041, 971, 2133, 2128, 1889, 2131, 2121, 971, 2120,
+	2074, 1549, 104, 1708, 1874, 998, 999, 1000, 997, 2021,
+	1666, 1640, 1998, 998, 999, 1000, 997, 1621, 1953, 1957,
+	641, 2472, 2015, 1581, 1985, 2470, 2014, 1565, 641, 1980,
+	1441, 998, 999, 1000, 997, 2013, 1425, 1971, 1330, 2012,
+	2040, 1405, 1340, 2019, 998, 999, 1000, 997, 998, 999,
+	1000, 997, 1089, 641, 1984, 1088, 1086, 998, 999, 1000,
+	997, 998, 999, 1000, 997, 112, 2036, 1085, 1084, 1991,
+	1082, 1079, 1078, 112, 1076, 1075, 639, 1945, 2020, 1074,
+	1069, 987, 1037, 1036, 639, 1035, 2017, 1034, 1033, 1031,
+	1030, 1029, 440, 2008, 1028, 1027, 641, 641, 1026, 2035,
+	1025, 112, 2083, 2026, 443, 1022, 1021, 2039, 2425, 2007,
+	1020, 2043, 1019, 1018, 2060, 998, 999, 1000, 997, 2005,
+	2046, 1017, 72, 443, 2004, 1016, 1015, 802, 535, 521,
+	2048, 998, 999, 1000, 997, 435, 2047, 1976, 1977, 1979,
+	1631, 998, 999, 1000, 997, 2069, 998, 999, 1000, 997,
+	1595, 1983, 639, 2077, 1449, 2003, 1448, 2067, 1316, 534,
+	1982, 1981, 2085, 1729, 1734, 1941, 2108, 2141, 2118, 2119,
+	1735, 1993, 2081, 1728, 2082, 2075, 1432, 998, 999, 1000,
+	997, 2002, 1732, 2117, 1584, 2006, 2126, 937, 1733, 2009,
+	2010, 1608, 59, 505, 1730, 1314, 1315, 1996, 2084, 33,
+	1731, 1723, 554, 2124, 2125, 2087, 32, 2023, 1995, 1852,
+	1317, 529, 2163, 437, 2038, 1424, 436, 2051, 2521, 998,
+	999, 1000, 997, 1994, 2404, 2061, 2129, 329, 2132, 1559,
+	998, 999, 1000, 997, 330, 2139, 1736, 2155, 1417, 1418,
+	1419, 331, 2145, 2301, 1851, 998, 999, 1000, 997, 434,
+	1617, 998, 999, 1000, 997, 884, 1479, 1478, 438, 2189,
+	2439, 2156, 608, 2157, 1041, 1040, 1050, 1051, 1043, 1044,
+	1045, 1046, 1047, 1048, 1049, 1042, 566, 567, 564, 565,
+	562, 563, 607, 2168, 505, 2210, 2518, 1408, 1849, 560,
+	561, 1276, 1657, 556, 2455, 505, 2177, 2398, 2396, 2179,
+	2365, 2359, 2354, 2353, 2174, 2351, 2299, 1413, 1416, 1417,
+	1418, 1419, 1414, 2178, 1415, 1420, 2182, 2183, 641, 2180,
+	2181, 2298, 2050, 2037, 2225, 1990, 559, 393, 112, 1413,
+	1416, 1417, 1418, 1419, 1414, 2195, 1415, 1420, 394, 395,
+	396, 397, 1989, 1753, 2211, 2207, 889, 2214, 1832, 422,
+	1770, 393, 2057, 519, 505, 2058, 2059, 505, 505, 505,
+	2220, 2085, 2474, 2473, 2223, 2108, 2473, 2474, 2239, 957,
+	425, 2227, 39, 1, 2077, 1296, 2065, 1872, 1860, 580,
+	886, 414, 1526, 571, 971, 816, 488, 2158, 2253, 514,
+	2160, 2261, 2262, 2263, 2249, 813, 513, 2221, 2222, 511,
+	2162, 1561, 1490, 749, 1093, 2260, 1099, 1709, 2438, 2480,
+	2392, 2274, 2519, 2441, 829, 735, 2346, 2202, 2285, 2348,
+	2287, 2053, 2173, 2198, 1301, 551, 2275, 1815, 1816, 761,
+	752, 1077, 2305, 797, 490, 2175, 751, 2154, 2283, 1717,
+	400, 431, 487, 433, 426, 2243, 2294, 430, 428, 427,
+	439, 432, 2109, 441, 442, 2130, 971, 2193, 1041, 1040,
+	1050, 1051, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1042,
+	2113, 2300, 2566, 2555, 2537, 2516, 2412, 2551, 2457, 2496,
+	2242, 2489, 2408, 2302, 363, 505, 2311, 2314, 2313, 958,
+	620, 459, 2265, 1866, 1627, 1626, 2414, 2318, 404, 423,
+	1329, 2317, 405, 1041, 1040, 1050, 1051, 1043, 1044, 1045,
+	1046, 1047, 1048, 1049, 1042, 1332, 1331, 1470, 1007, 2334,
+	1547, 1067, 668, 1794, 724, 2339, 718, 2366, 1714, 2102,
+	1746, 505, 2283, 38, 37, 36, 996, 1107, 750, 114,
+	505, 1354, 1108, 2514, 2451, 2443, 734, 2350, 1040, 1050,
+	1051, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1042, 2390,
+	2370, 2186, 2185, 1800, 2364, 733, 732, 2369, 731, 730,
+	2372, 2375, 72, 729, 2379, 1412, 1410, 1409, 948, 947,
+	994, 2383, 2384, 2385, 2386, 2422, 2376, 2377, 2402, 2236,
+	2397, 1694, 2399, 2400, 2232, 2228, 1951, 2209, 2395, 2208,
+	2088, 2089, 2284, 2095, 1895, 1891, 1893, 1894, 1892, 2025,
+	1997, 1854, 1978, 1974, 2445, 1095, 1102, 862, 2388, 109,
+	2420, 945, 2356, 1846, 856, 2219, 11, 2444, 10, 2312,
+	820, 9, 2056, 2432, 88, 30, 2437, 2449, 2436, 1318,
+	906, 14, 55, 2283, 54, 16, 51, 29, 15, 23,
+	22, 21, 2465, 67, 2018, 2390, 2460, 2462, 66, 65,
+	64, 20, 8, 63, 2468, 62, 2471, 2469, 61, 19,
+	18, 2482, 52, 53, 2476, 1041, 1040, 1050, 1051, 1043,
+	1044, 1045, 1046, 1047, 1048, 1049, 1042, 49, 48, 47,
+	2492, 46, 2494, 45, 2487, 44, 43, 50, 42, 41,
+	40, 76, 75, 74, 73, 2445, 2513, 2515, 2500, 1792,
+	25, 2507, 2506, 26, 505, 27, 505, 28, 2444, 2512,
+	86, 2520, 853, 2522, 853, 85, 87, 2374, 2529, 2525,
+	1041, 1040, 1050, 1051, 1043, 1044, 1045, 1046, 1047, 1048,
+	1049, 1042, 2482, 2531, 505, 83, 81, 84, 2540, 82,
+	80, 2543, 853, 35, 13, 2546, 1774, 2547, 1041, 1040,
+	1050, 1051, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1042,
+	2, 0, 0, 0, 0, 2554, 0, 0, 2561, 0,
+	0, 0, 2565, 2564, 0, 0, 0, 0, 0, 0,
+	0, 0, 2576, 0, 0, 0, 2561, 2579, 2578, 2577,
+	0, 2565, 1041, 1040, 1050, 1051, 1043, 1044, 1045, 1046,
+	1047, 1048, 1049, 1042, 0, 1220, 1263, 0, 2456, 1208,
+	0, 1169, 1222, 1143, 1158, 1230, 1159, 1160, 1194, 1122,
+	1178, 242, 1156, 0, 1211, 1114, 1146, 1147, 1116, 1153,
+	1117, 1144, 1171, 187, 1142, 1181, 212, 1228, 0, 0,
+	271, 226, 0, 0, 1174, 1213, 1176, 1199, 199, 1168,
+	1195, 1130, 1188, 1223, 1157, 0, 1192, 1224, 0, 0,
+	0, 0, 877, 878, 879, 0, 0, 0, 0, 169,
+	0, 0, 0, 0, 0, 1191, 1217, 1155, 0, 172,
+	1221, 1175, 1193, 0, 0, 1115, 1189, 0, 1120, 1123,
+	1229, 1215, 1150, 1151, 0, 0, 0, 0, 0, 0,
+	0, 1172, 1177, 1196, 1165, 0, 0, 0, 0, 0,
+	0, 0, 2533, 0, 1148, 0, 1185, 0, 0, 0,
+	1125, 1121, 0, 1170, 0, 0, 161, 276, 290, 170,
+	267, 303, 175, 274, 166, 241, 263, 0, 1262, 163,
+	288, 273, 223, 206, 207, 162, 0, 258, 185, 198,
+	182, 239, 0, 1219, 315, 181, 306, 1124, 298, 165,
+	1257, 297, 238, 285, 289, 224, 218, 164, 287, 222,
+	217, 210, 189, 0, 202, 250, 216, 251, 203, 228,
+	227, 229, 1241, 1242, 1243, 1244, 1245, 1253, 1254, 0,
+	1258, 1259, 1260, 1129, 0, 1149, 1197, 0, 1113, 153,
+	1206, 1214, 1167, 300, 1216, 1164, 1163, 1248, 0, 1247,
+	275, 1249, 1250, 211, 1212, 1145, 1154, 316, 1152, 261,
+	244, 1218, 1184, 1261, 259, 214, 286, 252, 291, 277,
+	299, 255, 253, 157, 278, 184, 225, 167, 168, 180,
+	186, 188, 190, 191, 234, 235, 247, 266, 279, 280,
+	281, 183, 176, 260, 177, 200, 178, 158, 268, 179,
+	159, 248, 284, 1246, 196, 256, 221, 160, 220, 249,
+	283, 282, 307, 313, 314, 318, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1275, 320,
+	321, 322, 323, 324, 325, 326, 327, 328, 0, 1255,
+	0, 1256, 312, 194, 152, 295, 0, 240, 1209, 1118,
+	1128, 1126, 1161, 1186, 1187, 236, 311, 1201, 1205, 1202,
+	1231, 264, 0, 0, 0, 0, 0, 205, 246, 1203,
+	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1119, 0, 272, 293, 305, 1264, 1265, 1266, 1267,
+	0, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 296, 1162,
+	1136, 1173, 304, 1139, 1137, 1200, 1138, 1190, 1233, 230,
+	231, 232, 233, 197, 0, 174, 1182, 1166, 1234, 1235,
+	1236, 1237, 1238, 1239, 1240, 1141, 317, 193, 0, 201,
+	173, 245, 195, 302, 208, 1207, 237, 204, 269, 209,
+	215, 257, 301, 243, 262, 171, 292, 270, 219, 1135,
+	1140, 1134, 1179, 1180, 1225, 1226, 1227, 1198, 1127, 1210,
+	1131, 1133, 1132, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1204, 0, 1183, 156, 0, 213, 1232, 254,
+	192, 757, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 726,
+	0, 0, 0, 187, 0, 0, 212, 0, 0, 0,
+	271, 226, 0, 0, 0, 0, 773, 780, 199, 154,
+	155, 0, 0, 1251, 1252, 308, 309, 310, 294, 719,
+	0, 0, 669, 764, 763, 737, 746, 0, 0, 169,
+	738, 0, 745, 739, 743, 742, 740, 741, 0, 706,
+	0, 0, 0, 0, 0, 0, 666, 723, 0, 727,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 776, 0, 0, 0, 0, 0, 0,
+	720, 721, 0, 0, 0, 0, 758, 0, 722, 0,
+	0, 760, 0, 747, 0, 0, 161, 276, 290, 170,
+	267, 303, 175, 274, 166, 241, 263, 0, 0, 163,
+	288, 273, 223, 206, 207, 162, 0, 258, 185, 198,
+	182, 239, 744, 756, 712, 181, 710, 755, 298, 165,
+	0, 297, 238, 285, 289, 224, 218, 164, 287, 222,
+	217, 210, 189, 785, 202, 250, 216, 251, 203, 228,
+	227, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 153,
+	753, 0, 0, 300, 0, 0, 771, 0, 0, 0,
+	275, 0, 0, 211, 0, 0, 0, 713, 0, 261,
+	244, 783, 667, 0, 259, 214, 286, 252, 291, 277,
+	299, 255, 253, 157, 278, 184, 225, 167, 168, 180,
+	186, 188, 190, 191, 234, 235, 247, 266, 279, 280,
+	281, 183, 176, 260, 177, 200, 178, 158, 268, 179,
+	159, 248, 284, 0, 196, 256, 221, 160, 220, 249,
+	283, 282, 307, 313, 314, 318, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 772, 0, 0, 0, 320,
+	321, 322, 323, 324, 325, 326, 327, 328, 0, 1528,
+	1527, 1529, 312, 194, 152, 295, 769, 240, 782, 765,
+	766, 767, 770, 774, 775, 708, 711, 777, 779, 781,
+	784, 264, 0, 0, 0, 0, 0, 205, 246, 0,
+	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 272, 293, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 709, 0,
+	0, 0, 304, 0, 0, 0, 0, 0, 759, 230,
+	231, 232, 233, 707, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 317, 193, 0, 201,
+	173, 245, 195, 302, 208, 0, 237, 204, 269, 209,
+	215, 257, 301, 243, 262, 171, 292, 270, 219, 791,
+	768, 790, 792, 793, 789, 794, 795, 778, 728, 0,
+	787, 786, 788, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 213, 0, 254,
+	192, 671, 672, 673, 674, 675, 676, 677, 678, 679,
+	680, 681, 682, 683, 684, 685, 131, 686, 687, 688,
+	689, 690, 691, 692, 693, 694, 695, 696, 697, 698,
+	699, 700, 701, 702, 703, 704, 705, 762, 0, 154,
+	155, 107, 0, 757, 0, 308, 309, 310, 294, 0,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 726, 0, 0, 0, 187, 0, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 773, 780,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 719, 0, 0, 669, 764, 763, 737, 746, 0,
+	0, 169, 738, 0, 745, 739, 743, 742, 740, 741,
+	0, 706, 0, 0, 0, 0, 0, 0, 666, 723,
+	0, 727, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 0, 0, 0,
+	0, 0, 720, 721, 0, 0, 0, 0, 758, 0,
+	722, 0, 0, 760, 0, 747, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 744, 756, 712, 181, 710, 755,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 785, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 753, 0, 0, 300, 0, 0, 771, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 713,
+	0, 261, 244, 783, 667, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 772, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 769, 240,
+	782, 765, 766, 767, 770, 774, 775, 708, 711, 777,
+	779, 781, 784, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	709, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	759, 230, 231, 232, 233, 707, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 791, 768, 790, 792, 793, 789, 794, 795, 778,
+	728, 0, 787, 786, 788, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	79, 254, 192, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 131, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 762,
+	0, 154, 155, 757, 0, 0, 0, 308, 309, 310,
+	294, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 726, 0, 0, 0, 187, 972, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 773, 780,
+	199, 0, 0, 0, 0, 0, 0, 0, 968, 0,
+	0, 719, 0, 0, 669, 764, 763, 737, 746, 0,
+	0, 169, 738, 0, 745, 739, 743, 742, 740, 741,
+	0, 706, 0, 0, 0, 0, 0, 0, 666, 723,
+	0, 727, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 0, 0, 0,
+	0, 0, 720, 721, 0, 0, 0, 0, 758, 0,
+	722, 0, 0, 969, 0, 747, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 744, 756, 712, 181, 710, 755,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 785, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 753, 0, 0, 300, 0, 0, 771, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 713,
+	0, 261, 244, 783, 667, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 772, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 769, 240,
+	782, 765, 766, 767, 770, 774, 775, 708, 711, 777,
+	779, 781, 784, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	709, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	759, 230, 231, 232, 233, 707, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 791, 768, 790, 792, 793, 789, 794, 795, 778,
+	728, 0, 787, 786, 788, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 131, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 762,
+	0, 154, 155, 757, 0, 0, 0, 308, 309, 310,
+	294, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 726, 0, 0, 0, 187, 2532, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 773, 780,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 719, 0, 0, 669, 764, 763, 737, 746, 0,
+	0, 169, 738, 0, 745, 739, 743, 742, 740, 741,
+	0, 706, 0, 0, 0, 0, 0, 0, 666, 723,
+	0, 727, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 0, 0, 0,
+	0, 0, 720, 721, 0, 0, 0, 0, 758, 0,
+	722, 0, 0, 760, 0, 747, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 744, 756, 712, 181, 710, 755,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 785, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 753, 0, 0, 300, 0, 0, 771, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 713,
+	0, 261, 244, 783, 667, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 772, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 769, 240,
+	782, 765, 766, 767, 770, 774, 775, 708, 711, 777,
+	779, 781, 784, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	709, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	759, 230, 231, 232, 233, 707, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 791, 768, 790, 792, 793, 789, 794, 795, 778,
+	728, 0, 787, 786, 788, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 131, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 762,
+	0, 154, 155, 757, 0, 0, 0, 308, 309, 310,
+	294, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 726, 0, 0, 0, 187, 0, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 773, 780,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 719, 0, 0, 669, 764, 763, 737, 746, 0,
+	0, 169, 738, 0, 745, 739, 743, 742, 740, 741,
+	0, 706, 0, 0, 0, 0, 0, 0, 0, 723,
+	2280, 727, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 0, 0, 0,
+	0, 0, 720, 721, 0, 0, 0, 0, 758, 0,
+	722, 0, 0, 760, 0, 747, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 744, 756, 712, 181, 710, 755,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 785, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 753, 0, 0, 300, 0, 0, 771, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 713,
+	0, 261, 244, 783, 0, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 772, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 769, 240,
+	782, 765, 766, 767, 770, 774, 775, 708, 711, 777,
+	779, 781, 784, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 2281, 0, 0, 0, 2282, 0,
+	709, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	759, 230, 231, 232, 233, 707, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 791, 768, 790, 792, 793, 789, 794, 795, 778,
+	728, 0, 787, 786, 788, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 131, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 762,
+	0, 154, 155, 757, 0, 0, 0, 308, 309, 310,
+	294, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 726, 0, 0, 0, 187, 972, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 773, 780,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 719, 0, 0, 669, 764, 763, 737, 746, 0,
+	0, 169, 738, 0, 745, 739, 743, 742, 740, 741,
+	0, 706, 0, 0, 0, 0, 0, 0, 666, 723,
+	0, 727, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 0, 0, 0,
+	0, 0, 720, 721, 0, 0, 0, 0, 758, 0,
+	722, 0, 0, 760, 0, 747, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 744, 756, 712, 181, 710, 755,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 785, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 753, 0, 0, 300, 0, 0, 771, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 713,
+	0, 261, 244, 783, 667, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 772, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 769, 240,
+	782, 765, 766, 767, 770, 774, 775, 708, 711, 777,
+	779, 781, 784, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	709, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	759, 230, 231, 232, 233, 707, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 791, 768, 790, 792, 793, 789, 794, 795, 778,
+	728, 0, 787, 786, 788, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 131, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 762,
+	0, 154, 155, 757, 0, 0, 1779, 308, 309, 310,
+	294, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 726, 0, 0, 0, 187, 0, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 773, 780,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 719, 0, 0, 669, 764, 763, 737, 746, 0,
+	0, 169, 738, 0, 745, 739, 743, 742, 740, 741,
+	0, 706, 0, 0, 0, 0, 0, 0, 666, 723,
+	0, 727, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 0, 0, 0,
+	0, 0, 720, 721, 0, 0, 0, 0, 758, 0,
+	722, 0, 0, 760, 0, 747, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 744, 756, 712, 181, 710, 755,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 785, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 753, 0, 0, 300, 0, 0, 771, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 713,
+	0, 261, 244, 783, 667, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 772, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 769, 240,
+	782, 765, 766, 767, 770, 774, 775, 708, 711, 777,
+	779, 781, 784, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	709, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	759, 230, 231, 232, 233, 707, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 791, 768, 790, 792, 793, 789, 794, 795, 778,
+	728, 0, 787, 786, 788, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 131, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 762,
+	0, 154, 155, 757, 0, 0, 0, 308, 309, 310,
+	294, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 726, 0, 0, 0, 187, 0, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 773, 780,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 719, 0, 0, 669, 764, 763, 737, 746, 0,
+	0, 169, 738, 0, 745, 739, 743, 742, 740, 741,
+	0, 706, 0, 0, 0, 0, 0, 0, 666, 723,
+	0, 727, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 0, 0, 0,
+	0, 0, 720, 721, 663, 0, 0, 0, 758, 0,
+	722, 0, 0, 760, 0, 747, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 744, 756, 712, 181, 710, 755,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 785, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 753, 0, 0, 300, 0, 0, 771, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 713,
+	0, 261, 244, 783, 667, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 772, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 769, 240,
+	782, 765, 766, 767, 770, 774, 775, 708, 711, 777,
+	779, 781, 784, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	709, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	759, 230, 231, 232, 233, 707, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 791, 768, 790, 792, 793, 789, 794, 795, 778,
+	728, 0, 787, 786, 788, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 131, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 762,
+	0, 154, 155, 757, 0, 0, 0, 308, 309, 310,
+	294, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 726, 0, 0, 0, 187, 0, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 773, 780,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 719, 0, 0, 669, 764, 763, 737, 746, 0,
+	0, 169, 738, 0, 745, 739, 743, 742, 740, 741,
+	0, 706, 0, 0, 0, 0, 0, 0, 666, 723,
+	0, 727, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 0, 0, 0,
+	0, 0, 720, 721, 0, 0, 0, 0, 758, 0,
+	722, 0, 0, 760, 0, 747, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 744, 756, 712, 181, 710, 755,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 785, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 753, 0, 0, 300, 0, 0, 771, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 713,
+	0, 261, 244, 783, 667, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 772, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 769, 240,
+	782, 765, 766, 767, 770, 774, 775, 708, 711, 777,
+	779, 781, 784, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	709, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	759, 230, 231, 232, 233, 707, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 791, 768, 790, 792, 793, 789, 794, 795, 778,
+	728, 0, 787, 786, 788, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 131, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 762,
+	0, 154, 155, 757, 0, 0, 0, 308, 309, 310,
+	294, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 726, 0, 0, 0, 187, 0, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 773, 780,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 719, 0, 0, 669, 764, 763, 737, 746, 0,
+	0, 169, 738, 0, 745, 739, 743, 742, 740, 741,
+	0, 706, 0, 0, 0, 0, 0, 0, 0, 723,
+	0, 727, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 0, 0, 0,
+	0, 0, 720, 721, 0, 0, 0, 0, 758, 0,
+	722, 0, 0, 760, 0, 747, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 744, 756, 712, 181, 710, 755,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 785, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 753, 0, 0, 300, 0, 0, 771, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 713,
+	0, 261, 244, 783, 0, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 772, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 769, 240,
+	782, 765, 766, 767, 770, 774, 775, 708, 711, 777,
+	779, 781, 784, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 2281, 0, 0, 0, 2282, 0,
+	709, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	759, 230, 231, 232, 233, 707, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 791, 768, 790, 792, 793, 789, 794, 795, 778,
+	728, 0, 787, 786, 788, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 131, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 762,
+	0, 154, 155, 757, 0, 0, 0, 308, 309, 310,
+	294, 0, 0, 242, 0, 0, 0, 1471, 0, 0,
+	0, 726, 0, 0, 0, 187, 0, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 773, 780,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 719, 0, 0, 669, 764, 763, 737, 746, 0,
+	0, 169, 738, 0, 745, 739, 743, 742, 740, 741,
+	0, 706, 0, 0, 0, 0, 0, 0, 0, 723,
+	0, 727, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 0, 0, 0,
+	0, 0, 720, 721, 0, 0, 0, 0, 758, 0,
+	722, 0, 0, 760, 0, 747, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 744, 756, 712, 181, 710, 755,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 785, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 753, 0, 0, 300, 0, 0, 771, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 713,
+	0, 261, 244, 783, 0, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 1472, 1473, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 772, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 769, 240,
+	782, 765, 766, 767, 770, 774, 775, 708, 711, 777,
+	779, 781, 784, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	709, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	759, 230, 231, 232, 233, 707, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 791, 768, 790, 792, 793, 789, 794, 795, 778,
+	728, 0, 787, 786, 788, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 131, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 762,
+	0, 154, 155, 757, 0, 0, 0, 308, 309, 310,
+	294, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 726, 0, 0, 0, 187, 0, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 773, 780,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 669, 764, 763, 737, 746, 0,
+	0, 169, 738, 0, 745, 739, 743, 742, 740, 741,
+	0, 706, 0, 0, 0, 0, 0, 0, 666, 723,
+	0, 727, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 0, 0, 0,
+	0, 0, 720, 721, 0, 0, 0, 0, 758, 0,
+	722, 0, 0, 760, 0, 747, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 744, 756, 712, 181, 710, 755,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 785, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 753, 0, 0, 300, 0, 0, 771, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 713,
+	0, 261, 244, 783, 667, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 772, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 769, 240,
+	782, 765, 766, 767, 770, 774, 775, 708, 711, 777,
+	779, 781, 784, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	709, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	759, 230, 231, 232, 233, 707, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 791, 768, 790, 792, 793, 789, 794, 795, 778,
+	728, 0, 787, 786, 788, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 131, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 762,
+	0, 154, 155, 757, 0, 0, 0, 308, 309, 310,
+	294, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 726, 0, 0, 0, 187, 0, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 773, 780,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 719, 0, 0, 669, 764, 763, 737, 746, 0,
+	0, 169, 738, 0, 745, 739, 743, 742, 740, 741,
+	0, 706, 0, 0, 0, 0, 0, 0, 0, 723,
+	0, 727, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 0, 0, 0,
+	0, 0, 720, 721, 0, 0, 0, 0, 758, 0,
+	722, 0, 0, 760, 0, 747, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 744, 756, 712, 181, 710, 755,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 785, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 753, 0, 0, 300, 0, 0, 771, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 713,
+	0, 261, 244, 783, 0, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 772, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 769, 240,
+	782, 765, 766, 767, 770, 774, 775, 708, 711, 777,
+	779, 781, 784, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	709, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	759, 230, 231, 232, 233, 707, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 791, 768, 790, 792, 793, 789, 794, 795, 778,
+	728, 0, 787, 786, 788, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 131, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 762,
+	0, 154, 155, 0, 0, 0, 0, 308, 309, 310,
+	294, 107, 0, 31, 97, 78, 0, 0, 0, 0,
+	0, 0, 0, 242, 335, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 187, 0, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 340, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 0, 0, 315, 181, 306, 0,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 0, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 339,
+	0, 153, 0, 0, 0, 300, 0, 0, 0, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 316,
+	0, 261, 244, 0, 0, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 0, 240,
+	0, 0, 0, 0, 0, 0, 0, 236, 311, 0,
+	0, 0, 0, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	0, 230, 231, 232, 233, 336, 338, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	79, 254, 192, 116, 117, 118, 119, 120, 121, 122,
 	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
-	133, 134, 135, 136, 137, 138, 139, 140, 141, 229,
-	0, 0, 295, 296, 297, 281, 920, 0, 0, 0,
-	0, 174, 0, 0, 199, 0, 0, 0, 258, 213,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 103,
-	0, 0, 921, 0, 0, 0, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 0, 916,
-	917, 918, 915, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 148, 263, 277, 157, 254, 290, 162,
-	261, 153, 228, 250, 0, 0, 150, 275, 260, 210,
-	193, 194, 149, 0, 245, 172, 185, 169, 226, 0,
-	0, 302, 168, 293, 0, 285, 152, 0, 284, 225,
-	272, 276, 211, 205, 151, 274, 209, 204, 197, 176,
-	0, 189, 237, 203, 238, 190, 215, 214, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	0, 916, 917, 918, 915, 0, 262, 0, 0, 198,
-	0, 0, 0, 303, 0, 248, 231, 0, 0, 0,
-	246, 201, 273, 239, 278, 264, 286, 242, 240, 144,
-	265, 171, 212, 154, 155, 167, 173, 175, 177, 178,
-	221, 222, 234, 253, 266, 267, 268, 170, 163, 247,
-	164, 187, 165, 145, 255, 166, 146, 235, 271, 0,
-	183, 243, 208, 147, 207, 236, 270, 269, 294, 300,
-	301, 305, 0, 306, 0, 1362, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 299, 181,
-	142, 282, 0, 227, 0, 0, 0, 0, 0, 0,
-	0, 223, 298, 0, 0, 0, 0, 251, 0, 0,
-	0, 0, 0, 192, 233, 0, 252, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	280, 292, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 283, 0, 0, 0, 291, 0,
-	0, 0, 0, 0, 0, 217, 218, 219, 220, 184,
-	0, 161, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 304, 180, 186, 0, 188, 160, 232, 182,
-	289, 195, 0, 224, 191, 256, 196, 202, 244, 288,
-	230, 249, 158, 279, 257, 206, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1358, 0, 1355, 0, 0, 0,
-	1357, 1354, 1356, 1360, 1361, 0, 0, 0, 1359, 0,
-	0, 0, 143, 0, 200, 0, 241, 179, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
-	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
-	138, 139, 140, 141, 229, 0, 0, 295, 296, 297,
-	281, 0, 0, 0, 0, 0, 174, 429, 0, 199,
-	0, 0, 0, 258, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 103, 437, 438, 0, 0, 0,
-	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 442, 1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350,
-	1351, 1352, 1353, 1365, 1366, 1367, 1368, 1369, 1370, 1363,
-	1364, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 148, 263,
-	277, 157, 254, 290, 162, 261, 153, 228, 250, 0,
-	0, 150, 275, 260, 210, 193, 194, 149, 0, 245,
-	172, 185, 169, 226, 0, 0, 302, 168, 293, 410,
-	285, 152, 409, 284, 225, 272, 276, 211, 205, 151,
-	274, 209, 204, 197, 176, 0, 189, 237, 203, 238,
-	190, 215, 214, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 0, 0, 0, 0, 0,
-	0, 262, 0, 0, 198, 0, 0, 0, 303, 0,
-	248, 231, 0, 0, 0, 246, 201, 273, 239, 278,
-	264, 286, 428, 240, 144, 265, 171, 212, 154, 155,
-	167, 173, 175, 177, 178, 221, 222, 234, 253, 266,
-	267, 268, 170, 163, 247, 164, 187, 165, 145, 255,
-	166, 146, 235, 271, 0, 183, 243, 208, 147, 207,
-	236, 270, 269, 294, 300, 301, 305, 0, 306, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 299, 181, 142, 282, 0, 227, 0,
-	0, 0, 0, 0, 0, 0, 223, 298, 0, 0,
-	0, 0, 251, 0, 0, 0, 0, 0, 192, 233,
-	0, 252, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 280, 292, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 283,
-	0, 0, 0, 291, 0, 0, 0, 0, 0, 431,
-	217, 218, 219, 220, 184, 0, 161, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 304, 180, 186,
-	0, 188, 160, 232, 182, 289, 195, 0, 439, 434,
-	435, 196, 202, 244, 288, 230, 249, 158, 279, 257,
-	436, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 143, 0, 200,
-	0, 241, 179, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
-	133, 134, 135, 136, 137, 138, 139, 140, 141, 97,
-	0, 0, 295, 296, 297, 281, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 174, 0, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 94, 1008,
-	0, 103, 0, 0, 0, 0, 0, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 0, 0, 302, 168, 293, 0, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 0, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 303, 0, 248, 231, 0,
-	0, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 0, 227, 0, 0, 0, 0,
-	0, 0, 0, 223, 298, 0, 0, 0, 0, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 0, 217, 218, 219,
-	220, 184, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 70, 241, 179,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 138, 139, 140, 141, 229, 0, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 0, 174, 0,
-	0, 199, 0, 0, 0, 258, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 103, 437, 438, 0,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 442, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 0, 0, 302, 168,
-	293, 410, 285, 152, 409, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 0, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 242, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 0, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	439, 434, 435, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 436, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
-	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
-	141, 229, 0, 0, 295, 296, 297, 281, 0, 0,
-	0, 0, 0, 174, 607, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 103, 0, 0, 605, 0, 0, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	603, 0, 0, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 0, 0, 302, 168, 293, 0, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 0, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 303, 0, 248, 231, 0,
-	0, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 0, 227, 0, 0, 0, 0,
-	0, 0, 0, 223, 298, 0, 0, 0, 0, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 0, 217, 218, 219,
-	220, 184, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 138, 139, 140, 141, 229, 0, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 0, 174, 601,
-	0, 199, 0, 0, 0, 258, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 103, 0, 0, 605,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 603, 0, 0, 0, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 0, 0, 302, 168,
-	293, 0, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 0, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 242, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 0, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	224, 191, 256, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
-	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
-	141, 229, 0, 0, 295, 296, 297, 281, 0, 0,
-	0, 0, 0, 174, 0, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2238,
-	0, 103, 725, 0, 0, 0, 0, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 0, 0, 302, 168, 293, 0, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 0, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 303, 0, 248, 231, 0,
-	0, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 0, 227, 0, 0, 0, 0,
-	0, 0, 0, 223, 298, 0, 0, 0, 0, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 0, 217, 218, 219,
-	220, 184, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 138, 139, 140, 141, 229, 0, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 0, 174, 0,
-	0, 199, 0, 0, 0, 258, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 103, 0, 0, 605,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 603, 0, 0, 0, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 0, 0, 302, 168,
-	293, 0, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 0, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 242, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 0, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	224, 191, 256, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	133, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 147, 148, 149, 150, 151, 242,
+	0, 154, 155, 0, 0, 0, 0, 308, 309, 310,
+	294, 187, 0, 0, 212, 0, 0, 0, 271, 226,
+	0, 0, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	113, 0, 0, 0, 0, 0, 0, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 1366, 1369,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 276, 290, 170, 267, 303,
+	175, 274, 166, 241, 263, 0, 0, 163, 288, 273,
+	223, 206, 207, 162, 0, 258, 185, 198, 182, 239,
+	0, 0, 315, 181, 306, 0, 298, 165, 0, 297,
+	238, 285, 289, 224, 218, 164, 287, 222, 217, 210,
+	189, 0, 202, 250, 216, 251, 203, 228, 227, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	1370, 300, 0, 0, 0, 1363, 0, 1362, 275, 1364,
+	1367, 211, 0, 0, 0, 316, 0, 261, 244, 0,
+	0, 0, 259, 214, 286, 252, 291, 277, 299, 255,
+	253, 157, 278, 184, 225, 167, 168, 180, 186, 188,
+	190, 191, 234, 235, 247, 266, 279, 280, 281, 183,
+	176, 260, 177, 200, 178, 158, 268, 179, 159, 248,
+	284, 1368, 196, 256, 221, 160, 220, 249, 283, 282,
+	307, 313, 314, 318, 0, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 320, 321, 322,
+	323, 324, 325, 326, 327, 328, 0, 0, 0, 0,
+	312, 194, 152, 295, 0, 240, 0, 0, 0, 0,
+	0, 0, 0, 236, 311, 0, 0, 0, 0, 264,
+	0, 0, 0, 0, 0, 205, 246, 0, 265, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 272, 293, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 296, 0, 0, 0,
+	304, 0, 0, 0, 0, 0, 0, 230, 231, 232,
+	233, 197, 0, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 193, 0, 201, 173, 245,
+	195, 302, 208, 0, 237, 204, 269, 209, 215, 257,
+	301, 243, 262, 171, 292, 270, 219, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 213, 0, 254, 192, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+	147, 148, 149, 150, 151, 242, 0, 154, 155, 0,
+	0, 0, 1002, 308, 309, 310, 294, 187, 0, 0,
+	212, 0, 0, 0, 271, 226, 0, 0, 0, 0,
+	0, 0, 199, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 113, 0, 0, 1003,
+	0, 0, 0, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 0, 998, 999, 1000, 997,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	161, 276, 290, 170, 267, 303, 175, 274, 166, 241,
+	263, 0, 0, 163, 288, 273, 223, 206, 207, 162,
+	0, 258, 185, 198, 182, 239, 0, 0, 315, 181,
+	306, 0, 298, 165, 0, 297, 238, 285, 289, 224,
+	218, 164, 287, 222, 217, 210, 189, 0, 202, 250,
+	216, 251, 203, 228, 227, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 300, 0, 998,
+	999, 1000, 997, 0, 275, 0, 0, 211, 0, 0,
+	0, 316, 0, 261, 244, 0, 0, 0, 259, 214,
+	286, 252, 291, 277, 299, 255, 253, 157, 278, 184,
+	225, 167, 168, 180, 186, 188, 190, 191, 234, 235,
+	247, 266, 279, 280, 281, 183, 176, 260, 177, 200,
+	178, 158, 268, 179, 159, 248, 284, 0, 196, 256,
+	221, 160, 220, 249, 283, 282, 307, 313, 314, 318,
+	0, 319, 0, 1510, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 320, 321, 322, 323, 324, 325, 326,
+	327, 328, 0, 0, 0, 0, 312, 194, 152, 295,
+	0, 240, 0, 0, 0, 0, 0, 0, 0, 236,
+	311, 0, 0, 0, 0, 264, 0, 0, 0, 0,
+	0, 205, 246, 0, 265, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 272, 293, 305,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 0, 0, 0, 304, 0, 0, 0,
+	0, 0, 0, 230, 231, 232, 233, 197, 0, 174,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	317, 193, 0, 201, 173, 245, 195, 302, 208, 0,
+	237, 204, 269, 209, 215, 257, 301, 243, 262, 171,
+	292, 270, 219, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1506, 0, 1503, 0, 0, 0, 1505,
+	1502, 1504, 1508, 1509, 0, 0, 0, 1507, 0, 156,
+	0, 213, 0, 254, 192, 116, 117, 118, 119, 120,
 	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
 	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
-	141, 229, 0, 0, 295, 296, 297, 281, 0, 0,
-	0, 0, 0, 174, 0, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 103, 0, 0, 605, 0, 0, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1841, 0, 0, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 0, 0, 302, 168, 293, 0, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 0, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 303, 0, 248, 231, 0,
-	0, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 0, 227, 0, 0, 0, 0,
-	0, 0, 0, 223, 298, 0, 0, 0, 0, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 0, 217, 218, 219,
-	220, 184, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 138, 139, 140, 141, 229, 0, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 0, 174, 1299,
-	0, 199, 0, 0, 0, 258, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 103, 0, 0, 605,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 0, 0, 302, 168,
-	293, 0, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 0, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 242, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 0, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	224, 191, 256, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	141, 142, 143, 144, 145, 146, 147, 148, 149, 150,
+	151, 242, 0, 154, 155, 0, 0, 0, 0, 308,
+	309, 310, 294, 187, 458, 0, 212, 0, 0, 0,
+	271, 226, 0, 0, 0, 0, 0, 0, 199, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 466, 467, 0, 0, 0, 0, 169,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 471,
+	1491, 1492, 1493, 1494, 1495, 1496, 1497, 1498, 1499, 1500,
+	1501, 1513, 1514, 1515, 1516, 1517, 1518, 1511, 1512, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 276, 290, 170,
+	267, 303, 175, 274, 166, 241, 263, 0, 0, 163,
+	288, 273, 223, 206, 207, 162, 0, 258, 185, 198,
+	182, 239, 0, 0, 315, 181, 306, 437, 298, 165,
+	436, 297, 238, 285, 289, 224, 218, 164, 287, 222,
+	217, 210, 189, 0, 202, 250, 216, 251, 203, 228,
+	227, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 153,
+	0, 0, 0, 300, 0, 0, 0, 0, 0, 0,
+	275, 0, 0, 211, 0, 0, 0, 316, 0, 261,
+	244, 0, 0, 0, 259, 214, 286, 252, 291, 277,
+	299, 457, 253, 157, 278, 184, 225, 167, 168, 180,
+	186, 188, 190, 191, 234, 235, 247, 266, 279, 280,
+	281, 183, 176, 260, 177, 200, 178, 158, 268, 179,
+	159, 248, 284, 0, 196, 256, 221, 160, 220, 249,
+	283, 282, 307, 313, 314, 318, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 320,
+	321, 322, 323, 324, 325, 326, 327, 328, 0, 0,
+	0, 0, 312, 194, 152, 295, 0, 240, 0, 0,
+	0, 0, 0, 0, 0, 236, 311, 0, 0, 0,
+	0, 264, 0, 0, 0, 0, 0, 205, 246, 0,
+	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 272, 293, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 296, 0,
+	0, 0, 304, 0, 0, 0, 0, 0, 460, 230,
+	231, 232, 233, 197, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 317, 193, 0, 201,
+	173, 245, 195, 302, 208, 0, 468, 463, 464, 209,
+	215, 257, 301, 243, 262, 171, 292, 270, 465, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 213, 0, 254,
+	192, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 143, 144,
+	145, 146, 147, 148, 149, 150, 151, 107, 0, 154,
+	155, 0, 0, 0, 0, 308, 309, 310, 294, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 187, 0, 0, 212, 0, 0, 0, 271, 226,
+	0, 0, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 104, 1096, 0,
+	113, 0, 0, 0, 0, 0, 0, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 276, 290, 170, 267, 303,
+	175, 274, 166, 241, 263, 0, 0, 163, 288, 273,
+	223, 206, 207, 162, 0, 258, 185, 198, 182, 239,
+	0, 0, 315, 181, 306, 0, 298, 165, 0, 297,
+	238, 285, 289, 224, 218, 164, 287, 222, 217, 210,
+	189, 0, 202, 250, 216, 251, 203, 228, 227, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 300, 0, 0, 0, 0, 0, 0, 275, 0,
+	0, 211, 0, 0, 0, 316, 0, 261, 244, 0,
+	0, 0, 259, 214, 286, 252, 291, 277, 299, 255,
+	253, 157, 278, 184, 225, 167, 168, 180, 186, 188,
+	190, 191, 234, 235, 247, 266, 279, 280, 281, 183,
+	176, 260, 177, 200, 178, 158, 268, 179, 159, 248,
+	284, 0, 196, 256, 221, 160, 220, 249, 283, 282,
+	307, 313, 314, 318, 0, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 320, 321, 322,
+	323, 324, 325, 326, 327, 328, 0, 0, 0, 0,
+	312, 194, 152, 295, 0, 240, 0, 0, 0, 0,
+	0, 0, 0, 236, 311, 0, 0, 0, 0, 264,
+	0, 0, 0, 0, 0, 205, 246, 0, 265, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 272, 293, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 296, 0, 0, 0,
+	304, 0, 0, 0, 0, 0, 0, 230, 231, 232,
+	233, 197, 0, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 193, 0, 201, 173, 245,
+	195, 302, 208, 0, 237, 204, 269, 209, 215, 257,
+	301, 243, 262, 171, 292, 270, 219, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 213, 79, 254, 192, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+	147, 148, 149, 150, 151, 242, 0, 154, 155, 0,
+	0, 0, 0, 308, 309, 310, 294, 187, 0, 0,
+	212, 0, 0, 0, 271, 226, 0, 0, 0, 0,
+	0, 0, 199, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 113, 466, 467, 0,
+	0, 0, 0, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 471, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	161, 276, 290, 170, 267, 303, 175, 274, 166, 241,
+	263, 0, 0, 163, 288, 273, 223, 206, 207, 162,
+	0, 258, 185, 198, 182, 239, 0, 0, 315, 181,
+	306, 437, 298, 165, 436, 297, 238, 285, 289, 224,
+	218, 164, 287, 222, 217, 210, 189, 0, 202, 250,
+	216, 251, 203, 228, 227, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 300, 0, 0,
+	0, 0, 0, 0, 275, 0, 0, 211, 0, 0,
+	0, 316, 0, 261, 244, 0, 0, 0, 259, 214,
+	286, 252, 291, 277, 299, 255, 253, 157, 278, 184,
+	225, 167, 168, 180, 186, 188, 190, 191, 234, 235,
+	247, 266, 279, 280, 281, 183, 176, 260, 177, 200,
+	178, 158, 268, 179, 159, 248, 284, 0, 196, 256,
+	221, 160, 220, 249, 283, 282, 307, 313, 314, 318,
+	0, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 320, 321, 322, 323, 324, 325, 326,
+	327, 328, 0, 0, 0, 0, 312, 194, 152, 295,
+	0, 240, 0, 0, 0, 0, 0, 0, 0, 236,
+	311, 0, 0, 0, 0, 264, 0, 0, 0, 0,
+	0, 205, 246, 0, 265, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 272, 293, 305,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 0, 0, 0, 304, 0, 0, 0,
+	0, 0, 0, 230, 231, 232, 233, 197, 0, 174,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	317, 193, 0, 201, 173, 245, 195, 302, 208, 0,
+	468, 463, 464, 209, 215, 257, 301, 243, 262, 171,
+	292, 270, 465, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 213, 0, 254, 192, 116, 117, 118, 119, 120,
 	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
 	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
-	141, 229, 0, 0, 295, 296, 297, 281, 0, 0,
-	0, 0, 0, 174, 0, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 103, 725, 0, 0, 0, 0, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 0, 0, 302, 168, 293, 0, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 0, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 303, 0, 248, 231, 0,
-	0, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 0, 227, 0, 0, 0, 0,
-	0, 0, 0, 223, 298, 0, 0, 0, 0, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 0, 217, 218, 219,
-	220, 184, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 138, 139, 140, 141, 229, 0, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 0, 174, 0,
-	0, 199, 0, 0, 0, 258, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1990, 0, 0, 103, 0, 0, 0,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 0, 0, 302, 168,
-	293, 0, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 0, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 242, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 0, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	224, 191, 256, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	141, 142, 143, 144, 145, 146, 147, 148, 149, 150,
+	151, 242, 0, 154, 155, 0, 0, 0, 1377, 308,
+	309, 310, 294, 187, 0, 0, 212, 0, 0, 0,
+	271, 226, 0, 0, 0, 0, 0, 0, 199, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 0, 0, 0, 0, 169,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 276, 290, 170,
+	267, 303, 175, 274, 166, 241, 263, 0, 0, 163,
+	288, 273, 223, 206, 207, 162, 0, 258, 185, 198,
+	182, 239, 0, 0, 315, 181, 306, 0, 298, 165,
+	0, 297, 238, 285, 289, 224, 218, 164, 287, 222,
+	217, 210, 189, 0, 202, 250, 216, 251, 203, 228,
+	227, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 153,
+	0, 0, 0, 300, 1376, 0, 0, 0, 1375, 0,
+	275, 0, 0, 211, 0, 0, 0, 316, 0, 261,
+	244, 0, 0, 0, 259, 214, 286, 252, 291, 277,
+	299, 255, 253, 157, 278, 184, 225, 167, 168, 180,
+	186, 188, 190, 191, 234, 235, 247, 266, 279, 280,
+	281, 183, 176, 260, 177, 200, 178, 158, 268, 179,
+	159, 248, 284, 0, 196, 256, 221, 160, 220, 249,
+	283, 282, 307, 313, 314, 318, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 320,
+	321, 322, 323, 324, 325, 326, 327, 328, 0, 0,
+	0, 0, 312, 194, 152, 295, 0, 240, 0, 0,
+	0, 0, 0, 0, 0, 236, 311, 0, 0, 0,
+	0, 264, 0, 0, 0, 0, 0, 205, 246, 0,
+	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 272, 293, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 296, 0,
+	0, 0, 304, 0, 0, 0, 0, 0, 0, 230,
+	231, 232, 233, 197, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 317, 193, 0, 201,
+	173, 245, 195, 302, 208, 0, 237, 204, 269, 209,
+	215, 257, 301, 243, 262, 171, 292, 270, 219, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 213, 0, 254,
+	192, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 143, 144,
+	145, 146, 147, 148, 149, 150, 151, 242, 0, 154,
+	155, 0, 0, 0, 0, 308, 309, 310, 294, 187,
+	644, 0, 212, 0, 0, 0, 271, 226, 0, 0,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
+	0, 642, 0, 0, 0, 169, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 640, 0, 0,
+	0, 0, 161, 276, 290, 170, 267, 303, 175, 274,
+	166, 241, 263, 0, 0, 163, 288, 273, 223, 206,
+	207, 162, 0, 258, 185, 198, 182, 239, 0, 0,
+	315, 181, 306, 0, 298, 165, 0, 297, 238, 285,
+	289, 224, 218, 164, 287, 222, 217, 210, 189, 0,
+	202, 250, 216, 251, 203, 228, 227, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 153, 0, 0, 0, 300,
+	0, 0, 0, 0, 0, 0, 275, 0, 0, 211,
+	0, 0, 0, 316, 0, 261, 244, 0, 0, 0,
+	259, 214, 286, 252, 291, 277, 299, 255, 253, 157,
+	278, 184, 225, 167, 168, 180, 186, 188, 190, 191,
+	234, 235, 247, 266, 279, 280, 281, 183, 176, 260,
+	177, 200, 178, 158, 268, 179, 159, 248, 284, 0,
+	196, 256, 221, 160, 220, 249, 283, 282, 307, 313,
+	314, 318, 0, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 320, 321, 322, 323, 324,
+	325, 326, 327, 328, 0, 0, 0, 0, 312, 194,
+	152, 295, 0, 240, 0, 0, 0, 0, 0, 0,
+	0, 236, 311, 0, 0, 0, 0, 264, 0, 0,
+	0, 0, 0, 205, 246, 0, 265, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 272,
+	293, 305, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 0, 0, 304, 0,
+	0, 0, 0, 0, 0, 230, 231, 232, 233, 197,
+	0, 174, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 317, 193, 0, 201, 173, 245, 195, 302,
+	208, 0, 237, 204, 269, 209, 215, 257, 301, 243,
+	262, 171, 292, 270, 219, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 156, 0, 213, 0, 254, 192, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	149, 150, 151, 242, 0, 154, 155, 0, 0, 0,
+	0, 308, 309, 310, 294, 187, 638, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 642, 0, 0,
+	0, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 640, 0, 0, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 0, 0, 315, 181, 306, 0,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 0, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 0, 0, 0, 300, 0, 0, 0, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 316,
+	0, 261, 244, 0, 0, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 0, 240,
+	0, 0, 0, 0, 0, 0, 0, 236, 311, 0,
+	0, 0, 0, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	0, 230, 231, 232, 233, 197, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 147, 148, 149, 150, 151, 242,
+	0, 154, 155, 0, 0, 0, 0, 308, 309, 310,
+	294, 187, 0, 0, 212, 0, 0, 0, 271, 226,
+	0, 0, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2440, 0,
+	113, 764, 0, 0, 0, 0, 0, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 276, 290, 170, 267, 303,
+	175, 274, 166, 241, 263, 0, 0, 163, 288, 273,
+	223, 206, 207, 162, 0, 258, 185, 198, 182, 239,
+	0, 0, 315, 181, 306, 0, 298, 165, 0, 297,
+	238, 285, 289, 224, 218, 164, 287, 222, 217, 210,
+	189, 0, 202, 250, 216, 251, 203, 228, 227, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 300, 0, 0, 0, 0, 0, 0, 275, 0,
+	0, 211, 0, 0, 0, 316, 0, 261, 244, 0,
+	0, 0, 259, 214, 286, 252, 291, 277, 299, 255,
+	253, 157, 278, 184, 225, 167, 168, 180, 186, 188,
+	190, 191, 234, 235, 247, 266, 279, 280, 281, 183,
+	176, 260, 177, 200, 178, 158, 268, 179, 159, 248,
+	284, 0, 196, 256, 221, 160, 220, 249, 283, 282,
+	307, 313, 314, 318, 0, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 320, 321, 322,
+	323, 324, 325, 326, 327, 328, 0, 0, 0, 0,
+	312, 194, 152, 295, 0, 240, 0, 0, 0, 0,
+	0, 0, 0, 236, 311, 0, 0, 0, 0, 264,
+	0, 0, 0, 0, 0, 205, 246, 0, 265, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 272, 293, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 296, 0, 0, 0,
+	304, 0, 0, 0, 0, 0, 0, 230, 231, 232,
+	233, 197, 0, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 193, 0, 201, 173, 245,
+	195, 302, 208, 0, 237, 204, 269, 209, 215, 257,
+	301, 243, 262, 171, 292, 270, 219, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 213, 0, 254, 192, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+	147, 148, 149, 150, 151, 242, 0, 154, 155, 0,
+	0, 0, 0, 308, 309, 310, 294, 187, 0, 0,
+	212, 0, 0, 0, 271, 226, 0, 0, 0, 0,
+	0, 0, 199, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 113, 0, 0, 642,
+	0, 0, 0, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2078, 0, 0, 0, 0,
+	161, 276, 290, 170, 267, 303, 175, 274, 166, 241,
+	263, 0, 0, 163, 288, 273, 223, 206, 207, 162,
+	0, 258, 185, 198, 182, 239, 0, 0, 315, 181,
+	306, 0, 298, 165, 0, 297, 238, 285, 289, 224,
+	218, 164, 287, 222, 217, 210, 189, 0, 202, 250,
+	216, 251, 203, 228, 227, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 300, 0, 0,
+	0, 0, 0, 0, 275, 0, 0, 211, 0, 0,
+	0, 316, 0, 261, 244, 0, 0, 0, 259, 214,
+	286, 252, 291, 277, 299, 255, 253, 157, 278, 184,
+	225, 167, 168, 180, 186, 188, 190, 191, 234, 235,
+	247, 266, 279, 280, 281, 183, 176, 260, 177, 200,
+	178, 158, 268, 179, 159, 248, 284, 0, 196, 256,
+	221, 160, 220, 249, 283, 282, 307, 313, 314, 318,
+	0, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 320, 321, 322, 323, 324, 325, 326,
+	327, 328, 0, 0, 0, 0, 312, 194, 152, 295,
+	0, 240, 0, 0, 0, 0, 0, 0, 0, 236,
+	311, 0, 0, 0, 0, 264, 0, 0, 0, 0,
+	0, 205, 246, 0, 265, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 272, 293, 305,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 0, 0, 0, 304, 0, 0, 0,
+	0, 0, 0, 230, 231, 232, 233, 197, 0, 174,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	317, 193, 0, 201, 173, 245, 195, 302, 208, 0,
+	237, 204, 269, 209, 215, 257, 301, 243, 262, 171,
+	292, 270, 219, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 213, 0, 254, 192, 116, 117, 118, 119, 120,
 	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
 	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
-	141, 229, 0, 0, 295, 296, 297, 281, 0, 0,
-	0, 0, 0, 174, 0, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 103, 0, 0, 0, 0, 0, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1677, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 0, 0, 302, 168, 293, 0, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 0, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 303, 0, 248, 231, 0,
-	0, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 0, 227, 0, 0, 0, 0,
-	0, 0, 0, 223, 298, 0, 0, 0, 0, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 0, 217, 218, 219,
-	220, 184, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 138, 139, 140, 141, 229, 0, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 0, 174, 0,
-	0, 199, 0, 0, 0, 258, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 870, 0, 0, 103, 0, 0, 0,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 0, 0, 302, 168,
-	293, 0, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 0, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 242, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 0, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	224, 191, 256, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	141, 142, 143, 144, 145, 146, 147, 148, 149, 150,
+	151, 242, 0, 154, 155, 0, 0, 0, 0, 308,
+	309, 310, 294, 187, 0, 0, 212, 0, 0, 0,
+	271, 226, 0, 0, 0, 0, 0, 0, 199, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 951,
+	0, 0, 113, 0, 0, 0, 0, 0, 0, 169,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 276, 290, 170,
+	267, 303, 175, 274, 166, 241, 263, 0, 0, 163,
+	288, 273, 223, 206, 207, 162, 0, 258, 185, 198,
+	182, 239, 0, 0, 315, 181, 306, 0, 298, 165,
+	0, 297, 238, 285, 289, 224, 218, 164, 287, 222,
+	217, 210, 189, 0, 202, 250, 216, 251, 203, 228,
+	227, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 153,
+	0, 0, 0, 300, 0, 0, 0, 0, 0, 0,
+	275, 0, 0, 211, 0, 0, 0, 316, 0, 261,
+	244, 0, 0, 0, 259, 214, 286, 252, 291, 277,
+	299, 255, 253, 157, 278, 184, 225, 167, 168, 180,
+	186, 188, 190, 191, 234, 235, 247, 266, 279, 280,
+	281, 183, 176, 260, 177, 200, 178, 158, 268, 179,
+	159, 248, 284, 0, 196, 256, 221, 160, 220, 249,
+	283, 282, 307, 313, 314, 318, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 320,
+	321, 322, 323, 324, 325, 326, 327, 328, 0, 0,
+	0, 0, 312, 194, 152, 295, 0, 240, 0, 0,
+	0, 0, 0, 0, 0, 236, 311, 0, 0, 0,
+	0, 264, 0, 0, 0, 0, 0, 205, 246, 0,
+	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 272, 293, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 296, 0,
+	0, 0, 304, 0, 0, 0, 0, 0, 0, 230,
+	231, 232, 233, 197, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 317, 193, 0, 201,
+	173, 245, 195, 302, 208, 0, 237, 204, 269, 209,
+	215, 257, 301, 243, 262, 171, 292, 270, 219, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 213, 0, 254,
+	192, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 143, 144,
+	145, 146, 147, 148, 149, 150, 151, 242, 949, 154,
+	155, 0, 0, 0, 0, 308, 309, 310, 294, 187,
+	0, 0, 212, 0, 0, 0, 271, 226, 0, 0,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
+	0, 642, 0, 0, 0, 169, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 640, 0, 0,
+	0, 0, 161, 276, 290, 170, 267, 303, 175, 274,
+	166, 241, 263, 0, 0, 163, 288, 273, 223, 206,
+	207, 162, 0, 258, 185, 198, 182, 239, 0, 0,
+	315, 181, 306, 0, 298, 165, 0, 297, 238, 285,
+	289, 224, 218, 164, 287, 222, 217, 210, 189, 0,
+	202, 250, 216, 251, 203, 228, 227, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 153, 0, 0, 0, 300,
+	0, 0, 0, 0, 0, 0, 275, 0, 0, 211,
+	0, 0, 0, 316, 0, 261, 244, 0, 0, 0,
+	259, 214, 286, 252, 291, 277, 299, 255, 253, 157,
+	278, 184, 225, 167, 168, 180, 186, 188, 190, 191,
+	234, 235, 247, 266, 279, 280, 281, 183, 176, 260,
+	177, 200, 178, 158, 268, 179, 159, 248, 284, 0,
+	196, 256, 221, 160, 220, 249, 283, 282, 307, 313,
+	314, 318, 0, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 320, 321, 322, 323, 324,
+	325, 326, 327, 328, 0, 0, 0, 0, 312, 194,
+	152, 295, 0, 240, 0, 0, 0, 0, 0, 0,
+	0, 236, 311, 0, 0, 0, 0, 264, 0, 0,
+	0, 0, 0, 205, 246, 0, 265, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 272,
+	293, 305, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 0, 0, 304, 0,
+	0, 0, 0, 0, 0, 230, 231, 232, 233, 197,
+	0, 174, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 317, 193, 0, 201, 173, 245, 195, 302,
+	208, 0, 237, 204, 269, 209, 215, 257, 301, 243,
+	262, 171, 292, 270, 219, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 156, 0, 213, 0, 254, 192, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	149, 150, 151, 242, 0, 154, 155, 0, 0, 0,
+	0, 308, 309, 310, 294, 187, 1446, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 642, 0, 0,
+	0, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 0, 0, 315, 181, 306, 0,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 0, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 0, 0, 0, 300, 0, 0, 0, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 316,
+	0, 261, 244, 0, 0, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 0, 240,
+	0, 0, 0, 0, 0, 0, 0, 236, 311, 0,
+	0, 0, 0, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	0, 230, 231, 232, 233, 197, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 147, 148, 149, 150, 151, 242,
+	0, 154, 155, 0, 0, 0, 0, 308, 309, 310,
+	294, 187, 0, 0, 212, 0, 0, 0, 271, 226,
+	0, 0, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	113, 764, 0, 0, 0, 0, 0, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 276, 290, 170, 267, 303,
+	175, 274, 166, 241, 263, 0, 0, 163, 288, 273,
+	223, 206, 207, 162, 0, 258, 185, 198, 182, 239,
+	0, 0, 315, 181, 306, 0, 298, 165, 0, 297,
+	238, 285, 289, 224, 218, 164, 287, 222, 217, 210,
+	189, 0, 202, 250, 216, 251, 203, 228, 227, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 300, 0, 0, 0, 0, 0, 0, 275, 0,
+	0, 211, 0, 0, 0, 316, 0, 261, 244, 0,
+	0, 0, 259, 214, 286, 252, 291, 277, 299, 255,
+	253, 157, 278, 184, 225, 167, 168, 180, 186, 188,
+	190, 191, 234, 235, 247, 266, 279, 280, 281, 183,
+	176, 260, 177, 200, 178, 158, 268, 179, 159, 248,
+	284, 0, 196, 256, 221, 160, 220, 249, 283, 282,
+	307, 313, 314, 318, 0, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 320, 321, 322,
+	323, 324, 325, 326, 327, 328, 0, 0, 0, 0,
+	312, 194, 152, 295, 0, 240, 0, 0, 0, 0,
+	0, 0, 0, 236, 311, 0, 0, 0, 0, 264,
+	0, 0, 0, 0, 0, 205, 246, 0, 265, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 272, 293, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 296, 0, 0, 0,
+	304, 0, 0, 0, 0, 0, 0, 230, 231, 232,
+	233, 197, 0, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 193, 0, 201, 173, 245,
+	195, 302, 208, 0, 237, 204, 269, 209, 215, 257,
+	301, 243, 262, 171, 292, 270, 219, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 213, 0, 254, 192, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+	147, 148, 149, 150, 151, 242, 0, 154, 155, 0,
+	0, 0, 0, 308, 309, 310, 294, 187, 0, 0,
+	212, 0, 0, 0, 271, 226, 0, 0, 0, 0,
+	0, 0, 199, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2218, 0, 0, 113, 0, 0, 0,
+	0, 0, 0, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	161, 276, 290, 170, 267, 303, 175, 274, 166, 241,
+	263, 0, 0, 163, 288, 273, 223, 206, 207, 162,
+	0, 258, 185, 198, 182, 239, 0, 0, 315, 181,
+	306, 0, 298, 165, 0, 297, 238, 285, 289, 224,
+	218, 164, 287, 222, 217, 210, 189, 0, 202, 250,
+	216, 251, 203, 228, 227, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 300, 0, 0,
+	0, 0, 0, 0, 275, 0, 0, 211, 0, 0,
+	0, 316, 0, 261, 244, 0, 0, 0, 259, 214,
+	286, 252, 291, 277, 299, 255, 253, 157, 278, 184,
+	225, 167, 168, 180, 186, 188, 190, 191, 234, 235,
+	247, 266, 279, 280, 281, 183, 176, 260, 177, 200,
+	178, 158, 268, 179, 159, 248, 284, 0, 196, 256,
+	221, 160, 220, 249, 283, 282, 307, 313, 314, 318,
+	0, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 320, 321, 322, 323, 324, 325, 326,
+	327, 328, 0, 0, 0, 0, 312, 194, 152, 295,
+	0, 240, 0, 0, 0, 0, 0, 0, 0, 236,
+	311, 0, 0, 0, 0, 264, 0, 0, 0, 0,
+	0, 205, 246, 0, 265, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 272, 293, 305,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 0, 0, 0, 304, 0, 0, 0,
+	0, 0, 0, 230, 231, 232, 233, 197, 0, 174,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	317, 193, 0, 201, 173, 245, 195, 302, 208, 0,
+	237, 204, 269, 209, 215, 257, 301, 243, 262, 171,
+	292, 270, 219, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 213, 0, 254, 192, 116, 117, 118, 119, 120,
 	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
 	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
-	141, 229, 0, 0, 295, 296, 297, 281, 0, 0,
-	0, 0, 0, 174, 0, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 103, 0, 0, 605, 0, 0, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 0, 0, 302, 168, 293, 0, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 0, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 303, 0, 248, 231, 0,
-	0, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 0, 227, 0, 0, 0, 0,
-	0, 0, 0, 223, 298, 0, 0, 0, 0, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 0, 217, 218, 219,
-	220, 184, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 138, 139, 140, 141, 229, 0, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 0, 174, 0,
-	0, 199, 0, 0, 0, 258, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 103, 0, 0, 0,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1703, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 0, 0, 302, 168,
-	293, 0, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 0, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 242, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 0, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	224, 191, 256, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	141, 142, 143, 144, 145, 146, 147, 148, 149, 150,
+	151, 242, 0, 154, 155, 0, 0, 0, 0, 308,
+	309, 310, 294, 187, 0, 0, 212, 0, 0, 0,
+	271, 226, 0, 0, 0, 0, 0, 0, 199, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 0, 0, 0, 0, 169,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1885, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 276, 290, 170,
+	267, 303, 175, 274, 166, 241, 263, 0, 0, 163,
+	288, 273, 223, 206, 207, 162, 0, 258, 185, 198,
+	182, 239, 0, 0, 315, 181, 306, 0, 298, 165,
+	0, 297, 238, 285, 289, 224, 218, 164, 287, 222,
+	217, 210, 189, 0, 202, 250, 216, 251, 203, 228,
+	227, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 153,
+	0, 0, 0, 300, 0, 0, 0, 0, 0, 0,
+	275, 0, 0, 211, 0, 0, 0, 316, 0, 261,
+	244, 0, 0, 0, 259, 214, 286, 252, 291, 277,
+	299, 255, 253, 157, 278, 184, 225, 167, 168, 180,
+	186, 188, 190, 191, 234, 235, 247, 266, 279, 280,
+	281, 183, 176, 260, 177, 200, 178, 158, 268, 179,
+	159, 248, 284, 0, 196, 256, 221, 160, 220, 249,
+	283, 282, 307, 313, 314, 318, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 320,
+	321, 322, 323, 324, 325, 326, 327, 328, 0, 0,
+	0, 0, 312, 194, 152, 295, 0, 240, 0, 0,
+	0, 0, 0, 0, 0, 236, 311, 0, 0, 0,
+	0, 264, 0, 0, 0, 0, 0, 205, 246, 0,
+	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 272, 293, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 296, 0,
+	0, 0, 304, 0, 0, 0, 0, 0, 0, 230,
+	231, 232, 233, 197, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 317, 193, 0, 201,
+	173, 245, 195, 302, 208, 0, 237, 204, 269, 209,
+	215, 257, 301, 243, 262, 171, 292, 270, 219, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 213, 0, 254,
+	192, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 143, 144,
+	145, 146, 147, 148, 149, 150, 151, 242, 0, 154,
+	155, 0, 0, 0, 0, 308, 309, 310, 294, 187,
+	0, 0, 212, 0, 0, 0, 271, 226, 0, 0,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
+	0, 642, 0, 0, 0, 169, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 161, 276, 290, 170, 267, 303, 175, 274,
+	166, 241, 263, 0, 0, 163, 288, 273, 223, 206,
+	207, 162, 0, 258, 185, 198, 182, 239, 0, 0,
+	315, 181, 306, 0, 298, 165, 0, 297, 238, 285,
+	289, 224, 218, 164, 287, 222, 217, 210, 189, 0,
+	202, 250, 216, 251, 203, 228, 227, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 153, 0, 0, 0, 300,
+	0, 0, 0, 0, 0, 0, 275, 0, 0, 211,
+	0, 0, 0, 316, 0, 261, 244, 0, 0, 0,
+	259, 214, 286, 252, 291, 277, 299, 255, 253, 157,
+	278, 184, 225, 167, 168, 180, 186, 188, 190, 191,
+	234, 235, 247, 266, 279, 280, 281, 183, 176, 260,
+	177, 200, 178, 158, 268, 179, 159, 248, 284, 0,
+	196, 256, 221, 160, 220, 249, 283, 282, 307, 313,
+	314, 318, 0, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 320, 321, 322, 323, 324,
+	325, 326, 327, 328, 0, 0, 0, 0, 312, 194,
+	152, 295, 0, 240, 0, 0, 0, 0, 0, 0,
+	0, 236, 311, 0, 0, 0, 0, 264, 0, 0,
+	0, 0, 0, 205, 246, 0, 265, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 272,
+	293, 305, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 0, 0, 0, 304, 0,
+	0, 0, 0, 0, 0, 230, 231, 232, 233, 197,
+	0, 174, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 317, 193, 0, 201, 173, 245, 195, 302,
+	208, 0, 237, 204, 269, 209, 215, 257, 301, 243,
+	262, 171, 292, 270, 219, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 156, 0, 213, 0, 254, 192, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	149, 150, 151, 242, 0, 154, 155, 0, 0, 0,
+	0, 308, 309, 310, 294, 187, 0, 0, 212, 0,
+	0, 0, 271, 226, 0, 0, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1992, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 161, 276,
+	290, 170, 267, 303, 175, 274, 166, 241, 263, 0,
+	0, 163, 288, 273, 223, 206, 207, 162, 0, 258,
+	185, 198, 182, 239, 0, 0, 315, 181, 306, 0,
+	298, 165, 0, 297, 238, 285, 289, 224, 218, 164,
+	287, 222, 217, 210, 189, 0, 202, 250, 216, 251,
+	203, 228, 227, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 0, 0, 0, 300, 0, 0, 0, 0,
+	0, 0, 275, 0, 0, 211, 0, 0, 0, 316,
+	0, 261, 244, 0, 0, 0, 259, 214, 286, 252,
+	291, 277, 299, 255, 253, 157, 278, 184, 225, 167,
+	168, 180, 186, 188, 190, 191, 234, 235, 247, 266,
+	279, 280, 281, 183, 176, 260, 177, 200, 178, 158,
+	268, 179, 159, 248, 284, 0, 196, 256, 221, 160,
+	220, 249, 283, 282, 307, 313, 314, 318, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 320, 321, 322, 323, 324, 325, 326, 327, 328,
+	0, 0, 0, 0, 312, 194, 152, 295, 0, 240,
+	0, 0, 0, 0, 0, 0, 0, 236, 311, 0,
+	0, 0, 0, 264, 0, 0, 0, 0, 0, 205,
+	246, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 293, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	0, 230, 231, 232, 233, 197, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 193,
+	0, 201, 173, 245, 195, 302, 208, 0, 237, 204,
+	269, 209, 215, 257, 301, 243, 262, 171, 292, 270,
+	219, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 213,
+	0, 254, 192, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 147, 148, 149, 150, 151, 242,
+	0, 154, 155, 0, 0, 0, 0, 308, 309, 310,
+	294, 187, 0, 0, 212, 0, 0, 0, 271, 226,
+	0, 0, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	113, 0, 0, 1842, 0, 0, 0, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 276, 290, 170, 267, 303,
+	175, 274, 166, 241, 263, 0, 0, 163, 288, 273,
+	223, 206, 207, 162, 0, 258, 185, 198, 182, 239,
+	0, 0, 315, 181, 306, 0, 298, 165, 0, 297,
+	238, 285, 289, 224, 218, 164, 287, 222, 217, 210,
+	189, 0, 202, 250, 216, 251, 203, 228, 227, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 300, 0, 0, 0, 0, 0, 0, 275, 0,
+	0, 211, 0, 0, 0, 316, 0, 261, 244, 0,
+	0, 0, 259, 214, 286, 252, 291, 277, 299, 255,
+	253, 157, 278, 184, 225, 167, 168, 180, 186, 188,
+	190, 191, 234, 235, 247, 266, 279, 280, 281, 183,
+	176, 260, 177, 200, 178, 158, 268, 179, 159, 248,
+	284, 0, 196, 256, 221, 160, 220, 249, 283, 282,
+	307, 313, 314, 318, 0, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 320, 321, 322,
+	323, 324, 325, 326, 327, 328, 0, 0, 0, 0,
+	312, 194, 152, 295, 0, 240, 0, 0, 0, 0,
+	0, 0, 0, 236, 311, 0, 0, 0, 0, 264,
+	0, 0, 0, 0, 0, 205, 246, 0, 265, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 272, 293, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 296, 0, 0, 0,
+	304, 0, 0, 0, 0, 0, 0, 230, 231, 232,
+	233, 197, 0, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 193, 0, 201, 173, 245,
+	195, 302, 208, 0, 237, 204, 269, 209, 215, 257,
+	301, 243, 262, 171, 292, 270, 219, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 213, 0, 254, 192, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+	147, 148, 149, 150, 151, 242, 0, 154, 155, 0,
+	0, 0, 0, 308, 309, 310, 294, 187, 0, 0,
+	212, 0, 0, 0, 271, 226, 0, 0, 0, 0,
+	0, 0, 199, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 113, 0, 0, 0,
+	0, 0, 0, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1462, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	161, 276, 290, 170, 267, 303, 175, 274, 166, 241,
+	263, 0, 0, 163, 288, 273, 223, 206, 207, 162,
+	0, 258, 185, 198, 182, 239, 0, 0, 315, 181,
+	306, 0, 298, 165, 0, 297, 238, 285, 289, 224,
+	218, 164, 287, 222, 217, 210, 189, 0, 202, 250,
+	216, 251, 203, 228, 227, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 300, 0, 0,
+	0, 0, 0, 0, 275, 0, 0, 211, 0, 0,
+	0, 316, 0, 261, 244, 0, 0, 0, 259, 214,
+	286, 252, 291, 277, 299, 255, 253, 157, 278, 184,
+	225, 167, 168, 180, 186, 188, 190, 191, 234, 235,
+	247, 266, 279, 280, 281, 183, 176, 260, 177, 200,
+	178, 158, 268, 179, 159, 248, 284, 0, 196, 256,
+	221, 160, 220, 249, 283, 282, 307, 313, 314, 318,
+	0, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 320, 321, 322, 323, 324, 325, 326,
+	327, 328, 0, 0, 0, 0, 312, 194, 152, 295,
+	0, 240, 0, 0, 0, 0, 0, 0, 0, 236,
+	311, 0, 0, 0, 0, 264, 0, 0, 0, 0,
+	0, 205, 246, 0, 265, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 272, 293, 305,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 0, 0, 0, 304, 0, 0, 0,
+	0, 0, 0, 230, 231, 232, 233, 197, 0, 174,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	317, 193, 0, 201, 173, 245, 195, 302, 208, 0,
+	237, 204, 269, 209, 215, 257, 301, 243, 262, 171,
+	292, 270, 219, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 213, 0, 254, 192, 116, 117, 118, 119, 120,
 	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
 	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
-	141, 229, 0, 0, 295, 296, 297, 281, 0, 0,
-	0, 0, 0, 174, 0, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 103, 0, 0, 0, 0, 0, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 0, 0, 302, 168, 293, 0, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 0, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 303, 0, 248, 231, 0,
-	0, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 0, 227, 0, 0, 0, 0,
-	0, 0, 0, 223, 298, 0, 0, 0, 0, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 0, 217, 218, 219,
-	220, 184, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	141, 142, 143, 144, 145, 146, 147, 148, 149, 150,
+	151, 242, 0, 154, 155, 0, 0, 0, 0, 308,
+	309, 310, 294, 187, 0, 0, 212, 0, 0, 0,
+	271, 226, 0, 0, 0, 0, 0, 0, 199, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 0, 1460, 0, 0, 0, 169,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 276, 290, 170,
+	267, 303, 175, 274, 166, 241, 263, 0, 0, 163,
+	288, 273, 223, 206, 207, 162, 0, 258, 185, 198,
+	182, 239, 0, 0, 315, 181, 306, 0, 298, 165,
+	0, 297, 238, 285, 289, 224, 218, 164, 287, 222,
+	217, 210, 189, 0, 202, 250, 216, 251, 203, 228,
+	227, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 153,
+	0, 0, 0, 300, 0, 0, 0, 0, 0, 0,
+	275, 0, 0, 211, 0, 0, 0, 316, 0, 261,
+	244, 0, 0, 0, 259, 214, 286, 252, 291, 277,
+	299, 255, 253, 157, 278, 184, 225, 167, 168, 180,
+	186, 188, 190, 191, 234, 235, 247, 266, 279, 280,
+	281, 183, 176, 260, 177, 200, 178, 158, 268, 179,
+	159, 248, 284, 0, 196, 256, 221, 160, 220, 249,
+	283, 282, 307, 313, 314, 318, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 320,
+	321, 322, 323, 324, 325, 326, 327, 328, 0, 0,
+	0, 0, 312, 194, 152, 295, 0, 240, 0, 0,
+	0, 0, 0, 0, 0, 236, 311, 0, 0, 0,
+	0, 264, 0, 0, 0, 0, 0, 205, 246, 0,
+	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 272, 293, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 296, 0,
+	0, 0, 304, 0, 0, 0, 0, 0, 0, 230,
+	231, 232, 233, 197, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 317, 193, 0, 201,
+	173, 245, 195, 302, 208, 0, 237, 204, 269, 209,
+	215, 257, 301, 243, 262, 171, 292, 270, 219, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 213, 0, 254,
+	192, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 143, 144,
+	145, 146, 147, 148, 149, 150, 151, 1336, 0, 154,
+	155, 0, 0, 0, 242, 308, 309, 310, 294, 0,
+	0, 0, 0, 0, 0, 0, 187, 0, 0, 212,
+	0, 0, 0, 271, 226, 0, 0, 0, 0, 0,
+	0, 199, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 113, 0, 0, 0, 0,
+	0, 0, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 172, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
+	276, 290, 170, 267, 303, 175, 274, 166, 241, 263,
+	0, 0, 163, 288, 273, 223, 206, 207, 162, 0,
+	258, 185, 198, 182, 239, 0, 0, 315, 181, 306,
+	0, 298, 165, 0, 297, 238, 285, 289, 224, 218,
+	164, 287, 222, 217, 210, 189, 0, 202, 250, 216,
+	251, 203, 228, 227, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 153, 0, 0, 0, 300, 0, 0, 0,
+	0, 0, 0, 275, 0, 0, 211, 0, 0, 0,
+	316, 0, 261, 244, 0, 0, 0, 259, 214, 286,
+	252, 291, 277, 299, 255, 253, 157, 278, 184, 225,
+	167, 168, 180, 186, 188, 190, 191, 234, 235, 247,
+	266, 279, 280, 281, 183, 176, 260, 177, 200, 178,
+	158, 268, 179, 159, 248, 284, 0, 196, 256, 221,
+	160, 220, 249, 283, 282, 307, 313, 314, 318, 0,
+	319, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 320, 321, 322, 323, 324, 325, 326, 327,
+	328, 0, 0, 0, 0, 312, 194, 152, 295, 0,
+	240, 0, 0, 0, 0, 0, 0, 0, 236, 311,
+	0, 0, 0, 0, 264, 0, 0, 0, 0, 0,
+	205, 246, 0, 265, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 272, 293, 305, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 296, 0, 0, 0, 304, 0, 0, 0, 0,
+	0, 0, 230, 231, 232, 233, 197, 0, 174, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
+	193, 0, 201, 173, 245, 195, 302, 208, 0, 237,
+	204, 269, 209, 215, 257, 301, 243, 262, 171, 292,
+	270, 219, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 156, 0,
+	213, 0, 254, 192, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	242, 0, 154, 155, 0, 0, 0, 0, 308, 309,
+	310, 294, 187, 0, 0, 212, 0, 0, 0, 271,
+	226, 0, 0, 0, 0, 0, 0, 199, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 113, 0, 0, 0, 0, 0, 0, 169, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 161, 276, 290, 170, 267,
+	303, 175, 274, 166, 241, 263, 0, 0, 163, 288,
+	273, 223, 206, 207, 162, 0, 258, 185, 198, 182,
+	239, 0, 0, 315, 181, 306, 0, 298, 165, 0,
+	297, 238, 285, 289, 224, 218, 164, 287, 222, 217,
+	210, 189, 0, 202, 250, 216, 251, 203, 228, 227,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 153, 0,
+	0, 0, 300, 0, 0, 1321, 0, 0, 0, 275,
+	0, 0, 211, 0, 0, 0, 316, 0, 261, 244,
+	0, 0, 0, 259, 214, 286, 252, 291, 277, 299,
+	255, 253, 157, 278, 184, 225, 167, 168, 180, 186,
+	188, 190, 191, 234, 235, 247, 266, 279, 280, 281,
+	183, 176, 260, 177, 200, 178, 158, 268, 179, 159,
+	248, 284, 0, 196, 256, 221, 160, 220, 249, 283,
+	282, 307, 313, 314, 318, 0, 319, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 320, 321,
+	322, 323, 324, 325, 326, 327, 328, 0, 0, 0,
+	0, 312, 194, 152, 295, 0, 240, 0, 0, 0,
+	0, 0, 0, 0, 236, 311, 0, 0, 0, 0,
+	264, 0, 0, 0, 0, 0, 205, 246, 0, 265,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 272, 293, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 0,
+	0, 304, 0, 0, 0, 0, 0, 0, 230, 231,
+	232, 233, 197, 0, 174, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 317, 193, 0, 201, 173,
+	245, 195, 302, 208, 0, 237, 204, 269, 209, 215,
+	257, 301, 243, 262, 171, 292, 270, 219, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 156, 0, 213, 0, 254, 192,
 	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
 	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 138, 139, 140, 141, 229, 0, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 0, 174, 0,
-	0, 199, 0, 0, 0, 258, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 103, 0, 0, 1312,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 0, 0, 302, 168,
-	293, 0, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 0, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 242, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 0, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	224, 191, 256, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	136, 137, 138, 139, 140, 141, 142, 143, 144, 145,
+	146, 147, 148, 149, 150, 151, 242, 0, 154, 155,
+	0, 0, 0, 0, 308, 309, 310, 294, 187, 0,
+	0, 212, 0, 0, 0, 271, 226, 0, 0, 0,
+	0, 0, 0, 199, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 113, 0, 0,
+	0, 0, 0, 0, 169, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 161, 276, 290, 170, 267, 303, 175, 274, 166,
+	241, 263, 0, 0, 163, 288, 273, 223, 206, 207,
+	162, 0, 258, 185, 198, 182, 239, 0, 0, 315,
+	181, 306, 0, 298, 165, 0, 297, 238, 285, 289,
+	224, 218, 164, 287, 222, 217, 210, 189, 0, 202,
+	250, 216, 251, 203, 228, 227, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 153, 0, 0, 0, 300, 0,
+	0, 0, 0, 0, 0, 275, 0, 0, 211, 0,
+	0, 0, 316, 0, 261, 244, 0, 0, 0, 259,
+	214, 286, 252, 291, 277, 299, 255, 253, 157, 278,
+	184, 225, 167, 168, 180, 186, 188, 190, 191, 234,
+	235, 247, 266, 279, 280, 281, 183, 176, 260, 177,
+	200, 178, 158, 268, 179, 159, 248, 284, 0, 196,
+	256, 221, 160, 220, 249, 283, 282, 307, 313, 314,
+	318, 0, 319, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 320, 321, 322, 323, 324, 325,
+	326, 327, 328, 0, 0, 0, 0, 312, 194, 152,
+	295, 0, 240, 0, 0, 0, 0, 0, 0, 0,
+	236, 311, 0, 0, 0, 0, 264, 0, 0, 0,
+	0, 0, 205, 246, 0, 265, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 272, 293,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 296, 0, 0, 0, 304, 0, 0,
+	0, 0, 0, 0, 230, 231, 232, 233, 197, 0,
+	174, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 317, 193, 0, 201, 173, 245, 195, 302, 208,
+	0, 237, 204, 269, 209, 215, 257, 301, 243, 262,
+	171, 292, 270, 219, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 419, 0, 0,
+	156, 0, 213, 0, 254, 192, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 131, 132, 133, 134, 135, 136, 137, 138, 139,
+	140, 141, 142, 143, 144, 145, 146, 147, 148, 149,
+	150, 151, 242, 0, 154, 155, 0, 0, 0, 0,
+	308, 309, 310, 294, 187, 0, 0, 212, 0, 0,
+	0, 271, 226, 0, 0, 0, 0, 0, 0, 199,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 113, 0, 0, 0, 0, 0, 0,
+	169, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 276, 290,
+	170, 267, 303, 175, 274, 166, 241, 263, 0, 0,
+	163, 288, 273, 223, 206, 207, 162, 0, 258, 185,
+	198, 182, 239, 0, 0, 315, 181, 306, 0, 298,
+	165, 0, 297, 238, 285, 289, 224, 218, 164, 287,
+	222, 217, 210, 189, 0, 202, 250, 216, 251, 203,
+	228, 227, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	153, 0, 0, 0, 300, 0, 0, 0, 0, 0,
+	0, 275, 0, 0, 211, 0, 0, 0, 316, 0,
+	261, 244, 0, 0, 0, 259, 214, 286, 252, 291,
+	277, 299, 354, 253, 157, 278, 184, 225, 167, 168,
+	180, 186, 188, 190, 191, 234, 235, 247, 266, 279,
+	280, 281, 183, 176, 260, 177, 200, 178, 158, 268,
+	179, 159, 248, 284, 0, 196, 256, 221, 160, 220,
+	249, 283, 282, 307, 313, 314, 318, 0, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	320, 321, 322, 323, 324, 325, 326, 327, 328, 0,
+	0, 0, 0, 312, 194, 152, 295, 0, 240, 0,
+	0, 0, 0, 0, 0, 0, 236, 311, 0, 0,
+	0, 0, 264, 0, 0, 0, 0, 0, 205, 246,
+	0, 265, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 272, 293, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 355, 296,
+	0, 0, 0, 304, 0, 0, 0, 0, 0, 0,
+	230, 231, 232, 233, 197, 0, 174, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 317, 193, 0,
+	201, 173, 245, 195, 302, 208, 0, 237, 204, 269,
+	209, 215, 257, 301, 243, 262, 171, 292, 270, 219,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 156, 0, 213, 0,
+	254, 192, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
+	134, 135, 136, 137, 138, 139, 140, 141, 142, 143,
+	144, 145, 146, 147, 148, 149, 150, 151, 0, 242,
+	154, 155, 0, 0, 0, 0, 308, 309, 310, 294,
+	110, 187, 0, 0, 212, 0, 0, 0, 271, 226,
+	0, 0, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	113, 0, 0, 0, 0, 0, 0, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 276, 290, 170, 267, 303,
+	175, 274, 166, 241, 263, 0, 0, 163, 288, 273,
+	223, 206, 207, 162, 0, 258, 185, 198, 182, 239,
+	0, 0, 315, 181, 306, 0, 298, 165, 0, 297,
+	238, 285, 289, 224, 218, 164, 287, 222, 217, 210,
+	189, 0, 202, 250, 216, 251, 203, 228, 227, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 300, 0, 0, 0, 0, 0, 0, 275, 0,
+	0, 211, 0, 0, 0, 316, 0, 261, 244, 0,
+	0, 0, 259, 214, 286, 252, 291, 277, 299, 255,
+	253, 157, 278, 184, 225, 167, 168, 180, 186, 188,
+	190, 191, 234, 235, 247, 266, 279, 280, 281, 183,
+	176, 260, 177, 200, 178, 158, 268, 179, 159, 248,
+	284, 0, 196, 256, 221, 160, 220, 249, 283, 282,
+	307, 313, 314, 318, 0, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 320, 321, 322,
+	323, 324, 325, 326, 327, 328, 0, 0, 0, 0,
+	312, 194, 152, 295, 0, 240, 0, 0, 0, 0,
+	0, 0, 0, 236, 311, 0, 0, 0, 0, 264,
+	0, 0, 0, 0, 0, 205, 246, 0, 265, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 272, 293, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 296, 0, 0, 0,
+	304, 0, 0, 0, 0, 0, 0, 230, 231, 232,
+	233, 197, 0, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 193, 0, 201, 173, 245,
+	195, 302, 208, 0, 237, 204, 269, 209, 215, 257,
+	301, 243, 262, 171, 292, 270, 219, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 213, 0, 254, 192, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+	147, 148, 149, 150, 151, 242, 0, 154, 155, 0,
+	0, 0, 0, 308, 309, 310, 294, 187, 0, 0,
+	212, 0, 0, 0, 271, 226, 0, 0, 0, 0,
+	0, 0, 199, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 113, 0, 0, 0,
+	0, 0, 0, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	161, 276, 290, 170, 267, 303, 175, 274, 166, 241,
+	263, 0, 0, 163, 288, 273, 223, 206, 207, 162,
+	0, 258, 185, 198, 182, 239, 0, 0, 315, 181,
+	306, 0, 298, 165, 0, 297, 238, 285, 289, 224,
+	218, 164, 287, 222, 217, 210, 189, 0, 202, 250,
+	216, 251, 203, 228, 227, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 300, 0, 0,
+	0, 0, 0, 0, 275, 0, 0, 211, 0, 0,
+	0, 316, 0, 261, 244, 0, 0, 0, 259, 214,
+	286, 252, 291, 277, 299, 255, 253, 157, 278, 184,
+	225, 167, 168, 180, 186, 188, 190, 191, 234, 235,
+	247, 266, 279, 280, 281, 183, 176, 260, 177, 200,
+	178, 158, 268, 179, 159, 248, 284, 0, 196, 256,
+	221, 160, 220, 249, 283, 282, 307, 313, 314, 318,
+	0, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 320, 321, 322, 323, 324, 325, 326,
+	327, 328, 0, 0, 0, 0, 312, 194, 152, 295,
+	0, 240, 0, 0, 0, 0, 0, 0, 0, 236,
+	311, 0, 0, 0, 0, 264, 0, 0, 0, 0,
+	0, 205, 246, 0, 265, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 272, 293, 305,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 0, 0, 0, 304, 0, 0, 0,
+	0, 0, 0, 230, 231, 232, 233, 197, 0, 174,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	317, 193, 0, 201, 173, 245, 195, 302, 208, 0,
+	237, 204, 269, 209, 215, 257, 301, 243, 262, 171,
+	292, 270, 219, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 213, 0, 254, 192, 116, 117, 118, 119, 120,
 	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
 	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
-	141, 1241, 0, 0, 295, 296, 297, 281, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	174, 0, 0, 199, 0, 0, 0, 258, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 103, 0,
-	0, 0, 0, 0, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 148, 263, 277, 157, 254, 290, 162, 261,
-	153, 228, 250, 0, 0, 150, 275, 260, 210, 193,
-	194, 149, 0, 245, 172, 185, 169, 226, 0, 0,
-	302, 168, 293, 0, 285, 152, 0, 284, 225, 272,
-	276, 211, 205, 151, 274, 209, 204, 197, 176, 0,
-	189, 237, 203, 238, 190, 215, 214, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 0,
-	0, 0, 0, 0, 0, 262, 0, 0, 198, 0,
-	0, 0, 303, 0, 248, 231, 0, 0, 0, 246,
-	201, 273, 239, 278, 264, 286, 242, 240, 144, 265,
-	171, 212, 154, 155, 167, 173, 175, 177, 178, 221,
-	222, 234, 253, 266, 267, 268, 170, 163, 247, 164,
-	187, 165, 145, 255, 166, 146, 235, 271, 0, 183,
-	243, 208, 147, 207, 236, 270, 269, 294, 300, 301,
-	305, 0, 306, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 299, 181, 142,
-	282, 0, 227, 0, 0, 0, 0, 0, 0, 0,
-	223, 298, 0, 0, 0, 0, 251, 0, 0, 0,
-	0, 0, 192, 233, 0, 252, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 259, 280,
-	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 283, 0, 0, 0, 291, 0, 0,
-	0, 0, 0, 0, 217, 218, 219, 220, 184, 0,
-	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 304, 180, 186, 0, 188, 160, 232, 182, 289,
-	195, 0, 224, 191, 256, 196, 202, 244, 288, 230,
-	249, 158, 279, 257, 206, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 143, 0, 200, 0, 241, 179, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
-	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
-	139, 140, 141, 229, 0, 0, 295, 296, 297, 281,
-	0, 0, 0, 0, 0, 174, 0, 0, 199, 0,
-	0, 0, 258, 213, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 103, 0, 0, 0, 0, 0, 0,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 148, 263, 277,
-	157, 254, 290, 162, 261, 153, 228, 250, 0, 0,
-	150, 275, 260, 210, 193, 194, 149, 0, 245, 172,
-	185, 169, 226, 0, 0, 302, 168, 293, 0, 285,
-	152, 0, 284, 225, 272, 276, 211, 205, 151, 274,
-	209, 204, 197, 176, 0, 189, 237, 203, 238, 190,
-	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 0, 0, 1224, 0, 0, 0,
-	262, 0, 0, 198, 0, 0, 0, 303, 0, 248,
-	231, 0, 0, 0, 246, 201, 273, 239, 278, 264,
-	286, 242, 240, 144, 265, 171, 212, 154, 155, 167,
-	173, 175, 177, 178, 221, 222, 234, 253, 266, 267,
-	268, 170, 163, 247, 164, 187, 165, 145, 255, 166,
-	146, 235, 271, 0, 183, 243, 208, 147, 207, 236,
-	270, 269, 294, 300, 301, 305, 0, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 299, 181, 142, 282, 0, 227, 0, 0,
-	0, 0, 0, 0, 0, 223, 298, 0, 0, 0,
-	0, 251, 0, 0, 0, 0, 0, 192, 233, 0,
-	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 280, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 283, 0,
-	0, 0, 291, 0, 0, 0, 0, 0, 0, 217,
-	218, 219, 220, 184, 0, 161, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 304, 180, 186, 0,
-	188, 160, 232, 182, 289, 195, 0, 224, 191, 256,
-	196, 202, 244, 288, 230, 249, 158, 279, 257, 206,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 0, 200, 0,
-	241, 179, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
-	134, 135, 136, 137, 138, 139, 140, 141, 229, 0,
-	0, 295, 296, 297, 281, 0, 0, 0, 0, 0,
-	174, 0, 0, 199, 0, 0, 0, 258, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 103, 0,
-	0, 0, 0, 0, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 148, 263, 277, 157, 254, 290, 162, 261,
-	153, 228, 250, 0, 0, 150, 275, 260, 210, 193,
-	194, 149, 0, 245, 172, 185, 169, 226, 0, 0,
-	302, 168, 293, 0, 285, 152, 0, 284, 225, 272,
-	276, 211, 205, 151, 274, 209, 204, 197, 176, 0,
-	189, 237, 203, 238, 190, 215, 214, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 0,
-	0, 0, 0, 0, 0, 262, 0, 0, 198, 0,
-	0, 0, 303, 0, 248, 231, 0, 0, 0, 246,
-	201, 273, 239, 278, 264, 286, 242, 240, 144, 265,
-	171, 212, 154, 155, 167, 173, 175, 177, 178, 221,
-	222, 234, 253, 266, 267, 268, 170, 163, 247, 164,
-	187, 165, 145, 255, 166, 146, 235, 271, 0, 183,
-	243, 208, 147, 207, 236, 270, 269, 294, 300, 301,
-	305, 0, 306, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 299, 181, 142,
-	282, 0, 227, 0, 0, 0, 0, 0, 0, 0,
-	223, 298, 0, 0, 0, 0, 251, 0, 0, 0,
-	0, 0, 192, 233, 0, 252, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 259, 280,
-	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 283, 0, 0, 0, 291, 0, 0,
-	0, 0, 0, 0, 217, 218, 219, 220, 184, 0,
-	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 304, 180, 186, 0, 188, 160, 232, 182, 289,
-	195, 0, 224, 191, 256, 196, 202, 244, 288, 230,
-	249, 158, 279, 257, 206, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 393, 0,
-	0, 143, 0, 200, 0, 241, 179, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
-	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
-	139, 140, 141, 229, 0, 0, 295, 296, 297, 281,
-	0, 0, 0, 0, 0, 174, 0, 0, 199, 0,
-	0, 0, 258, 213, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 103, 0, 0, 0, 0, 0, 0,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 148, 263, 277,
-	157, 254, 290, 162, 261, 153, 228, 250, 0, 0,
-	150, 275, 260, 210, 193, 194, 149, 0, 245, 172,
-	185, 169, 226, 0, 0, 302, 168, 293, 0, 285,
-	152, 0, 284, 225, 272, 276, 211, 205, 151, 274,
-	209, 204, 197, 176, 0, 189, 237, 203, 238, 190,
-	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 0, 0, 0, 0, 0, 0,
-	262, 0, 0, 198, 0, 0, 0, 303, 0, 248,
-	231, 0, 0, 0, 246, 201, 273, 239, 278, 264,
-	286, 330, 240, 144, 265, 171, 212, 154, 155, 167,
-	173, 175, 177, 178, 221, 222, 234, 253, 266, 267,
-	268, 170, 163, 247, 164, 187, 165, 145, 255, 166,
-	146, 235, 271, 0, 183, 243, 208, 147, 207, 236,
-	270, 269, 294, 300, 301, 305, 0, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 299, 181, 142, 282, 0, 227, 0, 0,
-	0, 0, 0, 0, 0, 223, 298, 0, 0, 0,
-	0, 251, 0, 0, 0, 0, 0, 192, 233, 0,
-	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 280, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 331, 283, 0,
-	0, 0, 291, 0, 0, 0, 0, 0, 0, 217,
-	218, 219, 220, 184, 0, 161, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 304, 180, 186, 0,
-	188, 160, 232, 182, 289, 195, 0, 224, 191, 256,
-	196, 202, 244, 288, 230, 249, 158, 279, 257, 206,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 0, 200, 0,
-	241, 179, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
-	134, 135, 136, 137, 138, 139, 140, 141, 229, 0,
-	0, 295, 296, 297, 281, 0, 0, 0, 0, 100,
-	174, 0, 0, 199, 0, 0, 0, 258, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 103, 0,
-	0, 0, 0, 0, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 148, 263, 277, 157, 254, 290, 162, 261,
-	153, 228, 250, 0, 0, 150, 275, 260, 210, 193,
-	194, 149, 0, 245, 172, 185, 169, 226, 0, 0,
-	302, 168, 293, 0, 285, 152, 0, 284, 225, 272,
-	276, 211, 205, 151, 274, 209, 204, 197, 176, 0,
-	189, 237, 203, 238, 190, 215, 214, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 0,
-	0, 0, 0, 0, 0, 262, 0, 0, 198, 0,
-	0, 0, 303, 0, 248, 231, 0, 0, 0, 246,
-	201, 273, 239, 278, 264, 286, 242, 240, 144, 265,
-	171, 212, 154, 155, 167, 173, 175, 177, 178, 221,
-	222, 234, 253, 266, 267, 268, 170, 163, 247, 164,
-	187, 165, 145, 255, 166, 146, 235, 271, 0, 183,
-	243, 208, 147, 207, 236, 270, 269, 294, 300, 301,
-	305, 0, 306, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 299, 181, 142,
-	282, 0, 227, 0, 0, 0, 0, 0, 0, 0,
-	223, 298, 0, 0, 0, 0, 251, 0, 0, 0,
-	0, 0, 192, 233, 0, 252, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 259, 280,
-	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 283, 0, 0, 0, 291, 0, 0,
-	0, 0, 0, 0, 217, 218, 219, 220, 184, 0,
-	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 304, 180, 186, 0, 188, 160, 232, 182, 289,
-	195, 0, 224, 191, 256, 196, 202, 244, 288, 230,
-	249, 158, 279, 257, 206, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 143, 0, 200, 0, 241, 179, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
-	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
-	139, 140, 141, 229, 0, 0, 295, 296, 297, 281,
-	0, 0, 0, 0, 0, 174, 0, 0, 199, 0,
-	0, 0, 258, 213, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 103, 0, 0, 0, 0, 0, 0,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 148, 263, 277,
-	157, 254, 290, 162, 261, 153, 228, 250, 0, 0,
-	150, 275, 260, 210, 193, 194, 149, 0, 245, 172,
-	185, 169, 226, 0, 0, 302, 168, 293, 0, 285,
-	152, 0, 284, 225, 272, 276, 211, 205, 151, 274,
-	209, 204, 197, 176, 0, 189, 237, 203, 238, 190,
-	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 0, 0, 0, 0, 0, 0,
-	262, 0, 0, 198, 0, 0, 0, 303, 0, 248,
-	231, 0, 0, 0, 246, 201, 273, 239, 278, 264,
-	286, 242, 240, 144, 265, 171, 212, 154, 155, 167,
-	173, 175, 177, 178, 221, 222, 234, 253, 266, 267,
-	268, 170, 163, 247, 164, 187, 165, 145, 255, 166,
-	146, 235, 271, 0, 183, 243, 208, 147, 207, 236,
-	270, 269, 294, 300, 301, 305, 0, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 299, 181, 142, 282, 0, 227, 0, 0,
-	0, 0, 0, 0, 0, 223, 298, 0, 0, 0,
-	0, 251, 0, 0, 0, 0, 0, 192, 233, 0,
-	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 280, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 283, 0,
-	0, 0, 291, 0, 0, 0, 0, 0, 0, 217,
-	218, 219, 220, 184, 0, 161, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 304, 180, 186, 0,
-	188, 160, 232, 182, 289, 195, 0, 224, 191, 256,
-	196, 202, 244, 288, 230, 249, 158, 279, 257, 206,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 0, 200, 0,
-	241, 179, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
-	134, 135, 136, 137, 138, 139, 140, 141, 229, 0,
-	0, 295, 296, 297, 281, 1281, 0, 0, 0, 0,
-	174, 0, 0, 199, 0, 0, 0, 258, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 831, 832,
-	833, 1283, 0, 0, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 148, 263, 277, 157, 254, 290, 162, 261,
-	153, 228, 250, 0, 0, 150, 275, 260, 210, 193,
-	194, 149, 0, 245, 172, 185, 169, 226, 0, 0,
-	302, 168, 293, 0, 285, 152, 0, 284, 225, 272,
-	276, 211, 205, 151, 274, 209, 204, 197, 176, 0,
-	189, 237, 203, 238, 190, 215, 214, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 0,
-	0, 0, 0, 0, 0, 262, 0, 0, 198, 0,
-	0, 0, 303, 0, 248, 231, 0, 0, 0, 246,
-	201, 273, 239, 278, 264, 286, 242, 240, 144, 265,
-	171, 212, 154, 155, 167, 173, 175, 177, 178, 221,
-	222, 234, 253, 266, 267, 268, 170, 163, 247, 164,
-	187, 165, 145, 255, 166, 146, 235, 271, 0, 183,
-	243, 208, 147, 207, 236, 270, 269, 294, 300, 301,
-	305, 0, 306, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 299, 181, 142,
-	282, 0, 227, 0, 0, 0, 0, 0, 0, 0,
-	223, 298, 0, 0, 0, 0, 251, 0, 0, 0,
-	0, 0, 192, 233, 0, 252, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 259, 280,
-	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 283, 0, 0, 0, 291, 0, 0,
-	0, 0, 0, 0, 217, 218, 219, 220, 184, 0,
-	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 304, 180, 186, 0, 188, 160, 232, 182, 289,
-	195, 0, 224, 191, 256, 196, 202, 244, 288, 230,
-	249, 158, 279, 257, 206, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
-	0, 0, 199, 0, 0, 0, 258, 213, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 143, 0, 200, 0, 241, 179, 831, 832, 833,
-	1283, 0, 0, 0, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 295, 296, 297, 281,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 148, 263, 277, 157, 254, 290, 162, 261, 153,
-	228, 250, 0, 0, 150, 275, 260, 210, 193, 194,
-	149, 0, 245, 172, 185, 169, 226, 0, 0, 302,
-	168, 293, 0, 285, 152, 0, 284, 225, 272, 276,
-	211, 205, 151, 274, 209, 204, 197, 176, 0, 189,
-	237, 203, 238, 190, 215, 214, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 0, 0,
-	0, 0, 0, 0, 262, 0, 0, 198, 0, 0,
-	0, 303, 0, 248, 231, 0, 0, 0, 246, 201,
-	273, 239, 278, 264, 286, 242, 240, 144, 265, 171,
-	212, 154, 155, 167, 173, 175, 177, 178, 221, 222,
-	234, 253, 266, 267, 268, 170, 163, 247, 164, 187,
-	165, 145, 255, 166, 146, 235, 271, 0, 183, 243,
-	208, 147, 207, 236, 270, 269, 294, 300, 301, 305,
-	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 299, 181, 142, 282,
-	0, 227, 0, 0, 0, 0, 0, 0, 0, 223,
-	298, 0, 0, 0, 0, 251, 0, 0, 0, 0,
-	0, 192, 233, 0, 252, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 280, 292,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 283, 0, 0, 0, 291, 0, 0, 0,
-	0, 0, 0, 217, 218, 219, 220, 184, 0, 161,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	304, 180, 186, 0, 188, 160, 232, 182, 289, 195,
-	0, 224, 191, 256, 196, 202, 244, 288, 230, 249,
-	158, 279, 257, 206, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 174, 0,
-	0, 199, 0, 0, 0, 258, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	143, 0, 200, 0, 241, 179, 831, 832, 833, 0,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 295, 296, 297, 281, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 0, 0, 302, 168,
-	293, 0, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 0, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 242, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 1732, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 0, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	224, 191, 256, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 206, 0, 0, 0, 0, 0, 0, 1732,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1720, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 1740, 1744, 1746, 1748, 1750,
-	1751, 1753, 1947, 1757, 1754, 1755, 1756, 0, 0, 1735,
-	1736, 1737, 1738, 1718, 1719, 1741, 0, 1721, 0, 1722,
-	1723, 1724, 1725, 1726, 1727, 1728, 1729, 1730, 1731, 1733,
-	1739, 1239, 0, 0, 295, 296, 297, 281, 1743, 1745,
-	1747, 1749, 1752, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1720, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1929, 0, 0, 0, 0,
-	0, 0, 1734, 1740, 1744, 1746, 1748, 1750, 1751, 1753,
-	0, 1757, 1754, 1755, 1756, 0, 0, 1735, 1736, 1737,
-	1738, 1718, 1719, 1741, 0, 1721, 0, 1722, 1723, 1724,
-	1725, 1726, 1727, 1728, 1729, 1730, 1731, 1733, 1739, 0,
-	97, 0, 27, 87, 69, 0, 1743, 1745, 1747, 1749,
-	1752, 2026, 0, 0, 0, 92, 0, 0, 0, 0,
-	0, 0, 81, 0, 0, 0, 0, 0, 350, 0,
-	349, 353, 345, 0, 0, 0, 0, 0, 0, 0,
-	1734, 0, 0, 51, 341, 0, 0, 0, 0, 94,
-	0, 0, 0, 0, 360, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1710, 1711,
-	363, 0, 0, 364, 0, 0, 0, 1933, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1937, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 88, 89, 1926, 90,
-	91, 0, 1928, 1930, 1932, 0, 1934, 1935, 1936, 1938,
-	1939, 1940, 1942, 1943, 1944, 1945, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1948, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1742, 68, 86, 95, 0, 49, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1946,
-	0, 0, 0, 85, 80, 79, 0, 0, 0, 0,
-	0, 343, 342, 346, 0, 0, 1925, 0, 0, 348,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 352, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1941, 0, 344, 0, 0, 0, 0,
-	1931, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1742, 0, 0, 0, 0, 0, 0, 82, 83, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	59, 0, 0, 0, 84, 0, 60, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 347, 351, 354, 0, 355, 356, 0, 0,
-	357, 358, 359, 0, 0, 361, 362, 0, 0, 0,
-	0, 0, 0, 0, 0, 61, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 70,
+	141, 142, 143, 144, 145, 146, 147, 148, 149, 150,
+	151, 242, 0, 154, 155, 0, 0, 0, 1428, 308,
+	309, 310, 294, 187, 0, 0, 212, 0, 0, 0,
+	271, 226, 0, 0, 0, 0, 0, 0, 199, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 877, 878, 879, 1430, 0, 0, 0, 169,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 276, 290, 170,
+	267, 303, 175, 274, 166, 241, 263, 0, 0, 163,
+	288, 273, 223, 206, 207, 162, 0, 258, 185, 198,
+	182, 239, 0, 0, 315, 181, 306, 0, 298, 165,
+	0, 297, 238, 285, 289, 224, 218, 164, 287, 222,
+	217, 210, 189, 0, 202, 250, 216, 251, 203, 228,
+	227, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 153,
+	0, 0, 0, 300, 0, 0, 0, 0, 0, 0,
+	275, 0, 0, 211, 0, 0, 0, 316, 0, 261,
+	244, 0, 0, 0, 259, 214, 286, 252, 291, 277,
+	299, 255, 253, 157, 278, 184, 225, 167, 168, 180,
+	186, 188, 190, 191, 234, 235, 247, 266, 279, 280,
+	281, 183, 176, 260, 177, 200, 178, 158, 268, 179,
+	159, 248, 284, 0, 196, 256, 221, 160, 220, 249,
+	283, 282, 307, 313, 314, 318, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 320,
+	321, 322, 323, 324, 325, 326, 327, 328, 0, 0,
+	0, 0, 312, 194, 152, 295, 0, 240, 0, 0,
+	0, 0, 0, 0, 0, 236, 311, 0, 0, 0,
+	0, 264, 0, 0, 0, 0, 0, 205, 246, 0,
+	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 272, 293, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 296, 0,
+	0, 0, 304, 0, 0, 0, 0, 0, 0, 230,
+	231, 232, 233, 197, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 317, 193, 0, 201,
+	173, 245, 195, 302, 208, 0, 237, 204, 269, 209,
+	215, 257, 301, 243, 262, 171, 292, 270, 219, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 187, 0, 0, 212, 0, 0, 0, 271,
+	226, 0, 0, 0, 0, 0, 0, 199, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 213, 0, 254,
+	192, 877, 878, 879, 1430, 0, 0, 0, 169, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 154,
+	155, 0, 0, 0, 0, 308, 309, 310, 294, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 161, 276, 290, 170, 267,
+	303, 175, 274, 166, 241, 263, 0, 0, 163, 288,
+	273, 223, 206, 207, 162, 0, 258, 185, 198, 182,
+	239, 0, 0, 315, 181, 306, 0, 298, 165, 0,
+	297, 238, 285, 289, 224, 218, 164, 287, 222, 217,
+	210, 189, 0, 202, 250, 216, 251, 203, 228, 227,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 153, 0,
+	0, 0, 300, 0, 0, 0, 0, 0, 0, 275,
+	0, 0, 211, 0, 0, 0, 316, 0, 261, 244,
+	0, 0, 0, 259, 214, 286, 252, 291, 277, 299,
+	255, 253, 157, 278, 184, 225, 167, 168, 180, 186,
+	188, 190, 191, 234, 235, 247, 266, 279, 280, 281,
+	183, 176, 260, 177, 200, 178, 158, 268, 179, 159,
+	248, 284, 0, 196, 256, 221, 160, 220, 249, 283,
+	282, 307, 313, 314, 318, 0, 319, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 320, 321,
+	322, 323, 324, 325, 326, 327, 328, 0, 0, 0,
+	0, 312, 194, 152, 295, 0, 240, 0, 0, 0,
+	0, 0, 0, 0, 236, 311, 0, 0, 0, 0,
+	264, 0, 0, 0, 0, 0, 205, 246, 0, 265,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 272, 293, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 0, 0,
+	0, 304, 0, 0, 0, 0, 0, 0, 230, 231,
+	232, 233, 197, 0, 174, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 317, 193, 0, 201, 173,
+	245, 195, 302, 208, 0, 237, 204, 269, 209, 215,
+	257, 301, 243, 262, 171, 292, 270, 219, 0, 242,
+	0, 0, 0, 0, 0, 0, 1428, 0, 0, 0,
+	0, 187, 0, 0, 212, 0, 0, 0, 271, 226,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 156, 0, 213, 0, 254, 192,
+	877, 878, 879, 1430, 0, 0, 0, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 154, 155,
+	0, 0, 0, 0, 308, 309, 310, 294, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 276, 290, 170, 267, 303,
+	175, 274, 166, 241, 263, 0, 0, 163, 288, 273,
+	223, 206, 207, 162, 0, 258, 185, 198, 182, 239,
+	0, 0, 315, 181, 306, 0, 298, 165, 0, 297,
+	238, 285, 289, 224, 218, 164, 287, 222, 217, 210,
+	189, 0, 202, 250, 216, 251, 203, 228, 227, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 300, 0, 0, 0, 0, 0, 0, 275, 0,
+	0, 211, 0, 0, 0, 316, 0, 261, 244, 0,
+	0, 0, 259, 214, 286, 252, 291, 277, 299, 255,
+	253, 157, 278, 184, 225, 167, 168, 180, 186, 188,
+	190, 191, 234, 235, 247, 266, 279, 280, 281, 183,
+	176, 260, 177, 200, 178, 158, 268, 179, 159, 248,
+	284, 0, 196, 256, 221, 160, 220, 249, 283, 282,
+	307, 313, 314, 318, 0, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 320, 321, 322,
+	323, 324, 325, 326, 327, 328, 0, 0, 0, 0,
+	312, 194, 152, 295, 0, 240, 0, 0, 0, 0,
+	0, 0, 0, 236, 311, 0, 0, 0, 0, 264,
+	0, 0, 0, 0, 0, 205, 246, 0, 265, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 272, 293, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 296, 0, 0, 0,
+	304, 0, 0, 0, 0, 0, 0, 230, 231, 232,
+	233, 197, 0, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 193, 0, 201, 173, 245,
+	195, 302, 208, 0, 237, 204, 269, 209, 215, 257,
+	301, 243, 262, 171, 292, 270, 219, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 0, 0, 212, 0, 0, 0, 271, 226, 0,
+	0, 0, 0, 0, 0, 199, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 213, 0, 254, 192, 877,
+	878, 879, 0, 0, 0, 0, 169, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 172, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 154, 155, 0,
+	0, 0, 0, 308, 309, 310, 294, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 161, 276, 290, 170, 267, 303, 175,
+	274, 166, 241, 263, 0, 0, 163, 288, 273, 223,
+	206, 207, 162, 0, 258, 185, 198, 182, 239, 0,
+	0, 315, 181, 306, 0, 298, 165, 0, 297, 238,
+	285, 289, 224, 218, 164, 287, 222, 217, 210, 189,
+	0, 202, 250, 216, 251, 203, 228, 227, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 0,
+	300, 0, 0, 0, 0, 0, 0, 275, 0, 0,
+	211, 0, 0, 0, 316, 0, 261, 244, 0, 0,
+	0, 259, 214, 286, 252, 291, 277, 299, 255, 253,
+	157, 278, 184, 225, 167, 168, 180, 186, 188, 190,
+	191, 234, 235, 247, 266, 279, 280, 281, 183, 176,
+	260, 177, 200, 178, 158, 268, 179, 159, 248, 284,
+	0, 196, 256, 221, 160, 220, 249, 283, 282, 307,
+	313, 314, 318, 0, 319, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 320, 321, 322, 323,
+	324, 325, 326, 327, 328, 0, 0, 0, 0, 312,
+	194, 152, 295, 0, 240, 0, 0, 0, 0, 0,
+	0, 0, 236, 311, 0, 0, 0, 0, 264, 0,
+	0, 0, 0, 0, 205, 246, 0, 265, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 293, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 0, 0, 0, 304,
+	0, 0, 0, 0, 0, 0, 230, 231, 232, 233,
+	197, 0, 174, 0, 0, 0, 0, 0, 0, 0,
+	0, 1911, 0, 317, 193, 0, 201, 173, 245, 195,
+	302, 208, 0, 237, 204, 269, 209, 215, 257, 301,
+	243, 262, 171, 292, 270, 219, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2435, 0, 1911, 0, 0, 0,
+	0, 0, 156, 0, 213, 0, 254, 192, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1899, 0,
+	935, 0, 0, 0, 0, 0, 154, 155, 0, 0,
+	0, 1911, 308, 309, 310, 294, 1919, 1923, 1925, 1927,
+	1929, 1930, 1932, 0, 1936, 1933, 1934, 1935, 0, 937,
+	1914, 1915, 1916, 1917, 1897, 1898, 1920, 0, 1900, 0,
+	1901, 1902, 1903, 1904, 1905, 1906, 1907, 1908, 1909, 1910,
+	1912, 1918, 0, 1899, 0, 0, 0, 0, 0, 1922,
+	1924, 1926, 1928, 1931, 917, 0, 0, 0, 0, 0,
+	0, 1919, 1923, 1925, 1927, 1929, 1930, 1932, 0, 1936,
+	1933, 1934, 1935, 0, 0, 1914, 1915, 1916, 1917, 1897,
+	1898, 1920, 0, 1900, 1913, 1901, 1902, 1903, 1904, 1905,
+	1906, 1907, 1908, 1909, 1910, 1912, 1918, 0, 1899, 0,
+	0, 0, 0, 0, 1922, 1924, 1926, 1928, 1931, 0,
+	0, 0, 0, 0, 0, 0, 1919, 1923, 1925, 1927,
+	1929, 1930, 1932, 0, 1936, 1933, 1934, 1935, 0, 0,
+	1914, 1915, 1916, 1917, 1897, 1898, 1920, 935, 1900, 1913,
+	1901, 1902, 1903, 1904, 1905, 1906, 1907, 1908, 1909, 1910,
+	1912, 1918, 107, 0, 31, 97, 78, 0, 0, 1922,
+	1924, 1926, 1928, 1931, 0, 0, 937, 102, 0, 0,
+	0, 0, 0, 0, 91, 0, 0, 0, 0, 0,
+	909, 910, 0, 908, 911, 0, 0, 921, 0, 0,
+	0, 2560, 935, 0, 1913, 0, 60, 0, 925, 0,
+	0, 917, 104, 0, 0, 0, 0, 0, 0, 0,
+	1999, 2000, 0, 0, 0, 0, 0, 0, 914, 0,
+	0, 937, 916, 918, 920, 0, 922, 923, 924, 926,
+	927, 928, 930, 931, 932, 933, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 935, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 917, 0, 0, 0,
+	0, 0, 0, 0, 0, 936, 0, 0, 0, 98,
+	99, 0, 100, 101, 0, 937, 374, 0, 373, 377,
+	369, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 365, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 384, 0, 0, 0, 0, 0, 0, 934,
+	917, 0, 2304, 1921, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 913, 0, 0, 387,
+	0, 0, 388, 0, 921, 0, 77, 96, 105, 56,
+	57, 0, 0, 0, 0, 925, 0, 0, 0, 0,
+	0, 0, 0, 929, 0, 0, 0, 95, 90, 89,
+	919, 0, 0, 0, 0, 914, 0, 0, 1921, 916,
+	918, 920, 0, 922, 923, 924, 926, 927, 928, 930,
+	931, 932, 933, 0, 0, 0, 0, 0, 0, 921,
+	0, 0, 0, 374, 0, 373, 377, 369, 0, 0,
+	925, 0, 0, 0, 0, 0, 0, 0, 0, 365,
+	0, 0, 936, 0, 0, 0, 0, 0, 0, 384,
+	914, 92, 93, 1921, 916, 918, 920, 0, 922, 923,
+	924, 926, 927, 928, 930, 931, 932, 933, 0, 58,
+	0, 0, 0, 921, 0, 0, 387, 0, 0, 388,
+	0, 0, 0, 0, 925, 0, 934, 0, 0, 0,
+	367, 366, 370, 0, 68, 0, 0, 936, 94, 372,
+	69, 0, 0, 913, 914, 0, 0, 0, 916, 918,
+	920, 376, 922, 923, 924, 926, 927, 928, 930, 931,
+	932, 933, 0, 0, 0, 368, 0, 0, 0, 360,
+	929, 0, 0, 0, 0, 0, 0, 919, 0, 0,
+	0, 934, 0, 0, 0, 0, 0, 0, 0, 70,
+	0, 936, 0, 0, 0, 0, 0, 0, 913, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 929, 0, 0, 0, 0,
+	0, 0, 919, 0, 0, 934, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 367, 366, 370,
+	0, 0, 913, 0, 0, 0, 372, 0, 0, 0,
+	0, 79, 371, 375, 378, 0, 379, 380, 376, 0,
+	381, 382, 383, 0, 0, 385, 386, 0, 0, 929,
+	0, 0, 368, 0, 0, 0, 919, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 371,
+	375, 378, 0, 379, 380, 0, 0, 381, 382, 383,
+	0, 0, 385, 386,
 }
 
 var yyPact = [...]int{
-	21100, -1000, -300, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	24152, -1000, -306, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 21417, -1000, -1000, 1578, -1000, 9281, 21883, 114, 21883,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 18886, -1000, -1000,
-	1467, -1000, 7947, 19341, 65, 19341, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 279,
-	-1000, 18431, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 248,
-	1745, 163, -1000, 1876, -1000, -1000, -1000, -1000, 140, 301,
-	17976, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 671, 16, 301, 388, 395,
-	444, 444, 9312, 1876, 237, 69, -1000, 546, 21100, 208,
-	19341, -1000, 801, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 340, 332, 87, -1000,
+	20950, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 287, 24276,
+	210, -1000, 2112, -1000, -1000, -1000, -1000, 215, 367, 20484,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1835, 148, 367, 450, 460,
+	777, 777, 10679, 2112, 223, 116, -1000, 983, 24152, 260,
+	21883, -1000, 920, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3342,79 +3694,86 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1876, 1876, 19341, -63, 879, -1000, 196, 239, 234, 798,
-	-1000, -1000, -1000, -1000, 1898, -1000, 19341, 1677, 19341, -1000,
-	1182, 185, 21128, 1784, 701, 318, 1688, -1000, -1000, 1669,
-	-1000, 19, -4, 141, -1000, -1000, 181, -1000, -1000, -1000,
-	-1000, -1000, 70, -1000, 15, -1000, 5, -1000, -1000, -1000,
-	-108, -1000, -1000, -1000, -1000, -187, 1760, 1845, 1502, 1880,
-	1846, 1844, 1838, 1809, 2, 242, 242, 242, 272, 242,
-	277, -1000, -1000, -1000, -1000, -1000, -1000, 356, -1000, -1000,
-	-1000, -1000, 699, 19341, -1000, 1519, 826, 826, 887, 194,
-	-1000, -1000, -121, -136, 826, 826, -136, 12, -1000, 1834,
-	1830, -1000, -1000, -1000, -1000, -1000, -1000, 248, 248, 251,
-	-1000, -195, -1000, -1000, 397, -1000, 366, -1000, 276, 179,
-	705, 916, -1000, 843, 19341, 19341, 19341, 843, 843, 11144,
-	10689, 796, -1000, 1845, 1502, -1000, 1430, 1781, 1502, 248,
-	248, 248, 248, 248, 248, 248, 19341, 5609, 5609, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 235, 1667, -1000,
-	19341, 1845, 1760, 1845, -1000, 794, 1139, 1312, -1000, -1000,
-	196, 802, -1000, 630, -1000, -1000, -1000, -1000, 19341, 226,
-	-1000, 1301, 1664, -1000, 298, 480, 769, -1000, 61, 8035,
-	15239, 1182, 15239, 19341, -1000, -1000, -1000, -1000, -113, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -74,
-	-1000, -1000, -290, 1760, 6075, -1000, -1000, 6075, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 270, 242, -1000, 1332,
-	917, 15239, 1182, 1170, 19341, 242, 251, -1000, 19341, 699,
-	1799, 19341, 1889, 7473, 1889, 19341, -1000, -1000, 826, 826,
-	-1000, 887, 887, -1000, -1000, -133, 1889, 1889, -155, 19341,
-	19341, 242, -1000, -1000, 1332, 15239, 14784, -1000, -177, 384,
-	371, 374, -1000, -1000, 1910, -1000, -1000, 488, 338, 10234,
-	238, 15239, 3739, -1000, -1000, 843, 843, 843, 3739, 3739,
-	757, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 19341, 1760,
-	-1000, -1000, -1000, -1000, -1000, 1332, 15239, 1182, 19341, 19341,
-	19341, 20554, -1000, 753, -1000, -1000, 8857, 793, 6075, -1000,
-	1098, 1662, -1000, -1000, 1653, 1648, 1645, 1642, 1639, 1634,
-	1633, -1000, 1458, -1000, -1000, 1626, 1623, 1620, 1619, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1618, -1000, -1000, -1000, 1616,
-	1458, -1000, -1000, 1613, 1612, 1608, 1607, 1604, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1310, 1308, 2146, -1000, -1000, -1000,
-	-1000, 3273, 7473, 7473, 7473, 7473, -1000, -1000, 1555, 6075,
-	1600, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 7007, -1000,
-	1599, 1596, 1595, 1593, 1592, 1458, 1591, 1307, 1589, 1588,
-	1587, 7473, 1586, 1585, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 753, -1000, -288, -1000, 9779,
-	19341, 19341, -1000, 1760, -1000, 1760, 2370, -1000, 1839, -1000,
-	196, 100, -1000, -1000, -1000, -1000, -1000, -1000, 786, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 692, -1000,
-	19341, -1000, -1000, 61, 15239, 834, -1000, -1000, -1000, -1000,
-	-1000, -1000, 74, -1000, -1000, 122, -1000, 261, -40, 791,
-	-1000, -1000, -1, 118, 1297, -1000, 704, 780, 691, -1000,
-	1214, 17521, 19341, -17, -1000, 1783, 674, -1000, -40, 488,
-	1627, -1000, -1000, -1000, 1629, 19341, 17066, -1000, 1570, 788,
-	-1000, -1000, 6075, -1000, -1000, 1889, 1889, 1889, 826, 20554,
-	887, 19341, 887, -1000, -1000, 887, -1000, 777, -1000, 19341,
-	-17, 674, 1800, 733, -1000, -1000, -1000, -1000, 1798, 19796,
-	237, -1000, -1000, 380, 364, 363, 1182, 257, -1000, -1000,
-	488, -1000, -1000, -1000, 1567, 877, -1000, -1000, 7473, -1000,
-	1233, -1000, 3739, 3739, 3739, -1000, -1000, 12964, -1000, -1000,
-	-1000, 665, 488, 1685, 639, -1000, 639, -1000, -1000, -1000,
-	1889, 5609, -1000, 14784, -1000, 6075, 6075, 6075, 6075, -1000,
-	16604, -1000, 16149, -1000, 290, 6541, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 6075, 1803, 1803, 1803, 6075, 931, 6075,
-	6075, -1000, 990, 8071, 1803, 1803, 1803, 1803, 1803, -1000,
-	2799, 1803, 1803, 1803, 1803, -1000, -1000, 7473, 7473, 7473,
-	7473, 7473, 7473, 7473, 7473, 7473, 7473, 7473, 7473, 1544,
-	891, 7473, 7473, 7473, 1781, 1701, 606, -1000, -1000, -1000,
-	-1000, -1000, 890, 1233, 6075, 8979, 6075, 6075, 6075, -1000,
-	1423, 1419, -1000, -1000, 6075, -1000, 6075, 7473, 6075, -1000,
-	1803, 1295, 1889, 441, -1000, 1566, -1000, 781, 1731, -1000,
-	768, 603, -1000, 873, 778, -1000, -1000, -1000, -1000, 764,
+	-1000, -1000, 2112, 2112, 21883, -64, 1097, -1000, 233, 225,
+	219, 919, -1000, -1000, -1000, -1000, 2118, -1000, 21883, 1851,
+	21883, 21883, 21883, -1000, 1342, 235, 24393, 1961, 828, 376,
+	1882, -1000, -1000, 1848, -1000, 49, 24, 408, -1000, -1000,
+	230, -1000, -1000, -1000, -1000, -1000, 62, -1000, 40, -1000,
+	31, -1000, -1000, -1000, -115, -1000, -1000, -1000, -1000, -168,
+	1949, 2052, 1690, 2086, 2043, 2034, 2032, 2030, 285, 285,
+	30, 285, 285, 285, 326, 285, 330, -1000, -1000, -1000,
+	-1000, -1000, -1000, 404, -1000, -1000, -1000, -1000, 749, 21883,
+	-1000, 1700, 927, 927, 927, 1159, 241, -1000, -1000, -113,
+	1854, 927, 927, 1854, 57, -1000, 2037, 2017, -1000, -1000,
+	-1000, -1000, -1000, -1000, 21883, 21883, 287, 287, 294, -1000,
+	-184, -1000, -1000, 474, -1000, 426, -1000, 333, 229, 754,
+	1164, -1000, 1057, 21883, 21883, 21883, 1057, 1057, 13021, 12555,
+	918, -1000, 2052, 1690, -1000, 1627, 1685, 1690, 287, 287,
+	287, 287, 287, 287, 287, 287, 21883, 6391, 6391, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 243, 1847, -1000,
+	21883, 2052, 1949, 2052, -1000, 900, 1331, 1513, -1000, -1000,
+	233, 1099, -1000, 789, -1000, -1000, -1000, -1000, 21883, 207,
+	-1000, 1496, 316, 1689, -1000, -1000, 334, 811, 715, -1000,
+	47, 804, 17215, 1342, 17215, 21883, -1000, -1000, -1000, -1000,
+	-123, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -68, -1000, -1000, -295, 1949, 6871, -1000, -1000, 6871,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 21883, 1120,
+	21883, 319, 285, -1000, 1519, 17215, 1342, 1351, 21883, 285,
+	294, -1000, 21883, 749, 2006, 21883, -1000, 8791, 2110, 2110,
+	21883, -1000, -1000, 927, 927, -1000, 1159, 1159, -1000, -1000,
+	-125, 2110, 2110, -121, 21883, 21883, 285, -1000, -1000, 23994,
+	48, 1519, 17215, 14419, -1000, -149, 447, 439, 432, -1000,
+	-1000, 2142, -1000, -1000, 635, 308, 11623, 248, 17215, 3991,
+	-1000, -1000, 1057, 1057, 1057, 3991, 3991, 801, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 21883, 1949, -1000, -1000, -1000,
+	-1000, -1000, 21883, 1519, 17215, 1342, 21883, 21883, 21883, 23516,
+	-1000, 660, -1000, -1000, 10213, 895, 6871, -1000, 1233, 1846,
+	-1000, -1000, 1845, 1841, 1833, 1832, 1830, 1826, 1825, -1000,
+	1647, -1000, -1000, 1820, 1818, 1815, 1814, -1000, 1811, -1000,
+	-1000, -1000, -1000, 1810, -1000, -1000, -1000, 1809, 1647, -1000,
+	-1000, 1808, 1807, 1805, 1803, 1802, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1512, 1506, 1000, -1000, -289, -1000, -1000, 3511,
+	8791, 8791, 8791, 8791, -1000, -1000, 1722, 6871, 1800, 98,
+	-1000, -1000, 98, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 8311, -1000, 1799,
+	1795, 1794, 1792, 1791, 1647, 1790, 1505, 1788, 1787, 1776,
+	8791, 1775, 1772, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 660, -1000, -292, -1000,
+	11157, 21883, 21883, -1000, 1949, -1000, 1949, 2589, -1000, 2048,
+	-1000, 233, 135, -1000, -1000, -1000, -1000, -1000, -1000, 893,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 747,
+	-1000, 21883, 21883, 96, -1000, -1000, 47, 17215, 1087, -1000,
+	-1000, -1000, -1000, -1000, -1000, 101, -1000, -1000, 132, -1000,
+	313, -30, 1096, -1000, -1000, 22, -61, 129, 1504, -1000,
+	964, 892, 739, -1000, 1230, 1881, 1960, 82, 20018, 21883,
+	0, -1000, 602, -1000, -30, 635, 1760, -1000, -1000, -1000,
+	1932, 21883, 19552, -1000, 1762, 1076, -1000, -1000, -1000, 6871,
+	-1000, 2110, 2110, 2110, 927, 23516, 1159, 21883, 1159, -1000,
+	-1000, 1159, -1000, 889, -1000, 21883, 729, -1000, 716, 669,
+	12089, 281, -1000, 1011, 1011, 713, 1011, 1011, 1011, 1011,
+	208, 201, 1011, 1011, 1011, 1011, 1011, 1011, 1011, 1011,
+	1011, 1011, 1011, 1011, 1011, 1011, 1761, -1000, -1000, -1000,
+	0, 602, 2042, 578, -1000, -1000, -1000, -1000, 1966, 1756,
+	23127, 223, -1000, -1000, 443, 414, 429, 1342, 312, -1000,
+	-1000, 635, -1000, -1000, -1000, 1750, 1088, -1000, -1000, 8791,
+	-1000, 1214, -1000, 3991, 3991, 3991, -1000, -1000, 15351, -1000,
+	-1000, 1879, -1000, 724, 635, 1877, 722, -1000, 722, -1000,
+	-1000, -1000, 2110, 6391, -1000, 14419, -1000, 6871, 6871, 6871,
+	6871, -1000, 19079, -1000, 18613, -1000, 682, 7831, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 6871, 2010, 2010, 2010, 6871,
+	1122, 6871, 6871, -1000, 1234, 812, 2010, 2010, 2010, 8791,
+	2010, 2010, -1000, 3029, 2010, 2010, 2010, 2010, -1000, -1000,
+	8791, 8791, 8791, 8791, 8791, 8791, 8791, 8791, 8791, 8791,
+	8791, 8791, 1721, 1064, 8791, 8791, 8791, 1484, 1481, 1685,
+	1948, 687, -1000, -1000, -1000, -1000, -1000, 1091, 1214, 6871,
+	-1000, 1747, -1000, 10336, 6871, 6871, 6871, -1000, 1622, 1620,
+	-1000, -1000, 6871, -1000, 6871, 8791, 6871, -1000, 2010, 1480,
+	2110, 584, -1000, 1743, -1000, 1075, 1926, -1000, 883, 639,
+	-1000, 1086, 1059, -1000, -1000, -1000, -1000, 881, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3429,170 +3788,187 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -64, -1000, -1000,
-	19341, 1301, 775, 834, 439, -1000, 253, 762, 755, -1000,
-	15239, 17, 15239, -1000, 15239, -1000, -1000, 193, -1000, 19341,
-	1737, -1000, -1000, -1000, 1293, -142, 6075, 6075, 19341, 6075,
-	-1000, -1000, -1000, 1519, 904, 1565, -115, 853, -1000, 353,
-	15239, 172, -1000, 1684, 102, -1000, 1629, -1000, 370, -1000,
-	1560, 19341, 543, -1000, 752, 21100, -1000, 19341, 1233, -1000,
-	-1000, -1000, 1889, -1000, 826, -1000, 826, 887, 19341, -1000,
-	-1000, -115, 353, 19341, 14784, 14784, 14784, 14784, -1000, 1723,
-	1717, -1000, 1704, 1703, 1761, 19341, 14784, 19341, -1000, -1000,
-	-1000, 20175, -1000, -1000, -1000, -1000, 1415, 1876, -1000, -1000,
-	-1000, 340, 488, 15239, 1292, 238, -1000, -1000, -1000, -1000,
-	-1000, 19341, 19341, 1886, -1000, 483, 1750, -1000, 914, 914,
-	915, -1000, -1000, 751, -1000, -1000, 302, -1000, -1000, -1000,
-	-1000, -1000, 1555, -1000, -1000, -1000, 1410, 430, 1233, 6075,
-	-1000, -1000, 6075, 6075, 1492, 6075, 1409, 772, 760, -1000,
-	1408, 1893, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 6075, 6075, 6075, 6075, 6075, 1129, 5143, -1000, -1000,
-	-1000, 6075, 6075, 6075, 6075, 1113, 1231, -1000, 903, 903,
-	739, 739, 739, 739, 739, 999, 999, -1000, -1000, -1000,
-	3273, 1544, 7473, 7473, 7473, 221, 2677, 3028, -1000, 6075,
-	897, -1000, 6075, 654, -1000, 1405, 1238, 1398, 1397, -1000,
-	-1000, 478, 1396, 983, 1389, 6075, 464, 1886, -288, 4671,
-	236, 19341, -288, 19341, 19341, 4671, -1000, 19341, 2370, 1138,
-	-1000, -1000, -1000, 1881, 15239, 1182, 787, 831, -1000, 13,
-	-1000, 73, 252, 1291, -1000, 275, 872, -95, -81, 1233,
-	1233, 747, -1000, 1790, 1773, 8402, 1038, -1000, -1000, 1290,
-	-1000, 245, -1000, -1000, -1000, 305, 14, 1136, 240, -1000,
-	1331, 19341, -1000, -1000, -1000, -1000, -1000, 853, 853, 853,
-	8402, 208, 673, 735, 14784, 19341, -1000, 14329, 1388, -1000,
-	-1000, 1889, 1889, 826, -1000, 1038, 245, 732, -1000, 868,
-	1676, 1681, 1676, -1000, -1000, -1000, -1000, 1715, -1000, 1702,
-	-1000, -1000, 732, -1000, 1519, -1000, -1000, -1000, 439, 1386,
-	-1000, -1000, -1000, -1000, 1884, 1879, 15694, -1000, -1000, -1000,
-	-1000, -1000, 6075, 1690, 1682, 1631, 20856, 749, -1000, -1000,
-	-1000, -1000, 6075, 1605, 1558, 1553, 1549, 1523, -1000, 6075,
-	6075, 1167, 1483, 1440, 1425, 1404, 748, -1000, 221, 2677,
-	2785, -1000, 7473, 7473, 1377, 884, -1000, 6075, 1037, 734,
-	12054, -1000, 6075, -1000, -1000, -1000, 12054, -1000, 7473, -1000,
-	1369, 1271, 1884, -1000, 1384, 459, -1000, -288, -1000, -1000,
-	441, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	15239, -1000, 72, -1000, -1000, -1000, -1000, -1000, -1000, -52,
-	-1000, 19341, 1267, -292, -77, 1878, 1877, 19341, 237, 19341,
-	1383, 442, -1000, -1000, -1000, 20934, 759, -1000, 19341, 932,
-	393, 242, 393, 930, 1543, -1000, -1000, -1000, 1263, -41,
-	-1000, -1000, 1261, 1259, 1134, -1000, -1000, 1120, 445, -29,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1542, 12054, 12509, 1248,
-	1373, 19796, 14784, 14329, 702, -1000, 726, -1000, -1000, -1000,
-	-1000, 1889, -1000, -1000, 1882, 19341, 4671, -1000, -1000, 6075,
-	1537, -1000, 6075, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	6075, 6075, -1000, -1000, 1233, -1000, -1000, -1000, 1371, -1000,
-	342, 342, -12, -1000, -1000, -1000, 1475, -1000, 1526, 1526,
-	1475, 1475, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1536, 1535, -1000, -1000, 1475, 1520, 1520, 1475, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -65, -1000, -1000, 21883,
+	1496, -1000, 1032, 1873, 1087, 544, -1000, 311, 879, 868,
+	-1000, 17215, 33, 17215, -1000, 17215, -1000, -1000, 193, -1000,
+	21883, 1938, -1000, -1000, -1000, 1478, -1000, 1476, -61, 6871,
+	6871, 21883, 6871, -1000, -1000, -1000, 21883, -1000, 2001, 1474,
+	1700, 1112, 1737, -93, 1011, -37, 17215, 227, -1000, 1863,
+	124, -1000, 1932, -1000, 661, 1731, 21883, 601, -1000, 858,
+	24152, -1000, 21883, 1214, -1000, -1000, -1000, 2110, -1000, 927,
+	-1000, 927, 1159, 21883, -1000, -1000, 23994, 21883, -1000, -1000,
+	641, -1000, 21883, 1181, 449, 285, 449, 1176, 1730, -1000,
+	-1000, 21883, 21883, 21883, 1174, 21883, 21883, 21883, -1000, 21883,
+	1328, -1000, 1327, 1011, 1011, 1326, 1472, 1467, 1466, 1011,
+	1011, 1321, 1465, 22738, 1319, 1318, 1316, 1275, 1462, 846,
+	1273, 1271, 1269, 21883, 1723, 1426, -93, 279, 21883, 14419,
+	14419, 14419, 14419, -1000, 1908, 1898, -1000, 1929, 1917, 1899,
+	1971, 21883, 14419, 21883, -1000, 6871, -1000, -1000, 22738, -1000,
+	-1000, -1000, -1000, 1612, 2112, -1000, -1000, -1000, 410, 635,
+	17215, 1461, 248, -1000, -1000, -1000, -1000, -1000, 21883, 21883,
+	21883, 2106, -1000, 631, 2064, -1000, 1108, 1108, 1125, -1000,
+	-1000, 837, -1000, -1000, 359, -1000, -1000, -1000, -1000, -1000,
+	1722, -1000, -1000, -1000, 1598, 499, 1214, 6871, -1000, -1000,
+	6871, 6871, 1491, 6871, 1597, 1030, 1020, -1000, 1589, 2115,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1527, 1527, 1533, 1527, -1000, 1357,
-	-1000, -1000, -1000, -1000, -1000, 1341, 1321, 6075, -1000, -1000,
-	-1000, -1000, -1000, -1000, 7473, -1000, -1000, -1000, -1000, 1233,
-	6075, 1370, -1000, 1475, 1526, -1000, 1475, 1520, 1475, 342,
-	342, 1367, 1103, 1358, 2229, -1000, 1356, -1000, -1000, 4671,
-	441, 439, -1000, -1000, -155, 52, -294, 1243, -1000, 1874,
-	1236, 1171, -1000, 1519, 928, 8402, 1625, -1000, -1000, 19341,
-	19341, -1000, 19341, 19341, 242, 6075, -1000, -1000, 184, -1000,
-	-1000, 41, -1000, -1000, 1097, 281, -1000, 13874, -1000, -1000,
-	-1000, -1000, -1000, -74, 1889, 702, 726, -1000, -1000, 756,
-	-1000, 1845, -1000, -1000, 1233, 19341, 1233, 1233, 430, -1000,
-	-1000, -1000, -1000, -1000, -15, -1000, -1000, -1000, -1000, 1132,
-	-1000, 1130, -1000, -1000, -1000, 1235, 1235, -1000, -1000, 1092,
-	-1000, -1000, -1000, 1091, -1000, -1000, 1089, -1000, -1000, -1000,
-	-1000, 1269, -1000, 1233, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 6075, -1000, -1000, -1000, -1000, -1000,
-	-104, -88, -1000, 1226, -296, 1088, -1000, 1225, -80, -1000,
-	-1000, 1786, 205, 20996, -1000, 853, 853, 646, 853, 853,
-	853, 853, 154, 144, 853, 853, 853, 853, 853, 853,
-	853, 853, 853, 853, 853, 853, 853, 853, 1513, -1000,
-	-1000, 1625, -1000, -1000, 937, 7473, -1000, -1000, 1219, 12509,
-	449, 470, 1512, -1000, 113, 1903, 923, 921, -1000, 19341,
-	-1000, 1511, 1525, 80, 1503, -1000, 1501, 1488, 19341, 1227,
-	250, 1218, -1000, -1000, 39, -1000, -1000, 744, -1000, 1475,
-	6075, -1000, -155, 1882, -1000, -1000, 740, -1000, -1000, 1348,
-	1347, 736, -1000, 721, 1340, 720, 718, -1000, 1222, 246,
-	-87, -88, -1000, 1872, -86, 1871, 1870, 867, -1000, 1473,
-	-1000, -1000, 1866, 237, -1000, 1857, 928, -1000, 1087, 1069,
-	853, 853, 1068, 1216, 1215, 1210, 853, 853, 1061, 1207,
-	20175, 1060, 1048, 1035, 1066, 1206, 462, 1033, 1014, 1007,
-	19341, 1472, 1152, -1000, -1000, 2677, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1032, 1471, -171,
-	-1000, -1000, 1469, 13874, 112, 112, 13874, 13874, 13874, 1468,
-	288, -1000, -53, -1000, -1000, -1000, 13874, 1747, 1155, -1000,
-	1845, -1000, -1000, -1000, -1000, 1205, -1000, -1000, -1000, 1006,
-	-1000, 1003, -1000, 1460, 1001, -77, 1856, -1000, 1171, 1855,
-	1171, 1171, 1198, 19341, 1171, -1000, 143, -1000, -1000, -1000,
-	12054, 12054, -1000, -1000, -1000, -1000, 1196, 1195, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6871,
+	6871, 6871, 2431, 6871, 6871, 1534, 5911, -1000, -1000, -1000,
+	6871, 6871, 6871, 6871, 1322, 2220, -1000, 1116, 1116, 839,
+	839, 839, 839, 839, 1207, 1207, -1000, -1000, -1000, 3511,
+	1721, 8791, 8791, 8791, 266, 2475, 2403, -1000, -1000, -1000,
+	6871, 1130, -1000, 6871, 1093, 262, -1000, 1586, 1370, 1583,
+	1582, -1000, -1000, 534, 1579, 1160, 1570, 6871, 630, 2106,
+	-292, 5431, 220, 21883, -292, 21883, 21883, 5431, -1000, 21883,
+	2589, 1315, -1000, -1000, -1000, 21883, 2113, 17215, 1342, 878,
+	1084, -1000, 37, -1000, 90, 301, 18147, -1000, 328, -1000,
+	1080, 212, 1214, 1214, 834, -1000, 2045, 1460, -1000, 1995,
+	1959, 9747, 1333, -1000, -1000, 1459, 279, -1000, -40, -1000,
+	1518, 21883, -1000, -1000, -1000, -1000, -1000, 1011, 1011, 1011,
+	9747, 260, 765, 807, 14419, 21883, -1000, 16749, 1563, -1000,
+	-1000, 2110, 2110, 927, -1000, -1000, -1000, 23986, -1000, -1000,
+	21883, 21883, -1000, 21883, 21883, 285, 6871, -1000, -1000, -1000,
+	-1000, 310, -1000, -1000, -1000, -1000, -1000, 14885, 14885, -1000,
+	-1000, -1000, -1000, 1456, 1455, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	149, 19341, 717, -1000, 858, 1339, 6075, 1458, -233, 13874,
-	709, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 696, 694,
-	693, 13874, -1000, -1000, -1000, 110, -1000, 123, -1000, -1000,
-	1747, -1000, -1000, 1338, 1336, 1815, 11599, -97, -1000, 1191,
-	-1000, 1171, -1000, -1000, -305, 685, -1000, -1000, 76, 168,
-	164, -1000, 266, -1000, -1000, -1000, -1000, -1000, -1000, 183,
-	680, -1000, 1152, 1151, -1000, 1096, -1000, 1679, -1000, 4,
-	672, -1000, -1000, -1000, -1000, 669, -1000, -1000, 853, 1118,
-	57, -1000, -1000, -1000, -1000, -1000, -1000, 19341, -1000, 660,
-	-1000, -1000, -1000, 723, -1000, -1000, -1000, -1000, -1000, -1000,
-	19341, 90, 992, 7473, 1457, 7473, 1447, 103, 1444, -1000,
-	-1000, -1000, -1000, -1000, 288, -1000, 1561, 1556, 1897, -1000,
-	-1000, -1000, -1000, 123, 123, 123, 123, 10, 987, -1000,
-	1170, 401, -1000, 851, -1000, 13419, 19341, -1000, 1438, 1848,
-	-1000, 2158, 19341, 1904, 19341, 1435, 841, 7473, -1000, -1000,
-	1899, -1000, 1901, 443, 443, -1000, -1000, -1000, 19341, 4205,
-	-1000, 647, -1000, 202, 94, -1000, 642, -1000, 638, 19341,
-	976, 1442, -1000, -1000, -1000, 988, 121, -1000, -1000, -1000,
-	1233, 19341, 627, -1000, 1326, 75, -1000, -1000, 626, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 202, 1708, -1000, 974,
-	-1000, -1000, 905, 322, -1000, -1000, 905, 83, -1000, 188,
-	1434, -1000, -1000, 493, -1000, 1217, 361, 6075, -1000, 83,
-	928, -1000, 6075, 474, -1000, 928, 473, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 217, 21883, 1016,
+	-1000, 1067, 1333, 272, -1000, -1000, -1000, 398, 32, 1311,
+	261, 612, -1000, 1066, 1860, 1862, 1860, -1000, -1000, -1000,
+	-1000, 1896, -1000, 1895, -1000, 1886, -1000, -1000, 612, -1000,
+	1700, 953, -1000, -1000, -1000, 544, 1562, -1000, -1000, -1000,
+	-1000, -1000, 2104, 2085, 17681, -1000, -1000, -1000, -1000, -1000,
+	6871, 1942, 1927, 1916, 23876, 968, -1000, -1000, -1000, -1000,
+	6871, 1874, 1843, 1838, 6871, 1828, 1812, -1000, 6871, 6871,
+	1276, 1758, 1754, 1745, 1741, 916, -1000, 266, 2475, 2348,
+	-1000, 8791, 8791, 1728, 1060, -1000, 6871, 1198, 738, 14885,
+	2088, 2083, -1000, 6871, -1000, -1000, -1000, 14885, -1000, 8791,
+	-1000, 1710, 1453, 2104, -1000, 1560, 623, -1000, -292, -1000,
+	-1000, 584, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 611, 17215, -1000, 89, -1000, -1000, -1000, -1000, -1000,
+	-1000, -52, -1000, 2082, 21883, 1438, -130, 1436, 21883, 2122,
+	-1000, 223, 21883, 1554, 593, -1000, -1000, -1000, -1000, 1433,
+	-34, -1000, 272, -1000, -1000, -1000, 259, -1000, 1432, 1430,
+	1429, 1428, -1000, -1000, 1720, 14885, 13953, 1427, 1553, 22349,
+	14419, 16749, 599, -1000, 796, -1000, -1000, -1000, -1000, 2110,
+	755, 13, -1000, -1000, -1000, 1670, -1000, 1704, 1704, 1670,
+	1670, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1719, 1717, -1000, -1000, 1701, 1701, 1701, 1670, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1714, 1714, 1716, 1714, 1713, 1712, 121,
+	1709, -1000, 1707, 1706, 21883, 1702, 21883, -1000, -1000, -1000,
+	-1000, -1000, 218, 915, -1000, 1426, 1425, -1000, -1000, 1420,
+	1417, 1304, -1000, -1000, 1268, 766, -14, -1000, -1000, -1000,
+	-1000, 2088, 21883, 5431, -1000, -1000, 6871, 1705, -1000, 6871,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1411, -1000, -1000, 6871,
+	6871, -1000, -1000, 1214, -1000, -1000, -1000, 1552, -1000, 411,
+	411, -1000, 1659, -1000, -1000, -1000, 1655, -1000, -1000, 1642,
+	1591, 6871, -1000, -1000, -1000, -1000, -1000, -1000, 8791, -1000,
+	-1000, -1000, -1000, 1214, 6871, 1549, -1000, 1670, 1704, -1000,
+	1670, 1701, 1701, 411, 411, 1548, 23, 6871, 1284, 1547,
+	2176, -1000, 1545, -1000, -1000, 5431, 584, 544, -1000, -1000,
+	1410, -121, 65, -87, -79, -1000, 306, -1000, -1000, -1000,
+	-1000, 1700, 24250, 9747, -1000, -1000, 192, -1000, -24, -1000,
+	-1000, -1000, -1000, -1000, 16283, -1000, -1000, -1000, -1000, -1000,
+	-68, 2110, 599, 796, -1000, -1000, 794, -1000, -1000, 755,
+	-1000, -1000, 1211, 8791, -1000, -1000, 1398, 13953, 479, 477,
+	1696, -1000, 174, 2135, 1173, 1145, -1000, 21883, -1000, 10,
+	-1000, -1000, -1000, -1000, 1294, -1000, 1293, -1000, -1000, -1000,
+	1396, 1396, -1000, 1286, -1000, -1000, -1000, -1000, 1285, -1000,
+	-1000, 1282, -1000, 16283, 119, 119, 16283, 16283, 16283, 1671,
+	343, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 69,
+	-1000, -1000, 1267, 296, -1000, 2052, -1000, -1000, 1214, 21883,
+	1214, -134, 1214, 499, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1551, -1000, 1214, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1544, 4951, -1000, -1000, 499,
+	-1000, 6871, -1000, -1000, -1000, -1000, -1000, -1000, -102, -75,
+	-1000, 1392, -297, -70, 2081, 2066, 1342, 1994, 255, 24196,
+	-1000, -1000, 288, 1391, -1000, 905, -1000, 1670, 6871, -1000,
+	-121, 2088, -1000, -1000, -1000, 2475, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1281, 1669, -126,
+	-1000, -1000, 1653, -1000, -1000, 1538, 1536, 869, -1000, 866,
+	1533, 856, 842, 841, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 838, 832, 797, 16283, -1000, -1000, -1000, 169, -1000,
+	-1000, 68, -1000, -1000, -1000, 786, 1648, -1000, -1000, -1000,
+	7351, -293, 95, 91, 1543, 292, -85, -75, -1000, 2065,
+	-73, 2063, 2062, 1050, 1203, 1386, -1000, 2061, 1380, 1353,
+	1581, 223, -1000, 2060, 24250, -1000, -1000, -53, -1000, -1000,
+	16283, 1944, 1482, -1000, 2052, 1532, 6871, 1647, -223, 16283,
+	-1000, -1000, -1000, 1378, -1000, -1000, -1000, 1280, -1000, 1278,
+	-1000, -1000, -1000, -1000, 782, -1000, -1000, -1000, 21883, 1117,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1646, 1277, -70, 2058,
+	-1000, 1353, 2057, 1353, 1353, 1376, -299, 1975, -1000, 1363,
+	-80, -1000, -1000, 1641, -1000, 162, -1000, -1000, 141, -1000,
+	-1000, 1944, -1000, -1000, 1473, -1000, 1831, -1000, 431, 774,
+	-1000, 1528, 1524, 141, 141, 141, 141, 35, 735, -1000,
+	23931, 7351, 2015, 13487, -90, -1000, 1361, -1000, 1353, -1000,
+	-1000, -310, -301, 1265, 1360, -1000, 2054, 6871, 149, 211,
+	182, -1000, 318, -1000, -1000, 1011, 1355, 110, -1000, -1000,
+	-1000, 343, -1000, 1748, 1744, 2129, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1320, 21883, -283, 176, -1000, -1000, 21883,
+	-1000, 733, -1000, -1000, -1000, 790, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1639, -1000, -1000, 1353, 1310, 144, 1240, 8791,
+	1638, 8791, 1637, 164, 1636, 1222, -1000, 1351, -1000, -1000,
+	2134, -1000, 2132, 452, 452, 1966, -1000, -1000, 1352, -286,
+	485, -1000, 886, -1000, 15817, 21883, 21883, -1000, -1000, 1630,
+	2046, -1000, 2131, 21883, 1937, 21883, 1479, 876, 8791, -1000,
+	-1000, -1000, -1000, -1000, 1250, 178, -1000, 21883, -1000, 1289,
+	21883, 4471, -1000, 670, 730, -1000, -1000, 254, 154, -1000,
+	726, -1000, 719, 21883, 1218, 1400, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1214, 21883, -1000, 21883, 683, -1000, 1517,
+	130, -1000, -1000, 681, -1000, -1000, -1000, -1000, -1000, 254,
+	1724, -1000, 1217, -1000, -1000, 24141, 352, -1000, -1000, 24141,
+	142, -1000, 247, 1287, -1000, -1000, 673, -1000, 1499, 421,
+	6871, -1000, 142, 24250, -1000, 6871, 657, -1000, 24250, 538,
+	-1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 122, 2247, 181, 151, 149, 180, 2245, 1822, 1812,
-	2240, 2239, 2238, 2237, 2236, 2235, 2231, 2230, 2229, 2228,
-	2227, 2226, 2225, 2224, 2221, 2220, 2219, 179, 2217, 2216,
-	2214, 2213, 2212, 2211, 2210, 2209, 2208, 2207, 2206, 2204,
-	2203, 120, 2202, 1780, 2201, 2200, 2198, 2196, 2195, 165,
-	2193, 2192, 2191, 2190, 2189, 2188, 2186, 2182, 2181, 2180,
-	2178, 2177, 2176, 160, 60, 2175, 42, 47, 110, 195,
-	73, 200, 194, 139, 78, 127, 170, 117, 2174, 2173,
-	114, 24, 130, 2171, 46, 44, 35, 191, 103, 58,
-	64, 96, 2170, 2169, 91, 2168, 2167, 102, 2166, 63,
-	2165, 81, 31, 95, 27, 2164, 2163, 2159, 83, 2158,
-	2157, 2156, 2155, 90, 2154, 70, 54, 2153, 2149, 2148,
-	2147, 2146, 29, 2145, 49, 2144, 2143, 2142, 2141, 2140,
-	2139, 2137, 11, 17, 21, 2133, 14, 2, 2131, 163,
-	61, 87, 108, 2130, 384, 2129, 2128, 2127, 115, 2125,
-	133, 2124, 2123, 2120, 2118, 2117, 10, 2116, 57, 2113,
-	2111, 2110, 56, 2109, 2107, 2106, 107, 45, 51, 105,
-	2105, 2101, 80, 162, 16, 40, 0, 155, 43, 2100,
-	2098, 2096, 193, 154, 153, 171, 119, 278, 131, 2095,
-	2094, 50, 2093, 123, 2092, 111, 75, 67, 2091, 89,
-	2090, 37, 88, 2085, 112, 2081, 136, 1, 100, 26,
-	164, 2080, 2076, 2075, 126, 2070, 2066, 68, 124, 2065,
-	2062, 2058, 25, 2057, 32, 19, 2056, 99, 178, 2055,
-	148, 97, 129, 128, 84, 159, 173, 77, 116, 983,
-	118, 86, 18, 2054, 169, 2052, 256, 217, 144, 2050,
-	2049, 176, 348, 157, 2048, 137, 7, 2047, 2046, 9,
-	2044, 22, 2043, 2042, 2041, 2039, 6, 2037, 2036, 2034,
-	4, 3, 2033, 5, 113, 55, 72, 2031, 66, 76,
-	2030, 2027, 2026, 2025, 2024, 207, 2022, 2008, 2007, 2005,
-	2004, 2003, 1985, 85, 38, 1975, 1974, 1973, 69, 1971,
-	1969, 1968, 1967, 1966, 41, 1965, 1963, 23, 1962, 30,
-	1961, 1959, 1957, 12, 143, 1956, 1954, 15, 1953, 1952,
-	8, 13, 1951, 1950, 65, 53, 39, 82, 79, 1949,
-	20, 1948, 98, 1947, 1945, 141, 1944, 109, 1941, 134,
-	174, 218, 1937, 161, 1936, 1935, 1933, 1932, 1931, 1930,
-	1929, 986, 1928, 1927, 175, 62, 166, 1925, 1924, 147,
-	1923, 125, 94, 92, 156, 1922, 145, 1921, 1920, 1919,
-	158, 1918,
-}
-
-//line mysql_sql.y:7248
+	0, 122, 2550, 189, 131, 127, 188, 2534, 1986, 1979,
+	2533, 2530, 2529, 2527, 2526, 2525, 2506, 2505, 2500, 2497,
+	2495, 2493, 2490, 2484, 2483, 2482, 2481, 187, 2480, 2479,
+	2478, 2477, 2476, 2475, 2473, 2471, 2469, 2468, 2467, 2453,
+	2452, 125, 2450, 1972, 2449, 2448, 2445, 2443, 2442, 166,
+	2441, 2440, 2439, 2438, 2433, 2431, 2430, 2429, 2428, 2427,
+	2426, 2425, 2424, 2422, 2421, 124, 2420, 2419, 2415, 2414,
+	2412, 2411, 2410, 2408, 2406, 168, 59, 2405, 2404, 138,
+	2403, 2402, 47, 42, 119, 209, 50, 214, 210, 147,
+	73, 137, 174, 142, 2401, 2399, 113, 19, 2398, 28,
+	135, 2397, 110, 45, 35, 192, 100, 46, 63, 91,
+	2396, 2395, 82, 2393, 2392, 96, 2391, 60, 156, 118,
+	152, 90, 89, 117, 2390, 2389, 27, 2388, 2387, 2386,
+	2385, 84, 2384, 62, 54, 2383, 2381, 2380, 2379, 2377,
+	79, 2376, 53, 2375, 2374, 2371, 2369, 2368, 2367, 2366,
+	17, 21, 23, 2365, 25, 2, 2360, 163, 95, 77,
+	107, 2359, 372, 2358, 2357, 2356, 114, 2355, 177, 2353,
+	2349, 2348, 2346, 2345, 164, 2343, 2342, 2341, 24, 2326,
+	8, 2325, 52, 2324, 2323, 2322, 130, 2321, 2319, 2318,
+	98, 76, 16, 94, 2317, 2316, 72, 162, 20, 56,
+	0, 179, 112, 2315, 2314, 2313, 208, 178, 172, 175,
+	121, 250, 129, 2310, 2309, 55, 2308, 92, 2306, 104,
+	65, 10, 2304, 31, 2303, 190, 78, 2302, 109, 2301,
+	141, 1, 101, 33, 165, 2300, 2298, 2297, 145, 2296,
+	2295, 93, 134, 2282, 2280, 2278, 34, 2277, 36, 30,
+	2276, 106, 186, 44, 153, 97, 136, 133, 83, 169,
+	176, 2275, 2274, 2273, 58, 68, 115, 1078, 105, 75,
+	22, 2272, 173, 2271, 225, 171, 148, 2270, 2269, 185,
+	346, 160, 2264, 140, 7, 2263, 2262, 9, 2261, 26,
+	2259, 2258, 2257, 2256, 5, 2255, 2254, 2253, 4, 6,
+	2252, 3, 102, 48, 64, 2250, 67, 71, 2235, 2232,
+	2226, 2225, 2224, 292, 2222, 2220, 2219, 2217, 2216, 2214,
+	2213, 81, 41, 2211, 2210, 2209, 69, 2208, 2207, 2205,
+	2204, 2203, 39, 2201, 2200, 18, 2199, 29, 2198, 2197,
+	2196, 13, 139, 2195, 2194, 14, 2193, 2190, 11, 12,
+	2189, 2188, 88, 70, 49, 120, 123, 2187, 66, 2186,
+	99, 2184, 2183, 146, 2182, 108, 2181, 143, 181, 223,
+	2179, 161, 2176, 2175, 2169, 2166, 2165, 2163, 2162, 1159,
+	2161, 2159, 182, 57, 167, 2158, 2157, 154, 2156, 116,
+	86, 74, 157, 2155, 150, 2153, 2152, 158, 2150, 170,
+	15,
+}
+
+//line mysql_sql.y:7721
 type yySymType struct {
 	union interface{}
 	id    int
@@ -3636,6 +4012,16 @@ func (st *yySymType) aliasedTableExprUnion() *tree.AliasedTableExpr {
 	return v
 }
 
+func (st *yySymType) alterTableOptionUnion() tree.AlterTableOption {
+	v, _ := st.union.(tree.AlterTableOption)
+	return v
+}
+
+func (st *yySymType) alterTableOptionsUnion() tree.AlterTableOptions {
+	v, _ := st.union.(tree.AlterTableOptions)
+	return v
+}
+
 func (st *yySymType) assignmentUnion() *tree.Assignment {
 	v, _ := st.union.(*tree.Assignment)
 	return v
@@ -3771,6 +4157,21 @@ func (st *yySymType) fieldsListUnion() []*tree.Fields {
 	return v
 }
 
+func (st *yySymType) frameBoundUnion() *tree.FrameBound {
+	v, _ := st.union.(*tree.FrameBound)
+	return v
+}
+
+func (st *yySymType) frameClauseUnion() *tree.FrameClause {
+	v, _ := st.union.(*tree.FrameClause)
+	return v
+}
+
+func (st *yySymType) frameTypeUnion() tree.FrameType {
+	v, _ := st.union.(tree.FrameType)
+	return v
+}
+
 func (st *yySymType) fromUnion() *tree.From {
 	v, _ := st.union.(*tree.From)
 	return v
@@ -3836,6 +4237,16 @@ func (st *yySymType) joinCondUnion() tree.JoinCond {
 	return v
 }
 
+func (st *yySymType) jsonTableColumnUnion() *tree.JsonTableColumn {
+	v, _ := st.union.(*tree.JsonTableColumn)
+	return v
+}
+
+func (st *yySymType) jsonTableColumnsUnion() []*tree.JsonTableColumn {
+	v, _ := st.union.([]*tree.JsonTableColumn)
+	return v
+}
+
 func (st *yySymType) keyPartUnion() *tree.KeyPart {
 	v, _ := st.union.(*tree.KeyPart)
 	return v
@@ -3931,6 +4342,11 @@ func (st *yySymType) partitionsUnion() []*tree.Partition {
 	return v
 }
 
+func (st *yySymType) policyCommandUnion() tree.PolicyCommand {
+	v, _ := st.union.(tree.PolicyCommand)
+	return v
+}
+
 func (st *yySymType) privilegeUnion() *tree.Privilege {
 	v, _ := st.union.(*tree.Privilege)
 	return v
@@ -4226,6 +4642,11 @@ func (st *yySymType) whereUnion() *tree.Where {
 	return v
 }
 
+func (st *yySymType) windowSpecUnion() *tree.WindowSpec {
+	v, _ := st.union.(*tree.WindowSpec)
+	return v
+}
+
 func (st *yySymType) withClauseUnion() *tree.With {
 	v, _ := st.union.(*tree.With)
 	return v
@@ -4237,258 +4658,277 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 367, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 395, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 57, 323, 323, 322, 322, 321,
-	321, 320, 320, 320, 319, 319, 319, 318, 318, 317,
-	317, 316, 316, 314, 314, 315, 313, 312, 312, 312,
-	310, 310, 308, 308, 309, 309, 303, 303, 306, 306,
-	304, 304, 304, 304, 307, 302, 302, 302, 301, 301,
-	56, 56, 56, 241, 241, 55, 55, 255, 255, 255,
-	255, 255, 253, 253, 253, 253, 253, 253, 252, 252,
-	251, 251, 256, 256, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 254, 254, 254, 254, 50, 50, 50,
-	50, 53, 54, 249, 249, 249, 249, 249, 250, 250,
-	250, 51, 52, 52, 240, 240, 245, 245, 244, 244,
-	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
-	248, 248, 248, 247, 247, 246, 246, 44, 44, 44,
-	47, 46, 239, 239, 239, 239, 239, 239, 239, 239,
-	239, 45, 45, 45, 45, 45, 45, 42, 42, 42,
-	42, 42, 41, 41, 43, 43, 238, 238, 237, 60,
-	60, 60, 60, 60, 60, 60, 59, 59, 62, 62,
-	61, 49, 49, 49, 49, 48, 48, 48, 48, 48,
-	48, 48, 48, 48, 346, 346, 346, 348, 348, 180,
-	181, 368, 179, 179, 179, 342, 342, 343, 344, 345,
-	345, 345, 58, 7, 7, 39, 40, 366, 366, 285,
-	285, 193, 193, 192, 192, 192, 192, 192, 192, 192,
-	192, 192, 192, 192, 192, 192, 192, 192, 192, 288,
-	289, 189, 27, 27, 27, 27, 27, 27, 27, 27,
-	27, 27, 27, 31, 31, 38, 369, 369, 369, 36,
-	37, 284, 284, 284, 35, 34, 33, 32, 32, 30,
-	30, 29, 29, 186, 186, 188, 188, 184, 370, 370,
-	261, 261, 187, 187, 28, 28, 28, 185, 185, 163,
-	183, 183, 183, 6, 10, 10, 10, 10, 10, 10,
-	10, 10, 16, 18, 235, 235, 232, 17, 13, 12,
-	15, 11, 14, 5, 5, 5, 5, 8, 8, 9,
-	75, 75, 103, 103, 355, 355, 351, 351, 352, 352,
-	352, 353, 353, 354, 354, 4, 292, 292, 292, 292,
-	292, 292, 331, 331, 331, 332, 93, 93, 88, 88,
-	293, 293, 208, 333, 333, 300, 300, 299, 299, 298,
-	298, 91, 91, 92, 92, 79, 79, 63, 63, 305,
-	305, 305, 305, 311, 311, 282, 282, 129, 129, 159,
-	159, 160, 160, 67, 67, 68, 68, 68, 68, 68,
-	68, 339, 339, 341, 341, 340, 90, 90, 86, 86,
-	87, 87, 87, 85, 85, 84, 83, 83, 82, 81,
-	81, 81, 70, 70, 69, 69, 69, 69, 69, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 71, 71, 347, 347, 347, 286, 286, 286, 291,
-	291, 141, 141, 142, 142, 140, 140, 72, 72, 73,
-	73, 73, 73, 139, 139, 138, 74, 74, 80, 76,
-	76, 78, 78, 78, 78, 149, 149, 148, 148, 148,
-	148, 96, 96, 147, 146, 146, 146, 95, 95, 94,
-	94, 89, 89, 77, 77, 145, 371, 371, 143, 172,
-	172, 172, 178, 178, 171, 171, 171, 171, 171, 182,
-	177, 177, 173, 173, 174, 174, 174, 3, 3, 3,
-	3, 22, 22, 22, 22, 26, 26, 349, 349, 19,
-	356, 359, 357, 360, 360, 360, 361, 361, 361, 362,
-	362, 20, 358, 363, 363, 363, 236, 236, 233, 234,
-	234, 231, 230, 230, 230, 365, 365, 364, 364, 364,
-	175, 175, 21, 227, 227, 228, 229, 229, 221, 221,
-	221, 221, 25, 225, 225, 226, 226, 226, 226, 226,
-	222, 222, 224, 224, 220, 220, 220, 220, 220, 24,
-	219, 219, 217, 217, 215, 215, 216, 216, 214, 214,
-	214, 218, 218, 23, 23, 65, 64, 64, 64, 66,
-	287, 287, 257, 257, 260, 260, 267, 267, 268, 268,
-	266, 266, 273, 273, 272, 272, 271, 271, 270, 270,
-	269, 269, 269, 269, 264, 264, 263, 263, 258, 258,
-	258, 258, 258, 259, 259, 262, 262, 265, 265, 120,
-	120, 121, 121, 121, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 329, 329, 330, 123, 123, 123, 127,
-	127, 127, 127, 127, 127, 122, 122, 124, 124, 104,
-	104, 102, 102, 97, 97, 98, 98, 99, 99, 100,
-	100, 101, 101, 101, 101, 101, 101, 243, 243, 327,
-	327, 328, 328, 324, 324, 324, 326, 326, 326, 326,
-	326, 325, 325, 105, 157, 157, 157, 176, 176, 176,
-	156, 156, 156, 119, 119, 118, 118, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 242, 242, 190, 190, 191, 191, 136, 135, 135,
-	135, 135, 135, 133, 134, 132, 132, 132, 132, 132,
-	131, 131, 130, 130, 130, 223, 223, 128, 128, 126,
-	126, 126, 125, 125, 125, 274, 197, 197, 197, 197,
-	197, 197, 197, 197, 197, 197, 197, 197, 197, 199,
-	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
-	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
-	200, 200, 205, 205, 338, 338, 337, 106, 106, 106,
-	107, 107, 107, 107, 107, 107, 107, 107, 107, 115,
-	115, 115, 154, 154, 154, 154, 154, 154, 154, 154,
-	154, 154, 154, 154, 154, 154, 154, 297, 297, 297,
-	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 155, 350, 350, 350, 334, 334, 335, 335,
-	335, 335, 335, 335, 335, 335, 335, 335, 335, 335,
-	336, 336, 336, 336, 336, 336, 336, 336, 336, 336,
-	336, 336, 336, 336, 336, 336, 336, 153, 153, 152,
-	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
-	152, 152, 209, 209, 210, 210, 294, 294, 294, 294,
-	294, 294, 295, 295, 296, 296, 296, 296, 290, 290,
-	290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
-	290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
-	290, 290, 290, 290, 290, 290, 290, 198, 150, 150,
-	150, 211, 206, 206, 207, 207, 201, 201, 201, 201,
-	201, 201, 201, 201, 201, 201, 201, 203, 203, 203,
-	203, 203, 195, 195, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 202, 202, 204, 204, 213, 213, 213,
-	212, 212, 212, 212, 212, 212, 212, 117, 117, 117,
-	117, 194, 194, 194, 194, 194, 194, 194, 194, 194,
-	194, 108, 108, 108, 108, 112, 112, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 113, 113, 113, 113, 111, 111, 111, 111, 111,
-	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
-	109, 109, 109, 109, 109, 109, 110, 158, 158, 275,
-	275, 278, 278, 276, 276, 277, 279, 279, 279, 280,
-	280, 280, 281, 281, 281, 283, 283, 162, 162, 162,
-	168, 168, 161, 161, 169, 169, 170, 170, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 165, 165, 165, 165, 165,
-	165, 165, 165, 165, 165, 164, 164, 164, 164, 164,
-	164, 164, 164, 164, 164, 164, 164, 164, 164, 164,
-	164, 164, 164, 164, 164, 164, 164, 164, 164, 164,
-	164, 164, 164, 164, 164, 164, 164, 164, 164, 164,
-	164,
+	1, 1, 1, 1, 1, 1, 57, 351, 351, 350,
+	350, 349, 349, 348, 348, 348, 347, 347, 347, 346,
+	346, 345, 345, 344, 344, 342, 342, 343, 341, 340,
+	340, 340, 338, 338, 336, 336, 337, 337, 331, 331,
+	334, 334, 332, 332, 332, 332, 335, 330, 330, 330,
+	329, 329, 56, 56, 56, 269, 269, 55, 55, 283,
+	283, 283, 283, 283, 281, 281, 281, 281, 281, 281,
+	280, 280, 279, 279, 284, 284, 282, 282, 282, 282,
+	282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+	282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+	282, 282, 282, 282, 282, 282, 282, 282, 282, 50,
+	50, 50, 50, 53, 54, 277, 277, 277, 277, 277,
+	278, 278, 278, 51, 52, 52, 268, 268, 273, 273,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 276, 276, 276, 275, 275, 274, 274, 44,
+	44, 44, 47, 46, 267, 267, 267, 267, 267, 267,
+	267, 267, 267, 45, 45, 45, 45, 45, 45, 42,
+	42, 42, 42, 42, 41, 41, 43, 43, 266, 266,
+	265, 72, 72, 72, 72, 72, 72, 72, 71, 71,
+	74, 74, 73, 49, 49, 49, 49, 48, 48, 48,
+	48, 48, 48, 48, 48, 48, 374, 374, 374, 376,
+	376, 204, 205, 396, 203, 203, 203, 370, 370, 371,
+	372, 373, 373, 373, 58, 58, 58, 7, 7, 7,
+	7, 63, 66, 66, 65, 65, 65, 65, 65, 65,
+	65, 65, 65, 65, 65, 65, 397, 397, 64, 62,
+	62, 61, 39, 40, 394, 394, 313, 313, 217, 217,
+	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
+	216, 216, 216, 216, 216, 216, 316, 317, 213, 27,
+	27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+	27, 60, 31, 31, 38, 398, 398, 398, 36, 37,
+	312, 312, 312, 35, 34, 33, 32, 32, 30, 30,
+	29, 29, 210, 210, 212, 212, 208, 399, 399, 289,
+	289, 211, 211, 28, 28, 28, 209, 209, 187, 207,
+	207, 207, 6, 10, 10, 10, 10, 10, 10, 10,
+	10, 10, 69, 16, 18, 259, 259, 256, 17, 13,
+	12, 15, 11, 14, 5, 5, 5, 5, 8, 8,
+	9, 91, 91, 121, 121, 383, 383, 379, 379, 380,
+	380, 380, 381, 381, 382, 382, 4, 320, 320, 320,
+	320, 320, 320, 359, 359, 359, 360, 111, 111, 106,
+	106, 321, 321, 232, 361, 361, 328, 328, 327, 327,
+	326, 326, 109, 109, 110, 110, 95, 95, 75, 75,
+	80, 80, 81, 81, 333, 333, 333, 333, 339, 339,
+	310, 310, 147, 147, 183, 183, 184, 184, 83, 83,
+	84, 84, 84, 84, 84, 84, 367, 367, 369, 369,
+	368, 108, 108, 104, 104, 105, 105, 105, 103, 103,
+	102, 101, 101, 100, 99, 99, 99, 86, 86, 85,
+	85, 85, 85, 85, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 87, 87, 375, 375,
+	375, 314, 314, 314, 319, 319, 159, 159, 160, 160,
+	158, 158, 88, 88, 89, 89, 89, 89, 157, 157,
+	156, 90, 90, 96, 92, 92, 94, 94, 94, 94,
+	167, 167, 166, 166, 166, 166, 166, 166, 114, 114,
+	165, 164, 164, 164, 113, 113, 112, 112, 107, 107,
+	93, 93, 93, 98, 98, 97, 97, 97, 163, 400,
+	400, 161, 196, 196, 196, 202, 202, 195, 195, 195,
+	195, 195, 206, 201, 201, 197, 197, 198, 198, 198,
+	3, 3, 3, 3, 3, 3, 68, 70, 70, 70,
+	59, 67, 67, 22, 22, 22, 22, 26, 26, 377,
+	377, 19, 384, 387, 385, 388, 388, 388, 389, 389,
+	389, 390, 390, 20, 386, 391, 391, 391, 261, 261,
+	262, 262, 262, 262, 263, 263, 263, 264, 264, 264,
+	264, 260, 260, 257, 258, 258, 255, 254, 254, 254,
+	393, 393, 392, 392, 392, 392, 199, 199, 21, 251,
+	251, 252, 253, 253, 245, 245, 245, 245, 25, 249,
+	249, 250, 250, 250, 250, 250, 246, 246, 248, 248,
+	244, 244, 244, 244, 244, 24, 243, 243, 241, 241,
+	239, 239, 240, 240, 238, 238, 238, 242, 242, 23,
+	23, 77, 76, 79, 79, 78, 78, 78, 82, 315,
+	315, 285, 285, 288, 288, 295, 295, 296, 296, 294,
+	294, 301, 301, 300, 300, 299, 299, 298, 298, 297,
+	297, 297, 297, 292, 292, 291, 291, 286, 286, 286,
+	286, 286, 287, 287, 290, 290, 293, 293, 138, 138,
+	139, 139, 139, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 357, 357, 358, 141, 141, 141, 145, 145,
+	145, 145, 145, 145, 140, 140, 142, 142, 122, 122,
+	120, 120, 115, 115, 116, 116, 117, 117, 118, 118,
+	119, 119, 119, 119, 119, 119, 271, 271, 355, 355,
+	356, 356, 352, 352, 352, 354, 354, 354, 354, 354,
+	353, 353, 123, 181, 181, 181, 200, 200, 200, 180,
+	180, 180, 137, 137, 136, 136, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	270, 270, 214, 214, 215, 215, 154, 153, 153, 153,
+	153, 153, 151, 152, 150, 150, 150, 150, 150, 149,
+	149, 148, 148, 148, 247, 247, 146, 146, 144, 144,
+	144, 143, 143, 143, 302, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 224, 224, 229, 229, 366, 366, 365, 124,
+	124, 124, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 133, 133, 133, 172, 172, 172, 172, 172, 172,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 173,
+	173, 174, 175, 175, 176, 176, 176, 177, 177, 178,
+	178, 178, 178, 178, 325, 325, 325, 169, 169, 169,
+	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
+	179, 378, 378, 378, 362, 362, 363, 363, 363, 363,
+	363, 363, 363, 363, 363, 363, 363, 363, 364, 364,
+	364, 364, 364, 364, 364, 364, 364, 364, 364, 364,
+	364, 364, 364, 364, 364, 171, 171, 170, 170, 170,
+	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	233, 233, 234, 234, 322, 322, 322, 322, 322, 322,
+	323, 323, 324, 324, 324, 324, 318, 318, 318, 318,
+	318, 318, 318, 318, 318, 318, 318, 318, 318, 318,
+	318, 318, 318, 318, 318, 318, 318, 318, 318, 318,
+	318, 318, 318, 318, 318, 318, 318, 222, 168, 168,
+	168, 235, 230, 230, 231, 231, 225, 225, 225, 225,
+	225, 225, 225, 225, 225, 225, 225, 227, 227, 227,
+	227, 227, 219, 219, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 226, 226, 228, 228, 237, 237, 237,
+	236, 236, 236, 236, 236, 236, 236, 135, 135, 135,
+	135, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 126, 126, 126, 126, 130, 130, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
+	132, 131, 131, 131, 131, 129, 129, 129, 129, 129,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 128, 182, 182, 303,
+	303, 306, 306, 304, 304, 305, 307, 307, 307, 308,
+	308, 308, 309, 309, 309, 311, 311, 186, 186, 186,
+	192, 192, 185, 185, 193, 193, 194, 194, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 9, 0, 2, 1, 3, 3,
-	3, 1, 3, 5, 0, 2, 3, 1, 3, 1,
-	1, 1, 3, 1, 1, 1, 1, 0, 3, 3,
-	0, 3, 0, 3, 0, 3, 0, 2, 1, 2,
-	3, 4, 3, 3, 1, 0, 1, 1, 0, 1,
-	8, 5, 7, 0, 3, 8, 5, 1, 3, 3,
-	3, 1, 0, 1, 1, 1, 1, 1, 1, 3,
-	1, 4, 1, 3, 1, 2, 1, 1, 2, 2,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 1,
-	2, 1, 2, 2, 1, 1, 1, 3, 2, 2,
-	2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
-	1, 3, 6, 3, 1, 1, 1, 1, 1, 1,
-	1, 2, 4, 6, 1, 4, 1, 3, 3, 4,
-	4, 4, 3, 3, 2, 4, 4, 2, 2, 2,
-	1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
-	2, 2, 0, 1, 4, 2, 4, 1, 5, 3,
-	2, 1, 2, 2, 4, 4, 5, 2, 1, 3,
-	4, 4, 1, 2, 9, 7, 1, 3, 3, 1,
-	1, 1, 1, 1, 1, 1, 4, 4, 2, 4,
-	3, 1, 1, 1, 1, 2, 3, 4, 7, 2,
-	3, 3, 4, 5, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 2, 1, 1,
-	1, 1, 6, 1, 1, 7, 7, 0, 3, 0,
-	2, 0, 1, 1, 1, 1, 4, 2, 2, 3,
-	3, 4, 5, 3, 4, 4, 2, 2, 2, 3,
-	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 4, 4, 5, 1, 1, 1, 5,
-	5, 0, 1, 1, 2, 2, 3, 6, 7, 4,
-	4, 7, 8, 0, 2, 0, 2, 2, 1, 1,
-	1, 1, 0, 1, 4, 4, 5, 1, 3, 1,
-	1, 3, 5, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 4, 4, 1, 3, 1, 4, 6, 4,
-	4, 4, 3, 1, 1, 2, 2, 11, 8, 9,
-	1, 3, 2, 4, 0, 2, 0, 1, 1, 1,
-	1, 0, 1, 0, 1, 4, 2, 1, 5, 4,
-	4, 2, 0, 1, 3, 3, 1, 3, 1, 3,
-	1, 3, 4, 0, 1, 0, 1, 1, 3, 1,
-	1, 0, 4, 1, 3, 2, 1, 0, 8, 0,
-	4, 7, 4, 0, 2, 0, 2, 0, 2, 0,
-	4, 1, 3, 1, 1, 4, 3, 4, 5, 4,
-	5, 2, 3, 1, 3, 6, 0, 3, 0, 1,
-	2, 4, 4, 0, 1, 3, 1, 3, 2, 0,
-	1, 1, 3, 3, 1, 3, 3, 3, 3, 1,
-	2, 2, 1, 2, 2, 1, 2, 2, 1, 2,
-	2, 7, 7, 1, 1, 1, 0, 1, 1, 1,
-	1, 0, 2, 0, 3, 0, 2, 1, 3, 1,
-	2, 3, 5, 0, 1, 2, 1, 3, 1, 1,
-	1, 4, 4, 4, 3, 2, 2, 2, 3, 2,
-	3, 0, 2, 1, 1, 2, 2, 0, 1, 2,
-	4, 1, 3, 1, 4, 3, 0, 1, 2, 0,
-	1, 2, 1, 1, 0, 1, 2, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 8, 11, 0, 1, 7,
-	1, 4, 1, 3, 4, 3, 0, 1, 1, 0,
-	2, 7, 1, 0, 2, 2, 1, 3, 2, 1,
-	3, 2, 1, 3, 2, 0, 1, 3, 4, 3,
-	1, 1, 4, 1, 3, 1, 1, 1, 0, 1,
-	1, 1, 11, 0, 2, 3, 2, 3, 1, 1,
-	1, 3, 3, 4, 0, 2, 2, 2, 2, 5,
-	1, 1, 0, 3, 0, 1, 1, 2, 4, 4,
-	4, 0, 1, 10, 9, 2, 2, 6, 10, 5,
-	0, 1, 0, 6, 0, 4, 0, 3, 1, 3,
-	4, 5, 0, 3, 1, 3, 2, 3, 1, 2,
-	0, 4, 6, 5, 0, 2, 0, 2, 4, 5,
-	4, 5, 1, 6, 5, 0, 3, 0, 1, 0,
-	1, 1, 3, 2, 3, 3, 4, 4, 3, 3,
-	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	4, 5, 4, 1, 3, 3, 0, 2, 2, 1,
+	1, 1, 1, 1, 1, 0, 9, 0, 2, 1,
+	3, 3, 3, 1, 3, 5, 0, 2, 3, 1,
+	3, 1, 1, 1, 3, 1, 1, 1, 1, 0,
+	3, 3, 0, 3, 0, 3, 0, 3, 0, 2,
+	1, 2, 3, 4, 3, 3, 1, 0, 1, 1,
+	0, 1, 8, 5, 7, 0, 3, 8, 5, 1,
+	3, 3, 3, 1, 0, 1, 1, 1, 1, 1,
+	1, 3, 1, 4, 1, 3, 1, 2, 1, 1,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
+	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
+	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
+	3, 4, 4, 4, 3, 3, 2, 4, 4, 2,
+	2, 2, 1, 1, 1, 1, 1, 1, 3, 1,
+	1, 1, 2, 2, 0, 1, 4, 2, 4, 1,
+	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
+	1, 3, 4, 4, 1, 2, 9, 7, 1, 3,
+	3, 1, 1, 1, 1, 1, 1, 1, 4, 4,
+	2, 4, 3, 1, 1, 1, 1, 2, 3, 4,
+	7, 2, 3, 3, 4, 5, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 2,
+	1, 1, 1, 1, 3, 6, 7, 1, 1, 1,
+	1, 4, 1, 3, 3, 2, 3, 3, 3, 3,
+	5, 3, 3, 2, 3, 1, 0, 1, 5, 4,
+	4, 3, 7, 7, 0, 3, 0, 2, 0, 1,
+	1, 1, 1, 4, 2, 2, 3, 3, 4, 5,
+	3, 4, 4, 2, 2, 2, 3, 0, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 3, 0, 1, 1, 3, 1, 1, 2,
-	1, 7, 7, 7, 7, 8, 5, 0, 1, 0,
-	1, 1, 1, 1, 3, 3, 1, 1, 1, 1,
-	1, 0, 1, 3, 1, 3, 5, 1, 1, 1,
-	1, 3, 5, 0, 1, 1, 2, 1, 2, 2,
-	1, 1, 2, 2, 2, 2, 2, 1, 5, 6,
-	4, 1, 2, 0, 1, 1, 2, 5, 0, 1,
-	1, 2, 2, 3, 3, 1, 1, 2, 2, 2,
-	0, 1, 2, 2, 2, 0, 3, 0, 3, 1,
-	1, 1, 1, 1, 1, 1, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 1, 1,
-	1, 1, 3, 5, 2, 2, 2, 2, 1, 1,
-	2, 5, 6, 6, 6, 1, 1, 1, 1, 1,
-	0, 2, 0, 1, 1, 2, 4, 1, 2, 2,
-	1, 2, 2, 1, 2, 2, 2, 2, 2, 0,
-	1, 1, 5, 4, 4, 5, 5, 5, 5, 4,
-	5, 5, 5, 5, 5, 5, 5, 1, 1, 1,
-	4, 4, 6, 8, 6, 4, 5, 5, 4, 6,
-	6, 7, 6, 1, 1, 1, 1, 1, 1, 1,
+	1, 3, 4, 4, 5, 1, 1, 1, 5, 5,
+	0, 1, 1, 2, 2, 3, 6, 7, 4, 4,
+	7, 8, 0, 2, 0, 2, 2, 1, 1, 1,
+	1, 0, 1, 4, 4, 5, 1, 3, 1, 1,
+	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 6, 4, 4, 1, 3, 1, 4, 6,
+	4, 4, 4, 3, 1, 1, 2, 2, 11, 8,
+	9, 1, 3, 2, 4, 0, 2, 0, 1, 1,
+	1, 1, 0, 1, 0, 1, 4, 2, 1, 5,
+	4, 4, 2, 0, 1, 3, 3, 1, 3, 1,
+	3, 1, 3, 4, 0, 1, 0, 1, 1, 3,
+	1, 1, 0, 4, 1, 3, 2, 1, 0, 11,
+	0, 2, 0, 3, 0, 4, 7, 4, 0, 2,
+	0, 2, 0, 2, 0, 4, 1, 3, 1, 1,
+	4, 3, 4, 5, 4, 5, 2, 3, 1, 3,
+	6, 0, 3, 0, 1, 2, 4, 4, 0, 1,
+	3, 1, 3, 2, 0, 1, 1, 3, 3, 1,
+	3, 3, 3, 3, 1, 2, 2, 1, 2, 2,
+	1, 2, 2, 1, 2, 2, 7, 7, 1, 1,
+	1, 0, 1, 1, 1, 1, 0, 2, 0, 3,
+	0, 2, 1, 3, 1, 2, 3, 5, 0, 1,
+	2, 1, 3, 1, 1, 1, 4, 4, 4, 3,
+	2, 2, 2, 3, 2, 3, 2, 3, 0, 2,
+	1, 1, 2, 2, 0, 1, 2, 4, 1, 3,
+	1, 4, 12, 1, 3, 3, 4, 5, 3, 0,
+	1, 2, 0, 1, 2, 1, 1, 0, 1, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 14, 1, 1, 1,
+	7, 0, 2, 1, 1, 1, 1, 8, 11, 0,
+	1, 7, 1, 4, 1, 3, 4, 3, 0, 1,
+	1, 0, 2, 8, 1, 0, 2, 2, 0, 1,
+	2, 2, 2, 2, 1, 3, 2, 2, 2, 2,
+	2, 1, 3, 2, 1, 3, 2, 1, 3, 2,
+	0, 1, 3, 4, 3, 5, 1, 1, 4, 1,
+	3, 1, 1, 1, 0, 1, 1, 1, 11, 0,
+	2, 3, 2, 3, 1, 1, 1, 3, 3, 4,
+	0, 2, 2, 2, 2, 5, 1, 1, 0, 3,
+	0, 1, 1, 2, 4, 4, 4, 0, 1, 10,
+	9, 2, 2, 0, 2, 2, 6, 10, 5, 0,
+	1, 0, 6, 0, 4, 0, 3, 1, 3, 4,
+	5, 0, 3, 1, 3, 2, 3, 1, 2, 0,
+	4, 6, 5, 0, 2, 0, 2, 4, 5, 4,
+	5, 1, 6, 5, 0, 3, 0, 1, 0, 1,
+	1, 3, 2, 3, 3, 4, 4, 3, 3, 3,
+	3, 4, 4, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
+	5, 4, 1, 3, 3, 0, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 3, 0, 1, 1, 3, 1, 1, 2, 1,
+	7, 7, 7, 7, 8, 5, 0, 1, 0, 1,
+	1, 1, 1, 3, 3, 1, 1, 1, 1, 1,
+	0, 1, 3, 1, 3, 5, 1, 1, 1, 1,
+	3, 5, 0, 1, 1, 2, 1, 2, 2, 1,
+	1, 2, 2, 2, 2, 2, 1, 5, 6, 4,
+	1, 2, 0, 1, 1, 2, 5, 0, 1, 1,
+	2, 2, 3, 3, 1, 1, 2, 2, 2, 0,
+	1, 2, 2, 2, 0, 3, 0, 3, 1, 1,
+	1, 1, 1, 1, 1, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 1, 1, 3,
+	3, 1, 1, 3, 5, 2, 2, 2, 2, 1,
+	1, 2, 5, 6, 6, 6, 1, 1, 1, 1,
+	1, 1, 0, 2, 0, 1, 1, 2, 4, 1,
+	2, 2, 1, 2, 2, 1, 2, 2, 2, 2,
+	2, 0, 1, 1, 5, 4, 4, 5, 5, 5,
+	5, 4, 5, 5, 5, 5, 5, 5, 5, 2,
+	2, 6, 0, 3, 0, 2, 5, 1, 1, 2,
+	2, 2, 2, 2, 1, 1, 1, 4, 4, 6,
+	8, 6, 6, 4, 5, 5, 4, 6, 6, 7,
+	6, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 4,
-	2, 3, 2, 4, 6, 2, 2, 4, 6, 4,
-	2, 2, 0, 1, 2, 3, 1, 1, 1, 1,
-	1, 1, 0, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 4, 2, 3,
+	2, 4, 6, 2, 2, 4, 6, 4, 2, 2,
+	0, 1, 2, 3, 1, 1, 1, 1, 1, 1,
+	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 3, 0, 1,
@@ -4543,505 +4983,548 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -367, -2, -1, -3, -4, -5, -6, -48, -59,
-	-61, -62, -27, -7, -58, -41, -42, -44, -50, -55,
-	-56, -57, -67, -22, -21, -20, -19, 12, -8, -9,
-	-339, -10, -179, -180, -181, -368, -28, -29, -30, -32,
-	-33, -34, -35, -36, -37, -38, -31, -40, -39, 197,
-	-43, 53, -45, -46, -47, -51, -52, -53, -54, 310,
-	316, 365, -68, -70, -23, -24, -25, -26, 193, 14,
-	438, -11, -14, -12, -15, -13, -17, -18, -16, 215,
-	214, 32, 277, 278, 314, 213, 194, 13, 126, 127,
-	129, 130, 25, -69, 59, 195, -71, 10, 484, -79,
-	33, -102, -176, 62, -164, -167, 441, 442, 443, 444,
-	445, 446, 447, 448, 449, 450, 451, 452, 453, 454,
-	455, 456, 457, 458, 459, 460, 461, 462, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 293, 435, 232, 256, 259, 266, 126, 145,
-	139, 167, 159, 134, 236, 237, 69, 129, 395, 79,
-	380, 364, 132, 251, 253, 255, 258, 238, 155, 150,
-	250, 234, 148, 239, 34, 240, 172, 241, 242, 440,
-	376, 292, 382, 263, 362, 149, 377, 254, 379, 174,
-	178, 387, 316, 143, 144, 384, 389, 171, 212, 37,
-	437, 224, 390, 176, 170, 166, 398, 267, 265, 169,
-	142, 165, 235, 42, 180, 179, 181, 358, 359, 360,
-	361, 243, 244, 304, 386, 162, 151, 296, 135, 22,
-	393, 219, 381, 317, 245, 260, 268, 175, 177, 226,
-	231, 439, 230, 264, 391, 147, 223, 252, 218, 394,
-	136, 310, 319, 246, 130, 257, 388, 397, 41, 332,
-	141, 133, 209, 127, 228, 233, 247, 248, 249, 270,
-	269, 261, 163, 225, 168, 140, 164, 128, 227, 396,
-	333, 483, 294, 347, 161, 158, 229, 202, 392, 383,
-	131, 351, 334, 156, 271, 480, 481, 482, 305, 291,
-	272, 273, 154, 216, 375, 274, 276, -43, -9, -8,
-	-69, -70, -183, 23, 362, -49, 363, 197, 59, -176,
-	-5, -4, -41, -67, -182, -176, 277, -182, 200, -176,
-	230, 346, -285, 205, -252, -227, 231, -251, -228, -254,
-	-229, 26, 194, 193, 227, 14, 195, 314, 201, 12,
-	10, 315, 213, 13, 316, 318, 319, 322, 323, 324,
-	36, 327, 328, 62, 65, 238, -84, -85, -144, 19,
-	6, 7, 8, 9, -287, 293, 347, 230, -221, -219,
-	274, 325, 210, 209, 80, 400, 199, -351, -352, 190,
-	191, 192, -341, 432, -340, -176, 433, -162, -369, -284,
-	383, 382, -187, 381, 374, 385, 376, 193, 79, 160,
-	157, 201, 384, 37, 387, 388, 377, 293, 347, -351,
-	131, 128, -239, 131, 85, 136, 135, -239, 230, 35,
-	-245, 357, -244, -246, 387, 388, 398, 63, 64, 386,
-	-162, -176, 79, -85, -144, -84, -68, -70, -339, 293,
-	347, 230, 201, 200, 202, 400, 277, -286, -347, 26,
-	-291, 187, 188, 189, 27, 28, -1, -91, 222, -102,
-	124, -85, -84, -85, -156, -176, 364, 94, -49, -49,
-	363, -342, -343, -344, -346, 197, 363, 362, 124, 15,
-	-182, 57, -102, -228, 230, -252, -227, 30, 56, 125,
-	203, 125, 56, 59, 312, 326, 347, 313, 325, 202,
-	230, 326, 230, 374, 202, 317, 320, 321, 375, -301,
-	398, -63, 33, -87, 21, -71, -70, 20, 26, 27,
-	26, 27, 26, 27, 26, 27, -349, 200, 432, -217,
-	205, -217, -217, 201, -217, 200, -353, 138, 125, -341,
-	-90, 59, -186, 102, -186, -370, 15, 104, 229, 228,
-	378, 375, -261, 379, 380, -187, -186, -186, -187, 200,
-	202, 400, 25, 25, -285, -285, -354, 204, 391, 438,
-	134, 135, 136, -249, 26, 35, 356, -227, 230, 125,
-	94, 23, -247, 94, 105, -246, -246, -246, -247, -247,
-	-122, 35, -175, 121, -176, 65, -122, 35, 124, -86,
-	-87, -71, -70, 60, 60, -285, -285, -285, -285, -285,
-	-285, -285, -182, -72, -73, 112, -201, -176, 86, 221,
-	-203, 62, -196, 441, 442, 443, 444, 445, 446, 447,
-	448, 449, 450, 451, 452, 453, 454, 455, 457, 458,
-	459, 460, 461, 462, 463, 464, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 79, 362,
-	304, 347, 156, 305, 154, 216, -197, -199, -320, -314,
-	-194, 59, 110, 111, 118, 87, -198, -274, 30, 89,
-	408, -151, -152, -153, -154, -155, -315, -313, 65, 70,
-	73, 76, 77, 75, 74, 152, 72, 66, 123, -70,
-	-334, -165, -290, -296, 199, -294, 157, 153, 12, 116,
-	357, 121, -297, 477, 64, 63, 298, 299, 300, 400,
-	295, 301, 205, 47, 302, 303, 306, 407, 307, 48,
-	308, 297, 220, 309, 173, 411, 410, 412, 404, 401,
-	399, 402, 403, 405, 406, -72, -292, 38, -67, 59,
-	25, 59, -176, -86, -63, -87, 124, 70, 65, -49,
-	60, 125, -345, 76, 77, -348, 171, 163, -176, -60,
-	65, -3, -4, -5, -6, -27, -41, -67, -316, -314,
-	59, 26, 356, 56, 15, -253, 200, 400, 311, 226,
-	202, -251, -235, -232, -230, -175, -228, -231, -230, -256,
-	-156, 375, -64, 366, 479, -63, -201, -176, -83, -82,
-	-201, 202, -217, -356, 62, 86, -236, -233, -230, -227,
-	-174, 62, 63, 64, -176, -217, -354, -340, 29, -89,
-	-176, -140, 16, -199, -140, -102, -186, -186, -188, -370,
-	-184, -370, 375, -140, -140, -261, -185, -176, -185, -217,
-	-356, -236, -76, -74, -77, -78, -80, -143, -145, -102,
-	59, 392, 393, 132, 135, 134, 7, -250, 356, 26,
-	-227, -244, -240, 65, 357, -231, -248, 56, 121, -298,
-	-201, 35, -247, -247, -247, -248, -248, 120, -176, -63,
-	-356, -235, -227, -176, -104, -102, -104, -177, -174, -167,
-	-139, 125, -138, 15, -171, 85, 82, 83, 84, -176,
-	29, 65, 124, -201, 101, -212, 94, 95, 96, 97,
-	98, 99, 100, 59, 59, 59, 59, 59, 59, 59,
-	59, -210, 59, 59, 59, 59, 59, 59, 59, -210,
-	59, 59, 59, 59, 59, 65, 65, 107, 106, 117,
-	110, 111, 112, 113, 114, 115, 116, 108, 109, 104,
-	86, 102, 103, 88, -70, -201, -207, -199, -199, -199,
-	-199, -274, -205, -201, 59, -201, 59, 59, 59, -295,
-	59, 59, -209, -210, 59, 65, 59, 59, 59, -199,
-	59, 59, -139, -293, -208, -333, 478, -93, 60, -88,
-	-176, -331, -332, -88, -92, -176, -63, -63, -169, -170,
-	-161, -166, -173, -174, -167, 198, 26, 85, 29, 31,
-	298, 330, 88, 121, 20, 89, 157, 120, 300, 408,
-	299, 193, 51, 410, 412, 411, 401, 399, 349, 353,
-	355, 352, 400, 374, 35, 14, 32, 214, 27, 28,
-	114, 195, 92, 93, 217, 30, 215, 77, 23, 54,
-	15, 17, 18, 301, 348, 205, 204, 104, 366, 201,
-	49, 12, 123, 33, 101, 350, 45, 81, 47, 102,
-	21, 402, 403, 36, 365, 434, 221, 116, 302, 303,
-	52, 86, 356, 75, 56, 82, 19, 50, 103, 196,
-	407, 48, 354, 306, 308, 318, 432, 307, 199, 385,
-	10, 297, 409, 25, 213, 46, 200, 91, 203, 76,
-	220, 153, 6, 80, 13, 53, 57, 404, 405, 406,
-	38, 90, 16, 309, 438, 357, 367, 368, 369, 370,
-	371, 372, 373, 182, 183, 184, 185, 186, 262, 208,
-	206, 210, 211, 478, 479, 187, 188, 288, 290, 160,
-	190, 191, 192, 222, 138, 7, 335, 336, 337, 338,
-	340, 341, 342, 343, 344, 345, 346, 23, -49, -343,
-	124, 125, -89, -253, -234, -231, -255, 112, 62, -241,
-	125, 438, 485, 63, 203, -365, -364, 348, 60, 125,
-	-302, 204, 407, 65, 486, 65, 125, 22, 124, 125,
-	-81, 31, 32, -102, 205, -102, -359, 335, 30, -366,
-	125, 35, -364, -220, 57, -215, -216, -214, -218, 35,
-	-102, 15, -75, -103, -176, 59, 60, 125, -201, -140,
-	-140, -140, -186, -177, -188, -183, -188, -184, 124, -163,
-	-176, -359, -366, 25, -146, -147, -148, -149, 45, 49,
-	51, 46, 47, 48, 52, 25, 125, -371, 29, -172,
-	-178, 29, -173, 65, -174, -167, -68, -70, 133, 136,
-	136, 135, -227, 203, 59, 94, -248, -248, -248, 35,
-	-175, 56, 125, -140, -73, -74, -76, -201, -201, -201,
-	-201, -176, 65, -176, 112, -195, 86, 291, 75, 76,
-	77, -196, -213, 26, 272, 273, -206, -207, -201, -150,
-	27, 26, -150, -150, -201, -150, 112, -207, -207, 60,
-	70, -335, -336, 413, 414, 415, 416, 417, 418, 419,
-	420, 421, 422, 423, 302, 297, 303, 301, 295, 309,
-	304, 305, 156, 430, 431, 424, 425, 426, 427, 428,
-	429, -150, -150, -150, -150, -150, -201, -350, 289, 288,
-	290, -150, -150, -150, -150, -197, -197, -197, -197, -197,
-	-197, -197, -197, -197, -197, -197, -197, -204, -211, -274,
-	59, 104, 102, 103, 88, -199, -197, -197, 60, 125,
-	-338, -337, 90, -201, -335, -206, -201, -206, -206, 60,
-	60, -207, -206, -197, -206, -150, 65, -140, 125, 59,
-	60, 125, 38, 124, 125, 94, 60, 125, 124, 364,
-	-176, -314, 60, -255, 125, 203, 124, 124, -232, 316,
-	-175, -234, 20, 438, -156, 33, 65, -305, 379, -201,
-	-201, -176, -82, -90, 86, 59, -361, 381, 337, -168,
-	94, -193, -192, 294, 36, -288, 357, 343, 344, -233,
-	230, 56, 283, 284, 285, 286, -214, -162, 120, 241,
-	59, -102, -75, -176, 15, 125, -355, 124, -1, -176,
-	-140, -186, -186, -188, -176, -361, -193, -238, -237, -156,
-	-77, -77, -77, -77, 45, 45, 45, 50, 45, 50,
-	45, -148, -238, -80, -176, -178, 60, 136, -234, -189,
-	65, -240, -102, -102, -142, 17, 124, -195, 291, 75,
-	-274, 60, 125, -201, -201, -201, 29, -207, 60, 60,
-	60, 60, 15, -201, -201, -201, -201, -201, 60, 15,
-	15, -201, -201, -201, -201, -201, -207, -204, -199, -197,
-	-197, -202, 217, 85, -201, -200, -337, 92, -201, 125,
-	57, 60, 15, 60, 60, 60, 57, 60, 125, 60,
-	-201, 125, -142, -208, -300, -299, -298, 38, -67, -88,
-	-293, -176, -332, -298, -176, -169, -166, -174, -167, 70,
-	15, -231, -227, 112, 112, 62, 317, -241, 65, 336,
-	65, 200, 94, -311, 372, 367, 369, 124, 29, 30,
-	-97, -98, -99, -105, -100, -156, -191, -101, 208, 206,
-	210, -328, 80, 211, 262, 81, 201, -362, 69, -357,
-	65, -363, 69, 338, 123, 296, 35, 339, 340, 354,
-	292, 70, 70, 345, -358, 62, -102, -168, -168, -168,
-	-97, -91, 57, 124, -74, -103, -176, 112, 60, -140,
-	-140, -186, -362, -363, -140, 125, 94, -95, -94, 56,
-	57, -96, 56, -94, 45, 45, -140, -90, 60, -141,
-	18, 20, -176, 112, -201, 60, 60, 60, -106, -108,
-	332, 333, -112, -109, -111, -110, -114, -113, 157, 158,
-	121, 161, 163, 164, 165, 166, 167, 168, 169, 170,
-	171, 172, 25, 173, 216, 153, 154, 155, 156, 174,
-	139, 159, 436, 182, 140, 183, 141, 184, 142, 185,
-	143, 144, 186, 145, 148, 149, 150, 147, 60, -201,
-	60, 60, 60, 60, 60, -201, -201, 15, 60, 60,
-	60, 60, 60, -202, 85, -199, -196, 60, 93, -201,
-	91, -107, -113, 121, 157, 216, 156, 155, 153, 332,
-	333, -122, -201, -122, -197, 60, 65, -141, 60, 125,
-	-293, -234, -241, 357, -102, 65, -282, 480, -309, 367,
-	20, 20, -67, -102, 60, 125, -108, -101, -176, 81,
-	-327, -328, -217, -327, 81, 59, 65, -360, 348, 65,
-	65, 70, 35, 70, 123, 341, -289, 59, -122, -124,
-	-175, 121, 65, 60, -172, -74, -176, -140, -355, 124,
-	-140, -85, -237, -298, -201, 59, -201, -201, -207, 60,
-	-115, 144, 143, -115, -281, 333, 332, -275, -277, 59,
-	-276, 59, -276, -275, -275, 59, 59, -275, -278, 59,
-	-278, -275, -279, 59, -279, -280, 59, -279, 60, 60,
-	60, -201, -196, -201, 60, -275, -276, -275, -278, -275,
-	-115, -115, 60, 60, 23, 60, 60, 60, -298, -66,
-	-303, -261, 487, 125, -129, 481, 65, 20, 65, -307,
-	65, -90, -120, -121, -137, 330, 232, -218, 236, 69,
-	237, 364, 238, 201, 240, 241, 242, 212, 243, 244,
-	245, 357, 246, 247, 248, 249, 313, 6, 279, -99,
-	-119, -118, -116, 75, 86, 35, 330, -117, 69, 120,
-	255, 233, 256, -136, -190, 56, 206, 80, 81, 318,
-	-191, -324, -325, -176, -325, -176, -324, -324, -217, -201,
-	20, 438, 295, 35, 70, 35, 342, -222, -224, -156,
-	59, -65, -64, -140, -140, -86, -89, -283, 334, 70,
-	70, -158, 65, -158, 70, 70, 70, 60, -201, -310,
-	372, -306, -304, 367, 368, 369, 370, 65, -159, 482,
-	70, 65, 369, 29, -257, 222, 125, -137, -168, -168,
-	-162, 120, -168, -168, -168, -168, 239, 239, -168, -168,
-	-168, -168, -168, -168, -168, -168, -168, -168, -168, -168,
-	-168, -168, 59, -116, 75, -197, 65, -124, -125, 35,
-	254, 250, -126, 35, 234, 235, -128, 59, 262, 13,
-	81, 81, -102, 59, 57, 271, 59, 59, 59, -325,
-	60, 65, 336, 65, 295, 60, 125, -275, -201, -66,
-	-85, 60, 60, 60, 60, 125, 60, 60, 60, 125,
-	60, 125, 60, -312, 204, -308, 371, -304, 20, 369,
-	20, 20, 94, 59, 20, -67, 20, -137, 70, 70,
-	-168, -168, 70, 65, 65, 65, -168, -168, 70, 65,
-	-178, 70, 70, 70, 70, 35, 65, -127, 35, 250,
-	254, 251, 252, 253, 70, 35, 70, 35, 70, 35,
-	-176, 59, -329, -330, 65, 70, 59, -294, -223, 59,
-	-222, -326, 283, 284, 285, 287, 286, -326, -222, -222,
-	-222, 59, -243, -242, 263, 86, 357, -225, -224, -81,
-	60, -86, 65, 70, 70, -319, 59, 70, -309, 20,
-	-307, 20, -307, -307, 65, -160, -176, -307, -258, 264,
-	265, -259, -265, 267, -122, -122, 65, 65, -123, 233,
-	-104, 60, 125, 94, 60, -201, -209, -131, -130, 434,
-	-222, 60, 60, 60, 60, -222, 263, -226, 212, 69,
-	438, 281, 282, -81, 60, 60, -323, 25, 60, -318,
-	-317, -157, -313, -176, 372, 373, 65, -307, 487, 60,
-	125, -263, 268, 59, -261, 59, -261, 81, 284, 234,
-	235, 60, -330, 65, 60, -135, -133, -134, 56, 377,
-	260, 261, 60, -225, -225, -225, -225, 60, -168, 65,
-	280, -322, -321, -320, 60, 125, 124, -176, -260, 269,
-	70, -197, 59, -197, 59, -262, 266, 59, -242, -134,
-	56, -133, 56, 14, 13, -136, 70, -174, 125, 94,
-	-317, -176, -267, 59, 20, 60, -256, 60, -256, 59,
-	94, -197, -132, 257, 258, 25, 135, -132, -321, 35,
-	-201, 124, -268, -266, 222, -259, 60, 60, -256, 70,
-	60, 75, 35, 259, -176, 60, 125, 62, -264, 270,
-	60, -266, -269, 38, 70, -273, -270, 59, -137, 224,
-	104, -273, -137, -272, -271, 269, 225, 59, 60, 125,
-	62, 221, 59, -207, -271, -270, -207, 60, 60,
+	-1000, -395, -2, -1, -3, -4, -5, -6, -48, -71,
+	-73, -74, -27, -7, -64, -58, -61, -41, -42, -44,
+	-50, -55, -56, -57, -83, -22, -21, -20, -19, -59,
+	-68, 12, -8, -9, -367, -10, -203, -204, -205, -396,
+	-28, -29, -30, -32, -33, -34, -35, -36, -37, -38,
+	-31, -60, -40, -39, -62, -63, 197, 198, 297, -43,
+	54, -45, -46, -47, -51, -52, -53, -54, 322, 328,
+	377, -84, -86, -23, -24, -25, -26, 194, 14, 449,
+	-11, -14, -12, -15, -13, -17, -18, -16, -69, 217,
+	216, 32, 279, 280, 326, 215, 195, 13, 127, 128,
+	130, 131, 25, -85, 60, 196, -87, 10, 500, -95,
+	33, -120, -200, 63, -188, -191, 452, 453, 454, 455,
+	456, 457, 458, 459, 460, 461, 462, 463, 464, 465,
+	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 305, 200, 490, 491, 446, 234, 258, 261,
+	268, 127, 146, 140, 168, 160, 135, 238, 239, 70,
+	130, 406, 80, 391, 376, 133, 253, 255, 257, 260,
+	240, 156, 151, 252, 236, 149, 241, 34, 242, 173,
+	243, 244, 451, 388, 304, 393, 265, 374, 150, 49,
+	256, 390, 175, 179, 398, 328, 144, 145, 395, 400,
+	172, 214, 37, 448, 226, 401, 177, 171, 167, 409,
+	269, 267, 170, 143, 166, 237, 42, 181, 180, 182,
+	370, 371, 372, 373, 245, 246, 316, 397, 163, 152,
+	308, 136, 22, 404, 221, 392, 329, 247, 262, 270,
+	176, 178, 228, 233, 450, 232, 266, 402, 148, 225,
+	254, 220, 405, 137, 322, 331, 248, 131, 259, 399,
+	408, 41, 344, 142, 134, 211, 128, 230, 235, 249,
+	250, 251, 272, 271, 263, 164, 227, 169, 141, 165,
+	129, 229, 407, 345, 499, 306, 359, 162, 159, 231,
+	204, 403, 394, 132, 363, 346, 157, 273, 496, 497,
+	498, 317, 303, 274, 275, 155, 218, 387, 276, 278,
+	290, 291, 292, 293, 294, 295, 296, 297, 298, -43,
+	-9, -8, -85, -86, -207, 23, 374, -49, 375, 198,
+	60, -200, -5, -4, -41, -83, -206, -200, 279, -206,
+	202, 202, 293, -200, 232, 358, -313, 207, -280, -251,
+	233, -279, -252, -282, -253, 26, 195, 194, 229, 14,
+	196, 326, 203, 12, 10, 327, 215, 13, 328, 330,
+	331, 334, 335, 336, 36, 339, 340, 63, 66, 240,
+	-102, -103, -162, 19, 6, 7, 8, 9, 298, 293,
+	-315, 305, 359, 232, -245, -243, 276, 337, 212, 211,
+	81, 411, 201, -379, -380, 191, 192, 193, -369, 443,
+	-368, -200, 294, 444, -186, -398, -312, 394, 393, -211,
+	392, 386, 396, 388, 194, 80, 161, 158, 203, 395,
+	37, 398, 399, 49, 202, 293, 305, 359, -379, 132,
+	129, -267, 132, 86, 137, 136, -267, 232, 35, -273,
+	369, -272, -274, 398, 399, 409, 64, 65, 397, -186,
+	-200, 80, -103, -162, -102, -84, -86, -367, 298, 305,
+	359, 232, 203, 202, 204, 411, 279, -314, -375, 26,
+	-319, 188, 189, 190, 27, 28, -1, -109, 224, -120,
+	125, -103, -102, -103, -180, -200, 376, 95, -49, -49,
+	375, -370, -371, -372, -374, 198, 375, 374, 125, 15,
+	-206, 58, -120, -120, -200, -252, 232, -280, -251, 30,
+	57, 126, 205, 126, 57, 60, 324, 338, 359, 325,
+	337, 204, 232, 338, 232, 386, 204, 329, 332, 333,
+	387, -329, 409, -75, 33, -105, 21, -87, -86, 20,
+	26, 27, 26, 27, 26, 27, 26, 27, -241, 207,
+	-241, -377, 202, 443, -241, -241, -241, 203, -241, 202,
+	-381, 139, 126, -369, -108, 60, -210, 103, -210, -210,
+	-399, 15, 105, 231, 230, 389, 387, -289, 390, 391,
+	-211, -210, -210, -211, 202, 204, 411, 25, 25, -120,
+	-200, -313, -313, -382, 206, 402, 449, 135, 136, 137,
+	-277, 26, 35, 368, -251, 232, 126, 95, 23, -275,
+	95, 106, -274, -274, -274, -275, -275, -140, 35, -199,
+	122, -200, 66, -140, 35, 125, -104, -105, -87, -86,
+	61, 61, -313, -313, -313, -313, -313, -313, -313, -313,
+	-206, -88, -89, 113, -225, -200, 87, 223, -227, 63,
+	-220, 452, 453, 454, 455, 456, 457, 458, 459, 460,
+	461, 462, 463, 464, 465, 466, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 483, 484, 485, 486, 487, 80, 374, 316, 359,
+	157, 317, 155, 218, -221, -223, -348, -342, -218, 60,
+	111, 112, 119, 88, -222, -302, 30, 90, 419, -169,
+	-170, -171, -172, -173, -179, -343, -341, 66, 71, 74,
+	77, 78, 76, 75, 153, 73, 67, 124, -86, -362,
+	-189, -318, -324, 201, -322, 158, 154, 12, 117, 369,
+	122, -325, 488, 65, 64, 310, 311, 312, 411, 307,
+	313, 207, 286, 47, 314, 315, 104, 318, 418, 319,
+	48, 320, 309, 222, 321, 174, 422, 421, 423, 415,
+	412, 410, 413, 414, 416, 417, -88, -320, 38, -83,
+	60, 25, 60, -200, -104, -75, -105, 125, 71, 66,
+	-49, 61, 126, -373, 77, 78, -376, 172, 164, -200,
+	-72, 66, -3, -4, -5, -6, -27, -41, -83, -344,
+	-342, 205, 60, 13, 26, 368, 57, 15, -281, 202,
+	411, 323, 228, 204, -279, -259, -256, -254, -199, -252,
+	-255, -254, -284, -180, 387, -76, -78, 378, 495, -75,
+	-225, -200, -101, -100, -225, -200, 87, -200, 204, -241,
+	-384, 63, -260, -257, -254, -251, -198, 63, 64, 65,
+	-200, -241, -382, -368, 29, -107, -200, -223, -158, 16,
+	-158, -120, -210, -210, -212, -399, -208, -399, 387, -158,
+	-158, -289, -209, -200, -209, -241, -66, -65, 199, 196,
+	197, 200, -155, 342, 234, -242, 238, 70, 239, 376,
+	240, 203, 242, 243, 244, 214, 245, 246, 247, 369,
+	248, 249, 250, 251, 325, 6, 281, 35, 349, 296,
+	-384, -260, -92, -90, -93, -94, -96, -161, -163, 489,
+	-120, 60, 403, 404, 133, 136, 135, 7, -278, 368,
+	26, -251, -272, -268, 66, 369, -255, -276, 57, 122,
+	-326, -225, 35, -275, -275, -275, -276, -276, 121, -200,
+	-75, -200, -384, -259, -251, -200, -122, -120, -122, -201,
+	-198, -191, -157, 126, -156, 15, -195, 86, 83, 84,
+	85, -200, 29, 66, 125, -225, 102, -236, 95, 96,
+	97, 98, 99, 100, 101, 60, 60, 60, 60, 60,
+	60, 60, 60, -234, 60, 60, 60, 60, 60, 60,
+	60, 60, -234, 60, 60, 60, 60, 60, 66, 66,
+	108, 107, 118, 111, 112, 113, 114, 115, 116, 117,
+	109, 110, 105, 87, 103, 104, 89, 492, 493, -86,
+	-225, -231, -223, -223, -223, -223, -302, -229, -225, 60,
+	-174, 289, -174, -225, 60, 60, 60, -323, 60, 60,
+	-233, -234, 60, 66, 60, 60, 60, -223, 60, 60,
+	-157, -321, -232, -361, 494, -111, 61, -106, -200, -359,
+	-360, -106, -110, -200, -75, -75, -193, -194, -185, -190,
+	-197, -198, -191, 199, 26, 86, 29, 31, 310, 342,
+	89, 122, 20, 90, 158, 121, 312, 419, 311, 194,
+	52, 421, 423, 422, 412, 410, 361, 365, 367, 364,
+	411, 386, 35, 14, 32, 216, 27, 28, 115, 196,
+	93, 94, 219, 30, 217, 78, 23, 55, 15, 17,
+	18, 313, 360, 207, 206, 105, 378, 203, 50, 12,
+	124, 33, 102, 362, 45, 82, 47, 103, 21, 413,
+	414, 36, 377, 445, 223, 117, 314, 315, 53, 87,
+	368, 76, 57, 83, 19, 51, 104, 197, 418, 48,
+	366, 318, 320, 330, 443, 319, 201, 396, 10, 309,
+	420, 25, 215, 46, 202, 92, 205, 77, 222, 154,
+	6, 81, 13, 54, 58, 415, 416, 417, 38, 91,
+	16, 321, 449, 369, 379, 380, 381, 382, 383, 384,
+	385, 183, 184, 185, 186, 187, 264, 210, 208, 212,
+	213, 494, 495, 188, 189, 300, 302, 161, 191, 192,
+	193, 224, 139, 7, 347, 348, 349, 350, 352, 353,
+	354, 355, 356, 357, 358, 289, 23, -49, -371, 125,
+	126, -120, -107, 292, -281, -258, -255, -283, 113, 63,
+	-269, 126, 449, 501, 64, 205, -393, -392, 360, 61,
+	126, -330, 206, 418, -79, 374, 66, 502, 66, 126,
+	22, 125, 126, -99, 31, 32, 57, 30, -67, 295,
+	-120, 207, -120, -387, 347, -394, 126, 35, -392, -244,
+	58, -239, -240, -238, -242, -120, 15, -91, -121, -200,
+	60, 61, 126, -225, -158, -158, -158, -210, -201, -212,
+	-207, -212, -208, 125, -187, -200, 126, -397, -118, 209,
+	-215, -119, 210, 208, 212, -356, 81, 213, 264, 82,
+	203, -397, 203, 82, 208, 209, 205, 29, -120, -397,
+	-192, 95, -192, -186, 121, -192, -192, -192, -192, 241,
+	241, -192, -192, -192, -192, -192, -192, -192, -192, -192,
+	-192, -192, -192, -192, -192, 60, -387, -394, 25, -164,
+	-165, -166, -167, 45, 50, 52, 46, 47, 48, 49,
+	53, 25, 126, -400, 29, 60, -196, -202, 29, -197,
+	66, -198, -191, -84, -86, 134, 137, 137, 136, -251,
+	205, 60, 95, -276, -276, -276, 35, -199, 57, 57,
+	126, -158, -89, -90, -92, -225, -225, -225, -225, -200,
+	66, -200, 113, -219, 87, 303, 76, 77, 78, -220,
+	-237, 26, 274, 275, -230, -231, -225, -168, 27, 26,
+	-168, -168, -225, -168, 113, -231, -231, 61, 71, -363,
+	-364, 424, 425, 426, 427, 428, 429, 430, 431, 432,
+	433, 434, 314, 309, 315, 313, 307, 321, 316, 317,
+	157, 441, 442, 435, 436, 437, 438, 439, 440, -168,
+	-168, -168, -221, -168, -168, -225, -378, 301, 300, 302,
+	-168, -168, -168, -168, -221, -221, -221, -221, -221, -221,
+	-221, -221, -221, -221, -221, -221, -228, -235, -302, 60,
+	105, 103, 104, 89, -223, -221, -221, 66, 66, 61,
+	126, -366, -365, 91, -225, 60, -363, -230, -225, -230,
+	-230, 61, 61, -231, -230, -221, -230, -168, 66, -158,
+	126, 60, 61, 126, 38, 125, 126, 95, 61, 126,
+	125, 376, -200, -342, 61, 57, -283, 126, 205, 125,
+	125, -256, 328, -199, -258, 20, 449, -180, 33, 66,
+	66, -79, -225, -225, -200, -100, -120, 29, 66, -108,
+	87, 60, -389, 392, 349, -192, -261, -262, 366, -257,
+	232, 57, 285, 286, 287, 288, -238, -186, 121, 243,
+	60, -120, -91, -200, 15, 126, -383, 125, -1, -200,
+	-158, -210, -210, -212, -200, -65, -123, -180, -119, -200,
+	82, -355, -356, -241, -355, 82, 60, -200, -200, -200,
+	82, -200, -120, -120, -123, 71, 71, -192, -192, 71,
+	66, 66, 66, -192, -192, 71, 66, -202, 71, 71,
+	71, 71, 35, 66, -145, 35, 252, 256, 253, 254,
+	255, 71, 35, 71, 35, 71, 35, -200, 60, -357,
+	-358, 66, -389, -217, -216, 306, 36, -316, 369, 355,
+	356, -266, -265, -180, -93, -93, -93, -93, 45, 45,
+	45, 51, 45, 51, 45, 51, 45, -166, -266, -96,
+	-200, -225, -202, 61, 137, -258, -213, 66, -268, -120,
+	-120, -120, -160, 17, 125, -219, 303, 76, -302, 61,
+	126, -225, -225, -225, 29, -231, 61, 61, 61, 61,
+	15, -225, -225, -225, 105, -225, -225, 61, 15, 15,
+	-225, -225, -225, -225, -225, -231, -228, -223, -221, -221,
+	-226, 219, 86, -225, -224, -365, 93, -225, 126, 58,
+	-175, 224, 61, 15, 61, 61, 61, 58, 61, 126,
+	61, -225, 126, -160, -232, -328, -327, -326, 38, -83,
+	-106, -321, -200, -360, -326, -200, -193, -190, -198, -191,
+	71, -107, 15, -255, -251, 113, 113, 63, 329, -269,
+	66, 348, 66, -199, 202, 95, -80, 239, 125, 23,
+	66, 29, 30, -115, -116, -117, -123, -118, -390, 70,
+	-385, 66, -217, 368, 367, 363, -263, -264, 362, 364,
+	361, 365, -386, 63, -120, -192, -192, -192, -115, -109,
+	58, 125, -90, -121, -200, 113, 61, -158, -158, -210,
+	-126, -130, -127, -129, -128, -132, -131, 158, 159, 122,
+	162, 164, 165, 166, 167, 168, 169, 170, 171, 172,
+	173, 25, 174, 218, 154, 155, 156, 157, 175, 140,
+	160, 447, 183, 141, 184, 142, 185, 143, 186, 144,
+	145, 187, 146, 149, 150, 151, 148, -352, -353, -200,
+	-353, -200, -352, -352, -241, -225, 205, -140, -140, 66,
+	66, -141, 235, -122, 61, 126, 95, -390, -391, 70,
+	350, 124, 308, 35, 351, 352, 366, 304, 71, 71,
+	357, -158, 126, 95, -113, -112, 57, 58, -114, 57,
+	-112, 45, 45, 45, -158, -108, 126, 61, -159, 18,
+	20, -200, 113, -225, 61, 61, 61, -124, -126, 344,
+	345, 61, -225, 61, 61, 61, -225, 61, 61, -225,
+	-225, 15, 61, 61, 61, 61, 61, -226, 86, -223,
+	-220, 61, 94, -225, 92, -125, -131, 122, 158, 218,
+	157, 156, 154, 344, 345, -140, -103, 20, -225, -140,
+	-221, 61, 66, -159, 61, 126, -321, -258, -269, 369,
+	20, -120, 66, -333, 390, 66, -70, 10, 13, 14,
+	-83, -120, 61, 126, 66, -388, 360, -391, 86, -264,
+	66, 66, 66, 66, 60, -140, -142, -199, 122, 66,
+	61, -196, -90, -200, -158, -383, 125, -158, -137, -136,
+	-134, 76, 87, 35, 342, -135, 70, 121, 257, 235,
+	258, -154, -214, 57, 208, 81, 82, 330, -215, -309,
+	345, 344, -303, -305, 60, -304, 60, -304, -303, -303,
+	60, 60, -306, 60, -306, -306, -303, -307, 60, -307,
+	-308, 60, -307, 60, 58, 273, 60, 60, 60, -353,
+	61, -200, 236, 237, 61, -358, 66, 66, 66, 71,
+	35, 71, 124, 353, -317, -103, -265, -326, -225, 60,
+	-225, 66, -225, -231, 61, -133, 145, 144, -133, 61,
+	61, 61, 61, -225, -220, -225, 61, -303, -304, -303,
+	-306, -306, -133, -133, 61, -176, -177, 385, 266, -231,
+	61, 23, 61, 61, 61, -326, 66, -82, -331, -289,
+	503, 126, -339, 384, 379, 381, 205, -108, -138, -139,
+	-155, -117, 20, 449, -264, -246, -248, -180, 60, -77,
+	-76, -158, -158, -134, 76, -221, 66, -142, -143, 35,
+	256, 252, -144, 35, 236, 237, -146, 60, 264, 13,
+	82, 82, -120, -311, 346, 71, 71, -182, 66, -182,
+	71, 71, 71, -246, -354, 285, 286, 287, 299, 288,
+	-354, -246, -246, -246, 60, -271, -270, 265, 87, 307,
+	35, 71, 35, 354, -104, -107, 391, 61, 61, -178,
+	89, 353, 357, -223, -225, -338, 384, -334, -332, 379,
+	380, 381, 382, 66, -310, 496, -337, 379, 20, 20,
+	-253, 29, -285, 224, 126, -155, 66, 348, 66, 61,
+	126, -303, -225, -82, -103, 71, 60, -322, -247, 60,
+	61, 61, 61, 126, 61, 61, 61, 126, 61, 126,
+	61, 61, 61, 61, -246, 265, 307, 61, 60, -178,
+	494, 290, 291, 290, 291, 61, -340, 206, -336, 383,
+	-332, 20, 381, 20, 20, 95, -81, 76, 66, 20,
+	66, -335, 66, 58, -83, 20, -155, 369, -249, -248,
+	-99, 61, -104, 61, -225, -233, -149, -148, 445, -246,
+	66, 71, 71, -249, -249, -249, -249, 61, -98, -97,
+	-200, 86, -347, 60, 71, -337, 20, -335, 20, -335,
+	-335, 66, -147, 497, 29, 66, 381, 60, -286, 266,
+	267, -287, -293, 269, -250, 214, 70, 449, 283, 284,
+	-99, 61, -153, -151, -152, 57, 49, 262, 263, 61,
+	61, 61, -154, 61, 126, 23, -126, -178, -351, 25,
+	61, -346, -345, -181, -341, -200, 384, 385, 66, -335,
+	503, -183, 498, 71, 66, 20, -225, -291, 270, 60,
+	-289, 60, -289, 82, 286, -192, 66, 282, -270, -152,
+	57, -151, 57, 14, 13, 61, -97, 490, 491, 30,
+	-350, -349, -348, 61, 126, 125, 60, -335, 61, -288,
+	271, 71, -221, 60, -221, 60, -290, 268, 60, 71,
+	-198, -150, 259, 260, 25, 136, -150, -400, 66, 491,
+	126, 95, -345, -200, -184, -200, -295, 60, 20, 61,
+	-284, 61, -284, 60, 95, -221, 76, 35, 261, -200,
+	66, -349, 35, -225, 125, 61, 126, -296, -294, 224,
+	-287, 61, 61, -284, 71, 61, -200, -200, 61, 126,
+	63, -292, 272, 61, -294, -297, 38, 71, -301, -298,
+	60, -155, 226, 105, -301, -155, -300, -299, 271, 227,
+	60, 61, 126, 63, 223, 60, -231, -299, -298, -231,
+	61, 61,
 }
 
 var yyDef = [...]int{
-	23, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	25, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	20, 21, 22, 537, 538, 539, 540, 0, 343, 344,
-	0, 323, 0, 0, 0, 0, 272, 273, 274, 275,
-	276, 277, 278, 279, 280, 281, 282, 243, 244, 0,
-	192, 188, 167, 168, 169, 127, 128, 129, 130, 249,
-	0, 0, 413, -2, 541, 542, 543, 544, -2, 356,
-	0, 324, 325, 326, 327, 328, 329, 330, 331, 232,
-	233, 234, 229, 230, 231, -2, 0, 356, 181, 0,
-	172, 172, 0, 433, 0, 0, 444, 466, 23, 391,
-	0, 396, 721, 757, 758, 759, 1475, 1476, 1477, 1478,
+	20, 21, 22, 23, 24, 580, 581, 582, 583, 584,
+	585, 0, 374, 375, 0, 352, 0, 0, 0, 0,
+	299, 300, 301, 302, 303, 304, 305, 306, 307, 308,
+	309, 310, 247, 248, 249, 250, 0, 0, 0, 194,
+	190, 169, 170, 171, 129, 130, 131, 132, 276, 0,
+	0, 448, -2, 593, 594, 595, 596, -2, 387, 0,
+	353, 354, 355, 356, 357, 358, 359, 360, 361, 234,
+	235, 236, 231, 232, 233, -2, 0, 387, 183, 0,
+	174, 174, 0, 468, 0, 0, 479, 501, 25, 422,
+	0, 427, 790, 826, 827, 828, 1577, 1578, 1579, 1580,
+	1581, 1582, 1583, 1584, 1585, 1586, 1587, 1588, 1589, 1590,
+	1591, 1592, 1593, 1594, 1595, 1596, 1597, 1598, 1599, 1600,
+	1601, 1602, 1603, 1604, 1605, 1606, 1607, 1608, 1609, 1610,
+	1611, 1612, 1391, 1392, 1393, 1394, 1395, 1396, 1397, 1398,
+	1399, 1400, 1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408,
+	1409, 1410, 1411, 1412, 1413, 1414, 1415, 1416, 1417, 1418,
+	1419, 1420, 1421, 1422, 1423, 1424, 1425, 1426, 1427, 1428,
+	1429, 1430, 1431, 1432, 1433, 1434, 1435, 1436, 1437, 1438,
+	1439, 1440, 1441, 1442, 1443, 1444, 1445, 1446, 1447, 1448,
+	1449, 1450, 1451, 1452, 1453, 1454, 1455, 1456, 1457, 1458,
+	1459, 1460, 1461, 1462, 1463, 1464, 1465, 1466, 1467, 1468,
+	1469, 1470, 1471, 1472, 1473, 1474, 1475, 1476, 1477, 1478,
 	1479, 1480, 1481, 1482, 1483, 1484, 1485, 1486, 1487, 1488,
 	1489, 1490, 1491, 1492, 1493, 1494, 1495, 1496, 1497, 1498,
 	1499, 1500, 1501, 1502, 1503, 1504, 1505, 1506, 1507, 1508,
-	1509, 1510, 1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307,
-	1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317,
-	1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327,
-	1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337,
-	1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346, 1347,
-	1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356, 1357,
-	1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367,
-	1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377,
-	1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387,
-	1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395, 1396, 1397,
-	1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405, 1406, 1407,
-	1408, 1409, 1410, 1411, 1412, 1413, 1414, 1415, 1416, 1417,
-	1418, 1419, 1420, 1421, 1422, 1423, 1424, 1425, 1426, 1427,
-	1428, 1429, 1430, 1431, 1432, 1433, 1434, 1435, 1436, 1437,
-	1438, 1439, 1440, 1441, 1442, 1443, 1444, 1445, 1446, 1447,
-	1448, 1449, 1450, 1451, 1452, 1453, 1454, 1455, 1456, 1457,
-	1458, 1459, 1460, 1461, 1462, 1463, 1464, 193, 345, 346,
-	433, 433, 215, 0, 1342, 219, 0, 0, 0, 320,
-	211, 212, 213, 214, 0, 529, 0, 208, 0, 187,
-	1400, 0, 0, 0, 0, 0, 0, 88, 583, 90,
-	585, 94, 96, 97, 100, 101, 102, 103, 104, 105,
-	106, 107, 0, 109, 0, 111, 0, 114, 115, 116,
-	0, 125, 126, 586, 587, 68, -2, 0, 0, 0,
-	449, 452, 455, 458, 547, 612, 612, 612, 0, 612,
-	0, 631, 589, 590, 591, 610, 611, 361, 357, 358,
-	359, 360, 421, 0, 423, 426, 303, 303, 0, 0,
-	294, 295, 0, 312, 303, 303, 312, 0, 1127, 0,
-	0, 286, 287, 288, 292, 293, 313, 249, 249, 363,
-	182, 183, 171, 173, 0, 177, 0, 170, 1400, 0,
-	141, 0, 146, 0, 1349, 1414, 1364, 0, 0, 1382,
-	0, 165, -2, 428, 0, 434, 0, 433, 0, 249,
-	249, 249, 249, 249, 249, 249, 0, 0, 0, 467,
-	468, 463, 464, 465, 469, 470, 3, 0, 0, 395,
-	0, 428, -2, 0, 216, 760, 0, 0, 220, 221,
-	0, 0, 235, 0, 238, 224, 225, 226, 0, 0,
-	210, 0, 0, 189, 0, 0, 0, 250, 82, 0,
-	0, 0, 0, 0, 95, 124, 98, 99, 0, 119,
-	121, 123, 122, 108, 120, 110, 112, 113, 118, 0,
-	69, 416, 0, 397, 0, 446, 448, 0, 450, 451,
-	453, 454, 456, 457, 459, 460, 0, 612, 548, 0,
-	0, 0, 0, 0, 0, 612, 363, 362, 0, 422,
-	0, 0, 475, 0, 475, 0, 308, 309, 303, 303,
-	296, 305, 0, 310, 311, 0, 475, 475, 0, 0,
-	0, 612, 1128, 1129, 0, 0, 0, 364, 0, 0,
-	175, 0, 180, 131, 136, 134, 135, 137, 0, 0,
-	0, 0, 0, 163, 164, 0, 0, 0, 0, 0,
-	154, 157, 715, 716, 580, 581, 158, 159, 0, 397,
-	429, 445, 447, 442, 443, 0, 0, 0, 0, 0,
-	0, 0, 342, 483, 477, 479, 524, 31, 0, 1011,
-	1016, 757, 1021, -2, 1476, 1477, 1478, 1479, 1480, 1481,
-	1482, -2, 1484, -2, -2, 1487, 1488, 1489, 1491, -2,
-	-2, -2, -2, -2, -2, 1498, -2, -2, -2, 1502,
-	1503, -2, -2, 1506, 1507, 1508, 1509, 1510, -2, -2,
-	-2, -2, -2, -2, -2, -2, 1032, 828, 829, 830,
-	831, 0, 0, 0, 0, 0, 838, 839, 0, 852,
-	0, 845, 846, 847, 848, 849, 43, 44, 1051, 1052,
-	1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 977, 815,
-	0, 0, 0, 962, 0, 952, 0, 990, 0, 0,
-	0, 0, 0, 0, 45, 46, 968, 970, 971, 973,
-	974, 975, 976, 979, 980, 981, 984, 985, 986, 987,
-	988, 989, 992, 994, 996, 964, 965, 966, 967, 956,
-	957, 958, 959, 960, 961, 483, 365, 383, 367, 0,
-	372, 0, 722, 397, 419, 397, 0, 217, 0, 222,
-	0, 0, 237, 239, 240, 241, 227, 228, 321, 206,
-	207, 199, 200, 201, 202, 203, 204, 205, 209, 41,
-	0, 190, 191, 82, 0, 0, 83, 84, 85, 86,
-	87, 89, 73, 334, 336, 572, 584, 0, 575, 0,
-	92, 117, 65, 0, 0, 417, 430, 31, 435, 436,
-	439, 0, 0, 0, 550, 0, 247, 566, 0, 582,
-	604, 534, 535, 536, -2, 0, 0, 424, 0, 0,
-	511, 283, 0, 304, 284, 475, 475, 475, 303, 0,
-	305, 0, 305, 299, 300, 0, 314, 317, 315, 0,
-	0, 247, 488, 0, 489, 490, 486, 513, 516, 519,
-	0, 184, 185, 0, 0, 179, 0, 0, 138, 139,
-	140, 147, 142, 144, 0, 0, 148, 160, 161, 162,
-	389, 390, 0, 0, 0, 152, 153, 0, 166, 415,
-	332, 333, 337, 0, 339, 719, 340, 341, 530, 531,
-	475, 0, 484, 0, 480, 0, 0, 0, 0, 525,
-	0, 527, 0, 1010, 0, 0, 1040, 1041, 1042, 1043,
-	1044, 1045, 1046, 1002, 998, 998, 998, 0, 998, 0,
-	0, 937, 0, 0, 998, 998, 998, 998, 998, 938,
-	0, 998, 998, 998, 998, 951, 945, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, -2, 1004, 0, 834, 835, 836,
-	837, 840, 0, 853, 0, 0, 1002, 1002, 1002, 940,
-	0, 0, 942, 953, 0, 946, 1002, 0, 1002, 950,
-	998, 0, 475, 366, 380, 0, 384, 0, 0, 376,
-	378, 371, 373, 0, 0, 393, 418, 420, 761, 0,
-	1134, -2, 1136, -2, -2, 1138, 1139, 1140, 1141, 1142,
-	1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152,
-	1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162,
-	1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
-	1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182,
-	1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
-	1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202,
-	1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212,
-	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
-	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
-	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
-	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
-	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
-	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
-	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
-	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
-	1293, 1294, 1295, 1296, 1297, 1298, 1299, 0, 223, 236,
-	0, 0, 0, 0, 76, 569, 0, 77, 81, 71,
-	0, 0, 0, 574, 0, 571, 576, 0, 91, 0,
-	0, 66, 67, 626, 0, 399, 0, 0, 0, 0,
-	438, 440, 441, 426, 0, 0, 556, 1130, 613, 251,
-	0, 0, 568, 0, 0, 609, -2, 616, 0, 622,
-	0, 0, 0, 350, 354, 23, 427, 0, 476, 285,
-	289, 290, 475, 306, 303, 307, 303, 305, 0, 316,
-	319, 556, 251, 0, 0, 0, 0, 0, 504, 0,
-	0, 503, 0, 0, 0, 0, 0, 0, 517, 518,
-	520, 0, 522, 523, 532, 533, 0, 433, 186, 174,
-	176, 0, 133, 0, 0, 0, 149, 150, 151, 155,
-	156, 0, 0, 473, 478, 485, 488, 1006, 1007, 1008,
-	1009, 526, 528, 32, 481, 1012, 0, 1014, 1017, 1022,
-	1023, 1019, 0, 1037, -2, -2, 0, 1003, 1004, 0,
-	999, 1000, 0, 0, 0, 0, 0, 0, 0, 954,
-	0, 0, 908, 909, 910, 911, 912, 913, 914, 915,
-	916, 917, 918, 919, 920, 921, 922, 923, 924, 925,
-	926, 927, 928, 929, 930, 931, 932, 933, 934, 935,
-	936, 0, 0, 0, 0, 0, 0, 0, 903, 904,
-	905, 0, 0, 0, 0, 816, 817, 818, 819, 820,
-	821, 822, 823, 824, 825, 826, 827, 1024, 1035, 1036,
-	0, 0, 0, 0, 0, 1033, 1028, 0, 832, 0,
-	850, 854, 0, 0, 997, 0, 1004, 0, 0, 963,
-	941, 0, 0, 0, 0, 0, 0, 473, 383, 385,
-	0, 0, 383, 0, 0, 0, 392, 0, 0, 0,
-	322, 42, 242, 0, 0, 0, 0, 0, 335, 0,
-	573, 73, 0, 0, 93, 0, 0, 403, 0, 431,
-	432, 32, 437, 0, 0, 723, 559, 557, 558, 0,
-	1131, 563, 252, 253, 254, 255, 0, 0, 0, 567,
-	0, 0, 605, 606, 607, 608, 617, 1130, 1130, 1130,
-	723, 391, 0, -2, 0, 0, 352, 0, 0, 512,
-	297, 475, 475, 303, 318, 559, 563, 475, 196, 0,
-	507, 501, 0, 494, 505, 506, 497, 0, 499, 0,
-	495, 496, 475, 487, 426, 521, 515, 178, 132, 0,
-	271, 143, 338, 720, 471, 0, 0, 1013, 1015, 1018,
-	1020, 890, 0, 0, 0, 0, 0, 0, 879, 873,
-	874, 955, 0, 0, 0, 0, 0, 0, 898, 0,
-	0, 0, 0, 0, 0, 0, 0, 1025, 1033, 1029,
-	0, 1026, 0, 0, 1005, 0, 855, 0, 0, 0,
-	0, 891, 0, 895, 939, 943, 0, 947, 0, 949,
-	0, 0, 471, 381, 0, 386, 387, 383, 370, 377,
-	369, 379, 374, 375, 394, 762, 1135, 1132, 1133, 218,
-	0, 570, 73, 78, 79, 80, 74, 72, 577, 0,
-	579, 0, 0, 405, 54, 0, 0, 0, 0, 0,
-	0, 724, 725, 727, 728, 0, 0, 730, 785, 0,
-	739, 612, 739, 0, 0, 741, 742, 549, 0, 0,
-	552, 561, 0, 0, 0, 257, 258, 0, 0, 0,
-	270, 266, 267, 268, 248, 562, 0, 0, 0, 0,
-	0, 519, 0, 0, 475, 351, 354, 355, 425, 301,
-	298, 475, 245, 246, 433, 0, 0, 491, 508, 0,
-	0, 492, 0, 493, 498, 500, 195, 514, 145, 461,
-	0, 0, 33, 482, 1005, 875, 876, 877, 0, 857,
-	869, 869, 1122, 1062, 1063, 1064, 1109, 1066, 1113, 1113,
-	1109, 1109, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101,
-	1102, 0, 0, 1105, 1085, 1109, 1111, 1111, 1109, 1106,
-	1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076,
-	1077, 1078, 1079, 1080, 1116, 1116, 1119, 1116, 878, 0,
-	897, 880, 881, 884, 882, 0, 0, 0, 896, 885,
-	886, 872, 1001, 1027, 0, 1034, 1030, 833, 841, 851,
-	0, 0, 860, 1109, 1113, 863, 1109, 1111, 1109, 869,
-	869, 0, 0, 0, 0, 883, 0, 462, 382, 0,
-	368, 75, 70, 578, 56, 0, 407, 0, 404, 0,
-	0, 0, 545, 426, -2, 0, -2, 729, 786, 751,
-	751, 740, 751, 751, 612, 0, 560, 551, 0, 564,
-	565, 0, 259, 260, 0, 263, 269, 0, 618, 619,
-	717, 718, 620, 0, 475, 475, -2, 348, 353, 0,
-	302, 428, 197, 198, 509, 0, 502, 472, 474, 842,
-	858, 870, 871, 859, 1125, 1123, 1124, 1065, 1110, 0,
-	1090, 0, 1091, 1092, 1093, 0, 0, 1086, 1087, 0,
-	1088, 1089, 1081, 0, 1082, 1083, 0, 1084, 894, 899,
-	900, 0, 1031, 856, 843, 861, 862, 864, 865, 866,
-	867, 868, 844, 892, 0, 944, 948, 902, 388, 24,
-	50, 0, 627, 0, 409, 0, 406, 0, 400, 402,
-	64, 0, 632, -2, 671, 1130, 1130, 0, 1130, 1130,
-	1130, 1130, 0, 0, 1130, 1130, 1130, 1130, 1130, 1130,
-	1130, 1130, 1130, 1130, 1130, 1130, 1130, 1130, 0, 726,
-	753, -2, 765, 767, 0, 0, 770, 771, 0, 0,
-	0, 0, 807, 777, 0, 0, 0, 1049, 1050, 0,
-	784, 0, 743, 752, 0, 752, 0, 0, 751, 0,
-	0, 0, 256, 261, 0, 264, 265, 0, 600, 1109,
-	0, 624, 56, 433, 349, 194, 0, 1061, 1126, 0,
-	0, 0, 1107, 0, 0, 0, 0, 901, 0, 47,
-	52, 57, 58, 0, 0, 0, 0, 0, 398, 0,
-	408, 55, 0, 0, 623, 0, 621, 673, 0, 0,
-	1130, 1130, 0, 0, 0, 0, 1130, 1130, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 766, 768, 769, 772, 773, 774, 812,
-	813, 814, 775, 809, 810, 811, 776, 0, 0, 0,
-	1047, 1048, 805, 0, 0, 0, 0, 0, 0, 0,
-	737, 553, 0, 555, 262, 593, 0, 439, 0, 625,
-	428, 510, 1115, 1114, 1103, 0, 1104, 1112, 1117, 0,
-	1120, 0, 893, 34, 0, 54, 0, 59, 0, 0,
-	0, 0, 0, 0, 0, 546, 667, 672, 674, 675,
-	0, 0, 678, 679, 680, 681, 0, 0, 684, 685,
-	686, 687, 688, 689, 690, 691, 692, 693, 709, 710,
-	711, 712, 713, 714, 694, 695, 696, 697, 698, 699,
-	706, 0, 0, 703, 0, 0, 0, 952, 800, 0,
-	0, 744, 746, 747, 748, 749, 750, 745, 0, 0,
-	0, 0, 736, 738, 781, 0, 554, 592, 601, 602,
-	439, 347, 1108, 0, 0, 25, 0, 0, 51, 0,
-	60, 0, 62, 63, 0, 0, 411, 401, 656, 0,
-	0, 662, 0, 668, 676, 677, 682, 683, 700, 0,
-	0, 702, 0, 0, 808, 0, 780, 788, 801, 0,
-	0, 593, 593, 593, 593, 0, 782, 594, 1130, 0,
-	0, 598, 599, 603, 1118, 1121, 629, 0, 35, 0,
-	37, 39, 40, 754, 48, 49, 53, 61, 628, 410,
-	0, 634, 0, 0, 0, 0, 0, 665, 0, 707,
-	708, 701, 704, 705, 778, 787, 789, 790, 0, 802,
-	803, 804, 806, 731, 732, 733, 734, 0, 0, 596,
-	0, 26, 27, 0, 36, 0, 0, 412, 636, 0,
-	657, 0, 0, 0, 0, 0, 0, 0, 779, 791,
-	0, 792, 0, 0, 0, 735, 595, 597, 0, 0,
-	38, 755, 633, 0, 667, 658, 0, 660, 0, 0,
-	0, 0, 793, 795, 796, 0, 0, 794, 28, 29,
-	30, 0, 0, 638, 0, 654, 659, 661, 0, 666,
-	664, 797, 799, 798, 756, 637, 0, 650, 635, 0,
-	663, 639, -2, 0, 655, 640, -2, 0, 648, 0,
-	0, 641, 649, 0, 644, 0, 0, 0, 643, 0,
-	-2, 651, 0, 0, 645, -2, 0, 653, 652,
+	1509, 1510, 1511, 1512, 1513, 1514, 1515, 1516, 1517, 1518,
+	1519, 1520, 1521, 1522, 1523, 1524, 1525, 1526, 1527, 1528,
+	1529, 1530, 1531, 1532, 1533, 1534, 1535, 1536, 1537, 1538,
+	1539, 1540, 1541, 1542, 1543, 1544, 1545, 1546, 1547, 1548,
+	1549, 1550, 1551, 1552, 1553, 1554, 1555, 1556, 1557, 1558,
+	1559, 1560, 1561, 1562, 1563, 1564, 1565, 1566, 1567, 195,
+	376, 377, 468, 468, 217, 0, 1436, 221, 0, 0,
+	0, 349, 213, 214, 215, 216, 0, 572, 0, 210,
+	0, 0, 0, 189, 1494, 0, 0, 0, 0, 0,
+	0, 90, 649, 92, 651, 96, 98, 99, 102, 103,
+	104, 105, 106, 107, 108, 109, 0, 111, 0, 113,
+	0, 116, 117, 118, 0, 127, 128, 652, 653, 70,
+	-2, 0, 0, 0, 484, 487, 490, 493, 678, 678,
+	599, 678, 678, 678, 0, 678, 0, 700, 655, 656,
+	657, 676, 677, 392, 388, 389, 390, 391, 456, 0,
+	458, 461, 332, 332, 332, 0, 0, 323, 324, 0,
+	341, 332, 332, 341, 0, 1217, 0, 0, 315, 316,
+	317, 321, 322, 342, 0, 0, 276, 276, 394, 184,
+	185, 173, 175, 0, 179, 0, 172, 1494, 0, 143,
+	0, 148, 0, 1443, 1508, 1458, 0, 0, 1476, 0,
+	167, -2, 463, 0, 469, 0, 468, 0, 276, 276,
+	276, 276, 276, 276, 276, 276, 0, 0, 0, 502,
+	503, 498, 499, 500, 504, 505, 3, 0, 0, 426,
+	0, 463, -2, 0, 218, 829, 0, 0, 222, 223,
+	0, 0, 237, 0, 240, 226, 227, 228, 0, 0,
+	212, 0, 0, 244, 271, 191, 0, 0, 0, 277,
+	84, 0, 0, 0, 0, 0, 97, 126, 100, 101,
+	0, 121, 123, 125, 124, 110, 122, 112, 114, 115,
+	120, 0, 71, 451, 0, 428, 0, 481, 483, 0,
+	485, 486, 488, 489, 491, 492, 494, 495, 0, 0,
+	0, 0, 678, 600, 0, 0, 0, 0, 0, 678,
+	394, 393, 0, 457, 0, 0, 311, 0, 510, 510,
+	0, 337, 338, 332, 332, 325, 334, 0, 339, 340,
+	0, 510, 510, 0, 0, 0, 678, 1218, 1219, 687,
+	0, 0, 0, 0, 395, 0, 0, 177, 0, 182,
+	133, 138, 136, 137, 139, 0, 0, 0, 0, 0,
+	165, 166, 0, 0, 0, 0, 0, 156, 159, 784,
+	785, 646, 647, 160, 161, 0, 428, 464, 480, 482,
+	477, 478, 0, 0, 0, 0, 0, 0, 0, 0,
+	373, 518, 512, 514, 567, 33, 0, 1101, 1106, 826,
+	1111, -2, 1578, 1579, 1580, 1581, 1582, 1583, 1584, -2,
+	1586, -2, -2, 1589, 1590, 1591, 1593, -2, 1595, -2,
+	-2, -2, -2, 1600, -2, -2, -2, 1604, 1605, -2,
+	-2, 1608, 1609, 1610, 1611, 1612, -2, -2, -2, -2,
+	-2, -2, -2, -2, 1122, 897, 898, 901, 902, 0,
+	0, 0, 0, 0, 909, 910, 0, 924, 0, 916,
+	917, 918, 919, 920, 921, 45, 46, 1141, 1142, 1143,
+	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1065, 884, 0,
+	0, 0, 1050, 0, 1040, 0, 1080, 0, 0, 0,
+	0, 0, 0, 47, 48, 1056, 1058, 1059, 1061, 1062,
+	1063, 1064, 1067, 1068, 1069, 1070, 1073, 1074, 1075, 1076,
+	1077, 1078, 1079, 1082, 1084, 1086, 1052, 1053, 1054, 1055,
+	1044, 1045, 1046, 1047, 1048, 1049, 518, 396, 414, 398,
+	0, 403, 0, 791, 428, 454, 428, 0, 219, 0,
+	224, 0, 0, 239, 241, 242, 243, 229, 230, 350,
+	208, 209, 201, 202, 203, 204, 205, 206, 207, 211,
+	43, 0, 0, 0, 192, 193, 84, 0, 0, 85,
+	86, 87, 88, 89, 91, 75, 365, 367, 637, 650,
+	0, 640, 0, 94, 119, 67, 693, 0, 0, 452,
+	465, 33, 470, 471, 474, 0, 0, 591, 0, 0,
+	0, 602, 274, 631, 0, 648, 670, 577, 578, 579,
+	-2, 0, 0, 459, 0, 0, 548, 333, 312, 0,
+	313, 510, 510, 510, 332, 0, 334, 0, 334, 328,
+	329, 0, 343, 346, 344, 0, 251, 252, 266, 266,
+	0, 266, 265, 1220, 1220, 0, 1220, 1220, 1220, 1220,
+	0, 0, 1220, 1220, 1220, 1220, 1220, 1220, 1220, 1220,
+	1220, 1220, 1220, 1220, 1220, 1220, 0, 688, 269, 270,
+	0, 274, 523, 0, 524, 525, 521, 550, 559, 0,
+	562, 0, 186, 187, 0, 0, 181, 0, 0, 140,
+	141, 142, 149, 144, 146, 0, 0, 150, 162, 163,
+	164, 420, 421, 0, 0, 0, 154, 155, 0, 168,
+	450, 0, 363, 364, 368, 0, 370, 788, 371, 372,
+	573, 574, 510, 0, 519, 0, 515, 0, 0, 0,
+	0, 568, 0, 570, 0, 1100, 0, 0, 1130, 1131,
+	1132, 1133, 1134, 1135, 1136, 1092, 1088, 1088, 1088, 0,
+	1088, 0, 0, 1025, 0, 0, 1088, 1088, 1088, 0,
+	1088, 1088, 1026, 0, 1088, 1088, 1088, 1088, 1039, 1033,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, -2,
+	1094, 0, 905, 906, 907, 908, 911, 0, 925, 0,
+	959, 0, 960, 0, 1092, 1092, 1092, 1028, 0, 0,
+	1030, 1041, 0, 1034, 1092, 0, 1092, 1038, 1088, 0,
+	510, 397, 411, 0, 415, 0, 0, 407, 409, 402,
+	404, 0, 0, 424, 453, 455, 830, 0, 1224, -2,
+	1226, -2, -2, 1228, 1229, 1230, 1231, 1232, 1233, 1234,
+	1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244,
+	1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254,
+	1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264,
+	1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274,
+	1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284,
+	1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294,
+	1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304,
+	1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314,
+	1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324,
+	1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334,
+	1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344,
+	1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354,
+	1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364,
+	1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374,
+	1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384,
+	1385, 1386, 1387, 1388, 1389, 1390, 0, 225, 238, 0,
+	0, 268, 0, 0, 0, 78, 634, 0, 79, 83,
+	73, 0, 0, 0, 639, 0, 636, 641, 0, 93,
+	0, 0, 68, 69, 692, 0, 695, 0, 693, 0,
+	0, 0, 0, 473, 475, 476, 0, 679, 0, 0,
+	461, 0, 0, 608, 1220, 618, 0, 0, 633, 0,
+	0, 675, -2, 682, 0, 0, 0, 0, 381, 385,
+	25, 462, 0, 511, 314, 318, 319, 510, 335, 332,
+	336, 332, 334, 0, 345, 348, 687, 0, 255, 267,
+	0, 799, 854, 0, 808, 678, 808, 0, 0, 810,
+	811, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	0, 1221, 0, 1220, 1220, 0, 0, 0, 0, 1220,
+	1220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 608, 278, 0, 0,
+	0, 0, 0, 541, 0, 0, 540, 0, 0, 0,
+	0, 0, 0, 0, 560, 0, 561, 563, 0, 565,
+	566, 575, 576, 0, 468, 188, 176, 178, 0, 135,
+	0, 0, 0, 151, 152, 153, 157, 158, 0, 0,
+	0, 508, 513, 520, 523, 1096, 1097, 1098, 1099, 569,
+	571, 34, 516, 1102, 0, 1104, 1107, 1112, 1113, 1109,
+	0, 1127, -2, -2, 0, 1093, 1094, 0, 1089, 1090,
+	0, 0, 0, 0, 0, 0, 0, 1042, 0, 0,
+	996, 997, 998, 999, 1000, 1001, 1002, 1003, 1004, 1005,
+	1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015,
+	1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 0,
+	0, 0, 0, 0, 0, 0, 0, 991, 992, 993,
+	0, 0, 0, 0, 885, 886, 887, 888, 889, 890,
+	891, 892, 893, 894, 895, 896, 1114, 1125, 1126, 0,
+	0, 0, 0, 0, 1123, 1118, 0, 899, 900, 903,
+	0, 922, 926, 0, 0, 962, 1087, 0, 1094, 0,
+	0, 1051, 1029, 0, 0, 0, 0, 0, 0, 508,
+	414, 416, 0, 0, 414, 0, 0, 0, 423, 0,
+	0, 0, 351, 44, 245, 0, 0, 0, 0, 0,
+	0, 366, 0, 638, 75, 0, 0, 95, 0, 694,
+	0, 430, 466, 467, 34, 472, 0, 0, 592, 0,
+	0, 792, 611, 609, 610, 0, 278, 619, 0, 632,
+	0, 0, 671, 672, 673, 674, 683, 1220, 1220, 1220,
+	792, 422, 0, -2, 0, 0, 383, 0, 0, 549,
+	326, 510, 510, 332, 347, 253, 254, 0, 798, 855,
+	820, 820, 809, 820, 820, 678, 0, 256, 257, 258,
+	259, 0, 261, 262, 264, 743, 744, 0, 0, 747,
+	748, 749, 750, 0, 0, 753, 754, 755, 756, 757,
+	758, 759, 760, 761, 762, 778, 779, 780, 781, 782,
+	783, 763, 764, 765, 766, 767, 768, 775, 0, 0,
+	772, 0, 611, 615, 279, 280, 281, 282, 0, 0,
+	0, 510, 198, 0, 544, 538, 0, 529, 542, 543,
+	532, 0, 534, 0, 536, 0, 530, 531, 510, 522,
+	461, 0, 564, 558, 180, 134, 0, 298, 145, 362,
+	369, 789, 506, 0, 0, 1103, 1105, 1108, 1110, 977,
+	0, 0, 0, 0, 0, 0, 951, 945, 946, 1043,
+	0, 0, 0, 0, 0, 0, 0, 986, 0, 0,
+	0, 0, 0, 0, 0, 0, 1115, 1123, 1119, 0,
+	1116, 0, 0, 1095, 0, 927, 0, 0, 0, 0,
+	468, 0, 978, 0, 983, 1027, 1031, 0, 1035, 0,
+	1037, 0, 0, 506, 412, 0, 417, 418, 414, 401,
+	408, 400, 410, 405, 406, 425, 831, 1225, 1222, 1223,
+	220, 246, 0, 635, 75, 80, 81, 82, 76, 74,
+	642, 0, -2, 0, 0, 0, 434, 0, 0, 0,
+	590, 0, 0, 0, 793, 794, 796, 797, 601, 0,
+	0, 604, 615, 620, 621, 622, 623, 624, 0, 0,
+	0, 0, 275, 614, 0, 0, 0, 0, 0, 562,
+	0, 0, 510, 382, 385, 386, 460, 330, 327, 510,
+	-2, 1212, 1152, 1153, 1154, 1199, 1156, 1203, 1203, 1199,
+	1199, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
+	0, 0, 1195, 1175, 1201, 1201, 1201, 1199, 1196, 1157,
+	1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167,
+	1168, 1169, 1170, 1206, 1206, 1209, 1206, 0, 812, 821,
+	0, 821, 0, 0, 820, 0, 0, 745, 746, 751,
+	752, 769, 0, 0, 771, 0, 0, 272, 273, 0,
+	0, 0, 284, 285, 0, 0, 0, 297, 293, 294,
+	295, 468, 0, 0, 526, 545, 0, 0, 527, 0,
+	528, 533, 535, 537, 197, 551, 0, 147, 496, 0,
+	0, 35, 517, 1095, 947, 948, 949, 0, 929, 941,
+	941, 950, 0, 985, 952, 953, 0, 956, 954, 0,
+	0, 0, 984, 957, 958, 944, 1091, 1117, 0, 1124,
+	1120, 904, 912, 923, 0, 0, 932, 1199, 1203, 935,
+	1199, 1201, 1201, 941, 941, 0, 964, 0, 0, 0,
+	0, 955, 0, 497, 413, 0, 399, 77, 72, 643,
+	0, 58, 0, 438, 0, 431, 0, 587, 588, 589,
+	597, 461, -2, 0, 612, 603, 0, 613, 0, 626,
+	627, 628, 629, 630, 0, 684, 685, 786, 787, 686,
+	0, 510, 510, -2, 379, 384, 0, 331, 822, -2,
+	834, 836, 0, 0, 839, 840, 0, 0, 0, 0,
+	876, 846, 0, 0, 0, 1139, 1140, 0, 853, 1215,
+	1213, 1214, 1155, 1200, 0, 1180, 0, 1181, 1182, 1183,
+	0, 0, 1176, 0, 1177, 1178, 1179, 1171, 0, 1172,
+	1173, 0, 1174, 0, 0, 0, 0, 0, 0, 0,
+	806, 260, 776, 777, 770, 773, 774, 616, 617, 0,
+	286, 287, 0, 290, 296, 463, 199, 200, 546, 0,
+	539, 0, 507, 509, 913, 930, 942, 943, 931, 981,
+	982, 987, 988, 0, 1121, 928, 914, 933, 934, 936,
+	937, 938, 939, 940, 915, 0, 0, 967, 968, 963,
+	979, 0, 1032, 1036, 990, 419, 645, 26, 52, 0,
+	696, 0, 440, 56, 0, 0, 0, 0, 701, -2,
+	740, 795, 0, 0, 625, 0, 666, 1199, 0, 690,
+	58, 468, 380, 835, 837, 838, 841, 842, 843, 881,
+	882, 883, 844, 878, 879, 880, 845, 0, 0, 0,
+	1137, 1138, 874, 1151, 1216, 0, 0, 0, 1197, 0,
+	0, 0, 0, 0, 813, 815, 816, 817, 818, 819,
+	814, 0, 0, 0, 0, 805, 807, 850, 0, 283,
+	288, 0, 291, 292, 196, 0, 0, 989, 961, 965,
+	0, 0, 0, 0, 0, 49, 54, 59, 60, 0,
+	0, 0, 0, 0, 432, 0, 439, 0, 0, 0,
+	0, 0, 689, 0, 687, 742, 605, 0, 607, 659,
+	0, 474, 0, 691, 463, 0, 0, 1040, 869, 0,
+	1205, 1204, 1193, 0, 1194, 1202, 1207, 0, 1210, 0,
+	659, 659, 659, 659, 0, 851, 289, 547, 0, 0,
+	969, 970, 971, 972, 973, 980, 36, 0, 56, 0,
+	61, 0, 0, 0, 0, 0, 442, 0, 441, 0,
+	435, 437, 66, 0, 598, 736, 741, 606, 658, 667,
+	668, 474, 378, 877, 0, 849, 857, 870, 0, 0,
+	1198, 0, 0, 800, 801, 802, 803, 0, 0, 553,
+	0, 0, 27, 0, 0, 53, 0, 62, 0, 64,
+	65, 0, 444, 0, 0, 57, 0, 0, 725, 0,
+	0, 731, 0, 737, 660, 1220, 0, 0, 664, 665,
+	669, 847, 856, 858, 859, 0, 871, 872, 873, 875,
+	1208, 1211, 804, 0, 0, 0, 0, 966, 698, 0,
+	37, 0, 39, 41, 42, 823, 50, 51, 55, 63,
+	697, 429, 0, 443, 433, 0, 0, 703, 0, 0,
+	0, 0, 0, 734, 0, 0, 662, 0, 848, 860,
+	0, 861, 0, 0, 0, 559, 554, 555, 0, 0,
+	28, 29, 0, 38, 0, 0, 0, 436, 586, 705,
+	0, 726, 0, 0, 0, 0, 0, 0, 0, 661,
+	663, 862, 864, 865, 0, 0, 863, 0, 556, 0,
+	0, 0, 40, 824, 0, 446, 702, 0, 736, 727,
+	0, 729, 0, 0, 0, 0, 866, 868, 867, 552,
+	557, 30, 31, 32, 0, 445, 0, 0, 707, 0,
+	723, 728, 730, 0, 735, 733, 825, 447, 706, 0,
+	719, 704, 0, 732, 708, -2, 0, 724, 709, -2,
+	0, 717, 0, 0, 710, 718, 0, 713, 0, 0,
+	0, 712, 0, -2, 720, 0, 0, 714, -2, 0,
+	722, 721,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 87, 3, 3, 3, 115, 107, 3,
-	59, 60, 112, 110, 125, 111, 124, 113, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 484,
-	95, 94, 96, 3, 485, 3, 3, 3, 3, 3,
+	3, 3, 3, 88, 3, 3, 3, 116, 108, 3,
+	60, 61, 113, 111, 126, 112, 125, 114, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 500,
+	96, 95, 97, 3, 501, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 117, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 118, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 486, 106, 487, 118,
+	3, 3, 3, 502, 107, 503, 119,
 }
 
 var yyTok2 = [...]int{
@@ -5050,12 +5533,12 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 55, 56, 57, 58, 61, 62, 63,
+	52, 53, 54, 55, 56, 57, 58, 59, 62, 63,
 	64, 65, 66, 67, 68, 69, 70, 71, 72, 73,
 	74, 75, 76, 77, 78, 79, 80, 81, 82, 83,
-	84, 85, 86, 88, 89, 90, 91, 92, 93, 97,
-	98, 99, 100, 101, 102, 103, 104, 105, 108, 109,
-	114, 116, 119, 120, 121, 122, 123, 126, 127, 128,
+	84, 85, 86, 87, 89, 90, 91, 92, 93, 94,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 109,
+	110, 115, 117, 120, 121, 122, 123, 124, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -5115,7 +5598,11 @@ var yyTok3 = [...]int{
 	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
 	57795, 470, 57796, 471, 57797, 472, 57798, 473, 57799, 474,
 	57800, 475, 57801, 476, 57802, 477, 57803, 478, 57804, 479,
-	57805, 480, 57806, 481, 57807, 482, 57808, 483, 0,
+	57805, 480, 57806, 481, 57807, 482, 57808, 483, 57809, 484,
+	57810, 485, 57811, 486, 57812, 487, 57813, 488, 57814, 489,
+	57815, 490, 57816, 491, 57817, 492, 57818, 493, 57819, 494,
+	57820, 495, 57821, 496, 57822, 497, 57823, 498, 57824, 499,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5465,7 +5952,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:543
+//line mysql_sql.y:581
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -5473,32 +5960,32 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:549
+//line mysql_sql.y:587
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 22:
+	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:575
+//line mysql_sql.y:615
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 25:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:579
+//line mysql_sql.y:619
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 26:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:585
+//line mysql_sql.y:625
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -5509,42 +5996,42 @@ yydefault:
 			yyLOCAL.(*tree.Load).Param.Tail = yyDollar[9].tailParamUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 27:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:596
+//line mysql_sql.y:636
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 28:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:600
+//line mysql_sql.y:640
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:606
+//line mysql_sql.y:646
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 30:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:610
+//line mysql_sql.y:650
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 31:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:616
+//line mysql_sql.y:656
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -5552,10 +6039,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:623
+//line mysql_sql.y:663
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -5563,58 +6050,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:632
+//line mysql_sql.y:672
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 34:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:636
+//line mysql_sql.y:676
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 35:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:640
+//line mysql_sql.y:680
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 36:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:645
+//line mysql_sql.y:685
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 37:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:649
+//line mysql_sql.y:689
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 38:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:653
+//line mysql_sql.y:693
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:659
+//line mysql_sql.y:699
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -5624,10 +6111,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:668
+//line mysql_sql.y:708
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -5637,58 +6124,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:679
+//line mysql_sql.y:719
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:683
+//line mysql_sql.y:723
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:689
+//line mysql_sql.y:729
 		{
 			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 44:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:693
+//line mysql_sql.y:733
 		{
 			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:699
+//line mysql_sql.y:739
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:703
+//line mysql_sql.y:743
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:709
+//line mysql_sql.y:749
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5711,10 +6198,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:733
+//line mysql_sql.y:773
 		{
 			//        vs := strings.Split($1, ".")
 			//        var r string
@@ -5733,42 +6220,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 47:
+	case 49:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:752
+//line mysql_sql.y:792
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 48:
+	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:756
+//line mysql_sql.y:796
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 49:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:760
+//line mysql_sql.y:800
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 50:
+	case 52:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:765
+//line mysql_sql.y:805
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:769
+//line mysql_sql.y:809
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -5776,42 +6263,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 54:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:777
+//line mysql_sql.y:817
 		{
 			yyVAL.str = ""
 		}
-	case 53:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:781
+//line mysql_sql.y:821
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 54:
+	case 56:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:786
+//line mysql_sql.y:826
 		{
 			yyVAL.str = "\n"
 		}
-	case 55:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:790
+//line mysql_sql.y:830
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 56:
+	case 58:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:795
+//line mysql_sql.y:835
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 59:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:799
+//line mysql_sql.y:839
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -5834,36 +6321,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:823
+//line mysql_sql.y:863
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 61:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:827
+//line mysql_sql.y:867
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:833
+//line mysql_sql.y:873
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 63:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:839
+//line mysql_sql.y:879
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -5882,10 +6369,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:857
+//line mysql_sql.y:897
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5903,10 +6390,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:874
+//line mysql_sql.y:914
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5924,50 +6411,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 67:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:897
+//line mysql_sql.y:937
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:901
+//line mysql_sql.y:941
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:905
+//line mysql_sql.y:945
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 70:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:910
+//line mysql_sql.y:950
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:914
+//line mysql_sql.y:954
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 72:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:920
+//line mysql_sql.y:960
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypePrivilege,
@@ -5981,10 +6468,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 73:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:933
+//line mysql_sql.y:973
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeRole,
@@ -5996,10 +6483,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 74:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:944
+//line mysql_sql.y:984
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeProxy,
@@ -6012,26 +6499,26 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 75:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:957
+//line mysql_sql.y:997
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:961
+//line mysql_sql.y:1001
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 77:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:971
+//line mysql_sql.y:1011
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypePrivilege,
@@ -6045,10 +6532,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 78:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:984
+//line mysql_sql.y:1024
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypeRole,
@@ -6060,30 +6547,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:997
+//line mysql_sql.y:1037
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1003
+//line mysql_sql.y:1043
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1009
+//line mysql_sql.y:1049
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR,
@@ -6091,10 +6578,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1016
+//line mysql_sql.y:1056
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE,
@@ -6103,10 +6590,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1024
+//line mysql_sql.y:1064
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -6114,74 +6601,74 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 84:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1032
+//line mysql_sql.y:1072
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1036
+//line mysql_sql.y:1076
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1040
+//line mysql_sql.y:1080
 		{
 			yyLOCAL = tree.OBJECT_TYPE_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1044
+//line mysql_sql.y:1084
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1048
+//line mysql_sql.y:1088
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1052
+//line mysql_sql.y:1092
 		{
 			yyLOCAL = tree.OBJECT_TYPE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1058
+//line mysql_sql.y:1098
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1062
+//line mysql_sql.y:1102
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1068
+//line mysql_sql.y:1108
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -6189,10 +6676,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 93:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1075
+//line mysql_sql.y:1115
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -6200,418 +6687,418 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1084
+//line mysql_sql.y:1124
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1088
+//line mysql_sql.y:1128
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1094
+//line mysql_sql.y:1134
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1098
+//line mysql_sql.y:1138
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1102
+//line mysql_sql.y:1142
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1106
+//line mysql_sql.y:1146
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1110
+//line mysql_sql.y:1150
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1114
+//line mysql_sql.y:1154
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1118
+//line mysql_sql.y:1158
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1122
+//line mysql_sql.y:1162
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1126
+//line mysql_sql.y:1166
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1130
+//line mysql_sql.y:1170
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1134
+//line mysql_sql.y:1174
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1138
+//line mysql_sql.y:1178
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1142
+//line mysql_sql.y:1182
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1146
+//line mysql_sql.y:1186
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1150
+//line mysql_sql.y:1190
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1154
+//line mysql_sql.y:1194
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1158
+//line mysql_sql.y:1198
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1162
+//line mysql_sql.y:1202
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1166
+//line mysql_sql.y:1206
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1170
+//line mysql_sql.y:1210
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1174
+//line mysql_sql.y:1214
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1178
+//line mysql_sql.y:1218
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1182
+//line mysql_sql.y:1222
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1186
+//line mysql_sql.y:1226
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1190
+//line mysql_sql.y:1230
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 119:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1194
+//line mysql_sql.y:1234
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 120:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1198
+//line mysql_sql.y:1238
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 121:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1202
+//line mysql_sql.y:1242
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 122:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1206
+//line mysql_sql.y:1246
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1210
+//line mysql_sql.y:1250
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1214
+//line mysql_sql.y:1254
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1218
+//line mysql_sql.y:1258
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1222
+//line mysql_sql.y:1262
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1234
+//line mysql_sql.y:1274
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 134:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1240
+//line mysql_sql.y:1280
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1248
+//line mysql_sql.y:1288
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1252
+//line mysql_sql.y:1292
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1256
+//line mysql_sql.y:1296
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1260
+//line mysql_sql.y:1300
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1264
+//line mysql_sql.y:1304
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 138:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1270
+//line mysql_sql.y:1310
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1274
+//line mysql_sql.y:1314
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1278
+//line mysql_sql.y:1318
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1284
+//line mysql_sql.y:1324
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1290
+//line mysql_sql.y:1330
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 145:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1294
+//line mysql_sql.y:1334
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1301
+//line mysql_sql.y:1341
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 146:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1307
+//line mysql_sql.y:1347
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1311
+//line mysql_sql.y:1351
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1317
+//line mysql_sql.y:1357
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6620,10 +7107,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 151:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1325
+//line mysql_sql.y:1365
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6633,10 +7120,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 152:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1334
+//line mysql_sql.y:1374
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6645,10 +7132,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 153:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1342
+//line mysql_sql.y:1382
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6657,10 +7144,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 152:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1350
+//line mysql_sql.y:1390
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -6684,10 +7171,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1373
+//line mysql_sql.y:1413
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -6711,10 +7198,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1396
+//line mysql_sql.y:1436
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6722,10 +7209,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 157:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1403
+//line mysql_sql.y:1443
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6733,10 +7220,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 158:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1410
+//line mysql_sql.y:1450
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -6745,10 +7232,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 157:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1418
+//line mysql_sql.y:1458
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6756,10 +7243,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 158:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1425
+//line mysql_sql.y:1465
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6767,10 +7254,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 159:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1432
+//line mysql_sql.y:1472
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6778,190 +7265,190 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 160:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1441
+//line mysql_sql.y:1481
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 161:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1445
+//line mysql_sql.y:1485
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 162:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1449
+//line mysql_sql.y:1489
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 163:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1455
+//line mysql_sql.y:1495
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 164:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1459
+//line mysql_sql.y:1499
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 166:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1466
+//line mysql_sql.y:1506
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 170:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1477
+//line mysql_sql.y:1517
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1483
+//line mysql_sql.y:1523
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 174:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1488
+//line mysql_sql.y:1528
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1492
+//line mysql_sql.y:1532
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 176:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1496
+//line mysql_sql.y:1536
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1500
+//line mysql_sql.y:1540
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 178:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1504
+//line mysql_sql.y:1544
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1508
+//line mysql_sql.y:1548
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 180:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1512
+//line mysql_sql.y:1552
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1516
+//line mysql_sql.y:1556
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1520
+//line mysql_sql.y:1560
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1526
+//line mysql_sql.y:1566
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1530
+//line mysql_sql.y:1570
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1534
+//line mysql_sql.y:1574
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1538
+//line mysql_sql.y:1578
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 185:
+	case 187:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1543
+//line mysql_sql.y:1583
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 186:
+	case 188:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1548
+//line mysql_sql.y:1588
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 187:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1554
+//line mysql_sql.y:1594
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -6969,20 +7456,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1561
+//line mysql_sql.y:1601
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1567
+//line mysql_sql.y:1607
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -6990,10 +7477,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 192:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1574
+//line mysql_sql.y:1614
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -7001,10 +7488,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 193:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1581
+//line mysql_sql.y:1621
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -7012,19 +7499,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1591
+//line mysql_sql.y:1631
 		{
 			yyDollar[2].statementUnion().(*tree.Update).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 196:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1598
+//line mysql_sql.y:1638
 		{
 			// Single-table syntax
 			yyLOCAL = &tree.Update{
@@ -7036,10 +7523,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 197:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1609
+//line mysql_sql.y:1649
 		{
 			// Multiple-table syntax
 			yyLOCAL = &tree.Update{
@@ -7049,130 +7536,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1620
+//line mysql_sql.y:1660
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1624
+//line mysql_sql.y:1664
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 200:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1630
+//line mysql_sql.y:1670
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1642
+//line mysql_sql.y:1682
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 206:
+	case 208:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1648
+//line mysql_sql.y:1688
 		{
 			yyLOCAL = tree.NewPrepareStmt(tree.Identifier(yyDollar[2].str), yyDollar[4].statementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 207:
+	case 209:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1652
+//line mysql_sql.y:1692
 		{
 			yyLOCAL = tree.NewPrepareString(tree.Identifier(yyDollar[2].str), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 208:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1658
+//line mysql_sql.y:1698
 		{
 			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 209:
+	case 211:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1662
+//line mysql_sql.y:1702
 		{
 			yyLOCAL = tree.NewExecuteWithVariables(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 212:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1668
+//line mysql_sql.y:1708
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), false)
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1677
+//line mysql_sql.y:1717
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1683
+//line mysql_sql.y:1723
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1687
+//line mysql_sql.y:1727
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 219:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1691
+//line mysql_sql.y:1731
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 220:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1695
+//line mysql_sql.y:1735
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1699
+//line mysql_sql.y:1739
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1703
+//line mysql_sql.y:1743
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -7181,10 +7668,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1711
+//line mysql_sql.y:1751
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -7193,10 +7680,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 224:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1719
+//line mysql_sql.y:1759
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -7207,76 +7694,256 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 225:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1729
+//line mysql_sql.y:1769
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
 			explainStmt.Options = yyDollar[3].epxlainOptionsUnion()
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1761
+//line mysql_sql.y:1801
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 238:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1765
+//line mysql_sql.y:1805
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 239:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:1771
+//line mysql_sql.y:1811
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 238:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1777
+//line mysql_sql.y:1817
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 239:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1782
+//line mysql_sql.y:1822
 		{
 			yyVAL.str = "true"
 		}
-	case 240:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1783
+//line mysql_sql.y:1823
 		{
 			yyVAL.str = "false"
 		}
-	case 241:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1784
+//line mysql_sql.y:1824
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 242:
+	case 244:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1829
+		{
+			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 245:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1789
+//line mysql_sql.y:1833
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 246:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1837
+		{
+			stmt := tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), nil)
+			stmt.HistogramCols = yyDollar[7].identifierListUnion()
+			yyLOCAL = stmt
+		}
+		yyVAL.union = yyLOCAL
+	case 251:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1851
+		{
+			yyLOCAL = tree.NewAlterTable(yyDollar[3].tableNameUnion(), yyDollar[4].alterTableOptionsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 252:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:1857
+		{
+			yyLOCAL = tree.AlterTableOptions{yyDollar[1].alterTableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 253:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:1861
+		{
+			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 254:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1867
+		{
+			yyLOCAL = &tree.AlterOptionAdd{Def: yyDollar[3].columnTableDefUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 255:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1871
+		{
+			yyLOCAL = &tree.AlterOptionAdd{Def: yyDollar[2].tableDefUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 256:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1875
+		{
+			yyLOCAL = &tree.AlterOptionDrop{Typ: tree.AlterTableDropColumn, Name: tree.Identifier(yyDollar[3].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 257:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1879
+		{
+			yyLOCAL = &tree.AlterOptionDrop{Typ: tree.AlterTableDropIndex, Name: tree.Identifier(yyDollar[3].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 258:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1883
+		{
+			yyLOCAL = &tree.AlterOptionDrop{Typ: tree.AlterTableDropKey, Name: tree.Identifier(yyDollar[3].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 259:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1887
+		{
+			yyLOCAL = &tree.AlterOptionDrop{Typ: tree.AlterTableDropPrimaryKey}
+		}
+		yyVAL.union = yyLOCAL
+	case 260:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1891
+		{
+			yyLOCAL = &tree.AlterOptionRenameColumn{OldName: tree.Identifier(yyDollar[3].str), NewName: tree.Identifier(yyDollar[5].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 261:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1895
+		{
+			yyLOCAL = &tree.AlterOptionRenameTable{Name: yyDollar[3].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 262:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1899
+		{
+			yyLOCAL = &tree.AlterOptionRenameTable{Name: yyDollar[3].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 263:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1903
+		{
+			yyLOCAL = &tree.AlterOptionRenameTable{Name: yyDollar[2].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 264:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1907
+		{
+			yyLOCAL = &tree.AlterOptionModifyColumn{Def: yyDollar[3].columnTableDefUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 265:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1911
+		{
+			yyLOCAL = &tree.AlterOptionTableOption{Option: yyDollar[1].tableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 266:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:1916
+		{
+		}
+	case 267:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1918
+		{
+		}
+	case 268:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1922
+		{
+			yyLOCAL = tree.NewAlterTable(yyDollar[3].tableNameUnion(), tree.AlterTableOptions{&tree.AlterOptionRenameTable{Name: yyDollar[5].tableNameUnion()}})
+		}
+		yyVAL.union = yyLOCAL
+	case 269:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1928
+		{
+			yyLOCAL = &tree.AlterTask{Name: yyDollar[3].str, Option: tree.AlterTaskSuspend}
+		}
+		yyVAL.union = yyLOCAL
+	case 270:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1932
+		{
+			yyLOCAL = &tree.AlterTask{Name: yyDollar[3].str, Option: tree.AlterTaskResume}
+		}
+		yyVAL.union = yyLOCAL
+	case 271:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1938
+		{
+			yyLOCAL = &tree.CancelTask{Name: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 272:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1800
+//line mysql_sql.y:1944
 		{
 			yyLOCAL = &tree.AlterAccount{
 				IfExists:     yyDollar[3].boolValUnion(),
@@ -7287,10 +7954,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 273:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1812
+//line mysql_sql.y:1956
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:           yyDollar[3].boolValUnion(),
@@ -7301,214 +7968,222 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 274:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:1823
+//line mysql_sql.y:1967
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:1827
+//line mysql_sql.y:1971
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 276:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1832
+//line mysql_sql.y:1976
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 277:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1836
+//line mysql_sql.y:1980
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 278:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1841
+//line mysql_sql.y:1985
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1845
+//line mysql_sql.y:1989
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1861
+//line mysql_sql.y:2005
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1865
+//line mysql_sql.y:2009
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 282:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1869
+//line mysql_sql.y:2013
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1873
+//line mysql_sql.y:2017
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1877
+//line mysql_sql.y:2021
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1881
+//line mysql_sql.y:2025
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1885
+//line mysql_sql.y:2029
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1889
+//line mysql_sql.y:2033
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryCount{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 288:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1893
+//line mysql_sql.y:2037
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 289:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1897
+//line mysql_sql.y:2041
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalCount{Value: yyDollar[4].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1901
+//line mysql_sql.y:2045
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1905
+//line mysql_sql.y:2049
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 292:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1909
+//line mysql_sql.y:2053
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentOptional{}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 293:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1913
+//line mysql_sql.y:2057
 		{
 			yyLOCAL = &tree.UserMiscOptionFailedLoginAttempts{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 294:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1917
+//line mysql_sql.y:2061
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeCount{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 295:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1921
+//line mysql_sql.y:2065
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeUnbounded{}
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1927
+//line mysql_sql.y:2071
 		{
 			yyVAL.item = nil
 		}
-	case 270:
+	case 297:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1932
+//line mysql_sql.y:2076
 		{
 			yyVAL.item = nil
 		}
-	case 283:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 311:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1954
+//line mysql_sql.y:2099
+		{
+			yyLOCAL = &tree.ShowTasks{Like: yyDollar[3].comparisionExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 312:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2105
 		{
 			yyLOCAL = &tree.ShowTarget{Target: yyDollar[2].str, Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 313:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1958
+//line mysql_sql.y:2109
 		{
 			yyLOCAL = &tree.ShowTarget{Target: "charset", Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 314:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1964
+//line mysql_sql.y:2115
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -7516,10 +8191,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 318:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1978
+//line mysql_sql.y:2129
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -7528,10 +8203,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 319:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1988
+//line mysql_sql.y:2139
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -7540,58 +8215,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 320:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1997
+//line mysql_sql.y:2148
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2001
+//line mysql_sql.y:2152
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 322:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2005
+//line mysql_sql.y:2156
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2011
+//line mysql_sql.y:2162
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2017
+//line mysql_sql.y:2168
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 325:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2023
+//line mysql_sql.y:2174
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 326:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2029
+//line mysql_sql.y:2180
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -7602,10 +8277,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 327:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2039
+//line mysql_sql.y:2190
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -7616,26 +8291,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 328:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2051
+//line mysql_sql.y:2202
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 300:
+	case 329:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2055
+//line mysql_sql.y:2206
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 330:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2061
+//line mysql_sql.y:2212
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -7648,10 +8323,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 331:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2073
+//line mysql_sql.y:2224
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -7664,126 +8339,138 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 332:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:2086
+//line mysql_sql.y:2237
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:2090
+//line mysql_sql.y:2241
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 334:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2095
+//line mysql_sql.y:2246
 		{
 			yyVAL.str = ""
 		}
-	case 306:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2099
+//line mysql_sql.y:2250
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 307:
+	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2105
+//line mysql_sql.y:2256
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2118
+//line mysql_sql.y:2269
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2122
+//line mysql_sql.y:2273
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 343:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2128
+//line mysql_sql.y:2279
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 344:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2133
+//line mysql_sql.y:2284
 		{
 			yyLOCAL = &tree.ShowCreateView{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 345:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2137
+//line mysql_sql.y:2288
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 346:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2143
+//line mysql_sql.y:2294
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 347:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2147
+//line mysql_sql.y:2298
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 349:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2156
+//line mysql_sql.y:2307
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 350:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2160
+//line mysql_sql.y:2311
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 351:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2164
+//line mysql_sql.y:2315
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 362:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2335
+		{
+			yyLOCAL = &tree.DropPolicy{
+				IfExists: yyDollar[3].boolValUnion(),
+				Name:     tree.Identifier(yyDollar[4].str),
+				Table:    *yyDollar[6].tableNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 363:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2183
+//line mysql_sql.y:2345
 		{
 			yyLOCAL = &tree.DropAccount{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -7791,10 +8478,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 364:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2192
+//line mysql_sql.y:2354
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -7802,26 +8489,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 365:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2201
+//line mysql_sql.y:2363
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2205
+//line mysql_sql.y:2367
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2211
+//line mysql_sql.y:2373
 		{
 			yyLOCAL = &tree.User{
 				Username: yyDollar[1].usernameRecordUnion().Username,
@@ -7829,10 +8516,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 368:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2220
+//line mysql_sql.y:2382
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -7840,10 +8527,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 369:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2229
+//line mysql_sql.y:2391
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -7852,60 +8539,60 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 370:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2239
+//line mysql_sql.y:2401
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 371:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2245
+//line mysql_sql.y:2407
 		{
 			yyLOCAL = &tree.DropView{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 372:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2251
+//line mysql_sql.y:2413
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2257
+//line mysql_sql.y:2419
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), true)
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2265
+//line mysql_sql.y:2427
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2270
+//line mysql_sql.y:2432
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 378:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2277
+//line mysql_sql.y:2439
 		{
 			// Single-Table Syntax
 			t := &tree.AliasedTableExpr{
@@ -7922,10 +8609,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 379:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2293
+//line mysql_sql.y:2455
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -7935,10 +8622,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 380:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2306
+//line mysql_sql.y:2468
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -7948,69 +8635,69 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2317
+//line mysql_sql.y:2479
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 382:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2321
+//line mysql_sql.y:2483
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:2327
+//line mysql_sql.y:2489
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 384:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:2332
+//line mysql_sql.y:2494
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 385:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2339
+//line mysql_sql.y:2501
 		{
 		}
-	case 355:
+	case 386:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2341
+//line mysql_sql.y:2503
 		{
 		}
-	case 356:
+	case 387:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2344
+//line mysql_sql.y:2506
 		{
 		}
-	case 361:
+	case 392:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2353
+//line mysql_sql.y:2515
 		{
 		}
-	case 363:
+	case 394:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2357
+//line mysql_sql.y:2519
 		{
 		}
-	case 365:
+	case 396:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2362
+//line mysql_sql.y:2524
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -8018,10 +8705,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2371
+//line mysql_sql.y:2533
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -8029,20 +8716,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2378
+//line mysql_sql.y:2540
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 399:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2384
+//line mysql_sql.y:2546
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -8051,10 +8738,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 400:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2392
+//line mysql_sql.y:2554
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -8062,10 +8749,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 401:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2399
+//line mysql_sql.y:2561
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -8073,10 +8760,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2406
+//line mysql_sql.y:2568
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -8095,34 +8782,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 403:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2425
+//line mysql_sql.y:2587
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2429
+//line mysql_sql.y:2591
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2433
+//line mysql_sql.y:2595
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:2439
+//line mysql_sql.y:2601
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -8130,171 +8817,204 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2448
+//line mysql_sql.y:2610
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2452
+//line mysql_sql.y:2614
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2458
+//line mysql_sql.y:2620
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 379:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2462
+//line mysql_sql.y:2624
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 380:
+	case 411:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2468
+//line mysql_sql.y:2630
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2472
+//line mysql_sql.y:2634
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 413:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2478
+//line mysql_sql.y:2640
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 414:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2483
+//line mysql_sql.y:2645
 		{
 		}
-	case 385:
+	case 416:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2487
+//line mysql_sql.y:2649
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 418:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2494
+//line mysql_sql.y:2656
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2498
+//line mysql_sql.y:2660
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2505
+//line mysql_sql.y:2667
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 422:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2510
+//line mysql_sql.y:2672
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 423:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2514
+//line mysql_sql.y:2676
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 424:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2520
+//line mysql_sql.y:2682
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 425:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2524
+//line mysql_sql.y:2686
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2530
+//line mysql_sql.y:2692
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2534
+//line mysql_sql.y:2696
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 428:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2539
+//line mysql_sql.y:2701
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 429:
+		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2543
+//line mysql_sql.y:2705
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
 				FilePath:    yyDollar[3].str,
-				Fields:      yyDollar[4].fieldsUnion(),
-				Lines:       yyDollar[5].linesUnion(),
-				Header:      yyDollar[6].unsignedOptUnion(),
-				MaxFileSize: uint64(yyDollar[7].int64ValUnion()) * 1024,
-				ForceQuote:  yyDollar[8].strsUnion(),
+				FileFormat:  yyDollar[4].str,
+				Compression: yyDollar[5].str,
+				Fields:      yyDollar[6].fieldsUnion(),
+				Lines:       yyDollar[7].linesUnion(),
+				Header:      yyDollar[8].unsignedOptUnion(),
+				NullValue:   yyDollar[9].str,
+				MaxFileSize: uint64(yyDollar[10].int64ValUnion()) * 1024,
+				ForceQuote:  yyDollar[11].strsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 430:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2721
+		{
+			yyVAL.str = ""
+		}
+	case 431:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:2725
+		{
+			switch strings.ToLower(yyDollar[2].str) {
+			case tree.NOCOMPRESS, tree.GZIP, tree.ZSTD, tree.SNAPPY:
+				yyVAL.str = strings.ToLower(yyDollar[2].str)
+			default:
+				yylex.Error(fmt.Sprintf("the compression '%s' is not supported", yyDollar[2].str))
+				return 1
+			}
+		}
+	case 432:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2736
+		{
+			yyVAL.str = tree.NULL_VALUE
+		}
+	case 433:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line mysql_sql.y:2740
+		{
+			yyVAL.str = yyDollar[3].str
+		}
+	case 434:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2556
+//line mysql_sql.y:2745
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -8302,10 +9022,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 435:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2563
+//line mysql_sql.y:2752
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -8313,10 +9033,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 436:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2570
+//line mysql_sql.y:2759
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -8335,10 +9055,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 437:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2588
+//line mysql_sql.y:2777
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -8357,38 +9077,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 438:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2607
+//line mysql_sql.y:2796
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2613
+//line mysql_sql.y:2802
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 440:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2620
+//line mysql_sql.y:2809
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2624
+//line mysql_sql.y:2813
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -8401,115 +9121,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 442:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2637
+//line mysql_sql.y:2826
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 443:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2641
+//line mysql_sql.y:2830
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 444:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2646
+//line mysql_sql.y:2835
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 445:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2650
+//line mysql_sql.y:2839
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2657
+//line mysql_sql.y:2846
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2662
+//line mysql_sql.y:2851
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2669
+//line mysql_sql.y:2858
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 450:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2675
+//line mysql_sql.y:2864
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2679
+//line mysql_sql.y:2868
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2683
+//line mysql_sql.y:2872
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 453:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2687
+//line mysql_sql.y:2876
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2691
+//line mysql_sql.y:2880
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 455:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2695
+//line mysql_sql.y:2884
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 456:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2701
+//line mysql_sql.y:2890
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -8517,10 +9237,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2708
+//line mysql_sql.y:2897
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -8528,26 +9248,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2717
+//line mysql_sql.y:2906
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 459:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2721
+//line mysql_sql.y:2910
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 460:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:2727
+//line mysql_sql.y:2916
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].str), Cols: yyDollar[2].identifierListUnion()},
@@ -8555,162 +9275,162 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 461:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2735
+//line mysql_sql.y:2924
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2739
+//line mysql_sql.y:2928
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 463:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2744
+//line mysql_sql.y:2933
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2748
+//line mysql_sql.y:2937
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 465:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2754
+//line mysql_sql.y:2943
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 466:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2758
+//line mysql_sql.y:2947
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 467:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2762
+//line mysql_sql.y:2951
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 468:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2767
+//line mysql_sql.y:2956
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2771
+//line mysql_sql.y:2960
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 470:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2777
+//line mysql_sql.y:2966
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2783
+//line mysql_sql.y:2972
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2787
+//line mysql_sql.y:2976
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 473:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2793
+//line mysql_sql.y:2982
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 474:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2798
+//line mysql_sql.y:2987
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2802
+//line mysql_sql.y:2991
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2806
+//line mysql_sql.y:2995
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2813
+//line mysql_sql.y:3002
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2817
+//line mysql_sql.y:3006
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2823
+//line mysql_sql.y:3012
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2827
+//line mysql_sql.y:3016
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -8721,10 +9441,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2837
+//line mysql_sql.y:3026
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -8735,10 +9455,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2847
+//line mysql_sql.y:3036
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -8749,10 +9469,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2857
+//line mysql_sql.y:3046
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -8763,10 +9483,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 484:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2869
+//line mysql_sql.y:3058
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -8775,10 +9495,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2877
+//line mysql_sql.y:3066
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -8787,10 +9507,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2885
+//line mysql_sql.y:3074
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -8799,10 +9519,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2894
+//line mysql_sql.y:3083
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -8811,10 +9531,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2902
+//line mysql_sql.y:3091
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -8823,10 +9543,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2910
+//line mysql_sql.y:3099
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -8835,10 +9555,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 490:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2918
+//line mysql_sql.y:3107
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -8847,10 +9567,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2926
+//line mysql_sql.y:3115
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -8859,10 +9579,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2934
+//line mysql_sql.y:3123
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -8871,10 +9591,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2942
+//line mysql_sql.y:3131
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -8883,10 +9603,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 494:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2950
+//line mysql_sql.y:3139
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -8895,10 +9615,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2958
+//line mysql_sql.y:3147
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -8907,10 +9627,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 496:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2968
+//line mysql_sql.y:3157
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -8922,10 +9642,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 497:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2979
+//line mysql_sql.y:3168
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: false,
@@ -8938,130 +9658,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 501:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2997
+//line mysql_sql.y:3186
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3001
+//line mysql_sql.y:3190
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 503:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3005
+//line mysql_sql.y:3194
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 506:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3014
+//line mysql_sql.y:3203
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3018
+//line mysql_sql.y:3207
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 508:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:3023
+//line mysql_sql.y:3212
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 509:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:3027
+//line mysql_sql.y:3216
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 510:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3032
+//line mysql_sql.y:3221
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 511:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3036
+//line mysql_sql.y:3225
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:3042
+//line mysql_sql.y:3231
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:3046
+//line mysql_sql.y:3235
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3052
+//line mysql_sql.y:3241
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3056
+//line mysql_sql.y:3245
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3060
+//line mysql_sql.y:3249
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 517:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3064
+//line mysql_sql.y:3253
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 518:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:3069
+//line mysql_sql.y:3258
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier(""), prefix)
@@ -9070,44 +9790,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:3077
+//line mysql_sql.y:3266
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:3083
+//line mysql_sql.y:3272
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3091
+//line mysql_sql.y:3280
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 522:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3095
+//line mysql_sql.y:3284
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 526:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3108
+//line mysql_sql.y:3297
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -9117,10 +9837,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 527:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3117
+//line mysql_sql.y:3306
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -9130,10 +9850,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 528:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3127
+//line mysql_sql.y:3316
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -9143,10 +9863,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 529:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3136
+//line mysql_sql.y:3325
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -9155,15 +9875,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3146
+//line mysql_sql.y:3335
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 496:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3150
+//line mysql_sql.y:3339
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -9171,130 +9891,142 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 497:
+	case 532:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3160
+//line mysql_sql.y:3349
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 498:
+	case 533:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:3164
+//line mysql_sql.y:3353
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 499:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3168
+//line mysql_sql.y:3357
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 500:
+	case 535:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:3172
+//line mysql_sql.y:3361
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 501:
+	case 536:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:3365
+		{
+			yyVAL.str = tree.JOIN_TYPE_FULL
+		}
+	case 537:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line mysql_sql.y:3369
+		{
+			yyVAL.str = tree.JOIN_TYPE_FULL
+		}
+	case 538:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:3178
+//line mysql_sql.y:3375
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 539:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:3182
+//line mysql_sql.y:3379
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3188
+//line mysql_sql.y:3385
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 504:
+	case 541:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3194
+//line mysql_sql.y:3391
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 505:
+	case 542:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3198
+//line mysql_sql.y:3395
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 506:
+	case 543:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3202
+//line mysql_sql.y:3399
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 507:
+	case 544:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:3208
+//line mysql_sql.y:3405
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:3212
+//line mysql_sql.y:3409
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:3218
+//line mysql_sql.y:3415
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 547:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:3222
+//line mysql_sql.y:3419
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3228
+//line mysql_sql.y:3425
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3232
+//line mysql_sql.y:3429
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3238
+//line mysql_sql.y:3435
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 551:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3242
+//line mysql_sql.y:3439
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -9305,28 +10037,93 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 552:
+		yyDollar = yyS[yypt-12 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:3449
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: tree.NewJsonTable(yyDollar[3].exprUnion(), yyDollar[5].str, yyDollar[8].jsonTableColumnsUnion()),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[12].str),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 553:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.JsonTableColumn
+//line mysql_sql.y:3461
+		{
+			yyLOCAL = []*tree.JsonTableColumn{yyDollar[1].jsonTableColumnUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 554:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.JsonTableColumn
+//line mysql_sql.y:3465
+		{
+			yyLOCAL = append(yyDollar[1].jsonTableColumnsUnion(), yyDollar[3].jsonTableColumnUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 555:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:3471
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Name:       tree.Identifier(yyDollar[1].str),
+				Ordinality: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 556:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:3478
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Name: tree.Identifier(yyDollar[1].str),
+				Type: yyDollar[2].columnTypeUnion(),
+				Path: yyDollar[4].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 557:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:3486
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Name:   tree.Identifier(yyDollar[1].str),
+				Type:   yyDollar[2].columnTypeUnion(),
+				Exists: true,
+				Path:   yyDollar[5].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 558:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:3255
+//line mysql_sql.y:3497
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 559:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3260
+//line mysql_sql.y:3502
 		{
 		}
-	case 517:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3261
+//line mysql_sql.y:3503
 		{
 		}
-	case 518:
+	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:3265
+//line mysql_sql.y:3507
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -9336,58 +10133,122 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 562:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3277
+//line mysql_sql.y:3521
 		{
 			yyVAL.str = ""
 		}
-	case 520:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3281
+//line mysql_sql.y:3525
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 521:
+	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3285
+//line mysql_sql.y:3529
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 524:
+	case 567:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3294
+//line mysql_sql.y:3538
 		{
 			yyVAL.str = ""
 		}
-	case 525:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3298
+//line mysql_sql.y:3542
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 526:
+	case 569:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3302
+//line mysql_sql.y:3546
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 527:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3306
+//line mysql_sql.y:3550
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 528:
+	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3310
+//line mysql_sql.y:3554
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 545:
+	case 586:
+		yyDollar = yyS[yypt-14 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3584
+		{
+			yyLOCAL = &tree.CreatePolicy{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Name:        tree.Identifier(yyDollar[4].str),
+				Table:       *yyDollar[6].tableNameUnion(),
+				Command:     yyDollar[8].policyCommandUnion(),
+				Role:        yyDollar[10].str,
+				Using:       yyDollar[13].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 587:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PolicyCommand
+//line mysql_sql.y:3597
+		{
+			yyLOCAL = tree.PolicyCommandSelect
+		}
+		yyVAL.union = yyLOCAL
+	case 588:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PolicyCommand
+//line mysql_sql.y:3601
+		{
+			yyLOCAL = tree.PolicyCommandUpdate
+		}
+		yyVAL.union = yyLOCAL
+	case 589:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PolicyCommand
+//line mysql_sql.y:3605
+		{
+			yyLOCAL = tree.PolicyCommandDelete
+		}
+		yyVAL.union = yyLOCAL
+	case 590:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3611
+		{
+			yyLOCAL = &tree.CreateTask{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Name:        yyDollar[4].str,
+				Schedule:    yyDollar[5].str,
+				Body:        yyDollar[7].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 591:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:3621
+		{
+			yyVAL.str = ""
+		}
+	case 592:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:3625
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 597:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3344
+//line mysql_sql.y:3637
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[5].tableNameUnion(),
@@ -9398,10 +10259,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 598:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3354
+//line mysql_sql.y:3647
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[8].tableNameUnion(),
@@ -9412,15 +10273,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 599:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3365
+//line mysql_sql.y:3658
 		{
 		}
-	case 549:
+	case 601:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3370
+//line mysql_sql.y:3663
 		{
 			yyLOCAL = &tree.CreateAccount{
 				IfNotExists:  yyDollar[3].ifNotExistsUnion(),
@@ -9431,16 +10292,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3382
+//line mysql_sql.y:3675
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 551:
+	case 603:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountAuthOption
-//line mysql_sql.y:3388
+//line mysql_sql.y:3681
 		{
 			yyLOCAL = tree.AccountAuthOption{
 				Equal:          yyDollar[2].str,
@@ -9449,16 +10310,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3398
+//line mysql_sql.y:3691
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 553:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:3404
+//line mysql_sql.y:3697
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -9466,20 +10327,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 606:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:3411
+//line mysql_sql.y:3704
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:3417
+//line mysql_sql.y:3710
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -9487,20 +10348,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 608:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:3425
+//line mysql_sql.y:3718
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:3431
+//line mysql_sql.y:3724
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -9508,10 +10369,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:3438
+//line mysql_sql.y:3731
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -9519,101 +10380,209 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:3446
+	case 611:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.AccountComment
+//line mysql_sql.y:3739
+		{
+			yyLOCAL = tree.AccountComment{
+				Exist: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 612:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AccountComment
+//line mysql_sql.y:3745
+		{
+			yyLOCAL = tree.AccountComment{
+				Exist:   true,
+				Comment: yyDollar[2].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 613:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3754
+		{
+			yyLOCAL = &tree.CreateUser{
+				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
+				Users:              yyDollar[4].usersUnion(),
+				Role:               yyDollar[5].accountRoleUnion(),
+				TlsOpts:            yyDollar[6].tlsOptionsUnion(),
+				MiscOpt:            yyDollar[7].userMiscOptionUnion(),
+				CommentOrAttribute: yyDollar[8].accountCommentOrAttributeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 614:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:3767
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 615:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.AccountCommentOrAttribute
+//line mysql_sql.y:3772
+		{
+			yyLOCAL = tree.AccountCommentOrAttribute{
+				Exist: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 616:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AccountCommentOrAttribute
+//line mysql_sql.y:3778
+		{
+			yyLOCAL = tree.AccountCommentOrAttribute{
+				Exist:     true,
+				IsComment: true,
+				Str:       yyDollar[2].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 617:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AccountCommentOrAttribute
+//line mysql_sql.y:3786
+		{
+			yyLOCAL = tree.AccountCommentOrAttribute{
+				Exist:     true,
+				IsComment: false,
+				Str:       yyDollar[2].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 618:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:3833
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 619:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:3837
+		{
+			yyLOCAL = yyDollar[1].tlsOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 620:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:3843
+		{
+			t := &tree.TlsOptionNone{}
+			yyLOCAL = []tree.TlsOption{t}
+		}
+		yyVAL.union = yyLOCAL
+	case 621:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:3848
+		{
+			t := &tree.TlsOptionSSL{}
+			yyLOCAL = []tree.TlsOption{t}
+		}
+		yyVAL.union = yyLOCAL
+	case 622:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:3853
+		{
+			t := &tree.TlsOptionX509{}
+			yyLOCAL = []tree.TlsOption{t}
+		}
+		yyVAL.union = yyLOCAL
+	case 623:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:3858
+		{
+			yyLOCAL = yyDollar[2].tlsOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 624:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:3864
+		{
+			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 625:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:3868
 		{
-			yyLOCAL = tree.AccountComment{
-				Exist: false,
-			}
+			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:3452
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:3872
 		{
-			yyLOCAL = tree.AccountComment{
-				Exist:   true,
-				Comment: yyDollar[2].str,
-			}
+			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		var yyLOCAL tree.Statement
-//line mysql_sql.y:3461
+	case 627:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TlsOption
+//line mysql_sql.y:3878
 		{
-			yyLOCAL = &tree.CreateUser{
-				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
-				Users:              yyDollar[4].usersUnion(),
-				Role:               yyDollar[5].accountRoleUnion(),
-				MiscOpt:            yyDollar[6].userMiscOptionUnion(),
-				CommentOrAttribute: yyDollar[7].accountCommentOrAttributeUnion(),
-			}
+			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3473
-		{
-			yyVAL.str = yyDollar[1].str
-		}
-	case 563:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:3478
+	case 628:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TlsOption
+//line mysql_sql.y:3882
 		{
-			yyLOCAL = tree.AccountCommentOrAttribute{
-				Exist: false,
-			}
+			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 629:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:3484
+		var yyLOCAL tree.TlsOption
+//line mysql_sql.y:3886
 		{
-			yyLOCAL = tree.AccountCommentOrAttribute{
-				Exist:     true,
-				IsComment: true,
-				Str:       yyDollar[2].str,
-			}
+			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 630:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:3492
+		var yyLOCAL tree.TlsOption
+//line mysql_sql.y:3890
 		{
-			yyLOCAL = tree.AccountCommentOrAttribute{
-				Exist:     true,
-				IsComment: false,
-				Str:       yyDollar[2].str,
-			}
+			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3598
+//line mysql_sql.y:3896
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3602
+//line mysql_sql.y:3900
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 633:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:3608
+//line mysql_sql.y:3906
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -9622,26 +10591,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3618
+//line mysql_sql.y:3916
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 635:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3622
+//line mysql_sql.y:3920
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:3628
+//line mysql_sql.y:3926
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -9650,50 +10619,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3638
+//line mysql_sql.y:3936
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3642
+//line mysql_sql.y:3940
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 639:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3646
+//line mysql_sql.y:3944
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 640:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:3651
+//line mysql_sql.y:3949
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:3655
+//line mysql_sql.y:3953
 		{
 			yyLOCAL = yyDollar[1].userIdentifiedUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:3661
+//line mysql_sql.y:3959
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -9701,20 +10670,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 643:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:3668
+//line mysql_sql.y:3966
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:3674
+//line mysql_sql.y:3972
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -9722,10 +10691,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 645:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.AccountIdentified
+//line mysql_sql.y:3979
+		{
+			yyLOCAL = &tree.AccountIdentified{
+				Typ:        tree.AccountIdentifiedByPassword,
+				Str:        yyDollar[5].str,
+				AuthPlugin: yyDollar[3].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 648:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3687
+//line mysql_sql.y:3993
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9733,66 +10714,66 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3696
+//line mysql_sql.y:4002
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 650:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3700
+//line mysql_sql.y:4006
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3706
+//line mysql_sql.y:4012
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 654:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3723
+//line mysql_sql.y:4029
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3727
+//line mysql_sql.y:4033
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3731
+//line mysql_sql.y:4037
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3735
+//line mysql_sql.y:4041
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 658:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3741
+//line mysql_sql.y:4047
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -9811,18 +10792,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3760
+//line mysql_sql.y:4066
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 660:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3764
+//line mysql_sql.y:4070
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -9843,123 +10824,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 661:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3786
+//line mysql_sql.y:4092
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 662:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3790
+//line mysql_sql.y:4096
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3794
+//line mysql_sql.y:4100
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3798
+//line mysql_sql.y:4104
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3802
+//line mysql_sql.y:4108
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3808
+//line mysql_sql.y:4114
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 667:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3812
+//line mysql_sql.y:4118
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 668:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3818
+//line mysql_sql.y:4124
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 669:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3823
+//line mysql_sql.y:4129
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 670:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3828
+//line mysql_sql.y:4134
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 671:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3832
+//line mysql_sql.y:4138
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 672:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3836
+//line mysql_sql.y:4142
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 673:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3840
+//line mysql_sql.y:4146
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3844
+//line mysql_sql.y:4150
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 675:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3850
+//line mysql_sql.y:4156
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -9968,98 +10949,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 678:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3864
+//line mysql_sql.y:4170
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 679:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3868
+//line mysql_sql.y:4174
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 680:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3873
+//line mysql_sql.y:4179
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3877
+//line mysql_sql.y:4183
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3883
+//line mysql_sql.y:4189
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 683:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3887
+//line mysql_sql.y:4193
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 684:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3893
+//line mysql_sql.y:4199
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 685:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3897
+//line mysql_sql.y:4203
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 686:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3901
+//line mysql_sql.y:4207
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 687:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3906
+//line mysql_sql.y:4212
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3910
+//line mysql_sql.y:4216
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 689:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3916
+//line mysql_sql.y:4222
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -10071,10 +11052,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 690:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3927
+//line mysql_sql.y:4233
 		{
 			yyLOCAL = &tree.CreateTable{
 				IfNotExists: yyDollar[4].ifNotExistsUnion(),
@@ -10084,19 +11065,46 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 691:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:3938
+//line mysql_sql.y:4244
 		{
 			yyLOCAL = yyDollar[1].loadParamUnion()
 			yyLOCAL.Tail = yyDollar[2].tailParamUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 692:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.ExternParam
+//line mysql_sql.y:4251
+		{
+			yyLOCAL = yyDollar[1].loadParamUnion()
+			yyLOCAL.Format = yyDollar[2].str
+		}
+		yyVAL.union = yyLOCAL
+	case 693:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:4257
+		{
+			yyVAL.str = tree.CSV
+		}
+	case 694:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:4261
+		{
+			switch strings.ToLower(yyDollar[2].str) {
+			case tree.CSV, tree.PARQUET, tree.JSONLINE:
+				yyVAL.str = strings.ToLower(yyDollar[2].str)
+			default:
+				yylex.Error(fmt.Sprintf("the format '%s' is not supported", yyDollar[2].str))
+				return 1
+			}
+		}
+	case 695:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:3945
+//line mysql_sql.y:4273
 		{
 			yyLOCAL = &tree.ExternParam{
 				Filepath:     yyDollar[2].str,
@@ -10104,10 +11112,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 696:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:3952
+//line mysql_sql.y:4280
 		{
 			if strings.ToLower(yyDollar[3].str) != "filepath" {
 				yylex.Error(fmt.Sprintf("can not recognize the '%s'", yyDollar[3].str))
@@ -10119,10 +11127,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 697:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:3963
+//line mysql_sql.y:4291
 		{
 			if strings.ToLower(yyDollar[3].str) != "filepath" || strings.ToLower(yyDollar[7].str) != "compression" {
 				yylex.Error(fmt.Sprintf("can not recognize the '%s' or '%s' ", yyDollar[3].str, yyDollar[7].str))
@@ -10134,10 +11142,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 698:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.TailParameter
-//line mysql_sql.y:3976
+//line mysql_sql.y:4304
 		{
 			yyLOCAL = &tree.TailParameter{
 				Fields:       yyDollar[1].fieldsUnion(),
@@ -10148,34 +11156,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 699:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3987
+//line mysql_sql.y:4315
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3991
+//line mysql_sql.y:4319
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 701:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3996
+//line mysql_sql.y:4324
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 702:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:4000
+//line mysql_sql.y:4328
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -10185,18 +11193,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 703:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4010
+//line mysql_sql.y:4338
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 704:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4014
+//line mysql_sql.y:4342
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -10205,42 +11213,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 705:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:4023
+//line mysql_sql.y:4351
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 706:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:4027
+//line mysql_sql.y:4355
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:4033
+//line mysql_sql.y:4361
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 708:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:4037
+//line mysql_sql.y:4365
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 709:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:4043
+//line mysql_sql.y:4371
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -10250,10 +11258,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 710:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:4052
+//line mysql_sql.y:4380
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -10263,42 +11271,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 711:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:4062
+//line mysql_sql.y:4390
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 712:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:4066
+//line mysql_sql.y:4394
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:4072
+//line mysql_sql.y:4400
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 714:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:4076
+//line mysql_sql.y:4404
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:4082
+//line mysql_sql.y:4410
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -10306,10 +11314,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 716:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:4089
+//line mysql_sql.y:4417
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -10317,67 +11325,67 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4098
+//line mysql_sql.y:4426
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 718:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4102
+//line mysql_sql.y:4430
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 719:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:4107
+//line mysql_sql.y:4435
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 720:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:4111
+//line mysql_sql.y:4439
 		{
 			expr := tree.NewMaxValue()
 			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{expr}}
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 721:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:4116
+//line mysql_sql.y:4444
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 722:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:4120
+//line mysql_sql.y:4448
 		{
 			yyLOCAL = &tree.ValuesIn{ValueList: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 723:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4125
+//line mysql_sql.y:4453
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4129
+//line mysql_sql.y:4457
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -10387,18 +11395,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 725:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4139
+//line mysql_sql.y:4467
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4143
+//line mysql_sql.y:4471
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -10408,10 +11416,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 727:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4154
+//line mysql_sql.y:4482
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -10420,10 +11428,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 728:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4162
+//line mysql_sql.y:4490
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -10432,10 +11440,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 729:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4170
+//line mysql_sql.y:4498
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -10444,10 +11452,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 730:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4178
+//line mysql_sql.y:4506
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -10456,10 +11464,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 732:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4189
+//line mysql_sql.y:4517
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -10470,10 +11478,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 733:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4199
+//line mysql_sql.y:4527
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -10483,500 +11491,510 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 734:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4209
+//line mysql_sql.y:4537
 		{
 			yyLOCAL = 2
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 735:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4213
+//line mysql_sql.y:4541
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 736:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4218
+//line mysql_sql.y:4546
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4222
+//line mysql_sql.y:4550
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 738:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4227
+//line mysql_sql.y:4555
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4231
+//line mysql_sql.y:4559
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 740:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4237
+//line mysql_sql.y:4565
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 741:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4241
+//line mysql_sql.y:4569
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 742:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4245
+//line mysql_sql.y:4573
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4251
+//line mysql_sql.y:4579
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 744:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4255
+//line mysql_sql.y:4583
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 745:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4259
+//line mysql_sql.y:4587
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 746:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4263
+//line mysql_sql.y:4591
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4267
+//line mysql_sql.y:4595
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4271
+//line mysql_sql.y:4599
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4275
+//line mysql_sql.y:4603
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 750:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4279
+//line mysql_sql.y:4607
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 751:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4283
+//line mysql_sql.y:4611
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 752:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4287
+//line mysql_sql.y:4615
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 753:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4291
+//line mysql_sql.y:4619
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 754:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4295
+//line mysql_sql.y:4623
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4299
+//line mysql_sql.y:4627
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4303
+//line mysql_sql.y:4631
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4307
+//line mysql_sql.y:4635
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 758:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4311
+//line mysql_sql.y:4639
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4315
+//line mysql_sql.y:4643
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 760:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4319
+//line mysql_sql.y:4647
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4323
+//line mysql_sql.y:4651
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 762:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4327
+//line mysql_sql.y:4655
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4331
+//line mysql_sql.y:4659
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 764:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4335
+//line mysql_sql.y:4663
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 765:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4339
+//line mysql_sql.y:4667
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4343
+//line mysql_sql.y:4671
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4347
+//line mysql_sql.y:4675
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 768:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4351
+//line mysql_sql.y:4679
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 769:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4355
+//line mysql_sql.y:4683
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 770:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4359
+//line mysql_sql.y:4687
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 771:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4363
+//line mysql_sql.y:4691
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 772:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:4370
+//line mysql_sql.y:4698
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 773:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:4374
+//line mysql_sql.y:4702
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 774:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:4380
+//line mysql_sql.y:4708
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 775:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4385
+//line mysql_sql.y:4713
 		{
 			yyVAL.str = ""
 		}
-	case 707:
+	case 776:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4389
+//line mysql_sql.y:4717
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 708:
+	case 777:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4393
+//line mysql_sql.y:4721
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 709:
+	case 778:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:4399
+//line mysql_sql.y:4727
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 779:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:4403
+//line mysql_sql.y:4731
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 780:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:4407
+//line mysql_sql.y:4735
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:4411
+//line mysql_sql.y:4739
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 782:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:4415
+//line mysql_sql.y:4743
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 783:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:4419
+//line mysql_sql.y:4747
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 788:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:4433
+//line mysql_sql.y:4761
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 789:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:4437
+//line mysql_sql.y:4765
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 790:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:4446
+//line mysql_sql.y:4774
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 791:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:4451
+//line mysql_sql.y:4779
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 792:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:4457
+//line mysql_sql.y:4785
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 794:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:4464
+//line mysql_sql.y:4792
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 795:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:4468
+//line mysql_sql.y:4796
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 796:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4474
+//line mysql_sql.y:4802
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 797:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4478
+//line mysql_sql.y:4806
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4484
+//line mysql_sql.y:4812
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
 				case *tree.PrimaryKeyIndex:
 					v.Name = yyDollar[1].str
+				case *tree.UniqueIndex:
+					if v.Name == "" {
+						v.Name = yyDollar[1].str
+					}
+				case *tree.ForeignKey:
+					if v.Name == "" {
+						v.Name = yyDollar[1].str
+					}
+				case *tree.CheckIndex:
+					v.Name = yyDollar[1].str
 				}
 			}
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 799:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4494
+//line mysql_sql.y:4832
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 800:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4500
+//line mysql_sql.y:4838
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -10986,10 +12004,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 801:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4509
+//line mysql_sql.y:4847
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -10999,10 +12017,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 802:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4518
+//line mysql_sql.y:4856
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -11026,10 +12044,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 803:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4541
+//line mysql_sql.y:4879
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -11039,10 +12057,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 804:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4550
+//line mysql_sql.y:4888
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -11053,10 +12071,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 805:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4560
+//line mysql_sql.y:4898
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -11064,258 +12082,258 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 806:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4568
+//line mysql_sql.y:4906
 		{
-			yyLOCAL = false
+			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 808:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4574
+//line mysql_sql.y:4912
 		{
 			yyVAL.str = ""
 		}
-	case 740:
+	case 809:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4578
+//line mysql_sql.y:4916
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 743:
+	case 812:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4588
+//line mysql_sql.y:4926
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 813:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4594
+//line mysql_sql.y:4932
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 814:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4600
+//line mysql_sql.y:4938
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 820:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4614
+//line mysql_sql.y:4952
 		{
 			yyVAL.str = ""
 		}
-	case 753:
+	case 822:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:4621
+//line mysql_sql.y:4959
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 823:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4627
+//line mysql_sql.y:4965
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 824:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4631
+//line mysql_sql.y:4969
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 825:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4635
+//line mysql_sql.y:4973
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4646
+//line mysql_sql.y:4984
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 830:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4650
+//line mysql_sql.y:4988
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 831:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4654
+//line mysql_sql.y:4992
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 832:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4659
+//line mysql_sql.y:4997
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 833:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4663
+//line mysql_sql.y:5001
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 834:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4669
+//line mysql_sql.y:5007
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 835:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4673
+//line mysql_sql.y:5011
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 836:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4679
+//line mysql_sql.y:5017
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 837:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4683
+//line mysql_sql.y:5021
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 838:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4687
+//line mysql_sql.y:5025
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 839:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4691
+//line mysql_sql.y:5029
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 840:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4695
+//line mysql_sql.y:5033
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 841:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4699
+//line mysql_sql.y:5037
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char))
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 842:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4703
+//line mysql_sql.y:5041
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 843:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4707
+//line mysql_sql.y:5045
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 844:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4711
+//line mysql_sql.y:5049
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 845:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4715
+//line mysql_sql.y:5053
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4719
+//line mysql_sql.y:5057
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 847:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4723
+//line mysql_sql.y:5061
 		{
-			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
+			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), true, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 848:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4727
+//line mysql_sql.y:5065
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 849:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4731
+//line mysql_sql.y:5069
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			var es tree.Exprs = nil
@@ -11329,50 +12347,50 @@ yydefault:
 			yyLOCAL = tree.NewAttributeOnUpdate(expr)
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 850:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4746
+//line mysql_sql.y:5084
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 851:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4750
+//line mysql_sql.y:5088
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 852:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4755
+//line mysql_sql.y:5093
 		{
 			yyVAL.str = ""
 		}
-	case 784:
+	case 853:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4759
+//line mysql_sql.y:5097
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 785:
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4765
+//line mysql_sql.y:5103
 		{
 			yyVAL.str = ""
 		}
-	case 786:
+	case 855:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4769
+//line mysql_sql.y:5107
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 787:
+	case 856:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:4775
+//line mysql_sql.y:5113
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -11383,10 +12401,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 857:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4787
+//line mysql_sql.y:5125
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -11394,10 +12412,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 858:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4794
+//line mysql_sql.y:5132
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -11405,10 +12423,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 859:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4801
+//line mysql_sql.y:5139
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -11416,10 +12434,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 860:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4808
+//line mysql_sql.y:5146
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -11427,10 +12445,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 861:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4815
+//line mysql_sql.y:5153
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -11438,339 +12456,369 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 862:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4824
+//line mysql_sql.y:5162
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 863:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4830
+//line mysql_sql.y:5168
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4836
+//line mysql_sql.y:5174
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4840
+//line mysql_sql.y:5178
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 866:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4844
+//line mysql_sql.y:5182
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 867:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4848
+//line mysql_sql.y:5186
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 868:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4852
+//line mysql_sql.y:5190
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 869:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4857
+//line mysql_sql.y:5195
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 871:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4864
+//line mysql_sql.y:5202
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4868
+//line mysql_sql.y:5206
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 873:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4872
+//line mysql_sql.y:5210
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 874:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4877
+//line mysql_sql.y:5215
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 875:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4881
+//line mysql_sql.y:5219
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 876:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4886
+//line mysql_sql.y:5224
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 877:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4890
+//line mysql_sql.y:5228
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 884:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4906
+//line mysql_sql.y:5244
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 885:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4912
+//line mysql_sql.y:5250
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 886:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4916
+//line mysql_sql.y:5254
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 887:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4920
+//line mysql_sql.y:5258
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 888:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4924
+//line mysql_sql.y:5262
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 889:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4928
+//line mysql_sql.y:5266
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 890:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4932
+//line mysql_sql.y:5270
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 891:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4936
+//line mysql_sql.y:5274
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 892:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4940
+//line mysql_sql.y:5278
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 893:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4944
+//line mysql_sql.y:5282
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 894:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4948
+//line mysql_sql.y:5286
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 895:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4952
+//line mysql_sql.y:5290
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 896:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4956
+//line mysql_sql.y:5294
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 897:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4960
+//line mysql_sql.y:5298
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 898:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4966
+//line mysql_sql.y:5304
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 899:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5308
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			path := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), path},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 900:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5317
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			path := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
+			extract := &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), path},
+			}
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_unquote")),
+				Exprs: tree.Exprs{extract},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 901:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4970
+//line mysql_sql.y:5330
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4974
+//line mysql_sql.y:5334
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 903:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4978
+//line mysql_sql.y:5338
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 904:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4982
+//line mysql_sql.y:5342
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 905:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4986
+//line mysql_sql.y:5346
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 906:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4990
+//line mysql_sql.y:5350
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 907:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4994
+//line mysql_sql.y:5354
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 908:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4998
+//line mysql_sql.y:5358
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 909:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5002
+//line mysql_sql.y:5362
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 910:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5006
+//line mysql_sql.y:5366
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 911:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5010
+//line mysql_sql.y:5370
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 912:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5015
+//line mysql_sql.y:5375
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -11779,26 +12827,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 913:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5023
+//line mysql_sql.y:5383
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 914:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5027
+//line mysql_sql.y:5387
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 915:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5031
+//line mysql_sql.y:5391
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
@@ -11808,98 +12856,106 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 916:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5040
+//line mysql_sql.y:5400
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 917:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5044
+//line mysql_sql.y:5404
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5048
+//line mysql_sql.y:5408
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 919:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5052
+//line mysql_sql.y:5412
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 920:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5056
+//line mysql_sql.y:5416
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 921:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5420
+		{
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 922:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5061
+//line mysql_sql.y:5425
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 923:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5065
+//line mysql_sql.y:5429
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 924:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5070
+//line mysql_sql.y:5434
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 925:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5074
+//line mysql_sql.y:5438
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:5080
+//line mysql_sql.y:5444
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 927:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:5084
+//line mysql_sql.y:5448
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 928:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:5090
+//line mysql_sql.y:5454
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -11907,10 +12963,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 930:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5100
+//line mysql_sql.y:5464
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -11928,10 +12984,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 931:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5117
+//line mysql_sql.y:5481
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11946,10 +13002,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 933:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5134
+//line mysql_sql.y:5498
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11963,10 +13019,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 934:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5147
+//line mysql_sql.y:5511
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11980,10 +13036,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5160
+//line mysql_sql.y:5524
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11996,10 +13052,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 936:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5172
+//line mysql_sql.y:5536
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12014,10 +13070,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 937:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5186
+//line mysql_sql.y:5550
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12033,10 +13089,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 938:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5201
+//line mysql_sql.y:5565
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12044,7 +13100,7 @@ yydefault:
 					Family:             tree.TimeFamily,
 					FamilyString:       yyDollar[1].str,
 					DisplayWith:        yyDollar[2].lengthOptUnion(),
-					Precision:          0,
+					Precision:          yyDollar[2].lengthOptUnion(),
 					TimePrecisionIsSet: false,
 					Locale:             &locale,
 					Oid:                uint32(defines.MYSQL_TYPE_TIME),
@@ -12052,10 +13108,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 939:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5216
+//line mysql_sql.y:5580
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -12073,10 +13129,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 940:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5233
+//line mysql_sql.y:5597
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12091,15 +13147,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 941:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5248
+//line mysql_sql.y:5612
 		{
 		}
-	case 872:
+	case 944:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5254
+//line mysql_sql.y:5618
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12109,10 +13165,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 945:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5263
+//line mysql_sql.y:5627
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12121,10 +13177,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 946:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5271
+//line mysql_sql.y:5635
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12133,10 +13189,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 947:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5279
+//line mysql_sql.y:5643
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12146,10 +13202,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 948:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5288
+//line mysql_sql.y:5652
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12159,10 +13215,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 949:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5297
+//line mysql_sql.y:5661
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12172,10 +13228,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 950:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5306
+//line mysql_sql.y:5670
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12185,10 +13241,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 951:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5315
+//line mysql_sql.y:5679
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -12198,10 +13254,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 952:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5324
+//line mysql_sql.y:5688
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12211,10 +13267,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 953:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5333
+//line mysql_sql.y:5697
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12224,10 +13280,62 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 954:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5342
+//line mysql_sql.y:5706
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 955:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5715
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 956:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5724
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 957:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5733
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 958:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5742
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12237,62 +13345,141 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5351
+	case 959:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5753
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[2].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 960:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5758
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[2].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 961:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:5765
+		{
+			yyLOCAL = tree.NewWindowSpec(yyDollar[3].exprsUnion(), yyDollar[4].orderByUnion(), yyDollar[5].frameClauseUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 962:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:5770
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 963:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:5774
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 964:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:5779
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 965:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:5783
+		{
+			yyLOCAL = &tree.FrameClause{
+				Type:  yyDollar[1].frameTypeUnion(),
+				Start: yyDollar[2].frameBoundUnion(),
+				End:   &tree.FrameBound{Type: tree.CurrentRow},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 966:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:5791
+		{
+			yyLOCAL = &tree.FrameClause{
+				Type:   yyDollar[1].frameTypeUnion(),
+				HasEnd: true,
+				Start:  yyDollar[3].frameBoundUnion(),
+				End:    yyDollar[5].frameBoundUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 967:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line mysql_sql.y:5802
+		{
+			yyLOCAL = tree.Rows
+		}
+		yyVAL.union = yyLOCAL
+	case 968:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line mysql_sql.y:5806
+		{
+			yyLOCAL = tree.Range
+		}
+		yyVAL.union = yyLOCAL
+	case 969:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:5812
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
-			}
+			yyLOCAL = &tree.FrameBound{Type: tree.CurrentRow}
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5360
+	case 970:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:5816
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
-			}
+			yyLOCAL = &tree.FrameBound{Type: tree.Preceding, UnBounded: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5369
+	case 971:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:5820
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
-			}
+			yyLOCAL = &tree.FrameBound{Type: tree.Following, UnBounded: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5378
+	case 972:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:5824
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
-			}
+			yyLOCAL = &tree.FrameBound{Type: tree.Preceding, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 973:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:5828
+		{
+			yyLOCAL = &tree.FrameBound{Type: tree.Following, Expr: yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 977:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5394
+//line mysql_sql.y:5839
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12301,10 +13488,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 978:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5402
+//line mysql_sql.y:5847
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12313,10 +13500,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 979:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5410
+//line mysql_sql.y:5855
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12325,10 +13512,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 980:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5418
+//line mysql_sql.y:5863
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12337,10 +13524,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 981:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5426
+//line mysql_sql.y:5871
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			timeUinit := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12350,10 +13537,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 982:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5880
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 983:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5435
+//line mysql_sql.y:5888
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12362,10 +13561,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 984:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5443
+//line mysql_sql.y:5896
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12375,10 +13574,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 985:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5452
+//line mysql_sql.y:5905
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12388,10 +13587,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 986:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5461
+//line mysql_sql.y:5914
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12400,22 +13599,23 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 987:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5469
+//line mysql_sql.y:5922
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			arg1 := tree.NewNumValWithType(constant.MakeString("both"), "both", false, tree.P_char)
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[3].exprUnion()},
+				Exprs: tree.Exprs{arg1, yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 988:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5477
+//line mysql_sql.y:5931
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg1 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12425,10 +13625,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 989:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5486
+//line mysql_sql.y:5940
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg1 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12438,10 +13638,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 990:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5496
+//line mysql_sql.y:5950
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			a1 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12452,16 +13652,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5517
+//line mysql_sql.y:5971
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 937:
+	case 1025:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5553
+//line mysql_sql.y:6007
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -12474,10 +13674,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 1026:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5565
+//line mysql_sql.y:6019
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -12490,10 +13690,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 1027:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5579
+//line mysql_sql.y:6033
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12502,10 +13702,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 1028:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5587
+//line mysql_sql.y:6041
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12513,10 +13713,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 1029:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5594
+//line mysql_sql.y:6048
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12524,10 +13724,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 1030:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5601
+//line mysql_sql.y:6055
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -12540,10 +13740,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 1031:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5613
+//line mysql_sql.y:6067
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -12552,10 +13752,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 1032:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5621
+//line mysql_sql.y:6075
 		{
 			cn := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
 			es := yyDollar[3].exprsUnion()
@@ -12567,10 +13767,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 1033:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5632
+//line mysql_sql.y:6086
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("date")
@@ -12580,10 +13780,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 1034:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5641
+//line mysql_sql.y:6095
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("time")
@@ -12593,10 +13793,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 1035:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5650
+//line mysql_sql.y:6104
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -12605,10 +13805,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 1036:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5658
+//line mysql_sql.y:6112
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -12619,10 +13819,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 1037:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5668
+//line mysql_sql.y:6122
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -12631,10 +13831,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1038:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5676
+//line mysql_sql.y:6130
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -12643,10 +13843,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1039:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5684
+//line mysql_sql.y:6138
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("timestamp")
@@ -12656,34 +13856,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1040:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5694
+//line mysql_sql.y:6148
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1041:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5698
+//line mysql_sql.y:6152
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1042:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5704
+//line mysql_sql.y:6158
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 1043:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5708
+//line mysql_sql.y:6162
 		{
 			ival, errStr := util.GetInt64(yyDollar[2].item)
 			if errStr != "" {
@@ -12694,20 +13894,20 @@ yydefault:
 			yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(ival), str, false, tree.P_int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1050:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5727
+//line mysql_sql.y:6181
 		{
 		}
-	case 963:
+	case 1051:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5729
+//line mysql_sql.y:6183
 		{
 		}
-	case 997:
+	case 1087:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5770
+//line mysql_sql.y:6226
 		{
 			name := tree.SetUnresolvedName("interval")
 			arg2 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12717,90 +13917,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 998:
+	case 1088:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5780
+//line mysql_sql.y:6236
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1089:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5784
+//line mysql_sql.y:6240
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1090:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5788
+//line mysql_sql.y:6244
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1091:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:5794
+//line mysql_sql.y:6250
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1092:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5799
+//line mysql_sql.y:6255
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1093:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5803
+//line mysql_sql.y:6259
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1094:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5809
+//line mysql_sql.y:6265
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1005:
+	case 1095:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5813
+//line mysql_sql.y:6269
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1006:
+	case 1096:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5820
+//line mysql_sql.y:6276
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1097:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5824
+//line mysql_sql.y:6280
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1098:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5828
+//line mysql_sql.y:6284
 		{
 			name := tree.SetUnresolvedName(strings.ToLower("concat"))
 			yyLOCAL = &tree.FuncExpr{
@@ -12809,340 +14009,340 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1099:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5836
+//line mysql_sql.y:6292
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1010:
+	case 1100:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5840
+//line mysql_sql.y:6296
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5844
+//line mysql_sql.y:6300
 		{
 			yyLOCAL = tree.NewMaxValue()
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1102:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5848
+//line mysql_sql.y:6304
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.EQUAL, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1103:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5852
+//line mysql_sql.y:6308
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_EQUAL, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1104:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5856
+//line mysql_sql.y:6312
 		{
 			arg := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), "", false, tree.P_char)
 			yyLOCAL = tree.NewComparisonExpr(tree.EQUAL, yyDollar[1].exprUnion(), arg)
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1105:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5861
+//line mysql_sql.y:6317
 		{
 			arg := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), "", false, tree.P_char)
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_EQUAL, yyDollar[1].exprUnion(), arg)
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5866
+//line mysql_sql.y:6322
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1107:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5872
+//line mysql_sql.y:6328
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1108:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5876
+//line mysql_sql.y:6332
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1109:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5880
+//line mysql_sql.y:6336
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1110:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5884
+//line mysql_sql.y:6340
 		{
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5891
+//line mysql_sql.y:6347
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5895
+//line mysql_sql.y:6351
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1114:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5901
+//line mysql_sql.y:6357
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1115:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5905
+//line mysql_sql.y:6361
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1116:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5909
+//line mysql_sql.y:6365
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1027:
+	case 1117:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5913
+//line mysql_sql.y:6369
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1118:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5917
+//line mysql_sql.y:6373
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1029:
+	case 1119:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5921
+//line mysql_sql.y:6377
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1120:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5925
+//line mysql_sql.y:6381
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1031:
+	case 1121:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5929
+//line mysql_sql.y:6385
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1033:
+	case 1123:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5935
+//line mysql_sql.y:6391
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1034:
+	case 1124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5939
+//line mysql_sql.y:6395
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1035:
+	case 1125:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5945
+//line mysql_sql.y:6401
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1036:
+	case 1126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5949
+//line mysql_sql.y:6405
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1037:
+	case 1127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5956
+//line mysql_sql.y:6412
 		{
 			yyLOCAL = tree.ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1038:
+	case 1128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5960
+//line mysql_sql.y:6416
 		{
 			yyLOCAL = tree.ANY
 		}
 		yyVAL.union = yyLOCAL
-	case 1039:
+	case 1129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5964
+//line mysql_sql.y:6420
 		{
 			yyLOCAL = tree.SOME
 		}
 		yyVAL.union = yyLOCAL
-	case 1040:
+	case 1130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5970
+//line mysql_sql.y:6426
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1041:
+	case 1131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5974
+//line mysql_sql.y:6430
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1042:
+	case 1132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5978
+//line mysql_sql.y:6434
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1043:
+	case 1133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5982
+//line mysql_sql.y:6438
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1044:
+	case 1134:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5986
+//line mysql_sql.y:6442
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1045:
+	case 1135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5990
+//line mysql_sql.y:6446
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5994
+//line mysql_sql.y:6450
 		{
 			yyLOCAL = tree.NULL_SAFE_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1047:
+	case 1137:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6000
+//line mysql_sql.y:6456
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1048:
+	case 1138:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6004
+//line mysql_sql.y:6460
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1049:
+	case 1139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6008
+//line mysql_sql.y:6464
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 1050:
+	case 1140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6012
+//line mysql_sql.y:6468
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1051:
+	case 1141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6018
+//line mysql_sql.y:6474
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 1052:
+	case 1142:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6022
+//line mysql_sql.y:6478
 		{
 			str := fmt.Sprintf("%v", yyDollar[1].item)
 			switch v := yyDollar[1].item.(type) {
@@ -13156,43 +14356,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1053:
+	case 1143:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6035
+//line mysql_sql.y:6491
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1144:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6040
+//line mysql_sql.y:6496
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1055:
+	case 1145:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6044
+//line mysql_sql.y:6500
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1146:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6048
+//line mysql_sql.y:6504
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6052
+//line mysql_sql.y:6508
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -13207,18 +14407,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1058:
+	case 1148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6066
+//line mysql_sql.y:6522
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal)
 		}
 		yyVAL.union = yyLOCAL
-	case 1059:
+	case 1149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6070
+//line mysql_sql.y:6526
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -13233,45 +14433,45 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1060:
+	case 1150:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6084
+//line mysql_sql.y:6540
 		{
 			yyLOCAL = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
 		}
 		yyVAL.union = yyLOCAL
-	case 1061:
+	case 1151:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6090
+//line mysql_sql.y:6546
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1065:
+	case 1155:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6101
+//line mysql_sql.y:6557
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1066:
+	case 1156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6106
+//line mysql_sql.y:6562
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1067:
+	case 1157:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6112
+//line mysql_sql.y:6568
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13284,10 +14484,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1068:
+	case 1158:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6124
+//line mysql_sql.y:6580
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13300,10 +14500,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1069:
+	case 1159:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6136
+//line mysql_sql.y:6592
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13316,10 +14516,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1070:
+	case 1160:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6148
+//line mysql_sql.y:6604
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13333,10 +14533,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1071:
+	case 1161:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6161
+//line mysql_sql.y:6617
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13350,10 +14550,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1072:
+	case 1162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6174
+//line mysql_sql.y:6630
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13367,10 +14567,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1073:
+	case 1163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6187
+//line mysql_sql.y:6643
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13384,10 +14584,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1074:
+	case 1164:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6200
+//line mysql_sql.y:6656
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13401,10 +14601,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1075:
+	case 1165:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6213
+//line mysql_sql.y:6669
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13418,10 +14618,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1076:
+	case 1166:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6226
+//line mysql_sql.y:6682
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13435,10 +14635,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1077:
+	case 1167:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6239
+//line mysql_sql.y:6695
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13452,10 +14652,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1078:
+	case 1168:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6252
+//line mysql_sql.y:6708
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13469,10 +14669,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1079:
+	case 1169:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6265
+//line mysql_sql.y:6721
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13486,10 +14686,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1080:
+	case 1170:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6278
+//line mysql_sql.y:6734
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13503,10 +14703,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1081:
+	case 1171:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6293
+//line mysql_sql.y:6749
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -13530,10 +14730,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1082:
+	case 1172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6316
+//line mysql_sql.y:6772
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -13570,10 +14770,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1083:
+	case 1173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6353
+//line mysql_sql.y:6809
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -13610,10 +14810,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1084:
+	case 1174:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6401
+//line mysql_sql.y:6857
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13629,10 +14829,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1085:
+	case 1175:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6418
+//line mysql_sql.y:6874
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13645,29 +14845,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1086:
+	case 1176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6430
+//line mysql_sql.y:6886
 		{
 			locale := ""
-			yyLOCAL = &tree.T{
-				InternalType: tree.InternalType{
-					Family:             tree.TimeFamily,
-					FamilyString:       yyDollar[1].str,
-					DisplayWith:        yyDollar[2].lengthOptUnion(),
-					Precision:          0,
-					TimePrecisionIsSet: false,
-					Locale:             &locale,
-					Oid:                uint32(defines.MYSQL_TYPE_TIME),
-				},
+			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
+				yylex.Error("For Time(fsp), fsp must in [0, 6]")
+				return 1
+			} else {
+				yyLOCAL = &tree.T{
+					InternalType: tree.InternalType{
+						Family:             tree.TimeFamily,
+						Precision:          yyDollar[2].lengthOptUnion(),
+						FamilyString:       yyDollar[1].str,
+						DisplayWith:        26,
+						TimePrecisionIsSet: true,
+						Locale:             &locale,
+						Oid:                uint32(defines.MYSQL_TYPE_TIME),
+					},
+				}
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1087:
+	case 1177:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6445
+//line mysql_sql.y:6906
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -13688,10 +14893,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1088:
+	case 1178:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6465
+//line mysql_sql.y:6926
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -13712,10 +14917,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1089:
+	case 1179:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6485
+//line mysql_sql.y:6946
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13730,10 +14935,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1090:
+	case 1180:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6501
+//line mysql_sql.y:6962
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13747,10 +14952,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1091:
+	case 1181:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6514
+//line mysql_sql.y:6975
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13764,10 +14969,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1092:
+	case 1182:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6527
+//line mysql_sql.y:6988
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13781,10 +14986,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1093:
+	case 1183:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6540
+//line mysql_sql.y:7001
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13798,10 +15003,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1094:
+	case 1184:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6553
+//line mysql_sql.y:7014
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13814,10 +15019,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1095:
+	case 1185:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6565
+//line mysql_sql.y:7026
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13830,10 +15035,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1096:
+	case 1186:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6577
+//line mysql_sql.y:7038
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13846,10 +15051,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1187:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6589
+//line mysql_sql.y:7050
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13862,10 +15067,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1188:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6601
+//line mysql_sql.y:7062
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13878,10 +15083,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1099:
+	case 1189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6613
+//line mysql_sql.y:7074
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13894,10 +15099,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1100:
+	case 1190:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6625
+//line mysql_sql.y:7086
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13910,10 +15115,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1101:
+	case 1191:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6637
+//line mysql_sql.y:7098
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13926,10 +15131,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1102:
+	case 1192:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6649
+//line mysql_sql.y:7110
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13942,10 +15147,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1103:
+	case 1193:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6661
+//line mysql_sql.y:7122
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13959,10 +15164,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1104:
+	case 1194:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6674
+//line mysql_sql.y:7135
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13976,10 +15181,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1105:
+	case 1195:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6687
+//line mysql_sql.y:7148
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13993,10 +15198,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1106:
+	case 1196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6704
+//line mysql_sql.y:7165
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -14009,75 +15214,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1107:
+	case 1197:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6727
+//line mysql_sql.y:7188
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1108:
+	case 1198:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6732
+//line mysql_sql.y:7193
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1199:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6738
+//line mysql_sql.y:7199
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1111:
+	case 1201:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6745
+//line mysql_sql.y:7206
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1112:
+	case 1202:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6749
+//line mysql_sql.y:7210
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1113:
+	case 1203:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6754
+//line mysql_sql.y:7215
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 1114:
+	case 1204:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6758
+//line mysql_sql.y:7219
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1115:
+	case 1205:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6764
+//line mysql_sql.y:7225
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1116:
+	case 1206:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6770
+//line mysql_sql.y:7231
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -14085,10 +15290,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1207:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6777
+//line mysql_sql.y:7238
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -14096,10 +15301,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1118:
+	case 1208:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6784
+//line mysql_sql.y:7245
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -14107,10 +15312,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1119:
+	case 1209:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6793
+//line mysql_sql.y:7254
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 34, // this is the default precision for decimal
@@ -14118,10 +15323,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1120:
+	case 1210:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6800
+//line mysql_sql.y:7261
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -14129,10 +15334,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1121:
+	case 1211:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6807
+//line mysql_sql.y:7268
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -14140,52 +15345,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1122:
+	case 1212:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6816
+//line mysql_sql.y:7277
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1123:
+	case 1213:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6820
+//line mysql_sql.y:7281
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1124:
+	case 1214:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6824
+//line mysql_sql.y:7285
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1125:
+	case 1215:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6830
+//line mysql_sql.y:7291
 		{
 		}
-	case 1126:
+	case 1216:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6832
+//line mysql_sql.y:7293
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1130:
+	case 1220:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6842
+//line mysql_sql.y:7303
 		{
 			yyVAL.str = ""
 		}
-	case 1131:
+	case 1221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6846
+//line mysql_sql.y:7307
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
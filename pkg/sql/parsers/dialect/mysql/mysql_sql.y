@@ -43,6 +43,8 @@ import (
     columnTableDef *tree.ColumnTableDef
     tableOption tree.TableOption
     tableOptions []tree.TableOption
+    alterTableOption tree.AlterTableOption
+    alterTableOptions tree.AlterTableOptions
     tableExprs tree.TableExprs
     tableExpr tree.TableExpr
     rowFormatType tree.RowFormatType
@@ -55,6 +57,8 @@ import (
     where *tree.Where
     groupBy tree.GroupBy
     aliasedTableExpr *tree.AliasedTableExpr
+    jsonTableColumn *tree.JsonTableColumn
+    jsonTableColumns []*tree.JsonTableColumn
     direction tree.Direction
     orderBy tree.OrderBy
     order *tree.Order
@@ -122,6 +126,10 @@ import (
 
     subquery *tree.Subquery
     funcExpr *tree.FuncExpr
+    windowSpec *tree.WindowSpec
+    frameClause *tree.FrameClause
+    frameBound *tree.FrameBound
+    frameType tree.FrameType
 
     roles []*tree.Role
     role *tree.Role
@@ -142,6 +150,7 @@ import (
     fullOpt bool
     boolVal bool
     int64Val int64
+    policyCommand tree.PolicyCommand
     strs []string
 
     duplicateKey tree.DuplicateKey
@@ -185,7 +194,7 @@ import (
 %token <str> VALUES
 %token <str> NEXT VALUE SHARE MODE
 %token <str> SQL_NO_CACHE SQL_CACHE
-%left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
+%left <str> JOIN STRAIGHT_JOIN LEFT RIGHT FULL INNER OUTER CROSS NATURAL USE FORCE
 %nonassoc LOWER_THAN_ON
 %nonassoc <str> ON USING
 %left <str> SUBQUERY_AS_EXPR
@@ -235,7 +244,7 @@ import (
 %token <str> LOW_PRIORITY HIGH_PRIORITY DELAYED
 
 // Create Table
-%token <str> CREATE ALTER DROP RENAME ANALYZE ADD
+%token <str> CREATE ALTER DROP RENAME ANALYZE ADD MODIFY
 %token <str> SCHEMA TABLE INDEX VIEW TO IGNORE IF PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
 %token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
@@ -253,6 +262,18 @@ import (
 
 // Index
 %token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI
+
+// Window
+%token <str> OVER PRECEDING FOLLOWING
+
+// Analyze
+%token <str> HISTOGRAM
+
+// Task
+%token <str> TASK TASKS SCHEDULE RESUME CANCEL
+
+// Policy
+%token <str> POLICY
 %token <str> ZONEMAP LEADING BOTH TRAILING UNKNOWN
 
 // Alter
@@ -283,7 +304,7 @@ import (
 %token <str> LOAD INFILE TERMINATED OPTIONALLY ENCLOSED ESCAPED STARTING LINES ROWS
 
 // Supported SHOW tokens
-%token <str> DATABASES TABLES EXTENDED FULL PROCESSLIST FIELDS COLUMNS OPEN ERRORS WARNINGS INDEXES SCHEMAS
+%token <str> DATABASES TABLES EXTENDED PROCESSLIST FIELDS COLUMNS OPEN ERRORS WARNINGS INDEXES SCHEMAS
 
 // SET tokens
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
@@ -317,7 +338,8 @@ import (
 %token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG
 
 //JSON function
-%token <str> JSON_EXTRACT
+%token <str> JSON_EXTRACT JSON_TABLE ORDINALITY PATH
+%token <str> JSON_EXTRACT_OP JSON_UNQUOTE_EXTRACT_OP
 
 // Insert
 %token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE
@@ -342,9 +364,17 @@ import (
 %type <statement> revoke_stmt grant_stmt
 %type <statement> load_data_stmt
 %type <statement> analyze_stmt
+%type <statement> create_task_stmt show_tasks_stmt cancel_task_stmt alter_task_stmt
+%type <statement> alter_table_stmt rename_table_stmt
+%type <alterTableOption> alter_table_option
+%type <alterTableOptions> alter_table_option_list
+%type <str> task_schedule_opt
+%type <statement> create_policy_stmt drop_policy_stmt
+%type <policyCommand> policy_command
 %type <statement> prepare_stmt prepareable_stmt deallocate_stmt execute_stmt
 %type <exportParm> export_data_param_opt
-%type <loadParam> load_param_opt load_param_opt_2
+%type <loadParam> load_param_opt load_param_opt_2 infile_param
+%type <str> load_format_opt export_compression_opt export_null_opt
 %type <tailParam> tail_param_opt
 
 %type <select> select_stmt select_no_parens
@@ -353,6 +383,8 @@ import (
 %type <selectExpr> select_expression
 %type <tableExprs> table_references table_name_wild_list
 %type <tableExpr> table_reference table_factor join_table into_table_name escaped_table_reference
+%type <jsonTableColumn> json_table_column
+%type <jsonTableColumns> json_table_column_list
 %type <direction> asc_desc_opt
 %type <order> order
 %type <orderBy> order_list order_by_clause order_by_opt
@@ -393,6 +425,12 @@ import (
 %type <funcExpr> function_call_keyword
 %type <funcExpr> function_call_nonkeyword
 %type <funcExpr> function_call_aggregate
+%type <funcExpr> function_call_window
+%type <windowSpec> window_spec
+%type <exprs> window_partition_by_opt
+%type <frameClause> window_frame_clause_opt
+%type <frameType> window_frame_units
+%type <frameBound> window_frame_bound
 %type <funcExpr> function_call_json
 
 %type <unresolvedName> column_name column_name_unresolved
@@ -437,8 +475,8 @@ import (
 %type <usernameRecord> user_name
 %type <user> user_spec drop_user_spec user_spec_with_identified
 %type <users> user_spec_list drop_user_spec_list user_spec_list_of_create_user
-//%type <tlsOptions> require_clause_opt require_clause require_list
-//%type <tlsOption> require_elem
+%type <tlsOptions> require_clause_opt require_clause require_list
+%type <tlsOption> require_elem
 //%type <resourceOptions> conn_option_list conn_options
 //%type <resourceOption> conn_option
 %type <updateExpr> update_value
@@ -563,7 +601,9 @@ stmt:
 |   execute_stmt
 |   show_stmt
 |   alter_stmt
+|   rename_table_stmt
 |   analyze_stmt
+|   cancel_task_stmt
 |   update_stmt
 |   use_stmt
 |   transaction_stmt
@@ -1785,15 +1825,119 @@ utility_option_arg:
 
 
 analyze_stmt:
-    ANALYZE TABLE table_name '(' column_list ')'
+    ANALYZE TABLE table_name
+    {
+        $$ = tree.NewAnalyzeStmt($3, nil)
+    }
+|   ANALYZE TABLE table_name '(' column_list ')'
     {
         $$ = tree.NewAnalyzeStmt($3, $5)
     }
+|   ANALYZE TABLE table_name UPDATE HISTOGRAM ON column_list
+    {
+        stmt := tree.NewAnalyzeStmt($3, nil)
+        stmt.HistogramCols = $7
+        $$ = stmt
+    }
 
 alter_stmt:
     alter_user_stmt
 |   alter_account_stmt
-// |    alter_ddl_stmt
+|   alter_task_stmt
+|   alter_table_stmt
+
+alter_table_stmt:
+    ALTER TABLE table_name alter_table_option_list
+    {
+        $$ = tree.NewAlterTable($3, $4)
+    }
+
+alter_table_option_list:
+    alter_table_option
+    {
+        $$ = tree.AlterTableOptions{$1}
+    }
+|   alter_table_option_list ',' alter_table_option
+    {
+        $$ = append($1, $3)
+    }
+
+alter_table_option:
+    ADD column_keyword_opt column_def
+    {
+        $$ = &tree.AlterOptionAdd{Def: $3}
+    }
+|   ADD constaint_def
+    {
+        $$ = &tree.AlterOptionAdd{Def: $2}
+    }
+|   DROP column_keyword_opt ident
+    {
+        $$ = &tree.AlterOptionDrop{Typ: tree.AlterTableDropColumn, Name: tree.Identifier($3)}
+    }
+|   DROP INDEX ident
+    {
+        $$ = &tree.AlterOptionDrop{Typ: tree.AlterTableDropIndex, Name: tree.Identifier($3)}
+    }
+|   DROP KEY ident
+    {
+        $$ = &tree.AlterOptionDrop{Typ: tree.AlterTableDropKey, Name: tree.Identifier($3)}
+    }
+|   DROP PRIMARY KEY
+    {
+        $$ = &tree.AlterOptionDrop{Typ: tree.AlterTableDropPrimaryKey}
+    }
+|   RENAME COLUMN ident TO ident
+    {
+        $$ = &tree.AlterOptionRenameColumn{OldName: tree.Identifier($3), NewName: tree.Identifier($5)}
+    }
+|   RENAME TO table_name
+    {
+        $$ = &tree.AlterOptionRenameTable{Name: $3}
+    }
+|   RENAME AS table_name
+    {
+        $$ = &tree.AlterOptionRenameTable{Name: $3}
+    }
+|   RENAME table_name
+    {
+        $$ = &tree.AlterOptionRenameTable{Name: $2}
+    }
+|   MODIFY column_keyword_opt column_def
+    {
+        $$ = &tree.AlterOptionModifyColumn{Def: $3}
+    }
+|   table_option
+    {
+        $$ = &tree.AlterOptionTableOption{Option: $1}
+    }
+
+column_keyword_opt:
+    {}
+|   COLUMN
+    {}
+
+rename_table_stmt:
+    RENAME TABLE table_name TO table_name
+    {
+        $$ = tree.NewAlterTable($3, tree.AlterTableOptions{&tree.AlterOptionRenameTable{Name: $5}})
+    }
+
+alter_task_stmt:
+    ALTER TASK ident SUSPEND
+    {
+        $$ = &tree.AlterTask{Name: $3, Option: tree.AlterTaskSuspend}
+    }
+|   ALTER TASK ident RESUME
+    {
+        $$ = &tree.AlterTask{Name: $3, Option: tree.AlterTaskResume}
+    }
+
+cancel_task_stmt:
+    CANCEL TASK ident
+    {
+        $$ = &tree.CancelTask{Name: $3}
+    }
 
 alter_account_stmt:
     ALTER ACCOUNT exists_opt account_name account_auth_option account_status_option account_comment_opt
@@ -1948,6 +2092,13 @@ show_stmt:
 |   show_status_stmt
 |   show_index_stmt
 |	show_target_filter_stmt
+|   show_tasks_stmt
+
+show_tasks_stmt:
+    SHOW TASKS like_opt
+    {
+        $$ = &tree.ShowTasks{Like: $3}
+    }
 
 show_target_filter_stmt:
 	SHOW CONFIG like_opt where_expression_opt
@@ -2177,6 +2328,17 @@ drop_ddl_stmt:
 |   drop_role_stmt
 |   drop_user_stmt
 |   drop_account_stmt
+|   drop_policy_stmt
+
+drop_policy_stmt:
+    DROP POLICY exists_opt ident ON table_name
+    {
+        $$ = &tree.DropPolicy{
+            IfExists: $3,
+            Name: tree.Identifier($4),
+            Table: *$6,
+        }
+    }
 
 drop_account_stmt:
     DROP ACCOUNT exists_opt account_name
@@ -2539,19 +2701,46 @@ export_data_param_opt:
     {
         $$ = nil
     }
-|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   INTO OUTFILE STRING load_format_opt export_compression_opt export_fields export_lines_opt header_opt export_null_opt max_file_size_opt force_quote_opt
     {
         $$ = &tree.ExportParam{
             Outfile:    true,
             FilePath :  $3,
-            Fields:     $4,
-            Lines:      $5,
-            Header:     $6,
-            MaxFileSize:uint64($7)*1024,
-            ForceQuote: $8,
+            FileFormat: $4,
+            Compression:$5,
+            Fields:     $6,
+            Lines:      $7,
+            Header:     $8,
+            NullValue:  $9,
+            MaxFileSize:uint64($10)*1024,
+            ForceQuote: $11,
         }
     }
 
+export_compression_opt:
+    {
+        $$ = ""
+    }
+|   COMPRESSION STRING
+    {
+        switch strings.ToLower($2) {
+        case tree.NOCOMPRESS, tree.GZIP, tree.ZSTD, tree.SNAPPY:
+            $$ = strings.ToLower($2)
+        default:
+            yylex.Error(fmt.Sprintf("the compression '%s' is not supported", $2))
+            return 1
+        }
+    }
+
+export_null_opt:
+    {
+        $$ = tree.NULL_VALUE
+    }
+|   NULL AS STRING
+    {
+        $$ = $3
+    }
+
 export_fields:
     {
         $$ = &tree.Fields{
@@ -3172,6 +3361,14 @@ outer_join:
     {
         $$ = tree.JOIN_TYPE_RIGHT
     }
+|   FULL JOIN
+    {
+        $$ = tree.JOIN_TYPE_FULL
+    }
+|   FULL OUTER JOIN
+    {
+        $$ = tree.JOIN_TYPE_FULL
+    }
 
 on_expression_opt:
     %prec JOIN
@@ -3248,8 +3445,53 @@ table_factor:
             },
         }
     }
+|   JSON_TABLE '(' expression ',' STRING COLUMNS '(' json_table_column_list ')' ')' as_opt ident
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: tree.NewJsonTable($3, $5, $8),
+            As: tree.AliasClause{
+                Alias: tree.Identifier($12),
+            },
+        }
+    }
 // |   '(' table_references ')'
 
+json_table_column_list:
+    json_table_column
+    {
+        $$ = []*tree.JsonTableColumn{$1}
+    }
+|   json_table_column_list ',' json_table_column
+    {
+        $$ = append($1, $3)
+    }
+
+json_table_column:
+    ident FOR ORDINALITY
+    {
+        $$ = &tree.JsonTableColumn{
+            Name: tree.Identifier($1),
+            Ordinality: true,
+        }
+    }
+|   ident column_type PATH STRING
+    {
+        $$ = &tree.JsonTableColumn{
+            Name: tree.Identifier($1),
+            Type: $2,
+            Path: $4,
+        }
+    }
+|   ident column_type EXISTS PATH STRING
+    {
+        $$ = &tree.JsonTableColumn{
+            Name: tree.Identifier($1),
+            Type: $2,
+            Exists: true,
+            Path: $5,
+        }
+    }
+
 derived_table:
     '(' select_no_parens ')'
     {
@@ -3273,7 +3515,9 @@ aliased_table_name:
 // |   table_name PARTITION '(' partition_id_list ')' as_opt_id index_hint_list
 
 
+// FULL after a table name starts a full join rather than being its alias
 as_opt_id:
+    %prec LOWER_THAN_ON
     {
         $$ = ""
     }
@@ -3332,6 +3576,55 @@ create_stmt:
 |   create_role_stmt
 |   create_user_stmt
 |   create_account_stmt
+|   create_task_stmt
+|   create_policy_stmt
+
+create_policy_stmt:
+    CREATE POLICY not_exists_opt ident ON table_name FOR policy_command TO role_name USING '(' expression ')'
+    {
+        $$ = &tree.CreatePolicy{
+            IfNotExists: $3,
+            Name: tree.Identifier($4),
+            Table: *$6,
+            Command: $8,
+            Role: $10,
+            Using: $13,
+        }
+    }
+
+policy_command:
+    SELECT
+    {
+        $$ = tree.PolicyCommandSelect
+    }
+|   UPDATE
+    {
+        $$ = tree.PolicyCommandUpdate
+    }
+|   DELETE
+    {
+        $$ = tree.PolicyCommandDelete
+    }
+
+create_task_stmt:
+    CREATE TASK not_exists_opt ident task_schedule_opt AS STRING
+    {
+        $$ = &tree.CreateTask{
+            IfNotExists: $3,
+            Name: $4,
+            Schedule: $5,
+            Body: $7,
+        }
+    }
+
+task_schedule_opt:
+    {
+        $$ = ""
+    }
+|   SCHEDULE STRING
+    {
+        $$ = $2
+    }
 
 create_ddl_stmt:
     create_table_stmt
@@ -3457,14 +3750,15 @@ account_comment_opt:
     }
 
 create_user_stmt:
-    CREATE USER not_exists_opt user_spec_list_of_create_user default_role_opt pwd_or_lck_opt user_comment_or_attribute_opt
+    CREATE USER not_exists_opt user_spec_list_of_create_user default_role_opt require_clause_opt pwd_or_lck_opt user_comment_or_attribute_opt
     {
         $$ = &tree.CreateUser{
             IfNotExists: $3,
             Users: $4,
             Role: $5,
-            MiscOpt: $6,
-            CommentOrAttribute: $7,
+            TlsOpts: $6,
+            MiscOpt: $7,
+            CommentOrAttribute: $8,
         }
     }
 
@@ -3535,64 +3829,68 @@ user_comment_or_attribute_opt:
 //    }
 
 
-//require_clause_opt:
-//    {
-//        $$ = nil
-//    }
-//|   require_clause
-//
-//require_clause:
-//    REQUIRE NONE
-//    {
-//        t := &tree.TlsOptionNone{}
-//        $$ = []tree.TlsOption{t}
-//    }
-//|   REQUIRE SSL
-//    {
-//        t := &tree.TlsOptionSSL{}
-//        $$ = []tree.TlsOption{t}
-//    }
-//|   REQUIRE X509
-//    {
-//        t := &tree.TlsOptionX509{}
-//        $$ = []tree.TlsOption{t}
-//    }
-//|   REQUIRE require_list
-//    {
-//        $$ = $2
-//    }
-//
-//require_list:
-//    require_elem
-//    {
-//        $$ = []tree.TlsOption{$1}
-//    }
-//|   require_list AND require_elem
-//    {
-//        $$ = append($1, $3)
-//    }
-//|   require_list require_elem
-//    {
-//        $$ = append($1, $2)
-//    }
-//
-//require_elem:
-//    ISSUER STRING
-//    {
-//        $$ = &tree.TlsOptionIssuer{Issuer: $2}
-//    }
-//|   SUBJECT STRING
-//    {
-//        $$ = &tree.TlsOptionSubject{Subject: $2}
-//    }
-//|   CIPHER STRING
-//    {
-//        $$ = &tree.TlsOptionCipher{Cipher: $2}
-//    }
-//|   SAN STRING
-//    {
-//        $$ = &tree.TlsOptionSan{San: $2}
-//    }
+require_clause_opt:
+    {
+        $$ = nil
+    }
+|   require_clause
+    {
+        $$ = $1
+    }
+
+require_clause:
+    REQUIRE NONE
+    {
+        t := &tree.TlsOptionNone{}
+        $$ = []tree.TlsOption{t}
+    }
+|   REQUIRE SSL
+    {
+        t := &tree.TlsOptionSSL{}
+        $$ = []tree.TlsOption{t}
+    }
+|   REQUIRE X509
+    {
+        t := &tree.TlsOptionX509{}
+        $$ = []tree.TlsOption{t}
+    }
+|   REQUIRE require_list
+    {
+        $$ = $2
+    }
+
+require_list:
+    require_elem
+    {
+        $$ = []tree.TlsOption{$1}
+    }
+|   require_list AND require_elem
+    {
+        $$ = append($1, $3)
+    }
+|   require_list require_elem
+    {
+        $$ = append($1, $2)
+    }
+
+require_elem:
+    ISSUER STRING
+    {
+        $$ = &tree.TlsOptionIssuer{Issuer: $2}
+    }
+|   SUBJECT STRING
+    {
+        $$ = &tree.TlsOptionSubject{Subject: $2}
+    }
+|   CIPHER STRING
+    {
+        $$ = &tree.TlsOptionCipher{Cipher: $2}
+    }
+|   SAN STRING
+    {
+        $$ = &tree.TlsOptionSan{San: $2}
+    }
+
 user_spec_list_of_create_user:
     user_spec_with_identified
     {
@@ -3677,6 +3975,14 @@ user_identified:
 		Str: $3,
 	}
     }
+|   IDENTIFIED WITH name_string BY STRING
+    {
+	$$ = &tree.AccountIdentified{
+		Typ: tree.AccountIdentifiedByPassword,
+		Str: $5,
+		AuthPlugin: $3,
+	}
+    }
 
 name_string:
     ident
@@ -3941,6 +4247,28 @@ load_param_opt_2:
     }
 
 load_param_opt:
+    infile_param load_format_opt
+    {
+        $$ = $1
+        $$.Format = $2
+    }
+
+load_format_opt:
+    {
+        $$ = tree.CSV
+    }
+|   FORMAT STRING
+    {
+        switch strings.ToLower($2) {
+        case tree.CSV, tree.PARQUET, tree.JSONLINE:
+            $$ = strings.ToLower($2)
+        default:
+            yylex.Error(fmt.Sprintf("the format '%s' is not supported", $2))
+            return 1
+        }
+    }
+
+infile_param:
     INFILE STRING
     {
         $$ = &tree.ExternParam{
@@ -4486,6 +4814,16 @@ constaint_def:
 			switch v := $2.(type) {
             case *tree.PrimaryKeyIndex:
             	v.Name = $1
+            case *tree.UniqueIndex:
+            	if v.Name == "" {
+            		v.Name = $1
+            	}
+            case *tree.ForeignKey:
+            	if v.Name == "" {
+            		v.Name = $1
+            	}
+            case *tree.CheckIndex:
+            	v.Name = $1
             }
 		}
 		$$ = $2
@@ -4566,7 +4904,7 @@ constraint_elem:
 
 enforce_opt:
 	{
-		$$ = false
+		$$ = true
 	}
 |	enforce
 
@@ -4721,7 +5059,7 @@ column_attribute_elem:
     }
 |   constraint_keyword_opt CHECK '(' expression ')'
     {
-        $$ = tree.NewAttributeCheck($4, false, $1)
+        $$ = tree.NewAttributeCheck($4, true, $1)
     }
 |   constraint_keyword_opt CHECK '(' expression ')' enforce
     {
@@ -4966,6 +5304,28 @@ simple_expr:
     {
         $$ = $1
     }
+|   normal_ident JSON_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        path := tree.NewNumValWithType(constant.MakeString($3), $3, false, tree.P_char)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, path},
+        }
+    }
+|   normal_ident JSON_UNQUOTE_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        path := tree.NewNumValWithType(constant.MakeString($3), $3, false, tree.P_char)
+        extract := &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, path},
+        }
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_unquote")),
+            Exprs: tree.Exprs{extract},
+        }
+    }
 |   variable
     {
         $$ = $1
@@ -5052,6 +5412,10 @@ simple_expr:
     {
         $$ = $1
     }
+|   function_call_window
+    {
+        $$ = $1
+    }
 | function_call_json
     {
         $$ = $1
@@ -5197,7 +5561,7 @@ mysql_cast_type:
 	        },
         }
     }
-|   TIME length_opt
+|   TIME timestamp_option_opt
     {
         locale := ""
         $$ = &tree.T{
@@ -5205,7 +5569,7 @@ mysql_cast_type:
 		        Family: tree.TimeFamily,
                 FamilyString: $1,
                 DisplayWith: $2,
-		        Precision: 0,
+		        Precision: $2,
 		        TimePrecisionIsSet: false,
 		        Locale: &locale,
 		        Oid: uint32(defines.MYSQL_TYPE_TIME),
@@ -5384,6 +5748,87 @@ function_call_aggregate:
         }
     }
 
+function_call_window:
+    function_call_generic window_spec
+    {
+        $1.WindowSpec = $2
+        $$ = $1
+    }
+|   function_call_aggregate window_spec
+    {
+        $1.WindowSpec = $2
+        $$ = $1
+    }
+
+window_spec:
+    OVER '(' window_partition_by_opt order_by_opt window_frame_clause_opt ')'
+    {
+        $$ = tree.NewWindowSpec($3, $4, $5)
+    }
+
+window_partition_by_opt:
+    {
+        $$ = nil
+    }
+|   PARTITION BY expression_list
+    {
+        $$ = $3
+    }
+
+window_frame_clause_opt:
+    {
+        $$ = nil
+    }
+|   window_frame_units window_frame_bound
+    {
+        $$ = &tree.FrameClause{
+            Type: $1,
+            Start: $2,
+            End: &tree.FrameBound{Type: tree.CurrentRow},
+        }
+    }
+|   window_frame_units BETWEEN window_frame_bound AND window_frame_bound
+    {
+        $$ = &tree.FrameClause{
+            Type: $1,
+            HasEnd: true,
+            Start: $3,
+            End: $5,
+        }
+    }
+
+window_frame_units:
+    ROWS
+    {
+        $$ = tree.Rows
+    }
+|   RANGE
+    {
+        $$ = tree.Range
+    }
+
+window_frame_bound:
+    CURRENT ROW
+    {
+        $$ = &tree.FrameBound{Type: tree.CurrentRow}
+    }
+|   UNBOUNDED PRECEDING
+    {
+        $$ = &tree.FrameBound{Type: tree.Preceding, UnBounded: true}
+    }
+|   UNBOUNDED FOLLOWING
+    {
+        $$ = &tree.FrameBound{Type: tree.Following, UnBounded: true}
+    }
+|   simple_expr PRECEDING
+    {
+        $$ = &tree.FrameBound{Type: tree.Preceding, Expr: $1}
+    }
+|   simple_expr FOLLOWING
+    {
+        $$ = &tree.FrameBound{Type: tree.Following, Expr: $1}
+    }
+
 std_dev_pop:
     STD
 |   STDDEV
@@ -5431,6 +5876,14 @@ function_call_generic:
              Exprs: tree.Exprs{timeUinit, $5},
        }
     }
+|	POSITION '(' bit_expr IN expression ')'
+	{
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
 |	func_not_keyword '(' expression_list_opt ')'
 	{
         name := tree.SetUnresolvedName(strings.ToLower($1))
@@ -5468,9 +5921,10 @@ function_call_generic:
 |	TRIM '(' expression FROM expression ')'
 	{
 		name := tree.SetUnresolvedName(strings.ToLower($1))
+		arg1 := tree.NewNumValWithType(constant.MakeString("both"), "both", false, tree.P_char)
         $$ = &tree.FuncExpr{
              Func: tree.FuncName2ResolvableFunctionReference(name),
-             Exprs: tree.Exprs{$3},
+             Exprs: tree.Exprs{arg1, $3, $5},
         }
 	}
 |	TRIM '(' trim_direction FROM expression ')'
@@ -5746,11 +6200,13 @@ name_confict:
 |   IF
 |   INTERVAL
 |   FORMAT
+|   HASH
 |   LEFT
 |   MICROSECOND
 |   MINUTE
 |   MONTH
 |   QUARTER
+|   REGEXP
 |   REPEAT
 |   REPLACE
 |   REVERSE
@@ -6426,19 +6882,24 @@ time_type:
 	        },
         }
     }
-|   TIME length_opt
+|   TIME timestamp_option_opt
     {
         locale := ""
-        $$ = &tree.T{
-            InternalType: tree.InternalType{
-		        Family: tree.TimeFamily,
-                FamilyString: $1,
-                DisplayWith: $2,
-		        Precision: 0,
-		        TimePrecisionIsSet: false,
-		        Locale: &locale,
-		        Oid: uint32(defines.MYSQL_TYPE_TIME),
+        if $2 < 0 || $2 > 6 {
+        		yylex.Error("For Time(fsp), fsp must in [0, 6]")
+        		return 1
+                } else {
+                $$ = &tree.T{
+            		InternalType: tree.InternalType{
+		        Family:             tree.TimeFamily,
+		        Precision:          $2,
+                	FamilyString: $1,
+                	DisplayWith: 26,
+		        TimePrecisionIsSet: true,
+		        Locale:             &locale,
+		        Oid:                uint32(defines.MYSQL_TYPE_TIME),
 	        },
+	    }
         }
     }
 |   TIMESTAMP timestamp_option_opt
@@ -7022,9 +7483,13 @@ reserved_keyword:
 |   PASSWORD_LOCK_TIME
 |   UNBOUNDED
 |   SECONDARY
+|   OVER
 
 non_reserved_keyword:
     ACCOUNT
+|   MODIFY
+|   ORDINALITY
+|   PATH
 |   AGAINST
 |   AVG_ROW_LENGTH
 |   AUTO_RANDOM
@@ -7189,6 +7654,15 @@ non_reserved_keyword:
 |   TABLES
 |   EXTERNAL
 |   URL
+|   PRECEDING
+|   FOLLOWING
+|   HISTOGRAM
+|   TASK
+|   TASKS
+|   SCHEDULE
+|   RESUME
+|   CANCEL
+|   POLICY
 
 func_not_keyword:
 	DATE_ADD
@@ -7196,7 +7670,6 @@ func_not_keyword:
 |   NOW
 |	ADDDATE
 |   CURDATE
-|   POSITION
 |   SESSION_USER
 |   SUBDATE
 |   SYSTEM_USER
@@ -62,6 +62,21 @@ var (
 		output: "commit",
 	}, {
 		input: "select * from tables",
+	}, {
+		input:  "select a, row_number() over (partition by b order by c desc) from t1",
+		output: "select a, row_number() over (partition by b order by c desc) from t1",
+	}, {
+		input:  "select rank() over (order by a), dense_rank() over (order by a) from t1",
+		output: "select rank() over (order by a), dense_rank() over (order by a) from t1",
+	}, {
+		input:  "select sum(a) over (partition by b order by c rows between unbounded preceding and current row) from t1",
+		output: "select sum(a) over (partition by b order by c rows between unbounded preceding and current row) from t1",
+	}, {
+		input:  "select avg(a) over (order by c range between 2 preceding and 1 following) from t1",
+		output: "select avg(a) over (order by c range between 2 preceding and 1 following) from t1",
+	}, {
+		input:  "select lag(a, 1, 0) over (order by c rows 3 preceding), ntile(4) over () from t1",
+		output: "select lag(a, 1, 0) over (order by c rows 3 preceding), ntile(4) over () from t1",
 	}, {
 		input: "update t1 set a = default",
 	}, {
@@ -327,6 +342,9 @@ var (
 	}, {
 		input:  "create table t1 (a varchar)",
 		output: "create table t1 (a varchar)",
+	}, {
+		input:  "create table t1 (a varchar, b int) compression = 'zstd, a=dict'",
+		output: "create table t1 (a varchar, b int) compression = zstd, a=dict",
 	}, {
 		input:  "SELECT (CAST(0x7FFFFFFFFFFFFFFF AS char));",
 		output: "select (cast(0x7fffffffffffffff as char))",
@@ -369,6 +387,8 @@ var (
 		input: "with tw as (select * from t2), tf as (select * from t3) select * from tw where a > 1",
 	}, {
 		input: "with tw as (select * from t2) select * from tw where a > 1",
+	}, {
+		input: "with recursive c(n) as (select 1 union all select n + 1 from c where n < 5) select * from c",
 	}, {
 		input:  "create table t (a double(13))  // comment",
 		output: "create table t (a double(13))",
@@ -447,6 +467,17 @@ var (
 		output: "create table t (a int) properties(a = b)",
 	}, {
 		input: "create table t (a int, b char, check (1 + 1) enforced)",
+	}, {
+		input:  "create table t (a int, b char, constraint c1 check (a > 0))",
+		output: "create table t (a int, b char, constraint c1 check (a > 0) enforced)",
+	}, {
+		input: "create table t (a int, b char, check (a > 0) not enforced)",
+	}, {
+		input:  "create table t (a int, b char, constraint u1 unique key (a, b))",
+		output: "create table t (a int, b char, unique key u1 (a, b))",
+	}, {
+		input:  "create table t (a int, b char, constraint fk1 foreign key (a) references p(a) on delete cascade)",
+		output: "create table t (a int, b char, foreign key fk1 (a) references p(a) on delete cascade)",
 	}, {
 		input: "create table t (a int, b char, foreign key sdf (a, b) references b(a asc, b desc))",
 	}, {
@@ -472,6 +503,18 @@ var (
 	}, {
 		input:  "create external table t (a int) infile {'filepath'='data.txt', 'compression'='lz4'}",
 		output: "create external table t (a int) infile {'filepath':'data.txt', 'compression':'lz4'}",
+	}, {
+		input:  "create external table t (a int) infile 'data.parquet' format 'PARQUET'",
+		output: "create external table t (a int) infile 'data.parquet' format 'parquet'",
+	}, {
+		input:  "create external table t (a int) infile {'filepath'='data.json.gz', 'compression'='gzip'} format 'jsonline'",
+		output: "create external table t (a int) infile {'filepath':'data.json.gz', 'compression':'gzip'} format 'jsonline'",
+	}, {
+		input:  "create external table t (a int) infile 'data.csv' format 'csv'",
+		output: "create external table t (a int) infile 'data.csv'",
+	}, {
+		input:  "load data infile 'data.parquet' format 'parquet' into table t",
+		output: "load data infile data.parquet format 'parquet' into table t",
 	}, {
 		input:  "create external table t (a int) infile 'data.txt' FIELDS TERMINATED BY '' OPTIONALLY ENCLOSED BY '' LINES TERMINATED BY ''",
 		output: "create external table t (a int) infile 'data.txt' fields terminated by \t optionally enclosed by \u0000 lines",
@@ -729,6 +772,9 @@ var (
 		input: "create table a (a int) partition by range(1 + 21)",
 	}, {
 		input: "create table a (a int storage disk constraint cx check (b + c) enforced)",
+	}, {
+		input:  "create table a (a int check (a > 1))",
+		output: "create table a (a int constraint check (a > 1) enforced)",
 	}, {
 		input: "create table a (a int storage disk, b int references b(a asc, b desc) match full on delete cascade on update restrict)",
 	}, {
@@ -1109,7 +1155,7 @@ var (
 			input: "create table t (a float(20, 20) not null, b int(20) null, c int(30) null)",
 		}, {
 			input:  "create table t1 (t time(3) null, dt datetime(6) null, ts timestamp(1) null)",
-			output: "create table t1 (t time(3) null, dt datetime(26, 6) null, ts timestamp(26, 1) null)",
+			output: "create table t1 (t time(26, 3) null, dt datetime(26, 6) null, ts timestamp(26, 1) null)",
 		}, {
 			input:  "create table t1 (a int default 1 + 1 - 2 * 3 / 4 div 7 ^ 8 << 9 >> 10 % 11)",
 			output: "create table t1 (a int default 1 + 1 - 2 * 3 / 4 div 7 ^ 8 << 9 >> 10 % 11)",
@@ -1169,6 +1215,11 @@ var (
 		}, {
 			input:  "select * from t1 right outer join t2 on 123",
 			output: "select * from t1 right join t2 on 123",
+		}, {
+			input:  "select * from t1 full outer join t2 on t1.a = t2.a",
+			output: "select * from t1 full join t2 on t1.a = t2.a",
+		}, {
+			input: "select * from t1 as full full join t2 using (a)",
 		}, {
 			input: "select * from t1 natural left join t2",
 		}, {
@@ -1178,6 +1229,42 @@ var (
 		}, {
 			input:  "analyze table part (a,b )",
 			output: "analyze table part(a, b)",
+		}, {
+			input:  "analyze table part",
+			output: "analyze table part",
+		}, {
+			input:  "analyze table db.part update histogram on a, b",
+			output: "analyze table db.part update histogram on a, b",
+		}, {
+			input:  "create task t1 as 'insert into t select * from s'",
+			output: "create task t1 as 'insert into t select * from s'",
+		}, {
+			input:  "create task if not exists t1 schedule '0 */5 * * * *' as 'delete from t'",
+			output: "create task if not exists t1 schedule '0 */5 * * * *' as 'delete from t'",
+		}, {
+			input:  "show tasks",
+			output: "show tasks",
+		}, {
+			input:  "show tasks like 't%'",
+			output: "show tasks like t%",
+		}, {
+			input:  "cancel task t1",
+			output: "cancel task t1",
+		}, {
+			input:  "alter task t1 suspend",
+			output: "alter task t1 suspend",
+		}, {
+			input:  "alter task t1 resume",
+			output: "alter task t1 resume",
+		}, {
+			input:  "create policy p1 on t1 for select to r1 using (a > 1 and b = 'x')",
+			output: "create policy p1 on t1 for select to r1 using (a > 1 and b = x)",
+		}, {
+			input:  "create policy if not exists p1 on db.t1 for delete to r1 using (owner = current_user())",
+			output: "create policy if not exists p1 on db.t1 for delete to r1 using (owner = current_user())",
+		}, {
+			input:  "drop policy if exists p1 on t1",
+			output: "drop policy if exists p1 on t1",
 		}, {
 			input:  "select $ from t into outfile '/Users/tmp/test'",
 			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
@@ -1199,6 +1286,18 @@ var (
 		}, {
 			input:  "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY ',' LINES TERMINATED BY '\n' header 'FALSE' MAX_FILE_SIZE 100 FORCE_QUOTE (a, b)",
 			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header false max_file_size 102400 force_quote a, b",
+		},
+		{
+			input:  "select a from t into outfile 's3:exports/a.parquet' FORMAT 'Parquet' COMPRESSION 'ZSTD' MAX_FILE_SIZE 100",
+			output: "select a from t into outfile s3:exports/a.parquet format 'parquet' compression 'zstd' fields terminated by , enclosed by \" lines terminated by \n header true max_file_size 102400",
+		},
+		{
+			input:  "select a from t into outfile '/tmp/a.csv.gz' FORMAT 'csv' COMPRESSION 'gzip' header 'TRUE' NULL AS ''",
+			output: "select a from t into outfile /tmp/a.csv.gz compression 'gzip' fields terminated by , enclosed by \" lines terminated by \n header true null as ''",
+		},
+		{
+			input:  "select a from t into outfile '/tmp/a.jl' FORMAT 'jsonline' NULL AS '\\\\N'",
+			output: "select a from t into outfile /tmp/a.jl format 'jsonline' fields terminated by , enclosed by \" lines terminated by \n header true",
 		}, {
 			input: "drop prepare stmt_name1",
 		}, {
@@ -1228,6 +1327,35 @@ var (
 			input: "create account nihao admin_name 'admin' identified with '123'",
 		}, {
 			input: "drop account if exists abc",
+		}, {
+			input:  "alter table t1 add c int default 1",
+			output: "alter table t1 add column c int default 1",
+		}, {
+			input:  "alter table db.t1 add column c varchar(20) comment 'x', drop column d",
+			output: "alter table db.t1 add column c varchar(20) comment x, drop column d",
+		}, {
+			input:  "alter table t1 add index idx (a, b), drop key idx2",
+			output: "alter table t1 add index idx (a, b), drop key idx2",
+		}, {
+			input:  "alter table t1 add unique key uk (a)",
+			output: "alter table t1 add unique key uk (a)",
+		}, {
+			input:  "alter table t1 drop a, drop index idx, drop primary key",
+			output: "alter table t1 drop column a, drop index idx, drop primary key",
+		}, {
+			input: "alter table t1 rename column a to b",
+		}, {
+			input:  "alter table t1 modify a bigint not null",
+			output: "alter table t1 modify column a bigint not null",
+		}, {
+			input:  "alter table t1 rename as t2",
+			output: "alter table t1 rename to t2",
+		}, {
+			input:  "rename table t1 to db.t2",
+			output: "alter table t1 rename to db.t2",
+		}, {
+			input:  "alter table t1 comment = 'abc'",
+			output: "alter table t1 comment = abc",
 		}, {
 			input: "alter account if exists nihao admin_name 'admin' identified by '123' open comment 'new account'",
 		}, {
@@ -1275,6 +1403,16 @@ var (
 				"default role de_role",
 		}, {
 			input: "create user abc1 identified by 'a111'",
+		}, {
+			input:  "create user abc1 identified with caching_sha2_password by 'a111' require ssl",
+			output: "create user abc1 identified with caching_sha2_password by 'a111' require ssl",
+		}, {
+			input: "create user abc1 identified by 'a111' require x509",
+		}, {
+			input:  "create user abc1 identified by 'a111' require subject '/C=SE/CN=abc1' issuer '/C=SE/CN=ca'",
+			output: "create user abc1 identified by 'a111' require subject '/C=SE/CN=abc1' and issuer '/C=SE/CN=ca'",
+		}, {
+			input: "create user abc1 identified by 'a111' require cipher 'TLS_AES_128_GCM_SHA256' and subject '/CN=abc1' lock",
 		}, {
 			input: "drop user if exists abc1, abc2, abc3",
 		}, {
@@ -1369,6 +1507,23 @@ var (
 		}, {
 			input:  `select json_extract(a, '$.b') from t`,
 			output: `select json_extract(a, $.b) from t`,
+		}, {
+			input:  `select a->'$.b', t.a->>'$.c' from t`,
+			output: `select json_extract(a, $.b), json_unquote(json_extract(t.a, $.c)) from t`,
+		}, {
+			input:  `select json_object('a', 1, 'b', json_array(1, 2)), json_set(a, '$.b', 2) from t`,
+			output: `select json_object(a, 1, b, json_array(1, 2)), json_set(a, $.b, 2) from t`,
+		}, {
+			input:  `select * from t, json_table(t.a, '$[*]' columns(id for ordinality, b int path '$.b', c int exists path '$.c')) as jt`,
+			output: `select * from t, json_table(t.a, '$[*]' columns(id for ordinality, b int path '$.b', c int exists path '$.c')) as jt`,
+		}, {
+			input:  "select trim(leading 'x' from a), trim(both from a), trim(a) from t",
+			output: "select trim(leading, x, a), trim(both, a), trim(a) from t",
+		}, {
+			input:  "select position('a' in b), hash(a, b), regexp(a, 'x') from t where a regexp 'b'",
+			output: "select position(a, b), hash(a, b), regexp(a, x) from t where a reg_match b",
+		}, {
+			input: `select path from t where ordinality = 1`,
 		}, {
 			input: `create table t1 (a int, b uuid)`,
 		}, {
@@ -183,10 +183,11 @@ func (s *Scanner) stepBackOneChar(ch uint16) (int, string) {
 			}
 		case '>':
 			s.inc()
-			// TODO:
-			// JSON_UNQUOTE_EXTRACT_OP
-			// JSON_EXTRACT_OP
-			return 0, ""
+			if s.cur() == '>' {
+				s.inc()
+				return JSON_UNQUOTE_EXTRACT_OP, ""
+			}
+			return JSON_EXTRACT_OP, ""
 		}
 		return int(ch), ""
 	case '<':
@@ -79,3 +79,127 @@ func (ca *AlterAccount) Format(ctx *FmtCtx) {
 	ca.StatusOption.Format(ctx)
 	ca.Comment.Format(ctx)
 }
+
+type AlterTable struct {
+	statementImpl
+	Table   *TableName
+	Options AlterTableOptions
+}
+
+func (node *AlterTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter table ")
+	node.Table.Format(ctx)
+	prefix := " "
+	for _, opt := range node.Options {
+		ctx.WriteString(prefix)
+		opt.Format(ctx)
+		prefix = ", "
+	}
+}
+
+func NewAlterTable(table *TableName, opts AlterTableOptions) *AlterTable {
+	return &AlterTable{
+		Table:   table,
+		Options: opts,
+	}
+}
+
+type AlterTableOptions []AlterTableOption
+
+// AlterTableOption is a single change listed in an ALTER TABLE statement.
+type AlterTableOption interface {
+	NodeFormatter
+}
+
+type alterOptionImpl struct {
+	AlterTableOption
+}
+
+// AlterOptionAdd adds a column or an index.
+type AlterOptionAdd struct {
+	alterOptionImpl
+	Def TableDef
+}
+
+func (node *AlterOptionAdd) Format(ctx *FmtCtx) {
+	ctx.WriteString("add ")
+	if _, ok := node.Def.(*ColumnTableDef); ok {
+		ctx.WriteString("column ")
+	}
+	node.Def.Format(ctx)
+}
+
+type AlterTableDropType int
+
+const (
+	AlterTableDropColumn AlterTableDropType = iota
+	AlterTableDropIndex
+	AlterTableDropKey
+	AlterTableDropPrimaryKey
+)
+
+// AlterOptionDrop drops a column, an index or the primary key.
+type AlterOptionDrop struct {
+	alterOptionImpl
+	Typ  AlterTableDropType
+	Name Identifier
+}
+
+func (node *AlterOptionDrop) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop ")
+	switch node.Typ {
+	case AlterTableDropColumn:
+		ctx.WriteString("column ")
+	case AlterTableDropIndex:
+		ctx.WriteString("index ")
+	case AlterTableDropKey:
+		ctx.WriteString("key ")
+	case AlterTableDropPrimaryKey:
+		ctx.WriteString("primary key")
+		return
+	}
+	node.Name.Format(ctx)
+}
+
+type AlterOptionRenameColumn struct {
+	alterOptionImpl
+	OldName Identifier
+	NewName Identifier
+}
+
+func (node *AlterOptionRenameColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename column ")
+	node.OldName.Format(ctx)
+	ctx.WriteString(" to ")
+	node.NewName.Format(ctx)
+}
+
+type AlterOptionModifyColumn struct {
+	alterOptionImpl
+	Def *ColumnTableDef
+}
+
+func (node *AlterOptionModifyColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("modify column ")
+	node.Def.Format(ctx)
+}
+
+type AlterOptionRenameTable struct {
+	alterOptionImpl
+	Name *TableName
+}
+
+func (node *AlterOptionRenameTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename to ")
+	node.Name.Format(ctx)
+}
+
+// AlterOptionTableOption changes a table option such as the comment.
+type AlterOptionTableOption struct {
+	alterOptionImpl
+	Option TableOption
+}
+
+func (node *AlterOptionTableOption) Format(ctx *FmtCtx) {
+	node.Option.Format(ctx)
+}
@@ -14,19 +14,28 @@
 
 package tree
 
-// Use statement
+// Analyze statement
 type AnalyzeStmt struct {
 	statementImpl
 	Table *TableName
-	Cols  IdentifierList
+	// Cols restricts the analyzed columns, all columns are analyzed if it is empty
+	Cols IdentifierList
+	// HistogramCols are the columns to build histograms for
+	HistogramCols IdentifierList
 }
 
 func (node *AnalyzeStmt) Format(ctx *FmtCtx) {
 	ctx.WriteString("analyze table ")
 	node.Table.Format(ctx)
-	ctx.WriteString("(")
-	node.Cols.Format(ctx)
-	ctx.WriteString(")")
+	if len(node.Cols) > 0 {
+		ctx.WriteString("(")
+		node.Cols.Format(ctx)
+		ctx.WriteString(")")
+	}
+	if len(node.HistogramCols) > 0 {
+		ctx.WriteString(" update histogram on ")
+		node.HistogramCols.Format(ctx)
+	}
 }
 
 func NewAnalyzeStmt(tbl *TableName, cols IdentifierList) *AnalyzeStmt {
@@ -178,6 +178,7 @@ func (node *CreateTable) Format(ctx *FmtCtx) {
 			ctx.WriteString(" infile ")
 			ctx.WriteString("{'filepath':'" + node.Param.Filepath + "', 'compression':'" + strings.ToLower(node.Param.CompressType) + "'}")
 		}
+		node.Param.formatFormat(ctx)
 		if node.Param.Tail.Fields != nil {
 			ctx.WriteByte(' ')
 			node.Param.Tail.Fields.Format(ctx)
@@ -954,16 +955,24 @@ func NewFullTextIndex(k []*KeyPart, n string, e bool, io *IndexOption) *FullText
 
 type CheckIndex struct {
 	tableDefImpl
+	Name     string
 	Expr     Expr
 	Enforced bool
 }
 
 func (node *CheckIndex) Format(ctx *FmtCtx) {
+	if node.Name != "" {
+		ctx.WriteString("constraint ")
+		ctx.WriteString(node.Name)
+		ctx.WriteByte(' ')
+	}
 	ctx.WriteString("check (")
 	node.Expr.Format(ctx)
 	ctx.WriteByte(')')
 	if node.Enforced {
 		ctx.WriteString(" enforced")
+	} else {
+		ctx.WriteString(" not enforced")
 	}
 }
 
@@ -2008,8 +2017,7 @@ type TlsOptionCipher struct {
 }
 
 func (node *TlsOptionCipher) Format(ctx *FmtCtx) {
-	ctx.WriteString("cipher ")
-	ctx.WriteString(node.Cipher)
+	ctx.WriteString(fmt.Sprintf("cipher '%s'", node.Cipher))
 }
 
 type TlsOptionIssuer struct {
@@ -2018,8 +2026,7 @@ type TlsOptionIssuer struct {
 }
 
 func (node *TlsOptionIssuer) Format(ctx *FmtCtx) {
-	ctx.WriteString("issuer ")
-	ctx.WriteString(node.Issuer)
+	ctx.WriteString(fmt.Sprintf("issuer '%s'", node.Issuer))
 }
 
 type TlsOptionSubject struct {
@@ -2028,8 +2035,7 @@ type TlsOptionSubject struct {
 }
 
 func (node *TlsOptionSubject) Format(ctx *FmtCtx) {
-	ctx.WriteString("subject ")
-	ctx.WriteString(node.Subject)
+	ctx.WriteString(fmt.Sprintf("subject '%s'", node.Subject))
 }
 
 type TlsOptionSan struct {
@@ -2038,8 +2044,7 @@ type TlsOptionSan struct {
 }
 
 func (node *TlsOptionSan) Format(ctx *FmtCtx) {
-	ctx.WriteString("san ")
-	ctx.WriteString(node.San)
+	ctx.WriteString(fmt.Sprintf("san '%s'", node.San))
 }
 
 type ResourceOption interface {
@@ -2238,7 +2243,9 @@ type CreateUser struct {
 	IfNotExists bool
 	Users       []*User
 	Role        *Role
-	MiscOpt     UserMiscOption
+	// require ssl, x509 or the certificate
+	TlsOpts []TlsOption
+	MiscOpt UserMiscOption
 	// comment or attribute
 	CommentOrAttribute AccountCommentOrAttribute
 }
@@ -2263,6 +2270,16 @@ func (node *CreateUser) Format(ctx *FmtCtx) {
 		node.Role.Format(ctx)
 	}
 
+	if len(node.TlsOpts) != 0 {
+		ctx.WriteString(" require")
+		prefix := " "
+		for _, t := range node.TlsOpts {
+			ctx.WriteString(prefix)
+			t.Format(ctx)
+			prefix = " and "
+		}
+	}
+
 	if node.MiscOpt != nil {
 		ctx.WriteString(" ")
 		node.MiscOpt.Format(ctx)
@@ -2331,12 +2348,18 @@ const (
 type AccountIdentified struct {
 	Typ AccountIdentifiedOption
 	Str string
+	// the authentication plugin, it is empty if it is not specified
+	AuthPlugin string
 }
 
 func (node *AccountIdentified) Format(ctx *FmtCtx) {
 	switch node.Typ {
 	case AccountIdentifiedByPassword:
-		ctx.WriteString(fmt.Sprintf(" identified by '%s'", node.Str))
+		if len(node.AuthPlugin) != 0 {
+			ctx.WriteString(fmt.Sprintf(" identified with %s by '%s'", node.AuthPlugin, node.Str))
+		} else {
+			ctx.WriteString(fmt.Sprintf(" identified by '%s'", node.Str))
+		}
 	case AccountIdentifiedByRandomPassword:
 		ctx.WriteString(" identified by random password")
 	case AccountIdentifiedWithSSL:
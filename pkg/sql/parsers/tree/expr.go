@@ -545,6 +545,9 @@ type FuncExpr struct {
 
 	//aggregations which specify an order.
 	OrderBy OrderBy
+
+	//window functions which specify an OVER clause.
+	WindowSpec *WindowSpec
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -557,6 +560,11 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 	}
 	node.Exprs.Format(ctx)
 	ctx.WriteByte(')')
+
+	if node.WindowSpec != nil {
+		ctx.WriteByte(' ')
+		node.WindowSpec.Format(ctx)
+	}
 }
 
 func NewFuncExpr(ft FuncType, name *UnresolvedName, e Exprs, order OrderBy) *FuncExpr {
@@ -0,0 +1,73 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "fmt"
+
+// JsonTable is JSON_TABLE(expr, path COLUMNS (...)), every value matched by
+// the path in the document makes a row.
+type JsonTable struct {
+	TableExpr
+	Expr    Expr
+	Path    string
+	Columns []*JsonTableColumn
+}
+
+func (node *JsonTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("json_table(")
+	node.Expr.Format(ctx)
+	ctx.WriteString(fmt.Sprintf(", '%s' columns(", node.Path))
+	for i, c := range node.Columns {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		c.Format(ctx)
+	}
+	ctx.WriteString("))")
+}
+
+func NewJsonTable(e Expr, path string, cols []*JsonTableColumn) *JsonTable {
+	return &JsonTable{
+		Expr:    e,
+		Path:    path,
+		Columns: cols,
+	}
+}
+
+// JsonTableColumn is a column of JSON_TABLE, it is the row number for
+// FOR ORDINALITY, whether the path matches for EXISTS PATH, or the value
+// at the path relative to the row.
+type JsonTableColumn struct {
+	NodeFormatter
+	Name       Identifier
+	Type       ResolvableTypeReference
+	Ordinality bool
+	Exists     bool
+	Path       string
+}
+
+func (node *JsonTableColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString(string(node.Name))
+	if node.Ordinality {
+		ctx.WriteString(" for ordinality")
+		return
+	}
+	ctx.WriteByte(' ')
+	node.Type.(*T).InternalType.Format(ctx)
+	if node.Exists {
+		ctx.WriteString(" exists")
+	}
+	ctx.WriteString(fmt.Sprintf(" path '%s'", node.Path))
+}
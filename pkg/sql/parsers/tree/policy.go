@@ -0,0 +1,83 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// PolicyCommand is the statement that a row-level security policy applies to.
+type PolicyCommand int
+
+const (
+	PolicyCommandSelect PolicyCommand = iota
+	PolicyCommandUpdate
+	PolicyCommandDelete
+)
+
+func (c PolicyCommand) String() string {
+	switch c {
+	case PolicyCommandSelect:
+		return "select"
+	case PolicyCommandUpdate:
+		return "update"
+	case PolicyCommandDelete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// CreatePolicy creates a row-level security policy. The rows of the table
+// read by the command of the role are filtered by the Using expression.
+type CreatePolicy struct {
+	statementImpl
+	IfNotExists bool
+	Name        Identifier
+	Table       TableName
+	Command     PolicyCommand
+	Role        string
+	Using       Expr
+}
+
+func (node *CreatePolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("create policy ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+	ctx.WriteString(" for ")
+	ctx.WriteString(node.Command.String())
+	ctx.WriteString(" to ")
+	ctx.WriteString(node.Role)
+	ctx.WriteString(" using (")
+	node.Using.Format(ctx)
+	ctx.WriteByte(')')
+}
+
+// DropPolicy drops a row-level security policy of the table.
+type DropPolicy struct {
+	statementImpl
+	IfExists bool
+	Name     Identifier
+	Table    TableName
+}
+
+func (node *DropPolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop policy ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+}
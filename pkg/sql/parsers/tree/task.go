@@ -0,0 +1,89 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "fmt"
+
+// CreateTask creates an async task running the sql body, once or periodically
+// if the schedule is a cron expression.
+type CreateTask struct {
+	statementImpl
+	IfNotExists bool
+	Name        string
+	Schedule    string
+	Body        string
+}
+
+func (node *CreateTask) Format(ctx *FmtCtx) {
+	ctx.WriteString("create task ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	ctx.WriteString(node.Name)
+	if len(node.Schedule) != 0 {
+		ctx.WriteString(fmt.Sprintf(" schedule '%s'", node.Schedule))
+	}
+	ctx.WriteString(fmt.Sprintf(" as '%s'", node.Body))
+}
+
+// ShowTasks lists the tasks and the cron tasks.
+type ShowTasks struct {
+	showImpl
+	Like *ComparisonExpr
+}
+
+func (node *ShowTasks) Format(ctx *FmtCtx) {
+	ctx.WriteString("show tasks")
+	if node.Like != nil {
+		ctx.WriteByte(' ')
+		node.Like.Format(ctx)
+	}
+}
+
+// CancelTask cancels a task which is not completed.
+type CancelTask struct {
+	statementImpl
+	Name string
+}
+
+func (node *CancelTask) Format(ctx *FmtCtx) {
+	ctx.WriteString("cancel task ")
+	ctx.WriteString(node.Name)
+}
+
+type AlterTaskOption int
+
+const (
+	AlterTaskSuspend AlterTaskOption = iota
+	AlterTaskResume
+)
+
+// AlterTask suspends or resumes a task.
+type AlterTask struct {
+	statementImpl
+	Name   string
+	Option AlterTaskOption
+}
+
+func (node *AlterTask) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter task ")
+	ctx.WriteString(node.Name)
+	switch node.Option {
+	case AlterTaskSuspend:
+		ctx.WriteString(" suspend")
+	case AlterTaskResume:
+		ctx.WriteString(" resume")
+	}
+}
@@ -110,15 +110,34 @@ const (
 	LZW        = "lzw"
 	ZLIB       = "zlib"
 	LZ4        = "lz4"
+	ZSTD       = "zstd"
+	SNAPPY     = "snappy"
+)
+
+// NULL_VALUE is the default text of the null values in the exported csv files
+const NULL_VALUE = "\\N"
+
+// the formats of the external files
+const (
+	CSV      = "csv"
+	PARQUET  = "parquet"
+	JSONLINE = "jsonline"
 )
 
 type ExternParam struct {
 	Filepath     string
 	CompressType string
+	Format       string
 	Tail         *TailParameter
 	FileService  fileservice.FileService
 }
 
+func (node *ExternParam) formatFormat(ctx *FmtCtx) {
+	if len(node.Format) != 0 && node.Format != CSV {
+		ctx.WriteString(" format '" + node.Format + "'")
+	}
+}
+
 type TailParameter struct {
 	//Fields
 	Fields *Fields
@@ -155,6 +174,7 @@ func (node *Load) Format(ctx *FmtCtx) {
 		ctx.WriteString(" infile ")
 		ctx.WriteString("{'filepath':'" + node.Param.Filepath + "', 'compression':'" + strings.ToLower(node.Param.CompressType) + "'}")
 	}
+	node.Param.formatFormat(ctx)
 
 	switch node.DuplicateHandling.(type) {
 	case *DuplicateKeyError:
@@ -303,8 +323,12 @@ type ExportParam struct {
 	Writer *bufio.Writer
 	// outfile flag
 	Outfile bool
-	// filename path
+	// filename path, which may be prefixed by the name of a file service
 	FilePath string
+	// the format of the exported files, which is csv, parquet or jsonline
+	FileFormat string
+	// the compression of the exported files
+	Compression string
 	// Fields
 	Fields *Fields
 	// Lines
@@ -316,7 +340,9 @@ type ExportParam struct {
 	Rows        uint64
 	FileCnt     uint
 	// header flag
-	Header     bool
+	Header bool
+	// the text of the null values in the csv files
+	NullValue  string
 	ForceQuote []string
 	ColumnFlag []bool
 	Symbol     [][]byte
@@ -332,6 +358,12 @@ func (ep *ExportParam) Format(ctx *FmtCtx) {
 		return
 	}
 	ctx.WriteString("into outfile " + ep.FilePath)
+	if len(ep.FileFormat) != 0 && ep.FileFormat != CSV {
+		ctx.WriteString(" format '" + ep.FileFormat + "'")
+	}
+	if len(ep.Compression) != 0 {
+		ctx.WriteString(" compression '" + ep.Compression + "'")
+	}
 	if ep.Fields != nil {
 		ctx.WriteByte(' ')
 		ep.Fields.Format(ctx)
@@ -346,6 +378,9 @@ func (ep *ExportParam) Format(ctx *FmtCtx) {
 	} else {
 		ctx.WriteString("false")
 	}
+	if ep.NullValue != NULL_VALUE {
+		ctx.WriteString(" null as '" + ep.NullValue + "'")
+	}
 	if ep.MaxFileSize != 0 {
 		ctx.WriteString(" max_file_size ")
 		ctx.WriteString(strconv.FormatUint(ep.MaxFileSize, 10))
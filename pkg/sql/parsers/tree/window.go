@@ -0,0 +1,130 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// WindowSpec is the OVER (...) clause of a window function call.
+type WindowSpec struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	HasFrame    bool
+	Frame       *FrameClause
+}
+
+func (node *WindowSpec) Format(ctx *FmtCtx) {
+	ctx.WriteString("over (")
+	flag := false
+	if len(node.PartitionBy) > 0 {
+		ctx.WriteString("partition by ")
+		node.PartitionBy.Format(ctx)
+		flag = true
+	}
+	if len(node.OrderBy) > 0 {
+		if flag {
+			ctx.WriteByte(' ')
+		}
+		node.OrderBy.Format(ctx)
+		flag = true
+	}
+	if node.HasFrame && node.Frame != nil {
+		if flag {
+			ctx.WriteByte(' ')
+		}
+		node.Frame.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
+func NewWindowSpec(partitionBy Exprs, orderBy OrderBy, frame *FrameClause) *WindowSpec {
+	return &WindowSpec{
+		PartitionBy: partitionBy,
+		OrderBy:     orderBy,
+		HasFrame:    frame != nil,
+		Frame:       frame,
+	}
+}
+
+// FrameType is the unit of a window frame.
+type FrameType int
+
+const (
+	Rows FrameType = iota
+	Range
+)
+
+func (t FrameType) String() string {
+	switch t {
+	case Rows:
+		return "rows"
+	case Range:
+		return "range"
+	default:
+		return "Unknown FrameType"
+	}
+}
+
+// FrameClause represents ROWS|RANGE [BETWEEN] start [AND end].
+type FrameClause struct {
+	Type   FrameType
+	HasEnd bool
+	Start  *FrameBound
+	End    *FrameBound
+}
+
+func (node *FrameClause) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Type.String())
+	if node.HasEnd {
+		ctx.WriteString(" between ")
+		node.Start.Format(ctx)
+		ctx.WriteString(" and ")
+		node.End.Format(ctx)
+	} else {
+		ctx.WriteByte(' ')
+		node.Start.Format(ctx)
+	}
+}
+
+// BoundType is the kind of a window frame bound.
+type BoundType int
+
+const (
+	Following BoundType = iota
+	Preceding
+	CurrentRow
+)
+
+// FrameBound is one end point of a window frame.
+type FrameBound struct {
+	Type      BoundType
+	UnBounded bool
+	Expr      Expr
+}
+
+func (node *FrameBound) Format(ctx *FmtCtx) {
+	if node.UnBounded {
+		ctx.WriteString("unbounded")
+	}
+	if node.Type == CurrentRow {
+		ctx.WriteString("current row")
+		return
+	}
+	if node.Expr != nil {
+		node.Expr.Format(ctx)
+	}
+	if node.Type == Preceding {
+		ctx.WriteString(" preceding")
+	} else {
+		ctx.WriteString(" following")
+	}
+}
@@ -468,6 +468,13 @@ func (b *baseBinder) bindComparisonExpr(astExpr *tree.ComparisonExpr, depth int3
 		newExpr := tree.NewComparisonExpr(tree.LIKE, astExpr.Left, astExpr.Right)
 		return b.bindFuncExprImplByAstExpr("not", []tree.Expr{newExpr}, depth)
 
+	case tree.REG_MATCH:
+		op = "regexp"
+
+	case tree.NOT_REG_MATCH:
+		newExpr := tree.NewComparisonExpr(tree.REG_MATCH, astExpr.Left, astExpr.Right)
+		return b.bindFuncExprImplByAstExpr("not", []tree.Expr{newExpr}, depth)
+
 	case tree.IN:
 		switch list := astExpr.Right.(type) {
 		case *tree.Tuple:
@@ -628,6 +635,11 @@ func (b *baseBinder) bindFuncExpr(astExpr *tree.FuncExpr, depth int32, isRoot bo
 	}
 	funcName := funcRef.Parts[0]
 
+	// aggregate functions with an OVER clause are window functions too
+	if astExpr.WindowSpec != nil {
+		return b.impl.BindWinFunc(funcName, astExpr, depth, isRoot)
+	}
+
 	if function.GetFunctionIsAggregateByName(funcName) {
 		return b.impl.BindAggFunc(funcName, astExpr, depth, isRoot)
 	} else if function.GetFunctionIsWinfunByName(funcName) {
@@ -660,6 +672,19 @@ func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr,
 		whenExpr := tree.NewIsNullExpr(astArgs[0])
 		astArgs = []tree.Expr{whenExpr, thenExpr, elseExpr}
 		name = "case"
+	case "json_arrayagg", "json_objectagg":
+		// rewrite 'json_objectagg(k, v)' to 'json_objectagg(json_array(k, v))', the
+		// aggregates take one argument
+		if name == "json_arrayagg" && len(astArgs) != 1 {
+			return nil, errors.New("", "json_arrayagg function need one arg")
+		}
+		if name == "json_objectagg" && len(astArgs) != 2 {
+			return nil, errors.New("", "json_objectagg function need two args")
+		}
+		astArgs = []tree.Expr{&tree.FuncExpr{
+			Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_array")),
+			Exprs: astArgs,
+		}}
 	//case "extract":
 	//	// ”extract(year from col_name)"  parser return year as UnresolvedName.
 	//	// we must rewrite it to string。 because binder bind UnresolvedName as column name
@@ -706,6 +731,9 @@ func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr,
 }
 
 func bindFuncExprImplByPlanExpr(name string, args []*Expr) (*plan.Expr, error) {
+	if err := convertEnumArgs(name, args); err != nil {
+		return nil, err
+	}
 	var err error
 
 	// deal with some special function
@@ -861,6 +889,14 @@ func bindFuncExprImplByPlanExpr(name string, args []*Expr) (*plan.Expr, error) {
 				return nil, err
 			}
 		}
+	case "lag", "lead":
+		// the default value should have the same type as the first argument
+		if len(args) == 3 && !isSameColumnType(args[0].Typ, args[2].Typ) {
+			args[2], err = appendCastBeforeExpr(args[2], args[0].Typ)
+			if err != nil {
+				return nil, err
+			}
+		}
 	case "like":
 		// sql 'select * from t where col like ?'  the ? Expr's type will be T_any
 		if args[0].Typ.Id == int32(types.T_any) {
@@ -1092,6 +1128,9 @@ func appendCastBeforeExpr(expr *Expr, toType *Type) (*Expr, error) {
 	if expr.Typ.Id == int32(types.T_any) {
 		return expr, nil
 	}
+	if newExpr, ok, err := castEnumExpr(expr, toType); ok || err != nil {
+		return newExpr, err
+	}
 	argsType := []types.Type{
 		makeTypeByPlan2Expr(expr),
 		makeTypeByPlan2Type(toType),
@@ -1117,6 +1156,128 @@ func appendCastBeforeExpr(expr *Expr, toType *Type) (*Expr, error) {
 	}, nil
 }
 
+// enumNumericFunctions are the functions which use the ordinals of enum
+// and the bitmaps of set, the other functions use their values
+var enumNumericFunctions = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "div": true, "%": true, "mod": true,
+	"unary_minus": true, "unary_plus": true, "unary_tilde": true,
+	"&": true, "|": true, "^": true, "<<": true, ">>": true,
+	"sum": true, "avg": true, "bit_and": true, "bit_or": true, "bit_xor": true,
+	"std": true, "stddev_pop": true, "variance": true,
+	"abs": true, "ceil": true, "ceiling": true, "floor": true, "round": true, "sqrt": true,
+	"exp": true, "ln": true, "log": true, "bin": true, "oct": true,
+}
+
+// enumComparisonFunctions use the ordinals when the other side is a number
+var enumComparisonFunctions = map[string]bool{
+	"=": true, "<>": true, "<": true, "<=": true, ">": true, ">=": true, "in": true,
+}
+
+// convertEnumArgs converts the enum and set arguments of the function. They
+// are numbers in numeric context, and strings of their values elsewhere.
+func convertEnumArgs(name string, args []*Expr) error {
+	switch name {
+	case "cast_value_to_enum", "cast_enum_to_value", "cast_value_to_set", "cast_set_to_value":
+		return nil
+	}
+	numeric := enumNumericFunctions[name]
+	if !numeric && enumComparisonFunctions[name] {
+		for _, arg := range args {
+			oid := types.T(arg.Typ.Id)
+			if types.IsInteger(oid) || types.IsFloat(oid) || types.IsDecimal(oid) {
+				numeric = true
+				break
+			}
+		}
+	}
+	var err error
+	for i, arg := range args {
+		if !types.IsEnumRelate(types.T(arg.Typ.Id)) {
+			continue
+		}
+		if numeric || arg.Typ.Enumvalues == "" {
+			args[i], err = makeEnumNumberExpr(arg)
+		} else {
+			args[i], err = makeEnumValueExpr(arg)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// castEnumExpr builds the casts from or into enum and set, ok is false if
+// the cast function should be used directly
+func castEnumExpr(expr *Expr, toType *Type) (*Expr, bool, error) {
+	fromOid, toOid := types.T(expr.Typ.Id), types.T(toType.Id)
+	var err error
+	if types.IsEnumRelate(toOid) {
+		if fromOid == toOid && expr.Typ.Enumvalues == toType.Enumvalues {
+			return expr, true, nil
+		}
+		switch {
+		case types.IsEnumRelate(fromOid):
+			expr, err = makeEnumValueExpr(expr)
+		case fromOid == types.T_int64 || fromOid == types.T_uint64 || types.IsString(fromOid):
+		case types.IsInteger(fromOid) || types.IsFloat(fromOid) || types.IsDecimal(fromOid):
+			expr, err = appendCastBeforeExpr(expr, &Type{Id: int32(types.T_int64), Size: 8})
+		default:
+			expr, err = appendCastBeforeExpr(expr, &Type{Id: int32(types.T_varchar), Size: types.VarlenaSize, Width: types.MaxStringSize})
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		name := "cast_value_to_enum"
+		if toOid == types.T_set {
+			name = "cast_value_to_set"
+		}
+		newExpr, err := bindFuncExprImplByPlanExpr(name, []*Expr{makePlan2StringConstExprWithType(toType.Enumvalues), expr})
+		if err != nil {
+			return nil, false, err
+		}
+		newExpr.Typ = copyType(toType)
+		return newExpr, true, nil
+	}
+	if !types.IsEnumRelate(fromOid) {
+		return nil, false, nil
+	}
+	switch {
+	case toOid == types.T_int64 || toOid == types.T_uint64 || toOid == types.T_float64:
+		return nil, false, nil
+	case types.IsInteger(toOid) || types.IsFloat(toOid) || types.IsDecimal(toOid):
+		expr, err = makeEnumNumberExpr(expr)
+	default:
+		expr, err = makeEnumValueExpr(expr)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if toOid == types.T_varchar {
+		return expr, true, nil
+	}
+	expr, err = appendCastBeforeExpr(expr, toType)
+	return expr, true, err
+}
+
+// makeEnumValueExpr converts the enum or set expr to the string of its values
+func makeEnumValueExpr(expr *Expr) (*Expr, error) {
+	name := "cast_enum_to_value"
+	if expr.Typ.Id == int32(types.T_set) {
+		name = "cast_set_to_value"
+	}
+	return bindFuncExprImplByPlanExpr(name, []*Expr{makePlan2StringConstExprWithType(expr.Typ.Enumvalues), expr})
+}
+
+// makeEnumNumberExpr converts the enum to int64 and the set to uint64
+func makeEnumNumberExpr(expr *Expr) (*Expr, error) {
+	typ := &Type{Id: int32(types.T_int64), Size: 8, Nullable: expr.Typ.Nullable}
+	if expr.Typ.Id == int32(types.T_set) {
+		typ.Id = int32(types.T_uint64)
+	}
+	return appendCastBeforeExpr(expr, typ)
+}
+
 func resetDateFunctionArgs(dateExpr *Expr, intervalExpr *Expr) ([]*Expr, error) {
 
 	firstExpr := intervalExpr.Expr.(*plan.Expr_List).List.List[0]
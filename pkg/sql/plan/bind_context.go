@@ -27,6 +27,7 @@ func NewBindContext(builder *QueryBuilder, parent *BindContext) *BindContext {
 		groupByAst:     make(map[string]int32),
 		aggregateByAst: make(map[string]int32),
 		projectByExpr:  make(map[string]int32),
+		windowByAst:    make(map[string]int32),
 		aliasMap:       make(map[string]int32),
 		bindingByTag:   make(map[int32]*Binding),
 		bindingByTable: make(map[string]*Binding),
@@ -68,6 +69,16 @@ func (bc *BindContext) findCTE(name string) *CTERef {
 	return nil
 }
 
+// findWorkTable returns the work table if name is a recursive CTE whose recursive part is being built.
+func (bc *BindContext) findWorkTable(name string) *workTable {
+	for ; bc != nil; bc = bc.parent {
+		if bc.workTable != nil && bc.cteName == name {
+			return bc.workTable
+		}
+	}
+	return nil
+}
+
 func (bc *BindContext) mergeContexts(left, right *BindContext) error {
 	left.parent = bc
 	right.parent = bc
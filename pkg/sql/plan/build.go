@@ -26,7 +26,21 @@ import (
 )
 
 func runBuildSelectByBinder(stmtType plan.Query_StatementType, ctx CompilerContext, stmt *tree.Select) (*Plan, error) {
-	builder := NewQueryBuilder(stmtType, ctx)
+	return runBuildSelectByBuilder(NewQueryBuilder(stmtType, ctx), stmt)
+}
+
+// runBuildSelectOfModification builds the select reading the rows to be updated or deleted,
+// the scans of the modified tables are filtered by the policies of the statement type.
+func runBuildSelectOfModification(stmtType plan.Query_StatementType, ctx CompilerContext, stmt *tree.Select, objRefs []*ObjectRef) (*Plan, error) {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	builder.policyStmtTypes = make(map[string]plan.Query_StatementType, len(objRefs))
+	for _, obj := range objRefs {
+		builder.policyStmtTypes[obj.SchemaName+"."+obj.ObjName] = stmtType
+	}
+	return runBuildSelectByBuilder(builder, stmt)
+}
+
+func runBuildSelectByBuilder(builder *QueryBuilder, stmt *tree.Select) (*Plan, error) {
 	bindCtx := NewBindContext(builder, nil)
 	rootId, err := builder.buildSelect(stmt, bindCtx, true)
 	builder.qry.Steps = append(builder.qry.Steps, rootId)
@@ -44,12 +58,34 @@ func runBuildSelectByBinder(stmtType plan.Query_StatementType, ctx CompilerConte
 	}, err
 }
 
+// runBuildSelectOfResult builds the select returning its rows to the client,
+// the enum and set results are returned as the strings of their values.
+func runBuildSelectOfResult(ctx CompilerContext, stmt *tree.Select) (*Plan, error) {
+	pn, err := runBuildSelectByBinder(plan.Query_SELECT, ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	query := pn.GetQuery()
+	rootNode := query.Nodes[query.Steps[len(query.Steps)-1]]
+	if rootNode.NodeType != plan.Node_PROJECT {
+		return pn, nil
+	}
+	for i, expr := range rootNode.ProjectList {
+		if types.IsEnumRelate(types.T(expr.Typ.Id)) && expr.Typ.Enumvalues != "" {
+			if rootNode.ProjectList[i], err = makeEnumValueExpr(expr); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return pn, nil
+}
+
 func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 	switch stmt := stmt.(type) {
 	case *tree.Select:
-		return runBuildSelectByBinder(plan.Query_SELECT, ctx, stmt)
+		return runBuildSelectOfResult(ctx, stmt)
 	case *tree.ParenSelect:
-		return runBuildSelectByBinder(plan.Query_SELECT, ctx, stmt.Select)
+		return runBuildSelectOfResult(ctx, stmt.Select)
 	case *tree.Insert:
 		return buildInsert(stmt, ctx)
 	case *tree.Update:
@@ -78,6 +114,8 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 		return buildCreateIndex(stmt, ctx)
 	case *tree.DropIndex:
 		return buildDropIndex(stmt, ctx)
+	case *tree.AlterTable:
+		return buildAlterTable(stmt, ctx)
 	case *tree.ShowCreateDatabase:
 		return buildShowCreateDatabase(stmt, ctx)
 	case *tree.ShowCreateTable:
@@ -0,0 +1,461 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+)
+
+func getColDef(tableDef *TableDef, name string) *ColDef {
+	for _, col := range tableDef.Cols {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// buildUniqueIndexDefs builds the secondary unique indexes declared by CREATE TABLE,
+// a duplicate value is rejected by the primary key of the hidden table of the index.
+func buildUniqueIndexDefs(tableDef *TableDef, primaryKeys []string, uniques []*tree.UniqueIndex) ([]*plan.IndexDef, error) {
+	if len(uniques) == 0 {
+		return nil, nil
+	}
+	if len(primaryKeys) > 1 {
+		return nil, errors.New(errno.FeatureNotSupported, "secondary index requires a table with a single column primary key")
+	}
+	names := make(map[string]bool)
+	defs := make([]*plan.IndexDef, 0, len(uniques))
+	for _, unique := range uniques {
+		def := &plan.IndexDef{
+			Typ:      plan.IndexDef_SECONDARY,
+			Name:     unique.Name,
+			ColNames: make([]string, len(unique.KeyParts)),
+			Unique:   true,
+		}
+		nameMap := map[string]bool{}
+		for i, key := range unique.KeyParts {
+			name := key.ColName.Parts[0]
+			if nameMap[name] {
+				return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", name))
+			}
+			nameMap[name] = true
+			col := getColDef(tableDef, name)
+			if col == nil {
+				return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Key column '%s' doesn't exist in table", name))
+			}
+			if !colexec.IsIndexableType(types.T(col.Typ.Id)) {
+				return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("column '%s' of type '%s' can not be indexed", name, types.T(col.Typ.Id).String()))
+			}
+			def.ColNames[i] = name
+		}
+		// an unnamed key is named after its first column as in MySQL
+		if def.Name == "" {
+			def.Name = def.ColNames[0]
+			for i := 2; names[def.Name]; i++ {
+				def.Name = fmt.Sprintf("%s_%d", def.ColNames[0], i)
+			}
+		}
+		if names[def.Name] {
+			return nil, errors.New(errno.DuplicateObject, fmt.Sprintf("Duplicate key name '%s'", def.Name))
+		}
+		names[def.Name] = true
+		def.IndexTableName = colexec.GetIndexTableName(tableDef.Name, def.Name)
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// buildCheckDefs binds the CHECK constraints over the columns of the table, a
+// constraint which is not enforced is not kept.
+func buildCheckDefs(ctx CompilerContext, tableDef *TableDef, checks []*tree.CheckIndex) ([]*plan.CheckDef, error) {
+	if len(checks) == 0 {
+		return nil, nil
+	}
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	if err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext); err != nil {
+		return nil, err
+	}
+	binder := NewCheckBinder(builder, bindContext)
+
+	names := make(map[string]bool)
+	var defs []*plan.CheckDef
+	for i, check := range checks {
+		name := check.Name
+		if name == "" {
+			name = fmt.Sprintf("%s_chk_%d", tableDef.Name, i+1)
+		}
+		if names[name] {
+			return nil, moerr.New(moerr.ER_CHECK_CONSTRAINT_DUP_NAME, name)
+		}
+		names[name] = true
+		if !check.Enforced {
+			continue
+		}
+		expr, err := binder.BindExpr(check.Expr, 0, true)
+		if err != nil {
+			return nil, err
+		}
+		if expr.Typ.Id != int32(types.T_bool) {
+			if expr, err = makePlan2CastExpr(expr, &plan.Type{Id: int32(types.T_bool), Size: 1}); err != nil {
+				return nil, err
+			}
+		}
+		if err = bindCheckColRefs(expr, tableDef, name); err != nil {
+			return nil, err
+		}
+		defs = append(defs, &plan.CheckDef{
+			Name:     name,
+			Check:    expr,
+			CheckStr: tree.String(check.Expr, dialect.MYSQL),
+		})
+	}
+	return defs, nil
+}
+
+// bindCheckColRefs names the columns referred by a check, so that the check can be
+// evaluated over a batch whose columns are in any order.
+func bindCheckColRefs(expr *plan.Expr, tableDef *TableDef, name string) error {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		col := tableDef.Cols[e.Col.ColPos]
+		if col.AutoIncrement {
+			return moerr.New(moerr.ER_CHECK_CONSTRAINT_REFERS_AUTO_INCREMENT_COLUMN, name)
+		}
+		e.Col.RelPos = 0
+		e.Col.Name = col.Name
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			if err := bindCheckColRefs(arg, tableDef, name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// buildForeignKeyDefs checks the foreign keys declared by CREATE TABLE, the parent table
+// must be in the same database, and the referenced columns must be its primary key or a
+// unique key. A table can refer to itself.
+func buildForeignKeyDefs(ctx CompilerContext, dbName string, tableDef *TableDef, primaryKeys []string, uniques []*plan.IndexDef, fks []*tree.ForeignKey) ([]*plan.ForeignKeyDef, error) {
+	names := make(map[string]bool)
+	defs := make([]*plan.ForeignKeyDef, 0, len(fks))
+	for i, fk := range fks {
+		def := &plan.ForeignKeyDef{
+			Name:       fk.Name,
+			ForeignTbl: string(fk.Refer.TableName.ObjectName),
+		}
+		if def.Name == "" {
+			def.Name = fmt.Sprintf("%s_ibfk_%d", tableDef.Name, i+1)
+		}
+		if names[def.Name] {
+			return nil, moerr.New(moerr.ER_FK_DUP_NAME, def.Name)
+		}
+		names[def.Name] = true
+		if db := string(fk.Refer.TableName.SchemaName); db != "" && db != dbName {
+			return nil, errors.New(errno.FeatureNotSupported, "foreign key referencing a table of another database is not supported")
+		}
+
+		parentDef, parentKeys, parentUniques := tableDef, primaryKeys, uniques
+		if def.ForeignTbl != tableDef.Name {
+			_, parentDef = ctx.Resolve(dbName, def.ForeignTbl)
+			if parentDef == nil {
+				return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("Failed to open the referenced table '%s'", def.ForeignTbl))
+			}
+			if parentDef.TableType == catalog.SystemExternalRel || parentDef.TableType == catalog.SystemViewRel {
+				return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%s' is not a base table", def.ForeignTbl))
+			}
+			parentKeys = nil
+			for _, col := range ctx.GetPrimaryKeyDef(dbName, def.ForeignTbl) {
+				parentKeys = append(parentKeys, col.Name)
+			}
+			_, parentUniques = getSecondaryIndexes(ctx, dbName, parentDef)
+		}
+
+		for _, key := range fk.KeyParts {
+			def.Cols = append(def.Cols, key.ColName.Parts[0])
+		}
+		for _, key := range fk.Refer.KeyParts {
+			def.ForeignCols = append(def.ForeignCols, key.ColName.Parts[0])
+		}
+		if len(def.ForeignCols) == 0 {
+			def.ForeignCols = parentKeys
+		}
+		if len(def.Cols) != len(def.ForeignCols) {
+			return nil, errors.New(errno.InvalidForeignKey, fmt.Sprintf("Incorrect foreign key definition for '%s': Key reference and table reference don't match", def.Name))
+		}
+		if !isKeyOf(def.ForeignCols, parentKeys, parentUniques) {
+			return nil, moerr.New(moerr.ER_FK_NO_INDEX_PARENT, def.Name, def.ForeignTbl)
+		}
+
+		var err error
+		if def.OnDelete, err = getRefAction(fk.Refer.OnDelete); err != nil {
+			return nil, err
+		}
+		if def.OnUpdate, err = getRefAction(fk.Refer.OnUpdate); err != nil {
+			return nil, err
+		}
+		for j, name := range def.Cols {
+			col := getColDef(tableDef, name)
+			if col == nil {
+				return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Key column '%s' doesn't exist in table", name))
+			}
+			parentCol := getColDef(parentDef, def.ForeignCols[j])
+			if parentCol == nil {
+				return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Key column '%s' doesn't exist in referenced table", def.ForeignCols[j]))
+			}
+			if col.Typ.Id != parentCol.Typ.Id {
+				return nil, moerr.New(moerr.ER_FK_INCOMPATIBLE_COLUMNS, name, parentCol.Name, def.Name)
+			}
+			if !colexec.IsIndexableType(types.T(col.Typ.Id)) {
+				return nil, errors.New(errno.InvalidForeignKey, fmt.Sprintf("Type of column '%s' is not supported by foreign key '%s'", name, def.Name))
+			}
+			isSetNull := def.OnDelete == plan.ForeignKeyDef_SET_NULL || def.OnUpdate == plan.ForeignKeyDef_SET_NULL
+			if isSetNull && (col.Primary || col.Default == nil || !col.Default.NullAbility) {
+				return nil, moerr.New(moerr.ER_FK_COLUMN_NOT_NULL, name, def.Name)
+			}
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// isKeyOf reports whether the columns are the primary key or the columns of a unique index.
+func isKeyOf(cols []string, primaryKeys []string, indexes []*plan.IndexDef) bool {
+	isSameCols := func(keys []string) bool {
+		if len(keys) != len(cols) {
+			return false
+		}
+		for _, key := range keys {
+			found := false
+			for _, col := range cols {
+				if col == key {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	if isSameCols(primaryKeys) {
+		return true
+	}
+	for _, idx := range indexes {
+		if idx.Unique && isSameCols(idx.ColNames) {
+			return true
+		}
+	}
+	return false
+}
+
+func getRefAction(opt tree.ReferenceOptionType) (plan.ForeignKeyDef_RefAction, error) {
+	switch opt {
+	case tree.REFERENCE_OPTION_INVALID, tree.REFERENCE_OPTION_RESTRICT:
+		return plan.ForeignKeyDef_RESTRICT, nil
+	case tree.REFERENCE_OPTION_CASCADE:
+		return plan.ForeignKeyDef_CASCADE, nil
+	case tree.REFERENCE_OPTION_SET_NULL:
+		return plan.ForeignKeyDef_SET_NULL, nil
+	case tree.REFERENCE_OPTION_NO_ACTION:
+		return plan.ForeignKeyDef_NO_ACTION, nil
+	default:
+		return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("foreign key action '%s' is not supported", opt.ToString()))
+	}
+}
+
+// getConstraintDef returns the CHECK and FOREIGN KEY constraints of a table, or nil if there is none.
+func getConstraintDef(tableDef *TableDef) *plan.ConstraintDef {
+	for _, def := range tableDef.Defs {
+		if c, ok := def.Def.(*plan.TableDef_DefType_Constraint); ok {
+			return c.Constraint
+		}
+	}
+	return nil
+}
+
+// refChildKey is a foreign key of another table which refers to the table.
+type refChildKey struct {
+	tblName string
+	fk      *plan.ForeignKeyDef
+}
+
+// getRefChildKeys returns the foreign keys referring to the table, a self reference is included.
+func getRefChildKeys(ctx CompilerContext, dbName string, tableDef *TableDef) []refChildKey {
+	c := getConstraintDef(tableDef)
+	if c == nil {
+		return nil
+	}
+	var keys []refChildKey
+	for _, child := range c.RefChildTbls {
+		childDef := tableDef
+		if child != tableDef.Name {
+			if _, childDef = ctx.Resolve(dbName, child); childDef == nil {
+				continue
+			}
+		}
+		childConstraint := getConstraintDef(childDef)
+		if childConstraint == nil {
+			continue
+		}
+		for _, fk := range childConstraint.Fkeys {
+			if fk.ForeignTbl == tableDef.Name {
+				keys = append(keys, refChildKey{tblName: child, fk: fk})
+			}
+		}
+	}
+	return keys
+}
+
+// appendRefAttrs appends the columns of the table referred by the foreign keys of other
+// tables, whose old values are needed to check or change the referring rows.
+func appendRefAttrs(ctx CompilerContext, dbName string, tableDef *TableDef, attrs []string, updated []string) []string {
+	for _, key := range getRefChildKeys(ctx, dbName, tableDef) {
+		if updated != nil && !hasAnyCol(key.fk.ForeignCols, updated) {
+			continue
+		}
+		for _, name := range key.fk.ForeignCols {
+			if !hasAnyCol(attrs, []string{name}) {
+				attrs = append(attrs, name)
+			}
+		}
+	}
+	return attrs
+}
+
+func hasAnyCol(cols []string, names []string) bool {
+	for _, col := range cols {
+		for _, name := range names {
+			if col == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getConstraintStrings returns the unique keys, foreign keys and checks of a table as they are shown by SHOW CREATE TABLE.
+func getConstraintStrings(ctx CompilerContext, dbName string, tableDef *TableDef) []string {
+	quote := func(names []string) string {
+		return "`" + strings.Join(names, "`,`") + "`"
+	}
+	var strs []string
+	_, idxDefs := getSecondaryIndexes(ctx, dbName, tableDef)
+	for _, def := range idxDefs {
+		if def.Unique {
+			strs = append(strs, fmt.Sprintf("UNIQUE KEY `%s` (%s)", def.Name, quote(def.ColNames)))
+		}
+	}
+	c := getConstraintDef(tableDef)
+	for _, fk := range c.GetFkeys() {
+		strs = append(strs, fmt.Sprintf("CONSTRAINT `%s` FOREIGN KEY (%s) REFERENCES `%s` (%s) ON DELETE %s ON UPDATE %s",
+			fk.Name, quote(fk.Cols), fk.ForeignTbl, quote(fk.ForeignCols), getRefActionString(fk.OnDelete), getRefActionString(fk.OnUpdate)))
+	}
+	for _, check := range c.GetChecks() {
+		strs = append(strs, fmt.Sprintf("CONSTRAINT `%s` CHECK (%s)", check.Name, check.CheckStr))
+	}
+	return strs
+}
+
+func getRefActionString(action plan.ForeignKeyDef_RefAction) string {
+	switch action {
+	case plan.ForeignKeyDef_CASCADE:
+		return "CASCADE"
+	case plan.ForeignKeyDef_SET_NULL:
+		return "SET NULL"
+	case plan.ForeignKeyDef_NO_ACTION:
+		return "NO ACTION"
+	default:
+		return "RESTRICT"
+	}
+}
+
+// getConstraintCols returns the columns of the table used by its CHECK and FOREIGN KEY
+// constraints, and by the foreign keys of other tables, with the name of a constraint.
+func getConstraintCols(ctx CompilerContext, dbName string, tableDef *TableDef) map[string]string {
+	cols := make(map[string]string)
+	c := getConstraintDef(tableDef)
+	for _, check := range c.GetChecks() {
+		for _, name := range getExprColNames(check.Check, nil) {
+			cols[name] = check.Name
+		}
+	}
+	for _, fk := range c.GetFkeys() {
+		for _, name := range fk.Cols {
+			cols[name] = fk.Name
+		}
+	}
+	for _, key := range getRefChildKeys(ctx, dbName, tableDef) {
+		for _, name := range key.fk.ForeignCols {
+			cols[name] = key.fk.Name
+		}
+	}
+	return cols
+}
+
+func getExprColNames(expr *Expr, names []string) []string {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		names = append(names, e.Col.Name)
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			names = getExprColNames(arg, names)
+		}
+	case *plan.Expr_List:
+		for _, arg := range e.List.List {
+			names = getExprColNames(arg, names)
+		}
+	}
+	return names
+}
+
+// checkDropIndex returns an error if the index is the unique key referred by a foreign key of another table.
+func checkDropIndex(ctx CompilerContext, dbName string, tableDef *TableDef, idxName string) error {
+	var primaryKeys []string
+	for _, col := range ctx.GetPrimaryKeyDef(dbName, tableDef.Name) {
+		primaryKeys = append(primaryKeys, col.Name)
+	}
+	_, idxDefs := getSecondaryIndexes(ctx, dbName, tableDef)
+	for _, def := range idxDefs {
+		if def.Name != idxName {
+			continue
+		}
+		for _, key := range getRefChildKeys(ctx, dbName, tableDef) {
+			if !isKeyOf(key.fk.ForeignCols, primaryKeys, nil) && isKeyOf(key.fk.ForeignCols, nil, []*plan.IndexDef{def}) {
+				return moerr.New(moerr.ER_DROP_INDEX_FK, idxName)
+			}
+		}
+	}
+	return nil
+}
@@ -17,11 +17,13 @@ package plan
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -124,7 +126,11 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 	}
 
 	// set tableDef
-	err := buildTableDefs(stmt.Defs, ctx, createTable.TableDef)
+	dbName := createTable.Database
+	if dbName == "" {
+		dbName = ctx.DefaultDatabase()
+	}
+	err := buildTableDefs(stmt.Defs, ctx, dbName, createTable.TableDef)
 	if err != nil {
 		return nil, err
 	}
@@ -147,6 +153,10 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionCompression:
+			if err = setCompressTypes(createTable.TableDef, opt.Compression); err != nil {
+				return nil, err
+			}
 		// todo confirm: option data store like this?
 		case *tree.TableOptionComment:
 			if getNumOfCharacters(opt.Comment) > maxLengthOfTableComment {
@@ -260,9 +270,46 @@ func buildPartitionByClause(partitionBinder *PartitionBinder, partitionOp *tree.
 	return err
 }
 
-func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef) error {
+// setCompressTypes sets the compression algorithm or the encoding of the columns by the
+// compression option of the table, which is a list of the algorithms separated by commas,
+// the algorithm is the default of the table, or applies to a column if prefixed by "column=",
+// such as 'zstd, status=dict, id=rle', the items are applied in order.
+func setCompressTypes(tableDef *TableDef, option string) error {
+	for _, item := range strings.Split(option, ",") {
+		colName, name := "", strings.TrimSpace(item)
+		if i := strings.IndexByte(name, '='); i >= 0 {
+			colName, name = strings.TrimSpace(name[:i]), strings.TrimSpace(name[i+1:])
+		}
+		alg, ok := plan.CompressType(0), false
+		for key, value := range plan.CompressType_value {
+			if strings.EqualFold(key, name) {
+				alg, ok = plan.CompressType(value), true
+				break
+			}
+		}
+		if !ok {
+			return errors.New(errno.InvalidOptionValue, fmt.Sprintf("unknown compression '%s'", name))
+		}
+		found := false
+		for _, col := range tableDef.Cols {
+			if colName == "" || strings.EqualFold(col.Name, colName) {
+				col.Alg = alg
+				found = true
+			}
+		}
+		if colName != "" && !found {
+			return errors.New(errno.UndefinedColumn, fmt.Sprintf("column '%s' does not exist", colName))
+		}
+	}
+	return nil
+}
+
+func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, dbName string, tableDef *TableDef) error {
 	var primaryKeys []string
 	var indexs []string
+	var uniques []*tree.UniqueIndex
+	var checks []*tree.CheckIndex
+	var fks []*tree.ForeignKey
 	colNameMap := make(map[string]int32)
 	for _, item := range defs {
 		switch def := item.(type) {
@@ -302,6 +349,16 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 						return errors.New(errno.InvalidColumnDefinition, "the auto_incr column is only support int32 and int64 type now")
 					}
 				}
+
+				keyParts := []*tree.KeyPart{{ColName: def.Name}}
+				switch attr := attr.(type) {
+				case *tree.AttributeUnique, *tree.AttributeUniqueKey:
+					uniques = append(uniques, &tree.UniqueIndex{KeyParts: keyParts})
+				case *tree.AttributeCheckConstraint:
+					checks = append(checks, &tree.CheckIndex{Name: attr.Name, Expr: attr.Expr, Enforced: attr.Enforced})
+				case *tree.AttributeReference:
+					fks = append(fks, &tree.ForeignKey{KeyParts: keyParts, Refer: attr})
+				}
 			}
 			if len(pks) > 0 {
 				if len(primaryKeys) > 0 {
@@ -382,7 +439,13 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 					Idx: idxDef,
 				},
 			})
-		case *tree.UniqueIndex, *tree.CheckIndex, *tree.ForeignKey, *tree.FullTextIndex:
+		case *tree.UniqueIndex:
+			uniques = append(uniques, def)
+		case *tree.CheckIndex:
+			checks = append(checks, def)
+		case *tree.ForeignKey:
+			fks = append(fks, def)
+		case *tree.FullTextIndex:
 			// unsupport in plan. will support in next version.
 			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table def: '%v'", def))
 		default:
@@ -407,6 +470,44 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 			return errors.New(errno.InvalidColumnDefinition, "Type text don't support index")
 		}
 	}
+
+	uniqueDefs, err := buildUniqueIndexDefs(tableDef, primaryKeys, uniques)
+	if err != nil {
+		return err
+	}
+	for _, def := range uniqueDefs {
+		tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+			Def: &plan.TableDef_DefType_Idx{
+				Idx: def,
+			},
+		})
+	}
+	checkDefs, err := buildCheckDefs(ctx, tableDef, checks)
+	if err != nil {
+		return err
+	}
+	fkDefs, err := buildForeignKeyDefs(ctx, dbName, tableDef, primaryKeys, uniqueDefs, fks)
+	if err != nil {
+		return err
+	}
+	if len(checkDefs) > 0 || len(fkDefs) > 0 {
+		constraint := &plan.ConstraintDef{
+			Checks: checkDefs,
+			Fkeys:  fkDefs,
+		}
+		// a table referring to itself is its own child
+		for _, fk := range fkDefs {
+			if fk.ForeignTbl == tableDef.Name {
+				constraint.RefChildTbls = []string{tableDef.Name}
+				break
+			}
+		}
+		tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+			Def: &plan.TableDef_DefType_Constraint{
+				Constraint: constraint,
+			},
+		})
+	}
 	return nil
 }
 
@@ -537,36 +638,384 @@ func buildDropDatabase(stmt *tree.DropDatabase, ctx CompilerContext) (*Plan, err
 }
 
 func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error) {
-	return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL)))
-	// todo unsupport now
-	// createIndex := &plan.CreateIndex{}
-	// return &Plan{
-	// 	Plan: &plan.Plan_Ddl{
-	// 		Ddl: &plan.DataDefinition{
-	// 			DdlType: plan.DataDefinition_CREATE_INDEX,
-	// 			Definition: &plan.DataDefinition_CreateIndex{
-	// 				CreateIndex: createIndex,
-	// 			},
-	// 		},
-	// 	},
-	// }, nil
+	switch stmt.IndexCat {
+	case tree.INDEX_CATEGORY_NONE, tree.INDEX_CATEGORY_UNIQUE:
+	default:
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported index category '%s'", stmt.IndexCat.ToString()))
+	}
+	if stmt.IndexOption != nil {
+		switch stmt.IndexOption.IType {
+		case tree.INDEX_TYPE_INVALID, tree.INDEX_TYPE_BTREE, tree.INDEX_TYPE_HASH:
+		default:
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported index type '%s'", stmt.IndexOption.IType.ToString()))
+		}
+	}
+
+	createIndex := &plan.CreateIndex{
+		IfNotExists: stmt.IfNotExists,
+		Index:       string(stmt.Name),
+		Database:    string(stmt.Table.SchemaName),
+		Table:       string(stmt.Table.ObjectName),
+	}
+	if createIndex.Database == "" {
+		createIndex.Database = ctx.DefaultDatabase()
+	}
+	_, tableDef := ctx.Resolve(createIndex.Database, createIndex.Table)
+	if tableDef == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%s' does not exist", createIndex.Table))
+	}
+	if tableDef.TableType == catalog.SystemExternalRel || tableDef.TableType == catalog.SystemViewRel {
+		return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%s' is not a base table", createIndex.Table))
+	}
+	// the entries of a unique index are deleted by the values of the index columns,
+	// the other indexes need the primary key to tell the rows apart
+	switch len(ctx.GetPrimaryKeyDef(createIndex.Database, createIndex.Table)) {
+	case 0:
+		if stmt.IndexCat != tree.INDEX_CATEGORY_UNIQUE {
+			return nil, errors.New(errno.FeatureNotSupported, "secondary index requires a table with a single column primary key")
+		}
+	case 1:
+	default:
+		return nil, errors.New(errno.FeatureNotSupported, "secondary index requires a table with a single column primary key")
+	}
+	for _, def := range tableDef.Defs {
+		if idx, ok := def.Def.(*plan.TableDef_DefType_Idx); ok && idx.Idx.Name == createIndex.Index && !stmt.IfNotExists {
+			return nil, errors.New(errno.DuplicateObject, fmt.Sprintf("Duplicate key name '%s'", createIndex.Index))
+		}
+	}
+
+	idxDef := &plan.IndexDef{
+		Typ:            plan.IndexDef_SECONDARY,
+		Name:           createIndex.Index,
+		ColNames:       make([]string, len(stmt.KeyParts)),
+		Unique:         stmt.IndexCat == tree.INDEX_CATEGORY_UNIQUE,
+		IndexTableName: colexec.GetIndexTableName(createIndex.Table, createIndex.Index),
+	}
+	nameMap := map[string]bool{}
+	for i, key := range stmt.KeyParts {
+		name := key.ColName.Parts[0]
+		if _, ok := nameMap[name]; ok {
+			return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", name))
+		}
+		nameMap[name] = true
+		var col *ColDef
+		for _, c := range tableDef.Cols {
+			if c.Name == name {
+				col = c
+				break
+			}
+		}
+		if col == nil {
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Key column '%s' doesn't exist in table", name))
+		}
+		if !colexec.IsIndexableType(types.T(col.Typ.Id)) {
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("column '%s' of type '%s' can not be indexed", name, types.T(col.Typ.Id).String()))
+		}
+		idxDef.ColNames[i] = name
+	}
+	createIndex.IndexDef = idxDef
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_CREATE_INDEX,
+				Definition: &plan.DataDefinition_CreateIndex{
+					CreateIndex: createIndex,
+				},
+			},
+		},
+	}, nil
 }
 
 func buildDropIndex(stmt *tree.DropIndex, ctx CompilerContext) (*Plan, error) {
-	return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL)))
-	// todo unsupport now
-	// dropIndex := &plan.DropIndex{
-	// 	IfExists: stmt.IfExists,
-	// 	Index:    string(stmt.Name),
-	// }
-	// return &Plan{
-	// 	Plan: &plan.Plan_Ddl{
-	// 		Ddl: &plan.DataDefinition{
-	// 			DdlType: plan.DataDefinition_DROP_INDEX,
-	// 			Definition: &plan.DataDefinition_DropIndex{
-	// 				DropIndex: dropIndex,
-	// 			},
-	// 		},
-	// 	},
-	// }, nil
+	dropIndex := &plan.DropIndex{
+		IfExists: stmt.IfExists,
+		Index:    string(stmt.Name),
+		Database: string(stmt.TableName.SchemaName),
+		Table:    string(stmt.TableName.ObjectName),
+	}
+	if dropIndex.Database == "" {
+		dropIndex.Database = ctx.DefaultDatabase()
+	}
+	_, tableDef := ctx.Resolve(dropIndex.Database, dropIndex.Table)
+	if tableDef == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%s' does not exist", dropIndex.Table))
+	}
+	found := false
+	for _, def := range tableDef.Defs {
+		if idx, ok := def.Def.(*plan.TableDef_DefType_Idx); ok && idx.Idx.Typ == plan.IndexDef_SECONDARY && idx.Idx.Name == dropIndex.Index {
+			found = true
+			break
+		}
+	}
+	if !found && !stmt.IfExists {
+		return nil, errors.New(errno.UndefinedObject, fmt.Sprintf("Can't DROP '%s'; check that column/key exists", dropIndex.Index))
+	}
+	if err := checkDropIndex(ctx, dropIndex.Database, tableDef, dropIndex.Index); err != nil {
+		return nil, err
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_DROP_INDEX,
+				Definition: &plan.DataDefinition_DropIndex{
+					DropIndex: dropIndex,
+				},
+			},
+		},
+	}, nil
+}
+
+func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error) {
+	alterTable := &plan.AlterTable{
+		Database: string(stmt.Table.SchemaName),
+		Table:    string(stmt.Table.ObjectName),
+	}
+	if alterTable.Database == "" {
+		alterTable.Database = ctx.DefaultDatabase()
+	}
+	_, tableDef := ctx.Resolve(alterTable.Database, alterTable.Table)
+	if tableDef == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%s' does not exist", alterTable.Table))
+	}
+	if tableDef.TableType == catalog.SystemExternalRel || tableDef.TableType == catalog.SystemViewRel {
+		return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%s' is not a base table", alterTable.Table))
+	}
+
+	// cols tracks the columns as the actions are applied in order, so that
+	// every action is checked against the result of the previous ones.
+	cols := make(map[string]*ColDef, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		cols[col.Name] = col
+	}
+	indexes := make(map[string]bool)
+	keyCols := make(map[string]string)
+	for _, col := range ctx.GetPrimaryKeyDef(alterTable.Database, alterTable.Table) {
+		keyCols[col.Name] = "PRIMARY"
+	}
+	for _, def := range tableDef.Defs {
+		if idx, ok := def.Def.(*plan.TableDef_DefType_Idx); ok && idx.Idx.Typ == plan.IndexDef_SECONDARY {
+			indexes[idx.Idx.Name] = true
+			for _, name := range idx.Idx.ColNames {
+				keyCols[name] = idx.Idx.Name
+			}
+		}
+	}
+	for name, key := range getConstraintCols(ctx, alterTable.Database, tableDef) {
+		keyCols[name] = key
+	}
+	checkKeyColumn := func(name string) error {
+		if key, ok := keyCols[name]; ok {
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("column '%s' is used by key '%s'", name, key))
+		}
+		return nil
+	}
+
+	for _, opt := range stmt.Options {
+		var action *plan.AlterTableAction
+		switch opt := opt.(type) {
+		case *tree.AlterOptionAdd:
+			switch def := opt.Def.(type) {
+			case *tree.ColumnTableDef:
+				col, err := buildAlterColumnDef(def, ctx)
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := cols[col.Name]; ok {
+					return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", col.Name))
+				}
+				if col.AutoIncrement {
+					return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("auto_increment column '%s' can not be added", col.Name))
+				}
+				cols[col.Name] = col
+				action = &plan.AlterTableAction{Action: &plan.AlterTableAction_AddColumn{AddColumn: col}}
+			case *tree.Index, *tree.UniqueIndex:
+				createIndex := &tree.CreateIndex{
+					Table:    *stmt.Table,
+					IndexCat: tree.INDEX_CATEGORY_NONE,
+				}
+				switch def := def.(type) {
+				case *tree.Index:
+					createIndex.Name = tree.Identifier(def.Name)
+					createIndex.KeyParts = def.KeyParts
+					createIndex.IndexOption = def.IndexOption
+					createIndex.IfNotExists = def.IfNotExists
+				case *tree.UniqueIndex:
+					createIndex.Name = tree.Identifier(def.Name)
+					createIndex.KeyParts = def.KeyParts
+					createIndex.IndexOption = def.IndexOption
+					createIndex.IndexCat = tree.INDEX_CATEGORY_UNIQUE
+				}
+				if createIndex.Name == "" {
+					return nil, errors.New(errno.SyntaxError, "index name is required")
+				}
+				for _, key := range createIndex.KeyParts {
+					if _, ok := cols[key.ColName.Parts[0]]; !ok {
+						return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Key column '%s' doesn't exist in table", key.ColName.Parts[0]))
+					}
+				}
+				p, err := buildCreateIndex(createIndex, ctx)
+				if err != nil {
+					return nil, err
+				}
+				addIndex := p.GetDdl().GetCreateIndex()
+				if indexes[addIndex.Index] {
+					if addIndex.IfNotExists {
+						continue
+					}
+					return nil, errors.New(errno.DuplicateObject, fmt.Sprintf("Duplicate key name '%s'", addIndex.Index))
+				}
+				indexes[addIndex.Index] = true
+				for _, name := range addIndex.IndexDef.ColNames {
+					keyCols[name] = addIndex.Index
+				}
+				action = &plan.AlterTableAction{Action: &plan.AlterTableAction_AddIndex{AddIndex: addIndex}}
+			default:
+				return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported alter table definition: '%v'", tree.String(def, dialect.MYSQL)))
+			}
+		case *tree.AlterOptionDrop:
+			name := string(opt.Name)
+			switch opt.Typ {
+			case tree.AlterTableDropColumn:
+				if _, ok := cols[name]; !ok {
+					return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Can't DROP '%s'; check that column/key exists", name))
+				}
+				if err := checkKeyColumn(name); err != nil {
+					return nil, err
+				}
+				if len(cols) == 1 {
+					return nil, errors.New(errno.InvalidTableDefinition, "You can't delete all columns with ALTER TABLE; use DROP TABLE instead")
+				}
+				delete(cols, name)
+				action = &plan.AlterTableAction{Action: &plan.AlterTableAction_DropColumn{DropColumn: name}}
+			case tree.AlterTableDropIndex, tree.AlterTableDropKey:
+				if !indexes[name] {
+					return nil, errors.New(errno.UndefinedObject, fmt.Sprintf("Can't DROP '%s'; check that column/key exists", name))
+				}
+				if err := checkDropIndex(ctx, alterTable.Database, tableDef, name); err != nil {
+					return nil, err
+				}
+				delete(indexes, name)
+				for col, key := range keyCols {
+					if key == name {
+						delete(keyCols, col)
+					}
+				}
+				action = &plan.AlterTableAction{Action: &plan.AlterTableAction_DropIndex{DropIndex: &plan.DropIndex{
+					Index:    name,
+					Database: alterTable.Database,
+					Table:    alterTable.Table,
+				}}}
+			default:
+				return nil, errors.New(errno.FeatureNotSupported, "dropping the primary key is not supported")
+			}
+		case *tree.AlterOptionRenameColumn:
+			oldName, newName := string(opt.OldName), string(opt.NewName)
+			col, ok := cols[oldName]
+			if !ok {
+				return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in '%s'", oldName, alterTable.Table))
+			}
+			if _, ok := cols[newName]; ok {
+				return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", newName))
+			}
+			if err := checkKeyColumn(oldName); err != nil {
+				return nil, err
+			}
+			delete(cols, oldName)
+			cols[newName] = col
+			action = &plan.AlterTableAction{Action: &plan.AlterTableAction_RenameColumn{
+				RenameColumn: &plan.AlterRenameColumn{OldName: oldName, NewName: newName},
+			}}
+		case *tree.AlterOptionModifyColumn:
+			col, err := buildAlterColumnDef(opt.Def, ctx)
+			if err != nil {
+				return nil, err
+			}
+			old, ok := cols[col.Name]
+			if !ok {
+				return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in '%s'", col.Name, alterTable.Table))
+			}
+			if err := checkKeyColumn(col.Name); err != nil {
+				return nil, err
+			}
+			// only the metadata of a column is changed, the data already
+			// written must stay readable with the new definition
+			if old.Typ.Id != col.Typ.Id || old.Typ.Precision != col.Typ.Precision || old.Typ.Scale != col.Typ.Scale {
+				return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("changing the type of column '%s' is not supported", col.Name))
+			}
+			if old.Typ.Width > col.Typ.Width {
+				return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("shrinking the width of column '%s' is not supported", col.Name))
+			}
+			if old.AutoIncrement != col.AutoIncrement {
+				return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("changing the auto_increment of column '%s' is not supported", col.Name))
+			}
+			cols[col.Name] = col
+			action = &plan.AlterTableAction{Action: &plan.AlterTableAction_ModifyColumn{ModifyColumn: col}}
+		case *tree.AlterOptionRenameTable:
+			newDb := string(opt.Name.SchemaName)
+			if newDb != "" && newDb != alterTable.Database {
+				return nil, errors.New(errno.FeatureNotSupported, "moving a table to another database is not supported")
+			}
+			newName := string(opt.Name.ObjectName)
+			if _, def := ctx.Resolve(alterTable.Database, newName); def != nil {
+				return nil, errors.New(errno.DuplicateTable, fmt.Sprintf("table '%s' already exists", newName))
+			}
+			action = &plan.AlterTableAction{Action: &plan.AlterTableAction_RenameTable{RenameTable: newName}}
+		case *tree.AlterOptionTableOption:
+			var properties []*plan.Property
+			switch option := opt.Option.(type) {
+			case *tree.TableOptionComment:
+				if getNumOfCharacters(option.Comment) > maxLengthOfTableComment {
+					errmsg := fmt.Sprintf("Comment for table '%s' is too long (max = %d)", alterTable.Table, maxLengthOfTableComment)
+					return nil, errors.New(errno.InvalidOptionValue, errmsg)
+				}
+				properties = []*plan.Property{
+					{
+						Key:   catalog.SystemRelAttr_Comment,
+						Value: option.Comment,
+					},
+				}
+			case *tree.TableOptionProperties:
+				for _, property := range option.Preperties {
+					properties = append(properties, &plan.Property{
+						Key:   property.Key,
+						Value: property.Value,
+					})
+				}
+			default:
+				return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported table option: '%v'", tree.String(option, dialect.MYSQL)))
+			}
+			action = &plan.AlterTableAction{Action: &plan.AlterTableAction_Properties{
+				Properties: &plan.PropertiesDef{Properties: properties},
+			}}
+		default:
+			return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL)))
+		}
+		alterTable.Actions = append(alterTable.Actions, action)
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_ALTER_TABLE,
+				Definition: &plan.DataDefinition_AlterTable{
+					AlterTable: alterTable,
+				},
+			},
+		},
+	}, nil
+}
+
+// buildAlterColumnDef builds the definition of a column added or modified by
+// ALTER TABLE, keys can not be declared on such a column.
+func buildAlterColumnDef(def *tree.ColumnTableDef, ctx CompilerContext) (*ColDef, error) {
+	tableDef := &TableDef{}
+	if err := buildTableDefs(tree.TableDefs{def}, ctx, ctx.DefaultDatabase(), tableDef); err != nil {
+		return nil, err
+	}
+	if len(tableDef.Defs) > 0 {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("adding a key on column '%s' is not supported, add an index instead", def.Name.Parts[0]))
+	}
+	return tableDef.Cols[0], nil
 }
@@ -76,9 +76,15 @@ func buildDeleteSingleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, erro
 		return nil, fmt.Errorf("view is not support delete operation")
 	}
 
-	// optimize to truncate,
-	if stmt.Where == nil && stmt.Limit == nil {
-		return buildDelete2Truncate(objRef, tableDef)
+	// optimize to truncate, unless the rows are filtered by the policies or referred by other tables
+	if stmt.Where == nil && stmt.Limit == nil && len(getRefChildKeys(ctx, objRef.SchemaName, tableDef)) == 0 {
+		policies, err := ctx.Policies(objRef, plan.Query_DELETE)
+		if err != nil {
+			return nil, err
+		}
+		if len(policies) == 0 {
+			return buildDelete2Truncate(objRef, tableDef)
+		}
 	}
 
 	// find out use keys to delete
@@ -87,6 +93,9 @@ func buildDeleteSingleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, erro
 	if err != nil {
 		return nil, err
 	}
+	indexAttrs := getIndexAttrs(getSecondaryIndexes(ctx, objRef.SchemaName, tableDef))
+	indexAttrs = appendRefAttrs(ctx, objRef.SchemaName, tableDef, indexAttrs, nil)
+	useProjectExprs = buildIndexAttrsProjection(useProjectExprs, tf.baseNameMap[tableDef.Name], indexAttrs)
 
 	// build the stmt of select and append select node
 	selectStmt := &tree.Select{
@@ -99,7 +108,7 @@ func buildDeleteSingleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, erro
 		Limit:   stmt.Limit,
 		With:    stmt.With,
 	}
-	usePlan, err := runBuildSelectByBinder(plan.Query_SELECT, ctx, selectStmt)
+	usePlan, err := runBuildSelectOfModification(plan.Query_DELETE, ctx, selectStmt, []*ObjectRef{objRef})
 	if err != nil {
 		return nil, err
 	}
@@ -108,11 +117,13 @@ func buildDeleteSingleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, erro
 
 	// build delete node
 	d := &plan.DeleteTableCtx{
-		DbName:       objRef.SchemaName,
-		TblName:      tableDef.Name,
-		UseDeleteKey: useKey.Name,
-		IsHideKey:    isHideKey,
-		CanTruncate:  false,
+		DbName:        objRef.SchemaName,
+		TblName:       tableDef.Name,
+		UseDeleteKey:  useKey.Name,
+		IsHideKey:     isHideKey,
+		CanTruncate:   false,
+		IndexAttrs:    indexAttrs,
+		IndexAttrsIdx: 1,
 	}
 	node := &Node{
 		NodeType:        plan.Node_DELETE,
@@ -198,6 +209,14 @@ func buildDeleteMultipleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, er
 			return nil, err
 		}
 	}
+	indexAttrsArr := make([][]string, tableCount)
+	indexAttrsIdxArr := make([]int32, tableCount)
+	for i := 0; i < tableCount; i++ {
+		indexAttrsArr[i] = getIndexAttrs(getSecondaryIndexes(ctx, objRefs[i].SchemaName, tblDefs[i]))
+		indexAttrsArr[i] = appendRefAttrs(ctx, objRefs[i].SchemaName, tblDefs[i], indexAttrsArr[i], nil)
+		indexAttrsIdxArr[i] = int32(len(useProjectExprs))
+		useProjectExprs = buildIndexAttrsProjection(useProjectExprs, tf.baseNameMap[tblDefs[i].Name], indexAttrsArr[i])
+	}
 
 	// build the stmt of select and append select node
 	selectStmt := &tree.Select{
@@ -210,7 +229,7 @@ func buildDeleteMultipleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, er
 		Limit:   stmt.Limit,
 		With:    stmt.With,
 	}
-	usePlan, err := runBuildSelectByBinder(plan.Query_SELECT, ctx, selectStmt)
+	usePlan, err := runBuildSelectOfModification(plan.Query_DELETE, ctx, selectStmt, objRefs)
 	if err != nil {
 		return nil, err
 	}
@@ -220,11 +239,13 @@ func buildDeleteMultipleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, er
 	ds := make([]*plan.DeleteTableCtx, tableCount)
 	for i := 0; i < tableCount; i++ {
 		ds[i] = &plan.DeleteTableCtx{
-			DbName:       objRefs[i].SchemaName,
-			TblName:      tblDefs[i].Name,
-			UseDeleteKey: useKeys[i].Name,
-			IsHideKey:    isHideKeyArr[i],
-			CanTruncate:  false,
+			DbName:        objRefs[i].SchemaName,
+			TblName:       tblDefs[i].Name,
+			UseDeleteKey:  useKeys[i].Name,
+			IsHideKey:     isHideKeyArr[i],
+			CanTruncate:   false,
+			IndexAttrs:    indexAttrsArr[i],
+			IndexAttrsIdx: indexAttrsIdxArr[i],
 		}
 	}
 	node := &Node{
@@ -298,3 +319,60 @@ func isContainNameInFilter(stmt *tree.Delete, name string) bool {
 	}
 	return false
 }
+
+// getSecondaryIndexes returns the primary key and the secondary indexes of a table.
+func getSecondaryIndexes(ctx CompilerContext, dbName string, tableDef *TableDef) (string, []*plan.IndexDef) {
+	var defs []*plan.IndexDef
+	for _, def := range tableDef.Defs {
+		if idx, ok := def.Def.(*plan.TableDef_DefType_Idx); ok && idx.Idx.Typ == plan.IndexDef_SECONDARY {
+			defs = append(defs, idx.Idx)
+		}
+	}
+	if len(defs) == 0 {
+		return "", nil
+	}
+	// only a unique index can be kept for a table without primary key
+	priKeys := ctx.GetPrimaryKeyDef(dbName, tableDef.Name)
+	switch len(priKeys) {
+	case 0:
+		return "", defs
+	case 1:
+		return priKeys[0].Name, defs
+	default:
+		return "", nil
+	}
+}
+
+// getIndexAttrs returns the primary key and the columns of the indexes, whose values are needed
+// to remove the entries of a row from the indexes.
+func getIndexAttrs(pk string, defs []*plan.IndexDef) []string {
+	if len(defs) == 0 {
+		return nil
+	}
+	var attrs []string
+	if pk != "" {
+		attrs = append(attrs, pk)
+	}
+	for _, def := range defs {
+		for _, name := range def.ColNames {
+			found := false
+			for _, attr := range attrs {
+				if attr == name {
+					found = true
+					break
+				}
+			}
+			if !found {
+				attrs = append(attrs, name)
+			}
+		}
+	}
+	return attrs
+}
+
+func buildIndexAttrsProjection(ps tree.SelectExprs, tblName string, attrs []string) tree.SelectExprs {
+	for _, attr := range attrs {
+		ps = append(ps, tree.SelectExpr{Expr: tree.SetUnresolvedName(tblName, attr)})
+	}
+	return ps
+}
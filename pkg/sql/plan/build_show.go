@@ -112,6 +112,13 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 		if typ.Oid == types.T_varchar || typ.Oid == types.T_char {
 			typeStr += fmt.Sprintf("(%d)", col.Typ.Width)
 		}
+		if types.IsEnumRelate(typ.Oid) {
+			values := types.ParseEnumValues(col.Typ.Enumvalues)
+			for i, v := range values {
+				values[i] = "'" + strings.ReplaceAll(v, "'", "''") + "'"
+			}
+			typeStr += "(" + strings.Join(values, ",") + ")"
+		}
 		createStr += fmt.Sprintf("`%s` %s %s%s", colName, typeStr, nullOrNot, hasAttrComment)
 		rowCount++
 		if col.Primary {
@@ -129,6 +136,12 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 		}
 		createStr += pkStr
 	}
+	for _, str := range getConstraintStrings(ctx, dbName, tableDef) {
+		if rowCount != 0 {
+			createStr += ",\n"
+		}
+		createStr += str
+	}
 
 	if rowCount != 0 {
 		createStr += "\n"
@@ -244,7 +257,7 @@ func buildShowTables(stmt *tree.ShowTables, ctx CompilerContext) (*Plan, error)
 	}
 
 	ddlType := plan.DataDefinition_SHOW_TABLES
-	sql := fmt.Sprintf("SELECT relname as Tables_in_%s FROM %s.mo_tables WHERE reldatabase = '%s' and relname != '%s'", dbName, MO_CATALOG_DB_NAME, dbName, "%!%mo_increment_columns")
+	sql := fmt.Sprintf("SELECT relname as Tables_in_%s FROM %s.mo_tables WHERE reldatabase = '%s' and relname != '%s' and relname not like '%s'", dbName, MO_CATALOG_DB_NAME, dbName, "%!%mo_increment_columns", `\%!\%mo\_index\_%`)
 
 	if stmt.Where != nil {
 		return returnByWhereAndBaseSQL(ctx, sql, stmt.Where, ddlType)
@@ -18,9 +18,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 )
@@ -450,6 +452,9 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 		"select interval '1' day + l_shipdate  from lineitem",
 		"select interval '1' day + cast('2022-02-02 00:00:00' as datetime)",
 		"select cast('2022-02-02 00:00:00' as datetime) + interval '1' day",
+		"select cast('11:22:33.5' as time(1)) > '10:00:00', hour(cast('11:22:33' as time))",
+		"select timediff(cast('2022-02-02 00:00:00' as datetime), cast('2022-02-01 12:00:00' as datetime))",
+		"select addtime(cast('11:22:33' as time), '01:00:00'), time_to_sec('11:22:33'), sec_to_time(3600)",
 		"delete from nation",
 		"delete nation, nation2 from nation join nation2 on nation.n_name = nation2.n_name",
 	}
@@ -577,6 +582,12 @@ func TestCTESqlBuilder(t *testing.T) {
 		SELECT (WITH qn AS (SELECT "inner" as a) SELECT a from qn),
 		qn.a
 		FROM qn`,
+
+		"with recursive qn(n) as (select 1 union all select n + 1 from qn where n < 10) select * from qn",
+		"with recursive qn(n) as (select 1 union select n + 1 from qn where n < 10) select n from qn where n > 5",
+		"with recursive qn as (select n_nationkey, n_regionkey from nation where n_nationkey = 0 union all select nation.n_nationkey, nation.n_regionkey from nation join qn on nation.n_regionkey = qn.n_nationkey) select count(*) from qn",
+		"with recursive qn as (select 1 as a) select * from qn",
+		"with recursive qn(a, b) as (select 1, 'x' union all select a + 1, concat(b, 'x') from qn where a < 3) select b from qn order by a desc limit 1",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -589,6 +600,154 @@ func TestCTESqlBuilder(t *testing.T) {
 		`WITH qn2 AS (SELECT a FROM qn WHERE a IS NULL or a>0),
 		qn AS (SELECT b as a FROM qn2)
 		SELECT qn.a  FROM qn`,
+
+		"with recursive qn(n) as (select n + 1 from qn) select * from qn",                                        // without UNION
+		"with recursive qn(n) as (select n + 1 from qn union all select 1) select * from qn",                     // recursive anchor
+		"with recursive qn(n) as (select 1 union all select n, n from qn) select * from qn",                      // column count
+		"with recursive qn(n, n) as (select 1, 2 union all select n, n from qn) select * from qn",                // duplicate column
+		"with recursive qn(n) as (select 1 union all select n + 1 from qn order by n limit 10) select * from qn", // ORDER BY / LIMIT
+		"with recursive qn(n, m) as (select 1 union all select n + 1 from qn where n < 10) select * from qn",     // too many columns
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+// test window function plan building
+func TestWindowSqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	// should pass
+	sqls := []string{
+		"SELECT N_NAME, ROW_NUMBER() OVER (PARTITION BY N_REGIONKEY ORDER BY N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, RANK() OVER (ORDER BY N_NATIONKEY DESC), DENSE_RANK() OVER (ORDER BY N_NATIONKEY DESC) FROM NATION",
+		"SELECT N_NAME, PERCENT_RANK() OVER (ORDER BY N_NATIONKEY), CUME_DIST() OVER (ORDER BY N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, NTILE(3) OVER (PARTITION BY N_REGIONKEY ORDER BY N_NAME) FROM NATION",
+		"SELECT N_NAME, LAG(N_NAME) OVER (ORDER BY N_NATIONKEY), LEAD(N_NATIONKEY, 2, 0) OVER (ORDER BY N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, FIRST_VALUE(N_NAME) OVER (PARTITION BY N_REGIONKEY ORDER BY N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, LAST_VALUE(N_NAME) OVER (ORDER BY N_NATIONKEY ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING) FROM NATION",
+		"SELECT N_NAME, NTH_VALUE(N_NAME, 2) OVER (ORDER BY N_NATIONKEY ROWS 1 PRECEDING) FROM NATION",
+		"SELECT N_NAME, SUM(N_NATIONKEY) OVER (PARTITION BY N_REGIONKEY ORDER BY N_NATIONKEY ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING) FROM NATION",
+		"SELECT N_NAME, AVG(N_NATIONKEY) OVER (ORDER BY N_NATIONKEY RANGE BETWEEN 2 PRECEDING AND CURRENT ROW) FROM NATION",
+		"SELECT N_NAME, COUNT(*) OVER () FROM NATION",
+		"SELECT N_REGIONKEY, SUM(N_NATIONKEY), RANK() OVER (ORDER BY SUM(N_NATIONKEY)) FROM NATION GROUP BY N_REGIONKEY",
+		"SELECT N_NAME, ROW_NUMBER() OVER (ORDER BY N_NATIONKEY) AS RN FROM NATION ORDER BY RN DESC LIMIT 3",
+		"SELECT * FROM (SELECT N_NAME, N_REGIONKEY, RANK() OVER (PARTITION BY N_REGIONKEY ORDER BY N_NATIONKEY) R FROM NATION) T WHERE R = 1 AND N_REGIONKEY > 1",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT ROW_NUMBER() FROM NATION",                                                                          // without OVER
+		"SELECT ABS(N_NATIONKEY) OVER () FROM NATION",                                                              // not a window function
+		"SELECT N_NAME FROM NATION WHERE RANK() OVER (ORDER BY N_NATIONKEY) > 1",                                   // window function in WHERE
+		"SELECT N_NAME FROM NATION GROUP BY RANK() OVER (ORDER BY N_NATIONKEY)",                                    // window function in GROUP BY
+		"SELECT SUM(RANK() OVER (ORDER BY N_NATIONKEY)) FROM NATION",                                               // window function in aggregate
+		"SELECT SUM(RANK() OVER ()) OVER () FROM NATION",                                                           // nested window function
+		"SELECT COUNT(DISTINCT N_NAME) OVER () FROM NATION",                                                        // distinct
+		"SELECT SUM(N_NATIONKEY) OVER (ORDER BY N_NATIONKEY ROWS BETWEEN CURRENT ROW AND 1 PRECEDING) FROM NATION", // bad frame
+		"SELECT SUM(N_NATIONKEY) OVER (ROWS UNBOUNDED FOLLOWING) FROM NATION",                                      // bad frame
+		"SELECT SUM(N_NATIONKEY) OVER (ORDER BY N_NAME RANGE 1 PRECEDING) FROM NATION",                             // RANGE offset on string
+		"SELECT SUM(N_NATIONKEY) OVER (RANGE 1 PRECEDING) FROM NATION",                                             // RANGE offset without ORDER BY
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestEnumSetSqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	// should pass
+	sqls := []string{
+		"SELECT S_SIZE, S_COLORS FROM SHIRTS WHERE S_SIZE = 'medium' AND FIND_IN_SET('red', S_COLORS) > 0",
+		"SELECT S_SIZE + 0, S_COLORS + 0, S_SIZE > 1, S_SIZE IN (1, 3) FROM SHIRTS ORDER BY S_SIZE",
+		"SELECT S_SIZE, COUNT(*), MAX(S_COLORS), SUM(S_SIZE) FROM SHIRTS GROUP BY S_SIZE",
+		"SELECT CAST(S_SIZE AS CHAR), CAST(S_COLORS AS UNSIGNED), CONCAT(S_SIZE, '-', S_COLORS) FROM SHIRTS",
+		"INSERT INTO SHIRTS VALUES (1, 'small', 'red,blue'), (2, 3, 5), (3, NULL, '')",
+		"INSERT INTO SHIRTS SELECT * FROM SHIRTS",
+		"UPDATE SHIRTS SET S_SIZE = 'large', S_COLORS = 'green' WHERE S_SIZE = 'small'",
+		"create table tbl_name (a enum('x', 'y ') not null, b set('x', 'y') default 'x,y')",
+		"show create table shirts",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// the results are the values, and numbers in numeric context
+	logicPlan, err := runOneStmt(mock, t, "SELECT S_SIZE, S_COLORS, S_SIZE + 0 FROM SHIRTS")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var ids []types.T
+	for _, col := range GetResultColumnsFromPlan(logicPlan) {
+		ids = append(ids, types.T(col.Typ.Id))
+	}
+	if !reflect.DeepEqual(ids, []types.T{types.T_varchar, types.T_varchar, types.T_int64}) {
+		t.Fatalf("unexpected result types %v", ids)
+	}
+
+	logicPlan, err = runOneStmt(mock, t, "create table tbl_name (a enum('x', 'y '), b set('x', 'y'))")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cols := logicPlan.GetDdl().GetCreateTable().TableDef.Cols
+	if cols[0].Typ.Enumvalues != "x,y" || cols[1].Typ.Enumvalues != "x,y" {
+		t.Fatalf("unexpected value lists %s %s", cols[0].Typ.Enumvalues, cols[1].Typ.Enumvalues)
+	}
+
+	// should error
+	sqls = []string{
+		"create table tbl_name (a enum('x', 'X'))",  // duplicated value
+		"create table tbl_name (a set('x,y', 'z'))", // value contains comma
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestJsonSqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	// should pass
+	sqls := []string{
+		"SELECT JSON_OBJECT('name', N_NAME, 'key', N_NATIONKEY), JSON_ARRAY(N_NATIONKEY, NULL, 1.5) FROM NATION",
+		`SELECT JSON_SET('{"a": 1}', '$.b', N_NAME), JSON_REMOVE(N_COMMENT, '$.a'), JSON_CONTAINS(N_COMMENT, '1', '$.a') FROM NATION`,
+		"SELECT JSON_KEYS(N_COMMENT), JSON_LENGTH(N_COMMENT), JSON_TYPE(N_COMMENT), JSON_VALID(N_COMMENT), JSON_CONTAINS_PATH(N_COMMENT, 'one', '$.a', '$.b') FROM NATION",
+		"SELECT N_COMMENT->'$.a', N_COMMENT->>'$.b' FROM NATION WHERE N_COMMENT->>'$.c' = 'x'",
+		"SELECT N_REGIONKEY, JSON_ARRAYAGG(N_NAME), JSON_OBJECTAGG(N_NAME, N_NATIONKEY) FROM NATION GROUP BY N_REGIONKEY",
+		`SELECT * FROM JSON_TABLE('[{"a": 1}, {"a": 2}]', '$[*]' COLUMNS(id FOR ORDINALITY, a INT PATH '$.a', e INT EXISTS PATH '$.b')) AS jt WHERE a > 1`,
+		"SELECT N_NAME, jt.a FROM NATION, JSON_TABLE(N_COMMENT, '$[*]' COLUMNS(a INT PATH '$.a', b VARCHAR(10) PATH '$.b')) AS jt WHERE jt.a > 1 AND N_NATIONKEY = 2",
+		"SELECT N_NAME, jt.a FROM NATION LEFT JOIN JSON_TABLE(N_COMMENT, '$[*]' COLUMNS(a INT PATH '$.a')) AS jt ON TRUE",
+		"SELECT N_NAME, COUNT(*) FROM NATION JOIN JSON_TABLE(N_COMMENT, '$[*]' COLUMNS(a INT PATH '$.a')) AS jt ON jt.a = N_NATIONKEY GROUP BY N_NAME",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT JSON_OBJECT('a')",
+		"SELECT JSON_OBJECTAGG(N_NAME) FROM NATION",
+		"SELECT * FROM JSON_TABLE(1, '$' COLUMNS(a INT PATH '$.a')) AS jt",
+		"SELECT * FROM JSON_TABLE('[]', '$[' COLUMNS(a INT PATH '$.a')) AS jt",
+		"SELECT * FROM NATION RIGHT JOIN JSON_TABLE(N_COMMENT, '$' COLUMNS(a INT PATH '$.a')) AS jt ON TRUE",
+		"SELECT * FROM NATION LEFT JOIN JSON_TABLE(N_COMMENT, '$' COLUMNS(a INT PATH '$.a')) AS jt ON jt.a = 1",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestStringFunctionSqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	// should pass
+	sqls := []string{
+		"SELECT LOWER(N_NAME), UPPER(N_NAME), TRIM(N_NAME), TRIM(LEADING 'x' FROM N_NAME), TRIM(BOTH FROM N_NAME) FROM NATION",
+		"SELECT REPLACE(N_NAME, 'a', 'b'), REPEAT(N_NAME, 2), LEFT(N_NAME, 2), RIGHT(N_NAME, N_REGIONKEY), POSITION('a' IN N_NAME) FROM NATION",
+		"SELECT ASCII(N_NAME), CHR(N_NATIONKEY + 64), TRANSLATE(N_NAME, 'ab', 'x'), SPLIT(N_COMMENT, ' ', 2) FROM NATION",
+		"SELECT REGEXP(N_NAME, '^A'), REGEXP_REPLACE(N_NAME, '[aeiou]', ''), REGEXP_SUBSTR(N_COMMENT, '[0-9]+') FROM NATION WHERE N_NAME REGEXP 'A$' AND N_NAME NOT REGEXP '^B'",
+		"SELECT * FROM NATION WHERE LIKE_ANY(N_NAME, 'A%', '%B') OR LIKE_ALL(N_NAME, 'A%', '%A') OR ILIKE(N_NAME, 'a%') OR ILIKE_ANY(N_NAME, 'b%') OR ILIKE_ALL(N_NAME, 'c%', '%d')",
+		"SELECT BASE64_ENCODE(N_NAME), BASE64_DECODE(N_COMMENT), HEX_DECODE(N_COMMENT), HASH(N_NATIONKEY, N_NAME, NULL), SIGN(N_NATIONKEY - 3), SIGN(1.5) FROM NATION",
+		"SELECT GREATEST(N_NATIONKEY, N_REGIONKEY, 3), LEAST(N_NATIONKEY, 1.5), GREATEST(N_NAME, 'B'), LEAST(N_NAME, NULL) FROM NATION",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT GREATEST(N_NAME) FROM NATION",
+		"SELECT LIKE_ANY(N_NAME) FROM NATION",
+		"SELECT HASH() FROM NATION",
+		"SELECT REPEAT(N_NAME) FROM NATION",
 	}
 	runTestShouldError(mock, t, sqls)
 }
@@ -737,9 +896,28 @@ func TestDdl(t *testing.T) {
 		"drop table if exists tpch.tbl_not_exist",
 		"drop table if exists db_not_exist.tbl",
 		"drop view v1",
+		"create table tbl_name (a int, b varchar(20)) compression = 'zstd, B = dict'",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
+	sqls = []string{
+		"create table tbl_name (a int, b varchar(20)) compression = 'snappy'",
+		"create table tbl_name (a int, b varchar(20)) compression = 'c=rle'",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "create table tbl_name (a int, b varchar(20), c int) compression = 'zstd, b=dict, c=none'")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var algs []plan.CompressType
+	for _, col := range logicPlan.GetDdl().GetCreateTable().TableDef.Cols {
+		algs = append(algs, col.Alg)
+	}
+	if !reflect.DeepEqual(algs, []plan.CompressType{plan.CompressType_Zstd, plan.CompressType_Dict, plan.CompressType_None}) {
+		t.Fatalf("unexpected compression types %v", algs)
+	}
+
 	// should error
 	//sqls = []string{
 	//	"create database tpch",  //we mock database tpch。 so tpch is exist
@@ -756,6 +934,101 @@ func TestDdl(t *testing.T) {
 	//runTestShouldError(mock, t, sqls)
 }
 
+func TestCreateTableConstraints(t *testing.T) {
+	mock := NewMockOptimizer()
+	sqls := []string{
+		"create table t1 (a int unique, b int, c varchar(20), constraint c1 check (a > 0), unique key uk (b, c))",
+		"create table t1 (a int check (a > 1), b int, check (a < b) not enforced)",
+		"create table t2 (a int primary key, b int, foreign key (b) references nation(n_nationkey) on delete cascade on update set null)",
+		"create table t3 (a int primary key, p int, constraint fk_p foreign key (p) references t3(a) on delete set null)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"create table t1 (a int, constraint c1 check (a > 0), constraint c1 check (a < 10))", // duplicate check name
+		"create table t1 (a int, check (sum(a) > 0))",                                        // aggregate in check
+		"create table t1 (a int auto_increment, check (a > 0))",                              // auto_increment column in check
+		"create table t1 (a int, unique key (d))",                                            // unknown unique key column
+		"create table t2 (b int, foreign key (b) references nation(n_name))",                 // not a key of the parent
+		"create table t2 (b bigint, foreign key (b) references nation(n_nationkey))",         // incompatible types
+		"create table t2 (b int not null, foreign key (b) references nation(n_nationkey) on delete set null)",
+		"create table t2 (b int, foreign key (b) references tbl_not_exist(a))",
+		"create table t2 (b int, constraint f1 foreign key (b) references nation(n_nationkey), constraint f1 foreign key (b) references nation(n_nationkey))",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "create table t3 (a int primary key, p int, b int unique, constraint fk_p foreign key (p) references t3(a) on delete cascade, check (b > a))")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var constraint *plan.ConstraintDef
+	var uniques []string
+	for _, def := range logicPlan.GetDdl().GetCreateTable().TableDef.Defs {
+		switch def := def.Def.(type) {
+		case *plan.TableDef_DefType_Constraint:
+			constraint = def.Constraint
+		case *plan.TableDef_DefType_Idx:
+			if def.Idx.Unique {
+				uniques = append(uniques, def.Idx.Name)
+			}
+		}
+	}
+	if !reflect.DeepEqual(uniques, []string{"b"}) {
+		t.Fatalf("unexpected unique indexes %v", uniques)
+	}
+	if constraint == nil || len(constraint.Checks) != 1 || len(constraint.Fkeys) != 1 {
+		t.Fatalf("unexpected constraint %v", constraint)
+	}
+	if constraint.Checks[0].Name != "t3_chk_1" || constraint.Fkeys[0].OnDelete != plan.ForeignKeyDef_CASCADE {
+		t.Fatalf("unexpected constraint %v", constraint)
+	}
+	// a table referring to itself is its own child
+	if !reflect.DeepEqual(constraint.RefChildTbls, []string{"t3"}) {
+		t.Fatalf("unexpected children %v", constraint.RefChildTbls)
+	}
+}
+
+func TestAlterTable(t *testing.T) {
+	mock := NewMockOptimizer()
+	sqls := []string{
+		"alter table nation add column n_extra int default 5",
+		"alter table nation add n_extra varchar(10) comment 'extra', drop column n_comment",
+		"alter table nation drop n_comment, add n_comment varchar(20)",
+		"alter table nation rename column n_comment to n_note",
+		"alter table nation modify n_comment varchar(200) default 'none'",
+		"alter table nation add index idx1 (n_name), drop index idx1",
+		"alter table nation rename to nation3",
+		"alter table tpch.nation comment 'nations'",
+		"rename table nation to nation3",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"alter table tbl_not_exist add column a int",
+		"alter table nation add column n_name int",
+		"alter table nation add column a int primary key",
+		"alter table nation drop column a",
+		"alter table nation drop column n_nationkey",
+		"alter table nation drop primary key",
+		"alter table nation rename column n_name to n_comment",
+		"alter table nation modify n_name int",
+		"alter table nation modify n_name varchar(10)",
+		"alter table nation add index idx1 (n_name), drop column n_name",
+		"alter table nation rename to region",
+		"alter table nation drop index idx_not_exist",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "alter table nation add column n_extra int default 5, drop column n_comment")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	actions := logicPlan.GetDdl().GetAlterTable().Actions
+	if len(actions) != 2 || actions[0].GetAddColumn().GetName() != "n_extra" || actions[1].GetDropColumn() != "n_comment" {
+		t.Fatalf("unexpected alter table actions %v", actions)
+	}
+}
+
 func TestShow(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
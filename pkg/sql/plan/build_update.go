@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -93,7 +94,12 @@ func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
 		Limit:   stmt.Limit,
 		With:    stmt.With,
 	}
-	usePlan, err := runBuildSelectByBinder(plan.Query_SELECT, ctx, selectStmt)
+	// the rows of the updated tables are filtered by the UPDATE policies
+	updateObjRefs := make([]*ObjectRef, len(updateCtxs))
+	for i, ct := range updateCtxs {
+		updateObjRefs[i] = &ObjectRef{SchemaName: ct.DbName, ObjName: ct.TblName}
+	}
+	usePlan, err := runBuildSelectOfModification(plan.Query_UPDATE, ctx, selectStmt, updateObjRefs)
 	if err != nil {
 		return nil, err
 	}
@@ -286,6 +292,30 @@ func buildCtxAndProjection(updateColsArray [][]updateCol, updateExprsArray []tre
 		}
 		offset += int32(len(orderAttrs)) + 1
 
+		// project the old values of the columns kept by the secondary indexes which will be changed,
+		// and of the columns referred by the foreign keys of other tables
+		updateNames := make([]string, 0, len(updateCols)+len(onUpdateCols))
+		for _, u := range updateCols {
+			updateNames = append(updateNames, u.colDef.Name)
+		}
+		for _, u := range onUpdateCols {
+			updateNames = append(updateNames, u.colDef.Name)
+		}
+		pk, idxDefs := getSecondaryIndexes(ctx, updateCols[0].dbName, tblRefs[k])
+		var affectedDefs []*plan.IndexDef
+		for _, def := range idxDefs {
+			if colexec.IsIndexAffected(def, pk, updateNames) {
+				affectedDefs = append(affectedDefs, def)
+			}
+		}
+		indexAttrs := getIndexAttrs(pk, affectedDefs)
+		indexAttrs = appendRefAttrs(ctx, updateCols[0].dbName, tblRefs[k], indexAttrs, updateNames)
+		for _, attr := range indexAttrs {
+			e, _ := tree.NewUnresolvedName(updateCols[0].aliasTblName, attr)
+			useProjectExprs = append(useProjectExprs, tree.SelectExpr{Expr: e})
+		}
+		offset += int32(len(indexAttrs))
+
 		ct := &plan.UpdateCtx{
 			DbName:     updateCols[0].dbName,
 			TblName:    updateCols[0].tblName,
@@ -295,6 +325,7 @@ func buildCtxAndProjection(updateColsArray [][]updateCol, updateExprsArray []tre
 			HideKeyIdx: hideKeyIdx,
 			OtherAttrs: otherAttrs,
 			OrderAttrs: orderAttrs,
+			IndexAttrs: indexAttrs,
 		}
 		for _, u := range updateCols {
 			ct.UpdateCols = append(ct.UpdateCols, u.colDef)
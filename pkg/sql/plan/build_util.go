@@ -16,6 +16,8 @@ package plan
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -85,6 +87,8 @@ func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
 			return &plan.Type{Id: int32(types.T_varchar), Size: 24, Width: width}, nil
 		case defines.MYSQL_TYPE_DATE:
 			return &plan.Type{Id: int32(types.T_date), Size: 4}, nil
+		case defines.MYSQL_TYPE_TIME:
+			return &plan.Type{Id: int32(types.T_time), Size: 8, Width: n.InternalType.Width, Precision: n.InternalType.Precision}, nil
 		case defines.MYSQL_TYPE_DATETIME:
 			// currently the ast's width for datetime's is 26, this is not accurate and may need revise, not important though, as we don't need it anywhere else except to differentiate empty vector.Typ.
 			return &plan.Type{Id: int32(types.T_datetime), Size: 8, Width: n.InternalType.Width, Precision: n.InternalType.Precision}, nil
@@ -103,6 +107,18 @@ func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
 			return &plan.Type{Id: int32(types.T_json)}, nil
 		case defines.MYSQL_TYPE_UUID:
 			return &plan.Type{Id: int32(types.T_uuid), Size: 16}, nil
+		case defines.MYSQL_TYPE_ENUM:
+			values, err := checkEnumValues(n.InternalType.EnumValues, types.MaxEnumValues, "enum")
+			if err != nil {
+				return nil, err
+			}
+			return &plan.Type{Id: int32(types.T_enum), Size: 2, Enumvalues: values}, nil
+		case defines.MYSQL_TYPE_SET:
+			values, err := checkEnumValues(n.InternalType.EnumValues, types.MaxSetValues, "set")
+			if err != nil {
+				return nil, err
+			}
+			return &plan.Type{Id: int32(types.T_set), Size: 8, Enumvalues: values}, nil
 		case defines.MYSQL_TYPE_TINY_BLOB:
 			return &plan.Type{Id: int32(types.T_blob), Size: types.VarlenaSize}, nil
 		case defines.MYSQL_TYPE_MEDIUM_BLOB:
@@ -116,6 +132,28 @@ func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
 	return nil, errors.New(errno.IndeterminateDatatype, "Unknown data type.")
 }
 
+// checkEnumValues checks the value list of an enum or set column, the trailing
+// spaces of the values are removed as MySQL does
+func checkEnumValues(values []string, maxLen int, typ string) (string, error) {
+	if len(values) > maxLen {
+		return "", errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Too many values for the %s column, the max is %d", typ, maxLen))
+	}
+	result := make([]string, len(values))
+	for i, v := range values {
+		v = strings.TrimRight(v, " ")
+		if strings.Contains(v, types.EnumValueSeparator) {
+			return "", errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Illegal %s value '%s', it can not contain '%s'", typ, v, types.EnumValueSeparator))
+		}
+		for _, w := range result[:i] {
+			if strings.EqualFold(v, w) {
+				return "", errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Column has duplicated value '%s' in %s", v, strings.ToUpper(typ)))
+			}
+		}
+		result[i] = v
+	}
+	return types.EnumValuesToString(result), nil
+}
+
 func buildDefaultExpr(col *tree.ColumnTableDef, typ *plan.Type) (*plan.Default, error) {
 	nullAbility := true
 	var expr tree.Expr = nil
@@ -0,0 +1,52 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// NewCheckBinder returns the binder of the expressions of CHECK constraints,
+// which are evaluated over a single row of the table.
+func NewCheckBinder(builder *QueryBuilder, ctx *BindContext) *CheckBinder {
+	c := &CheckBinder{}
+	c.builder = builder
+	c.ctx = ctx
+	c.impl = c
+	return c
+}
+
+func (c *CheckBinder) BindExpr(expr tree.Expr, i int32, b bool) (*plan.Expr, error) {
+	return c.baseBindExpr(expr, i, b)
+}
+
+func (c *CheckBinder) BindColRef(name *tree.UnresolvedName, i int32, b bool) (*plan.Expr, error) {
+	return c.baseBindColRef(name, i, b)
+}
+
+func (c *CheckBinder) BindAggFunc(s string, expr *tree.FuncExpr, i int32, b bool) (*plan.Expr, error) {
+	return nil, errors.New(errno.GroupingError, "aggregate functions not allowed in check constraint")
+}
+
+func (c *CheckBinder) BindWinFunc(s string, expr *tree.FuncExpr, i int32, b bool) (*plan.Expr, error) {
+	return nil, errors.New(errno.WindowingError, "window functions not allowed in check constraint")
+}
+
+func (c *CheckBinder) BindSubquery(subquery *tree.Subquery, b bool) (*plan.Expr, error) {
+	return nil, errors.New(errno.InvalidColumnReference, "subquery not allowed in check constraint")
+}
@@ -0,0 +1,252 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	// defaultSelectivity is used for a predicate which the statistics can't help
+	defaultSelectivity = 0.1
+	// rangeSelectivity is used for a range predicate on a column without histogram
+	rangeSelectivity = 1.0 / 3
+)
+
+// addTableStats remembers the statistics of a scan node so that
+// the column references bound to its tag can be estimated.
+func (builder *QueryBuilder) addTableStats(node *plan.Node) {
+	stats := builder.compCtx.Stats(node.ObjRef)
+	if stats == nil {
+		return
+	}
+	builder.statsByTag[node.BindingTags[0]] = stats
+	builder.tableDefByTag[node.BindingTags[0]] = node.TableDef
+}
+
+// getColumnStats returns the statistics and the row count of the table of a column reference,
+// it only works before the column references are remapped.
+func (builder *QueryBuilder) getColumnStats(expr *plan.Expr) (*ColumnStats, float64) {
+	// a column may be cast to the type of the other operand
+	if f, ok := expr.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "cast" {
+		expr = f.F.Args[0]
+	}
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok {
+		return nil, 0
+	}
+	stats, ok := builder.statsByTag[col.Col.RelPos]
+	if !ok || stats.RowCount <= 0 {
+		return nil, 0
+	}
+	tableDef := builder.tableDefByTag[col.Col.RelPos]
+	if int(col.Col.ColPos) >= len(tableDef.Cols) {
+		return nil, 0
+	}
+	colStats, ok := stats.Cols[tableDef.Cols[col.Col.ColPos].Name]
+	if !ok {
+		return nil, 0
+	}
+	return colStats, stats.RowCount
+}
+
+// estimateSelectivity returns the estimated fraction of rows which satisfy the filter.
+func (builder *QueryBuilder) estimateSelectivity(expr *plan.Expr) float64 {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return defaultSelectivity
+	}
+	args := f.F.Args
+	switch f.F.Func.ObjName {
+	case "and":
+		return builder.estimateSelectivity(args[0]) * builder.estimateSelectivity(args[1])
+
+	case "or":
+		s0, s1 := builder.estimateSelectivity(args[0]), builder.estimateSelectivity(args[1])
+		return s0 + s1 - s0*s1
+
+	case "not":
+		if g, ok := args[0].Expr.(*plan.Expr_F); ok && isNullFunc(g.F.Func.ObjName) {
+			return 1 - builder.estimateSelectivity(args[0])
+		}
+		return 1 - defaultSelectivity
+
+	case "isnull", "is_null":
+		stats, rows := builder.getColumnStats(args[0])
+		if stats == nil {
+			return defaultSelectivity
+		}
+		return stats.NullCount / rows
+
+	case "=":
+		if sel, ok := builder.estimateEqualJoin(args[0], args[1]); ok {
+			return sel
+		}
+		stats, rows, v, ok := builder.getColumnAndConst(args[0], args[1])
+		if !ok {
+			return defaultSelectivity
+		}
+		notNull := (rows - stats.NullCount) / rows
+		if stats.Histogram != nil {
+			return histogramEqualSelectivity(stats.Histogram, v) * notNull
+		}
+		if stats.Ndv >= 1 {
+			return notNull / stats.Ndv
+		}
+		return defaultSelectivity
+
+	case "<", "<=", ">", ">=":
+		stats, rows, v, ok := builder.getColumnAndConst(args[0], args[1])
+		if !ok || (stats.Histogram == nil && !stats.HasRange) {
+			return rangeSelectivity
+		}
+		less := f.F.Func.ObjName == "<" || f.F.Func.ObjName == "<="
+		if !isColumn(args[0]) {
+			// const op col
+			less = !less
+		}
+		notNull := (rows - stats.NullCount) / rows
+		var sel float64
+		if stats.Histogram != nil {
+			sel = histogramLessSelectivity(stats.Histogram, v)
+		} else {
+			sel = rangeLessSelectivity(stats, v)
+		}
+		if !less {
+			sel = 1 - sel
+		}
+		return sel * notNull
+
+	default:
+		return defaultSelectivity
+	}
+}
+
+// estimateEqualJoin estimates col1 = col2 as 1 / max(ndv1, ndv2).
+func (builder *QueryBuilder) estimateEqualJoin(left, right *plan.Expr) (float64, bool) {
+	leftStats, _ := builder.getColumnStats(left)
+	rightStats, _ := builder.getColumnStats(right)
+	if leftStats == nil && rightStats == nil {
+		return 0, false
+	}
+	if !isColumn(left) || !isColumn(right) {
+		return 0, false
+	}
+	ndv := math.Max(leftStats.GetNdv(), rightStats.GetNdv())
+	if ndv < 1 {
+		return defaultSelectivity, true
+	}
+	return 1 / ndv, true
+}
+
+// getColumnAndConst matches col op const and const op col.
+func (builder *QueryBuilder) getColumnAndConst(left, right *plan.Expr) (*ColumnStats, float64, float64, bool) {
+	if !isColumn(left) {
+		left, right = right, left
+	}
+	stats, rows := builder.getColumnStats(left)
+	if stats == nil {
+		return nil, 0, 0, false
+	}
+	v, ok := getConstFloat64(right)
+	return stats, rows, v, ok
+}
+
+// estimateJoinSelectivity returns the selectivity of the conjunctive join conditions.
+func (builder *QueryBuilder) estimateJoinSelectivity(conds []*plan.Expr) float64 {
+	sel := 1.0
+	for _, cond := range conds {
+		sel *= builder.estimateSelectivity(cond)
+	}
+	return sel
+}
+
+// estimateGroupCard estimates the number of groups as the product of the ndv of the group by columns.
+func (builder *QueryBuilder) estimateGroupCard(groupBy []*plan.Expr, childCard float64) float64 {
+	card := 1.0
+	for _, expr := range groupBy {
+		stats, _ := builder.getColumnStats(expr)
+		if stats == nil || stats.Ndv < 1 {
+			return childCard * defaultSelectivity
+		}
+		card *= stats.Ndv
+	}
+	return math.Min(card, childCard)
+}
+
+// rangeLessSelectivity assumes the values are evenly distributed between min and max.
+func rangeLessSelectivity(stats *ColumnStats, v float64) float64 {
+	if v <= stats.MinValue {
+		return 0
+	}
+	if v > stats.MaxValue {
+		return 1
+	}
+	if stats.MaxValue == stats.MinValue {
+		return 0
+	}
+	return (v - stats.MinValue) / (stats.MaxValue - stats.MinValue)
+}
+
+func isColumn(expr *plan.Expr) bool {
+	if f, ok := expr.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "cast" {
+		expr = f.F.Args[0]
+	}
+	_, ok := expr.Expr.(*plan.Expr_Col)
+	return ok
+}
+
+func isNullFunc(name string) bool {
+	return name == "isnull" || name == "is_null"
+}
+
+// getConstFloat64 maps a number or time constant to float64, a constant may be wrapped by a cast.
+func getConstFloat64(expr *plan.Expr) (float64, bool) {
+	if f, ok := expr.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "cast" {
+		expr = f.F.Args[0]
+	}
+	c, ok := expr.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return 0, false
+	}
+	switch v := c.C.Value.(type) {
+	case *plan.Const_Ival:
+		return float64(v.Ival), true
+	case *plan.Const_Uval:
+		return float64(v.Uval), true
+	case *plan.Const_Dval:
+		return v.Dval, true
+	case *plan.Const_Fval:
+		return float64(v.Fval), true
+	case *plan.Const_Dateval:
+		return float64(v.Dateval), true
+	case *plan.Const_Datetimeval:
+		return float64(v.Datetimeval), true
+	case *plan.Const_Timestampval:
+		return float64(v.Timestampval), true
+	default:
+		return 0, false
+	}
+}
+
+// clampCard keeps an estimated cardinality not less than 1.
+func clampCard(card float64) float64 {
+	if card < 1 {
+		return 1
+	}
+	return card
+}
@@ -24,6 +24,30 @@ func DeepCopyExprList(list []*Expr) []*Expr {
 	return newList
 }
 
+func DeepCopyFrameClause(frame *plan.FrameClause) *plan.FrameClause {
+	if frame == nil {
+		return nil
+	}
+	newFrame := &plan.FrameClause{
+		Type: frame.Type,
+	}
+	if frame.Start != nil {
+		newFrame.Start = &plan.FrameBound{
+			Type:      frame.Start.Type,
+			Unbounded: frame.Start.Unbounded,
+			Val:       DeepCopyExpr(frame.Start.Val),
+		}
+	}
+	if frame.End != nil {
+		newFrame.End = &plan.FrameBound{
+			Type:      frame.End.Type,
+			Unbounded: frame.End.Unbounded,
+			Val:       DeepCopyExpr(frame.End.Val),
+		}
+	}
+	return newFrame
+}
+
 func DeepCopyNode(node *plan.Node) *plan.Node {
 	newNode := &Node{
 		NodeType:        node.NodeType,
@@ -44,6 +68,7 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		DeleteTablesCtx: make([]*plan.DeleteTableCtx, len(node.DeleteTablesCtx)),
 		UpdateCtxs:      make([]*plan.UpdateCtx, len(node.UpdateCtxs)),
 		TableDefVec:     make([]*plan.TableDef, len(node.TableDefVec)),
+		WindowIdx:       node.WindowIdx,
 	}
 
 	copy(newNode.Children, node.Children)
@@ -83,11 +108,15 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 
 	for idx, deleteTablesCtx := range node.DeleteTablesCtx {
 		newNode.DeleteTablesCtx[idx] = &plan.DeleteTableCtx{
-			DbName:       deleteTablesCtx.DbName,
-			TblName:      deleteTablesCtx.TblName,
-			UseDeleteKey: deleteTablesCtx.UseDeleteKey,
-			CanTruncate:  deleteTablesCtx.CanTruncate,
+			DbName:        deleteTablesCtx.DbName,
+			TblName:       deleteTablesCtx.TblName,
+			UseDeleteKey:  deleteTablesCtx.UseDeleteKey,
+			CanTruncate:   deleteTablesCtx.CanTruncate,
+			IsHideKey:     deleteTablesCtx.IsHideKey,
+			IndexAttrs:    make([]string, len(deleteTablesCtx.IndexAttrs)),
+			IndexAttrsIdx: deleteTablesCtx.IndexAttrsIdx,
 		}
+		copy(newNode.DeleteTablesCtx[idx].IndexAttrs, deleteTablesCtx.IndexAttrs)
 	}
 
 	for i, updateCtx := range node.UpdateCtxs {
@@ -101,18 +130,20 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 			OrderAttrs: make([]string, len(updateCtx.OrderAttrs)),
 			UpdateCols: make([]*ColDef, len(updateCtx.UpdateCols)),
 			OtherAttrs: make([]string, len(updateCtx.OtherAttrs)),
+			IndexAttrs: make([]string, len(updateCtx.IndexAttrs)),
 		}
 		for j, col := range updateCtx.UpdateCols {
 			newNode.UpdateCtxs[i].UpdateCols[j] = &plan.ColDef{
 				Name: col.Name,
 				Alg:  col.Alg,
 				Typ: &plan.Type{
-					Id:        col.Typ.Id,
-					Nullable:  col.Typ.Nullable,
-					Width:     col.Typ.Width,
-					Precision: col.Typ.Precision,
-					Size:      col.Typ.Size,
-					Scale:     col.Typ.Scale,
+					Id:         col.Typ.Id,
+					Nullable:   col.Typ.Nullable,
+					Width:      col.Typ.Width,
+					Precision:  col.Typ.Precision,
+					Size:       col.Typ.Size,
+					Scale:      col.Typ.Scale,
+					Enumvalues: col.Typ.Enumvalues,
 				},
 				Default: DeepCopyDefault(col.Default),
 				Primary: col.Primary,
@@ -121,6 +152,7 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		}
 		copy(newNode.UpdateCtxs[i].OtherAttrs, updateCtx.OtherAttrs)
 		copy(newNode.UpdateCtxs[i].OrderAttrs, updateCtx.OrderAttrs)
+		copy(newNode.UpdateCtxs[i].IndexAttrs, updateCtx.IndexAttrs)
 	}
 
 	for i, tbl := range node.TableDefVec {
@@ -150,12 +182,22 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		}
 	}
 
+	if node.IndexScan != nil {
+		newNode.IndexScan = DeepCopyIndexScan(node.IndexScan)
+	}
+
+	if node.JsonTable != nil {
+		newNode.JsonTable = DeepCopyJsonTable(node.JsonTable)
+	}
+
 	if node.WinSpec != nil {
 		newNode.WinSpec = &plan.WindowSpec{
 			PartitionBy: make([]*plan.Expr, len(node.WinSpec.PartitionBy)),
 			OrderBy:     make([]*plan.OrderBySpec, len(node.WinSpec.OrderBy)),
 			Lead:        node.WinSpec.Lead,
 			Lag:         node.WinSpec.Lag,
+			WindowFunc:  DeepCopyExpr(node.WinSpec.WindowFunc),
+			Frame:       DeepCopyFrameClause(node.WinSpec.Frame),
 		}
 		for idx, pb := range node.WinSpec.PartitionBy {
 			newNode.WinSpec.PartitionBy[idx] = DeepCopyExpr(pb)
@@ -206,12 +248,13 @@ func DeepCopyColDef(col *plan.ColDef) *plan.ColDef {
 		Name: col.Name,
 		Alg:  col.Alg,
 		Typ: &plan.Type{
-			Id:        col.Typ.Id,
-			Nullable:  col.Typ.Nullable,
-			Width:     col.Typ.Width,
-			Precision: col.Typ.Precision,
-			Size:      col.Typ.Size,
-			Scale:     col.Typ.Scale,
+			Id:         col.Typ.Id,
+			Nullable:   col.Typ.Nullable,
+			Width:      col.Typ.Width,
+			Precision:  col.Typ.Precision,
+			Size:       col.Typ.Size,
+			Scale:      col.Typ.Scale,
+			Enumvalues: col.Typ.Enumvalues,
 		},
 		Default: DeepCopyDefault(col.Default),
 		Primary: col.Primary,
@@ -236,6 +279,54 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 	return newTable
 }
 
+func DeepCopyIndexDef(def *plan.IndexDef) *plan.IndexDef {
+	newDef := &plan.IndexDef{
+		Typ:            def.Typ,
+		Name:           def.Name,
+		ColNames:       make([]string, len(def.ColNames)),
+		Unique:         def.Unique,
+		IndexTableName: def.IndexTableName,
+	}
+	copy(newDef.ColNames, def.ColNames)
+	return newDef
+}
+
+func DeepCopyIndexScan(scan *plan.IndexScan) *plan.IndexScan {
+	newScan := &plan.IndexScan{
+		IndexDef:   DeepCopyIndexDef(scan.IndexDef),
+		Keys:       make([]*plan.ExprList, len(scan.Keys)),
+		FilterList: make([]*plan.Expr, len(scan.FilterList)),
+	}
+	for i, key := range scan.Keys {
+		newScan.Keys[i] = &plan.ExprList{List: make([]*plan.Expr, len(key.List))}
+		for j, e := range key.List {
+			newScan.Keys[i].List[j] = DeepCopyExpr(e)
+		}
+	}
+	for i, e := range scan.FilterList {
+		newScan.FilterList[i] = DeepCopyExpr(e)
+	}
+	return newScan
+}
+
+func DeepCopyJsonTable(jt *plan.JsonTable) *plan.JsonTable {
+	newJt := &plan.JsonTable{
+		Doc:   DeepCopyExpr(jt.Doc),
+		Path:  jt.Path,
+		Cols:  make([]*plan.JsonTableColumn, len(jt.Cols)),
+		Outer: jt.Outer,
+	}
+	for i, col := range jt.Cols {
+		newJt.Cols[i] = &plan.JsonTableColumn{
+			Name: col.Name,
+			Kind: col.Kind,
+			Path: col.Path,
+			Expr: DeepCopyExpr(col.Expr),
+		}
+	}
+	return newJt
+}
+
 func DeepCopyColData(col *plan.ColData) *plan.ColData {
 	newCol := &plan.ColData{
 		RowCount:  col.RowCount,
@@ -15,7 +15,9 @@
 package explain
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -264,6 +266,34 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(options *ExplainOptions) ([]string,
 		lines = append(lines, aggListInfo)
 	}
 
+	// Get Window function info
+	if ndesc.Node.NodeType == plan.Node_WINDOW && ndesc.Node.WinSpec != nil {
+		windowInfo, err := ndesc.GetWindowInfo(options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, windowInfo...)
+	}
+
+	// Get JSON_TABLE info
+	if ndesc.Node.JsonTable != nil {
+		jsonTableInfo, err := ndesc.GetJsonTableInfo(options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, jsonTableInfo)
+	}
+
+	// Get secondary index info
+	if ndesc.Node.IndexScan != nil {
+		lines = append(lines, ndesc.GetIndexScanInfo(options))
+	}
+
+	// Get recursive CTE info
+	if ndesc.Node.RecursiveCte != nil {
+		lines = append(lines, ndesc.GetRecursiveCteInfo(options))
+	}
+
 	// Get Filter list info
 	if len(ndesc.Node.FilterList) > 0 {
 		filterInfo, err := ndesc.GetFilterConditionInfo(options)
@@ -291,6 +321,11 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(options *ExplainOptions) ([]string,
 		lines = append(lines, temp)
 	}
 
+	// Get the analyze info collected by running the query
+	if options.Anzlyze && ndesc.Node.AnalyzeInfo != nil {
+		lines = append(lines, ndesc.GetAnalyzeInfo(options))
+	}
+
 	//if ndesc.Node.UpdateList != nil {
 	//	updateListDesc := &UpdateListDescribeImpl{
 	//		UpdateList: ndesc.Node.UpdateList,
@@ -315,6 +350,32 @@ func (ndesc *NodeDescribeImpl) GetProjectListInfo(options *ExplainOptions) (stri
 	return result, nil
 }
 
+func (ndesc *NodeDescribeImpl) GetIndexScanInfo(options *ExplainOptions) string {
+	if len(ndesc.Node.IndexScan.Keys) > 0 {
+		return "Index Lookup: " + ndesc.Node.IndexScan.IndexDef.Name
+	}
+	return "Index Range Scan: " + ndesc.Node.IndexScan.IndexDef.Name
+}
+
+func (ndesc *NodeDescribeImpl) GetRecursiveCteInfo(options *ExplainOptions) string {
+	rc := ndesc.Node.RecursiveCte
+	result := "Recursive Union: "
+	if rc.UnionAll {
+		result = "Recursive Union All: "
+	}
+	return result + rc.Name + ", Max Depth: " + strconv.FormatInt(rc.MaxDepth, 10)
+}
+
+func (ndesc *NodeDescribeImpl) GetAnalyzeInfo(options *ExplainOptions) string {
+	info := ndesc.Node.AnalyzeInfo
+	result := fmt.Sprintf("Analyze: timeConsumed=%dus inputRows=%d outputRows=%d inputSize=%dbytes outputSize=%dbytes memorySize=%dbytes",
+		info.TimeConsumed, info.InputRows, info.OutputRows, info.InputSize, info.OutputSize, info.MemorySize)
+	if ndesc.Node.NodeType == plan.Node_TABLE_SCAN {
+		result += fmt.Sprintf(" prunedBlocks=%d", info.PrunedBlocks)
+	}
+	return result
+}
+
 func (ndesc *NodeDescribeImpl) GetJoinTypeInfo(options *ExplainOptions) (string, error) {
 	result := "Join Type: " + ndesc.Node.JoinType.String()
 	return result, nil
@@ -400,6 +461,97 @@ func (ndesc *NodeDescribeImpl) GetAggregationInfo(options *ExplainOptions) (stri
 	return result, nil
 }
 
+func (ndesc *NodeDescribeImpl) GetJsonTableInfo(options *ExplainOptions) (string, error) {
+	jt := ndesc.Node.JsonTable
+	if options.Format == EXPLAIN_FORMAT_TEXT {
+		descV, err := describeExpr(jt.Doc, options)
+		if err != nil {
+			return "", err
+		}
+		result := "Json Table: " + descV + ", '" + jt.Path + "'"
+		if jt.Outer {
+			result += " outer"
+		}
+		return result, nil
+	} else if options.Format == EXPLAIN_FORMAT_JSON {
+		return "", errors.New(errno.FeatureNotSupported, "unimplement explain format json")
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return "", errors.New(errno.FeatureNotSupported, "unimplement explain format dot")
+	}
+	return "", nil
+}
+
+func (ndesc *NodeDescribeImpl) GetWindowInfo(options *ExplainOptions) ([]string, error) {
+	spec := ndesc.Node.WinSpec
+	lines := make([]string, 0, 4)
+	if options.Format == EXPLAIN_FORMAT_TEXT {
+		descV, err := describeExpr(spec.WindowFunc, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, "Window Function: "+descV)
+
+		if len(spec.PartitionBy) > 0 {
+			result := "Partition By: "
+			for i, v := range spec.PartitionBy {
+				if i > 0 {
+					result += ", "
+				}
+				descV, err := describeExpr(v, options)
+				if err != nil {
+					return nil, err
+				}
+				result += descV
+			}
+			lines = append(lines, result)
+		}
+
+		if len(spec.OrderBy) > 0 {
+			result := "Order By: "
+			for i, v := range spec.OrderBy {
+				if i > 0 {
+					result += ", "
+				}
+				describe, err := NewOrderByDescribeImpl(v).GetDescription(options)
+				if err != nil {
+					return nil, err
+				}
+				result += describe
+			}
+			lines = append(lines, result)
+		}
+
+		if spec.Frame != nil {
+			result := "Frame: " + strings.ToLower(spec.Frame.Type.String()) + " between "
+			for i, bound := range []*plan.FrameBound{spec.Frame.Start, spec.Frame.End} {
+				if i > 0 {
+					result += " and "
+				}
+				switch {
+				case bound.Type == plan.FrameBound_CURRENT_ROW:
+					result += "current row"
+					continue
+				case bound.Unbounded:
+					result += "unbounded "
+				default:
+					descV, err := describeExpr(bound.Val, options)
+					if err != nil {
+						return nil, err
+					}
+					result += descV + " "
+				}
+				result += strings.ToLower(bound.Type.String())
+			}
+			lines = append(lines, result)
+		}
+	} else if options.Format == EXPLAIN_FORMAT_JSON {
+		return nil, errors.New(errno.FeatureNotSupported, "unimplement explain format json")
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return nil, errors.New(errno.FeatureNotSupported, "unimplement explain format dot")
+	}
+	return lines, nil
+}
+
 func (ndesc *NodeDescribeImpl) GetOrderByInfo(options *ExplainOptions) (string, error) {
 	result := "Sort Key:"
 	if options.Format == EXPLAIN_FORMAT_TEXT {
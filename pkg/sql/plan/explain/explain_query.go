@@ -51,9 +51,12 @@ func (e *ExplainQueryImpl) ExplainPlan(buffer *ExplainDataBuffer, options *Expla
 	return nil
 }
 
+// ExplainAnalyze explains the query plan with the analyze info of each node,
+// the query must have been run to fill the analyze info.
 func (e *ExplainQueryImpl) ExplainAnalyze(buffer *ExplainDataBuffer, options *ExplainOptions) error {
-	// TODO implement me
-	panic("implement me")
+	es := *options
+	es.Anzlyze = true
+	return e.ExplainPlan(buffer, &es)
 }
 
 func explainStep(step *plan.Node, settings *FormatSettings, options *ExplainOptions) error {
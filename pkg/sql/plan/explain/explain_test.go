@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	plan2 "github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
@@ -74,6 +75,34 @@ func TestBasicSqlExplain(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+func TestExplainAnalyze(t *testing.T) {
+	sql := "SELECT N_NAME FROM NATION WHERE N_NATIONKEY = 10"
+	stmts, err := mysql.Parse(sql)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	mock := plan.NewMockOptimizer()
+	logicPlan, err := plan.BuildPlan(mock.CurrentContext(), stmts[0])
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, node := range logicPlan.GetQuery().Nodes {
+		node.AnalyzeInfo = &plan2.AnalyzeInfo{InputRows: 100, OutputRows: 1}
+		if node.NodeType == plan2.Node_TABLE_SCAN {
+			node.AnalyzeInfo.PrunedBlocks = 3
+		}
+	}
+	buffer := NewExplainDataBuffer()
+	err = NewExplainQueryImpl(logicPlan.GetQuery()).ExplainAnalyze(buffer, NewExplainDefaultOptions())
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	explain := strings.Join(buffer.Lines, "\n")
+	if !strings.Contains(explain, "inputRows=100 outputRows=1") || !strings.Contains(explain, "prunedBlocks=3") {
+		t.Fatalf("unexpected explain analyze result: %s", explain)
+	}
+}
+
 // Single table query
 func TestSingleTableQuery(t *testing.T) {
 	sqls := []string{
@@ -187,6 +216,18 @@ func TestDerivedTableQuery(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+// Test Recursive CTE Query
+func TestRecursiveCTEQuery(t *testing.T) {
+	sqls := []string{
+		"explain with recursive qn(n) as (select 1 union all select n + 1 from qn where n < 10) select * from qn",
+		"explain verbose with recursive qn(n) as (select 1 union all select n + 1 from qn where n < 10) select * from qn",
+		"explain with recursive qn as (select n_nationkey from nation where n_nationkey = 0 union select nation.n_nationkey from nation join qn on nation.n_regionkey = qn.n_nationkey) select * from qn",
+		"explain verbose with recursive qn as (select n_nationkey from nation where n_nationkey = 0 union select nation.n_nationkey from nation join qn on nation.n_regionkey = qn.n_nationkey) select * from qn",
+	}
+	mockOptimizer := plan.NewMockOptimizer()
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
 // Collection query
 func TestCollectionQuery(t *testing.T) {
 
@@ -198,6 +198,14 @@ var aggregates = map[int]Functions{
 				ReturnTyp:     types.T_uuid,
 				AggregateInfo: agg.AggregateMax,
 			},
+			{
+				Index:         20,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_time},
+				ReturnTyp:     types.T_time,
+				AggregateInfo: agg.AggregateMax,
+			},
 		},
 	},
 	MIN: {
@@ -362,6 +370,14 @@ var aggregates = map[int]Functions{
 				ReturnTyp:     types.T_uuid,
 				AggregateInfo: agg.AggregateMin,
 			},
+			{
+				Index:         20,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_time},
+				ReturnTyp:     types.T_time,
+				AggregateInfo: agg.AggregateMin,
+			},
 		},
 	},
 	SUM: {
@@ -963,6 +979,50 @@ var aggregates = map[int]Functions{
 				ReturnTyp:     types.T_uuid,
 				AggregateInfo: agg.AggregateAnyValue,
 			},
+			{
+				Index:         20,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_time},
+				ReturnTyp:     types.T_time,
+				AggregateInfo: agg.AggregateAnyValue,
+			},
+		},
+	},
+	JSON_ARRAYAGG: {
+		Id: JSON_ARRAYAGG,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, _ []types.T) {
+			if len(inputs) == 1 && inputs[0] == types.T_json {
+				return 0, nil
+			}
+			return wrongFunctionParameters, nil
+		},
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				ReturnTyp:     types.T_json,
+				AggregateInfo: agg.AggregateJsonArrayAgg,
+			},
+		},
+	},
+	JSON_OBJECTAGG: {
+		Id: JSON_OBJECTAGG,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, _ []types.T) {
+			if len(inputs) == 1 && inputs[0] == types.T_json {
+				return 0, nil
+			}
+			return wrongFunctionParameters, nil
+		},
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				ReturnTyp:     types.T_json,
+				AggregateInfo: agg.AggregateJsonObjectAgg,
+			},
 		},
 	},
 }
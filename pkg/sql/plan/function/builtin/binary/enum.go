@@ -0,0 +1,137 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// The enum and set functions take the value list of the column as the first
+// argument, it's a constant made by the plan from the column definition
+
+// CastValueToEnum converts the values or the ordinals to the enum ordinals
+func CastValueToEnum(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	values := enumValues(vectors[0])
+	inputVector := vectors[1]
+	resultType := types.Type{Oid: types.T_enum, Size: 2}
+	switch inputVector.Typ.Oid {
+	case types.T_int64:
+		return enumCast(inputVector, vector.MustTCols[int64](inputVector), resultType, proc, func(v int64) (types.Enum, error) {
+			if v < 0 {
+				return types.ParseIntToEnum(values, 0)
+			}
+			return types.ParseIntToEnum(values, uint64(v))
+		})
+	case types.T_uint64:
+		return enumCast(inputVector, vector.MustTCols[uint64](inputVector), resultType, proc, func(v uint64) (types.Enum, error) {
+			return types.ParseIntToEnum(values, v)
+		})
+	default:
+		return enumCast(inputVector, vector.MustStrCols(inputVector), resultType, proc, func(v string) (types.Enum, error) {
+			return types.ParseEnum(values, v)
+		})
+	}
+}
+
+// CastValueToSet converts the comma separated values or the bitmaps to the set bitmaps
+func CastValueToSet(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	values := enumValues(vectors[0])
+	inputVector := vectors[1]
+	resultType := types.Type{Oid: types.T_set, Size: 8}
+	switch inputVector.Typ.Oid {
+	case types.T_int64:
+		return enumCast(inputVector, vector.MustTCols[int64](inputVector), resultType, proc, func(v int64) (types.Set, error) {
+			return types.ParseIntToSet(values, uint64(v))
+		})
+	case types.T_uint64:
+		return enumCast(inputVector, vector.MustTCols[uint64](inputVector), resultType, proc, func(v uint64) (types.Set, error) {
+			return types.ParseIntToSet(values, v)
+		})
+	default:
+		return enumCast(inputVector, vector.MustStrCols(inputVector), resultType, proc, func(v string) (types.Set, error) {
+			return types.ParseSet(values, v)
+		})
+	}
+}
+
+// CastEnumToValue converts the enum ordinals to their values
+func CastEnumToValue(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	values := enumValues(vectors[0])
+	return enumToString(vectors[1], proc, func(v types.Enum) string {
+		return v.ToString(values)
+	})
+}
+
+// CastSetToValue converts the set bitmaps to their comma separated values
+func CastSetToValue(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	values := enumValues(vectors[0])
+	return enumToString(vectors[1], proc, func(v types.Set) string {
+		return v.ToString(values)
+	})
+}
+
+func enumValues(vec *vector.Vector) []string {
+	return types.ParseEnumValues(vector.MustStrCols(vec)[0])
+}
+
+func enumCast[T any, R types.FixedSizeT](inputVector *vector.Vector, inputValues []T, resultType types.Type, proc *process.Process,
+	fn func(T) (R, error)) (*vector.Vector, error) {
+	if inputVector.IsScalar() {
+		if inputVector.IsScalarNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		r, err := fn(inputValues[0])
+		if err != nil {
+			return nil, err
+		}
+		return vector.NewConstFixed(resultType, inputVector.Length(), r), nil
+	}
+	resultVector, err := proc.AllocVectorOfRows(resultType, int64(len(inputValues)), inputVector.Nsp)
+	if err != nil {
+		return nil, err
+	}
+	resultValues := vector.MustTCols[R](resultVector)
+	for i, v := range inputValues {
+		if nulls.Contains(inputVector.Nsp, uint64(i)) {
+			continue
+		}
+		if resultValues[i], err = fn(v); err != nil {
+			resultVector.Free(proc.Mp())
+			return nil, err
+		}
+	}
+	return resultVector, nil
+}
+
+func enumToString[T types.Enum | types.Set](inputVector *vector.Vector, proc *process.Process, fn func(T) string) (*vector.Vector, error) {
+	resultType := types.T_varchar.ToType()
+	inputValues := vector.MustTCols[T](inputVector)
+	if inputVector.IsScalar() {
+		if inputVector.IsScalarNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		return vector.NewConstString(resultType, inputVector.Length(), fn(inputValues[0])), nil
+	}
+	resultValues := make([]string, len(inputValues))
+	for i, v := range inputValues {
+		if !nulls.Contains(inputVector.Nsp, uint64(i)) {
+			resultValues[i] = fn(v)
+		}
+	}
+	return vector.NewWithStrings(resultType, resultValues, inputVector.Nsp, proc.Mp()), nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCastEnum(t *testing.T) {
+	proc := testutil.NewProc()
+	vecs := []*vector.Vector{
+		testutil.MakeScalarVarchar("small,medium,large", 1),
+		testutil.MakeVarcharVector([]string{"Medium ", "", "3"}, []uint64{1}),
+	}
+	result, err := CastValueToEnum(vecs, proc)
+	require.NoError(t, err)
+	require.Equal(t, types.Enum(2), vector.MustTCols[types.Enum](result)[0])
+	require.Equal(t, types.Enum(3), vector.MustTCols[types.Enum](result)[2])
+	require.True(t, result.Nsp.Contains(1))
+
+	result, err = CastEnumToValue([]*vector.Vector{vecs[0], result}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "medium", result.GetString(0))
+	require.Equal(t, "large", result.GetString(2))
+	require.True(t, result.Nsp.Contains(1))
+
+	vecs[1] = testutil.MakeInt64Vector([]int64{1, 4}, nil)
+	_, err = CastValueToEnum(vecs, proc)
+	require.Error(t, err)
+
+	vecs[1] = testutil.MakeScalarVarchar("tiny", 1)
+	_, err = CastValueToEnum(vecs, proc)
+	require.Error(t, err)
+}
+
+func TestCastSet(t *testing.T) {
+	proc := testutil.NewProc()
+	vecs := []*vector.Vector{
+		testutil.MakeScalarVarchar("a,b,c,d", 1),
+		testutil.MakeVarcharVector([]string{"d,a,d", "", "b"}, nil),
+	}
+	result, err := CastValueToSet(vecs, proc)
+	require.NoError(t, err)
+	require.Equal(t, []types.Set{9, 0, 2}, vector.MustTCols[types.Set](result))
+
+	result, err = CastSetToValue([]*vector.Vector{vecs[0], result}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a,d", "", "b"}, vector.MustStrCols(result))
+
+	vecs[1] = testutil.MakeUint64Vector([]uint64{16}, nil)
+	_, err = CastValueToSet(vecs, proc)
+	require.Error(t, err)
+}
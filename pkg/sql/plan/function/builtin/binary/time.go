@@ -0,0 +1,112 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var errDatetimeOverflow = errors.New(errno.DataException, "Datetime value is out of range in 'addtime'")
+
+func TimeDiff(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_time, Size: 8, Precision: maxPrecision(vectors)}
+	return clockBinary(vectors, resultType, proc, func(x, y types.Time) (types.Time, error) {
+		return x.SubTime(y), nil
+	})
+}
+
+func DatetimeDiff(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_time, Size: 8, Precision: maxPrecision(vectors)}
+	return clockBinary(vectors, resultType, proc, func(x, y types.Datetime) (types.Time, error) {
+		return x.DiffTime(y), nil
+	})
+}
+
+func AddTime(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_time, Size: 8, Precision: maxPrecision(vectors)}
+	return clockBinary(vectors, resultType, proc, func(x, y types.Time) (types.Time, error) {
+		return x.AddTime(y), nil
+	})
+}
+
+func DatetimeAddTime(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_datetime, Size: 8, Precision: maxPrecision(vectors)}
+	return clockBinary(vectors, resultType, proc, func(x types.Datetime, y types.Time) (types.Datetime, error) {
+		r, ok := x.AddTime(y)
+		if !ok {
+			return 0, errDatetimeOverflow
+		}
+		return r, nil
+	})
+}
+
+func maxPrecision(vectors []*vector.Vector) int32 {
+	precision := vectors[0].Typ.Precision
+	if vectors[1].Typ.Precision > precision {
+		precision = vectors[1].Typ.Precision
+	}
+	return precision
+}
+
+// clockBinary applies fn row by row to the two input vectors, a constant input
+// is used for all the rows and a null in either input makes the result null
+func clockBinary[T1, T2, R types.FixedSizeT](vectors []*vector.Vector, resultType types.Type, proc *process.Process,
+	fn func(T1, T2) (R, error)) (*vector.Vector, error) {
+	left, right := vectors[0], vectors[1]
+	leftValues, rightValues := vector.MustTCols[T1](left), vector.MustTCols[T2](right)
+	if left.IsScalarNull() || right.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	if left.IsScalar() && right.IsScalar() {
+		r, err := fn(leftValues[0], rightValues[0])
+		if err != nil {
+			return nil, err
+		}
+		return vector.NewConstFixed(resultType, 1, r), nil
+	}
+
+	length := len(leftValues)
+	if left.IsScalar() {
+		length = len(rightValues)
+	}
+	resultVector, err := proc.AllocVectorOfRows(resultType, int64(length), nil)
+	if err != nil {
+		return nil, err
+	}
+	resultValues := vector.MustTCols[R](resultVector)
+	nulls.Or(left.Nsp, right.Nsp, resultVector.Nsp)
+	for i := range resultValues {
+		if nulls.Contains(resultVector.Nsp, uint64(i)) {
+			continue
+		}
+		x, y := leftValues[0], rightValues[0]
+		if !left.IsScalar() {
+			x = leftValues[i]
+		}
+		if !right.IsScalar() {
+			y = rightValues[i]
+		}
+		if resultValues[i], err = fn(x, y); err != nil {
+			resultVector.Free(proc.Mp())
+			return nil, err
+		}
+	}
+	return resultVector, nil
+}
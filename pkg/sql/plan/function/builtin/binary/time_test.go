@@ -0,0 +1,74 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeDiff(t *testing.T) {
+	proc := testutil.NewProc()
+	vecs := []*vector.Vector{
+		testutil.MakeTimeVector([]string{"10:00:00", "00:00:00", "838:00:00"}, []uint64{1}),
+		testutil.MakeScalarTime("12:30:00", 3),
+	}
+	result, err := TimeDiff(vecs, proc)
+	require.NoError(t, err)
+	require.Equal(t, "-02:30:00", vector.MustTCols[types.Time](result)[0].String())
+	require.Equal(t, "825:30:00", vector.MustTCols[types.Time](result)[2].String())
+	require.True(t, result.Nsp.Contains(1))
+
+	vecs = []*vector.Vector{
+		testutil.MakeScalarDateTime("2022-10-02 10:00:00", 1),
+		testutil.MakeScalarDateTime("2022-10-01 12:30:00", 1),
+	}
+	result, err = DatetimeDiff(vecs, proc)
+	require.NoError(t, err)
+	require.True(t, result.IsScalar())
+	require.Equal(t, "21:30:00", vector.MustTCols[types.Time](result)[0].String())
+
+	vecs[1] = testutil.MakeScalarNull(types.T_datetime, 1)
+	result, err = DatetimeDiff(vecs, proc)
+	require.NoError(t, err)
+	require.True(t, result.IsScalarNull())
+}
+
+func TestAddTime(t *testing.T) {
+	proc := testutil.NewProc()
+	vecs := []*vector.Vector{
+		testutil.MakeTimeVector([]string{"10:00:00", "838:00:00"}, nil),
+		testutil.MakeTimeVector([]string{"12:30:00", "02:00:00"}, nil),
+	}
+	result, err := AddTime(vecs, proc)
+	require.NoError(t, err)
+	require.Equal(t, []types.Time{types.TimeFromClock(false, 22, 30, 0, 0), types.MaxTime}, vector.MustTCols[types.Time](result))
+
+	vecs = []*vector.Vector{
+		testutil.MakeDateTimeVector([]string{"2022-10-01 23:00:00"}, nil),
+		testutil.MakeScalarTime("01:30:00", 1),
+	}
+	result, err = DatetimeAddTime(vecs, proc)
+	require.NoError(t, err)
+	require.Equal(t, "2022-10-02 00:30:00", vector.MustTCols[types.Datetime](result)[0].String())
+
+	vecs[0] = testutil.MakeDateTimeVector([]string{"9999-12-31 23:00:00"}, nil)
+	_, err = DatetimeAddTime(vecs, proc)
+	require.Error(t, err)
+}
@@ -168,6 +168,11 @@ var (
 	DateToDatetime      = dateToDateTime
 	DatetimeToBytes     = datetimeToBytes
 	DatetimeToDate      = datetimeToDate
+	DatetimeToTime      = datetimeToTime
+	TimeToBytes         = timeToBytes
+	TimeToDatetime      = timeToDatetime
+	TimeToInt64         = timeToInt64
+	TimeToFloat64       = timeToFloat64
 	UuidToBytes         = uuidToBytes
 )
 
@@ -740,6 +745,75 @@ func datetimeToBytes(xs []types.Datetime, rs []string, precision int32) ([]strin
 	return rs, nil
 }
 
+func datetimeToTime(xs []types.Datetime, rs []types.Time, precision int32) ([]types.Time, error) {
+	for i, x := range xs {
+		rs[i] = x.ToTime(precision)
+	}
+	return rs, nil
+}
+
+func timeToBytes(xs []types.Time, rs []string, precision int32) ([]string, error) {
+	for i, x := range xs {
+		rs[i] = x.String2(precision)
+	}
+	return rs, nil
+}
+
+// timeToDatetime converts the times to the datetimes of the date, mysql takes the current date
+func timeToDatetime(date types.Date, xs []types.Time, rs []types.Datetime) ([]types.Datetime, error) {
+	for i, x := range xs {
+		rs[i] = x.ToDatetime(date)
+	}
+	return rs, nil
+}
+
+func timeToInt64(xs []types.Time, rs []int64) ([]int64, error) {
+	for i, x := range xs {
+		rs[i] = x.ToInt64()
+	}
+	return rs, nil
+}
+
+func timeToFloat64(xs []types.Time, rs []float64) ([]float64, error) {
+	for i, x := range xs {
+		rs[i] = x.ToFloat64()
+	}
+	return rs, nil
+}
+
+// NumericToTime converts the numbers hhmmss to times
+func NumericToTime[T constraints.Integer](xs []T, rs []types.Time) ([]types.Time, error) {
+	var err error
+	for i, x := range xs {
+		if x > 0 && int64(x) < 0 {
+			return nil, types.ErrTimeOutOfRange
+		}
+		if rs[i], err = types.ParseInt64ToTime(int64(x)); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+// FloatToTime converts the numbers hhmmss.msec to times
+func FloatToTime[T constraints.Float](xs []T, rs []types.Time, precision int32) ([]types.Time, error) {
+	var err error
+	for i, x := range xs {
+		if rs[i], err = types.ParseFloat64ToTime(float64(x), precision); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+// EnumToNumeric converts the enum ordinals or the set bitmaps to numbers
+func EnumToNumeric[T1 types.Enum | types.Set, T2 int64 | uint64 | float64](xs []T1, rs []T2) ([]T2, error) {
+	for i, x := range xs {
+		rs[i] = T2(x)
+	}
+	return rs, nil
+}
+
 func uuidToBytes(xs []types.Uuid, rs []string) ([]string, error) {
 	for i, x := range xs {
 		rs[i] = x.ToString()
@@ -0,0 +1,90 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// constArgs returns whether each argument is a constant, the number of rows
+// of the result and whether the result is a constant, which is if all the
+// arguments are constants
+func constArgs(vectors []*vector.Vector) ([]bool, int, bool) {
+	cs := make([]bool, len(vectors))
+	rows, isConst := 1, true
+	for j, vec := range vectors {
+		cs[j] = vec.IsScalar()
+		if !cs[j] {
+			rows, isConst = vec.Length(), false
+		}
+	}
+	return cs, rows, isConst
+}
+
+// constNullArg checks whether any argument is a constant null
+func constNullArg(vectors []*vector.Vector) bool {
+	for _, vec := range vectors {
+		if vec.IsScalarNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// argsLength is the length of the arguments
+func argsLength(vectors []*vector.Vector) int {
+	for _, vec := range vectors {
+		if !vec.IsScalar() {
+			return vec.Length()
+		}
+	}
+	return vectors[0].Length()
+}
+
+// argNulls returns the rows having a null argument
+func argNulls(vectors []*vector.Vector, rows int) *nulls.Nulls {
+	ns := nulls.NewWithSize(rows)
+	for _, vec := range vectors {
+		if !vec.IsScalar() {
+			nulls.Set(ns, vec.Nsp)
+		}
+	}
+	return ns
+}
+
+func stringResult(vectors []*vector.Vector, resultType types.Type, resultValues []string, resultNsp *nulls.Nulls,
+	isConst bool, proc *process.Process) *vector.Vector {
+	if isConst {
+		if nulls.Contains(resultNsp, 0) {
+			return proc.AllocConstNullVector(resultType, argsLength(vectors))
+		}
+		return vector.NewConstString(resultType, argsLength(vectors), resultValues[0])
+	}
+	return vector.NewWithStrings(resultType, resultValues, resultNsp, proc.Mp())
+}
+
+func fixedResult[T types.FixedSizeT](vectors []*vector.Vector, resultType types.Type, resultValues []T, resultNsp *nulls.Nulls,
+	isConst bool, proc *process.Process) *vector.Vector {
+	if isConst {
+		if nulls.Contains(resultNsp, 0) {
+			return proc.AllocConstNullVector(resultType, argsLength(vectors))
+		}
+		return vector.NewConstFixed(resultType, argsLength(vectors), resultValues[0])
+	}
+	return vector.NewWithFixed(resultType, resultValues, resultNsp, proc.Mp())
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/greatest"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"golang.org/x/exp/constraints"
+)
+
+// GreatestOrdered is GREATEST(expr, expr...) of the numbers and the datetimes,
+// the result is null if any argument is null
+func GreatestOrdered[T constraints.Ordered](vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return extremeOrdered(vectors, proc, greatest.Greatest[T])
+}
+
+// LeastOrdered is LEAST(expr, expr...) of the numbers and the datetimes
+func LeastOrdered[T constraints.Ordered](vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return extremeOrdered(vectors, proc, greatest.Least[T])
+}
+
+// GreatestString is GREATEST(expr, expr...) of the strings
+func GreatestString(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return extremeString(vectors, proc, greatest.Greatest[string])
+}
+
+// LeastString is LEAST(expr, expr...) of the strings
+func LeastString(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return extremeString(vectors, proc, greatest.Least[string])
+}
+
+// extremeOrdered keeps the value of each row chosen by the kernel from one argument after another
+func extremeOrdered[T constraints.Ordered](vectors []*vector.Vector, proc *process.Process,
+	kernel func([]T, bool, []T) []T) (*vector.Vector, error) {
+	resultType := extremeResultType(vectors)
+	if constNullArg(vectors) {
+		return proc.AllocConstNullVector(resultType, argsLength(vectors)), nil
+	}
+	cs, rows, isConst := constArgs(vectors)
+	resultValues := greatest.Fill(vector.MustTCols[T](vectors[0]), cs[0], make([]T, rows))
+	for j := 1; j < len(vectors); j++ {
+		kernel(vector.MustTCols[T](vectors[j]), cs[j], resultValues)
+	}
+	return fixedResult(vectors, resultType, resultValues, argNulls(vectors, rows), isConst, proc), nil
+}
+
+func extremeString(vectors []*vector.Vector, proc *process.Process,
+	kernel func([]string, bool, []string) []string) (*vector.Vector, error) {
+	resultType := extremeResultType(vectors)
+	if constNullArg(vectors) {
+		return proc.AllocConstNullVector(resultType, argsLength(vectors)), nil
+	}
+	cs, rows, isConst := constArgs(vectors)
+	resultValues := greatest.Fill(vector.MustStrCols(vectors[0]), cs[0], make([]string, rows))
+	for j := 1; j < len(vectors); j++ {
+		kernel(vector.MustStrCols(vectors[j]), cs[j], resultValues)
+	}
+	return stringResult(vectors, resultType, resultValues, argNulls(vectors, rows), isConst, proc), nil
+}
+
+// extremeResultType is the type of the first argument which is not a null
+// constant, the arguments have been cast to the same type
+func extremeResultType(vectors []*vector.Vector) types.Type {
+	for _, vec := range vectors {
+		if vec.Typ.Oid != types.T_any {
+			return vec.Typ
+		}
+	}
+	return vectors[0].Typ
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/hash"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Hash is HASH(expr...), a 64 bits hash of the values of the arguments, the
+// null values are hashed too
+func Hash(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.T_int64.ToType()
+	_, rows, isConst := constArgs(vectors)
+	hs := hash.New(rows)
+	for _, vec := range vectors {
+		c := vec.IsScalar()
+		switch vec.Typ.Oid {
+		case types.T_bool:
+			hash.UpdateFixed(hs, vector.MustTCols[bool](vec), c, vec.Nsp)
+		case types.T_int8:
+			hash.UpdateFixed(hs, vector.MustTCols[int8](vec), c, vec.Nsp)
+		case types.T_int16:
+			hash.UpdateFixed(hs, vector.MustTCols[int16](vec), c, vec.Nsp)
+		case types.T_int32:
+			hash.UpdateFixed(hs, vector.MustTCols[int32](vec), c, vec.Nsp)
+		case types.T_int64:
+			hash.UpdateFixed(hs, vector.MustTCols[int64](vec), c, vec.Nsp)
+		case types.T_uint8:
+			hash.UpdateFixed(hs, vector.MustTCols[uint8](vec), c, vec.Nsp)
+		case types.T_uint16:
+			hash.UpdateFixed(hs, vector.MustTCols[uint16](vec), c, vec.Nsp)
+		case types.T_uint32:
+			hash.UpdateFixed(hs, vector.MustTCols[uint32](vec), c, vec.Nsp)
+		case types.T_uint64:
+			hash.UpdateFixed(hs, vector.MustTCols[uint64](vec), c, vec.Nsp)
+		case types.T_float32:
+			hash.UpdateFixed(hs, vector.MustTCols[float32](vec), c, vec.Nsp)
+		case types.T_float64:
+			hash.UpdateFixed(hs, vector.MustTCols[float64](vec), c, vec.Nsp)
+		case types.T_date:
+			hash.UpdateFixed(hs, vector.MustTCols[types.Date](vec), c, vec.Nsp)
+		case types.T_time:
+			hash.UpdateFixed(hs, vector.MustTCols[types.Time](vec), c, vec.Nsp)
+		case types.T_datetime:
+			hash.UpdateFixed(hs, vector.MustTCols[types.Datetime](vec), c, vec.Nsp)
+		case types.T_timestamp:
+			hash.UpdateFixed(hs, vector.MustTCols[types.Timestamp](vec), c, vec.Nsp)
+		case types.T_decimal64:
+			hash.UpdateFixed(hs, vector.MustTCols[types.Decimal64](vec), c, vec.Nsp)
+		case types.T_decimal128:
+			hash.UpdateFixed(hs, vector.MustTCols[types.Decimal128](vec), c, vec.Nsp)
+		case types.T_uuid:
+			hash.UpdateFixed(hs, vector.MustTCols[types.Uuid](vec), c, vec.Nsp)
+		case types.T_enum:
+			hash.UpdateFixed(hs, vector.MustTCols[types.Enum](vec), c, vec.Nsp)
+		case types.T_set:
+			hash.UpdateFixed(hs, vector.MustTCols[types.Set](vec), c, vec.Nsp)
+		case types.T_char, types.T_varchar, types.T_blob, types.T_json:
+			hash.UpdateBytes(hs, vector.MustBytesCols(vec), c, vec.Nsp)
+		default:
+			// the constant null
+			hash.UpdateNull(hs)
+		}
+	}
+	resultValues := hash.Sum(hs, make([]int64, rows))
+	if isConst {
+		return vector.NewConstFixed(resultType, argsLength(vectors), resultValues[0]), nil
+	}
+	return vector.NewWithFixed(resultType, resultValues, nil, proc.Mp()), nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHash(t *testing.T) {
+	proc := testutil.NewProc()
+	ints := testutil.MakeInt64Vector([]int64{1, 2, 1, 1}, []uint64{3})
+	strs := testutil.MakeVarcharVector([]string{"a", "a", "a", "a"}, nil)
+	vec, err := Hash([]*vector.Vector{ints, strs}, proc)
+	require.NoError(t, err)
+	hs := vector.MustTCols[int64](vec)
+	require.Equal(t, hs[0], hs[2])
+	require.NotEqual(t, hs[0], hs[1])
+	require.NotEqual(t, hs[0], hs[3])
+	require.False(t, vec.Nsp.Any())
+
+	// the constants hash as the same values of a column
+	vec, err = Hash([]*vector.Vector{testutil.MakeScalarInt64(1, 1), testutil.MakeScalarVarchar("a", 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalar())
+	require.Equal(t, hs[0], vector.MustTCols[int64](vec)[0])
+
+	// the arguments are length prefixed
+	v1, err := Hash([]*vector.Vector{testutil.MakeScalarVarchar("ab", 1), testutil.MakeScalarVarchar("c", 1)}, proc)
+	require.NoError(t, err)
+	v2, err := Hash([]*vector.Vector{testutil.MakeScalarVarchar("a", 1), testutil.MakeScalarVarchar("bc", 1)}, proc)
+	require.NoError(t, err)
+	require.NotEqual(t, vector.MustTCols[int64](v1)[0], vector.MustTCols[int64](v2)[0])
+
+	vec, err = Hash([]*vector.Vector{testutil.MakeScalarNull(types.T_any, 1)}, proc)
+	require.NoError(t, err)
+	require.False(t, vec.IsScalarNull())
+}
+
+func TestGreatestLeast(t *testing.T) {
+	proc := testutil.NewProc()
+	a := testutil.MakeInt64Vector([]int64{1, 5, 3, 0}, []uint64{3})
+	b := testutil.MakeInt64Vector([]int64{4, 2, 3, 1}, nil)
+	c := testutil.MakeScalarInt64(2, 4)
+
+	vec, err := GreatestOrdered[int64]([]*vector.Vector{a, b, c}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 5, 3}, vector.MustTCols[int64](vec)[:3])
+	require.True(t, vec.Nsp.Contains(3))
+
+	vec, err = LeastOrdered[int64]([]*vector.Vector{a, b, c}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 2}, vector.MustTCols[int64](vec)[:3])
+
+	strs := testutil.MakeVarcharVector([]string{"apple", "pear", "fig"}, nil)
+	vec, err = GreatestString([]*vector.Vector{strs, testutil.MakeScalarVarchar("kiwi", 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"kiwi", "pear", "kiwi"}, rowStrings(vec))
+
+	vec, err = LeastString([]*vector.Vector{testutil.MakeScalarVarchar("b", 1), testutil.MakeScalarVarchar("a", 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalar())
+	require.Equal(t, []string{"a"}, rowStrings(vec))
+
+	vec, err = LeastString([]*vector.Vector{strs, testutil.MakeScalarNull(types.T_any, 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"NULL", "NULL", "NULL"}, rowStrings(vec))
+	require.Equal(t, types.T_varchar, vec.Typ.Oid)
+}
@@ -0,0 +1,452 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// The arguments of the JSON functions are documents, values and paths.
+// A document is a json or a string parsed as a JSON text, a value is a
+// json, a string taken as a JSON string or a number, and a path is a string.
+
+// JsonObject builds an object of the key value pairs
+func JsonObject(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if len(vectors)%2 != 0 {
+		return nil, errors.New(errno.DataException, "incorrect parameter count in the call to native function 'json_object'")
+	}
+	return jsonEval(vectors, proc, types.T_json.ToType(), func(args jsonArgs, i int) ([]byte, bool, error) {
+		keys := make([]string, 0, len(vectors)/2)
+		vals := make([]bytejson.ByteJson, 0, len(vectors)/2)
+		for j := 0; j < len(vectors); j += 2 {
+			if args.isNull(j, i) {
+				return nil, false, errors.New(errno.DataException, "JSON documents may not contain NULL member names")
+			}
+			val, err := args.value(j+1, i)
+			if err != nil {
+				return nil, false, err
+			}
+			keys = append(keys, args.str(j, i))
+			vals = append(vals, val)
+		}
+		bj, err := bytejson.CreateObject(keys, vals)
+		if err != nil {
+			return nil, false, err
+		}
+		return encodeJson(bj)
+	})
+}
+
+// JsonArray builds an array of the values
+func JsonArray(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonEval(vectors, proc, types.T_json.ToType(), func(args jsonArgs, i int) ([]byte, bool, error) {
+		elems := make([]bytejson.ByteJson, len(vectors))
+		for j := range vectors {
+			val, err := args.value(j, i)
+			if err != nil {
+				return nil, false, err
+			}
+			elems[j] = val
+		}
+		return encodeJson(bytejson.CreateArray(elems))
+	})
+}
+
+// JsonSet replaces the existing values and adds the missing ones
+func JsonSet(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonModify(vectors, proc, "json_set", bytejson.ModifySet)
+}
+
+// JsonInsert adds the missing values
+func JsonInsert(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonModify(vectors, proc, "json_insert", bytejson.ModifyInsert)
+}
+
+// JsonReplace replaces the existing values
+func JsonReplace(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonModify(vectors, proc, "json_replace", bytejson.ModifyReplace)
+}
+
+func jsonModify(vectors []*vector.Vector, proc *process.Process, name string, tp bytejson.ModifyType) (*vector.Vector, error) {
+	if len(vectors) < 3 || len(vectors)%2 == 0 {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("incorrect parameter count in the call to native function '%s'", name))
+	}
+	return jsonEval(vectors, proc, types.T_json.ToType(), func(args jsonArgs, i int) ([]byte, bool, error) {
+		if args.isNull(0, i) {
+			return nil, true, nil
+		}
+		paths := make([]bytejson.Path, 0, len(vectors)/2)
+		vals := make([]bytejson.ByteJson, 0, len(vectors)/2)
+		for j := 1; j < len(vectors); j += 2 {
+			if args.isNull(j, i) {
+				return nil, true, nil
+			}
+			path, err := args.path(j, i)
+			if err != nil {
+				return nil, false, err
+			}
+			val, err := args.value(j+1, i)
+			if err != nil {
+				return nil, false, err
+			}
+			paths = append(paths, path)
+			vals = append(vals, val)
+		}
+		doc, err := args.doc(0, i)
+		if err != nil {
+			return nil, false, err
+		}
+		if doc, err = doc.Modify(paths, vals, tp); err != nil {
+			return nil, false, err
+		}
+		return encodeJson(doc)
+	})
+}
+
+// JsonRemove removes the values at the paths
+func JsonRemove(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonEval(vectors, proc, types.T_json.ToType(), func(args jsonArgs, i int) ([]byte, bool, error) {
+		if args.anyNull(i) {
+			return nil, true, nil
+		}
+		paths, err := args.paths(1, i)
+		if err != nil {
+			return nil, false, err
+		}
+		doc, err := args.doc(0, i)
+		if err != nil {
+			return nil, false, err
+		}
+		if doc, err = doc.Remove(paths); err != nil {
+			return nil, false, err
+		}
+		return encodeJson(doc)
+	})
+}
+
+// JsonContains checks whether the candidate is contained in the document or
+// in the value at the path of the document
+func JsonContains(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonEval(vectors, proc, types.T_int64.ToType(), func(args jsonArgs, i int) (int64, bool, error) {
+		if args.anyNull(i) {
+			return 0, true, nil
+		}
+		doc, ok, err := args.target(2, i)
+		if err != nil || !ok {
+			return 0, !ok, err
+		}
+		candidate, err := args.doc(1, i)
+		if err != nil {
+			return 0, false, err
+		}
+		return boolToInt64(doc.Contains(candidate)), false, nil
+	})
+}
+
+// JsonContainsPath checks whether the document has one or all of the paths
+func JsonContainsPath(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonEval(vectors, proc, types.T_int64.ToType(), func(args jsonArgs, i int) (int64, bool, error) {
+		if args.anyNull(i) {
+			return 0, true, nil
+		}
+		var all bool
+		switch strings.ToLower(args.str(1, i)) {
+		case "one":
+		case "all":
+			all = true
+		default:
+			return 0, false, errors.New(errno.DataException, "the oneOrAll argument to json_contains_path may take these values: 'one' or 'all'")
+		}
+		paths, err := args.paths(2, i)
+		if err != nil {
+			return 0, false, err
+		}
+		doc, err := args.doc(0, i)
+		if err != nil {
+			return 0, false, err
+		}
+		return boolToInt64(doc.ContainsPath(paths, all)), false, nil
+	})
+}
+
+// JsonKeys returns the keys of the object in the document or at the path as an array
+func JsonKeys(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonEval(vectors, proc, types.T_json.ToType(), func(args jsonArgs, i int) ([]byte, bool, error) {
+		if args.anyNull(i) {
+			return nil, true, nil
+		}
+		doc, ok, err := args.target(1, i)
+		if err != nil || !ok {
+			return nil, !ok, err
+		}
+		keys, ok := doc.Keys()
+		if !ok {
+			return nil, true, nil
+		}
+		return encodeJson(keys)
+	})
+}
+
+// JsonLength returns the length of the document or of the value at the path
+func JsonLength(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonEval(vectors, proc, types.T_int64.ToType(), func(args jsonArgs, i int) (int64, bool, error) {
+		if args.anyNull(i) {
+			return 0, true, nil
+		}
+		doc, ok, err := args.target(1, i)
+		if err != nil || !ok {
+			return 0, !ok, err
+		}
+		return int64(doc.Length()), false, nil
+	})
+}
+
+// JsonType returns the type name of the document
+func JsonType(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonEval(vectors, proc, types.T_varchar.ToType(), func(args jsonArgs, i int) ([]byte, bool, error) {
+		if args.isNull(0, i) {
+			return nil, true, nil
+		}
+		doc, err := args.doc(0, i)
+		if err != nil {
+			return nil, false, err
+		}
+		return []byte(doc.TypeName()), false, nil
+	})
+}
+
+// JsonValid checks whether the argument is a valid JSON document, the values
+// which are neither json nor strings are never valid
+func JsonValid(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonEval(vectors, proc, types.T_int64.ToType(), func(args jsonArgs, i int) (int64, bool, error) {
+		if args.isNull(0, i) {
+			return 0, true, nil
+		}
+		switch {
+		case vectors[0].Typ.Oid == types.T_json:
+			return 1, false, nil
+		case vectors[0].Typ.IsString():
+			return boolToInt64(json.Valid(args.bytes(0, i))), false, nil
+		}
+		return 0, false, nil
+	})
+}
+
+// JsonUnquote removes the quotes of a JSON string
+func JsonUnquote(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonEval(vectors, proc, types.T_varchar.ToType(), func(args jsonArgs, i int) ([]byte, bool, error) {
+		if args.isNull(0, i) {
+			return nil, true, nil
+		}
+		if vectors[0].Typ.Oid == types.T_json {
+			return []byte(types.DecodeJson(args.bytes(0, i)).Unquote()), false, nil
+		}
+		s, err := bytejson.UnquoteString(args.str(0, i))
+		if err != nil {
+			return nil, false, errors.New(errno.InvalidJsonText, fmt.Sprintf("invalid JSON text in argument 1 to function json_unquote: %v", err))
+		}
+		return []byte(s), false, nil
+	})
+}
+
+// jsonArgs reads the row i of the arguments, a constant argument is always
+// read at the row 0 and its path is only parsed once
+type jsonArgs struct {
+	vectors []*vector.Vector
+	parsed  map[int]bytejson.Path
+}
+
+func (a jsonArgs) row(j, i int) int64 {
+	if a.vectors[j].IsScalar() {
+		return 0
+	}
+	return int64(i)
+}
+
+func (a jsonArgs) isNull(j, i int) bool {
+	vec := a.vectors[j]
+	if vec.IsScalar() {
+		return vec.IsScalarNull()
+	}
+	return nulls.Contains(vec.Nsp, uint64(i))
+}
+
+func (a jsonArgs) anyNull(i int) bool {
+	for j := range a.vectors {
+		if a.isNull(j, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a jsonArgs) bytes(j, i int) []byte {
+	return a.vectors[j].GetBytes(a.row(j, i))
+}
+
+func (a jsonArgs) str(j, i int) string {
+	return a.vectors[j].GetString(a.row(j, i))
+}
+
+func (a jsonArgs) doc(j, i int) (bytejson.ByteJson, error) {
+	if a.vectors[j].Typ.Oid == types.T_json {
+		return types.DecodeJson(a.bytes(j, i)), nil
+	}
+	bj, err := types.ParseSliceToByteJson(a.bytes(j, i))
+	if err != nil {
+		return bytejson.ByteJson{}, errors.New(errno.InvalidJsonText, fmt.Sprintf("invalid JSON text in argument %d: %v", j+1, err))
+	}
+	return bj, nil
+}
+
+func (a jsonArgs) value(j, i int) (bytejson.ByteJson, error) {
+	if a.isNull(j, i) {
+		return bytejson.CreateNull(), nil
+	}
+	vec, row := a.vectors[j], a.row(j, i)
+	switch vec.Typ.Oid {
+	case types.T_json:
+		return types.DecodeJson(vec.GetBytes(row)), nil
+	case types.T_bool:
+		return bytejson.CreateBool(vector.MustTCols[bool](vec)[row]), nil
+	case types.T_int64:
+		return bytejson.CreateInt64(vector.MustTCols[int64](vec)[row]), nil
+	case types.T_uint64:
+		return bytejson.CreateUint64(vector.MustTCols[uint64](vec)[row]), nil
+	case types.T_float64:
+		return bytejson.CreateFloat64(vector.MustTCols[float64](vec)[row])
+	case types.T_any:
+		return bytejson.CreateNull(), nil
+	}
+	return bytejson.CreateString(vec.GetString(row)), nil
+}
+
+func (a jsonArgs) path(j, i int) (bytejson.Path, error) {
+	if p, ok := a.parsed[j]; ok {
+		return p, nil
+	}
+	p, err := bytejson.ParseJsonPath(a.str(j, i))
+	if err != nil {
+		return p, err
+	}
+	if a.vectors[j].IsScalar() {
+		a.parsed[j] = p
+	}
+	return p, nil
+}
+
+// paths parses the arguments from the j-th to the last as paths
+func (a jsonArgs) paths(j, i int) ([]bytejson.Path, error) {
+	paths := make([]bytejson.Path, 0, len(a.vectors)-j)
+	for ; j < len(a.vectors); j++ {
+		p, err := a.path(j, i)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+// target returns the document of the first argument, or the value at the
+// path of the j-th argument if it's given, ok is false if the path is missing
+func (a jsonArgs) target(j, i int) (bytejson.ByteJson, bool, error) {
+	doc, err := a.doc(0, i)
+	if err != nil || j >= len(a.vectors) {
+		return doc, err == nil, err
+	}
+	path, err := a.path(j, i)
+	if err != nil {
+		return doc, false, err
+	}
+	switch found := doc.Find(path); len(found) {
+	case 0:
+		return doc, false, nil
+	case 1:
+		return found[0], true, nil
+	default:
+		return bytejson.CreateArray(found), true, nil
+	}
+}
+
+func encodeJson(bj bytejson.ByteJson) ([]byte, bool, error) {
+	buf, err := types.EncodeJson(bj)
+	return buf, false, err
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// jsonEval evaluates the function row by row, fn returns the value of the
+// row and whether it's null. The result is a constant if all the arguments are.
+func jsonEval[T []byte | int64](vectors []*vector.Vector, proc *process.Process, resultType types.Type,
+	fn func(jsonArgs, int) (T, bool, error)) (*vector.Vector, error) {
+	args := jsonArgs{vectors: vectors, parsed: make(map[int]bytejson.Path)}
+	rows, isConst := 1, true
+	for _, vec := range vectors {
+		if !vec.IsScalar() {
+			rows, isConst = vec.Length(), false
+			break
+		}
+	}
+	resultValues := make([]T, rows)
+	resultNsp := nulls.NewWithSize(rows)
+	for i := 0; i < rows; i++ {
+		v, isNull, err := fn(args, i)
+		if err != nil {
+			return nil, err
+		}
+		if isNull {
+			nulls.Add(resultNsp, uint64(i))
+			continue
+		}
+		resultValues[i] = v
+	}
+	if isConst {
+		length := 1
+		if len(vectors) > 0 {
+			length = vectors[0].Length()
+		}
+		if nulls.Contains(resultNsp, 0) {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		switch rs := any(resultValues).(type) {
+		case [][]byte:
+			return vector.NewConstBytes(resultType, length, rs[0]), nil
+		case []int64:
+			return vector.NewConstFixed(resultType, length, rs[0]), nil
+		}
+	}
+	switch rs := any(resultValues).(type) {
+	case [][]byte:
+		return vector.NewWithBytes(resultType, rs, resultNsp, proc.Mp()), nil
+	default:
+		return vector.NewWithFixed(resultType, rs.([]int64), resultNsp, proc.Mp()), nil
+	}
+}
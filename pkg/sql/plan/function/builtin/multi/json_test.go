@@ -0,0 +1,103 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func jsonStrings(vec *vector.Vector) []string {
+	var rs []string
+	for i := 0; i < vec.Length(); i++ {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			rs = append(rs, "NULL")
+			continue
+		}
+		rs = append(rs, types.DecodeJson(vec.GetBytes(int64(i))).String())
+	}
+	return rs
+}
+
+func TestJsonObjectAndArray(t *testing.T) {
+	proc := testutil.NewProc()
+	keys := testutil.MakeScalarVarchar("k", 3)
+	vals := testutil.MakeInt64Vector([]int64{1, 2, 3}, []uint64{2})
+	vec, err := JsonObject([]*vector.Vector{keys, vals}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"k": 1}`, `{"k": 2}`, `{"k": null}`}, jsonStrings(vec))
+
+	strs := testutil.MakeVarcharVector([]string{"a", "b", "c"}, nil)
+	vec, err = JsonArray([]*vector.Vector{strs, vals}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{`["a", 1]`, `["b", 2]`, `["c", null]`}, jsonStrings(vec))
+}
+
+func TestJsonModify(t *testing.T) {
+	proc := testutil.NewProc()
+	docs := testutil.MakeVarcharVector([]string{`{"a": 1}`, `{"b": 2}`}, nil)
+	path := testutil.MakeScalarVarchar("$.a", 2)
+	val := testutil.MakeScalarInt64(5, 2)
+
+	vec, err := JsonSet([]*vector.Vector{docs, path, val}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"a": 5}`, `{"a": 5, "b": 2}`}, jsonStrings(vec))
+	vec, err = JsonInsert([]*vector.Vector{docs, path, val}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"a": 1}`, `{"a": 5, "b": 2}`}, jsonStrings(vec))
+	vec, err = JsonReplace([]*vector.Vector{docs, path, val}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"a": 5}`, `{"b": 2}`}, jsonStrings(vec))
+	vec, err = JsonRemove([]*vector.Vector{docs, path}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{`{}`, `{"b": 2}`}, jsonStrings(vec))
+
+	_, err = JsonSet([]*vector.Vector{testutil.MakeScalarVarchar("{", 1), path, val}, proc)
+	require.Error(t, err)
+}
+
+func TestJsonInspect(t *testing.T) {
+	proc := testutil.NewProc()
+	docs := testutil.MakeVarcharVector([]string{`{"a": [1, 2]}`, `"x"`, ``}, []uint64{2})
+
+	vec, err := JsonLength([]*vector.Vector{docs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 1}, vector.MustTCols[int64](vec)[:2])
+	require.True(t, nulls.Contains(vec.Nsp, 2))
+
+	vec, err = JsonType([]*vector.Vector{docs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"OBJECT", "STRING"}, vector.GetStrVectorValues(vec)[:2])
+
+	vec, err = JsonUnquote([]*vector.Vector{docs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "x", vector.GetStrVectorValues(vec)[1])
+
+	candidate := testutil.MakeScalarVarchar("2", 3)
+	path := testutil.MakeScalarVarchar("$.a", 3)
+	vec, err = JsonContains([]*vector.Vector{docs, candidate, path}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 0}, vector.MustTCols[int64](vec)[:2])
+
+	invalid := testutil.MakeVarcharVector([]string{`{"a": 1}`, `{a}`}, nil)
+	vec, err = JsonValid([]*vector.Vector{invalid}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 0}, vector.MustTCols[int64](vec))
+}
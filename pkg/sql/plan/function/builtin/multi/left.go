@@ -0,0 +1,48 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/left"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Left is LEFT(str, len), the leftmost len characters of str
+func Left(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.T_varchar.ToType()
+	if constNullArg(vectors) {
+		return proc.AllocConstNullVector(resultType, argsLength(vectors)), nil
+	}
+	cs, rows, isConst := constArgs(vectors)
+	resultValues := make([]string, rows)
+	resultNsp := argNulls(vectors, rows)
+	left.Left(vector.MustStrCols(vectors[0]), vector.MustTCols[int64](vectors[1]), cs, resultNsp, resultValues)
+	return stringResult(vectors, resultType, resultValues, resultNsp, isConst, proc), nil
+}
+
+// Right is RIGHT(str, len), the rightmost len characters of str
+func Right(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.T_varchar.ToType()
+	if constNullArg(vectors) {
+		return proc.AllocConstNullVector(resultType, argsLength(vectors)), nil
+	}
+	cs, rows, isConst := constArgs(vectors)
+	resultValues := make([]string, rows)
+	resultNsp := argNulls(vectors, rows)
+	left.Right(vector.MustStrCols(vectors[0]), vector.MustTCols[int64](vectors[1]), cs, resultNsp, resultValues)
+	return stringResult(vectors, resultType, resultValues, resultNsp, isConst, proc), nil
+}
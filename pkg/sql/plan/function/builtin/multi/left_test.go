@@ -0,0 +1,58 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeftRight(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"hello", "你好世界", "ab", "x"}, []uint64{3})
+	lens := testutil.MakeInt64Vector([]int64{2, 3, 5, 1}, nil)
+	vec, err := Left([]*vector.Vector{strs, lens}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"he", "你好世", "ab", "NULL"}, rowStrings(vec))
+
+	vec, err = Right([]*vector.Vector{strs, lens}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"lo", "好世界", "ab", "NULL"}, rowStrings(vec))
+
+	vec, err = Left([]*vector.Vector{strs, testutil.MakeScalarInt64(-1, 4)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"", "", "", "NULL"}, rowStrings(vec))
+}
+
+func TestPosition(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"foobar", "你好bar", "foo", ""}, []uint64{3})
+	vec, err := Position([]*vector.Vector{testutil.MakeScalarVarchar("bar", 4), strs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 3, 0, 0}, vector.MustTCols[int64](vec))
+	require.True(t, vec.Nsp.Contains(3))
+}
+
+func TestSplit(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeScalarVarchar("a,b,c", 5)
+	parts := testutil.MakeInt64Vector([]int64{1, 3, -1, 4, 0}, nil)
+	vec, err := Split([]*vector.Vector{strs, testutil.MakeScalarVarchar(",", 5), parts}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c", "c", "NULL", "NULL"}, rowStrings(vec))
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/like"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// LikeAny is LIKE_ANY(str, pattern...), whether str matches any of the patterns
+func LikeAny(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return likeMulti(vectors, proc, true, false)
+}
+
+// LikeAll is LIKE_ALL(str, pattern...), whether str matches all the patterns
+func LikeAll(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return likeMulti(vectors, proc, false, false)
+}
+
+// Ilike is ILIKE(str, pattern), the case insensitive LIKE
+func Ilike(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return likeMulti(vectors, proc, true, true)
+}
+
+// IlikeAny is ILIKE_ANY(str, pattern...), the case insensitive LIKE_ANY
+func IlikeAny(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return likeMulti(vectors, proc, true, true)
+}
+
+// IlikeAll is ILIKE_ALL(str, pattern...), the case insensitive LIKE_ALL
+func IlikeAll(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return likeMulti(vectors, proc, false, true)
+}
+
+// likeMulti matches str with the patterns one after another, the result is
+// null if any argument is null
+func likeMulti(vectors []*vector.Vector, proc *process.Process, isAny, fold bool) (*vector.Vector, error) {
+	resultType := types.T_bool.ToType()
+	if constNullArg(vectors) {
+		return proc.AllocConstNullVector(resultType, argsLength(vectors)), nil
+	}
+	cs, rows, isConst := constArgs(vectors)
+	resultNsp := argNulls(vectors, rows)
+	xs := vector.MustStrCols(vectors[0])
+	if fold {
+		for i, x := range xs {
+			xs[i] = strings.ToLower(x)
+		}
+	}
+	resultValues := make([]bool, rows)
+	matched := make([]bool, rows)
+	for j := 1; j < len(vectors); j++ {
+		patterns := vector.MustBytesCols(vectors[j])
+		if fold {
+			for i, p := range patterns {
+				patterns[i] = bytes.ToLower(p)
+			}
+		}
+		var err error
+		switch {
+		case cs[0] && cs[j]:
+			matched[0], err = like.BtConstAndConst(xs[0], patterns[0])
+			for i := 1; i < rows; i++ {
+				matched[i] = matched[0]
+			}
+		case cs[0]:
+			_, err = like.BtConstAndSliceNull(xs[0], patterns, resultNsp, matched)
+		case cs[j]:
+			_, err = like.BtSliceNullAndConst(xs, patterns[0], resultNsp, matched)
+		default:
+			_, err = like.BtSliceNullAndSliceNull(xs, patterns, resultNsp, matched)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i, m := range matched {
+			switch {
+			case j == 1:
+				resultValues[i] = m
+			case isAny:
+				resultValues[i] = resultValues[i] || m
+			default:
+				resultValues[i] = resultValues[i] && m
+			}
+		}
+	}
+	return fixedResult(vectors, resultType, resultValues, resultNsp, isConst, proc), nil
+}
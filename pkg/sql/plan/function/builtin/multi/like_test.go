@@ -0,0 +1,58 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLikeAnyAll(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"apple", "banana", "Avocado", ""}, []uint64{3})
+	p1, p2 := testutil.MakeScalarVarchar("a%", 4), testutil.MakeScalarVarchar("%a", 4)
+
+	vec, err := LikeAny([]*vector.Vector{strs, p1, p2}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, true, false}, vector.MustTCols[bool](vec)[:3])
+	require.True(t, vec.Nsp.Contains(3))
+
+	vec, err = LikeAll([]*vector.Vector{strs, p1, testutil.MakeScalarVarchar("%e", 4)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, false}, vector.MustTCols[bool](vec)[:3])
+
+	vec, err = IlikeAny([]*vector.Vector{strs, p1, p2}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, true, true}, vector.MustTCols[bool](vec)[:3])
+
+	vec, err = IlikeAll([]*vector.Vector{strs, p1, testutil.MakeScalarVarchar("%O", 4)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []bool{false, false, true}, vector.MustTCols[bool](vec)[:3])
+
+	// the patterns which are not constants are evaluated row by row
+	patterns := testutil.MakeVarcharVector([]string{"APP%", "b_nana", "x%", "%"}, nil)
+	vec, err = Ilike([]*vector.Vector{strs, patterns}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, true, false}, vector.MustTCols[bool](vec)[:3])
+	require.True(t, vec.Nsp.Contains(3))
+
+	vec, err = LikeAny([]*vector.Vector{strs, p1, testutil.MakeScalarNull(types.T_varchar, 4)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalarNull())
+}
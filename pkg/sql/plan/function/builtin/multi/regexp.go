@@ -0,0 +1,70 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/regexp"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Regexp is REGEXP(str, pattern) and str REGEXP pattern, whether str matches the pattern
+func Regexp(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.T_bool.ToType()
+	if constNullArg(vectors) {
+		return proc.AllocConstNullVector(resultType, argsLength(vectors)), nil
+	}
+	cs, rows, isConst := constArgs(vectors)
+	resultValues := make([]bool, rows)
+	resultNsp := argNulls(vectors, rows)
+	if _, err := regexp.Match(vector.MustStrCols(vectors[0]), vector.MustStrCols(vectors[1]), cs, resultNsp, resultValues); err != nil {
+		return nil, err
+	}
+	return fixedResult(vectors, resultType, resultValues, resultNsp, isConst, proc), nil
+}
+
+// RegexpReplace is REGEXP_REPLACE(str, pattern, replacement), the matches of
+// the pattern are replaced, $n in the replacement is the n-th submatch
+func RegexpReplace(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.T_varchar.ToType()
+	if constNullArg(vectors) {
+		return proc.AllocConstNullVector(resultType, argsLength(vectors)), nil
+	}
+	cs, rows, isConst := constArgs(vectors)
+	resultValues := make([]string, rows)
+	resultNsp := argNulls(vectors, rows)
+	if _, err := regexp.Replace(vector.MustStrCols(vectors[0]), vector.MustStrCols(vectors[1]), vector.MustStrCols(vectors[2]),
+		cs, resultNsp, resultValues); err != nil {
+		return nil, err
+	}
+	return stringResult(vectors, resultType, resultValues, resultNsp, isConst, proc), nil
+}
+
+// RegexpSubstr is REGEXP_SUBSTR(str, pattern), the first match of the pattern
+// in str, or null if it doesn't match
+func RegexpSubstr(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.T_varchar.ToType()
+	if constNullArg(vectors) {
+		return proc.AllocConstNullVector(resultType, argsLength(vectors)), nil
+	}
+	cs, rows, isConst := constArgs(vectors)
+	resultValues := make([]string, rows)
+	resultNsp := argNulls(vectors, rows)
+	if _, err := regexp.Substr(vector.MustStrCols(vectors[0]), vector.MustStrCols(vectors[1]), cs, resultNsp, resultValues); err != nil {
+		return nil, err
+	}
+	return stringResult(vectors, resultType, resultValues, resultNsp, isConst, proc), nil
+}
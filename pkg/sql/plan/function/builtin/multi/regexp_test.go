@@ -0,0 +1,45 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegexp(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"abc123", "xyz", "a1b22", ""}, []uint64{3})
+	pattern := testutil.MakeScalarVarchar("[0-9]+", 4)
+
+	vec, err := Regexp([]*vector.Vector{strs, pattern}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, true, false}, vector.MustTCols[bool](vec))
+	require.True(t, vec.Nsp.Contains(3))
+
+	vec, err = RegexpReplace([]*vector.Vector{strs, pattern, testutil.MakeScalarVarchar("#", 4)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"abc#", "xyz", "a#b#", "NULL"}, rowStrings(vec))
+
+	vec, err = RegexpSubstr([]*vector.Vector{strs, pattern}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"123", "NULL", "1", "NULL"}, rowStrings(vec))
+
+	_, err = Regexp([]*vector.Vector{strs, testutil.MakeScalarVarchar("(", 4)}, proc)
+	require.Error(t, err)
+}
@@ -0,0 +1,57 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplace(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"abcabc", "xyz", "", "a"}, []uint64{3})
+	vec, err := Replace([]*vector.Vector{strs, testutil.MakeScalarVarchar("bc", 4), testutil.MakeScalarVarchar("-", 4)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a-a-", "xyz", "", "NULL"}, rowStrings(vec))
+
+	vec, err = Replace([]*vector.Vector{strs, testutil.MakeScalarVarchar("", 4), testutil.MakeScalarVarchar("-", 4)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"abcabc", "xyz", "", "NULL"}, rowStrings(vec))
+
+	vec, err = Replace([]*vector.Vector{strs, testutil.MakeScalarNull(types.T_varchar, 4), testutil.MakeScalarVarchar("-", 4)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"NULL", "NULL", "NULL", "NULL"}, rowStrings(vec))
+}
+
+func TestTranslate(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"abcba", "你好", "xyz"}, nil)
+	vec, err := Translate([]*vector.Vector{strs, testutil.MakeScalarVarchar("ab你", 3), testutil.MakeScalarVarchar("A我", 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"A我c我A", "好", "xyz"}, rowStrings(vec))
+}
+
+func TestRepeat(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"ab", "ab", "ab", "ab"}, nil)
+	counts := testutil.MakeInt64Vector([]int64{3, 0, -1, types.MaxStringSize}, nil)
+	vec, err := Repeat([]*vector.Vector{strs, counts}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ababab", "", "", "NULL"}, rowStrings(vec))
+}
@@ -15,6 +15,8 @@
 package multi
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -116,6 +118,11 @@ func serialWithSomeCols(vectors []*vector.Vector, proc *process.Process) (*vecto
 			for i, b := range s {
 				ps[i].EncodeTimestamp(b)
 			}
+		case types.T_time:
+			s := vector.MustTCols[types.Time](v)
+			for i, b := range s {
+				ps[i].EncodeTime(b)
+			}
 		case types.T_decimal64:
 			s := vector.MustTCols[types.Decimal64](v)
 			for i, b := range s {
@@ -131,6 +138,8 @@ func serialWithSomeCols(vectors []*vector.Vector, proc *process.Process) (*vecto
 			for i := range vs {
 				ps[i].EncodeStringType([]byte(vs[i]))
 			}
+		default:
+			return nil, errors.New(errno.DataException, fmt.Sprintf("serial function don't support type %s", v.Typ))
 		}
 	}
 
@@ -45,6 +45,15 @@ func TestSerial(t *testing.T) {
 	}
 }
 
+func TestSerialUnsupportedType(t *testing.T) {
+	var proc = testutil.NewProc()
+	var mheap = testutil.NewMheap()
+	v := vector.New(types.Type{Oid: types.T_uuid})
+	vector.AppendFixed[types.Uuid](v, []types.Uuid{{}}, mheap)
+	_, err := Serial([]*vector.Vector{v}, proc)
+	require.Error(t, err)
+}
+
 func MakeVectors(columnSi int, rowCount int, mheap *mheap.Mheap) ([]*vector.Vector, map[int]interface{}) {
 	valueCount := make(map[int]interface{})
 	vs := make([]*vector.Vector, columnSi)
@@ -56,7 +65,7 @@ func MakeVectors(columnSi int, rowCount int, mheap *mheap.Mheap) ([]*vector.Vect
 }
 
 func randType() types.T {
-	t := rand.Intn(17)
+	t := rand.Intn(18)
 	var vt types.T
 	switch t {
 	case 0:
@@ -93,6 +102,8 @@ func randType() types.T {
 		vt = types.T_decimal128
 	case 16:
 		vt = types.T_varchar
+	case 17:
+		vt = types.T_time
 	}
 	return vt
 }
@@ -188,6 +199,13 @@ func randInsertValues(v *vector.Vector, t types.T, rowCount int, valueCount map[
 			valueCount[valueBegin+i] = vs[i]
 		}
 		vector.AppendFixed[types.Timestamp](v, vs, mheap)
+	case types.T_time:
+		vs := make([]types.Time, rowCount)
+		for i := 0; i < rowCount; i++ {
+			vs[i] = randTime()
+			valueCount[valueBegin+i] = vs[i]
+		}
+		vector.AppendFixed[types.Time](v, vs, mheap)
 	case types.T_float32:
 		vs := make([]float32, rowCount)
 		for i := 0; i < rowCount; i++ {
@@ -277,6 +295,15 @@ func randDatetime() types.Datetime {
 	return types.FromClock(int32(year), uint8(month), uint8(day), uint8(hour), uint8(minute), uint8(second), uint32(microSecond))
 }
 
+func randTime() types.Time {
+	isNeg := rand.Intn(2) == 0
+	hour := rand.Intn(int(types.MaxTimeHour))
+	minute := rand.Intn(60)
+	second := rand.Intn(60)
+	microSecond := rand.Intn(1e6)
+	return types.TimeFromClock(isNeg, uint64(hour), uint8(minute), uint8(second), uint32(microSecond))
+}
+
 func randTimestamp() types.Timestamp {
 	year := rand.Intn(types.MaxDatetimeYear) + types.MinDatetimeYear
 	month := rand.Intn(12) + 1
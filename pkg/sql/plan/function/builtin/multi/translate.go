@@ -0,0 +1,38 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/translate"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Translate is TRANSLATE(str, from, to), every character of from in str is
+// replaced by the character of to at the same position, or removed if to
+// is shorter than from
+func Translate(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.T_varchar.ToType()
+	if constNullArg(vectors) {
+		return proc.AllocConstNullVector(resultType, argsLength(vectors)), nil
+	}
+	cs, rows, isConst := constArgs(vectors)
+	resultValues := make([]string, rows)
+	resultNsp := argNulls(vectors, rows)
+	translate.Translate(vector.MustStrCols(vectors[0]), vector.MustStrCols(vectors[1]), vector.MustStrCols(vectors[2]),
+		cs, resultNsp, resultValues)
+	return stringResult(vectors, resultType, resultValues, resultNsp, isConst, proc), nil
+}
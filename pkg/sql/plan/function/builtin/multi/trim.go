@@ -0,0 +1,49 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/trim"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Trim is TRIM([[BOTH | LEADING | TRAILING] [remstr] FROM] str), the parser
+// makes it trim(str), trim(direction, str) or trim(direction, remstr, str).
+// The prefixes or suffixes equal to remstr are removed, remstr is a space by default.
+func Trim(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.T_varchar.ToType()
+	if constNullArg(vectors) {
+		return proc.AllocConstNullVector(resultType, argsLength(vectors)), nil
+	}
+	cs, rows, isConst := constArgs(vectors)
+	resultValues := make([]string, rows)
+	resultNsp := argNulls(vectors, rows)
+
+	last := len(vectors) - 1
+	directions, remstrs := []string{"both"}, []string{" "}
+	flags := []bool{true, true, cs[last]}
+	if last > 0 {
+		directions, flags[0] = vector.MustStrCols(vectors[0]), cs[0]
+	}
+	if last > 1 {
+		remstrs, flags[1] = vector.MustStrCols(vectors[1]), cs[1]
+	}
+	if _, err := trim.Trim(directions, remstrs, vector.MustStrCols(vectors[last]), flags, resultNsp, resultValues); err != nil {
+		return nil, err
+	}
+	return stringResult(vectors, resultType, resultValues, resultNsp, isConst, proc), nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// rowStrings returns the strings of the rows of the vector, NULL for the nulls
+func rowStrings(vec *vector.Vector) []string {
+	rs := make([]string, vec.Length())
+	for i := range rs {
+		switch {
+		case vec.IsScalarNull() || (!vec.IsScalar() && nulls.Contains(vec.Nsp, uint64(i))):
+			rs[i] = "NULL"
+		case vec.IsScalar():
+			rs[i] = vec.GetString(0)
+		default:
+			rs[i] = vec.GetString(int64(i))
+		}
+	}
+	return rs
+}
+
+func TestTrim(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"  a  ", "xxaxx", "", "b"}, []uint64{3})
+
+	vec, err := Trim([]*vector.Vector{strs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "xxaxx", "", "NULL"}, rowStrings(vec))
+
+	directions := testutil.MakeVarcharVector([]string{"leading", "trailing", "both", "both"}, nil)
+	vec, err = Trim([]*vector.Vector{directions, testutil.MakeScalarVarchar("x", 4), strs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"  a  ", "xxa", "", "NULL"}, rowStrings(vec))
+
+	vec, err = Trim([]*vector.Vector{testutil.MakeScalarVarchar("both", 1), testutil.MakeScalarVarchar("ab", 1), testutil.MakeScalarVarchar("ababcab", 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalar())
+	require.Equal(t, []string{"c"}, rowStrings(vec))
+
+	_, err = Trim([]*vector.Vector{testutil.MakeScalarVarchar("middle", 4), strs}, proc)
+	require.Error(t, err)
+}
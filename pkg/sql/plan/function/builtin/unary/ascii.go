@@ -0,0 +1,45 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ascii"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Ascii returns the code of the first byte of the string, 0 for the empty string
+func Ascii(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_uint8.ToType()
+	inputValues := vector.MustStrCols(inputVector)
+	if inputVector.IsScalar() {
+		if inputVector.ConstVectorIsNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		resultVector := vector.NewConst(resultType, inputVector.Length())
+		resultValues := vector.MustTCols[uint8](resultVector)
+		ascii.Ascii(inputValues, resultValues)
+		return resultVector, nil
+	}
+	resultVector, err := proc.AllocVectorOfRows(resultType, int64(len(inputValues)), inputVector.Nsp)
+	if err != nil {
+		return nil, err
+	}
+	resultValues := vector.MustTCols[uint8](resultVector)
+	ascii.Ascii(inputValues, resultValues)
+	return resultVector, nil
+}
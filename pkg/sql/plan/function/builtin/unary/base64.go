@@ -0,0 +1,64 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/base64"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func Base64Encode(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_varchar.ToType()
+	inputValues := vector.MustStrCols(inputVector)
+	if inputVector.IsScalar() {
+		if inputVector.ConstVectorIsNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		resultValues := make([]string, 1)
+		base64.Encode(inputValues, resultValues)
+		return vector.NewConstString(resultType, inputVector.Length(), resultValues[0]), nil
+	}
+	resultValues := make([]string, len(inputValues))
+	base64.Encode(inputValues, resultValues)
+	return vector.NewWithStrings(resultType, resultValues, inputVector.Nsp, proc.Mp()), nil
+}
+
+// Base64Decode returns null for the strings which are not base64
+func Base64Decode(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_varchar.ToType()
+	inputValues := vector.MustStrCols(inputVector)
+	if inputVector.IsScalar() {
+		if inputVector.ConstVectorIsNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		resultValues := make([]string, 1)
+		resultNsp := nulls.NewWithSize(1)
+		base64.Decode(inputValues, resultNsp, resultValues)
+		if nulls.Contains(resultNsp, 0) {
+			return proc.AllocConstNullVector(resultType, inputVector.Length()), nil
+		}
+		return vector.NewConstString(resultType, inputVector.Length(), resultValues[0]), nil
+	}
+	resultValues := make([]string, len(inputValues))
+	resultNsp := nulls.NewWithSize(len(inputValues))
+	nulls.Set(resultNsp, inputVector.Nsp)
+	base64.Decode(inputValues, resultNsp, resultValues)
+	return vector.NewWithStrings(resultType, resultValues, resultNsp, proc.Mp()), nil
+}
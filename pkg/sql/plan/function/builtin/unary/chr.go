@@ -0,0 +1,47 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/chr"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Chr returns the character of the unicode code point, null for an invalid code point
+func Chr(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_varchar.ToType()
+	inputValues := vector.MustTCols[int64](inputVector)
+	if inputVector.IsScalar() {
+		if inputVector.ConstVectorIsNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		resultValues := make([]string, 1)
+		resultNsp := nulls.NewWithSize(1)
+		chr.Chr(inputValues, resultNsp, resultValues)
+		if nulls.Contains(resultNsp, 0) {
+			return proc.AllocConstNullVector(resultType, inputVector.Length()), nil
+		}
+		return vector.NewConstString(resultType, inputVector.Length(), resultValues[0]), nil
+	}
+	resultValues := make([]string, len(inputValues))
+	resultNsp := nulls.NewWithSize(len(inputValues))
+	nulls.Set(resultNsp, inputVector.Nsp)
+	chr.Chr(inputValues, resultNsp, resultValues)
+	return vector.NewWithStrings(resultType, resultValues, resultNsp, proc.Mp()), nil
+}
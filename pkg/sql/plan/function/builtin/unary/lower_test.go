@@ -0,0 +1,90 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLowerUpper(t *testing.T) {
+	proc := testutil.NewProc()
+	ivec := testutil.MakeVarcharVector([]string{"Hello", "ÀÉÎ", "", "x"}, []uint64{3})
+	ovec, err := Lower([]*vector.Vector{ivec}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"hello", "àéî", ""}, vector.MustStrCols(ovec)[:3])
+	require.True(t, ovec.Nsp.Contains(3))
+
+	ovec, err = Upper([]*vector.Vector{testutil.MakeScalarVarchar("abc", 5)}, proc)
+	require.NoError(t, err)
+	require.True(t, ovec.IsScalar())
+	require.Equal(t, "ABC", ovec.GetString(0))
+
+	ovec, err = Upper([]*vector.Vector{testutil.MakeScalarNull(types.T_varchar, 5)}, proc)
+	require.NoError(t, err)
+	require.True(t, ovec.IsScalarNull())
+}
+
+func TestAsciiChr(t *testing.T) {
+	proc := testutil.NewProc()
+	ovec, err := Ascii([]*vector.Vector{testutil.MakeVarcharVector([]string{"abc", "", "A"}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint8{97, 0, 65}, vector.MustTCols[uint8](ovec))
+
+	ovec, err = Chr([]*vector.Vector{testutil.MakeInt64Vector([]int64{65, 20320, -1, 0xD800}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"A", "你"}, vector.MustStrCols(ovec)[:2])
+	require.True(t, ovec.Nsp.Contains(2))
+	require.True(t, ovec.Nsp.Contains(3))
+}
+
+func TestBase64AndHexDecode(t *testing.T) {
+	proc := testutil.NewProc()
+	ovec, err := Base64Encode([]*vector.Vector{testutil.MakeVarcharVector([]string{"hello", ""}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"aGVsbG8=", ""}, vector.MustStrCols(ovec))
+
+	ovec, err = Base64Decode([]*vector.Vector{testutil.MakeVarcharVector([]string{"aGVsbG8=", "!!"}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "hello", ovec.GetString(0))
+	require.True(t, ovec.Nsp.Contains(1))
+
+	ovec, err = HexDecode([]*vector.Vector{testutil.MakeVarcharVector([]string{"616263", "zz", "6"}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "abc", ovec.GetString(0))
+	require.True(t, ovec.Nsp.Contains(1))
+	require.True(t, ovec.Nsp.Contains(2))
+
+	ovec, err = HexDecode([]*vector.Vector{testutil.MakeScalarVarchar("zz", 2)}, proc)
+	require.NoError(t, err)
+	require.True(t, ovec.IsScalarNull())
+}
+
+func TestSign(t *testing.T) {
+	proc := testutil.NewProc()
+	ovec, err := Sign[int64]([]*vector.Vector{testutil.MakeInt64Vector([]int64{-5, 0, 7, 1}, []uint64{3})}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{-1, 0, 1}, vector.MustTCols[int64](ovec)[:3])
+	require.True(t, ovec.Nsp.Contains(3))
+
+	ovec, err = Sign[float64]([]*vector.Vector{testutil.MakeScalarFloat64(-0.5, 3)}, proc)
+	require.NoError(t, err)
+	require.True(t, ovec.IsScalar())
+	require.Equal(t, int64(-1), vector.MustTCols[int64](ovec)[0])
+}
@@ -0,0 +1,123 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/clock"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func TimeToHour(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return clockUnary(vectors[0], types.T_int64.ToType(), proc, clock.TimeToHour)
+}
+
+func DatetimeToHour(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return clockUnary(vectors[0], types.T_int64.ToType(), proc, clock.DatetimeToHour)
+}
+
+func TimeToMinute(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return clockUnary(vectors[0], types.T_uint8.ToType(), proc, clock.TimeToMinute)
+}
+
+func DatetimeToMinute(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return clockUnary(vectors[0], types.T_uint8.ToType(), proc, clock.DatetimeToMinute)
+}
+
+func TimeToSecond(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return clockUnary(vectors[0], types.T_uint8.ToType(), proc, clock.TimeToSecond)
+}
+
+func DatetimeToSecond(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return clockUnary(vectors[0], types.T_uint8.ToType(), proc, clock.DatetimeToSecond)
+}
+
+func TimeToSec(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return clockUnary(vectors[0], types.T_int64.ToType(), proc, clock.TimeToSec)
+}
+
+func Int64SecToTime(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_time, Size: 8}
+	return clockUnary(vectors[0], resultType, proc, clock.Int64ToTime)
+}
+
+func Float64SecToTime(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_time, Size: 8, Precision: 6}
+	return clockUnary(vectors[0], resultType, proc, clock.Float64ToTime)
+}
+
+func DatetimeToTime(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.Type{Oid: types.T_time, Size: 8, Precision: inputVector.Typ.Precision}
+	return clockUnary(inputVector, resultType, proc, func(xs []types.Datetime, rs []types.Time) []types.Time {
+		return clock.DatetimeToTime(xs, rs, resultType.Precision)
+	})
+}
+
+func TimeToTime(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.Type{Oid: types.T_time, Size: 8, Precision: inputVector.Typ.Precision}
+	return clockUnary(inputVector, resultType, proc, func(xs []types.Time, rs []types.Time) []types.Time {
+		copy(rs, xs)
+		return rs
+	})
+}
+
+func StringToTime(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.Type{Oid: types.T_time, Size: 8, Precision: 6}
+	inputValues := vector.MustStrCols(inputVector)
+	if inputVector.IsScalar() {
+		if inputVector.IsScalarNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		resultValues, err := clock.StringToTime(inputValues, make([]types.Time, 1), resultType.Precision, nil)
+		if err != nil {
+			return nil, err
+		}
+		return vector.NewConstFixed(resultType, 1, resultValues[0]), nil
+	}
+	resultVector, err := proc.AllocVectorOfRows(resultType, int64(len(inputValues)), inputVector.Nsp)
+	if err != nil {
+		return nil, err
+	}
+	resultValues := vector.MustTCols[types.Time](resultVector)
+	if _, err = clock.StringToTime(inputValues, resultValues, resultType.Precision, inputVector.Nsp); err != nil {
+		resultVector.Free(proc.Mp())
+		return nil, err
+	}
+	return resultVector, nil
+}
+
+// clockUnary applies fn to the fixed size values of the input vector, the nulls
+// of the input are kept in the result
+func clockUnary[T, R types.FixedSizeT](inputVector *vector.Vector, resultType types.Type, proc *process.Process,
+	fn func([]T, []R) []R) (*vector.Vector, error) {
+	inputValues := vector.MustTCols[T](inputVector)
+	if inputVector.IsScalar() {
+		if inputVector.IsScalarNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		resultValues := fn(inputValues, make([]R, 1))
+		return vector.NewConstFixed(resultType, 1, resultValues[0]), nil
+	}
+	resultVector, err := proc.AllocVectorOfRows(resultType, int64(len(inputValues)), inputVector.Nsp)
+	if err != nil {
+		return nil, err
+	}
+	fn(inputValues, vector.MustTCols[R](resultVector))
+	return resultVector, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeClockFunc(t *testing.T) {
+	proc := testutil.NewProc()
+	vecs := []*vector.Vector{testutil.MakeTimeVector([]string{"-838:59:58", "12:34:56", "00:00:00"}, []uint64{2})}
+
+	result, err := TimeToHour(vecs, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{838, 12, 0}, vector.MustTCols[int64](result))
+	require.True(t, result.Nsp.Contains(2))
+
+	result, err = TimeToMinute(vecs, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint8{59, 34, 0}, vector.MustTCols[uint8](result))
+
+	result, err = TimeToSecond(vecs, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint8{58, 56, 0}, vector.MustTCols[uint8](result))
+
+	result, err = TimeToSec(vecs, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{-3020398, 45296, 0}, vector.MustTCols[int64](result))
+
+	vecs = []*vector.Vector{testutil.MakeScalarDateTime("2022-10-01 11:22:33", 1)}
+	result, err = DatetimeToHour(vecs, proc)
+	require.NoError(t, err)
+	require.True(t, result.IsScalar())
+	require.Equal(t, []int64{11}, vector.MustTCols[int64](result))
+
+	vecs = []*vector.Vector{testutil.MakeScalarNull(types.T_time, 1)}
+	result, err = TimeToHour(vecs, proc)
+	require.NoError(t, err)
+	require.True(t, result.IsScalarNull())
+}
+
+func TestToTimeFunc(t *testing.T) {
+	proc := testutil.NewProc()
+
+	result, err := Int64SecToTime([]*vector.Vector{testutil.MakeInt64Vector([]int64{3661, -59, 1e10}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"01:01:01", "-00:00:59", "838:59:59"}, timeStrings(result))
+
+	result, err = Float64SecToTime([]*vector.Vector{testutil.MakeScalarFloat64(1.5, 1)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, types.SecondsToTime(1.5), vector.MustTCols[types.Time](result)[0])
+
+	result, err = DatetimeToTime([]*vector.Vector{testutil.MakeDateTimeVector([]string{"2022-10-01 11:22:33"}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"11:22:33"}, timeStrings(result))
+
+	result, err = StringToTime([]*vector.Vector{testutil.MakeVarcharVector([]string{"1 01:00:00", ""}, []uint64{1})}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "25:00:00", timeStrings(result)[0])
+	require.True(t, result.Nsp.Contains(1))
+
+	_, err = StringToTime([]*vector.Vector{testutil.MakeVarcharVector([]string{"abc"}, nil)}, proc)
+	require.Error(t, err)
+}
+
+func timeStrings(vec *vector.Vector) []string {
+	values := vector.MustTCols[types.Time](vec)
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = v.String()
+	}
+	return result
+}
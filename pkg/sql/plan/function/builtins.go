@@ -2005,6 +2005,208 @@ var builtins = map[int]Functions{
 		},
 	},
 
+	JSON_OBJECT: {
+		Id:          JSON_OBJECT,
+		TypeCheckFn: jsonTypeCheckFn(func(n int) bool { return n%2 == 0 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Volatile:  true,
+				Flag:      plan.Function_NONE,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_json,
+				Fn:        multi.JsonObject,
+			},
+		},
+	},
+
+	JSON_ARRAY: {
+		Id:          JSON_ARRAY,
+		TypeCheckFn: jsonTypeCheckFn(func(int) bool { return true }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Volatile:  true,
+				Flag:      plan.Function_NONE,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_json,
+				Fn:        multi.JsonArray,
+			},
+		},
+	},
+
+	JSON_SET: {
+		Id:          JSON_SET,
+		TypeCheckFn: jsonTypeCheckFn(func(n int) bool { return n >= 3 && n%2 == 1 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Volatile:  true,
+				Flag:      plan.Function_NONE,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_json,
+				Fn:        multi.JsonSet,
+			},
+		},
+	},
+
+	JSON_INSERT: {
+		Id:          JSON_INSERT,
+		TypeCheckFn: jsonTypeCheckFn(func(n int) bool { return n >= 3 && n%2 == 1 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Volatile:  true,
+				Flag:      plan.Function_NONE,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_json,
+				Fn:        multi.JsonInsert,
+			},
+		},
+	},
+
+	JSON_REPLACE: {
+		Id:          JSON_REPLACE,
+		TypeCheckFn: jsonTypeCheckFn(func(n int) bool { return n >= 3 && n%2 == 1 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Volatile:  true,
+				Flag:      plan.Function_NONE,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_json,
+				Fn:        multi.JsonReplace,
+			},
+		},
+	},
+
+	JSON_REMOVE: {
+		Id:          JSON_REMOVE,
+		TypeCheckFn: jsonTypeCheckFn(func(n int) bool { return n >= 2 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Volatile:  true,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_json,
+				Fn:        multi.JsonRemove,
+			},
+		},
+	},
+
+	JSON_CONTAINS: {
+		Id:          JSON_CONTAINS,
+		TypeCheckFn: jsonTypeCheckFn(func(n int) bool { return n == 2 || n == 3 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.JsonContains,
+			},
+		},
+	},
+
+	JSON_CONTAINS_PATH: {
+		Id:          JSON_CONTAINS_PATH,
+		TypeCheckFn: jsonTypeCheckFn(func(n int) bool { return n >= 3 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.JsonContainsPath,
+			},
+		},
+	},
+
+	JSON_KEYS: {
+		Id:          JSON_KEYS,
+		TypeCheckFn: jsonTypeCheckFn(func(n int) bool { return n == 1 || n == 2 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Volatile:  true,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_json,
+				Fn:        multi.JsonKeys,
+			},
+		},
+	},
+
+	JSON_LENGTH: {
+		Id:          JSON_LENGTH,
+		TypeCheckFn: jsonTypeCheckFn(func(n int) bool { return n == 1 || n == 2 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.JsonLength,
+			},
+		},
+	},
+
+	JSON_TYPE: {
+		Id:          JSON_TYPE,
+		TypeCheckFn: jsonTypeCheckFn(func(n int) bool { return n == 1 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.JsonType,
+			},
+		},
+	},
+
+	JSON_VALID: {
+		Id:          JSON_VALID,
+		TypeCheckFn: jsonTypeCheckFn(func(n int) bool { return n == 1 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.JsonValid,
+			},
+		},
+	},
+
+	JSON_UNQUOTE: {
+		Id:          JSON_UNQUOTE,
+		TypeCheckFn: jsonTypeCheckFn(func(n int) bool { return n == 1 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.JsonUnquote,
+			},
+		},
+	},
+
 	ENABLE_FAULT_INJECTION: {
 		Id: ENABLE_FAULT_INJECTION,
 		Overloads: []Function{
@@ -2151,4 +2353,926 @@ var builtins = map[int]Functions{
 			},
 		},
 	},
+	TIME: {
+		Id: TIME,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime},
+				ReturnTyp: types.T_time,
+				Fn:        unary.DatetimeToTime,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        unary.TimeToTime,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_time,
+				Fn:        unary.StringToTime,
+			},
+			{
+				Index:     3,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_char},
+				ReturnTyp: types.T_time,
+				Fn:        unary.StringToTime,
+			},
+		},
+	},
+	HOUR: {
+		Id: HOUR,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time},
+				ReturnTyp: types.T_int64,
+				Fn:        unary.TimeToHour,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime},
+				ReturnTyp: types.T_int64,
+				Fn:        unary.DatetimeToHour,
+			},
+		},
+	},
+	MINUTE: {
+		Id: MINUTE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time},
+				ReturnTyp: types.T_uint8,
+				Fn:        unary.TimeToMinute,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime},
+				ReturnTyp: types.T_uint8,
+				Fn:        unary.DatetimeToMinute,
+			},
+		},
+	},
+	SECOND: {
+		Id: SECOND,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time},
+				ReturnTyp: types.T_uint8,
+				Fn:        unary.TimeToSecond,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime},
+				ReturnTyp: types.T_uint8,
+				Fn:        unary.DatetimeToSecond,
+			},
+		},
+	},
+	TIMEDIFF: {
+		Id: TIMEDIFF,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        binary.TimeDiff,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime, types.T_datetime},
+				ReturnTyp: types.T_time,
+				Fn:        binary.DatetimeDiff,
+			},
+		},
+	},
+	ADDTIME: {
+		Id: ADDTIME,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        binary.AddTime,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime, types.T_time},
+				ReturnTyp: types.T_datetime,
+				Fn:        binary.DatetimeAddTime,
+			},
+		},
+	},
+	TIME_TO_SEC: {
+		Id: TIME_TO_SEC,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time},
+				ReturnTyp: types.T_int64,
+				Fn:        unary.TimeToSec,
+			},
+		},
+	},
+	SEC_TO_TIME: {
+		Id: SEC_TO_TIME,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64},
+				ReturnTyp: types.T_time,
+				Fn:        unary.Int64SecToTime,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_float64},
+				ReturnTyp: types.T_time,
+				Fn:        unary.Float64SecToTime,
+			},
+		},
+	},
+	CAST_VALUE_TO_ENUM: {
+		Id: CAST_VALUE_TO_ENUM,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_enum,
+				Fn:        binary.CastValueToEnum,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_char},
+				ReturnTyp: types.T_enum,
+				Fn:        binary.CastValueToEnum,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_blob},
+				ReturnTyp: types.T_enum,
+				Fn:        binary.CastValueToEnum,
+			},
+			{
+				Index:     3,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_enum,
+				Fn:        binary.CastValueToEnum,
+			},
+			{
+				Index:     4,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_uint64},
+				ReturnTyp: types.T_enum,
+				Fn:        binary.CastValueToEnum,
+			},
+		},
+	},
+	CAST_ENUM_TO_VALUE: {
+		Id: CAST_ENUM_TO_VALUE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_enum},
+				ReturnTyp: types.T_varchar,
+				Fn:        binary.CastEnumToValue,
+			},
+		},
+	},
+	CAST_VALUE_TO_SET: {
+		Id: CAST_VALUE_TO_SET,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_set,
+				Fn:        binary.CastValueToSet,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_char},
+				ReturnTyp: types.T_set,
+				Fn:        binary.CastValueToSet,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_blob},
+				ReturnTyp: types.T_set,
+				Fn:        binary.CastValueToSet,
+			},
+			{
+				Index:     3,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_set,
+				Fn:        binary.CastValueToSet,
+			},
+			{
+				Index:     4,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_uint64},
+				ReturnTyp: types.T_set,
+				Fn:        binary.CastValueToSet,
+			},
+		},
+	},
+	CAST_SET_TO_VALUE: {
+		Id: CAST_SET_TO_VALUE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_set},
+				ReturnTyp: types.T_varchar,
+				Fn:        binary.CastSetToValue,
+			},
+		},
+	},
+	LOWER: {
+		Id: LOWER,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Lower,
+			},
+		},
+	},
+	UPPER: {
+		Id: UPPER,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Upper,
+			},
+		},
+	},
+	TRIM: {
+		Id: TRIM,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Trim,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Trim,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Trim,
+			},
+		},
+	},
+	REPLACE: {
+		Id: REPLACE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Replace,
+			},
+		},
+	},
+	TRANSLATE: {
+		Id: TRANSLATE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Translate,
+			},
+		},
+	},
+	REPEAT: {
+		Id: REPEAT,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Repeat,
+			},
+		},
+	},
+	LEFT: {
+		Id: LEFT,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Left,
+			},
+		},
+	},
+	RIGHT: {
+		Id: RIGHT,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Right,
+			},
+		},
+	},
+	POSITION: {
+		Id: POSITION,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.Position,
+			},
+		},
+	},
+	SPLIT: {
+		Id: SPLIT,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Split,
+			},
+		},
+	},
+	ASCII: {
+		Id: ASCII,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_uint8,
+				Fn:        unary.Ascii,
+			},
+		},
+	},
+	CHR: {
+		Id: CHR,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Chr,
+			},
+		},
+	},
+	REGEXP: {
+		Id: REGEXP,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_bool,
+				Fn:        multi.Regexp,
+			},
+		},
+	},
+	REGEXP_REPLACE: {
+		Id: REGEXP_REPLACE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.RegexpReplace,
+			},
+		},
+	},
+	REGEXP_SUBSTR: {
+		Id: REGEXP_SUBSTR,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.RegexpSubstr,
+			},
+		},
+	},
+	ILIKE: {
+		Id: ILIKE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_bool,
+				Fn:        multi.Ilike,
+			},
+		},
+	},
+	LIKE_ANY: {
+		Id:          LIKE_ANY,
+		TypeCheckFn: stringTypeCheckFn(func(n int) bool { return n > 1 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_bool,
+				Fn:        multi.LikeAny,
+			},
+		},
+	},
+	LIKE_ALL: {
+		Id:          LIKE_ALL,
+		TypeCheckFn: stringTypeCheckFn(func(n int) bool { return n > 1 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_bool,
+				Fn:        multi.LikeAll,
+			},
+		},
+	},
+	ILIKE_ANY: {
+		Id:          ILIKE_ANY,
+		TypeCheckFn: stringTypeCheckFn(func(n int) bool { return n > 1 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_bool,
+				Fn:        multi.IlikeAny,
+			},
+		},
+	},
+	ILIKE_ALL: {
+		Id:          ILIKE_ALL,
+		TypeCheckFn: stringTypeCheckFn(func(n int) bool { return n > 1 }),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_bool,
+				Fn:        multi.IlikeAll,
+			},
+		},
+	},
+	BASE64_ENCODE: {
+		Id: BASE64_ENCODE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Base64Encode,
+			},
+		},
+	},
+	BASE64_DECODE: {
+		Id: BASE64_DECODE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Base64Decode,
+			},
+		},
+	},
+	HEX_DECODE: {
+		Id: HEX_DECODE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexDecode,
+			},
+		},
+	},
+	HASH: {
+		Id:          HASH,
+		TypeCheckFn: hashTypeCheckFn,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_NONE,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.Hash,
+			},
+		},
+	},
+	SIGN: {
+		Id: SIGN,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64},
+				ReturnTyp: types.T_int64,
+				Fn:        unary.Sign[int64],
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_uint64},
+				ReturnTyp: types.T_int64,
+				Fn:        unary.Sign[uint64],
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_float64},
+				ReturnTyp: types.T_int64,
+				Fn:        unary.Sign[float64],
+			},
+		},
+	},
+	GREATEST: {
+		Id:          GREATEST,
+		TypeCheckFn: sameTypeCheckFn(2),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.GreatestString,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_char,
+				Fn:        multi.GreatestString,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int8,
+				Fn:        multi.GreatestOrdered[int8],
+			},
+			{
+				Index:     3,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int16,
+				Fn:        multi.GreatestOrdered[int16],
+			},
+			{
+				Index:     4,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int32,
+				Fn:        multi.GreatestOrdered[int32],
+			},
+			{
+				Index:     5,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.GreatestOrdered[int64],
+			},
+			{
+				Index:     6,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_uint8,
+				Fn:        multi.GreatestOrdered[uint8],
+			},
+			{
+				Index:     7,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_uint16,
+				Fn:        multi.GreatestOrdered[uint16],
+			},
+			{
+				Index:     8,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_uint32,
+				Fn:        multi.GreatestOrdered[uint32],
+			},
+			{
+				Index:     9,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_uint64,
+				Fn:        multi.GreatestOrdered[uint64],
+			},
+			{
+				Index:     10,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_float32,
+				Fn:        multi.GreatestOrdered[float32],
+			},
+			{
+				Index:     11,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_float64,
+				Fn:        multi.GreatestOrdered[float64],
+			},
+			{
+				Index:     12,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_date,
+				Fn:        multi.GreatestOrdered[types.Date],
+			},
+			{
+				Index:     13,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_time,
+				Fn:        multi.GreatestOrdered[types.Time],
+			},
+			{
+				Index:     14,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_datetime,
+				Fn:        multi.GreatestOrdered[types.Datetime],
+			},
+			{
+				Index:     15,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_timestamp,
+				Fn:        multi.GreatestOrdered[types.Timestamp],
+			},
+		},
+	},
+	LEAST: {
+		Id:          LEAST,
+		TypeCheckFn: sameTypeCheckFn(2),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.LeastString,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_char,
+				Fn:        multi.LeastString,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int8,
+				Fn:        multi.LeastOrdered[int8],
+			},
+			{
+				Index:     3,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int16,
+				Fn:        multi.LeastOrdered[int16],
+			},
+			{
+				Index:     4,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int32,
+				Fn:        multi.LeastOrdered[int32],
+			},
+			{
+				Index:     5,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.LeastOrdered[int64],
+			},
+			{
+				Index:     6,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_uint8,
+				Fn:        multi.LeastOrdered[uint8],
+			},
+			{
+				Index:     7,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_uint16,
+				Fn:        multi.LeastOrdered[uint16],
+			},
+			{
+				Index:     8,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_uint32,
+				Fn:        multi.LeastOrdered[uint32],
+			},
+			{
+				Index:     9,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_uint64,
+				Fn:        multi.LeastOrdered[uint64],
+			},
+			{
+				Index:     10,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_float32,
+				Fn:        multi.LeastOrdered[float32],
+			},
+			{
+				Index:     11,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_float64,
+				Fn:        multi.LeastOrdered[float64],
+			},
+			{
+				Index:     12,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_date,
+				Fn:        multi.LeastOrdered[types.Date],
+			},
+			{
+				Index:     13,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_time,
+				Fn:        multi.LeastOrdered[types.Time],
+			},
+			{
+				Index:     14,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_datetime,
+				Fn:        multi.LeastOrdered[types.Datetime],
+			},
+			{
+				Index:     15,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_timestamp,
+				Fn:        multi.LeastOrdered[types.Timestamp],
+			},
+		},
+	},
 }
@@ -155,6 +155,10 @@ func (f Function) IsAggregate() bool {
 	return f.Flag == plan.Function_AGG
 }
 
+func (f Function) IsWindow() bool {
+	return f.Flag == plan.Function_WIN
+}
+
 func (f Function) isFunction() bool {
 	return f.Layout == STANDARD_FUNCTION || f.Layout >= NOPARAMETER_FUNCTION
 }
@@ -236,6 +240,12 @@ func GetFunctionByName(name string, args []types.Type) (int64, types.Type, []typ
 	finalTypes := make([]types.Type, len(args))
 	if targetTs != nil {
 		copy(finalTypes, targetTypes)
+		// a target of T_any means the argument keeps its own type.
+		for i := range finalTypes {
+			if finalTypes[i].Oid == types.T_any {
+				finalTypes[i] = args[i]
+			}
+		}
 	} else {
 		copy(finalTypes, args)
 	}
@@ -307,6 +317,8 @@ func setDefaultPrecision(typ *types.Type) {
 		typ.Precision = 6
 	} else if typ.Oid == types.T_datetime {
 		typ.Precision = 6
+	} else if typ.Oid == types.T_time {
+		typ.Precision = 6
 	}
 	typ.Size = int32(typ.Oid.TypeLen())
 }
@@ -320,6 +332,9 @@ func getRealReturnType(fid int32, f Function, realArgs []types.Type) types.Type
 			}
 		}
 	}
+	if f.IsWindow() && f.ReturnTyp == types.T_any {
+		return realArgs[0]
+	}
 	rt := f.ReturnTyp.ToType()
 	for i := range realArgs {
 		if realArgs[i].Oid == rt.Oid {
@@ -239,6 +239,35 @@ const (
 
 	SERIAL
 
+	TIME        // TIME
+	HOUR        // HOUR
+	MINUTE      // MINUTE
+	SECOND      // SECOND
+	TIMEDIFF    // TIMEDIFF
+	TIME_TO_SEC // TIME_TO_SEC
+	SEC_TO_TIME // SEC_TO_TIME
+
+	CAST_VALUE_TO_ENUM // CAST_VALUE_TO_ENUM
+	CAST_ENUM_TO_VALUE // CAST_ENUM_TO_VALUE
+	CAST_VALUE_TO_SET  // CAST_VALUE_TO_SET
+	CAST_SET_TO_VALUE  // CAST_SET_TO_VALUE
+
+	JSON_OBJECT        // JSON_OBJECT
+	JSON_ARRAY         // JSON_ARRAY
+	JSON_SET           // JSON_SET
+	JSON_INSERT        // JSON_INSERT
+	JSON_REPLACE       // JSON_REPLACE
+	JSON_REMOVE        // JSON_REMOVE
+	JSON_CONTAINS      // JSON_CONTAINS
+	JSON_CONTAINS_PATH // JSON_CONTAINS_PATH
+	JSON_KEYS          // JSON_KEYS
+	JSON_LENGTH        // JSON_LENGTH
+	JSON_TYPE          // JSON_TYPE
+	JSON_VALID         // JSON_VALID
+	JSON_UNQUOTE       // JSON_UNQUOTE
+	JSON_ARRAYAGG      // JSON_ARRAYAGG
+	JSON_OBJECTAGG     // JSON_OBJECTAGG
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -303,6 +332,18 @@ var functionIdRegister = map[string]int32{
 	"variance":              VAR_POP,
 	"approx_count_distinct": APPROX_COUNT_DISTINCT,
 	"any_value":             ANY_VALUE,
+	// window
+	"row_number":   ROW_NUMBER,
+	"rank":         RANK,
+	"dense_rank":   DENSE_RANK,
+	"percent_rank": PERCENT_RANK,
+	"cume_dist":    CUME_DIST,
+	"ntile":        NTILE,
+	"lag":          LAG,
+	"lead":         LEAD,
+	"first_value":  FIRST_VALUE,
+	"last_value":   LAST_VALUE,
+	"nth_value":    NTH_VALUE,
 	// builtin
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// binary functions
@@ -393,6 +434,60 @@ var functionIdRegister = map[string]int32{
 	"load_file":               LOAD_FILE,
 	"hex":                     HEX,
 	"serial":                  SERIAL,
+	"time":                    TIME,
+	"hour":                    HOUR,
+	"minute":                  MINUTE,
+	"second":                  SECOND,
+	"timediff":                TIMEDIFF,
+	"addtime":                 ADDTIME,
+	"time_to_sec":             TIME_TO_SEC,
+	"sec_to_time":             SEC_TO_TIME,
+	"cast_value_to_enum":      CAST_VALUE_TO_ENUM,
+	"cast_enum_to_value":      CAST_ENUM_TO_VALUE,
+	"cast_value_to_set":       CAST_VALUE_TO_SET,
+	"cast_set_to_value":       CAST_SET_TO_VALUE,
+	"json_object":             JSON_OBJECT,
+	"json_array":              JSON_ARRAY,
+	"json_set":                JSON_SET,
+	"json_insert":             JSON_INSERT,
+	"json_replace":            JSON_REPLACE,
+	"json_remove":             JSON_REMOVE,
+	"json_contains":           JSON_CONTAINS,
+	"json_contains_path":      JSON_CONTAINS_PATH,
+	"json_keys":               JSON_KEYS,
+	"json_length":             JSON_LENGTH,
+	"json_type":               JSON_TYPE,
+	"json_valid":              JSON_VALID,
+	"json_unquote":            JSON_UNQUOTE,
+	"json_arrayagg":           JSON_ARRAYAGG,
+	"json_objectagg":          JSON_OBJECTAGG,
+	"lower":                   LOWER,
+	"upper":                   UPPER,
+	"trim":                    TRIM,
+	"replace":                 REPLACE,
+	"repeat":                  REPEAT,
+	"left":                    LEFT,
+	"right":                   RIGHT,
+	"position":                POSITION,
+	"ascii":                   ASCII,
+	"chr":                     CHR,
+	"translate":               TRANSLATE,
+	"split":                   SPLIT,
+	"regexp":                  REGEXP,
+	"regexp_replace":          REGEXP_REPLACE,
+	"regexp_substr":           REGEXP_SUBSTR,
+	"like_any":                LIKE_ANY,
+	"like_all":                LIKE_ALL,
+	"ilike":                   ILIKE,
+	"ilike_any":               ILIKE_ANY,
+	"ilike_all":               ILIKE_ALL,
+	"base64_encode":           BASE64_ENCODE,
+	"base64_decode":           BASE64_DECODE,
+	"hex_decode":              HEX_DECODE,
+	"hash":                    HASH,
+	"sign":                    SIGN,
+	"greatest":                GREATEST,
+	"least":                   LEAST,
 }
 
 func GetFunctionIsWinfunByName(name string) bool {
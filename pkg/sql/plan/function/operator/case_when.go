@@ -82,6 +82,10 @@ var (
 		return cwGeneral[types.Date](vs, proc, types.Type{Oid: types.T_date})
 	}
 
+	CaseWhenTime = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return cwGeneral[types.Time](vs, proc, types.Type{Oid: types.T_time})
+	}
+
 	CaseWhenDateTime = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 		return cwGeneral[types.Datetime](vs, proc, types.Type{Oid: types.T_datetime})
 	}
@@ -138,11 +142,11 @@ func CwTypeCheckFn(inputTypes []types.T, _ []types.T, ret types.T) bool {
 }
 
 type OrderedValue interface {
-	constraints.Integer | constraints.Float | types.Date | types.Datetime | types.Decimal64 | types.Timestamp
+	constraints.Integer | constraints.Float | types.Date | types.Time | types.Datetime | types.Decimal64 | types.Timestamp
 }
 
 type NormalType interface {
-	constraints.Integer | constraints.Float | bool | types.Date | types.Datetime |
+	constraints.Integer | constraints.Float | bool | types.Date | types.Time | types.Datetime |
 		types.Decimal64 | types.Decimal128 | types.Timestamp
 }
 
@@ -113,6 +113,8 @@ func doCast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
 			return CastSameType[float64](lv, rv, proc)
 		case types.T_date:
 			return CastSameType[types.Date](lv, rv, proc)
+		case types.T_time:
+			return CastSameType[types.Time](lv, rv, proc)
 		case types.T_datetime:
 			return CastSameType[types.Datetime](lv, rv, proc)
 		case types.T_timestamp:
@@ -495,6 +497,81 @@ func doCast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
 	if isString(lv.Typ.Oid) && rv.Typ.Oid == types.T_timestamp {
 		return CastVarcharAsTimestamp(lv, rv, proc)
 	}
+
+	if isString(lv.Typ.Oid) && rv.Typ.Oid == types.T_time {
+		return CastVarcharAsTime(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_time && isString(rv.Typ.Oid) {
+		return CastTimeAsString(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_datetime && rv.Typ.Oid == types.T_time {
+		return CastDatetimeAsTime(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_time && rv.Typ.Oid == types.T_datetime {
+		return CastTimeAsDatetime(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_time && rv.Typ.Oid == types.T_int64 {
+		return castFixedWith(lv, rv, proc, binary.TimeToInt64)
+	}
+
+	if lv.Typ.Oid == types.T_time && rv.Typ.Oid == types.T_float64 {
+		return castFixedWith(lv, rv, proc, binary.TimeToFloat64)
+	}
+
+	if types.IsEnumRelate(lv.Typ.Oid) {
+		switch {
+		case lv.Typ.Oid == types.T_enum && rv.Typ.Oid == types.T_int64:
+			return castFixedWith(lv, rv, proc, binary.EnumToNumeric[types.Enum, int64])
+		case lv.Typ.Oid == types.T_enum && rv.Typ.Oid == types.T_uint64:
+			return castFixedWith(lv, rv, proc, binary.EnumToNumeric[types.Enum, uint64])
+		case lv.Typ.Oid == types.T_enum && rv.Typ.Oid == types.T_float64:
+			return castFixedWith(lv, rv, proc, binary.EnumToNumeric[types.Enum, float64])
+		case lv.Typ.Oid == types.T_set && rv.Typ.Oid == types.T_int64:
+			return castFixedWith(lv, rv, proc, binary.EnumToNumeric[types.Set, int64])
+		case lv.Typ.Oid == types.T_set && rv.Typ.Oid == types.T_uint64:
+			return castFixedWith(lv, rv, proc, binary.EnumToNumeric[types.Set, uint64])
+		case lv.Typ.Oid == types.T_set && rv.Typ.Oid == types.T_float64:
+			return castFixedWith(lv, rv, proc, binary.EnumToNumeric[types.Set, float64])
+		}
+	}
+
+	if IsInteger(lv.Typ.Oid) && rv.Typ.Oid == types.T_time {
+		switch lv.Typ.Oid {
+		case types.T_int8:
+			return castFixedWith(lv, rv, proc, binary.NumericToTime[int8])
+		case types.T_int16:
+			return castFixedWith(lv, rv, proc, binary.NumericToTime[int16])
+		case types.T_int32:
+			return castFixedWith(lv, rv, proc, binary.NumericToTime[int32])
+		case types.T_int64:
+			return castFixedWith(lv, rv, proc, binary.NumericToTime[int64])
+		case types.T_uint8:
+			return castFixedWith(lv, rv, proc, binary.NumericToTime[uint8])
+		case types.T_uint16:
+			return castFixedWith(lv, rv, proc, binary.NumericToTime[uint16])
+		case types.T_uint32:
+			return castFixedWith(lv, rv, proc, binary.NumericToTime[uint32])
+		case types.T_uint64:
+			return castFixedWith(lv, rv, proc, binary.NumericToTime[uint64])
+		}
+	}
+
+	if IsFloat(lv.Typ.Oid) && rv.Typ.Oid == types.T_time {
+		switch lv.Typ.Oid {
+		case types.T_float32:
+			return castFixedWith(lv, rv, proc, func(xs []float32, rs []types.Time) ([]types.Time, error) {
+				return binary.FloatToTime(xs, rs, rv.Typ.Precision)
+			})
+		case types.T_float64:
+			return castFixedWith(lv, rv, proc, func(xs []float64, rs []types.Time) ([]types.Time, error) {
+				return binary.FloatToTime(xs, rs, rv.Typ.Precision)
+			})
+		}
+	}
 	if lv.Typ.Oid == types.T_decimal64 && rv.Typ.Oid == types.T_decimal128 {
 		return CastDecimal64AsDecimal128(lv, rv, proc)
 	}
@@ -658,6 +735,10 @@ func doCast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
 		return CastStringToJson(lv, rv, proc)
 	}
 
+	if lv.Typ.Oid == types.T_json && isString(rv.Typ.Oid) {
+		return CastJsonToString(lv, rv, proc)
+	}
+
 	if isString(lv.Typ.Oid) && rv.Typ.Oid == types.T_uuid {
 		return CastStringToUuid(lv, rv, proc)
 	}
@@ -1666,6 +1747,106 @@ func CastDatetimeAsDate(lv, rv *vector.Vector, proc *process.Process) (*vector.V
 	return vec, nil
 }
 
+// CastVarcharAsTime : Cast converts varchar to time type
+func CastVarcharAsTime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	vs := vector.MustStrCols(lv)
+
+	if lv.IsScalar() {
+		if lv.IsScalarNull() {
+			return proc.AllocConstNullVector(rv.Typ, lv.Length()), nil
+		}
+		data, err2 := types.ParseTime(vs[0], rv.Typ.Precision)
+		if err2 != nil {
+			return nil, err2
+		}
+		return vector.NewConstFixed(rv.Typ, lv.Length(), data), nil
+	}
+
+	vec, err := proc.AllocVectorOfRows(rv.Typ, int64(len(vs)), lv.Nsp)
+	if err != nil {
+		return nil, err
+	}
+	rs := vector.MustTCols[types.Time](vec)
+	for i, str := range vs {
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		data, err2 := types.ParseTime(str, rv.Typ.Precision)
+		if err2 != nil {
+			return nil, err2
+		}
+		rs[i] = data
+	}
+	return vec, nil
+}
+
+func CastTimeAsString(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	var err error
+
+	lvs := vector.MustTCols[types.Time](lv)
+	col := make([]string, len(lvs))
+	if lv.IsScalar() {
+		if lv.IsScalarNull() {
+			return proc.AllocConstNullVector(rv.Typ, lv.Length()), nil
+		}
+		if col, err = binary.TimeToBytes(lvs, col, lv.Typ.Precision); err != nil {
+			return nil, err
+		}
+		return vector.NewConstString(rv.Typ, lv.Length(), col[0]), nil
+	}
+
+	if col, err = binary.TimeToBytes(lvs, col, lv.Typ.Precision); err != nil {
+		return nil, err
+	}
+	return vector.NewWithStrings(rv.Typ, col, lv.Nsp, proc.Mp()), nil
+}
+
+// CastDatetimeAsTime : Cast keeps the time of the day of the datetime
+func CastDatetimeAsTime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return castFixedWith(lv, rv, proc, func(xs []types.Datetime, rs []types.Time) ([]types.Time, error) {
+		return binary.DatetimeToTime(xs, rs, rv.Typ.Precision)
+	})
+}
+
+// CastTimeAsDatetime : Cast converts time to the datetime of the current date
+func CastTimeAsDatetime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	var t *time.Location
+	if proc == nil {
+		t = time.Local
+	} else {
+		t = proc.SessionInfo.TimeZone
+	}
+	date := types.Now(t).ToDate()
+	return castFixedWith(lv, rv, proc, func(xs []types.Time, rs []types.Datetime) ([]types.Datetime, error) {
+		return binary.TimeToDatetime(date, xs, rs)
+	})
+}
+
+// castFixedWith converts the fixed size values of lv into the type of rv with fn
+func castFixedWith[T1, T2 types.FixedSizeT](lv, rv *vector.Vector, proc *process.Process, fn func([]T1, []T2) ([]T2, error)) (*vector.Vector, error) {
+	lvs := vector.MustTCols[T1](lv)
+	if lv.IsScalar() {
+		if lv.IsScalarNull() {
+			return proc.AllocConstNullVector(rv.Typ, lv.Length()), nil
+		}
+		rs := make([]T2, 1)
+		if _, err := fn(lvs, rs); err != nil {
+			return nil, err
+		}
+		return vector.NewConstFixed(rv.Typ, lv.Length(), rs[0]), nil
+	}
+
+	vec, err := proc.AllocVectorOfRows(rv.Typ, int64(len(lvs)), lv.Nsp)
+	if err != nil {
+		return nil, err
+	}
+	rs := vector.MustTCols[T2](vec)
+	if _, err := fn(lvs, rs); err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
+
 func CastIntAsTimestamp[T constraints.Signed](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	lvs := vector.MustTCols[T](lv)
 	if lv.IsScalar() {
@@ -2093,6 +2274,25 @@ func CastStringToJson(lv, rv *vector.Vector, proc *process.Process) (*vector.Vec
 	return vector.NewWithBytes(rv.Typ, col, lv.Nsp, proc.Mp()), nil
 }
 
+func CastJsonToString(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	vs := vector.MustBytesCols(lv)
+	if lv.IsScalar() {
+		if lv.IsScalarNull() {
+			return proc.AllocConstNullVector(rv.Typ, lv.Length()), nil
+		}
+		return vector.NewConstString(rv.Typ, lv.Length(), types.DecodeJson(vs[0]).String()), nil
+	}
+
+	col := make([]string, len(vs))
+	for i, v := range vs {
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		col[i] = types.DecodeJson(v).String()
+	}
+	return vector.NewWithStrings(rv.Typ, col, lv.Nsp, proc.Mp()), nil
+}
+
 func CastStringToBool(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	vs := vector.MustStrCols(lv)
 	if lv.IsScalar() {
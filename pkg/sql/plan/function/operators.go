@@ -221,6 +221,16 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.IsNull,
 			},
+			{
+				Index:  18,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNull,
+			},
 		},
 	},
 
@@ -407,6 +417,16 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.IsNotNull,
 			},
+			{
+				Index:  18,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNotNull,
+			},
 		},
 	},
 	// comparison operator
@@ -1138,6 +1158,17 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.EqUuid,
 			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_time,
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.EqGeneral[types.Time],
+			},
 		},
 	},
 
@@ -1365,6 +1396,17 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.GtUuid,
 			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_time,
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.GtGeneral[types.Time],
+			},
 		},
 	},
 
@@ -1592,6 +1634,17 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.GeUuid,
 			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_time,
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.GeGeneral[types.Time],
+			},
 		},
 	},
 
@@ -1819,6 +1872,17 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.LtUuid,
 			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_time,
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtGeneral[types.Time],
+			},
 		},
 	},
 
@@ -2046,6 +2110,17 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.LeUuid,
 			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_time,
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LeGeneral[types.Time],
+			},
 		},
 	},
 
@@ -2273,6 +2348,17 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.NeUuid,
 			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_time,
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.NeGeneral[types.Time],
+			},
 		},
 	},
 
@@ -5560,44 +5646,245 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_uuid,
 				Fn:        operator.Cast,
 			},
+			{
+				Index:     278,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     279,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_char, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     280,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varchar, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     281,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_blob, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     282,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_char},
+				ReturnTyp: types.T_char,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     283,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     284,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_blob},
+				ReturnTyp: types.T_blob,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     285,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_datetime, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     286,
+				Volatile:  true,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_datetime},
+				ReturnTyp: types.T_datetime,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     287,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int8, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     288,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int16, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     289,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int32, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     290,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int64, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     291,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint8, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     292,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint16, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     293,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint32, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     294,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint64, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     295,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_float32, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     296,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_float64, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     297,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_int64},
+				ReturnTyp: types.T_int64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     298,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_float64},
+				ReturnTyp: types.T_float64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     299,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_enum, types.T_int64},
+				ReturnTyp: types.T_int64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     300,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_enum, types.T_uint64},
+				ReturnTyp: types.T_uint64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     301,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_enum, types.T_float64},
+				ReturnTyp: types.T_float64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     302,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_set, types.T_int64},
+				ReturnTyp: types.T_int64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     303,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_set, types.T_uint64},
+				ReturnTyp: types.T_uint64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     304,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_set, types.T_float64},
+				ReturnTyp: types.T_float64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     305,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_json, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     306,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_json, types.T_char},
+				ReturnTyp: types.T_char,
+				Fn:        operator.Cast,
+			},
 		},
 	},
 
 	COALESCE: {
-		Id: COALESCE,
-		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
-			l := len(inputs)
-			if l == 0 {
-				return wrongFunctionParameters, nil
-			}
-
-			for i, o := range overloads {
-				if operator.CoalesceTypeCheckFn(inputs, nil, o.ReturnTyp) {
-					return int32(i), nil
-				}
-			}
-
-			minCost, minIndex := math.MaxInt32, -1
-			convertTypes := make([]types.T, l)
-			targetTypes := make([]types.T, l)
-
-			for i, o := range overloads {
-				for j := 0; j < l; j++ {
-					targetTypes[j] = o.ReturnTyp
-				}
-				if code, c := tryToMatch(inputs, targetTypes); code == matchedByConvert {
-					if c < minCost {
-						minCost = c
-						copy(convertTypes, targetTypes)
-						minIndex = i
-					}
-				}
-			}
-			if minIndex != -1 {
-				return int32(minIndex), convertTypes
-			}
-			return wrongFunctionParameters, nil
-		},
+		Id:          COALESCE,
+		TypeCheckFn: sameTypeCheckFn(1),
 		Overloads: []Function{
 			{
 				Index:     0,
@@ -5743,6 +6030,14 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_timestamp,
 				Fn:        operator.CoalesceTimestamp,
 			},
+			{
+				Index:     18,
+				Volatile:  true,
+				Flag:      plan.Function_NONE,
+				Layout:    STANDARD_FUNCTION,
+				ReturnTyp: types.T_time,
+				Fn:        operator.CoalesceTime,
+			},
 		},
 	},
 
@@ -5943,6 +6238,14 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_blob,
 				Fn:        operator.CaseWhenText,
 			},
+			{
+				Index:     19,
+				Volatile:  true,
+				Flag:      plan.Function_NONE,
+				Layout:    CASE_WHEN_EXPRESSION,
+				ReturnTyp: types.T_time,
+				Fn:        operator.CaseWhenTime,
+			},
 		},
 	},
 
@@ -6132,6 +6435,14 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_blob,
 				Fn:        operator.IfText,
 			},
+			{
+				Index:     19,
+				Volatile:  true,
+				Flag:      plan.Function_NONE,
+				Layout:    STANDARD_FUNCTION,
+				ReturnTyp: types.T_time,
+				Fn:        operator.IfTime,
+			},
 		},
 	},
 }
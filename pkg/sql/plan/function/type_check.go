@@ -15,7 +15,10 @@
 package function
 
 import (
+	"math"
+
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/operator"
 )
 
 const (
@@ -58,7 +61,7 @@ func initTypeCheckRelated() {
 		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
 		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
 		types.T_float32, types.T_float64,
-		types.T_date, types.T_datetime, types.T_timestamp,
+		types.T_date, types.T_datetime, types.T_timestamp, types.T_time,
 		types.T_char, types.T_varchar, types.T_blob,
 		types.T_decimal64, types.T_decimal128,
 	}
@@ -166,6 +169,8 @@ func initTypeCheckRelated() {
 		convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{types.T_int64, types.T_uint64, types.T_int64, types.T_int64})
 		convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{types.T_date, types.T_datetime, types.T_datetime, types.T_datetime})
 		convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{types.T_datetime, types.T_date, types.T_datetime, types.T_datetime})
+		convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{types.T_time, types.T_datetime, types.T_datetime, types.T_datetime})
+		convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{types.T_datetime, types.T_time, types.T_datetime, types.T_datetime})
 		for _, t1 := range strings {
 			for _, t2 := range all {
 				if t1 == t2 || t2 == types.T_any {
@@ -295,13 +300,24 @@ func initTypeCheckRelated() {
 		castTable[types.T_datetime][types.T_datetime] = true
 		castTable[types.T_datetime][types.T_date] = true
 		castTable[types.T_datetime][types.T_timestamp] = true
+		castTable[types.T_datetime][types.T_time] = true
 		for _, typ := range strings {
 			castTable[types.T_datetime][typ] = true
 		}
 	}
+	{ // time
+		castTable[types.T_time][types.T_time] = true
+		castTable[types.T_time][types.T_datetime] = true
+		castTable[types.T_time][types.T_int64] = true
+		castTable[types.T_time][types.T_float64] = true
+		for _, typ := range strings {
+			castTable[types.T_time][typ] = true
+		}
+	}
 	{ //  float
 		for _, t := range floats {
 			castTable[t][types.T_bool] = true
+			castTable[t][types.T_time] = true
 			for _, typ := range floats {
 				castTable[t][typ] = true
 			}
@@ -327,6 +343,7 @@ func initTypeCheckRelated() {
 				castTable[t][typ] = true
 			}
 			castTable[t][types.T_timestamp] = true
+			castTable[t][types.T_time] = true
 			castTable[t][types.T_decimal64] = true
 			castTable[t][types.T_decimal128] = true
 			for _, typ := range strings {
@@ -466,6 +483,171 @@ func generalTypeCheckForUnaryAggregate(overloads []Function, inputs []types.T) (
 	return wrongFuncParamForAgg, nil
 }
 
+// generalTypeCheckForWindow matches the overload with the same number of parameters.
+// T_any in an overload accepts any type, other parameters can be converted implicitly.
+func generalTypeCheckForWindow(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	for i, o := range overloads {
+		if len(o.Args) != len(inputs) {
+			continue
+		}
+		matched, needCast := true, false
+		for j, t := range o.Args {
+			if t == types.T_any || inputs[j] == t || inputs[j] == ScalarNull {
+				continue
+			}
+			if !castTable[inputs[j]][t] {
+				matched = false
+				break
+			}
+			needCast = true
+		}
+		if matched {
+			if needCast {
+				return int32(i), o.Args
+			}
+			return int32(i), nil
+		}
+	}
+	return wrongFunctionParameters, nil
+}
+
+// jsonTypeCheckFn returns the type check function of the JSON functions whose
+// parameter count is accepted by valid. The parameters are kept if the functions
+// take them as they are, other numbers are converted to int64, uint64 or float64
+// and the rest to varchar.
+func jsonTypeCheckFn(valid func(int) bool) func([]Function, []types.T) (int32, []types.T) {
+	return func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+		if !valid(len(inputs)) {
+			return wrongFunctionParameters, nil
+		}
+		ret := make([]types.T, len(inputs))
+		convert := false
+		for i, t := range inputs {
+			ret[i] = t
+			switch t {
+			case types.T_json, types.T_char, types.T_varchar, types.T_blob, types.T_bool,
+				types.T_int64, types.T_uint64, types.T_float64, types.T_any:
+				continue
+			case types.T_int8, types.T_int16, types.T_int32:
+				ret[i] = types.T_int64
+			case types.T_uint8, types.T_uint16, types.T_uint32:
+				ret[i] = types.T_uint64
+			case types.T_float32, types.T_decimal64, types.T_decimal128:
+				ret[i] = types.T_float64
+			default:
+				if !castTable[t][types.T_varchar] {
+					return wrongFunctionParameters, nil
+				}
+				ret[i] = types.T_varchar
+			}
+			convert = true
+		}
+		if convert {
+			return 0, ret
+		}
+		return 0, nil
+	}
+}
+
+// sameTypeCheckFn returns the type check function of the functions taking at
+// least minArgs parameters of the type they return, as coalesce. The overload
+// whose return type all the parameters have is chosen, or the one to which the
+// parameters are converted at the lowest cost.
+func sameTypeCheckFn(minArgs int) func([]Function, []types.T) (int32, []types.T) {
+	return func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+		l := len(inputs)
+		if l == 0 || l < minArgs {
+			return wrongFunctionParameters, nil
+		}
+
+		for i, o := range overloads {
+			if operator.CoalesceTypeCheckFn(inputs, nil, o.ReturnTyp) {
+				return int32(i), nil
+			}
+		}
+
+		minCost, minIndex := math.MaxInt32, -1
+		convertTypes := make([]types.T, l)
+		targetTypes := make([]types.T, l)
+
+		for i, o := range overloads {
+			for j := 0; j < l; j++ {
+				targetTypes[j] = o.ReturnTyp
+			}
+			if code, c := tryToMatch(inputs, targetTypes); code == matchedByConvert {
+				if c < minCost {
+					minCost = c
+					copy(convertTypes, targetTypes)
+					minIndex = i
+				}
+			}
+		}
+		if minIndex != -1 {
+			return int32(minIndex), convertTypes
+		}
+		return wrongFunctionParameters, nil
+	}
+}
+
+// stringTypeCheckFn returns the type check function of the functions taking
+// strings only, the parameter count is accepted by valid and the parameters of
+// other types are converted to varchar.
+func stringTypeCheckFn(valid func(int) bool) func([]Function, []types.T) (int32, []types.T) {
+	return func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+		if !valid(len(inputs)) {
+			return wrongFunctionParameters, nil
+		}
+		ret := make([]types.T, len(inputs))
+		convert := false
+		for i, t := range inputs {
+			ret[i] = t
+			switch t {
+			case types.T_char, types.T_varchar, types.T_blob, types.T_any:
+				continue
+			}
+			if !castTable[t][types.T_varchar] {
+				return wrongFunctionParameters, nil
+			}
+			ret[i] = types.T_varchar
+			convert = true
+		}
+		if convert {
+			return 0, ret
+		}
+		return 0, nil
+	}
+}
+
+// hashTypeCheckFn is the type check function of hash, which takes the values
+// of any type but the ones which can only be hashed as strings.
+func hashTypeCheckFn(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) == 0 {
+		return wrongFunctionParameters, nil
+	}
+	ret := make([]types.T, len(inputs))
+	convert := false
+	for i, t := range inputs {
+		ret[i] = t
+		switch t {
+		case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+			types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+			types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+			types.T_date, types.T_time, types.T_datetime, types.T_timestamp, types.T_uuid,
+			types.T_enum, types.T_set, types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_any:
+			continue
+		}
+		if !castTable[t][types.T_varchar] {
+			return wrongFunctionParameters, nil
+		}
+		ret[i] = types.T_varchar
+		convert = true
+	}
+	if convert {
+		return 0, ret
+	}
+	return 0, nil
+}
+
 // tryToMatch checks whether the types of the two input parameters match directly
 // or can be matched by implicit type conversion.
 // If the match is successful,
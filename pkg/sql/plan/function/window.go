@@ -0,0 +1,209 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+func initWindowFunction() {
+	var err error
+
+	for fid, fs := range windows {
+		err = appendFunction(fid, fs)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// windows contains the window function indexed by function id.
+// These functions have no vector implementation, they are evaluated by the window operator.
+// An argument type of T_any accepts any input type, and a return type of T_any
+// means the function returns the type of its first argument.
+var windows = map[int]Functions{
+	ROW_NUMBER: {
+		Id:          ROW_NUMBER,
+		TypeCheckFn: generalTypeCheckForWindow,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+			},
+		},
+	},
+	RANK: {
+		Id:          RANK,
+		TypeCheckFn: generalTypeCheckForWindow,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+			},
+		},
+	},
+	DENSE_RANK: {
+		Id:          DENSE_RANK,
+		TypeCheckFn: generalTypeCheckForWindow,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+			},
+		},
+	},
+	PERCENT_RANK: {
+		Id:          PERCENT_RANK,
+		TypeCheckFn: generalTypeCheckForWindow,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_float64,
+			},
+		},
+	},
+	CUME_DIST: {
+		Id:          CUME_DIST,
+		TypeCheckFn: generalTypeCheckForWindow,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_float64,
+			},
+		},
+	},
+	NTILE: {
+		Id:          NTILE,
+		TypeCheckFn: generalTypeCheckForWindow,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64},
+				ReturnTyp: types.T_int64,
+			},
+		},
+	},
+	LAG: {
+		Id:          LAG,
+		TypeCheckFn: generalTypeCheckForWindow,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_any},
+				ReturnTyp: types.T_any,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_any, types.T_int64},
+				ReturnTyp: types.T_any,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_any, types.T_int64, types.T_any},
+				ReturnTyp: types.T_any,
+			},
+		},
+	},
+	LEAD: {
+		Id:          LEAD,
+		TypeCheckFn: generalTypeCheckForWindow,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_any},
+				ReturnTyp: types.T_any,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_any, types.T_int64},
+				ReturnTyp: types.T_any,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_any, types.T_int64, types.T_any},
+				ReturnTyp: types.T_any,
+			},
+		},
+	},
+	FIRST_VALUE: {
+		Id:          FIRST_VALUE,
+		TypeCheckFn: generalTypeCheckForWindow,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_any},
+				ReturnTyp: types.T_any,
+			},
+		},
+	},
+	LAST_VALUE: {
+		Id:          LAST_VALUE,
+		TypeCheckFn: generalTypeCheckForWindow,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_any},
+				ReturnTyp: types.T_any,
+			},
+		},
+	},
+	NTH_VALUE: {
+		Id:          NTH_VALUE,
+		TypeCheckFn: generalTypeCheckForWindow,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_WIN,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_any, types.T_int64},
+				ReturnTyp: types.T_any,
+			},
+		},
+	},
+}
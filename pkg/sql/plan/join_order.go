@@ -71,7 +71,7 @@ func (builder *QueryBuilder) pushdownSemiAntiJoins(nodeID int32) int32 {
 			break
 		}
 
-		if joinNode.JoinType != plan.Node_INNER && joinNode.JoinType != plan.Node_LEFT {
+		if joinNode.JoinType != plan.Node_INNER && joinNode.JoinType != plan.Node_LEFT && joinNode.JoinType != plan.Node_RIGHT {
 			break
 		}
 
@@ -90,11 +90,11 @@ func (builder *QueryBuilder) pushdownSemiAntiJoins(nodeID int32) int32 {
 			joinSide |= getJoinSide(cond, leftTags, rightTags)
 		}
 
-		if joinSide == JoinSideLeft {
+		if joinNode.JoinType != plan.Node_RIGHT && joinSide == JoinSideLeft {
 			targetNode = joinNode
 			targetSide = 0
 			joinNode = builder.qry.Nodes[joinNode.Children[0]]
-		} else if joinNode.JoinType == plan.Node_INNER && joinSide == JoinSideRight {
+		} else if joinNode.JoinType != plan.Node_LEFT && joinSide == JoinSideRight {
 			targetNode = joinNode
 			targetSide = 1
 			joinNode = builder.qry.Nodes[joinNode.Children[1]]
@@ -112,6 +112,54 @@ func (builder *QueryBuilder) pushdownSemiAntiJoins(nodeID int32) int32 {
 	return nodeID
 }
 
+// pickBuildSide puts the smaller child of an outer join on the right, which is the
+// side the hash table is built on. A left join turns into a right join, and a full
+// join just swaps its children. Only hash joins can be flipped, so the join must
+// have an equal condition between both children.
+func (builder *QueryBuilder) pickBuildSide(node *plan.Node) {
+	if node.JoinType != plan.Node_LEFT && node.JoinType != plan.Node_OUTER {
+		return
+	}
+	leftCost := builder.qry.Nodes[node.Children[0]].Cost
+	rightCost := builder.qry.Nodes[node.Children[1]].Cost
+	if leftCost.Card >= rightCost.Card {
+		return
+	}
+
+	leftTags := make(map[int32]*Binding)
+	for _, tag := range builder.enumerateTags(node.Children[0]) {
+		leftTags[tag] = nil
+	}
+
+	rightTags := make(map[int32]*Binding)
+	for _, tag := range builder.enumerateTags(node.Children[1]) {
+		rightTags[tag] = nil
+	}
+
+	isEqui := false
+	for _, cond := range node.OnList {
+		for _, conjElem := range splitPlanConjunction(cond) {
+			f, ok := conjElem.Expr.(*plan.Expr_F)
+			if !ok || f.F.Func.ObjName != "=" {
+				continue
+			}
+			lside := getJoinSide(f.F.Args[0], leftTags, rightTags)
+			rside := getJoinSide(f.F.Args[1], leftTags, rightTags)
+			if lside|rside == JoinSideBoth && lside != JoinSideBoth && rside != JoinSideBoth {
+				isEqui = true
+			}
+		}
+	}
+	if !isEqui {
+		return
+	}
+
+	if node.JoinType == plan.Node_LEFT {
+		node.JoinType = plan.Node_RIGHT
+	}
+	node.Children[0], node.Children[1] = node.Children[1], node.Children[0]
+}
+
 func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 	node := builder.qry.Nodes[nodeID]
 
@@ -123,6 +171,8 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 
 			switch node.NodeType {
 			case plan.Node_JOIN:
+				builder.pickBuildSide(node)
+
 				leftCost := builder.qry.Nodes[node.Children[0]].Cost
 				rightCost := builder.qry.Nodes[node.Children[1]].Cost
 
@@ -130,7 +180,7 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 				case plan.Node_LEFT:
 					card := leftCost.Card * rightCost.Card
 					if len(node.OnList) > 0 {
-						card *= 0.1
+						card *= builder.estimateJoinSelectivity(node.OnList)
 						card += leftCost.Card
 					}
 					node.Cost = &plan.Cost{
@@ -140,7 +190,7 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 				case plan.Node_RIGHT:
 					card := leftCost.Card * rightCost.Card
 					if len(node.OnList) > 0 {
-						card *= 0.1
+						card *= builder.estimateJoinSelectivity(node.OnList)
 						card += rightCost.Card
 					}
 					node.Cost = &plan.Cost{
@@ -150,7 +200,7 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 				case plan.Node_OUTER:
 					card := leftCost.Card * rightCost.Card
 					if len(node.OnList) > 0 {
-						card *= 0.1
+						card *= builder.estimateJoinSelectivity(node.OnList)
 						card += leftCost.Card + rightCost.Card
 					}
 					node.Cost = &plan.Cost{
@@ -168,7 +218,7 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 				if len(node.GroupBy) > 0 {
 					childCost := builder.qry.Nodes[node.Children[0]].Cost
 					node.Cost = &plan.Cost{
-						Card: childCost.Card * 0.1,
+						Card: builder.estimateGroupCard(node.GroupBy, childCost.Card),
 					}
 				} else {
 					node.Cost = &plan.Cost{
@@ -230,9 +280,11 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 	firstConnected := nLeaf
 	visited := make([]bool, nLeaf)
 
-	for _, cond := range conds {
+	hyperEdges := make([]map[int32]any, len(conds))
+	for k, cond := range conds {
 		hyperEdge := make(map[int32]any)
 		getHyperEdgeFromExpr(cond, leafByTag, hyperEdge)
+		hyperEdges[k] = hyperEdge
 
 		for i := range hyperEdge {
 			if i < firstConnected {
@@ -282,7 +334,25 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 				JoinType: plan.Node_INNER,
 			}, nil)
 
-			leftCard = leftCard * rightCard * 0.1
+			// the conditions between the new leaf and the joined ones decide the cardinality
+			var joinConds []*plan.Expr
+			for k, hyperEdge := range hyperEdges {
+				if _, ok := hyperEdge[nextSibling]; !ok || len(hyperEdge) < 2 {
+					continue
+				}
+				joined := true
+				for i := range hyperEdge {
+					if !visited[i] {
+						joined = false
+						break
+					}
+				}
+				if joined {
+					joinConds = append(joinConds, conds[k])
+				}
+			}
+			leftCard = clampCard(leftCard * rightCard * builder.estimateJoinSelectivity(joinConds))
+			builder.qry.Nodes[nodeID].Cost.Card = leftCard
 
 			for i, adj := range adjMat[nextSibling*nLeaf : (nextSibling+1)*nLeaf] {
 				eligible[i] = eligible[i] || adj
@@ -354,10 +424,10 @@ func (builder *QueryBuilder) getJoinGraph(leaves []*plan.Node, conds []*plan.Exp
 
 		if node.NodeType == plan.Node_TABLE_SCAN {
 			binding := builder.ctxByNode[node.NodeId].bindingByTag[node.BindingTags[0]]
-			pkDef := builder.compCtx.GetPrimaryKeyDef(node.ObjRef.SchemaName, node.ObjRef.ObjName)
-			pks := make([]int32, len(pkDef))
-			for i, pk := range pkDef {
-				pks[i] = binding.FindColumn(pk.Name)
+			pkNames := getPrimaryKeyNames(node.TableDef)
+			pks := make([]int32, len(pkNames))
+			for i, pk := range pkNames {
+				pks[i] = binding.FindColumn(pk)
 			}
 			vertices[i].pks = pks
 			tag2Vert[node.BindingTags[0]] = int32(i)
@@ -365,7 +435,7 @@ func (builder *QueryBuilder) getJoinGraph(leaves []*plan.Node, conds []*plan.Exp
 
 		for _, filter := range node.FilterList {
 			if builder.filterOnPK(filter, vertices[i].pks) {
-				vertices[i].pkSelRate *= 0.1
+				vertices[i].pkSelRate *= builder.estimateSelectivity(filter)
 			}
 		}
 	}
@@ -0,0 +1,196 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// JSON_TABLE is built as a FUNCTION_SCAN node over the tables on its left, so
+// its document can refer to their columns. It is built over a one row VALUE_SCAN
+// if it's the first table of FROM.
+
+// jsonTableOf returns the table expression if it's a JSON_TABLE
+func jsonTableOf(stmt tree.TableExpr) (*tree.AliasedTableExpr, bool) {
+	tbl, ok := stmt.(*tree.AliasedTableExpr)
+	if !ok {
+		return nil, false
+	}
+	_, ok = tbl.Expr.(*tree.JsonTable)
+	return tbl, ok
+}
+
+// buildJsonTable builds the JSON_TABLE over the child node, the document is
+// bound in leftCtx which has the tables of the child, and the columns are
+// bound in ctx.
+func (builder *QueryBuilder) buildJsonTable(tbl *tree.AliasedTableExpr, childID int32, leftCtx, ctx *BindContext, outer bool) (int32, error) {
+	jt := tbl.Expr.(*tree.JsonTable)
+	if tbl.As.Alias == "" {
+		return 0, errors.New("", "every table function must have an alias")
+	}
+
+	leftCtx.binder = NewTableBinder(builder, leftCtx)
+	doc, err := leftCtx.binder.BindExpr(jt.Expr, 0, true)
+	if err != nil {
+		return 0, err
+	}
+	switch types.T(doc.Typ.Id) {
+	case types.T_json, types.T_char, types.T_varchar, types.T_blob:
+	default:
+		return 0, errors.New("", "incorrect arguments to JSON_TABLE, the document should be a json or a string")
+	}
+	if _, err = types.ParseStringToPath(jt.Path); err != nil {
+		return 0, err
+	}
+
+	tableDef := &TableDef{
+		Name: string(tbl.As.Alias),
+	}
+	jsonTable := &plan.JsonTable{
+		Doc:   doc,
+		Path:  jt.Path,
+		Outer: outer,
+	}
+	for _, col := range jt.Columns {
+		c := &plan.JsonTableColumn{
+			Name: string(col.Name),
+			Path: col.Path,
+		}
+		typ := &plan.Type{Id: int32(types.T_int64), Size: 8}
+		if col.Ordinality {
+			c.Kind = plan.JsonTableColumn_ORDINALITY
+		} else {
+			if typ, err = getTypeFromAst(col.Type); err != nil {
+				return 0, err
+			}
+			if _, err = types.ParseStringToPath(col.Path); err != nil {
+				return 0, err
+			}
+			// the operator makes the value at the path as a varchar, a json or
+			// an int64 for EXISTS PATH, then casts it to the type of the column
+			raw := &plan.Type{Id: int32(types.T_varchar), Size: types.VarlenaSize, Width: types.MaxStringSize}
+			if col.Exists {
+				c.Kind = plan.JsonTableColumn_EXISTS
+				raw = &plan.Type{Id: int32(types.T_int64), Size: 8}
+			} else if types.T(typ.Id) == types.T_json {
+				raw = typ
+			}
+			c.Expr = &plan.Expr{
+				Typ: raw,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{Name: c.Name},
+				},
+			}
+			if raw.Id != typ.Id {
+				if c.Expr, err = appendCastBeforeExpr(c.Expr, typ); err != nil {
+					return 0, err
+				}
+			}
+		}
+		tableDef.Cols = append(tableDef.Cols, &ColDef{
+			Name: c.Name,
+			Typ:  typ,
+		})
+		jsonTable.Cols = append(jsonTable.Cols, c)
+	}
+
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_FUNCTION_SCAN,
+		Children:    []int32{childID},
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+		JsonTable:   jsonTable,
+	}, ctx)
+	if err = builder.addBinding(nodeID, tbl.As, ctx); err != nil {
+		return 0, err
+	}
+	return nodeID, nil
+}
+
+// buildJoinJsonTable builds the JSON_TABLE joined to the tables on its left,
+// the conditions of an inner join filter the rows, while a left join keeps
+// the rows of the left side whose document matches nothing.
+func (builder *QueryBuilder) buildJoinJsonTable(tbl *tree.JoinTableExpr, right *tree.AliasedTableExpr, joinType plan.Node_JoinFlag, ctx *BindContext) (int32, error) {
+	switch tbl.JoinType {
+	case tree.JOIN_TYPE_CROSS, tree.JOIN_TYPE_INNER, tree.JOIN_TYPE_LEFT:
+	default:
+		return 0, errors.New("", fmt.Sprintf("%s JOIN of JSON_TABLE is not supported", tbl.JoinType))
+	}
+
+	leftCtx := NewBindContext(builder, ctx)
+	rightCtx := NewBindContext(builder, ctx)
+
+	leftChildID, err := builder.buildTable(tbl.Left, leftCtx)
+	if err != nil {
+		return 0, err
+	}
+
+	nodeID, err := builder.buildJsonTable(right, leftChildID, leftCtx, rightCtx, joinType == plan.Node_LEFT)
+	if err != nil {
+		return 0, err
+	}
+
+	err = ctx.mergeContexts(leftCtx, rightCtx)
+	if err != nil {
+		return 0, err
+	}
+	builder.ctxByNode[nodeID] = ctx
+
+	switch cond := tbl.Cond.(type) {
+	case nil:
+	case *tree.OnJoinCond:
+		ctx.binder = NewTableBinder(builder, ctx)
+		conds, err := splitAndBindCondition(cond.Expr, ctx)
+		if err != nil {
+			return 0, err
+		}
+		if joinType == plan.Node_LEFT {
+			for _, cond := range conds {
+				if !isTrueConstant(cond) {
+					return 0, errors.New("", "only ON TRUE is supported for LEFT JOIN of JSON_TABLE")
+				}
+			}
+			break
+		}
+		nodeID = builder.appendNode(&plan.Node{
+			NodeType:   plan.Node_FILTER,
+			Children:   []int32{nodeID},
+			FilterList: conds,
+		}, ctx)
+	default:
+		return 0, errors.New("", "USING of JSON_TABLE is not supported")
+	}
+
+	return nodeID, nil
+}
+
+func isTrueConstant(expr *plan.Expr) bool {
+	c, ok := expr.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return false
+	}
+	switch v := c.C.Value.(type) {
+	case *plan.Const_Bval:
+		return v.Bval
+	case *plan.Const_Ival:
+		return v.Ival != 0
+	}
+	return false
+}
@@ -136,6 +136,9 @@ func makePlan2StringConstExprWithType(v string) *plan.Expr {
 }
 
 func makePlan2CastExpr(expr *Expr, targetType *Type) (*Expr, error) {
+	if newExpr, ok, err := castEnumExpr(expr, targetType); ok || err != nil {
+		return newExpr, err
+	}
 	t1, t2 := makeTypeByPlan2Expr(expr), makeTypeByPlan2Type(targetType)
 	if isSameColumnType(expr.Typ, targetType) {
 		return expr, nil
@@ -164,12 +167,13 @@ func makePlan2CastExpr(expr *Expr, targetType *Type) (*Expr, error) {
 
 func copyType(t *Type) *Type {
 	return &Type{
-		Id:        t.Id,
-		Nullable:  t.Nullable,
-		Width:     t.Width,
-		Precision: t.Precision,
-		Size:      t.Size,
-		Scale:     t.Scale,
+		Id:         t.Id,
+		Nullable:   t.Nullable,
+		Width:      t.Width,
+		Precision:  t.Precision,
+		Size:       t.Size,
+		Scale:      t.Scale,
+		Enumvalues: t.Enumvalues,
 	}
 }
 
@@ -21,6 +21,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 )
@@ -29,7 +30,10 @@ type MockCompilerContext struct {
 	objects map[string]*ObjectRef
 	tables  map[string]*TableDef
 	costs   map[string]*Cost
+	stats   map[string]*TableStats
 	pks     map[string][]int
+	// the predicates of the policies indexed by the table name and the statement type
+	policies map[string][]string
 }
 
 func (m *MockCompilerContext) ResolveVariable(varName string, isSystemVar, isGlobalVar bool) (interface{}, error) {
@@ -66,9 +70,12 @@ func NewEmptyCompilerContext() *MockCompilerContext {
 }
 
 type Schema struct {
-	cols []col
-	pks  []int
-	card float64
+	cols    []col
+	pks     []int
+	card    float64
+	indexes []*plan.IndexDef
+	// the value lists of the enum and set columns
+	enumValues map[string]string
 }
 
 const SF float64 = 1
@@ -163,6 +170,11 @@ func NewMockCompilerContext() *MockCompilerContext {
 		},
 		pks:  []int{0},
 		card: SF * 15e4,
+		//not exist in tpch, create for test secondary index
+		indexes: []*plan.IndexDef{
+			{Typ: plan.IndexDef_SECONDARY, Name: "c_phone_idx", ColNames: []string{"c_phone"}, Unique: true},
+			{Typ: plan.IndexDef_SECONDARY, Name: "c_segment_idx", ColNames: []string{"c_mktsegment", "c_nationkey"}},
+		},
 	}
 	tpchSchema["orders"] = &Schema{
 		cols: []col{
@@ -201,6 +213,19 @@ func NewMockCompilerContext() *MockCompilerContext {
 		pks:  []int{0, 3},
 		card: SF * 6e6,
 	}
+	tpchSchema["shirts"] = &Schema{ //not exist in tpch, create for test enum and set
+		cols: []col{
+			{"s_id", types.T_int32, false, 0, 0},
+			{"s_size", types.T_enum, true, 0, 0},
+			{"s_colors", types.T_set, true, 0, 0},
+		},
+		pks:  []int{0},
+		card: 10,
+		enumValues: map[string]string{
+			"s_size":   "small,medium,large",
+			"s_colors": "red,green,blue",
+		},
+	}
 	// it's a view
 	tpchSchema["v1"] = &Schema{
 		cols: []col{
@@ -237,6 +262,7 @@ func NewMockCompilerContext() *MockCompilerContext {
 	objects := make(map[string]*ObjectRef)
 	tables := make(map[string]*TableDef)
 	costs := make(map[string]*Cost)
+	stats := make(map[string]*TableStats)
 	pks := make(map[string][]int)
 	// build tpch/mo context data(schema)
 	for db, schema := range schemas {
@@ -247,10 +273,11 @@ func NewMockCompilerContext() *MockCompilerContext {
 			for _, col := range table.cols {
 				colDefs = append(colDefs, &ColDef{
 					Typ: &plan.Type{
-						Id:        int32(col.Id),
-						Nullable:  col.Nullable,
-						Width:     col.Width,
-						Precision: col.Precision,
+						Id:         int32(col.Id),
+						Nullable:   col.Nullable,
+						Width:      col.Width,
+						Precision:  col.Precision,
+						Enumvalues: table.enumValues[col.Name],
 					},
 					Name:  col.Name,
 					Pkidx: 1,
@@ -299,6 +326,14 @@ func NewMockCompilerContext() *MockCompilerContext {
 					},
 				})
 			}
+			for _, idx := range table.indexes {
+				idx.IndexTableName = colexec.GetIndexTableName(tableName, idx.Name)
+				tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+					Def: &plan.TableDef_DefType_Idx{
+						Idx: idx,
+					},
+				})
+			}
 			tables[tableName] = tableDef
 			tableIdx++
 
@@ -309,6 +344,16 @@ func NewMockCompilerContext() *MockCompilerContext {
 				Card: table.card,
 			}
 
+			// the primary key columns are unique, others are unknown
+			if len(table.pks) == 1 {
+				stats[tableName] = &TableStats{
+					RowCount: table.card,
+					Cols: map[string]*ColumnStats{
+						table.cols[table.pks[0]].Name: {Ndv: table.card},
+					},
+				}
+			}
+
 			pks[tableName] = table.pks
 		}
 	}
@@ -317,6 +362,7 @@ func NewMockCompilerContext() *MockCompilerContext {
 		objects: objects,
 		tables:  tables,
 		costs:   costs,
+		stats:   stats,
 		pks:     pks,
 	}
 }
@@ -354,6 +400,14 @@ func (m *MockCompilerContext) Cost(obj *ObjectRef, e *Expr) *Cost {
 	return m.costs[obj.ObjName]
 }
 
+func (m *MockCompilerContext) Stats(obj *ObjectRef) *TableStats {
+	return m.stats[obj.ObjName]
+}
+
+func (m *MockCompilerContext) Policies(obj *ObjectRef, stmtType plan.Query_StatementType) ([]string, error) {
+	return m.policies[obj.ObjName+"-"+stmtType.String()], nil
+}
+
 type MockOptimizer struct {
 	ctxt MockCompilerContext
 }
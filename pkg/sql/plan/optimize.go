@@ -15,25 +15,63 @@
 package plan
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
 )
 
-var defaultRules = []Rule{}
+// registeredRule is a rule known by the optimizer, a new instance is created for every query
+// because a rule may keep states while applied.
+type registeredRule struct {
+	name    string
+	newRule func() Rule
+}
+
+// ruleRegistry keeps the rules in the order they are applied.
+var ruleRegistry []registeredRule
 
 func init() {
-	defaultRules = []Rule{
-		rule.NewConstantFold(),
-		rule.NewPredicatePushdown(),
+	RegisterRule("constant_fold", func() Rule { return rule.NewConstantFold() })
+	RegisterRule("predicate_pushdown", func() Rule { return rule.NewPredicatePushdown() })
+	RegisterRule("outer_join_to_inner", func() Rule { return rule.NewOuterJoinToInner() })
+	RegisterRule("join_elimination", func() Rule { return rule.NewJoinElimination() })
+	RegisterRule("aggregate_pushdown", func() Rule { return rule.NewAggregatePushdown() })
+	RegisterRule("limit_pushdown", func() Rule { return rule.NewLimitPushdown() })
+	RegisterRule("projection_pruning", func() Rule { return rule.NewProjectionPruning() })
+	RegisterRule("index_scan", func() Rule { return rule.NewIndexScan() })
+}
+
+// RegisterRule appends a rule to the optimizer, the rules are applied to every node
+// in the order they are registered.
+func RegisterRule(name string, newRule func() Rule) {
+	for _, r := range ruleRegistry {
+		if r.name == name {
+			panic(errors.New("", fmt.Sprintf("optimizer rule '%s' is registered twice", name)))
+		}
 	}
+	ruleRegistry = append(ruleRegistry, registeredRule{name: name, newRule: newRule})
+}
+
+// RegisteredRules returns the names of the registered rules in order.
+func RegisteredRules() []string {
+	names := make([]string, len(ruleRegistry))
+	for i, r := range ruleRegistry {
+		names[i] = r.name
+	}
+	return names
 }
 
 func NewBaseOptimizer(ctx CompilerContext) *BaseOptimizer {
+	rules := make([]Rule, len(ruleRegistry))
+	for i, r := range ruleRegistry {
+		rules[i] = r.newRule()
+	}
 	return &BaseOptimizer{
 		ctx:   ctx,
-		rules: defaultRules,
+		rules: rules,
 	}
 }
 
@@ -0,0 +1,315 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleRegistry(t *testing.T) {
+	require.Equal(t, []string{
+		"constant_fold",
+		"predicate_pushdown",
+		"outer_join_to_inner",
+		"join_elimination",
+		"aggregate_pushdown",
+		"limit_pushdown",
+		"projection_pruning",
+		"index_scan",
+	}, RegisteredRules())
+	require.Panics(t, func() {
+		RegisterRule("constant_fold", nil)
+	})
+}
+
+func TestJoinElimination(t *testing.T) {
+	qry := runOptimize(t, "select n_name from nation left join region on n_regionkey = r_regionkey")
+	require.Empty(t, findNodes(qry, plan.Node_JOIN))
+
+	// the right side is used
+	qry = runOptimize(t, "select n_name, r_name from nation left join region on n_regionkey = r_regionkey")
+	require.Len(t, findNodes(qry, plan.Node_JOIN), 1)
+
+	// the right side is not unique
+	qry = runOptimize(t, "select n_name from nation left join region on n_regionkey = r_name")
+	require.Len(t, findNodes(qry, plan.Node_JOIN), 1)
+}
+
+func TestOuterJoinToInner(t *testing.T) {
+	qry := runOptimize(t, "select * from nation left join region on n_regionkey = r_regionkey join supplier on r_regionkey = s_nationkey")
+	for _, n := range findNodes(qry, plan.Node_JOIN) {
+		require.Equal(t, plan.Node_INNER, n.JoinType)
+	}
+
+	// is null keeps the rows padded with nulls
+	qry = runOptimize(t, "select * from nation left join region on n_regionkey = r_regionkey join supplier on r_regionkey is null and n_nationkey = s_nationkey")
+	joinTypes := make([]plan.Node_JoinFlag, 0, 2)
+	for _, n := range findNodes(qry, plan.Node_JOIN) {
+		joinTypes = append(joinTypes, n.JoinType)
+	}
+	require.Contains(t, joinTypes, plan.Node_LEFT)
+}
+
+func TestLimitPushdown(t *testing.T) {
+	qry := runOptimize(t, "select n_name from nation limit 3 offset 2")
+	scans := findNodes(qry, plan.Node_TABLE_SCAN)
+	require.Len(t, scans, 1)
+	require.Equal(t, int64(5), scans[0].Limit.GetC().GetIval())
+
+	qry = runOptimize(t, "select n_name from nation left join region on n_regionkey = r_regionkey where r_name is null limit 3")
+	for _, n := range findNodes(qry, plan.Node_TABLE_SCAN) {
+		require.Nil(t, n.Limit)
+	}
+
+	qry = runOptimize(t, "select n_name from nation order by n_name limit 3")
+	require.Nil(t, findNodes(qry, plan.Node_TABLE_SCAN)[0].Limit)
+}
+
+func TestAggregatePushdown(t *testing.T) {
+	qry := runOptimize(t, "select r_name, sum(n_nationkey), count(*) from nation join region on n_regionkey = r_regionkey group by r_name")
+	aggs := findNodes(qry, plan.Node_AGG)
+	require.Len(t, aggs, 2)
+	// the partial aggregation is below the join
+	join := findNodes(qry, plan.Node_JOIN)[0]
+	require.Contains(t, join.Children, aggs[0].NodeId)
+	require.Equal(t, "sum", aggs[1].AggList[1].GetF().Func.ObjName)
+
+	// distinct can't be merged
+	qry = runOptimize(t, "select r_name, count(distinct n_name) from nation join region on n_regionkey = r_regionkey group by r_name")
+	require.Len(t, findNodes(qry, plan.Node_AGG), 1)
+
+	// avg can't be merged
+	qry = runOptimize(t, "select r_name, avg(n_nationkey) from nation join region on n_regionkey = r_regionkey group by r_name")
+	require.Len(t, findNodes(qry, plan.Node_AGG), 1)
+}
+
+func TestAggregatePushdownNonColumnProjection(t *testing.T) {
+	sql := "select r_name, sum(n_nationkey) from nation join region on n_regionkey = r_regionkey group by r_name"
+	build := func() (*Query, *plan.Node, *plan.Node) {
+		stmts, err := mysql.Parse(sql)
+		require.NoError(t, err)
+		pn, err := BuildPlan(NewMockCompilerContext(), stmts[0])
+		require.NoError(t, err)
+		qry := pn.GetQuery()
+		for _, n := range findNodes(qry, plan.Node_AGG) {
+			if join := qry.Nodes[n.Children[0]]; join.NodeType == plan.Node_JOIN {
+				return qry, n, join
+			}
+		}
+		t.Fatal("no aggregation over a join")
+		return nil, nil, nil
+	}
+
+	qry, agg, _ := build()
+	rule.NewAggregatePushdown().Apply(agg, qry)
+	require.Len(t, findNodes(qry, plan.Node_AGG), 2)
+
+	// the join outputs an expression, so the rewrite is abandoned
+	qry, agg, join := build()
+	join.ProjectList = append(join.ProjectList, makePlan2Int64ConstExprWithType(1))
+	rule.NewAggregatePushdown().Apply(agg, qry)
+	require.Len(t, findNodes(qry, plan.Node_AGG), 1)
+}
+
+func TestProjectionPruning(t *testing.T) {
+	sql := "select * from (select n_name, n_nationkey from nation) t"
+	stmts, err := mysql.Parse(sql)
+	require.NoError(t, err)
+	pn, err := BuildPlan(NewMockCompilerContext(), stmts[0])
+	require.NoError(t, err)
+
+	qry := runOptimize(t, sql)
+	require.Less(t, len(qry.Nodes), len(pn.GetQuery().Nodes))
+	require.Len(t, findNodes(qry, plan.Node_PROJECT), 1)
+}
+
+func TestIndexScan(t *testing.T) {
+	// point lookup of a unique index
+	qry := runOptimize(t, "select c_name from customer where c_phone = '27-918-335-1736'")
+	scans := findNodes(qry, plan.Node_TABLE_SCAN)
+	require.Equal(t, 1, len(scans))
+	require.NotNil(t, scans[0].IndexScan)
+	require.Equal(t, "c_phone_idx", scans[0].IndexScan.IndexDef.Name)
+	require.Equal(t, 1, len(scans[0].IndexScan.Keys))
+	require.NotEmpty(t, scans[0].FilterList)
+
+	qry = runOptimize(t, "select c_name from customer where c_phone in ('27-918-335-1736', '33-464-151-3439') and c_acctbal > 0")
+	scans = findNodes(qry, plan.Node_TABLE_SCAN)
+	require.NotNil(t, scans[0].IndexScan)
+	require.Equal(t, 2, len(scans[0].IndexScan.Keys))
+
+	// the leading column of a non unique index is constrained
+	qry = runOptimize(t, "select c_name from customer where c_mktsegment = 'BUILDING' and c_nationkey = 3")
+	scans = findNodes(qry, plan.Node_TABLE_SCAN)
+	require.NotNil(t, scans[0].IndexScan)
+	require.Equal(t, "c_segment_idx", scans[0].IndexScan.IndexDef.Name)
+	require.Empty(t, scans[0].IndexScan.Keys)
+	require.Equal(t, 2, len(scans[0].IndexScan.FilterList))
+
+	// a range on the whole table is cheaper to scan
+	qry = runOptimize(t, "select c_name from customer where c_mktsegment > 'BUILDING'")
+	require.Nil(t, findNodes(qry, plan.Node_TABLE_SCAN)[0].IndexScan)
+
+	qry = runOptimize(t, "select c_name from customer where c_nationkey = 3")
+	require.Nil(t, findNodes(qry, plan.Node_TABLE_SCAN)[0].IndexScan)
+
+	qry = runOptimize(t, "select c_name from customer where c_phone like '27%'")
+	require.Nil(t, findNodes(qry, plan.Node_TABLE_SCAN)[0].IndexScan)
+}
+
+func TestIndexMaintenance(t *testing.T) {
+	qry := runOptimize(t, "delete from customer where c_custkey = 1")
+	deletes := findNodes(qry, plan.Node_DELETE)
+	require.Equal(t, []string{"c_custkey", "c_phone", "c_mktsegment", "c_nationkey"}, deletes[0].DeleteTablesCtx[0].IndexAttrs)
+	require.Equal(t, int32(1), deletes[0].DeleteTablesCtx[0].IndexAttrsIdx)
+
+	qry = runOptimize(t, "update customer set c_phone = '0' where c_custkey = 1")
+	updates := findNodes(qry, plan.Node_UPDATE)
+	require.Equal(t, []string{"c_custkey", "c_phone"}, updates[0].UpdateCtxs[0].IndexAttrs)
+
+	qry = runOptimize(t, "update customer set c_comment = '' where c_custkey = 1")
+	updates = findNodes(qry, plan.Node_UPDATE)
+	require.Empty(t, updates[0].UpdateCtxs[0].IndexAttrs)
+
+	// the old values follow the columns of the table
+	ctx := updates[0].UpdateCtxs[0]
+	require.Equal(t, len(ctx.OrderAttrs)+1, len(qry.Nodes[updates[0].Children[0]].ProjectList))
+	qry = runOptimize(t, "update customer set c_phone = '0' where c_custkey = 1")
+	updates = findNodes(qry, plan.Node_UPDATE)
+	ctx = updates[0].UpdateCtxs[0]
+	require.Equal(t, len(ctx.OrderAttrs)+len(ctx.IndexAttrs)+1, len(qry.Nodes[updates[0].Children[0]].ProjectList))
+}
+
+func TestCostModel(t *testing.T) {
+	// n_nationkey is the primary key of 25 rows
+	qry := runOptimize(t, "select * from nation where n_nationkey = 1")
+	require.Equal(t, 1.0, findNodes(qry, plan.Node_TABLE_SCAN)[0].Cost.Card)
+
+	h := &plan.Histogram{
+		Lower: 0,
+		Buckets: []*plan.HistogramBucket{
+			{Upper: 10, Count: 10, Ndv: 10},
+			{Upper: 20, Count: 30, Ndv: 5},
+		},
+	}
+	require.Equal(t, 0.025, histogramEqualSelectivity(h, 5))
+	require.Equal(t, 0.15, histogramEqualSelectivity(h, 15))
+	require.Equal(t, 0.0, histogramEqualSelectivity(h, 25))
+	require.Equal(t, 0.125, histogramLessSelectivity(h, 5))
+	require.Equal(t, 0.625, histogramLessSelectivity(h, 15))
+	require.Equal(t, 1.0, histogramLessSelectivity(h, 25))
+
+	stats := &ColumnStats{HasRange: true, MinValue: 10, MaxValue: 20}
+	require.Equal(t, 0.0, rangeLessSelectivity(stats, 5))
+	require.Equal(t, 0.5, rangeLessSelectivity(stats, 15))
+	require.Equal(t, 1.0, rangeLessSelectivity(stats, 25))
+}
+
+func TestBuildHistogram(t *testing.T) {
+	require.Nil(t, BuildHistogram(nil, 4))
+
+	values := []float64{5, 1, 2, 2, 2, 2, 3, 4, 6, 7}
+	h := BuildHistogram(values, 4)
+	require.Equal(t, 1.0, h.Lower)
+	// the run of 2 is not split
+	require.Equal(t, []*plan.HistogramBucket{
+		{Upper: 2, Count: 5, Ndv: 2},
+		{Upper: 5, Count: 3, Ndv: 3},
+		{Upper: 7, Count: 2, Ndv: 2},
+	}, h.Buckets)
+	require.Equal(t, 0.25, histogramEqualSelectivity(h, 2))
+}
+
+func TestPolicyFilters(t *testing.T) {
+	ctx := NewMockCompilerContext()
+	ctx.policies = map[string][]string{
+		"nation-SELECT":   {"n_regionkey = 1", "n_name = 'CHINA'"},
+		"nation-DELETE":   {"n_nationkey > 10"},
+		"supplier-SELECT": {"s_nationkey in (select n_nationkey from nation)"},
+	}
+	optimize := func(sql string) (*Query, error) {
+		stmts, err := mysql.Parse(sql)
+		require.NoError(t, err)
+		return NewBaseOptimizer(ctx).Optimize(stmts[0])
+	}
+
+	// the policies are ORed and pushed down with the other filters
+	qry, err := optimize("select n_name from nation where n_nationkey < 5")
+	require.NoError(t, err)
+	scans := findNodes(qry, plan.Node_TABLE_SCAN)
+	require.Equal(t, 2, len(scans[0].FilterList))
+	require.Equal(t, "or", scans[0].FilterList[0].GetF().Func.ObjName)
+
+	qry, err = optimize("select r_name from region")
+	require.NoError(t, err)
+	require.Empty(t, findNodes(qry, plan.Node_TABLE_SCAN)[0].FilterList)
+
+	// the deleted rows are filtered by the DELETE policies instead of truncating the table
+	qry, err = optimize("delete from nation")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(findNodes(qry, plan.Node_TABLE_SCAN)[0].FilterList))
+
+	// there is no UPDATE policy
+	qry, err = optimize("update nation set n_comment = '' where n_nationkey = 1")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(findNodes(qry, plan.Node_TABLE_SCAN)[0].FilterList))
+
+	_, err = optimize("select s_name from supplier")
+	require.Error(t, err)
+}
+
+func TestOptimizeTPCH(t *testing.T) {
+	_, fn, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(fn)
+	for qn := 1; qn <= 22; qn++ {
+		qnf, err := os.ReadFile(fmt.Sprintf("%s/tpch/q%d.sql", dir, qn))
+		require.NoError(t, err)
+		qns, err := parsers.Parse(dialect.MYSQL, string(qnf))
+		require.NoError(t, err)
+		for _, ast := range qns {
+			_, err := NewBaseOptimizer(NewMockCompilerContext()).Optimize(ast)
+			require.NoError(t, err, "query %d", qn)
+		}
+	}
+}
+
+func runOptimize(t *testing.T, sql string) *Query {
+	stmts, err := mysql.Parse(sql)
+	require.NoError(t, err)
+	qry, err := NewBaseOptimizer(NewMockCompilerContext()).Optimize(stmts[0])
+	require.NoError(t, err)
+	return qry
+}
+
+func findNodes(qry *Query, typ plan.Node_NodeType) []*plan.Node {
+	var nodes []*plan.Node
+	for _, n := range qry.Nodes {
+		if n.NodeType == typ {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
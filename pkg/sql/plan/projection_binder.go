@@ -15,10 +15,15 @@
 package plan
 
 import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 )
 
 func NewProjectionBinder(builder *QueryBuilder, ctx *BindContext, havingBinder *HavingBinder) *ProjectionBinder {
@@ -59,6 +64,18 @@ func (b *ProjectionBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool)
 		}, nil
 	}
 
+	if colPos, ok := b.ctx.windowByAst[astStr]; ok {
+		return &plan.Expr{
+			Typ: b.ctx.windows[colPos].WindowFunc.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: b.ctx.windowTag,
+					ColPos: colPos,
+				},
+			},
+		}, nil
+	}
+
 	return b.baseBindExpr(astExpr, depth, isRoot)
 }
 
@@ -71,7 +88,201 @@ func (b *ProjectionBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr,
 }
 
 func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
-	return nil, errors.New("", "window functions will be supported in future version")
+	if b.insideWin {
+		return nil, errors.New(errno.WindowingError, "window function calls cannot be nested")
+	}
+	if astExpr.WindowSpec == nil {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("window function '%s' requires an OVER clause", funcName))
+	}
+	if !function.GetFunctionIsAggregateByName(funcName) && !function.GetFunctionIsWinfunByName(funcName) {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("OVER is specified but '%s' is not a window function nor an aggregate function", funcName))
+	}
+	if astExpr.Type == tree.FUNC_TYPE_DISTINCT {
+		return nil, errors.New(errno.FeatureNotSupported, "DISTINCT is not supported in window functions")
+	}
+
+	b.insideWin = true
+	spec, err := b.bindWindowSpec(funcName, astExpr, depth)
+	b.insideWin = false
+	if err != nil {
+		return nil, err
+	}
+
+	colPos := int32(len(b.ctx.windows))
+	astStr := tree.String(astExpr, dialect.MYSQL)
+	b.ctx.windowByAst[astStr] = colPos
+	b.ctx.windows = append(b.ctx.windows, spec)
+
+	return &plan.Expr{
+		Typ: spec.WindowFunc.Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: b.ctx.windowTag,
+				ColPos: colPos,
+			},
+		},
+	}, nil
+}
+
+func (b *ProjectionBinder) bindWindowSpec(funcName string, astExpr *tree.FuncExpr, depth int32) (*plan.WindowSpec, error) {
+	expr, err := b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
+	if err != nil {
+		return nil, err
+	}
+	spec := &plan.WindowSpec{
+		WindowFunc: expr,
+	}
+
+	ws := astExpr.WindowSpec
+	for _, e := range ws.PartitionBy {
+		expr, err = b.BindExpr(e, depth, false)
+		if err != nil {
+			return nil, err
+		}
+		spec.PartitionBy = append(spec.PartitionBy, expr)
+	}
+	for _, order := range ws.OrderBy {
+		expr, err = b.BindExpr(order.Expr, depth, false)
+		if err != nil {
+			return nil, err
+		}
+
+		orderBy := &plan.OrderBySpec{
+			Expr: expr,
+		}
+
+		switch order.Direction {
+		case tree.DefaultDirection:
+			orderBy.Flag = plan.OrderBySpec_INTERNAL
+		case tree.Ascending:
+			orderBy.Flag = plan.OrderBySpec_ASC
+		case tree.Descending:
+			orderBy.Flag = plan.OrderBySpec_DESC
+		}
+
+		spec.OrderBy = append(spec.OrderBy, orderBy)
+	}
+
+	spec.Frame, err = b.bindFrameClause(ws.Frame, spec.OrderBy, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// bindFrameClause binds the frame of a window, if there is no frame clause,
+// the frame is from the start of the partition to the last peer of the current row when ORDER BY is given,
+// or else the whole partition.
+func (b *ProjectionBinder) bindFrameClause(astFrame *tree.FrameClause, orderBy []*plan.OrderBySpec, depth int32) (*plan.FrameClause, error) {
+	if astFrame == nil {
+		if len(orderBy) > 0 {
+			return &plan.FrameClause{
+				Type:  plan.FrameClause_RANGE,
+				Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Unbounded: true},
+				End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+			}, nil
+		}
+		return &plan.FrameClause{
+			Type:  plan.FrameClause_ROWS,
+			Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Unbounded: true},
+			End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Unbounded: true},
+		}, nil
+	}
+
+	frame := &plan.FrameClause{
+		Type: plan.FrameClause_ROWS,
+	}
+	if astFrame.Type == tree.Range {
+		frame.Type = plan.FrameClause_RANGE
+	}
+
+	var err error
+	frame.Start, err = b.bindFrameBound(astFrame.Start, frame.Type, depth)
+	if err != nil {
+		return nil, err
+	}
+	if astFrame.HasEnd {
+		frame.End, err = b.bindFrameBound(astFrame.End, frame.Type, depth)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		frame.End = &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW}
+	}
+
+	if frame.Start.Unbounded && frame.Start.Type == plan.FrameBound_FOLLOWING {
+		return nil, errors.New(errno.WindowingError, "frame start cannot be UNBOUNDED FOLLOWING")
+	}
+	if frame.End.Unbounded && frame.End.Type == plan.FrameBound_PRECEDING {
+		return nil, errors.New(errno.WindowingError, "frame end cannot be UNBOUNDED PRECEDING")
+	}
+	if frameBoundOrder(frame.Start) > frameBoundOrder(frame.End) {
+		return nil, errors.New(errno.WindowingError, "frame starting from a later row cannot end with an earlier row")
+	}
+
+	if frame.Type == plan.FrameClause_RANGE && (frame.Start.Val != nil || frame.End.Val != nil) {
+		if len(orderBy) != 1 {
+			return nil, errors.New(errno.WindowingError, "RANGE with offset PRECEDING/FOLLOWING requires exactly one ORDER BY column")
+		}
+		typ := types.T(orderBy[0].Expr.Typ.Id)
+		if !typ.ToType().IsIntOrUint() && !typ.ToType().IsFloat() {
+			return nil, errors.New(errno.WindowingError, fmt.Sprintf("RANGE with offset PRECEDING/FOLLOWING does not support type '%s'", typ))
+		}
+	}
+
+	return frame, nil
+}
+
+func (b *ProjectionBinder) bindFrameBound(astBound *tree.FrameBound, typ plan.FrameClause_FrameType, depth int32) (*plan.FrameBound, error) {
+	bound := &plan.FrameBound{
+		Unbounded: astBound.UnBounded,
+	}
+	switch astBound.Type {
+	case tree.Preceding:
+		bound.Type = plan.FrameBound_PRECEDING
+	case tree.Following:
+		bound.Type = plan.FrameBound_FOLLOWING
+	case tree.CurrentRow:
+		bound.Type = plan.FrameBound_CURRENT_ROW
+	}
+	if astBound.UnBounded || astBound.Expr == nil {
+		return bound, nil
+	}
+
+	expr, err := b.BindExpr(astBound.Expr, depth, false)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := expr.Expr.(*plan.Expr_C); !ok {
+		return nil, errors.New(errno.WindowingError, "frame offset must be a constant")
+	}
+	t := types.T(expr.Typ.Id).ToType()
+	if typ == plan.FrameClause_ROWS && !t.IsIntOrUint() {
+		return nil, errors.New(errno.WindowingError, "ROWS frame offset must be an integer")
+	}
+	if !t.IsIntOrUint() && !t.IsFloat() {
+		return nil, errors.New(errno.WindowingError, "frame offset must be a number")
+	}
+	bound.Val = expr
+
+	return bound, nil
+}
+
+// frameBoundOrder is the order of bound types, a frame cannot start from a bound after its end.
+func frameBoundOrder(bound *plan.FrameBound) int {
+	switch {
+	case bound.Unbounded && bound.Type == plan.FrameBound_PRECEDING:
+		return 0
+	case bound.Type == plan.FrameBound_PRECEDING:
+		return 1
+	case bound.Type == plan.FrameBound_CURRENT_ROW:
+		return 2
+	case bound.Unbounded:
+		return 4
+	default:
+		return 3
+	}
 }
 
 func (b *ProjectionBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
@@ -34,10 +34,12 @@ func NewQueryBuilder(queryType plan.Query_StatementType, ctx CompilerContext) *Q
 		qry: &Query{
 			StmtType: queryType,
 		},
-		compCtx:      ctx,
-		ctxByNode:    []*BindContext{},
-		nameByColRef: make(map[[2]int32]string),
-		nextTag:      0,
+		compCtx:       ctx,
+		ctxByNode:     []*BindContext{},
+		nameByColRef:  make(map[[2]int32]string),
+		statsByTag:    make(map[int32]*TableStats),
+		tableDefByTag: make(map[int32]*TableDef),
+		nextTag:       0,
 	}
 }
 
@@ -162,7 +164,8 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 
 	case plan.Node_INTERSECT, plan.Node_INTERSECT_ALL,
 		plan.Node_UNION, plan.Node_UNION_ALL,
-		plan.Node_MINUS, plan.Node_MINUS_ALL:
+		plan.Node_MINUS, plan.Node_MINUS_ALL,
+		plan.Node_RECURSIVE_CTE:
 
 		thisTag := node.BindingTags[0]
 		leftID := node.Children[0]
@@ -480,6 +483,118 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			})
 		}
 
+	case plan.Node_WINDOW:
+		exprs := windowSpecExprs(node.WinSpec)
+		for _, expr := range exprs {
+			increaseRefCnt(expr, colRefCnt)
+		}
+
+		childRemapping, err := builder.remapAllColRefs(node.Children[0], colRefCnt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, expr := range exprs {
+			decreaseRefCnt(expr, colRefCnt)
+			err := builder.remapExpr(expr, childRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		childProjList := builder.qry.Nodes[node.Children[0]].ProjectList
+		for i, globalRef := range childRemapping.localToGlobal {
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: childProjList[i].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		// the window operator appends the result of window function after the columns of child
+		globalRef := [2]int32{node.BindingTags[0], node.WindowIdx}
+		if colRefCnt[globalRef] > 0 || len(node.ProjectList) == 0 {
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: node.WinSpec.WindowFunc.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(len(childProjList)),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+	case plan.Node_FUNCTION_SCAN:
+		increaseRefCnt(node.JsonTable.Doc, colRefCnt)
+
+		childRemapping, err := builder.remapAllColRefs(node.Children[0], colRefCnt)
+		if err != nil {
+			return nil, err
+		}
+
+		decreaseRefCnt(node.JsonTable.Doc, colRefCnt)
+		err = builder.remapExpr(node.JsonTable.Doc, childRemapping.globalToLocal)
+		if err != nil {
+			return nil, err
+		}
+
+		childProjList := builder.qry.Nodes[node.Children[0]].ProjectList
+		for i, globalRef := range childRemapping.localToGlobal {
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: childProjList[i].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		// the JSON_TABLE operator appends all its columns after the columns of child
+		tag := node.BindingTags[0]
+		for i, col := range node.TableDef.Cols {
+			globalRef := [2]int32{tag, int32(i)}
+			if colRefCnt[globalRef] == 0 && (i > 0 || len(node.ProjectList) > 0) {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: col.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(len(childProjList) + i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
 	case plan.Node_FILTER:
 		for _, expr := range node.FilterList {
 			increaseRefCnt(expr, colRefCnt)
@@ -950,8 +1065,9 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 			maskedNames = append(maskedNames, name)
 
 			ctx.cteByName[name] = &CTERef{
-				ast:        cte,
-				maskedCTEs: maskedCTEs,
+				isRecursive: stmt.With.IsRecursive,
+				ast:         cte,
+				maskedCTEs:  maskedCTEs,
 			}
 		}
 	}
@@ -1106,6 +1222,7 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	ctx.groupTag = builder.genNewTag()
 	ctx.aggregateTag = builder.genNewTag()
 	ctx.projectTag = builder.genNewTag()
+	ctx.windowTag = builder.genNewTag()
 
 	// bind GROUP BY clause
 	if clause.GroupBy != nil {
@@ -1271,6 +1388,23 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		}
 	}
 
+	// append WINDOW node
+	if len(ctx.windows) > 0 {
+		for i, w := range ctx.windows {
+			nodeID = builder.appendNode(&plan.Node{
+				NodeType:    plan.Node_WINDOW,
+				Children:    []int32{nodeID},
+				WinSpec:     w,
+				WindowIdx:   int32(i),
+				BindingTags: []int32{ctx.windowTag},
+			}, ctx)
+		}
+
+		for name, id := range ctx.windowByAst {
+			builder.nameByColRef[[2]int32{ctx.windowTag, id}] = name
+		}
+	}
+
 	// append PROJECT node
 	for i, proj := range ctx.projects {
 		nodeID, proj, err = builder.flattenSubqueries(nodeID, proj, ctx)
@@ -1366,7 +1500,7 @@ func (builder *QueryBuilder) appendNode(node *plan.Node, ctx *BindContext) int32
 		case plan.Node_INNER:
 			card := leftCost.Card * rightCost.Card
 			if len(node.OnList) > 0 {
-				card *= 0.1
+				card *= builder.estimateJoinSelectivity(node.OnList)
 			}
 			node.Cost = &plan.Cost{
 				Card: card,
@@ -1375,7 +1509,7 @@ func (builder *QueryBuilder) appendNode(node *plan.Node, ctx *BindContext) int32
 		case plan.Node_LEFT:
 			card := leftCost.Card * rightCost.Card
 			if len(node.OnList) > 0 {
-				card *= 0.1
+				card *= builder.estimateJoinSelectivity(node.OnList)
 				card += leftCost.Card
 			}
 			node.Cost = &plan.Cost{
@@ -1385,7 +1519,7 @@ func (builder *QueryBuilder) appendNode(node *plan.Node, ctx *BindContext) int32
 		case plan.Node_RIGHT:
 			card := leftCost.Card * rightCost.Card
 			if len(node.OnList) > 0 {
-				card *= 0.1
+				card *= builder.estimateJoinSelectivity(node.OnList)
 				card += rightCost.Card
 			}
 			node.Cost = &plan.Cost{
@@ -1395,7 +1529,7 @@ func (builder *QueryBuilder) appendNode(node *plan.Node, ctx *BindContext) int32
 		case plan.Node_OUTER:
 			card := leftCost.Card * rightCost.Card
 			if len(node.OnList) > 0 {
-				card *= 0.1
+				card *= builder.estimateJoinSelectivity(node.OnList)
 				card += leftCost.Card + rightCost.Card
 			}
 			node.Cost = &plan.Cost{
@@ -1417,7 +1551,7 @@ func (builder *QueryBuilder) appendNode(node *plan.Node, ctx *BindContext) int32
 		if len(node.GroupBy) > 0 {
 			childCost := builder.qry.Nodes[node.Children[0]].Cost
 			node.Cost = &plan.Cost{
-				Card: childCost.Card * 0.1,
+				Card: builder.estimateGroupCard(node.GroupBy, childCost.Card),
 			}
 		} else {
 			node.Cost = &plan.Cost{
@@ -1469,16 +1603,23 @@ func (builder *QueryBuilder) buildFrom(stmt tree.TableExprs, ctx *BindContext) (
 
 	for i := 1; i < len(stmt); i++ {
 		rightCtx := NewBindContext(builder, ctx)
-		rightChildID, err := builder.buildTable(stmt[i], rightCtx)
-		if err != nil {
-			return 0, err
-		}
+		if tbl, ok := jsonTableOf(stmt[i]); ok {
+			leftChildID, err = builder.buildJsonTable(tbl, leftChildID, leftCtx, rightCtx, false)
+			if err != nil {
+				return 0, err
+			}
+		} else {
+			rightChildID, err := builder.buildTable(stmt[i], rightCtx)
+			if err != nil {
+				return 0, err
+			}
 
-		leftChildID = builder.appendNode(&plan.Node{
-			NodeType: plan.Node_JOIN,
-			Children: []int32{leftChildID, rightChildID},
-			JoinType: plan.Node_INNER,
-		}, nil)
+			leftChildID = builder.appendNode(&plan.Node{
+				NodeType: plan.Node_JOIN,
+				Children: []int32{leftChildID, rightChildID},
+				JoinType: plan.Node_INNER,
+			}, nil)
+		}
 
 		if i == len(stmt)-1 {
 			builder.ctxByNode[leftChildID] = ctx
@@ -1537,15 +1678,26 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 					subCtx.defaultDatabase = cteRef.defaultDatabase
 				}
 
-				switch stmt := cteRef.ast.Stmt.(type) {
-				case *tree.Select:
-					nodeID, err = builder.buildSelect(stmt, subCtx, false)
+				var union *tree.UnionClause
+				if cteRef.isRecursive {
+					if union, err = getRecursiveUnion(cteRef.ast.Stmt, table); err != nil {
+						return
+					}
+				}
+
+				if union != nil {
+					nodeID, err = builder.buildRecursiveCTE(union, cteRef.ast.Name.Cols, subCtx)
+				} else {
+					switch stmt := cteRef.ast.Stmt.(type) {
+					case *tree.Select:
+						nodeID, err = builder.buildSelect(stmt, subCtx, false)
 
-				case *tree.ParenSelect:
-					nodeID, err = builder.buildSelect(stmt.Select, subCtx, false)
+					case *tree.ParenSelect:
+						nodeID, err = builder.buildSelect(stmt.Select, subCtx, false)
 
-				default:
-					err = errors.New("", fmt.Sprintf("unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL)))
+					default:
+						err = errors.New("", fmt.Sprintf("unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL)))
+					}
 				}
 
 				if err != nil {
@@ -1572,6 +1724,20 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 
 				break
 			}
+
+			if wt := ctx.findWorkTable(table); wt != nil {
+				nodeID = builder.appendNode(&plan.Node{
+					NodeType: plan.Node_MATERIAL_SCAN,
+					Cost:     &plan.Cost{Card: wt.card},
+					TableDef: &plan.TableDef{
+						Name: wt.tableDef.Name,
+						Cols: wt.tableDef.Cols,
+					},
+					BindingTags: []int32{builder.genNewTag()},
+				}, ctx)
+
+				break
+			}
 			schema = ctx.defaultDatabase
 		}
 
@@ -1648,13 +1814,30 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 			}
 		}
 
+		if nodeType == plan.Node_TABLE_SCAN {
+			builder.addPrimaryKeyDef(obj, tableDef)
+		}
+
+		cost := &plan.Cost{Card: 1}
+		if c := builder.compCtx.Cost(obj, nil); c != nil {
+			// the cost is updated by the filters pushed down, so don't share it between scans
+			cost.Card = c.Card
+			// a full scan reads every row
+			cost.Total = c.Card
+		}
 		nodeID = builder.appendNode(&plan.Node{
 			NodeType:    nodeType,
-			Cost:        builder.compCtx.Cost(obj, nil),
+			Cost:        cost,
 			ObjRef:      obj,
 			TableDef:    tableDef,
 			BindingTags: []int32{builder.genNewTag()},
 		}, ctx)
+		if nodeType == plan.Node_TABLE_SCAN {
+			builder.addTableStats(builder.qry.Nodes[nodeID])
+			if err = builder.addPolicyFilters(nodeID); err != nil {
+				return 0, err
+			}
+		}
 
 	case *tree.JoinTableExpr:
 		return builder.buildJoinTable(tbl, ctx)
@@ -1669,6 +1852,13 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 			}
 		}
 
+		if _, ok := tbl.Expr.(*tree.JsonTable); ok {
+			childID := builder.appendNode(&plan.Node{
+				NodeType: plan.Node_VALUE_SCAN,
+			}, ctx)
+			return builder.buildJsonTable(tbl, childID, ctx, ctx, false)
+		}
+
 		nodeID, err = builder.buildTable(tbl.Expr, ctx)
 		if err != nil {
 			return
@@ -1695,6 +1885,76 @@ func (builder *QueryBuilder) genNewTag() int32 {
 	return builder.nextTag
 }
 
+// addPrimaryKeyDef records the primary key in the table definition of a scan node,
+// so that the rules working on the final plan know which columns are unique.
+func (builder *QueryBuilder) addPrimaryKeyDef(obj *ObjectRef, tableDef *TableDef) {
+	if getPrimaryKeyNames(tableDef) != nil {
+		return
+	}
+	pkDefs := builder.compCtx.GetPrimaryKeyDef(obj.SchemaName, obj.ObjName)
+	if len(pkDefs) == 0 {
+		return
+	}
+	names := make([]string, len(pkDefs))
+	for i, pk := range pkDefs {
+		names[i] = pk.Name
+	}
+	tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Pk{
+			Pk: &plan.PrimaryKeyDef{
+				Names: names,
+			},
+		},
+	})
+}
+
+// addPolicyFilters filters the rows of a scan by the row-level security policies on the
+// table. The predicates of several policies are ORed, and put into the filters of the scan
+// before the filters are pushed down, so that they are also used to prune the blocks.
+func (builder *QueryBuilder) addPolicyFilters(nodeID int32) error {
+	node := builder.qry.Nodes[nodeID]
+	stmtType := plan.Query_SELECT
+	if typ, ok := builder.policyStmtTypes[node.ObjRef.SchemaName+"."+node.ObjRef.ObjName]; ok {
+		stmtType = typ
+	}
+	predicates, err := builder.compCtx.Policies(node.ObjRef, stmtType)
+	if err != nil || len(predicates) == 0 {
+		return err
+	}
+
+	var astExpr tree.Expr
+	for _, predicate := range predicates {
+		stmts, err := mysql.Parse("select " + predicate)
+		if err != nil {
+			return err
+		}
+		expr := stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr
+		if astExpr == nil {
+			astExpr = expr
+		} else {
+			astExpr = tree.NewOrExpr(astExpr, expr)
+		}
+	}
+
+	// the predicates only see the columns of the table
+	ctx := NewBindContext(builder, nil)
+	if err = builder.addBinding(nodeID, tree.AliasClause{}, ctx); err != nil {
+		return err
+	}
+	ctx.binder = NewWhereBinder(builder, ctx)
+	filters, err := splitAndBindCondition(astExpr, ctx)
+	if err != nil {
+		return err
+	}
+	for _, filter := range filters {
+		if hasSubquery(filter) {
+			return errors.New("", fmt.Sprintf("subquery in the policy of table %q is not supported", node.ObjRef.ObjName))
+		}
+	}
+	node.FilterList = append(node.FilterList, filters...)
+	return nil
+}
+
 func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ctx *BindContext) error {
 	node := builder.qry.Nodes[nodeID]
 
@@ -1706,7 +1966,7 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 	var types []*plan.Type
 	var binding *Binding
 
-	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN || node.NodeType == plan.Node_EXTERNAL_SCAN {
+	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN || node.NodeType == plan.Node_EXTERNAL_SCAN || node.NodeType == plan.Node_FUNCTION_SCAN {
 		if len(alias.Cols) > len(node.TableDef.Cols) {
 			return errors.New("", fmt.Sprintf("table %q has %d columns available but %d columns specified", alias.Alias, len(node.TableDef.Cols), len(alias.Cols)))
 		}
@@ -1811,6 +2071,10 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 		joinType = plan.Node_OUTER
 	}
 
+	if right, ok := jsonTableOf(tbl.Right); ok {
+		return builder.buildJoinJsonTable(tbl, right, joinType, ctx)
+	}
+
 	leftCtx := NewBindContext(builder, ctx)
 	rightCtx := NewBindContext(builder, ctx)
 
@@ -2113,8 +2377,67 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr)
 
 		node.Children[0] = childID
 
-	case plan.Node_TABLE_SCAN, plan.Node_EXTERNAL_SCAN:
+	case plan.Node_WINDOW:
+		// only the filters on partition keys can be evaluated before the window function
+		windowTag := node.BindingTags[0]
+
+		for _, filter := range filters {
+			if !containsTag(filter, windowTag) && onlyContainsExprs(filter, node.WinSpec.PartitionBy) {
+				canPushdown = append(canPushdown, filter)
+			} else {
+				cantPushdown = append(cantPushdown, filter)
+			}
+		}
+
+		childID, cantPushdownChild := builder.pushdownFilters(node.Children[0], canPushdown)
+
+		if len(cantPushdownChild) > 0 {
+			childID = builder.appendNode(&plan.Node{
+				NodeType:   plan.Node_FILTER,
+				Children:   []int32{node.Children[0]},
+				FilterList: cantPushdownChild,
+			}, nil)
+		}
+
+		node.Children[0] = childID
+
+	case plan.Node_FUNCTION_SCAN:
+		// the filters on the columns of JSON_TABLE are evaluated after it
+		tag := node.BindingTags[0]
+
+		for _, filter := range filters {
+			if containsTag(filter, tag) {
+				cantPushdown = append(cantPushdown, filter)
+			} else {
+				canPushdown = append(canPushdown, filter)
+			}
+		}
+
+		childID, cantPushdownChild := builder.pushdownFilters(node.Children[0], canPushdown)
+
+		if len(cantPushdownChild) > 0 {
+			childID = builder.appendNode(&plan.Node{
+				NodeType:   plan.Node_FILTER,
+				Children:   []int32{node.Children[0]},
+				FilterList: cantPushdownChild,
+			}, nil)
+		}
+
+		node.Children[0] = childID
+
+	case plan.Node_RECURSIVE_CTE:
+		// the rows of a recursive CTE are fed back into its recursive part, so the
+		// filters on them are kept above it
+		for i, childID := range node.Children {
+			node.Children[i], _ = builder.pushdownFilters(childID, nil)
+		}
+		cantPushdown = filters
+
+	case plan.Node_TABLE_SCAN, plan.Node_EXTERNAL_SCAN, plan.Node_MATERIAL_SCAN:
 		node.FilterList = append(node.FilterList, filters...)
+		for _, filter := range filters {
+			node.Cost.Card = clampCard(node.Cost.Card * builder.estimateSelectivity(filter))
+		}
 
 	default:
 		if len(node.Children) > 0 {
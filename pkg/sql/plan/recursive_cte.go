@@ -0,0 +1,242 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// A recursive CTE is built as a RECURSIVE_CTE node over its anchor part and its
+// recursive part. The recursive part reads the rows returned by its last run, the
+// anchor part for the first run, through a MATERIAL_SCAN node on the CTE.
+
+// defaultMaxRecursionDepth is the default of cte_max_recursion_depth.
+const defaultMaxRecursionDepth = 1000
+
+// getRecursiveUnion returns the UNION of the anchor part and the recursive part of a
+// CTE of WITH RECURSIVE, and nil if the CTE does not reference itself.
+func getRecursiveUnion(stmt tree.Statement, name string) (*tree.UnionClause, error) {
+	var sel *tree.Select
+	switch s := stmt.(type) {
+	case *tree.Select:
+		sel = s
+	case *tree.ParenSelect:
+		sel = s.Select
+	default:
+		return nil, nil
+	}
+	if !referencesTable(sel, name) {
+		return nil, nil
+	}
+
+	for {
+		if paren, ok := sel.Select.(*tree.ParenSelect); ok && sel.OrderBy == nil && sel.Limit == nil {
+			sel = paren.Select
+			continue
+		}
+		break
+	}
+	union, ok := sel.Select.(*tree.UnionClause)
+	if !ok || union.Type != tree.UNION {
+		return nil, errors.New("", fmt.Sprintf("recursive Common Table Expression %q should contain a UNION", name))
+	}
+	if referencesTable(union.Left, name) {
+		return nil, errors.New("", fmt.Sprintf("recursive Common Table Expression %q should have one or more non-recursive query blocks followed by one recursive query block", name))
+	}
+	if sel.OrderBy != nil || sel.Limit != nil {
+		return nil, errors.New("", fmt.Sprintf("ORDER BY / LIMIT in recursive Common Table Expression %q will be supported in future version", name))
+	}
+	return union, nil
+}
+
+// referencesTable reports whether the FROM clauses of the statement read the table.
+func referencesTable(stmt tree.SelectStatement, name string) bool {
+	switch s := stmt.(type) {
+	case *tree.Select:
+		return referencesTable(s.Select, name)
+	case *tree.ParenSelect:
+		return referencesTable(s.Select, name)
+	case *tree.UnionClause:
+		return referencesTable(s.Left, name) || referencesTable(s.Right, name)
+	case *tree.SelectClause:
+		if s.From == nil {
+			return false
+		}
+		for _, tbl := range s.From.Tables {
+			if tableExprReferences(tbl, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func tableExprReferences(expr tree.TableExpr, name string) bool {
+	switch tbl := expr.(type) {
+	case *tree.TableName:
+		return len(tbl.SchemaName) == 0 && string(tbl.ObjectName) == name
+	case *tree.AliasedTableExpr:
+		return tableExprReferences(tbl.Expr, name)
+	case *tree.ParenTableExpr:
+		return tableExprReferences(tbl.Expr, name)
+	case *tree.JoinTableExpr:
+		return tableExprReferences(tbl.Left, name) || (tbl.Right != nil && tableExprReferences(tbl.Right, name))
+	case *tree.Select:
+		return referencesTable(tbl, name)
+	}
+	return false
+}
+
+// buildRecursiveCTE builds the recursive CTE in ctx, which is the context of the CTE.
+func (builder *QueryBuilder) buildRecursiveCTE(stmt *tree.UnionClause, cols tree.IdentifierList, ctx *BindContext) (int32, error) {
+	name := ctx.cteName
+
+	anchorCtx := NewBindContext(builder, ctx)
+	anchorID, err := builder.buildSelect(&tree.Select{Select: stmt.Left}, anchorCtx, false)
+	if err != nil {
+		return 0, err
+	}
+	anchor := builder.qry.Nodes[anchorID]
+
+	if len(cols) > len(anchorCtx.headings) {
+		return 0, errors.New("", fmt.Sprintf("table %q has %d columns available but %d columns specified", name, len(anchorCtx.headings), len(cols)))
+	}
+	headings := make([]string, len(anchorCtx.headings))
+	copy(headings, anchorCtx.headings)
+	for i, col := range cols {
+		headings[i] = string(col)
+	}
+	// the columns of the work table are found by their names
+	seen := make(map[string]struct{})
+	for _, heading := range headings {
+		if _, ok := seen[heading]; ok {
+			return 0, errors.New("", fmt.Sprintf("duplicate column name %q in recursive Common Table Expression %q", heading, name))
+		}
+		seen[heading] = struct{}{}
+	}
+
+	// the types of the columns are decided by the anchor part
+	tableDef := &plan.TableDef{
+		Name: name,
+	}
+	for i, expr := range anchor.ProjectList {
+		tableDef.Cols = append(tableDef.Cols, &plan.ColDef{
+			Name: headings[i],
+			Typ:  expr.Typ,
+		})
+	}
+	ctx.workTable = &workTable{
+		tableDef: tableDef,
+		card:     anchor.Cost.Card,
+	}
+	recursiveCtx := NewBindContext(builder, ctx)
+	recursiveID, err := builder.buildSelect(&tree.Select{Select: stmt.Right}, recursiveCtx, false)
+	ctx.workTable = nil
+	if err != nil {
+		return 0, err
+	}
+	if anchorCtx.isCorrelated || recursiveCtx.isCorrelated {
+		return 0, errors.New("", "correlated column in CTE is will be supported in future version")
+	}
+
+	recursive := builder.qry.Nodes[recursiveID]
+	if len(recursive.ProjectList) != len(anchor.ProjectList) {
+		return 0, errors.New("", "The used SELECT statements have a different number of columns")
+	}
+	for i, expr := range recursive.ProjectList {
+		typ := makeTypeByPlan2Expr(anchor.ProjectList[i])
+		if makeTypeByPlan2Expr(expr).Eq(typ) {
+			continue
+		}
+		if recursive.ProjectList[i], err = appendCastBeforeExpr(expr, anchor.ProjectList[i].Typ); err != nil {
+			return 0, err
+		}
+	}
+
+	cteTag := builder.genNewTag()
+	anchorTag := anchor.BindingTags[0]
+	projectList := make([]*plan.Expr, len(anchor.ProjectList))
+	for i, expr := range anchor.ProjectList {
+		projectList[i] = &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: anchorTag,
+					ColPos: int32(i),
+				},
+			},
+		}
+	}
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_RECURSIVE_CTE,
+		Children:    []int32{anchorID, recursiveID},
+		BindingTags: []int32{cteTag},
+		ProjectList: projectList,
+		RecursiveCte: &plan.RecursiveCte{
+			Name:     name,
+			ColNames: headings,
+			UnionAll: stmt.All,
+			MaxDepth: builder.getMaxRecursionDepth(),
+		},
+	}, ctx)
+
+	ctx.groupTag = builder.genNewTag()
+	ctx.aggregateTag = builder.genNewTag()
+	ctx.projectTag = builder.genNewTag()
+	ctx.headings = headings
+	for i, heading := range headings {
+		ctx.aliasMap[heading] = int32(i)
+		builder.nameByColRef[[2]int32{cteTag, int32(i)}] = heading
+		builder.nameByColRef[[2]int32{ctx.projectTag, int32(i)}] = heading
+	}
+	for i, expr := range projectList {
+		ctx.projects = append(ctx.projects, &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: cteTag,
+					ColPos: int32(i),
+				},
+			},
+		})
+	}
+	ctx.results = ctx.projects
+
+	return builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		ProjectList: ctx.projects,
+		Children:    []int32{nodeID},
+		BindingTags: []int32{ctx.projectTag},
+	}, ctx), nil
+}
+
+// getMaxRecursionDepth returns cte_max_recursion_depth of the session.
+func (builder *QueryBuilder) getMaxRecursionDepth() int64 {
+	val, err := builder.compCtx.ResolveVariable("cte_max_recursion_depth", true, false)
+	if err != nil {
+		return defaultMaxRecursionDepth
+	}
+	switch v := val.(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	}
+	return defaultMaxRecursionDepth
+}
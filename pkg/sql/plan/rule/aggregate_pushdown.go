@@ -0,0 +1,231 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+)
+
+// finalAggs is the function merging the partial results of an aggregate function.
+var finalAggs = map[string]string{
+	"sum":       "sum",
+	"count":     "sum",
+	"starcount": "sum",
+	"min":       "min",
+	"max":       "max",
+}
+
+// AggregatePushdown evaluates the aggregate functions partially below an inner join,
+// if all of them use the columns of one side and the other side matches at most one row
+// for a row of that side, for example
+//
+//	select d.name, sum(f.v) from f join d on f.k = d.pk group by d.name
+//
+// f is grouped by f.k first, so the join reads one row for every key instead of every row of f.
+type AggregatePushdown struct {
+}
+
+func NewAggregatePushdown() *AggregatePushdown {
+	return &AggregatePushdown{}
+}
+
+// Match only accepts the aggregation with group by, because the final
+// aggregation of an empty input doesn't return the same result, e.g. sum(count) is null.
+func (r *AggregatePushdown) Match(n *plan.Node) bool {
+	return n.NodeType == plan.Node_AGG && len(n.GroupBy) > 0 && len(n.AggList) > 0
+}
+
+func (r *AggregatePushdown) Apply(n *plan.Node, qry *plan.Query) {
+	join := qry.Nodes[n.Children[0]]
+	if join.NodeType != plan.Node_JOIN || join.JoinType != plan.Node_INNER || len(join.FilterList) > 0 ||
+		join.Limit != nil || join.Offset != nil {
+		return
+	}
+
+	// all aggregate functions must read the same side of the join
+	side := int32(-1)
+	for _, agg := range n.AggList {
+		f, ok := agg.Expr.(*plan.Expr_F)
+		if !ok || uint64(f.F.Func.Obj)&function.Distinct != 0 {
+			return
+		}
+		if _, ok := finalAggs[f.F.Func.ObjName]; !ok {
+			return
+		}
+		valid := true
+		walkColRefs(agg, func(col *plan.ColRef) {
+			c, ok := getJoinSideCol(join, col.ColPos)
+			if !ok || (side >= 0 && c.RelPos != side) {
+				valid = false
+				return
+			}
+			side = c.RelPos
+		})
+		if !valid {
+			return
+		}
+	}
+	if side < 0 {
+		return
+	}
+	other := 1 - side
+
+	// the join conditions must be equalities of columns, which become group by columns of the partial aggregation
+	var conds []*plan.Expr
+	for _, cond := range join.OnList {
+		conds = append(conds, splitConjunction(cond)...)
+	}
+	sideCols := getEquiJoinCols(conds, side)
+	if len(sideCols) == 0 || len(sideCols) != len(conds) {
+		return
+	}
+	aggChild, otherChild := qry.Nodes[join.Children[side]], qry.Nodes[join.Children[other]]
+	if !isUniqueScan(otherChild, getEquiJoinCols(conds, other)) {
+		return
+	}
+	// it is not worth grouping the smaller side
+	if aggChild.Cost != nil && otherChild.Cost != nil && aggChild.Cost.Card <= otherChild.Cost.Card {
+		return
+	}
+
+	groupPos := make(map[int32]int32)
+	var groupCols []int32
+	addGroupCol := func(colPos int32) {
+		if _, ok := groupPos[colPos]; !ok {
+			groupPos[colPos] = int32(len(groupCols))
+			groupCols = append(groupCols, colPos)
+		}
+	}
+	for _, colPos := range sideCols {
+		addGroupCol(colPos)
+	}
+	valid := true
+	for _, e := range n.GroupBy {
+		walkColRefs(e, func(col *plan.ColRef) {
+			c, ok := getJoinSideCol(join, col.ColPos)
+			if !ok {
+				valid = false
+				return
+			}
+			if c.RelPos == side {
+				addGroupCol(c.ColPos)
+			}
+		})
+	}
+	if !valid {
+		return
+	}
+
+	// every column of the join output is rebuilt below, so all of them must come from a child
+	joinCols := make([]*plan.ColRef, len(join.ProjectList))
+	for i := range join.ProjectList {
+		c, ok := getJoinSideCol(join, int32(i))
+		if !ok {
+			return
+		}
+		joinCols[i] = c
+	}
+
+	// check the final aggregate functions before changing anything
+	finalFuncs := make([]*plan.ObjectRef, len(n.AggList))
+	for i, agg := range n.AggList {
+		name := finalAggs[agg.Expr.(*plan.Expr_F).F.Func.ObjName]
+		fid, typ, _, err := function.GetFunctionByName(name, []types.Type{makeType(agg.Typ)})
+		if err != nil || typ.Oid != types.T(agg.Typ.Id) || typ.Scale != agg.Typ.Scale {
+			return
+		}
+		finalFuncs[i] = &plan.ObjectRef{Obj: fid, ObjName: name}
+	}
+
+	// the partial aggregation outputs the group by columns and then the aggregate functions
+	partial := &plan.Node{
+		NodeType: plan.Node_AGG,
+		Children: []int32{aggChild.NodeId},
+		Cost:     &plan.Cost{Card: n.Cost.GetCard()},
+	}
+	for i, colPos := range groupCols {
+		typ := aggChild.ProjectList[colPos].Typ
+		partial.GroupBy = append(partial.GroupBy, makeColRef(typ, 0, colPos))
+		partial.ProjectList = append(partial.ProjectList, makeColRef(typ, -1, int32(i)))
+	}
+	for i, agg := range n.AggList {
+		e := cloneExpr(agg)
+		walkColRefs(e, func(col *plan.ColRef) {
+			if c, ok := getJoinSideCol(join, col.ColPos); ok {
+				col.RelPos, col.ColPos = 0, c.ColPos
+			} else {
+				valid = false
+			}
+		})
+		if !valid {
+			return
+		}
+		partial.AggList = append(partial.AggList, e)
+		partial.ProjectList = append(partial.ProjectList, makeColRef(agg.Typ, -2, int32(i)))
+	}
+	partial.NodeId = int32(len(qry.Nodes))
+	qry.Nodes = append(qry.Nodes, partial)
+
+	// rebuild the output of the join, the columns of the grouped side only used by aggregate functions are gone
+	newPos := make([]int32, len(join.ProjectList))
+	var projList []*plan.Expr
+	for i, e := range join.ProjectList {
+		c := joinCols[i]
+		newPos[i] = int32(len(projList))
+		if c.RelPos == other {
+			projList = append(projList, e)
+		} else if pos, ok := groupPos[c.ColPos]; ok {
+			projList = append(projList, makeColRef(e.Typ, side, pos))
+		} else {
+			newPos[i] = -1
+		}
+	}
+	aggPos := int32(len(projList))
+	for i, agg := range n.AggList {
+		projList = append(projList, makeColRef(agg.Typ, side, int32(len(groupCols)+i)))
+	}
+	for _, cond := range join.OnList {
+		walkColRefs(cond, func(col *plan.ColRef) {
+			if col.RelPos == side {
+				col.ColPos = groupPos[col.ColPos]
+			}
+		})
+	}
+	join.ProjectList = projList
+	join.Children[side] = partial.NodeId
+
+	for _, e := range n.GroupBy {
+		walkColRefs(e, func(col *plan.ColRef) {
+			col.ColPos = newPos[col.ColPos]
+		})
+	}
+	for i, agg := range n.AggList {
+		f := agg.Expr.(*plan.Expr_F)
+		f.F.Func = finalFuncs[i]
+		f.F.Args = []*plan.Expr{makeColRef(agg.Typ, 0, aggPos+int32(i))}
+	}
+}
+
+func makeType(typ *plan.Type) types.Type {
+	return types.Type{
+		Oid:       types.T(typ.Id),
+		Size:      typ.Size,
+		Width:     typ.Width,
+		Scale:     typ.Scale,
+		Precision: typ.Precision,
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	// indexScanSelectivity is the largest fraction of the rows read through an index range scan,
+	// a full scan is cheaper for a less selective predicate.
+	indexScanSelectivity = 0.1
+	// maxIndexLookupKeys limits the number of keys of a point lookup.
+	maxIndexLookupKeys = 1024
+)
+
+// IndexScan makes a table scan read the rows through a secondary index when the filters
+// constrain the leading column of the index. A unique index whose columns are all compared
+// with constants by = or IN is looked up by key, otherwise the entries are filtered by
+// the conditions on the index columns if they select a small part of the table.
+// The filters of the scan are kept, so the scan returns the same rows either way.
+type IndexScan struct {
+}
+
+func NewIndexScan() *IndexScan {
+	return &IndexScan{}
+}
+
+func (r *IndexScan) Match(n *plan.Node) bool {
+	if n.NodeType != plan.Node_TABLE_SCAN || n.IndexScan != nil || len(n.FilterList) == 0 || n.TableDef == nil {
+		return false
+	}
+	for _, def := range n.TableDef.Defs {
+		if idx, ok := def.Def.(*plan.TableDef_DefType_Idx); ok && idx.Idx.Typ == plan.IndexDef_SECONDARY {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *IndexScan) Apply(n *plan.Node, _ *plan.Query) {
+	var conds []*plan.Expr
+	for _, e := range n.FilterList {
+		conds = append(conds, splitConjunction(e)...)
+	}
+
+	var best *plan.IndexScan
+	bestCols := 0
+	for _, def := range n.TableDef.Defs {
+		idx, ok := def.Def.(*plan.TableDef_DefType_Idx)
+		if !ok || idx.Idx.Typ != plan.IndexDef_SECONDARY {
+			continue
+		}
+		if idx.Idx.Unique {
+			if keys := r.getLookupKeys(n, idx.Idx, conds); keys != nil {
+				best = &plan.IndexScan{IndexDef: idx.Idx, Keys: keys}
+				break
+			}
+		}
+		filters, cols := r.getIndexFilters(n, idx.Idx, conds)
+		if len(filters) > 0 && cols > bestCols {
+			best = &plan.IndexScan{IndexDef: idx.Idx, FilterList: filters}
+			bestCols = cols
+		}
+	}
+	if best == nil {
+		return
+	}
+	if len(best.Keys) == 0 {
+		if n.Cost == nil || n.Cost.Total <= 0 || n.Cost.Card > n.Cost.Total*indexScanSelectivity {
+			return
+		}
+	}
+	n.IndexScan = best
+	if n.Cost != nil {
+		n.Cost.Total = n.Cost.Card
+	}
+}
+
+// getLookupKeys returns the keys of a point lookup in a unique index, that is the combinations
+// of the constants compared with every index column, or nil if some column is not constrained so.
+func (r *IndexScan) getLookupKeys(n *plan.Node, def *plan.IndexDef, conds []*plan.Expr) []*plan.ExprList {
+	keys := []*plan.ExprList{{}}
+	for _, name := range def.ColNames {
+		var vals []*plan.Expr
+		for _, cond := range conds {
+			if vals = r.getEqualConsts(n, name, cond); vals != nil {
+				break
+			}
+		}
+		if vals == nil || len(keys)*len(vals) > maxIndexLookupKeys {
+			return nil
+		}
+		newKeys := make([]*plan.ExprList, 0, len(keys)*len(vals))
+		for _, key := range keys {
+			for _, val := range vals {
+				list := append(append([]*plan.Expr{}, key.List...), cloneExpr(val))
+				newKeys = append(newKeys, &plan.ExprList{List: list})
+			}
+		}
+		keys = newKeys
+	}
+	return keys
+}
+
+// getEqualConsts returns the constants of a condition col = c or an OR of them.
+func (r *IndexScan) getEqualConsts(n *plan.Node, name string, cond *plan.Expr) []*plan.Expr {
+	f, ok := cond.Expr.(*plan.Expr_F)
+	if !ok {
+		return nil
+	}
+	switch f.F.Func.ObjName {
+	case "=":
+		for i := 0; i < 2; i++ {
+			col, ok := f.F.Args[i].Expr.(*plan.Expr_Col)
+			if !ok || getScanColName(n, col.Col) != name {
+				continue
+			}
+			c, ok := f.F.Args[1-i].Expr.(*plan.Expr_C)
+			if !ok || c.C.Isnull || f.F.Args[1-i].Typ.Id != f.F.Args[i].Typ.Id {
+				return nil
+			}
+			return []*plan.Expr{f.F.Args[1-i]}
+		}
+	case "or":
+		l := r.getEqualConsts(n, name, f.F.Args[0])
+		if l == nil {
+			return nil
+		}
+		if rs := r.getEqualConsts(n, name, f.F.Args[1]); rs != nil {
+			return append(l, rs...)
+		}
+	}
+	return nil
+}
+
+// getIndexFilters returns the conditions which can be evaluated on the entries of the index,
+// with the column references pointing to the index columns, and the number of the columns
+// constrained by them. Nothing is returned if the leading column is not constrained.
+func (r *IndexScan) getIndexFilters(n *plan.Node, def *plan.IndexDef, conds []*plan.Expr) ([]*plan.Expr, int) {
+	colPos := make(map[string]int32, len(def.ColNames))
+	for i, name := range def.ColNames {
+		colPos[name] = int32(i)
+	}
+	var filters []*plan.Expr
+	used := make(map[string]struct{})
+	for _, cond := range conds {
+		if !isIndexCond(cond) {
+			continue
+		}
+		ok := true
+		names := make(map[string]struct{})
+		walkColRefs(cond, func(col *plan.ColRef) {
+			name := getScanColName(n, col)
+			if _, found := colPos[name]; !found {
+				ok = false
+			}
+			names[name] = struct{}{}
+		})
+		if !ok || len(names) == 0 {
+			continue
+		}
+		filter := cloneExpr(cond)
+		walkColRefs(filter, func(col *plan.ColRef) {
+			col.RelPos, col.ColPos = 0, colPos[getScanColName(n, col)]
+		})
+		filters = append(filters, filter)
+		for name := range names {
+			used[name] = struct{}{}
+		}
+	}
+	if _, ok := used[def.ColNames[0]]; !ok {
+		return nil, 0
+	}
+	return filters, len(used)
+}
+
+// isIndexCond reports whether a condition compares a column with a constant, or is an OR of them.
+func isIndexCond(cond *plan.Expr) bool {
+	f, ok := cond.Expr.(*plan.Expr_F)
+	if !ok {
+		return false
+	}
+	switch f.F.Func.ObjName {
+	case "=", "<", "<=", ">", ">=":
+		return isColumnOperand(f.F.Args[0]) && isConstOperand(f.F.Args[1]) ||
+			isConstOperand(f.F.Args[0]) && isColumnOperand(f.F.Args[1])
+	case "or":
+		return isIndexCond(f.F.Args[0]) && isIndexCond(f.F.Args[1])
+	}
+	return false
+}
+
+func isColumnOperand(e *plan.Expr) bool {
+	if f, ok := e.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "cast" {
+		e = f.F.Args[0]
+	}
+	_, ok := e.Expr.(*plan.Expr_Col)
+	return ok
+}
+
+func isConstOperand(e *plan.Expr) bool {
+	c, ok := e.Expr.(*plan.Expr_C)
+	return ok && !c.C.Isnull
+}
+
+func getScanColName(n *plan.Node, col *plan.ColRef) string {
+	if col.RelPos != 0 || int(col.ColPos) >= len(n.TableDef.Cols) {
+		return ""
+	}
+	return n.TableDef.Cols[col.ColPos].Name
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// JoinElimination removes a left join whose right side is not used and
+// matches at most one row for every left row, for example
+//
+//	select a.* from a left join b on a.x = b.pk
+//
+// the left join neither filters nor duplicates the rows of a, so it is the same as a.
+type JoinElimination struct {
+}
+
+func NewJoinElimination() *JoinElimination {
+	return &JoinElimination{}
+}
+
+func (r *JoinElimination) Match(n *plan.Node) bool {
+	return n.NodeType == plan.Node_JOIN && n.JoinType == plan.Node_LEFT
+}
+
+func (r *JoinElimination) Apply(n *plan.Node, qry *plan.Query) {
+	for _, e := range n.ProjectList {
+		used := false
+		walkColRefs(e, func(col *plan.ColRef) {
+			if col.RelPos != 0 {
+				used = true
+			}
+		})
+		if used {
+			return
+		}
+	}
+	for _, e := range n.FilterList {
+		used := false
+		walkColRefs(e, func(col *plan.ColRef) {
+			if col.RelPos != 0 {
+				used = true
+			}
+		})
+		if used {
+			return
+		}
+	}
+	var conds []*plan.Expr
+	for _, cond := range n.OnList {
+		conds = append(conds, splitConjunction(cond)...)
+	}
+	if !isUniqueScan(qry.Nodes[n.Children[1]], getEquiJoinCols(conds, 1)) {
+		return
+	}
+
+	// the column references of the left side are not changed
+	n.NodeType = plan.Node_PROJECT
+	n.JoinType = plan.Node_INNER
+	n.OnList = nil
+	n.Children = n.Children[:1]
+	if left := qry.Nodes[n.Children[0]]; left.Cost != nil {
+		n.Cost = &plan.Cost{Card: left.Cost.Card}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// LimitPushdown copies a constant limit without order by to the nodes below,
+// which produce no fewer rows than they read, so that the scans can stop early.
+// The limit of the original node is kept, a pushed limit is limit + offset.
+type LimitPushdown struct {
+}
+
+func NewLimitPushdown() *LimitPushdown {
+	return &LimitPushdown{}
+}
+
+func (r *LimitPushdown) Match(n *plan.Node) bool {
+	if n.Limit == nil || len(n.OrderBy) > 0 || len(n.FilterList) > 0 {
+		return false
+	}
+	return n.NodeType == plan.Node_PROJECT || n.NodeType == plan.Node_UNION_ALL
+}
+
+func (r *LimitPushdown) Apply(n *plan.Node, qry *plan.Query) {
+	limit, ok := getConstInt64(n.Limit)
+	if !ok {
+		return
+	}
+	if n.Offset != nil {
+		offset, ok := getConstInt64(n.Offset)
+		if !ok {
+			return
+		}
+		limit += offset
+	}
+	for _, childID := range n.Children {
+		r.pushdown(qry.Nodes[childID], makeConstInt64(n.Limit.Typ, limit), qry)
+	}
+}
+
+func (r *LimitPushdown) pushdown(n *plan.Node, limit *plan.Expr, qry *plan.Query) {
+	if n.Limit != nil || n.Offset != nil || len(n.OrderBy) > 0 || len(n.FilterList) > 0 {
+		return
+	}
+	switch n.NodeType {
+	case plan.Node_TABLE_SCAN:
+		n.Limit = limit
+	case plan.Node_PROJECT:
+		n.Limit = limit
+		r.pushdown(qry.Nodes[n.Children[0]], cloneExpr(limit), qry)
+	case plan.Node_UNION_ALL:
+		r.pushdown(qry.Nodes[n.Children[0]], cloneExpr(limit), qry)
+		r.pushdown(qry.Nodes[n.Children[1]], cloneExpr(limit), qry)
+	case plan.Node_JOIN:
+		// every row of the left side outputs at least one row
+		switch n.JoinType {
+		case plan.Node_LEFT:
+			r.pushdown(qry.Nodes[n.Children[0]], cloneExpr(limit), qry)
+		case plan.Node_RIGHT:
+			r.pushdown(qry.Nodes[n.Children[1]], cloneExpr(limit), qry)
+		}
+	}
+}
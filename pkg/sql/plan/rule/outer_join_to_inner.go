@@ -0,0 +1,124 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// strictFuncs return null when any argument is null.
+var strictFuncs = map[string]struct{}{
+	"=": {}, "<>": {}, "!=": {}, "<": {}, "<=": {}, ">": {}, ">=": {},
+	"+": {}, "-": {}, "*": {}, "/": {}, "div": {}, "%": {}, "unary_minus": {},
+	"like": {}, "cast": {},
+}
+
+// OuterJoinToInner turns a left or right join into an inner join if the rows padded with nulls
+// for its inner side are rejected by a filter or a join condition of the parent node, for example
+//
+//	select * from (a left join b on a.x = b.x) join c on b.y = c.y
+type OuterJoinToInner struct {
+}
+
+func NewOuterJoinToInner() *OuterJoinToInner {
+	return &OuterJoinToInner{}
+}
+
+func (r *OuterJoinToInner) Match(n *plan.Node) bool {
+	switch n.NodeType {
+	case plan.Node_JOIN:
+		return n.JoinType == plan.Node_INNER && len(n.OnList) > 0
+	case plan.Node_FILTER, plan.Node_PROJECT:
+		return len(n.FilterList) > 0
+	}
+	return false
+}
+
+func (r *OuterJoinToInner) Apply(n *plan.Node, qry *plan.Query) {
+	conds := n.FilterList
+	if n.NodeType == plan.Node_JOIN {
+		conds = n.OnList
+	}
+	for relPos, childID := range n.Children {
+		child := qry.Nodes[childID]
+		if child.NodeType != plan.Node_JOIN {
+			continue
+		}
+		// the side of the join padded with nulls
+		var nullSide int32
+		switch child.JoinType {
+		case plan.Node_LEFT:
+			nullSide = 1
+		case plan.Node_RIGHT:
+			nullSide = 0
+		default:
+			continue
+		}
+		// the columns of n which are from the null padded side of the join
+		isNullSideCol := func(col *plan.ColRef) bool {
+			if col.RelPos != int32(relPos) {
+				return false
+			}
+			c, ok := getJoinSideCol(child, col.ColPos)
+			return ok && c.RelPos == nullSide
+		}
+		for _, cond := range conds {
+			if rejectsNull(cond, isNullSideCol) {
+				child.JoinType = plan.Node_INNER
+				break
+			}
+		}
+	}
+}
+
+// rejectsNull reports whether the filter is false or null when the columns are null.
+func rejectsNull(e *plan.Expr, isNullCol func(*plan.ColRef) bool) bool {
+	f, ok := e.Expr.(*plan.Expr_F)
+	if !ok {
+		return isNullIfNullCol(e, isNullCol)
+	}
+	switch f.F.Func.ObjName {
+	case "and":
+		return rejectsNull(f.F.Args[0], isNullCol) || rejectsNull(f.F.Args[1], isNullCol)
+	case "or":
+		return rejectsNull(f.F.Args[0], isNullCol) && rejectsNull(f.F.Args[1], isNullCol)
+	case "not":
+		// not (x is null)
+		if g, ok := f.F.Args[0].Expr.(*plan.Expr_F); ok && (g.F.Func.ObjName == "isnull" || g.F.Func.ObjName == "is_null") {
+			return isNullIfNullCol(g.F.Args[0], isNullCol)
+		}
+		return isNullIfNullCol(f.F.Args[0], isNullCol)
+	default:
+		return isNullIfNullCol(e, isNullCol)
+	}
+}
+
+// isNullIfNullCol reports whether the expression is null when the columns are null.
+func isNullIfNullCol(e *plan.Expr, isNullCol func(*plan.ColRef) bool) bool {
+	switch ex := e.Expr.(type) {
+	case *plan.Expr_Col:
+		return isNullCol(ex.Col)
+	case *plan.Expr_F:
+		if _, ok := strictFuncs[ex.F.Func.ObjName]; !ok {
+			return false
+		}
+		for _, arg := range ex.F.Args {
+			if isNullIfNullCol(arg, isNullCol) {
+				return true
+			}
+		}
+	}
+	return false
+}
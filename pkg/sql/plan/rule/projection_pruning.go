@@ -0,0 +1,124 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// ProjectionPruning removes the projections which do nothing:
+// a projection that outputs the columns of its child as they are is skipped by its parent,
+// and a projection over another projection is merged if no expression is evaluated twice.
+type ProjectionPruning struct {
+}
+
+func NewProjectionPruning() *ProjectionPruning {
+	return &ProjectionPruning{}
+}
+
+func (r *ProjectionPruning) Match(n *plan.Node) bool {
+	switch n.NodeType {
+	case plan.Node_PROJECT, plan.Node_FILTER, plan.Node_AGG, plan.Node_SORT,
+		plan.Node_JOIN, plan.Node_WINDOW, plan.Node_DISTINCT, plan.Node_FUNCTION_SCAN:
+		return true
+	}
+	return false
+}
+
+func (r *ProjectionPruning) Apply(n *plan.Node, qry *plan.Query) {
+	for i, childID := range n.Children {
+		if child := qry.Nodes[childID]; isIdentityProjection(child, qry) {
+			n.Children[i] = child.Children[0]
+		}
+	}
+	if n.NodeType == plan.Node_PROJECT {
+		r.merge(n, qry)
+	}
+}
+
+// merge replaces the column references of n with the expressions of its child projection.
+func (r *ProjectionPruning) merge(n *plan.Node, qry *plan.Query) {
+	child := qry.Nodes[n.Children[0]]
+	if child.NodeType != plan.Node_PROJECT || !isPlainNode(child) {
+		return
+	}
+	refCnt := make([]int, len(child.ProjectList))
+	for _, e := range n.ProjectList {
+		walkColRefs(e, func(col *plan.ColRef) { refCnt[col.ColPos]++ })
+	}
+	for _, e := range n.FilterList {
+		walkColRefs(e, func(col *plan.ColRef) { refCnt[col.ColPos]++ })
+	}
+	for i, e := range child.ProjectList {
+		if refCnt[i] > 1 && !isCheapExpr(e) {
+			return
+		}
+	}
+	for i := range n.ProjectList {
+		n.ProjectList[i] = replaceColRefs(n.ProjectList[i], child.ProjectList)
+	}
+	for i := range n.FilterList {
+		n.FilterList[i] = replaceColRefs(n.FilterList[i], child.ProjectList)
+	}
+	n.Children[0] = child.Children[0]
+}
+
+// isIdentityProjection reports whether n outputs the columns of its child in the same order.
+func isIdentityProjection(n *plan.Node, qry *plan.Query) bool {
+	if n.NodeType != plan.Node_PROJECT || !isPlainNode(n) {
+		return false
+	}
+	child := qry.Nodes[n.Children[0]]
+	if len(n.ProjectList) != len(child.ProjectList) {
+		return false
+	}
+	for i, e := range n.ProjectList {
+		col, ok := e.Expr.(*plan.Expr_Col)
+		if !ok || col.Col.RelPos != 0 || col.Col.ColPos != int32(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// isPlainNode reports whether n has a single child and does nothing but projecting.
+func isPlainNode(n *plan.Node) bool {
+	return len(n.Children) == 1 && len(n.FilterList) == 0 && len(n.OrderBy) == 0 &&
+		n.Limit == nil && n.Offset == nil
+}
+
+func isCheapExpr(e *plan.Expr) bool {
+	switch e.Expr.(type) {
+	case *plan.Expr_Col, *plan.Expr_C, *plan.Expr_P, *plan.Expr_V:
+		return true
+	}
+	return false
+}
+
+func replaceColRefs(e *plan.Expr, exprs []*plan.Expr) *plan.Expr {
+	switch ex := e.Expr.(type) {
+	case *plan.Expr_Col:
+		return cloneExpr(exprs[ex.Col.ColPos])
+	case *plan.Expr_F:
+		for i, arg := range ex.F.Args {
+			ex.F.Args[i] = replaceColRefs(arg, exprs)
+		}
+	case *plan.Expr_List:
+		for i, arg := range ex.List.List {
+			ex.List.List[i] = replaceColRefs(arg, exprs)
+		}
+	}
+	return e
+}
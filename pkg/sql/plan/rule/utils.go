@@ -0,0 +1,159 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// The rules work on the final plan, in which a column reference is (RelPos, ColPos):
+// RelPos is the index of the child and ColPos is the index in the ProjectList of that child,
+// a scan node refers to its TableDef.Cols with RelPos 0.
+
+func cloneExpr(e *plan.Expr) *plan.Expr {
+	return proto.Clone(e).(*plan.Expr)
+}
+
+func splitConjunction(e *plan.Expr) []*plan.Expr {
+	if f, ok := e.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "and" {
+		return append(splitConjunction(f.F.Args[0]), splitConjunction(f.F.Args[1])...)
+	}
+	return []*plan.Expr{e}
+}
+
+// walkColRefs calls fn for every column reference in the expression.
+func walkColRefs(e *plan.Expr, fn func(*plan.ColRef)) {
+	switch ex := e.Expr.(type) {
+	case *plan.Expr_Col:
+		fn(ex.Col)
+	case *plan.Expr_F:
+		for _, arg := range ex.F.Args {
+			walkColRefs(arg, fn)
+		}
+	case *plan.Expr_List:
+		for _, arg := range ex.List.List {
+			walkColRefs(arg, fn)
+		}
+	}
+}
+
+// getConstInt64 returns the value of a constant int64 expression.
+func getConstInt64(e *plan.Expr) (int64, bool) {
+	if e == nil {
+		return 0, false
+	}
+	c, ok := e.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return 0, false
+	}
+	if v, ok := c.C.Value.(*plan.Const_Ival); ok {
+		return v.Ival, true
+	}
+	return 0, false
+}
+
+func makeConstInt64(typ *plan.Type, v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ: proto.Clone(typ).(*plan.Type),
+		Expr: &plan.Expr_C{
+			C: &plan.Const{
+				Value: &plan.Const_Ival{Ival: v},
+			},
+		},
+	}
+}
+
+func makeColRef(typ *plan.Type, relPos, colPos int32) *plan.Expr {
+	return &plan.Expr{
+		Typ: proto.Clone(typ).(*plan.Type),
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: relPos,
+				ColPos: colPos,
+			},
+		},
+	}
+}
+
+// getJoinSideCol resolves a column of the join output to the child it comes from.
+func getJoinSideCol(join *plan.Node, colPos int32) (*plan.ColRef, bool) {
+	if int(colPos) >= len(join.ProjectList) {
+		return nil, false
+	}
+	col, ok := join.ProjectList[colPos].Expr.(*plan.Expr_Col)
+	if !ok || col.Col.RelPos < 0 || col.Col.RelPos > 1 {
+		return nil, false
+	}
+	return col.Col, true
+}
+
+// isUniqueScan reports whether a scan node outputs at most one row for the given columns,
+// that is they cover the primary key of the table.
+func isUniqueScan(n *plan.Node, cols []int32) bool {
+	if n.NodeType != plan.Node_TABLE_SCAN || n.Limit != nil || n.Offset != nil {
+		return false
+	}
+	var pkNames []string
+	for _, def := range n.TableDef.Defs {
+		if pk, ok := def.Def.(*plan.TableDef_DefType_Pk); ok {
+			pkNames = pk.Pk.Names
+			break
+		}
+	}
+	if len(pkNames) == 0 {
+		return false
+	}
+	names := make(map[string]struct{}, len(cols))
+	for _, colPos := range cols {
+		if int(colPos) >= len(n.ProjectList) {
+			return false
+		}
+		col, ok := n.ProjectList[colPos].Expr.(*plan.Expr_Col)
+		if !ok || int(col.Col.ColPos) >= len(n.TableDef.Cols) {
+			continue
+		}
+		names[n.TableDef.Cols[col.Col.ColPos].Name] = struct{}{}
+	}
+	for _, name := range pkNames {
+		if _, ok := names[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// getEquiJoinCols returns the columns of the given side in the conditions of form l = r,
+// a condition referring to both sides in other forms is ignored.
+func getEquiJoinCols(conds []*plan.Expr, side int32) []int32 {
+	var cols []int32
+	for _, cond := range conds {
+		f, ok := cond.Expr.(*plan.Expr_F)
+		if !ok || f.F.Func.ObjName != "=" {
+			continue
+		}
+		l, lok := f.F.Args[0].Expr.(*plan.Expr_Col)
+		r, rok := f.F.Args[1].Expr.(*plan.Expr_Col)
+		if !lok || !rok || l.Col.RelPos == r.Col.RelPos {
+			continue
+		}
+		if l.Col.RelPos == side {
+			cols = append(cols, l.Col.ColPos)
+		} else if r.Col.RelPos == side {
+			cols = append(cols, r.Col.ColPos)
+		}
+	}
+	return cols
+}
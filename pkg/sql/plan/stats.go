@@ -0,0 +1,93 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// histogramEqualSelectivity returns the estimated fraction of non-null values which equal v.
+func histogramEqualSelectivity(h *plan.Histogram, v float64) float64 {
+	total := histogramTotal(h)
+	if total == 0 || v < h.Lower {
+		return 0
+	}
+	i := sort.Search(len(h.Buckets), func(i int) bool { return h.Buckets[i].Upper >= v })
+	if i == len(h.Buckets) {
+		return 0
+	}
+	b := h.Buckets[i]
+	if b.Ndv < 1 {
+		return b.Count / total
+	}
+	return b.Count / b.Ndv / total
+}
+
+// histogramLessSelectivity returns the estimated fraction of non-null values which are less than v,
+// values are assumed to be evenly distributed in a bucket.
+func histogramLessSelectivity(h *plan.Histogram, v float64) float64 {
+	total := histogramTotal(h)
+	if total == 0 || v <= h.Lower {
+		return 0
+	}
+	var count float64
+	lower := h.Lower
+	for _, b := range h.Buckets {
+		if v > b.Upper {
+			count += b.Count
+			lower = b.Upper
+			continue
+		}
+		if b.Upper > lower {
+			count += b.Count * (v - lower) / (b.Upper - lower)
+		}
+		break
+	}
+	return count / total
+}
+
+func histogramTotal(h *plan.Histogram) float64 {
+	var total float64
+	for _, b := range h.Buckets {
+		total += b.Count
+	}
+	return total
+}
+
+// BuildHistogram builds an equi-depth histogram with at most the given number of buckets,
+// the values are sorted in place. Equal values are always put into the same bucket.
+func BuildHistogram(values []float64, buckets int) *plan.Histogram {
+	if len(values) == 0 || buckets <= 0 {
+		return nil
+	}
+	sort.Float64s(values)
+	depth := (len(values) + buckets - 1) / buckets
+	h := &plan.Histogram{Lower: values[0]}
+	b := &plan.HistogramBucket{}
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			b.Ndv++
+		}
+		b.Count++
+		b.Upper = v
+		if i == len(values)-1 || (b.Count >= float64(depth) && values[i+1] != v) {
+			h.Buckets = append(h.Buckets, b)
+			b = &plan.HistogramBucket{}
+		}
+	}
+	return h
+}
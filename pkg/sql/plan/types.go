@@ -35,6 +35,9 @@ type ColDef = plan.ColDef
 type ObjectRef = plan.ObjectRef
 type ColRef = plan.ColRef
 type Cost = plan.Cost
+type TableStats = plan.TableStats
+type ColumnStats = plan.ColumnStats
+type Histogram = plan.Histogram
 type Const = plan.Const
 type MaxValue = plan.MaxValue
 type Expr = plan.Expr
@@ -48,9 +51,12 @@ type Property = plan.Property
 type TableDef_DefType_Properties = plan.TableDef_DefType_Properties
 type TableDef_DefType_View = plan.TableDef_DefType_View
 type TableDef_DefType_Partition = plan.TableDef_DefType_Partition
+type TableDef_DefType_Idx = plan.TableDef_DefType_Idx
+type TableDef_DefType_Constraint = plan.TableDef_DefType_Constraint
 type PropertiesDef = plan.PropertiesDef
 type ViewDef = plan.ViewDef
 type PartitionInfo = plan.PartitionInfo
+type ConstraintDef = plan.ConstraintDef
 
 type CompilerContext interface {
 	// Default database/schema in context
@@ -67,6 +73,11 @@ type CompilerContext interface {
 	GetHideKeyDef(dbName string, tableName string) *ColDef
 	// get estimated cost by table & expr
 	Cost(obj *ObjectRef, e *Expr) *Cost
+	// get the statistics of the table, nil if the table has not been analyzed
+	Stats(obj *ObjectRef) *TableStats
+	// get the predicates of the row-level security policies on the table applying to
+	// the current roles for the statement type
+	Policies(obj *ObjectRef, stmtType plan.Query_StatementType) ([]string, error)
 	// get origin sql string of the root
 	GetRootSql() string
 }
@@ -118,15 +129,30 @@ type QueryBuilder struct {
 	ctxByNode    []*BindContext
 	nameByColRef map[[2]int32]string
 
+	// statistics of the scanned tables, indexed by binding tag
+	statsByTag    map[int32]*TableStats
+	tableDefByTag map[int32]*TableDef
+
+	// the statement type of the policies filtering the scans of the modified
+	// tables indexed by db.table, the scans of other tables use the SELECT policies
+	policyStmtTypes map[string]plan.Query_StatementType
+
 	nextTag int32
 }
 
 type CTERef struct {
 	defaultDatabase string
+	isRecursive     bool
 	ast             *tree.CTE
 	maskedCTEs      map[string]any
 }
 
+// workTable is the rows returned by the last run of the recursive part of a recursive CTE.
+type workTable struct {
+	tableDef *plan.TableDef
+	card     float64
+}
+
 type BindContext struct {
 	binder Binder
 
@@ -136,19 +162,25 @@ type BindContext struct {
 	cteName  string
 	headings []string
 
+	// workTable is set while the recursive part of the recursive CTE cteName is built
+	workTable *workTable
+
 	groupTag     int32
 	aggregateTag int32
 	projectTag   int32
 	resultTag    int32
+	windowTag    int32
 
 	groups     []*plan.Expr
 	aggregates []*plan.Expr
 	projects   []*plan.Expr
 	results    []*plan.Expr
+	windows    []*plan.WindowSpec
 
 	groupByAst     map[string]int32
 	aggregateByAst map[string]int32
 	projectByExpr  map[string]int32
+	windowByAst    map[string]int32
 
 	aliasMap map[string]int32
 
@@ -225,6 +257,7 @@ type HavingBinder struct {
 type ProjectionBinder struct {
 	baseBinder
 	havingBinder *HavingBinder
+	insideWin    bool
 }
 
 type OrderBinder struct {
@@ -240,6 +273,10 @@ type PartitionBinder struct {
 	baseBinder
 }
 
+type CheckBinder struct {
+	baseBinder
+}
+
 var _ Binder = (*TableBinder)(nil)
 var _ Binder = (*WhereBinder)(nil)
 var _ Binder = (*GroupBinder)(nil)
@@ -247,6 +284,7 @@ var _ Binder = (*HavingBinder)(nil)
 var _ Binder = (*ProjectionBinder)(nil)
 var _ Binder = (*LimitBinder)(nil)
 var _ Binder = (*PartitionBinder)(nil)
+var _ Binder = (*CheckBinder)(nil)
 
 const (
 	NotFound      int32 = math.MaxInt32
@@ -124,12 +124,13 @@ func DeepCopyExpr(expr *Expr) *Expr {
 	}
 	newExpr := &Expr{
 		Typ: &plan.Type{
-			Id:        expr.Typ.GetId(),
-			Nullable:  expr.Typ.GetNullable(),
-			Width:     expr.Typ.GetWidth(),
-			Precision: expr.Typ.GetPrecision(),
-			Size:      expr.Typ.GetSize(),
-			Scale:     expr.Typ.GetScale(),
+			Id:         expr.Typ.GetId(),
+			Nullable:   expr.Typ.GetNullable(),
+			Width:      expr.Typ.GetWidth(),
+			Precision:  expr.Typ.GetPrecision(),
+			Size:       expr.Typ.GetSize(),
+			Scale:      expr.Typ.GetScale(),
+			Enumvalues: expr.Typ.GetEnumvalues(),
 		},
 	}
 
@@ -256,6 +257,56 @@ func containsTag(expr *plan.Expr, tag int32) bool {
 	return ret
 }
 
+func hasSubquery(expr *plan.Expr) bool {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Sub:
+		return true
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if hasSubquery(arg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// onlyContainsExprs checks whether all the column references of expr are in exprs.
+func onlyContainsExprs(expr *plan.Expr, exprs []*plan.Expr) bool {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if !onlyContainsExprs(arg, exprs) {
+				return false
+			}
+		}
+
+	case *plan.Expr_Col:
+		for _, e := range exprs {
+			if col, ok := e.Expr.(*plan.Expr_Col); ok && col.Col.RelPos == exprImpl.Col.RelPos && col.Col.ColPos == exprImpl.Col.ColPos {
+				return true
+			}
+		}
+		return false
+
+	case *plan.Expr_Sub, *plan.Expr_Corr:
+		return false
+	}
+
+	return true
+}
+
+// windowSpecExprs returns the window function and the expressions of partition and order.
+func windowSpecExprs(spec *plan.WindowSpec) []*plan.Expr {
+	exprs := make([]*plan.Expr, 0, 1+len(spec.PartitionBy)+len(spec.OrderBy))
+	exprs = append(exprs, spec.WindowFunc)
+	exprs = append(exprs, spec.PartitionBy...)
+	for _, orderBy := range spec.OrderBy {
+		exprs = append(exprs, orderBy.Expr)
+	}
+	return exprs
+}
+
 func replaceColRefs(expr *plan.Expr, tag int32, projects []*plan.Expr) *plan.Expr {
 	switch exprImpl := expr.Expr.(type) {
 	case *plan.Expr_F:
@@ -655,3 +706,13 @@ func isConstant(e *plan.Expr) bool {
 		return false
 	}
 }
+
+// getPrimaryKeyNames returns the primary key columns recorded in the table definition.
+func getPrimaryKeyNames(tableDef *TableDef) []string {
+	for _, def := range tableDef.Defs {
+		if pk, ok := def.Def.(*plan.TableDef_DefType_Pk); ok {
+			return pk.Pk.Names
+		}
+	}
+	return nil
+}
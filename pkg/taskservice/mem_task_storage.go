@@ -245,5 +245,12 @@ func (s *memTaskStorage) filter(c conditions, task task.Task) bool {
 			ok = task.Epoch < c.taskEpoch
 		}
 	}
+
+	if ok && c.hasTaskMetadataIDCond {
+		switch c.taskMetadataIDOp {
+		case EQ:
+			ok = task.Metadata.ID == c.taskMetadataID
+		}
+	}
 	return ok
 }
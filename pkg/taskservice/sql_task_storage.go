@@ -0,0 +1,638 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskservice
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	// TaskDatabase is the database of the task tables
+	TaskDatabase = "mo_task"
+	// AsyncTaskTable is the table of the async tasks
+	AsyncTaskTable = "sys_async_task"
+	// CronTaskTable is the table of the cron tasks
+	CronTaskTable = "sys_cron_task"
+
+	// queryPageSize is the number of rows read by a query at a time, the internal
+	// executor keeps at most about 100 rows of a result set.
+	queryPageSize = 100
+)
+
+var (
+	sqlCreateTaskDatabase = fmt.Sprintf("create database if not exists %s", TaskDatabase)
+
+	sqlCreateAsyncTaskTable = fmt.Sprintf(`create table %s.%s(
+ task_id bigint primary key auto_increment,
+ task_metadata_id varchar(1024) not null,
+ task_metadata_executor int unsigned not null,
+ task_metadata_context text not null comment 'hex encoded',
+ task_metadata_option varchar(1024) not null comment 'json encoded',
+ task_parent_id varchar(1024) not null,
+ task_status int not null,
+ task_runner varchar(1024) not null,
+ task_epoch int unsigned not null,
+ last_heartbeat bigint not null,
+ result_code int not null,
+ error_msg text not null,
+ create_at bigint not null,
+ end_at bigint not null
+)`, TaskDatabase, AsyncTaskTable)
+	sqlCreateAsyncTaskIndex = fmt.Sprintf("create unique index idx_task_metadata_id on %s.%s(task_metadata_id)",
+		TaskDatabase, AsyncTaskTable)
+
+	sqlCreateCronTaskTable = fmt.Sprintf(`create table %s.%s(
+ cron_task_id bigint primary key auto_increment,
+ task_metadata_id varchar(1024) not null,
+ task_metadata_executor int unsigned not null,
+ task_metadata_context text not null comment 'hex encoded',
+ task_metadata_option varchar(1024) not null comment 'json encoded',
+ cron_expr varchar(1024) not null,
+ next_time bigint not null,
+ create_at bigint not null,
+ update_at bigint not null
+)`, TaskDatabase, CronTaskTable)
+	sqlCreateCronTaskIndex = fmt.Sprintf("create unique index idx_task_metadata_id on %s.%s(task_metadata_id)",
+		TaskDatabase, CronTaskTable)
+
+	asyncTaskColumns = []string{
+		"task_id",
+		"task_metadata_id",
+		"task_metadata_executor",
+		"task_metadata_context",
+		"task_metadata_option",
+		"task_parent_id",
+		"task_status",
+		"task_runner",
+		"task_epoch",
+		"last_heartbeat",
+		"result_code",
+		"error_msg",
+		"create_at",
+		"end_at",
+	}
+	cronTaskColumns = []string{
+		"cron_task_id",
+		"task_metadata_id",
+		"task_metadata_executor",
+		"task_metadata_context",
+		"task_metadata_option",
+		"cron_expr",
+		"next_time",
+		"create_at",
+		"update_at",
+	}
+)
+
+// InitSchema creates the database and the tables of the sql task storage if they do not exist.
+func InitSchema(ctx context.Context, executor ie.InternalExecutor) error {
+	opts := ie.NewOptsBuilder().Internal(true).Finish()
+	if err := executor.Exec(ctx, sqlCreateTaskDatabase, opts); err != nil {
+		return fmt.Errorf("init task schema failed: %w, sql: %s", err, sqlCreateTaskDatabase)
+	}
+	res := executor.Query(ctx, fmt.Sprintf("show tables from %s", TaskDatabase), opts)
+	if err := res.Error(); err != nil {
+		return err
+	}
+	tables := make(map[string]struct{})
+	for i := uint64(0); i < res.RowCount(); i++ {
+		v, err := res.Value(i, 0)
+		if err != nil {
+			return err
+		}
+		tables[fmt.Sprintf("%s", v)] = struct{}{}
+	}
+
+	// the index is created along with the table, as CREATE INDEX has no IF NOT EXISTS
+	for table, sqls := range map[string][]string{
+		AsyncTaskTable: {sqlCreateAsyncTaskTable, sqlCreateAsyncTaskIndex},
+		CronTaskTable:  {sqlCreateCronTaskTable, sqlCreateCronTaskIndex},
+	} {
+		if _, ok := tables[table]; ok {
+			continue
+		}
+		for _, sql := range sqls {
+			if err := executor.Exec(ctx, sql, opts); err != nil {
+				return fmt.Errorf("init task schema failed: %w, sql: %s", err, sql)
+			}
+		}
+	}
+	return nil
+}
+
+// sqlTaskStorage stores the tasks in the system tables of MatrixOne through the internal
+// executor, so the tasks survive the restart of the process. The conditions of the queries
+// are pushed down as the predicates of the statements.
+type sqlTaskStorage struct {
+	executor ie.InternalExecutor
+}
+
+// NewSQLTaskStorage returns a task storage based on the tables created by InitSchema.
+func NewSQLTaskStorage(executor ie.InternalExecutor) TaskStorage {
+	return &sqlTaskStorage{executor: executor}
+}
+
+func (s *sqlTaskStorage) Close() error {
+	return nil
+}
+
+func (s *sqlTaskStorage) Add(ctx context.Context, tasks ...task.Task) (int, error) {
+	if len(tasks) == 0 {
+		return 0, nil
+	}
+	exists, err := s.getExistMetadataIDs(ctx, AsyncTaskTable, tasks2MetadataIDs(tasks))
+	if err != nil {
+		return 0, err
+	}
+
+	values := make([]string, 0, len(tasks))
+	for _, v := range tasks {
+		if _, ok := exists[v.Metadata.ID]; ok {
+			continue
+		}
+		exists[v.Metadata.ID] = struct{}{}
+		value, err := asyncTaskValues(v)
+		if err != nil {
+			return 0, err
+		}
+		values = append(values, value)
+	}
+	if len(values) == 0 {
+		return 0, nil
+	}
+	// the unique index of task_metadata_id rejects the tasks added concurrently
+	sql := fmt.Sprintf("insert into %s.%s(%s) values %s", TaskDatabase, AsyncTaskTable,
+		strings.Join(asyncTaskColumns[1:], ", "), strings.Join(values, ", "))
+	if err := s.exec(ctx, sql); err != nil {
+		return 0, err
+	}
+	return len(values), nil
+}
+
+func (s *sqlTaskStorage) Update(ctx context.Context, tasks []task.Task, conds ...Condition) (int, error) {
+	where := buildWhereClause(newConditions(conds...))
+	n := 0
+	for _, v := range tasks {
+		sets, err := asyncTaskSets(v)
+		if err != nil {
+			return n, err
+		}
+		sql := fmt.Sprintf("update %s.%s set %s where task_id = %d", TaskDatabase, AsyncTaskTable, sets, v.ID)
+		if where != "" {
+			sql += " and " + where
+		}
+		rows, err := s.execAffected(ctx, sql)
+		if err != nil {
+			return n, err
+		}
+		n += rows
+	}
+	return n, nil
+}
+
+func (s *sqlTaskStorage) Delete(ctx context.Context, conds ...Condition) (int, error) {
+	sql := fmt.Sprintf("delete from %s.%s", TaskDatabase, AsyncTaskTable)
+	if where := buildWhereClause(newConditions(conds...)); where != "" {
+		sql += " where " + where
+	}
+	return s.execAffected(ctx, sql)
+}
+
+func (s *sqlTaskStorage) Query(ctx context.Context, conds ...Condition) ([]task.Task, error) {
+	c := newConditions(conds...)
+	where := buildWhereClause(c)
+
+	var result []task.Task
+	lastID := uint64(0)
+	for {
+		limit := queryPageSize
+		if c.limit > 0 && c.limit-len(result) < limit {
+			limit = c.limit - len(result)
+		}
+		sql := fmt.Sprintf("select %s from %s.%s where task_id > %d", strings.Join(asyncTaskColumns, ", "),
+			TaskDatabase, AsyncTaskTable, lastID)
+		if where != "" {
+			sql += " and " + where
+		}
+		sql += fmt.Sprintf(" order by task_id limit %d", limit)
+
+		res, err := s.query(ctx, sql)
+		if err != nil {
+			return nil, err
+		}
+		for i := uint64(0); i < res.RowCount(); i++ {
+			v, err := readAsyncTask(res, i)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, v)
+			lastID = v.ID
+		}
+		if res.RowCount() < uint64(limit) || (c.limit > 0 && len(result) >= c.limit) {
+			return result, nil
+		}
+	}
+}
+
+func (s *sqlTaskStorage) AddCronTask(ctx context.Context, tasks ...task.CronTask) (int, error) {
+	if len(tasks) == 0 {
+		return 0, nil
+	}
+	ids := make([]string, 0, len(tasks))
+	for _, v := range tasks {
+		ids = append(ids, v.Metadata.ID)
+	}
+	exists, err := s.getExistMetadataIDs(ctx, CronTaskTable, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	values := make([]string, 0, len(tasks))
+	for _, v := range tasks {
+		if _, ok := exists[v.Metadata.ID]; ok {
+			continue
+		}
+		exists[v.Metadata.ID] = struct{}{}
+		value, err := cronTaskValues(v)
+		if err != nil {
+			return 0, err
+		}
+		values = append(values, value)
+	}
+	if len(values) == 0 {
+		return 0, nil
+	}
+	sql := fmt.Sprintf("insert into %s.%s(%s) values %s", TaskDatabase, CronTaskTable,
+		strings.Join(cronTaskColumns[1:], ", "), strings.Join(values, ", "))
+	if err := s.exec(ctx, sql); err != nil {
+		return 0, err
+	}
+	return len(values), nil
+}
+
+func (s *sqlTaskStorage) QueryCronTask(ctx context.Context) ([]task.CronTask, error) {
+	var result []task.CronTask
+	lastID := uint64(0)
+	for {
+		sql := fmt.Sprintf("select %s from %s.%s where cron_task_id > %d order by cron_task_id limit %d",
+			strings.Join(cronTaskColumns, ", "), TaskDatabase, CronTaskTable, lastID, queryPageSize)
+		res, err := s.query(ctx, sql)
+		if err != nil {
+			return nil, err
+		}
+		for i := uint64(0); i < res.RowCount(); i++ {
+			v, err := readCronTask(res, i)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, v)
+			lastID = v.ID
+		}
+		if res.RowCount() < queryPageSize {
+			return result, nil
+		}
+	}
+}
+
+func (s *sqlTaskStorage) UpdateCronTask(ctx context.Context, cron task.CronTask, value task.Task) (int, error) {
+	exists, err := s.getExistMetadataIDs(ctx, AsyncTaskTable, []string{value.Metadata.ID})
+	if err != nil {
+		return 0, err
+	}
+	if len(exists) > 0 {
+		return 0, nil
+	}
+	res, err := s.query(ctx, fmt.Sprintf("select cron_task_id from %s.%s where cron_task_id = %d",
+		TaskDatabase, CronTaskTable, cron.ID))
+	if err != nil {
+		return 0, err
+	}
+	if res.RowCount() == 0 {
+		return 0, nil
+	}
+
+	taskValue, err := asyncTaskValues(value)
+	if err != nil {
+		return 0, err
+	}
+	cronSets, err := cronTaskSets(cron)
+	if err != nil {
+		return 0, err
+	}
+	// the new task and the cron task are written in a transaction, the transaction is
+	// rollbacked if any of the statements fails, including the insertion of a task added
+	// concurrently which is rejected by the unique index of task_metadata_id.
+	sql := strings.Join([]string{
+		"begin",
+		fmt.Sprintf("insert into %s.%s(%s) values %s", TaskDatabase, AsyncTaskTable,
+			strings.Join(asyncTaskColumns[1:], ", "), taskValue),
+		fmt.Sprintf("update %s.%s set %s where cron_task_id = %d", TaskDatabase, CronTaskTable, cronSets, cron.ID),
+		"commit",
+	}, "; ")
+	if err := s.exec(ctx, sql); err != nil {
+		return 0, err
+	}
+	return 2, nil
+}
+
+func (s *sqlTaskStorage) exec(ctx context.Context, sql string) error {
+	return s.executor.Exec(ctx, sql, ie.NewOptsBuilder().Internal(true).Finish())
+}
+
+func (s *sqlTaskStorage) execAffected(ctx context.Context, sql string) (int, error) {
+	res, err := s.query(ctx, sql)
+	if err != nil {
+		return 0, err
+	}
+	return int(res.AffectedRows()), nil
+}
+
+func (s *sqlTaskStorage) query(ctx context.Context, sql string) (ie.InternalExecResult, error) {
+	res := s.executor.Query(ctx, sql, ie.NewOptsBuilder().Internal(true).Finish())
+	if err := res.Error(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// getExistMetadataIDs returns the metadata ids of the tasks which are already in the table.
+func (s *sqlTaskStorage) getExistMetadataIDs(ctx context.Context, table string, ids []string) (map[string]struct{}, error) {
+	exists := make(map[string]struct{}, len(ids))
+	for start := 0; start < len(ids); start += queryPageSize {
+		end := start + queryPageSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		values := make([]string, 0, end-start)
+		for _, id := range ids[start:end] {
+			values = append(values, quoteString(id))
+		}
+		res, err := s.query(ctx, fmt.Sprintf("select task_metadata_id from %s.%s where task_metadata_id in (%s)",
+			TaskDatabase, table, strings.Join(values, ", ")))
+		if err != nil {
+			return nil, err
+		}
+		for i := uint64(0); i < res.RowCount(); i++ {
+			id, err := res.StringValueByName(i, "task_metadata_id")
+			if err != nil {
+				return nil, err
+			}
+			exists[id] = struct{}{}
+		}
+	}
+	return exists, nil
+}
+
+func newConditions(conds ...Condition) conditions {
+	c := conditions{}
+	for _, cond := range conds {
+		cond(&c)
+	}
+	return c
+}
+
+var opSymbols = map[Op]string{
+	EQ: "=",
+	GT: ">",
+	GE: ">=",
+	LT: "<",
+	LE: "<=",
+}
+
+// buildWhereClause returns the predicates of the conditions, the limit is not included.
+func buildWhereClause(c conditions) string {
+	var preds []string
+	if c.hasTaskIDCond {
+		preds = append(preds, fmt.Sprintf("task_id %s %d", opSymbols[c.taskIDOp], c.taskID))
+	}
+	if c.hasTaskRunnerCond {
+		preds = append(preds, fmt.Sprintf("task_runner %s %s", opSymbols[c.taskRunnerOp], quoteString(c.taskRunner)))
+	}
+	if c.hasTaskStatusCond {
+		preds = append(preds, fmt.Sprintf("task_status %s %d", opSymbols[c.taskStatusOp], c.taskStatus))
+	}
+	if c.hasTaskEpochCond {
+		preds = append(preds, fmt.Sprintf("task_epoch %s %d", opSymbols[c.taskEpochOp], c.taskEpoch))
+	}
+	if c.hasTaskMetadataIDCond {
+		preds = append(preds, fmt.Sprintf("task_metadata_id %s %s", opSymbols[c.taskMetadataIDOp], quoteString(c.taskMetadataID)))
+	}
+	return strings.Join(preds, " and ")
+}
+
+func tasks2MetadataIDs(tasks []task.Task) []string {
+	ids := make([]string, 0, len(tasks))
+	for _, v := range tasks {
+		ids = append(ids, v.Metadata.ID)
+	}
+	return ids
+}
+
+// metadataValues returns the values of the task_metadata_xxx columns.
+func metadataValues(m task.TaskMetadata) ([]string, error) {
+	option, err := json.Marshal(m.Options)
+	if err != nil {
+		return nil, err
+	}
+	return []string{
+		quoteString(m.ID),
+		strconv.FormatUint(uint64(m.Executor), 10),
+		quoteString(hex.EncodeToString(m.Context)),
+		quoteString(string(option)),
+	}, nil
+}
+
+// asyncTaskRow returns the values of the columns of a task except task_id.
+func asyncTaskRow(v task.Task) ([]string, error) {
+	values, err := metadataValues(v.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	result := task.ExecuteResult{}
+	if v.ExecuteResult != nil {
+		result = *v.ExecuteResult
+	}
+	return append(values,
+		quoteString(v.ParentTaskID),
+		strconv.FormatInt(int64(v.Status), 10),
+		quoteString(v.TaskRunner),
+		strconv.FormatUint(uint64(v.Epoch), 10),
+		strconv.FormatInt(v.LastHeartbeat, 10),
+		strconv.FormatInt(int64(result.Code), 10),
+		quoteString(result.Error),
+		strconv.FormatInt(v.CreateAt, 10),
+		strconv.FormatInt(v.CompletedAt, 10),
+	), nil
+}
+
+func asyncTaskValues(v task.Task) (string, error) {
+	values, err := asyncTaskRow(v)
+	if err != nil {
+		return "", err
+	}
+	return "(" + strings.Join(values, ", ") + ")", nil
+}
+
+func asyncTaskSets(v task.Task) (string, error) {
+	values, err := asyncTaskRow(v)
+	if err != nil {
+		return "", err
+	}
+	return buildSets(asyncTaskColumns[1:], values), nil
+}
+
+// cronTaskRow returns the values of the columns of a cron task except cron_task_id.
+func cronTaskRow(v task.CronTask) ([]string, error) {
+	values, err := metadataValues(v.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return append(values,
+		quoteString(v.CronExpr),
+		strconv.FormatInt(v.NextTime, 10),
+		strconv.FormatInt(v.CreateAt, 10),
+		strconv.FormatInt(v.UpdateAt, 10),
+	), nil
+}
+
+func cronTaskValues(v task.CronTask) (string, error) {
+	values, err := cronTaskRow(v)
+	if err != nil {
+		return "", err
+	}
+	return "(" + strings.Join(values, ", ") + ")", nil
+}
+
+func cronTaskSets(v task.CronTask) (string, error) {
+	values, err := cronTaskRow(v)
+	if err != nil {
+		return "", err
+	}
+	return buildSets(cronTaskColumns[1:], values), nil
+}
+
+func buildSets(cols, values []string) string {
+	sets := make([]string, 0, len(cols))
+	for i, col := range cols {
+		sets = append(sets, col+" = "+values[i])
+	}
+	return strings.Join(sets, ", ")
+}
+
+// rowReader reads the values of a row of a result set, the first error is kept.
+type rowReader struct {
+	res ie.InternalExecResult
+	row uint64
+	err error
+}
+
+func (r *rowReader) string(col string) string {
+	if r.err != nil {
+		return ""
+	}
+	var v string
+	v, r.err = r.res.StringValueByName(r.row, col)
+	return v
+}
+
+func (r *rowReader) int64(col string) int64 {
+	v := r.string(col)
+	if r.err != nil {
+		return 0
+	}
+	var n int64
+	n, r.err = strconv.ParseInt(v, 10, 64)
+	return n
+}
+
+func (r *rowReader) uint64(col string) uint64 {
+	v := r.string(col)
+	if r.err != nil {
+		return 0
+	}
+	var n uint64
+	n, r.err = strconv.ParseUint(v, 10, 64)
+	return n
+}
+
+func (r *rowReader) metadata() task.TaskMetadata {
+	m := task.TaskMetadata{
+		ID:       r.string("task_metadata_id"),
+		Executor: uint32(r.uint64("task_metadata_executor")),
+	}
+	context := r.string("task_metadata_context")
+	option := r.string("task_metadata_option")
+	if r.err != nil {
+		return m
+	}
+	if m.Context, r.err = hex.DecodeString(context); r.err != nil {
+		return m
+	}
+	if len(m.Context) == 0 {
+		m.Context = nil
+	}
+	r.err = json.Unmarshal([]byte(option), &m.Options)
+	return m
+}
+
+func readAsyncTask(res ie.InternalExecResult, row uint64) (task.Task, error) {
+	r := &rowReader{res: res, row: row}
+	v := task.Task{
+		ID:            r.uint64("task_id"),
+		Metadata:      r.metadata(),
+		ParentTaskID:  r.string("task_parent_id"),
+		Status:        task.TaskStatus(r.int64("task_status")),
+		TaskRunner:    r.string("task_runner"),
+		Epoch:         uint32(r.uint64("task_epoch")),
+		LastHeartbeat: r.int64("last_heartbeat"),
+		CreateAt:      r.int64("create_at"),
+		CompletedAt:   r.int64("end_at"),
+	}
+	// only the completed tasks have a execute result
+	code := task.ResultCode(r.int64("result_code"))
+	msg := r.string("error_msg")
+	if v.Status == task.TaskStatus_Completed {
+		v.ExecuteResult = &task.ExecuteResult{Code: code, Error: msg}
+	}
+	return v, r.err
+}
+
+func readCronTask(res ie.InternalExecResult, row uint64) (task.CronTask, error) {
+	r := &rowReader{res: res, row: row}
+	v := task.CronTask{
+		ID:       r.uint64("cron_task_id"),
+		Metadata: r.metadata(),
+		CronExpr: r.string("cron_expr"),
+		NextTime: r.int64("next_time"),
+		CreateAt: r.int64("create_at"),
+		UpdateAt: r.int64("update_at"),
+	}
+	return v, r.err
+}
+
+// quoteString returns the string literal of s.
+func quoteString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `\'`)
+	return "'" + s + "'"
+}
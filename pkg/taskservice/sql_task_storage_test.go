@@ -0,0 +1,194 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ ie.InternalExecutor = &testSQLExecutor{}
+
+// testSQLExecutor records the statements and returns the prepared results in order.
+type testSQLExecutor struct {
+	sqls    []string
+	results []*testSQLResult
+}
+
+func (e *testSQLExecutor) Exec(ctx context.Context, sql string, opts ie.SessionOverrideOptions) error {
+	return e.Query(ctx, sql, opts).Error()
+}
+
+func (e *testSQLExecutor) Query(ctx context.Context, sql string, opts ie.SessionOverrideOptions) ie.InternalExecResult {
+	e.sqls = append(e.sqls, sql)
+	if len(e.results) == 0 {
+		return &testSQLResult{}
+	}
+	res := e.results[0]
+	e.results = e.results[1:]
+	return res
+}
+
+func (e *testSQLExecutor) ApplySessionOverride(ie.SessionOverrideOptions) {}
+
+type testSQLResult struct {
+	err      error
+	affected uint64
+	cols     []string
+	rows     [][]string
+}
+
+func (r *testSQLResult) Error() error                   { return r.err }
+func (r *testSQLResult) AffectedRows() uint64           { return r.affected }
+func (r *testSQLResult) ColumnCount() uint64            { return uint64(len(r.cols)) }
+func (r *testSQLResult) RowCount() uint64               { return uint64(len(r.rows)) }
+func (r *testSQLResult) Row(i uint64) ([]any, error)    { panic("not impl") }
+func (r *testSQLResult) Value(i, j uint64) (any, error) { return r.rows[i][j], nil }
+func (r *testSQLResult) Column(uint64) (string, uint8, bool, error) {
+	panic("not impl")
+}
+func (r *testSQLResult) ValueByName(i uint64, col string) (any, error) {
+	return r.StringValueByName(i, col)
+}
+func (r *testSQLResult) StringValueByName(i uint64, col string) (string, error) {
+	for j, name := range r.cols {
+		if name == col {
+			return r.rows[i][j], nil
+		}
+	}
+	return "", fmt.Errorf("column %s not found", col)
+}
+
+func TestSQLInitSchema(t *testing.T) {
+	e := &testSQLExecutor{results: []*testSQLResult{
+		{},
+		{cols: []string{"Tables_in_mo_task"}, rows: [][]string{{AsyncTaskTable}}},
+	}}
+	require.NoError(t, InitSchema(context.Background(), e))
+	require.Equal(t, 4, len(e.sqls))
+	assert.Equal(t, sqlCreateCronTaskTable, e.sqls[2])
+	assert.Equal(t, sqlCreateCronTaskIndex, e.sqls[3])
+}
+
+func TestSQLBuildWhereClause(t *testing.T) {
+	assert.Equal(t, "", buildWhereClause(newConditions(WithLimitCond(1))))
+	assert.Equal(t, "task_id >= 1 and task_runner = 'a\\'b' and task_status < 2 and task_epoch = 3 and task_metadata_id = 't1'",
+		buildWhereClause(newConditions(
+			WithTaskIDCond(GE, 1),
+			WithTaskRunnerCond(EQ, "a'b"),
+			WithTaskStatusCond(LT, task.TaskStatus_Completed),
+			WithTaskEpochCond(EQ, 3),
+			WithTaskMetadataIDCond(EQ, "t1"))))
+}
+
+func TestSQLAddTask(t *testing.T) {
+	e := &testSQLExecutor{results: []*testSQLResult{
+		{cols: []string{"task_metadata_id"}, rows: [][]string{{"t1"}}},
+	}}
+	s := NewSQLTaskStorage(e)
+	n, err := s.Add(context.Background(), newTestTask("t1"), newTestTask("t2"), newTestTask("t2"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	require.Equal(t, 2, len(e.sqls))
+	assert.Equal(t, "select task_metadata_id from mo_task.sys_async_task where task_metadata_id in ('t1', 't2', 't2')", e.sqls[0])
+	assert.True(t, strings.HasPrefix(e.sqls[1], "insert into mo_task.sys_async_task("))
+	assert.Equal(t, 1, strings.Count(e.sqls[1], "('t2'"))
+}
+
+func TestSQLUpdateTask(t *testing.T) {
+	e := &testSQLExecutor{results: []*testSQLResult{{affected: 1}, {affected: 0}}}
+	s := NewSQLTaskStorage(e)
+	v1, v2 := newTestTask("t1"), newTestTask("t2")
+	v1.ID, v2.ID = 1, 2
+	n, err := s.Update(context.Background(), []task.Task{v1, v2}, WithTaskEpochCond(EQ, 0))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.True(t, strings.HasSuffix(e.sqls[0], "where task_id = 1 and task_epoch = 0"))
+	assert.True(t, strings.HasSuffix(e.sqls[1], "where task_id = 2 and task_epoch = 0"))
+}
+
+func TestSQLQueryTask(t *testing.T) {
+	v := newTestTask("t1")
+	v.ID = 1
+	v.Metadata.Context = []byte("context")
+	v.Metadata.Options.MaxRetryTimes = 3
+	v.Status = task.TaskStatus_Completed
+	v.ExecuteResult = &task.ExecuteResult{Code: task.ResultCode_Failed, Error: "error"}
+	values, err := asyncTaskRow(v)
+	require.NoError(t, err)
+	row := []string{"1"}
+	for _, value := range values {
+		// remove the quotes of the string literals
+		row = append(row, strings.Trim(value, "'"))
+	}
+
+	e := &testSQLExecutor{results: []*testSQLResult{{cols: asyncTaskColumns, rows: [][]string{row}}}}
+	s := NewSQLTaskStorage(e)
+	tasks, err := s.Query(context.Background(), WithTaskRunnerCond(EQ, "r1"), WithLimitCond(10))
+	require.NoError(t, err)
+	require.Equal(t, []task.Task{v}, tasks)
+	assert.True(t, strings.HasSuffix(e.sqls[0], "where task_id > 0 and task_runner = 'r1' order by task_id limit 10"))
+}
+
+func TestSQLQueryTaskByPage(t *testing.T) {
+	page := &testSQLResult{cols: asyncTaskColumns}
+	for i := 1; i <= queryPageSize; i++ {
+		page.rows = append(page.rows, []string{fmt.Sprintf("%d", i), "", "0", "", "{}", "", "0", "", "0", "0", "0", "", "0", "0"})
+	}
+	e := &testSQLExecutor{results: []*testSQLResult{page, {cols: asyncTaskColumns}}}
+	s := NewSQLTaskStorage(e)
+	tasks, err := s.Query(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, queryPageSize, len(tasks))
+	require.Equal(t, 2, len(e.sqls))
+	assert.True(t, strings.HasSuffix(e.sqls[1], fmt.Sprintf("where task_id > %d order by task_id limit %d", queryPageSize, queryPageSize)))
+}
+
+func TestSQLUpdateCronTask(t *testing.T) {
+	e := &testSQLExecutor{results: []*testSQLResult{
+		{},
+		{cols: []string{"cron_task_id"}, rows: [][]string{{"1"}}},
+	}}
+	s := NewSQLTaskStorage(e)
+	cron := newTestCronTask("c1", "* * * * * *")
+	cron.ID = 1
+	n, err := s.UpdateCronTask(context.Background(), cron, newTestTask("t1"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	require.Equal(t, 3, len(e.sqls))
+	stmts := strings.Split(e.sqls[2], "; ")
+	require.Equal(t, 4, len(stmts))
+	assert.Equal(t, "begin", stmts[0])
+	assert.True(t, strings.HasPrefix(stmts[1], "insert into mo_task.sys_async_task("))
+	assert.True(t, strings.HasSuffix(stmts[2], "where cron_task_id = 1"))
+	assert.Equal(t, "commit", stmts[3])
+
+	// the task is already created
+	e = &testSQLExecutor{results: []*testSQLResult{
+		{cols: []string{"task_metadata_id"}, rows: [][]string{{"t1"}}},
+	}}
+	s = NewSQLTaskStorage(e)
+	n, err = s.UpdateCronTask(context.Background(), cron, newTestTask("t1"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, 1, len(e.sqls))
+}
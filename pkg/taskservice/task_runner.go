@@ -80,6 +80,16 @@ func WithRunnerHeartbeatInterval(interval time.Duration) RunnerOption {
 	}
 }
 
+// WithRunnerAllocateCreatedTasks makes the runner allocate the created tasks to itself, which is
+// used if there is no scheduler allocating the tasks of the task service, e.g. the task service
+// based on the sql task storage. Allocate is a CAS on the task epoch, so only one of the runners
+// competing for a task gets it.
+func WithRunnerAllocateCreatedTasks() RunnerOption {
+	return func(r *taskRunner) {
+		r.options.allocateCreatedTasks = true
+	}
+}
+
 // WithRunnerRetryInterval set retry interval duration for operation
 func WithRunnerRetryInterval(interval time.Duration) RunnerOption {
 	return func(r *taskRunner) {
@@ -92,7 +102,6 @@ type taskRunner struct {
 	runnerID     string
 	service      TaskService
 	stopper      stopper.Stopper
-	lastTaskID   uint64
 	waitTasksC   chan task.Task
 	parallelismC chan struct{}
 	doneC        chan runningTask
@@ -103,16 +112,19 @@ type taskRunner struct {
 		executors    map[int]TaskExecutor
 		runningTasks map[uint64]runningTask
 		retryTasks   []runningTask
+		// fetchedTasks is the tasks fetched which are still running on the runner
+		fetchedTasks map[uint64]struct{}
 	}
 
 	options struct {
-		queryLimit        int
-		parallelism       int
-		maxWaitTasks      int
-		fetchInterval     time.Duration
-		fetchTimeout      time.Duration
-		retryInterval     time.Duration
-		heartbeatInterval time.Duration
+		allocateCreatedTasks bool
+		queryLimit           int
+		parallelism          int
+		maxWaitTasks         int
+		fetchInterval        time.Duration
+		fetchTimeout         time.Duration
+		retryInterval        time.Duration
+		heartbeatInterval    time.Duration
 	}
 }
 
@@ -135,6 +147,7 @@ func NewTaskRunner(runnerID string, service TaskService, opts ...RunnerOption) (
 	r.waitTasksC = make(chan task.Task, r.options.maxWaitTasks)
 	r.doneC = make(chan runningTask, r.options.maxWaitTasks)
 	r.mu.runningTasks = make(map[uint64]runningTask)
+	r.mu.fetchedTasks = make(map[uint64]struct{})
 	r.mu.executors = make(map[int]TaskExecutor)
 	return r, nil
 }
@@ -248,23 +261,68 @@ func (r *taskRunner) fetch(ctx context.Context) {
 
 func (r *taskRunner) doFetch() ([]task.Task, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), r.options.fetchTimeout)
+	defer cancel()
+
+	if r.options.allocateCreatedTasks {
+		r.allocateCreatedTasks(ctx)
+	}
+
+	// all the running tasks allocated to the runner are queried, the ones fetched already are
+	// skipped. A fetched task is forgot once it is not running on the runner, e.g. completed or
+	// paused, so it is fetched again after it is resumed and allocated to the runner.
+	r.mu.RLock()
+	limit := r.options.queryLimit + len(r.mu.fetchedTasks)
+	r.mu.RUnlock()
 	tasks, err := r.service.QueryTask(ctx,
-		WithTaskIDCond(GT, r.lastTaskID),
-		WithLimitCond(r.options.queryLimit))
-	cancel()
+		WithTaskRunnerCond(EQ, r.runnerID),
+		WithTaskStatusCond(EQ, task.TaskStatus_Running),
+		WithLimitCond(limit))
 	if err != nil {
 		r.logger.Error("fetch task failed", zap.Error(err))
 		return nil, err
 	}
-	if len(tasks) == 0 {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	running := make(map[uint64]struct{}, len(tasks))
+	newTasks := tasks[:0]
+	for _, v := range tasks {
+		running[v.ID] = struct{}{}
+		if _, ok := r.mu.fetchedTasks[v.ID]; ok {
+			continue
+		}
+		r.mu.fetchedTasks[v.ID] = struct{}{}
+		newTasks = append(newTasks, v)
+	}
+	for id := range r.mu.fetchedTasks {
+		if _, ok := running[id]; !ok {
+			delete(r.mu.fetchedTasks, id)
+		}
+	}
+	if len(newTasks) == 0 {
 		return nil, nil
 	}
 
-	r.lastTaskID = tasks[len(tasks)-1].ID
 	r.logger.Debug("new task fetched",
-		zap.Int("count", len(tasks)),
-		zap.Uint64("last-task-id", r.lastTaskID))
-	return tasks, nil
+		zap.Int("count", len(newTasks)))
+	return newTasks, nil
+}
+
+func (r *taskRunner) allocateCreatedTasks(ctx context.Context) {
+	tasks, err := r.service.QueryTask(ctx,
+		WithTaskStatusCond(EQ, task.TaskStatus_Created),
+		WithLimitCond(r.options.queryLimit))
+	if err != nil {
+		r.logger.Error("fetch created task failed", zap.Error(err))
+		return
+	}
+	for _, v := range tasks {
+		if err := r.service.Allocate(ctx, v, r.runnerID); err != nil && err != ErrInvalidTask {
+			r.logger.Error("allocate task failed",
+				zap.String("task", v.DebugString()),
+				zap.Error(err))
+		}
+	}
 }
 
 func (r *taskRunner) addTasks(ctx context.Context, tasks []task.Task) {
@@ -190,6 +190,28 @@ func TestCancelRunningTask(t *testing.T) {
 		WithRunnerFetchInterval(time.Millisecond))
 }
 
+func TestCancelTaskByService(t *testing.T) {
+	runTaskRunnerTest(t, func(r *taskRunner, s TaskService, store TaskStorage) {
+		c := make(chan struct{})
+		cancelC := make(chan struct{})
+		r.RegisterExectuor(0, func(ctx context.Context, task task.Task) error {
+			close(c)
+			<-ctx.Done()
+			close(cancelC)
+			return nil
+		})
+		mustAddTestTask(t, store, 1, newTestTask("t1"))
+		<-c
+		require.NoError(t, s.CancelTask(context.Background(), "t1"))
+		<-cancelC
+		v := mustGetTestTask(t, store, 1)[0]
+		assert.Equal(t, task.ResultCode_Canceled, v.ExecuteResult.Code)
+	}, WithRunnerParallelism(2),
+		WithRunnerAllocateCreatedTasks(),
+		WithRunnerHeartbeatInterval(time.Millisecond),
+		WithRunnerFetchInterval(time.Millisecond))
+}
+
 func runTaskRunnerTest(t *testing.T,
 	testFunc func(r *taskRunner, s TaskService, store TaskStorage),
 	opts ...RunnerOption) {
@@ -16,21 +16,34 @@ package taskservice
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/task"
 	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
 )
 
 type taskService struct {
+	logger     *zap.Logger
 	store      TaskStorage
 	cronParser cron.Parser
+
+	crons struct {
+		sync.Mutex
+		started  bool
+		interval time.Duration
+		stopper  *stopper.Stopper
+	}
 }
 
 // NewTaskService create a task service based on a task storage.
 func NewTaskService(store TaskStorage) TaskService {
-	return &taskService{
-		store: store,
+	s := &taskService{
+		logger: logutil.Adjust(nil).Named("task-service"),
+		store:  store,
 		cronParser: cron.NewParser(
 			cron.Second |
 				cron.Minute |
@@ -40,6 +53,8 @@ func NewTaskService(store TaskStorage) TaskService {
 				cron.Dow |
 				cron.Descriptor),
 	}
+	s.crons.interval = defaultCronScheduleInterval
+	return s
 }
 
 func (s *taskService) Create(ctx context.Context, value task.TaskMetadata) error {
@@ -164,6 +179,84 @@ func (s *taskService) QueryCronTask(ctx context.Context) ([]task.CronTask, error
 	return s.store.QueryCronTask(ctx)
 }
 
+func (s *taskService) CancelTask(ctx context.Context, metadataID string) error {
+	return s.changeTask(ctx, metadataID, func(v *task.Task) (bool, error) {
+		if v.Status == task.TaskStatus_Completed {
+			return false, ErrTaskCompleted
+		}
+		v.Status = task.TaskStatus_Completed
+		v.CompletedAt = time.Now().UnixMilli()
+		v.ExecuteResult = &task.ExecuteResult{Code: task.ResultCode_Canceled}
+		return true, nil
+	})
+}
+
+func (s *taskService) PauseTask(ctx context.Context, metadataID string) error {
+	return s.changeTask(ctx, metadataID, func(v *task.Task) (bool, error) {
+		switch v.Status {
+		case task.TaskStatus_Completed:
+			return false, ErrTaskCompleted
+		case task.TaskStatus_Paused:
+			return false, nil
+		}
+		v.Status = task.TaskStatus_Paused
+		return true, nil
+	})
+}
+
+func (s *taskService) ResumeTask(ctx context.Context, metadataID string) error {
+	return s.changeTask(ctx, metadataID, func(v *task.Task) (bool, error) {
+		switch v.Status {
+		case task.TaskStatus_Completed:
+			return false, ErrTaskCompleted
+		case task.TaskStatus_Paused:
+			v.Status = task.TaskStatus_Created
+			v.TaskRunner = ""
+			v.Epoch = 0
+			v.LastHeartbeat = 0
+			return true, nil
+		}
+		return false, nil
+	})
+}
+
+// changeTask applies change to the task with the metadata id, and retries if the task is
+// changed concurrently, e.g. allocated to another task runner.
+func (s *taskService) changeTask(ctx context.Context, metadataID string, change func(*task.Task) (bool, error)) error {
+	for {
+		tasks, err := s.store.Query(ctx, WithTaskMetadataIDCond(EQ, metadataID), WithLimitCond(1))
+		if err != nil {
+			return err
+		}
+		if len(tasks) == 0 {
+			return ErrTaskNotFound
+		}
+
+		old := tasks[0]
+		value := old
+		changed, err := change(&value)
+		if err != nil || !changed {
+			return err
+		}
+		n, err := s.store.Update(ctx, []task.Task{value},
+			WithTaskStatusCond(EQ, old.Status),
+			WithTaskEpochCond(EQ, old.Epoch))
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+}
+
 func (s *taskService) Close() error {
+	s.StopScheduleCronTask()
 	return s.store.Close()
 }
@@ -0,0 +1,117 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"go.uber.org/zap"
+)
+
+var (
+	defaultCronScheduleInterval = time.Second
+)
+
+func (s *taskService) StartScheduleCronTask() {
+	s.crons.Lock()
+	defer s.crons.Unlock()
+
+	if s.crons.started {
+		return
+	}
+	s.crons.started = true
+	s.crons.stopper = stopper.NewStopper("cron-task-scheduler", stopper.WithLogger(s.logger))
+	if err := s.crons.stopper.RunNamedTask("schedule-cron-task", s.scheduleCronTasks); err != nil {
+		s.logger.Error("start schedule cron task failed", zap.Error(err))
+	}
+}
+
+func (s *taskService) StopScheduleCronTask() {
+	s.crons.Lock()
+	defer s.crons.Unlock()
+
+	if !s.crons.started {
+		return
+	}
+	s.crons.started = false
+	s.crons.stopper.Stop()
+}
+
+func (s *taskService) scheduleCronTasks(ctx context.Context) {
+	s.logger.Info("schedule cron task started")
+	timer := time.NewTimer(s.crons.interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("schedule cron task stopped")
+			return
+		case <-timer.C:
+			if err := s.doScheduleCronTasks(ctx); err != nil {
+				s.logger.Error("schedule cron task failed", zap.Error(err))
+			}
+		}
+		timer.Reset(s.crons.interval)
+	}
+}
+
+// doScheduleCronTasks creates the tasks of the cron tasks whose next time is reached.
+func (s *taskService) doScheduleCronTasks(ctx context.Context) error {
+	crons, err := s.store.QueryCronTask(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, c := range crons {
+		if c.NextTime > now.UnixMilli() {
+			continue
+		}
+		sche, err := s.cronParser.Parse(c.CronExpr)
+		if err != nil {
+			s.logger.Error("invalid cron task",
+				zap.String("cron-task", c.Metadata.ID),
+				zap.String("cron-expr", c.CronExpr),
+				zap.Error(err))
+			continue
+		}
+
+		value := newTaskFromCron(c, now)
+		c.NextTime = sche.Next(now).UnixMilli()
+		c.UpdateAt = now.UnixMilli()
+		if _, err := s.store.UpdateCronTask(ctx, c, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newTaskFromCron returns the task of the cron task for its next time, the metadata id
+// of the task is derived from the next time, so it is only created once.
+func newTaskFromCron(c task.CronTask, now time.Time) task.Task {
+	value := task.Task{
+		Metadata:     c.Metadata,
+		ParentTaskID: c.Metadata.ID,
+		Status:       task.TaskStatus_Created,
+		CreateAt:     now.UnixMilli(),
+	}
+	value.Metadata.ID = fmt.Sprintf("%s-%d", c.Metadata.ID, c.NextTime)
+	return value
+}
@@ -376,6 +376,99 @@ func TestQueryTask(t *testing.T) {
 	assert.Equal(t, 2, len(v))
 }
 
+func TestCancelTask(t *testing.T) {
+	store := NewMemTaskStorage()
+	s := NewTaskService(store)
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*10)
+	defer cancel()
+
+	assert.Equal(t, ErrTaskNotFound, s.CancelTask(ctx, "t1"))
+	assert.NoError(t, s.Create(ctx, newTestTaskMetadata("t1")))
+	v := mustGetTestTask(t, store, 1)[0]
+	assert.NoError(t, s.Allocate(ctx, v, "r1"))
+
+	assert.NoError(t, s.CancelTask(ctx, "t1"))
+	v = mustGetTestTask(t, store, 1)[0]
+	assert.Equal(t, task.TaskStatus_Completed, v.Status)
+	assert.Equal(t, task.ResultCode_Canceled, v.ExecuteResult.Code)
+	assert.True(t, v.CompletedAt > 0)
+	// the heartbeat of the task runner is rejected
+	assert.Equal(t, ErrInvalidTask, s.Heartbeat(ctx, v))
+	assert.Equal(t, ErrTaskCompleted, s.CancelTask(ctx, "t1"))
+}
+
+func TestPauseAndResumeTask(t *testing.T) {
+	store := NewMemTaskStorage()
+	s := NewTaskService(store)
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*10)
+	defer cancel()
+
+	assert.NoError(t, s.Create(ctx, newTestTaskMetadata("t1")))
+	v := mustGetTestTask(t, store, 1)[0]
+	assert.NoError(t, s.Allocate(ctx, v, "r1"))
+	running := mustGetTestTask(t, store, 1)[0]
+
+	assert.NoError(t, s.PauseTask(ctx, "t1"))
+	assert.NoError(t, s.PauseTask(ctx, "t1"))
+	v = mustGetTestTask(t, store, 1)[0]
+	assert.Equal(t, task.TaskStatus_Paused, v.Status)
+	assert.Equal(t, ErrInvalidTask, s.Heartbeat(ctx, running))
+	assert.Equal(t, ErrInvalidTask, s.Allocate(ctx, v, "r2"))
+
+	assert.NoError(t, s.ResumeTask(ctx, "t1"))
+	v = mustGetTestTask(t, store, 1)[0]
+	assert.Equal(t, task.TaskStatus_Created, v.Status)
+	assert.Equal(t, "", v.TaskRunner)
+	assert.Equal(t, uint32(0), v.Epoch)
+	assert.NoError(t, s.Allocate(ctx, v, "r2"))
+	v = mustGetTestTask(t, store, 1)[0]
+	assert.Equal(t, "r2", v.TaskRunner)
+	assert.Equal(t, uint32(1), v.Epoch)
+}
+
+func TestScheduleCronTask(t *testing.T) {
+	store := NewMemTaskStorage()
+	s := NewTaskService(store)
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*10)
+	defer cancel()
+
+	assert.NoError(t, s.CreateCronTask(ctx, newTestTaskMetadata("c1"), "*/1 * * * * *"))
+	c := mustQueryTestCronTask(t, store, 1)[0]
+
+	s.(*taskService).crons.interval = time.Millisecond
+	s.StartScheduleCronTask()
+	defer s.StopScheduleCronTask()
+	for {
+		tasks, err := s.QueryTask(ctx)
+		assert.NoError(t, err)
+		if len(tasks) > 0 {
+			assert.Equal(t, fmt.Sprintf("c1-%d", c.NextTime), tasks[0].Metadata.ID)
+			assert.Equal(t, "c1", tasks[0].ParentTaskID)
+			assert.Equal(t, task.TaskStatus_Created, tasks[0].Status)
+			break
+		}
+		select {
+		case <-ctx.Done():
+			assert.Fail(t, "wait cron task timeout")
+			return
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+	assert.True(t, mustQueryTestCronTask(t, store, 1)[0].NextTime > c.NextTime)
+}
+
 func newTestTaskMetadata(id string) task.TaskMetadata {
 	return task.TaskMetadata{
 		ID: id,
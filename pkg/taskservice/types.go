@@ -24,6 +24,10 @@ import (
 var (
 	// ErrInvalidTask task does not belong to the current task runner
 	ErrInvalidTask = moerr.New(moerr.INVALID_STATE, "task does not belong to the current task runner")
+	// ErrTaskNotFound task does not exist
+	ErrTaskNotFound = moerr.New(moerr.INVALID_STATE, "task does not exist")
+	// ErrTaskCompleted task has been completed, it can not be changed
+	ErrTaskCompleted = moerr.New(moerr.INVALID_STATE, "task has been completed")
 )
 
 // Condition options for query tasks
@@ -63,6 +67,10 @@ type conditions struct {
 	hasTaskEpochCond bool
 	taskEpochOp      Op
 	taskEpoch        uint32
+
+	hasTaskMetadataIDCond bool
+	taskMetadataIDOp      Op
+	taskMetadataID        string
 }
 
 // WithLimitCond set query result limit
@@ -108,6 +116,15 @@ func WithTaskEpochCond(op Op, value uint32) Condition {
 	}
 }
 
+// WithTaskMetadataIDCond set task metadata id condition
+func WithTaskMetadataIDCond(op Op, value string) Condition {
+	return func(qo *conditions) {
+		qo.hasTaskMetadataIDCond = true
+		qo.taskMetadataID = value
+		qo.taskMetadataIDOp = op
+	}
+}
+
 // TaskService Asynchronous Task Service, which provides scheduling execution and management of
 // asynchronous tasks. CN, DN, HAKeeper, LogService will all hold this service.
 type TaskService interface {
@@ -135,6 +152,23 @@ type TaskService interface {
 	QueryTask(context.Context, ...Condition) ([]task.Task, error)
 	// QueryCronTask returns all cron task metadata
 	QueryCronTask(context.Context) ([]task.CronTask, error)
+	// CancelTask cancel the task with the metadata id. The task is completed with the result
+	// `Canceled`, and a running task is terminated by its task runner once its heartbeat
+	// returns `ErrInvalidTask`.
+	CancelTask(ctx context.Context, metadataID string) error
+	// PauseTask pause the task with the metadata id, a paused task is not scheduled until it
+	// is resumed, and a running task is terminated like a canceled one.
+	PauseTask(ctx context.Context, metadataID string) error
+	// ResumeTask resume the paused task with the metadata id, the task is scheduled again
+	// from the first epoch.
+	ResumeTask(ctx context.Context, metadataID string) error
+
+	// StartScheduleCronTask start to create the tasks of the cron tasks periodically. The
+	// tasks created for a schedule time are the same on every node, so the cron tasks can
+	// be scheduled by multiple nodes.
+	StartScheduleCronTask()
+	// StopScheduleCronTask stop to schedule the cron tasks
+	StopScheduleCronTask()
 }
 
 // TaskExecutor which is responsible for the execution logic of a specific Task, and the function exits to
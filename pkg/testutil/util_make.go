@@ -164,6 +164,22 @@ var (
 		return vector.NewWithFixed(types.T_datetime.ToType(), ds, ns, nil)
 	}
 
+	MakeTimeVector = func(values []string, nsp []uint64) *vector.Vector {
+		ds := make([]types.Time, len(values))
+		ns := nulls.Build(len(values), nsp...)
+		for i, s := range values {
+			if nulls.Contains(ns, uint64(i)) {
+				continue
+			}
+			d, err := types.ParseTime(s, 6)
+			if err != nil {
+				panic(err)
+			}
+			ds[i] = d
+		}
+		return vector.NewWithFixed(types.T_time.ToType(), ds, ns, nil)
+	}
+
 	MakeTimeStampVector = func(values []string, nsp []uint64) *vector.Vector {
 		ds := make([]types.Timestamp, len(values))
 		ns := nulls.Build(len(values), nsp...)
@@ -280,6 +296,14 @@ var (
 		return vector.NewConstFixed(datetimeType, length, d)
 	}
 
+	MakeScalarTime = func(value string, length int) *vector.Vector {
+		d, err := types.ParseTime(value, 6)
+		if err != nil {
+			panic(err)
+		}
+		return vector.NewConstFixed(types.T_time.ToType(), length, d)
+	}
+
 	MakeScalarTimeStamp = func(value string, length int) *vector.Vector {
 		d, err := types.ParseTimestamp(time.Local, value, 6)
 		if err != nil {
@@ -0,0 +1,992 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taestorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	apipb "github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/txn/clock"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage"
+	txnstorage "github.com/matrixorigin/matrixone/pkg/txn/storage/txn"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	txnengine "github.com/matrixorigin/matrixone/pkg/vm/engine/txn"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+type handler struct {
+	db     *db.DB
+	engine moengine.TxnEngine
+	clock  clock.Clock
+	mheap  *mheap.Mheap
+
+	// transactions
+	transactions struct {
+		sync.Mutex
+		// transaction id -> transaction
+		Map map[string]*Transaction
+	}
+
+	// iterators
+	iterators struct {
+		sync.Mutex
+		// iterator id -> iterator
+		Map map[string]*Iter
+	}
+
+	// primary keys appended by the prepared transactions and the transactions
+	// committing in TAE, see lockKeys
+	keys struct {
+		sync.Mutex
+		// key -> the number of prepared transactions appending it
+		prepared map[string]int
+		// key -> the number of committing transactions appending it
+		committing map[string]int
+	}
+
+	// replay applies a write request of a prepared transaction again on recovery
+	replay func(meta txn.TxnMeta, op uint32, payload []byte) error
+	// recovered are the prepared transactions restored from the wal
+	recovered []txn.TxnMeta
+}
+
+// Transaction is a DN transaction and the TAE transaction it runs in
+type Transaction struct {
+	Meta     txn.TxnMeta
+	Txn      txnif.AsyncTxn
+	Operator client.TxnOperator
+	// Writes are the write requests applied by the transaction, they are
+	// logged by Prepare so that the transaction can be restored on recovery
+	Writes []Write
+	// LSNs are the lsns of the records logged for the transaction in
+	// wal.GroupPrepare
+	LSNs []uint64
+	// Keys are the primary keys appended by the transaction, a key is made
+	// of the relation id and the encoded values of the key
+	Keys map[string]bool
+	// Prepared is true if the transaction holds its keys until it's resolved
+	Prepared bool
+}
+
+// Write is a write request as received by TxnStorage.Write
+type Write struct {
+	Op      uint32
+	Payload []byte
+}
+
+type Iter struct {
+	Reader engine.Reader
+	Attrs  map[string]bool
+}
+
+func newHandler(
+	tae *db.DB,
+	clock clock.Clock,
+	mheap *mheap.Mheap,
+) *handler {
+	h := &handler{
+		db:     tae,
+		engine: moengine.NewEngine(tae),
+		clock:  clock,
+		mheap:  mheap,
+	}
+	h.transactions.Map = make(map[string]*Transaction)
+	h.iterators.Map = make(map[string]*Iter)
+	h.keys.prepared = make(map[string]int)
+	h.keys.committing = make(map[string]int)
+	return h
+}
+
+var _ txnstorage.Handler = new(handler)
+
+// The ids handed to the CN are made of names instead of the ids of the TAE
+// catalog: a prepared transaction applies its writes again on recovery, which
+// gives the databases and tables it created new catalog ids, while the ids
+// already held by the CN must stay valid.
+// A database id also carries the access info, relation requests don't.
+
+func databaseID(info txnengine.AccessInfo, name string) string {
+	return fmt.Sprintf("%d-%d-%d-%s", info.AccountID, info.UserID, info.RoleID, name)
+}
+
+func parseDatabaseID(id string) (info txnengine.AccessInfo, name string, ok bool) {
+	parts := strings.SplitN(id, "-", 4)
+	if len(parts) != 4 {
+		return
+	}
+	var ids [3]uint64
+	for i := range ids {
+		var err error
+		if ids[i], err = strconv.ParseUint(parts[i], 10, 32); err != nil {
+			return
+		}
+	}
+	info.AccountID = uint32(ids[0])
+	info.UserID = uint32(ids[1])
+	info.RoleID = uint32(ids[2])
+	return info, parts[3], true
+}
+
+func relationID(dbID string, name string) string {
+	return dbID + "\x00" + name
+}
+
+func parseRelationID(id string) (dbID string, name string, ok bool) {
+	i := strings.LastIndexByte(id, 0)
+	if i < 0 {
+		return
+	}
+	return id[:i], id[i+1:], true
+}
+
+func accessContext(info txnengine.AccessInfo) context.Context {
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, defines.TenantIDKey{}, info.AccountID)
+	ctx = context.WithValue(ctx, defines.UserIDKey{}, info.UserID)
+	ctx = context.WithValue(ctx, defines.RoleIDKey{}, info.RoleID)
+	return ctx
+}
+
+func isNotFound(err error) bool {
+	return errors.Is(err, catalog.ErrNotFound)
+}
+
+func isConflict(err error) bool {
+	return errors.Is(err, txnif.ErrTxnWWConflict) ||
+		errors.Is(err, txnif.ErrTxnRWConflict)
+}
+
+// toStorageError converts the errors of TAE the txn service knows about
+func toStorageError(err error) error {
+	if isConflict(err) {
+		return storage.ErrWriteConflict
+	}
+	return err
+}
+
+func (h *handler) getTx(meta txn.TxnMeta) (*Transaction, error) {
+	id := string(meta.ID)
+	h.transactions.Lock()
+	defer h.transactions.Unlock()
+	tx, ok := h.transactions.Map[id]
+	if !ok {
+		// the id is kept by TAE in the commit record of the transaction, see Storage.StartRecovery
+		taeTxn, err := h.db.StartTxn(meta.ID)
+		if err != nil {
+			return nil, err
+		}
+		tx = newTransaction(meta, taeTxn)
+		h.transactions.Map[id] = tx
+	}
+	return tx, nil
+}
+
+func newTransaction(meta txn.TxnMeta, taeTxn txnif.AsyncTxn) *Transaction {
+	return &Transaction{
+		Meta:     meta,
+		Txn:      taeTxn,
+		Operator: moengine.TxnToTxnOperator(taeTxn),
+	}
+}
+
+func (h *handler) takeTx(meta txn.TxnMeta) *Transaction {
+	id := string(meta.ID)
+	h.transactions.Lock()
+	defer h.transactions.Unlock()
+	tx := h.transactions.Map[id]
+	delete(h.transactions.Map, id)
+	return tx
+}
+
+func (h *handler) addWrite(meta txn.TxnMeta, op uint32, payload []byte) {
+	h.transactions.Lock()
+	defer h.transactions.Unlock()
+	tx, ok := h.transactions.Map[string(meta.ID)]
+	if !ok {
+		return
+	}
+	// payload is not safe to hold after the request returns
+	buf := make([]byte, len(payload))
+	copy(buf, payload)
+	tx.Writes = append(tx.Writes, Write{
+		Op:      op,
+		Payload: buf,
+	})
+}
+
+func (h *handler) addKeys(ctx context.Context, tx *Transaction, rel engine.Relation, id string, bat *batch.Batch) error {
+	attrs, err := rel.GetPrimaryKeys(ctx)
+	if err != nil || len(attrs) == 0 {
+		return err
+	}
+	vecs := make([]*vector.Vector, len(attrs))
+	for i, attr := range attrs {
+		for j, name := range bat.Attrs {
+			if name == attr.Name {
+				vecs[i] = bat.Vecs[j]
+			}
+		}
+		if vecs[i] == nil {
+			return nil
+		}
+	}
+
+	h.transactions.Lock()
+	defer h.transactions.Unlock()
+	if tx.Keys == nil {
+		tx.Keys = make(map[string]bool)
+	}
+	for row := 0; row < vector.Length(vecs[0]); row++ {
+		key := []byte(id)
+		for i, vec := range vecs {
+			value := types.EncodeValue(moengine.GetValue(vec, uint32(row)), attrs[i].Type)
+			key = append(key, 0)
+			key = append(key, types.EncodeFixed(uint32(len(value)))...)
+			key = append(key, value...)
+		}
+		tx.Keys[string(key)] = true
+	}
+	return nil
+}
+
+func (h *handler) getDatabase(tx *Transaction, id string) (engine.Database, context.Context, error) {
+	info, name, ok := parseDatabaseID(id)
+	if !ok {
+		return nil, nil, catalog.ErrNotFound
+	}
+	ctx := accessContext(info)
+	db, err := h.engine.Database(ctx, name, tx.Operator)
+	if err != nil {
+		return nil, nil, err
+	}
+	return db, ctx, nil
+}
+
+func (h *handler) getRelation(tx *Transaction, id string) (engine.Relation, context.Context, error) {
+	dbID, name, ok := parseRelationID(id)
+	if !ok {
+		return nil, nil, catalog.ErrNotFound
+	}
+	db, ctx, err := h.getDatabase(tx, dbID)
+	if err != nil {
+		return nil, nil, err
+	}
+	rel, err := db.Relation(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rel, ctx, nil
+}
+
+func (h *handler) HandleCreateDatabase(meta txn.TxnMeta, req txnengine.CreateDatabaseReq, resp *txnengine.CreateDatabaseResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	err = h.engine.Create(accessContext(req.AccessInfo), req.Name, tx.Operator)
+	if errors.Is(err, catalog.ErrDuplicate) {
+		resp.ErrExisted = true
+		return nil
+	}
+	if err != nil {
+		return toStorageError(err)
+	}
+	resp.ID = databaseID(req.AccessInfo, req.Name)
+	return nil
+}
+
+func (h *handler) HandleOpenDatabase(meta txn.TxnMeta, req txnengine.OpenDatabaseReq, resp *txnengine.OpenDatabaseResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	_, err = h.engine.Database(accessContext(req.AccessInfo), req.Name, tx.Operator)
+	if isNotFound(err) {
+		resp.ErrNotFound.Name = req.Name
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	resp.ID = databaseID(req.AccessInfo, req.Name)
+	return nil
+}
+
+func (h *handler) HandleGetDatabases(meta txn.TxnMeta, req txnengine.GetDatabasesReq, resp *txnengine.GetDatabasesResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	names, err := h.engine.Databases(accessContext(req.AccessInfo), tx.Operator)
+	if err != nil {
+		return err
+	}
+	resp.Names = names
+	return nil
+}
+
+func (h *handler) HandleDeleteDatabase(meta txn.TxnMeta, req txnengine.DeleteDatabaseReq, resp *txnengine.DeleteDatabaseResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	err = h.engine.Delete(accessContext(req.AccessInfo), req.Name, tx.Operator)
+	if isNotFound(err) {
+		resp.ErrNotFound.Name = req.Name
+		return nil
+	}
+	if errors.Is(err, catalog.ErrNotPermitted) {
+		resp.ErrReadOnly.Why = err.Error()
+		return nil
+	}
+	if err != nil {
+		return toStorageError(err)
+	}
+	resp.ID = databaseID(req.AccessInfo, req.Name)
+	return nil
+}
+
+func (h *handler) HandleCreateRelation(meta txn.TxnMeta, req txnengine.CreateRelationReq, resp *txnengine.CreateRelationResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	db, ctx, err := h.getDatabase(tx, req.DatabaseID)
+	if isNotFound(err) {
+		resp.ErrDatabaseNotFound.ID = req.DatabaseID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// the defs are validated before the name is checked by TAE
+	if _, err := db.Relation(ctx, req.Name); err == nil {
+		resp.ErrExisted = true
+		return nil
+	}
+	err = db.Create(ctx, req.Name, req.Defs)
+	if errors.Is(err, catalog.ErrDuplicate) {
+		resp.ErrExisted = true
+		return nil
+	}
+	if err != nil {
+		return toStorageError(err)
+	}
+	resp.ID = relationID(req.DatabaseID, req.Name)
+	return nil
+}
+
+func (h *handler) HandleDeleteRelation(meta txn.TxnMeta, req txnengine.DeleteRelationReq, resp *txnengine.DeleteRelationResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	db, ctx, err := h.getDatabase(tx, req.DatabaseID)
+	if isNotFound(err) {
+		// the caller expects no error if table not exist
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = db.Delete(ctx, req.Name)
+	if isNotFound(err) {
+		// the caller expects no error if table not exist
+		return nil
+	}
+	if err != nil {
+		return toStorageError(err)
+	}
+	resp.ID = relationID(req.DatabaseID, req.Name)
+	return nil
+}
+
+func (h *handler) HandleOpenRelation(meta txn.TxnMeta, req txnengine.OpenRelationReq, resp *txnengine.OpenRelationResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	id := relationID(req.DatabaseID, req.Name)
+	rel, ctx, err := h.getRelation(tx, id)
+	if isNotFound(err) {
+		resp.ErrNotFound.Name = req.Name
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return err
+	}
+	resp.ID = id
+	resp.Type = txnengine.RelationTable
+	for _, def := range defs {
+		if _, ok := def.(*engine.ViewDef); ok {
+			resp.Type = txnengine.RelationView
+			break
+		}
+	}
+	return nil
+}
+
+func (h *handler) HandleGetRelations(meta txn.TxnMeta, req txnengine.GetRelationsReq, resp *txnengine.GetRelationsResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	db, ctx, err := h.getDatabase(tx, req.DatabaseID)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	names, err := db.Relations(ctx)
+	if err != nil {
+		return err
+	}
+	resp.Names = names
+	return nil
+}
+
+func (h *handler) HandleAddTableDef(meta txn.TxnMeta, req txnengine.AddTableDefReq, resp *txnengine.AddTableDefResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	rel, ctx, err := h.getRelation(tx, req.TableID)
+	if isNotFound(err) {
+		resp.ErrTableNotFound.ID = req.TableID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = rel.AddTableDef(ctx, req.Def)
+	if errors.Is(err, moengine.ErrReadOnly) {
+		resp.ErrReadOnly.Why = err.Error()
+		return nil
+	}
+	return toStorageError(err)
+}
+
+func (h *handler) HandleDelTableDef(meta txn.TxnMeta, req txnengine.DelTableDefReq, resp *txnengine.DelTableDefResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	rel, ctx, err := h.getRelation(tx, req.TableID)
+	if isNotFound(err) {
+		resp.ErrTableNotFound.ID = req.TableID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = rel.DelTableDef(ctx, req.Def)
+	if errors.Is(err, moengine.ErrReadOnly) {
+		resp.ErrReadOnly.Why = err.Error()
+		return nil
+	}
+	return toStorageError(err)
+}
+
+func (h *handler) HandleGetPrimaryKeys(meta txn.TxnMeta, req txnengine.GetPrimaryKeysReq, resp *txnengine.GetPrimaryKeysResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	rel, ctx, err := h.getRelation(tx, req.TableID)
+	if isNotFound(err) {
+		resp.ErrTableNotFound.ID = req.TableID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	attrs, err := rel.GetPrimaryKeys(ctx)
+	if err != nil {
+		return err
+	}
+	resp.Attrs = attrs
+	return nil
+}
+
+func (h *handler) HandleGetTableDefs(meta txn.TxnMeta, req txnengine.GetTableDefsReq, resp *txnengine.GetTableDefsResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	rel, ctx, err := h.getRelation(tx, req.TableID)
+	if isNotFound(err) {
+		// the caller expects no error if table not exist
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return err
+	}
+	resp.Defs = defs
+	return nil
+}
+
+func (h *handler) HandleGetHiddenKeys(meta txn.TxnMeta, req txnengine.GetHiddenKeysReq, resp *txnengine.GetHiddenKeysResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	rel, ctx, err := h.getRelation(tx, req.TableID)
+	if isNotFound(err) {
+		resp.ErrTableNotFound.ID = req.TableID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	attrs, err := rel.GetHideKeys(ctx)
+	if err != nil {
+		return err
+	}
+	resp.Attrs = attrs
+	return nil
+}
+
+func (h *handler) HandleNewTableIter(meta txn.TxnMeta, req txnengine.NewTableIterReq, resp *txnengine.NewTableIterResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	rel, ctx, err := h.getRelation(tx, req.TableID)
+	if isNotFound(err) {
+		resp.ErrTableNotFound.ID = req.TableID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	attrs, err := relationAttrs(ctx, rel)
+	if err != nil {
+		return err
+	}
+	readers, err := rel.NewReader(ctx, 1, req.Expr, req.Shards)
+	if err != nil {
+		return err
+	}
+
+	h.iterators.Lock()
+	defer h.iterators.Unlock()
+	id := uuid.NewString()
+	resp.IterID = id
+	h.iterators.Map[id] = &Iter{
+		Reader: readers[0],
+		Attrs:  attrs,
+	}
+
+	return nil
+}
+
+func (h *handler) HandleRead(meta txn.TxnMeta, req txnengine.ReadReq, resp *txnengine.ReadResp) error {
+	resp.SetHeap(h.mheap)
+
+	h.iterators.Lock()
+	iter, ok := h.iterators.Map[req.IterID]
+	if !ok {
+		h.iterators.Unlock()
+		resp.ErrIterNotFound.ID = req.IterID
+		return nil
+	}
+	h.iterators.Unlock()
+
+	for _, name := range req.ColNames {
+		if !iter.Attrs[name] {
+			resp.ErrColumnNotFound.Name = name
+			return nil
+		}
+	}
+
+	bat, err := iter.Reader.Read(req.ColNames, nil, h.mheap)
+	if err != nil {
+		return err
+	}
+	resp.Batch = bat
+
+	return nil
+}
+
+func (h *handler) HandleCloseTableIter(meta txn.TxnMeta, req txnengine.CloseTableIterReq, resp *txnengine.CloseTableIterResp) error {
+	h.iterators.Lock()
+	defer h.iterators.Unlock()
+	iter, ok := h.iterators.Map[req.IterID]
+	if !ok {
+		resp.ErrIterNotFound.ID = req.IterID
+		return nil
+	}
+	delete(h.iterators.Map, req.IterID)
+	return iter.Reader.Close()
+}
+
+func (h *handler) HandleDelete(meta txn.TxnMeta, req txnengine.DeleteReq, resp *txnengine.DeleteResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	rel, ctx, err := h.getRelation(tx, req.TableID)
+	if isNotFound(err) {
+		resp.ErrTableNotFound.ID = req.TableID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	attrs, err := relationAttrs(ctx, rel)
+	if err != nil {
+		return err
+	}
+	if !attrs[req.ColumnName] {
+		resp.ErrColumnNotFound.Name = req.ColumnName
+		return nil
+	}
+	err = rel.Delete(ctx, req.Vector, req.ColumnName)
+	if errors.Is(err, moengine.ErrReadOnly) {
+		resp.ErrReadOnly.Why = err.Error()
+		return nil
+	}
+	return toStorageError(err)
+}
+
+func (h *handler) HandleTruncate(meta txn.TxnMeta, req txnengine.TruncateReq, resp *txnengine.TruncateResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	rel, ctx, err := h.getRelation(tx, req.TableID)
+	if isNotFound(err) {
+		resp.ErrTableNotFound.ID = req.TableID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	rows, err := rel.Truncate(ctx)
+	if err != nil {
+		return toStorageError(err)
+	}
+	resp.AffectedRows = int64(rows)
+	return nil
+}
+
+func (h *handler) HandleUpdate(meta txn.TxnMeta, req txnengine.UpdateReq, resp *txnengine.UpdateResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	rel, ctx, err := h.getRelation(tx, req.TableID)
+	if isNotFound(err) {
+		resp.ErrTableNotFound.ID = req.TableID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = rel.Update(ctx, req.Batch)
+	if errors.Is(err, moengine.ErrReadOnly) {
+		resp.ErrReadOnly.Why = err.Error()
+		return nil
+	}
+	return toStorageError(err)
+}
+
+func (h *handler) HandleWrite(meta txn.TxnMeta, req txnengine.WriteReq, resp *txnengine.WriteResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	rel, ctx, err := h.getRelation(tx, req.TableID)
+	if isNotFound(err) {
+		resp.ErrTableNotFound.ID = req.TableID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = rel.Write(ctx, req.Batch)
+	if errors.Is(err, moengine.ErrReadOnly) {
+		resp.ErrReadOnly.Why = err.Error()
+		return nil
+	}
+	if err != nil {
+		return toStorageError(err)
+	}
+	return h.addKeys(ctx, tx, rel, req.TableID, req.Batch)
+}
+
+func (h *handler) HandleTableStats(meta txn.TxnMeta, req txnengine.TableStatsReq, resp *txnengine.TableStatsResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	rel, ctx, err := h.getRelation(tx, req.TableID)
+	if isNotFound(err) {
+		resp.ErrTableNotFound.ID = req.TableID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	rows, err := rel.Rows(ctx)
+	if err != nil {
+		return err
+	}
+	resp.Rows = int(rows)
+	return nil
+}
+
+func (h *handler) HandleGetLogTail(meta txn.TxnMeta, req txnengine.GetLogTailReq, resp *txnengine.GetLogTailResp) error {
+	tx, err := h.getTx(meta)
+	if err != nil {
+		return err
+	}
+	rel, ctx, err := h.getRelation(tx, req.TableID)
+	if isNotFound(err) {
+		resp.ErrRelationNotFound.ID = req.TableID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	dbID, tableName, _ := parseRelationID(req.TableID)
+	_, dbName, _ := parseDatabaseID(dbID)
+	dbHandle, err := tx.Txn.GetDatabase(dbName)
+	if err != nil {
+		return err
+	}
+	tableNumberID, err := strconv.ParseUint(rel.GetTableID(ctx), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	// attributes
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return err
+	}
+	hiddenKeys, err := rel.GetHideKeys(ctx)
+	if err != nil {
+		return err
+	}
+	var insertNames, deleteNames []string
+	var deleteTypes []*engine.Attribute
+	for _, def := range defs {
+		attr, ok := def.(*engine.AttributeDef)
+		if !ok {
+			continue
+		}
+		insertNames = append(insertNames, attr.Attr.Name)
+		if attr.Attr.Primary {
+			deleteNames = append(deleteNames, attr.Attr.Name)
+			deleteTypes = append(deleteTypes, &attr.Attr)
+		}
+	}
+	for _, key := range hiddenKeys {
+		insertNames = append(insertNames, key.Name)
+		deleteNames = append(deleteNames, key.Name)
+		deleteTypes = append(deleteTypes, key)
+	}
+
+	addEntry := func(typ apipb.Entry_EntryType, bat *batch.Batch) error {
+		pbBatch, err := toPBBatch(bat)
+		if err != nil {
+			return err
+		}
+		resp.Response.Commands = append(resp.Response.Commands, &apipb.Entry{
+			EntryType:    typ,
+			Bat:          pbBatch,
+			TableId:      tableNumberID,
+			TableName:    tableName,
+			DatabaseId:   dbHandle.GetID(),
+			DatabaseName: dbName,
+		})
+		return nil
+	}
+
+	if req.Request.CnHave != nil {
+		relHandle, err := dbHandle.GetRelationByName(tableName)
+		if err != nil {
+			return err
+		}
+		start := types.BuildTS(req.Request.CnHave.PhysicalTime, req.Request.CnHave.LogicalTime)
+		end := tx.Txn.GetStartTS()
+		if want := req.Request.CnWant; want != nil {
+			end = types.BuildTS(want.PhysicalTime, want.LogicalTime)
+		}
+		return h.collectLogTail(
+			relHandle.GetMeta().(*catalog.TableEntry),
+			start, end,
+			insertNames, deleteNames,
+			addEntry,
+		)
+	}
+
+	// the rows visible to the transaction are all inserts
+	readers, err := rel.NewReader(ctx, 1, nil, nil)
+	if err != nil {
+		return err
+	}
+	reader := readers[0]
+	defer reader.Close()
+	for {
+		bat, err := reader.Read(insertNames, nil, h.mheap)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			break
+		}
+		err = addEntry(apipb.Entry_Insert, bat)
+		bat.Clean(h.mheap)
+		if err != nil {
+			return err
+		}
+	}
+
+	deleteBatch := batch.New(false, deleteNames)
+	for i, attr := range deleteTypes {
+		deleteBatch.Vecs[i] = vector.New(attr.Type)
+	}
+	return addEntry(apipb.Entry_Delete, deleteBatch)
+}
+
+// collectLogTail adds the rows appended and deleted by the transactions
+// committed in (start, end] to the log tail, the blocks changed by them are
+// found by db.LogtailMgr
+func (h *handler) collectLogTail(
+	table *catalog.TableEntry,
+	start, end types.TS,
+	insertNames, deleteNames []string,
+	addEntry func(apipb.Entry_EntryType, *batch.Batch) error,
+) error {
+	schema := table.GetSchema()
+	deleteIdxs := make([]int, len(deleteNames))
+	for i, name := range deleteNames {
+		deleteIdxs[i] = schema.GetColIdx(name)
+	}
+
+	addBatch := func(typ apipb.Entry_EntryType, names []string, bat *containers.Batch) error {
+		if bat == nil {
+			return nil
+		}
+		defer bat.Close()
+		moBatch := batch.New(true, names)
+		for i, name := range names {
+			moBatch.Vecs[i] = moengine.CopyToMoVector(bat.GetVectorByName(name))
+		}
+		return addEntry(typ, moBatch)
+	}
+
+	// the segments and blocks are visited in the order they are created
+	dirties := h.db.LogtailMgr.GetLogtailView(start.Next(), end, table.GetID()).GetDirtyPoints()
+	segs := dirties.Segs
+	sort.Slice(segs, func(i, j int) bool {
+		return segs[i].Sig < segs[j].Sig
+	})
+	for _, dirty := range segs {
+		seg, err := table.GetSegmentByID(dirty.Sig)
+		if err != nil {
+			return err
+		}
+		sort.Slice(dirty.Blks, func(i, j int) bool {
+			return dirty.Blks[i] < dirty.Blks[j]
+		})
+		for _, id := range dirty.Blks {
+			blkEntry, err := seg.GetBlockEntryByID(id)
+			if err != nil {
+				return err
+			}
+			blk := blkEntry.GetBlockData()
+			inserts, err := blk.CollectAppendInRange(start, end)
+			if err != nil {
+				return err
+			}
+			if err := addBatch(apipb.Entry_Insert, insertNames, inserts); err != nil {
+				return err
+			}
+			deletes, err := blk.CollectDeleteInRange(start, end, deleteIdxs)
+			if err != nil {
+				return err
+			}
+			if err := addBatch(apipb.Entry_Delete, deleteNames, deletes); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func relationAttrs(ctx context.Context, rel engine.Relation) (map[string]bool, error) {
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hiddenKeys, err := rel.GetHideKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attrs := make(map[string]bool)
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			attrs[attr.Attr.Name] = true
+		}
+	}
+	for _, key := range hiddenKeys {
+		attrs[key.Name] = true
+	}
+	return attrs, nil
+}
+
+func toPBBatch(bat *batch.Batch) (*apipb.Batch, error) {
+	ret := new(apipb.Batch)
+	ret.Attrs = bat.Attrs
+	for _, vec := range bat.Vecs {
+		pbVector, err := vector.VectorToProtoVector(vec)
+		if err != nil {
+			return nil, err
+		}
+		ret.Vecs = append(ret.Vecs, pbVector)
+	}
+	return ret, nil
+}
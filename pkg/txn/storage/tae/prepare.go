@@ -0,0 +1,415 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taestorage
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"os"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+// The 2PC state of the transactions is logged to wal.GroupPrepare of TAE.
+// A prepare record holds the metadata and the write requests of a
+// transaction, a committing record the metadata decided by the coordinator,
+// and a commit or rollback record the id of the resolved transaction. The
+// records of a resolved transaction are checkpointed so that they don't hold
+// back the truncation of the wal.
+const (
+	etPrepare = entry.ETCustomizedStart + 200 + iota
+	etCommitting
+	etCommit
+	etRollback
+)
+
+type txnRecord struct {
+	Meta   []byte
+	Writes []Write
+}
+
+func encodeTxnRecord(meta txn.TxnMeta, writes []Write) ([]byte, error) {
+	metaBytes, err := meta.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	buf := new(bytes.Buffer)
+	if err := gob.NewEncoder(buf).Encode(txnRecord{
+		Meta:   metaBytes,
+		Writes: writes,
+	}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeTxnRecord(payload []byte) (meta txn.TxnMeta, writes []Write, err error) {
+	var record txnRecord
+	if err = gob.NewDecoder(bytes.NewReader(payload)).Decode(&record); err != nil {
+		return
+	}
+	if err = meta.Unmarshal(record.Meta); err != nil {
+		return
+	}
+	writes = record.Writes
+	return
+}
+
+func (h *handler) logRecord(typ uint16, txnID uint64, payload []byte) (uint64, error) {
+	e := entry.GetBase()
+	defer e.Free()
+	e.SetType(typ)
+	if err := e.SetPayload(payload); err != nil {
+		return 0, err
+	}
+	e.SetInfo(&entry.Info{
+		Group: wal.GroupPrepare,
+		TxnId: txnID,
+	})
+	lsn, err := h.db.Wal.AppendEntry(wal.GroupPrepare, e)
+	if err != nil {
+		return 0, err
+	}
+	if err := e.WaitDone(); err != nil {
+		return 0, err
+	}
+	return lsn, nil
+}
+
+func (h *handler) checkpoint(lsns []uint64) error {
+	for _, lsn := range lsns {
+		e, err := h.db.Wal.RangeCheckpoint(wal.GroupPrepare, lsn, lsn)
+		if err != nil {
+			return err
+		}
+		if err := e.WaitDone(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *handler) resolve(typ uint16, tx *Transaction) error {
+	lsn, err := h.logRecord(typ, tx.Txn.GetID(), tx.Meta.ID)
+	if err != nil {
+		return err
+	}
+	return h.checkpoint(append(tx.LSNs, lsn))
+}
+
+func (h *handler) lookupTx(meta txn.TxnMeta) (*Transaction, bool) {
+	h.transactions.Lock()
+	defer h.transactions.Unlock()
+	tx, ok := h.transactions.Map[string(meta.ID)]
+	return tx, ok
+}
+
+// lockKeys takes the primary keys appended by the transaction, it fails with
+// storage.ErrWriteConflict if one of them is taken by a prepared transaction,
+// or by a committing transaction when the transaction is prepared.
+// TAE finds the duplicated keys of concurrent transactions when they commit,
+// the keys are held by a prepared transaction until it's resolved so that the
+// checks done by the prepare still hold when the commit comes. The deletes and
+// updates conflict when they are applied.
+func (h *handler) lockKeys(tx *Transaction, prepare bool) error {
+	h.keys.Lock()
+	defer h.keys.Unlock()
+	for key := range tx.Keys {
+		if h.keys.prepared[key] > 0 || prepare && h.keys.committing[key] > 0 {
+			return storage.ErrWriteConflict
+		}
+	}
+	h.addKeysLocked(tx, prepare)
+	return nil
+}
+
+func (h *handler) addKeysLocked(tx *Transaction, prepared bool) {
+	keys := h.keys.committing
+	if prepared {
+		keys = h.keys.prepared
+	}
+	for key := range tx.Keys {
+		keys[key]++
+	}
+}
+
+func (h *handler) unlockKeys(tx *Transaction, prepared bool) {
+	h.keys.Lock()
+	defer h.keys.Unlock()
+	keys := h.keys.committing
+	if prepared {
+		keys = h.keys.prepared
+	}
+	for key := range tx.Keys {
+		if keys[key]--; keys[key] == 0 {
+			delete(keys, key)
+		}
+	}
+}
+
+func (h *handler) HandlePrepare(meta txn.TxnMeta) (timestamp.Timestamp, error) {
+	tx, ok := h.lookupTx(meta)
+	if !ok {
+		return timestamp.Timestamp{}, storage.ErrMissingTxn
+	}
+
+	if err := h.lockKeys(tx, true); err != nil {
+		return timestamp.Timestamp{}, err
+	}
+	// a duplicate found now is written by a transaction committed after
+	// this one started
+	if err := tx.Txn.GetStore().CheckConflicts(); err != nil {
+		h.unlockKeys(tx, true)
+		if errors.Is(err, data.ErrDuplicate) {
+			return timestamp.Timestamp{}, storage.ErrWriteConflict
+		}
+		return timestamp.Timestamp{}, toStorageError(err)
+	}
+
+	meta.PreparedTS, _ = h.clock.Now()
+	meta.Status = txn.TxnStatus_Prepared
+	payload, err := encodeTxnRecord(meta, tx.Writes)
+	if err != nil {
+		h.unlockKeys(tx, true)
+		return timestamp.Timestamp{}, err
+	}
+	lsn, err := h.logRecord(etPrepare, tx.Txn.GetID(), payload)
+	if err != nil {
+		h.unlockKeys(tx, true)
+		return timestamp.Timestamp{}, err
+	}
+	tx.Meta = meta
+	tx.LSNs = append(tx.LSNs, lsn)
+	tx.Prepared = true
+
+	return meta.PreparedTS, nil
+}
+
+func (h *handler) HandleCommitting(meta txn.TxnMeta) error {
+	tx, ok := h.lookupTx(meta)
+	if !ok {
+		return storage.ErrMissingTxn
+	}
+
+	meta.Status = txn.TxnStatus_Committing
+	payload, err := encodeTxnRecord(meta, nil)
+	if err != nil {
+		return err
+	}
+	lsn, err := h.logRecord(etCommitting, tx.Txn.GetID(), payload)
+	if err != nil {
+		return err
+	}
+	tx.Meta = meta
+	tx.LSNs = append(tx.LSNs, lsn)
+
+	return nil
+}
+
+func (h *handler) HandleCommit(meta txn.TxnMeta) error {
+	tx := h.takeTx(meta)
+	if tx == nil {
+		return nil
+	}
+
+	if !tx.Prepared {
+		if err := h.lockKeys(tx, false); err != nil {
+			if e := tx.Txn.Rollback(); e != nil {
+				return e
+			}
+			return err
+		}
+	}
+	defer h.unlockKeys(tx, tx.Prepared)
+
+	// the TAE transaction is committed at the timestamp decided by the
+	// coordinator, the clock shared with TAE is moved past it so that the
+	// transactions started later see the commit. A transaction restored on
+	// recovery starts before its prepared timestamp, see restore.
+	var err error
+	if meta.CommitTS.IsEmpty() {
+		err = tx.Txn.Commit()
+	} else {
+		h.clock.Update(meta.CommitTS)
+		err = tx.Txn.CommitAt(types.BuildTS(meta.CommitTS.PhysicalTime, meta.CommitTS.LogicalTime))
+		if errors.Is(err, txnbase.ErrTxnCannotCommitAt) {
+			if e := tx.Txn.Rollback(); e != nil {
+				return e
+			}
+		}
+	}
+	if err != nil {
+		return toStorageError(err)
+	}
+
+	if len(tx.LSNs) == 0 {
+		return nil
+	}
+	return h.resolve(etCommit, tx)
+}
+
+func (h *handler) HandleRollback(meta txn.TxnMeta) error {
+	tx := h.takeTx(meta)
+	if tx == nil {
+		return nil
+	}
+	if tx.Prepared {
+		defer h.unlockKeys(tx, true)
+	}
+
+	if err := tx.Txn.Rollback(); err != nil {
+		return err
+	}
+
+	if len(tx.LSNs) == 0 {
+		return nil
+	}
+	return h.resolve(etRollback, tx)
+}
+
+// restore resolves the transactions logged in wal.GroupPrepare and restores
+// the prepared ones in new TAE transactions, which are reported by
+// HandleStartRecovery
+func (h *handler) restore() error {
+	type pendingTxn struct {
+		meta   txn.TxnMeta
+		writes []Write
+		lsns   []uint64
+	}
+	var ids []string
+	pending := make(map[string]*pendingTxn)
+	var resolved []uint64
+
+	entries := make([]db.PrepareEntry, len(h.db.PrepareLog.Entries))
+	copy(entries, h.db.PrepareLog.Entries)
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].LSN < entries[j].LSN
+	})
+	for _, e := range entries {
+		switch e.Type {
+
+		case etPrepare, etCommitting:
+			meta, writes, err := decodeTxnRecord(e.Payload)
+			if err != nil {
+				return err
+			}
+			id := string(meta.ID)
+			p, ok := pending[id]
+			if !ok {
+				p = &pendingTxn{}
+				pending[id] = p
+				ids = append(ids, id)
+			}
+			p.meta = meta
+			if e.Type == etPrepare {
+				p.writes = writes
+			}
+			p.lsns = append(p.lsns, e.LSN)
+
+		case etCommit, etRollback:
+			id := string(e.Payload)
+			if p, ok := pending[id]; ok {
+				resolved = append(resolved, p.lsns...)
+				delete(pending, id)
+			}
+			resolved = append(resolved, e.LSN)
+
+		}
+	}
+	if err := h.checkpoint(resolved); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		p, ok := pending[id]
+		if !ok {
+			continue
+		}
+
+		if h.db.PrepareLog.IsCommitted(p.meta.ID) {
+			// committed by TAE before the commit record was logged
+			lsn, err := h.logRecord(etCommit, 0, p.meta.ID)
+			if err != nil {
+				return err
+			}
+			if err := h.checkpoint(append(p.lsns, lsn)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// restore the transaction in a new TAE transaction started before
+		// the prepared timestamp, the commit timestamp decided by the
+		// coordinator is not before it
+		preparedTS := types.BuildTS(p.meta.PreparedTS.PhysicalTime, p.meta.PreparedTS.LogicalTime)
+		taeTxn, err := h.db.StartTxnAt(p.meta.ID, preparedTS.Prev())
+		if err != nil {
+			return err
+		}
+		h.transactions.Lock()
+		h.transactions.Map[id] = newTransaction(p.meta, taeTxn)
+		h.transactions.Unlock()
+		for _, write := range p.writes {
+			if err := h.replay(p.meta, write.Op, write.Payload); err != nil {
+				return err
+			}
+		}
+		tx, err := h.getTx(p.meta)
+		if err != nil {
+			return err
+		}
+		tx.Meta = p.meta
+		tx.Writes = p.writes
+		tx.LSNs = p.lsns
+		tx.Prepared = true
+		// the conflicts were checked before the crash
+		h.keys.Lock()
+		h.addKeysLocked(tx, true)
+		h.keys.Unlock()
+
+		h.recovered = append(h.recovered, p.meta)
+	}
+
+	return nil
+}
+
+func (h *handler) HandleStartRecovery(ch chan txn.TxnMeta) {
+	defer close(ch)
+	for _, meta := range h.recovered {
+		ch <- meta
+	}
+	h.recovered = nil
+}
+
+func (h *handler) HandleClose() error {
+	return h.db.Close()
+}
+
+func (h *handler) HandleDestroy() error {
+	if err := h.db.Close(); err != nil {
+		return err
+	}
+	return os.RemoveAll(h.db.Dir)
+}
@@ -24,13 +24,24 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
 	"github.com/matrixorigin/matrixone/pkg/txn/storage"
+	txnstorage "github.com/matrixorigin/matrixone/pkg/txn/storage/txn"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 )
 
+// Storage is a TxnStorage on top of TAE. The requests are decoded as the
+// memory storage does and every DN transaction runs in a TAE transaction.
 type Storage struct {
 	shard     metadata.DNShard
 	logClient logservice.Client
 	fs        fileservice.FileService
 	clock     clock.Clock
+
+	handler *handler
+	storage *txnstorage.Storage
 }
 
 func New(
@@ -38,59 +49,90 @@ func New(
 	logClient logservice.Client,
 	fs fileservice.FileService,
 	clock clock.Clock,
+	dir string,
 ) (*Storage, error) {
 
+	tae, err := db.Open(dir, &options.Options{
+		Clock: clock,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	h := newHandler(tae, clock, mheap.New(gm))
+	s, err := txnstorage.New(h)
+	if err != nil {
+		return nil, err
+	}
+	h.replay = func(meta txn.TxnMeta, op uint32, payload []byte) error {
+		_, err := s.Write(context.TODO(), meta, op, payload)
+		return err
+	}
+	if err := h.restore(); err != nil {
+		tae.Close()
+		return nil, err
+	}
+
 	return &Storage{
 		shard:     shard,
 		logClient: logClient,
 		fs:        fs,
 		clock:     clock,
+		handler:   h,
+		storage:   s,
 	}, nil
 }
 
 var _ storage.TxnStorage = new(Storage)
 
 // Close implements storage.TxnStorage
-func (*Storage) Close(ctx context.Context) error {
-	panic("unimplemented")
+func (s *Storage) Close(ctx context.Context) error {
+	return s.storage.Close(ctx)
 }
 
 // Commit implements storage.TxnStorage
-func (*Storage) Commit(ctx context.Context, txnMeta txn.TxnMeta) error {
-	panic("unimplemented")
+func (s *Storage) Commit(ctx context.Context, txnMeta txn.TxnMeta) error {
+	return s.storage.Commit(ctx, txnMeta)
 }
 
 // Committing implements storage.TxnStorage
-func (*Storage) Committing(ctx context.Context, txnMeta txn.TxnMeta) error {
-	panic("unimplemented")
+func (s *Storage) Committing(ctx context.Context, txnMeta txn.TxnMeta) error {
+	return s.storage.Committing(ctx, txnMeta)
 }
 
 // Destroy implements storage.TxnStorage
-func (*Storage) Destroy(ctx context.Context) error {
-	panic("unimplemented")
+func (s *Storage) Destroy(ctx context.Context) error {
+	return s.storage.Destroy(ctx)
 }
 
 // Prepare implements storage.TxnStorage
-func (*Storage) Prepare(ctx context.Context, txnMeta txn.TxnMeta) (timestamp.Timestamp, error) {
-	panic("unimplemented")
+func (s *Storage) Prepare(ctx context.Context, txnMeta txn.TxnMeta) (timestamp.Timestamp, error) {
+	return s.storage.Prepare(ctx, txnMeta)
 }
 
 // Read implements storage.TxnStorage
-func (*Storage) Read(ctx context.Context, txnMeta txn.TxnMeta, op uint32, payload []byte) (storage.ReadResult, error) {
-	panic("unimplemented")
+func (s *Storage) Read(ctx context.Context, txnMeta txn.TxnMeta, op uint32, payload []byte) (storage.ReadResult, error) {
+	return s.storage.Read(ctx, txnMeta, op, payload)
 }
 
 // Rollback implements storage.TxnStorage
-func (*Storage) Rollback(ctx context.Context, txnMeta txn.TxnMeta) error {
-	panic("unimplemented")
+func (s *Storage) Rollback(ctx context.Context, txnMeta txn.TxnMeta) error {
+	return s.storage.Rollback(ctx, txnMeta)
 }
 
 // StartRecovery implements storage.TxnStorage
-func (*Storage) StartRecovery(context.Context, chan txn.TxnMeta) {
-	panic("unimplemented")
+func (s *Storage) StartRecovery(ctx context.Context, ch chan txn.TxnMeta) {
+	s.storage.StartRecovery(ctx, ch)
 }
 
 // Write implements storage.TxnStorage
-func (*Storage) Write(ctx context.Context, txnMeta txn.TxnMeta, op uint32, payload []byte) ([]byte, error) {
-	panic("unimplemented")
+func (s *Storage) Write(ctx context.Context, txnMeta txn.TxnMeta, op uint32, payload []byte) ([]byte, error) {
+	res, err := s.storage.Write(ctx, txnMeta, op, payload)
+	if err != nil {
+		return nil, err
+	}
+	s.handler.addWrite(txnMeta, op, payload)
+	return res, nil
 }
@@ -0,0 +1,582 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taestorage
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	apipb "github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/txn/clock"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	txnengine "github.com/matrixorigin/matrixone/pkg/vm/engine/txn"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestStorage(t *testing.T, dir string) *Storage {
+	clock := clock.NewHLCClock(func() int64 {
+		return time.Now().UnixNano()
+	}, math.MaxInt)
+	s, err := New(metadata.DNShard{}, nil, nil, clock, dir)
+	assert.Nil(t, err)
+	return s
+}
+
+func newTestTxn(id string) txn.TxnMeta {
+	return txn.TxnMeta{
+		ID:     []byte(id),
+		Status: txn.TxnStatus_Active,
+		SnapshotTS: timestamp.Timestamp{
+			PhysicalTime: time.Now().UnixNano(),
+		},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	s := newTestStorage(t, t.TempDir())
+	defer s.Close(context.TODO())
+
+	txnMeta := newTestTxn("1")
+	relID := createTestTable(t, s, txnMeta)
+
+	// get relations
+	{
+		dbID, _, _ := parseRelationID(relID)
+		resp := testRead[txnengine.GetRelationsResp](
+			t, s, txnMeta,
+			txnengine.OpGetRelations,
+			txnengine.GetRelationsReq{
+				DatabaseID: dbID,
+			},
+		)
+		assert.Equal(t, []string{"table"}, resp.Names)
+	}
+
+	// open relation
+	{
+		dbID, _, _ := parseRelationID(relID)
+		resp := testRead[txnengine.OpenRelationResp](
+			t, s, txnMeta,
+			txnengine.OpOpenRelation,
+			txnengine.OpenRelationReq{
+				DatabaseID: dbID,
+				Name:       "table",
+			},
+		)
+		assert.Empty(t, resp.ErrNotFound)
+		assert.Equal(t, relID, resp.ID)
+		assert.Equal(t, txnengine.RelationTable, resp.Type)
+	}
+
+	// not found
+	{
+		resp := testRead[txnengine.GetPrimaryKeysResp](
+			t, s, txnMeta,
+			txnengine.OpGetPrimaryKeys,
+			txnengine.GetPrimaryKeysReq{
+				TableID: relationID("0-0-0-foo", "bar"),
+			},
+		)
+		assert.NotEmpty(t, resp.ErrTableNotFound.ID)
+	}
+
+	writeTestRows(t, s, txnMeta, relID, 1, 2, 3, 4, 5)
+	assert.Equal(t, 5, countTestRows(t, s, txnMeta, relID))
+	assert.Nil(t, s.Commit(context.TODO(), txnMeta))
+
+	txnMeta = newTestTxn("2")
+	assert.Equal(t, 5, countTestRows(t, s, txnMeta, relID))
+
+	// table stats
+	{
+		resp := testRead[txnengine.TableStatsResp](
+			t, s, txnMeta,
+			txnengine.OpTableStats,
+			txnengine.TableStatsReq{
+				TableID: relID,
+			},
+		)
+		assert.Equal(t, 5, resp.Rows)
+	}
+
+	// log tail
+	{
+		resp := testRead[txnengine.GetLogTailResp](
+			t, s, txnMeta,
+			txnengine.OpGetLogTail,
+			txnengine.GetLogTailReq{
+				TableID: relID,
+			},
+		)
+		assert.Empty(t, resp.ErrRelationNotFound)
+		assert.Equal(t, 2, len(resp.Response.Commands))
+		assert.Equal(t, "table", resp.Response.Commands[0].TableName)
+		assert.Equal(t, "db", resp.Response.Commands[0].DatabaseName)
+	}
+
+	assert.Nil(t, s.Rollback(context.TODO(), txnMeta))
+}
+
+func TestPrepareAndRecovery(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestStorage(t, dir)
+
+	txnMeta := newTestTxn("1")
+	relID := createTestTable(t, s, txnMeta)
+	assert.Nil(t, s.Commit(context.TODO(), txnMeta))
+
+	// in doubt
+	inDoubt := newTestTxn("2")
+	writeTestRows(t, s, inDoubt, relID, 1, 2, 3)
+	preparedTS, err := s.Prepare(context.TODO(), inDoubt)
+	assert.Nil(t, err)
+	assert.False(t, preparedTS.IsEmpty())
+
+	// rolled back
+	txnMeta = newTestTxn("3")
+	writeTestRows(t, s, txnMeta, relID, 4)
+	_, err = s.Prepare(context.TODO(), txnMeta)
+	assert.Nil(t, err)
+	assert.Nil(t, s.Rollback(context.TODO(), txnMeta))
+
+	// committed
+	txnMeta = newTestTxn("4")
+	writeTestRows(t, s, txnMeta, relID, 5)
+	txnMeta.PreparedTS, err = s.Prepare(context.TODO(), txnMeta)
+	assert.Nil(t, err)
+	txnMeta.CommitTS = txnMeta.PreparedTS
+	assert.Nil(t, s.Commit(context.TODO(), txnMeta))
+
+	assert.Nil(t, s.Close(context.TODO()))
+
+	// recover the in doubt transaction
+	s = newTestStorage(t, dir)
+	ch := make(chan txn.TxnMeta, 16)
+	s.StartRecovery(context.TODO(), ch)
+	var recovered []txn.TxnMeta
+	for meta := range ch {
+		recovered = append(recovered, meta)
+	}
+	assert.Equal(t, 1, len(recovered))
+	assert.Equal(t, inDoubt.ID, recovered[0].ID)
+	assert.Equal(t, txn.TxnStatus_Prepared, recovered[0].Status)
+	assert.Equal(t, preparedTS, recovered[0].PreparedTS)
+
+	txnMeta = newTestTxn("5")
+	assert.Equal(t, 1, countTestRows(t, s, txnMeta, relID))
+	assert.Nil(t, s.Rollback(context.TODO(), txnMeta))
+
+	inDoubt = recovered[0]
+	inDoubt.Status = txn.TxnStatus_Committing
+	inDoubt.CommitTS = inDoubt.PreparedTS
+	assert.Nil(t, s.Committing(context.TODO(), inDoubt))
+	assert.Nil(t, s.Commit(context.TODO(), inDoubt))
+
+	txnMeta = newTestTxn("6")
+	assert.Equal(t, 4, countTestRows(t, s, txnMeta, relID))
+	assert.Nil(t, s.Rollback(context.TODO(), txnMeta))
+
+	assert.Nil(t, s.Close(context.TODO()))
+
+	// nothing left to recover
+	s = newTestStorage(t, dir)
+	defer s.Close(context.TODO())
+	ch = make(chan txn.TxnMeta, 16)
+	s.StartRecovery(context.TODO(), ch)
+	for range ch {
+		t.Fatal("should not recover a resolved transaction")
+	}
+
+	txnMeta = newTestTxn("7")
+	assert.Equal(t, 4, countTestRows(t, s, txnMeta, relID))
+	assert.Nil(t, s.Rollback(context.TODO(), txnMeta))
+}
+
+func TestPrepareMissingTxn(t *testing.T) {
+	s := newTestStorage(t, t.TempDir())
+	defer s.Close(context.TODO())
+	_, err := s.Prepare(context.TODO(), newTestTxn("1"))
+	assert.NotNil(t, err)
+}
+
+func TestConflictAtPrepare(t *testing.T) {
+	s := newTestStorage(t, t.TempDir())
+	defer s.Close(context.TODO())
+
+	txnMeta := newTestTxn("1")
+	relID := createTestTable(t, s, txnMeta)
+	assert.Nil(t, s.Commit(context.TODO(), txnMeta))
+
+	txn1 := newTestTxn("2")
+	txn2 := newTestTxn("3")
+	writeTestRows(t, s, txn1, relID, 1)
+	writeTestRows(t, s, txn2, relID, 1)
+	assert.Nil(t, s.Commit(context.TODO(), txn1))
+	_, err := s.Prepare(context.TODO(), txn2)
+	assert.Equal(t, storage.ErrWriteConflict, err)
+	assert.Nil(t, s.Rollback(context.TODO(), txn2))
+
+	// a prepared transaction holds the keys it appends
+	txn1 = newTestTxn("4")
+	txn2 = newTestTxn("5")
+	writeTestRows(t, s, txn1, relID, 2)
+	writeTestRows(t, s, txn2, relID, 2)
+	_, err = s.Prepare(context.TODO(), txn1)
+	assert.Nil(t, err)
+	_, err = s.Prepare(context.TODO(), txn2)
+	assert.Equal(t, storage.ErrWriteConflict, err)
+	assert.Nil(t, s.Rollback(context.TODO(), txn2))
+	assert.Nil(t, s.Rollback(context.TODO(), txn1))
+
+	txnMeta = newTestTxn("6")
+	assert.Equal(t, 1, countTestRows(t, s, txnMeta, relID))
+	assert.Nil(t, s.Rollback(context.TODO(), txnMeta))
+}
+
+func TestIncrementalLogTail(t *testing.T) {
+	s := newTestStorage(t, t.TempDir())
+	defer s.Close(context.TODO())
+
+	txnMeta := newTestTxn("1")
+	relID := createTestTable(t, s, txnMeta)
+	assert.Nil(t, s.Commit(context.TODO(), txnMeta))
+	have, _ := s.clock.Now()
+
+	// committed at the commit timestamp of the coordinator
+	txnMeta = newTestTxn("2")
+	writeTestRows(t, s, txnMeta, relID, 1, 2, 3)
+	preparedTS, err := s.Prepare(context.TODO(), txnMeta)
+	assert.Nil(t, err)
+	txnMeta.CommitTS = preparedTS.Next()
+	assert.Nil(t, s.Commit(context.TODO(), txnMeta))
+	insertTS := txnMeta.CommitTS
+
+	txnMeta = newTestTxn("3")
+	resp := testWrite[txnengine.DeleteResp](
+		t, s, txnMeta,
+		txnengine.OpDelete,
+		txnengine.DeleteReq{
+			TableID:    relID,
+			ColumnName: "a",
+			Vector:     testutil.NewVector(1, types.T_int64.ToType(), testutil.NewMheap(), false, []int64{2}),
+		},
+	)
+	assert.Empty(t, resp.ErrReadOnly)
+	assert.Nil(t, s.Commit(context.TODO(), txnMeta))
+	want, _ := s.clock.Now()
+
+	inserts, deletes := countLogTail(t, s, relID, have, insertTS.Prev())
+	assert.Equal(t, 0, inserts)
+	assert.Equal(t, 0, deletes)
+	inserts, deletes = countLogTail(t, s, relID, have, insertTS)
+	assert.Equal(t, 3, inserts)
+	assert.Equal(t, 0, deletes)
+	inserts, deletes = countLogTail(t, s, relID, have, want)
+	assert.Equal(t, 3, inserts)
+	assert.Equal(t, 1, deletes)
+	inserts, deletes = countLogTail(t, s, relID, insertTS, want)
+	assert.Equal(t, 0, inserts)
+	assert.Equal(t, 1, deletes)
+}
+
+func TestCommitRestoredTxnAtCommitTS(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestStorage(t, dir)
+
+	txnMeta := newTestTxn("1")
+	relID := createTestTable(t, s, txnMeta)
+	assert.Nil(t, s.Commit(context.TODO(), txnMeta))
+
+	inDoubt := newTestTxn("2")
+	writeTestRows(t, s, inDoubt, relID, 1, 2, 3)
+	_, err := s.Prepare(context.TODO(), inDoubt)
+	assert.Nil(t, err)
+
+	// committed after the in doubt transaction is prepared
+	txnMeta = newTestTxn("3")
+	writeTestRows(t, s, txnMeta, relID, 4)
+	assert.Nil(t, s.Commit(context.TODO(), txnMeta))
+	assert.Nil(t, s.Close(context.TODO()))
+
+	s = newTestStorage(t, dir)
+	defer s.Close(context.TODO())
+	ch := make(chan txn.TxnMeta, 16)
+	s.StartRecovery(context.TODO(), ch)
+	var recovered []txn.TxnMeta
+	for meta := range ch {
+		recovered = append(recovered, meta)
+	}
+	assert.Equal(t, 1, len(recovered))
+
+	// the restored transaction is committed at the timestamp of the
+	// coordinator, which is before the transactions replayed by TAE
+	inDoubt = recovered[0]
+	inDoubt.Status = txn.TxnStatus_Committing
+	inDoubt.CommitTS = inDoubt.PreparedTS
+	tx, ok := s.handler.lookupTx(inDoubt)
+	assert.True(t, ok)
+	assert.Nil(t, s.Committing(context.TODO(), inDoubt))
+	assert.Nil(t, s.Commit(context.TODO(), inDoubt))
+	commitTS := types.BuildTS(inDoubt.CommitTS.PhysicalTime, inDoubt.CommitTS.LogicalTime)
+	assert.Equal(t, commitTS, tx.Txn.GetCommitTS())
+
+	txnMeta = newTestTxn("4")
+	assert.Equal(t, 4, countTestRows(t, s, txnMeta, relID))
+	assert.Nil(t, s.Rollback(context.TODO(), txnMeta))
+}
+
+func countLogTail(t *testing.T, s *Storage, relID string, have, want timestamp.Timestamp) (inserts, deletes int) {
+	txnMeta := newTestTxn("4")
+	defer s.Rollback(context.TODO(), txnMeta)
+	resp := testRead[txnengine.GetLogTailResp](
+		t, s, txnMeta,
+		txnengine.OpGetLogTail,
+		txnengine.GetLogTailReq{
+			TableID: relID,
+			Request: apipb.SyncLogTailReq{
+				CnHave: &have,
+				CnWant: &want,
+			},
+		},
+	)
+	assert.Empty(t, resp.ErrRelationNotFound)
+	for _, entry := range resp.Response.Commands {
+		vec, err := vector.ProtoVectorToVector(entry.Bat.Vecs[0])
+		assert.Nil(t, err)
+		switch entry.EntryType {
+		case apipb.Entry_Insert:
+			inserts += vec.Length()
+		case apipb.Entry_Delete:
+			deletes += vec.Length()
+		}
+	}
+	return
+}
+
+func createTestTable(t *testing.T, s *Storage, txnMeta txn.TxnMeta) string {
+
+	// create database
+	{
+		resp := testWrite[txnengine.CreateDatabaseResp](
+			t, s, txnMeta,
+			txnengine.OpCreateDatabase,
+			txnengine.CreateDatabaseReq{
+				Name: "db",
+			},
+		)
+		assert.Equal(t, txnengine.ErrExisted(false), resp.ErrExisted)
+		assert.NotEmpty(t, resp.ID)
+	}
+
+	// open database
+	var dbID string
+	{
+		resp := testRead[txnengine.OpenDatabaseResp](
+			t, s, txnMeta,
+			txnengine.OpOpenDatabase,
+			txnengine.OpenDatabaseReq{
+				Name: "db",
+			},
+		)
+		assert.Empty(t, resp.ErrNotFound)
+		assert.NotEmpty(t, resp.ID)
+		dbID = resp.ID
+	}
+
+	// create relation
+	var relID string
+	{
+		resp := testWrite[txnengine.CreateRelationResp](
+			t, s, txnMeta,
+			txnengine.OpCreateRelation,
+			txnengine.CreateRelationReq{
+				DatabaseID: dbID,
+				Name:       "table",
+				Type:       txnengine.RelationTable,
+				Defs: []engine.TableDef{
+					&engine.AttributeDef{
+						Attr: engine.Attribute{
+							Name:    "a",
+							Type:    types.T_int64.ToType(),
+							Primary: true,
+							Default: &plan.Default{},
+						},
+					},
+					&engine.AttributeDef{
+						Attr: engine.Attribute{
+							Name: "b",
+							Type: types.T_int64.ToType(),
+							Default: &plan.Default{
+								NullAbility: true,
+							},
+						},
+					},
+					&engine.PrimaryIndexDef{
+						Names: []string{"a"},
+					},
+				},
+			},
+		)
+		assert.Equal(t, txnengine.ErrExisted(false), resp.ErrExisted)
+		assert.Empty(t, resp.ErrDatabaseNotFound)
+		assert.NotEmpty(t, resp.ID)
+		relID = resp.ID
+	}
+
+	// create again
+	{
+		resp := testWrite[txnengine.CreateRelationResp](
+			t, s, txnMeta,
+			txnengine.OpCreateRelation,
+			txnengine.CreateRelationReq{
+				DatabaseID: dbID,
+				Name:       "table",
+				Type:       txnengine.RelationTable,
+			},
+		)
+		assert.Equal(t, txnengine.ErrExisted(true), resp.ErrExisted)
+	}
+
+	return relID
+}
+
+func writeTestRows(t *testing.T, s *Storage, txnMeta txn.TxnMeta, relID string, keys ...int64) {
+	heap := testutil.NewMheap()
+	values := make([]int64, len(keys))
+	for i, key := range keys {
+		values[i] = key * 10
+	}
+	bat := batch.New(false, []string{"a", "b"})
+	bat.Vecs[0] = testutil.NewVector(len(keys), types.T_int64.ToType(), heap, false, keys)
+	bat.Vecs[1] = testutil.NewVector(len(keys), types.T_int64.ToType(), heap, false, values)
+	bat.InitZsOne(len(keys))
+	resp := testWrite[txnengine.WriteResp](
+		t, s, txnMeta,
+		txnengine.OpWrite,
+		txnengine.WriteReq{
+			TableID: relID,
+			Batch:   bat,
+		},
+	)
+	assert.Empty(t, resp.ErrReadOnly)
+	assert.Empty(t, resp.ErrTableNotFound)
+}
+
+func countTestRows(t *testing.T, s *Storage, txnMeta txn.TxnMeta, relID string) int {
+	var iterID string
+	{
+		resp := testRead[txnengine.NewTableIterResp](
+			t, s, txnMeta,
+			txnengine.OpNewTableIter,
+			txnengine.NewTableIterReq{
+				TableID: relID,
+			},
+		)
+		assert.Empty(t, resp.ErrTableNotFound)
+		iterID = resp.IterID
+	}
+	n := 0
+	for {
+		resp := testRead[txnengine.ReadResp](
+			t, s, txnMeta,
+			txnengine.OpRead,
+			txnengine.ReadReq{
+				IterID:   iterID,
+				ColNames: []string{"a", "b"},
+			},
+		)
+		assert.Empty(t, resp.ErrIterNotFound)
+		assert.Empty(t, resp.ErrColumnNotFound)
+		if resp.Batch == nil {
+			break
+		}
+		n += resp.Batch.Length()
+	}
+	testRead[txnengine.CloseTableIterResp](
+		t, s, txnMeta,
+		txnengine.OpCloseTableIter,
+		txnengine.CloseTableIterReq{
+			IterID: iterID,
+		},
+	)
+	return n
+}
+
+func testRead[
+	Resp any,
+	Req any,
+](
+	t *testing.T,
+	s *Storage,
+	txnMeta txn.TxnMeta,
+	op uint32,
+	req Req,
+) (
+	resp Resp,
+) {
+
+	buf := new(bytes.Buffer)
+	err := gob.NewEncoder(buf).Encode(req)
+	assert.Nil(t, err)
+
+	res, err := s.Read(context.TODO(), txnMeta, op, buf.Bytes())
+	assert.Nil(t, err)
+	data, err := res.Read()
+	assert.Nil(t, err)
+
+	err = gob.NewDecoder(bytes.NewReader(data)).Decode(&resp)
+	assert.Nil(t, err)
+
+	return
+}
+
+func testWrite[
+	Resp any,
+	Req any,
+](
+	t *testing.T,
+	s *Storage,
+	txnMeta txn.TxnMeta,
+	op uint32,
+	req Req,
+) (
+	resp Resp,
+) {
+
+	buf := new(bytes.Buffer)
+	err := gob.NewEncoder(buf).Encode(req)
+	assert.Nil(t, err)
+
+	data, err := s.Write(context.TODO(), txnMeta, op, buf.Bytes())
+	assert.Nil(t, err)
+
+	err = gob.NewDecoder(bytes.NewReader(data)).Decode(&resp)
+	assert.Nil(t, err)
+
+	return
+}
@@ -244,6 +244,51 @@ func vectorAt(vec *vector.Vector, i int) (value Nullable) {
 		}
 		return
 
+	case types.T_time:
+		if vec.IsScalarNull() {
+			var zero types.Time
+			value = Nullable{
+				IsNull: true,
+				Value:  zero,
+			}
+			return
+		}
+		value = Nullable{
+			IsNull: vec.GetNulls().Contains(uint64(i)),
+			Value:  vec.Col.([]types.Time)[i],
+		}
+		return
+
+	case types.T_enum:
+		if vec.IsScalarNull() {
+			var zero types.Enum
+			value = Nullable{
+				IsNull: true,
+				Value:  zero,
+			}
+			return
+		}
+		value = Nullable{
+			IsNull: vec.GetNulls().Contains(uint64(i)),
+			Value:  vec.Col.([]types.Enum)[i],
+		}
+		return
+
+	case types.T_set:
+		if vec.IsScalarNull() {
+			var zero types.Set
+			value = Nullable{
+				IsNull: true,
+				Value:  zero,
+			}
+			return
+		}
+		value = Nullable{
+			IsNull: vec.GetNulls().Contains(uint64(i)),
+			Value:  vec.Col.([]types.Set)[i],
+		}
+		return
+
 	case types.T_datetime:
 		if vec.IsScalarNull() {
 			var zero types.Datetime
@@ -82,15 +82,16 @@ func (d DatabaseRow) AttrByName(tx *Transaction, name string) (ret Nullable, err
 }
 
 type RelationRow struct {
-	ID           string
-	NumberID     uint64
-	DatabaseID   string
-	Name         string
-	Type         txnengine.RelationType
-	Comments     string
-	Properties   map[string]string
-	PartitionDef string
-	ViewDef      string
+	ID            string
+	NumberID      uint64
+	DatabaseID    string
+	Name          string
+	Type          txnengine.RelationType
+	Comments      string
+	Properties    map[string]string
+	PartitionDef  string
+	ViewDef       string
+	ConstraintDef string
 
 	handler *MemHandler
 }
@@ -164,6 +165,8 @@ type AttributeRow struct {
 	Order      int
 	Nullable   bool
 	engine.Attribute
+	// Fill is the value of the attribute in the rows written before it was added
+	Fill Nullable
 
 	handler *MemHandler
 }
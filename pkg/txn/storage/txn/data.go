@@ -80,5 +80,11 @@ type NamedDataRow struct {
 var _ NamedRow = new(NamedDataRow)
 
 func (n *NamedDataRow) AttrByName(tx *Transaction, name string) (Nullable, error) {
-	return n.Row.attributes[n.AttrsMap[name].ID], nil
+	attr := n.AttrsMap[name]
+	value, ok := n.Row.attributes[attr.ID]
+	if !ok {
+		// the attribute is added after the row is written
+		return attr.Fill, nil
+	}
+	return value, nil
 }
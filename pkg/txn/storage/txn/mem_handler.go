@@ -32,6 +32,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	txnengine "github.com/matrixorigin/matrixone/pkg/vm/engine/txn"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
@@ -148,6 +149,21 @@ func (m *MemHandler) HandleAddTableDef(meta txn.TxnMeta, req txnengine.AddTableD
 			return err
 		}
 
+	case *engine.ConstraintDef:
+		// update
+		row, err := m.relations.Get(tx, Text(req.TableID))
+		if errors.Is(err, sql.ErrNoRows) {
+			resp.ErrTableNotFound.ID = req.TableID
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		row.ConstraintDef = def.Constraint
+		if err := m.relations.Update(tx, *row); err != nil {
+			return err
+		}
+
 	case *engine.ViewDef:
 		// update
 		row, err := m.relations.Get(tx, Text(req.TableID))
@@ -190,6 +206,119 @@ func (m *MemHandler) HandleAddTableDef(meta txn.TxnMeta, req txnengine.AddTableD
 			return err
 		}
 
+	case *engine.AddColumnDef:
+		// add attribute to a relation which may have rows
+		keys, err := m.attributes.Index(tx, Tuple{
+			index_RelationID_Name,
+			Text(req.TableID),
+			Text(def.Attr.Name),
+		})
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			resp.ErrExisted = true
+			return nil
+		}
+		fill := Nullable{
+			IsNull: def.Fill == nil,
+		}
+		if def.Fill != nil {
+			fill.Value = types.DecodeValue(def.Fill, def.Attr.Type)
+		}
+		attrRow := AttributeRow{
+			ID:         uuid.NewString(),
+			RelationID: req.TableID,
+			Order:      maxAttributeOrder + 1,
+			Nullable:   true, //TODO fix
+			Attribute:  def.Attr,
+			Fill:       fill,
+		}
+		if err := m.attributes.Insert(tx, attrRow); err != nil {
+			return err
+		}
+
+	case *engine.ModifyColumnDef:
+		// replace attribute definition, keeping its id and order
+		rows, err := m.attributes.IndexRows(tx, Tuple{
+			index_RelationID_Name,
+			Text(req.TableID),
+			Text(def.Attr.Name),
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			resp.ErrColumnNotFound.Name = def.Attr.Name
+			return nil
+		}
+		row := rows[0]
+		primary, hidden := row.Primary, row.IsHidden
+		row.Attribute = def.Attr
+		row.Primary, row.IsHidden = primary, hidden
+		if err := m.attributes.Update(tx, *row); err != nil {
+			return err
+		}
+
+	case *engine.RenameColumnDef:
+		// rename attribute
+		keys, err := m.attributes.Index(tx, Tuple{
+			index_RelationID_Name,
+			Text(req.TableID),
+			Text(def.NewName),
+		})
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			resp.ErrExisted = true
+			return nil
+		}
+		rows, err := m.attributes.IndexRows(tx, Tuple{
+			index_RelationID_Name,
+			Text(req.TableID),
+			Text(def.OldName),
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			resp.ErrColumnNotFound.Name = def.OldName
+			return nil
+		}
+		row := rows[0]
+		row.Name = def.NewName
+		if err := m.attributes.Update(tx, *row); err != nil {
+			return err
+		}
+
+	case *engine.RenameTableDef:
+		// rename relation
+		row, err := m.relations.Get(tx, Text(req.TableID))
+		if errors.Is(err, sql.ErrNoRows) {
+			resp.ErrTableNotFound.ID = req.TableID
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		keys, err := m.relations.Index(tx, Tuple{
+			index_DatabaseID_Name,
+			Text(row.DatabaseID),
+			Text(def.Name),
+		})
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			resp.ErrExisted = true
+			return nil
+		}
+		row.Name = def.Name
+		if err := m.relations.Update(tx, *row); err != nil {
+			return err
+		}
+
 	case *engine.IndexTableDef:
 		// add index
 		// check existence
@@ -224,6 +353,9 @@ func (m *MemHandler) HandleAddTableDef(meta txn.TxnMeta, req txnengine.AddTableD
 		}
 		for _, prop := range def.Properties {
 			row.Properties[prop.Key] = prop.Value
+			if prop.Key == catalog.SystemRelAttr_Comment {
+				row.Comments = prop.Value
+			}
 		}
 		if err := m.relations.Update(tx, *row); err != nil {
 			return err
@@ -363,6 +495,9 @@ func (m *MemHandler) HandleCreateRelation(meta txn.TxnMeta, req txnengine.Create
 		case *engine.ViewDef:
 			row.ViewDef = def.View
 
+		case *engine.ConstraintDef:
+			row.ConstraintDef = def.Constraint
+
 		case *engine.AttributeDef:
 			relAttrs = append(relAttrs, def.Attr)
 
@@ -797,6 +932,13 @@ func (m *MemHandler) HandleGetTableDefs(meta txn.TxnMeta, req txnengine.GetTable
 		})
 	}
 
+	// constraint
+	if relRow.ConstraintDef != "" {
+		resp.Defs = append(resp.Defs, &engine.ConstraintDef{
+			Constraint: relRow.ConstraintDef,
+		})
+	}
+
 	// view
 	if relRow.ViewDef != "" {
 		resp.Defs = append(resp.Defs, &engine.ViewDef{
@@ -1003,9 +1145,7 @@ func (m *MemHandler) HandleRead(meta txn.TxnMeta, req txnengine.ReadReq, resp *t
 		}
 
 		//TODO handle iter.Expr
-		if iter.Expr != nil {
-			panic(iter.Expr)
-		}
+		// the filter only lets a reader skip rows, it is applied again by the pipeline
 
 		rows = append(rows, Row{
 			Value:       row,
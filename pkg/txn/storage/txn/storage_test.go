@@ -280,6 +280,87 @@ func testDatabase(
 		assert.Equal(t, 5, resp.Batch.Length())
 	}
 
+	// alter table
+	{
+		resp := testWrite[txnengine.AddTableDefResp](
+			t, s, txnMeta,
+			txnengine.OpAddTableDef,
+			txnengine.AddTableDefReq{
+				TableID: relID,
+				Def: &engine.AddColumnDef{
+					Attr: engine.Attribute{
+						Name: "c",
+						Type: types.T_int64.ToType(),
+					},
+					Fill: types.EncodeValue(int64(42), types.T_int64.ToType()),
+				},
+			},
+		)
+		assert.Empty(t, resp.ErrExisted)
+		resp = testWrite[txnengine.AddTableDefResp](
+			t, s, txnMeta,
+			txnengine.OpAddTableDef,
+			txnengine.AddTableDefReq{
+				TableID: relID,
+				Def: &engine.RenameColumnDef{
+					OldName: "b",
+					NewName: "bb",
+				},
+			},
+		)
+		assert.Empty(t, resp.ErrExisted)
+		assert.Empty(t, resp.ErrColumnNotFound)
+	}
+	{
+		resp := testRead[txnengine.NewTableIterResp](
+			t, s, txnMeta,
+			txnengine.OpNewTableIter,
+			txnengine.NewTableIterReq{
+				TableID: relID,
+			},
+		)
+		assert.Empty(t, resp.ErrTableNotFound)
+		iterID = resp.IterID
+	}
+	{
+		resp := testRead[txnengine.ReadResp](
+			t, s, txnMeta,
+			txnengine.OpRead,
+			txnengine.ReadReq{
+				IterID:   iterID,
+				ColNames: []string{"bb", "c"},
+			},
+		)
+		assert.Empty(t, resp.ErrColumnNotFound)
+		assert.Equal(t, 5, resp.Batch.Length())
+		assert.Equal(t, []int64{42, 42, 42, 42, 42}, vector.MustTCols[int64](resp.Batch.Vecs[1]))
+	}
+	{
+		resp := testWrite[txnengine.DelTableDefResp](
+			t, s, txnMeta,
+			txnengine.OpDelTableDef,
+			txnengine.DelTableDefReq{
+				TableID: relID,
+				Def: &engine.AttributeDef{
+					Attr: engine.Attribute{Name: "c"},
+				},
+			},
+		)
+		assert.Empty(t, resp.ErrTableNotFound)
+		addResp := testWrite[txnengine.AddTableDefResp](
+			t, s, txnMeta,
+			txnengine.OpAddTableDef,
+			txnengine.AddTableDefReq{
+				TableID: relID,
+				Def: &engine.RenameColumnDef{
+					OldName: "bb",
+					NewName: "b",
+				},
+			},
+		)
+		assert.Empty(t, addResp.ErrColumnNotFound)
+	}
+
 	// delete by primary key
 	{
 		colA := testutil.NewVector(
@@ -59,6 +59,7 @@ func (s *OptsBuilder) Finish() SessionOverrideOptions {
 
 type InternalExecResult interface {
 	Error() error
+	AffectedRows() uint64
 	ColumnCount() uint64
 	Column(uint64) (string, uint8, bool, error) // type refer: pkg/defines/type.go & func convertEngineTypeToMysqlType
 	RowCount() uint64
@@ -23,6 +23,24 @@ var (
 		},
 		[]string{constTenantKey, "type"},
 	)
+
+	SpillBytesCounterFactory = NewCounterVec(
+		CounterOpts{
+			Subsystem: "sql",
+			Name:      "spill_bytes_total",
+			Help:      "Counter of bytes spilled to disk by sql operators",
+		},
+		[]string{"type"},
+	)
+
+	SpillPartitionsCounterFactory = NewCounterVec(
+		CounterOpts{
+			Subsystem: "sql",
+			Name:      "spill_partitions_total",
+			Help:      "Counter of partitions spilled to disk by sql operators",
+		},
+		[]string{"type"},
+	)
 )
 
 type SQLType string
@@ -38,3 +56,13 @@ var (
 func StatementCounter(tenant string, t SQLType) Counter {
 	return StatementCounterFactory.WithLabelValues(tenant, string(t))
 }
+
+// SpillBytesCounter counts the bytes spilled by the operator typ, such as join, group or order
+func SpillBytesCounter(typ string) Counter {
+	return SpillBytesCounterFactory.WithLabelValues(typ)
+}
+
+// SpillPartitionsCounter counts the partitions spilled by the operator typ
+func SpillPartitionsCounter(typ string) Counter {
+	return SpillPartitionsCounterFactory.WithLabelValues(typ)
+}
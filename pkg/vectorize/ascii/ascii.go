@@ -0,0 +1,27 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ascii
+
+// Ascii gets the code of the first byte of the strings, 0 for the empty string
+func Ascii(xs []string, rs []uint8) []uint8 {
+	for i, x := range xs {
+		if len(x) == 0 {
+			rs[i] = 0
+			continue
+		}
+		rs[i] = x[0]
+	}
+	return rs
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base64
+
+import (
+	"encoding/base64"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+)
+
+func Encode(xs []string, rs []string) []string {
+	for i, x := range xs {
+		rs[i] = base64.StdEncoding.EncodeToString([]byte(x))
+	}
+	return rs
+}
+
+// Decode adds the rows which are not base64 to ns
+func Decode(xs []string, ns *nulls.Nulls, rs []string) []string {
+	for i, x := range xs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		data, err := base64.StdEncoding.DecodeString(x)
+		if err != nil {
+			nulls.Add(ns, uint64(i))
+			continue
+		}
+		rs[i] = string(data)
+	}
+	return rs
+}
@@ -0,0 +1,33 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base64
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase64(t *testing.T) {
+	encoded := Encode([]string{"hello", ""}, make([]string, 2))
+	require.Equal(t, []string{"aGVsbG8=", ""}, encoded)
+
+	ns := nulls.NewWithSize(2)
+	decoded := Decode([]string{"aGVsbG8=", "%%%"}, ns, make([]string, 2))
+	require.Equal(t, "hello", decoded[0])
+	require.False(t, nulls.Contains(ns, 0))
+	require.True(t, nulls.Contains(ns, 1))
+}
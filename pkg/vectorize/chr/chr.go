@@ -0,0 +1,36 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chr
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+)
+
+// Chr gets the characters of the unicode code points, the invalid code points are added to ns
+func Chr(xs []int64, ns *nulls.Nulls, rs []string) []string {
+	for i, x := range xs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		if x < 0 || x > utf8.MaxRune || !utf8.ValidRune(rune(x)) {
+			nulls.Add(ns, uint64(i))
+			continue
+		}
+		rs[i] = string(rune(x))
+	}
+	return rs
+}
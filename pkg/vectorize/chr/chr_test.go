@@ -0,0 +1,30 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chr
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChr(t *testing.T) {
+	ns := nulls.NewWithSize(4)
+	rs := Chr([]int64{65, 20320, -1, 0xD800}, ns, make([]string, 4))
+	require.Equal(t, []string{"A", "你"}, rs[:2])
+	require.True(t, nulls.Contains(ns, 2))
+	require.True(t, nulls.Contains(ns, 3))
+}
@@ -0,0 +1,132 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	TimeToHour       func([]types.Time, []int64) []int64
+	DatetimeToHour   func([]types.Datetime, []int64) []int64
+	TimeToMinute     func([]types.Time, []uint8) []uint8
+	DatetimeToMinute func([]types.Datetime, []uint8) []uint8
+	TimeToSecond     func([]types.Time, []uint8) []uint8
+	DatetimeToSecond func([]types.Datetime, []uint8) []uint8
+	TimeToSec        func([]types.Time, []int64) []int64
+	Int64ToTime      func([]int64, []types.Time) []types.Time
+	Float64ToTime    func([]float64, []types.Time) []types.Time
+	DatetimeToTime   func([]types.Datetime, []types.Time, int32) []types.Time
+	StringToTime     func([]string, []types.Time, int32, *nulls.Nulls) ([]types.Time, error)
+)
+
+func init() {
+	TimeToHour = timeToHour
+	DatetimeToHour = datetimeToHour
+	TimeToMinute = timeToMinute
+	DatetimeToMinute = datetimeToMinute
+	TimeToSecond = timeToSecond
+	DatetimeToSecond = datetimeToSecond
+	TimeToSec = timeToSec
+	Int64ToTime = int64ToTime
+	Float64ToTime = float64ToTime
+	DatetimeToTime = datetimeToTime
+	StringToTime = stringToTime
+}
+
+func timeToHour(xs []types.Time, rs []int64) []int64 {
+	for i, x := range xs {
+		rs[i] = x.Hour()
+	}
+	return rs
+}
+
+func datetimeToHour(xs []types.Datetime, rs []int64) []int64 {
+	for i, x := range xs {
+		rs[i] = int64(x.Hour())
+	}
+	return rs
+}
+
+func timeToMinute(xs []types.Time, rs []uint8) []uint8 {
+	for i, x := range xs {
+		rs[i] = x.Minute()
+	}
+	return rs
+}
+
+func datetimeToMinute(xs []types.Datetime, rs []uint8) []uint8 {
+	for i, x := range xs {
+		rs[i] = uint8(x.Minute())
+	}
+	return rs
+}
+
+func timeToSecond(xs []types.Time, rs []uint8) []uint8 {
+	for i, x := range xs {
+		rs[i] = x.Sec()
+	}
+	return rs
+}
+
+func datetimeToSecond(xs []types.Datetime, rs []uint8) []uint8 {
+	for i, x := range xs {
+		rs[i] = uint8(x.Sec())
+	}
+	return rs
+}
+
+func timeToSec(xs []types.Time, rs []int64) []int64 {
+	for i, x := range xs {
+		rs[i] = x.ToSeconds()
+	}
+	return rs
+}
+
+func int64ToTime(xs []int64, rs []types.Time) []types.Time {
+	for i, x := range xs {
+		rs[i] = types.SecondsToTime(float64(x))
+	}
+	return rs
+}
+
+func float64ToTime(xs []float64, rs []types.Time) []types.Time {
+	for i, x := range xs {
+		rs[i] = types.SecondsToTime(x)
+	}
+	return rs
+}
+
+func datetimeToTime(xs []types.Datetime, rs []types.Time, precision int32) []types.Time {
+	for i, x := range xs {
+		rs[i] = x.ToTime(precision)
+	}
+	return rs
+}
+
+func stringToTime(xs []string, rs []types.Time, precision int32, ns *nulls.Nulls) ([]types.Time, error) {
+	for i, x := range xs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		t, err := types.ParseTime(x, precision)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = t
+	}
+	return rs, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package greatest
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Greatest keeps in rs the greatest of rs[i] and xs[i], xs has one value if c is true.
+// rs is filled with the values of the first argument before.
+func Greatest[T constraints.Ordered](xs []T, c bool, rs []T) []T {
+	if c {
+		for i := range rs {
+			if xs[0] > rs[i] {
+				rs[i] = xs[0]
+			}
+		}
+		return rs
+	}
+	for i, x := range xs {
+		if x > rs[i] {
+			rs[i] = x
+		}
+	}
+	return rs
+}
+
+// Least keeps in rs the least of rs[i] and xs[i]
+func Least[T constraints.Ordered](xs []T, c bool, rs []T) []T {
+	if c {
+		for i := range rs {
+			if xs[0] < rs[i] {
+				rs[i] = xs[0]
+			}
+		}
+		return rs
+	}
+	for i, x := range xs {
+		if x < rs[i] {
+			rs[i] = x
+		}
+	}
+	return rs
+}
+
+// Fill fills rs with the values of the first argument
+func Fill[T any](xs []T, c bool, rs []T) []T {
+	if !c {
+		copy(rs, xs)
+		return rs
+	}
+	for i := range rs {
+		rs[i] = xs[0]
+	}
+	return rs
+}
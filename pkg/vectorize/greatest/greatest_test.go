@@ -0,0 +1,37 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package greatest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGreatest(t *testing.T) {
+	rs := Fill([]int64{1, 5, 3}, false, make([]int64, 3))
+	rs = Greatest([]int64{4, 2, 3}, false, rs)
+	rs = Greatest([]int64{2}, true, rs)
+	require.Equal(t, []int64{4, 5, 3}, rs)
+
+	rs = Fill([]int64{1, 5, 3}, false, make([]int64, 3))
+	rs = Least([]int64{4, 2, 3}, false, rs)
+	rs = Least([]int64{2}, true, rs)
+	require.Equal(t, []int64{1, 2, 2}, rs)
+
+	ss := Fill([]string{"kiwi"}, true, make([]string, 3))
+	ss = Greatest([]string{"apple", "pear", "fig"}, false, ss)
+	require.Equal(t, []string{"kiwi", "pear", "kiwi"}, ss)
+}
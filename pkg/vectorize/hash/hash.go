@@ -0,0 +1,90 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hash
+
+import (
+	"encoding/binary"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// The values of the arguments are hashed one column after another into the
+// digests of the rows, a null is hashed as a 0 byte, and a value as a 1 byte
+// followed by its length and its bytes, so that the hash of (ab, c) is not
+// the hash of (a, bc).
+
+// New returns the digests of the rows
+func New(rows int) []*xxhash.Digest {
+	hs := make([]*xxhash.Digest, rows)
+	for i := range hs {
+		hs[i] = xxhash.New()
+	}
+	return hs
+}
+
+// UpdateFixed hashes the column of fixed size values, xs has one value if c is true
+func UpdateFixed[T types.FixedSizeT](hs []*xxhash.Digest, xs []T, c bool, ns *nulls.Nulls) {
+	for i, h := range hs {
+		j := i
+		if c {
+			j = 0
+		}
+		if nulls.Contains(ns, uint64(j)) {
+			_, _ = h.Write([]byte{0})
+			continue
+		}
+		write(h, types.EncodeFixed(xs[j]))
+	}
+}
+
+// UpdateBytes hashes the column of strings
+func UpdateBytes(hs []*xxhash.Digest, xs [][]byte, c bool, ns *nulls.Nulls) {
+	for i, h := range hs {
+		j := i
+		if c {
+			j = 0
+		}
+		if nulls.Contains(ns, uint64(j)) {
+			_, _ = h.Write([]byte{0})
+			continue
+		}
+		write(h, xs[j])
+	}
+}
+
+// UpdateNull hashes the null constant
+func UpdateNull(hs []*xxhash.Digest) {
+	for _, h := range hs {
+		_, _ = h.Write([]byte{0})
+	}
+}
+
+// Sum gets the 64 bits hashes of the rows
+func Sum(hs []*xxhash.Digest, rs []int64) []int64 {
+	for i, h := range hs {
+		rs[i] = int64(h.Sum64())
+	}
+	return rs
+}
+
+func write(h *xxhash.Digest, buf []byte) {
+	var lenBuf [binary.MaxVarintLen64 + 1]byte
+	lenBuf[0] = 1
+	n := binary.PutUvarint(lenBuf[1:], uint64(len(buf)))
+	_, _ = h.Write(lenBuf[:n+1])
+	_, _ = h.Write(buf)
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hash
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHash(t *testing.T) {
+	ns := nulls.NewWithSize(4)
+	nulls.Add(ns, 3)
+	hs := New(4)
+	UpdateFixed(hs, []int64{1, 2, 1, 1}, false, ns)
+	UpdateBytes(hs, [][]byte{[]byte("a")}, true, nulls.NewWithSize(0))
+	rs := Sum(hs, make([]int64, 4))
+	require.Equal(t, rs[0], rs[2])
+	require.NotEqual(t, rs[0], rs[1])
+	require.NotEqual(t, rs[0], rs[3])
+
+	// the values are length prefixed
+	h1, h2 := New(1), New(1)
+	UpdateBytes(h1, [][]byte{[]byte("ab")}, true, nulls.NewWithSize(0))
+	UpdateBytes(h1, [][]byte{[]byte("c")}, true, nulls.NewWithSize(0))
+	UpdateBytes(h2, [][]byte{[]byte("a")}, true, nulls.NewWithSize(0))
+	UpdateBytes(h2, [][]byte{[]byte("bc")}, true, nulls.NewWithSize(0))
+	require.NotEqual(t, Sum(h1, make([]int64, 1)), Sum(h2, make([]int64, 1)))
+
+	// a null is not the empty string
+	h1, h2 = New(1), New(1)
+	UpdateNull(h1)
+	UpdateBytes(h2, [][]byte{nil}, true, nulls.NewWithSize(0))
+	require.NotEqual(t, Sum(h1, make([]int64, 1)), Sum(h2, make([]int64, 1)))
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package left
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+)
+
+// Left gets the leftmost lens[i] characters of xs[i], cs tells whether each
+// argument is a constant. The null rows in ns are skipped.
+func Left(xs []string, lens []int64, cs []bool, ns *nulls.Nulls, rs []string) []string {
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		x, n := xs[0], lens[0]
+		if !cs[0] {
+			x = xs[i]
+		}
+		if !cs[1] {
+			n = lens[i]
+		}
+		rs[i] = leftOne(x, n)
+	}
+	return rs
+}
+
+// Right gets the rightmost lens[i] characters of xs[i]
+func Right(xs []string, lens []int64, cs []bool, ns *nulls.Nulls, rs []string) []string {
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		x, n := xs[0], lens[0]
+		if !cs[0] {
+			x = xs[i]
+		}
+		if !cs[1] {
+			n = lens[i]
+		}
+		rs[i] = rightOne(x, n)
+	}
+	return rs
+}
+
+func leftOne(s string, n int64) string {
+	if n <= 0 {
+		return ""
+	}
+	for pos := range s {
+		if n == 0 {
+			return s[:pos]
+		}
+		n--
+	}
+	return s
+}
+
+func rightOne(s string, n int64) string {
+	if n <= 0 {
+		return ""
+	}
+	cnt := int64(utf8.RuneCountInString(s))
+	if n >= cnt {
+		return s
+	}
+	for pos := range s {
+		if cnt == n {
+			return s[pos:]
+		}
+		cnt--
+	}
+	return ""
+}
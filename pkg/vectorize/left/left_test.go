@@ -0,0 +1,36 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package left
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeft(t *testing.T) {
+	xs := []string{"hello", "你好世界", "abc", ""}
+	rs := Left(xs, []int64{2, 3, -1, 1}, []bool{false, false}, nulls.NewWithSize(4), make([]string, 4))
+	require.Equal(t, []string{"he", "你好世", "", ""}, rs)
+
+	rs = Right(xs, []int64{2}, []bool{false, true}, nulls.NewWithSize(4), make([]string, 4))
+	require.Equal(t, []string{"lo", "世界", "bc", ""}, rs)
+
+	ns := nulls.NewWithSize(2)
+	nulls.Add(ns, 1)
+	rs = Right([]string{"abc"}, []int64{5, 1}, []bool{true, false}, ns, make([]string, 2))
+	require.Equal(t, []string{"abc", ""}, rs)
+}
@@ -0,0 +1,49 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package position
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+)
+
+// Position gets the position in characters of the first occurrence of
+// substrs[i] in strs[i] from 1, or 0 if it's not found
+func Position(substrs, strs []string, cs []bool, ns *nulls.Nulls, rs []int64) []int64 {
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		substr, str := substrs[0], strs[0]
+		if !cs[0] {
+			substr = substrs[i]
+		}
+		if !cs[1] {
+			str = strs[i]
+		}
+		rs[i] = positionOne(substr, str)
+	}
+	return rs
+}
+
+func positionOne(substr, str string) int64 {
+	pos := strings.Index(str, substr)
+	if pos < 0 {
+		return 0
+	}
+	return int64(utf8.RuneCountInString(str[:pos])) + 1
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regexp
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+)
+
+// Match checks whether xs[i] matches the pattern patterns[i]
+func Match(xs, patterns []string, cs []bool, ns *nulls.Nulls, rs []bool) ([]bool, error) {
+	c := make(compiled)
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		x, pattern := xs[0], patterns[0]
+		if !cs[0] {
+			x = xs[i]
+		}
+		if !cs[1] {
+			pattern = patterns[i]
+		}
+		reg, err := c.compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = reg.MatchString(x)
+	}
+	return rs, nil
+}
+
+// Replace replaces the matches of patterns[i] in xs[i] by repls[i], $n in the
+// replacement is the n-th submatch
+func Replace(xs, patterns, repls []string, cs []bool, ns *nulls.Nulls, rs []string) ([]string, error) {
+	c := make(compiled)
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		x, pattern, repl := xs[0], patterns[0], repls[0]
+		if !cs[0] {
+			x = xs[i]
+		}
+		if !cs[1] {
+			pattern = patterns[i]
+		}
+		if !cs[2] {
+			repl = repls[i]
+		}
+		reg, err := c.compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = reg.ReplaceAllString(x, repl)
+	}
+	return rs, nil
+}
+
+// Substr gets the first match of patterns[i] in xs[i], the row is added to ns
+// if it doesn't match
+func Substr(xs, patterns []string, cs []bool, ns *nulls.Nulls, rs []string) ([]string, error) {
+	c := make(compiled)
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		x, pattern := xs[0], patterns[0]
+		if !cs[0] {
+			x = xs[i]
+		}
+		if !cs[1] {
+			pattern = patterns[i]
+		}
+		reg, err := c.compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		loc := reg.FindStringIndex(x)
+		if loc == nil {
+			nulls.Add(ns, uint64(i))
+			continue
+		}
+		rs[i] = x[loc[0]:loc[1]]
+	}
+	return rs, nil
+}
+
+// compiled keeps the compiled patterns, so a constant pattern is only compiled once
+type compiled map[string]*regexp.Regexp
+
+func (c compiled) compile(pattern string) (*regexp.Regexp, error) {
+	if reg, ok := c[pattern]; ok {
+		return reg, nil
+	}
+	reg, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, moerr.NewError(moerr.INVALID_ARGUMENT, fmt.Sprintf("invalid regular expression '%s': %v", pattern, err))
+	}
+	c[pattern] = reg
+	return reg, nil
+}
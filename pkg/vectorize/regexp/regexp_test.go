@@ -0,0 +1,43 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regexp
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegexp(t *testing.T) {
+	xs := []string{"abc123", "abc", "123"}
+	cs := []bool{false, true}
+	matched, err := Match(xs, []string{"[0-9]+"}, cs, nulls.NewWithSize(3), make([]bool, 3))
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, true}, matched)
+
+	replaced, err := Replace(xs, []string{"([a-z]+)"}, []string{"<$1>"}, []bool{false, true, true}, nulls.NewWithSize(3), make([]string, 3))
+	require.NoError(t, err)
+	require.Equal(t, []string{"<abc>123", "<abc>", "123"}, replaced)
+
+	ns := nulls.NewWithSize(3)
+	substrs, err := Substr(xs, []string{"[0-9]+"}, cs, ns, make([]string, 3))
+	require.NoError(t, err)
+	require.Equal(t, "123", substrs[0])
+	require.True(t, nulls.Contains(ns, 1))
+
+	_, err = Match(xs, []string{"("}, cs, nulls.NewWithSize(3), make([]bool, 3))
+	require.Error(t, err)
+}
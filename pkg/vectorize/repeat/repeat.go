@@ -0,0 +1,49 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repeat
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// Repeat repeats xs[i] counts[i] times, it's an empty string if the count is less
+// than 1, and the row is added to ns if the result is longer than a string can be
+func Repeat(xs []string, counts []int64, cs []bool, ns *nulls.Nulls, rs []string) []string {
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		x, count := xs[0], counts[0]
+		if !cs[0] {
+			x = xs[i]
+		}
+		if !cs[1] {
+			count = counts[i]
+		}
+		if count < 1 || len(x) == 0 {
+			rs[i] = ""
+			continue
+		}
+		if count > types.MaxStringSize/int64(len(x)) {
+			nulls.Add(ns, uint64(i))
+			continue
+		}
+		rs[i] = strings.Repeat(x, int(count))
+	}
+	return rs
+}
@@ -0,0 +1,46 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replace
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+)
+
+// Replace replaces all the occurrences of froms[i] in xs[i] by tos[i]
+func Replace(xs, froms, tos []string, cs []bool, ns *nulls.Nulls, rs []string) []string {
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		x, from, to := xs[0], froms[0], tos[0]
+		if !cs[0] {
+			x = xs[i]
+		}
+		if !cs[1] {
+			from = froms[i]
+		}
+		if !cs[2] {
+			to = tos[i]
+		}
+		if len(from) == 0 {
+			rs[i] = x
+			continue
+		}
+		rs[i] = strings.ReplaceAll(x, from, to)
+	}
+	return rs
+}
@@ -0,0 +1,34 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sign
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Sign gets -1, 0 or 1 as the numbers are negative, zero or positive
+func Sign[T constraints.Integer | constraints.Float](xs []T, rs []int64) []int64 {
+	for i, x := range xs {
+		switch {
+		case x > 0:
+			rs[i] = 1
+		case x < 0:
+			rs[i] = -1
+		default:
+			rs[i] = 0
+		}
+	}
+	return rs
+}
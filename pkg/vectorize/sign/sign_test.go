@@ -0,0 +1,27 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sign
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	require.Equal(t, []int64{-1, 0, 1}, Sign([]int64{-5, 0, 7}, make([]int64, 3)))
+	require.Equal(t, []int64{0, 1}, Sign([]uint64{0, 3}, make([]int64, 2)))
+	require.Equal(t, []int64{-1, 1}, Sign([]float64{-0.5, 0.1}, make([]int64, 2)))
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package split
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+)
+
+// Split gets the nths[i]-th part of xs[i] split by delimiters[i] from 1, a negative
+// n counts from the end. The row is added to ns if there is no such part.
+func Split(xs, delimiters []string, nths []int64, cs []bool, ns *nulls.Nulls, rs []string) []string {
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		x, delimiter, n := xs[0], delimiters[0], nths[0]
+		if !cs[0] {
+			x = xs[i]
+		}
+		if !cs[1] {
+			delimiter = delimiters[i]
+		}
+		if !cs[2] {
+			n = nths[i]
+		}
+		var parts []string
+		if len(delimiter) == 0 {
+			parts = []string{x}
+		} else {
+			parts = strings.Split(x, delimiter)
+		}
+		if n < 0 {
+			n += int64(len(parts)) + 1
+		}
+		if n < 1 || n > int64(len(parts)) {
+			nulls.Add(ns, uint64(i))
+			continue
+		}
+		rs[i] = parts[n-1]
+	}
+	return rs
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translate
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+)
+
+// Translate replaces every character of froms[i] in xs[i] by the character of
+// tos[i] at the same position, or removes it if tos[i] is shorter than froms[i]
+func Translate(xs, froms, tos []string, cs []bool, ns *nulls.Nulls, rs []string) []string {
+	mappings := make(map[[2]string]map[rune]rune)
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		x, key := xs[0], [2]string{froms[0], tos[0]}
+		if !cs[0] {
+			x = xs[i]
+		}
+		if !cs[1] {
+			key[0] = froms[i]
+		}
+		if !cs[2] {
+			key[1] = tos[i]
+		}
+		mapping, ok := mappings[key]
+		if !ok {
+			mapping = translateMapping(key[0], key[1])
+			mappings[key] = mapping
+		}
+		rs[i] = strings.Map(func(r rune) rune {
+			if to, ok := mapping[r]; ok {
+				return to
+			}
+			return r
+		}, x)
+	}
+	return rs
+}
+
+// translateMapping maps the characters to be removed to -1, a character
+// appearing more than once in from is mapped by its first position
+func translateMapping(from, to string) map[rune]rune {
+	mapping := make(map[rune]rune)
+	toRunes := []rune(to)
+	for i, r := range []rune(from) {
+		if _, ok := mapping[r]; ok {
+			continue
+		}
+		if i < len(toRunes) {
+			mapping[r] = toRunes[i]
+		} else {
+			mapping[r] = -1
+		}
+	}
+	return mapping
+}
@@ -0,0 +1,31 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translate
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslate(t *testing.T) {
+	rs := Translate([]string{"abcabc", "你好"}, []string{"abc", "你"}, []string{"x", "我"}, []bool{false, false, false},
+		nulls.NewWithSize(2), make([]string, 2))
+	require.Equal(t, []string{"xx", "我好"}, rs)
+
+	rs = Translate([]string{"aab"}, []string{"aa"}, []string{"xy"}, []bool{true, true, true}, nulls.NewWithSize(1), make([]string, 1))
+	require.Equal(t, []string{"xxb"}, rs)
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trim
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+)
+
+// Trim removes the prefixes or the suffixes of xs[i] equal to remstrs[i], the
+// direction is both, leading or trailing
+func Trim(directions, remstrs, xs []string, cs []bool, ns *nulls.Nulls, rs []string) ([]string, error) {
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		direction, remstr, x := directions[0], remstrs[0], xs[0]
+		if !cs[0] {
+			direction = directions[i]
+		}
+		if !cs[1] {
+			remstr = remstrs[i]
+		}
+		if !cs[2] {
+			x = xs[i]
+		}
+		if len(remstr) == 0 {
+			rs[i] = x
+			continue
+		}
+		switch strings.ToLower(direction) {
+		case "both":
+			rs[i] = trimSuffix(trimPrefix(x, remstr), remstr)
+		case "leading":
+			rs[i] = trimPrefix(x, remstr)
+		case "trailing":
+			rs[i] = trimSuffix(x, remstr)
+		default:
+			return nil, moerr.NewError(moerr.INVALID_ARGUMENT, fmt.Sprintf("invalid direction '%s' of trim", direction))
+		}
+	}
+	return rs, nil
+}
+
+func trimPrefix(s, remstr string) string {
+	for strings.HasPrefix(s, remstr) {
+		s = s[len(remstr):]
+	}
+	return s
+}
+
+func trimSuffix(s, remstr string) string {
+	for strings.HasSuffix(s, remstr) {
+		s = s[:len(s)-len(remstr)]
+	}
+	return s
+}
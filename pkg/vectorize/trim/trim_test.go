@@ -0,0 +1,34 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trim
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrim(t *testing.T) {
+	ns := nulls.NewWithSize(4)
+	nulls.Add(ns, 3)
+	rs, err := Trim([]string{"both", "LEADING", "trailing", "bad"}, []string{"x"}, []string{"xxaxx"},
+		[]bool{false, true, true}, ns, make([]string, 4))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "axx", "xxa", ""}, rs)
+
+	_, err = Trim([]string{"middle"}, []string{" "}, []string{"a"}, []bool{true, true, true}, nulls.NewWithSize(1), make([]string, 1))
+	require.Error(t, err)
+}
@@ -32,9 +32,14 @@ func (db *database) Relation(ctx context.Context, name string) (engine.Relation,
 	if err != nil {
 		return nil, nil
 	}
+	defs, err := db.txn.getTableDefs(ctx, db.databaseId, id)
+	if err != nil {
+		return nil, err
+	}
 	return &table{
 		tableId:   id,
 		tableName: name,
+		defs:      defs,
 		db:        db,
 	}, nil
 }
@@ -20,6 +20,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/logservice"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -29,10 +30,12 @@ type GetClusterDetailsFunc = func() (logservice.ClusterDetails, error)
 
 func New(
 	ctx context.Context,
+	fs fileservice.FileService,
 	getClusterDetails GetClusterDetailsFunc,
 ) *Engine {
 	return &Engine{
 		db:                newDB(),
+		fs:                fs,
 		getClusterDetails: getClusterDetails,
 		txns:              make(map[string]*Transaction),
 	}
@@ -158,6 +161,7 @@ func (e *Engine) getOrAddTransaction(op client.TxnOperator,
 			meta:     op.Txn(),
 			dnStores: cluster.DNStores,
 			fileMap:  make(map[string]uint64),
+			fs:       e.fs,
 		}
 		txn.writes = append(txn.writes, make([]Entry, 0, 1))
 		e.txns[id] = txn
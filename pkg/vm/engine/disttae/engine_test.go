@@ -23,10 +23,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/txn/rpc"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/stretchr/testify/require"
 )
 
@@ -50,7 +52,7 @@ func TestEngine(t *testing.T) {
 		return
 	}
 	txnOp := newTestTxnOperator()
-	e := New(ctx, getClusterDetails)
+	e := New(ctx, nil, getClusterDetails)
 	err := e.Create(ctx, "test", txnOp)
 	require.NoError(t, err)
 	err = e.Delete(ctx, "test", txnOp)
@@ -87,7 +89,14 @@ func TestTransaction(t *testing.T) {
 }
 
 func TestTable(t *testing.T) {
-	tbl := new(table)
+	tbl := &table{
+		db: &database{
+			txn: &Transaction{
+				db:   newDB(),
+				meta: newTxnMeta(rand.Int63()),
+			},
+		},
+	}
 	ctx := context.TODO()
 	_, _ = tbl.Rows(ctx)
 	_, _ = tbl.Size(ctx, "test")
@@ -114,6 +123,28 @@ func TestTools(t *testing.T) {
 	_ = genTableIdExpr(0, "test")
 }
 
+func TestGenTableDefs(t *testing.T) {
+	// database id | table id | position | name | type | length | constraint type | auto increment | comment | hidden
+	rows := [][]any{
+		{int32(1), int32(2), int32(1), "b", int32(types.T_varchar), int32(10), "n", int8(0), "", int8(0)},
+		{int32(1), int32(3), int32(0), "c", int32(types.T_int32), int32(0), "n", int8(0), "", int8(0)},
+		{int32(1), int32(2), int32(0), "a", int32(types.T_int64), int32(0), "p", int8(1), "pk", int8(0)},
+	}
+	defs := genTableDefs(rows, 1, 2)
+	require.Equal(t, 2, len(defs))
+	a := defs[0].(*engine.AttributeDef).Attr
+	require.Equal(t, "a", a.Name)
+	require.Equal(t, types.T_int64, a.Type.Oid)
+	require.True(t, a.Primary)
+	require.True(t, a.AutoIncrement)
+	require.Equal(t, "pk", a.Comment)
+	b := defs[1].(*engine.AttributeDef).Attr
+	require.Equal(t, "b", b.Name)
+	require.Equal(t, types.T_varchar, b.Type.Oid)
+	require.Equal(t, int32(10), b.Type.Width)
+	require.False(t, b.Primary)
+}
+
 func newTestTxnOperator() *testTxnOperator {
 	return &testTxnOperator{
 		meta: newTxnMeta(rand.Int63()),
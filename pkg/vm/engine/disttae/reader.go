@@ -0,0 +1,385 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+var _ engine.Reader = new(blockReader)
+
+var _ engine.PruneReader = new(blockReader)
+
+func (r *blockReader) Close() error {
+	return nil
+}
+
+func (r *blockReader) PrunedBlocks() int64 {
+	return r.prunedBlocks
+}
+
+// Read reads the next block which may have rows satisfying the filter,
+// the blocks are checked by their indexes before the column data is read.
+func (r *blockReader) Read(attrs []string, _ *plan.Expr, m *mheap.Mheap) (*batch.Batch, error) {
+	idxs := make([]uint16, len(attrs))
+	for i, attr := range attrs {
+		idx, ok := r.colIdxs[attr]
+		if !ok {
+			return nil, moerr.NewInternalError("column '%s' not found in the blocks", attr)
+		}
+		idxs[i] = idx
+	}
+	for len(r.blks) > 0 {
+		blk := r.blks[0]
+		r.blks = r.blks[1:]
+		rd, err := objectio.NewObjectReader(blk.name, r.fs)
+		if err != nil {
+			return nil, err
+		}
+		blocks, err := rd.ReadMeta([]objectio.Extent{blk.extent})
+		if err != nil {
+			return nil, err
+		}
+		ok, err := mayMatch(r.expr, idxs, blocks[0])
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			r.prunedBlocks++
+			continue
+		}
+		data, err := rd.Read(blk.extent, idxs)
+		if err != nil {
+			return nil, err
+		}
+		bat := batch.New(true, attrs)
+		for i := range attrs {
+			bat.Vecs[i] = vector.New(types.Type{})
+			if err = bat.Vecs[i].Read(data.Entries[i].Data); err != nil {
+				return nil, err
+			}
+		}
+		bat.InitZsOne(vector.Length(bat.Vecs[0]))
+		return bat, nil
+	}
+	return nil, nil
+}
+
+// mayMatch returns false if no row of the block can satisfy the expr,
+// the column at position i of the expr is the column idxs[i] of the block.
+// The =, IN, range and IS NULL predicates on a column and constants are
+// checked against the zone map and the bloom filter of the column,
+// the other predicates are taken as being satisfied.
+func mayMatch(expr *plan.Expr, idxs []uint16, blk objectio.BlockObject) (bool, error) {
+	if expr == nil {
+		return true, nil
+	}
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return true, nil
+	}
+	args := f.F.Args
+	switch name := f.F.Func.ObjName; name {
+	case "and":
+		if ok, err := mayMatch(args[0], idxs, blk); err != nil || !ok {
+			return ok, err
+		}
+		return mayMatch(args[1], idxs, blk)
+	case "or":
+		if ok, err := mayMatch(args[0], idxs, blk); err != nil || ok {
+			return ok, err
+		}
+		return mayMatch(args[1], idxs, blk)
+	case "isnull", "is_null":
+		zm, _, ok, err := getZoneMap(args[0], idxs, blk)
+		if err != nil || !ok {
+			return true, err
+		}
+		return zm.MayHaveNull(), nil
+	case "not":
+		// IS NOT NULL
+		g, ok := args[0].Expr.(*plan.Expr_F)
+		if !ok || (g.F.Func.ObjName != "isnull" && g.F.Func.ObjName != "is_null") {
+			return true, nil
+		}
+		zm, _, ok, err := getZoneMap(g.F.Args[0], idxs, blk)
+		if err != nil || !ok {
+			return true, err
+		}
+		return zm.MayHaveValue(), nil
+	case "=", "<", "<=", ">", ">=":
+		col, c := args[0], args[1]
+		if _, ok := col.Expr.(*plan.Expr_C); ok {
+			col, c = c, col
+			name = reverseCompare[name]
+		}
+		return mayCompare(name, col, []*plan.Expr{c}, idxs, blk)
+	case "in":
+		list, ok := args[1].Expr.(*plan.Expr_List)
+		if !ok {
+			return true, nil
+		}
+		return mayCompare("=", args[0], list.List.List, idxs, blk)
+	}
+	return true, nil
+}
+
+var reverseCompare = map[string]string{
+	"=":  "=",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+// mayCompare returns false if no value of the column satisfies the comparison
+// with any of the constants
+func mayCompare(op string, col *plan.Expr, consts []*plan.Expr, idxs []uint16,
+	blk objectio.BlockObject) (bool, error) {
+	zm, typ, ok, err := getZoneMap(col, idxs, blk)
+	if err != nil || !ok {
+		return true, err
+	}
+	values := make([][]byte, 0, len(consts))
+	for _, expr := range consts {
+		c, ok := expr.Expr.(*plan.Expr_C)
+		if !ok {
+			return true, nil
+		}
+		if c.C.Isnull {
+			// the comparison with null is never true
+			continue
+		}
+		v, ok := encodeConst(c.C, typ)
+		if !ok {
+			return true, nil
+		}
+		values = append(values, v)
+	}
+	for _, v := range values {
+		var may bool
+		switch op {
+		case "=":
+			may = zm.MayContain(typ, v)
+		case "<":
+			may = zm.MayLess(typ, v, false)
+		case "<=":
+			may = zm.MayLess(typ, v, true)
+		case ">":
+			may = zm.MayGreater(typ, v, false)
+		case ">=":
+			may = zm.MayGreater(typ, v, true)
+		}
+		if may && op == "=" {
+			if may, err = bloomFilterMayContain(col, idxs, blk, v); err != nil {
+				return false, err
+			}
+		}
+		if may {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getZoneMap returns the zone map and the type of the column referred by the expr,
+// it returns false if the expr is not a column of the block
+func getZoneMap(expr *plan.Expr, idxs []uint16, blk objectio.BlockObject) (*objectio.ZoneMap, types.Type, bool, error) {
+	col, typ, ok, err := getColumn(expr, idxs, blk)
+	if err != nil || !ok {
+		return nil, typ, false, err
+	}
+	index, err := col.GetIndex(objectio.ZoneMapType)
+	if err != nil {
+		return nil, typ, false, err
+	}
+	zm, ok := index.(*objectio.ZoneMap)
+	return zm, typ, ok, nil
+}
+
+func bloomFilterMayContain(expr *plan.Expr, idxs []uint16, blk objectio.BlockObject, v []byte) (bool, error) {
+	col, _, ok, err := getColumn(expr, idxs, blk)
+	if err != nil || !ok {
+		return true, err
+	}
+	index, err := col.GetIndex(objectio.BloomFilterType)
+	if err != nil {
+		return false, err
+	}
+	bf, ok := index.(*objectio.BloomFilter)
+	if !ok {
+		return true, nil
+	}
+	return bf.MayContain(v)
+}
+
+// getColumn returns the column of the block referred by the expr and its type,
+// a cast which keeps the order of the values is removed from the column.
+func getColumn(expr *plan.Expr, idxs []uint16, blk objectio.BlockObject) (objectio.ColumnObject, types.Type, bool, error) {
+	if f, ok := expr.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "cast" {
+		if !isWidenCast(f.F.Args[0].Typ, expr.Typ) {
+			return nil, types.Type{}, false, nil
+		}
+		expr = f.F.Args[0]
+	}
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok || int(col.Col.ColPos) >= len(idxs) {
+		return nil, types.Type{}, false, nil
+	}
+	typ := types.Type{
+		Oid:       types.T(expr.Typ.Id),
+		Width:     expr.Typ.Width,
+		Scale:     expr.Typ.Scale,
+		Precision: expr.Typ.Precision,
+	}
+	obj, err := blk.GetColumn(idxs[col.Col.ColPos])
+	if err != nil {
+		return nil, typ, false, err
+	}
+	return obj, typ, true, nil
+}
+
+// isWidenCast returns true if every value of the type from is
+// cast to the type to exactly
+func isWidenCast(from, to *plan.Type) bool {
+	f, t := types.T(from.Id), types.T(to.Id)
+	switch {
+	case isSignedType(f) && isSignedType(t), isUnsignedType(f) && isUnsignedType(t):
+		return f.FixedLength() <= t.FixedLength()
+	case isUnsignedType(f) && isSignedType(t):
+		return f.FixedLength() < t.FixedLength()
+	case f == types.T_float32 && t == types.T_float64:
+		return true
+	}
+	return false
+}
+
+func isSignedType(t types.T) bool {
+	return t == types.T_int8 || t == types.T_int16 || t == types.T_int32 || t == types.T_int64
+}
+
+func isUnsignedType(t types.T) bool {
+	return t == types.T_uint8 || t == types.T_uint16 || t == types.T_uint32 || t == types.T_uint64
+}
+
+// encodeConst encodes the constant in the memory layout of the type,
+// it returns false if the constant can not be represented by the type exactly
+func encodeConst(c *plan.Const, typ types.Type) ([]byte, bool) {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		var v int64
+		switch val := c.Value.(type) {
+		case *plan.Const_Ival:
+			v = val.Ival
+		case *plan.Const_Uval:
+			if val.Uval > math.MaxInt64 {
+				return nil, false
+			}
+			v = int64(val.Uval)
+		default:
+			return nil, false
+		}
+		switch typ.Oid {
+		case types.T_int8:
+			if v < math.MinInt8 || v > math.MaxInt8 {
+				return nil, false
+			}
+			return types.EncodeFixed(int8(v)), true
+		case types.T_int16:
+			if v < math.MinInt16 || v > math.MaxInt16 {
+				return nil, false
+			}
+			return types.EncodeFixed(int16(v)), true
+		case types.T_int32:
+			if v < math.MinInt32 || v > math.MaxInt32 {
+				return nil, false
+			}
+			return types.EncodeFixed(int32(v)), true
+		}
+		return types.EncodeFixed(v), true
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		var v uint64
+		switch val := c.Value.(type) {
+		case *plan.Const_Ival:
+			if val.Ival < 0 {
+				return nil, false
+			}
+			v = uint64(val.Ival)
+		case *plan.Const_Uval:
+			v = val.Uval
+		default:
+			return nil, false
+		}
+		switch typ.Oid {
+		case types.T_uint8:
+			if v > math.MaxUint8 {
+				return nil, false
+			}
+			return types.EncodeFixed(uint8(v)), true
+		case types.T_uint16:
+			if v > math.MaxUint16 {
+				return nil, false
+			}
+			return types.EncodeFixed(uint16(v)), true
+		case types.T_uint32:
+			if v > math.MaxUint32 {
+				return nil, false
+			}
+			return types.EncodeFixed(uint32(v)), true
+		}
+		return types.EncodeFixed(v), true
+	case types.T_float32:
+		if val, ok := c.Value.(*plan.Const_Fval); ok {
+			return types.EncodeFixed(val.Fval), true
+		}
+	case types.T_float64:
+		switch val := c.Value.(type) {
+		case *plan.Const_Dval:
+			return types.EncodeFixed(val.Dval), true
+		case *plan.Const_Fval:
+			return types.EncodeFixed(float64(val.Fval)), true
+		}
+	case types.T_bool:
+		if val, ok := c.Value.(*plan.Const_Bval); ok {
+			return types.EncodeFixed(val.Bval), true
+		}
+	case types.T_date:
+		if val, ok := c.Value.(*plan.Const_Dateval); ok {
+			return types.EncodeFixed(types.Date(val.Dateval)), true
+		}
+	case types.T_datetime:
+		if val, ok := c.Value.(*plan.Const_Datetimeval); ok {
+			return types.EncodeFixed(types.Datetime(val.Datetimeval)), true
+		}
+	case types.T_timestamp:
+		if val, ok := c.Value.(*plan.Const_Timestampval); ok {
+			return types.EncodeFixed(types.Timestamp(val.Timestampval)), true
+		}
+	case types.T_char, types.T_varchar, types.T_blob:
+		if val, ok := c.Value.(*plan.Const_Sval); ok {
+			return []byte(val.Sval), true
+		}
+	}
+	return nil, false
+}
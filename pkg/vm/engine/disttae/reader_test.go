@@ -0,0 +1,159 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockReaderPrune(t *testing.T) {
+	fs, err := fileservice.NewMemoryFS("memory")
+	require.NoError(t, err)
+
+	// block i holds the ids [i*10, i*10+10), the names of the last block are null
+	writer, err := objectio.NewObjectWriter("a.blk", fs)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		ids := make([]int64, 10)
+		names := make([]string, 10)
+		for j := range ids {
+			ids[j] = int64(i*10 + j)
+			names[j] = string(rune('a' + i))
+		}
+		nsp := &nulls.Nulls{}
+		if i == 2 {
+			for j := range names {
+				nulls.Add(nsp, uint64(j))
+			}
+		}
+		bat := batch.New(true, []string{"id", "name"})
+		bat.Vecs[0] = vector.NewWithFixed(types.Type{Oid: types.T_int64}, ids, nil, nil)
+		bat.Vecs[1] = vector.NewWithStrings(types.Type{Oid: types.T_varchar, Width: 10}, names, nsp, nil)
+		fd, err := writer.Write(bat)
+		require.NoError(t, err)
+		for j, vec := range bat.Vecs {
+			zm, err := objectio.BuildZoneMap(uint16(j), vec)
+			require.NoError(t, err)
+			require.NoError(t, writer.WriteIndex(fd, zm))
+			bf, err := objectio.BuildBloomFilter(uint16(j), vec)
+			require.NoError(t, err)
+			require.NoError(t, writer.WriteIndex(fd, bf))
+		}
+	}
+	blocks, err := writer.WriteEnd()
+	require.NoError(t, err)
+	require.NoError(t, writer.(*objectio.ObjectWriter).Sync(""))
+	ranges := make([][]byte, len(blocks))
+	for i := range ranges {
+		ranges[i] = encodeBlockMeta(BlockMeta{name: "a.blk", extent: blocks[uint32(i)].GetExtent()})
+	}
+
+	tbl := &table{
+		db: &database{txn: &Transaction{fs: fs}},
+		defs: []engine.TableDef{
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "id", Type: types.Type{Oid: types.T_int64}}},
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "name", Type: types.Type{Oid: types.T_varchar}}},
+		},
+	}
+	// the attributes are read in the reverse order
+	attrs := []string{"name", "id"}
+	id := newColExpr(1, types.T_int64)
+	name := newColExpr(0, types.T_varchar)
+
+	tests := []struct {
+		expr   *plan.Expr
+		rows   int
+		pruned int64
+	}{
+		{nil, 30, 0},
+		{newFuncExpr("=", id, newIntExpr(15)), 10, 2},
+		{newFuncExpr("=", newIntExpr(100), id), 0, 3},
+		{newFuncExpr(">=", id, newIntExpr(20)), 10, 2},
+		{newFuncExpr("<", newIntExpr(10), id), 20, 1},
+		{newFuncExpr("=", name, newStrExpr("b")), 10, 2},
+		// 'aa' is in the range of the zone map of the first block, but not in the bloom filter
+		{newFuncExpr("=", name, newStrExpr("aa")), 0, 3},
+		{newFuncExpr("isnull", name), 10, 2},
+		{newFuncExpr("not", newFuncExpr("isnull", name)), 20, 1},
+		{newFuncExpr("or", newFuncExpr("=", id, newIntExpr(1)), newFuncExpr("=", id, newIntExpr(21))), 20, 1},
+		{newFuncExpr("and", newFuncExpr(">", id, newIntExpr(5)), newFuncExpr("=", name, newStrExpr("a"))), 10, 2},
+		{newFuncExpr("in", id, &plan.Expr{Expr: &plan.Expr_List{List: &plan.ExprList{
+			List: []*plan.Expr{newIntExpr(3), newIntExpr(25)},
+		}}}), 20, 1},
+		// the filter on other expressions can not be checked
+		{newFuncExpr("=", newFuncExpr("+", id, newIntExpr(1)), newIntExpr(100)), 30, 0},
+	}
+	for _, test := range tests {
+		rds, err := tbl.NewReader(context.Background(), 2, test.expr, ranges)
+		require.NoError(t, err)
+		rows, pruned := 0, int64(0)
+		for _, rd := range rds {
+			for {
+				bat, err := rd.Read(attrs, nil, nil)
+				require.NoError(t, err)
+				if bat == nil {
+					break
+				}
+				require.Equal(t, types.T_int64, bat.Vecs[1].Typ.Oid)
+				rows += bat.Length()
+			}
+			pruned += rd.(engine.PruneReader).PrunedBlocks()
+		}
+		require.Equal(t, test.rows, rows, test.expr.String())
+		require.Equal(t, test.pruned, pruned, test.expr.String())
+	}
+}
+
+func newColExpr(pos int32, typ types.T) *plan.Expr {
+	return &plan.Expr{
+		Typ:  &plan.Type{Id: int32(typ)},
+		Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: pos}},
+	}
+}
+
+func newIntExpr(v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ:  &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: v}}},
+	}
+}
+
+func newStrExpr(v string) *plan.Expr {
+	return &plan.Expr{
+		Typ:  &plan.Type{Id: int32(types.T_varchar)},
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Sval{Sval: v}}},
+	}
+}
+
+func newFuncExpr(name string, args ...*plan.Expr) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_bool)},
+		Expr: &plan.Expr_F{F: &plan.Function{
+			Func: &plan.ObjectRef{ObjName: name},
+			Args: args,
+		}},
+	}
+}
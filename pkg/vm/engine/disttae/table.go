@@ -34,12 +34,21 @@ func (tbl *table) Size(ctx context.Context, name string) (int64, error) {
 	return 0, nil
 }
 
+// Ranges returns the blocks of the table which are not modified by the txn,
+// every range is the encoded meta of a block.
 func (tbl *table) Ranges(ctx context.Context) ([][]byte, error) {
-	return nil, nil
+	txn := tbl.db.txn
+	blks := txn.db.BlockList(ctx, txn.dnStores, tbl.db.databaseId, tbl.tableId,
+		txn.meta.SnapshotTS, txn.visibleWrites())
+	ranges := make([][]byte, len(blks))
+	for i := range blks {
+		ranges[i] = encodeBlockMeta(blks[i])
+	}
+	return ranges, nil
 }
 
 func (tbl *table) TableDefs(ctx context.Context) ([]engine.TableDef, error) {
-	return nil, nil
+	return tbl.defs, nil
 }
 
 func (tbl *table) GetPrimaryKeys(ctx context.Context) ([]*engine.Attribute, error) {
@@ -78,7 +87,34 @@ func (tbl *table) GetTableID(ctx context.Context) string {
 	return strconv.FormatUint(tbl.tableId, 10)
 }
 
+// NewReader creates num readers to read the blocks of the ranges, every range is a block
+// written by objectio, the blocks are distributed to the readers evenly.
 func (tbl *table) NewReader(ctx context.Context, num int, expr *plan.Expr,
 	ranges [][]byte) ([]engine.Reader, error) {
-	return nil, nil
+	colIdxs := make(map[string]uint16)
+	for _, def := range tbl.defs {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			colIdxs[attr.Attr.Name] = uint16(len(colIdxs))
+		}
+	}
+	rds := make([]*blockReader, num)
+	for i := range rds {
+		rds[i] = &blockReader{
+			fs:      tbl.db.txn.fs,
+			expr:    expr,
+			colIdxs: colIdxs,
+		}
+	}
+	for i := range ranges {
+		blk, err := decodeBlockMeta(ranges[i])
+		if err != nil {
+			return nil, err
+		}
+		rds[i%num].blks = append(rds[i%num].blks, blk)
+	}
+	readers := make([]engine.Reader, num)
+	for i := range rds {
+		readers[i] = rds[i]
+	}
+	return readers, nil
 }
@@ -15,7 +15,16 @@
 package disttae
 
 import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
@@ -55,3 +64,109 @@ func genDatabaseIdExpr(name string) *plan.Expr {
 func genTableIdExpr(databaseId uint64, name string) *plan.Expr {
 	return nil
 }
+
+// encodeBlockMeta encodes the block meta as a range of the table,
+// the format is name length(4B) | name | id(8B) | offset(4B) | length(4B) | origin size(4B)
+func encodeBlockMeta(blk BlockMeta) []byte {
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(len(blk.name)))
+	buf.WriteString(blk.name)
+	_ = binary.Write(&buf, binary.LittleEndian, blk.extent.Id())
+	_ = binary.Write(&buf, binary.LittleEndian, blk.extent.Offset())
+	_ = binary.Write(&buf, binary.LittleEndian, blk.extent.Length())
+	_ = binary.Write(&buf, binary.LittleEndian, blk.extent.OriginSize())
+	return buf.Bytes()
+}
+
+func decodeBlockMeta(data []byte) (BlockMeta, error) {
+	var blk BlockMeta
+	if len(data) < 4 {
+		return blk, moerr.NewInternalError("invalid block meta")
+	}
+	n := binary.LittleEndian.Uint32(data)
+	data = data[4:]
+	if uint32(len(data)) != n+20 {
+		return blk, moerr.NewInternalError("invalid block meta")
+	}
+	blk.name = string(data[:n])
+	data = data[n:]
+	blk.extent = objectio.NewExtent(
+		binary.LittleEndian.Uint64(data),
+		binary.LittleEndian.Uint32(data[8:]),
+		binary.LittleEndian.Uint32(data[12:]),
+		binary.LittleEndian.Uint32(data[16:]))
+	return blk, nil
+}
+
+// genRows converts the batches read from a catalog table to rows,
+// the values of a row are in the order of the columns read
+func genRows(bats []*batch.Batch) [][]any {
+	var rows [][]any
+	for _, bat := range bats {
+		if bat == nil {
+			continue
+		}
+		for i := 0; i < bat.Length(); i++ {
+			row := make([]any, len(bat.Vecs))
+			for j, vec := range bat.Vecs {
+				row[j] = getValue(vec, i)
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// getValue returns the value of the row of a vector of the catalog column types,
+// a null is returned as nil
+func getValue(vec *vector.Vector, row int) any {
+	if vec.IsScalar() {
+		row = 0
+	}
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		return nil
+	}
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return vector.MustTCols[int8](vec)[row]
+	case types.T_int32:
+		return vector.MustTCols[int32](vec)[row]
+	case types.T_int64:
+		return vector.MustTCols[int64](vec)[row]
+	case types.T_uint64:
+		return vector.MustTCols[uint64](vec)[row]
+	case types.T_timestamp:
+		return vector.MustTCols[types.Timestamp](vec)[row]
+	case types.T_char, types.T_varchar:
+		return vector.MustStrCols(vec)[row]
+	}
+	return nil
+}
+
+// genTableDefs builds the attributes of the table from the rows of mo_columns, a row is
+// database id | table id | position | name | type | length | constraint type | auto increment | comment | hidden
+func genTableDefs(rows [][]any, databaseId, tableId uint64) []engine.TableDef {
+	var attrs [][]any
+	for _, row := range rows {
+		if uint64(row[0].(int32)) == databaseId && uint64(row[1].(int32)) == tableId {
+			attrs = append(attrs, row)
+		}
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		return attrs[i][2].(int32) < attrs[j][2].(int32)
+	})
+	defs := make([]engine.TableDef, 0, len(attrs))
+	for _, row := range attrs {
+		defs = append(defs, &engine.AttributeDef{
+			Attr: engine.Attribute{
+				Name:          row[3].(string),
+				Type:          types.New(types.T(row[4].(int32)), row[5].(int32), 0, 0),
+				Primary:       row[6] == "p",
+				AutoIncrement: row[7].(int8) == 1,
+				Comment:       row[8].(string),
+				IsHidden:      row[9].(int8) == 1,
+			},
+		})
+	}
+	return defs
+}
@@ -18,8 +18,10 @@ import (
 	"context"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 func (txn *Transaction) getTableList(ctx context.Context, databaseId uint64) ([]string, error) {
@@ -43,7 +45,29 @@ func (txn *Transaction) getTableId(ctx context.Context, databaseId uint64,
 	if err != nil {
 		return 0, err
 	}
-	return row[0].(uint64), nil
+	return uint64(row[0].(int32)), nil
+}
+
+// getTableDefs returns the attributes of the table in the order of their positions
+func (txn *Transaction) getTableDefs(ctx context.Context, databaseId uint64,
+	tableId uint64) ([]engine.TableDef, error) {
+	rows, err := txn.getRows(ctx, catalog.MO_CATALOG_ID, catalog.MO_COLUMNS_ID, txn.dnStores[:1],
+		[]string{
+			catalog.MoColumnsSchema[catalog.MO_COLUMNS_ATT_DATABASE_ID_IDX],
+			catalog.MoColumnsSchema[catalog.MO_COLUMNS_ATT_RELNAME_ID_IDX],
+			catalog.MoColumnsSchema[catalog.MO_COLUMNS_ATTNUM_IDX],
+			catalog.MoColumnsSchema[catalog.MO_COLUMNS_ATTNAME_IDX],
+			catalog.MoColumnsSchema[catalog.MO_COLUMNS_ATTTYP_IDX],
+			catalog.MoColumnsSchema[catalog.MO_COLUMNS_ATT_LENGTH_IDX],
+			catalog.MoColumnsSchema[catalog.MO_COLUMNS_ATT_CONSTRAINT_TYPE_IDX],
+			catalog.MoColumnsSchema[catalog.MO_COLUMNS_ATT_IS_AUTO_INCREMENT_IDX],
+			catalog.MoColumnsSchema[catalog.MO_COLUMNS_ATT_COMMENT_IDX],
+			catalog.MoColumnsSchema[catalog.MO_COLUMNS_ATT_IS_HIDDEN_IDX],
+		})
+	if err != nil {
+		return nil, err
+	}
+	return genTableDefs(rows, databaseId, tableId), nil
 }
 
 func (txn *Transaction) getDatabaseList(ctx context.Context) ([]string, error) {
@@ -65,7 +89,7 @@ func (txn *Transaction) getDatabaseId(ctx context.Context, name string) (uint64,
 	if err != nil {
 		return 0, err
 	}
-	return row[0].(uint64), nil
+	return uint64(row[0].(int32)), nil
 }
 
 // detecting whether a transaction is a read-only transaction
@@ -122,15 +146,13 @@ func (txn *Transaction) getRow(ctx context.Context, databaseId uint64, tableId u
 	dnList []DNStore, columns []string, expr *plan.Expr) ([]any, error) {
 	bats, err := txn.readTable(ctx, databaseId, tableId, dnList, columns, expr)
 	if err != nil {
-		return nil, nil
-	}
-	if len(bats) != 1 {
-		return nil, nil
+		return nil, err
 	}
-	if bats[0].Length() != 1 {
-		return nil, nil
+	rows := genRows(bats)
+	if len(rows) != 1 {
+		return nil, moerr.NewInternalError("%d rows found in table %d", len(rows), tableId)
 	}
-	return nil, nil
+	return rows[0], nil
 }
 
 // getRows used to get rows of table
@@ -138,27 +160,25 @@ func (txn *Transaction) getRows(ctx context.Context, databaseId uint64, tableId
 	dnList []DNStore, columns []string) ([][]any, error) {
 	bats, err := txn.readTable(ctx, databaseId, tableId, dnList, columns, nil)
 	if err != nil {
-		return nil, nil
-	}
-	if len(bats) == 0 {
-		return nil, nil
+		return nil, err
 	}
-	if bats[0].Length() != 1 {
-		return nil, nil
+	return genRows(bats), nil
+}
+
+// visibleWrites returns the writes of the statements before the current one,
+// the writes of the current statement are invisible to consider halloween problem
+func (txn *Transaction) visibleWrites() [][]Entry {
+	if int64(txn.statementId)-1 > 0 {
+		return txn.writes[:txn.statementId-1]
 	}
-	return nil, nil
+	return nil
 }
 
 // readTable used to get tuples of table based on a condition
 // only used to read data from catalog, for which the execution is currently single-core
 func (txn *Transaction) readTable(ctx context.Context, databaseId uint64, tableId uint64,
 	dnList []DNStore, columns []string, expr *plan.Expr) ([]*batch.Batch, error) {
-	var writes [][]Entry
-
-	// consider halloween problem
-	if int64(txn.statementId)-1 > 0 {
-		writes = txn.writes[:txn.statementId-1]
-	}
+	writes := txn.visibleWrites()
 	blkInfos := txn.db.BlockList(ctx, dnList, databaseId, tableId, txn.meta.SnapshotTS, writes)
 	bats := make([]*batch.Batch, 0, len(blkInfos))
 	for _, blkInfo := range blkInfos {
@@ -177,11 +197,16 @@ func (txn *Transaction) readTable(ctx context.Context, databaseId uint64, tableI
 		return nil, err
 	}
 	for _, rd := range rds {
-		bat, err := rd.Read(columns, expr, nil)
-		if err != nil {
-			return nil, err
+		for {
+			bat, err := rd.Read(columns, expr, nil)
+			if err != nil {
+				return nil, err
+			}
+			if bat == nil {
+				break
+			}
+			bats = append(bats, bat)
 		}
-		bats = append(bats, bat)
 	}
 	return bats, nil
 }
@@ -19,6 +19,8 @@ import (
 	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
@@ -37,6 +39,10 @@ type DNStore = logservice.DNStore
 // does not serve any purpose When tae submits a concrete structure,
 // it will replace this structure with tae's code
 type BlockMeta struct {
+	// name of the object which holds the block
+	name string
+	// location of the block metadata in the object
+	extent objectio.Extent
 }
 
 // Cache is a multi-version cache for maintaining some table data.
@@ -65,6 +71,7 @@ type Engine struct {
 	sync.RWMutex
 	getClusterDetails GetClusterDetailsFunc
 	db                *DB
+	fs                fileservice.FileService
 	txns              map[string]*Transaction
 }
 
@@ -92,6 +99,8 @@ type Transaction struct {
 	// every statement is an element
 	writes   [][]Entry
 	dnStores []DNStore
+	// fs is used to read the blocks written by objectio
+	fs fileservice.FileService
 }
 
 // Entry represents a delete/insert
@@ -119,4 +128,19 @@ type table struct {
 	tableId   uint64
 	tableName string
 	db        *database
+	// defs is the definition of the table, the columns of the blocks
+	// are in the order of its attributes
+	defs []engine.TableDef
+}
+
+// blockReader reads the blocks written by objectio, the blocks which have no row
+// satisfying the filter are skipped through their zone maps and bloom filters
+type blockReader struct {
+	fs   fileservice.FileService
+	blks []BlockMeta
+	expr *plan.Expr
+	// colIdxs is the index of each attribute in the blocks
+	colIdxs map[string]uint16
+	// prunedBlocks is the number of the skipped blocks
+	prunedBlocks int64
 }
@@ -109,6 +109,14 @@ func (e *MemEngine) Cost(_ *plan.ObjectRef, _ *plan.Expr) *plan.Cost {
 	return &plan.Cost{}
 }
 
+func (e *MemEngine) Stats(_ *plan.ObjectRef) *plan.TableStats {
+	return nil
+}
+
+func (e *MemEngine) Policies(_ *plan.ObjectRef, _ plan.Query_StatementType) ([]string, error) {
+	return nil, nil
+}
+
 func (e *MemEngine) GetRootSql() string {
 	return ""
 }
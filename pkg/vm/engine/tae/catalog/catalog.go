@@ -272,6 +272,7 @@ func (catalog *Catalog) onReplayUpdateTable(cmd *EntryCommand, dataFactory DataF
 	} else {
 		tblun.UpdateNode(un)
 	}
+	tbl.onReplaySchema(cmd.Table.GetSchema())
 
 	if observer != nil {
 		observer.OnTimeStamp(cmd.GetTs())
@@ -303,6 +304,7 @@ func (catalog *Catalog) onReplayTable(cmd *EntryCommand, dataFactory DataFactory
 			}
 			return true
 		}, true)
+		rel.onReplaySchema(cmd.Table.GetSchema())
 	}
 }
 
@@ -15,12 +15,17 @@
 package catalog
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -32,6 +37,101 @@ const (
 	ModuleName = "TAECATALOG"
 )
 
+func TestSchemaCompressAlg(t *testing.T) {
+	schema := NewEmptySchema(t.Name())
+	err := schema.AppendPKCol("pk", types.T_int32.ToType(), 0)
+	assert.NoError(t, err)
+	err = schema.AppendColWithAttribute(engine.Attribute{
+		Name:    "name",
+		Type:    types.T_varchar.ToType(),
+		Alg:     compress.Dict,
+		Default: &plan.Default{},
+	})
+	assert.NoError(t, err)
+	err = schema.Finalize(false)
+	assert.NoError(t, err)
+
+	buf, err := schema.Marshal()
+	assert.NoError(t, err)
+	schema2 := NewEmptySchema("")
+	_, err = schema2.ReadFrom(bytes.NewBuffer(buf))
+	assert.NoError(t, err)
+	assert.Equal(t, compress.T(compress.Lz4), schema2.ColDefs[0].CompressAlg)
+	assert.Equal(t, compress.T(compress.Dict), schema2.ColDefs[1].CompressAlg)
+}
+
+// marshalSchemaV0 marshals the schema in the format before it is versioned
+func marshalSchemaV0(t *testing.T, s *Schema) []byte {
+	var w bytes.Buffer
+	write := func(v any) {
+		assert.NoError(t, binary.Write(&w, binary.BigEndian, v))
+	}
+	writeString := func(v string) {
+		_, err := common.WriteString(v, &w)
+		assert.NoError(t, err)
+	}
+	write(s.BlockMaxRows)
+	write(s.SegmentMaxBlocks)
+	_, err := s.AcInfo.WriteTo(&w)
+	assert.NoError(t, err)
+	for _, v := range []string{s.Name, s.Comment, s.Partition, s.Relkind, s.Createsql, s.View} {
+		writeString(v)
+	}
+	write(uint16(len(s.ColDefs)))
+	for _, def := range s.ColDefs {
+		w.Write(types.EncodeType(&def.Type))
+		writeString(def.Name)
+		writeString(def.Comment)
+		for _, v := range []any{def.NullAbility, def.Hidden, def.PhyAddr, def.AutoIncrement, def.SortIdx, def.Primary, def.SortKey} {
+			write(v)
+		}
+		assert.NoError(t, MarshalDefault(&w, def.Default))
+		assert.NoError(t, MarshalOnUpdate(&w, def.OnUpdate))
+	}
+	return w.Bytes()
+}
+
+func TestSchemaFormatV0(t *testing.T) {
+	schema := NewEmptySchema(t.Name())
+	schema.BlockMaxRows = 100
+	schema.Comment = "comment"
+	err := schema.AppendPKCol("pk", types.T_int32.ToType(), 0)
+	assert.NoError(t, err)
+	err = schema.AppendColWithAttribute(engine.Attribute{
+		Name:    "name",
+		Type:    types.T_varchar.ToType(),
+		Comment: "name",
+		Default: &plan.Default{NullAbility: true},
+	})
+	assert.NoError(t, err)
+	err = schema.Finalize(false)
+	assert.NoError(t, err)
+
+	buf := marshalSchemaV0(t, schema)
+	schema2 := NewEmptySchema("")
+	_, err = schema2.ReadFrom(bytes.NewBuffer(buf))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(100), schema2.BlockMaxRows)
+	assert.Equal(t, "comment", schema2.Comment)
+	assert.Equal(t, uint32(0), schema2.Version)
+	assert.Equal(t, 3, len(schema2.ColDefs))
+	assert.Equal(t, "name", schema2.ColDefs[1].Comment)
+	assert.True(t, schema2.ColDefs[1].Nullable())
+	for _, def := range schema2.ColDefs {
+		assert.Equal(t, compress.T(compress.Lz4), def.CompressAlg)
+		assert.False(t, def.IsDropped())
+	}
+
+	// the current format is read back as it is
+	buf, err = schema.Marshal()
+	assert.NoError(t, err)
+	schema3 := NewEmptySchema("")
+	_, err = schema3.ReadFrom(bytes.NewBuffer(buf))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(100), schema3.BlockMaxRows)
+	assert.Equal(t, 3, len(schema3.ColDefs))
+}
+
 func TestCompoundPKSchema(t *testing.T) {
 	schema := NewEmptySchema(t.Name())
 	err := schema.AppendPKCol("pk1", types.T_int32.ToType(), 1)
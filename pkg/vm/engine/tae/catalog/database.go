@@ -284,7 +284,7 @@ func (e *DBEntry) RemoveEntry(table *TableEntry) (err error) {
 	defer func() {
 		if err == nil {
 			e.catalog.AddTableCnt(-1)
-			e.catalog.AddColumnCnt(-1 * len(table.schema.ColDefs))
+			e.catalog.AddColumnCnt(-1 * len(table.GetSchema().ColDefs))
 		}
 	}()
 	logutil.Info("[Catalog]", common.OperationField("remove"),
@@ -305,6 +305,28 @@ func (e *DBEntry) RemoveEntry(table *TableEntry) (err error) {
 	return
 }
 
+// renameEntry moves a table renamed by a committed txn to its new name
+func (e *DBEntry) renameEntry(table *TableEntry, oldFullName string) {
+	e.Lock()
+	defer e.Unlock()
+	if nn := e.nameNodes[oldFullName]; nn != nil {
+		if _, empty := nn.DeleteNode(table.GetID()); empty {
+			delete(e.nameNodes, oldFullName)
+		}
+	}
+	table.fullName = ""
+	fullName := table.GetFullName()
+	nn := e.nameNodes[fullName]
+	if nn == nil {
+		nn = newNodeList(e.GetItemNodeByIDLocked,
+			tableVisibilityFn[*TableEntry],
+			&e.nodesMu,
+			fullName)
+		e.nameNodes[fullName] = nn
+	}
+	nn.CreateNode(table.GetID())
+}
+
 // Catalog entry is created in following steps:
 // 1. Locate the record. Creating always gets the latest DBEntry.
 // 2.1 If there doesn't exist a DBEntry, add new entry and return.
@@ -320,7 +342,7 @@ func (e *DBEntry) AddEntryLocked(table *TableEntry, txn txnif.AsyncTxn) (err err
 	defer func() {
 		if err == nil {
 			e.catalog.AddTableCnt(1)
-			e.catalog.AddColumnCnt(len(table.schema.ColDefs))
+			e.catalog.AddColumnCnt(len(table.GetSchema().ColDefs))
 		}
 	}()
 	fullName := table.GetFullName()
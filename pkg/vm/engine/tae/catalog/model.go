@@ -15,6 +15,7 @@
 package catalog
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
 
@@ -36,6 +37,7 @@ const (
 	PhyAddrColumnName    = "PADDR"
 	PhyAddrColumnComment = "Physical address"
 	SortKeyNamePrefx     = "_SORT_"
+	DroppedColumnPrefix  = "_DROPPED_"
 
 	TenantSysID = uint32(0)
 
@@ -237,12 +239,13 @@ func init() {
 
 	SystemColumnSchema = NewEmptySchema(SystemTable_Columns_Name)
 	if err = SystemColumnSchema.AppendColDef(&ColDef{
-		Name:    SystemColAttr_UniqName,
-		Type:    tvarchar,
-		Hidden:  true,
-		SortIdx: 0,
-		SortKey: true,
-		Primary: true,
+		Name:        SystemColAttr_UniqName,
+		Type:        tvarchar,
+		Hidden:      true,
+		SortIdx:     0,
+		SortKey:     true,
+		Primary:     true,
+		CompressAlg: compress.Lz4,
 	}); err != nil {
 		panic(err)
 	}
@@ -20,10 +20,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"math/rand"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
@@ -75,6 +78,16 @@ type ColDef struct {
 	Comment       string
 	Default       Default
 	OnUpdate      []byte
+	// CompressAlg is the compression algorithm or the encoding of the column
+	CompressAlg compress.T
+	// EnumValues is the comma separated value list of enum and set
+	EnumValues string
+	// Dropped column keeps its position so that the blocks written before
+	// the drop are still read by position
+	Dropped bool
+	// Fill is the value of a column added by alter table in the rows
+	// written before, encoded by types.EncodeValue. nil means null
+	Fill []byte
 }
 
 func (def *ColDef) GetName() string     { return def.Name }
@@ -86,6 +99,22 @@ func (def *ColDef) IsPhyAddr() bool       { return def.PhyAddr }
 func (def *ColDef) IsPrimary() bool       { return def.Primary }
 func (def *ColDef) IsAutoIncrement() bool { return def.AutoIncrement }
 func (def *ColDef) IsSortKey() bool       { return def.SortKey }
+func (def *ColDef) IsDropped() bool       { return def.Dropped }
+
+// FillValue returns the value of the column in the rows written before the
+// column is added, a not null column without a fill holds the zero value
+func (def *ColDef) FillValue() any {
+	if def.Fill != nil {
+		return types.DecodeValue(def.Fill, def.Type)
+	}
+	if def.Nullable() {
+		return types.Null{}
+	}
+	if def.Type.IsVarlen() {
+		return []byte{}
+	}
+	return types.DecodeValue(make([]byte, def.Type.TypeSize()), def.Type)
+}
 
 type SortKey struct {
 	Defs      []*ColDef
@@ -133,6 +162,9 @@ type Schema struct {
 	Relkind          string
 	Createsql        string
 	View             string
+	Constraint       string
+	// Version is increased by every alter table
+	Version uint32
 
 	SortKey    *SortKey
 	PhyAddrKey *ColDef
@@ -283,14 +315,44 @@ func UnMarshalDefault(r io.Reader, data *Default) (n int64, err error) {
 	return n, nil
 }
 
+// The marshaled schema starts with schemaFormatMagic and the format version. A schema
+// marshaled before the format is versioned starts with BlockMaxRows instead, and its
+// format is the version 0.
+const (
+	schemaFormatMagic uint32 = math.MaxUint32
+	// schemaFormatV1 adds the constraints and the version of the schema, and the enum
+	// values, the compression algorithm, the dropped flag and the fill value of a column
+	schemaFormatV1 uint16 = 1
+
+	schemaFormatVersion = schemaFormatV1
+)
+
 func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
-	if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
+	var head uint32
+	if err = binary.Read(r, binary.BigEndian, &head); err != nil {
 		return
 	}
+	n = 4
+	format := uint16(0)
+	if head == schemaFormatMagic {
+		if err = binary.Read(r, binary.BigEndian, &format); err != nil {
+			return
+		}
+		if format > schemaFormatVersion {
+			err = fmt.Errorf("%w: unknown schema format %d", ErrSchemaValidation, format)
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
+			return
+		}
+		n += 2 + 4
+	} else {
+		s.BlockMaxRows = head
+	}
 	if err = binary.Read(r, binary.BigEndian, &s.SegmentMaxBlocks); err != nil {
 		return
 	}
-	n = 4 + 4
+	n += 4
 	var sn int64
 	if sn, err = s.AcInfo.ReadFrom(r); err != nil {
 		return
@@ -321,6 +383,16 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 		return
 	}
 	n += sn
+	if format >= schemaFormatV1 {
+		if s.Constraint, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if err = binary.Read(r, binary.BigEndian, &s.Version); err != nil {
+			return
+		}
+		n += 4
+	}
 	colCnt := uint16(0)
 	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
 		return
@@ -342,6 +414,12 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += sn
+		if format >= schemaFormatV1 {
+			if def.EnumValues, sn, err = common.ReadString(r); err != nil {
+				return
+			}
+			n += sn
+		}
 		if err = binary.Read(r, binary.BigEndian, &def.NullAbility); err != nil {
 			return
 		}
@@ -370,6 +448,15 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += 1
+		if format >= schemaFormatV1 {
+			if err = binary.Read(r, binary.BigEndian, &def.CompressAlg); err != nil {
+				return
+			}
+			n += 1
+		} else {
+			// the columns were always compressed by lz4
+			def.CompressAlg = compress.Lz4
+		}
 		def.Default = Default{}
 		if sn, err = UnMarshalDefault(r, &def.Default); err != nil {
 			return
@@ -379,6 +466,25 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += sn
+		if format >= schemaFormatV1 {
+			if err = binary.Read(r, binary.BigEndian, &def.Dropped); err != nil {
+				return
+			}
+			n += 1
+			hasFill := false
+			if err = binary.Read(r, binary.BigEndian, &hasFill); err != nil {
+				return
+			}
+			n += 1
+			if hasFill {
+				var fill string
+				if fill, sn, err = common.ReadString(r); err != nil {
+					return
+				}
+				n += sn
+				def.Fill = []byte(fill)
+			}
+		}
 		if err = s.AppendColDef(def); err != nil {
 			return
 		}
@@ -389,6 +495,12 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 
 func (s *Schema) Marshal() (buf []byte, err error) {
 	var w bytes.Buffer
+	if err = binary.Write(&w, binary.BigEndian, schemaFormatMagic); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, schemaFormatVersion); err != nil {
+		return
+	}
 	if err = binary.Write(&w, binary.BigEndian, s.BlockMaxRows); err != nil {
 		return
 	}
@@ -416,6 +528,12 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 	if _, err = common.WriteString(s.View, &w); err != nil {
 		return
 	}
+	if _, err = common.WriteString(s.Constraint, &w); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.Version); err != nil {
+		return
+	}
 	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.ColDefs))); err != nil {
 		return
 	}
@@ -429,6 +547,9 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if _, err = common.WriteString(def.Comment, &w); err != nil {
 			return
 		}
+		if _, err = common.WriteString(def.EnumValues, &w); err != nil {
+			return
+		}
 		if err = binary.Write(&w, binary.BigEndian, def.NullAbility); err != nil {
 			return
 		}
@@ -450,18 +571,36 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if err = binary.Write(&w, binary.BigEndian, def.SortKey); err != nil {
 			return
 		}
+		if err = binary.Write(&w, binary.BigEndian, def.CompressAlg); err != nil {
+			return
+		}
 		if err = MarshalDefault(&w, def.Default); err != nil {
 			return
 		}
 		if err = MarshalOnUpdate(&w, def.OnUpdate); err != nil {
 			return
 		}
+		if err = binary.Write(&w, binary.BigEndian, def.Dropped); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, def.Fill != nil); err != nil {
+			return
+		}
+		if def.Fill != nil {
+			if _, err = common.WriteString(string(def.Fill), &w); err != nil {
+				return
+			}
+		}
 	}
 	buf = w.Bytes()
 	return
 }
 
 func (s *Schema) AppendColDef(def *ColDef) (err error) {
+	if !def.IsDropped() && strings.HasPrefix(def.Name, DroppedColumnPrefix) {
+		err = fmt.Errorf("%w: column name \"%s\" is reserved", ErrSchemaValidation, def.Name)
+		return
+	}
 	def.Idx = len(s.ColDefs)
 	s.ColDefs = append(s.ColDefs, def)
 	_, existed := s.NameIndex[def.Name]
@@ -475,10 +614,11 @@ func (s *Schema) AppendColDef(def *ColDef) (err error) {
 
 func (s *Schema) AppendSortKey(name string, typ types.Type, idx int, isPrimary bool) error {
 	def := &ColDef{
-		Name:    name,
-		Type:    typ,
-		SortIdx: int8(idx),
-		SortKey: true,
+		Name:        name,
+		Type:        typ,
+		SortIdx:     int8(idx),
+		SortKey:     true,
+		CompressAlg: compress.Lz4,
 	}
 	def.Primary = isPrimary
 	return s.AppendColDef(def)
@@ -486,11 +626,12 @@ func (s *Schema) AppendSortKey(name string, typ types.Type, idx int, isPrimary b
 
 func (s *Schema) AppendPKCol(name string, typ types.Type, idx int) error {
 	def := &ColDef{
-		Name:    name,
-		Type:    typ,
-		SortIdx: int8(idx),
-		SortKey: true,
-		Primary: true,
+		Name:        name,
+		Type:        typ,
+		SortIdx:     int8(idx),
+		SortKey:     true,
+		Primary:     true,
+		CompressAlg: compress.Lz4,
 	}
 	return s.AppendColDef(def)
 }
@@ -519,48 +660,63 @@ func (s *Schema) AppendPKColWithAttribute(attr engine.Attribute, idx int) error
 		Comment:       attr.Comment,
 		Default:       attrDefault,
 		AutoIncrement: attr.AutoIncrement,
+		CompressAlg:   attr.Alg,
+		EnumValues:    attr.EnumValues,
 	}
 	return s.AppendColDef(def)
 }
 
 func (s *Schema) AppendCol(name string, typ types.Type) error {
 	def := &ColDef{
-		Name:    name,
-		Type:    typ,
-		SortIdx: -1,
+		Name:        name,
+		Type:        typ,
+		SortIdx:     -1,
+		CompressAlg: compress.Lz4,
 	}
 	return s.AppendColDef(def)
 }
 
 func (s *Schema) AppendColWithDefault(name string, typ types.Type, val Default) error {
 	def := &ColDef{
-		Name:    name,
-		Type:    typ,
-		SortIdx: -1,
-		Default: val,
+		Name:        name,
+		Type:        typ,
+		SortIdx:     -1,
+		Default:     val,
+		CompressAlg: compress.Lz4,
 	}
 	return s.AppendColDef(def)
 }
 
 func (s *Schema) AppendColWithAttribute(attr engine.Attribute) error {
+	def, err := newColDefWithAttribute(attr)
+	if err != nil {
+		return err
+	}
+	return s.AppendColDef(def)
+}
+
+func newColDefWithAttribute(attr engine.Attribute) (*ColDef, error) {
 	var bs []byte = nil
 	var ps []byte = nil
 	var err error
-	if attr.Default.Expr != nil {
+	if attr.Default != nil && attr.Default.Expr != nil {
 		bs, err = attr.Default.Expr.Marshal()
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
-	attrDefault := Default{
-		NullAbility:  attr.Default.NullAbility,
-		Expr:         bs,
-		OriginString: attr.Default.OriginString,
+	var attrDefault Default
+	if attr.Default != nil {
+		attrDefault = Default{
+			NullAbility:  attr.Default.NullAbility,
+			Expr:         bs,
+			OriginString: attr.Default.OriginString,
+		}
 	}
 	if attr.OnUpdate != nil {
 		ps, err = attr.OnUpdate.Marshal()
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	def := &ColDef{
@@ -572,8 +728,111 @@ func (s *Schema) AppendColWithAttribute(attr engine.Attribute) error {
 		Default:       attrDefault,
 		AutoIncrement: attr.AutoIncrement,
 		OnUpdate:      ps,
+		CompressAlg:   attr.Alg,
+		EnumValues:    attr.EnumValues,
 	}
-	return s.AppendColDef(def)
+	return def, nil
+}
+
+// AddColumn appends a column after all the existing ones, including the
+// physical address column, so that no column changes its position.
+func (s *Schema) AddColumn(attr engine.Attribute, fill []byte) (err error) {
+	if _, ok := s.NameIndex[attr.Name]; ok {
+		return fmt.Errorf("%w: duplicate column \"%s\"", ErrSchemaValidation, attr.Name)
+	}
+	def, err := newColDefWithAttribute(attr)
+	if err != nil {
+		return
+	}
+	def.Fill = fill
+	if err = s.AppendColDef(def); err != nil {
+		return
+	}
+	return s.refinalize()
+}
+
+// DropColumn marks a column dropped. The column is renamed so that a new
+// column can reuse its name.
+func (s *Schema) DropColumn(name string) (err error) {
+	idx, ok := s.getColIdxNotDropped(name)
+	if !ok {
+		return fmt.Errorf("%w: column \"%s\" not found", ErrSchemaValidation, name)
+	}
+	def := s.ColDefs[idx]
+	if def.IsSortKey() || def.IsPhyAddr() || def.IsHidden() {
+		return fmt.Errorf("%w: column \"%s\" can not be dropped", ErrSchemaValidation, name)
+	}
+	if len(s.Attrs()) == 1 {
+		return fmt.Errorf("%w: can not drop all columns", ErrSchemaValidation)
+	}
+	delete(s.NameIndex, name)
+	def.Dropped = true
+	def.Name = fmt.Sprintf("%s%d", DroppedColumnPrefix, idx)
+	s.NameIndex[def.Name] = idx
+	return s.refinalize()
+}
+
+func (s *Schema) RenameColumn(oldName, newName string) (err error) {
+	idx, ok := s.getColIdxNotDropped(oldName)
+	if !ok {
+		return fmt.Errorf("%w: column \"%s\" not found", ErrSchemaValidation, oldName)
+	}
+	if _, ok = s.NameIndex[newName]; ok {
+		return fmt.Errorf("%w: duplicate column \"%s\"", ErrSchemaValidation, newName)
+	}
+	def := s.ColDefs[idx]
+	if def.IsPhyAddr() || def.IsHidden() {
+		return fmt.Errorf("%w: column \"%s\" can not be renamed", ErrSchemaValidation, oldName)
+	}
+	if strings.HasPrefix(newName, DroppedColumnPrefix) {
+		return fmt.Errorf("%w: column name \"%s\" is reserved", ErrSchemaValidation, newName)
+	}
+	delete(s.NameIndex, oldName)
+	s.NameIndex[newName] = idx
+	def.Name = newName
+	return s.refinalize()
+}
+
+// ModifyColumn changes the definition of a column but not the layout of its
+// data, the type may only be widened.
+func (s *Schema) ModifyColumn(attr engine.Attribute) (err error) {
+	idx, ok := s.getColIdxNotDropped(attr.Name)
+	if !ok {
+		return fmt.Errorf("%w: column \"%s\" not found", ErrSchemaValidation, attr.Name)
+	}
+	def := s.ColDefs[idx]
+	if def.IsPhyAddr() || def.IsHidden() {
+		return fmt.Errorf("%w: column \"%s\" can not be modified", ErrSchemaValidation, attr.Name)
+	}
+	if def.Type.Oid != attr.Type.Oid || def.Type.Precision != attr.Type.Precision ||
+		def.Type.Scale != attr.Type.Scale || def.Type.Width > attr.Type.Width {
+		return fmt.Errorf("%w: type of column \"%s\" can not be changed from %s to %s",
+			ErrSchemaValidation, attr.Name, def.Type.String(), attr.Type.String())
+	}
+	ndef, err := newColDefWithAttribute(attr)
+	if err != nil {
+		return
+	}
+	def.Type.Width = ndef.Type.Width
+	def.Comment = ndef.Comment
+	def.Default = ndef.Default
+	def.OnUpdate = ndef.OnUpdate
+	def.EnumValues = ndef.EnumValues
+	return s.refinalize()
+}
+
+func (s *Schema) getColIdxNotDropped(name string) (idx int, ok bool) {
+	if idx, ok = s.NameIndex[name]; ok && s.ColDefs[idx].IsDropped() {
+		ok = false
+	}
+	return
+}
+
+// refinalize validates the schema again after it is altered
+func (s *Schema) refinalize() error {
+	s.SortKey = nil
+	s.PhyAddrKey = nil
+	return s.Finalize(true)
 }
 
 func (s *Schema) String() string {
@@ -588,7 +847,7 @@ func (s *Schema) IsPartOfPK(idx int) bool {
 func (s *Schema) Attrs() []string {
 	attrs := make([]string, 0, len(s.ColDefs)-1)
 	for _, def := range s.ColDefs {
-		if def.IsPhyAddr() {
+		if def.IsPhyAddr() || def.IsDropped() {
 			continue
 		}
 		attrs = append(attrs, def.Name)
@@ -599,7 +858,7 @@ func (s *Schema) Attrs() []string {
 func (s *Schema) Types() []types.Type {
 	ts := make([]types.Type, 0, len(s.ColDefs)-1)
 	for _, def := range s.ColDefs {
-		if def.IsPhyAddr() {
+		if def.IsPhyAddr() || def.IsDropped() {
 			continue
 		}
 		ts = append(ts, def.Type)
@@ -610,7 +869,7 @@ func (s *Schema) Types() []types.Type {
 func (s *Schema) Nullables() []bool {
 	nulls := make([]bool, 0, len(s.ColDefs)-1)
 	for _, def := range s.ColDefs {
-		if def.IsPhyAddr() {
+		if def.IsPhyAddr() || def.IsDropped() {
 			continue
 		}
 		nulls = append(nulls, def.Nullable())
@@ -653,11 +912,12 @@ func (s *Schema) Finalize(rebuild bool) (err error) {
 	}
 	if !rebuild {
 		phyAddrDef := &ColDef{
-			Name:    PhyAddrColumnName,
-			Comment: PhyAddrColumnComment,
-			Type:    PhyAddrColumnType,
-			Hidden:  true,
-			PhyAddr: true,
+			Name:        PhyAddrColumnName,
+			Comment:     PhyAddrColumnComment,
+			Type:        PhyAddrColumnType,
+			Hidden:      true,
+			PhyAddr:     true,
+			CompressAlg: compress.Lz4,
 		}
 		if err = s.AppendColDef(phyAddrDef); err != nil {
 			return
@@ -679,6 +939,10 @@ func (s *Schema) Finalize(rebuild bool) (err error) {
 			return
 		}
 		names[def.Name] = true
+		if def.IsDropped() {
+			def.NullAbility = true
+			continue
+		}
 		if def.IsSortKey() {
 			sortIdx = append(sortIdx, idx)
 		}
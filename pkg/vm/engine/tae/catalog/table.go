@@ -25,6 +25,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 )
 
 type TableDataFactory = func(meta *TableEntry) data.Table
@@ -37,8 +39,9 @@ func tableVisibilityFn[T *TableEntry](n *common.GenericDLNode[*TableEntry], ts t
 
 type TableEntry struct {
 	*TableBaseEntry
-	db        *DBEntry
-	schema    *Schema
+	db *DBEntry
+	// schema is the latest committed *Schema
+	schema    atomic.Value
 	entries   map[uint64]*common.GenericDLNode[*SegmentEntry]
 	link      *common.GenericSortedDList[*SegmentEntry]
 	tableData data.Table
@@ -65,10 +68,10 @@ func NewTableEntry(db *DBEntry, schema *Schema, txnCtx txnif.AsyncTxn, dataFacto
 	e := &TableEntry{
 		TableBaseEntry: NewTableBaseEntry(id),
 		db:             db,
-		schema:         schema,
 		link:           common.NewGenericSortedDList(compareSegmentFn),
 		entries:        make(map[uint64]*common.GenericDLNode[*SegmentEntry]),
 	}
+	e.schema.Store(schema)
 	if dataFactory != nil {
 		e.tableData = dataFactory(e)
 	}
@@ -80,10 +83,10 @@ func NewSystemTableEntry(db *DBEntry, id uint64, schema *Schema) *TableEntry {
 	e := &TableEntry{
 		TableBaseEntry: NewTableBaseEntry(id),
 		db:             db,
-		schema:         schema,
 		link:           common.NewGenericSortedDList(compareSegmentFn),
 		entries:        make(map[uint64]*common.GenericDLNode[*SegmentEntry]),
 	}
+	e.schema.Store(schema)
 	e.CreateWithTS(types.SystemDBTS)
 	var sid uint64
 	if schema.Name == SystemTableSchema.Name {
@@ -110,21 +113,23 @@ func NewReplayTableEntry() *TableEntry {
 }
 
 func MockStaloneTableEntry(id uint64, schema *Schema) *TableEntry {
-	return &TableEntry{
+	e := &TableEntry{
 		TableBaseEntry: NewTableBaseEntry(id),
-		schema:         schema,
 		link:           common.NewGenericSortedDList(compareSegmentFn),
 		entries:        make(map[uint64]*common.GenericDLNode[*SegmentEntry]),
 	}
+	e.schema.Store(schema)
+	return e
 }
 
 func (entry *TableEntry) IsVirtual() bool {
 	if !entry.db.IsSystemDB() {
 		return false
 	}
-	return entry.schema.Name == SystemTable_DB_Name ||
-		entry.schema.Name == SystemTable_Table_Name ||
-		entry.schema.Name == SystemTable_Columns_Name
+	name := entry.GetSchema().Name
+	return name == SystemTable_DB_Name ||
+		name == SystemTable_Table_Name ||
+		name == SystemTable_Columns_Name
 }
 
 func (entry *TableEntry) GetRows() uint64 {
@@ -186,12 +191,25 @@ func (entry *TableEntry) deleteEntryLocked(segment *SegmentEntry) error {
 }
 
 func (entry *TableEntry) GetSchema() *Schema {
-	return entry.schema
+	schema, _ := entry.schema.Load().(*Schema)
+	return schema
+}
+
+// GetUpdateSchema returns the schema altered by the latest update of the
+// entry, or the committed one if the update doesn't alter the table
+func (entry *TableEntry) GetUpdateSchema() *Schema {
+	entry.RLock()
+	defer entry.RUnlock()
+	if node := entry.GetUpdateNodeLocked(); node != nil && node.(*TableMVCCNode).Schema != nil {
+		return node.(*TableMVCCNode).Schema
+	}
+	return entry.GetSchema()
 }
 
 func (entry *TableEntry) GetFullName() string {
 	if len(entry.fullName) == 0 {
-		entry.fullName = genTblFullName(entry.schema.AcInfo.TenantID, entry.schema.Name)
+		schema := entry.GetSchema()
+		entry.fullName = genTblFullName(schema.AcInfo.TenantID, schema.Name)
 	}
 	return entry.fullName
 }
@@ -223,7 +241,7 @@ func (entry *TableEntry) String() string {
 }
 
 func (entry *TableEntry) StringLocked() string {
-	return fmt.Sprintf("TABLE%s[name=%s]", entry.TableBaseEntry.StringLocked(), entry.schema.Name)
+	return fmt.Sprintf("TABLE%s[name=%s]", entry.TableBaseEntry.StringLocked(), entry.GetSchema().Name)
 }
 
 func (entry *TableEntry) GetCatalog() *Catalog { return entry.db.catalog }
@@ -328,11 +346,83 @@ func (entry *TableEntry) PrepareRollback() (err error) {
 	return
 }
 
+// AlterTable alters a copy of the schema of the table with fn, the altered
+// schema takes effect when txn commits. isNewNode is true when it is the
+// first change of the table made by txn
+func (entry *TableEntry) AlterTable(txn txnif.AsyncTxn, fn func(*Schema) error) (isNewNode bool, err error) {
+	entry.Lock()
+	defer entry.Unlock()
+	needWait, waitTxn := entry.NeedWaitCommitting(txn.GetStartTS())
+	if needWait {
+		entry.Unlock()
+		waitTxn.GetTxnState(true)
+		entry.Lock()
+	}
+	if err = entry.CheckConflict(txn); err != nil {
+		return
+	}
+	head := entry.GetUpdateNodeLocked().(*TableMVCCNode)
+	if head.HasDropped() {
+		err = ErrNotFound
+		return
+	}
+	sameTxn := !head.IsCommitted() && head.IsSameTxn(txn.GetStartTS())
+	base := entry.GetSchema()
+	if sameTxn && head.Schema != nil {
+		base = head.Schema
+	}
+	schema := base.Clone()
+	schema.Version = entry.GetSchema().Version + 1
+	if err = fn(schema); err != nil {
+		return
+	}
+	if sameTxn {
+		head.Schema = schema
+		return
+	}
+	node := head.CloneData().(*TableMVCCNode)
+	node.TxnMVCCNode = txnbase.NewTxnMVCCNodeWithTxn(txn)
+	node.Schema = schema
+	entry.InsertNode(node)
+	isNewNode = true
+	return
+}
+
+func (entry *TableEntry) ApplyCommit(index *wal.Index) (err error) {
+	entry.Lock()
+	node := entry.GetUpdateNodeLocked().(*TableMVCCNode)
+	if err = node.ApplyCommit(index); err != nil {
+		entry.Unlock()
+		return
+	}
+	entry.Unlock()
+	if node.Schema != nil {
+		entry.applySchema(node.Schema)
+	}
+	return
+}
+
+// applySchema makes schema the committed schema of the table and moves the
+// table to its new name if it is renamed
+func (entry *TableEntry) applySchema(schema *Schema) {
+	old := entry.GetSchema()
+	entry.schema.Store(schema)
+	if old.Name != schema.Name {
+		entry.db.renameEntry(entry, genTblFullName(old.AcInfo.TenantID, old.Name))
+	}
+}
+
+func (entry *TableEntry) onReplaySchema(schema *Schema) {
+	if schema.Version > entry.GetSchema().Version {
+		entry.applySchema(schema)
+	}
+}
+
 func (entry *TableEntry) WriteTo(w io.Writer) (n int64, err error) {
 	if n, err = entry.TableBaseEntry.WriteAllTo(w); err != nil {
 		return
 	}
-	buf, err := entry.schema.Marshal()
+	buf, err := entry.GetSchema().Marshal()
 	if err != nil {
 		return
 	}
@@ -346,12 +436,14 @@ func (entry *TableEntry) ReadFrom(r io.Reader) (n int64, err error) {
 	if n, err = entry.TableBaseEntry.ReadAllFrom(r); err != nil {
 		return
 	}
-	if entry.schema == nil {
-		entry.schema = NewEmptySchema("")
+	schema := entry.GetSchema()
+	if schema == nil {
+		schema = NewEmptySchema("")
 	}
 	sn := int64(0)
-	sn, err = entry.schema.ReadFrom(r)
+	sn, err = schema.ReadFrom(r)
 	n += sn
+	entry.schema.Store(schema)
 	return
 }
 
@@ -364,19 +456,21 @@ func (entry *TableEntry) GetCheckpointItems(start, end types.TS) CheckpointItems
 	if ret == nil {
 		return nil
 	}
-	return &TableEntry{
+	e := &TableEntry{
 		TableBaseEntry: ret.(*TableBaseEntry),
-		schema:         entry.schema,
 		db:             entry.db,
 	}
+	e.schema.Store(entry.GetSchema())
+	return e
 }
 
 func (entry *TableEntry) CloneCreateEntry() *TableEntry {
-	return &TableEntry{
+	e := &TableEntry{
 		TableBaseEntry: entry.TableBaseEntry.CloneCreateEntry().(*TableBaseEntry),
 		db:             entry.db,
-		schema:         entry.schema,
 	}
+	e.schema.Store(entry.GetUpdateSchema())
+	return e
 }
 
 // IsActive is coarse API: no consistency check
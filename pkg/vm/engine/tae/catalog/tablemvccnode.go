@@ -25,6 +25,9 @@ import (
 type TableMVCCNode struct {
 	*EntryMVCCNode
 	*txnbase.TxnMVCCNode
+	// Schema is the schema altered by the txn of the node, nil if the
+	// txn doesn't alter the table. It is logged with the table command
+	Schema *Schema
 }
 
 func NewEmptyTableMVCCNode() txnbase.MVCCNode {
@@ -41,6 +44,7 @@ func CompareTableBaseNode(e, o txnbase.MVCCNode) int {
 func (e *TableMVCCNode) CloneAll() txnbase.MVCCNode {
 	node := e.CloneData()
 	node.(*TableMVCCNode).TxnMVCCNode = e.TxnMVCCNode.CloneAll()
+	node.(*TableMVCCNode).Schema = e.Schema
 	return node
 }
 
@@ -84,6 +84,12 @@ func CompareGeneric(a, b any, t types.Type) int64 {
 		return CompareOrdered[types.Timestamp](a, b)
 	case types.T_date:
 		return CompareOrdered[types.Date](a, b)
+	case types.T_time:
+		return CompareOrdered[types.Time](a, b)
+	case types.T_enum:
+		return CompareOrdered[types.Enum](a, b)
+	case types.T_set:
+		return CompareOrdered[types.Set](a, b)
 	case types.T_datetime:
 		return CompareOrdered[types.Datetime](a, b)
 	case types.T_uuid:
@@ -52,6 +52,12 @@ func MakeVector(typ types.Type, nullable bool, opts ...*Options) (vec Vector) {
 		vec = NewVector[types.Date](typ, nullable, opts...)
 	case types.T_timestamp:
 		vec = NewVector[types.Timestamp](typ, nullable, opts...)
+	case types.T_time:
+		vec = NewVector[types.Time](typ, nullable, opts...)
+	case types.T_enum:
+		vec = NewVector[types.Enum](typ, nullable, opts...)
+	case types.T_set:
+		vec = NewVector[types.Set](typ, nullable, opts...)
 	case types.T_datetime:
 		vec = NewVector[types.Datetime](typ, nullable, opts...)
 	case types.T_TS:
@@ -185,6 +185,18 @@ func MockVector(t types.Type, rows int, unique, nullable bool, provider Vector)
 				vec.Append([]byte(s))
 			}
 		}
+	case types.T_time:
+		for i := 1; i <= rows; i++ {
+			vec.Append(types.TimeFromClock(false, uint64(i%800), 1, 1, 1))
+		}
+	case types.T_enum:
+		for i := 1; i <= rows; i++ {
+			vec.Append(types.Enum(i % 65535))
+		}
+	case types.T_set:
+		for i := 1; i <= rows; i++ {
+			vec.Append(types.Set(i))
+		}
 	case types.T_datetime:
 		for i := 1; i <= rows; i++ {
 			vec.Append(types.FromClock(int32(i*100), 1, 1, 1, 1, 1, 1))
@@ -291,6 +303,18 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 		for i := 0; i < rows; i++ {
 			vec.Append(types.Date(i + offset))
 		}
+	case types.T_time:
+		for i := 0; i < rows; i++ {
+			vec.Append(types.Time(i + offset))
+		}
+	case types.T_enum:
+		for i := 0; i < rows; i++ {
+			vec.Append(types.Enum(i + offset))
+		}
+	case types.T_set:
+		for i := 0; i < rows; i++ {
+			vec.Append(types.Set(i + offset))
+		}
 	case types.T_datetime:
 		for i := 0; i < rows; i++ {
 			vec.Append(types.Datetime(i + offset))
@@ -341,7 +341,7 @@ func (vec *vector[T]) ReadFromFile(f common.IVFile, buffer *bytes.Buffer) (err e
 			}
 			buf = buffer.Bytes()[:osize]
 		}
-		if _, err = compress.Decompress(srcBuf, buf, compress.Lz4); err != nil {
+		if _, err = compress.Decompress(srcBuf, buf, stat.CompressAlgo()); err != nil {
 			if n != nil {
 				vec.GetAllocator().Free(n)
 			}
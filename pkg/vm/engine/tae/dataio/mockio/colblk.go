@@ -15,6 +15,7 @@
 package mockio
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -45,6 +46,9 @@ func newColumnBlock(block *blockFile, indexCnt int) *columnBlock {
 	return cb
 }
 
+// SetCompressAlgo is a no-op, the data in memory is not compressed
+func (cb *columnBlock) SetCompressAlgo(algo compress.T) {}
+
 func (cb *columnBlock) WriteTS(ts types.TS) (err error) {
 	cb.ts = ts
 	return
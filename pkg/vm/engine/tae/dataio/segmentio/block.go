@@ -204,9 +204,9 @@ func (bf *blockFile) LoadBatch(
 		if _, err = f.Read(buf); err != nil {
 			return
 		}
-		if f.Stat().CompressAlgo() == compress.Lz4 {
+		if f.Stat().CompressAlgo() != compress.None {
 			decompress := make([]byte, f.Stat().OriginSize())
-			decompress, err = compress.Decompress(buf, decompress, compress.Lz4)
+			decompress, err = compress.Decompress(buf, decompress, f.Stat().CompressAlgo())
 			if err != nil {
 				return nil, err
 			}
@@ -230,6 +230,8 @@ func (bf *blockFile) LoadBatch(
 }
 
 func (bf *blockFile) WriteColumnVec(ts types.TS, colIdx int, vec containers.Vector) (err error) {
+	// the block is opened before a column is added to the table
+	bf.AddColumn(colIdx + 1)
 	cb, err := bf.OpenColumn(colIdx)
 	if err != nil {
 		return err
@@ -372,7 +374,7 @@ func (bf *blockFile) LoadDeletes() (mask *roaring.Bitmap, err error) {
 	node := common.GPool.Alloc(uint64(osize))
 	defer common.GPool.Free(node)
 
-	if _, err = compress.Decompress(dnode.Buf[:size], node.Buf[:osize], compress.Lz4); err != nil {
+	if _, err = compress.Decompress(dnode.Buf[:size], node.Buf[:osize], stats.CompressAlgo()); err != nil {
 		return
 	}
 	mask = roaring.New()
@@ -86,3 +86,35 @@ func TestBlock1(t *testing.T) {
 
 	block.Unref()
 }
+
+func TestBlockCompressAlgo(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	seg := SegmentFactory.Build(dir, common.NextGlobalSeqNum()).(*segmentFile)
+	block := newBlock(common.NextGlobalSeqNum(), seg, 2, nil)
+	defer block.Unref()
+	blockTs := types.NextGlobalTsForTest()
+	assert.Nil(t, block.WriteTS(blockTs))
+
+	dataStr := bytes.Repeat([]byte("hello tae "), 100)
+	for col, algo := range []compress.T{compress.Zstd, compress.Dict} {
+		colBlk, err := block.OpenColumn(col)
+		assert.Nil(t, err)
+		colBlk.SetCompressAlgo(algo)
+		assert.Nil(t, colBlk.WriteTS(blockTs))
+		assert.Nil(t, colBlk.WriteData(dataStr))
+
+		dataFile, err := colBlk.OpenDataFile()
+		assert.Nil(t, err)
+		// the encodings are compressed by zstd
+		assert.Equal(t, int(compress.Zstd), dataFile.Stat().CompressAlgo())
+		assert.Less(t, dataFile.Stat().Size(), dataFile.Stat().OriginSize())
+		buf := make([]byte, dataFile.Stat().Size())
+		_, err = dataFile.Read(buf)
+		assert.Nil(t, err)
+		dbuf, err := compress.Decompress(buf, make([]byte, dataFile.Stat().OriginSize()), compress.Zstd)
+		assert.Nil(t, err)
+		assert.Equal(t, dataStr, dbuf)
+		dataFile.Unref()
+		colBlk.Close()
+	}
+}
@@ -32,6 +32,7 @@ type columnBlock struct {
 	updates *updatesFile
 	data    *dataFile
 	col     int
+	algo    uint8
 }
 
 func newColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
@@ -39,6 +40,7 @@ func newColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
 		block:   block,
 		indexes: make([]*indexFile, indexCnt),
 		col:     col,
+		algo:    compress.Lz4,
 	}
 	for i := range cb.indexes {
 		cb.indexes[i] = newIndex(cb)
@@ -64,12 +66,26 @@ func (cb *columnBlock) AddIndex(idx int) {
 	}
 }
 
+// SetCompressAlgo sets the compression algorithm of the data files written later,
+// the encodings of the columns are not supported by the segment files, which are
+// compressed by zstd instead.
+func (cb *columnBlock) SetCompressAlgo(algo compress.T) {
+	switch algo {
+	case compress.Dict, compress.Rle:
+		cb.algo = compress.Zstd
+	default:
+		cb.algo = uint8(algo)
+	}
+}
+
 func (cb *columnBlock) WriteTS(ts types.TS) (err error) {
 	cb.ts = ts
+	data := cb.block.seg.GetSegmentFile().NewBlockFile(fmt.Sprintf("%d_%d_%s.blk", cb.col,
+		cb.block.id, ts.ToString()))
+	data.snode.algo = cb.algo
 	cb.data.SetFile(
 		//cb.block.seg.GetSegmentFile().NewBlockFile(fmt.Sprintf("%d_%d_%d.blk", cb.col, cb.block.id, ts)),
-		cb.block.seg.GetSegmentFile().NewBlockFile(fmt.Sprintf("%d_%d_%s.blk", cb.col,
-			cb.block.id, ts.ToString())),
+		data,
 		uint32(len(cb.block.columns)),
 		uint32(len(cb.indexes)))
 	cb.updates.SetFile(
@@ -24,7 +24,6 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
-	"github.com/pierrec/lz4/v4"
 )
 
 const INODE_NUM = 20480
@@ -257,10 +256,10 @@ func (s *Driver) Append(fd *DriverFile, pl []byte) (err error) {
 		}
 	}
 	buf := pl
-	if fd.snode.algo == compress.Lz4 {
+	if fd.snode.algo != compress.None {
 		colSize := len(pl)
-		buf = make([]byte, lz4.CompressBlockBound(colSize))
-		if buf, err = compress.Compress(pl, buf, compress.Lz4); err != nil {
+		buf = make([]byte, compress.CompressBound(colSize, int(fd.snode.algo)))
+		if buf, err = compress.Compress(pl, buf, int(fd.snode.algo)); err != nil {
 			return
 		}
 	}
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -146,6 +147,7 @@ func getColumnBlock(col int, block *blockFile) *columnBlock {
 		block:   block,
 		indexes: make([]*indexFile, 0),
 		col:     col,
+		algo:    compress.Lz4,
 	}
 	cb.updates = newUpdates(cb)
 	cb.data = newData(cb)
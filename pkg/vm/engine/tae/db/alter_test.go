@@ -0,0 +1,131 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func checkAlteredColumn(t *testing.T, e *testEngine, oldRows, newRows int) {
+	txn, rel := e.getRelation()
+	schema := rel.Schema().(*catalog.Schema)
+	assert.Equal(t, []string{"mock_0", "mock_2", "renamed", "extra"}, schema.Attrs())
+	assert.Equal(t, -1, schema.GetColIdx("mock_1"))
+	filled, appended := 0, 0
+	forEachColumnView(rel, schema.GetColIdx("extra"), func(view *model.ColumnView) (err error) {
+		view.ApplyDeletes()
+		for i := 0; i < view.Length(); i++ {
+			if v := view.GetData().Get(i).(int32); v == 7 {
+				filled++
+			} else {
+				assert.GreaterOrEqual(t, v, int32(100))
+				appended++
+			}
+		}
+		return
+	})
+	assert.Equal(t, oldRows, filled)
+	assert.Equal(t, newRows, appended)
+	assert.Equal(t, oldRows+newRows, getColumnRowsByScan(t, rel, schema.GetColIdx("renamed"), true))
+	assert.NoError(t, txn.Commit())
+}
+
+func TestAlterTable(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(4, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 20)
+	defer bat.Close()
+	bats := bat.Split(4)
+	tae.createRelAndAppend(bats[0], true)
+	tae.doAppend(bats[1])
+	tae.doAppend(bats[2])
+
+	// A txn reading the table before the alter can not append after it
+	txn1, rel1 := tae.getRelation()
+	assert.NoError(t, rel1.Append(bats[3]))
+
+	typ := types.T_int32.ToType()
+	txn, rel := tae.getRelation()
+	err := rel.AlterTable(func(schema *catalog.Schema) error {
+		if err := schema.AddColumn(engine.Attribute{Name: "extra", Type: typ, Alg: compress.Lz4}, types.EncodeValue(int32(7), typ)); err != nil {
+			return err
+		}
+		if err := schema.DropColumn("mock_1"); err != nil {
+			return err
+		}
+		return schema.RenameColumn("mock_3", "renamed")
+	})
+	assert.NoError(t, err)
+	err = rel.AlterTable(func(schema *catalog.Schema) error {
+		return schema.DropColumn("mock_2")
+	})
+	assert.ErrorIs(t, err, catalog.ErrSchemaValidation)
+	assert.NoError(t, txn.Commit())
+	assert.Error(t, txn1.Commit())
+	tae.bindSchema(rel.Schema().(*catalog.Schema))
+	assert.Equal(t, uint32(1), tae.schema.Version)
+	checkAlteredColumn(t, tae, 15, 0)
+
+	// Append rows laid out as the altered schema
+	data := containers.NewBatch()
+	data.AddVector("mock_0", bats[3].Vecs[0])
+	data.AddVector("mock_2", bats[3].Vecs[2])
+	data.AddVector("renamed", bats[3].Vecs[3])
+	extra := containers.MakeVector(typ, false)
+	defer extra.Close()
+	for i := 0; i < bats[3].Length(); i++ {
+		extra.Append(int32(100 + i))
+	}
+	data.AddVector("extra", extra)
+	tae.doAppend(data)
+	checkAlteredColumn(t, tae, 15, 5)
+
+	tae.compactBlocks(false)
+	tae.mergeBlocks(false)
+	checkAlteredColumn(t, tae, 15, 5)
+
+	tae.restart()
+	checkAlteredColumn(t, tae, 15, 5)
+
+	txn, rel = tae.getRelation()
+	assert.NoError(t, rel.AlterTable(func(schema *catalog.Schema) error {
+		schema.Name = "renamed_table"
+		return nil
+	}))
+	assert.NoError(t, txn.Commit())
+	tae.bindSchema(rel.Schema().(*catalog.Schema))
+	checkAlteredColumn(t, tae, 15, 5)
+
+	tae.restart()
+	checkAlteredColumn(t, tae, 15, 5)
+	txn, db := tae.getTestDB()
+	_, err = db.GetRelationByName(schema.Name)
+	assert.Error(t, err)
+	assert.NoError(t, txn.Commit())
+}
@@ -20,6 +20,7 @@ import (
 	"runtime"
 	"sync/atomic"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
@@ -62,6 +63,9 @@ type DB struct {
 
 	FileFactory file.SegmentFactory
 
+	// PrepareLog is filled by the replay with the records of GroupPrepare
+	PrepareLog *PrepareLog
+
 	DBLocker io.Closer
 
 	Closed *atomic.Value
@@ -71,6 +75,10 @@ func (db *DB) StartTxn(info []byte) (txnif.AsyncTxn, error) {
 	return db.TxnMgr.StartTxn(info)
 }
 
+func (db *DB) StartTxnAt(info []byte, ts types.TS) (txnif.AsyncTxn, error) {
+	return db.TxnMgr.StartTxnAt(info, ts)
+}
+
 func (db *DB) CommitTxn(txn txnif.AsyncTxn) (err error) {
 	return txn.Commit()
 }
@@ -1820,6 +1820,53 @@ func TestGetByFilter(t *testing.T) {
 	assert.NoError(t, txn1.Commit())
 }
 
+// 1. Append 10 rows and compact the block
+// 2. BatchGetByFilter finds the keys in the non-appendable block and the rows
+// written by the txn, and skips the deleted and the missing keys
+func TestBatchGetByFilter(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13, 12)
+	schema.BlockMaxRows = 10
+	bat := catalog.MockBatch(schema, 15)
+	defer bat.Close()
+	bats := bat.Split(3)
+
+	// Step 1
+	createRelationAndAppend(t, 0, tae, "db", schema, bats[0], true)
+	compactBlocks(t, 0, tae, "db", schema, false)
+	pkIdx := schema.GetSingleSortKeyIdx()
+	{
+		txn, rel := getDefaultRelation(t, tae, schema.Name)
+		id, row, err := rel.GetByFilter(handle.NewEQFilter(bats[0].Vecs[pkIdx].Get(1)))
+		assert.NoError(t, err)
+		assert.NoError(t, rel.RangeDelete(id, row, row, handle.DT_Normal))
+		assert.NoError(t, txn.Commit())
+	}
+
+	// Step 2
+	txn, rel := getDefaultRelation(t, tae, schema.Name)
+	assert.NoError(t, rel.Append(bats[1]))
+	keys := bats[0].Vecs[pkIdx].CloneWindow(0, 3)
+	defer keys.Close()
+	keys.Append(bats[1].Vecs[pkIdx].Get(2))
+	keys.Append(bats[2].Vecs[pkIdx].Get(0))
+	ids, offsets, err := rel.BatchGetByFilter(keys)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(ids))
+	for i, ok := range []bool{true, false, true, true, false} {
+		if !ok {
+			assert.Nil(t, ids[i])
+			continue
+		}
+		v, err := rel.GetValue(ids[i], offsets[i], uint16(pkIdx))
+		assert.NoError(t, err)
+		assert.Equal(t, keys.Get(i), v)
+	}
+	assert.NoError(t, txn.Commit())
+}
+
 //  1. Set a big BlockMaxRows
 //  2. Mock one row batch
 //  3. Start tones of workers. Each work execute below routines:
@@ -60,6 +60,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 		MTBufMgr:    mutBufMgr,
 		TxnBufMgr:   txnBufMgr,
 		FileFactory: segmentio.SegmentFactory,
+		PrepareLog:  NewPrepareLog(),
 		Closed:      new(atomic.Value),
 	}
 
@@ -0,0 +1,55 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+// PrepareEntry is an entry of wal.GroupPrepare found by the replay
+type PrepareEntry struct {
+	LSN     uint64
+	Type    uint16
+	Payload []byte
+}
+
+// PrepareLog collects what the replay learns about the txns prepared by the
+// txn storage on top of the db. The entries of wal.GroupPrepare are kept in
+// lsn order and the commit records carrying a txn info mark the info as
+// committed.
+type PrepareLog struct {
+	Entries   []PrepareEntry
+	Committed map[string]bool
+}
+
+func NewPrepareLog() *PrepareLog {
+	return &PrepareLog{
+		Committed: make(map[string]bool),
+	}
+}
+
+func (l *PrepareLog) OnReplayEntry(lsn uint64, typ uint16, payload []byte) {
+	buf := make([]byte, len(payload))
+	copy(buf, payload)
+	l.Entries = append(l.Entries, PrepareEntry{
+		LSN:     lsn,
+		Type:    typ,
+		Payload: buf,
+	})
+}
+
+func (l *PrepareLog) OnCommitted(info []byte) {
+	l.Committed[string(info)] = true
+}
+
+func (l *PrepareLog) IsCommitted(info []byte) bool {
+	return l.Committed[string(info)]
+}
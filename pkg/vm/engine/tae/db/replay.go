@@ -200,6 +200,10 @@ func (replayer *Replayer) OnStaleIndex(idx *wal.Index) {
 
 func (replayer *Replayer) OnReplayEntry(group uint32, commitId uint64, payload []byte, typ uint16, info any) {
 	replayer.once.Do(replayer.PreReplayWal)
+	if group == wal.GroupPrepare {
+		replayer.db.PrepareLog.OnReplayEntry(commitId, typ, payload)
+		return
+	}
 	if group != wal.GroupC {
 		return
 	}
@@ -253,6 +257,8 @@ func (replayer *Replayer) OnReplayCmd(txncmd txnif.TxnCmd, idxCtx *wal.Index) {
 		replayer.db.onReplayAppendCmd(cmd, replayer)
 	case *updates.UpdateCmd:
 		err = replayer.db.onReplayUpdateCmd(cmd, idxCtx, replayer)
+	case *txnbase.TxnInfoCmd:
+		replayer.db.PrepareLog.OnCommitted(cmd.Info)
 	}
 	if err != nil {
 		panic(err)
@@ -80,9 +80,24 @@ type Block interface {
 	GetTotalChanges() int
 	CollectChangesInRange(startTs, endTs types.TS) (*model.BlockView, error)
 	CollectAppendLogIndexes(startTs, endTs types.TS) ([]*wal.Index, error)
+	// CollectAppendInRange returns the rows appended by the txns committed in
+	// (startTs, endTs], it returns nil if there is none
+	CollectAppendInRange(startTs, endTs types.TS) (*containers.Batch, error)
+	// CollectDeleteInRange returns the columns colIdxs of the rows deleted by the
+	// txns committed in (startTs, endTs], it returns nil if there is none
+	CollectDeleteInRange(startTs, endTs types.TS, colIdxs []int) (*containers.Batch, error)
 
 	BatchDedup(txn txnif.AsyncTxn, pks containers.Vector, rowmask *roaring.Bitmap) error
 	GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (uint32, error)
+	// SortKeyIntersects returns false if no sort key in [min, max] is in the block,
+	// a nil bound is unbounded
+	SortKeyIntersects(min, max any) bool
+	// SortKeyRange returns the min and max sort keys in the zone map of the block,
+	// ok is false if the block has no zone map
+	SortKeyRange() (min, max any, ok bool)
+	// BatchGetByFilter finds the rows of the single column primary keys which are not in found yet,
+	// the keys found are added to found and their rows are set in offsets
+	BatchGetByFilter(txn txnif.AsyncTxn, keys containers.Vector, found *roaring.Bitmap, offsets []uint32) error
 	GetValue(txn txnif.AsyncTxn, row, col int) (any, error)
 	PPString(level common.PPLevel, depth int, prefix string) string
 	GetBlockFile() file.Block
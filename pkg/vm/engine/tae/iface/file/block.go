@@ -18,6 +18,7 @@ import (
 	"io"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
@@ -58,6 +59,8 @@ type Block interface {
 
 type ColumnBlock interface {
 	io.Closer
+	// SetCompressAlgo sets the compression algorithm of the column data written later
+	SetCompressAlgo(algo compress.T)
 	WriteTS(ts types.TS) error
 	WriteData(buf []byte) error
 	WriteIndex(idx int, buf []byte) error
@@ -42,6 +42,8 @@ type Relation interface {
 	RangeDelete(id *common.ID, start, end uint32, dt DeleteType) error
 	Update(id *common.ID, row uint32, col uint16, v any) error
 	GetByFilter(filter *Filter) (id *common.ID, offset uint32, err error)
+	// BatchGetByFilter finds the rows of the primary keys, ids[i] is nil if keys[i] is not found
+	BatchGetByFilter(keys containers.Vector) (ids []*common.ID, offsets []uint32, err error)
 	GetValue(id *common.ID, row uint32, col uint16) (any, error)
 	GetValueByFilter(filter *Filter, col int) (any, error)
 	UpdateByFilter(filter *Filter, col uint16, v any) error
@@ -58,6 +60,10 @@ type Relation interface {
 	SoftDeleteSegment(id uint64) (err error)
 
 	GetDB() (Database, error)
+
+	// AlterTable alters the schema of the relation by fn, which is a
+	// func(*catalog.Schema) error
+	AlterTable(fn any) error
 }
 
 type RelationIt interface {
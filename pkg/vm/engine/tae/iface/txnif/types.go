@@ -44,6 +44,7 @@ type TxnReader interface {
 	GetStartTS() types.TS
 	GetCommitTS() types.TS
 	GetPrepareTS() types.TS
+	GetCommitAtTS() types.TS
 	GetInfo() []byte
 	IsTerminated(bool) bool
 	IsVisible(o TxnReader) bool
@@ -83,6 +84,7 @@ type TxnChanger interface {
 	Prepare() error
 	Committing() error
 	Commit() error
+	CommitAt(ts types.TS) error
 	Rollback() error
 	SetError(error)
 }
@@ -192,6 +194,9 @@ type TxnStore interface {
 	GetLSN() uint64
 
 	BatchDedup(dbId, id uint64, pks ...containers.Vector) error
+	// CheckConflicts checks the txn against the txns committed since it started
+	// without applying anything, the checks are done again when the txn commits
+	CheckConflicts() error
 	LogSegmentID(dbId, tid, sid uint64)
 	LogBlockID(dbId, tid, bid uint64)
 
@@ -200,6 +205,7 @@ type TxnStore interface {
 	RangeDelete(dbId uint64, id *common.ID, start, end uint32, dt handle.DeleteType) error
 	Update(dbId uint64, id *common.ID, row uint32, col uint16, v any) error
 	GetByFilter(dbId uint64, id uint64, filter *handle.Filter) (*common.ID, uint32, error)
+	BatchGetByFilter(dbId uint64, id uint64, keys containers.Vector) ([]*common.ID, []uint32, error)
 	GetValue(dbId uint64, id *common.ID, row uint32, col uint16) (any, error)
 
 	CreateRelation(dbId uint64, def any) (handle.Relation, error)
@@ -117,6 +117,30 @@ func (zm *ZoneMap) Contains(key any) (ok bool) {
 	return
 }
 
+// Intersects returns true if any key in [min, max] may be in the zone map,
+// a nil bound is unbounded
+func (zm *ZoneMap) Intersects(min, max any) bool {
+	if !zm.inited {
+		return false
+	}
+	if min != nil && !zm.isInf && compute.CompareGeneric(min, zm.max, zm.typ) > 0 {
+		return false
+	}
+	if max != nil && compute.CompareGeneric(max, zm.min, zm.typ) < 0 {
+		return false
+	}
+	return true
+}
+
+// Range returns the min and max keys of the zone map, ok is false if the zone map is empty
+// or its max is unknown
+func (zm *ZoneMap) Range() (min, max any, ok bool) {
+	if !zm.inited || zm.isInf {
+		return
+	}
+	return zm.min, zm.max, true
+}
+
 func (zm *ZoneMap) ContainsAny(keys containers.Vector) (visibility *roaring.Bitmap, ok bool) {
 	if !zm.inited {
 		return
@@ -287,6 +311,21 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[32:]
 		zm.max = types.DecodeFixed[types.Date](buf[:4])
 		return nil
+	case types.T_time:
+		zm.min = types.DecodeFixed[types.Time](buf[:8])
+		buf = buf[32:]
+		zm.max = types.DecodeFixed[types.Time](buf[:8])
+		return nil
+	case types.T_enum:
+		zm.min = types.DecodeFixed[types.Enum](buf[:2])
+		buf = buf[32:]
+		zm.max = types.DecodeFixed[types.Enum](buf[:2])
+		return nil
+	case types.T_set:
+		zm.min = types.DecodeFixed[types.Set](buf[:8])
+		buf = buf[32:]
+		zm.max = types.DecodeFixed[types.Set](buf[:8])
+		return nil
 	case types.T_datetime:
 		zm.min = types.DecodeFixed[types.Datetime](buf[:8])
 		buf = buf[32:]
@@ -265,3 +265,22 @@ func TestZMTruncatedString(t *testing.T) {
 	require.True(t, zm2.Contains(mockBytes(0xff, 100)))
 
 }
+
+func TestZoneMapIntersects(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	typ := types.Type{Oid: types.T_int32}
+	zm := NewZoneMap(typ)
+	require.False(t, zm.Intersects(nil, nil))
+
+	require.NoError(t, zm.Update(int32(10)))
+	require.NoError(t, zm.Update(int32(20)))
+
+	require.True(t, zm.Intersects(nil, nil))
+	require.True(t, zm.Intersects(int32(15), int32(15)))
+	require.True(t, zm.Intersects(int32(20), nil))
+	require.True(t, zm.Intersects(nil, int32(10)))
+	require.True(t, zm.Intersects(int32(0), int32(30)))
+	require.False(t, zm.Intersects(int32(21), nil))
+	require.False(t, zm.Intersects(nil, int32(9)))
+	require.False(t, zm.Intersects(int32(0), int32(9)))
+}
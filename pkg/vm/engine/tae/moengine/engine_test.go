@@ -619,3 +619,175 @@ func TestSysRelation(t *testing.T) {
 	checkSysTable(t, catalog.SystemTable_Columns_Name, dbase, txn, 3, schema)
 	assert.Nil(t, txn.Commit())
 }
+
+func TestTxnRelation_ReadByPrimaryKeys(t *testing.T) {
+	ctx := context.TODO()
+	testutils.EnsureNoLeak(t)
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	txnOperator := TxnToTxnOperator(txn)
+	err = e.Create(ctx, "db", txnOperator)
+	assert.Nil(t, err)
+	dbase, err := e.Database(ctx, "db", txnOperator)
+	assert.Nil(t, err)
+	schema := catalog.MockSchema(3, 1)
+	defs, err := SchemaToDefs(schema)
+	assert.NoError(t, err)
+	err = dbase.Create(ctx, schema.Name, defs)
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(ctx, schema.Name)
+	assert.Nil(t, err)
+	bat := catalog.MockBatch(schema, 110)
+	defer bat.Close()
+	newbat := mobat.New(true, bat.Attrs)
+	newbat.Vecs = CopyToMoVectors(bat.Window(0, 100).Vecs)
+	err = rel.Write(ctx, newbat)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	txnOperator = TxnToTxnOperator(txn)
+	dbase, err = e.Database(ctx, "db", txnOperator)
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(ctx, schema.Name)
+	assert.Nil(t, err)
+	// the rows written by the txn are found too
+	txnbat := mobat.New(true, bat.Attrs)
+	txnbat.Vecs = CopyToMoVectors(bat.Window(100, 10).Vecs)
+	err = rel.Write(ctx, txnbat)
+	assert.Nil(t, err)
+	pks := vector.MustTCols[int32](newbat.Vecs[1])
+	txnPks := vector.MustTCols[int32](txnbat.Vecs[1])
+	// the last key doesn't exist
+	keys := vector.NewWithFixed(types.T_int32.ToType(), []int32{pks[3], txnPks[5], pks[42], -1}, nil, nil)
+	m := mheap.New(guest.New(1<<20, host.New(1<<20)))
+	res, err := rel.(engine.KeyReader).ReadByPrimaryKeys(ctx, []string{schema.ColDefs[1].Name, schema.ColDefs[2].Name}, keys, m)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, res.Length())
+	// the rows are grouped by block
+	cols := vector.MustTCols[int32](newbat.Vecs[2])
+	txnCols := vector.MustTCols[int32](txnbat.Vecs[2])
+	rows := make(map[int32]int32)
+	for i, pk := range vector.MustTCols[int32](res.Vecs[0]) {
+		rows[pk] = vector.MustTCols[int32](res.Vecs[1])[i]
+	}
+	assert.Equal(t, map[int32]int32{pks[3]: cols[3], pks[42]: cols[42], txnPks[5]: txnCols[5]}, rows)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestTxnRelation_NewReaderPrune(t *testing.T) {
+	ctx := context.TODO()
+	testutils.EnsureNoLeak(t)
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	// the tables created through the engine have the default block size
+	schema := catalog.MockSchema(3, 1)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	bat := catalog.MockBatch(schema, 50)
+	defer bat.Close()
+	txn, err := tae.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	taeRel, err := dbase.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, taeRel.Append(bat.Window(0, 40)))
+	assert.Nil(t, txn.Commit())
+
+	moTxn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	txnOperator := TxnToTxnOperator(moTxn)
+	moDB, err := e.Database(ctx, "db", txnOperator)
+	assert.Nil(t, err)
+	rel, err := moDB.Relation(ctx, schema.Name)
+	assert.Nil(t, err)
+	// the block written by the txn is never skipped
+	txnbat := mobat.New(true, bat.Attrs)
+	txnbat.Vecs = CopyToMoVectors(bat.Window(40, 10).Vecs)
+	err = rel.Write(ctx, txnbat)
+	assert.Nil(t, err)
+
+	// the pk is the column 0 of the read attrs
+	pkType := &plan.Type{Id: int32(types.T_int32)}
+	expr := &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_bool)},
+		Expr: &plan.Expr_F{F: &plan.Function{
+			Func: &plan.ObjectRef{ObjName: "="},
+			Args: []*plan.Expr{
+				{Typ: pkType, Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}}},
+				{Typ: pkType, Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: 25}}}},
+			},
+		}},
+	}
+	rds, err := rel.NewReader(ctx, 1, expr, nil)
+	assert.Nil(t, err)
+	m := mheap.New(guest.New(1<<20, host.New(1<<20)))
+	var pks []int32
+	for {
+		res, err := rds[0].Read([]string{schema.ColDefs[1].Name}, expr, m)
+		assert.Nil(t, err)
+		if res == nil {
+			break
+		}
+		pks = append(pks, vector.MustTCols[int32](res.Vecs[0])...)
+	}
+	assert.Equal(t, 20, len(pks))
+	assert.Contains(t, pks, int32(25))
+	assert.Equal(t, int64(3), rds[0].(engine.PruneReader).PrunedBlocks())
+	assert.Nil(t, moTxn.Commit())
+}
+
+func TestTxnRelation_ColumnRange(t *testing.T) {
+	ctx := context.TODO()
+	testutils.EnsureNoLeak(t)
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	schema := catalog.MockSchema(3, 1)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	bat := catalog.MockBatch(schema, 50)
+	defer bat.Close()
+	txn, err := tae.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	taeRel, err := dbase.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, taeRel.Append(bat.Window(0, 40)))
+	assert.Nil(t, txn.Commit())
+
+	moTxn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	txnOperator := TxnToTxnOperator(moTxn)
+	moDB, err := e.Database(ctx, "db", txnOperator)
+	assert.Nil(t, err)
+	rel, err := moDB.Relation(ctx, schema.Name)
+	assert.Nil(t, err)
+	zm := rel.(engine.ZoneMapReader)
+	min, max, ok, err := zm.ColumnRange(ctx, schema.ColDefs[1].Name)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int32(0), min)
+	assert.Equal(t, int32(39), max)
+	// only the sort key has zone maps
+	_, _, ok, err = zm.ColumnRange(ctx, schema.ColDefs[0].Name)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	// the block written by the txn has no zone map
+	txnbat := mobat.New(true, bat.Attrs)
+	txnbat.Vecs = CopyToMoVectors(bat.Window(40, 10).Vecs)
+	err = rel.Write(ctx, txnbat)
+	assert.Nil(t, err)
+	_, _, ok, err = zm.ColumnRange(ctx, schema.ColDefs[1].Name)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, moTxn.Commit())
+}
@@ -0,0 +1,233 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+)
+
+// blockMayMatch returns false if no row of the committed block can satisfy the expr,
+// the column at position i of the expr is attrs[i]. Only the predicates on the sort key
+// are checked against the zone map of the block, the other predicates are taken as being satisfied.
+func blockMayMatch(expr *plan.Expr, attrs []string, sortKey *catalog.ColDef, h handle.Block) bool {
+	if expr == nil || sortKey == nil || h.IsUncommitted() {
+		return true
+	}
+	blk := h.GetMeta().(*catalog.BlockEntry).GetBlockData()
+	if blk == nil {
+		return true
+	}
+	return mayMatch(expr, attrs, sortKey, blk)
+}
+
+func mayMatch(expr *plan.Expr, attrs []string, sortKey *catalog.ColDef, blk data.Block) bool {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return true
+	}
+	args := f.F.Args
+	switch name := f.F.Func.ObjName; name {
+	case "and":
+		return mayMatch(args[0], attrs, sortKey, blk) && mayMatch(args[1], attrs, sortKey, blk)
+	case "or":
+		return mayMatch(args[0], attrs, sortKey, blk) || mayMatch(args[1], attrs, sortKey, blk)
+	case "=", "<", "<=", ">", ">=":
+		col, c := args[0], args[1]
+		if _, ok := col.Expr.(*plan.Expr_C); ok {
+			col, c = c, col
+			name = reverseCompare[name]
+		}
+		return mayCompare(name, col, []*plan.Expr{c}, attrs, sortKey, blk)
+	case "in":
+		list, ok := args[1].Expr.(*plan.Expr_List)
+		if !ok {
+			return true
+		}
+		return mayCompare("=", args[0], list.List.List, attrs, sortKey, blk)
+	}
+	return true
+}
+
+var reverseCompare = map[string]string{
+	"=":  "=",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+// mayCompare returns false if no sort key of the block satisfies the comparison
+// with any of the constants, a strict comparison is checked as an inclusive one.
+func mayCompare(op string, col *plan.Expr, consts []*plan.Expr, attrs []string,
+	sortKey *catalog.ColDef, blk data.Block) bool {
+	if !isSortKey(col, attrs, sortKey) {
+		return true
+	}
+	values := make([]any, 0, len(consts))
+	for _, expr := range consts {
+		c, ok := expr.Expr.(*plan.Expr_C)
+		if !ok {
+			return true
+		}
+		if c.C.Isnull {
+			// the comparison with null is never true
+			continue
+		}
+		v, ok := constValue(c.C, sortKey.Type)
+		if !ok {
+			return true
+		}
+		values = append(values, v)
+	}
+	for _, v := range values {
+		var min, max any
+		switch op {
+		case "=":
+			min, max = v, v
+		case "<", "<=":
+			max = v
+		case ">", ">=":
+			min = v
+		}
+		if blk.SortKeyIntersects(min, max) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSortKey returns true if the expr is the sort key, a cast which keeps
+// the order of the values is removed from the column.
+func isSortKey(expr *plan.Expr, attrs []string, sortKey *catalog.ColDef) bool {
+	if f, ok := expr.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "cast" {
+		if !isWidenCast(f.F.Args[0].Typ, expr.Typ) {
+			return false
+		}
+		expr = f.F.Args[0]
+	}
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok || int(col.Col.ColPos) >= len(attrs) {
+		return false
+	}
+	return attrs[col.Col.ColPos] == sortKey.Name
+}
+
+// isWidenCast returns true if every value of the type from is
+// cast to the type to exactly
+func isWidenCast(from, to *plan.Type) bool {
+	f, t := types.T(from.Id), types.T(to.Id)
+	switch {
+	case isSignedType(f) && isSignedType(t), isUnsignedType(f) && isUnsignedType(t):
+		return f.FixedLength() <= t.FixedLength()
+	case isUnsignedType(f) && isSignedType(t):
+		return f.FixedLength() < t.FixedLength()
+	case f == types.T_float32 && t == types.T_float64:
+		return true
+	}
+	return false
+}
+
+func isSignedType(t types.T) bool {
+	return t == types.T_int8 || t == types.T_int16 || t == types.T_int32 || t == types.T_int64
+}
+
+func isUnsignedType(t types.T) bool {
+	return t == types.T_uint8 || t == types.T_uint16 || t == types.T_uint32 || t == types.T_uint64
+}
+
+// constValue returns the constant as a value of the type in the containers,
+// it returns false if the constant can not be represented by the type exactly
+func constValue(c *plan.Const, typ types.Type) (any, bool) {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		var v int64
+		switch val := c.Value.(type) {
+		case *plan.Const_Ival:
+			v = val.Ival
+		case *plan.Const_Uval:
+			if val.Uval > math.MaxInt64 {
+				return nil, false
+			}
+			v = int64(val.Uval)
+		default:
+			return nil, false
+		}
+		switch typ.Oid {
+		case types.T_int8:
+			return int8(v), v >= math.MinInt8 && v <= math.MaxInt8
+		case types.T_int16:
+			return int16(v), v >= math.MinInt16 && v <= math.MaxInt16
+		case types.T_int32:
+			return int32(v), v >= math.MinInt32 && v <= math.MaxInt32
+		}
+		return v, true
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		var v uint64
+		switch val := c.Value.(type) {
+		case *plan.Const_Ival:
+			if val.Ival < 0 {
+				return nil, false
+			}
+			v = uint64(val.Ival)
+		case *plan.Const_Uval:
+			v = val.Uval
+		default:
+			return nil, false
+		}
+		switch typ.Oid {
+		case types.T_uint8:
+			return uint8(v), v <= math.MaxUint8
+		case types.T_uint16:
+			return uint16(v), v <= math.MaxUint16
+		case types.T_uint32:
+			return uint32(v), v <= math.MaxUint32
+		}
+		return v, true
+	case types.T_float32:
+		if val, ok := c.Value.(*plan.Const_Fval); ok {
+			return val.Fval, true
+		}
+	case types.T_float64:
+		switch val := c.Value.(type) {
+		case *plan.Const_Dval:
+			return val.Dval, true
+		case *plan.Const_Fval:
+			return float64(val.Fval), true
+		}
+	case types.T_date:
+		if val, ok := c.Value.(*plan.Const_Dateval); ok {
+			return types.Date(val.Dateval), true
+		}
+	case types.T_datetime:
+		if val, ok := c.Value.(*plan.Const_Datetimeval); ok {
+			return types.Datetime(val.Datetimeval), true
+		}
+	case types.T_timestamp:
+		if val, ok := c.Value.(*plan.Const_Timestampval); ok {
+			return types.Timestamp(val.Timestampval), true
+		}
+	case types.T_char, types.T_varchar, types.T_blob:
+		if val, ok := c.Value.(*plan.Const_Sval); ok {
+			return []byte(val.Sval), true
+		}
+	}
+	return nil, false
+}
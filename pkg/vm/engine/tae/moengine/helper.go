@@ -59,8 +59,14 @@ func SchemaToDefs(schema *catalog.Schema) (defs []engine.TableDef, err error) {
 		viewDef.View = schema.View
 		defs = append(defs, viewDef)
 	}
+
+	if schema.Constraint != "" {
+		constraintDef := new(engine.ConstraintDef)
+		constraintDef.Constraint = schema.Constraint
+		defs = append(defs, constraintDef)
+	}
 	for _, col := range schema.ColDefs {
-		if col.IsPhyAddr() {
+		if col.IsPhyAddr() || col.IsDropped() {
 			continue
 		}
 
@@ -95,6 +101,8 @@ func SchemaToDefs(schema *catalog.Schema) (defs []engine.TableDef, err error) {
 				},
 				OnUpdate:      onUpdate,
 				AutoIncrement: col.IsAutoIncrement(),
+				Alg:           col.CompressAlg,
+				EnumValues:    col.EnumValues,
 			},
 		}
 		defs = append(defs, def)
@@ -165,6 +173,9 @@ func DefsToSchema(name string, defs []engine.TableDef) (schema *catalog.Schema,
 		case *engine.ViewDef:
 			schema.View = defVal.View
 
+		case *engine.ConstraintDef:
+			schema.Constraint = defVal.Constraint
+
 		default:
 			// We will not deal with other cases for the time being
 		}
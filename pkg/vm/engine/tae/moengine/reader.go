@@ -21,36 +21,56 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
 var (
-	_ engine.Reader = (*txnReader)(nil)
+	_ engine.Reader      = (*txnReader)(nil)
+	_ engine.PruneReader = (*txnReader)(nil)
 )
 
-func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
-	return &txnReader{
+func newReader(rel handle.Relation, it handle.BlockIt, expr *plan.Expr) *txnReader {
+	r := &txnReader{
 		handle: rel,
 		it:     it,
+		expr:   expr,
 	}
+	if schema := rel.Schema().(*catalog.Schema); schema.HasPK() && !schema.IsCompoundSortKey() {
+		r.sortKey = schema.GetSingleSortKey()
+	}
+	return r
+}
+
+func (r *txnReader) PrunedBlocks() int64 {
+	return r.prunedBlocks
 }
 
+// Read reads the next block which may have rows satisfying the filter,
+// the committed blocks are checked by the zone map of the sort key first.
 func (r *txnReader) Read(attrs []string, _ *plan.Expr, m *mheap.Mheap) (*batch.Batch, error) {
-	r.it.Lock()
-	if !r.it.Valid() {
-		r.it.Unlock()
-		return nil, nil
-	}
 	if r.buffer == nil {
 		r.buffer = make([]*bytes.Buffer, len(attrs))
 		for i := 0; i < len(attrs); i++ {
 			r.buffer[i] = new(bytes.Buffer)
 		}
 	}
-	h := r.it.GetBlock()
-	r.it.Next()
-	r.it.Unlock()
+	var h handle.Block
+	for {
+		r.it.Lock()
+		if !r.it.Valid() {
+			r.it.Unlock()
+			return nil, nil
+		}
+		h = r.it.GetBlock()
+		r.it.Next()
+		r.it.Unlock()
+		if blockMayMatch(r.expr, attrs, r.sortKey, h) {
+			break
+		}
+		r.prunedBlocks++
+	}
 	block := newBlock(h)
 	bat, err := block.Read(attrs, nil, r.buffer)
 	if err != nil {
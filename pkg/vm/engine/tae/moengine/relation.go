@@ -24,10 +24,16 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
 var (
-	_ engine.Relation = (*baseRelation)(nil)
+	_ engine.Relation      = (*baseRelation)(nil)
+	_ engine.KeyReader     = (*baseRelation)(nil)
+	_ engine.ZoneMapReader = (*baseRelation)(nil)
 )
 
 const ADDR = "localhost:20000"
@@ -53,7 +59,7 @@ func (*baseRelation) DelTableDef(_ context.Context, def engine.TableDef) error {
 }
 
 func (rel *baseRelation) TableDefs(_ context.Context) ([]engine.TableDef, error) {
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	schema := rel.handle.Schema().(*catalog.Schema)
 	defs, _ := SchemaToDefs(schema)
 	return defs, nil
 }
@@ -63,7 +69,7 @@ func (rel *baseRelation) Rows(context.Context) (int64, error) {
 }
 
 func (rel *baseRelation) GetPrimaryKeys(_ context.Context) ([]*engine.Attribute, error) {
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	schema := rel.handle.Schema().(*catalog.Schema)
 	if !schema.HasPK() {
 		return nil, nil
 	}
@@ -83,7 +89,7 @@ func (rel *baseRelation) GetPrimaryKeys(_ context.Context) ([]*engine.Attribute,
 // Might that can be done in the future
 
 func (rel *baseRelation) GetHideKeys(_ context.Context) ([]*engine.Attribute, error) {
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	schema := rel.handle.Schema().(*catalog.Schema)
 	key := new(engine.Attribute)
 	key.Name = schema.PhyAddrKey.Name
 	key.Type = schema.PhyAddrKey.Type
@@ -109,17 +115,138 @@ func (rel *baseRelation) Truncate(_ context.Context) (uint64, error) {
 	return 0, nil
 }
 
-func (rel *baseRelation) NewReader(_ context.Context, num int, _ *plan.Expr, _ [][]byte) ([]engine.Reader, error) {
+// NewReader returns the readers sharing the blocks of the relation, the blocks
+// whose sort key zone map can not satisfy the expr are skipped.
+func (rel *baseRelation) NewReader(_ context.Context, num int, expr *plan.Expr, _ [][]byte) ([]engine.Reader, error) {
 	var rds []engine.Reader
 
 	it := rel.handle.MakeBlockIt()
 	for i := 0; i < num; i++ {
-		reader := newReader(rel.handle, it)
+		reader := newReader(rel.handle, it, expr)
 		rds = append(rds, reader)
 	}
 	return rds, nil
 }
 
+// ReadByPrimaryKeys finds all keys through the indexes of each block at once, and reads the
+// columns of a block once for all rows found in it. The rows are grouped by block.
+func (rel *baseRelation) ReadByPrimaryKeys(_ context.Context, attrs []string, keys *vector.Vector, m *mheap.Mheap) (*batch.Batch, error) {
+	schema := rel.handle.Schema().(*catalog.Schema)
+	if !schema.HasPK() || schema.IsCompoundSortKey() {
+		return nil, ErrNoPrimaryKey
+	}
+	cols := make([]containers.Vector, len(attrs))
+	colIdxs := make([]int, len(attrs))
+	for i, attr := range attrs {
+		colIdx := schema.GetColIdx(attr)
+		colIdxs[i] = colIdx
+		cols[i] = containers.MakeVector(schema.ColDefs[colIdx].Type, schema.ColDefs[colIdx].Nullable())
+		defer cols[i].Close()
+	}
+	pks := MOToVectorTmp(keys, false)
+	defer pks.Close()
+	ids, offsets, err := rel.handle.BatchGetByFilter(pks)
+	if err != nil {
+		return nil, err
+	}
+	type blockRows struct {
+		id   *common.ID
+		rows []uint32
+	}
+	var blks []*blockRows
+	blkMap := make(map[common.ID]*blockRows)
+	for i, id := range ids {
+		if id == nil {
+			continue
+		}
+		blk, ok := blkMap[*id]
+		if !ok {
+			blk = &blockRows{id: id}
+			blkMap[*id] = blk
+			blks = append(blks, blk)
+		}
+		blk.rows = append(blk.rows, offsets[i])
+	}
+	it := rel.handle.MakeBlockIt()
+	for ; it.Valid() && len(blkMap) > 0; it.Next() {
+		h := it.GetBlock()
+		blk, ok := blkMap[*h.Fingerprint()]
+		if !ok {
+			continue
+		}
+		delete(blkMap, *blk.id)
+		for j, colIdx := range colIdxs {
+			view, err := h.GetColumnDataById(colIdx, nil)
+			if err != nil {
+				return nil, err
+			}
+			for _, row := range blk.rows {
+				cols[j].Append(view.GetValue(int(row)))
+			}
+			view.Close()
+		}
+	}
+	// the blocks not visited by the iterator are read value by value
+	for _, blk := range blks {
+		if _, ok := blkMap[*blk.id]; !ok {
+			continue
+		}
+		for _, row := range blk.rows {
+			for j, colIdx := range colIdxs {
+				v, err := rel.handle.GetValue(blk.id, row, uint16(colIdx))
+				if err != nil {
+					return nil, err
+				}
+				cols[j].Append(v)
+			}
+		}
+	}
+	bat := batch.New(true, attrs)
+	for i := range cols {
+		bat.Vecs[i] = CopyToMoVector(cols[i])
+	}
+	bat.InitZsOne(vector.Length(bat.Vecs[0]))
+	return bat, nil
+}
+
+// ColumnRange merges the zone maps of the committed blocks, only the single sort key has zone maps.
+func (rel *baseRelation) ColumnRange(_ context.Context, attr string) (min, max any, ok bool, err error) {
+	schema := rel.handle.Schema().(*catalog.Schema)
+	if !schema.HasPK() || schema.IsCompoundSortKey() {
+		return
+	}
+	sortKey := schema.GetSingleSortKey()
+	if sortKey.Name != attr {
+		return
+	}
+	it := rel.handle.MakeBlockIt()
+	for ; it.Valid(); it.Next() {
+		h := it.GetBlock()
+		if h.IsUncommitted() {
+			return nil, nil, false, nil
+		}
+		blk := h.GetMeta().(*catalog.BlockEntry).GetBlockData()
+		if blk == nil {
+			return nil, nil, false, nil
+		}
+		blkMin, blkMax, blkOk := blk.SortKeyRange()
+		if !blkOk {
+			return nil, nil, false, nil
+		}
+		if !ok {
+			min, max, ok = blkMin, blkMax, true
+			continue
+		}
+		if compute.CompareGeneric(blkMin, min, sortKey.Type) < 0 {
+			min = blkMin
+		}
+		if compute.CompareGeneric(blkMax, max, sortKey.Type) > 0 {
+			max = blkMax
+		}
+	}
+	return
+}
+
 func (rel *baseRelation) GetTableID(_ context.Context) string {
 	return fmt.Sprintf("%d", rel.handle.ID())
 }
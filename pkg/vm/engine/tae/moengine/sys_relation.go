@@ -61,3 +61,11 @@ func (s *sysRelation) Delete(_ context.Context, _ *vector.Vector, _ string) erro
 func (s *sysRelation) Truncate(_ context.Context) (uint64, error) {
 	return 0, ErrReadOnly
 }
+
+func (s *sysRelation) AddTableDef(_ context.Context, _ engine.TableDef) error {
+	return ErrReadOnly
+}
+
+func (s *sysRelation) DelTableDef(_ context.Context, _ engine.TableDef) error {
+	return ErrReadOnly
+}
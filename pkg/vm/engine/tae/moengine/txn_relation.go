@@ -16,6 +16,7 @@ package moengine
 
 import (
 	"context"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -42,12 +43,11 @@ func newRelation(h handle.Relation) *txnRelation {
 }
 
 func (rel *txnRelation) Write(_ context.Context, bat *batch.Batch) error {
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
-	allNullables := schema.AllNullables()
+	schema := rel.handle.Schema().(*catalog.Schema)
 	taeBatch := containers.NewEmptyBatch()
 	defer taeBatch.Close()
 	for i, vec := range bat.Vecs {
-		v := MOToVectorTmp(vec, allNullables[i])
+		v := MOToVectorTmp(vec, schema.ColDefs[schema.GetColIdx(bat.Attrs[i])].Nullable())
 		//v := MOToVector(vec, allNullables[i])
 		taeBatch.AddVector(bat.Attrs[i], v)
 	}
@@ -55,7 +55,7 @@ func (rel *txnRelation) Write(_ context.Context, bat *batch.Batch) error {
 }
 
 func (rel *txnRelation) Update(_ context.Context, data *batch.Batch) error {
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	schema := rel.handle.Schema().(*catalog.Schema)
 	allNullables := schema.AllNullables()
 	bat := containers.NewEmptyBatch()
 	defer bat.Close()
@@ -87,7 +87,7 @@ func (rel *txnRelation) Update(_ context.Context, data *batch.Batch) error {
 }
 
 func (rel *txnRelation) Delete(_ context.Context, data *vector.Vector, col string) error {
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	schema := rel.handle.Schema().(*catalog.Schema)
 	logutil.Debugf("Delete col: %v", col)
 	allNullables := schema.AllNullables()
 	idx := catalog.GetAttrIdx(schema.AllNames(), col)
@@ -122,7 +122,7 @@ func (rel *txnRelation) Truncate(ctx context.Context) (uint64, error) {
 	if err != nil {
 		return 0, err
 	}
-	name := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema().Name
+	name := rel.handle.Schema().(*catalog.Schema).Name
 	db, err := rel.handle.GetDB()
 	if err != nil {
 		return 0, err
@@ -133,3 +133,42 @@ func (rel *txnRelation) Truncate(ctx context.Context) (uint64, error) {
 	}
 	return uint64(rows), nil
 }
+
+func (rel *txnRelation) AddTableDef(_ context.Context, def engine.TableDef) error {
+	return rel.handle.AlterTable(func(schema *catalog.Schema) error {
+		switch defVal := def.(type) {
+		case *engine.AddColumnDef:
+			return schema.AddColumn(defVal.Attr, defVal.Fill)
+		case *engine.ModifyColumnDef:
+			return schema.ModifyColumn(defVal.Attr)
+		case *engine.RenameColumnDef:
+			return schema.RenameColumn(defVal.OldName, defVal.NewName)
+		case *engine.RenameTableDef:
+			schema.Name = defVal.Name
+		case *engine.CommentDef:
+			schema.Comment = defVal.Comment
+		case *engine.ConstraintDef:
+			schema.Constraint = defVal.Constraint
+		case *engine.PropertiesDef:
+			for _, property := range defVal.Properties {
+				if strings.ToLower(property.Key) == catalog.SystemRelAttr_Comment {
+					schema.Comment = property.Value
+				}
+			}
+		default:
+			return ErrUnsupportedTableDef
+		}
+		return nil
+	})
+}
+
+func (rel *txnRelation) DelTableDef(_ context.Context, def engine.TableDef) error {
+	return rel.handle.AlterTable(func(schema *catalog.Schema) error {
+		switch defVal := def.(type) {
+		case *engine.AttributeDef:
+			return schema.DropColumn(defVal.Attr.Name)
+		default:
+			return ErrUnsupportedTableDef
+		}
+	})
+}
@@ -18,13 +18,17 @@ import (
 	"bytes"
 	"errors"
 
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 )
 
 var ErrReadOnly = errors.New("tae moengine: read only")
+var ErrNoPrimaryKey = errors.New("tae moengine: no single primary key")
+var ErrUnsupportedTableDef = errors.New("tae moengine: unsupported table def")
 
 type Txn interface {
 	GetCtx() []byte
@@ -69,7 +73,10 @@ type txnBlock struct {
 }
 
 type txnReader struct {
-	handle handle.Relation
-	it     handle.BlockIt
-	buffer []*bytes.Buffer
+	handle       handle.Relation
+	it           handle.BlockIt
+	buffer       []*bytes.Buffer
+	expr         *plan.Expr
+	sortKey      *catalog.ColDef
+	prunedBlocks int64
 }
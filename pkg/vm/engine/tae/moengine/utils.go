@@ -133,6 +133,24 @@ func MockVec(typ types.Type, rows int, offset int) *vector.Vector {
 			data = append(data, types.Date(i+offset))
 		}
 		_ = vector.AppendFixed(vec, data, nil)
+	case types.T_time:
+		data := make([]types.Time, 0)
+		for i := 0; i < rows; i++ {
+			data = append(data, types.Time(i+offset))
+		}
+		_ = vector.AppendFixed(vec, data, nil)
+	case types.T_enum:
+		data := make([]types.Enum, 0)
+		for i := 0; i < rows; i++ {
+			data = append(data, types.Enum(i+offset))
+		}
+		_ = vector.AppendFixed(vec, data, nil)
+	case types.T_set:
+		data := make([]types.Set, 0)
+		for i := 0; i < rows; i++ {
+			data = append(data, types.Set(i+offset))
+		}
+		_ = vector.AppendFixed(vec, data, nil)
 	case types.T_datetime:
 		data := make([]types.Datetime, 0)
 		for i := 0; i < rows; i++ {
@@ -240,6 +258,12 @@ func AppendValue(vec *vector.Vector, v any) {
 		AppendFixedValue[types.Date](vec, v)
 	case types.T_timestamp:
 		AppendFixedValue[types.Timestamp](vec, v)
+	case types.T_time:
+		AppendFixedValue[types.Time](vec, v)
+	case types.T_enum:
+		AppendFixedValue[types.Enum](vec, v)
+	case types.T_set:
+		AppendFixedValue[types.Set](vec, v)
 	case types.T_datetime:
 		AppendFixedValue[types.Datetime](vec, v)
 	case types.T_uuid:
@@ -290,6 +314,12 @@ func GetValue(col *vector.Vector, row uint32) any {
 		return vector.GetValueAt[float64](col, int64(row))
 	case types.T_date:
 		return vector.GetValueAt[types.Date](col, int64(row))
+	case types.T_time:
+		return vector.GetValueAt[types.Time](col, int64(row))
+	case types.T_enum:
+		return vector.GetValueAt[types.Enum](col, int64(row))
+	case types.T_set:
+		return vector.GetValueAt[types.Set](col, int64(row))
 	case types.T_datetime:
 		return vector.GetValueAt[types.Datetime](col, int64(row))
 	case types.T_timestamp:
@@ -336,6 +366,12 @@ func UpdateValue(col *vector.Vector, row uint32, val any) {
 		GenericUpdateFixedValue[float64](col, row, val)
 	case types.T_date:
 		GenericUpdateFixedValue[types.Date](col, row, val)
+	case types.T_time:
+		GenericUpdateFixedValue[types.Time](col, row, val)
+	case types.T_enum:
+		GenericUpdateFixedValue[types.Enum](col, row, val)
+	case types.T_set:
+		GenericUpdateFixedValue[types.Set](col, row, val)
 	case types.T_datetime:
 		GenericUpdateFixedValue[types.Datetime](col, row, val)
 	case types.T_timestamp:
@@ -525,6 +561,12 @@ func MOToVector(v *vector.Vector, nullable bool) containers.Vector {
 			bs.Data = types.EncodeFixedSlice(v.Col.([]float64), 8)
 		case types.T_date:
 			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Date), 4)
+		case types.T_time:
+			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Time), 8)
+		case types.T_enum:
+			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Enum), 2)
+		case types.T_set:
+			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Set), 8)
 		case types.T_datetime:
 			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Datetime), 8)
 		case types.T_timestamp:
@@ -655,6 +697,30 @@ func MOToVectorTmp(v *vector.Vector, nullable bool) containers.Vector {
 		} else {
 			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Date), 4)
 		}
+	case types.T_time:
+		if v.Col == nil || len(v.Col.([]types.Time)) == 0 {
+			bs.Data = make([]byte, v.Length()*8)
+			logutil.Warn("[Moengine]", common.OperationField("MOToVector"),
+				common.OperandField("Col length is 0"))
+		} else {
+			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Time), 8)
+		}
+	case types.T_enum:
+		if v.Col == nil || len(v.Col.([]types.Enum)) == 0 {
+			bs.Data = make([]byte, v.Length()*2)
+			logutil.Warn("[Moengine]", common.OperationField("MOToVector"),
+				common.OperandField("Col length is 0"))
+		} else {
+			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Enum), 2)
+		}
+	case types.T_set:
+		if v.Col == nil || len(v.Col.([]types.Set)) == 0 {
+			bs.Data = make([]byte, v.Length()*8)
+			logutil.Warn("[Moengine]", common.OperationField("MOToVector"),
+				common.OperandField("Col length is 0"))
+		} else {
+			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Set), 8)
+		}
 	case types.T_datetime:
 		if v.Col == nil || len(v.Col.([]types.Datetime)) == 0 {
 			bs.Data = make([]byte, v.Length()*8)
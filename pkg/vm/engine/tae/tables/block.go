@@ -86,15 +86,19 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 	if meta.GetSchema().HasSortKey() {
 		indexCnt[meta.GetSchema().SortKey.Defs[0].Idx] = 2
 	}
-	file, err := segFile.OpenBlock(meta.GetID(), colCnt, indexCnt)
+	blkFile, err := segFile.OpenBlock(meta.GetID(), colCnt, indexCnt)
 	if err != nil {
 		panic(err)
 	}
 	colFiles := make(map[int]common.IRWFile)
 	for i := 0; i < colCnt; i++ {
-		if colBlk, err := file.OpenColumn(i); err != nil {
+		if colBlk, err := blkFile.OpenColumn(i); err == file.ErrInvalidParam {
+			// the column is added after the block is written
+			continue
+		} else if err != nil {
 			panic(err)
 		} else {
+			colBlk.SetCompressAlgo(meta.GetSchema().ColDefs[i].CompressAlg)
 			colFiles[i], err = colBlk.OpenDataFile()
 			if err != nil {
 				panic(err)
@@ -107,7 +111,7 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 	block := &dataBlock{
 		RWMutex:   new(sync.RWMutex),
 		meta:      meta,
-		file:      file,
+		file:      blkFile,
 		colFiles:  colFiles,
 		mvcc:      updates.NewMVCCHandle(meta),
 		scheduler: scheduler,
@@ -118,7 +122,7 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 	block.mvcc.SetAppendListener(block.OnApplyAppend)
 	if meta.IsAppendable() {
 		block.mvcc.SetDeletesListener(block.ABlkApplyDelete)
-		node = newNode(bufMgr, block, file)
+		node = newNode(bufMgr, block, blkFile)
 		block.node = node
 		if meta.GetSchema().HasPK() {
 			block.index = indexwrapper.NewMutableIndex(meta.GetSchema().GetSortKeyType())
@@ -617,11 +621,33 @@ func (blk *dataBlock) LoadColumnData(
 	colIdx int,
 	buffer *bytes.Buffer) (vec containers.Vector, err error) {
 	def := blk.meta.GetSchema().ColDefs[colIdx]
+	rows := int(blk.file.ReadRows())
+	colFile, ok := blk.colFiles[colIdx]
+	if !ok || (rows > 0 && colFile.Stat().Size() == 0) {
+		vec = makeFillVector(def, rows, nil)
+		return
+	}
 	vec = containers.MakeVector(def.Type, def.Nullable())
-	err = vec.ReadFromFile(blk.colFiles[colIdx], buffer)
+	err = vec.ReadFromFile(colFile, buffer)
 	return
 }
 
+// makeFillVector makes the data of a column for the rows written before the
+// column is added
+func makeFillVector(def *catalog.ColDef, rows int, opts *containers.Options) containers.Vector {
+	var vec containers.Vector
+	if opts == nil {
+		vec = containers.MakeVector(def.Type, def.Nullable())
+	} else {
+		vec = containers.MakeVector(def.Type, def.Nullable(), opts)
+	}
+	v := def.FillValue()
+	for i := 0; i < rows; i++ {
+		vec.Append(v)
+	}
+	return vec
+}
+
 func (blk *dataBlock) ablkGetByFilter(ts types.TS, filter *handle.Filter) (offset uint32, err error) {
 	blk.mvcc.RLock()
 	defer blk.mvcc.RUnlock()
@@ -714,6 +740,81 @@ func (blk *dataBlock) GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (of
 	return blk.blkGetByFilter(ts, filter)
 }
 
+func (blk *dataBlock) SortKeyIntersects(min, max any) bool {
+	if blk.index == nil {
+		return true
+	}
+	blk.mvcc.RLock()
+	defer blk.mvcc.RUnlock()
+	return blk.index.Intersects(min, max)
+}
+
+func (blk *dataBlock) SortKeyRange() (min, max any, ok bool) {
+	if blk.index == nil {
+		return
+	}
+	blk.mvcc.RLock()
+	defer blk.mvcc.RUnlock()
+	return blk.index.Range()
+}
+
+func (blk *dataBlock) BatchGetByFilter(txn txnif.AsyncTxn, keys containers.Vector, found *roaring.Bitmap, offsets []uint32) (err error) {
+	ts := txn.GetStartTS()
+	if blk.meta.IsAppendable() {
+		for i := 0; i < keys.Length(); i++ {
+			if found.Contains(uint32(i)) {
+				continue
+			}
+			offset, err := blk.ablkGetByFilter(ts, handle.NewEQFilter(keys.Get(i)))
+			if err == data.ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			found.Add(uint32(i))
+			offsets[i] = offset
+		}
+		return
+	}
+	// check all keys against the zone map and the bloom filter at once, and load
+	// the sort key only if some of them may be in the block
+	keyselects, err := blk.index.BatchDedup(keys, nil)
+	if err == nil {
+		return
+	}
+	if err != data.ErrPossibleDuplicate {
+		return
+	}
+	sortKey, err := blk.LoadColumnData(blk.meta.GetSchema().GetSingleSortKeyIdx(), nil)
+	if err != nil {
+		return
+	}
+	defer sortKey.Close()
+	blk.mvcc.RLock()
+	defer blk.mvcc.RUnlock()
+	it := keyselects.Iterator()
+	for it.HasNext() {
+		i := it.Next()
+		if found.Contains(i) {
+			continue
+		}
+		off, existed := compute.GetOffsetByVal(sortKey, keys.Get(int(i)), nil)
+		if !existed {
+			continue
+		}
+		deleted, err := blk.mvcc.IsDeletedLocked(uint32(off), ts, blk.mvcc.RWMutex)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			found.Add(i)
+			offsets[i] = uint32(off)
+		}
+	}
+	return
+}
+
 func (blk *dataBlock) BlkApplyDelete(deleted uint64, gen common.RowGen, ts types.TS) (err error) {
 	blk.meta.GetSegment().GetTable().RemoveRows(deleted)
 	return
@@ -872,6 +973,71 @@ func (blk *dataBlock) CollectChangesInRange(startTs, endTs types.TS) (view *mode
 	blk.mvcc.RUnlock()
 	return
 }
+
+func (blk *dataBlock) CollectAppendInRange(startTs, endTs types.TS) (bat *containers.Batch, err error) {
+	// the rows of a non-appendable block are moved from other blocks
+	if !blk.meta.IsAppendable() {
+		return
+	}
+	var minRow, maxRow uint32
+	blk.mvcc.RLock()
+	minRow, _, err = blk.mvcc.GetMaxVisibleRowLocked(startTs)
+	if err == nil {
+		maxRow, _, err = blk.mvcc.GetMaxVisibleRowLocked(endTs)
+	}
+	blk.mvcc.RUnlock()
+	if err != nil || maxRow <= minRow {
+		return
+	}
+
+	bat = containers.NewBatch()
+	for i, def := range blk.meta.GetSchema().ColDefs {
+		var vec containers.Vector
+		if vec, err = blk.node.GetColumnDataWindow(minRow, maxRow, i); err != nil {
+			bat.Close()
+			return nil, err
+		}
+		bat.AddVector(def.Name, vec)
+	}
+	return
+}
+
+func (blk *dataBlock) CollectDeleteInRange(
+	startTs, endTs types.TS,
+	colIdxs []int) (bat *containers.Batch, err error) {
+	var mask *roaring.Bitmap
+	blk.mvcc.RLock()
+	mask, _, err = blk.mvcc.GetDeleteChain().CollectDeletesInRange(startTs, endTs, blk.mvcc.RWMutex)
+	blk.mvcc.RUnlock()
+	if err != nil || mask == nil || mask.IsEmpty() {
+		return
+	}
+
+	schema := blk.meta.GetSchema()
+	bat = containers.NewBatch()
+	for _, colIdx := range colIdxs {
+		var data containers.Vector
+		if blk.meta.IsAppendable() {
+			data, err = blk.node.GetColumnDataWindow(0, mask.Maximum()+1, colIdx)
+		} else {
+			data, err = blk.LoadColumnData(colIdx, nil)
+		}
+		if err != nil {
+			bat.Close()
+			return nil, err
+		}
+		def := schema.ColDefs[colIdx]
+		vec := containers.MakeVector(def.Type, def.Nullable())
+		it := mask.Iterator()
+		for it.HasNext() {
+			vec.Append(data.Get(int(it.Next())))
+		}
+		data.Close()
+		bat.AddVector(def.Name, vec)
+	}
+	return
+}
+
 func (blk *dataBlock) GetSortColumns(schema *catalog.Schema, data *containers.Batch) []containers.Vector {
 	vs := make([]containers.Vector, schema.GetSortKeyCnt())
 	for i := range vs {
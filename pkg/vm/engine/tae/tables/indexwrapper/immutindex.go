@@ -85,6 +85,20 @@ func (index *immutableIndex) BatchDedup(keys containers.Vector,
 	return
 }
 
+func (index *immutableIndex) Intersects(min, max any) bool {
+	if index.zmReader == nil {
+		return true
+	}
+	return index.zmReader.Intersects(min, max)
+}
+
+func (index *immutableIndex) Range() (min, max any, ok bool) {
+	if index.zmReader == nil {
+		return
+	}
+	return index.zmReader.Range()
+}
+
 func (index *immutableIndex) Close() (err error) {
 	// TODO
 	return
@@ -150,6 +150,14 @@ func (idx *mutableIndex) BatchDedup(keys containers.Vector,
 	return
 }
 
+func (idx *mutableIndex) Intersects(min, max any) bool {
+	return idx.zonemap.Intersects(min, max)
+}
+
+func (idx *mutableIndex) Range() (min, max any, ok bool) {
+	return idx.zonemap.Range()
+}
+
 func (idx *mutableIndex) Destroy() error {
 	return idx.Close()
 }
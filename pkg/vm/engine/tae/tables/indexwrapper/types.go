@@ -52,6 +52,13 @@ type Index interface {
 
 	BatchDedup(keys containers.Vector, rowmask *roaring.Bitmap) (keyselects *roaring.Bitmap, err error)
 
+	// Intersects returns false if no key in [min, max] is indexed,
+	// a nil bound is unbounded
+	Intersects(min, max any) bool
+
+	// Range returns the min and max keys indexed, ok is false if they are not known
+	Range() (min, max any, ok bool)
+
 	// BatchUpsert batch insert the specific keys
 	// If any deduplication, it will fetch the old value first, fill the active map with new value, insert the old value into delete map
 	// If any other unknown error hanppens, return error
@@ -120,6 +120,18 @@ func (reader *ZMReader) Contains(key any) bool {
 	return reader.node.zonemap.Contains(key)
 }
 
+func (reader *ZMReader) Intersects(min, max any) bool {
+	handle := reader.node.mgr.Pin(reader.node)
+	defer handle.Close()
+	return reader.node.zonemap.Intersects(min, max)
+}
+
+func (reader *ZMReader) Range() (min, max any, ok bool) {
+	handle := reader.node.mgr.Pin(reader.node)
+	defer handle.Close()
+	return reader.node.zonemap.Range()
+}
+
 type ZMWriter struct {
 	cType       CompressType
 	file        common.IRWFile
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -86,10 +87,16 @@ func (task *compactBlockTask) PrepareData(blkKey []byte) (preparer *model.Prepar
 
 	schema := task.meta.GetSchema()
 	var view *model.ColumnView
+	var dropped []*catalog.ColDef
 	for _, def := range schema.ColDefs {
 		if def.IsPhyAddr() {
 			continue
 		}
+		// the data of the dropped columns is not rewritten
+		if def.IsDropped() {
+			dropped = append(dropped, def)
+			continue
+		}
 		view, err = task.compacted.GetColumnDataById(def.Idx, nil)
 		if err != nil {
 			return
@@ -99,19 +106,26 @@ func (task *compactBlockTask) PrepareData(blkKey []byte) (preparer *model.Prepar
 		vec := view.Orphan()
 		preparer.Columns.AddVector(def.Name, vec)
 	}
+	for _, def := range dropped {
+		vec := containers.MakeVector(def.Type, true)
+		for i := 0; i < preparer.Columns.Length(); i++ {
+			vec.Append(types.Null{})
+		}
+		preparer.Columns.AddVector(def.Name, vec)
+	}
 	// Sort only if sort key is defined
 	if schema.HasSortKey() {
 		var idx int
 		var vecs []containers.Vector
 		if schema.IsSingleSortKey() {
-			idx = schema.SortKey.Defs[0].Idx
+			idx = catalog.GetAttrIdx(preparer.Columns.Attrs, schema.SortKey.Defs[0].Name)
 			preparer.SortKey = preparer.Columns.Vecs[idx]
 			vecs = preparer.Columns.Vecs
 		} else {
 			vecs = preparer.Columns.Vecs
 			cols := make([]containers.Vector, schema.SortKey.Size())
 			for i := range cols {
-				cols[i] = preparer.Columns.Vecs[schema.SortKey.Defs[i].Idx]
+				cols[i] = preparer.Columns.GetVectorByName(schema.SortKey.Defs[i].Name)
 			}
 			preparer.SortKey = model.EncodeCompoundColumn(cols...)
 			idx = len(preparer.Columns.Vecs)
@@ -131,7 +145,17 @@ func (task *compactBlockTask) PrepareData(blkKey []byte) (preparer *model.Prepar
 	if err != nil {
 		return
 	}
-	preparer.Columns.AddVector(catalog.PhyAddrColumnName, phyAddrVec)
+	// lay the columns out as the schema, the columns added after the
+	// physical address column follow it
+	columns := containers.NewBatch()
+	for _, def := range schema.ColDefs {
+		if def.IsPhyAddr() {
+			columns.AddVector(def.Name, phyAddrVec)
+		} else {
+			columns.AddVector(def.Name, preparer.Columns.GetVectorByName(def.Name))
+		}
+	}
+	preparer.Columns = columns
 	return
 }
 
@@ -298,7 +298,8 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		// Skip
 		// PhyAddr column was processed before
 		// If only one single sort key, it was processed before
-		if def.IsPhyAddr() || (schema.IsSingleSortKey() && def.IsSortKey()) {
+		// The data of the dropped columns is not rewritten
+		if def.IsPhyAddr() || def.IsDropped() || (schema.IsSingleSortKey() && def.IsSortKey()) {
 			continue
 		}
 		vecs = vecs[:0]
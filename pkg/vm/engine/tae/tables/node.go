@@ -54,6 +54,35 @@ func newNode(mgr base.INodeManager, block *dataBlock, file file.Block) *appendab
 	return impl
 }
 
+// extendColumns adds the columns added to the table after the data is
+// loaded, the rows already appended hold the fill values of them
+func (node *appendableNode) extendColumns() {
+	schema := node.block.meta.GetSchema()
+	node.block.RLock()
+	extended := len(node.data.Vecs) >= len(schema.ColDefs)
+	node.block.RUnlock()
+	if extended {
+		return
+	}
+	node.block.Lock()
+	defer node.block.Unlock()
+	if len(node.data.Vecs) >= len(schema.ColDefs) {
+		return
+	}
+	opts := new(containers.Options)
+	opts.Capacity = int(schema.BlockMaxRows)
+	opts.Allocator = ImmutMemAllocator
+	bat := containers.NewBatch()
+	for i, def := range schema.ColDefs {
+		if i < len(node.data.Vecs) {
+			bat.AddVector(def.Name, node.data.Vecs[i])
+		} else {
+			bat.AddVector(def.Name, makeFillVector(def, int(node.rows), opts))
+		}
+	}
+	node.data = bat
+}
+
 func (node *appendableNode) Rows(txn txnif.AsyncTxn, coarse bool) uint32 {
 	if coarse {
 		node.block.mvcc.RLock()
@@ -75,6 +104,7 @@ func (node *appendableNode) GetDataCopy(maxRow uint32) (columns *containers.Batc
 		err = exception.(error)
 		return
 	}
+	node.extendColumns()
 	node.block.RLock()
 	columns = node.data.CloneWindow(0, int(maxRow), containers.DefaultAllocator)
 	node.block.RUnlock()
@@ -89,6 +119,7 @@ func (node *appendableNode) GetColumnDataCopy(
 		err = exception.(error)
 		return
 	}
+	node.extendColumns()
 	node.block.RLock()
 	if buffer != nil {
 		win := node.data.Vecs[colIdx]
@@ -103,6 +134,21 @@ func (node *appendableNode) GetColumnDataCopy(
 	return
 }
 
+// GetColumnDataWindow returns a copy of the rows [from, to) of the column
+func (node *appendableNode) GetColumnDataWindow(
+	from, to uint32,
+	colIdx int) (vec containers.Vector, err error) {
+	if exception := node.exception.Load(); exception != nil {
+		err = exception.(error)
+		return
+	}
+	node.extendColumns()
+	node.block.RLock()
+	vec = node.data.Vecs[colIdx].CloneWindow(int(from), int(to-from), containers.DefaultAllocator)
+	node.block.RUnlock()
+	return
+}
+
 func (node *appendableNode) Close() (err error) {
 	if node.data != nil {
 		node.data.Close()
@@ -149,16 +195,26 @@ func (node *appendableNode) ApplyAppend(bat *containers.Batch, txn txnif.AsyncTx
 		err = exception.(error)
 		return
 	}
+	node.extendColumns()
 	schema := node.block.meta.GetSchema()
 	from = int(node.rows)
-	for srcPos, attr := range bat.Attrs {
-		def := schema.ColDefs[schema.GetColIdx(attr)]
+	// bat is laid out as the schema when the rows are appended, the columns
+	// keep their positions after the table is altered
+	for _, def := range schema.ColDefs {
 		if def.IsPhyAddr() {
 			continue
 		}
 		destVec := node.data.Vecs[def.Idx]
+		var srcVec containers.Vector
+		if def.Idx < len(bat.Vecs) {
+			srcVec = bat.Vecs[def.Idx]
+		} else {
+			// the rows are appended before the column is added
+			srcVec = makeFillVector(def, bat.Length(), nil)
+			defer srcVec.Close()
+		}
 		node.block.Lock()
-		destVec.Extend(bat.Vecs[srcPos])
+		destVec.Extend(srcVec)
 		node.block.Unlock()
 	}
 	if err = node.FillPhyAddrColumn(uint32(from), uint32(bat.Length())); err != nil {
@@ -33,6 +33,7 @@ import (
 type MVCCHandle struct {
 	*sync.RWMutex
 	columns         map[uint16]*ColumnChain
+	columnsMu       sync.RWMutex
 	deletes         *DeleteChain
 	holes           *roaring.Bitmap
 	meta            *catalog.BlockEntry
@@ -114,7 +115,7 @@ func (n *MVCCHandle) GetChangeNodeCnt() uint32 {
 }
 
 func (n *MVCCHandle) GetColumnUpdateCnt(colIdx uint16) uint32 {
-	return n.columns[colIdx].LoadUpdateCnt()
+	return n.getColumnChain(colIdx).LoadUpdateCnt()
 }
 
 func (n *MVCCHandle) GetDeleteCnt() uint32 {
@@ -137,7 +138,7 @@ func (n *MVCCHandle) StringLocked() string {
 	if n.deletes.DepthLocked() > 0 {
 		s = fmt.Sprintf("%s%s", s, n.deletes.StringLocked())
 	}
-	for _, chain := range n.columns {
+	for _, chain := range n.getColumnChains() {
 		chain.RLock()
 		if chain.DepthLocked() > 0 {
 			s = fmt.Sprintf("%s\n%s", s, chain.StringLocked())
@@ -149,7 +150,7 @@ func (n *MVCCHandle) StringLocked() string {
 }
 
 func (n *MVCCHandle) GetColumnExclusiveLock(idx uint16) sync.Locker {
-	col := n.columns[idx]
+	col := n.getColumnChain(idx)
 	col.Lock()
 	return col.RWMutex
 }
@@ -168,23 +169,23 @@ func (n *MVCCHandle) OnReplayDeleteNode(deleteNode txnif.DeleteNode) {
 }
 
 func (n *MVCCHandle) CreateUpdateNode(colIdx uint16, txn txnif.AsyncTxn) txnif.UpdateNode {
-	chain := n.columns[colIdx]
+	chain := n.getColumnChain(colIdx)
 	return chain.AddNodeLocked(txn)
 }
 
 func (n *MVCCHandle) DropUpdateNode(colIdx uint16, node txnif.UpdateNode) {
-	chain := n.columns[colIdx]
+	chain := n.getColumnChain(colIdx)
 	chain.DeleteNodeLocked(node.(*ColumnUpdateNode))
 }
 
 func (n *MVCCHandle) PrepareUpdate(row uint32, colIdx uint16, update txnif.UpdateNode) error {
 
-	chain := n.columns[colIdx]
+	chain := n.getColumnChain(colIdx)
 	return chain.PrepareUpdate(row, update)
 }
 
 func (n *MVCCHandle) CheckNotUpdated(start, end uint32, ts types.TS) (err error) {
-	for _, chain := range n.columns {
+	for _, chain := range n.getColumnChains() {
 		for i := start; i <= end; i++ {
 			if err = chain.view.PrepapreInsert(i, ts); err != nil {
 				return
@@ -195,7 +196,35 @@ func (n *MVCCHandle) CheckNotUpdated(start, end uint32, ts types.TS) (err error)
 }
 
 func (n *MVCCHandle) GetColumnChain(colIdx uint16) *ColumnChain {
-	return n.columns[colIdx]
+	return n.getColumnChain(colIdx)
+}
+
+// getColumnChain returns the update chain of a column, the chain of a column
+// added to the table after the block is loaded is created on the first use
+func (n *MVCCHandle) getColumnChain(colIdx uint16) *ColumnChain {
+	n.columnsMu.RLock()
+	chain := n.columns[colIdx]
+	n.columnsMu.RUnlock()
+	if chain != nil {
+		return chain
+	}
+	n.columnsMu.Lock()
+	defer n.columnsMu.Unlock()
+	if chain = n.columns[colIdx]; chain == nil {
+		chain = NewColumnChain(nil, colIdx, n)
+		n.columns[colIdx] = chain
+	}
+	return chain
+}
+
+func (n *MVCCHandle) getColumnChains() []*ColumnChain {
+	n.columnsMu.RLock()
+	defer n.columnsMu.RUnlock()
+	chains := make([]*ColumnChain, 0, len(n.columns))
+	for _, chain := range n.columns {
+		chains = append(chains, chain)
+	}
+	return chains
 }
 
 func (n *MVCCHandle) GetDeleteChain() *DeleteChain {
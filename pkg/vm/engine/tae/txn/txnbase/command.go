@@ -36,6 +36,10 @@ const (
 	CmdCustomized
 )
 
+const (
+	CmdTxnInfo int16 = 0x0300 + iota
+)
+
 func init() {
 	txnif.RegisterCmdFactory(CmdPointer, func(int16) txnif.TxnCmd {
 		return new(PointerCmd)
@@ -49,6 +53,9 @@ func init() {
 	txnif.RegisterCmdFactory(CmdComposed, func(int16) txnif.TxnCmd {
 		return new(ComposedCmd)
 	})
+	txnif.RegisterCmdFactory(CmdTxnInfo, func(int16) txnif.TxnCmd {
+		return new(TxnInfoCmd)
+	})
 }
 
 type CustomizedCmd interface {
@@ -86,6 +93,14 @@ type ComposedCmd struct {
 	CmdSize uint32
 }
 
+// TxnInfoCmd carries the info a txn was started with into its commit
+// record, so that the owner of the info can tell on replay which of its
+// txns were committed
+type TxnInfoCmd struct {
+	BaseCmd
+	Info []byte
+}
+
 type BaseCustomizedCmd struct {
 	BaseCmd
 	ID   uint32
@@ -99,6 +114,12 @@ func NewBaseCustomizedCmd(id uint32, impl txnif.TxnCmd) *BaseCustomizedCmd {
 	}
 }
 
+func NewTxnInfoCmd(info []byte) *TxnInfoCmd {
+	return &TxnInfoCmd{
+		Info: info,
+	}
+}
+
 func NewDeleteBitmapCmd(bitmap *roaring.Bitmap) *DeleteBitmapCmd {
 	return &DeleteBitmapCmd{
 		Bitmap: bitmap,
@@ -177,6 +198,62 @@ func (e *PointerCmd) Unmarshal(buf []byte) error {
 	return err
 }
 
+func (e *TxnInfoCmd) GetType() int16 {
+	return CmdTxnInfo
+}
+func (e *TxnInfoCmd) Desc() string {
+	return fmt.Sprintf("CmdName=TxnInfo;Size=%d", len(e.Info))
+}
+func (e *TxnInfoCmd) String() string {
+	return fmt.Sprintf("CmdName=TxnInfo;Info=%x", e.Info)
+}
+func (e *TxnInfoCmd) VerboseString() string {
+	return e.String()
+}
+func (e *TxnInfoCmd) WriteTo(w io.Writer) (n int64, err error) {
+	if err = binary.Write(w, binary.BigEndian, e.GetType()); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, uint32(len(e.Info))); err != nil {
+		return
+	}
+	var sn int
+	if sn, err = w.Write(e.Info); err != nil {
+		return
+	}
+	n = 6 + int64(sn)
+	return
+}
+
+func (e *TxnInfoCmd) Marshal() (buf []byte, err error) {
+	var bbuf bytes.Buffer
+	if _, err = e.WriteTo(&bbuf); err != nil {
+		return
+	}
+	buf = bbuf.Bytes()
+	return
+}
+
+func (e *TxnInfoCmd) ReadFrom(r io.Reader) (n int64, err error) {
+	var length uint32
+	if err = binary.Read(r, binary.BigEndian, &length); err != nil {
+		return
+	}
+	e.Info = make([]byte, length)
+	var sn int
+	if sn, err = io.ReadFull(r, e.Info); err != nil {
+		return
+	}
+	n = 4 + int64(sn)
+	return
+}
+
+func (e *TxnInfoCmd) Unmarshal(buf []byte) error {
+	bbuf := bytes.NewBuffer(buf)
+	_, err := e.ReadFrom(bbuf)
+	return err
+}
+
 func (e *DeleteBitmapCmd) GetType() int16 {
 	return CmdDeleteBitmap
 }
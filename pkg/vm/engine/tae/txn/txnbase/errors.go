@@ -22,6 +22,8 @@ var (
 	ErrTxnNotRollbacking   = errors.New("tae: txn not rollbacking")
 	ErrTxnNotActive        = errors.New("tae: txn not active")
 	ErrTxnCannotRollback   = errors.New("tae: txn cannot txn rollback")
+	ErrTxnCannotCommitAt   = errors.New("tae: txn cannot commit before it starts")
+	ErrTxnStartTSInUse     = errors.New("tae: txn start ts is in use")
 
 	ErrDDLDropCreated = errors.New("tae: DDL cannot drop created in a txn")
 
@@ -60,6 +60,7 @@ func (rel *TxnRelation) Rows() int64
 func (rel *TxnRelation) Size(attr string) int64                                      { return 0 }
 func (rel *TxnRelation) GetCardinality(attr string) int64                            { return 0 }
 func (rel *TxnRelation) Schema() any                                                 { return nil }
+func (rel *TxnRelation) AlterTable(fn any) error                                     { return nil }
 func (rel *TxnRelation) MakeSegmentIt() handle.SegmentIt                             { return nil }
 func (rel *TxnRelation) MakeBlockIt() handle.BlockIt                                 { return nil }
 func (rel *TxnRelation) BatchDedup(cols ...containers.Vector) error                  { return nil }
@@ -82,6 +83,9 @@ func (rel *TxnRelation) GetByFilter(*handle.Filter) (id *common.ID, offset uint3
 func (rel *TxnRelation) GetValueByFilter(filter *handle.Filter, col int) (v any, err error)   { return }
 func (rel *TxnRelation) UpdateByFilter(filter *handle.Filter, col uint16, v any) (err error)  { return }
 func (rel *TxnRelation) DeleteByFilter(filter *handle.Filter) (err error)                     { return }
+func (rel *TxnRelation) BatchGetByFilter(containers.Vector) (ids []*common.ID, offsets []uint32, err error) {
+	return
+}
 func (rel *TxnRelation) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	return
 }
@@ -32,6 +32,7 @@ func (store *NoopTxnStore) Close() error
 func (store *NoopTxnStore) Append(dbId, id uint64, data *containers.Batch) error { return nil }
 func (store *NoopTxnStore) PrepareRollback() error                               { return nil }
 func (store *NoopTxnStore) PrePrepare() error                                    { return nil }
+func (store *NoopTxnStore) CheckConflicts() error                                { return nil }
 func (store *NoopTxnStore) PrepareCommit() error                                 { return nil }
 func (store *NoopTxnStore) Prepare2PCPrepare() error                             { return nil }
 func (store *NoopTxnStore) ApplyRollback() error                                 { return nil }
@@ -79,6 +80,9 @@ func (store *NoopTxnStore) RangeDelete(uint64, *common.ID, uint32, uint32, handl
 func (store *NoopTxnStore) GetByFilter(uint64, uint64, *handle.Filter) (id *common.ID, offset uint32, err error) {
 	return
 }
+func (store *NoopTxnStore) BatchGetByFilter(uint64, uint64, containers.Vector) (ids []*common.ID, offsets []uint32, err error) {
+	return
+}
 func (store *NoopTxnStore) GetValue(uint64, *common.ID, uint32, uint16) (v any, err error) {
 	return
 }
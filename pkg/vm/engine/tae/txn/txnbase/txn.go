@@ -216,6 +216,22 @@ func (txn *Txn) Commit() (err error) {
 	return txn.commit1PC()
 }
 
+// CommitAt commits the txn at the timestamp decided by the coordinator of a
+// distributed transaction instead of an allocated one. The timestamp must be
+// after the start timestamp of the txn.
+func (txn *Txn) CommitAt(ts types.TS) (err error) {
+	if txn.Store.IsReadonly() {
+		return txn.Commit()
+	}
+	if !ts.Greater(txn.GetStartTS()) {
+		return ErrTxnCannotCommitAt
+	}
+	txn.Lock()
+	txn.CommitAtTS = ts
+	txn.Unlock()
+	return txn.Commit()
+}
+
 func (txn *Txn) GetStore() txnif.TxnStore {
 	return txn.Store
 }
@@ -40,9 +40,12 @@ type TxnCtx struct {
 	ID                           uint64
 	IDCtx                        []byte
 	StartTS, CommitTS, PrepareTS types.TS
-	Info                         []byte
-	State                        txnif.TxnState
-	Kind2PC                      bool
+	// CommitAtTS is the commit timestamp decided by the coordinator of a
+	// distributed transaction, the txn is committed at it if it is not empty
+	CommitAtTS types.TS
+	Info       []byte
+	State      txnif.TxnState
+	Kind2PC    bool
 }
 
 func NewTxnCtx(id uint64, start types.TS, info []byte) *TxnCtx {
@@ -87,6 +90,11 @@ func (ctx *TxnCtx) GetCommitTS() types.TS {
 	defer ctx.RUnlock()
 	return ctx.CommitTS
 }
+func (ctx *TxnCtx) GetCommitAtTS() types.TS {
+	ctx.RLock()
+	defer ctx.RUnlock()
+	return ctx.CommitAtTS
+}
 func (ctx *TxnCtx) GetPrepareTS() types.TS {
 	ctx.RLock()
 	defer ctx.RUnlock()
@@ -164,6 +164,31 @@ func (mgr *TxnManager) StartTxn(info []byte) (txn txnif.AsyncTxn, err error) {
 	return
 }
 
+// StartTxnAt starts a txn at a given ts instead of a new one, it's used to
+// restore a prepared txn which has to commit at a ts decided before the
+// restart. It fails if an active txn already starts at the ts.
+func (mgr *TxnManager) StartTxnAt(info []byte, startTs types.TS) (txn txnif.AsyncTxn, err error) {
+	if exp := mgr.Exception.Load(); exp != nil {
+		err = exp.(error)
+		logutil.Warnf("StartTxnAt: %v", err)
+		return
+	}
+	mgr.Lock()
+	defer mgr.Unlock()
+	if _, ok := mgr.Active.Get(startTs); ok {
+		err = ErrTxnStartTSInUse
+		return
+	}
+	txnId := mgr.IdAlloc.Alloc()
+
+	store := mgr.TxnStoreFactory()
+	txn = mgr.TxnFactory(mgr, store, txnId, startTs, info)
+	store.BindTxn(txn)
+	mgr.IDMap[txnId] = txn
+	mgr.Active.Set(startTs)
+	return
+}
+
 func (mgr *TxnManager) DeleteTxn(id uint64) {
 	mgr.Lock()
 	defer mgr.Unlock()
@@ -236,6 +261,9 @@ func (mgr *TxnManager) onBindPrepareTimeStamp(op *OpTxn) (ts types.TS) {
 	defer mgr.Unlock()
 
 	ts = mgr.TsAlloc.Alloc()
+	if commitAt := op.Txn.GetCommitAtTS(); op.Op == OpCommit && !commitAt.IsEmpty() {
+		ts = commitAt
+	}
 
 	op.Txn.Lock()
 	defer op.Txn.Unlock()
@@ -180,6 +180,12 @@ func (idx *simpleTableIndex) BatchInsert(col containers.Vector, start, count int
 		return InsertOp[types.Date](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_timestamp:
 		return InsertOp[types.Timestamp](col.Slice(), start, count, row, dedupInput, idx.tree)
+	case types.T_time:
+		return InsertOp[types.Time](col.Slice(), start, count, row, dedupInput, idx.tree)
+	case types.T_enum:
+		return InsertOp[types.Enum](col.Slice(), start, count, row, dedupInput, idx.tree)
+	case types.T_set:
+		return InsertOp[types.Set](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_datetime:
 		return InsertOp[types.Datetime](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_TS:
@@ -249,6 +255,12 @@ func (idx *simpleTableIndex) BatchDedup(col containers.Vector) error {
 		return DedupOp[float64](vals, idx.tree)
 	case types.T_date:
 		return DedupOp[types.Date](vals, idx.tree)
+	case types.T_time:
+		return DedupOp[types.Time](vals, idx.tree)
+	case types.T_enum:
+		return DedupOp[types.Enum](vals, idx.tree)
+	case types.T_set:
+		return DedupOp[types.Set](vals, idx.tree)
 	case types.T_datetime:
 		return DedupOp[types.Datetime](vals, idx.tree)
 	case types.T_timestamp:
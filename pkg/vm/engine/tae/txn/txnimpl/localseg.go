@@ -362,7 +362,7 @@ func (seg *localSegment) IsDeleted(row uint32) bool {
 }
 
 func (seg *localSegment) Update(row uint32, col uint16, value any) error {
-	if seg.table.entry.GetSchema().PhyAddrKey.Idx == int(col) {
+	if seg.table.schema.PhyAddrKey.Idx == int(col) {
 		return data.ErrUpdatePhyAddrKey
 	}
 	npos, noffset := seg.GetLocalPhysicalAxis(row)
@@ -417,7 +417,7 @@ func (seg *localSegment) GetByFilter(filter *handle.Filter) (id *common.ID, offs
 }
 
 func (seg *localSegment) GetPKColumn() containers.Vector {
-	schema := seg.table.entry.GetSchema()
+	schema := seg.table.schema
 	return seg.index.KeyToVector(schema.GetSortKeyType())
 }
 
@@ -340,7 +340,7 @@ func (n *insertNode) PrepareAppend(data *containers.Batch, offset uint32) uint32
 }
 
 func (n *insertNode) Append(data *containers.Batch, offset uint32) (an uint32, err error) {
-	schema := n.table.entry.GetSchema()
+	schema := n.table.schema
 	if n.data == nil {
 		n.data = containers.BuildBatch(
 			schema.AllNames(),
@@ -371,7 +371,7 @@ func (n *insertNode) FillPhyAddrColumn(startRow, length uint32) (err error) {
 		return
 	}
 	defer col.Close()
-	vec := n.data.Vecs[n.table.entry.GetSchema().PhyAddrKey.Idx]
+	vec := n.data.Vecs[n.table.schema.PhyAddrKey.Idx]
 	vec.Extend(col)
 	return
 }
@@ -137,6 +137,11 @@ func (h *txnRelation) SimplePPString(level common.PPLevel) string {
 func (h *txnRelation) Close() error   { return nil }
 func (h *txnRelation) GetMeta() any   { return h.table.entry }
 func (h *txnRelation) GetSchema() any { return h.table.entry.GetSchema() }
+func (h *txnRelation) Schema() any    { return h.table.GetSchema() }
+
+func (h *txnRelation) AlterTable(fn any) error {
+	return h.table.AlterTable(fn.(func(*catalog.Schema) error))
+}
 
 func (h *txnRelation) Rows() int64 {
 	if h.table.entry.GetDB().IsSystemDB() && h.table.entry.IsVirtual() {
@@ -194,6 +199,10 @@ func (h *txnRelation) GetByFilter(filter *handle.Filter) (*common.ID, uint32, er
 	return h.Txn.GetStore().GetByFilter(h.table.entry.GetDB().ID, h.table.entry.GetID(), filter)
 }
 
+func (h *txnRelation) BatchGetByFilter(keys containers.Vector) ([]*common.ID, []uint32, error) {
+	return h.Txn.GetStore().BatchGetByFilter(h.table.entry.GetDB().ID, h.table.entry.GetID(), keys)
+}
+
 func (h *txnRelation) GetValueByFilter(filter *handle.Filter, col int) (v any, err error) {
 	id, row, err := h.GetByFilter(filter)
 	if err != nil {
@@ -207,6 +207,14 @@ func (store *txnStore) GetByFilter(dbId, tid uint64, filter *handle.Filter) (id
 	return db.GetByFilter(tid, filter)
 }
 
+func (store *txnStore) BatchGetByFilter(dbId, tid uint64, keys containers.Vector) (ids []*common.ID, offsets []uint32, err error) {
+	db, err := store.getOrSetDB(dbId)
+	if err != nil {
+		return
+	}
+	return db.BatchGetByFilter(tid, keys)
+}
+
 func (store *txnStore) GetValue(dbId uint64, id *common.ID, row uint32, colIdx uint16) (v any, err error) {
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
@@ -435,6 +443,20 @@ func (store *txnStore) ApplyCommit() (err error) {
 	return
 }
 
+func (store *txnStore) CheckConflicts() (err error) {
+	if store.warChecker != nil {
+		if err = store.warChecker.check(); err != nil {
+			return
+		}
+	}
+	for _, db := range store.dbs {
+		if err = db.PrePrepareDedup(); err != nil {
+			return
+		}
+	}
+	return
+}
+
 func (store *txnStore) PrePrepare() (err error) {
 	for _, db := range store.dbs {
 		if err = db.PrePrepare(); err != nil {
@@ -473,6 +495,9 @@ func (store *txnStore) PreApplyCommit() (err error) {
 	if store.cmdMgr.GetCSN() == 0 {
 		return
 	}
+	if info := store.txn.GetInfo(); len(info) > 0 {
+		store.cmdMgr.AddInternalCmd(txnbase.NewTxnInfoCmd(info))
+	}
 
 	//TODO:How to distinguish prepare log of 2PC entry from commit log entry of 1PC?
 	logEntry, err := store.cmdMgr.ApplyTxnRecord(store.txn.GetID())
@@ -134,7 +134,11 @@ func (blk *txnSysBlock) processTable(entry *catalog.DBEntry, fn func(*catalog.Ta
 func (blk *txnSysBlock) columnRows() int {
 	rows := 0
 	fn := func(table *catalog.TableEntry) error {
-		rows += len(table.GetSchema().ColDefs)
+		for _, def := range table.GetSchema().ColDefs {
+			if !def.IsDropped() {
+				rows++
+			}
+		}
 		return nil
 	}
 	dbFn := func(db *catalog.DBEntry) error {
@@ -181,6 +185,9 @@ func (blk *txnSysBlock) getColumnTableData(colIdx int) (view *model.ColumnView,
 		schema := table.GetSchema()
 		tableID := table.GetID()
 		for i, colDef := range table.GetSchema().ColDefs {
+			if colDef.IsDropped() {
+				continue
+			}
 			switch col.Name {
 			case catalog.SystemColAttr_UniqName:
 				colData.Append([]byte(fmt.Sprintf("%d-%s", tableID, colDef.Name)))
@@ -22,6 +22,7 @@ import (
 
 	// "github.com/matrixorigin/matrixone/pkg/logutil"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
@@ -34,7 +35,8 @@ import (
 )
 
 var (
-	ErrDuplicateNode = errors.New("tae: duplicate node")
+	ErrDuplicateNode   = errors.New("tae: duplicate node")
+	ErrAlterDirtyTable = errors.New("tae: alter a table written by the txn")
 )
 
 type txnTable struct {
@@ -47,6 +49,7 @@ type txnTable struct {
 	deleteNodes  map[common.ID]txnif.DeleteNode
 	entry        *catalog.TableEntry
 	schema       *catalog.Schema
+	altered      bool
 	logs         []wal.LogEntry
 	maxSegId     uint64
 	maxBlkId     uint64
@@ -268,6 +271,30 @@ func (tbl *txnTable) SetDropEntry(e txnif.TxnEntry) error {
 	return nil
 }
 
+// AlterTable alters the schema of the table, the txn must not have written
+// the table before
+func (tbl *txnTable) AlterTable(fn func(*catalog.Schema) error) (err error) {
+	if tbl.IsDeleted() {
+		return catalog.ErrNotFound
+	}
+	if tbl.localSegment != nil || len(tbl.updateNodes) > 0 || len(tbl.deleteNodes) > 0 {
+		return ErrAlterDirtyTable
+	}
+	isNewNode, err := tbl.entry.AlterTable(tbl.store.txn, fn)
+	if err != nil {
+		return
+	}
+	tbl.altered = true
+	if isNewNode {
+		tbl.store.IncreateWriteCnt()
+		tbl.store.dirtyMemo.recordCatalogChange()
+		tbl.txnEntries = append(tbl.txnEntries, tbl.entry)
+		tbl.store.warChecker.ReadDB(tbl.entry.GetDB().GetID())
+	}
+	tbl.schema = tbl.entry.GetUpdateSchema()
+	return
+}
+
 func (tbl *txnTable) IsDeleted() bool {
 	return tbl.dropEntry != nil
 }
@@ -333,7 +360,43 @@ func (tbl *txnTable) GetSortColumns(data *containers.Batch) []containers.Vector
 
 // func (tbl *txnTable)
 
+// alignBatch lays the vectors of data out as the columns of the schema when
+// they are not, the columns missing from data are filled with nulls. The
+// vectors built are returned to be closed by the caller
+func (tbl *txnTable) alignBatch(data *containers.Batch) (*containers.Batch, []containers.Vector) {
+	aligned := len(data.Attrs) <= len(tbl.schema.ColDefs)
+	for i := 0; aligned && i < len(data.Attrs); i++ {
+		aligned = data.Attrs[i] == tbl.schema.ColDefs[i].Name
+	}
+	if aligned {
+		return data, nil
+	}
+	positions := make(map[string]int, len(data.Attrs))
+	for i, attr := range data.Attrs {
+		positions[attr] = i
+	}
+	bat := containers.NewBatch()
+	var built []containers.Vector
+	for _, def := range tbl.schema.ColDefs {
+		if pos, ok := positions[def.Name]; ok && !def.IsDropped() {
+			bat.AddVector(def.Name, data.Vecs[pos])
+			continue
+		}
+		vec := containers.MakeVector(def.Type, true)
+		for i := 0; i < data.Length(); i++ {
+			vec.Append(types.Null{})
+		}
+		built = append(built, vec)
+		bat.AddVector(def.Name, vec)
+	}
+	return bat, built
+}
+
 func (tbl *txnTable) Append(data *containers.Batch) (err error) {
+	data, built := tbl.alignBatch(data)
+	for _, vec := range built {
+		defer vec.Close()
+	}
 	if tbl.schema.IsSinglePK() {
 		if err = tbl.DoBatchDedup(data.Vecs[tbl.schema.GetSingleSortKeyIdx()]); err != nil {
 			return
@@ -445,6 +508,49 @@ func (tbl *txnTable) GetByFilter(filter *handle.Filter) (id *common.ID, offset u
 	return
 }
 
+// BatchGetByFilter looks up the keys in the rows written by the txn first, and then
+// in each committed block once for all keys not found yet.
+func (tbl *txnTable) BatchGetByFilter(keys containers.Vector) (ids []*common.ID, offsets []uint32, err error) {
+	n := keys.Length()
+	ids = make([]*common.ID, n)
+	offsets = make([]uint32, n)
+	found := roaring.New()
+	if tbl.localSegment != nil {
+		for i := 0; i < n; i++ {
+			id, offset, err := tbl.localSegment.GetByFilter(handle.NewEQFilter(keys.Get(i)))
+			if err == nil {
+				ids[i], offsets[i] = id, offset
+				found.Add(uint32(i))
+			}
+		}
+	}
+	h := newRelation(tbl)
+	blockIt := h.MakeBlockIt()
+	for blockIt.Valid() && found.GetCardinality() < uint64(n) {
+		h := blockIt.GetBlock()
+		if h.IsUncommitted() {
+			blockIt.Next()
+			continue
+		}
+		meta := h.GetMeta().(*catalog.BlockEntry)
+		before := found.GetCardinality()
+		if err = meta.GetBlockData().BatchGetByFilter(tbl.store.txn, keys, found, offsets); err != nil {
+			return
+		}
+		if found.GetCardinality() > before {
+			id := h.Fingerprint()
+			it := found.Iterator()
+			for it.HasNext() {
+				if i := it.Next(); ids[i] == nil {
+					ids[i] = id
+				}
+			}
+		}
+		blockIt.Next()
+	}
+	return
+}
+
 func (tbl *txnTable) GetLocalValue(row uint32, col uint16) (v any, err error) {
 	if tbl.localSegment == nil {
 		return
@@ -541,7 +647,15 @@ func (tbl *txnTable) UncommittedRows() uint32 {
 
 // PrePrepareDedup do deduplication check for 1PC Commit or 2PC Prepare
 func (tbl *txnTable) PrePrepareDedup() (err error) {
-	if tbl.localSegment == nil || !tbl.schema.HasPK() {
+	if tbl.localSegment == nil {
+		return
+	}
+	// the rows are laid out by the schema the txn read, they cannot be
+	// applied once another txn altered the table
+	if !tbl.altered && tbl.entry.GetSchema().Version != tbl.schema.Version {
+		return txnif.ErrTxnWWConflict
+	}
+	if !tbl.schema.HasPK() {
 		return
 	}
 	pks := tbl.localSegment.GetPKColumn()
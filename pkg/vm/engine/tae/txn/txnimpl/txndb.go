@@ -144,6 +144,18 @@ func (db *txnDB) GetByFilter(tid uint64, filter *handle.Filter) (id *common.ID,
 	return table.GetByFilter(filter)
 }
 
+func (db *txnDB) BatchGetByFilter(tid uint64, keys containers.Vector) (ids []*common.ID, offsets []uint32, err error) {
+	table, err := db.getOrSetTable(tid)
+	if err != nil {
+		return
+	}
+	if table.IsDeleted() {
+		err = data.ErrNotFound
+		return
+	}
+	return table.BatchGetByFilter(keys)
+}
+
 func (db *txnDB) GetValue(id *common.ID, row uint32, colIdx uint16) (v any, err error) {
 	table, err := db.getOrSetTable(id.TableID)
 	if err != nil {
@@ -350,12 +362,19 @@ func (db *txnDB) ApplyCommit() (err error) {
 	return
 }
 
-func (db *txnDB) PrePrepare() (err error) {
+func (db *txnDB) PrePrepareDedup() (err error) {
 	for _, table := range db.tables {
 		if err = table.PrePrepareDedup(); err != nil {
 			return
 		}
 	}
+	return
+}
+
+func (db *txnDB) PrePrepare() (err error) {
+	if err = db.PrePrepareDedup(); err != nil {
+		return
+	}
 	for _, table := range db.tables {
 		if err = table.PrePrepare(); err != nil {
 			panic(err)
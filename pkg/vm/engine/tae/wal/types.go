@@ -24,6 +24,9 @@ const (
 	GroupUC        = entry.GTUncommit
 	GroupC  uint32 = iota + 10
 	GroupCatalog
+	// GroupPrepare keeps the records of the 2PC transactions prepared by the
+	// txn storage on top of TAE
+	GroupPrepare
 )
 
 type Index = store.Index
@@ -38,6 +41,7 @@ type LogEntry entry.Entry
 type Driver interface {
 	GetCheckpointed() uint64
 	Checkpoint(indexes []*Index) (LogEntry, error)
+	RangeCheckpoint(group uint32, start, end uint64) (LogEntry, error)
 	AppendEntry(uint32, LogEntry) (uint64, error)
 	LoadEntry(groupID uint32, lsn uint64) (LogEntry, error)
 	GetCurrSeqNum() uint64
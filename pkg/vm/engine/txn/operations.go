@@ -57,10 +57,15 @@ func init() {
 	gob.Register(new(engine.ViewDef))
 	gob.Register(new(engine.CommentDef))
 	gob.Register(new(engine.PartitionDef))
+	gob.Register(new(engine.ConstraintDef))
 	gob.Register(new(engine.AttributeDef))
 	gob.Register(new(engine.IndexTableDef))
 	gob.Register(new(engine.PropertiesDef))
 	gob.Register(new(engine.PrimaryIndexDef))
+	gob.Register(new(engine.AddColumnDef))
+	gob.Register(new(engine.ModifyColumnDef))
+	gob.Register(new(engine.RenameColumnDef))
+	gob.Register(new(engine.RenameTableDef))
 
 	// register vector column types
 	gob.Register([]bool{})
@@ -58,6 +58,8 @@ type Attribute struct {
 	Comment string
 	// AutoIncrement is auto incr or not
 	AutoIncrement bool
+	// EnumValues is the comma separated value list of enum and set
+	EnumValues string
 }
 
 type PrimaryIndexDef struct {
@@ -119,6 +121,35 @@ type ViewDef struct {
 	View string
 }
 
+// ConstraintDef holds the marshaled plan.ConstraintDef of a table, adding it
+// to an existing table replaces the one the table had.
+type ConstraintDef struct {
+	Constraint string
+}
+
+// AddColumnDef adds a column to a table which may already hold rows. Fill is
+// the value of the column in those rows, encoded by types.EncodeValue, nil
+// means null.
+type AddColumnDef struct {
+	Attr Attribute
+	Fill []byte
+}
+
+// ModifyColumnDef replaces the definition of the column with the same name,
+// the data already written is kept as it is.
+type ModifyColumnDef struct {
+	Attr Attribute
+}
+
+type RenameColumnDef struct {
+	OldName string
+	NewName string
+}
+
+type RenameTableDef struct {
+	Name string
+}
+
 type TableDef interface {
 	tableDef()
 }
@@ -126,10 +157,15 @@ type TableDef interface {
 func (*CommentDef) tableDef()      {}
 func (*PartitionDef) tableDef()    {}
 func (*ViewDef) tableDef()         {}
+func (*ConstraintDef) tableDef()   {}
 func (*AttributeDef) tableDef()    {}
 func (*IndexTableDef) tableDef()   {}
 func (*PropertiesDef) tableDef()   {}
 func (*PrimaryIndexDef) tableDef() {}
+func (*AddColumnDef) tableDef()    {}
+func (*ModifyColumnDef) tableDef() {}
+func (*RenameColumnDef) tableDef() {}
+func (*RenameTableDef) tableDef()  {}
 
 type Relation interface {
 	Statistics
@@ -164,6 +200,27 @@ type Reader interface {
 	Read([]string, *plan.Expr, *mheap.Mheap) (*batch.Batch, error)
 }
 
+// PruneReader is implemented by the readers which skip the blocks through the indexes of the blocks
+// when no row of them can satisfy the filter, the number of the skipped blocks is shown in EXPLAIN ANALYZE.
+type PruneReader interface {
+	PrunedBlocks() int64
+}
+
+// KeyReader is implemented by the relations which are able to look up rows by the primary key,
+// a secondary index reads the rows it points to through it.
+type KeyReader interface {
+	// ReadByPrimaryKeys returns the visible rows whose primary key is in keys, a missing key is skipped.
+	ReadByPrimaryKeys(ctx context.Context, attrs []string, keys *vector.Vector, m *mheap.Mheap) (*batch.Batch, error)
+}
+
+// ZoneMapReader is implemented by the relations which keep the min and max values of a column
+// in the zone maps of the blocks, ANALYZE TABLE reads the range of the column from them.
+type ZoneMapReader interface {
+	// ColumnRange returns the min and max values of the column in the zone maps, the range may cover
+	// deleted rows. ok is false if a block of the relation has no zone map on the column.
+	ColumnRange(ctx context.Context, attr string) (min, max any, ok bool, err error)
+}
+
 type Database interface {
 	Relations(context.Context) ([]string, error)
 	Relation(context.Context, string) (Relation, error)
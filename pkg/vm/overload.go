@@ -17,9 +17,11 @@ package vm
 import (
 	"bytes"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/intersectall"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/jsontable"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/anti"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/full"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/intersect"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopanti"
@@ -55,9 +57,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/recursive"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/right"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/semi"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -80,6 +85,8 @@ var stringFunc = [...]func(any, *bytes.Buffer){
 	Projection: projection.String,
 	Anti:       anti.String,
 	Mark:       mark.String,
+	Right:      right.String,
+	Full:       full.String,
 
 	LoopJoin:   loopjoin.String,
 	LoopLeft:   loopleft.String,
@@ -103,6 +110,12 @@ var stringFunc = [...]func(any, *bytes.Buffer){
 	IntersectAll: intersectall.String,
 
 	HashBuild: hashbuild.String,
+
+	Window: window.String,
+
+	JsonTable: jsontable.String,
+
+	Recursive: recursive.String,
 }
 
 var prepareFunc = [...]func(*process.Process, any) error{
@@ -124,6 +137,8 @@ var prepareFunc = [...]func(*process.Process, any) error{
 	Projection: projection.Prepare,
 	Anti:       anti.Prepare,
 	Mark:       mark.Prepare,
+	Right:      right.Prepare,
+	Full:       full.Prepare,
 
 	LoopJoin:   loopjoin.Prepare,
 	LoopLeft:   loopleft.Prepare,
@@ -147,6 +162,12 @@ var prepareFunc = [...]func(*process.Process, any) error{
 	IntersectAll: intersectall.Prepare,
 
 	HashBuild: hashbuild.Prepare,
+
+	Window: window.Prepare,
+
+	JsonTable: jsontable.Prepare,
+
+	Recursive: recursive.Prepare,
 }
 
 var execFunc = [...]func(int, *process.Process, any) (bool, error){
@@ -168,6 +189,8 @@ var execFunc = [...]func(int, *process.Process, any) (bool, error){
 	Projection: projection.Call,
 	Anti:       anti.Call,
 	Mark:       mark.Call,
+	Right:      right.Call,
+	Full:       full.Call,
 	LoopJoin:   loopjoin.Call,
 	LoopLeft:   loopleft.Call,
 	LoopSingle: loopsingle.Call,
@@ -190,4 +213,10 @@ var execFunc = [...]func(int, *process.Process, any) (bool, error){
 	IntersectAll: intersectall.Call,
 
 	HashBuild: hashbuild.Call,
+
+	Window: window.Call,
+
+	JsonTable: jsontable.Call,
+
+	Recursive: recursive.Call,
 }
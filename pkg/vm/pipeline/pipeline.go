@@ -64,12 +64,17 @@ func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (bool, error) {
 		return false, err
 	}
 	for {
-		// read data from storage engine
-		if bat, err = r.Read(p.attrs, nil, proc.Mp()); err != nil {
-			return false, err
+		// read data from storage engine, the reader is not read any more once it is done,
+		// the instructions are run with no input until all of them return their results
+		if r != nil {
+			if bat, err = r.Read(p.attrs, nil, proc.Mp()); err != nil {
+				return false, err
+			}
 		}
 		if bat != nil {
 			bat.Cnt = 1
+		} else {
+			r = nil
 		}
 		// processing the batch according to the instructions
 		proc.Reg.InputBatch = bat
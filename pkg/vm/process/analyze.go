@@ -30,6 +30,7 @@ func NewAnalyzeInfo(nodeId int32) *AnalyzeInfo {
 		InputSize:    0,
 		OutputSize:   0,
 		MemorySize:   0,
+		PrunedBlocks: 0,
 	}
 }
 
@@ -62,3 +63,9 @@ func (a *analyze) Output(bat *batch.Batch) {
 		atomic.AddInt64(&a.analInfo.OutputRows, int64(bat.Length()))
 	}
 }
+
+func (a *analyze) Prune(blocks int64) {
+	if a.analInfo != nil {
+		atomic.AddInt64(&a.analInfo.PrunedBlocks, blocks)
+	}
+}
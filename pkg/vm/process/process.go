@@ -63,6 +63,7 @@ func NewFromProc(p *Process, ctx context.Context, regNumber int) *Process {
 	proc.AnalInfos = p.AnalInfos
 	proc.SessionInfo = p.SessionInfo
 	proc.FileService = p.FileService
+	proc.TxnChecks = p.TxnChecks
 
 	// reg and cancel
 	proc.Ctx = newctx
@@ -0,0 +1,80 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"context"
+	"sync"
+)
+
+// TxnCheck is a check which can only be done when the transaction commits,
+// such as a foreign key, which may be satisfied by a later statement.
+type TxnCheck interface {
+	Verify(ctx context.Context) error
+}
+
+// TxnChecks holds the checks of a transaction by key, so that the statements
+// of the transaction can add to the same check.
+type TxnChecks struct {
+	sync.Mutex
+	keys   []string
+	checks map[string]TxnCheck
+}
+
+func NewTxnChecks() *TxnChecks {
+	return &TxnChecks{
+		checks: make(map[string]TxnCheck),
+	}
+}
+
+// GetOrAdd returns the check of the key, the check is made by fn if there is none.
+func (c *TxnChecks) GetOrAdd(key string, fn func() TxnCheck) TxnCheck {
+	c.Lock()
+	defer c.Unlock()
+	if check, ok := c.checks[key]; ok {
+		return check
+	}
+	check := fn()
+	c.keys = append(c.keys, key)
+	c.checks[key] = check
+	return check
+}
+
+// Verify runs the checks in the order they are added, and removes them.
+func (c *TxnChecks) Verify(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+	c.Lock()
+	keys, checks := c.keys, c.checks
+	c.keys, c.checks = nil, make(map[string]TxnCheck)
+	c.Unlock()
+	for _, key := range keys {
+		if err := checks[key].Verify(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reset removes the checks without running them.
+func (c *TxnChecks) Reset() {
+	if c == nil {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.keys, c.checks = nil, make(map[string]TxnCheck)
+}
@@ -31,6 +31,7 @@ type Analyze interface {
 	Alloc(int64)
 	Input(*batch.Batch)
 	Output(*batch.Batch)
+	Prune(int64)
 }
 
 // WaitRegister channel
@@ -92,6 +93,8 @@ type AnalyzeInfo struct {
 	OutputSize int64
 	// MemorySize, memory alloc by node
 	MemorySize int64
+	// PrunedBlocks, number of blocks skipped by the indexes of the blocks when scanning a table
+	PrunedBlocks int64
 }
 
 // Process contains context used in query execution
@@ -120,6 +123,10 @@ type Process struct {
 	Cancel context.CancelFunc
 
 	FileService fileservice.FileService
+
+	// TxnChecks, checks done when the transaction commits, the checks are
+	// done right away if it is nil.
+	TxnChecks *TxnChecks
 }
 
 type analyze struct {
@@ -33,6 +33,8 @@ const (
 	Anti
 	Single
 	Mark
+	Right
+	Full
 
 	LoopJoin
 	LoopLeft
@@ -56,6 +58,12 @@ const (
 	IntersectAll
 
 	HashBuild
+
+	Window
+
+	JsonTable
+
+	Recursive
 )
 
 // Instruction contains relational algebra
@@ -57,12 +57,25 @@ func Run(ins Instructions, proc *process.Process) (end bool, err error) {
 			err = moerr.NewPanicError(e)
 		}
 	}()
-	for _, in := range ins {
+	for i := 0; i < len(ins); i++ {
+		in := ins[i]
+		// done is true if the input of the operator is done
+		done := end && proc.InputBatch() == nil
 		if ok, err = execFunc[in.Op](in.Idx, proc, in.Arg); err != nil {
 			return ok || end, err
 		}
 		if ok { // ok is true shows that at least one operator has done its work
 			end = true
+			continue
+		}
+		if done && proc.InputBatch() != nil {
+			// the operator returns its results left one batch at a time once its input
+			// is done, so the batch is passed down and the operator is called again
+			if _, err = Run(ins[i+1:], proc); err != nil {
+				return true, err
+			}
+			proc.SetInputBatch(nil)
+			i--
 		}
 	}
 	return end, err